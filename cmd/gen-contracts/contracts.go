@@ -0,0 +1,277 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"gopkg.in/yaml.v2"
+)
+
+// generatedHeader marks contract files that must not be edited by hand
+const generatedHeader = "# Code generated by cmd/gen-contracts from the registered tools; DO NOT EDIT.\n" +
+	"# Regenerate with: make contracts\n"
+
+// Contract is a contract file in the format read by the BDD contract steps
+type Contract struct {
+	Feature string                  `yaml:"feature"`
+	Version string                  `yaml:"version"`
+	Tools   map[string]ToolContract `yaml:"tools"`
+}
+
+// ToolContract describes one tool's version, parameters, response and
+// error codes
+type ToolContract struct {
+	Description      string                     `yaml:"description"`
+	Version          int                        `yaml:"version"`
+	Deprecated       *DeprecationContract       `yaml:"deprecated,omitempty"`
+	RequiredParams   []string                   `yaml:"required_params"`
+	OptionalParams   []string                   `yaml:"optional_params"`
+	ParamConstraints map[string]ParamConstraint `yaml:"param_constraints,omitempty"`
+	SuccessResponse  *ResponseContract          `yaml:"success_response,omitempty"`
+	ErrorCodes       []int                      `yaml:"error_codes"`
+}
+
+// ParamConstraint is the part of a parameter's JSON Schema the contract tracks
+type ParamConstraint struct {
+	Type      string   `yaml:"type"`
+	Minimum   *float64 `yaml:"minimum,omitempty"`
+	Maximum   *float64 `yaml:"maximum,omitempty"`
+	MinLength *int     `yaml:"min_length,omitempty"`
+	MaxLength *int     `yaml:"max_length,omitempty"`
+	Format    string   `yaml:"format,omitempty"`
+	Enum      []any    `yaml:"enum,omitempty"`
+	Default   any      `yaml:"default,omitempty"`
+}
+
+// DeprecationContract records that a tool is deprecated and what replaces it
+type DeprecationContract struct {
+	Replacement string `yaml:"replacement,omitempty"`
+	Message     string `yaml:"message"`
+}
+
+// ResponseContract lists the fields of a tool's structured output
+type ResponseContract struct {
+	RequiredFields []string `yaml:"required_fields"`
+	OptionalFields []string `yaml:"optional_fields"`
+}
+
+// contractFile groups tools into one generated file
+type contractFile struct {
+	name    string
+	feature string
+	matches func(tool string) bool
+}
+
+// contractFiles are the generated files; a tool goes to the first match
+var contractFiles = []contractFile{
+	{
+		name:    "actor_tools.yaml",
+		feature: "Actor Management Tool Contracts",
+		matches: func(tool string) bool { return strings.Contains(tool, "actor") },
+	},
+	{
+		name:    "movie_tools.yaml",
+		feature: "Movie Management Tool Contracts",
+		matches: func(string) bool { return true },
+	},
+}
+
+// contractVersion is the version written to every generated file
+const contractVersion = "1.0"
+
+// schema is the subset of JSON Schema read from tool input and output schemas
+type schema struct {
+	Type       any                `json:"type"`
+	Properties map[string]*schema `json:"properties"`
+	Required   []string           `json:"required"`
+	Minimum    *float64           `json:"minimum"`
+	Maximum    *float64           `json:"maximum"`
+	MinLength  *int               `json:"minLength"`
+	MaxLength  *int               `json:"maxLength"`
+	Format     string             `json:"format"`
+	Enum       []any              `json:"enum"`
+	Default    any                `json:"default"`
+}
+
+// toolMeta is the part of a tool's _meta the contract tracks
+type toolMeta struct {
+	Version     int `json:"version"`
+	Deprecation *struct {
+		Replacement string `json:"replacement"`
+		Message     string `json:"message"`
+	} `json:"deprecation"`
+}
+
+// decodeToolMeta reads a tool's version, 1 when unset, and deprecation
+func decodeToolMeta(tool *mcp.Tool) (toolMeta, error) {
+	meta := toolMeta{Version: 1}
+	if len(tool.Meta) == 0 {
+		return meta, nil
+	}
+	data, err := json.Marshal(tool.Meta)
+	if err != nil {
+		return toolMeta{}, err
+	}
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return toolMeta{}, err
+	}
+	return meta, nil
+}
+
+// typeName returns the schema's type, ignoring "null" in a nullable union
+func (s *schema) typeName() string {
+	switch t := s.Type.(type) {
+	case string:
+		return t
+	case []any:
+		for _, name := range t {
+			if name, ok := name.(string); ok && name != "null" {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// decodeSchema converts a tool schema, which the client sees as decoded JSON,
+// into a schema
+func decodeSchema(raw any) (*schema, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	var s schema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// splitFields returns a schema's required and optional property names, sorted
+func splitFields(s *schema) (required, optional []string) {
+	isRequired := make(map[string]bool, len(s.Required))
+	for _, name := range s.Required {
+		isRequired[name] = true
+	}
+	for name := range s.Properties {
+		if isRequired[name] {
+			required = append(required, name)
+		} else {
+			optional = append(optional, name)
+		}
+	}
+	sort.Strings(required)
+	sort.Strings(optional)
+	return required, optional
+}
+
+// toolContract builds the contract of one tool
+func toolContract(tool *mcp.Tool, errorCodes []int) (ToolContract, error) {
+	meta, err := decodeToolMeta(tool)
+	if err != nil {
+		return ToolContract{}, fmt.Errorf("tool %s: invalid _meta: %w", tool.Name, err)
+	}
+	contract := ToolContract{
+		Description:    tool.Description,
+		Version:        meta.Version,
+		RequiredParams: []string{},
+		OptionalParams: []string{},
+		ErrorCodes:     errorCodes,
+	}
+	if meta.Deprecation != nil {
+		contract.Deprecated = &DeprecationContract{
+			Replacement: meta.Deprecation.Replacement,
+			Message:     meta.Deprecation.Message,
+		}
+	}
+
+	input, err := decodeSchema(tool.InputSchema)
+	if err != nil {
+		return ToolContract{}, fmt.Errorf("tool %s: invalid input schema: %w", tool.Name, err)
+	}
+	if input != nil {
+		required, optional := splitFields(input)
+		contract.RequiredParams = append(contract.RequiredParams, required...)
+		contract.OptionalParams = append(contract.OptionalParams, optional...)
+		for name, property := range input.Properties {
+			if contract.ParamConstraints == nil {
+				contract.ParamConstraints = make(map[string]ParamConstraint)
+			}
+			contract.ParamConstraints[name] = ParamConstraint{
+				Type:      property.typeName(),
+				Minimum:   property.Minimum,
+				Maximum:   property.Maximum,
+				MinLength: property.MinLength,
+				MaxLength: property.MaxLength,
+				Format:    property.Format,
+				Enum:      property.Enum,
+				Default:   property.Default,
+			}
+		}
+	}
+
+	output, err := decodeSchema(tool.OutputSchema)
+	if err != nil {
+		return ToolContract{}, fmt.Errorf("tool %s: invalid output schema: %w", tool.Name, err)
+	}
+	if output != nil {
+		required, optional := splitFields(output)
+		contract.SuccessResponse = &ResponseContract{
+			RequiredFields: append([]string{}, required...),
+			OptionalFields: append([]string{}, optional...),
+		}
+	}
+
+	return contract, nil
+}
+
+// buildContracts renders the contract files for tools, keyed by file name
+func buildContracts(tools []*mcp.Tool, errorCodes []int) (map[string][]byte, error) {
+	contracts := make(map[string]*Contract, len(contractFiles))
+	for _, file := range contractFiles {
+		contracts[file.name] = &Contract{
+			Feature: file.feature,
+			Version: contractVersion,
+			Tools:   make(map[string]ToolContract),
+		}
+	}
+
+	all := make(map[string]ToolContract, len(tools))
+	for _, tool := range tools {
+		contract, err := toolContract(tool, errorCodes)
+		if err != nil {
+			return nil, err
+		}
+		all[tool.Name] = contract
+		for _, file := range contractFiles {
+			if file.matches(tool.Name) {
+				contracts[file.name].Tools[tool.Name] = contract
+				break
+			}
+		}
+	}
+
+	if err := checkVersions(all); err != nil {
+		return nil, err
+	}
+
+	rendered := make(map[string][]byte, len(contracts))
+	for name, contract := range contracts {
+		data, err := yaml.Marshal(contract)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render %s: %w", name, err)
+		}
+		var buf bytes.Buffer
+		buf.WriteString(generatedHeader)
+		buf.Write(data)
+		rendered[name] = buf.Bytes()
+	}
+	return rendered, nil
+}
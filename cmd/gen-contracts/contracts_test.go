@@ -0,0 +1,122 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"gopkg.in/yaml.v2"
+)
+
+func TestToolContract(t *testing.T) {
+	tool := &mcp.Tool{
+		Name:        "add_movie",
+		Description: "Add a new movie",
+		InputSchema: map[string]any{
+			"type":     "object",
+			"required": []any{"year", "title"},
+			"properties": map[string]any{
+				"title":  map[string]any{"type": "string", "minLength": 1, "maxLength": 255},
+				"year":   map[string]any{"type": "integer", "minimum": 1888},
+				"rating": map[string]any{"type": []any{"null", "number"}, "maximum": 10},
+				"genre":  map[string]any{"type": "string", "enum": []any{"Drama", "Comedy"}},
+			},
+		},
+		OutputSchema: map[string]any{
+			"type":       "object",
+			"required":   []any{"id"},
+			"properties": map[string]any{"id": map[string]any{"type": "integer"}, "rating": map[string]any{"type": "number"}},
+		},
+	}
+
+	contract, err := toolContract(tool, []int{-32602})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if !reflect.DeepEqual(contract.RequiredParams, []string{"title", "year"}) {
+		t.Errorf("Expected required params [title year], got: %v", contract.RequiredParams)
+	}
+	if !reflect.DeepEqual(contract.OptionalParams, []string{"genre", "rating"}) {
+		t.Errorf("Expected optional params [genre rating], got: %v", contract.OptionalParams)
+	}
+	if got := contract.ParamConstraints["rating"].Type; got != "number" {
+		t.Errorf("Expected nullable rating to have type number, got: %q", got)
+	}
+	if title := contract.ParamConstraints["title"]; title.MinLength == nil || *title.MinLength != 1 || title.MaxLength == nil || *title.MaxLength != 255 {
+		t.Errorf("Expected title length 1..255, got: %+v", title)
+	}
+	if year := contract.ParamConstraints["year"]; year.Minimum == nil || *year.Minimum != 1888 || year.Maximum != nil {
+		t.Errorf("Expected year minimum 1888 and no maximum, got: %+v", year)
+	}
+	if got := contract.ParamConstraints["genre"].Enum; len(got) != 2 {
+		t.Errorf("Expected genre enum of 2 values, got: %v", got)
+	}
+	if contract.SuccessResponse == nil {
+		t.Fatal("Expected a success response")
+	}
+	if !reflect.DeepEqual(contract.SuccessResponse.RequiredFields, []string{"id"}) ||
+		!reflect.DeepEqual(contract.SuccessResponse.OptionalFields, []string{"rating"}) {
+		t.Errorf("Expected response fields [id] and [rating], got: %+v", contract.SuccessResponse)
+	}
+}
+
+func TestToolContract_WithoutOutputSchema(t *testing.T) {
+	contract, err := toolContract(&mcp.Tool{Name: "ping", InputSchema: map[string]any{"type": "object"}}, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if contract.SuccessResponse != nil {
+		t.Errorf("Expected no success response, got: %+v", contract.SuccessResponse)
+	}
+	if contract.RequiredParams == nil || contract.OptionalParams == nil {
+		t.Error("Expected empty rather than nil parameter lists")
+	}
+}
+
+func TestBuildContracts(t *testing.T) {
+	tools := []*mcp.Tool{
+		{Name: "get_movie", InputSchema: map[string]any{"type": "object"}},
+		{Name: "get_actor", InputSchema: map[string]any{"type": "object"}},
+		{Name: "get_movie_cast", InputSchema: map[string]any{"type": "object"}},
+	}
+
+	rendered, err := buildContracts(tools, []int{-32602})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	tests := []struct {
+		file  string
+		tools []string
+	}{
+		{file: "actor_tools.yaml", tools: []string{"get_actor"}},
+		{file: "movie_tools.yaml", tools: []string{"get_movie", "get_movie_cast"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			data, ok := rendered[tt.file]
+			if !ok {
+				t.Fatalf("Expected %s to be generated", tt.file)
+			}
+			if !strings.HasPrefix(string(data), generatedHeader) {
+				t.Error("Expected the generated header")
+			}
+
+			var contract Contract
+			if err := yaml.Unmarshal(data, &contract); err != nil {
+				t.Fatalf("Expected valid YAML, got: %v", err)
+			}
+			if len(contract.Tools) != len(tt.tools) {
+				t.Errorf("Expected %d tools, got: %d", len(tt.tools), len(contract.Tools))
+			}
+			for _, name := range tt.tools {
+				if _, ok := contract.Tools[name]; !ok {
+					t.Errorf("Expected tool %s in %s", name, tt.file)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+// Command gen-contracts generates the BDD tool contracts in
+// tests/bdd/contracts from the tools the server actually registers.
+//
+// It builds and starts cmd/server-sdk over stdio, lists the tools and writes
+// one contract per tool from its version, deprecation and input and output
+// schemas. It refuses to write contracts that break a tool at its current
+// version, unless -allow-breaking is set: incompatible changes belong in a
+// new version of the tool, registered next to the old one. With -verify it
+// writes nothing and exits non-zero when a contract file differs from what
+// would be generated, so hand edits and unregenerated changes are caught.
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/mcp/middleware"
+	"github.com/francknouama/movies-mcp-server/pkg/mcptest"
+)
+
+func main() {
+	root := flag.String("root", ".", "Repository root")
+	out := flag.String("out", "tests/bdd/contracts", "Contract directory, relative to -root")
+	server := flag.String("server", "", "Server binary to introspect (built from cmd/server-sdk if empty)")
+	verify := flag.Bool("verify", false, "Fail if the contract files are out of date instead of writing them")
+	allowBreaking := flag.Bool("allow-breaking", false, "Write contracts that break a tool at its current version")
+	timeout := flag.Duration("timeout", 2*time.Minute, "Time allowed to build and query the server")
+	flag.Parse()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	if err := run(ctx, *root, *out, *server, *verify, *allowBreaking); err != nil {
+		fmt.Fprintf(os.Stderr, "gen-contracts: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run generates the contracts and writes or verifies them
+func run(ctx context.Context, root, out, server string, verify, allowBreaking bool) error {
+	workDir, err := os.MkdirTemp("", "gen-contracts-")
+	if err != nil {
+		return fmt.Errorf("failed to create work directory: %w", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	if server == "" {
+		server = filepath.Join(workDir, "movies-server")
+		if err := mcptest.BuildServer(ctx, root, server); err != nil {
+			return err
+		}
+	}
+
+	tools, err := listTools(ctx, root, server, filepath.Join(workDir, "contracts.db"))
+	if err != nil {
+		return err
+	}
+
+	contracts, err := buildContracts(tools, middleware.ErrorCodes())
+	if err != nil {
+		return err
+	}
+
+	dir := out
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(root, dir)
+	}
+	if verify {
+		return verifyContracts(dir, contracts)
+	}
+	if !allowBreaking {
+		if err := checkBreakingChanges(dir, contracts); err != nil {
+			return err
+		}
+	}
+	return writeContracts(dir, contracts)
+}
+
+// listTools starts the server with every optional tool enabled and returns
+// its tools sorted by name
+func listTools(ctx context.Context, root, server, dbPath string) ([]*mcp.Tool, error) {
+	cmd := exec.Command(server)
+	cmd.Dir = root
+	cmd.Env = append(os.Environ(),
+		"DB_NAME="+dbPath,
+		"DATABASE_URL=",
+		"WRITE_QUEUE_ENABLED=true",
+		"TMDB_API_KEY=", // Tool descriptions must not depend on the local setup
+		"LOG_LEVEL=error",
+	)
+
+	client, err := mcptest.ConnectCommand(ctx, cmd, &mcptest.Options{
+		ClientInfo: &mcp.Implementation{Name: "gen-contracts", Version: "1.0.0"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+	defer client.Close()
+
+	tools, err := client.ListTools(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(tools, func(i, j int) bool { return tools[i].Name < tools[j].Name })
+	return tools, nil
+}
+
+// checkBreakingChanges fails when contracts break a tool in the contract
+// files in dir at its current version
+func checkBreakingChanges(dir string, contracts map[string][]byte) error {
+	previous, err := readContracts(dir)
+	if err != nil {
+		return err
+	}
+	current, err := renderedTools(contracts)
+	if err != nil {
+		return err
+	}
+	if problems := checkCompatibility(previous, current); len(problems) > 0 {
+		return fmt.Errorf("breaking tool changes: %s; register a new version of each tool with middleware.Versioned, "+
+			"deprecating the old one with middleware.Deprecated, or pass -allow-breaking", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// writeContracts replaces the contract files in dir
+func writeContracts(dir string, contracts map[string][]byte) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", dir, err)
+	}
+	for _, name := range sortedNames(contracts) {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, contracts[name], 0o644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		fmt.Fprintf(os.Stderr, "wrote %s\n", path)
+	}
+	return nil
+}
+
+// verifyContracts reports the contract files in dir that differ from contracts
+func verifyContracts(dir string, contracts map[string][]byte) error {
+	var drifted []string
+	for _, name := range sortedNames(contracts) {
+		current, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to read %s: %w", name, err)
+		}
+		if !bytes.Equal(current, contracts[name]) {
+			drifted = append(drifted, name)
+		}
+	}
+	if len(drifted) > 0 {
+		return fmt.Errorf("contracts in %s are out of date: %v; run make contracts", dir, drifted)
+	}
+	fmt.Fprintf(os.Stderr, "contracts in %s are up to date\n", dir)
+	return nil
+}
+
+// sortedNames returns the file names of contracts in order
+func sortedNames(contracts map[string][]byte) []string {
+	names := make([]string, 0, len(contracts))
+	for name := range contracts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// versionSuffix matches the _v<version> suffix of a tool registered after
+// its first version
+var versionSuffix = regexp.MustCompile(`_v(\d+)$`)
+
+// checkVersions checks the tools' version metadata: every tool is named for
+// its version, and every deprecated tool's replacement is registered
+func checkVersions(contracts map[string]ToolContract) error {
+	var problems []string
+	for _, name := range sortedTools(contracts) {
+		contract := contracts[name]
+		named := 1
+		if match := versionSuffix.FindStringSubmatch(name); match != nil {
+			named, _ = strconv.Atoi(match[1])
+		}
+		if contract.Version != named {
+			problems = append(problems, fmt.Sprintf("%s is version %d but named for version %d", name, contract.Version, named))
+		}
+		if deprecated := contract.Deprecated; deprecated != nil && deprecated.Replacement != "" {
+			if _, ok := contracts[deprecated.Replacement]; !ok {
+				problems = append(problems, fmt.Sprintf("%s is replaced by %s, which is not registered", name, deprecated.Replacement))
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid tool versions: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// checkCompatibility returns the breaking changes between the previous and
+// current contracts. Dropping or retyping a parameter, requiring a new one or
+// no longer returning a field breaks a tool, so such changes take a new
+// version registered next to it; a tool may only be removed once deprecated.
+func checkCompatibility(previous, current map[string]ToolContract) []string {
+	var problems []string
+	for _, name := range sortedTools(previous) {
+		before := previous[name]
+		after, ok := current[name]
+		switch {
+		case !ok:
+			if before.Deprecated == nil {
+				problems = append(problems, name+" was removed without being deprecated first")
+			}
+			continue
+		case toolVersion(after) < toolVersion(before):
+			problems = append(problems, fmt.Sprintf("%s went from version %d to %d", name, toolVersion(before), toolVersion(after)))
+			continue
+		case toolVersion(after) > toolVersion(before):
+			continue
+		}
+
+		params := make(map[string]bool)
+		for _, param := range after.RequiredParams {
+			params[param] = true
+		}
+		for _, param := range after.OptionalParams {
+			params[param] = false
+		}
+		for _, param := range append(append([]string{}, before.RequiredParams...), before.OptionalParams...) {
+			if _, ok := params[param]; !ok {
+				problems = append(problems, fmt.Sprintf("%s drops parameter %s", name, param))
+				continue
+			}
+			if was, is := before.ParamConstraints[param].Type, after.ParamConstraints[param].Type; was != is {
+				problems = append(problems, fmt.Sprintf("%s changes parameter %s from %s to %s", name, param, was, is))
+			}
+		}
+		for _, param := range after.RequiredParams {
+			if !slices.Contains(before.RequiredParams, param) {
+				problems = append(problems, fmt.Sprintf("%s requires parameter %s", name, param))
+			}
+		}
+
+		if before.SuccessResponse == nil {
+			continue
+		}
+		var returned []string
+		if after.SuccessResponse != nil {
+			returned = after.SuccessResponse.RequiredFields
+		}
+		for _, field := range before.SuccessResponse.RequiredFields {
+			if !slices.Contains(returned, field) {
+				problems = append(problems, fmt.Sprintf("%s no longer always returns %s", name, field))
+			}
+		}
+	}
+	return problems
+}
+
+// toolVersion returns a contract's version; contracts from before tools
+// were versioned are version 1
+func toolVersion(contract ToolContract) int {
+	if contract.Version == 0 {
+		return 1
+	}
+	return contract.Version
+}
+
+// readContracts returns the tools in the contract files in dir, keyed by
+// name, or none when the files do not exist yet
+func readContracts(dir string) (map[string]ToolContract, error) {
+	tools := make(map[string]ToolContract)
+	for _, file := range contractFiles {
+		data, err := os.ReadFile(filepath.Join(dir, file.name))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", file.name, err)
+		}
+		if err := mergeContractTools(tools, data); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", file.name, err)
+		}
+	}
+	return tools, nil
+}
+
+// renderedTools returns the tools in rendered contract files, keyed by name
+func renderedTools(contracts map[string][]byte) (map[string]ToolContract, error) {
+	tools := make(map[string]ToolContract)
+	for name, data := range contracts {
+		if err := mergeContractTools(tools, data); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", name, err)
+		}
+	}
+	return tools, nil
+}
+
+// mergeContractTools adds the tools of a contract file to tools
+func mergeContractTools(tools map[string]ToolContract, data []byte) error {
+	var contract Contract
+	if err := yaml.Unmarshal(data, &contract); err != nil {
+		return err
+	}
+	for name, tool := range contract.Tools {
+		tools[name] = tool
+	}
+	return nil
+}
+
+// sortedTools returns the names of tools in order
+func sortedTools(tools map[string]ToolContract) []string {
+	names := make([]string, 0, len(tools))
+	for name := range tools {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestToolContract_Versions(t *testing.T) {
+	tool := &mcp.Tool{
+		Name:        "search_movies",
+		InputSchema: map[string]any{"type": "object"},
+		Meta: mcp.Meta{
+			"version":     float64(1),
+			"deprecation": map[string]any{"replacement": "search_movies_v2", "message": "use cursors"},
+		},
+	}
+	contract, err := toolContract(tool, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	want := &DeprecationContract{Replacement: "search_movies_v2", Message: "use cursors"}
+	if contract.Version != 1 || !reflect.DeepEqual(contract.Deprecated, want) {
+		t.Errorf("Expected version 1 deprecated for search_movies_v2, got version %d, %+v", contract.Version, contract.Deprecated)
+	}
+
+	if contract, _ := toolContract(&mcp.Tool{Name: "ping"}, nil); contract.Version != 1 || contract.Deprecated != nil {
+		t.Errorf("Expected an unversioned tool to be version 1, got %+v", contract)
+	}
+}
+
+func TestCheckVersions(t *testing.T) {
+	tests := []struct {
+		name      string
+		contracts map[string]ToolContract
+		wantErr   string
+	}{
+		{
+			name: "versions side by side",
+			contracts: map[string]ToolContract{
+				"search_movies":    {Version: 1, Deprecated: &DeprecationContract{Replacement: "search_movies_v2"}},
+				"search_movies_v2": {Version: 2},
+			},
+		},
+		{
+			name:      "version not in name",
+			contracts: map[string]ToolContract{"search_movies": {Version: 2}},
+			wantErr:   "search_movies is version 2 but named for version 1",
+		},
+		{
+			name:      "replacement not registered",
+			contracts: map[string]ToolContract{"search_movies": {Version: 1, Deprecated: &DeprecationContract{Replacement: "search_movies_v2"}}},
+			wantErr:   "replaced by search_movies_v2, which is not registered",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkVersions(tt.contracts)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestCheckCompatibility(t *testing.T) {
+	previous := map[string]ToolContract{
+		"get_movie": {
+			RequiredParams:   []string{"movie_id"},
+			OptionalParams:   []string{"language"},
+			ParamConstraints: map[string]ParamConstraint{"movie_id": {Type: "integer"}, "language": {Type: "string"}},
+			SuccessResponse:  &ResponseContract{RequiredFields: []string{"id", "title"}},
+		},
+		"search_movies": {Version: 1, Deprecated: &DeprecationContract{Replacement: "search_movies_v2"}},
+		"list_movies":   {Version: 1},
+	}
+
+	additive := map[string]ToolContract{
+		"get_movie": {
+			Version:          1,
+			RequiredParams:   []string{"movie_id"},
+			OptionalParams:   []string{"language", "fields"},
+			ParamConstraints: map[string]ParamConstraint{"movie_id": {Type: "integer"}, "language": {Type: "string"}, "fields": {Type: "array"}},
+			SuccessResponse:  &ResponseContract{RequiredFields: []string{"id", "title"}, OptionalFields: []string{"rating"}},
+		},
+		"list_movies": {Version: 1},
+	}
+	if problems := checkCompatibility(previous, additive); len(problems) != 0 {
+		t.Errorf("Expected additive changes and removing a deprecated tool to pass, got: %v", problems)
+	}
+
+	breaking := map[string]ToolContract{
+		"get_movie": {
+			Version:          1,
+			RequiredParams:   []string{"movie_id", "region"},
+			ParamConstraints: map[string]ParamConstraint{"movie_id": {Type: "string"}, "region": {Type: "string"}},
+			SuccessResponse:  &ResponseContract{RequiredFields: []string{"id"}, OptionalFields: []string{"title"}},
+		},
+		"search_movies": {Version: 1},
+	}
+	want := []string{
+		"get_movie drops parameter language",
+		"get_movie changes parameter movie_id from integer to string",
+		"get_movie requires parameter region",
+		"get_movie no longer always returns title",
+		"list_movies was removed without being deprecated first",
+	}
+	got := checkCompatibility(previous, breaking)
+	for _, problem := range want {
+		if !slices.Contains(got, problem) {
+			t.Errorf("Expected %q among %v", problem, got)
+		}
+	}
+	if len(got) != len(want) {
+		t.Errorf("Expected %d problems, got: %v", len(want), got)
+	}
+}
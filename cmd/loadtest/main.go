@@ -0,0 +1,211 @@
+// Command loadtest measures the server's latency under load.
+//
+// It builds and starts cmd/server-sdk over stdio on a scratch database, seeds
+// it, then sends a weighted mix of tool calls and resource reads from a
+// number of concurrent workers for a fixed duration or request count. It
+// prints p50/p95/p99 latency and error rates per operation, optionally writes
+// them as a JSON report, and exits non-zero when a threshold is exceeded, so
+// CI can gate on the results.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/pkg/mcptest"
+)
+
+// options are the command's flags
+type options struct {
+	root         string
+	server       string
+	mixPath      string
+	seed         string
+	concurrency  int
+	duration     time.Duration
+	requests     int
+	timeout      time.Duration
+	reportPath   string
+	thresholds   Thresholds
+	writeQueueOn bool
+}
+
+func main() {
+	var opts options
+	flag.StringVar(&opts.root, "root", ".", "Repository root")
+	flag.StringVar(&opts.server, "server", "", "Server binary to load (built from cmd/server-sdk if empty)")
+	flag.StringVar(&opts.mixPath, "mix", "", "JSON file with the operation mix (built-in read-heavy mix if empty)")
+	flag.StringVar(&opts.seed, "seed", "classics", "Dataset to seed with seed_database before the run (empty to skip)")
+	flag.IntVar(&opts.concurrency, "concurrency", 8, "Number of concurrent workers")
+	flag.DurationVar(&opts.duration, "duration", 10*time.Second, "How long to send requests; ignored when -requests is set")
+	flag.IntVar(&opts.requests, "requests", 0, "Total number of requests to send")
+	flag.DurationVar(&opts.timeout, "timeout", 10*time.Second, "Timeout of each request")
+	flag.StringVar(&opts.reportPath, "report", "", "Write the JSON report to this file ('-' for stdout)")
+	flag.DurationVar(&opts.thresholds.MaxP95, "max-p95", 0, "Fail if the overall p95 latency exceeds this")
+	flag.DurationVar(&opts.thresholds.MaxP99, "max-p99", 0, "Fail if the overall p99 latency exceeds this")
+	flag.Float64Var(&opts.thresholds.MaxErrorRate, "max-error-rate", 0, "Fail if the error rate (0-1) exceeds this")
+	flag.BoolVar(&opts.writeQueueOn, "write-queue", false, "Start the server with the write queue enabled")
+	flag.Parse()
+
+	report, err := run(context.Background(), opts)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "loadtest: %v\n", err)
+		os.Exit(1)
+	}
+	if !report.Passed {
+		os.Exit(1)
+	}
+}
+
+// run starts the server, applies the load and reports the results
+func run(ctx context.Context, opts options) (*Report, error) {
+	if opts.concurrency < 1 {
+		return nil, errors.New("concurrency must be at least 1")
+	}
+	mix, err := loadMix(opts.mixPath)
+	if err != nil {
+		return nil, err
+	}
+
+	workDir, err := os.MkdirTemp("", "loadtest-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create work directory: %w", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	server := opts.server
+	if server == "" {
+		server = filepath.Join(workDir, "movies-server")
+		if err := mcptest.BuildServer(ctx, opts.root, server); err != nil {
+			return nil, err
+		}
+	}
+
+	cmd := exec.Command(server)
+	cmd.Dir = opts.root
+	cmd.Env = append(os.Environ(),
+		"DB_NAME="+filepath.Join(workDir, "loadtest.db"),
+		"DATABASE_URL=",
+		"LOG_LEVEL=error",
+		fmt.Sprintf("WRITE_QUEUE_ENABLED=%t", opts.writeQueueOn),
+	)
+	client, err := mcptest.ConnectCommand(ctx, cmd, &mcptest.Options{
+		Timeout:    opts.timeout,
+		ClientInfo: &mcp.Implementation{Name: "loadtest", Version: "1.0.0"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start server: %w", err)
+	}
+	defer client.Close()
+
+	if opts.seed != "" {
+		if err := call(ctx, client, Operation{Tool: "seed_database", Arguments: map[string]any{"dataset": opts.seed}}); err != nil {
+			return nil, fmt.Errorf("failed to seed %s: %w", opts.seed, err)
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "Sending %s at concurrency %d...\n", describeLoad(opts), opts.concurrency)
+	rec := newRecorder(len(mix.Operations))
+	startedAt := time.Now()
+	applyLoad(ctx, client, mix, rec, opts)
+	report := rec.report(mix, startedAt, time.Since(startedAt), opts.concurrency, opts.thresholds)
+
+	writeSummary(os.Stderr, report)
+	if err := writeReport(opts.reportPath, report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// applyLoad sends the mix from concurrent workers until the duration passes
+// or the request budget is spent
+func applyLoad(ctx context.Context, client *mcptest.Client, mix Mix, rec *recorder, opts options) {
+	if opts.requests == 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.duration)
+		defer cancel()
+	}
+
+	order := mix.schedule()
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	for w := 0; w < opts.concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				n := next.Add(1) - 1
+				if opts.requests > 0 && n >= int64(opts.requests) {
+					return
+				}
+				op := order[n%int64(len(order))]
+
+				start := time.Now()
+				err := call(ctx, client, mix.Operations[op])
+				if ctx.Err() != nil && opts.requests == 0 {
+					return // Cut off by the end of the run rather than failed
+				}
+				rec.record(op, time.Since(start), err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// call sends one operation. A tool error result counts as a failure.
+func call(ctx context.Context, client *mcptest.Client, op Operation) error {
+	if op.Resource != "" {
+		_, err := client.ReadResource(ctx, op.Resource)
+		return err
+	}
+
+	result, err := client.CallTool(ctx, op.Tool, op.Arguments)
+	if err != nil {
+		return err
+	}
+	if result.IsError {
+		return &mcptest.ToolError{Tool: op.Tool, Message: mcptest.TextContent(result.Content)}
+	}
+	return nil
+}
+
+// describeLoad describes how much load a run sends
+func describeLoad(opts options) string {
+	if opts.requests > 0 {
+		return fmt.Sprintf("%d requests", opts.requests)
+	}
+	return fmt.Sprintf("requests for %s", opts.duration)
+}
+
+// writeReport writes the JSON report to path, or to stdout for "-"
+func writeReport(path string, report *Report) error {
+	if path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode report: %w", err)
+	}
+	data = append(data, '\n')
+
+	if path == "-" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+	return nil
+}
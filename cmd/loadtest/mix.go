@@ -0,0 +1,96 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Operation is one kind of request in a load mix: a tool call or a resource
+// read, sent in proportion to its weight
+type Operation struct {
+	Name      string         `json:"name"`
+	Tool      string         `json:"tool,omitempty"`
+	Resource  string         `json:"resource,omitempty"`
+	Arguments map[string]any `json:"arguments,omitempty"`
+	Weight    int            `json:"weight"`
+	// MaxP95Ms fails the run when the operation's p95 latency exceeds it,
+	// for latency targets that differ per tool or resource
+	MaxP95Ms float64 `json:"max_p95_ms,omitempty"`
+}
+
+// Mix is the set of operations a load test sends
+type Mix struct {
+	Operations []Operation `json:"operations"`
+}
+
+// defaultMix is a read-heavy mix over a seeded catalogue, with the latency
+// targets the resource contracts set
+var defaultMix = Mix{Operations: []Operation{
+	{Name: "get_movie", Tool: "get_movie", Arguments: map[string]any{"movie_id": 1}, Weight: 4},
+	{Name: "search_movies", Tool: "search_movies", Arguments: map[string]any{"title": "the"}, Weight: 3},
+	{Name: "list_top_movies", Tool: "list_top_movies", Arguments: map[string]any{"limit": 10}, Weight: 2},
+	{Name: "database_stats", Resource: "movies://database/stats", Weight: 1, MaxP95Ms: 200},
+	{Name: "add_movie", Tool: "add_movie", Arguments: map[string]any{
+		"title": "Load Test Movie", "director": "Load Tester", "year": 2024,
+	}, Weight: 1},
+}}
+
+// loadMix reads a mix from a JSON file, or returns the default mix when path
+// is empty
+func loadMix(path string) (Mix, error) {
+	if path == "" {
+		return defaultMix, nil
+	}
+
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return Mix{}, fmt.Errorf("failed to read mix %s: %w", path, err)
+	}
+	var mix Mix
+	if err := json.Unmarshal(data, &mix); err != nil {
+		return Mix{}, fmt.Errorf("failed to parse mix %s: %w", path, err)
+	}
+	if err := mix.validate(); err != nil {
+		return Mix{}, fmt.Errorf("invalid mix %s: %w", path, err)
+	}
+	return mix, nil
+}
+
+// validate checks that every operation targets exactly one tool or resource
+func (m Mix) validate() error {
+	if len(m.Operations) == 0 {
+		return fmt.Errorf("no operations")
+	}
+	names := make(map[string]bool, len(m.Operations))
+	for i, op := range m.Operations {
+		if op.Name == "" {
+			return fmt.Errorf("operation %d has no name", i)
+		}
+		if names[op.Name] {
+			return fmt.Errorf("operation %s is defined twice", op.Name)
+		}
+		names[op.Name] = true
+		if (op.Tool == "") == (op.Resource == "") {
+			return fmt.Errorf("operation %s must set exactly one of tool and resource", op.Name)
+		}
+		if op.Weight <= 0 {
+			return fmt.Errorf("operation %s must have a positive weight", op.Name)
+		}
+	}
+	return nil
+}
+
+// schedule expands the mix by weight into the order requests are sent in.
+// Workers take the next entry in turn, so every run sends the same
+// proportions without depending on a random source.
+func (m Mix) schedule() []int {
+	var order []int
+	for i, op := range m.Operations {
+		for n := 0; n < op.Weight; n++ {
+			order = append(order, i)
+		}
+	}
+	return order
+}
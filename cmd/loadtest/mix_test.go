@@ -0,0 +1,70 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoadMix(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "valid mix",
+			content: `{"operations":[{"name":"get","tool":"get_movie","arguments":{"movie_id":1},"weight":2},{"name":"stats","resource":"movies://database/stats","weight":1,"max_p95_ms":200}]}`,
+		},
+		{name: "no operations", content: `{"operations":[]}`, wantErr: "no operations"},
+		{name: "both targets", content: `{"operations":[{"name":"x","tool":"get_movie","resource":"movies://database/stats","weight":1}]}`, wantErr: "exactly one"},
+		{name: "no target", content: `{"operations":[{"name":"x","weight":1}]}`, wantErr: "exactly one"},
+		{name: "zero weight", content: `{"operations":[{"name":"x","tool":"get_movie"}]}`, wantErr: "positive weight"},
+		{name: "duplicate name", content: `{"operations":[{"name":"x","tool":"a","weight":1},{"name":"x","tool":"b","weight":1}]}`, wantErr: "defined twice"},
+		{name: "invalid JSON", content: `{`, wantErr: "failed to parse"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "mix.json")
+			if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+				t.Fatalf("failed to write mix: %v", err)
+			}
+
+			mix, err := loadMix(path)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Expected error containing %q, got: %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if len(mix.Operations) != 2 || mix.Operations[1].MaxP95Ms != 200 {
+				t.Errorf("Expected the mix to be decoded, got: %+v", mix)
+			}
+		})
+	}
+}
+
+func TestLoadMix_DefaultIsValid(t *testing.T) {
+	mix, err := loadMix("")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if err := mix.validate(); err != nil {
+		t.Errorf("Expected the default mix to be valid, got: %v", err)
+	}
+}
+
+func TestMixSchedule(t *testing.T) {
+	mix := Mix{Operations: []Operation{{Weight: 2}, {Weight: 1}, {Weight: 3}}}
+
+	expected := []int{0, 0, 1, 2, 2, 2}
+	if got := mix.schedule(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected schedule %v, got: %v", expected, got)
+	}
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// Latency summarizes a set of request durations in milliseconds
+type Latency struct {
+	P50  float64 `json:"p50"`
+	P95  float64 `json:"p95"`
+	P99  float64 `json:"p99"`
+	Max  float64 `json:"max"`
+	Mean float64 `json:"mean"`
+}
+
+// OperationReport is the result of one operation in the mix
+type OperationReport struct {
+	Name       string  `json:"name"`
+	Requests   int     `json:"requests"`
+	Errors     int     `json:"errors"`
+	ErrorRate  float64 `json:"error_rate"`
+	LatencyMs  Latency `json:"latency_ms"`
+	FirstError string  `json:"first_error,omitempty"`
+}
+
+// Thresholds are the limits a run must stay within; zero disables a limit
+type Thresholds struct {
+	MaxP95       time.Duration
+	MaxP99       time.Duration
+	MaxErrorRate float64
+}
+
+// Report is the JSON report of a load test run
+type Report struct {
+	StartedAt         time.Time         `json:"started_at"`
+	DurationSeconds   float64           `json:"duration_seconds"`
+	Concurrency       int               `json:"concurrency"`
+	Requests          int               `json:"requests"`
+	Errors            int               `json:"errors"`
+	ErrorRate         float64           `json:"error_rate"`
+	RequestsPerSecond float64           `json:"requests_per_second"`
+	LatencyMs         Latency           `json:"latency_ms"`
+	Operations        []OperationReport `json:"operations"`
+	Passed            bool              `json:"passed"`
+	Violations        []string          `json:"violations,omitempty"`
+}
+
+// operationSamples are the results recorded for one operation
+type operationSamples struct {
+	durations  []time.Duration
+	errors     int
+	firstError string
+}
+
+// recorder collects request results from concurrent workers
+type recorder struct {
+	mu         sync.Mutex
+	operations []operationSamples
+}
+
+// newRecorder creates a recorder for a mix of n operations
+func newRecorder(n int) *recorder {
+	return &recorder{operations: make([]operationSamples, n)}
+}
+
+// record adds the result of one request to operation op
+func (r *recorder) record(op int, duration time.Duration, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	samples := &r.operations[op]
+	samples.durations = append(samples.durations, duration)
+	if err != nil {
+		samples.errors++
+		if samples.firstError == "" {
+			samples.firstError = err.Error()
+		}
+	}
+}
+
+// report builds the run's report and checks it against thresholds
+func (r *recorder) report(mix Mix, startedAt time.Time, elapsed time.Duration, concurrency int, thresholds Thresholds) *Report {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	report := &Report{
+		StartedAt:       startedAt.UTC(),
+		DurationSeconds: elapsed.Seconds(),
+		Concurrency:     concurrency,
+	}
+
+	var all []time.Duration
+	for i, op := range mix.Operations {
+		samples := r.operations[i]
+		all = append(all, samples.durations...)
+		report.Requests += len(samples.durations)
+		report.Errors += samples.errors
+		report.Operations = append(report.Operations, OperationReport{
+			Name:       op.Name,
+			Requests:   len(samples.durations),
+			Errors:     samples.errors,
+			ErrorRate:  rate(samples.errors, len(samples.durations)),
+			LatencyMs:  summarize(samples.durations),
+			FirstError: samples.firstError,
+		})
+	}
+
+	report.ErrorRate = rate(report.Errors, report.Requests)
+	report.LatencyMs = summarize(all)
+	if elapsed > 0 {
+		report.RequestsPerSecond = float64(report.Requests) / elapsed.Seconds()
+	}
+	report.Violations = violations(report, mix, thresholds)
+	report.Passed = len(report.Violations) == 0
+	return report
+}
+
+// violations lists the thresholds the report exceeds
+func violations(report *Report, mix Mix, thresholds Thresholds) []string {
+	var found []string
+	if limit := milliseconds(thresholds.MaxP95); limit > 0 && report.LatencyMs.P95 > limit {
+		found = append(found, fmt.Sprintf("p95 latency %.1fms exceeds %v", report.LatencyMs.P95, thresholds.MaxP95))
+	}
+	if limit := milliseconds(thresholds.MaxP99); limit > 0 && report.LatencyMs.P99 > limit {
+		found = append(found, fmt.Sprintf("p99 latency %.1fms exceeds %v", report.LatencyMs.P99, thresholds.MaxP99))
+	}
+	if thresholds.MaxErrorRate > 0 && report.ErrorRate > thresholds.MaxErrorRate {
+		found = append(found, fmt.Sprintf("error rate %.4f exceeds %.4f", report.ErrorRate, thresholds.MaxErrorRate))
+	}
+	for i, op := range mix.Operations {
+		if op.MaxP95Ms > 0 && report.Operations[i].LatencyMs.P95 > op.MaxP95Ms {
+			found = append(found, fmt.Sprintf("%s p95 latency %.1fms exceeds %.1fms",
+				op.Name, report.Operations[i].LatencyMs.P95, op.MaxP95Ms))
+		}
+	}
+	if report.Requests == 0 {
+		found = append(found, "no requests completed")
+	}
+	return found
+}
+
+// summarize computes nearest-rank percentiles of durations
+func summarize(durations []time.Duration) Latency {
+	if len(durations) == 0 {
+		return Latency{}
+	}
+
+	sorted := append([]time.Duration(nil), durations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var total time.Duration
+	for _, d := range sorted {
+		total += d
+	}
+	return Latency{
+		P50:  milliseconds(percentile(sorted, 50)),
+		P95:  milliseconds(percentile(sorted, 95)),
+		P99:  milliseconds(percentile(sorted, 99)),
+		Max:  milliseconds(sorted[len(sorted)-1]),
+		Mean: milliseconds(total / time.Duration(len(sorted))),
+	}
+}
+
+// percentile returns the nearest-rank p-th percentile of sorted durations
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// milliseconds converts a duration to fractional milliseconds
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// rate returns errors as a fraction of requests
+func rate(errors, requests int) float64 {
+	if requests == 0 {
+		return 0
+	}
+	return float64(errors) / float64(requests)
+}
+
+// writeSummary prints the report as a table
+func writeSummary(w io.Writer, report *Report) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "OPERATION\tREQUESTS\tERRORS\tP50 MS\tP95 MS\tP99 MS\tMAX MS")
+	rows := append(append([]OperationReport(nil), report.Operations...), OperationReport{
+		Name:      "total",
+		Requests:  report.Requests,
+		Errors:    report.Errors,
+		LatencyMs: report.LatencyMs,
+	})
+	for _, op := range rows {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%.1f\t%.1f\t%.1f\t%.1f\n", op.Name, op.Requests, op.Errors,
+			op.LatencyMs.P50, op.LatencyMs.P95, op.LatencyMs.P99, op.LatencyMs.Max)
+	}
+	tw.Flush()
+
+	fmt.Fprintf(w, "\n%d requests in %.1fs (%.1f req/s) at concurrency %d, error rate %.2f%%\n",
+		report.Requests, report.DurationSeconds, report.RequestsPerSecond, report.Concurrency, report.ErrorRate*100)
+	for _, violation := range report.Violations {
+		fmt.Fprintf(w, "FAIL: %s\n", violation)
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSummarize(t *testing.T) {
+	var durations []time.Duration
+	for i := 100; i >= 1; i-- {
+		durations = append(durations, time.Duration(i)*time.Millisecond)
+	}
+
+	latency := summarize(durations)
+
+	expected := Latency{P50: 50, P95: 95, P99: 99, Max: 100, Mean: 50.5}
+	if latency != expected {
+		t.Errorf("Expected %+v, got: %+v", expected, latency)
+	}
+	if durations[0] != 100*time.Millisecond {
+		t.Error("Expected the input to be left unsorted")
+	}
+}
+
+func TestSummarize_Empty(t *testing.T) {
+	if latency := summarize(nil); latency != (Latency{}) {
+		t.Errorf("Expected a zero summary, got: %+v", latency)
+	}
+}
+
+func TestRecorderReport(t *testing.T) {
+	mix := Mix{Operations: []Operation{
+		{Name: "fast", Tool: "get_movie", Weight: 1},
+		{Name: "slow", Resource: "movies://database/stats", Weight: 1, MaxP95Ms: 50},
+	}}
+
+	tests := []struct {
+		name       string
+		thresholds Thresholds
+		violations []string
+	}{
+		{
+			name:       "within thresholds",
+			thresholds: Thresholds{MaxP95: time.Second, MaxErrorRate: 0.5},
+			violations: []string{"slow p95 latency 100.0ms exceeds 50.0ms"},
+		},
+		{
+			name:       "overall limits exceeded",
+			thresholds: Thresholds{MaxP95: 10 * time.Millisecond, MaxP99: 20 * time.Millisecond, MaxErrorRate: 0.1},
+			violations: []string{"p95 latency", "p99 latency", "error rate 0.1667 exceeds 0.1000", "slow p95"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := newRecorder(len(mix.Operations))
+			rec.record(0, 5*time.Millisecond, nil)
+			rec.record(0, 5*time.Millisecond, errors.New("movie not found"))
+			rec.record(0, 5*time.Millisecond, errors.New("second failure"))
+			for i := 0; i < 4; i++ {
+				rec.record(1, 100*time.Millisecond, nil)
+			}
+			for i := 0; i < 5; i++ {
+				rec.record(0, 5*time.Millisecond, nil)
+			}
+
+			report := rec.report(mix, time.Now(), 2*time.Second, 4, tt.thresholds)
+
+			if report.Requests != 12 || report.Errors != 2 {
+				t.Errorf("Expected 12 requests and 2 errors, got: %d and %d", report.Requests, report.Errors)
+			}
+			if report.RequestsPerSecond != 6 {
+				t.Errorf("Expected 6 requests per second, got: %v", report.RequestsPerSecond)
+			}
+			if got := report.Operations[0].FirstError; got != "movie not found" {
+				t.Errorf("Expected the first error to be kept, got: %q", got)
+			}
+			if report.Passed {
+				t.Error("Expected the run to fail")
+			}
+			if len(report.Violations) != len(tt.violations) {
+				t.Fatalf("Expected %d violations, got: %v", len(tt.violations), report.Violations)
+			}
+			for i, violation := range tt.violations {
+				if !strings.HasPrefix(report.Violations[i], violation) {
+					t.Errorf("Expected violation %q, got: %q", violation, report.Violations[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRecorderReport_NoRequestsFails(t *testing.T) {
+	report := newRecorder(len(defaultMix.Operations)).report(defaultMix, time.Now(), time.Second, 1, Thresholds{})
+	if report.Passed {
+		t.Error("Expected a run without requests to fail")
+	}
+}
@@ -0,0 +1,520 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/francknouama/movies-mcp-server/internal/mcp/tools"
+	"github.com/francknouama/movies-mcp-server/migrations"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+	"github.com/francknouama/movies-mcp-server/pkg/mcptest"
+)
+
+// CLI runs admin commands against a connected server
+type CLI struct {
+	client         *mcptest.Client
+	db             *sql.DB // Set only when the database was opened directly
+	migrationsPath string  // Empty for the migrations built into the binary
+	out            io.Writer
+}
+
+// Close disconnects from the server and closes the database
+func (c *CLI) Close() error {
+	err := c.client.Close()
+	if c.db != nil {
+		err = errors.Join(err, c.db.Close())
+	}
+	return err
+}
+
+// command is one of the CLI's commands
+type command struct {
+	name    string
+	args    string
+	summary string
+	run     func(c *CLI, ctx context.Context, args []string) error
+}
+
+// commands lists the commands in the order usage shows them
+var commands = []command{
+	{"movies list", "[-title t] [-director d] [-genre g] [-limit n] [-offset n]", "List movies", (*CLI).listMovies},
+	{"movies show", "<id>", "Show a movie", (*CLI).showMovie},
+	{"movies add", "-title t -director d -year y [-rating r] [-genres a,b] [-poster url]", "Add a movie", (*CLI).addMovie},
+	{"movies update", "<id> [-title t] [-director d] [-year y] [-rating r] [-genres a,b] [-poster url]", "Change the given fields of a movie", (*CLI).updateMovie},
+	{"movies delete", "<id>", "Delete a movie", (*CLI).deleteMovie},
+	{"actors list", "[-name n] [-movie id] [-limit n] [-offset n]", "List actors", (*CLI).listActors},
+	{"actors show", "<id>", "Show an actor", (*CLI).showActor},
+	{"actors add", "-name n [-birth-year y] [-bio text]", "Add an actor", (*CLI).addActor},
+	{"actors update", "<id> [-name n] [-birth-year y] [-bio text]", "Change the given fields of an actor", (*CLI).updateActor},
+	{"actors delete", "<id>", "Delete an actor", (*CLI).deleteActor},
+	{"export", "<path>", "Back up the library to a checksummed archive", (*CLI).export},
+	{"import", "<path>", "Replace the library with a backup archive", (*CLI).restore},
+	{"migrate", "", "Apply pending database migrations (direct access only)", (*CLI).migrate},
+	{"stats", "", "Show library statistics", (*CLI).stats},
+}
+
+// usage describes every command
+func usage() string {
+	var b strings.Builder
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %s %s\t%s\n", cmd.name, cmd.args, cmd.summary)
+	}
+	fmt.Fprintf(w, "  help \tList the commands\n")
+	w.Flush()
+	return b.String()
+}
+
+// Run runs the command named by the leading words of args
+func (c *CLI) Run(ctx context.Context, args []string) error {
+	if args[0] == "help" {
+		fmt.Fprint(c.out, usage())
+		return nil
+	}
+	for _, cmd := range commands {
+		words := strings.Fields(cmd.name)
+		if len(args) >= len(words) && strings.Join(args[:len(words)], " ") == cmd.name {
+			return cmd.run(c, ctx, args[len(words):])
+		}
+	}
+	return fmt.Errorf("unknown command %q; run help to list the commands", strings.Join(args, " "))
+}
+
+// flags returns a flag set for a command that reports to the CLI's output
+func (c *CLI) flags(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.out)
+	return fs
+}
+
+// parseWithID parses a command's flags and its ID argument, which may come
+// before or after the flags
+func parseWithID(fs *flag.FlagSet, args []string) (int, error) {
+	var idArg string
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		idArg, args = args[0], args[1:]
+	}
+	if err := fs.Parse(args); err != nil {
+		return 0, err
+	}
+	if idArg == "" && fs.NArg() > 0 {
+		idArg = fs.Arg(0)
+	} else if fs.NArg() > 0 {
+		return 0, fmt.Errorf("%s: unexpected argument %q", fs.Name(), fs.Arg(0))
+	}
+	if idArg == "" {
+		return 0, fmt.Errorf("%s: an ID is required", fs.Name())
+	}
+
+	id, err := strconv.Atoi(idArg)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("%s: invalid ID %q", fs.Name(), idArg)
+	}
+	return id, nil
+}
+
+// parseNoArgs parses a command's flags, rejecting positional arguments
+func parseNoArgs(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("%s: unexpected argument %q", fs.Name(), fs.Arg(0))
+	}
+	return nil
+}
+
+// parsePath parses a command that takes a single path
+func parsePath(fs *flag.FlagSet, args []string) (string, error) {
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+	if fs.NArg() != 1 {
+		return "", fmt.Errorf("%s: exactly one path is required", fs.Name())
+	}
+	return fs.Arg(0), nil
+}
+
+// splitList splits a comma-separated flag value, dropping empty entries
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// isSet reports whether a flag was given on the command line
+func isSet(fs *flag.FlagSet, name string) bool {
+	set := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+// ===== Movies =====
+
+func (c *CLI) listMovies(ctx context.Context, args []string) error {
+	fs := c.flags("movies list")
+	var input tools.SearchMoviesInput
+	fs.StringVar(&input.Title, "title", "", "Title to search for")
+	fs.StringVar(&input.Director, "director", "", "Director to search for")
+	fs.StringVar(&input.Genre, "genre", "", "Genre to filter by")
+	fs.IntVar(&input.Limit, "limit", 20, "Maximum number of movies")
+	fs.IntVar(&input.Offset, "offset", 0, "Number of movies to skip")
+	if err := parseNoArgs(fs, args); err != nil {
+		return err
+	}
+
+	output, err := mcptest.CallToolAs[tools.SearchMoviesOutput](ctx, c.client, "search_movies", input)
+	if err != nil {
+		return err
+	}
+	c.printMovies(output.Movies)
+	fmt.Fprintf(c.out, "%d of %d movies\n", len(output.Movies), output.Total)
+	if output.Truncation != nil {
+		fmt.Fprintf(c.out, "Truncated to fit the response limit; lower -limit to page through the rest\n")
+	}
+	return nil
+}
+
+func (c *CLI) showMovie(ctx context.Context, args []string) error {
+	id, err := parseWithID(c.flags("movies show"), args)
+	if err != nil {
+		return err
+	}
+	movie, err := c.getMovie(ctx, id)
+	if err != nil {
+		return err
+	}
+	c.printMovies([]tools.MovieOutput{movie})
+	return nil
+}
+
+func (c *CLI) addMovie(ctx context.Context, args []string) error {
+	fs := c.flags("movies add")
+	var input tools.AddMovieInput
+	var genres string
+	fs.StringVar(&input.Title, "title", "", "Movie title")
+	fs.StringVar(&input.Director, "director", "", "Movie director")
+	fs.IntVar(&input.Year, "year", 0, "Release year")
+	fs.Float64Var(&input.Rating, "rating", 0, "Rating, on -scale")
+	fs.IntVar(&input.Scale, "scale", 0, "Scale -rating is given in: 5, 10 or 100 (default 10)")
+	fs.StringVar(&genres, "genres", "", "Comma-separated genres")
+	fs.StringVar(&input.PosterURL, "poster", "", "Poster URL")
+	if err := parseNoArgs(fs, args); err != nil {
+		return err
+	}
+	input.Genres = splitList(genres)
+
+	movie, err := mcptest.CallToolAs[tools.AddMovieOutput](ctx, c.client, "add_movie", input)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.out, "Added movie %d\n", movie.ID)
+	c.printMovies([]tools.MovieOutput{movie})
+	return nil
+}
+
+func (c *CLI) updateMovie(ctx context.Context, args []string) error {
+	fs := c.flags("movies update")
+	var title, director, genres, poster string
+	var year, scale int
+	var rating float64
+	fs.StringVar(&title, "title", "", "Movie title")
+	fs.StringVar(&director, "director", "", "Movie director")
+	fs.IntVar(&year, "year", 0, "Release year")
+	fs.Float64Var(&rating, "rating", 0, "Rating, on -scale")
+	fs.IntVar(&scale, "scale", 0, "Scale -rating is given in: 5, 10 or 100 (default 10)")
+	fs.StringVar(&genres, "genres", "", "Comma-separated genres, replacing the current ones")
+	fs.StringVar(&poster, "poster", "", "Poster URL")
+	id, err := parseWithID(fs, args)
+	if err != nil {
+		return err
+	}
+
+	// update_movie leaves out fields that are not sent, so send only the flags given
+	input := tools.UpdateMovieInput{ID: id}
+	if isSet(fs, "title") {
+		input.Title = &title
+	}
+	if isSet(fs, "director") {
+		input.Director = &director
+	}
+	if isSet(fs, "year") {
+		// A release date from another year would contradict the new year
+		current, err := c.getMovie(ctx, id)
+		if err != nil {
+			return err
+		}
+		input.Year = &year
+		if current.ReleaseDate != "" && !strings.HasPrefix(current.ReleaseDate, fmt.Sprintf("%04d-", year)) {
+			cleared := ""
+			input.ReleaseDate = &cleared
+		}
+	}
+	if isSet(fs, "rating") {
+		input.Rating, input.Scale = &rating, scale
+	}
+	if isSet(fs, "genres") {
+		// An empty list clears the genres, where no list would keep them
+		input.Genres = append([]string{}, splitList(genres)...)
+	}
+	if isSet(fs, "poster") {
+		input.PosterURL = &poster
+	}
+
+	movie, err := mcptest.CallToolAs[tools.UpdateMovieOutput](ctx, c.client, "update_movie", input)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.out, "Updated movie %d\n", movie.ID)
+	c.printMovies([]tools.MovieOutput{movie})
+	return nil
+}
+
+func (c *CLI) deleteMovie(ctx context.Context, args []string) error {
+	id, err := parseWithID(c.flags("movies delete"), args)
+	if err != nil {
+		return err
+	}
+	if _, err := mcptest.CallToolAs[tools.DeleteMovieOutput](ctx, c.client, "delete_movie", tools.DeleteMovieInput{MovieID: id}); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.out, "Deleted movie %d\n", id)
+	return nil
+}
+
+// getMovie fetches a movie by ID
+func (c *CLI) getMovie(ctx context.Context, id int) (tools.MovieOutput, error) {
+	return mcptest.CallToolAs[tools.GetMovieOutput](ctx, c.client, "get_movie", tools.GetMovieInput{MovieID: id})
+}
+
+// printMovies writes movies as a table
+func (c *CLI) printMovies(movies []tools.MovieOutput) {
+	w := tabwriter.NewWriter(c.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tTITLE\tYEAR\tDIRECTOR\tRATING\tGENRES")
+	for _, movie := range movies {
+		fmt.Fprintf(w, "%d\t%s\t%d\t%s\t%.1f\t%s\n",
+			movie.ID, movie.Title, movie.Year, movie.Director, movie.Rating, strings.Join(movie.Genres, ", "))
+	}
+	w.Flush()
+}
+
+// ===== Actors =====
+
+func (c *CLI) listActors(ctx context.Context, args []string) error {
+	fs := c.flags("actors list")
+	var input tools.SearchActorsInput
+	fs.StringVar(&input.Name, "name", "", "Name to search for")
+	fs.IntVar(&input.MovieID, "movie", 0, "Only actors in this movie")
+	fs.IntVar(&input.Limit, "limit", 20, "Maximum number of actors")
+	fs.IntVar(&input.Offset, "offset", 0, "Number of actors to skip")
+	if err := parseNoArgs(fs, args); err != nil {
+		return err
+	}
+
+	output, err := mcptest.CallToolAs[tools.SearchActorsOutput](ctx, c.client, "search_actors", input)
+	if err != nil {
+		return err
+	}
+	c.printActors(output.Actors)
+	fmt.Fprintf(c.out, "%d of %d actors\n", len(output.Actors), output.Total)
+	if output.Truncation != nil {
+		fmt.Fprintf(c.out, "Truncated to fit the response limit; lower -limit to page through the rest\n")
+	}
+	return nil
+}
+
+func (c *CLI) showActor(ctx context.Context, args []string) error {
+	id, err := parseWithID(c.flags("actors show"), args)
+	if err != nil {
+		return err
+	}
+	actor, err := c.getActor(ctx, id)
+	if err != nil {
+		return err
+	}
+	c.printActors([]tools.ActorOutput{actor})
+	if actor.Bio != "" {
+		fmt.Fprintf(c.out, "\n%s\n", actor.Bio)
+	}
+	return nil
+}
+
+func (c *CLI) addActor(ctx context.Context, args []string) error {
+	fs := c.flags("actors add")
+	var input tools.AddActorInput
+	fs.StringVar(&input.Name, "name", "", "Actor name")
+	fs.IntVar(&input.BirthYear, "birth-year", 0, "Birth year")
+	fs.StringVar(&input.Bio, "bio", "", "Biography")
+	if err := parseNoArgs(fs, args); err != nil {
+		return err
+	}
+
+	actor, err := mcptest.CallToolAs[tools.ActorOutput](ctx, c.client, "add_actor", input)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.out, "Added actor %d\n", actor.ID)
+	c.printActors([]tools.ActorOutput{actor})
+	return nil
+}
+
+func (c *CLI) updateActor(ctx context.Context, args []string) error {
+	fs := c.flags("actors update")
+	var name, bio string
+	var birthYear int
+	fs.StringVar(&name, "name", "", "Actor name")
+	fs.IntVar(&birthYear, "birth-year", 0, "Birth year")
+	fs.StringVar(&bio, "bio", "", "Biography")
+	id, err := parseWithID(fs, args)
+	if err != nil {
+		return err
+	}
+
+	// update_actor replaces every field, so start from the current actor
+	current, err := c.getActor(ctx, id)
+	if err != nil {
+		return err
+	}
+	input := tools.UpdateActorInput{ID: id, Name: current.Name, BirthYear: current.BirthYear, Bio: current.Bio}
+	if isSet(fs, "name") {
+		input.Name = name
+	}
+	if isSet(fs, "birth-year") {
+		input.BirthYear = birthYear
+	}
+	if isSet(fs, "bio") {
+		input.Bio = bio
+	}
+
+	actor, err := mcptest.CallToolAs[tools.ActorOutput](ctx, c.client, "update_actor", input)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.out, "Updated actor %d\n", actor.ID)
+	c.printActors([]tools.ActorOutput{actor})
+	return nil
+}
+
+func (c *CLI) deleteActor(ctx context.Context, args []string) error {
+	id, err := parseWithID(c.flags("actors delete"), args)
+	if err != nil {
+		return err
+	}
+	if _, err := mcptest.CallToolAs[tools.DeleteActorOutput](ctx, c.client, "delete_actor", tools.DeleteActorInput{ActorID: id}); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.out, "Deleted actor %d\n", id)
+	return nil
+}
+
+// getActor fetches an actor by ID
+func (c *CLI) getActor(ctx context.Context, id int) (tools.ActorOutput, error) {
+	return mcptest.CallToolAs[tools.ActorOutput](ctx, c.client, "get_actor", tools.GetActorInput{ActorID: id})
+}
+
+// printActors writes actors as a table
+func (c *CLI) printActors(actors []tools.ActorOutput) {
+	w := tabwriter.NewWriter(c.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tBORN\tMOVIES")
+	for _, actor := range actors {
+		born := "-"
+		if actor.BirthYear > 0 {
+			born = strconv.Itoa(actor.BirthYear)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%d\n", actor.ID, actor.Name, born, len(actor.MovieIDs))
+	}
+	w.Flush()
+}
+
+// ===== Library =====
+
+func (c *CLI) export(ctx context.Context, args []string) error {
+	path, err := parsePath(c.flags("export"), args)
+	if err != nil {
+		return err
+	}
+	output, err := mcptest.CallToolAs[tools.BackupOutput](ctx, c.client, "backup_database", tools.BackupDatabaseInput{Path: path})
+	if err != nil {
+		return err
+	}
+	c.printBackup("Exported", output)
+	return nil
+}
+
+func (c *CLI) restore(ctx context.Context, args []string) error {
+	path, err := parsePath(c.flags("import"), args)
+	if err != nil {
+		return err
+	}
+	output, err := mcptest.CallToolAs[tools.BackupOutput](ctx, c.client, "restore_database", tools.RestoreDatabaseInput{Path: path})
+	if err != nil {
+		return err
+	}
+	c.printBackup("Imported", output)
+	return nil
+}
+
+// printBackup writes the row counts of a backup or restore
+func (c *CLI) printBackup(verb string, output tools.BackupOutput) {
+	for _, table := range output.Tables {
+		fmt.Fprintf(c.out, "  %s: %d rows\n", table.Name, table.Rows)
+	}
+	fmt.Fprintf(c.out, "%s %d rows (%s)\n", verb, output.TotalRows, output.Path)
+}
+
+func (c *CLI) migrate(ctx context.Context, args []string) error {
+	flags := c.flags("migrate")
+	phaseName := flags.String("phase", "", "Apply only the expand or the contract migrations (default all)")
+	if err := parseNoArgs(flags, args); err != nil {
+		return err
+	}
+	phase, err := database.ParseMigrationPhase(*phaseName)
+	if err != nil {
+		return err
+	}
+	if c.db == nil {
+		return errors.New("migrate needs direct database access; the server migrates on startup unless -skip-migrations is set")
+	}
+
+	migrationFS, source := fs.FS(migrations.FS), "the binary"
+	if c.migrationsPath != "" {
+		migrationFS, source = os.DirFS(c.migrationsPath), c.migrationsPath
+	}
+	applied, err := database.MigratePhase(ctx, c.db, migrationFS, phase)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.out, "Applied %d migrations from %s\n", applied, source)
+	return nil
+}
+
+func (c *CLI) stats(ctx context.Context, args []string) error {
+	if err := parseNoArgs(c.flags("stats"), args); err != nil {
+		return err
+	}
+	result, err := c.client.ReadResource(ctx, "movies://database/stats")
+	if err != nil {
+		return err
+	}
+	for _, contents := range result.Contents {
+		fmt.Fprintln(c.out, contents.Text)
+	}
+	return nil
+}
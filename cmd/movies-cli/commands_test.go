@@ -0,0 +1,179 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/migrations"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+	"github.com/francknouama/movies-mcp-server/pkg/mcptest"
+)
+
+// newTestCLI returns a CLI over a migrated scratch database and its output
+func newTestCLI(t *testing.T) (*CLI, *bytes.Buffer) {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "movies.db"))
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	if _, err := database.Migrate(context.Background(), db, migrations.FS); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+	client, err := mcptest.ConnectServer(context.Background(), newServer(db), nil)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+
+	out := &bytes.Buffer{}
+	cli := &CLI{client: client, db: db, out: out}
+	t.Cleanup(func() { cli.Close() })
+	return cli, out
+}
+
+// runCommand runs a command line and returns its output
+func runCommand(t *testing.T, cli *CLI, out *bytes.Buffer, line string) (string, error) {
+	t.Helper()
+
+	args, err := splitLine(line)
+	if err != nil {
+		t.Fatalf("failed to split %q: %v", line, err)
+	}
+	out.Reset()
+	err = cli.Run(context.Background(), args)
+	return out.String(), err
+}
+
+func TestCLI_Movies(t *testing.T) {
+	cli, out := newTestCLI(t)
+
+	text, err := runCommand(t, cli, out, `movies add -title "The Matrix" -director Wachowski -year 1999 -rating 8.7 -genres "sci-fi, action"`)
+	if err != nil || !strings.Contains(text, "Added movie 1") {
+		t.Fatalf("Expected the movie to be added, got: %q, %v", text, err)
+	}
+
+	text, err = runCommand(t, cli, out, "movies update 1 -rating 9")
+	if err != nil || !strings.Contains(text, "9.0") || !strings.Contains(text, "The Matrix") {
+		t.Fatalf("Expected only the rating to change, got: %q, %v", text, err)
+	}
+
+	text, err = runCommand(t, cli, out, "movies list -title matrix")
+	if err != nil || !strings.Contains(text, "sci-fi, action") || !strings.Contains(text, "1 of 1 movies") {
+		t.Errorf("Expected the movie to be listed, got: %q, %v", text, err)
+	}
+
+	if text, err = runCommand(t, cli, out, "movies delete 1"); err != nil || !strings.Contains(text, "Deleted movie 1") {
+		t.Errorf("Expected the movie to be deleted, got: %q, %v", text, err)
+	}
+	if _, err = runCommand(t, cli, out, "movies show 1"); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("Expected a not found error, got: %v", err)
+	}
+}
+
+func TestCLI_Actors(t *testing.T) {
+	cli, out := newTestCLI(t)
+
+	if _, err := runCommand(t, cli, out, `actors add -name "Keanu Reeves" -birth-year 1964 -bio "Canadian actor"`); err != nil {
+		t.Fatalf("Expected the actor to be added, got: %v", err)
+	}
+
+	text, err := runCommand(t, cli, out, "actors update -name Keanu 1")
+	if err != nil || !strings.Contains(text, "1964") {
+		t.Fatalf("Expected the birth year to be kept, got: %q, %v", text, err)
+	}
+
+	text, err = runCommand(t, cli, out, "actors show 1")
+	if err != nil || !strings.Contains(text, "Canadian actor") {
+		t.Errorf("Expected the bio to be kept, got: %q, %v", text, err)
+	}
+}
+
+func TestCLI_Library(t *testing.T) {
+	cli, out := newTestCLI(t)
+	if _, err := runCommand(t, cli, out, "movies add -title Alien -director Scott -year 1979"); err != nil {
+		t.Fatalf("Expected the movie to be added, got: %v", err)
+	}
+
+	text, err := runCommand(t, cli, out, "stats")
+	if err != nil || !strings.Contains(text, `"total_movies": 1`) {
+		t.Errorf("Expected the statistics, got: %q, %v", text, err)
+	}
+
+	archive := filepath.Join(t.TempDir(), "backup.zip")
+	if text, err = runCommand(t, cli, out, "export "+archive); err != nil || !strings.Contains(text, "Exported") {
+		t.Fatalf("Expected the library to be exported, got: %q, %v", text, err)
+	}
+	if _, err = runCommand(t, cli, out, "movies delete 1"); err != nil {
+		t.Fatalf("Expected the movie to be deleted, got: %v", err)
+	}
+	if text, err = runCommand(t, cli, out, "import "+archive); err != nil || !strings.Contains(text, "Imported") {
+		t.Fatalf("Expected the library to be imported, got: %q, %v", text, err)
+	}
+	if text, err = runCommand(t, cli, out, "movies show 1"); err != nil || !strings.Contains(text, "Alien") {
+		t.Errorf("Expected the movie to be restored, got: %q, %v", text, err)
+	}
+
+	if text, err = runCommand(t, cli, out, "migrate"); err != nil || !strings.Contains(text, "Applied 0 migrations") {
+		t.Errorf("Expected nothing left to migrate, got: %q, %v", text, err)
+	}
+	cli.db = nil
+	if _, err = runCommand(t, cli, out, "migrate"); err == nil || !strings.Contains(err.Error(), "direct database access") {
+		t.Errorf("Expected migrate to need the database, got: %v", err)
+	}
+}
+
+func TestCLI_Errors(t *testing.T) {
+	cli, out := newTestCLI(t)
+
+	tests := []struct {
+		line string
+		want string
+	}{
+		{line: "movies rename 1", want: "unknown command"},
+		{line: "movies delete", want: "an ID is required"},
+		{line: "movies delete abc", want: "invalid ID"},
+		{line: "export", want: "one path is required"},
+		{line: "movies add -title Untitled", want: "director"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			if _, err := runCommand(t, cli, out, tt.line); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected an error containing %q, got: %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestCLI_Interact(t *testing.T) {
+	cli, out := newTestCLI(t)
+
+	input := strings.NewReader("movies add -title Heat -director Mann -year 1995\n\nmovies show 7\nmovies list\nquit\nmovies list\n")
+	if err := cli.Interact(context.Background(), input); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	text := out.String()
+	if !strings.Contains(text, "movie not found") || strings.Count(text, "Heat") != 2 {
+		t.Errorf("Expected failures to be reported and the session to stop at quit, got: %q", text)
+	}
+}
+
+func TestSplitLine(t *testing.T) {
+	words, err := splitLine(`movies add -title "The Thing" -director 'John Carpenter'  -year 1982`)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	want := []string{"movies", "add", "-title", "The Thing", "-director", "John Carpenter", "-year", "1982"}
+	if strings.Join(words, "|") != strings.Join(want, "|") {
+		t.Errorf("Expected %q, got: %q", want, words)
+	}
+
+	if _, err := splitLine(`movies add -title "Open`); err == nil {
+		t.Error("Expected an unterminated quote to be rejected")
+	}
+}
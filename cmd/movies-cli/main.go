@@ -0,0 +1,180 @@
+// Command movies-cli administers the movie library from a terminal.
+//
+// By default it opens the configured SQLite database directly and serves its
+// commands from the same tools the server exposes, in process. With -mcp it
+// starts a server command over stdio and sends the commands to it instead.
+// Given a command it runs it and exits; without one it reads commands from
+// stdin, one per line, until EOF or "quit".
+//
+//	movies-cli movies list -title matrix
+//	movies-cli movies add -title "Alien" -director "Ridley Scott" -year 1979 -genres sci-fi,horror
+//	movies-cli -mcp "./movies-server-sdk -skip-migrations" stats
+package main
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/francknouama/movies-mcp-server/internal/config"
+	"github.com/francknouama/movies-mcp-server/pkg/mcptest"
+)
+
+// options are the command's flags
+type options struct {
+	configPath     string
+	migrationsPath string
+	mcpCommand     string
+}
+
+func main() {
+	var opts options
+	flag.StringVar(&opts.configPath, "config", "", "Path to a YAML config file (environment variables override it)")
+	flag.StringVar(&opts.migrationsPath, "migrations", "", "Directory of database migrations (defaults to the migrations built into the binary)")
+	flag.StringVar(&opts.mcpCommand, "mcp", "", "Server command to run over stdio instead of opening the database")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [options] [command]\n\nCommands:\n%s\nOptions:\n", os.Args[0], usage())
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if err := run(context.Background(), opts, flag.Args(), os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "movies-cli: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run connects to the library and runs args, or the commands read from in
+// when args is empty
+func run(ctx context.Context, opts options, args []string, in io.Reader, out io.Writer) error {
+	cli, err := connect(ctx, opts, out)
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	if len(args) == 0 {
+		return cli.Interact(ctx, in)
+	}
+	if err := cli.Run(ctx, args); !errors.Is(err, flag.ErrHelp) {
+		return err
+	}
+	return nil
+}
+
+// connect opens the database and serves it in process, or starts the
+// configured server command
+func connect(ctx context.Context, opts options, out io.Writer) (*CLI, error) {
+	if opts.mcpCommand != "" {
+		fields := strings.Fields(opts.mcpCommand)
+		client, err := mcptest.ConnectCommand(ctx, exec.Command(fields[0], fields[1:]...), &mcptest.Options{ClientInfo: clientInfo})
+		if err != nil {
+			return nil, fmt.Errorf("failed to start %s: %w", fields[0], err)
+		}
+		return &CLI{client: client, out: out}, nil
+	}
+
+	cfg, err := config.LoadFile(opts.configPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+	db, err := openDatabase(&cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+	client, err := mcptest.ConnectServer(ctx, newServer(db), &mcptest.Options{ClientInfo: clientInfo})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &CLI{client: client, db: db, migrationsPath: opts.migrationsPath, out: out}, nil
+}
+
+// openDatabase opens and pings the configured SQLite database
+func openDatabase(cfg *config.DatabaseConfig) (*sql.DB, error) {
+	db, err := sql.Open("sqlite", cfg.ConnectionString())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open SQLite database: %w", err)
+	}
+	if cfg.InMemory() {
+		db.SetMaxOpenConns(1) // A second connection would open an empty database
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to connect to SQLite database %s: %w", cfg.Name, err)
+	}
+	return db, nil
+}
+
+// Interact runs the commands read from in, one per line, reporting failures
+// without stopping
+func (c *CLI) Interact(ctx context.Context, in io.Reader) error {
+	scanner := bufio.NewScanner(in)
+	for {
+		fmt.Fprint(c.out, "movies> ")
+		if !scanner.Scan() {
+			fmt.Fprintln(c.out)
+			return scanner.Err()
+		}
+
+		args, err := splitLine(scanner.Text())
+		switch {
+		case err != nil:
+			fmt.Fprintf(c.out, "error: %v\n", err)
+			continue
+		case len(args) == 0:
+			continue
+		case args[0] == "quit" || args[0] == "exit":
+			return nil
+		}
+
+		if err := c.Run(ctx, args); err != nil && !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintf(c.out, "error: %v\n", err)
+		}
+	}
+}
+
+// splitLine splits a command line into words, keeping single- or
+// double-quoted text together
+func splitLine(line string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	inWord := false
+	var quote rune
+
+	for _, r := range line {
+		switch {
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			word.WriteRune(r)
+		case r == '"' || r == '\'':
+			quote, inWord = r, true
+		case r == ' ' || r == '\t':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote", quote)
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
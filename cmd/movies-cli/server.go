@@ -0,0 +1,53 @@
+package main
+
+import (
+	"database/sql"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	actorApp "github.com/francknouama/movies-mcp-server/internal/application/actor"
+	historyApp "github.com/francknouama/movies-mcp-server/internal/application/history"
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/infrastructure/sqlite"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/middleware"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/resources"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/tools"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+// clientInfo identifies the CLI to the server
+var clientInfo = &mcp.Implementation{Name: "movies-cli", Version: "1.0.0"}
+
+// newServer builds an in-process server over db with the tools and the
+// resource the CLI's commands use, validated and error-mapped as the real
+// server does, so both ways of connecting behave the same
+func newServer(db *sql.DB) *mcp.Server {
+	movieRepo := historyApp.NewTrackedRepository(sqlite.NewMovieRepository(db), sqlite.NewHistoryRepository(db))
+	movieService := movieApp.NewService(movieRepo)
+	movieTools := tools.NewMovieTools(movieService)
+	actorTools := tools.NewActorTools(actorApp.NewService(sqlite.NewActorRepository(db)))
+	backupTools := tools.NewBackupTools(database.NewBackupManager(db))
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "movies-cli-local", Version: "1.0.0"}, nil)
+	registrar := middleware.NewToolRegistrar(server, middleware.MapErrors(), middleware.Validate())
+
+	middleware.AddTool(registrar, &mcp.Tool{Name: "get_movie", Description: "Get a movie by ID"}, movieTools.GetMovie)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "add_movie", Description: "Add a new movie to the database"}, movieTools.AddMovie)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "update_movie", Description: "Update the given fields of an existing movie, keeping the rest"}, movieTools.UpdateMovie)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "delete_movie", Description: "Delete a movie by ID"}, movieTools.DeleteMovie)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "search_movies", Description: "Search for movies"}, movieTools.SearchMovies)
+
+	middleware.AddTool(registrar, &mcp.Tool{Name: "get_actor", Description: "Get an actor by ID"}, actorTools.GetActor)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "add_actor", Description: "Add a new actor to the database"}, actorTools.AddActor)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "update_actor", Description: "Update an existing actor"}, actorTools.UpdateActor)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "delete_actor", Description: "Delete an actor by ID"}, actorTools.DeleteActor)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "search_actors", Description: "Search for actors"}, actorTools.SearchActors)
+
+	middleware.AddTool(registrar, &mcp.Tool{Name: "backup_database", Description: "Export the database to a checksummed archive"}, backupTools.BackupDatabase)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "restore_database", Description: "Replace the database contents with a backup archive"}, backupTools.RestoreDatabase)
+
+	dbResources := resources.NewDatabaseResources(movieService)
+	dbResources.SetStatsReader(sqlite.NewStatsRepository(db))
+	server.AddResource(dbResources.DatabaseStatsResource(), dbResources.HandleDatabaseStats)
+	return server
+}
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/mcp/resources"
+)
+
+// health is the part of movies://server/health the dashboard shows
+type health struct {
+	Status   string `json:"status"`
+	Ready    bool   `json:"ready"`
+	Uptime   string `json:"uptime"`
+	Database struct {
+		State               string `json:"state"`
+		LastError           string `json:"last_error"`
+		ConsecutiveFailures int    `json:"consecutive_failures"`
+		Reconnects          int    `json:"reconnects"`
+	} `json:"database"`
+	Migrations struct {
+		CurrentVersion int    `json:"current_version"`
+		LatestVersion  int    `json:"latest_version"`
+		UpToDate       bool   `json:"up_to_date"`
+		Error          string `json:"error"`
+	} `json:"migrations"`
+	Tools []struct {
+		Tool      string  `json:"tool"`
+		Calls     int     `json:"calls"`
+		Errors    int     `json:"errors"`
+		AverageMS float64 `json:"average_ms"`
+		SlowestMS float64 `json:"slowest_ms"`
+	} `json:"tools"`
+	RecentErrors []struct {
+		At      time.Time `json:"at"`
+		Tool    string    `json:"tool"`
+		Message string    `json:"message"`
+	} `json:"recent_errors"`
+	Tenants []string `json:"tenants"`
+}
+
+// libraryStats is the part of movies://database/stats the dashboard shows
+type libraryStats struct {
+	TotalMovies   int    `json:"total_movies"`
+	TotalGenres   int    `json:"total_genres"`
+	AverageRating string `json:"average_rating"`
+	YearRange     struct {
+		Earliest *int `json:"earliest"`
+		Latest   *int `json:"latest"`
+	} `json:"year_range"`
+}
+
+// snapshot is one read of the status file
+type snapshot struct {
+	writtenAt time.Time
+	health    *health
+	stats     *libraryStats
+	errors    map[string]string // Resources the server could not read
+}
+
+// readSnapshot reads and decodes a status file
+func readSnapshot(path string) (*snapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var status resources.Status
+	if err := json.Unmarshal(data, &status); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+
+	s := &snapshot{writtenAt: status.WrittenAt, errors: status.Errors}
+	if raw, ok := status.Resources["health"]; ok {
+		s.health = &health{}
+		if err := json.Unmarshal(raw, s.health); err != nil {
+			return nil, fmt.Errorf("failed to decode the health report: %w", err)
+		}
+	}
+	if raw, ok := status.Resources["stats"]; ok {
+		s.stats = &libraryStats{}
+		if err := json.Unmarshal(raw, s.stats); err != nil {
+			return nil, fmt.Errorf("failed to decode the library stats: %w", err)
+		}
+	}
+	return s, nil
+}
+
+// Dashboard turns successive snapshots into screens
+type Dashboard struct {
+	current    *snapshot
+	calls      map[string]int     // Calls per tool in the snapshot rates were last taken from
+	rates      map[string]float64 // Calls per second per tool between the last two snapshots
+	staleAfter time.Duration
+	maxTools   int
+}
+
+// NewDashboard creates a dashboard that flags snapshots older than
+// staleAfter and lists up to maxTools tools
+func NewDashboard(staleAfter time.Duration, maxTools int) *Dashboard {
+	return &Dashboard{staleAfter: staleAfter, maxTools: maxTools}
+}
+
+// Update records a snapshot, measuring throughput against the previous one
+// when the server has written a newer file
+func (d *Dashboard) Update(s *snapshot) {
+	if d.current != nil && !s.writtenAt.After(d.current.writtenAt) {
+		return
+	}
+
+	calls := make(map[string]int)
+	if s.health != nil {
+		for _, tool := range s.health.Tools {
+			calls[tool.Tool] = tool.Calls
+		}
+	}
+	if d.current != nil {
+		elapsed := s.writtenAt.Sub(d.current.writtenAt).Seconds()
+		d.rates = make(map[string]float64, len(calls))
+		for tool, count := range calls {
+			// A restarted server counts from zero again; a drop is no rate
+			if delta := count - d.calls[tool]; delta > 0 {
+				d.rates[tool] = float64(delta) / elapsed
+			}
+		}
+	}
+	d.current, d.calls = s, calls
+}
+
+// Render writes the current screen
+func (d *Dashboard) Render(w io.Writer, now time.Time) {
+	s := d.current
+	if s == nil {
+		fmt.Fprintln(w, "Waiting for the first status file...")
+		return
+	}
+
+	age := now.Sub(s.writtenAt).Truncate(time.Second)
+	updated := fmt.Sprintf("updated %s ago", age)
+	if age > d.staleAfter {
+		updated = fmt.Sprintf("STALE: last updated %s ago, is the server running?", age)
+	}
+
+	h := s.health
+	if h == nil {
+		fmt.Fprintf(w, "Movies MCP Server (%s)\nHealth unavailable: %s\n", updated, s.errors["health"])
+	} else {
+		readiness := "not ready"
+		if h.Ready {
+			readiness = "ready"
+		}
+		fmt.Fprintf(w, "Movies MCP Server: %s, %s (uptime %s, %s)\n", h.Status, readiness, h.Uptime, updated)
+
+		fmt.Fprintf(w, "Database: %s, %d consecutive failures, %d reconnects", h.Database.State, h.Database.ConsecutiveFailures, h.Database.Reconnects)
+		if h.Database.LastError != "" {
+			fmt.Fprintf(w, ", last error: %s", h.Database.LastError)
+		}
+		fmt.Fprintln(w)
+
+		if h.Migrations.Error != "" {
+			fmt.Fprintf(w, "Migrations: %s\n", h.Migrations.Error)
+		} else {
+			fmt.Fprintf(w, "Migrations: version %d of %d\n", h.Migrations.CurrentVersion, h.Migrations.LatestVersion)
+		}
+		if len(h.Tenants) > 0 {
+			fmt.Fprintf(w, "Tenants: %s\n", strings.Join(h.Tenants, ", "))
+		}
+	}
+
+	if st := s.stats; st != nil {
+		fmt.Fprintf(w, "Library: %d movies, %d genres, average rating %s", st.TotalMovies, st.TotalGenres, st.AverageRating)
+		if st.YearRange.Earliest != nil && st.YearRange.Latest != nil {
+			fmt.Fprintf(w, ", %d-%d", *st.YearRange.Earliest, *st.YearRange.Latest)
+		}
+		fmt.Fprintln(w)
+	} else if message, ok := s.errors["stats"]; ok {
+		fmt.Fprintf(w, "Library stats unavailable: %s\n", message)
+	}
+
+	if h == nil {
+		return
+	}
+	d.renderTools(w, h)
+
+	fmt.Fprintln(w, "\nRecent errors")
+	if len(h.RecentErrors) == 0 {
+		fmt.Fprintln(w, "  none")
+	}
+	for _, failure := range h.RecentErrors {
+		fmt.Fprintf(w, "  %s  %s  %s\n", failure.At.Local().Format("15:04:05"), failure.Tool, firstLine(failure.Message))
+	}
+}
+
+// renderTools writes the busiest tools as a table with a total row over
+// every tool
+func (d *Dashboard) renderTools(w io.Writer, h *health) {
+	tools := h.Tools
+	sort.SliceStable(tools, func(i, j int) bool {
+		if d.rates[tools[i].Tool] != d.rates[tools[j].Tool] {
+			return d.rates[tools[i].Tool] > d.rates[tools[j].Tool]
+		}
+		return tools[i].Calls > tools[j].Calls
+	})
+
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "\nTOOL\tCALLS\tCALLS/S\tERRORS\tAVG MS\tSLOWEST MS")
+	var calls, errors int
+	var rate float64
+	for i, tool := range tools {
+		calls += tool.Calls
+		errors += tool.Errors
+		rate += d.rates[tool.Tool]
+		if i < d.maxTools {
+			fmt.Fprintf(table, "%s\t%d\t%.1f\t%d\t%.1f\t%.1f\n",
+				tool.Tool, tool.Calls, d.rates[tool.Tool], tool.Errors, tool.AverageMS, tool.SlowestMS)
+		}
+	}
+	fmt.Fprintf(table, "total\t%d\t%.1f\t%d\n", calls, rate, errors)
+	table.Flush()
+	if len(tools) > d.maxTools {
+		fmt.Fprintf(w, "(%d quieter tools not shown)\n", len(tools)-d.maxTools)
+	}
+}
+
+// firstLine returns the first line of a message
+func firstLine(message string) string {
+	line, _, _ := strings.Cut(message, "\n")
+	return line
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/mcp/resources"
+)
+
+// writeStatus writes a status file with the given resources as a server would
+func writeStatus(t *testing.T, path string, texts map[string]string) {
+	t.Helper()
+
+	handlers := make(map[string]mcp.ResourceHandler)
+	for name, text := range texts {
+		handlers[name] = func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+			return &mcp.ReadResourceResult{Contents: []*mcp.ResourceContents{{Text: text}}}, nil
+		}
+	}
+	if err := resources.NewStatusWriter(path, time.Second, handlers).Write(context.Background()); err != nil {
+		t.Fatalf("failed to write status: %v", err)
+	}
+}
+
+const testHealth = `{
+  "status": "ok", "ready": true, "uptime": "1h0m0s",
+  "database": {"state": "healthy", "consecutive_failures": 0, "reconnects": 1},
+  "migrations": {"current_version": 13, "latest_version": 13, "up_to_date": true},
+  "tools": [
+    {"tool": "get_movie", "calls": %d, "errors": 1, "average_ms": 1.5, "slowest_ms": 4},
+    {"tool": "search_movies", "calls": 50, "errors": 0, "average_ms": 8, "slowest_ms": 20}
+  ],
+  "recent_errors": [{"at": "2024-05-01T12:00:00Z", "tool": "get_movie", "message": "movie not found\nmore detail"}]
+}`
+
+// healthWithCalls returns the test health report with get_movie's call count
+func healthWithCalls(calls int) string {
+	return fmt.Sprintf(testHealth, calls)
+}
+
+func TestDashboard_Throughput(t *testing.T) {
+	start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	dashboard := NewDashboard(10*time.Second, 15)
+
+	dashboard.Update(&snapshot{writtenAt: start, health: decodeHealth(t, healthWithCalls(10))})
+	dashboard.Update(&snapshot{writtenAt: start.Add(2 * time.Second), health: decodeHealth(t, healthWithCalls(30))})
+	if rate := dashboard.rates["get_movie"]; rate != 10 {
+		t.Errorf("Expected 10 calls/s, got: %v", rate)
+	}
+	if rate, ok := dashboard.rates["search_movies"]; ok {
+		t.Errorf("Expected no rate for an idle tool, got: %v", rate)
+	}
+
+	// Rereading an unchanged file keeps the last rates
+	dashboard.Update(&snapshot{writtenAt: start.Add(2 * time.Second), health: decodeHealth(t, healthWithCalls(30))})
+	if rate := dashboard.rates["get_movie"]; rate != 10 {
+		t.Errorf("Expected the rate to be kept, got: %v", rate)
+	}
+
+	// A restarted server's lower counts are not a negative rate
+	dashboard.Update(&snapshot{writtenAt: start.Add(4 * time.Second), health: decodeHealth(t, healthWithCalls(5))})
+	if rate, ok := dashboard.rates["get_movie"]; ok {
+		t.Errorf("Expected no rate after a restart, got: %v", rate)
+	}
+}
+
+func TestDashboard_Render(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "status.json")
+	writeStatus(t, path, map[string]string{
+		"health": healthWithCalls(10),
+		"stats":  `{"total_movies": 120, "total_genres": 14, "average_rating": "7.4", "year_range": {"earliest": 1950, "latest": 2024}}`,
+	})
+	s, err := readSnapshot(path)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	dashboard := NewDashboard(10*time.Second, 1)
+	dashboard.Update(s)
+	var out bytes.Buffer
+	dashboard.Render(&out, s.writtenAt.Add(3*time.Second))
+	screen := out.String()
+
+	for _, want := range []string{
+		"Movies MCP Server: ok, ready (uptime 1h0m0s, updated 3s ago)",
+		"Database: healthy, 0 consecutive failures, 1 reconnects",
+		"Migrations: version 13 of 13",
+		"Library: 120 movies, 14 genres, average rating 7.4, 1950-2024",
+		"search_movies",
+		"(1 quieter tools not shown)",
+		"get_movie  movie not found\n",
+	} {
+		if !strings.Contains(screen, want) {
+			t.Errorf("Expected the screen to contain %q, got:\n%s", want, screen)
+		}
+	}
+	if strings.Contains(screen, "more detail") {
+		t.Error("Expected only the first line of an error message")
+	}
+
+	out.Reset()
+	dashboard.Render(&out, s.writtenAt.Add(time.Minute))
+	if !strings.Contains(out.String(), "STALE") {
+		t.Errorf("Expected an old status to be flagged, got:\n%s", out.String())
+	}
+}
+
+func TestRun_Once(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "status.json")
+	opts := options{statusPath: path, refresh: time.Second, staleAfter: time.Minute, maxTools: 15, once: true}
+
+	if err := run(context.Background(), opts, &bytes.Buffer{}); !os.IsNotExist(err) {
+		t.Errorf("Expected a missing status file to be reported, got: %v", err)
+	}
+
+	writeStatus(t, path, map[string]string{"health": healthWithCalls(3)})
+	var out bytes.Buffer
+	if err := run(context.Background(), opts, &out); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if strings.Contains(out.String(), clearScreen) || !strings.Contains(out.String(), "total") {
+		t.Errorf("Expected one plain screen, got:\n%s", out.String())
+	}
+
+	if err := run(context.Background(), options{refresh: time.Second}, &out); err == nil || !strings.Contains(err.Error(), "STATUS_FILE") {
+		t.Errorf("Expected a missing -status to be explained, got: %v", err)
+	}
+}
+
+// decodeHealth decodes a health report
+func decodeHealth(t *testing.T, text string) *health {
+	t.Helper()
+
+	var h health
+	if err := json.Unmarshal([]byte(text), &h); err != nil {
+		t.Fatalf("failed to decode health: %v", err)
+	}
+	return &h
+}
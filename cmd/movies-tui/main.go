@@ -0,0 +1,104 @@
+// Command movies-tui shows a live dashboard of a running server.
+//
+// A stdio server's pipes belong to its MCP client, so the dashboard reads the
+// status file the server writes when STATUS_FILE is set: the
+// movies://server/health report and movies://database/stats, refreshed every
+// STATUS_INTERVAL. It shows server and database health, migration status,
+// per-tool throughput, latency and errors, the most recent failed calls and
+// library stats, redrawing the terminal until interrupted.
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// options are the command's flags
+type options struct {
+	statusPath string
+	refresh    time.Duration
+	staleAfter time.Duration
+	maxTools   int
+	once       bool
+}
+
+func main() {
+	var opts options
+	flag.StringVar(&opts.statusPath, "status", os.Getenv("STATUS_FILE"), "Status file written by the server (defaults to $STATUS_FILE)")
+	flag.DurationVar(&opts.refresh, "refresh", time.Second, "How often to reread the status file")
+	flag.DurationVar(&opts.staleAfter, "stale-after", 10*time.Second, "Flag the status as stale when the server has not written it for this long")
+	flag.IntVar(&opts.maxTools, "tools", 15, "Maximum number of tools to list")
+	flag.BoolVar(&opts.once, "once", false, "Print the dashboard once without clearing the screen and exit")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx, opts, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "movies-tui: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// clearScreen moves the cursor home and clears the terminal
+const clearScreen = "\x1b[H\x1b[2J"
+
+// run redraws the dashboard every refresh until ctx is done, or draws it once
+func run(ctx context.Context, opts options, out io.Writer) error {
+	if opts.statusPath == "" {
+		return errors.New("no status file: start the server with STATUS_FILE set and pass the same path with -status")
+	}
+	if opts.refresh <= 0 {
+		return errors.New("refresh must be positive")
+	}
+
+	dashboard := NewDashboard(opts.staleAfter, opts.maxTools)
+	if opts.once {
+		s, err := readSnapshot(opts.statusPath)
+		if err != nil {
+			return err
+		}
+		dashboard.Update(s)
+		dashboard.Render(out, time.Now())
+		return nil
+	}
+
+	ticker := time.NewTicker(opts.refresh)
+	defer ticker.Stop()
+	for {
+		// Render into a buffer so each screen replaces the last in one write
+		var screen bytes.Buffer
+		screen.WriteString(clearScreen)
+		s, err := readSnapshot(opts.statusPath)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			fmt.Fprintf(&screen, "Waiting for %s; is the server running with STATUS_FILE set?\n", opts.statusPath)
+		case err != nil:
+			// Show the last good screen with the error below it
+			dashboard.Render(&screen, time.Now())
+			fmt.Fprintf(&screen, "\n%v\n", err)
+		default:
+			dashboard.Update(s)
+			dashboard.Render(&screen, time.Now())
+		}
+		fmt.Fprintf(&screen, "\nRefreshing every %s from %s; Ctrl-C to quit\n", opts.refresh, opts.statusPath)
+		if _, err := out.Write(screen.Bytes()); err != nil {
+			return err
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// defaultIgnore are the keys left out of comparisons because they differ on
+// every run: timestamps, and the server build a response came from
+var defaultIgnore = []string{
+	"created_at", "updated_at", "expires_at", "written_at", "submitted_at", "occurred_at", "completed_at",
+	"serverInfo",
+}
+
+// defaultTokens are the keys holding values the server generates for a
+// client to send back. Replayed requests carry the replayed server's value
+// in place of the recorded one.
+var defaultTokens = []string{"context_id", "confirmation_token", "token"}
+
+// maxDiffs bounds the differences reported for one response
+const maxDiffs = 10
+
+// comparer compares recorded responses with replayed ones
+type comparer struct {
+	ignore map[string]bool
+	tokens map[string]bool
+}
+
+// newComparer creates a comparer ignoring and tracking the given keys
+func newComparer(ignore, tokens []string) *comparer {
+	c := &comparer{ignore: make(map[string]bool), tokens: make(map[string]bool)}
+	for _, key := range ignore {
+		c.ignore[key] = true
+	}
+	for _, key := range tokens {
+		c.tokens[key] = true
+	}
+	return c
+}
+
+// compare returns the differences between a recorded and a replayed
+// message, and adds the tokens the replayed server issued in place of the
+// recorded ones to replaced
+func (c *comparer) compare(recorded, replayed []byte, replaced map[string]string) ([]string, error) {
+	want, err := decode(recorded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recorded message: %w", err)
+	}
+	got, err := decode(replayed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid replayed message: %w", err)
+	}
+
+	// Tokens also appear in messages meant for people, so the recording is
+	// compared as if the replayed server had issued it
+	c.collectTokens(want, got, replaced)
+	if want, err = decode(substitute(recorded, replaced)); err != nil {
+		return nil, fmt.Errorf("invalid recorded message: %w", err)
+	}
+
+	var diffs []string
+	c.diff("", c.strip(want), c.strip(got), &diffs)
+	return diffs, nil
+}
+
+// substitute replaces each recorded token in data with the replayed one
+func substitute(data []byte, replaced map[string]string) []byte {
+	for recordedToken, replayedToken := range replaced {
+		data = bytes.ReplaceAll(data, []byte(recordedToken), []byte(replayedToken))
+	}
+	return data
+}
+
+// decode parses a JSON message, along with any JSON held in its strings
+// such as the text content of tool results
+func decode(data []byte) (any, error) {
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return expand(value), nil
+}
+
+// expand replaces strings that hold a JSON object or array with their
+// parsed value
+func expand(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		for key, v := range value {
+			value[key] = expand(v)
+		}
+	case []any:
+		for i, v := range value {
+			value[i] = expand(v)
+		}
+	case string:
+		trimmed := strings.TrimSpace(value)
+		if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+			var parsed any
+			if json.Unmarshal([]byte(trimmed), &parsed) == nil {
+				return expand(parsed)
+			}
+		}
+	}
+	return value
+}
+
+// collectTokens walks both values in step and maps each recorded token to
+// the replayed one where they differ
+func (c *comparer) collectTokens(want, got any, replaced map[string]string) {
+	switch want := want.(type) {
+	case map[string]any:
+		got, ok := got.(map[string]any)
+		if !ok {
+			return
+		}
+		for key, w := range want {
+			if c.tokens[key] {
+				wantToken, wok := w.(string)
+				gotToken, gok := got[key].(string)
+				if wok && gok && wantToken != "" && wantToken != gotToken {
+					replaced[wantToken] = gotToken
+				}
+				continue
+			}
+			c.collectTokens(w, got[key], replaced)
+		}
+	case []any:
+		got, ok := got.([]any)
+		if !ok {
+			return
+		}
+		for i := 0; i < len(want) && i < len(got); i++ {
+			c.collectTokens(want[i], got[i], replaced)
+		}
+	}
+}
+
+// strip removes ignored and token keys from a decoded value
+func (c *comparer) strip(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		stripped := make(map[string]any, len(value))
+		for key, v := range value {
+			if !c.ignore[key] && !c.tokens[key] {
+				stripped[key] = c.strip(v)
+			}
+		}
+		return stripped
+	case []any:
+		stripped := make([]any, len(value))
+		for i, v := range value {
+			stripped[i] = c.strip(v)
+		}
+		return stripped
+	default:
+		return value
+	}
+}
+
+// diff appends a line per difference between want and got, addressed by
+// path, up to maxDiffs
+func (c *comparer) diff(path string, want, got any, diffs *[]string) {
+	if len(*diffs) >= maxDiffs {
+		return
+	}
+
+	switch w := want.(type) {
+	case map[string]any:
+		g, ok := got.(map[string]any)
+		if !ok {
+			break
+		}
+		for _, key := range unionKeys(w, g) {
+			wv, inWant := w[key]
+			gv, inGot := g[key]
+			switch {
+			case !inGot:
+				*diffs = append(*diffs, fmt.Sprintf("%s: missing, was %s", join(path, key), brief(wv)))
+			case !inWant:
+				*diffs = append(*diffs, fmt.Sprintf("%s: added %s", join(path, key), brief(gv)))
+			default:
+				c.diff(join(path, key), wv, gv, diffs)
+			}
+			if len(*diffs) >= maxDiffs {
+				return
+			}
+		}
+		return
+	case []any:
+		g, ok := got.([]any)
+		if !ok {
+			break
+		}
+		if len(w) != len(g) {
+			*diffs = append(*diffs, fmt.Sprintf("%s: %d items, was %d", orRoot(path), len(g), len(w)))
+			return
+		}
+		for i := range w {
+			c.diff(fmt.Sprintf("%s[%d]", path, i), w[i], g[i], diffs)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(want, got) {
+		*diffs = append(*diffs, fmt.Sprintf("%s: %s, was %s", orRoot(path), brief(got), brief(want)))
+	}
+}
+
+// unionKeys returns the keys of both maps in sorted order
+func unionKeys(a, b map[string]any) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	keys := make([]string, 0, len(a)+len(b))
+	for _, m := range []map[string]any{a, b} {
+		for key := range m {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// join appends a key to a path
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// orRoot names the empty path
+func orRoot(path string) string {
+	if path == "" {
+		return "(message)"
+	}
+	return path
+}
+
+// brief renders a value as JSON, shortened to fit on a line
+func brief(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	const maxLen = 80
+	if len(data) > maxLen {
+		return string(data[:maxLen]) + "..."
+	}
+	return string(data)
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestComparer_Compare(t *testing.T) {
+	tests := []struct {
+		name      string
+		recorded  string
+		replayed  string
+		wantDiffs []string
+	}{
+		{
+			name:     "identical",
+			recorded: `{"id":1,"result":{"title":"Alien"}}`,
+			replayed: `{"id":1,"result":{"title":"Alien"}}`,
+		},
+		{
+			name:     "timestamps and server version are ignored",
+			recorded: `{"id":1,"result":{"created_at":"2026-01-01T00:00:00Z","serverInfo":{"version":"1.0"}}}`,
+			replayed: `{"id":1,"result":{"created_at":"2026-10-14T12:00:00Z","serverInfo":{"version":"1.1"}}}`,
+		},
+		{
+			name:      "changed value inside text content",
+			recorded:  `{"id":1,"result":{"content":[{"type":"text","text":"{\"rating\":8.5}"}]}}`,
+			replayed:  `{"id":1,"result":{"content":[{"type":"text","text":"{\"rating\":8.1}"}]}}`,
+			wantDiffs: []string{"result.content[0].text.rating: 8.1, was 8.5"},
+		},
+		{
+			name:      "missing and added keys",
+			recorded:  `{"id":1,"result":{"title":"Alien"}}`,
+			replayed:  `{"id":1,"result":{"name":"Alien"}}`,
+			wantDiffs: []string{`result.name: added "Alien"`, `result.title: missing, was "Alien"`},
+		},
+		{
+			name:      "lists of different lengths",
+			recorded:  `{"id":1,"result":{"movies":[1,2]}}`,
+			replayed:  `{"id":1,"result":{"movies":[1]}}`,
+			wantDiffs: []string{"result.movies: 1 items, was 2"},
+		},
+		{
+			name:      "result became an error",
+			recorded:  `{"id":1,"result":{}}`,
+			replayed:  `{"id":1,"error":{"code":-32602,"message":"invalid"}}`,
+			wantDiffs: []string{`error: added {"code":-32602,"message":"invalid"}`, "result: missing, was {}"},
+		},
+	}
+
+	c := newComparer(defaultIgnore, defaultTokens)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diffs, err := c.compare([]byte(tt.recorded), []byte(tt.replayed), map[string]string{})
+			if err != nil {
+				t.Fatalf("compare() error = %v", err)
+			}
+			if strings.Join(diffs, "\n") != strings.Join(tt.wantDiffs, "\n") {
+				t.Errorf("compare() = %q, want %q", diffs, tt.wantDiffs)
+			}
+		})
+	}
+}
+
+func TestComparer_CollectsTokens(t *testing.T) {
+	c := newComparer(defaultIgnore, defaultTokens)
+	replaced := map[string]string{}
+
+	diffs, err := c.compare(
+		[]byte(`{"id":1,"result":{"content":[{"type":"text","text":"{\"context_id\":\"old-id\",\"total\":3}"}]}}`),
+		[]byte(`{"id":1,"result":{"content":[{"type":"text","text":"{\"context_id\":\"new-id\",\"total\":3}"}]}}`),
+		replaced,
+	)
+	if err != nil {
+		t.Fatalf("compare() error = %v", err)
+	}
+	if len(diffs) != 0 {
+		t.Errorf("compare() = %q, want no differences", diffs)
+	}
+	if replaced["old-id"] != "new-id" {
+		t.Errorf("replaced = %v, want old-id mapped to new-id", replaced)
+	}
+}
@@ -0,0 +1,266 @@
+// Command replay re-sends a recorded MCP session to a server build and
+// reports every response that differs from the recording.
+//
+// Record a session by starting the server with -record <file>. Replay builds
+// and starts cmd/server-sdk over stdio on a scratch database (or runs the
+// binary given with -server), sends the recorded client messages in order
+// and compares each response with the recorded one. Timestamps and the
+// server's version are ignored, and tokens the server hands out, such as
+// search context IDs, are carried over from replayed responses to later
+// requests. It exits non-zero when any response differs, so CI can catch
+// behavioural regressions between releases.
+//
+// The replay starts from whatever library the server opens, so record
+// against a reproducible one: -demo, or a fresh database, and replay with
+// the same -args.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/jsonrpc"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/mcp/recording"
+	"github.com/francknouama/movies-mcp-server/pkg/mcptest"
+)
+
+// options are the command's flags
+type options struct {
+	root       string
+	server     string
+	args       string
+	recordPath string
+	ignore     string
+	timeout    time.Duration
+	verbose    bool
+}
+
+func main() {
+	var opts options
+	flag.StringVar(&opts.root, "root", ".", "Repository root")
+	flag.StringVar(&opts.server, "server", "", "Server binary to replay against (built from cmd/server-sdk if empty)")
+	flag.StringVar(&opts.args, "args", "", "Space-separated arguments to start the server with, e.g. -demo")
+	flag.StringVar(&opts.ignore, "ignore", strings.Join(defaultIgnore, ","), "Comma-separated keys left out of comparisons")
+	flag.DurationVar(&opts.timeout, "timeout", 30*time.Second, "Time allowed for each response")
+	flag.BoolVar(&opts.verbose, "v", false, "Also list the responses that match")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [options] <recording>\n\nOptions:\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	opts.recordPath = flag.Arg(0)
+
+	differ, err := run(context.Background(), opts, os.Stdout)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "replay: %v\n", err)
+		os.Exit(1)
+	}
+	if differ > 0 {
+		os.Exit(1)
+	}
+}
+
+// run replays the recording against the server and returns the number of
+// responses that differ
+func run(ctx context.Context, opts options, out io.Writer) (int, error) {
+	entries, err := recording.ReadFile(opts.recordPath)
+	if err != nil {
+		return 0, err
+	}
+
+	workDir, err := os.MkdirTemp("", "replay-")
+	if err != nil {
+		return 0, fmt.Errorf("failed to create work directory: %w", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	server := opts.server
+	if server == "" {
+		server = filepath.Join(workDir, "movies-server")
+		if err := mcptest.BuildServer(ctx, opts.root, server); err != nil {
+			return 0, err
+		}
+	}
+
+	cmd := exec.Command(server, strings.Fields(opts.args)...)
+	cmd.Dir = opts.root
+	cmd.Env = append(os.Environ(),
+		"DB_NAME="+filepath.Join(workDir, "replay.db"),
+		"DATABASE_URL=",
+		"LOG_LEVEL=error",
+	)
+	cmd.Stderr = io.Discard
+	conn, err := (&mcp.CommandTransport{Command: cmd}).Connect(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start server: %w", err)
+	}
+	defer conn.Close()
+
+	return replay(ctx, conn, entries, newComparer(splitList(opts.ignore), defaultTokens), opts, out)
+}
+
+// replay sends the client's messages over conn in order and compares each
+// response with the one recorded for the same request ID
+func replay(ctx context.Context, conn mcp.Connection, entries []recording.Entry, c *comparer, opts options, out io.Writer) (int, error) {
+	recorded := make(map[string][]byte)
+	for _, entry := range entries {
+		if entry.Direction != recording.FromServer {
+			continue
+		}
+		msg, err := entry.Decode()
+		if err != nil {
+			return 0, fmt.Errorf("invalid recorded message: %w", err)
+		}
+		if response, ok := msg.(*jsonrpc.Response); ok {
+			recorded[idKey(response.ID)] = entry.Message
+		}
+	}
+
+	replaced := make(map[string]string) // Recorded token -> replayed token
+	requests, differ := 0, 0
+	for _, entry := range entries {
+		if entry.Direction != recording.FromClient {
+			continue
+		}
+		msg, err := jsonrpc.DecodeMessage(substitute(entry.Message, replaced))
+		if err != nil {
+			return differ, fmt.Errorf("invalid recorded message: %w", err)
+		}
+		request, ok := msg.(*jsonrpc.Request)
+		if !ok {
+			continue // A response to a server request; this server makes none
+		}
+		if err := conn.Write(ctx, request); err != nil {
+			return differ, fmt.Errorf("failed to send %s: %w", request.Method, err)
+		}
+		if !request.IsCall() {
+			continue
+		}
+
+		requests++
+		label := describe(request)
+		response, err := readResponse(ctx, conn, request.ID, opts.timeout)
+		if err != nil {
+			return differ, fmt.Errorf("%s: %w", label, err)
+		}
+		want, ok := recorded[idKey(request.ID)]
+		if !ok {
+			fmt.Fprintf(out, "SKIP  %s: no recorded response\n", label)
+			continue
+		}
+		got, err := jsonrpc.EncodeMessage(response)
+		if err != nil {
+			return differ, fmt.Errorf("%s: failed to encode response: %w", label, err)
+		}
+
+		diffs, err := c.compare(want, got, replaced)
+		if err != nil {
+			return differ, fmt.Errorf("%s: %w", label, err)
+		}
+		if len(diffs) == 0 {
+			if opts.verbose {
+				fmt.Fprintf(out, "OK    %s\n", label)
+			}
+			continue
+		}
+		differ++
+		fmt.Fprintf(out, "DIFF  %s\n", label)
+		for _, diff := range diffs {
+			fmt.Fprintf(out, "      %s\n", diff)
+		}
+	}
+
+	fmt.Fprintf(out, "%d requests replayed, %d differ\n", requests, differ)
+	return differ, nil
+}
+
+// readResponse reads messages until the response to the request with id,
+// skipping the server's notifications
+func readResponse(ctx context.Context, conn mcp.Connection, id jsonrpc.ID, timeout time.Duration) (*jsonrpc.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	type result struct {
+		response *jsonrpc.Response
+		err      error
+	}
+	done := make(chan result, 1)
+	go func() {
+		for {
+			msg, err := conn.Read(ctx)
+			if err != nil {
+				done <- result{err: err}
+				return
+			}
+			if response, ok := msg.(*jsonrpc.Response); ok && idKey(response.ID) == idKey(id) {
+				done <- result{response: response}
+				return
+			}
+		}
+	}()
+
+	select {
+	case r := <-done:
+		if r.err != nil {
+			return nil, fmt.Errorf("server closed before responding: %w", r.err)
+		}
+		return r.response, nil
+	case <-ctx.Done():
+		conn.Close() // Unblocks the read; the session cannot continue
+		return nil, errors.New("timed out waiting for the response")
+	}
+}
+
+// describe names a request in the report, with the tool or resource it
+// addresses
+func describe(request *jsonrpc.Request) string {
+	label := fmt.Sprintf("#%v %s", request.ID.Raw(), request.Method)
+	switch request.Method {
+	case "tools/call":
+		var params struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(request.Params, &params); err == nil && params.Name != "" {
+			label += " " + params.Name
+		}
+	case "resources/read":
+		var params struct {
+			URI string `json:"uri"`
+		}
+		if err := json.Unmarshal(request.Params, &params); err == nil && params.URI != "" {
+			label += " " + params.URI
+		}
+	}
+	return label
+}
+
+// idKey makes a request ID comparable across decodes
+func idKey(id jsonrpc.ID) string {
+	return fmt.Sprint(id.Raw())
+}
+
+// splitList splits a comma-separated flag, dropping empty items
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/mcp/recording"
+	"github.com/francknouama/movies-mcp-server/pkg/mcptest"
+)
+
+type openInput struct{}
+
+type openOutput struct {
+	Token string `json:"token"`
+}
+
+type readInput struct {
+	Token string `json:"token"`
+}
+
+type readOutput struct {
+	Rating float64 `json:"rating"`
+}
+
+// newTestServer serves open, which hands out a fresh token, and read,
+// which takes it back and returns rating
+func newTestServer(rating float64) *mcp.Server {
+	server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "1.0.0"}, nil)
+	tokens := make(map[string]bool)
+	mcp.AddTool(server, &mcp.Tool{Name: "open"}, func(ctx context.Context, req *mcp.CallToolRequest, input openInput) (*mcp.CallToolResult, openOutput, error) {
+		token := uuid.New().String()
+		tokens[token] = true
+		return nil, openOutput{Token: token}, nil
+	})
+	mcp.AddTool(server, &mcp.Tool{Name: "read"}, func(ctx context.Context, req *mcp.CallToolRequest, input readInput) (*mcp.CallToolResult, readOutput, error) {
+		if !tokens[input.Token] {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "unknown token"}}}, readOutput{}, nil
+		}
+		return nil, readOutput{Rating: rating}, nil
+	})
+	return server
+}
+
+// recordSession records a client opening a token and reading with it
+func recordSession(t *testing.T) []recording.Entry {
+	t.Helper()
+	ctx := context.Background()
+
+	var out bytes.Buffer
+	recorder := recording.NewRecorder(&out)
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	session, err := newTestServer(8.5).Connect(ctx, recorder.Transport(serverTransport), nil)
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	client, err := mcptest.Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("mcptest.Connect() error = %v", err)
+	}
+	opened, err := mcptest.CallToolAs[openOutput](ctx, client, "open", map[string]any{})
+	if err != nil {
+		t.Fatalf("open error = %v", err)
+	}
+	if _, err := mcptest.CallToolAs[readOutput](ctx, client, "read", map[string]any{"token": opened.Token}); err != nil {
+		t.Fatalf("read error = %v", err)
+	}
+	client.Close()
+	session.Wait()
+
+	entries, err := recording.Read(&out)
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	return entries
+}
+
+func TestReplay(t *testing.T) {
+	entries := recordSession(t)
+
+	tests := []struct {
+		name       string
+		rating     float64
+		wantDiffer int
+		wantOutput string
+	}{
+		{name: "same behaviour", rating: 8.5, wantOutput: "3 requests replayed, 0 differ"},
+		{name: "regression", rating: 7.0, wantDiffer: 1, wantOutput: "DIFF  #3 tools/call read"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			serverTransport, clientTransport := mcp.NewInMemoryTransports()
+			session, err := newTestServer(tt.rating).Connect(ctx, serverTransport, nil)
+			if err != nil {
+				t.Fatalf("Connect() error = %v", err)
+			}
+			defer session.Close()
+			conn, err := clientTransport.Connect(ctx)
+			if err != nil {
+				t.Fatalf("Connect() error = %v", err)
+			}
+			defer conn.Close()
+
+			var out bytes.Buffer
+			differ, err := replay(ctx, conn, entries, newComparer(defaultIgnore, defaultTokens), options{timeout: 5 * time.Second}, &out)
+			if err != nil {
+				t.Fatalf("replay() error = %v", err)
+			}
+			if differ != tt.wantDiffer {
+				t.Errorf("replay() = %d differ, want %d\n%s", differ, tt.wantDiffer, out.String())
+			}
+			if !strings.Contains(out.String(), tt.wantOutput) {
+				t.Errorf("output = %q, want it to contain %q", out.String(), tt.wantOutput)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/sirupsen/logrus"
+
+	actorApp "github.com/francknouama/movies-mcp-server/internal/application/actor"
+	"github.com/francknouama/movies-mcp-server/internal/application/events"
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/application/seed"
+	"github.com/francknouama/movies-mcp-server/internal/config"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/infrastructure/memory"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/middleware"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/resources"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/tools"
+	"github.com/francknouama/movies-mcp-server/pkg/logging"
+)
+
+// demoDatasets are the embedded datasets a demo library starts with
+var demoDatasets = []string{"classics", "recent"}
+
+// runDemo serves a library held in memory and preloaded with demoDatasets
+// over transport, so the server can be tried without a database. Only the
+// tools built on movies and actors are registered; changes are lost when it
+// exits.
+func runDemo(ctx context.Context, cfg *config.Config, transport mcp.Transport) error {
+	store := memory.NewStore()
+	movieService := movieApp.NewService(memory.NewMovieRepository(store))
+	validationPolicy, err := shared.ParseValidationPolicy(cfg.Server.ValidationPolicy)
+	if err != nil {
+		return fmt.Errorf("invalid validation policy: %w", err)
+	}
+	movieService.SetValidationPolicy(validationPolicy)
+	catalog, err := newCatalog(cfg.Server.Locale)
+	if err != nil {
+		return fmt.Errorf("invalid server locale: %w", err)
+	}
+	verbosity, err := middleware.ParseVerbosity(cfg.Server.Verbosity)
+	if err != nil {
+		return fmt.Errorf("invalid response verbosity: %w", err)
+	}
+	movieService.SetRankingWeights(cfg.Server.SearchRankingWeights)
+	actorService := actorApp.NewService(memory.NewActorRepository(store))
+
+	seeder := seed.NewSeeder(movieService)
+	for _, dataset := range demoDatasets {
+		result, err := seeder.Seed(ctx, dataset)
+		if err != nil {
+			return fmt.Errorf("failed to load demo dataset %s: %w", dataset, err)
+		}
+		fmt.Fprintf(os.Stderr, "Loaded demo dataset %q: %d movies\n", result.Dataset, result.Inserted)
+	}
+
+	movieTools := tools.NewMovieTools(movieService)
+	movieTools.SetInteractive(cfg.Server.InteractiveTools)
+	actorTools := tools.NewActorTools(actorService)
+	actorTools.SetMovieReader(movieService)
+	compoundTools := tools.NewCompoundTools(movieService)
+	searchAllTools := tools.NewSearchAllTools(movieService, actorService)
+	contextTools := tools.NewContextTools(movieService)
+	seedTools := tools.NewSeedTools(movieService)
+	batchTools := tools.NewBatchTools(movieService, actorService, cfg.Server.MaxBatchSize)
+	bulkUpdateTools := tools.NewBulkUpdateTools(movieService)
+	timelineTools := tools.NewTimelineTools(movieService)
+	runtimeTools := tools.NewRuntimeTools(movieService)
+
+	preferenceStore := tools.NewPreferenceStore()
+	preferenceTools := tools.NewPreferenceTools(preferenceStore)
+	movieTools.SetPreferenceStore(preferenceStore)
+	compoundTools.SetPreferenceStore(preferenceStore)
+	compoundTools.SetCastReader(actorService)
+	tasteTools := tools.NewTasteTools(movieService)
+	tasteTools.SetPreferenceStore(preferenceStore)
+
+	eventBus := events.NewBus(cfg.Events.HistorySize)
+	domainPublisher := events.NewDomainPublisher(eventBus)
+	movieService.SetEventPublisher(domainPublisher)
+	actorService.SetEventPublisher(domainPublisher)
+	eventTools := tools.NewEventTools(eventBus)
+
+	dbResources := resources.NewDatabaseResources(movieService)
+	dbResources.SetMaxPageSize(cfg.Server.MaxPageSize)
+
+	changeNotifier := resources.NewChangeNotifier()
+	server := mcp.NewServer(
+		&mcp.Implementation{
+			Name:    name,
+			Version: version,
+		},
+		&mcp.ServerOptions{
+			SubscribeHandler:   changeNotifier.HandleSubscribe,
+			UnsubscribeHandler: changeNotifier.HandleUnsubscribe,
+		},
+	)
+	changeNotifier.Attach(server)
+	eventBus.Subscribe(changeNotifier)
+
+	logger := logging.NewLogger()
+	if level, err := logrus.ParseLevel(cfg.Server.LogLevel); err == nil {
+		logger.SetLevel(level)
+	}
+
+	// The same request handling as the full server, less the database checks
+	inFlight := middleware.NewInFlightTracker(ctx)
+	server.AddReceivingMiddleware(
+		middleware.RecoverPanics(logger),
+		middleware.LimitRequestSize(cfg.Server.MaxRequestBytes),
+		inFlight.Middleware(),
+		middleware.Timeout(cfg.Server.Timeout),
+		middleware.Verbosities(verbosity),
+	)
+	registrar := middleware.NewToolRegistrar(server,
+		middleware.Logging(logger, middleware.LogPolicy{
+			RedactFields:    cfg.Server.LogRedactFields,
+			MaxPayloadBytes: cfg.Server.LogMaxPayloadBytes,
+		}),
+		middleware.MapErrors(),
+		middleware.Localize(catalog, sessionLocales(preferenceStore, cfg.Server.Locale)),
+		middleware.Recover(logger),
+		middleware.LimitResponses(middleware.ResponseLimits{
+			MaxBytes: cfg.Server.MaxResponseBytes,
+			PerTool:  cfg.Server.ToolResponseBytes,
+		}),
+		middleware.PublishEvents(eventBus, events.ToolEventTypes),
+		middleware.Validate(),
+	)
+
+	middleware.AddTool(registrar, &mcp.Tool{Name: "get_movie", Description: "Get a movie by ID", OutputSchema: tools.SelectableOutputSchema[tools.GetMovieOutput]()}, movieTools.GetMovie)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "add_movie", Description: "Add a new movie to the database"}, movieTools.AddMovie)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "update_movie", Description: "Update the given fields of an existing movie, keeping the rest"}, movieTools.UpdateMovie)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "delete_movie", Description: "Delete a movie by ID, or by exact title; asks which one when a title matches several"}, movieTools.DeleteMovie)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "list_top_movies", Description: "Get top-rated movies"}, movieTools.ListTopMovies)
+	middleware.AddTool(registrar, middleware.Deprecated(&mcp.Tool{Name: "search_movies", Description: "Search for movies with various filters", OutputSchema: tools.SelectableOutputSchema[tools.SearchMoviesOutput]()}, searchMoviesDeprecation), movieTools.SearchMovies)
+	middleware.AddTool(registrar, middleware.Versioned(&mcp.Tool{Name: "search_movies", Description: "Search for movies with various filters, a page at a time", OutputSchema: tools.SelectableOutputSchema[tools.SearchMoviesV2Output]()}, 2), movieTools.SearchMoviesV2)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "search_by_decade", Description: "Search movies by decade (e.g., 1990s, 90s, the nineties, 1990-1999)", OutputSchema: tools.SelectableOutputSchema[tools.SearchMoviesOutput]()}, movieTools.SearchByDecade)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "search_by_rating_range", Description: "Search movies by rating range", OutputSchema: tools.SelectableOutputSchema[tools.SearchMoviesOutput]()}, movieTools.SearchByRatingRange)
+
+	middleware.AddTool(registrar, &mcp.Tool{Name: "get_actor", Description: "Get an actor by ID"}, actorTools.GetActor)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "add_actor", Description: "Add a new actor to the database"}, actorTools.AddActor)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "update_actor", Description: "Update an existing actor"}, actorTools.UpdateActor)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "delete_actor", Description: "Delete an actor by ID"}, actorTools.DeleteActor)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "link_actor_to_movie", Description: "Link an actor to a movie, optionally with the character played, billing order and role type"}, actorTools.LinkActorToMovie)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "unlink_actor_from_movie", Description: "Unlink an actor from a movie"}, actorTools.UnlinkActorFromMovie)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "get_movie_cast", Description: "Get all actors in a movie with their characters, in billing order"}, actorTools.GetMovieCast)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "get_actor_movies", Description: "Get all movies for an actor"}, actorTools.GetActorMovies)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "search_actors", Description: "Search for actors"}, actorTools.SearchActors)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "search_by_character", Description: "Find which actors played a character, e.g. Wolverine or James Bond"}, actorTools.SearchByCharacter)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "analyze_performance_ages", Description: "Find the youngest and oldest performances by actors' age at release"}, actorTools.AnalyzePerformanceAges)
+
+	middleware.AddTool(registrar, &mcp.Tool{Name: "bulk_movie_import", Description: "Import multiple movies at once"}, compoundTools.BulkMovieImport)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "movie_recommendation_engine", Description: "Get personalized movie recommendations based on preferences"}, compoundTools.MovieRecommendationEngine)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "pick_movie_for_me", Description: "Pick what to watch from a mood, runtime, decade and rating, leaving out movies already seen"}, compoundTools.PickMovieForMe)
+	middleware.AddTool(registrar, middleware.Deprecated(&mcp.Tool{Name: "director_career_analysis", Description: "Analyze a director's career trajectory and filmography"}, directorCareerDeprecation), compoundTools.DirectorCareerAnalysis)
+	middleware.AddTool(registrar, middleware.Versioned(&mcp.Tool{Name: "director_career_analysis", Description: "Analyze a director's career eras, collaborators and per-film metrics"}, 2), compoundTools.DirectorCareerAnalysisV2)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "search_all", Description: "Search movies, actors and directors in one call"}, searchAllTools.SearchAll)
+
+	middleware.AddTool(registrar, &mcp.Tool{Name: "create_search_context", Description: "Create a paginated context for large search results"}, contextTools.CreateSearchContext)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "get_context_page", Description: "Get a specific page from a search context"}, contextTools.GetContextPage)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "get_context_info", Description: "Get metadata about a search context"}, contextTools.GetContextInfo)
+
+	middleware.AddTool(registrar, &mcp.Tool{Name: "seed_database", Description: "Load a curated embedded dataset (classics, recent, fixtures); movies already present are skipped"}, seedTools.SeedDatabase)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "set_preferences", Description: "Remember this session's preferences as defaults for search and recommendations"}, preferenceTools.SetPreferences)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "get_preferences", Description: "Get the preferences this session has set"}, preferenceTools.GetPreferences)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "taste_profile", Description: "Compare the user's own ratings with the stored ones to find the genres and directors they over- or under-rate"}, tasteTools.TasteProfile)
+
+	middleware.AddTool(registrar, &mcp.Tool{Name: "get_movies_by_ids", Description: fmt.Sprintf("Get up to %d movies by ID in one call", batchTools.MaxBatchSize()), OutputSchema: tools.SelectableOutputSchema[tools.GetMoviesByIDsOutput]()}, batchTools.GetMoviesByIDs)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "get_actors_by_ids", Description: fmt.Sprintf("Get up to %d actors by ID in one call", batchTools.MaxBatchSize())}, batchTools.GetActorsByIDs)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "bulk_update_movies", Description: "Apply a partial update to every movie matching a filter, as a dry run unless given a confirmation_token"}, bulkUpdateTools.BulkUpdateMovies)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "get_release_timeline", Description: "Show what was released when: movies grouped by release year with counts, average ratings and top-rated picks"}, timelineTools.GetReleaseTimeline)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "get_runtime_by_genre", Description: "Compare movie runtimes by genre: average, shortest and longest runtime for each genre"}, runtimeTools.GetRuntimeByGenre)
+	middleware.AddTool(registrar, &mcp.Tool{Name: "list_recent_events", Description: "List recent data change events published by mutating tools, newest first"}, eventTools.ListRecentEvents)
+
+	server.AddResource(dbResources.AllMoviesResource(), dbResources.HandleAllMovies)
+	server.AddResource(dbResources.DatabaseStatsResource(), dbResources.HandleDatabaseStats)
+	server.AddResource(dbResources.GenresResource(), dbResources.HandleGenres)
+	server.AddResource(dbResources.DirectorsResource(), dbResources.HandleDirectors)
+	server.AddResourceTemplate(dbResources.AllMoviesTemplate(), dbResources.HandleAllMovies)
+
+	fmt.Fprintf(os.Stderr, "✓ Registered 34 tools and 4 resources over the in-memory library\n")
+	fmt.Fprintf(os.Stderr, "\nDemo server ready - listening on stdin/stdout; changes are not saved\n")
+
+	runErr := server.Run(ctx, transport)
+	if err := inFlight.Drain(cfg.Server.ShutdownTimeout); err != nil {
+		fmt.Fprintf(os.Stderr, "Shutdown drain incomplete: %v\n", err)
+	}
+	if runErr != nil && ctx.Err() == nil {
+		return fmt.Errorf("server error: %w", runErr)
+	}
+	return nil
+}
@@ -3,21 +3,55 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"flag"
 	"fmt"
+	"io/fs"
 	"log"
+	"net/http"
 	"os"
-	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/sirupsen/logrus"
 	_ "modernc.org/sqlite"
 
 	actorApp "github.com/francknouama/movies-mcp-server/internal/application/actor"
+	availabilityApp "github.com/francknouama/movies-mcp-server/internal/application/availability"
+	"github.com/francknouama/movies-mcp-server/internal/application/events"
+	franchiseApp "github.com/francknouama/movies-mcp-server/internal/application/franchise"
+	historyApp "github.com/francknouama/movies-mcp-server/internal/application/history"
+	"github.com/francknouama/movies-mcp-server/internal/application/librarysync"
+	mediaApp "github.com/francknouama/movies-mcp-server/internal/application/media"
 	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	popularityApp "github.com/francknouama/movies-mcp-server/internal/application/popularity"
+	posterApp "github.com/francknouama/movies-mcp-server/internal/application/poster"
+	"github.com/francknouama/movies-mcp-server/internal/application/retention"
+	"github.com/francknouama/movies-mcp-server/internal/application/seed"
+	similarityApp "github.com/francknouama/movies-mcp-server/internal/application/similarity"
+	tagApp "github.com/francknouama/movies-mcp-server/internal/application/tag"
+	"github.com/francknouama/movies-mcp-server/internal/application/transfer"
+	translationApp "github.com/francknouama/movies-mcp-server/internal/application/translation"
+	"github.com/francknouama/movies-mcp-server/internal/application/writequeue"
 	"github.com/francknouama/movies-mcp-server/internal/config"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/infrastructure/remote"
 	"github.com/francknouama/movies-mcp-server/internal/infrastructure/sqlite"
+	"github.com/francknouama/movies-mcp-server/internal/infrastructure/tmdb"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/i18n"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/middleware"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/recording"
 	"github.com/francknouama/movies-mcp-server/internal/mcp/resources"
 	"github.com/francknouama/movies-mcp-server/internal/mcp/tools"
+	"github.com/francknouama/movies-mcp-server/migrations"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+	"github.com/francknouama/movies-mcp-server/pkg/httpclient"
+	"github.com/francknouama/movies-mcp-server/pkg/image"
+	"github.com/francknouama/movies-mcp-server/pkg/logging"
 )
 
 var (
@@ -29,17 +63,54 @@ var (
 
 const name = "movies-mcp-server-sdk"
 
+// untimedTools copy, rewrite or fetch the whole library, so they take longer
+// than SERVER_TIMEOUT as it grows; they run without a deadline
+var untimedTools = []string{
+	"backup_database", "restore_database", "export_movies_ndjson", "import_movies_ndjson",
+	"maintain_database", "sync_from_remote", "bulk_movie_import",
+}
+
+// searchMoviesDeprecation retires search_movies, whose limit and offset
+// leave clients guessing whether another page follows
+var searchMoviesDeprecation = middleware.Deprecation{
+	Replacement: middleware.VersionedName("search_movies", 2),
+	Message:     "limit and offset pagination is replaced by cursors",
+}
+
+// directorCareerDeprecation retires director_career_analysis, whose phases
+// split the career into equal spans of years and report ratings as text
+var directorCareerDeprecation = middleware.Deprecation{
+	Replacement: middleware.VersionedName("director_career_analysis", 2),
+	Message:     "fixed phases and text ratings are replaced by rating-based eras and per-film metrics",
+}
+
 func main() {
 	var (
 		showVersion    = flag.Bool("version", false, "Show version information")
 		showHelp       = flag.Bool("help", false, "Show help information")
 		skipMigrations = flag.Bool("skip-migrations", false, "Skip database migrations")
+		autoMigrate    = flag.Bool("auto-migrate", false, "With -skip-migrations, apply pending migrations instead of refusing to start")
 		migrateOnly    = flag.Bool("migrate-only", false, "Run migrations and exit")
-		migrationsPath = flag.String("migrations", "./migrations", "Path to database migrations")
+		initOnly       = flag.Bool("init", false, "Create the database and schema, load DB_INIT_SEED into an empty library and exit")
+		migrationsPath = flag.String("migrations", "", "Directory of database migrations (defaults to the migrations built into the binary)")
+		migrationPhase = flag.String("migrations-phase", "", "Apply only the expand or the contract migrations, for rolling upgrades (default all)")
+		configPath     = flag.String("config", "", "Path to a YAML config file (environment variables override it)")
+		seedDataset    = flag.String("seed", "", "Load an embedded dataset (classics, recent, fixtures) and exit")
+		demo           = flag.Bool("demo", false, "Serve an in-memory sample library with no database; changes are lost on exit")
+		recordPath     = flag.String("record", "", "Record every message of the session to this file, for replay with cmd/replay")
+		selfTest       = flag.Bool("self-test", false, "Check the config, database, schema, a read, a write and the poster store, print a report and exit non-zero on failure")
 	)
 
 	flag.Parse()
 
+	// Exit with a non-zero status only after deferred cleanup has run
+	exitCode := 0
+	defer func() {
+		if exitCode != 0 {
+			os.Exit(exitCode)
+		}
+	}()
+
 	if *showVersion {
 		fmt.Printf("%s version %s (SDK-based)\n", name, version)
 		fmt.Printf("commit: %s\n", commit)
@@ -51,27 +122,91 @@ func main() {
 	if *showHelp {
 		fmt.Printf("Movies MCP Server (SDK Edition) - Official Golang MCP SDK Implementation\n")
 		fmt.Printf("Built with Clean Architecture and the official Model Context Protocol SDK\n\n")
-		fmt.Printf("Usage: %s [options]\n\n", os.Args[0])
+		fmt.Printf("Usage: %s [options] [command]\n\n", os.Args[0])
+		fmt.Printf("Commands:\n")
+		fmt.Printf("  validate-config    Validate configuration and print the merged effective config\n")
+		fmt.Printf("  backup <path>      Export movies, actors, franchises and posters to a checksummed archive\n")
+		fmt.Printf("  restore <path>     Replace the database contents with a backup archive\n")
+		fmt.Printf("  reindex            Rescore every pair of movies and rebuild the similar-movie index\n")
+		fmt.Printf("  sync [strategy]    Pull movies and posters from the SYNC_REMOTE_COMMAND server (prefer-local, prefer-remote or newest)\n")
+		fmt.Printf("  export-posters <dir>  Write stored posters under their CDN object keys, for POSTER_CDN_BASE_URL\n\n")
 		fmt.Printf("Options:\n")
 		flag.PrintDefaults()
 		fmt.Printf("\nThe server communicates via stdin/stdout using the MCP protocol.\n")
 		fmt.Printf("\nFeatures:\n")
 		fmt.Printf("  - Official MCP SDK integration\n")
 		fmt.Printf("  - Type-safe tool handlers with automatic schema generation\n")
-		fmt.Printf("  - 23 tools across movie/actor management, search, and analysis\n")
-		fmt.Printf("  - 3 database resources for movie data and statistics\n")
+		fmt.Printf("  - 74 tools across movie/actor/franchise/tag management, translations, media, posters, actor photos, history, events, search, preferences, and analysis\n")
+		fmt.Printf("  - 10 resources for movie data, actor photos, statistics and server diagnostics\n")
 		fmt.Printf("  - Clean Architecture with Domain-Driven Design\n")
 		fmt.Printf("  - SQLite database with automatic migrations\n")
+		fmt.Printf("  - Demo mode (-demo) over an in-memory sample library, no database needed\n")
 		os.Exit(0)
 	}
 
+	// Set up graceful shutdown
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Handle shutdown signals
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+
+	go func() {
+		<-sigChan
+		fmt.Fprintf(os.Stderr, "\nReceived shutdown signal, gracefully shutting down...\n")
+		cancel()
+	}()
+
 	// Load configuration
-	cfg, err := config.Load()
+	cfg, err := config.LoadFile(*configPath)
+	if *selfTest {
+		if !runSelfTest(ctx, cfg, err, *configPath) {
+			exitCode = 1
+		}
+		return
+	}
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
 		os.Exit(1)
 	}
 
+	if flag.Arg(0) == "validate-config" {
+		effective, err := cfg.EffectiveYAML()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to render configuration: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Fprintf(os.Stderr, "Configuration is valid\n")
+		fmt.Print(string(effective))
+		os.Exit(0)
+	}
+
+	// Serve over stdio, recording the session when asked
+	transport := mcp.Transport(&mcp.StdioTransport{})
+	if *recordPath != "" {
+		recorder, err := recording.Create(*recordPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to start recording: %v\n", err)
+			os.Exit(1)
+		}
+		defer func() {
+			if err := recorder.Close(); err != nil {
+				fmt.Fprintf(os.Stderr, "Recording incomplete: %v\n", err)
+			}
+		}()
+		transport = recorder.Transport(transport)
+		fmt.Fprintf(os.Stderr, "Recording session to %s\n", *recordPath)
+	}
+
+	if *demo {
+		if err := runDemo(ctx, cfg, transport); err != nil {
+			fmt.Fprintf(os.Stderr, "Demo mode failed: %v\n", err)
+			exitCode = 1
+		}
+		return
+	}
+
 	// Connect to database
 	db, err := connectToDatabase(&cfg.Database)
 	if err != nil {
@@ -84,13 +219,57 @@ func main() {
 		}
 	}()
 
-	// Run database migrations
-	if !*skipMigrations {
-		if err := runMigrations(*migrationsPath); err != nil {
+	// Time repository statements for movies://server/slow-queries; every
+	// request and background job inherits the log from ctx
+	var slowQueries *database.SlowQueryLog
+	if cfg.Database.SlowQueryThreshold > 0 {
+		slowQueries = database.NewSlowQueryLog(cfg.Database.SlowQueryThreshold)
+		ctx = database.WithSlowQueryLog(ctx, slowQueries)
+	}
+
+	// Run database migrations, from the binary unless a directory is given
+	migrationFS := fs.FS(migrations.FS)
+	if *migrationsPath != "" {
+		migrationFS = os.DirFS(*migrationsPath)
+	}
+	phase, err := database.ParseMigrationPhase(*migrationPhase)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid -migrations-phase: %v\n", err)
+		os.Exit(1)
+	}
+	if !*skipMigrations || *initOnly {
+		applied, err := database.MigratePhase(ctx, db, migrationFS, phase)
+		if err != nil {
 			fmt.Fprintf(os.Stderr, "Failed to run migrations: %v\n", err)
 			os.Exit(1)
 		}
-		fmt.Fprintf(os.Stderr, "Database migrations completed successfully\n")
+		fmt.Fprintf(os.Stderr, "Database migrations completed successfully (%d applied)\n", applied)
+	}
+
+	// Serve each tenant's library from its own SQLite file when configured;
+	// tenant files are migrated on first use and existing ones here
+	var tenantRouter *sqlite.TenantRouter
+	if cfg.Database.TenantDir != "" {
+		tenantRouter = sqlite.NewTenantRouter(cfg.Database.TenantDir, migrationFS, func(path string) (*sql.DB, error) {
+			tenantConfig := cfg.Database
+			tenantConfig.Name = path
+			return connectToDatabase(&tenantConfig)
+		})
+		tenantRouter.SetMigrationPhase(phase)
+		defer func() {
+			if err := tenantRouter.Close(); err != nil {
+				log.Printf("Error closing tenant databases: %v", err)
+			}
+		}()
+
+		if !*skipMigrations {
+			tenants, err := tenantRouter.MigrateExisting(ctx)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Failed to migrate tenant databases: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Fprintf(os.Stderr, "Tenant database migrations completed (%d tenants)\n", len(tenants))
+		}
 	}
 
 	// Exit if only running migrations
@@ -99,187 +278,1096 @@ func main() {
 		os.Exit(0)
 	}
 
+	// Refuse to start on a schema older than the migrations built into the
+	// binary, which the repositories' queries are written against
+	if err := checkSchema(ctx, db, migrationFS, phase, *autoMigrate); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+
 	fmt.Fprintf(os.Stderr, "Connected to SQLite database: %s\n", cfg.Database.Name)
+	if tenantRouter != nil {
+		fmt.Fprintf(os.Stderr, "Tenant libraries: %s/<tenant>.db\n", cfg.Database.TenantDir)
+	}
+
+	// Seed the database and exit if requested
+	if *seedDataset != "" {
+		seeder := seed.NewSeeder(movieApp.NewService(newMovieRepository(db, sqlite.NewHistoryRepository(db))))
+		result, err := seeder.Seed(ctx, *seedDataset)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to seed database: %v\n", err)
+			exitCode = 1
+			return
+		}
+		for _, seedErr := range result.Errors {
+			fmt.Fprintf(os.Stderr, "  ! %s\n", seedErr)
+		}
+		fmt.Fprintf(os.Stderr, "Seeded dataset %s: %d inserted, %d already present\n",
+			result.Dataset, result.Inserted, result.Skipped)
+		if len(result.Errors) > 0 {
+			exitCode = 1
+		}
+		return
+	}
+
+	// Load the first-boot dataset into an empty library, then exit for -init;
+	// backup and restore see the database as it is
+	if cfg.Database.InitSeed != "" && flag.NArg() == 0 {
+		if err := seedEmptyLibrary(ctx, db, cfg.Database.InitSeed); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to seed database: %v\n", err)
+			exitCode = 1
+			return
+		}
+	}
+	if *initOnly {
+		fmt.Fprintf(os.Stderr, "Database %s initialized, exiting as requested\n", cfg.Database.Name)
+		return
+	}
+
+	// Run backup/restore commands and exit
+	if command := flag.Arg(0); command == "backup" || command == "restore" {
+		if flag.NArg() != 2 {
+			fmt.Fprintf(os.Stderr, "Usage: %s %s <path>\n", os.Args[0], command)
+			exitCode = 2
+			return
+		}
+		if err := runBackupCommand(ctx, database.NewBackupManager(db), command, flag.Arg(1)); err != nil {
+			fmt.Fprintf(os.Stderr, "%s failed: %v\n", command, err)
+			exitCode = 1
+		}
+		return
+	}
+
+	// Rebuild the similar-movie index and exit
+	if flag.Arg(0) == "reindex" {
+		service := similarityApp.NewService(sqlite.NewSimilarityRepository(db), sqlite.NewMovieRepository(db))
+		result, err := service.Rebuild(ctx)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "reindex failed: %v\n", err)
+			exitCode = 1
+			return
+		}
+		fmt.Fprintf(os.Stderr, "Rebuilt similarity index: %d movies, %d similar pairs\n", result.Movies, result.Pairs)
+		return
+	}
+
 	fmt.Fprintf(os.Stderr, "Starting Movies MCP Server with Official SDK...\n")
 
-	// Initialize SQLite repositories
-	movieRepo := sqlite.NewMovieRepository(db)
+	// Initialize SQLite repositories; movie writes refresh the similarity
+	// index and are recorded in the history
+	historyRepo := sqlite.NewHistoryRepository(db)
+	similarityRepo := sqlite.NewSimilarityRepository(db)
+	movieRepo := newMovieRepository(db, historyRepo)
 	actorRepo := sqlite.NewActorRepository(db)
+	availabilityRepo := sqlite.NewAvailabilityRepository(db)
+	franchiseRepo := sqlite.NewFranchiseRepository(db)
+	tagRepo := sqlite.NewTagRepository(db)
+	translationRepo := sqlite.NewTranslationRepository(db)
+	mediaRepo := sqlite.NewMediaRepository(db)
+	posterStore := sqlite.NewPosterStore(db)
+	photoStore := sqlite.NewActorPhotoStore(db)
 
 	// Initialize services
 	movieService := movieApp.NewService(movieRepo)
+	validationPolicy, err := shared.ParseValidationPolicy(cfg.Server.ValidationPolicy)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid validation policy: %v\n", err)
+		exitCode = 1
+		return
+	}
+	movieService.SetValidationPolicy(validationPolicy)
+	catalog, err := newCatalog(cfg.Server.Locale)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid server locale: %v\n", err)
+		exitCode = 1
+		return
+	}
+	verbosity, err := middleware.ParseVerbosity(cfg.Server.Verbosity)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid response verbosity: %v\n", err)
+		exitCode = 1
+		return
+	}
+	movieService.SetScorer(movieApp.NewPopularityScorer(sqlite.NewStatsRepository(db)))
+	movieService.SetRankingWeights(cfg.Server.SearchRankingWeights)
 	actorService := actorApp.NewService(actorRepo)
+	availabilityService := availabilityApp.NewService(availabilityRepo, movieRepo)
+	franchiseService := franchiseApp.NewService(franchiseRepo, movieRepo)
+	tagService := tagApp.NewService(tagRepo, movieRepo)
+	translationService := translationApp.NewService(translationRepo, movieRepo)
+	mediaService := mediaApp.NewService(mediaRepo, movieRepo)
+	imageConfig := &image.ImageConfig{
+		MaxSize:       cfg.Image.MaxSize,
+		AllowedTypes:  cfg.Image.AllowedTypes,
+		OutputFormat:  cfg.Image.OutputFormat,
+		Quality:       cfg.Image.Quality,
+		StripMetadata: cfg.Image.StripMetadata,
+		MaxWidth:      cfg.Image.MaxWidth,
+		MaxHeight:     cfg.Image.MaxHeight,
+	}
+	posterService := posterApp.NewService(posterStore, movieRepo, imageConfig)
+	photoService := posterApp.NewPhotoService(photoStore, actorRepo, imageConfig)
+	syncService := librarysync.NewService(movieService, posterService, func(ctx context.Context) (librarysync.Remote, error) {
+		library, err := remote.Dial(ctx, cfg.Sync.RemoteCommand, version)
+		if err != nil {
+			return nil, err
+		}
+		return library, nil
+	})
+
+	// Pull the remote library and exit
+	if flag.Arg(0) == "sync" {
+		if err := runSyncCommand(ctx, syncService, flag.Arg(1)); err != nil {
+			fmt.Fprintf(os.Stderr, "sync failed: %v\n", err)
+			exitCode = 1
+		}
+		return
+	}
+
+	// Write stored posters under the keys their CDN URLs name and exit
+	if flag.Arg(0) == "export-posters" {
+		if flag.NArg() != 2 {
+			fmt.Fprintf(os.Stderr, "Usage: %s export-posters <dir>\n", os.Args[0])
+			exitCode = 2
+			return
+		}
+		result, err := posterService.PublishPosters(ctx, flag.Arg(1))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "export-posters failed: %v\n", err)
+			exitCode = 1
+			return
+		}
+		fmt.Fprintf(os.Stderr, "Exported posters to %s: %d written, %d already there\n", flag.Arg(1), result.Written, result.Unchanged)
+		return
+	}
+
+	historyService := historyApp.NewService(historyRepo, movieRepo)
+	similarityService := similarityApp.NewService(similarityRepo, movieRepo)
+	// Movies tools return are counted by day; searches can rank by the counts
+	accessRepo := sqlite.NewAccessRepository(db)
+	popularityService := popularityApp.NewService(accessRepo, movieRepo)
+	movieService.SetScorer(popularityApp.NewTrendingScorer(popularityService))
+	if cfg.TMDB.Enabled() {
+		outbound := httpclient.New(&http.Client{Timeout: 10 * time.Second}, httpclient.Config{
+			MaxRetries:       cfg.HTTP.MaxRetries,
+			InitialBackoff:   cfg.HTTP.RetryBackoff,
+			MaxBackoff:       cfg.HTTP.MaxRetryBackoff,
+			FailureThreshold: cfg.HTTP.FailureThreshold,
+			OpenDuration:     cfg.HTTP.OpenDuration,
+		})
+		tmdbClient := tmdb.NewClient(cfg.TMDB.APIKey, outbound)
+		tmdbClient.BaseURL = cfg.TMDB.BaseURL
+		availabilityService.SetSource(tmdbClient)
+	}
+
+	// Build the similarity index for databases that predate it or were
+	// restored from a backup; later writes keep it up to date
+	if result, err := similarityService.EnsureIndexed(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "Similarity index not built, similar movies stay empty until reindex: %v\n", err)
+	} else if result != nil {
+		fmt.Fprintf(os.Stderr, "Built similarity index: %d movies, %d similar pairs\n", result.Movies, result.Pairs)
+	}
 
 	// Initialize SDK-based tool handlers
 	movieTools := tools.NewMovieTools(movieService)
 	actorTools := tools.NewActorTools(actorService)
+	actorTools.SetMovieReader(movieService)
 	compoundTools := tools.NewCompoundTools(movieService)
+	searchAllTools := tools.NewSearchAllTools(movieService, actorService)
 	contextTools := tools.NewContextTools(movieService)
+	seedTools := tools.NewSeedTools(movieService)
+	// Tool calls only read and write archives and NDJSON files, with their
+	// checkpoints, inside the backup directory
+	archiveDir := tools.NewArchiveDir(cfg.Database.BackupDir)
+	backupTools := tools.NewBackupTools(database.NewBackupManager(db))
+	backupTools.SetArchiveDir(archiveDir)
+	transferService := transfer.NewService(movieService)
+	transferService.SetPathResolver(archiveDir.Resolve)
+	transferTools := tools.NewTransferTools(transferService)
+	batchTools := tools.NewBatchTools(movieService, actorService, cfg.Server.MaxBatchSize)
+	bulkUpdateTools := tools.NewBulkUpdateTools(movieService)
+	timelineTools := tools.NewTimelineTools(movieService)
+	runtimeTools := tools.NewRuntimeTools(movieService)
+	availabilityTools := tools.NewAvailabilityTools(availabilityService)
+	franchiseTools := tools.NewFranchiseTools(franchiseService)
+	tagTools := tools.NewTagTools(tagService)
+	translationTools := tools.NewTranslationTools(translationService)
+	summaryTools := tools.NewSummaryTools(movieService, translationService)
+	summaryTools.SetCastReader(actorService)
+	compoundTools.SetCastReader(actorService)
+	mediaTools := tools.NewMediaTools(mediaService)
+	posterTools := tools.NewPosterTools(posterService)
+	photoTools := tools.NewPhotoTools(photoService)
+	historyTools := tools.NewHistoryTools(historyService)
+	similarTools := tools.NewSimilarTools(similarityService)
+	trendingTools := tools.NewTrendingTools(popularityService)
+	compoundTools.SetSimilarMovies(similarityService)
+	backupTools.SetSimilarityRebuilder(similarityService)
+	movieTools.SetLocalizer(translationService)
+	movieTools.SetTrailerFinder(mediaService)
+	movieTools.SetInteractive(cfg.Server.InteractiveTools)
+	movieTools.SetAccessRecorder(popularityService)
+
+	// In poster CDN mode, stored posters are returned as URLs on the CDN
+	// they are published to rather than as image data
+	var posterSigner *posterApp.CDNSigner
+	if cfg.Image.CDNBaseURL != "" {
+		posterSigner = posterApp.NewCDNSigner(cfg.Image.CDNBaseURL, cfg.Image.CDNSigningKey, cfg.Image.CDNURLExpiry)
+		movieTools.SetPosterCDN(posterService, posterSigner)
+		posterTools.SetCDNSigner(posterSigner)
+	}
+
+	// Preferences a session sets become defaults for search and recommendations
+	preferenceStore := tools.NewPreferenceStore()
+	preferenceTools := tools.NewPreferenceTools(preferenceStore)
+	movieTools.SetPreferenceStore(preferenceStore)
+	compoundTools.SetPreferenceStore(preferenceStore)
+	tasteTools := tools.NewTasteTools(movieService)
+	tasteTools.SetPreferenceStore(preferenceStore)
+
+	// Initialize the optional write queue; strong-consistency reads wait on it
+	var writeQueue *writequeue.Queue
+	if cfg.WriteQueue.Enabled {
+		writeQueue = writequeue.New(writequeue.Config{
+			Capacity:      cfg.WriteQueue.Capacity,
+			BatchSize:     cfg.WriteQueue.BatchSize,
+			FlushInterval: cfg.WriteQueue.FlushInterval,
+		})
+		writeQueue.Start(context.Background())
+		defer writeQueue.Close()
+
+		movieTools.SetWriteBarrier(writeQueue)
+		contextTools.SetWriteBarrier(writeQueue)
+	}
+
+	// Record data change events from mutating tools and deliver them to
+	// any configured webhooks
+	eventBus := events.NewBus(cfg.Events.HistorySize)
+	if len(cfg.Events.WebhookURLs) > 0 {
+		webhookClient := httpclient.New(&http.Client{Timeout: 10 * time.Second}, httpclient.Config{
+			MaxRetries:       -1, // Webhooks redeliver from their own queue instead
+			FailureThreshold: cfg.HTTP.FailureThreshold,
+			OpenDuration:     cfg.HTTP.OpenDuration,
+		})
+		webhooks := events.NewWebhooks(eventBus, webhookClient, events.WebhookConfig{
+			URLs:         cfg.Events.WebhookURLs,
+			Secret:       cfg.Events.WebhookSecret,
+			MaxAttempts:  cfg.Events.WebhookMaxAttempts,
+			RetryBackoff: cfg.Events.WebhookBackoff,
+			QueueSize:    cfg.Events.WebhookQueueSize,
+		})
+		eventBus.Subscribe(webhooks)
+		webhooks.Start(context.Background())
+		defer webhooks.Close()
+	}
+	// Movies and actors publish the events their aggregates record after
+	// each save, whichever tool or queued write made the change
+	domainPublisher := events.NewDomainPublisher(eventBus)
+	movieService.SetEventPublisher(domainPublisher)
+	actorService.SetEventPublisher(domainPublisher)
+	eventTools := tools.NewEventTools(eventBus)
+
+	// Supervise database connectivity so outages degrade rather than crash the server
+	dbHealth := database.NewHealthMonitor(db, database.HealthConfig{
+		Interval:     cfg.Database.HealthCheckInterval,
+		Timeout:      cfg.Database.HealthCheckTimeout,
+		MaxIdleConns: cfg.Database.MaxIdleConns,
+	})
+	dbHealth.Start(ctx)
+	defer dbHealth.Stop()
+
+	// Purge history entries and access counters past their retention
+	// period, in the default database and every open tenant's
+	retentionJob := retention.NewJob(cfg.Retention.Interval,
+		retention.Policy{Name: "movie_history", MaxDays: cfg.Retention.HistoryDays, Purger: historyRepo},
+		retention.Policy{Name: "movie_access", MaxDays: cfg.Retention.AccessDays, Purger: accessRepo},
+	)
+	if tenantRouter != nil {
+		retentionJob.SetTenants(tenantRouter)
+	}
+	retentionJob.Start(ctx)
+	defer retentionJob.Stop()
+
+	// Vacuum, analyze and check the default database and every open
+	// tenant's on a schedule, if one is configured; maintain_database runs
+	// the same on request for the caller's library
+	maintainer := database.NewMaintainer(db)
+	if tenantRouter != nil {
+		maintainer.SetTenants(tenantRouter)
+	}
+	maintainer.Start(ctx, cfg.Database.MaintenanceInterval)
+	defer maintainer.Stop()
+	maintenanceTools := tools.NewMaintenanceTools(maintainer)
 
 	// Initialize resource handlers
 	dbResources := resources.NewDatabaseResources(movieService)
+	dbResources.SetMaxPageSize(cfg.Server.MaxPageSize)
+	dbResources.SetStatsReader(sqlite.NewStatsRepository(db))
+	dbResources.SetTagCounts(tagRepo)
+	photoResources := resources.NewActorPhotoResources(photoService)
+	posterResources := resources.NewMoviePosterResources(posterService)
+	if posterSigner != nil {
+		posterResources.SetCDNSigner(posterSigner)
+	}
+	healthResources := resources.NewHealthResources(dbHealth, database.NewMigrationChecker(db, migrationFS))
+	retentionResources := resources.NewRetentionResources(retentionJob)
+	if tenantRouter != nil {
+		healthResources.SetTenants(tenantRouter)
+	}
 
-	// Create MCP server with SDK
+	// Create MCP server with SDK; clients may subscribe to the movie
+	// resources and are notified when movie writes change them
+	changeNotifier := resources.NewChangeNotifier()
 	server := mcp.NewServer(
 		&mcp.Implementation{
 			Name:    name,
 			Version: version,
 		},
-		nil, // Options
+		&mcp.ServerOptions{
+			SubscribeHandler:   changeNotifier.HandleSubscribe,
+			UnsubscribeHandler: changeNotifier.HandleUnsubscribe,
+		},
 	)
+	changeNotifier.Attach(server)
+	eventBus.Subscribe(changeNotifier)
+
+	logger := logging.NewLogger()
+	if level, err := logrus.ParseLevel(cfg.Server.LogLevel); err == nil {
+		logger.SetLevel(level)
+	}
+	slowQueryResources := resources.NewSlowQueryResources(nil)
+	if slowQueries != nil {
+		slowQueries.OnRecord(func(query database.SlowQuery) {
+			logger.WithFields(logrus.Fields{
+				"query":       query.Query,
+				"duration_ms": float64(query.Duration.Microseconds()) / 1000,
+				"tenant":      query.Tenant,
+			}).Warn("Slow query")
+		})
+		slowQueryResources = resources.NewSlowQueryResources(slowQueries)
+	}
+
+	// Track in-flight tool calls so shutdown can drain them; the per-call
+	// timeout sits inside the tracker so detached calls keep their deadline,
+	// and spares the untimed tools.
+	// Panics in any handler become internal errors instead of crashing the server.
+	// Oversized arguments are rejected before any work is done. Tool calls
+	// get the verbosity they name or the server's. Resource reads carry an
+	// ETag and come back empty when it matches the client's. With
+	// multi-tenancy, calls and reads naming a tenant use its library.
+	inFlight := middleware.NewInFlightTracker(ctx)
+	receiving := []mcp.Middleware{
+		middleware.RecoverPanics(logger),
+		middleware.LimitRequestSize(cfg.Server.MaxRequestBytes),
+		middleware.RequireDatabase(dbHealth),
+		inFlight.Middleware(),
+		middleware.Timeout(cfg.Server.Timeout, untimedTools...),
+		middleware.Verbosities(verbosity),
+		middleware.ResourceETags(),
+	}
+	if tenantRouter != nil {
+		receiving = append(receiving, middleware.Tenants(tenantRouter))
+	}
+	server.AddReceivingMiddleware(receiving...)
+
+	// Wrap every tool handler: log each call, time it for the health
+	// resource, keep it for the request log, report domain errors with their
+	// JSON-RPC codes, write messages in the session's language, recover
+	// panics, truncate oversized list results, publish events for successful
+	// changes and run input Validate methods
+	toolTimings := middleware.NewToolTimings()
+	healthResources.SetToolTimings(toolTimings)
+	toolMiddleware := []middleware.ToolMiddleware{
+		middleware.Logging(logger, middleware.LogPolicy{
+			RedactFields:    cfg.Server.LogRedactFields,
+			MaxPayloadBytes: cfg.Server.LogMaxPayloadBytes,
+		}),
+		toolTimings.Middleware(),
+	}
+	requestLogResources := resources.NewRequestLogResources(nil)
+	if cfg.Server.RequestLogSize > 0 {
+		requestLog := middleware.NewRequestLog(cfg.Server.RequestLogSize)
+		requestLogResources = resources.NewRequestLogResources(requestLog)
+		toolMiddleware = append(toolMiddleware, requestLog.Middleware())
+	}
+	toolRegistrar := middleware.NewToolRegistrar(server, append(toolMiddleware,
+		middleware.MapErrors(),
+		middleware.Localize(catalog, sessionLocales(preferenceStore, cfg.Server.Locale)),
+		middleware.Recover(logger),
+		middleware.LimitResponses(middleware.ResponseLimits{
+			MaxBytes: cfg.Server.MaxResponseBytes,
+			PerTool:  cfg.Server.ToolResponseBytes,
+		}),
+		middleware.PublishEvents(eventBus, events.ToolEventTypes),
+		middleware.Validate(),
+	)...)
 
 	fmt.Fprintf(os.Stderr, "Registering tools with SDK...\n")
 
-	// Register Movie Tools (8 tools)
-	mcp.AddTool(server, &mcp.Tool{
-		Name:        "get_movie",
-		Description: "Get a movie by ID",
+	// Register Movie Tools (10 tools)
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "get_movie",
+		Description:  "Get a movie by ID with its primary trailer URL, optionally with its title and description in a preferred language",
+		OutputSchema: tools.SelectableOutputSchema[tools.GetMovieOutput](),
 	}, movieTools.GetMovie)
 
-	mcp.AddTool(server, &mcp.Tool{
-		Name:        "add_movie",
-		Description: "Add a new movie to the database",
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "add_movie",
+		Description:  "Add a new movie to the database",
+		OutputSchema: tools.OutputSchema[tools.AddMovieOutput](),
 	}, movieTools.AddMovie)
 
-	mcp.AddTool(server, &mcp.Tool{
-		Name:        "update_movie",
-		Description: "Update an existing movie",
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "update_movie",
+		Description:  "Update the given fields of an existing movie, keeping the rest",
+		OutputSchema: tools.OutputSchema[tools.UpdateMovieOutput](),
 	}, movieTools.UpdateMovie)
 
-	mcp.AddTool(server, &mcp.Tool{
-		Name:        "delete_movie",
-		Description: "Delete a movie by ID",
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "add_or_update_movie",
+		Description:  "Create a movie, or update the given fields of the one with the same title and year if it exists; reports which it did",
+		OutputSchema: tools.OutputSchema[tools.AddOrUpdateMovieOutput](),
+	}, movieTools.AddOrUpdateMovie)
+
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "delete_movie",
+		Description:  "Delete a movie by ID, or by exact title; asks which one when a title matches several",
+		OutputSchema: tools.OutputSchema[tools.DeleteMovieOutput](),
 	}, movieTools.DeleteMovie)
 
-	mcp.AddTool(server, &mcp.Tool{
-		Name:        "list_top_movies",
-		Description: "Get top-rated movies",
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "list_top_movies",
+		Description:  "Get top-rated movies",
+		OutputSchema: tools.OutputSchema[tools.ListTopMoviesOutput](),
 	}, movieTools.ListTopMovies)
 
-	mcp.AddTool(server, &mcp.Tool{
-		Name:        "search_movies",
-		Description: "Search for movies with various filters",
-	}, movieTools.SearchMovies)
+	middleware.AddTool(toolRegistrar, middleware.Deprecated(&mcp.Tool{
+		Name:         "search_movies",
+		Description:  "Search for movies with various filters, optionally localizing titles and descriptions",
+		OutputSchema: tools.SelectableOutputSchema[tools.SearchMoviesOutput](),
+	}, searchMoviesDeprecation), movieTools.SearchMovies)
 
-	mcp.AddTool(server, &mcp.Tool{
-		Name:        "search_by_decade",
-		Description: "Search movies by decade (e.g., 1990s, 90s)",
+	middleware.AddTool(toolRegistrar, middleware.Versioned(&mcp.Tool{
+		Name:         "search_movies",
+		Description:  "Search for movies with various filters, a page at a time, optionally localizing titles and descriptions",
+		OutputSchema: tools.SelectableOutputSchema[tools.SearchMoviesV2Output](),
+	}, 2), movieTools.SearchMoviesV2)
+
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "search_by_decade",
+		Description:  "Search movies by decade (e.g., 1990s, 90s, the nineties, 1990-1999)",
+		OutputSchema: tools.SelectableOutputSchema[tools.SearchMoviesOutput](),
 	}, movieTools.SearchByDecade)
 
-	mcp.AddTool(server, &mcp.Tool{
-		Name:        "search_by_rating_range",
-		Description: "Search movies by rating range",
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "search_by_rating_range",
+		Description:  "Search movies by rating range",
+		OutputSchema: tools.SelectableOutputSchema[tools.SearchMoviesOutput](),
 	}, movieTools.SearchByRatingRange)
 
-	// Register Actor Tools (9 tools)
-	mcp.AddTool(server, &mcp.Tool{
-		Name:        "get_actor",
-		Description: "Get an actor by ID",
+	// Register Actor Tools (11 tools)
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "get_actor",
+		Description:  "Get an actor by ID",
+		OutputSchema: tools.OutputSchema[tools.ActorOutput](),
 	}, actorTools.GetActor)
 
-	mcp.AddTool(server, &mcp.Tool{
-		Name:        "add_actor",
-		Description: "Add a new actor to the database",
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "add_actor",
+		Description:  "Add a new actor to the database",
+		OutputSchema: tools.OutputSchema[tools.ActorOutput](),
 	}, actorTools.AddActor)
 
-	mcp.AddTool(server, &mcp.Tool{
-		Name:        "update_actor",
-		Description: "Update an existing actor",
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "update_actor",
+		Description:  "Update an existing actor",
+		OutputSchema: tools.OutputSchema[tools.ActorOutput](),
 	}, actorTools.UpdateActor)
 
-	mcp.AddTool(server, &mcp.Tool{
-		Name:        "delete_actor",
-		Description: "Delete an actor by ID",
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "delete_actor",
+		Description:  "Delete an actor by ID",
+		OutputSchema: tools.OutputSchema[tools.DeleteActorOutput](),
 	}, actorTools.DeleteActor)
 
-	mcp.AddTool(server, &mcp.Tool{
-		Name:        "link_actor_to_movie",
-		Description: "Link an actor to a movie",
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "link_actor_to_movie",
+		Description:  "Link an actor to a movie, optionally with the character played, billing order and role type (lead/supporting/cameo)",
+		OutputSchema: tools.OutputSchema[tools.LinkActorToMovieOutput](),
 	}, actorTools.LinkActorToMovie)
 
-	mcp.AddTool(server, &mcp.Tool{
-		Name:        "unlink_actor_from_movie",
-		Description: "Unlink an actor from a movie",
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "unlink_actor_from_movie",
+		Description:  "Unlink an actor from a movie",
+		OutputSchema: tools.OutputSchema[tools.UnlinkActorFromMovieOutput](),
 	}, actorTools.UnlinkActorFromMovie)
 
-	mcp.AddTool(server, &mcp.Tool{
-		Name:        "get_movie_cast",
-		Description: "Get all actors in a movie",
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "get_movie_cast",
+		Description:  "Get all actors in a movie with their characters, in billing order",
+		OutputSchema: tools.OutputSchema[tools.GetMovieCastOutput](),
 	}, actorTools.GetMovieCast)
 
-	mcp.AddTool(server, &mcp.Tool{
-		Name:        "get_actor_movies",
-		Description: "Get all movies for an actor",
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "get_actor_movies",
+		Description:  "Get all movies for an actor",
+		OutputSchema: tools.OutputSchema[tools.GetActorMoviesOutput](),
 	}, actorTools.GetActorMovies)
 
-	mcp.AddTool(server, &mcp.Tool{
-		Name:        "search_actors",
-		Description: "Search for actors with various filters",
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "search_actors",
+		Description:  "Search for actors with various filters",
+		OutputSchema: tools.OutputSchema[tools.SearchActorsOutput](),
 	}, actorTools.SearchActors)
 
-	// Register Compound Tools (3 tools)
-	mcp.AddTool(server, &mcp.Tool{
-		Name:        "bulk_movie_import",
-		Description: "Import multiple movies at once",
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "search_by_character",
+		Description:  "Find which actors played a character, e.g. Wolverine or James Bond",
+		OutputSchema: tools.OutputSchema[tools.SearchByCharacterOutput](),
+	}, actorTools.SearchByCharacter)
+
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "analyze_performance_ages",
+		Description:  "Find the youngest and oldest performances by actors' age at release, from birth years and release years, counting performances whose age is unknown by reason",
+		OutputSchema: tools.OutputSchema[tools.AnalyzePerformanceAgesOutput](),
+	}, actorTools.AnalyzePerformanceAges)
+
+	// Register Compound Tools (5 tools)
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "bulk_movie_import",
+		Description:  "Import multiple movies at once; more than 100 are inserted in one batch, where the valid movies are saved together or not at all",
+		OutputSchema: tools.OutputSchema[tools.BulkMovieImportOutput](),
 	}, compoundTools.BulkMovieImport)
 
-	mcp.AddTool(server, &mcp.Tool{
-		Name:        "movie_recommendation_engine",
-		Description: "Get personalized movie recommendations based on preferences",
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "movie_recommendation_engine",
+		Description:  "Get personalized movie recommendations based on preferences",
+		OutputSchema: tools.OutputSchema[tools.MovieRecommendationOutput](),
 	}, compoundTools.MovieRecommendationEngine)
 
-	mcp.AddTool(server, &mcp.Tool{
-		Name:        "director_career_analysis",
-		Description: "Analyze a director's career trajectory and filmography",
-	}, compoundTools.DirectorCareerAnalysis)
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "pick_movie_for_me",
+		Description:  "Pick what to watch: a ranked shortlist, with justifications, of movies fitting a mood or genres, a maximum runtime, a decade and a minimum rating, leaving out movies already seen and the session's disliked directors",
+		OutputSchema: tools.OutputSchema[tools.PickMovieOutput](),
+	}, compoundTools.PickMovieForMe)
+
+	middleware.AddTool(toolRegistrar, middleware.Deprecated(&mcp.Tool{
+		Name:         "director_career_analysis",
+		Description:  "Analyze a director's career trajectory and filmography",
+		OutputSchema: tools.OutputSchema[tools.DirectorCareerAnalysisOutput](),
+	}, directorCareerDeprecation), compoundTools.DirectorCareerAnalysis)
+
+	middleware.AddTool(toolRegistrar, middleware.Versioned(&mcp.Tool{
+		Name:         "director_career_analysis",
+		Description:  "Analyze a director's career: early, peak and late eras from ratings over time, genre evolution, frequent collaborators from cast data and per-film metrics, with a narrative summary",
+		OutputSchema: tools.OutputSchema[tools.DirectorCareerAnalysisV2Output](),
+	}, 2), compoundTools.DirectorCareerAnalysisV2)
+
+	// Register Universal Search Tools (1 tool)
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "search_all",
+		Description:  "Search movies, actors and directors in one call; results are grouped by type with per-group counts, most relevant first",
+		OutputSchema: tools.OutputSchema[tools.SearchAllOutput](),
+	}, searchAllTools.SearchAll)
 
 	// Register Context Management Tools (3 tools)
-	mcp.AddTool(server, &mcp.Tool{
-		Name:        "create_search_context",
-		Description: "Create a paginated context for large search results",
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "create_search_context",
+		Description:  "Create a paginated context for large search results",
+		OutputSchema: tools.OutputSchema[tools.CreateSearchContextOutput](),
 	}, contextTools.CreateSearchContext)
 
-	mcp.AddTool(server, &mcp.Tool{
-		Name:        "get_context_page",
-		Description: "Get a specific page from a search context",
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "get_context_page",
+		Description:  "Get a specific page from a search context",
+		OutputSchema: tools.OutputSchema[tools.GetContextPageOutput](),
 	}, contextTools.GetContextPage)
 
-	mcp.AddTool(server, &mcp.Tool{
-		Name:        "get_context_info",
-		Description: "Get metadata about a search context",
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "get_context_info",
+		Description:  "Get metadata about a search context",
+		OutputSchema: tools.OutputSchema[tools.GetContextInfoOutput](),
 	}, contextTools.GetContextInfo)
 
-	fmt.Fprintf(os.Stderr, "✓ Registered 23 tools successfully\n")
-	fmt.Fprintf(os.Stderr, "  - Movie tools: 8\n")
-	fmt.Fprintf(os.Stderr, "  - Actor tools: 9\n")
-	fmt.Fprintf(os.Stderr, "  - Compound tools: 3\n")
+	// Register Seed Tools (1 tool)
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "seed_database",
+		Description:  "Load a curated embedded dataset (classics, recent, fixtures); movies already present are skipped",
+		OutputSchema: tools.OutputSchema[tools.SeedDatabaseOutput](),
+	}, seedTools.SeedDatabase)
+
+	// Register Preference Tools (2 tools)
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "set_preferences",
+		Description:  "Remember this session's favorite genres, disliked directors and language as defaults for search_movies and movie_recommendation_engine",
+		OutputSchema: tools.OutputSchema[tools.PreferencesOutput](),
+	}, preferenceTools.SetPreferences)
+
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "get_preferences",
+		Description:  "Get the preferences this session has set",
+		OutputSchema: tools.OutputSchema[tools.PreferencesOutput](),
+	}, preferenceTools.GetPreferences)
+
+	// Register Taste Tools (1 tool)
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "taste_profile",
+		Description:  "Compare the user's own ratings with the stored ones to find the genres and directors they over- or under-rate; apply makes the over-rated genres favorites for recommendations",
+		OutputSchema: tools.OutputSchema[tools.TasteProfileOutput](),
+	}, tasteTools.TasteProfile)
+
+	// Register Backup Tools (2 tools)
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "backup_database",
+		Description:  "Export all movies, actors, cast links, availability, franchises, tags, translations, media links, movie history and posters to a checksummed archive on the server",
+		OutputSchema: tools.OutputSchema[tools.BackupOutput](),
+	}, backupTools.BackupDatabase)
+
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "restore_database",
+		Description:  "Verify a backup archive and replace the database contents with it",
+		OutputSchema: tools.OutputSchema[tools.BackupOutput](),
+	}, backupTools.RestoreDatabase)
+
+	// Register Transfer Tools (2 tools)
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "export_movies_ndjson",
+		Description:  "Export every movie to an NDJSON file on the server in checkpointed batches with SHA-256 checksums; resume continues an interrupted export",
+		OutputSchema: tools.OutputSchema[tools.TransferOutput](),
+	}, transferTools.ExportMoviesNDJSON)
+
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "import_movies_ndjson",
+		Description:  "Create movies from an NDJSON file on the server in checkpointed batches, verifying each batch against its export checksum; resume continues an interrupted import",
+		OutputSchema: tools.OutputSchema[tools.TransferOutput](),
+	}, transferTools.ImportMoviesNDJSON)
+
+	// Register Maintenance Tools (1 tool)
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "maintain_database",
+		Description:  "Check the integrity of the database, refresh its query statistics and vacuum it, reporting the space reclaimed and the issues found",
+		OutputSchema: tools.OutputSchema[tools.MaintainDatabaseOutput](),
+	}, maintenanceTools.MaintainDatabase)
+
+	// Register Batch Tools (2 tools)
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "get_movies_by_ids",
+		Description:  fmt.Sprintf("Get up to %d movies by ID in one call; unknown IDs are listed as missing", batchTools.MaxBatchSize()),
+		OutputSchema: tools.SelectableOutputSchema[tools.GetMoviesByIDsOutput](),
+	}, batchTools.GetMoviesByIDs)
+
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "get_actors_by_ids",
+		Description:  fmt.Sprintf("Get up to %d actors by ID in one call; unknown IDs are listed as missing", batchTools.MaxBatchSize()),
+		OutputSchema: tools.OutputSchema[tools.GetActorsByIDsOutput](),
+	}, batchTools.GetActorsByIDs)
+
+	// Register Bulk Update Tools (1 tool)
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "bulk_update_movies",
+		Description:  "Apply a partial update (e.g. add a genre, fix a director) to every movie matching a filter; without a confirmation_token it is a dry run returning the affected count, sample before/after rows and the token to commit with",
+		OutputSchema: tools.OutputSchema[tools.BulkUpdateMoviesOutput](),
+	}, bulkUpdateTools.BulkUpdateMovies)
+
+	// Register Timeline Tools (1 tool)
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "get_release_timeline",
+		Description:  "Show what was released when: movies grouped by release year with counts, average ratings and the top-rated picks of each year (e.g. year 1999 for \"what came out in 1999?\")",
+		OutputSchema: tools.OutputSchema[tools.GetReleaseTimelineOutput](),
+	}, timelineTools.GetReleaseTimeline)
+
+	// Register Runtime Tools (1 tool)
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "get_runtime_by_genre",
+		Description:  "Compare movie runtimes by genre: average, shortest and longest runtime and short film count for each genre, longest average first; movies with no known runtime are counted separately",
+		OutputSchema: tools.OutputSchema[tools.GetRuntimeByGenreOutput](),
+	}, runtimeTools.GetRuntimeByGenre)
+
+	// Register Availability Tools (2 tools)
+	updateAvailabilityDescription := "Replace where a movie can be watched in a region (provider, offer type, URL)"
+	if availabilityService.HasSource() {
+		updateAvailabilityDescription += "; set fetch_tmdb to pull current watch providers from TMDB"
+	}
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "update_availability",
+		Description:  updateAvailabilityDescription,
+		OutputSchema: tools.OutputSchema[tools.AvailabilityOutput](),
+	}, availabilityTools.UpdateAvailability)
+
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "where_to_watch",
+		Description:  "List the streaming, rental and purchase options recorded for a movie, optionally in one region",
+		OutputSchema: tools.OutputSchema[tools.AvailabilityOutput](),
+	}, availabilityTools.WhereToWatch)
+
+	// Register Franchise Tools (7 tools)
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "create_franchise",
+		Description:  "Create a franchise or series (e.g. The Lord of the Rings), optionally with movies in story order",
+		OutputSchema: tools.OutputSchema[tools.FranchiseOutput](),
+	}, franchiseTools.CreateFranchise)
+
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "update_franchise",
+		Description:  "Rename a franchise or change its description",
+		OutputSchema: tools.OutputSchema[tools.FranchiseOutput](),
+	}, franchiseTools.UpdateFranchise)
+
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "delete_franchise",
+		Description:  "Delete a franchise; its movies are kept",
+		OutputSchema: tools.OutputSchema[tools.DeleteFranchiseOutput](),
+	}, franchiseTools.DeleteFranchise)
+
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "list_franchises",
+		Description:  "List franchises, optionally only those containing a movie",
+		OutputSchema: tools.OutputSchema[tools.ListFranchisesOutput](),
+	}, franchiseTools.ListFranchises)
+
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "add_movie_to_franchise",
+		Description:  "Add a movie to a franchise at a story-order position, or move it if already there",
+		OutputSchema: tools.OutputSchema[tools.FranchiseOutput](),
+	}, franchiseTools.AddMovieToFranchise)
+
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "remove_movie_from_franchise",
+		Description:  "Remove a movie from a franchise",
+		OutputSchema: tools.OutputSchema[tools.FranchiseOutput](),
+	}, franchiseTools.RemoveMovieFromFranchise)
+
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "get_franchise_timeline",
+		Description:  "Get a franchise's movies in chronological (story) order and in release order",
+		OutputSchema: tools.OutputSchema[tools.GetFranchiseTimelineOutput](),
+	}, franchiseTools.GetFranchiseTimeline)
+
+	// Register Tag Tools (5 tools)
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "add_tag",
+		Description:  "Create a freeform tag (e.g. time-travel or oscar-winner), separate from the curated genres",
+		OutputSchema: tools.OutputSchema[tools.TagOutput](),
+	}, tagTools.AddTag)
+
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "tag_movie",
+		Description:  "Attach tags to a movie, creating tags that do not exist yet",
+		OutputSchema: tools.OutputSchema[tools.TagMovieOutput](),
+	}, tagTools.TagMovie)
+
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "untag_movie",
+		Description:  "Remove a tag from a movie; the tag stays available for other movies",
+		OutputSchema: tools.OutputSchema[tools.UntagMovieOutput](),
+	}, tagTools.UntagMovie)
+
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "search_by_tag",
+		Description:  "Find movies carrying any, or all, of the given tags, best rated first",
+		OutputSchema: tools.OutputSchema[tools.SearchByTagOutput](),
+	}, tagTools.SearchByTag)
+
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "autocomplete_tags",
+		Description:  "Suggest existing tags starting with a prefix, most used first",
+		OutputSchema: tools.OutputSchema[tools.AutocompleteTagsOutput](),
+	}, tagTools.AutocompleteTags)
+
+	// Register Similarity Tools (1 tool)
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "get_similar_movies",
+		Description:  "Get the movies most similar to a movie by genres, director, release year and rating, read from a precomputed index",
+		OutputSchema: tools.OutputSchema[tools.GetSimilarMoviesOutput](),
+	}, similarTools.GetSimilarMovies)
+
+	// Register Popularity Tools (1 tool)
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "trending_movies",
+		Description:  "List the movies tools returned most over the last days, counting get_movie views and search results",
+		OutputSchema: tools.OutputSchema[tools.TrendingMoviesOutput](),
+	}, trendingTools.TrendingMovies)
+
+	// Register Translation Tools (3 tools)
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "add_translation",
+		Description:  "Add or replace a movie's alternative title and description in a language (e.g. fr or pt-BR)",
+		OutputSchema: tools.OutputSchema[tools.TranslationOutput](),
+	}, translationTools.AddTranslation)
+
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "list_translations",
+		Description:  "List a movie's translations by language",
+		OutputSchema: tools.OutputSchema[tools.ListTranslationsOutput](),
+	}, translationTools.ListTranslations)
+
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "summarize_movie",
+		Description:  "Have the client's model write a movie's description in a language from its stored metadata (sampling), stored as a generated description; imported descriptions are kept unless replace_imported is set",
+		OutputSchema: tools.OutputSchema[tools.SummarizeMovieOutput](),
+	}, summaryTools.SummarizeMovie)
+
+	// Register Media Tools (2 tools)
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "add_movie_media",
+		Description:  "Link a trailer, teaser, clip or still from a movie (URL, type, provider), optionally as its primary trailer",
+		OutputSchema: tools.OutputSchema[tools.MediaOutput](),
+	}, mediaTools.AddMovieMedia)
+
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "get_movie_media",
+		Description:  "Get a movie's trailers, clips and stills with playable links, optionally filtered by type",
+		OutputSchema: tools.OutputSchema[tools.GetMovieMediaOutput](),
+	}, mediaTools.GetMovieMedia)
+
+	// Register Poster Tools (3 tools)
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "upload_movie_poster",
+		Description:  "Store a movie's poster image from base64 data or a data URI; the type and size are checked against the allowed image types and maximum size",
+		OutputSchema: tools.OutputSchema[tools.UploadMoviePosterOutput](),
+	}, posterTools.UploadMoviePoster)
+
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "get_movie_poster",
+		Description:  "Get a movie's stored poster as image content, with its type and size",
+		OutputSchema: tools.OutputSchema[tools.GetMoviePosterOutput](),
+	}, posterTools.GetMoviePoster)
+
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "delete_movie_poster",
+		Description:  "Delete a movie's stored poster image; its poster URL is kept",
+		OutputSchema: tools.OutputSchema[tools.DeleteMoviePosterOutput](),
+	}, posterTools.DeleteMoviePoster)
+
+	// Register Photo Tools (3 tools)
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "upload_actor_photo",
+		Description:  "Store an actor's photo from base64 data or a data URI; checked and processed like movie posters",
+		OutputSchema: tools.OutputSchema[tools.ActorPhotoOutput](),
+	}, photoTools.UploadActorPhoto)
+
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "download_actor_photo",
+		Description:  "Get an actor's stored photo as image content, with its type, size and resource URI",
+		OutputSchema: tools.OutputSchema[tools.ActorPhotoOutput](),
+	}, photoTools.DownloadActorPhoto)
+
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "delete_actor_photo",
+		Description:  "Delete an actor's stored photo image; their photo URL is kept",
+		OutputSchema: tools.OutputSchema[tools.DeleteActorPhotoOutput](),
+	}, photoTools.DeleteActorPhoto)
+
+	// Register History Tools (2 tools)
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "get_movie_history",
+		Description:  "List a movie's recorded versions newest first, with the fields each create, update, delete or revert changed",
+		OutputSchema: tools.OutputSchema[tools.GetMovieHistoryOutput](),
+	}, historyTools.GetMovieHistory)
+
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "revert_movie_to_version",
+		Description:  "Restore a movie to an earlier version from get_movie_history; the revert is recorded as a new version",
+		OutputSchema: tools.OutputSchema[tools.RevertMovieToVersionOutput](),
+	}, historyTools.RevertMovieToVersion)
+
+	// Register Event Tools (1 tool)
+	middleware.AddTool(toolRegistrar, &mcp.Tool{
+		Name:         "list_recent_events",
+		Description:  "List recent data change events published by mutating tools, newest first, with each webhook's delivery status",
+		OutputSchema: tools.OutputSchema[tools.ListRecentEventsOutput](),
+	}, eventTools.ListRecentEvents)
+
+	fmt.Fprintf(os.Stderr, "  - Movie tools: 10\n")
+	fmt.Fprintf(os.Stderr, "  - Actor tools: 11\n")
+	fmt.Fprintf(os.Stderr, "  - Compound tools: 5\n")
+	fmt.Fprintf(os.Stderr, "  - Universal search tools: 1\n")
 	fmt.Fprintf(os.Stderr, "  - Context tools: 3\n")
+	fmt.Fprintf(os.Stderr, "  - Seed tools: 1\n")
+	fmt.Fprintf(os.Stderr, "  - Preference tools: 2\n")
+	fmt.Fprintf(os.Stderr, "  - Taste tools: 1\n")
+	fmt.Fprintf(os.Stderr, "  - Backup tools: 2\n")
+	fmt.Fprintf(os.Stderr, "  - Transfer tools: 2\n")
+	fmt.Fprintf(os.Stderr, "  - Maintenance tools: 1\n")
+	fmt.Fprintf(os.Stderr, "  - Batch tools: 2\n")
+	fmt.Fprintf(os.Stderr, "  - Bulk update tools: 1\n")
+	fmt.Fprintf(os.Stderr, "  - Timeline tools: 1\n")
+	fmt.Fprintf(os.Stderr, "  - Runtime tools: 1\n")
+	fmt.Fprintf(os.Stderr, "  - Availability tools: 2 (TMDB fetch %s)\n", enabledLabel(availabilityService.HasSource()))
+	fmt.Fprintf(os.Stderr, "  - Franchise tools: 7\n")
+	fmt.Fprintf(os.Stderr, "  - Tag tools: 5\n")
+	fmt.Fprintf(os.Stderr, "  - Similarity tools: 1\n")
+	fmt.Fprintf(os.Stderr, "  - Popularity tools: 1\n")
+	fmt.Fprintf(os.Stderr, "  - Translation tools: 3\n")
+	fmt.Fprintf(os.Stderr, "  - Media tools: 2\n")
+	fmt.Fprintf(os.Stderr, "  - Poster tools: 3\n")
+	fmt.Fprintf(os.Stderr, "  - Photo tools: 3\n")
+	fmt.Fprintf(os.Stderr, "  - History tools: 2\n")
+	fmt.Fprintf(os.Stderr, "  - Event tools: 1 (webhooks %s)\n", enabledLabel(len(cfg.Events.WebhookURLs) > 0))
+
+	// Register Write Queue Tools (optional, 2 tools)
+	if writeQueue != nil {
+		writeQueueTools := tools.NewWriteQueueTools(movieService, writeQueue)
+
+		middleware.AddTool(toolRegistrar, &mcp.Tool{
+			Name:         "queue_movie_write",
+			Description:  "Queue a movie add/update/delete for asynchronous batched application",
+			OutputSchema: tools.OutputSchema[tools.WriteStatusOutput](),
+		}, writeQueueTools.QueueMovieWrite)
+
+		middleware.AddTool(toolRegistrar, &mcp.Tool{
+			Name:         "get_write_status",
+			Description:  "Get the status of a queued write by its acknowledgment token",
+			OutputSchema: tools.OutputSchema[tools.WriteStatusOutput](),
+		}, writeQueueTools.GetWriteStatus)
+
+		fmt.Fprintf(os.Stderr, "  - Write queue tools: 2 (batch size %d)\n", cfg.WriteQueue.BatchSize)
+	}
+
+	// Register Sync Tools (optional, 1 tool)
+	if len(cfg.Sync.RemoteCommand) > 0 {
+		syncTools := tools.NewSyncTools(syncService)
+
+		middleware.AddTool(toolRegistrar, &mcp.Tool{
+			Name:         "sync_from_remote",
+			Description:  "Pull the movies and posters this library lacks from another movies-mcp-server, matching movies by title and year; strategy resolves movies that differ, and dry_run only reports the changes",
+			OutputSchema: tools.OutputSchema[tools.SyncFromRemoteOutput](),
+		}, syncTools.SyncFromRemote)
+
+		fmt.Fprintf(os.Stderr, "  - Sync tools: 1 (remote %s)\n", cfg.Sync.RemoteCommand[0])
+	}
+
+	fmt.Fprintf(os.Stderr, "✓ Registered %d tools successfully\n", toolRegistrar.Registered())
 
 	fmt.Fprintf(os.Stderr, "Registering resources with SDK...\n")
 
-	// Register Database Resources (3 resources)
+	// Register Database, Photo and Diagnostic Resources (10 resources)
 	server.AddResource(dbResources.AllMoviesResource(), dbResources.HandleAllMovies)
 	server.AddResource(dbResources.DatabaseStatsResource(), dbResources.HandleDatabaseStats)
+	server.AddResource(dbResources.GenresResource(), dbResources.HandleGenres)
+	server.AddResource(dbResources.DirectorsResource(), dbResources.HandleDirectors)
 	server.AddResource(dbResources.PosterCollectionResource(), dbResources.HandlePosterCollection)
+	server.AddResource(photoResources.PhotoCollectionResource(), photoResources.HandlePhotoCollection)
+	server.AddResource(healthResources.ServerHealthResource(), healthResources.HandleServerHealth)
+	server.AddResource(slowQueryResources.SlowQueriesResource(), slowQueryResources.HandleSlowQueries)
+	server.AddResource(requestLogResources.RequestLogResource(), requestLogResources.HandleRequestLog)
+	server.AddResource(retentionResources.RetentionStatusResource(), retentionResources.HandleRetentionStatus)
+
+	// Register the paged form of movies://database/all, single movie
+	// posters and single actor photos (3 resource templates)
+	server.AddResourceTemplate(dbResources.AllMoviesTemplate(), dbResources.HandleAllMovies)
+	server.AddResourceTemplate(posterResources.PosterTemplate(), posterResources.HandlePoster)
+	server.AddResourceTemplate(photoResources.PhotoTemplate(), photoResources.HandlePhoto)
 
-	fmt.Fprintf(os.Stderr, "✓ Registered 3 resources successfully\n")
-	fmt.Fprintf(os.Stderr, "  - movies://database/all\n")
-	fmt.Fprintf(os.Stderr, "  - movies://database/stats\n")
-	fmt.Fprintf(os.Stderr, "  - movies://posters/collection\n")
+	fmt.Fprintf(os.Stderr, "✓ Registered 10 resources successfully\n")
+	fmt.Fprintf(os.Stderr, "  - movies://database/all (subscribable)\n")
+	fmt.Fprintf(os.Stderr, "  - movies://database/stats (subscribable)\n")
+	fmt.Fprintf(os.Stderr, "  - movies://database/genres (subscribable)\n")
+	fmt.Fprintf(os.Stderr, "  - movies://database/directors (subscribable)\n")
+	fmt.Fprintf(os.Stderr, "  - movies://posters/collection (subscribable)\n")
+	fmt.Fprintf(os.Stderr, "  - movies://actors/photos\n")
+	fmt.Fprintf(os.Stderr, "  - movies://server/health\n")
+	fmt.Fprintf(os.Stderr, "  - movies://server/slow-queries\n")
+	fmt.Fprintf(os.Stderr, "  - movies://server/request-log\n")
+	fmt.Fprintf(os.Stderr, "  - movies://server/retention\n")
+	fmt.Fprintf(os.Stderr, "  - movies://database/all{?offset,limit,format,fields} (template, pages of up to %d)\n", cfg.Server.MaxPageSize)
+	fmt.Fprintf(os.Stderr, "  - movies://posters/{id} (template, CDN URLs %s)\n", enabledLabel(posterSigner != nil))
+	fmt.Fprintf(os.Stderr, "  - movies://actors/photos/{id} (template)\n")
+
+	// Publish the health report and library stats for cmd/movies-tui
+	if cfg.Server.StatusFile != "" {
+		statusWriter := resources.NewStatusWriter(cfg.Server.StatusFile, cfg.Server.StatusInterval, map[string]mcp.ResourceHandler{
+			"health": healthResources.HandleServerHealth,
+			"stats":  dbResources.HandleDatabaseStats,
+		})
+		statusWriter.Start(ctx)
+		defer statusWriter.Stop()
+		fmt.Fprintf(os.Stderr, "Status file: %s (every %s)\n", cfg.Server.StatusFile, cfg.Server.StatusInterval)
+	}
 
 	fmt.Fprintf(os.Stderr, "\nServer ready - listening on stdin/stdout\n")
 	fmt.Fprintf(os.Stderr, "Using official MCP SDK v1.1.0\n\n")
 
-	// Run server with stdio transport
-	ctx := context.Background()
-	if err := server.Run(ctx, &mcp.StdioTransport{}); err != nil {
-		fmt.Fprintf(os.Stderr, "Server error: %v\n", err)
-		os.Exit(1)
+	// Run server with stdio transport until the client disconnects or a signal arrives
+	runErr := server.Run(ctx, transport)
+
+	// Drain in-flight tool calls; deferred cleanup then flushes queued
+	// writes and closes the database
+	if err := inFlight.Drain(cfg.Server.ShutdownTimeout); err != nil {
+		fmt.Fprintf(os.Stderr, "Shutdown drain incomplete: %v\n", err)
+	}
+
+	if runErr != nil && ctx.Err() == nil {
+		fmt.Fprintf(os.Stderr, "Server error: %v\n", runErr)
+		exitCode = 1
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "Server stopped\n")
+}
+
+// enabledLabel describes an optional feature in startup output
+func enabledLabel(enabled bool) string {
+	if enabled {
+		return "enabled"
+	}
+	return "disabled"
+}
+
+// newCatalog loads the message bundles and checks the server's default
+// locale has one
+func newCatalog(locale string) (*i18n.Catalog, error) {
+	catalog, err := i18n.NewCatalog()
+	if err != nil {
+		return nil, err
 	}
+	if !catalog.Supports(locale) {
+		return nil, fmt.Errorf("%q is not one of %s", locale, strings.Join(catalog.Locales(), ", "))
+	}
+	return catalog, nil
+}
+
+// sessionLocales returns the languages a tool call's messages may be written
+// in, most preferred first: the session's preferred language, the locale
+// its client asked for, then the server's
+func sessionLocales(preferences *tools.PreferenceStore, serverLocale string) func(req *mcp.CallToolRequest) []string {
+	return func(req *mcp.CallToolRequest) []string {
+		return []string{preferences.Get(req).Language, middleware.ClientLocale(req), serverLocale}
+	}
+}
+
+// checkSchema verifies that db has every migration embedded in the binary
+// except contract ones. With autoMigrate, pending migrations of phase are
+// applied from migrationFS first.
+func checkSchema(ctx context.Context, db *sql.DB, migrationFS fs.FS, phase database.MigrationPhase, autoMigrate bool) error {
+	checker := database.NewMigrationChecker(db, migrations.FS)
+	_, err := checker.CheckSchema(ctx)
+	if errors.Is(err, database.ErrSchemaOutdated) && autoMigrate {
+		applied, migrateErr := database.MigratePhase(ctx, db, migrationFS, phase)
+		if migrateErr != nil {
+			return fmt.Errorf("failed to apply pending migrations: %w", migrateErr)
+		}
+		fmt.Fprintf(os.Stderr, "Applied %d pending migrations\n", applied)
+		_, err = checker.CheckSchema(ctx)
+	}
+	if errors.Is(err, database.ErrSchemaOutdated) {
+		return fmt.Errorf("%w; run with -migrate-only or without -skip-migrations, or pass -auto-migrate", err)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check the database schema: %w", err)
+	}
+	return nil
 }
 
 // connectToDatabase establishes a connection to SQLite
 func connectToDatabase(cfg *config.DatabaseConfig) (*sql.DB, error) {
+	// SQLite creates the file but not its directory, such as a fresh volume
+	if !cfg.InMemory() {
+		if err := os.MkdirAll(filepath.Dir(cfg.Name), 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create database directory: %w", err)
+		}
+	}
+
 	dsn := cfg.ConnectionString()
 
 	db, err := sql.Open("sqlite", dsn)
@@ -287,56 +1375,98 @@ func connectToDatabase(cfg *config.DatabaseConfig) (*sql.DB, error) {
 		return nil, fmt.Errorf("failed to open SQLite database: %w", err)
 	}
 
-	// Test connection
+	// Configure connection pool. Under WAL readers use their own connections
+	// while one writer holds the lock; others wait out the busy timeout.
+	maxOpen, maxIdle := cfg.MaxOpenConns, cfg.MaxIdleConns
+	if cfg.InMemory() {
+		maxOpen, maxIdle = 1, 1 // A second connection would open an empty database
+	}
+	db.SetMaxOpenConns(maxOpen)
+	db.SetMaxIdleConns(maxIdle)
+	db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+
+	// Test connection; this also applies the journal mode
 	if err := db.Ping(); err != nil {
 		db.Close()
 		return nil, fmt.Errorf("failed to connect to SQLite database: %w", err)
 	}
 
-	// Configure connection pool (SQLite works best with minimal connections)
-	db.SetMaxOpenConns(cfg.MaxOpenConns)
-	db.SetMaxIdleConns(cfg.MaxIdleConns)
-	db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
-
 	return db, nil
 }
 
-// runMigrations applies database migrations using our custom tool
-func runMigrations(migrationsPath string) error {
-	// First, build the migration tool
-	fmt.Fprintf(os.Stderr, "Building migration tool...\n")
-	buildCmd := exec.Command("go", "build", "-o", "./migrate", "./tools/migrate")
-	buildCmd.Stderr = os.Stderr
-	buildCmd.Stdout = os.Stderr
-	if err := buildCmd.Run(); err != nil {
-		return fmt.Errorf("failed to build migration tool: %w", err)
-	}
-
-	// Get database URL from environment
-	dbURL := os.Getenv("DATABASE_URL")
-	if dbURL == "" {
-		// Construct SQLite URL from DB_NAME
-		dbname := os.Getenv("DB_NAME")
-		if dbname == "" {
-			dbname = "movies.db"
+// runBackupCommand runs the backup or restore CLI command, printing progress to stderr
+func runBackupCommand(ctx context.Context, manager *database.BackupManager, command, path string) error {
+	progress := func(done, total int, message string) {
+		if done == total || done%100 == 0 {
+			fmt.Fprintf(os.Stderr, "  %s: %d/%d rows\n", message, done, total)
 		}
-		dbURL = fmt.Sprintf("sqlite://%s", dbname)
 	}
 
-	// Run the migration tool
-	fmt.Fprintf(os.Stderr, "Running migrations...\n")
-	migrateCmd := exec.Command("./migrate", dbURL, migrationsPath, "up")
-	migrateCmd.Stdout = os.Stderr
-	migrateCmd.Stderr = os.Stderr
+	var manifest *database.BackupManifest
+	var err error
+	if command == "backup" {
+		manifest, err = manager.BackupFile(ctx, path, progress)
+	} else {
+		manifest, err = manager.RestoreFile(ctx, path, progress)
+	}
+	if err != nil {
+		return err
+	}
 
-	if err := migrateCmd.Run(); err != nil {
-		return fmt.Errorf("failed to run migrations: %w", err)
+	for _, table := range manifest.Tables {
+		fmt.Fprintf(os.Stderr, "  - %s: %d rows\n", table.Name, table.Rows)
 	}
+	fmt.Fprintf(os.Stderr, "%s of %s completed (%d rows, %d checksummed entries)\n",
+		command, path, manifest.TotalRows(), len(manifest.Checksums))
+	return nil
+}
 
-	// Clean up the built binary
-	if err := os.Remove("./migrate"); err != nil {
-		log.Printf("Warning: failed to remove migrate binary: %v", err)
+// runSyncCommand runs the sync CLI command, printing the outcome to stderr
+func runSyncCommand(ctx context.Context, service *librarysync.Service, strategy string) error {
+	parsed, err := librarysync.ParseStrategy(strategy)
+	if err != nil {
+		return err
+	}
+	result, err := service.Sync(ctx, librarysync.SyncCommand{Strategy: parsed})
+	if err != nil {
+		return err
 	}
 
+	for _, itemErr := range result.Errors {
+		fmt.Fprintf(os.Stderr, "  ! %s (remote %d): %s\n", itemErr.Title, itemErr.RemoteID, itemErr.Error)
+	}
+	fmt.Fprintf(os.Stderr, "sync (%s) of %d remote movies completed: %d created, %d updated, %d kept local, %d unchanged, %d posters copied\n",
+		result.Strategy, result.RemoteMovies, result.Created, result.Updated, result.Kept, result.Unchanged, result.PostersCopied)
+	return nil
+}
+
+// newMovieRepository builds the movie repository every writer goes through.
+// The similarity index sits inside the history so reverts refresh it too.
+func newMovieRepository(db *sql.DB, historyRepo *sqlite.HistoryRepository) *historyApp.TrackedRepository {
+	index := similarityApp.NewIndexedRepository(sqlite.NewMovieRepository(db), sqlite.NewSimilarityRepository(db))
+	return historyApp.NewTrackedRepository(index, historyRepo)
+}
+
+// seedEmptyLibrary loads an embedded dataset when the database has no
+// movies, so a fresh container starts with a library while one whose movies
+// were deleted on purpose stays empty
+func seedEmptyLibrary(ctx context.Context, db *sql.DB, dataset string) error {
+	service := movieApp.NewService(newMovieRepository(db, sqlite.NewHistoryRepository(db)))
+	count, err := service.CountMovies(ctx)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	result, err := seed.NewSeeder(service).Seed(ctx, dataset)
+	if err != nil {
+		return err
+	}
+	for _, seedErr := range result.Errors {
+		fmt.Fprintf(os.Stderr, "  ! %s\n", seedErr)
+	}
+	fmt.Fprintf(os.Stderr, "Seeded empty library with dataset %s: %d movies\n", result.Dataset, result.Inserted)
 	return nil
 }
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/francknouama/movies-mcp-server/internal/config"
+	"github.com/francknouama/movies-mcp-server/migrations"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+// runSelfTest checks that the server could start and serve requests: the
+// configuration loaded from configPath, the database it names, its schema,
+// a read, a write and the poster store. It prints a report to stdout and
+// returns whether every check passed. Nothing the checks write is kept, and
+// a missing database file is reported rather than created.
+func runSelfTest(ctx context.Context, cfg *config.Config, cfgErr error, configPath string) bool {
+	var report database.SelfTestReport
+	defer func() {
+		if err := report.Write(os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to write self-test report: %v\n", err)
+		}
+	}()
+
+	if cfgErr != nil {
+		report.Add("config", "", cfgErr)
+		return false
+	}
+	source := "environment and defaults"
+	if configPath != "" {
+		source = configPath + " and environment"
+	}
+	report.Add("config", "valid, from "+source, nil)
+
+	if !cfg.Database.InMemory() {
+		if _, err := os.Stat(cfg.Database.Name); errors.Is(err, fs.ErrNotExist) {
+			report.Add("connection", "", fmt.Errorf("database %s does not exist", cfg.Database.Name))
+			return false
+		}
+	}
+	db, err := connectToDatabase(&cfg.Database)
+	if err != nil {
+		report.Add("connection", "", err)
+		return false
+	}
+	defer db.Close()
+
+	database.SelfTest(ctx, db, migrations.FS, &report)
+	return !report.Failed()
+}
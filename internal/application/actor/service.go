@@ -3,14 +3,19 @@ package actor
 import (
 	"context"
 	"fmt"
+	"math"
+	"sort"
 
 	"github.com/francknouama/movies-mcp-server/internal/domain/actor"
 	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/fuzzy"
+	"github.com/francknouama/movies-mcp-server/pkg/serialization"
 )
 
 // Service provides application-level actor operations
 type Service struct {
 	actorRepo actor.Repository
+	publisher shared.EventPublisher
 }
 
 // NewService creates a new actor application service
@@ -20,19 +25,43 @@ func NewService(actorRepo actor.Repository) *Service {
 	}
 }
 
+// SetEventPublisher sets where the events of created, updated, deleted and
+// linked actors are published once they are saved; without one they are
+// dropped
+func (s *Service) SetEventPublisher(publisher shared.EventPublisher) {
+	s.publisher = publisher
+}
+
+// publishEvents publishes the events a saved actor recorded and marks them
+// committed
+func (s *Service) publishEvents(ctx context.Context, domainActor *actor.Actor) {
+	if s.publisher != nil && domainActor.HasUncommittedEvents() {
+		s.publisher.Publish(ctx, domainActor.UncommittedEvents())
+	}
+	domainActor.MarkEventsAsCommitted()
+}
+
 // CreateActorCommand represents the command to create a new actor
 type CreateActorCommand struct {
-	Name      string
-	BirthYear int
-	Bio       string
+	Name        string
+	BirthYear   int
+	DeathYear   int // 0 while the actor is alive
+	Nationality string
+	Bio         string
+	BioSections []BioSectionDTO
+	PhotoURL    string
 }
 
 // UpdateActorCommand represents the command to update an existing actor
 type UpdateActorCommand struct {
-	ID        int
-	Name      string
-	BirthYear int
-	Bio       string
+	ID          int
+	Name        string
+	BirthYear   int
+	DeathYear   int // 0 while the actor is alive
+	Nationality string
+	Bio         string
+	BioSections []BioSectionDTO
+	PhotoURL    string
 }
 
 // SearchActorsQuery represents the query to search for actors
@@ -40,22 +69,65 @@ type SearchActorsQuery struct {
 	Name         string
 	MinBirthYear int
 	MaxBirthYear int
-	MovieID      int // Find actors who appeared in this movie
+	MovieID      int    // Find actors who appeared in this movie
+	Character    string // Find actors who played a character (partial match)
+	Nationality  string // Exact match, ignoring case
+	AliveOnly    bool   // Leave out actors with a death year
 	Limit        int
 	Offset       int
 	OrderBy      string
 	OrderDir     string
+	Fuzzy        bool      // Match Name by similarity and rank results by score
+	Sort         []SortKey // Ordered sort keys; when set, replaces OrderBy/OrderDir
+}
+
+// LinkActorCommand represents the command to link an actor to a movie
+type LinkActorCommand struct {
+	ActorID      int
+	MovieID      int
+	Character    string // Character played; optional
+	BillingOrder int    // Position in the credits, 1 is top-billed; 0 if unknown
+	RoleType     string // lead, supporting or cameo; optional
+}
+
+// SortKey represents one term of a multi-key sort
+type SortKey struct {
+	Field string // name, birth_year, created_at or updated_at
+	Dir   string // asc (default) or desc
 }
 
 // ActorDTO represents an actor data transfer object
 type ActorDTO struct {
-	ID        int    `json:"id"`
-	Name      string `json:"name"`
-	BirthYear int    `json:"birth_year"`
-	Bio       string `json:"bio,omitempty"`
-	MovieIDs  []int  `json:"movie_ids"`
-	CreatedAt string `json:"created_at"`
-	UpdatedAt string `json:"updated_at"`
+	ID          int             `json:"id"`
+	Name        string          `json:"name"`
+	BirthYear   int             `json:"birth_year"`
+	DeathYear   int             `json:"death_year,omitempty"`
+	Nationality string          `json:"nationality,omitempty"`
+	Bio         string          `json:"bio,omitempty"`
+	BioSections []BioSectionDTO `json:"bio_sections"`
+	PhotoURL    string          `json:"photo_url,omitempty"`
+	HasPhoto    bool            `json:"has_photo"` // Whether a photo image is stored
+	MovieIDs    []int           `json:"movie_ids"`
+	Credits     []CreditDTO     `json:"credits"`
+	CreatedAt   string          `json:"created_at"`
+	UpdatedAt   string          `json:"updated_at"`
+
+	// Similarity is the fuzzy name match score (0-1), set only by fuzzy searches
+	Similarity float64 `json:"similarity,omitempty"`
+}
+
+// BioSectionDTO represents one titled part of an actor's biography
+type BioSectionDTO struct {
+	Title string `json:"title"`
+	Text  string `json:"text"`
+}
+
+// CreditDTO represents an actor's part in a movie
+type CreditDTO struct {
+	MovieID      int    `json:"movie_id"`
+	Character    string `json:"character,omitempty"`
+	BillingOrder int    `json:"billing_order,omitempty"`
+	RoleType     string `json:"role_type,omitempty"`
 }
 
 // CreateActor creates a new actor
@@ -71,6 +143,11 @@ func (s *Service) CreateActor(ctx context.Context, cmd CreateActorCommand) (*Act
 		domainActor.SetBio(cmd.Bio)
 	}
 
+	// Set the optional details
+	if err := setDetails(domainActor, cmd.DeathYear, cmd.Nationality, cmd.BioSections, cmd.PhotoURL); err != nil {
+		return nil, err
+	}
+
 	// Validate the actor
 	if err := domainActor.Validate(); err != nil {
 		return nil, fmt.Errorf("actor validation failed: %w", err)
@@ -80,6 +157,8 @@ func (s *Service) CreateActor(ctx context.Context, cmd CreateActorCommand) (*Act
 	if err := s.actorRepo.Save(ctx, domainActor); err != nil {
 		return nil, fmt.Errorf("failed to save actor: %w", err)
 	}
+	domainActor.RecordCreated()
+	s.publishEvents(ctx, domainActor)
 
 	return s.toDTO(domainActor), nil
 }
@@ -99,6 +178,40 @@ func (s *Service) GetActor(ctx context.Context, id int) (*ActorDTO, error) {
 	return s.toDTO(domainActor), nil
 }
 
+// GetActorsByIDs retrieves several actors in a single query. IDs that do not
+// exist are left out of the result.
+func (s *Service) GetActorsByIDs(ctx context.Context, ids []int) ([]*ActorDTO, error) {
+	if len(ids) == 0 {
+		return []*ActorDTO{}, nil
+	}
+
+	actorIDs := make([]shared.ActorID, 0, len(ids))
+	for _, id := range ids {
+		actorID, err := shared.NewActorID(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid actor ID %d: %w", id, err)
+		}
+		actorIDs = append(actorIDs, actorID)
+	}
+
+	domainActors, err := s.actorRepo.FindByCriteria(ctx, actor.SearchCriteria{
+		IDs:      actorIDs,
+		Limit:    len(actorIDs),
+		OrderBy:  actor.OrderByName,
+		OrderDir: actor.OrderAsc,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get actors: %w", err)
+	}
+
+	dtos := make([]*ActorDTO, 0, len(domainActors))
+	for _, domainActor := range domainActors {
+		dtos = append(dtos, s.toDTO(domainActor))
+	}
+
+	return dtos, nil
+}
+
 // UpdateActor updates an existing actor
 func (s *Service) UpdateActor(ctx context.Context, cmd UpdateActorCommand) (*ActorDTO, error) {
 	actorID, err := shared.NewActorID(cmd.ID)
@@ -121,6 +234,12 @@ func (s *Service) UpdateActor(ctx context.Context, cmd UpdateActorCommand) (*Act
 	// Set bio
 	updatedActor.SetBio(cmd.Bio)
 
+	// Set the optional details; a stored photo image is kept
+	if err := setDetails(updatedActor, cmd.DeathYear, cmd.Nationality, cmd.BioSections, cmd.PhotoURL); err != nil {
+		return nil, err
+	}
+	updatedActor.SetHasPhoto(existingActor.HasPhoto())
+
 	// Preserve existing movie links
 	for _, movieID := range existingActor.MovieIDs() {
 		if err := updatedActor.AddMovie(movieID); err != nil {
@@ -137,6 +256,8 @@ func (s *Service) UpdateActor(ctx context.Context, cmd UpdateActorCommand) (*Act
 	if err := s.actorRepo.Save(ctx, updatedActor); err != nil {
 		return nil, fmt.Errorf("failed to save updated actor: %w", err)
 	}
+	updatedActor.RecordUpdated()
+	s.publishEvents(ctx, updatedActor)
 
 	return s.toDTO(updatedActor), nil
 }
@@ -148,9 +269,17 @@ func (s *Service) DeleteActor(ctx context.Context, id int) error {
 		return fmt.Errorf("invalid actor ID: %w", err)
 	}
 
+	// Load the actor first so their deletion can be published with their name
+	existing, err := s.actorRepo.FindByID(ctx, actorID)
+	if err != nil {
+		return fmt.Errorf("failed to delete actor: %w", err)
+	}
+
 	if err := s.actorRepo.Delete(ctx, actorID); err != nil {
 		return fmt.Errorf("failed to delete actor: %w", err)
 	}
+	existing.RecordDeleted()
+	s.publishEvents(ctx, existing)
 
 	return nil
 }
@@ -187,20 +316,32 @@ func (s *Service) updateActorMovieLink(ctx context.Context, actorDomainID shared
 	if err := s.actorRepo.Save(ctx, domainActor); err != nil {
 		return fmt.Errorf("failed to save actor: %w", err)
 	}
+	s.publishEvents(ctx, domainActor)
 
 	return nil
 }
 
-// LinkActorToMovie links an actor to a movie
-func (s *Service) LinkActorToMovie(ctx context.Context, actorID, movieID int) error {
-	actorDomainID, movieDomainID, err := s.validateActorMovieIDs(actorID, movieID)
+// LinkActorToMovie links an actor to a movie, recording the part they played
+func (s *Service) LinkActorToMovie(ctx context.Context, cmd LinkActorCommand) (*CreditDTO, error) {
+	actorDomainID, movieDomainID, err := s.validateActorMovieIDs(cmd.ActorID, cmd.MovieID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return s.updateActorMovieLink(ctx, actorDomainID, movieDomainID,
-		func(a *actor.Actor, m shared.MovieID) error { return a.AddMovie(m) },
+	credit, err := actor.NewCredit(movieDomainID, cmd.Character, cmd.BillingOrder, cmd.RoleType)
+	if err != nil {
+		return nil, fmt.Errorf("invalid credit: %w", err)
+	}
+
+	err = s.updateActorMovieLink(ctx, actorDomainID, movieDomainID,
+		func(a *actor.Actor, _ shared.MovieID) error { return a.AddCredit(credit) },
 		"link actor to movie")
+	if err != nil {
+		return nil, err
+	}
+
+	creditDTO := toCreditDTO(credit)
+	return &creditDTO, nil
 }
 
 // UnlinkActorFromMovie removes the link between an actor and a movie
@@ -221,6 +362,9 @@ func (s *Service) SearchActors(ctx context.Context, query SearchActorsQuery) ([]
 		Name:         query.Name,
 		MinBirthYear: query.MinBirthYear,
 		MaxBirthYear: query.MaxBirthYear,
+		Character:    query.Character,
+		Nationality:  query.Nationality,
+		AliveOnly:    query.AliveOnly,
 		Limit:        query.Limit,
 		Offset:       query.Offset,
 	}
@@ -239,19 +383,8 @@ func (s *Service) SearchActors(ctx context.Context, query SearchActorsQuery) ([]
 		criteria.Limit = 50
 	}
 
-	// Set order by
-	switch query.OrderBy {
-	case "name":
-		criteria.OrderBy = actor.OrderByName
-	case "birth_year":
-		criteria.OrderBy = actor.OrderByBirthYear
-	case "created_at":
-		criteria.OrderBy = actor.OrderByCreatedAt
-	case "updated_at":
-		criteria.OrderBy = actor.OrderByUpdatedAt
-	default:
-		criteria.OrderBy = actor.OrderByName
-	}
+	// Set order by, falling back to name for unknown fields
+	criteria.OrderBy, _ = parseOrderBy(query.OrderBy)
 
 	// Set order direction
 	if query.OrderDir == "desc" {
@@ -260,11 +393,40 @@ func (s *Service) SearchActors(ctx context.Context, query SearchActorsQuery) ([]
 		criteria.OrderDir = actor.OrderAsc
 	}
 
+	// Multi-key sorts are validated strictly since a silently dropped key
+	// would reorder results in a way the caller did not ask for
+	for _, key := range query.Sort {
+		field, ok := parseOrderBy(key.Field)
+		if !ok {
+			return nil, fmt.Errorf("unsupported sort field: %q", key.Field)
+		}
+		dir, ok := parseOrderDirection(key.Dir)
+		if !ok {
+			return nil, fmt.Errorf("unsupported sort direction for %s: %q", key.Field, key.Dir)
+		}
+		criteria.Sort = append(criteria.Sort, actor.SortKey{Field: field, Dir: dir})
+	}
+
+	// Fuzzy matching has to score every candidate, so the name filter and
+	// pagination move out of the query and are applied after ranking
+	fuzzyName := ""
+	limit, offset := criteria.Limit, criteria.Offset
+	if query.Fuzzy && query.Name != "" {
+		fuzzyName = query.Name
+		criteria.Name = ""
+		criteria.Limit = 0
+		criteria.Offset = 0
+	}
+
 	domainActors, err := s.actorRepo.FindByCriteria(ctx, criteria)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search actors: %w", err)
 	}
 
+	if fuzzyName != "" {
+		return s.rankBySimilarity(fuzzyName, domainActors, limit, offset), nil
+	}
+
 	var dtos []*ActorDTO
 	for _, domainActor := range domainActors {
 		dtos = append(dtos, s.toDTO(domainActor))
@@ -293,22 +455,130 @@ func (s *Service) GetActorsByMovie(ctx context.Context, movieID int) ([]*ActorDT
 	return dtos, nil
 }
 
+// parseOrderBy maps a field name to a sort field, reporting whether it is known
+func parseOrderBy(field string) (actor.OrderBy, bool) {
+	switch field {
+	case "name":
+		return actor.OrderByName, true
+	case "birth_year":
+		return actor.OrderByBirthYear, true
+	case "created_at":
+		return actor.OrderByCreatedAt, true
+	case "updated_at":
+		return actor.OrderByUpdatedAt, true
+	default:
+		return actor.OrderByName, false
+	}
+}
+
+// parseOrderDirection maps asc/desc to a sort direction, defaulting to ascending
+func parseOrderDirection(dir string) (actor.OrderDirection, bool) {
+	switch dir {
+	case "", "asc":
+		return actor.OrderAsc, true
+	case "desc":
+		return actor.OrderDesc, true
+	default:
+		return actor.OrderAsc, false
+	}
+}
+
+// rankBySimilarity keeps actors whose name fuzzily matches name, ordered by
+// descending score. Ties keep the repository order.
+func (s *Service) rankBySimilarity(name string, domainActors []*actor.Actor, limit, offset int) []*ActorDTO {
+	dtos := make([]*ActorDTO, 0, len(domainActors))
+	for _, domainActor := range domainActors {
+		score := fuzzy.Similarity(name, domainActor.Name())
+		if score < fuzzy.DefaultThreshold {
+			continue
+		}
+		dto := s.toDTO(domainActor)
+		dto.Similarity = math.Round(score*1000) / 1000
+		dtos = append(dtos, dto)
+	}
+
+	sort.SliceStable(dtos, func(i, j int) bool {
+		return dtos[i].Similarity > dtos[j].Similarity
+	})
+
+	if offset >= len(dtos) {
+		return []*ActorDTO{}
+	}
+	dtos = dtos[offset:]
+	if limit > 0 && limit < len(dtos) {
+		dtos = dtos[:limit]
+	}
+	return dtos
+}
+
 // toDTO converts a domain actor to a DTO
 func (s *Service) toDTO(domainActor *actor.Actor) *ActorDTO {
-	movieIDs := make([]int, len(domainActor.MovieIDs()))
-	for i, movieID := range domainActor.MovieIDs() {
-		movieIDs[i] = movieID.Value()
+	credits := domainActor.Credits()
+	movieIDs := make([]int, len(credits))
+	creditDTOs := make([]CreditDTO, len(credits))
+	for i, credit := range credits {
+		movieIDs[i] = credit.MovieID().Value()
+		creditDTOs[i] = toCreditDTO(credit)
+	}
+
+	sections := domainActor.BioSections()
+	sectionDTOs := make([]BioSectionDTO, len(sections))
+	for i, section := range sections {
+		sectionDTOs[i] = BioSectionDTO{Title: section.Title(), Text: section.Text()}
 	}
 
 	dto := &ActorDTO{
-		ID:        domainActor.ID().Value(),
-		Name:      domainActor.Name(),
-		BirthYear: domainActor.BirthYear().Value(),
-		Bio:       domainActor.Bio(),
-		MovieIDs:  movieIDs,
-		CreatedAt: domainActor.CreatedAt().Format("2006-01-02T15:04:05Z"),
-		UpdatedAt: domainActor.UpdatedAt().Format("2006-01-02T15:04:05Z"),
+		ID:          domainActor.ID().Value(),
+		Name:        domainActor.Name(),
+		BirthYear:   domainActor.BirthYear().Value(),
+		DeathYear:   domainActor.DeathYear().Value(),
+		Nationality: domainActor.Nationality(),
+		Bio:         domainActor.Bio(),
+		BioSections: sectionDTOs,
+		PhotoURL:    domainActor.PhotoURL(),
+		HasPhoto:    domainActor.HasPhoto(),
+		MovieIDs:    movieIDs,
+		Credits:     creditDTOs,
+		CreatedAt:   serialization.Timestamp(domainActor.CreatedAt()),
+		UpdatedAt:   serialization.Timestamp(domainActor.UpdatedAt()),
 	}
 
 	return dto
 }
+
+// setDetails sets the optional details a create or update command carries
+func setDetails(domainActor *actor.Actor, deathYear int, nationality string, sections []BioSectionDTO, photoURL string) error {
+	if err := domainActor.SetDeathYear(deathYear); err != nil {
+		return fmt.Errorf("invalid death year: %w", err)
+	}
+	if err := domainActor.SetNationality(nationality); err != nil {
+		return fmt.Errorf("invalid nationality: %w", err)
+	}
+
+	bioSections := make([]actor.BioSection, len(sections))
+	for i, section := range sections {
+		bioSection, err := actor.NewBioSection(section.Title, section.Text)
+		if err != nil {
+			return fmt.Errorf("invalid biography section: %w", err)
+		}
+		bioSections[i] = bioSection
+	}
+	if err := domainActor.SetBioSections(bioSections); err != nil {
+		return fmt.Errorf("invalid biography sections: %w", err)
+	}
+
+	if err := domainActor.SetPhotoURL(photoURL); err != nil {
+		return fmt.Errorf("invalid photo URL: %w", err)
+	}
+	return nil
+}
+
+// toCreditDTO converts a domain credit to a DTO
+func toCreditDTO(credit actor.Credit) CreditDTO {
+	return CreditDTO{
+		MovieID:      credit.MovieID().Value(),
+		Character:    credit.Character(),
+		BillingOrder: credit.BillingOrder(),
+		RoleType:     string(credit.RoleType()),
+	}
+}
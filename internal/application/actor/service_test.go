@@ -3,6 +3,7 @@ package actor
 import (
 	"context"
 	"errors"
+	"reflect"
 	"testing"
 
 	"github.com/francknouama/movies-mcp-server/internal/domain/actor"
@@ -75,6 +76,17 @@ func (m *MockActorRepository) FindByCriteria(ctx context.Context, criteria actor
 	for _, actorItem := range m.actors {
 		match := true
 
+		// Filter by IDs
+		if len(criteria.IDs) > 0 {
+			found := false
+			for _, id := range criteria.IDs {
+				if id == actorItem.ID() {
+					found = true
+				}
+			}
+			match = found
+		}
+
 		// Filter by name
 		if criteria.Name != "" && actorItem.Name() != criteria.Name {
 			match = false
@@ -219,6 +231,66 @@ func TestService_CreateActor_WithBio(t *testing.T) {
 	}
 }
 
+func TestService_CreateActor_WithBiographyDetails(t *testing.T) {
+	repo := NewMockActorRepository()
+	service := NewService(repo)
+
+	cmd := CreateActorCommand{
+		Name:        "Audrey Hepburn",
+		BirthYear:   1929,
+		DeathYear:   1993,
+		Nationality: "British",
+		BioSections: []BioSectionDTO{
+			{Title: "Early life", Text: "Born in Brussels."},
+			{Title: "Humanitarian work", Text: "UNICEF Goodwill Ambassador."},
+		},
+	}
+
+	result, err := service.CreateActor(context.Background(), cmd)
+	if err != nil {
+		t.Fatalf("CreateActor() error = %v", err)
+	}
+	if result.DeathYear != 1993 || result.Nationality != "British" {
+		t.Errorf("Expected death year 1993 and nationality British, got: %d %q", result.DeathYear, result.Nationality)
+	}
+	if len(result.BioSections) != 2 || result.BioSections[1] != cmd.BioSections[1] {
+		t.Errorf("Expected both biography sections, got: %v", result.BioSections)
+	}
+}
+
+func TestService_CreateActor_InvalidBiographyDetails(t *testing.T) {
+	tests := []struct {
+		name string
+		cmd  CreateActorCommand
+	}{
+		{
+			name: "death before birth",
+			cmd:  CreateActorCommand{Name: "Test Actor", BirthYear: 1980, DeathYear: 1970},
+		},
+		{
+			name: "duplicate section titles",
+			cmd: CreateActorCommand{Name: "Test Actor", BirthYear: 1980, BioSections: []BioSectionDTO{
+				{Title: "Career", Text: "Stage."},
+				{Title: "career", Text: "Film."},
+			}},
+		},
+		{
+			name: "section without text",
+			cmd:  CreateActorCommand{Name: "Test Actor", BirthYear: 1980, BioSections: []BioSectionDTO{{Title: "Career"}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewService(NewMockActorRepository())
+			_, err := service.CreateActor(context.Background(), tt.cmd)
+			if !errors.Is(err, shared.ErrValidation) {
+				t.Errorf("Expected a validation error, got: %v", err)
+			}
+		})
+	}
+}
+
 func TestService_GetActor(t *testing.T) {
 	repo := NewMockActorRepository()
 	service := NewService(repo)
@@ -244,6 +316,92 @@ func TestService_GetActor(t *testing.T) {
 	}
 }
 
+func TestService_GetActorsByIDs(t *testing.T) {
+	repo := NewMockActorRepository()
+	service := NewService(repo)
+
+	var ids []int
+	for _, name := range []string{"Actor A", "Actor B", "Actor C"} {
+		created, err := service.CreateActor(context.Background(), CreateActorCommand{Name: name, BirthYear: 1980})
+		if err != nil {
+			t.Fatalf("Failed to create actor: %v", err)
+		}
+		ids = append(ids, created.ID)
+	}
+
+	results, err := service.GetActorsByIDs(context.Background(), []int{ids[0], ids[2], 999})
+	if err != nil {
+		t.Fatalf("GetActorsByIDs() error = %v", err)
+	}
+
+	if len(results) != 2 {
+		t.Errorf("GetActorsByIDs() returned %d actors, want 2", len(results))
+	}
+}
+
+func TestService_SearchActors_Fuzzy(t *testing.T) {
+	repo := NewMockActorRepository()
+	service := NewService(repo)
+
+	for _, name := range []string{"Keanu Reeves", "Carrie-Anne Moss"} {
+		if _, err := service.CreateActor(context.Background(), CreateActorCommand{Name: name, BirthYear: 1964}); err != nil {
+			t.Fatalf("Failed to create actor: %v", err)
+		}
+	}
+
+	results, err := service.SearchActors(context.Background(), SearchActorsQuery{Name: "Keanu Reevs", Fuzzy: true})
+	if err != nil {
+		t.Fatalf("SearchActors() error = %v", err)
+	}
+
+	if len(results) != 1 || results[0].Name != "Keanu Reeves" {
+		t.Fatalf("SearchActors() = %v, want only Keanu Reeves", results)
+	}
+	if results[0].Similarity <= 0 {
+		t.Errorf("SearchActors() similarity = %v, want positive", results[0].Similarity)
+	}
+}
+
+func TestService_SearchActors_MultiKeySort(t *testing.T) {
+	repo := NewMockActorRepository()
+	var gotCriteria actor.SearchCriteria
+	repo.findByCriteriaFunc = func(ctx context.Context, criteria actor.SearchCriteria) ([]*actor.Actor, error) {
+		gotCriteria = criteria
+		return nil, nil
+	}
+	service := NewService(repo)
+
+	query := SearchActorsQuery{
+		Sort: []SortKey{{Field: "birth_year", Dir: "desc"}, {Field: "name"}},
+	}
+
+	if _, err := service.SearchActors(context.Background(), query); err != nil {
+		t.Fatalf("SearchActors() error = %v", err)
+	}
+
+	want := []actor.SortKey{
+		{Field: actor.OrderByBirthYear, Dir: actor.OrderDesc},
+		{Field: actor.OrderByName, Dir: actor.OrderAsc},
+	}
+	if !reflect.DeepEqual(gotCriteria.Sort, want) {
+		t.Errorf("SearchActors() sort = %v, want %v", gotCriteria.Sort, want)
+	}
+
+	if _, err := service.SearchActors(context.Background(), SearchActorsQuery{Sort: []SortKey{{Field: "rating"}}}); err == nil {
+		t.Error("SearchActors() expected error for unsupported sort field")
+	}
+}
+
+func TestService_GetActorsByIDs_InvalidID(t *testing.T) {
+	repo := NewMockActorRepository()
+	service := NewService(repo)
+
+	_, err := service.GetActorsByIDs(context.Background(), []int{1, -1})
+	if err == nil {
+		t.Error("GetActorsByIDs() expected error for invalid ID")
+	}
+}
+
 func TestService_GetActor_NotFound(t *testing.T) {
 	repo := NewMockActorRepository()
 	service := NewService(repo)
@@ -319,6 +477,46 @@ func TestService_DeleteActor(t *testing.T) {
 	}
 }
 
+// recordingPublisher records the types of the domain events it is given
+type recordingPublisher struct {
+	eventTypes []string
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, events []shared.DomainEvent) {
+	for _, event := range events {
+		p.eventTypes = append(p.eventTypes, event.EventType())
+	}
+}
+
+func TestService_PublishesEvents(t *testing.T) {
+	service := NewService(NewMockActorRepository())
+	publisher := &recordingPublisher{}
+	service.SetEventPublisher(publisher)
+	ctx := context.Background()
+
+	created, err := service.CreateActor(ctx, CreateActorCommand{Name: "Robert De Niro", BirthYear: 1943, Bio: "American actor"})
+	if err != nil {
+		t.Fatalf("CreateActor() error = %v", err)
+	}
+	if _, err := service.LinkActorToMovie(ctx, LinkActorCommand{ActorID: created.ID, MovieID: 7}); err != nil {
+		t.Fatalf("LinkActorToMovie() error = %v", err)
+	}
+	if _, err := service.UpdateActor(ctx, UpdateActorCommand{ID: created.ID, Name: "Robert De Niro", BirthYear: 1943}); err != nil {
+		t.Fatalf("UpdateActor() error = %v", err)
+	}
+	if err := service.UnlinkActorFromMovie(ctx, created.ID, 7); err != nil {
+		t.Fatalf("UnlinkActorFromMovie() error = %v", err)
+	}
+	if err := service.DeleteActor(ctx, created.ID); err != nil {
+		t.Fatalf("DeleteActor() error = %v", err)
+	}
+
+	want := []string{"ActorCreated", "ActorLinkedToMovie", "ActorUpdated", "ActorUnlinkedFromMovie", "ActorDeleted"}
+	if !reflect.DeepEqual(publisher.eventTypes, want) {
+		t.Errorf("Expected events %v, got: %v", want, publisher.eventTypes)
+	}
+}
+
 func TestService_LinkActorToMovie(t *testing.T) {
 	repo := NewMockActorRepository()
 	service := NewService(repo)
@@ -335,7 +533,7 @@ func TestService_LinkActorToMovie(t *testing.T) {
 
 	// Link to a movie
 	movieID := 123
-	err = service.LinkActorToMovie(context.Background(), created.ID, movieID)
+	_, err = service.LinkActorToMovie(context.Background(), LinkActorCommand{ActorID: created.ID, MovieID: movieID})
 	if err != nil {
 		t.Fatalf("LinkActorToMovie() error = %v", err)
 	}
@@ -355,6 +553,58 @@ func TestService_LinkActorToMovie(t *testing.T) {
 	}
 }
 
+func TestService_LinkActorToMovie_WithCredit(t *testing.T) {
+	repo := NewMockActorRepository()
+	service := NewService(repo)
+
+	created, err := service.CreateActor(context.Background(), CreateActorCommand{Name: "Keanu Reeves", BirthYear: 1964})
+	if err != nil {
+		t.Fatalf("Failed to create actor: %v", err)
+	}
+
+	linked, err := service.LinkActorToMovie(context.Background(), LinkActorCommand{
+		ActorID:      created.ID,
+		MovieID:      7,
+		Character:    " Neo ",
+		BillingOrder: 1,
+		RoleType:     "Lead",
+	})
+	if err != nil {
+		t.Fatalf("LinkActorToMovie() error = %v", err)
+	}
+
+	want := CreditDTO{MovieID: 7, Character: "Neo", BillingOrder: 1, RoleType: "lead"}
+	if *linked != want {
+		t.Errorf("Expected linked credit %+v, got: %+v", want, *linked)
+	}
+
+	result, err := service.GetActor(context.Background(), created.ID)
+	if err != nil {
+		t.Fatalf("Failed to get actor after linking: %v", err)
+	}
+
+	if len(result.Credits) != 1 || result.Credits[0] != want {
+		t.Errorf("Expected credits [%+v], got: %+v", want, result.Credits)
+	}
+}
+
+func TestService_LinkActorToMovie_InvalidRoleType(t *testing.T) {
+	repo := NewMockActorRepository()
+	service := NewService(repo)
+
+	created, _ := service.CreateActor(context.Background(), CreateActorCommand{Name: "Keanu Reeves", BirthYear: 1964})
+
+	_, err := service.LinkActorToMovie(context.Background(), LinkActorCommand{ActorID: created.ID, MovieID: 7, RoleType: "villain"})
+	if err == nil {
+		t.Fatal("Expected error for invalid role type")
+	}
+
+	result, _ := service.GetActor(context.Background(), created.ID)
+	if len(result.MovieIDs) != 0 {
+		t.Errorf("Expected no link after invalid role type, got: %v", result.MovieIDs)
+	}
+}
+
 func TestService_SearchActors(t *testing.T) {
 	repo := NewMockActorRepository()
 	service := NewService(repo)
@@ -411,8 +661,8 @@ func TestService_GetActorsByMovie(t *testing.T) {
 
 	// Link both actors to the same movie
 	movieID := 123
-	freshService.LinkActorToMovie(context.Background(), actor1.ID, movieID)
-	freshService.LinkActorToMovie(context.Background(), actor2.ID, movieID)
+	_, _ = freshService.LinkActorToMovie(context.Background(), LinkActorCommand{ActorID: actor1.ID, MovieID: movieID})
+	_, _ = freshService.LinkActorToMovie(context.Background(), LinkActorCommand{ActorID: actor2.ID, MovieID: movieID})
 
 	// Get actors by movie
 	results, err := freshService.GetActorsByMovie(context.Background(), movieID)
@@ -467,7 +717,7 @@ func TestService_UnlinkActorFromMovie(t *testing.T) {
 
 	// Link to a movie
 	movieID := 123
-	err = service.LinkActorToMovie(context.Background(), created.ID, movieID)
+	_, err = service.LinkActorToMovie(context.Background(), LinkActorCommand{ActorID: created.ID, MovieID: movieID})
 	if err != nil {
 		t.Fatalf("LinkActorToMovie() error = %v", err)
 	}
@@ -663,7 +913,7 @@ func TestService_LinkActorToMovie_InvalidActorID(t *testing.T) {
 	repo := NewMockActorRepository()
 	service := NewService(repo)
 
-	err := service.LinkActorToMovie(context.Background(), -1, 123)
+	_, err := service.LinkActorToMovie(context.Background(), LinkActorCommand{ActorID: -1, MovieID: 123})
 	if err == nil {
 		t.Error("Expected error for invalid actor ID")
 	}
@@ -673,7 +923,7 @@ func TestService_LinkActorToMovie_InvalidMovieID(t *testing.T) {
 	repo := NewMockActorRepository()
 	service := NewService(repo)
 
-	err := service.LinkActorToMovie(context.Background(), 1, -1)
+	_, err := service.LinkActorToMovie(context.Background(), LinkActorCommand{ActorID: 1, MovieID: -1})
 	if err == nil {
 		t.Error("Expected error for invalid movie ID")
 	}
@@ -683,7 +933,7 @@ func TestService_LinkActorToMovie_ActorNotFound(t *testing.T) {
 	repo := NewMockActorRepository()
 	service := NewService(repo)
 
-	err := service.LinkActorToMovie(context.Background(), 999, 123)
+	_, err := service.LinkActorToMovie(context.Background(), LinkActorCommand{ActorID: 999, MovieID: 123})
 	if err == nil {
 		t.Error("Expected error for non-existent actor")
 	}
@@ -708,7 +958,7 @@ func TestService_LinkActorToMovie_RepositoryError(t *testing.T) {
 		return errors.New("save failed")
 	}
 
-	err = service.LinkActorToMovie(context.Background(), created.ID, 123)
+	_, err = service.LinkActorToMovie(context.Background(), LinkActorCommand{ActorID: created.ID, MovieID: 123})
 	if err == nil {
 		t.Error("Expected error from repository save")
 	}
@@ -839,7 +1089,7 @@ func TestService_SearchActors_ComprehensiveCoverage(t *testing.T) {
 
 		// Link to movies
 		for _, movieID := range actorData.movieIDs {
-			err = service.LinkActorToMovie(context.Background(), created.ID, movieID)
+			_, err = service.LinkActorToMovie(context.Background(), LinkActorCommand{ActorID: created.ID, MovieID: movieID})
 			if err != nil {
 				t.Fatalf("Failed to link actor to movie: %v", err)
 			}
@@ -1115,11 +1365,11 @@ func TestService_UpdateActor_MovieLinks(t *testing.T) {
 	}
 
 	// Link actor to two movies
-	err = service.LinkActorToMovie(context.Background(), created.ID, 123)
+	_, err = service.LinkActorToMovie(context.Background(), LinkActorCommand{ActorID: created.ID, MovieID: 123})
 	if err != nil {
 		t.Fatalf("Failed to link actor to movie 123: %v", err)
 	}
-	err = service.LinkActorToMovie(context.Background(), created.ID, 456)
+	_, err = service.LinkActorToMovie(context.Background(), LinkActorCommand{ActorID: created.ID, MovieID: 456})
 	if err != nil {
 		t.Fatalf("Failed to link actor to movie 456: %v", err)
 	}
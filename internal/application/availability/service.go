@@ -0,0 +1,186 @@
+package availability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/availability"
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/serialization"
+)
+
+// ErrNoSource is returned when a fetch is requested but no external source is configured
+var ErrNoSource = errors.New("no availability source is configured")
+
+// Service provides application-level movie availability operations
+type Service struct {
+	availabilityRepo availability.Repository
+	movieRepo        movie.Reader
+	source           availability.Source
+	now              func() time.Time
+}
+
+// NewService creates a new availability application service
+func NewService(availabilityRepo availability.Repository, movieRepo movie.Reader) *Service {
+	return &Service{
+		availabilityRepo: availabilityRepo,
+		movieRepo:        movieRepo,
+		now:              time.Now,
+	}
+}
+
+// SetSource configures the external catalogue used when a fetch is requested
+func (s *Service) SetSource(source availability.Source) {
+	s.source = source
+}
+
+// HasSource reports whether an external catalogue is configured
+func (s *Service) HasSource() bool {
+	return s.source != nil
+}
+
+// OfferCommand represents one provider offering in an update
+type OfferCommand struct {
+	Provider  string
+	OfferType string // flatrate (default), rent, buy, free or ads
+	URL       string
+}
+
+// UpdateAvailabilityCommand represents the command to replace a movie's availability in a region
+type UpdateAvailabilityCommand struct {
+	MovieID         int
+	Region          string
+	Offers          []OfferCommand
+	FetchFromSource bool // Replace the region with offers from the configured source instead
+}
+
+// OfferDTO represents a provider offering data transfer object
+type OfferDTO struct {
+	Provider    string `json:"provider"`
+	Region      string `json:"region"`
+	OfferType   string `json:"offer_type"`
+	URL         string `json:"url,omitempty"`
+	LastChecked string `json:"last_checked"`
+}
+
+// AvailabilityDTO represents where a movie can be watched
+type AvailabilityDTO struct {
+	MovieID int         `json:"movie_id"`
+	Title   string      `json:"title"`
+	Year    int         `json:"year"`
+	Region  string      `json:"region,omitempty"` // Empty when all regions were requested
+	Offers  []*OfferDTO `json:"offers"`
+}
+
+// UpdateAvailability replaces everything recorded for a movie in a region,
+// either with the given offers or with a fresh lookup from the source.
+// An empty offer list clears the region.
+func (s *Service) UpdateAvailability(ctx context.Context, cmd UpdateAvailabilityCommand) (*AvailabilityDTO, error) {
+	domainMovie, err := s.findMovie(ctx, cmd.MovieID)
+	if err != nil {
+		return nil, err
+	}
+
+	region, err := availability.NormalizeRegion(cmd.Region)
+	if err != nil {
+		return nil, fmt.Errorf("invalid region: %w", err)
+	}
+
+	offers := cmd.Offers
+	if cmd.FetchFromSource {
+		if len(cmd.Offers) > 0 {
+			return nil, shared.NewValidationError("offers cannot be combined with a fetch from the source")
+		}
+		if s.source == nil {
+			return nil, ErrNoSource
+		}
+
+		fetched, err := s.source.Lookup(ctx, domainMovie.Title(), domainMovie.Year().Value(), region)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch availability: %w", err)
+		}
+		offers = make([]OfferCommand, 0, len(fetched))
+		for _, offer := range fetched {
+			offers = append(offers, OfferCommand{
+				Provider:  offer.Provider,
+				OfferType: string(offer.OfferType),
+				URL:       offer.URL,
+			})
+		}
+	}
+
+	checked := s.now()
+	entries := make([]*availability.Availability, 0, len(offers))
+	for i, offer := range offers {
+		entry, err := availability.NewAvailability(domainMovie.ID(), offer.Provider, region, offer.OfferType, offer.URL, checked)
+		if err != nil {
+			return nil, fmt.Errorf("invalid offer %d: %w", i+1, err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := s.availabilityRepo.ReplaceForRegion(ctx, domainMovie.ID(), region, entries); err != nil {
+		return nil, fmt.Errorf("failed to save availability: %w", err)
+	}
+
+	return s.getAvailability(ctx, domainMovie, region)
+}
+
+// GetAvailability retrieves where a movie can be watched. An empty region
+// returns every region on record.
+func (s *Service) GetAvailability(ctx context.Context, movieID int, region string) (*AvailabilityDTO, error) {
+	domainMovie, err := s.findMovie(ctx, movieID)
+	if err != nil {
+		return nil, err
+	}
+
+	if region != "" {
+		region, err = availability.NormalizeRegion(region)
+		if err != nil {
+			return nil, fmt.Errorf("invalid region: %w", err)
+		}
+	}
+
+	return s.getAvailability(ctx, domainMovie, region)
+}
+
+func (s *Service) getAvailability(ctx context.Context, domainMovie *movie.Movie, region string) (*AvailabilityDTO, error) {
+	entries, err := s.availabilityRepo.FindByMovieID(ctx, domainMovie.ID(), region)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get availability: %w", err)
+	}
+
+	dto := &AvailabilityDTO{
+		MovieID: domainMovie.ID().Value(),
+		Title:   domainMovie.Title(),
+		Year:    domainMovie.Year().Value(),
+		Region:  region,
+		Offers:  make([]*OfferDTO, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		dto.Offers = append(dto.Offers, &OfferDTO{
+			Provider:    entry.Provider(),
+			Region:      entry.Region(),
+			OfferType:   string(entry.OfferType()),
+			URL:         entry.URL(),
+			LastChecked: serialization.Timestamp(entry.LastChecked()),
+		})
+	}
+	return dto, nil
+}
+
+func (s *Service) findMovie(ctx context.Context, id int) (*movie.Movie, error) {
+	movieID, err := shared.NewMovieID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid movie ID: %w", err)
+	}
+
+	domainMovie, err := s.movieRepo.FindByID(ctx, movieID)
+	if err != nil {
+		return nil, fmt.Errorf("movie not found: %w", err)
+	}
+	return domainMovie, nil
+}
@@ -0,0 +1,222 @@
+package availability
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/availability"
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// MockMovieReader implements the FindByID part of movie.Reader for testing
+type MockMovieReader struct {
+	movie.Reader
+	movies map[int]*movie.Movie
+}
+
+func (m *MockMovieReader) FindByID(ctx context.Context, id shared.MovieID) (*movie.Movie, error) {
+	if found, exists := m.movies[id.Value()]; exists {
+		return found, nil
+	}
+	return nil, errors.New("movie not found")
+}
+
+// MockAvailabilityRepository implements availability.Repository for testing
+type MockAvailabilityRepository struct {
+	entries     map[string][]*availability.Availability // Keyed by region
+	replaceFunc func(ctx context.Context, movieID shared.MovieID, region string, entries []*availability.Availability) error
+}
+
+func NewMockAvailabilityRepository() *MockAvailabilityRepository {
+	return &MockAvailabilityRepository{entries: make(map[string][]*availability.Availability)}
+}
+
+func (m *MockAvailabilityRepository) FindByMovieID(ctx context.Context, movieID shared.MovieID, region string) ([]*availability.Availability, error) {
+	var result []*availability.Availability
+	for entryRegion, entries := range m.entries {
+		if region == "" || region == entryRegion {
+			result = append(result, entries...)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockAvailabilityRepository) ReplaceForRegion(ctx context.Context, movieID shared.MovieID, region string, entries []*availability.Availability) error {
+	if m.replaceFunc != nil {
+		return m.replaceFunc(ctx, movieID, region, entries)
+	}
+	m.entries[region] = entries
+	return nil
+}
+
+// MockSource implements availability.Source for testing
+type MockSource struct {
+	offers []availability.Offer
+	err    error
+	title  string
+	year   int
+}
+
+func (m *MockSource) Lookup(ctx context.Context, title string, year int, region string) ([]availability.Offer, error) {
+	m.title, m.year = title, year
+	return m.offers, m.err
+}
+
+func newTestService(t *testing.T) (*Service, *MockAvailabilityRepository) {
+	t.Helper()
+
+	movieID, _ := shared.NewMovieID(1)
+	matrix, err := movie.NewMovieWithID(movieID, "The Matrix", "Lana Wachowski", 1999)
+	if err != nil {
+		t.Fatalf("failed to create movie: %v", err)
+	}
+
+	repo := NewMockAvailabilityRepository()
+	service := NewService(repo, &MockMovieReader{movies: map[int]*movie.Movie{1: matrix}})
+	service.now = func() time.Time { return time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC) }
+	return service, repo
+}
+
+func TestService_UpdateAvailability(t *testing.T) {
+	service, repo := newTestService(t)
+
+	result, err := service.UpdateAvailability(context.Background(), UpdateAvailabilityCommand{
+		MovieID: 1,
+		Region:  "us",
+		Offers: []OfferCommand{
+			{Provider: "Netflix", URL: "https://netflix.com/title/1"},
+			{Provider: "Apple TV", OfferType: "rent"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(repo.entries["US"]) != 2 {
+		t.Fatalf("Expected 2 entries saved for US, got: %d", len(repo.entries["US"]))
+	}
+	if result.Title != "The Matrix" || result.Region != "US" {
+		t.Errorf("Expected The Matrix in US, got: %s in %s", result.Title, result.Region)
+	}
+	if len(result.Offers) != 2 || result.Offers[0].OfferType != "flatrate" {
+		t.Errorf("Expected default flatrate offer, got: %+v", result.Offers)
+	}
+	if result.Offers[0].LastChecked != "2024-05-01T12:00:00Z" {
+		t.Errorf("Expected last checked to be now, got: %s", result.Offers[0].LastChecked)
+	}
+}
+
+func TestService_UpdateAvailability_Validation(t *testing.T) {
+	service, _ := newTestService(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		cmd  UpdateAvailabilityCommand
+	}{
+		{name: "unknown movie", cmd: UpdateAvailabilityCommand{MovieID: 99, Region: "US"}},
+		{name: "invalid region", cmd: UpdateAvailabilityCommand{MovieID: 1, Region: "United States"}},
+		{name: "invalid offer type", cmd: UpdateAvailabilityCommand{MovieID: 1, Region: "US", Offers: []OfferCommand{{Provider: "Netflix", OfferType: "lease"}}}},
+		{name: "offers with fetch", cmd: UpdateAvailabilityCommand{MovieID: 1, Region: "US", FetchFromSource: true, Offers: []OfferCommand{{Provider: "Netflix"}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := service.UpdateAvailability(ctx, tt.cmd); err == nil {
+				t.Error("Expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestService_UpdateAvailability_FetchFromSource(t *testing.T) {
+	service, repo := newTestService(t)
+	source := &MockSource{offers: []availability.Offer{
+		{Provider: "Max", OfferType: availability.OfferFlatrate, URL: "https://www.themoviedb.org/movie/603/watch"},
+	}}
+	service.SetSource(source)
+
+	result, err := service.UpdateAvailability(context.Background(), UpdateAvailabilityCommand{
+		MovieID:         1,
+		Region:          "GB",
+		FetchFromSource: true,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if source.title != "The Matrix" || source.year != 1999 {
+		t.Errorf("Expected lookup by title and year, got: %s (%d)", source.title, source.year)
+	}
+	if len(repo.entries["GB"]) != 1 || len(result.Offers) != 1 || result.Offers[0].Provider != "Max" {
+		t.Errorf("Expected fetched offer to be saved, got: %+v", result.Offers)
+	}
+}
+
+func TestService_UpdateAvailability_NoSource(t *testing.T) {
+	service, _ := newTestService(t)
+
+	_, err := service.UpdateAvailability(context.Background(), UpdateAvailabilityCommand{
+		MovieID:         1,
+		Region:          "US",
+		FetchFromSource: true,
+	})
+	if !errors.Is(err, ErrNoSource) {
+		t.Errorf("Expected ErrNoSource, got: %v", err)
+	}
+}
+
+func TestService_UpdateAvailability_SourceError(t *testing.T) {
+	service, repo := newTestService(t)
+	service.SetSource(&MockSource{err: errors.New("rate limited")})
+
+	_, err := service.UpdateAvailability(context.Background(), UpdateAvailabilityCommand{
+		MovieID:         1,
+		Region:          "US",
+		FetchFromSource: true,
+	})
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if _, saved := repo.entries["US"]; saved {
+		t.Error("Expected nothing to be saved when the fetch fails")
+	}
+}
+
+func TestService_GetAvailability(t *testing.T) {
+	service, _ := newTestService(t)
+	ctx := context.Background()
+
+	for _, region := range []string{"US", "GB"} {
+		if _, err := service.UpdateAvailability(ctx, UpdateAvailabilityCommand{
+			MovieID: 1,
+			Region:  region,
+			Offers:  []OfferCommand{{Provider: "Netflix"}},
+		}); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+	}
+
+	all, err := service.GetAvailability(ctx, 1, "")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(all.Offers) != 2 || all.Region != "" {
+		t.Errorf("Expected 2 offers across regions, got: %d", len(all.Offers))
+	}
+
+	gb, err := service.GetAvailability(ctx, 1, "gb")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(gb.Offers) != 1 || gb.Offers[0].Region != "GB" {
+		t.Errorf("Expected 1 offer in GB, got: %+v", gb.Offers)
+	}
+
+	if _, err := service.GetAvailability(ctx, 99, ""); err == nil {
+		t.Error("Expected error for unknown movie")
+	}
+}
@@ -0,0 +1,157 @@
+// Package events records the data changes made by mutating tools and hands
+// them to subscribers, such as webhooks, without holding up the tool call.
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+// DeliveryStatus describes where an event's delivery to one webhook is
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliveryDelivered DeliveryStatus = "delivered"
+	DeliveryFailed    DeliveryStatus = "failed"
+)
+
+// Event is a notification that a mutating tool changed data
+type Event struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"` // e.g. "movie.created"
+	Tool       string          `json:"tool"`
+	Tenant     string          `json:"tenant,omitempty"` // Whose library changed; empty for the default one
+	Data       json.RawMessage `json:"data"`             // The tool's structured output
+	OccurredAt time.Time       `json:"occurred_at"`
+}
+
+// Delivery is the state of an event's delivery to one webhook
+type Delivery struct {
+	URL        string         `json:"url"`
+	Status     DeliveryStatus `json:"status"`
+	Attempts   int            `json:"attempts"`
+	StatusCode int            `json:"status_code,omitempty"` // Of the last response
+	LastError  string         `json:"last_error,omitempty"`
+}
+
+// Record is a published event and the state of its deliveries
+type Record struct {
+	Event
+	Deliveries []Delivery `json:"deliveries"`
+}
+
+// Subscriber receives every published event. Notify is called while
+// publishing, so it must hand slow work off instead of doing it inline.
+type Subscriber interface {
+	Notify(event Event)
+}
+
+// Bus publishes events to its subscribers and keeps the most recent ones,
+// with their delivery state, for inspection
+type Bus struct {
+	mutex       sync.RWMutex
+	history     int
+	records     []*Record // Oldest first
+	subscribers []Subscriber
+}
+
+// NewBus creates a bus remembering the last history events; a non-positive
+// history keeps 100
+func NewBus(history int) *Bus {
+	if history <= 0 {
+		history = 100
+	}
+	return &Bus{
+		history: history,
+	}
+}
+
+// Subscribe registers a subscriber for events published from now on
+func (b *Bus) Subscribe(subscriber Subscriber) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.subscribers = append(b.subscribers, subscriber)
+}
+
+// Publish records an event of the given type for the tenant ctx is served
+// for and notifies the subscribers; data is stored as JSON, and data that
+// cannot be encoded is stored as null
+func (b *Bus) Publish(ctx context.Context, eventType, tool string, data any) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		encoded = json.RawMessage("null")
+	}
+	event := Event{
+		ID:         uuid.NewString(),
+		Type:       eventType,
+		Tool:       tool,
+		Tenant:     database.TenantFromContext(ctx),
+		Data:       encoded,
+		OccurredAt: time.Now().UTC(),
+	}
+
+	b.mutex.Lock()
+	b.records = append(b.records, &Record{Event: event, Deliveries: []Delivery{}})
+	if overflow := len(b.records) - b.history; overflow > 0 {
+		b.records = slices.Delete(b.records, 0, overflow)
+	}
+	subscribers := slices.Clone(b.subscribers)
+	b.mutex.Unlock()
+
+	for _, subscriber := range subscribers {
+		subscriber.Notify(event)
+	}
+}
+
+// Recent returns up to limit of the tenant's most recent events, newest
+// first, optionally only those of eventType; a non-positive limit returns
+// all kept. The default library's events have the tenant "".
+func (b *Bus) Recent(tenant string, limit int, eventType string) []Record {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	records := []Record{}
+	for i := len(b.records) - 1; i >= 0; i-- {
+		if limit > 0 && len(records) == limit {
+			break
+		}
+		record := b.records[i]
+		if record.Tenant != tenant || (eventType != "" && record.Type != eventType) {
+			continue
+		}
+		records = append(records, Record{Event: record.Event, Deliveries: slices.Clone(record.Deliveries)})
+	}
+	return records
+}
+
+// updateDelivery applies update to the delivery of an event to url, adding
+// the delivery if needed; events no longer kept are ignored
+func (b *Bus) updateDelivery(eventID, url string, update func(*Delivery)) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for i := len(b.records) - 1; i >= 0; i-- {
+		record := b.records[i]
+		if record.ID != eventID {
+			continue
+		}
+		for j := range record.Deliveries {
+			if record.Deliveries[j].URL == url {
+				update(&record.Deliveries[j])
+				return
+			}
+		}
+		record.Deliveries = append(record.Deliveries, Delivery{URL: url, Status: DeliveryPending})
+		update(&record.Deliveries[len(record.Deliveries)-1])
+		return
+	}
+}
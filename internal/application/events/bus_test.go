@@ -0,0 +1,132 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+// MockSubscriber records the events it is notified of
+type MockSubscriber struct {
+	events []Event
+}
+
+func (m *MockSubscriber) Notify(event Event) {
+	m.events = append(m.events, event)
+}
+
+func TestBus_Publish(t *testing.T) {
+	// Arrange
+	bus := NewBus(10)
+	subscriber := &MockSubscriber{}
+	bus.Subscribe(subscriber)
+
+	// Act
+	bus.Publish(context.Background(), "movie.created", "add_movie", map[string]any{"id": 7})
+
+	// Assert
+	if len(subscriber.events) != 1 {
+		t.Fatalf("Expected 1 notification, got: %d", len(subscriber.events))
+	}
+	event := subscriber.events[0]
+	if event.ID == "" || event.Type != "movie.created" || event.Tool != "add_movie" {
+		t.Errorf("Unexpected event: %+v", event)
+	}
+	if string(event.Data) != `{"id":7}` {
+		t.Errorf("Expected the data as JSON, got: %s", event.Data)
+	}
+	if event.OccurredAt.IsZero() {
+		t.Error("Expected the event to be timestamped")
+	}
+}
+
+func TestBus_Publish_UnencodableData(t *testing.T) {
+	bus := NewBus(10)
+
+	bus.Publish(context.Background(), "movie.created", "add_movie", func() {})
+
+	records := bus.Recent("", 0, "")
+	if len(records) != 1 || string(records[0].Data) != "null" {
+		t.Errorf("Expected the event to be kept with null data, got: %+v", records)
+	}
+}
+
+func TestBus_Recent(t *testing.T) {
+	// Arrange
+	bus := NewBus(3)
+	for _, eventType := range []string{"movie.created", "actor.created", "movie.updated", "movie.deleted"} {
+		bus.Publish(context.Background(), eventType, "tool", nil)
+	}
+
+	tests := []struct {
+		name      string
+		limit     int
+		eventType string
+		expected  []string
+	}{
+		{name: "all kept, newest first", expected: []string{"movie.deleted", "movie.updated", "actor.created"}},
+		{name: "limited", limit: 2, expected: []string{"movie.deleted", "movie.updated"}},
+		{name: "by type", eventType: "actor.created", expected: []string{"actor.created"}},
+		{name: "trimmed type", eventType: "movie.created", expected: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			records := bus.Recent("", tt.limit, tt.eventType)
+
+			// Assert
+			types := []string{}
+			for _, record := range records {
+				types = append(types, record.Type)
+			}
+			got, _ := json.Marshal(types)
+			want, _ := json.Marshal(tt.expected)
+			if string(got) != string(want) {
+				t.Errorf("Expected %s, got: %s", want, got)
+			}
+		})
+	}
+}
+
+func TestBus_Recent_ByTenant(t *testing.T) {
+	bus := NewBus(10)
+	subscriber := &MockSubscriber{}
+	bus.Subscribe(subscriber)
+	acme := database.WithTenant(context.Background(), "acme", nil)
+	globex := database.WithTenant(context.Background(), "globex", nil)
+
+	bus.Publish(context.Background(), "movie.created", "add_movie", nil)
+	bus.Publish(acme, "movie.updated", "update_movie", nil)
+	bus.Publish(globex, "movie.deleted", "delete_movie", nil)
+
+	if tenant := subscriber.events[1].Tenant; tenant != "acme" {
+		t.Errorf("Expected the event recorded for acme, got: %q", tenant)
+	}
+	for tenant, want := range map[string]string{"": "movie.created", "acme": "movie.updated", "globex": "movie.deleted"} {
+		records := bus.Recent(tenant, 0, "")
+		if len(records) != 1 || records[0].Type != want || records[0].Tenant != tenant {
+			t.Errorf("Expected only %s for tenant %q, got: %+v", want, tenant, records)
+		}
+	}
+	if records := bus.Recent("initech", 0, ""); len(records) != 0 {
+		t.Errorf("Expected no events for a tenant without changes, got: %+v", records)
+	}
+}
+
+func TestBus_Recent_CopiesDeliveries(t *testing.T) {
+	bus := NewBus(10)
+	bus.Publish(context.Background(), "movie.created", "add_movie", nil)
+	id := bus.Recent("", 1, "")[0].ID
+	bus.updateDelivery(id, "https://hooks.example.com", func(d *Delivery) { d.Attempts = 1 })
+
+	records := bus.Recent("", 1, "")
+	records[0].Deliveries[0].Attempts = 99
+
+	delivery := bus.Recent("", 1, "")[0].Deliveries[0]
+	if delivery.Attempts != 1 || delivery.Status != DeliveryPending {
+		t.Errorf("Expected the kept delivery to be unchanged, got: %+v", delivery)
+	}
+}
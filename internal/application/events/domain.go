@@ -0,0 +1,115 @@
+package events
+
+import (
+	"context"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/actor"
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// DomainEventTypes maps the domain events recorded by the movie and actor
+// aggregates to the event type each is published as. The other domain
+// events, such as MovieGenreAdded, are part of an update and are covered by
+// its event.
+var DomainEventTypes = map[string]string{
+	"MovieCreated":       "movie.created",
+	"MovieUpdated":       "movie.updated",
+	"MovieDeleted":       "movie.deleted",
+	"MovieRatingChanged": "movie.rating_changed",
+
+	"ActorCreated":           "actor.created",
+	"ActorUpdated":           "actor.updated",
+	"ActorDeleted":           "actor.deleted",
+	"ActorLinkedToMovie":     "actor.linked",
+	"ActorUnlinkedFromMovie": "actor.unlinked",
+}
+
+// toolKey is the context key of the tool a call runs
+type toolKey struct{}
+
+// WithTool returns a copy of ctx for a call of tool, so the domain events
+// published during the call name the tool that raised them
+func WithTool(ctx context.Context, tool string) context.Context {
+	return context.WithValue(ctx, toolKey{}, tool)
+}
+
+// toolFrom returns the tool ctx was created for, or "" outside a tool call
+func toolFrom(ctx context.Context) string {
+	tool, _ := ctx.Value(toolKey{}).(string)
+	return tool
+}
+
+// DomainPublisher publishes the domain events of saved aggregates to a bus.
+// Set it as the event publisher of the movie and actor services.
+type DomainPublisher struct {
+	bus *Bus
+}
+
+// NewDomainPublisher creates a publisher of domain events to bus
+func NewDomainPublisher(bus *Bus) *DomainPublisher {
+	return &DomainPublisher{
+		bus: bus,
+	}
+}
+
+// Publish publishes each event with a type in DomainEventTypes. Events
+// raised during a call of a tool in ToolEventTypes are left out: those
+// tools, such as seed_database, publish one event for all their changes.
+func (p *DomainPublisher) Publish(ctx context.Context, domainEvents []shared.DomainEvent) {
+	tool := toolFrom(ctx)
+	if _, ok := ToolEventTypes[tool]; ok {
+		return
+	}
+
+	for _, event := range domainEvents {
+		if eventType, ok := DomainEventTypes[event.EventType()]; ok {
+			p.bus.Publish(ctx, eventType, tool, domainEventData(event))
+		}
+	}
+}
+
+// domainEventData returns the data an event is published with, keyed like
+// the movie and actor tool results
+func domainEventData(event shared.DomainEvent) map[string]any {
+	switch event := event.(type) {
+	case *movie.MovieCreatedEvent:
+		return movieData(event.MovieID, event.Title, event.Director, event.Year, event.Rating, event.Genres, event.PosterURL)
+	case *movie.MovieUpdatedEvent:
+		return movieData(event.MovieID, event.Title, event.Director, event.Year, event.Rating, event.Genres, event.PosterURL)
+	case *movie.MovieDeletedEvent:
+		return map[string]any{"id": event.MovieID.Value(), "title": event.Title}
+	case *movie.MovieRatingChangedEvent:
+		return map[string]any{"id": event.MovieID.Value(), "old_rating": event.OldRating.Value(), "new_rating": event.NewRating.Value()}
+	case *actor.ActorCreatedEvent:
+		return map[string]any{"id": event.ActorID.Value(), "name": event.Name, "birth_year": event.BirthYear.Value()}
+	case *actor.ActorUpdatedEvent:
+		return map[string]any{"id": event.ActorID.Value(), "name": event.Name, "birth_year": event.BirthYear.Value()}
+	case *actor.ActorDeletedEvent:
+		return map[string]any{"id": event.ActorID.Value(), "name": event.Name}
+	case *actor.ActorLinkedToMovieEvent:
+		return map[string]any{"actor_id": event.ActorID.Value(), "movie_id": event.MovieID.Value()}
+	case *actor.ActorUnlinkedFromMovieEvent:
+		return map[string]any{"actor_id": event.ActorID.Value(), "movie_id": event.MovieID.Value()}
+	}
+	return map[string]any{"id": event.AggregateID()}
+}
+
+// movieData returns the data of a created or updated movie
+func movieData(id shared.MovieID, title, director string, year shared.Year, rating shared.Rating, genres []string, posterURL string) map[string]any {
+	if genres == nil {
+		genres = []string{}
+	}
+	data := map[string]any{
+		"id":       id.Value(),
+		"title":    title,
+		"director": director,
+		"year":     year.Value(),
+		"rating":   rating.Value(),
+		"genres":   genres,
+	}
+	if posterURL != "" {
+		data["poster_url"] = posterURL
+	}
+	return data
+}
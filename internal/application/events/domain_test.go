@@ -0,0 +1,84 @@
+package events
+
+import (
+	"context"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/actor"
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+func TestDomainPublisher_Publish(t *testing.T) {
+	// Arrange
+	bus := NewBus(10)
+	subscriber := &MockSubscriber{}
+	bus.Subscribe(subscriber)
+
+	movieID := mustMovieID(t, 7)
+	actorID, _ := shared.NewActorID(3)
+	oldRating, _ := shared.NewRating(7.5)
+	newRating, _ := shared.NewRating(8.5)
+	domainMovie, _ := movie.NewMovieWithID(movieID, "Heat", "Michael Mann", 1995)
+
+	// Act
+	NewDomainPublisher(bus).Publish(WithTool(context.Background(), "update_movie"), []shared.DomainEvent{
+		movie.NewMovieRatingChangedEvent(movieID, oldRating, newRating, 1),
+		movie.NewMovieGenreAddedEvent(movieID, "Crime", 2),
+		movie.NewMovieUpdatedEvent(domainMovie, 3),
+		actor.NewActorLinkedToMovieEvent(actorID, movieID, 1),
+	})
+
+	// Assert
+	if len(subscriber.events) != 3 {
+		t.Fatalf("Expected the mapped events only, got: %+v", subscriber.events)
+	}
+	want := []struct{ eventType, data string }{
+		{"movie.rating_changed", `{"id":7,"new_rating":8.5,"old_rating":7.5}`},
+		{"movie.updated", `{"director":"Michael Mann","genres":[],"id":7,"rating":0,"title":"Heat","year":1995}`},
+		{"actor.linked", `{"actor_id":3,"movie_id":7}`},
+	}
+	for i, event := range subscriber.events {
+		if event.Type != want[i].eventType || event.Tool != "update_movie" || string(event.Data) != want[i].data {
+			t.Errorf("Event %d: expected %s from update_movie with %s, got: %s from %q with %s", i, want[i].eventType, want[i].data, event.Type, event.Tool, event.Data)
+		}
+	}
+}
+
+func TestDomainPublisher_Publish_OutsideToolCall(t *testing.T) {
+	bus := NewBus(10)
+
+	NewDomainPublisher(bus).Publish(context.Background(), []shared.DomainEvent{
+		movie.NewMovieDeletedEvent(mustMovieID(t, 7), "Heat", 1),
+	})
+
+	records := bus.Recent("", 0, "")
+	if len(records) != 1 || records[0].Type != "movie.deleted" || records[0].Tool != "" {
+		t.Fatalf("Expected movie.deleted with no tool, got: %+v", records)
+	}
+	if string(records[0].Data) != `{"id":7,"title":"Heat"}` {
+		t.Errorf("Expected the deleted movie's ID and title, got: %s", records[0].Data)
+	}
+}
+
+func TestDomainPublisher_Publish_LeftToToolEvent(t *testing.T) {
+	bus := NewBus(10)
+	domainMovie, _ := movie.NewMovieWithID(mustMovieID(t, 7), "Heat", "Michael Mann", 1995)
+
+	NewDomainPublisher(bus).Publish(WithTool(context.Background(), "seed_database"), []shared.DomainEvent{
+		movie.NewMovieCreatedEvent(domainMovie, 1),
+	})
+
+	if records := bus.Recent("", 0, ""); len(records) != 0 {
+		t.Errorf("Expected seed_database to publish its own event only, got: %+v", records)
+	}
+}
+
+func mustMovieID(t *testing.T, id int) shared.MovieID {
+	t.Helper()
+	movieID, err := shared.NewMovieID(id)
+	if err != nil {
+		t.Fatalf("NewMovieID(%d) error = %v", id, err)
+	}
+	return movieID
+}
@@ -0,0 +1,39 @@
+package events
+
+// ToolEventTypes maps each mutating tool to the event type it publishes
+// when it succeeds. Read-only tools publish nothing. Tools that create,
+// update, delete or link a single movie or actor are not listed: the
+// aggregates record those changes and DomainPublisher publishes them.
+var ToolEventTypes = map[string]string{
+	// Movies
+	"bulk_movie_import":       "movies.imported",
+	"import_movies_ndjson":    "movies.imported",
+	"bulk_update_movies":      "movies.updated",
+	"revert_movie_to_version": "movie.reverted",
+	"queue_movie_write":       "movie.write_queued",
+	"sync_from_remote":        "movies.synced",
+
+	// Franchises
+	"create_franchise":            "franchise.created",
+	"update_franchise":            "franchise.updated",
+	"delete_franchise":            "franchise.deleted",
+	"add_movie_to_franchise":      "franchise.movie_added",
+	"remove_movie_from_franchise": "franchise.movie_removed",
+
+	// Tags
+	"add_tag":     "tag.created",
+	"tag_movie":   "movie.tagged",
+	"untag_movie": "movie.untagged",
+
+	// Catalog details
+	"update_availability": "availability.updated",
+	"add_translation":     "translation.added",
+	"summarize_movie":     "translation.generated",
+	"add_movie_media":     "media.added",
+	"upload_movie_poster": "poster.uploaded",
+	"delete_movie_poster": "poster.deleted",
+
+	// Database
+	"seed_database":    "database.seeded",
+	"restore_database": "database.restored",
+}
@@ -0,0 +1,227 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/pkg/httpclient"
+)
+
+// Headers sent with every webhook delivery
+const (
+	HeaderEvent     = "X-Movies-Event"     // The event type
+	HeaderDelivery  = "X-Movies-Delivery"  // The event ID, the same for every attempt
+	HeaderTenant    = "X-Movies-Tenant"    // The tenant whose library changed; absent for the default one
+	HeaderTimestamp = "X-Movies-Timestamp" // Unix seconds when the attempt was signed
+	HeaderSignature = "X-Movies-Signature" // "sha256=" and the hex HMAC, when a secret is set
+)
+
+// WebhookConfig controls webhook delivery
+type WebhookConfig struct {
+	URLs         []string
+	Secret       string        // Signs payloads with HMAC-SHA256 when set
+	MaxAttempts  int           // Attempts per event and webhook before giving up
+	RetryBackoff time.Duration // Delay before the first redelivery, doubled for each further one
+	QueueSize    int           // Maximum deliveries waiting to be sent
+}
+
+// delivery is one attempt to send an event to a webhook
+type delivery struct {
+	event   Event
+	url     string
+	attempt int
+}
+
+// Webhooks delivers events to webhook URLs on a background worker. Failed
+// deliveries are queued again with exponential backoff, and their state is
+// recorded on the bus so list_recent_events shows what consumers received.
+type Webhooks struct {
+	bus    *Bus
+	client *httpclient.Client
+	config WebhookConfig
+	queue  chan delivery
+
+	mutex    sync.Mutex
+	started  bool
+	closed   bool
+	stop     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewWebhooks creates a webhook dispatcher for the bus's events; subscribe
+// it to the bus and call Start to begin delivering
+func NewWebhooks(bus *Bus, client *httpclient.Client, cfg WebhookConfig) *Webhooks {
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 5
+	}
+	if cfg.RetryBackoff <= 0 {
+		cfg.RetryBackoff = time.Second
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = 1000
+	}
+
+	return &Webhooks{
+		bus:    bus,
+		client: client,
+		config: cfg,
+		queue:  make(chan delivery, cfg.QueueSize),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+}
+
+// Start launches the delivery worker, which runs until ctx is cancelled or
+// Close is called
+func (w *Webhooks) Start(ctx context.Context) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.started || w.closed {
+		return
+	}
+	w.started = true
+	go w.run(ctx)
+}
+
+// Close stops the worker after its current delivery; events not yet
+// delivered stay pending
+func (w *Webhooks) Close() {
+	w.stopOnce.Do(func() {
+		w.mutex.Lock()
+		w.closed = true
+		started := w.started
+		close(w.stop)
+		w.mutex.Unlock()
+
+		if started {
+			<-w.done
+		}
+	})
+}
+
+// Notify queues an event for delivery to every webhook
+func (w *Webhooks) Notify(event Event) {
+	for _, url := range w.config.URLs {
+		w.bus.updateDelivery(event.ID, url, func(*Delivery) {})
+		w.enqueue(delivery{event: event, url: url, attempt: 1})
+	}
+}
+
+// enqueue adds a delivery to the queue, failing it when the queue is full
+// or closed
+func (w *Webhooks) enqueue(d delivery) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.closed {
+		return
+	}
+	select {
+	case w.queue <- d:
+	default:
+		w.bus.updateDelivery(d.event.ID, d.url, func(delivery *Delivery) {
+			delivery.Status = DeliveryFailed
+			delivery.LastError = "delivery queue is full"
+		})
+	}
+}
+
+func (w *Webhooks) run(ctx context.Context) {
+	defer close(w.done)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.stop:
+			return
+		case d := <-w.queue:
+			w.deliver(ctx, d)
+		}
+	}
+}
+
+// deliver sends one attempt and records the outcome, scheduling another
+// attempt after a failure until MaxAttempts is reached
+func (w *Webhooks) deliver(ctx context.Context, d delivery) {
+	statusCode, err := w.send(ctx, d)
+	w.bus.updateDelivery(d.event.ID, d.url, func(delivery *Delivery) {
+		delivery.Attempts = d.attempt
+		delivery.StatusCode = statusCode
+		switch {
+		case err == nil:
+			delivery.Status = DeliveryDelivered
+			delivery.LastError = ""
+		case d.attempt >= w.config.MaxAttempts:
+			delivery.Status = DeliveryFailed
+			delivery.LastError = err.Error()
+		default:
+			delivery.LastError = err.Error()
+		}
+	})
+	if err == nil || d.attempt >= w.config.MaxAttempts {
+		return
+	}
+
+	backoff := w.config.RetryBackoff << (d.attempt - 1)
+	time.AfterFunc(min(backoff, time.Hour), func() {
+		w.enqueue(delivery{event: d.event, url: d.url, attempt: d.attempt + 1})
+	})
+}
+
+// send posts an event to a webhook, returning the response status
+func (w *Webhooks) send(ctx context.Context, d delivery) (int, error) {
+	body, err := json.Marshal(d.event)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("invalid webhook URL: %w", err)
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, d.event.Type)
+	req.Header.Set(HeaderDelivery, d.event.ID)
+	if d.event.Tenant != "" {
+		req.Header.Set(HeaderTenant, d.event.Tenant)
+	}
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	if w.config.Secret != "" {
+		req.Header.Set(HeaderSignature, Sign(w.config.Secret, timestamp, body))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook responded with HTTP %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// Sign returns the X-Movies-Signature value for a payload: "sha256=" and
+// the hex HMAC-SHA256 of the timestamp, a dot and the body, keyed by secret.
+// Consumers recompute it to verify a delivery came from this server.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
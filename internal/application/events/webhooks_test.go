@@ -0,0 +1,218 @@
+package events
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+	"github.com/francknouama/movies-mcp-server/pkg/httpclient"
+)
+
+// waitForDelivery polls the bus until the event's delivery to url is no
+// longer pending
+func waitForDelivery(t *testing.T, bus *Bus, url string) Delivery {
+	t.Helper()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		records := bus.Recent("", 1, "")
+		if len(records) == 1 {
+			for _, delivery := range records[0].Deliveries {
+				if delivery.URL == url && delivery.Status != DeliveryPending {
+					return delivery
+				}
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("Timed out waiting for the delivery to %s", url)
+	return Delivery{}
+}
+
+func newWebhooks(t *testing.T, bus *Bus, cfg WebhookConfig) *Webhooks {
+	t.Helper()
+
+	client := httpclient.New(&http.Client{Timeout: time.Second}, httpclient.Config{MaxRetries: -1})
+	webhooks := NewWebhooks(bus, client, cfg)
+	bus.Subscribe(webhooks)
+	webhooks.Start(context.Background())
+	t.Cleanup(webhooks.Close)
+	return webhooks
+}
+
+func TestWebhooks_DeliversSignedEvent(t *testing.T) {
+	// Arrange
+	var mutex sync.Mutex
+	var header http.Header
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		header = r.Header.Clone()
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	bus := NewBus(10)
+	newWebhooks(t, bus, WebhookConfig{URLs: []string{server.URL}, Secret: "s3cret"})
+
+	// Act
+	bus.Publish(context.Background(), "movie.created", "add_movie", map[string]any{"id": 7})
+	delivery := waitForDelivery(t, bus, server.URL)
+
+	// Assert
+	if delivery.Status != DeliveryDelivered || delivery.Attempts != 1 || delivery.StatusCode != http.StatusOK {
+		t.Fatalf("Expected a delivered first attempt, got: %+v", delivery)
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	event := bus.Recent("", 1, "")[0]
+	if header.Get(HeaderEvent) != "movie.created" || header.Get(HeaderDelivery) != event.ID {
+		t.Errorf("Unexpected event headers: %v", header)
+	}
+	timestamp, err := strconv.ParseInt(header.Get(HeaderTimestamp), 10, 64)
+	if err != nil {
+		t.Fatalf("Expected a unix timestamp header, got: %q", header.Get(HeaderTimestamp))
+	}
+	if header.Get(HeaderSignature) != Sign("s3cret", timestamp, body) {
+		t.Errorf("Expected the body to be signed, got: %q", header.Get(HeaderSignature))
+	}
+}
+
+func TestWebhooks_UnsignedWithoutSecret(t *testing.T) {
+	var signature atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signature.Store(r.Header.Get(HeaderSignature))
+	}))
+	defer server.Close()
+
+	bus := NewBus(10)
+	newWebhooks(t, bus, WebhookConfig{URLs: []string{server.URL}})
+
+	bus.Publish(context.Background(), "movie.deleted", "delete_movie", nil)
+	waitForDelivery(t, bus, server.URL)
+
+	if signature.Load() != "" {
+		t.Errorf("Expected no signature header, got: %v", signature.Load())
+	}
+}
+
+func TestWebhooks_NamesTenant(t *testing.T) {
+	received := make(chan http.Header, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- r.Header.Clone()
+	}))
+	defer server.Close()
+
+	bus := NewBus(10)
+	newWebhooks(t, bus, WebhookConfig{URLs: []string{server.URL}})
+
+	bus.Publish(database.WithTenant(context.Background(), "acme", nil), "movie.created", "add_movie", nil)
+	bus.Publish(context.Background(), "movie.created", "add_movie", nil)
+
+	for _, want := range []string{"acme", ""} {
+		select {
+		case header := <-received:
+			if got := header.Get(HeaderTenant); got != want {
+				t.Errorf("Expected tenant header %q, got: %q", want, got)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatal("Timed out waiting for a delivery")
+		}
+	}
+}
+
+func TestWebhooks_RetriesFailedDelivery(t *testing.T) {
+	// Arrange
+	var calls atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= 2 {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	bus := NewBus(10)
+	newWebhooks(t, bus, WebhookConfig{URLs: []string{server.URL}, RetryBackoff: time.Millisecond})
+
+	// Act
+	bus.Publish(context.Background(), "actor.linked", "link_actor_to_movie", nil)
+	delivery := waitForDelivery(t, bus, server.URL)
+
+	// Assert
+	if delivery.Status != DeliveryDelivered || delivery.Attempts != 3 {
+		t.Errorf("Expected delivery on the third attempt, got: %+v", delivery)
+	}
+	if delivery.LastError != "" {
+		t.Errorf("Expected the last error to be cleared, got: %q", delivery.LastError)
+	}
+}
+
+func TestWebhooks_GivesUpAfterMaxAttempts(t *testing.T) {
+	var calls atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	bus := NewBus(10)
+	newWebhooks(t, bus, WebhookConfig{URLs: []string{server.URL}, MaxAttempts: 2, RetryBackoff: time.Millisecond})
+
+	bus.Publish(context.Background(), "movie.updated", "update_movie", nil)
+	delivery := waitForDelivery(t, bus, server.URL)
+
+	if delivery.Status != DeliveryFailed || delivery.Attempts != 2 || delivery.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Expected a failed delivery after 2 attempts, got: %+v", delivery)
+	}
+	if delivery.LastError == "" {
+		t.Error("Expected the last error to be recorded")
+	}
+	time.Sleep(20 * time.Millisecond)
+	if calls.Load() != 2 {
+		t.Errorf("Expected no attempts after giving up, got %d calls", calls.Load())
+	}
+}
+
+func TestWebhooks_FailsWhenQueueIsFull(t *testing.T) {
+	// Not started, so nothing drains the single-slot queue
+	bus := NewBus(10)
+	webhooks := NewWebhooks(bus, httpclient.New(nil, httpclient.Config{}), WebhookConfig{
+		URLs:      []string{"https://a.example.com", "https://b.example.com"},
+		QueueSize: 1,
+	})
+	bus.Subscribe(webhooks)
+
+	bus.Publish(context.Background(), "movie.created", "add_movie", nil)
+
+	deliveries := bus.Recent("", 1, "")[0].Deliveries
+	if len(deliveries) != 2 {
+		t.Fatalf("Expected a delivery per webhook, got: %+v", deliveries)
+	}
+	if deliveries[0].Status != DeliveryPending {
+		t.Errorf("Expected the queued delivery to be pending, got: %+v", deliveries[0])
+	}
+	if deliveries[1].Status != DeliveryFailed || deliveries[1].LastError != "delivery queue is full" {
+		t.Errorf("Expected the overflowing delivery to fail, got: %+v", deliveries[1])
+	}
+}
+
+func TestSign(t *testing.T) {
+	// HMAC-SHA256 of "1700000000.{}" keyed by "secret"
+	expected := "sha256=b8569b78799ff9e3cbff0fc2d63a33a2b57f3282abd07c37ae5e8e7d79a5f163"
+
+	if got := Sign("secret", 1700000000, []byte("{}")); got != expected {
+		t.Errorf("Expected %s, got: %s", expected, got)
+	}
+	if Sign("secret", 1700000001, []byte("{}")) == expected {
+		t.Error("Expected the signature to depend on the timestamp")
+	}
+}
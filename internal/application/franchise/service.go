@@ -0,0 +1,313 @@
+package franchise
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/franchise"
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/serialization"
+)
+
+// Service provides application-level franchise operations
+type Service struct {
+	franchiseRepo franchise.Repository
+	movieRepo     movie.Reader
+}
+
+// NewService creates a new franchise application service
+func NewService(franchiseRepo franchise.Repository, movieRepo movie.Reader) *Service {
+	return &Service{
+		franchiseRepo: franchiseRepo,
+		movieRepo:     movieRepo,
+	}
+}
+
+// CreateFranchiseCommand represents the command to create a new franchise
+type CreateFranchiseCommand struct {
+	Name        string
+	Description string
+	MovieIDs    []int // Initial movies in chronological order
+}
+
+// UpdateFranchiseCommand represents the command to update a franchise's details
+type UpdateFranchiseCommand struct {
+	ID          int
+	Name        string
+	Description string
+}
+
+// AddMovieCommand represents the command to place a movie in a franchise
+type AddMovieCommand struct {
+	FranchiseID int
+	MovieID     int
+	Position    int // 1-based chronological position; 0 appends
+}
+
+// FranchiseDTO represents a franchise data transfer object
+type FranchiseDTO struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	MovieIDs    []int  `json:"movie_ids"` // In chronological order
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+// TimelineEntryDTO represents one movie in a franchise timeline
+type TimelineEntryDTO struct {
+	Position int     `json:"position"` // Chronological (story) position
+	MovieID  int     `json:"movie_id"`
+	Title    string  `json:"title"`
+	Director string  `json:"director"`
+	Year     int     `json:"year"`
+	Rating   float64 `json:"rating,omitempty"`
+}
+
+// TimelineDTO lists a franchise's movies in chronological and release order
+type TimelineDTO struct {
+	Franchise     *FranchiseDTO       `json:"franchise"`
+	Chronological []*TimelineEntryDTO `json:"chronological"`
+	Release       []*TimelineEntryDTO `json:"release"`
+}
+
+// CreateFranchise creates a new franchise
+func (s *Service) CreateFranchise(ctx context.Context, cmd CreateFranchiseCommand) (*FranchiseDTO, error) {
+	domainFranchise, err := franchise.NewFranchise(cmd.Name, cmd.Description)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create franchise: %w", err)
+	}
+
+	for _, id := range cmd.MovieIDs {
+		movieID, err := s.findMovieID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if err := domainFranchise.PlaceMovie(movieID, 0); err != nil {
+			return nil, fmt.Errorf("failed to add movie %d: %w", id, err)
+		}
+	}
+
+	if err := s.franchiseRepo.Save(ctx, domainFranchise); err != nil {
+		return nil, fmt.Errorf("failed to save franchise: %w", err)
+	}
+
+	return s.toDTO(domainFranchise), nil
+}
+
+// GetFranchise retrieves a franchise by ID
+func (s *Service) GetFranchise(ctx context.Context, id int) (*FranchiseDTO, error) {
+	domainFranchise, err := s.findFranchise(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.toDTO(domainFranchise), nil
+}
+
+// UpdateFranchise renames a franchise and replaces its description
+func (s *Service) UpdateFranchise(ctx context.Context, cmd UpdateFranchiseCommand) (*FranchiseDTO, error) {
+	domainFranchise, err := s.findFranchise(ctx, cmd.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := domainFranchise.SetName(cmd.Name); err != nil {
+		return nil, fmt.Errorf("failed to update franchise: %w", err)
+	}
+	domainFranchise.SetDescription(cmd.Description)
+
+	if err := s.franchiseRepo.Save(ctx, domainFranchise); err != nil {
+		return nil, fmt.Errorf("failed to save franchise: %w", err)
+	}
+
+	return s.toDTO(domainFranchise), nil
+}
+
+// DeleteFranchise deletes a franchise; its movies are kept
+func (s *Service) DeleteFranchise(ctx context.Context, id int) error {
+	franchiseID, err := shared.NewFranchiseID(id)
+	if err != nil {
+		return fmt.Errorf("invalid franchise ID: %w", err)
+	}
+
+	if err := s.franchiseRepo.Delete(ctx, franchiseID); err != nil {
+		return fmt.Errorf("failed to delete franchise: %w", err)
+	}
+	return nil
+}
+
+// ListFranchises retrieves every franchise, or only those containing a movie
+// when movieID is positive
+func (s *Service) ListFranchises(ctx context.Context, movieID int) ([]*FranchiseDTO, error) {
+	var domainFranchises []*franchise.Franchise
+	var err error
+	if movieID > 0 {
+		var id shared.MovieID
+		id, err = shared.NewMovieID(movieID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid movie ID: %w", err)
+		}
+		domainFranchises, err = s.franchiseRepo.FindByMovieID(ctx, id)
+	} else {
+		domainFranchises, err = s.franchiseRepo.FindAll(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list franchises: %w", err)
+	}
+
+	dtos := make([]*FranchiseDTO, 0, len(domainFranchises))
+	for _, domainFranchise := range domainFranchises {
+		dtos = append(dtos, s.toDTO(domainFranchise))
+	}
+	return dtos, nil
+}
+
+// AddMovie places a movie in a franchise, moving it if it is already there
+func (s *Service) AddMovie(ctx context.Context, cmd AddMovieCommand) (*FranchiseDTO, error) {
+	domainFranchise, err := s.findFranchise(ctx, cmd.FranchiseID)
+	if err != nil {
+		return nil, err
+	}
+
+	movieID, err := s.findMovieID(ctx, cmd.MovieID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := domainFranchise.PlaceMovie(movieID, cmd.Position); err != nil {
+		return nil, fmt.Errorf("failed to add movie to franchise: %w", err)
+	}
+
+	if err := s.franchiseRepo.Save(ctx, domainFranchise); err != nil {
+		return nil, fmt.Errorf("failed to save franchise: %w", err)
+	}
+
+	return s.toDTO(domainFranchise), nil
+}
+
+// RemoveMovie removes a movie from a franchise
+func (s *Service) RemoveMovie(ctx context.Context, franchiseID, movieID int) (*FranchiseDTO, error) {
+	domainFranchise, err := s.findFranchise(ctx, franchiseID)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := shared.NewMovieID(movieID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid movie ID: %w", err)
+	}
+
+	if err := domainFranchise.RemoveMovie(id); err != nil {
+		return nil, fmt.Errorf("failed to remove movie from franchise: %w", err)
+	}
+
+	if err := s.franchiseRepo.Save(ctx, domainFranchise); err != nil {
+		return nil, fmt.Errorf("failed to save franchise: %w", err)
+	}
+
+	return s.toDTO(domainFranchise), nil
+}
+
+// GetTimeline lists a franchise's movies in chronological order and in
+// release order. Release order sorts by year and keeps chronological order
+// for movies released the same year. Movies deleted since they were added
+// are left out.
+func (s *Service) GetTimeline(ctx context.Context, id int) (*TimelineDTO, error) {
+	domainFranchise, err := s.findFranchise(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	timeline := &TimelineDTO{
+		Franchise:     s.toDTO(domainFranchise),
+		Chronological: []*TimelineEntryDTO{},
+		Release:       []*TimelineEntryDTO{},
+	}
+
+	movieIDs := domainFranchise.MovieIDs()
+	if len(movieIDs) == 0 {
+		return timeline, nil
+	}
+
+	domainMovies, err := s.movieRepo.FindByCriteria(ctx, movie.SearchCriteria{
+		IDs:      movieIDs,
+		Limit:    len(movieIDs),
+		OrderBy:  movie.OrderByYear,
+		OrderDir: movie.OrderAsc,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get franchise movies: %w", err)
+	}
+
+	byID := make(map[int]*movie.Movie, len(domainMovies))
+	for _, domainMovie := range domainMovies {
+		byID[domainMovie.ID().Value()] = domainMovie
+	}
+
+	for i, movieID := range movieIDs {
+		domainMovie, ok := byID[movieID.Value()]
+		if !ok {
+			continue
+		}
+		timeline.Chronological = append(timeline.Chronological, &TimelineEntryDTO{
+			Position: i + 1,
+			MovieID:  movieID.Value(),
+			Title:    domainMovie.Title(),
+			Director: domainMovie.Director(),
+			Year:     domainMovie.Year().Value(),
+			Rating:   domainMovie.Rating().Value(),
+		})
+	}
+
+	timeline.Release = append(timeline.Release, timeline.Chronological...)
+	sort.SliceStable(timeline.Release, func(i, j int) bool {
+		return timeline.Release[i].Year < timeline.Release[j].Year
+	})
+
+	return timeline, nil
+}
+
+func (s *Service) findFranchise(ctx context.Context, id int) (*franchise.Franchise, error) {
+	franchiseID, err := shared.NewFranchiseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid franchise ID: %w", err)
+	}
+
+	domainFranchise, err := s.franchiseRepo.FindByID(ctx, franchiseID)
+	if err != nil {
+		return nil, fmt.Errorf("franchise not found: %w", err)
+	}
+	return domainFranchise, nil
+}
+
+// findMovieID validates that a movie exists and returns its ID
+func (s *Service) findMovieID(ctx context.Context, id int) (shared.MovieID, error) {
+	movieID, err := shared.NewMovieID(id)
+	if err != nil {
+		return shared.MovieID{}, fmt.Errorf("invalid movie ID: %w", err)
+	}
+
+	if _, err := s.movieRepo.FindByID(ctx, movieID); err != nil {
+		return shared.MovieID{}, fmt.Errorf("movie %d not found: %w", id, err)
+	}
+	return movieID, nil
+}
+
+// toDTO converts a domain franchise to a DTO
+func (s *Service) toDTO(domainFranchise *franchise.Franchise) *FranchiseDTO {
+	movieIDs := make([]int, 0, domainFranchise.MovieCount())
+	for _, movieID := range domainFranchise.MovieIDs() {
+		movieIDs = append(movieIDs, movieID.Value())
+	}
+
+	return &FranchiseDTO{
+		ID:          domainFranchise.ID().Value(),
+		Name:        domainFranchise.Name(),
+		Description: domainFranchise.Description(),
+		MovieIDs:    movieIDs,
+		CreatedAt:   serialization.Timestamp(domainFranchise.CreatedAt()),
+		UpdatedAt:   serialization.Timestamp(domainFranchise.UpdatedAt()),
+	}
+}
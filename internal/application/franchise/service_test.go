@@ -0,0 +1,291 @@
+package franchise
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/franchise"
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// MockMovieReader implements the parts of movie.Reader the service uses
+type MockMovieReader struct {
+	movie.Reader
+	movies map[int]*movie.Movie
+}
+
+func (m *MockMovieReader) FindByID(ctx context.Context, id shared.MovieID) (*movie.Movie, error) {
+	if found, exists := m.movies[id.Value()]; exists {
+		return found, nil
+	}
+	return nil, errors.New("movie not found")
+}
+
+func (m *MockMovieReader) FindByCriteria(ctx context.Context, criteria movie.SearchCriteria) ([]*movie.Movie, error) {
+	var result []*movie.Movie
+	for _, id := range criteria.IDs {
+		if found, exists := m.movies[id.Value()]; exists {
+			result = append(result, found)
+		}
+	}
+	return result, nil
+}
+
+// MockFranchiseRepository implements franchise.Repository for testing
+type MockFranchiseRepository struct {
+	franchises map[int]*franchise.Franchise
+	nextID     int
+	saveFunc   func(ctx context.Context, f *franchise.Franchise) error
+}
+
+func NewMockFranchiseRepository() *MockFranchiseRepository {
+	return &MockFranchiseRepository{
+		franchises: make(map[int]*franchise.Franchise),
+		nextID:     1,
+	}
+}
+
+func (m *MockFranchiseRepository) FindByID(ctx context.Context, id shared.FranchiseID) (*franchise.Franchise, error) {
+	if found, exists := m.franchises[id.Value()]; exists {
+		return found, nil
+	}
+	return nil, errors.New("franchise not found")
+}
+
+func (m *MockFranchiseRepository) FindAll(ctx context.Context) ([]*franchise.Franchise, error) {
+	var result []*franchise.Franchise
+	for id := 1; id < m.nextID; id++ {
+		if found, exists := m.franchises[id]; exists {
+			result = append(result, found)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockFranchiseRepository) FindByMovieID(ctx context.Context, movieID shared.MovieID) ([]*franchise.Franchise, error) {
+	all, _ := m.FindAll(ctx)
+	var result []*franchise.Franchise
+	for _, found := range all {
+		if found.HasMovie(movieID) {
+			result = append(result, found)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockFranchiseRepository) Save(ctx context.Context, f *franchise.Franchise) error {
+	if m.saveFunc != nil {
+		return m.saveFunc(ctx, f)
+	}
+	if f.ID().IsZero() {
+		id, _ := shared.NewFranchiseID(m.nextID)
+		f.SetID(id)
+		m.nextID++
+	}
+	m.franchises[f.ID().Value()] = f
+	return nil
+}
+
+func (m *MockFranchiseRepository) Delete(ctx context.Context, id shared.FranchiseID) error {
+	if _, exists := m.franchises[id.Value()]; !exists {
+		return errors.New("franchise not found")
+	}
+	delete(m.franchises, id.Value())
+	return nil
+}
+
+// newTestService creates a service over a Star Wars catalogue, whose
+// release order differs from its story order
+func newTestService(t *testing.T) (*Service, *MockFranchiseRepository) {
+	t.Helper()
+
+	movies := map[int]*movie.Movie{}
+	for _, m := range []struct {
+		id    int
+		title string
+		year  int
+	}{
+		{1, "A New Hope", 1977},
+		{2, "The Empire Strikes Back", 1980},
+		{3, "The Phantom Menace", 1999},
+		{4, "Rogue One", 2016},
+	} {
+		movieID, _ := shared.NewMovieID(m.id)
+		domainMovie, err := movie.NewMovieWithID(movieID, m.title, "George Lucas", m.year)
+		if err != nil {
+			t.Fatalf("failed to create movie: %v", err)
+		}
+		movies[m.id] = domainMovie
+	}
+
+	repo := NewMockFranchiseRepository()
+	return NewService(repo, &MockMovieReader{movies: movies}), repo
+}
+
+func TestService_CreateFranchise(t *testing.T) {
+	service, repo := newTestService(t)
+
+	result, err := service.CreateFranchise(context.Background(), CreateFranchiseCommand{
+		Name:        "Star Wars",
+		Description: "A galaxy far, far away",
+		MovieIDs:    []int{3, 1},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if result.ID != 1 || result.Name != "Star Wars" {
+		t.Errorf("Expected franchise 1 named Star Wars, got: %+v", result)
+	}
+	if !reflect.DeepEqual(result.MovieIDs, []int{3, 1}) {
+		t.Errorf("Expected movies [3 1], got: %v", result.MovieIDs)
+	}
+	if len(repo.franchises) != 1 {
+		t.Errorf("Expected franchise to be saved, got: %d", len(repo.franchises))
+	}
+}
+
+func TestService_CreateFranchise_Validation(t *testing.T) {
+	service, repo := newTestService(t)
+	ctx := context.Background()
+
+	if _, err := service.CreateFranchise(ctx, CreateFranchiseCommand{Name: ""}); err == nil {
+		t.Error("Expected error for empty name")
+	}
+	if _, err := service.CreateFranchise(ctx, CreateFranchiseCommand{Name: "Star Wars", MovieIDs: []int{99}}); err == nil {
+		t.Error("Expected error for unknown movie")
+	}
+	if len(repo.franchises) != 0 {
+		t.Errorf("Expected nothing to be saved, got: %d", len(repo.franchises))
+	}
+}
+
+func TestService_AddAndRemoveMovie(t *testing.T) {
+	service, _ := newTestService(t)
+	ctx := context.Background()
+
+	created, _ := service.CreateFranchise(ctx, CreateFranchiseCommand{Name: "Star Wars", MovieIDs: []int{1, 2}})
+
+	result, err := service.AddMovie(ctx, AddMovieCommand{FranchiseID: created.ID, MovieID: 3, Position: 1})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !reflect.DeepEqual(result.MovieIDs, []int{3, 1, 2}) {
+		t.Errorf("Expected movies [3 1 2], got: %v", result.MovieIDs)
+	}
+
+	result, err = service.RemoveMovie(ctx, created.ID, 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !reflect.DeepEqual(result.MovieIDs, []int{3, 2}) {
+		t.Errorf("Expected movies [3 2], got: %v", result.MovieIDs)
+	}
+
+	if _, err := service.AddMovie(ctx, AddMovieCommand{FranchiseID: created.ID, MovieID: 99}); err == nil {
+		t.Error("Expected error for unknown movie")
+	}
+	if _, err := service.AddMovie(ctx, AddMovieCommand{FranchiseID: 42, MovieID: 1}); err == nil {
+		t.Error("Expected error for unknown franchise")
+	}
+	if _, err := service.RemoveMovie(ctx, created.ID, 4); err == nil {
+		t.Error("Expected error removing a movie not in the franchise")
+	}
+}
+
+func TestService_UpdateFranchise(t *testing.T) {
+	service, _ := newTestService(t)
+	ctx := context.Background()
+
+	created, _ := service.CreateFranchise(ctx, CreateFranchiseCommand{Name: "Star Wars", Description: "Old"})
+
+	result, err := service.UpdateFranchise(ctx, UpdateFranchiseCommand{ID: created.ID, Name: "Star Wars Saga"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.Name != "Star Wars Saga" || result.Description != "" {
+		t.Errorf("Expected renamed franchise with cleared description, got: %+v", result)
+	}
+
+	if _, err := service.UpdateFranchise(ctx, UpdateFranchiseCommand{ID: created.ID, Name: " "}); err == nil {
+		t.Error("Expected error for empty name")
+	}
+}
+
+func TestService_DeleteAndListFranchises(t *testing.T) {
+	service, _ := newTestService(t)
+	ctx := context.Background()
+
+	starWars, _ := service.CreateFranchise(ctx, CreateFranchiseCommand{Name: "Star Wars", MovieIDs: []int{1, 2}})
+	_, _ = service.CreateFranchise(ctx, CreateFranchiseCommand{Name: "Anthology", MovieIDs: []int{4}})
+
+	all, err := service.ListFranchises(ctx, 0)
+	if err != nil || len(all) != 2 {
+		t.Fatalf("Expected 2 franchises, got: %d (err %v)", len(all), err)
+	}
+
+	withMovie, err := service.ListFranchises(ctx, 1)
+	if err != nil || len(withMovie) != 1 || withMovie[0].Name != "Star Wars" {
+		t.Errorf("Expected only Star Wars to contain movie 1, got: %+v (err %v)", withMovie, err)
+	}
+
+	if err := service.DeleteFranchise(ctx, starWars.ID); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if _, err := service.GetFranchise(ctx, starWars.ID); err == nil {
+		t.Error("Expected deleted franchise to be gone")
+	}
+}
+
+func TestService_GetTimeline(t *testing.T) {
+	service, repo := newTestService(t)
+	ctx := context.Background()
+
+	// Story order: Phantom Menace, Rogue One, A New Hope, Empire
+	created, _ := service.CreateFranchise(ctx, CreateFranchiseCommand{Name: "Star Wars", MovieIDs: []int{3, 4, 1, 2}})
+
+	// A movie deleted after being linked is skipped
+	stale, _ := shared.NewMovieID(77)
+	_ = repo.franchises[created.ID].PlaceMovie(stale, 0)
+
+	timeline, err := service.GetTimeline(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	var chronological, release []int
+	for _, entry := range timeline.Chronological {
+		chronological = append(chronological, entry.MovieID)
+	}
+	for _, entry := range timeline.Release {
+		release = append(release, entry.MovieID)
+	}
+
+	if !reflect.DeepEqual(chronological, []int{3, 4, 1, 2}) {
+		t.Errorf("Expected chronological order [3 4 1 2], got: %v", chronological)
+	}
+	if !reflect.DeepEqual(release, []int{1, 2, 3, 4}) {
+		t.Errorf("Expected release order [1 2 3 4], got: %v", release)
+	}
+	if timeline.Release[0].Position != 3 {
+		t.Errorf("Expected release entries to keep story position, got: %d", timeline.Release[0].Position)
+	}
+}
+
+func TestService_GetTimeline_Empty(t *testing.T) {
+	service, _ := newTestService(t)
+	ctx := context.Background()
+
+	created, _ := service.CreateFranchise(ctx, CreateFranchiseCommand{Name: "Upcoming"})
+
+	timeline, err := service.GetTimeline(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if timeline.Chronological == nil || timeline.Release == nil || len(timeline.Chronological) != 0 {
+		t.Errorf("Expected empty non-nil timelines, got: %+v", timeline)
+	}
+}
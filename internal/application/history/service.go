@@ -0,0 +1,210 @@
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/history"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/serialization"
+)
+
+// Service provides application-level movie history operations
+type Service struct {
+	historyRepo history.Repository
+	movies      *TrackedRepository
+}
+
+// NewService creates a new history application service. Reverts are saved
+// through movies so they are recorded like any other change.
+func NewService(historyRepo history.Repository, movies *TrackedRepository) *Service {
+	return &Service{
+		historyRepo: historyRepo,
+		movies:      movies,
+	}
+}
+
+// ChangeDTO represents one field's value before and after a change; Old is
+// nil for created fields and New for deleted ones
+type ChangeDTO struct {
+	Field string `json:"field"`
+	Old   any    `json:"old,omitempty"`
+	New   any    `json:"new,omitempty"`
+}
+
+// EntryDTO represents a movie history entry data transfer object
+type EntryDTO struct {
+	Version    int          `json:"version"`
+	Operation  string       `json:"operation"`
+	RevertedTo int          `json:"reverted_to,omitempty"`
+	Changes    []*ChangeDTO `json:"changes"`
+	CreatedAt  string       `json:"created_at"`
+}
+
+// MovieHistoryDTO represents a movie's change history, newest first
+type MovieHistoryDTO struct {
+	MovieID int         `json:"movie_id"`
+	Title   string      `json:"title,omitempty"` // Empty once the movie is deleted
+	Deleted bool        `json:"deleted"`
+	Total   int         `json:"total"` // All versions, before any limit
+	Entries []*EntryDTO `json:"entries"`
+}
+
+// RevertResultDTO describes a movie restored to an earlier version
+type RevertResultDTO struct {
+	MovieID    int          `json:"movie_id"`
+	Title      string       `json:"title"`
+	Year       int          `json:"year"`
+	Version    int          `json:"version"` // Version the revert created; unchanged if nothing differed
+	RevertedTo int          `json:"reverted_to"`
+	Changes    []*ChangeDTO `json:"changes"`
+}
+
+// GetMovieHistory retrieves a movie's history newest first, keeping at most
+// limit entries when limit is positive. History outlives deleted movies.
+func (s *Service) GetMovieHistory(ctx context.Context, movieID, limit int) (*MovieHistoryDTO, error) {
+	id, err := shared.NewMovieID(movieID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid movie ID: %w", err)
+	}
+
+	entries, err := s.historyRepo.FindByMovieID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get history: %w", err)
+	}
+
+	dto := &MovieHistoryDTO{
+		MovieID: movieID,
+		Total:   len(entries),
+		Entries: make([]*EntryDTO, 0, len(entries)),
+	}
+
+	dto.Deleted = len(entries) > 0 && entries[len(entries)-1].Operation() == history.OperationDelete
+	if !dto.Deleted {
+		domainMovie, err := s.movies.FindByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("movie not found: %w", err)
+		}
+		dto.Title = domainMovie.Title()
+	}
+
+	for i := len(entries) - 1; i >= 0; i-- {
+		if limit > 0 && len(dto.Entries) == limit {
+			break
+		}
+		entry, err := toEntryDTO(entries[i])
+		if err != nil {
+			return nil, err
+		}
+		dto.Entries = append(dto.Entries, entry)
+	}
+	return dto, nil
+}
+
+// RevertMovie restores a movie's content to how it was at an earlier
+// version. The revert is recorded as a new version, so it can be undone too.
+func (s *Service) RevertMovie(ctx context.Context, movieID, version int) (*RevertResultDTO, error) {
+	id, err := shared.NewMovieID(movieID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid movie ID: %w", err)
+	}
+
+	current, err := s.movies.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("movie not found: %w", err)
+	}
+
+	entries, err := s.historyRepo.FindByMovieID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get history: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil, shared.NewNotFoundError("movie has no recorded history")
+	}
+	latest := entries[len(entries)-1].Version()
+	if version < 1 || version >= latest {
+		return nil, fmt.Errorf("version must be between 1 and %d (the current version is %d)", latest-1, latest)
+	}
+	// Entries purged by the retention job can no longer be undone, so the
+	// oldest reachable version is the one before the first kept entry
+	if oldest := entries[0].Version() - 1; version < oldest {
+		return nil, fmt.Errorf("version %d was purged; the oldest version kept is %d", version, oldest)
+	}
+
+	// Walk back from the current content, undoing each later change
+	target := history.SnapshotOf(current)
+	for i := len(entries) - 1; i >= 0 && entries[i].Version() > version; i-- {
+		if target, err = entries[i].Diff().Undo(target); err != nil {
+			return nil, fmt.Errorf("failed to rebuild version %d: %w", version, err)
+		}
+	}
+
+	restored, err := target.Movie(id)
+	if err != nil {
+		return nil, fmt.Errorf("version %d cannot be restored: %w", version, err)
+	}
+
+	entry, err := s.movies.saveAs(ctx, restored, history.OperationRevert, version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revert movie: %w", err)
+	}
+
+	result := &RevertResultDTO{
+		MovieID:    movieID,
+		Title:      restored.Title(),
+		Year:       restored.Year().Value(),
+		Version:    latest,
+		RevertedTo: version,
+		Changes:    []*ChangeDTO{},
+	}
+	if entry != nil {
+		result.Version = entry.Version()
+		if result.Changes, err = toChangeDTOs(entry.Diff()); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// toEntryDTO converts a domain history entry to a DTO
+func toEntryDTO(entry *history.Entry) (*EntryDTO, error) {
+	changes, err := toChangeDTOs(entry.Diff())
+	if err != nil {
+		return nil, err
+	}
+	return &EntryDTO{
+		Version:    entry.Version(),
+		Operation:  string(entry.Operation()),
+		RevertedTo: entry.RevertedTo(),
+		Changes:    changes,
+		CreatedAt:  serialization.Timestamp(entry.CreatedAt()),
+	}, nil
+}
+
+// toChangeDTOs decodes a diff into changes ordered by field name
+func toChangeDTOs(diff history.Diff) ([]*ChangeDTO, error) {
+	changes := make([]*ChangeDTO, 0, len(diff))
+	for _, field := range diff.Fields() {
+		change := &ChangeDTO{Field: field}
+		if err := decodeValue(diff[field].Old, &change.Old); err != nil {
+			return nil, err
+		}
+		if err := decodeValue(diff[field].New, &change.New); err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// decodeValue decodes a JSON value, leaving target nil for an empty one
+func decodeValue(value json.RawMessage, target *any) error {
+	if len(value) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(value, target); err != nil {
+		return fmt.Errorf("failed to decode history value: %w", err)
+	}
+	return nil
+}
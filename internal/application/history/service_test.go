@@ -0,0 +1,347 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/history"
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// MockMovieRepository implements the FindByID, Save, InsertAll, UpdateAll
+// and Delete parts of movie.Repository for testing
+type MockMovieRepository struct {
+	movie.Repository
+	movies map[int]*movie.Movie
+	nextID int
+}
+
+func (m *MockMovieRepository) FindByID(ctx context.Context, id shared.MovieID) (*movie.Movie, error) {
+	if found, exists := m.movies[id.Value()]; exists {
+		return found, nil
+	}
+	return nil, errors.New("movie not found")
+}
+
+func (m *MockMovieRepository) Save(ctx context.Context, domainMovie *movie.Movie) error {
+	if domainMovie.ID().IsZero() {
+		m.nextID++
+		id, _ := shared.NewMovieID(m.nextID)
+		domainMovie.SetID(id)
+	} else if _, exists := m.movies[domainMovie.ID().Value()]; !exists {
+		return errors.New("movie not found")
+	}
+	m.movies[domainMovie.ID().Value()] = domainMovie
+	return nil
+}
+
+func (m *MockMovieRepository) InsertAll(ctx context.Context, movies []*movie.Movie) error {
+	for _, domainMovie := range movies {
+		if err := m.Save(ctx, domainMovie); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MockMovieRepository) UpdateAll(ctx context.Context, movies []*movie.Movie) error {
+	for _, domainMovie := range movies {
+		if err := m.Save(ctx, domainMovie); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MockMovieRepository) Delete(ctx context.Context, id shared.MovieID) error {
+	if _, exists := m.movies[id.Value()]; !exists {
+		return errors.New("movie not found")
+	}
+	delete(m.movies, id.Value())
+	return nil
+}
+
+// MockHistoryRepository implements history.Repository for testing
+type MockHistoryRepository struct {
+	entries []*history.Entry // In append order
+	err     error
+}
+
+func (m *MockHistoryRepository) FindByMovieID(ctx context.Context, movieID shared.MovieID) ([]*history.Entry, error) {
+	result := []*history.Entry{}
+	for _, entry := range m.entries {
+		if entry.MovieID() == movieID {
+			result = append(result, entry)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockHistoryRepository) Append(ctx context.Context, entry *history.Entry) error {
+	if m.err != nil {
+		return m.err
+	}
+	version := 1
+	for _, existing := range m.entries {
+		if existing.MovieID() == entry.MovieID() {
+			version = existing.Version() + 1
+		}
+	}
+	entry.SetSaved(len(m.entries)+1, version)
+	m.entries = append(m.entries, entry)
+	return nil
+}
+
+func (m *MockHistoryRepository) AppendAll(ctx context.Context, entries []*history.Entry) error {
+	for _, entry := range entries {
+		if err := m.Append(ctx, entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newTestService(t *testing.T) (*Service, *TrackedRepository, *MockHistoryRepository) {
+	t.Helper()
+
+	historyRepo := &MockHistoryRepository{}
+	movies := NewTrackedRepository(&MockMovieRepository{movies: map[int]*movie.Movie{}}, historyRepo)
+	return NewService(historyRepo, movies), movies, historyRepo
+}
+
+// saveTestMovie saves an Inception with the given details through the tracked repository
+func saveTestMovie(t *testing.T, movies *TrackedRepository, id int, director string, rating float64) *movie.Movie {
+	t.Helper()
+
+	movieID, _ := shared.NewMovieID(id) // Zero saves a new movie
+	domainMovie, err := movie.NewMovieWithID(movieID, "Inception", director, 2010)
+	if err != nil {
+		t.Fatalf("failed to create movie: %v", err)
+	}
+	if rating > 0 {
+		_ = domainMovie.SetRating(rating)
+	}
+	if err := movies.Save(context.Background(), domainMovie); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	return domainMovie
+}
+
+func TestTrackedRepository_RecordsChanges(t *testing.T) {
+	_, movies, historyRepo := newTestService(t)
+	ctx := context.Background()
+
+	created := saveTestMovie(t, movies, 0, "C. Nolan", 0)
+	id := created.ID().Value()
+	saveTestMovie(t, movies, id, "Christopher Nolan", 8.8)
+	saveTestMovie(t, movies, id, "Christopher Nolan", 8.8) // No change, no entry
+
+	if err := movies.Delete(ctx, created.ID()); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	want := []history.Operation{history.OperationCreate, history.OperationUpdate, history.OperationDelete}
+	if len(historyRepo.entries) != len(want) {
+		t.Fatalf("Expected %d entries, got: %d", len(want), len(historyRepo.entries))
+	}
+	for i, operation := range want {
+		if historyRepo.entries[i].Operation() != operation {
+			t.Errorf("Expected entry %d to be %s, got: %s", i, operation, historyRepo.entries[i].Operation())
+		}
+	}
+	if fields := historyRepo.entries[1].Diff().Fields(); len(fields) != 2 || fields[0] != "director" || fields[1] != "rating" {
+		t.Errorf("Expected director and rating to change, got: %v", fields)
+	}
+}
+
+func TestTrackedRepository_HistoryError(t *testing.T) {
+	_, movies, historyRepo := newTestService(t)
+	historyRepo.err = errors.New("database locked")
+
+	domainMovie, _ := movie.NewMovie("Inception", "Christopher Nolan", 2010)
+	err := movies.Save(context.Background(), domainMovie)
+
+	if err == nil || !strings.Contains(err.Error(), "movie saved but history not recorded") {
+		t.Errorf("Expected history error, got: %v", err)
+	}
+}
+
+func TestTrackedRepository_InsertAll(t *testing.T) {
+	_, movies, historyRepo := newTestService(t)
+
+	inception, _ := movie.NewMovie("Inception", "Christopher Nolan", 2010)
+	heat, _ := movie.NewMovie("Heat", "Michael Mann", 1995)
+	if err := movies.InsertAll(context.Background(), []*movie.Movie{inception, heat}); err != nil {
+		t.Fatalf("InsertAll() error = %v", err)
+	}
+
+	if len(historyRepo.entries) != 2 {
+		t.Fatalf("Expected 2 entries, got: %d", len(historyRepo.entries))
+	}
+	for i, created := range []*movie.Movie{inception, heat} {
+		entry := historyRepo.entries[i]
+		if entry.MovieID() != created.ID() || entry.Operation() != history.OperationCreate || entry.Version() != 1 {
+			t.Errorf("Expected a create entry for %s, got: %s of movie %d", created.Title(), entry.Operation(), entry.MovieID().Value())
+		}
+	}
+}
+
+func TestTrackedRepository_UpdateAll(t *testing.T) {
+	_, movies, historyRepo := newTestService(t)
+	first := saveTestMovie(t, movies, 0, "C. Nolan", 0)
+	second := saveTestMovie(t, movies, 0, "C. Nolan", 0)
+
+	changed, _ := movie.NewMovieWithID(first.ID(), "Inception", "Christopher Nolan", 2010)
+	unchanged, _ := movie.NewMovieWithID(second.ID(), "Inception", "C. Nolan", 2010)
+	if err := movies.UpdateAll(context.Background(), []*movie.Movie{changed, unchanged}); err != nil {
+		t.Fatalf("UpdateAll() error = %v", err)
+	}
+
+	if len(historyRepo.entries) != 3 {
+		t.Fatalf("Expected one update entry after the 2 creates, got: %d entries", len(historyRepo.entries))
+	}
+	entry := historyRepo.entries[2]
+	if entry.MovieID() != first.ID() || entry.Operation() != history.OperationUpdate || entry.Version() != 2 {
+		t.Errorf("Expected an update entry for the changed movie, got: %s of movie %d", entry.Operation(), entry.MovieID().Value())
+	}
+}
+
+func TestTrackedRepository_InsertAll_HistoryError(t *testing.T) {
+	_, movies, historyRepo := newTestService(t)
+	historyRepo.err = errors.New("database locked")
+
+	domainMovie, _ := movie.NewMovie("Inception", "Christopher Nolan", 2010)
+	err := movies.InsertAll(context.Background(), []*movie.Movie{domainMovie})
+
+	if err == nil || !strings.Contains(err.Error(), "movies saved but history not recorded") {
+		t.Errorf("Expected history error, got: %v", err)
+	}
+}
+
+func TestService_GetMovieHistory(t *testing.T) {
+	service, movies, _ := newTestService(t)
+	ctx := context.Background()
+
+	created := saveTestMovie(t, movies, 0, "C. Nolan", 0)
+	id := created.ID().Value()
+	saveTestMovie(t, movies, id, "Christopher Nolan", 0)
+	saveTestMovie(t, movies, id, "Christopher Nolan", 8.8)
+
+	result, err := service.GetMovieHistory(ctx, id, 2)
+	if err != nil {
+		t.Fatalf("GetMovieHistory() error = %v", err)
+	}
+
+	if result.Title != "Inception" || result.Deleted || result.Total != 3 {
+		t.Errorf("Expected 3 versions of Inception, got: %+v", result)
+	}
+	if len(result.Entries) != 2 || result.Entries[0].Version != 3 || result.Entries[1].Version != 2 {
+		t.Fatalf("Expected the 2 newest entries first, got: %+v", result.Entries)
+	}
+	change := result.Entries[1].Changes[0]
+	if change.Field != "director" || change.Old != "C. Nolan" || change.New != "Christopher Nolan" {
+		t.Errorf("Expected decoded director change, got: %+v", change)
+	}
+
+	_ = movies.Delete(ctx, created.ID())
+	deleted, err := service.GetMovieHistory(ctx, id, 0)
+	if err != nil {
+		t.Fatalf("GetMovieHistory() error = %v", err)
+	}
+	if !deleted.Deleted || deleted.Total != 4 || deleted.Entries[0].Operation != "delete" {
+		t.Errorf("Expected history to outlive the movie, got: %+v", deleted)
+	}
+
+	if _, err := service.GetMovieHistory(ctx, 99, 0); err == nil {
+		t.Error("Expected error for a movie without history")
+	}
+}
+
+func TestService_RevertMovie(t *testing.T) {
+	service, movies, historyRepo := newTestService(t)
+	ctx := context.Background()
+
+	created := saveTestMovie(t, movies, 0, "C. Nolan", 8)
+	id := created.ID().Value()
+	saveTestMovie(t, movies, id, "Christopher Nolan", 8)
+	saveTestMovie(t, movies, id, "Someone Else", 2)
+
+	result, err := service.RevertMovie(ctx, id, 2)
+	if err != nil {
+		t.Fatalf("RevertMovie() error = %v", err)
+	}
+
+	current, _ := movies.FindByID(ctx, created.ID())
+	if current.Director() != "Christopher Nolan" || current.Rating().Value() != 8 {
+		t.Errorf("Expected version 2 content, got: %s rated %v", current.Director(), current.Rating().Value())
+	}
+	if result.Version != 4 || result.RevertedTo != 2 || len(result.Changes) != 2 {
+		t.Errorf("Expected version 4 reverting 2 fields to version 2, got: %+v", result)
+	}
+	last := historyRepo.entries[len(historyRepo.entries)-1]
+	if last.Operation() != history.OperationRevert || last.RevertedTo() != 2 {
+		t.Errorf("Expected revert entry, got: %s to %d", last.Operation(), last.RevertedTo())
+	}
+
+	// Reverting to the creation walks back through the revert as well
+	if _, err := service.RevertMovie(ctx, id, 1); err != nil {
+		t.Fatalf("RevertMovie() error = %v", err)
+	}
+	current, _ = movies.FindByID(ctx, created.ID())
+	if current.Director() != "C. Nolan" || current.Rating().Value() != 8 {
+		t.Errorf("Expected version 1 content, got: %s rated %v", current.Director(), current.Rating().Value())
+	}
+}
+
+func TestService_RevertMovie_Errors(t *testing.T) {
+	service, movies, _ := newTestService(t)
+	created := saveTestMovie(t, movies, 0, "C. Nolan", 0)
+	id := created.ID().Value()
+	saveTestMovie(t, movies, id, "Christopher Nolan", 0)
+
+	tests := []struct {
+		name    string
+		movieID int
+		version int
+		want    string
+	}{
+		{name: "invalid movie ID", movieID: -1, version: 1, want: "invalid movie ID"},
+		{name: "missing movie", movieID: 99, version: 1, want: "movie not found"},
+		{name: "current version", movieID: id, version: 2, want: "version must be between 1 and 1"},
+		{name: "unknown version", movieID: id, version: 0, want: "version must be between 1 and 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.RevertMovie(context.Background(), tt.movieID, tt.version)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got: %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestService_RevertMovie_PurgedVersion(t *testing.T) {
+	service, movies, historyRepo := newTestService(t)
+	created := saveTestMovie(t, movies, 0, "C. Nolan", 0)
+	id := created.ID().Value()
+	saveTestMovie(t, movies, id, "Christopher Nolan", 0)
+	saveTestMovie(t, movies, id, "Christopher Nolan", 9)
+
+	// The retention job purged versions 1 and 2, leaving version 3
+	historyRepo.entries = historyRepo.entries[2:]
+
+	if _, err := service.RevertMovie(context.Background(), id, 1); err == nil || !strings.Contains(err.Error(), "the oldest version kept is 2") {
+		t.Errorf("Expected version 1 to be reported purged, got: %v", err)
+	}
+	if _, err := service.RevertMovie(context.Background(), id, 2); err != nil {
+		t.Fatalf("Expected version 2 to be rebuilt from version 3's diff, got: %v", err)
+	}
+	current, _ := movies.FindByID(context.Background(), created.ID())
+	if current.Director() != "Christopher Nolan" || current.Rating().Value() != 0 {
+		t.Errorf("Expected version 2 content, got: %s rated %v", current.Director(), current.Rating().Value())
+	}
+}
@@ -0,0 +1,167 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/history"
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// TrackedRepository wraps a movie repository and records every save and
+// delete in the movie's history, so all writers are tracked without knowing
+// about it. DeleteAll is passed through untracked.
+type TrackedRepository struct {
+	movie.Repository
+	historyRepo history.Repository
+}
+
+// NewTrackedRepository creates a movie repository that records history
+func NewTrackedRepository(movieRepo movie.Repository, historyRepo history.Repository) *TrackedRepository {
+	return &TrackedRepository{
+		Repository:  movieRepo,
+		historyRepo: historyRepo,
+	}
+}
+
+// Save persists a movie and records what changed as a new version
+func (r *TrackedRepository) Save(ctx context.Context, m *movie.Movie) error {
+	operation := history.OperationUpdate
+	if m.ID().IsZero() {
+		operation = history.OperationCreate
+	}
+	_, err := r.saveAs(ctx, m, operation, 0)
+	return err
+}
+
+// InsertAll persists new movies and records each creation, appending the
+// history in a single batch too
+func (r *TrackedRepository) InsertAll(ctx context.Context, movies []*movie.Movie) error {
+	if err := r.Repository.InsertAll(ctx, movies); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	entries := make([]*history.Entry, 0, len(movies))
+	for _, m := range movies {
+		after := history.SnapshotOf(m)
+		diff, err := history.Compare(nil, &after)
+		if err != nil {
+			return fmt.Errorf("movies saved but history not recorded: %w", err)
+		}
+		entry, err := history.NewEntry(m.ID(), history.OperationCreate, diff, 0, now)
+		if err != nil {
+			return fmt.Errorf("movies saved but history not recorded: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := r.historyRepo.AppendAll(ctx, entries); err != nil {
+		return fmt.Errorf("movies saved but history not recorded: %w", err)
+	}
+	return nil
+}
+
+// UpdateAll persists changes to existing movies and records each as a new
+// version, appending the history in a single batch too
+func (r *TrackedRepository) UpdateAll(ctx context.Context, movies []*movie.Movie) error {
+	befores := make([]history.Snapshot, len(movies))
+	for i, m := range movies {
+		existing, err := r.Repository.FindByID(ctx, m.ID())
+		if err != nil {
+			return err
+		}
+		befores[i] = history.SnapshotOf(existing)
+	}
+
+	if err := r.Repository.UpdateAll(ctx, movies); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	entries := make([]*history.Entry, 0, len(movies))
+	for i, m := range movies {
+		after := history.SnapshotOf(m)
+		diff, err := history.Compare(&befores[i], &after)
+		if err != nil {
+			return fmt.Errorf("movies saved but history not recorded: %w", err)
+		}
+		if len(diff) == 0 {
+			continue
+		}
+		entry, err := history.NewEntry(m.ID(), history.OperationUpdate, diff, 0, now)
+		if err != nil {
+			return fmt.Errorf("movies saved but history not recorded: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := r.historyRepo.AppendAll(ctx, entries); err != nil {
+		return fmt.Errorf("movies saved but history not recorded: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a movie and records its last content
+func (r *TrackedRepository) Delete(ctx context.Context, id shared.MovieID) error {
+	existing, err := r.Repository.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := r.Repository.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	before := history.SnapshotOf(existing)
+	if _, err := r.record(ctx, id, history.OperationDelete, &before, nil, 0); err != nil {
+		return fmt.Errorf("movie deleted but history not recorded: %w", err)
+	}
+	return nil
+}
+
+// saveAs saves a movie and records the change under the given operation. It
+// returns the new entry, or nil when the save changed nothing.
+func (r *TrackedRepository) saveAs(ctx context.Context, m *movie.Movie, operation history.Operation, revertedTo int) (*history.Entry, error) {
+	var before *history.Snapshot
+	if !m.ID().IsZero() {
+		existing, err := r.Repository.FindByID(ctx, m.ID())
+		if err != nil {
+			return nil, err
+		}
+		snapshot := history.SnapshotOf(existing)
+		before = &snapshot
+	}
+
+	if err := r.Repository.Save(ctx, m); err != nil {
+		return nil, err
+	}
+
+	after := history.SnapshotOf(m)
+	entry, err := r.record(ctx, m.ID(), operation, before, &after, revertedTo)
+	if err != nil {
+		return nil, fmt.Errorf("movie saved but history not recorded: %w", err)
+	}
+	return entry, nil
+}
+
+// record appends the difference between two snapshots, skipping empty diffs
+func (r *TrackedRepository) record(ctx context.Context, id shared.MovieID, operation history.Operation, before, after *history.Snapshot, revertedTo int) (*history.Entry, error) {
+	diff, err := history.Compare(before, after)
+	if err != nil {
+		return nil, err
+	}
+	if len(diff) == 0 {
+		return nil, nil
+	}
+
+	entry, err := history.NewEntry(id, operation, diff, revertedTo, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := r.historyRepo.Append(ctx, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
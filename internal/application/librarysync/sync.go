@@ -0,0 +1,454 @@
+// Package librarysync pulls movies and posters from the library of another
+// movies-mcp-server into this one. Movies have no external IDs, so the two
+// libraries are matched by title and year; a movie only the remote has is
+// created, and one both have but that differs is resolved by a Strategy.
+package librarysync
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	posterApp "github.com/francknouama/movies-mcp-server/internal/application/poster"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/serialization"
+)
+
+// Strategy decides which side wins when a movie differs between libraries
+type Strategy string
+
+const (
+	// StrategyPreferLocal keeps the local movie
+	StrategyPreferLocal Strategy = "prefer-local"
+	// StrategyPreferRemote overwrites the local movie with the remote one
+	StrategyPreferRemote Strategy = "prefer-remote"
+	// StrategyNewest keeps whichever movie was updated last; ties keep the
+	// local one
+	StrategyNewest Strategy = "newest"
+)
+
+// ParseStrategy parses a conflict strategy; empty is prefer-local
+func ParseStrategy(s string) (Strategy, error) {
+	switch Strategy(strings.ToLower(s)) {
+	case "", StrategyPreferLocal:
+		return StrategyPreferLocal, nil
+	case StrategyPreferRemote:
+		return StrategyPreferRemote, nil
+	case StrategyNewest:
+		return StrategyNewest, nil
+	}
+	return "", shared.NewValidationError("strategy must be prefer-local, prefer-remote or newest, got %q", s)
+}
+
+// Actions recorded in a Change
+const (
+	ActionCreated = "created"
+	ActionUpdated = "updated"
+	ActionKept    = "kept" // The movies differ and the local one won
+)
+
+// createBatchSize is how many missing movies are created in one insert
+const createBatchSize = 500
+
+// maxChanges caps the changes a sync lists; the counts cover every movie
+const maxChanges = 100
+
+// Remote is the library of another server
+type Remote interface {
+	// EachMovie passes every remote movie to fn in ID order
+	EachMovie(ctx context.Context, fn func(*movieApp.MovieDTO) error) error
+	// Poster returns a remote movie's stored poster image; it fails with
+	// shared.ErrNotFound if the movie has none
+	Poster(ctx context.Context, movieID int) (data []byte, mimeType string, err error)
+	Close() error
+}
+
+// Dialer connects to the remote server for one sync
+type Dialer func(ctx context.Context) (Remote, error)
+
+// MovieService defines the movie operations a sync depends on
+type MovieService interface {
+	SearchMovies(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error)
+	CreateMovies(ctx context.Context, cmds []movieApp.CreateMovieCommand) ([]*movieApp.MovieDTO, []error, error)
+	UpdateMovie(ctx context.Context, cmd movieApp.UpdateMovieCommand) (*movieApp.MovieDTO, error)
+}
+
+// PosterService defines the poster operations a sync depends on
+type PosterService interface {
+	GetPoster(ctx context.Context, movieID int) (*posterApp.PosterImageDTO, error)
+	UploadPoster(ctx context.Context, cmd posterApp.UploadPosterCommand) (*posterApp.PosterDTO, error)
+}
+
+// SyncCommand asks for the remote library to be pulled into this one
+type SyncCommand struct {
+	Strategy    Strategy
+	DryRun      bool // Report what would change without writing; posters are not checked
+	SkipPosters bool
+}
+
+// Change describes one movie a sync created, updated or kept
+type Change struct {
+	Action   string
+	LocalID  int // Zero for a movie a dry run would create
+	RemoteID int
+	Title    string
+	Year     int
+	Fields   []string // Fields that differ, for updated and kept movies
+}
+
+// ItemError is a remote movie a sync could not pull
+type ItemError struct {
+	RemoteID int
+	Title    string
+	Error    string
+}
+
+// Result reports a sync
+type Result struct {
+	Strategy      Strategy
+	DryRun        bool
+	RemoteMovies  int
+	LocalMovies   int // Before the sync
+	Created       int
+	Updated       int
+	Kept          int
+	Unchanged     int
+	PostersCopied int
+	Changes       []Change // The first maxChanges created, updated and kept movies
+	Errors        []ItemError
+}
+
+// Service syncs this library from a remote one
+type Service struct {
+	movieService  MovieService
+	posterService PosterService
+	dial          Dialer
+}
+
+// NewService creates a new sync service pulling from the remote dial connects to
+func NewService(movieService MovieService, posterService PosterService, dial Dialer) *Service {
+	return &Service{
+		movieService:  movieService,
+		posterService: posterService,
+		dial:          dial,
+	}
+}
+
+// pair is a remote movie and the local movie it matches, if any
+type pair struct {
+	remote *movieApp.MovieDTO
+	local  *movieApp.MovieDTO
+}
+
+// Sync pulls the remote library into this one. Missing movies are created
+// with their posters; a movie both libraries have is updated or kept as the
+// strategy decides, and gets the remote poster when it has none or was
+// overwritten. A movie that fails is reported and the rest carry on.
+func (s *Service) Sync(ctx context.Context, cmd SyncCommand) (*Result, error) {
+	if cmd.Strategy == "" {
+		cmd.Strategy = StrategyPreferLocal
+	}
+	result := &Result{Strategy: cmd.Strategy, DryRun: cmd.DryRun, Changes: []Change{}, Errors: []ItemError{}}
+
+	local, err := s.localMovies(ctx, result)
+	if err != nil {
+		return nil, err
+	}
+
+	remote, err := s.dial(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to remote server: %w", err)
+	}
+	defer remote.Close()
+
+	var missing, matched []pair
+	pending := make(map[string]int) // Remote ID of each missing movie by key
+	err = remote.EachMovie(ctx, func(movie *movieApp.MovieDTO) error {
+		result.RemoteMovies++
+		key := matchKey(movie.Title, movie.Year)
+		if existing, ok := local[key]; ok {
+			matched = append(matched, pair{remote: movie, local: existing})
+			return nil
+		}
+		if first, ok := pending[key]; ok {
+			result.fail(movie, fmt.Errorf("same title and year as remote movie %d, which is pulled instead", first))
+			return nil
+		}
+		pending[key] = movie.ID
+		missing = append(missing, pair{remote: movie})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read remote movies: %w", err)
+	}
+
+	for start := 0; start < len(missing); start += createBatchSize {
+		if err := s.create(ctx, remote, cmd, missing[start:min(start+createBatchSize, len(missing))], result); err != nil {
+			return nil, err
+		}
+	}
+	for _, p := range matched {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		s.reconcile(ctx, remote, cmd, p, result)
+	}
+	return result, nil
+}
+
+// localMovies reads the local library keyed by title and year; the first
+// of several movies with the same key is the one matched
+func (s *Service) localMovies(ctx context.Context, result *Result) (map[string]*movieApp.MovieDTO, error) {
+	local := make(map[string]*movieApp.MovieDTO)
+	afterID := 0
+	for {
+		movies, err := s.movieService.SearchMovies(ctx, movieApp.SearchMoviesQuery{
+			AfterID: afterID,
+			Limit:   createBatchSize,
+			Sort:    []movieApp.SortKey{{Field: "id"}},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read local movies: %w", err)
+		}
+		for _, movie := range movies {
+			key := matchKey(movie.Title, movie.Year)
+			if _, ok := local[key]; !ok {
+				local[key] = movie
+			}
+		}
+		result.LocalMovies += len(movies)
+		if len(movies) < createBatchSize {
+			return local, nil
+		}
+		afterID = movies[len(movies)-1].ID
+	}
+}
+
+// create adds a batch of remote movies the local library lacks
+func (s *Service) create(ctx context.Context, remote Remote, cmd SyncCommand, batch []pair, result *Result) error {
+	if cmd.DryRun {
+		for _, p := range batch {
+			result.Created++
+			result.record(Change{Action: ActionCreated, RemoteID: p.remote.ID, Title: p.remote.Title, Year: p.remote.Year})
+		}
+		return nil
+	}
+
+	cmds := make([]movieApp.CreateMovieCommand, len(batch))
+	for i, p := range batch {
+		cmds[i] = createCommand(p.remote)
+	}
+	created, errs, err := s.movieService.CreateMovies(ctx, cmds)
+	if err != nil {
+		return fmt.Errorf("failed to create remote movies: %w", err)
+	}
+	for i, p := range batch {
+		if errs[i] != nil {
+			result.fail(p.remote, errs[i])
+			continue
+		}
+		result.Created++
+		result.record(Change{Action: ActionCreated, LocalID: created[i].ID, RemoteID: p.remote.ID, Title: created[i].Title, Year: created[i].Year})
+		if !cmd.SkipPosters {
+			s.copyPoster(ctx, remote, p.remote, created[i].ID, result)
+		}
+	}
+	return nil
+}
+
+// reconcile resolves a movie both libraries have
+func (s *Service) reconcile(ctx context.Context, remote Remote, cmd SyncCommand, p pair, result *Result) {
+	fields := differingFields(p.local, p.remote)
+	change := Change{LocalID: p.local.ID, RemoteID: p.remote.ID, Title: p.local.Title, Year: p.local.Year, Fields: fields}
+
+	overwrite := false
+	switch {
+	case len(fields) == 0:
+		result.Unchanged++
+	case remoteWins(cmd.Strategy, p):
+		overwrite = true
+		change.Action = ActionUpdated
+		if !cmd.DryRun {
+			if _, err := s.movieService.UpdateMovie(ctx, updateCommand(p.local.ID, p.remote)); err != nil {
+				result.fail(p.remote, err)
+				return
+			}
+		}
+		result.Updated++
+		result.record(change)
+	default:
+		change.Action = ActionKept
+		result.Kept++
+		result.record(change)
+	}
+
+	if cmd.DryRun || cmd.SkipPosters {
+		return
+	}
+	if !overwrite {
+		// Only a movie without a poster of its own gets the remote one
+		_, err := s.posterService.GetPoster(ctx, p.local.ID)
+		if !errors.Is(err, shared.ErrNotFound) {
+			return
+		}
+	}
+	s.copyPoster(ctx, remote, p.remote, p.local.ID, result)
+}
+
+// copyPoster stores the remote movie's poster, if it has one, as the local
+// movie's
+func (s *Service) copyPoster(ctx context.Context, remote Remote, movie *movieApp.MovieDTO, localID int, result *Result) {
+	data, mimeType, err := remote.Poster(ctx, movie.ID)
+	if errors.Is(err, shared.ErrNotFound) {
+		return
+	}
+	if err == nil {
+		_, err = s.posterService.UploadPoster(ctx, posterApp.UploadPosterCommand{
+			MovieID:  localID,
+			Data:     base64.StdEncoding.EncodeToString(data),
+			MimeType: mimeType,
+		})
+	}
+	if err != nil {
+		result.fail(movie, fmt.Errorf("poster not copied: %w", err))
+		return
+	}
+	result.PostersCopied++
+}
+
+// record lists a change, keeping the first few
+func (r *Result) record(change Change) {
+	if len(r.Changes) < maxChanges {
+		r.Changes = append(r.Changes, change)
+	}
+}
+
+// fail reports a remote movie that could not be pulled
+func (r *Result) fail(movie *movieApp.MovieDTO, err error) {
+	r.Errors = append(r.Errors, ItemError{RemoteID: movie.ID, Title: movie.Title, Error: err.Error()})
+}
+
+// remoteWins reports whether the strategy overwrites the local movie
+func remoteWins(strategy Strategy, p pair) bool {
+	switch strategy {
+	case StrategyPreferRemote:
+		return true
+	case StrategyNewest:
+		remoteAt, remoteErr := time.Parse(serialization.TimeLayout, p.remote.UpdatedAt)
+		localAt, localErr := time.Parse(serialization.TimeLayout, p.local.UpdatedAt)
+		return remoteErr == nil && (localErr != nil || remoteAt.After(localAt))
+	}
+	return false
+}
+
+// matchKey identifies a movie across libraries by its title, ignoring case
+// and spacing, and its year
+func matchKey(title string, year int) string {
+	return strings.ToLower(strings.Join(strings.Fields(title), " ")) + "|" + strconv.Itoa(year)
+}
+
+// differingFields lists the fields, other than title and year, in which two
+// matched movies differ
+func differingFields(local, remote *movieApp.MovieDTO) []string {
+	var fields []string
+	if local.Title != remote.Title {
+		fields = append(fields, "title")
+	}
+	if local.Director != remote.Director {
+		fields = append(fields, "director")
+	}
+	if local.Rating != remote.Rating {
+		fields = append(fields, "rating")
+	}
+	if !slices.Equal(sortedCopy(local.Genres), sortedCopy(remote.Genres)) {
+		fields = append(fields, "genres")
+	}
+	if local.PosterURL != remote.PosterURL {
+		fields = append(fields, "poster_url")
+	}
+	if local.ReleaseDate != remote.ReleaseDate {
+		fields = append(fields, "release_date")
+	}
+	if local.Duration != remote.Duration {
+		fields = append(fields, "duration")
+	}
+	if !maps.Equal(local.Certifications, remote.Certifications) {
+		fields = append(fields, "certifications")
+	}
+	if !slices.Equal(sortedCopy(local.ContentWarnings), sortedCopy(remote.ContentWarnings)) {
+		fields = append(fields, "content_warnings")
+	}
+	return fields
+}
+
+// sortedCopy returns values sorted without changing them
+func sortedCopy(values []string) []string {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	return sorted
+}
+
+// createCommand converts a remote movie to a create command
+func createCommand(movie *movieApp.MovieDTO) movieApp.CreateMovieCommand {
+	released, _ := time.Parse(time.DateOnly, movie.ReleaseDate) // Zero when absent
+	return movieApp.CreateMovieCommand{
+		Title:       movie.Title,
+		Director:    movie.Director,
+		Year:        movie.Year,
+		ReleaseDate: released,
+		Rating:      movie.Rating,
+		Duration:    movie.Duration,
+		Genres:      movie.Genres,
+		PosterURL:   movie.PosterURL,
+
+		Certifications:  movie.Certifications,
+		ContentWarnings: movie.ContentWarnings,
+
+		// The remote server accepted the movie; only its own rules apply
+		Validation: shared.ValidationLenient,
+	}
+}
+
+// updateCommand overwrites every field of a local movie with the remote one's
+func updateCommand(localID int, movie *movieApp.MovieDTO) movieApp.UpdateMovieCommand {
+	released, _ := time.Parse(time.DateOnly, movie.ReleaseDate) // The zero time clears the date
+	return movieApp.UpdateMovieCommand{
+		ID:          localID,
+		Title:       &movie.Title,
+		Director:    &movie.Director,
+		Year:        &movie.Year,
+		ReleaseDate: &released,
+		Rating:      &movie.Rating,
+		Duration:    &movie.Duration,
+		Genres:      nonNil(movie.Genres),
+		PosterURL:   &movie.PosterURL,
+
+		Certifications:  nonNilMap(movie.Certifications),
+		ContentWarnings: nonNil(movie.ContentWarnings),
+
+		Validation: shared.ValidationLenient,
+	}
+}
+
+// nonNil returns values, or an empty slice so an update clears the field
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// nonNilMap returns values, or an empty map so an update clears the field
+func nonNilMap(values map[string]string) map[string]string {
+	if values == nil {
+		return map[string]string{}
+	}
+	return values
+}
@@ -0,0 +1,243 @@
+package librarysync
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	posterApp "github.com/francknouama/movies-mcp-server/internal/application/poster"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// fakeRemote is a remote library held in memory
+type fakeRemote struct {
+	movies  []*movieApp.MovieDTO
+	posters map[int][]byte
+	closed  bool
+}
+
+func (r *fakeRemote) EachMovie(ctx context.Context, fn func(*movieApp.MovieDTO) error) error {
+	for _, movie := range r.movies {
+		if err := fn(movie); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *fakeRemote) Poster(ctx context.Context, movieID int) ([]byte, string, error) {
+	data, ok := r.posters[movieID]
+	if !ok {
+		return nil, "", shared.NewNotFoundError("no poster")
+	}
+	return data, "image/png", nil
+}
+
+func (r *fakeRemote) Close() error {
+	r.closed = true
+	return nil
+}
+
+// fakeLibrary is the local library held in memory
+type fakeLibrary struct {
+	movies  []*movieApp.MovieDTO
+	posters map[int]string
+	updates []movieApp.UpdateMovieCommand
+}
+
+func (l *fakeLibrary) SearchMovies(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+	var matches []*movieApp.MovieDTO
+	for _, movie := range l.movies {
+		if movie.ID > query.AfterID && len(matches) < query.Limit {
+			matches = append(matches, movie)
+		}
+	}
+	return matches, nil
+}
+
+func (l *fakeLibrary) CreateMovies(ctx context.Context, cmds []movieApp.CreateMovieCommand) ([]*movieApp.MovieDTO, []error, error) {
+	movies := make([]*movieApp.MovieDTO, len(cmds))
+	errs := make([]error, len(cmds))
+	for i, cmd := range cmds {
+		if cmd.Director == "" {
+			errs[i] = shared.NewValidationError("director is required")
+			continue
+		}
+		movies[i] = &movieApp.MovieDTO{ID: 100 + len(l.movies), Title: cmd.Title, Director: cmd.Director, Year: cmd.Year}
+		l.movies = append(l.movies, movies[i])
+	}
+	return movies, errs, nil
+}
+
+func (l *fakeLibrary) UpdateMovie(ctx context.Context, cmd movieApp.UpdateMovieCommand) (*movieApp.MovieDTO, error) {
+	l.updates = append(l.updates, cmd)
+	return &movieApp.MovieDTO{ID: cmd.ID}, nil
+}
+
+func (l *fakeLibrary) GetPoster(ctx context.Context, movieID int) (*posterApp.PosterImageDTO, error) {
+	if _, ok := l.posters[movieID]; !ok {
+		return nil, shared.NewNotFoundError("poster not found")
+	}
+	return &posterApp.PosterImageDTO{}, nil
+}
+
+func (l *fakeLibrary) UploadPoster(ctx context.Context, cmd posterApp.UploadPosterCommand) (*posterApp.PosterDTO, error) {
+	data, err := base64.StdEncoding.DecodeString(cmd.Data)
+	if err != nil {
+		return nil, err
+	}
+	l.posters[cmd.MovieID] = string(data)
+	return &posterApp.PosterDTO{MovieID: cmd.MovieID}, nil
+}
+
+// libraries returns a local library and a remote one sharing Heat, which
+// differs in rating, and Ronin, which is the same; only the remote has Thief
+func libraries() (*fakeLibrary, *fakeRemote) {
+	local := &fakeLibrary{
+		movies: []*movieApp.MovieDTO{
+			{ID: 1, Title: "Heat", Director: "Michael Mann", Year: 1995, Rating: 8.0, UpdatedAt: "2026-01-01T00:00:00Z"},
+			{ID: 2, Title: "Ronin", Director: "John Frankenheimer", Year: 1998, Genres: []string{"Action", "Thriller"}},
+		},
+		posters: map[int]string{2: "local ronin"},
+	}
+	remote := &fakeRemote{
+		movies: []*movieApp.MovieDTO{
+			{ID: 7, Title: "heat ", Director: "Michael Mann", Year: 1995, Rating: 8.3, UpdatedAt: "2026-02-01T00:00:00Z"},
+			{ID: 8, Title: "Ronin", Director: "John Frankenheimer", Year: 1998, Genres: []string{"Thriller", "Action"}},
+			{ID: 9, Title: "Thief", Director: "Michael Mann", Year: 1981},
+		},
+		posters: map[int][]byte{7: []byte("remote heat"), 8: []byte("remote ronin"), 9: []byte("remote thief")},
+	}
+	return local, remote
+}
+
+// newTestService syncs local from remote
+func newTestService(local *fakeLibrary, remote *fakeRemote) *Service {
+	return NewService(local, local, func(ctx context.Context) (Remote, error) { return remote, nil })
+}
+
+func TestSync_PreferLocal(t *testing.T) {
+	local, remote := libraries()
+
+	result, err := newTestService(local, remote).Sync(context.Background(), SyncCommand{})
+	if err != nil {
+		t.Fatalf("Expected the sync to succeed, got %v", err)
+	}
+	if result.Strategy != StrategyPreferLocal || result.RemoteMovies != 3 || result.LocalMovies != 2 {
+		t.Errorf("Unexpected result %+v", result)
+	}
+	if result.Created != 1 || result.Kept != 1 || result.Unchanged != 1 || result.Updated != 0 || len(local.updates) != 0 {
+		t.Errorf("Expected Thief created and Heat kept, got %+v", result)
+	}
+	if len(result.Changes) != 2 || result.Changes[0].Action != ActionCreated || result.Changes[0].LocalID != 102 {
+		t.Errorf("Expected Thief's change first, got %+v", result.Changes)
+	}
+	if kept := result.Changes[1]; kept.Action != ActionKept || kept.LocalID != 1 || len(kept.Fields) != 2 {
+		t.Errorf("Expected Heat kept with its title and rating differing, got %+v", kept)
+	}
+
+	// Thief brings its poster, Heat gets the remote one it lacked, and
+	// Ronin keeps its own
+	if result.PostersCopied != 2 || local.posters[102] != "remote thief" || local.posters[1] != "remote heat" || local.posters[2] != "local ronin" {
+		t.Errorf("Unexpected posters %v after copying %d", local.posters, result.PostersCopied)
+	}
+	if !remote.closed {
+		t.Error("Expected the remote to be closed")
+	}
+}
+
+func TestSync_PreferRemote(t *testing.T) {
+	local, remote := libraries()
+
+	result, err := newTestService(local, remote).Sync(context.Background(), SyncCommand{Strategy: StrategyPreferRemote, SkipPosters: true})
+	if err != nil {
+		t.Fatalf("Expected the sync to succeed, got %v", err)
+	}
+	if result.Updated != 1 || len(local.updates) != 1 {
+		t.Fatalf("Expected Heat updated, got %+v", result)
+	}
+	update := local.updates[0]
+	if update.ID != 1 || *update.Rating != 8.3 || *update.Title != "heat " || update.Genres == nil || update.Certifications == nil {
+		t.Errorf("Expected every field of Heat overwritten, got %+v", update)
+	}
+	if result.PostersCopied != 0 || len(local.posters) != 1 {
+		t.Errorf("Expected posters skipped, got %v", local.posters)
+	}
+}
+
+func TestSync_Newest(t *testing.T) {
+	local, remote := libraries()
+	remote.movies[0].UpdatedAt = "2025-12-01T00:00:00Z"
+
+	result, err := newTestService(local, remote).Sync(context.Background(), SyncCommand{Strategy: StrategyNewest})
+	if err != nil {
+		t.Fatalf("Expected the sync to succeed, got %v", err)
+	}
+	if result.Kept != 1 || result.Updated != 0 {
+		t.Errorf("Expected the newer local Heat kept, got %+v", result)
+	}
+
+	local, remote = libraries()
+	result, err = newTestService(local, remote).Sync(context.Background(), SyncCommand{Strategy: StrategyNewest})
+	if err != nil || result.Updated != 1 || local.posters[1] != "remote heat" {
+		t.Errorf("Expected the newer remote Heat and its poster pulled, got %+v, %v", result, err)
+	}
+}
+
+func TestSync_DryRunWritesNothing(t *testing.T) {
+	local, remote := libraries()
+
+	result, err := newTestService(local, remote).Sync(context.Background(), SyncCommand{Strategy: StrategyPreferRemote, DryRun: true})
+	if err != nil {
+		t.Fatalf("Expected the sync to succeed, got %v", err)
+	}
+	if !result.DryRun || result.Created != 1 || result.Updated != 1 || result.Changes[0].LocalID != 0 {
+		t.Errorf("Expected the changes reported, got %+v", result)
+	}
+	if len(local.movies) != 2 || len(local.updates) != 0 || len(local.posters) != 1 {
+		t.Errorf("Expected the library unchanged, got %d movies, %d updates, %d posters", len(local.movies), len(local.updates), len(local.posters))
+	}
+}
+
+func TestSync_ReportsFailedMovies(t *testing.T) {
+	local, remote := libraries()
+	remote.movies = append(remote.movies,
+		&movieApp.MovieDTO{ID: 10, Title: "Thief", Year: 1981},
+		&movieApp.MovieDTO{ID: 11, Title: "Manhunter", Year: 1986},
+	)
+
+	result, err := newTestService(local, remote).Sync(context.Background(), SyncCommand{})
+	if err != nil {
+		t.Fatalf("Expected the sync to succeed, got %v", err)
+	}
+	if result.Created != 1 || len(result.Errors) != 2 {
+		t.Fatalf("Expected the duplicate and the invalid movie reported, got %+v", result)
+	}
+	if result.Errors[0].RemoteID != 10 || result.Errors[1].RemoteID != 11 || result.Errors[1].Error != "director is required" {
+		t.Errorf("Unexpected errors %+v", result.Errors)
+	}
+}
+
+func TestSync_DialFails(t *testing.T) {
+	local, _ := libraries()
+	service := NewService(local, local, func(ctx context.Context) (Remote, error) {
+		return nil, errors.New("connection refused")
+	})
+
+	if _, err := service.Sync(context.Background(), SyncCommand{}); err == nil {
+		t.Error("Expected the sync to fail")
+	}
+}
+
+func TestParseStrategy(t *testing.T) {
+	for input, want := range map[string]Strategy{"": StrategyPreferLocal, "Prefer-Remote": StrategyPreferRemote, "newest": StrategyNewest} {
+		if got, err := ParseStrategy(input); err != nil || got != want {
+			t.Errorf("ParseStrategy(%q) = %q, %v; want %q", input, got, err, want)
+		}
+	}
+	if _, err := ParseStrategy("oldest"); !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("Expected an unknown strategy to be rejected, got %v", err)
+	}
+}
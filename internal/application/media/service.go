@@ -0,0 +1,164 @@
+package media
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/media"
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// Service provides application-level movie media operations
+type Service struct {
+	mediaRepo media.Repository
+	movieRepo movie.Reader
+}
+
+// NewService creates a new media application service
+func NewService(mediaRepo media.Repository, movieRepo movie.Reader) *Service {
+	return &Service{
+		mediaRepo: mediaRepo,
+		movieRepo: movieRepo,
+	}
+}
+
+// AddMediaCommand represents the command to link media from a movie
+type AddMediaCommand struct {
+	MovieID  int
+	Type     string // trailer, teaser, clip or still; defaults to trailer
+	URL      string
+	Provider string // Derived from the URL's host when empty
+	Title    string
+	Primary  bool // Make this the movie's primary trailer
+}
+
+// MediaDTO represents a movie media data transfer object
+type MediaDTO struct {
+	ID       int    `json:"id"`
+	MovieID  int    `json:"movie_id"`
+	Type     string `json:"type"`
+	URL      string `json:"url"`
+	Provider string `json:"provider"`
+	Title    string `json:"title,omitempty"`
+	Primary  bool   `json:"primary"`
+}
+
+// MovieMediaDTO represents the media linked from a movie
+type MovieMediaDTO struct {
+	MovieID           int         `json:"movie_id"`
+	Title             string      `json:"title"`
+	Year              int         `json:"year"`
+	PrimaryTrailerURL string      `json:"primary_trailer_url,omitempty"`
+	Media             []*MediaDTO `json:"media"`
+}
+
+// AddMedia links a trailer, clip or still from a movie. Adding a URL the
+// movie already links updates that entry instead of duplicating it.
+func (s *Service) AddMedia(ctx context.Context, cmd AddMediaCommand) (*MediaDTO, error) {
+	domainMovie, err := s.findMovie(ctx, cmd.MovieID)
+	if err != nil {
+		return nil, err
+	}
+
+	domainMedia, err := media.NewMedia(domainMovie.ID(), cmd.Type, cmd.URL, cmd.Provider, cmd.Title, cmd.Primary)
+	if err != nil {
+		return nil, fmt.Errorf("invalid media: %w", err)
+	}
+
+	if err := s.mediaRepo.Save(ctx, domainMedia); err != nil {
+		return nil, fmt.Errorf("failed to save media: %w", err)
+	}
+
+	primary, err := s.mediaRepo.FindPrimaryTrailer(ctx, domainMovie.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get primary trailer: %w", err)
+	}
+	return s.toDTO(domainMedia, primary), nil
+}
+
+// GetMovieMedia retrieves a movie's media, optionally of a single type,
+// along with its primary trailer
+func (s *Service) GetMovieMedia(ctx context.Context, movieID int, mediaType string) (*MovieMediaDTO, error) {
+	domainMovie, err := s.findMovie(ctx, movieID)
+	if err != nil {
+		return nil, err
+	}
+
+	var filter media.Type
+	if mediaType != "" {
+		if filter, err = media.ParseType(mediaType); err != nil {
+			return nil, fmt.Errorf("invalid media type: %w", err)
+		}
+	}
+
+	entries, err := s.mediaRepo.FindByMovieID(ctx, domainMovie.ID(), filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get media: %w", err)
+	}
+
+	primary, err := s.mediaRepo.FindPrimaryTrailer(ctx, domainMovie.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get primary trailer: %w", err)
+	}
+
+	dto := &MovieMediaDTO{
+		MovieID: domainMovie.ID().Value(),
+		Title:   domainMovie.Title(),
+		Year:    domainMovie.Year().Value(),
+		Media:   make([]*MediaDTO, 0, len(entries)),
+	}
+	if primary != nil {
+		dto.PrimaryTrailerURL = primary.URL()
+	}
+	for _, entry := range entries {
+		dto.Media = append(dto.Media, s.toDTO(entry, primary))
+	}
+	return dto, nil
+}
+
+// PrimaryTrailerURL returns the URL of a movie's primary trailer, or an
+// empty string if the movie has no trailers
+func (s *Service) PrimaryTrailerURL(ctx context.Context, movieID int) (string, error) {
+	id, err := shared.NewMovieID(movieID)
+	if err != nil {
+		return "", fmt.Errorf("invalid movie ID: %w", err)
+	}
+
+	primary, err := s.mediaRepo.FindPrimaryTrailer(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("failed to get primary trailer: %w", err)
+	}
+	if primary == nil {
+		return "", nil
+	}
+	return primary.URL(), nil
+}
+
+// toDTO converts domain media to a DTO. Primary reports whether the entry
+// is the movie's primary trailer, whether flagged or by falling back to the
+// oldest trailer.
+func (s *Service) toDTO(domainMedia, primary *media.Media) *MediaDTO {
+	return &MediaDTO{
+		ID:       domainMedia.ID(),
+		MovieID:  domainMedia.MovieID().Value(),
+		Type:     string(domainMedia.Type()),
+		URL:      domainMedia.URL(),
+		Provider: domainMedia.Provider(),
+		Title:    domainMedia.Title(),
+		Primary:  primary != nil && primary.ID() == domainMedia.ID(),
+	}
+}
+
+func (s *Service) findMovie(ctx context.Context, id int) (*movie.Movie, error) {
+	movieID, err := shared.NewMovieID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid movie ID: %w", err)
+	}
+
+	domainMovie, err := s.movieRepo.FindByID(ctx, movieID)
+	if err != nil {
+		return nil, fmt.Errorf("movie not found: %w", err)
+	}
+	return domainMovie, nil
+}
@@ -0,0 +1,172 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/media"
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// MockMovieReader implements the FindByID part of movie.Reader for testing
+type MockMovieReader struct {
+	movie.Reader
+	movies map[int]*movie.Movie
+}
+
+func (m *MockMovieReader) FindByID(ctx context.Context, id shared.MovieID) (*movie.Movie, error) {
+	if found, exists := m.movies[id.Value()]; exists {
+		return found, nil
+	}
+	return nil, errors.New("movie not found")
+}
+
+// MockMediaRepository implements media.Repository for testing
+type MockMediaRepository struct {
+	media  []*media.Media // In insertion order
+	nextID int
+}
+
+func (m *MockMediaRepository) FindByMovieID(ctx context.Context, movieID shared.MovieID, mediaType media.Type) ([]*media.Media, error) {
+	var result []*media.Media
+	for _, entry := range m.media {
+		if entry.MovieID() == movieID && (mediaType == "" || entry.Type() == mediaType) {
+			result = append(result, entry)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockMediaRepository) FindPrimaryTrailer(ctx context.Context, movieID shared.MovieID) (*media.Media, error) {
+	var oldest *media.Media
+	for _, entry := range m.media {
+		if entry.MovieID() != movieID || entry.Type() != media.TypeTrailer {
+			continue
+		}
+		if entry.IsPrimary() {
+			return entry, nil
+		}
+		if oldest == nil {
+			oldest = entry
+		}
+	}
+	return oldest, nil
+}
+
+func (m *MockMediaRepository) Save(ctx context.Context, entry *media.Media) error {
+	m.nextID++
+	entry.SetID(m.nextID)
+	m.media = append(m.media, entry)
+	return nil
+}
+
+func newTestService(t *testing.T) (*Service, *MockMediaRepository) {
+	t.Helper()
+
+	movieID, _ := shared.NewMovieID(1)
+	domainMovie, err := movie.NewMovieWithID(movieID, "Inception", "Christopher Nolan", 2010)
+	if err != nil {
+		t.Fatalf("failed to create movie: %v", err)
+	}
+
+	repo := &MockMediaRepository{}
+	return NewService(repo, &MockMovieReader{movies: map[int]*movie.Movie{1: domainMovie}}), repo
+}
+
+func TestService_AddMedia(t *testing.T) {
+	service, repo := newTestService(t)
+
+	dto, err := service.AddMedia(context.Background(), AddMediaCommand{
+		MovieID: 1,
+		URL:     "https://www.youtube.com/watch?v=YoHD9XEInc0",
+		Title:   "Official Trailer",
+	})
+	if err != nil {
+		t.Fatalf("AddMedia() error = %v", err)
+	}
+
+	if dto.Type != "trailer" || dto.Provider != "YouTube" {
+		t.Errorf("Expected YouTube trailer, got: %+v", dto)
+	}
+	if !dto.Primary {
+		t.Error("Expected the only trailer to be primary")
+	}
+	if len(repo.media) != 1 {
+		t.Errorf("Expected media to be saved, got: %d", len(repo.media))
+	}
+}
+
+func TestService_AddMedia_Errors(t *testing.T) {
+	service, _ := newTestService(t)
+
+	tests := []struct {
+		name string
+		cmd  AddMediaCommand
+	}{
+		{name: "unknown movie", cmd: AddMediaCommand{MovieID: 99, URL: "https://youtu.be/abc"}},
+		{name: "invalid type", cmd: AddMediaCommand{MovieID: 1, Type: "poster", URL: "https://youtu.be/abc"}},
+		{name: "invalid url", cmd: AddMediaCommand{MovieID: 1, URL: "youtu.be/abc"}},
+		{name: "primary still", cmd: AddMediaCommand{MovieID: 1, Type: "still", URL: "https://example.com/a.jpg", Primary: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := service.AddMedia(context.Background(), tt.cmd); err == nil {
+				t.Error("Expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestService_GetMovieMedia(t *testing.T) {
+	service, _ := newTestService(t)
+	ctx := context.Background()
+
+	_, _ = service.AddMedia(ctx, AddMediaCommand{MovieID: 1, URL: "https://youtu.be/teaser", Type: "teaser"})
+	_, _ = service.AddMedia(ctx, AddMediaCommand{MovieID: 1, URL: "https://youtu.be/first"})
+	_, _ = service.AddMedia(ctx, AddMediaCommand{MovieID: 1, URL: "https://youtu.be/second", Primary: true})
+
+	dto, err := service.GetMovieMedia(ctx, 1, "")
+	if err != nil {
+		t.Fatalf("GetMovieMedia() error = %v", err)
+	}
+
+	if dto.Title != "Inception" || len(dto.Media) != 3 {
+		t.Errorf("Expected 3 media for Inception, got: %s with %d", dto.Title, len(dto.Media))
+	}
+	if dto.PrimaryTrailerURL != "https://youtu.be/second" {
+		t.Errorf("Expected flagged trailer to be primary, got: %s", dto.PrimaryTrailerURL)
+	}
+
+	teasers, err := service.GetMovieMedia(ctx, 1, "Teaser")
+	if err != nil {
+		t.Fatalf("GetMovieMedia() error = %v", err)
+	}
+	if len(teasers.Media) != 1 || teasers.PrimaryTrailerURL != "https://youtu.be/second" {
+		t.Errorf("Expected 1 teaser and the primary trailer, got: %d and %q", len(teasers.Media), teasers.PrimaryTrailerURL)
+	}
+
+	if _, err := service.GetMovieMedia(ctx, 1, "poster"); err == nil {
+		t.Error("Expected error for invalid media type")
+	}
+}
+
+func TestService_PrimaryTrailerURL(t *testing.T) {
+	service, _ := newTestService(t)
+	ctx := context.Background()
+
+	url, err := service.PrimaryTrailerURL(ctx, 1)
+	if err != nil || url != "" {
+		t.Errorf("Expected no trailer, got: %q (%v)", url, err)
+	}
+
+	_, _ = service.AddMedia(ctx, AddMediaCommand{MovieID: 1, URL: "https://example.com/clip.mp4", Type: "clip"})
+	_, _ = service.AddMedia(ctx, AddMediaCommand{MovieID: 1, URL: "https://vimeo.com/1"})
+
+	url, err = service.PrimaryTrailerURL(ctx, 1)
+	if err != nil || url != "https://vimeo.com/1" {
+		t.Errorf("Expected oldest trailer, got: %q (%v)", url, err)
+	}
+}
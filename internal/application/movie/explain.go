@@ -0,0 +1,135 @@
+package movie
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+)
+
+// ExplanationDTO explains why a movie matched a search and where it sorts
+type ExplanationDTO struct {
+	Matched []MatchDTO     `json:"matched"`         // One entry per criterion the search applied
+	Sort    []SortValueDTO `json:"sort"`            // The movie's value for each sort key, in order
+	Score   float64        `json:"score,omitempty"` // Fuzzy title similarity or weighted score, when results are ranked by it
+	Rank    int            `json:"rank"`            // 1-based position, counting skipped pages
+
+	Signals []SignalScoreDTO `json:"signals,omitempty"` // What the weighted score is made of, in a ranked search
+}
+
+// MatchDTO describes how a movie satisfies one search criterion
+type MatchDTO struct {
+	Criterion string `json:"criterion"` // title, director, genre, year, rating, release_date, duration, certification or content_warnings
+	Detail    string `json:"detail"`
+}
+
+// SortValueDTO is a movie's value for one sort key
+type SortValueDTO struct {
+	Field     string `json:"field"`
+	Direction string `json:"direction"`
+	Value     string `json:"value"`
+}
+
+// explain describes how a movie matched the criteria it was found with.
+// fuzzyTitle is the title a fuzzy search ranked by, which never reaches the
+// criteria.
+func explain(criteria movie.SearchCriteria, fuzzyTitle string, dto *MovieDTO, rank int) *ExplanationDTO {
+	explanation := &ExplanationDTO{Matched: []MatchDTO{}, Rank: rank}
+	match := func(criterion, format string, args ...any) {
+		explanation.Matched = append(explanation.Matched, MatchDTO{Criterion: criterion, Detail: fmt.Sprintf(format, args...)})
+	}
+
+	switch {
+	case fuzzyTitle != "":
+		match("title", "%q is %g similar to %q", dto.Title, dto.Similarity, fuzzyTitle)
+	case criteria.Title != "":
+		match("title", "%q contains %q", dto.Title, criteria.Title)
+	}
+	if criteria.Director != "" {
+		match("director", "%q contains %q", dto.Director, criteria.Director)
+	}
+	if criteria.Genre != "" {
+		match("genre", "%s is among %s", criteria.Genre, strings.Join(dto.Genres, ", "))
+	}
+	if minYear, maxYear := criteria.YearRange(); minYear > 0 || maxYear > 0 {
+		match("year", "%d %s", dto.Year, window(minYear, maxYear))
+	}
+	if criteria.MinRating > 0 || criteria.MaxRating > 0 {
+		match("rating", "%g %s", dto.Rating, window(criteria.MinRating, criteria.MaxRating))
+	}
+	if !criteria.ReleasedAfter.IsZero() || !criteria.ReleasedBefore.IsZero() {
+		if dto.ReleaseDate == "" {
+			match("release_date", "only the year %d is known, which matches every date in it", dto.Year)
+		} else {
+			var after, before string
+			if !criteria.ReleasedAfter.IsZero() {
+				after = criteria.ReleasedAfter.Format(time.DateOnly)
+			}
+			if !criteria.ReleasedBefore.IsZero() {
+				before = criteria.ReleasedBefore.Format(time.DateOnly)
+			}
+			match("release_date", "%s %s", dto.ReleaseDate, window(after, before))
+		}
+	}
+	if criteria.MinDuration > 0 || criteria.MaxDuration > 0 {
+		match("duration", "%d minutes %s", dto.Duration, window(criteria.MinDuration, criteria.MaxDuration))
+	}
+	if criteria.CertificationRegion != "" && len(criteria.Certifications) > 0 {
+		match("certification", "rated %s in %s, one of %s", dto.Certifications[criteria.CertificationRegion],
+			criteria.CertificationRegion, strings.Join(criteria.Certifications, ", "))
+	}
+	if len(criteria.ExcludeWarnings) > 0 {
+		match("content_warnings", "carries none of %s", strings.Join(criteria.ExcludeWarnings, ", "))
+	}
+
+	if fuzzyTitle != "" {
+		explanation.Score = dto.Similarity
+		explanation.Sort = []SortValueDTO{{Field: "similarity", Direction: string(movie.OrderDesc), Value: strconv.FormatFloat(dto.Similarity, 'g', -1, 64)}}
+		return explanation
+	}
+
+	keys := criteria.Sort
+	if len(keys) == 0 {
+		keys = []movie.SortKey{{Field: criteria.OrderBy, Dir: criteria.OrderDir}}
+	}
+	explanation.Sort = make([]SortValueDTO, len(keys))
+	for i, key := range keys {
+		explanation.Sort[i] = SortValueDTO{Field: string(key.Field), Direction: string(key.Dir), Value: sortValue(key.Field, dto)}
+	}
+	return explanation
+}
+
+// window describes the bounds a value fell within; a zero bound is open
+func window[T int | float64 | string](low, high T) string {
+	var zero T
+	switch {
+	case low != zero && high != zero:
+		return fmt.Sprintf("is within %v to %v", low, high)
+	case low != zero:
+		return fmt.Sprintf("is at least %v", low)
+	default:
+		return fmt.Sprintf("is at most %v", high)
+	}
+}
+
+// sortValue returns a movie's value for a sort field
+func sortValue(field movie.OrderBy, dto *MovieDTO) string {
+	switch field {
+	case movie.OrderByDirector:
+		return dto.Director
+	case movie.OrderByYear:
+		return strconv.Itoa(dto.Year)
+	case movie.OrderByRating:
+		return strconv.FormatFloat(dto.Rating, 'g', -1, 64)
+	case movie.OrderByCreatedAt:
+		return dto.CreatedAt
+	case movie.OrderByUpdatedAt:
+		return dto.UpdatedAt
+	case movie.OrderByID:
+		return strconv.Itoa(dto.ID)
+	default:
+		return dto.Title
+	}
+}
@@ -0,0 +1,104 @@
+package movie
+
+import (
+	"testing"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+)
+
+func TestExplain(t *testing.T) {
+	dto := &MovieDTO{
+		ID:             1,
+		Title:          "The Matrix",
+		Director:       "Lana Wachowski",
+		Year:           1999,
+		Rating:         8.7,
+		Genres:         []string{"Action", "Sci-Fi"},
+		ReleaseDate:    "1999-03-31",
+		Duration:       136,
+		Certifications: map[string]string{"US": "R"},
+	}
+	criteria := movie.SearchCriteria{
+		Title:               "matrix",
+		Genre:               "Sci-Fi",
+		MinYear:             1990,
+		MaxYear:             1999,
+		MinRating:           8,
+		ReleasedAfter:       time.Date(1999, 3, 1, 0, 0, 0, 0, time.UTC),
+		MaxDuration:         150,
+		CertificationRegion: "US",
+		Certifications:      []string{"G", "PG", "PG-13", "R"},
+		ExcludeWarnings:     []string{"gore"},
+		Sort: []movie.SortKey{
+			{Field: movie.OrderByRating, Dir: movie.OrderDesc},
+			{Field: movie.OrderByYear, Dir: movie.OrderAsc},
+		},
+	}
+
+	explanation := explain(criteria, "", dto, 3)
+
+	want := map[string]string{
+		"title":            `"The Matrix" contains "matrix"`,
+		"genre":            "Sci-Fi is among Action, Sci-Fi",
+		"year":             "1999 is within 1999 to 1999",
+		"rating":           "8.7 is at least 8",
+		"release_date":     "1999-03-31 is at least 1999-03-01",
+		"duration":         "136 minutes is at most 150",
+		"certification":    "rated R in US, one of G, PG, PG-13, R",
+		"content_warnings": "carries none of gore",
+	}
+	if len(explanation.Matched) != len(want) {
+		t.Fatalf("Expected %d matched criteria, got: %+v", len(want), explanation.Matched)
+	}
+	for _, matched := range explanation.Matched {
+		if matched.Detail != want[matched.Criterion] {
+			t.Errorf("Criterion %s: expected %q, got %q", matched.Criterion, want[matched.Criterion], matched.Detail)
+		}
+	}
+
+	if len(explanation.Sort) != 2 || explanation.Sort[0].Value != "8.7" || explanation.Sort[1].Value != "1999" {
+		t.Errorf("Expected the rating then the year as sort values, got: %+v", explanation.Sort)
+	}
+	if explanation.Rank != 3 || explanation.Score != 0 {
+		t.Errorf("Expected rank 3 without a score, got: %+v", explanation)
+	}
+}
+
+func TestExplain_Fuzzy(t *testing.T) {
+	dto := &MovieDTO{ID: 1, Title: "The Matrix", Year: 1999, Similarity: 0.82}
+	criteria := movie.SearchCriteria{OrderBy: movie.OrderByTitle, OrderDir: movie.OrderAsc}
+
+	explanation := explain(criteria, "Matrx", dto, 1)
+
+	if len(explanation.Matched) != 1 || explanation.Matched[0].Detail != `"The Matrix" is 0.82 similar to "Matrx"` {
+		t.Errorf("Unexpected title match: %+v", explanation.Matched)
+	}
+	if explanation.Score != 0.82 || len(explanation.Sort) != 1 || explanation.Sort[0].Field != "similarity" {
+		t.Errorf("Expected results ranked by similarity, got: %+v", explanation)
+	}
+}
+
+func TestExplain_YearOnlyRelease(t *testing.T) {
+	dto := &MovieDTO{ID: 1, Title: "Heat", Year: 1995}
+	criteria := movie.SearchCriteria{
+		ReleasedBefore: time.Date(1995, 6, 30, 0, 0, 0, 0, time.UTC),
+		OrderBy:        movie.OrderByTitle,
+		OrderDir:       movie.OrderAsc,
+	}
+
+	explanation := explain(criteria, "", dto, 1)
+
+	var detail string
+	for _, matched := range explanation.Matched {
+		if matched.Criterion == "release_date" {
+			detail = matched.Detail
+		}
+	}
+	if detail != "only the year 1995 is known, which matches every date in it" {
+		t.Errorf("Unexpected release date match: %q", detail)
+	}
+	if explanation.Sort[0].Value != "Heat" {
+		t.Errorf("Expected the title as sort value, got: %+v", explanation.Sort)
+	}
+}
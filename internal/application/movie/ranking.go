@@ -0,0 +1,119 @@
+package movie
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// Ranking signals a search can be weighted by
+const (
+	SignalRecency    = "recency"
+	SignalRating     = "rating"
+	SignalPopularity = "popularity"
+	SignalTrending   = "trending"
+)
+
+// recencyHorizon is the age in years at which a movie's recency score
+// reaches zero
+const recencyHorizon = 50
+
+// Scorer scores the movies a search found by one ranking signal
+type Scorer interface {
+	// Signal is the name the scorer's weight is given under
+	Signal() string
+
+	// Score returns a score from 0 to 1 for each of movies, in order
+	Score(ctx context.Context, movies []*MovieDTO) ([]float64, error)
+}
+
+// recencyScorer favours recent releases: movies from this year or later
+// score 1, falling linearly to 0 for movies recencyHorizon years old
+type recencyScorer struct {
+	now func() time.Time
+}
+
+// Signal returns the recency signal name
+func (s recencyScorer) Signal() string {
+	return SignalRecency
+}
+
+// Score scores each movie by its release year
+func (s recencyScorer) Score(ctx context.Context, movies []*MovieDTO) ([]float64, error) {
+	currentYear := s.now().Year()
+	scores := make([]float64, len(movies))
+	for i, dto := range movies {
+		age := currentYear - dto.Year
+		scores[i] = max(0, 1-float64(max(age, 0))/recencyHorizon)
+	}
+	return scores, nil
+}
+
+// ratingScorer scores a movie by its rating out of 10; unrated movies score 0
+type ratingScorer struct{}
+
+// Signal returns the rating signal name
+func (ratingScorer) Signal() string {
+	return SignalRating
+}
+
+// Score scores each movie by its rating
+func (ratingScorer) Score(ctx context.Context, movies []*MovieDTO) ([]float64, error) {
+	scores := make([]float64, len(movies))
+	for i, dto := range movies {
+		scores[i] = dto.Rating / 10
+	}
+	return scores, nil
+}
+
+// PopularityScorer scores a movie by how much of the library refers to it,
+// relative to the most referred-to movie the search found
+type PopularityScorer struct {
+	references movie.PopularityReader
+}
+
+// NewPopularityScorer creates a popularity scorer reading references
+// from references
+func NewPopularityScorer(references movie.PopularityReader) *PopularityScorer {
+	return &PopularityScorer{
+		references: references,
+	}
+}
+
+// Signal returns the popularity signal name
+func (s *PopularityScorer) Signal() string {
+	return SignalPopularity
+}
+
+// Score scores each movie by its references
+func (s *PopularityScorer) Score(ctx context.Context, movies []*MovieDTO) ([]float64, error) {
+	ids := make([]shared.MovieID, 0, len(movies))
+	for _, dto := range movies {
+		id, err := shared.NewMovieID(dto.ID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid movie ID: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	references, err := s.references.MovieReferences(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read movie popularity: %w", err)
+	}
+	most := 0
+	for _, count := range references {
+		most = max(most, count)
+	}
+
+	scores := make([]float64, len(movies))
+	if most == 0 {
+		return scores, nil
+	}
+	for i, id := range ids {
+		scores[i] = float64(references[id]) / float64(most)
+	}
+	return scores, nil
+}
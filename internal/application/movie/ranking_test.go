@@ -0,0 +1,48 @@
+package movie
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+func TestRecencyScorer(t *testing.T) {
+	scorer := recencyScorer{now: func() time.Time { return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) }}
+
+	scores, err := scorer.Score(context.Background(), []*MovieDTO{{Year: 2025}, {Year: 2024}, {Year: 1999}, {Year: 1950}})
+	if err != nil {
+		t.Fatalf("Score() error = %v", err)
+	}
+	if want := []float64{1, 1, 0.5, 0}; !reflect.DeepEqual(scores, want) {
+		t.Errorf("Expected %v, got %v", want, scores)
+	}
+}
+
+func TestRatingScorer(t *testing.T) {
+	scores, _ := ratingScorer{}.Score(context.Background(), []*MovieDTO{{Rating: 8.5}, {Rating: 0}})
+	if want := []float64{0.85, 0}; !reflect.DeepEqual(scores, want) {
+		t.Errorf("Expected %v, got %v", want, scores)
+	}
+}
+
+// fakePopularity refers to movie 1 four times and movie 2 twice
+type fakePopularity struct{}
+
+func (fakePopularity) MovieReferences(ctx context.Context, ids []shared.MovieID) (map[shared.MovieID]int, error) {
+	one, _ := shared.NewMovieID(1)
+	two, _ := shared.NewMovieID(2)
+	return map[shared.MovieID]int{one: 4, two: 2}, nil
+}
+
+func TestPopularityScorer(t *testing.T) {
+	scores, err := NewPopularityScorer(fakePopularity{}).Score(context.Background(), []*MovieDTO{{ID: 2}, {ID: 3}, {ID: 1}})
+	if err != nil {
+		t.Fatalf("Score() error = %v", err)
+	}
+	if want := []float64{0.5, 0, 1}; !reflect.DeepEqual(scores, want) {
+		t.Errorf("Expected scores relative to the most referenced movie, %v, got %v", want, scores)
+	}
+}
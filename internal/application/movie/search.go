@@ -0,0 +1,244 @@
+package movie
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/fuzzy"
+)
+
+// SignalScoreDTO is a movie's score for one ranking signal
+type SignalScoreDTO struct {
+	Signal string  `json:"signal"`
+	Weight float64 `json:"weight"`
+	Value  float64 `json:"value"` // The scorer's 0-1 score, before weighting
+}
+
+// weightedScorer is a scorer with the weight its scores count for
+type weightedScorer struct {
+	scorer Scorer
+	weight float64
+}
+
+// rankedMovie is a found movie with the score it ranks by
+type rankedMovie struct {
+	dto     *MovieDTO
+	score   float64
+	signals []SignalScoreDTO
+}
+
+// searchPipeline runs a search in stages: the repository filters the
+// library, scorers score the movies it kept, the scores rank them and the
+// ranked movies are paginated. A search nothing scores is filtered, sorted
+// and paginated by the repository alone.
+type searchPipeline struct {
+	criteria   movie.SearchCriteria
+	fuzzyTitle string // Set when the title is matched by similarity, which is then part of the score
+	scorers    []weightedScorer
+}
+
+// SearchMovies searches for movies based on criteria
+func (s *Service) SearchMovies(ctx context.Context, query SearchMoviesQuery) ([]*MovieDTO, error) {
+	criteria, err := buildSearchCriteria(query)
+	if err != nil {
+		return nil, err
+	}
+	pipeline := searchPipeline{criteria: criteria}
+	if query.Fuzzy && query.Title != "" {
+		pipeline.fuzzyTitle = query.Title
+	}
+	if query.Ranked {
+		if pipeline.scorers, err = s.rankingScorers(query.RankingWeights); err != nil {
+			return nil, err
+		}
+	}
+
+	found, err := pipeline.filter(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+	if !pipeline.ranked() {
+		if query.Explain {
+			for i, dto := range found {
+				dto.Explanation = explain(criteria, "", dto, criteria.Offset+i+1)
+			}
+		}
+		return found, nil
+	}
+
+	ranked, err := pipeline.score(ctx, found)
+	if err != nil {
+		return nil, err
+	}
+	pipeline.rank(ranked)
+	page := pipeline.paginate(ranked)
+
+	dtos := make([]*MovieDTO, len(page))
+	for i, result := range page {
+		dtos[i] = result.dto
+		if len(pipeline.scorers) > 0 {
+			result.dto.Score = result.score
+		}
+		if query.Explain {
+			result.dto.Explanation = pipeline.explain(result, criteria.Offset+i+1)
+		}
+	}
+	return dtos, nil
+}
+
+// rankingScorers returns the scorers a ranked search weights, with the
+// service's default weights replaced by overrides. Signals weighted zero
+// are left out, as are default weights of scorers that are not set.
+func (s *Service) rankingScorers(overrides map[string]float64) ([]weightedScorer, error) {
+	weights := make(map[string]float64, len(s.rankingWeights)+len(overrides))
+	for signal, weight := range s.rankingWeights {
+		weights[signal] = weight
+	}
+	for signal, weight := range overrides {
+		if _, ok := s.scorers[signal]; !ok {
+			return nil, shared.NewValidationError("unknown ranking signal %q (supported: %s)", signal, strings.Join(s.rankingSignals(), ", "))
+		}
+		if weight < 0 || math.IsNaN(weight) || math.IsInf(weight, 0) {
+			return nil, shared.NewValidationError("ranking weight for %s must be a non-negative number", signal)
+		}
+		weights[signal] = weight
+	}
+
+	var scorers []weightedScorer
+	for _, signal := range s.rankingSignals() {
+		if weight := weights[signal]; weight > 0 {
+			scorers = append(scorers, weightedScorer{scorer: s.scorers[signal], weight: weight})
+		}
+	}
+	return scorers, nil
+}
+
+// rankingSignals returns the names of the service's scorers in order
+func (s *Service) rankingSignals() []string {
+	signals := make([]string, 0, len(s.scorers))
+	for signal := range s.scorers {
+		signals = append(signals, signal)
+	}
+	sort.Strings(signals)
+	return signals
+}
+
+// ranked reports whether the search is ordered by score rather than by
+// the repository
+func (p *searchPipeline) ranked() bool {
+	return p.fuzzyTitle != "" || len(p.scorers) > 0
+}
+
+// filter returns the movies matching the criteria. A ranked search reads
+// every match in the repository's order, which breaks ties between equal
+// scores; the title filter of a fuzzy search is applied here by similarity.
+func (p *searchPipeline) filter(ctx context.Context, s *Service) ([]*MovieDTO, error) {
+	criteria := p.criteria
+	if p.ranked() {
+		criteria.Limit, criteria.Offset = 0, 0
+	}
+	if p.fuzzyTitle != "" {
+		criteria.Title = ""
+	}
+
+	domainMovies, err := s.movieRepo.FindByCriteria(ctx, criteria)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search movies: %w", err)
+	}
+
+	dtos := make([]*MovieDTO, 0, len(domainMovies))
+	for _, domainMovie := range domainMovies {
+		dto := s.toDTO(domainMovie)
+		if p.fuzzyTitle != "" {
+			similarity := fuzzy.Similarity(p.fuzzyTitle, dto.Title)
+			if similarity < fuzzy.DefaultThreshold {
+				continue
+			}
+			dto.Similarity = roundScore(similarity)
+		}
+		dtos = append(dtos, dto)
+	}
+	return dtos, nil
+}
+
+// score adds each weighted signal to the fuzzy title similarity, if any
+func (p *searchPipeline) score(ctx context.Context, found []*MovieDTO) ([]rankedMovie, error) {
+	ranked := make([]rankedMovie, len(found))
+	for i, dto := range found {
+		ranked[i] = rankedMovie{dto: dto, score: dto.Similarity}
+		if p.fuzzyTitle != "" && len(p.scorers) > 0 {
+			ranked[i].signals = []SignalScoreDTO{{Signal: "similarity", Weight: 1, Value: dto.Similarity}}
+		}
+	}
+	if len(found) == 0 {
+		return ranked, nil
+	}
+
+	for _, weighted := range p.scorers {
+		scores, err := weighted.scorer.Score(ctx, found)
+		if err != nil {
+			return nil, fmt.Errorf("failed to score movies by %s: %w", weighted.scorer.Signal(), err)
+		}
+		for i, score := range scores {
+			ranked[i].score += weighted.weight * score
+			ranked[i].signals = append(ranked[i].signals, SignalScoreDTO{
+				Signal: weighted.scorer.Signal(),
+				Weight: weighted.weight,
+				Value:  roundScore(score),
+			})
+		}
+	}
+	for i := range ranked {
+		ranked[i].score = roundScore(ranked[i].score)
+	}
+	return ranked, nil
+}
+
+// rank orders movies by descending score; ties keep the repository order
+func (p *searchPipeline) rank(ranked []rankedMovie) {
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].score > ranked[j].score
+	})
+}
+
+// paginate returns the page of ranked movies the criteria ask for
+func (p *searchPipeline) paginate(ranked []rankedMovie) []rankedMovie {
+	if p.criteria.Offset >= len(ranked) {
+		return []rankedMovie{}
+	}
+	ranked = ranked[p.criteria.Offset:]
+	if p.criteria.Limit > 0 && p.criteria.Limit < len(ranked) {
+		ranked = ranked[:p.criteria.Limit]
+	}
+	return ranked
+}
+
+// explain describes a ranked movie: the criteria it matched, then its score
+// ahead of the repository's sort keys, which only break ties
+func (p *searchPipeline) explain(result rankedMovie, rank int) *ExplanationDTO {
+	explanation := explain(p.criteria, p.fuzzyTitle, result.dto, rank)
+	if len(p.scorers) == 0 {
+		return explanation
+	}
+
+	explanation.Score = result.score
+	explanation.Signals = result.signals
+	scoreKey := SortValueDTO{Field: "score", Direction: string(movie.OrderDesc), Value: strconv.FormatFloat(result.score, 'g', -1, 64)}
+	if p.fuzzyTitle != "" {
+		explanation.Sort = []SortValueDTO{scoreKey}
+	} else {
+		explanation.Sort = append([]SortValueDTO{scoreKey}, explanation.Sort...)
+	}
+	return explanation
+}
+
+// roundScore rounds a score to three decimals
+func roundScore(score float64) float64 {
+	return math.Round(score*1000) / 1000
+}
@@ -0,0 +1,178 @@
+package movie
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// newRankingTestService serves three movies in a fixed repository order,
+// recording the criteria each search reads them with
+func newRankingTestService(t *testing.T, criteria *movie.SearchCriteria) *Service {
+	t.Helper()
+
+	var movies []*movie.Movie
+	for i, m := range []struct {
+		title  string
+		year   int
+		rating float64
+	}{
+		{"Chinatown", 1974, 8.1},
+		{"Dune", 2021, 8.0},
+		{"Tenet", 2020, 7.3},
+	} {
+		id, _ := shared.NewMovieID(i + 1)
+		domainMovie, err := movie.NewMovieWithID(id, m.title, "Director", m.year)
+		if err != nil {
+			t.Fatalf("failed to create movie: %v", err)
+		}
+		if err := domainMovie.SetRating(m.rating); err != nil {
+			t.Fatalf("failed to set rating: %v", err)
+		}
+		movies = append(movies, domainMovie)
+	}
+
+	repo := NewMockMovieRepository()
+	repo.findByCriteriaFunc = func(ctx context.Context, c movie.SearchCriteria) ([]*movie.Movie, error) {
+		*criteria = c
+		return movies, nil
+	}
+	service := NewService(repo)
+	service.SetScorer(recencyScorer{now: func() time.Time { return time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC) }})
+	return service
+}
+
+func titles(dtos []*MovieDTO) []string {
+	names := make([]string, len(dtos))
+	for i, dto := range dtos {
+		names[i] = dto.Title
+	}
+	return names
+}
+
+func TestService_SearchMovies_Ranked(t *testing.T) {
+	var criteria movie.SearchCriteria
+	service := newRankingTestService(t, &criteria)
+
+	results, err := service.SearchMovies(context.Background(), SearchMoviesQuery{
+		Ranked:         true,
+		RankingWeights: map[string]float64{SignalRecency: 1, SignalRating: 0.5},
+		Limit:          2,
+		Explain:        true,
+	})
+	if err != nil {
+		t.Fatalf("SearchMovies() error = %v", err)
+	}
+
+	if criteria.Limit != 0 || criteria.Offset != 0 {
+		t.Errorf("Expected every match to be read for ranking, got limit %d offset %d", criteria.Limit, criteria.Offset)
+	}
+	if got := titles(results); len(got) != 2 || got[0] != "Dune" || got[1] != "Tenet" {
+		t.Fatalf("Expected Dune then Tenet, got %v", got)
+	}
+	// Dune: recency 1 - 3/50 = 0.94, plus half its 0.8 rating
+	if results[0].Score != 1.34 {
+		t.Errorf("Expected Dune to score 1.34, got %v", results[0].Score)
+	}
+
+	explanation := results[0].Explanation
+	if explanation.Score != 1.34 || explanation.Sort[0].Field != "score" || explanation.Sort[1].Field != "title" {
+		t.Errorf("Expected the score ahead of the title sort key, got %+v", explanation)
+	}
+	want := []SignalScoreDTO{{Signal: SignalRating, Weight: 0.5, Value: 0.8}, {Signal: SignalRecency, Weight: 1, Value: 0.94}}
+	if len(explanation.Signals) != 2 || explanation.Signals[0] != want[0] || explanation.Signals[1] != want[1] {
+		t.Errorf("Expected %+v, got %+v", want, explanation.Signals)
+	}
+}
+
+func TestService_SearchMovies_RankingDefaults(t *testing.T) {
+	var criteria movie.SearchCriteria
+	service := newRankingTestService(t, &criteria)
+	service.SetRankingWeights(map[string]float64{SignalRating: 1, SignalPopularity: 1})
+
+	results, err := service.SearchMovies(context.Background(), SearchMoviesQuery{Ranked: true, Offset: 1})
+	if err != nil {
+		t.Fatalf("SearchMovies() error = %v", err)
+	}
+	if got := titles(results); len(got) != 2 || got[0] != "Dune" || got[1] != "Tenet" {
+		t.Errorf("Expected the default rating weight to rank Chinatown first, got %v", got)
+	}
+
+	results, err = service.SearchMovies(context.Background(), SearchMoviesQuery{Ranked: true, RankingWeights: map[string]float64{SignalRating: 0}, Limit: 5})
+	if err != nil {
+		t.Fatalf("SearchMovies() error = %v", err)
+	}
+	if criteria.Limit != 5 || results[0].Score != 0 {
+		t.Errorf("Expected a zero override to leave ordering to the repository, got limit %d and score %v", criteria.Limit, results[0].Score)
+	}
+
+	if _, err := service.SearchMovies(context.Background(), SearchMoviesQuery{Limit: 5}); err != nil || criteria.Limit != 5 {
+		t.Errorf("Expected an unranked search to ignore the default weights, got limit %d (error %v)", criteria.Limit, err)
+	}
+}
+
+func TestService_SearchMovies_PluggedScorer(t *testing.T) {
+	var criteria movie.SearchCriteria
+	service := newRankingTestService(t, &criteria)
+	service.SetScorer(fixedScorer{signal: SignalPopularity, scores: []float64{0, 0, 1}})
+
+	results, err := service.SearchMovies(context.Background(), SearchMoviesQuery{Ranked: true, RankingWeights: map[string]float64{SignalPopularity: 2}})
+	if err != nil {
+		t.Fatalf("SearchMovies() error = %v", err)
+	}
+	if got := titles(results); got[0] != "Tenet" || got[1] != "Chinatown" || got[2] != "Dune" {
+		t.Errorf("Expected Tenet first and ties in repository order, got %v", got)
+	}
+}
+
+func TestService_SearchMovies_FuzzyRanked(t *testing.T) {
+	var criteria movie.SearchCriteria
+	service := newRankingTestService(t, &criteria)
+
+	results, err := service.SearchMovies(context.Background(), SearchMoviesQuery{
+		Title: "Chinatwn", Fuzzy: true, Ranked: true, RankingWeights: map[string]float64{SignalRating: 1}, Explain: true,
+	})
+	if err != nil {
+		t.Fatalf("SearchMovies() error = %v", err)
+	}
+	if len(results) != 1 || results[0].Title != "Chinatown" {
+		t.Fatalf("Expected the fuzzy title filter to keep Chinatown only, got %v", titles(results))
+	}
+	if results[0].Score != roundScore(results[0].Similarity+0.81) || results[0].Explanation.Signals[0].Signal != "similarity" {
+		t.Errorf("Expected the similarity plus the rating, got %v (%+v)", results[0].Score, results[0].Explanation.Signals)
+	}
+}
+
+func TestService_SearchMovies_InvalidRankingWeights(t *testing.T) {
+	var criteria movie.SearchCriteria
+	service := newRankingTestService(t, &criteria)
+
+	for _, weights := range []map[string]float64{
+		{SignalPopularity: 1}, // No popularity scorer is set
+		{"hype": 1},
+		{SignalRating: -1},
+	} {
+		_, err := service.SearchMovies(context.Background(), SearchMoviesQuery{Ranked: true, RankingWeights: weights})
+		if !errors.Is(err, shared.ErrValidation) {
+			t.Errorf("Expected a validation error for %v, got: %v", weights, err)
+		}
+	}
+}
+
+// fixedScorer scores movies with preset scores, in repository order
+type fixedScorer struct {
+	signal string
+	scores []float64
+}
+
+func (s fixedScorer) Signal() string {
+	return s.signal
+}
+
+func (s fixedScorer) Score(ctx context.Context, movies []*MovieDTO) ([]float64, error) {
+	return s.scores[:len(movies)], nil
+}
@@ -2,43 +2,127 @@ package movie
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
 	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/serialization"
 )
 
 // Service provides application-level movie operations
 type Service struct {
 	movieRepo movie.Repository
+	policy    shared.ValidationPolicy
+	publisher shared.EventPublisher
+
+	scorers        map[string]Scorer
+	rankingWeights map[string]float64
 }
 
-// NewService creates a new movie application service
+// NewService creates a new movie application service that can rank
+// searches by recency and rating
 func NewService(movieRepo movie.Repository) *Service {
 	return &Service{
 		movieRepo: movieRepo,
+		scorers: map[string]Scorer{
+			SignalRecency: recencyScorer{now: time.Now},
+			SignalRating:  ratingScorer{},
+		},
+	}
+}
+
+// SetValidationPolicy sets how created and updated movies are validated
+// when a command does not ask for a policy of its own (default lenient)
+func (s *Service) SetValidationPolicy(policy shared.ValidationPolicy) {
+	s.policy = policy
+}
+
+// SetEventPublisher sets where the events of created, updated and deleted
+// movies are published once they are saved; without one they are dropped
+func (s *Service) SetEventPublisher(publisher shared.EventPublisher) {
+	s.publisher = publisher
+}
+
+// SetScorer adds a ranking signal to searches, replacing any scorer of the
+// same signal
+func (s *Service) SetScorer(scorer Scorer) {
+	s.scorers[scorer.Signal()] = scorer
+}
+
+// SetRankingWeights sets the weight of each ranking signal in ranked
+// searches that do not override it; without weights they keep the sort order
+func (s *Service) SetRankingWeights(weights map[string]float64) {
+	s.rankingWeights = weights
+}
+
+// publishEvents publishes the events a saved movie recorded and marks them
+// committed
+func (s *Service) publishEvents(ctx context.Context, domainMovie *movie.Movie) {
+	if s.publisher != nil && domainMovie.HasUncommittedEvents() {
+		s.publisher.Publish(ctx, domainMovie.UncommittedEvents())
 	}
+	domainMovie.MarkEventsAsCommitted()
 }
 
+// validationPolicy returns the policy a command is validated with
+func (s *Service) validationPolicy(override shared.ValidationPolicy) shared.ValidationPolicy {
+	if override != "" {
+		return override
+	}
+	return s.policy
+}
+
+// defaultCertificationRegion is used for max_certification filters that name no region
+const defaultCertificationRegion = "US"
+
+// Bounds on the before/after rows a bulk update returns
+const (
+	defaultBulkUpdateSamples = 5
+	maxBulkUpdateSamples     = 20
+)
+
 // CreateMovieCommand represents the command to create a new movie
 type CreateMovieCommand struct {
-	Title     string
-	Director  string
-	Year      int
-	Rating    float64
-	Genres    []string
-	PosterURL string
+	Title       string
+	Director    string
+	Year        int       // May be left out when ReleaseDate is set
+	ReleaseDate time.Time // Zero when only the year is known
+	Rating      float64
+	Duration    int // Runtime in minutes; zero when unknown
+	Genres      []string
+	PosterURL   string
+
+	Certifications  map[string]string // Age rating keyed by region, e.g. US: PG-13
+	ContentWarnings []string
+
+	Validation shared.ValidationPolicy // Overrides the service's policy when set
 }
 
-// UpdateMovieCommand represents the command to update an existing movie
+// UpdateMovieCommand represents the command to update an existing movie.
+// Nil fields are left unchanged; a field set to its zero value clears it
+// where a movie can go without it.
 type UpdateMovieCommand struct {
-	ID        int
-	Title     string
-	Director  string
-	Year      int
-	Rating    float64
-	Genres    []string
-	PosterURL string
+	ID          int
+	Title       *string
+	Director    *string
+	Year        *int       // Follows ReleaseDate when only the date is set
+	ReleaseDate *time.Time // The zero time clears the date and keeps the year
+	Rating      *float64   // 0 clears the rating
+	Duration    *int       // Runtime in minutes; 0 clears it
+	Genres      []string   // Replaces the genres; non-nil and empty clears them
+	PosterURL   *string    // "" removes the poster
+
+	Certifications  map[string]string // Replaces the age ratings; non-nil and empty clears them
+	ContentWarnings []string          // Replaces the warnings; non-nil and empty clears them
+
+	Validation shared.ValidationPolicy // Overrides the service's policy when set
 }
 
 // SearchMoviesQuery represents the query to search for movies
@@ -54,6 +138,70 @@ type SearchMoviesQuery struct {
 	Offset    int
 	OrderBy   string
 	OrderDir  string
+	Fuzzy     bool      // Match Title by similarity and rank results by score
+	Sort      []SortKey // Ordered sort keys; when set, replaces OrderBy/OrderDir
+	Explain   bool      // Set Explanation on every result
+
+	// Ranked orders results by their weighted ranking signals, with the sort
+	// keys breaking ties. RankingWeights replaces the service's default
+	// weight of each signal it names; a zero weight turns a signal off.
+	Ranked         bool
+	RankingWeights map[string]float64
+
+	// MaxCertification keeps movies rated no more restrictively than this in
+	// CertificationRegion (default US); movies unrated there are left out
+	MaxCertification    string
+	CertificationRegion string
+	ExcludeWarnings     []string // Drop movies carrying any of these content warnings
+
+	// ReleasedAfter and ReleasedBefore bound the release date. Movies with
+	// only a release year match every date in it.
+	ReleasedAfter  time.Time
+	ReleasedBefore time.Time
+
+	// MinDuration and MaxDuration bound the runtime in minutes; movies with
+	// no known runtime are left out when either is set
+	MinDuration int
+	MaxDuration int
+
+	// AfterID keeps movies with a greater ID; sorted by id, it pages through
+	// the library without skipping movies when earlier ones are deleted
+	AfterID int
+}
+
+// MoviePatch represents a partial update applied to every movie a bulk
+// update matches. Zero values leave a field unchanged.
+type MoviePatch struct {
+	Director              string
+	Rating                float64
+	AddGenres             []string
+	RemoveGenres          []string
+	Certifications        map[string]string // An empty rating removes the region's rating
+	AddContentWarnings    []string
+	RemoveContentWarnings []string
+}
+
+// IsEmpty reports whether the patch changes nothing
+func (p MoviePatch) IsEmpty() bool {
+	return p.Director == "" && p.Rating == 0 && len(p.AddGenres) == 0 && len(p.RemoveGenres) == 0 &&
+		len(p.Certifications) == 0 && len(p.AddContentWarnings) == 0 && len(p.RemoveContentWarnings) == 0
+}
+
+// BulkUpdateMoviesCommand represents the command to patch every movie matching a filter
+type BulkUpdateMoviesCommand struct {
+	Filter SearchMoviesQuery // Pagination, sorting and fuzzy matching are ignored
+	Patch  MoviePatch
+
+	// ConfirmationToken commits the update previewed by the dry run that
+	// returned it; without one the update is only previewed
+	ConfirmationToken string
+	SampleSize        int // Before/after rows to return (default 5, max 20)
+}
+
+// SortKey represents one term of a multi-key sort
+type SortKey struct {
+	Field string // title, director, year, rating, created_at, updated_at or id
+	Dir   string // asc (default) or desc
 }
 
 // MovieDTO represents a movie data transfer object
@@ -67,15 +215,162 @@ type MovieDTO struct {
 	PosterURL string   `json:"poster_url,omitempty"`
 	CreatedAt string   `json:"created_at"`
 	UpdatedAt string   `json:"updated_at"`
+
+	ReleaseDate string `json:"release_date,omitempty"` // YYYY-MM-DD, when known to the day
+	Duration    int    `json:"duration,omitempty"`     // Runtime in minutes, when known
+
+	Certifications  map[string]string `json:"certifications,omitempty"`
+	ContentWarnings []string          `json:"content_warnings,omitempty"`
+
+	// Similarity is the fuzzy title match score (0-1), set only by fuzzy searches
+	Similarity float64 `json:"similarity,omitempty"`
+
+	// Score is the weighted score a ranked search ordered the movie by
+	Score float64 `json:"score,omitempty"`
+
+	// Explanation is set only by searches that ask for it
+	Explanation *ExplanationDTO `json:"explanation,omitempty"`
+}
+
+// MovieChangeDTO represents a movie before and after a bulk update
+type MovieChangeDTO struct {
+	Before *MovieDTO `json:"before"`
+	After  *MovieDTO `json:"after"`
+}
+
+// BulkUpdateResultDTO represents the outcome of a bulk update or its dry run
+type BulkUpdateResultDTO struct {
+	DryRun            bool              `json:"dry_run"`
+	Matched           int               `json:"matched"`
+	Affected          int               `json:"affected"` // Matched movies the patch changes
+	Updated           int               `json:"updated"`
+	ConfirmationToken string            `json:"confirmation_token,omitempty"`
+	Samples           []*MovieChangeDTO `json:"samples"`
+}
+
+// ReleaseTimelineQuery represents the query for a release timeline. The
+// timeline is grouped by year; movies with a release date are also counted
+// by month.
+type ReleaseTimelineQuery struct {
+	StartYear int    // Zero for no lower bound
+	EndYear   int    // Zero for no upper bound
+	Genre     string // Only movies with this genre, when set
+	TopPicks  int    // Best-rated movies listed per year; defaults to 3
+}
+
+// ReleaseYearDTO represents the movies released in one year
+type ReleaseYearDTO struct {
+	Year          int                `json:"year"`
+	Count         int                `json:"count"`
+	AverageRating float64            `json:"average_rating"` // Over the rated movies; zero when none are
+	TopRated      []*MovieDTO        `json:"top_rated"`
+	Months        []*ReleaseMonthDTO `json:"months"`  // Months with dated releases, in order
+	Undated       int                `json:"undated"` // Movies known only to the year
+}
+
+// ReleaseMonthDTO represents the movies with a release date in one month
+type ReleaseMonthDTO struct {
+	Month int `json:"month"` // 1 for January
+	Count int `json:"count"`
+}
+
+// ReleaseTimelineDTO represents the years movies were released in, oldest
+// first; years without releases are left out
+type ReleaseTimelineDTO struct {
+	Total int               `json:"total"`
+	Years []*ReleaseYearDTO `json:"years"`
+}
+
+// RuntimeQuery represents the query for runtime statistics by genre
+type RuntimeQuery struct {
+	MinYear int // Zero for no lower bound
+	MaxYear int // Zero for no upper bound
+}
+
+// GenreRuntimeDTO represents the runtimes of one genre's movies
+type GenreRuntimeDTO struct {
+	Genre            string  `json:"genre"`
+	Movies           int     `json:"movies"` // Movies with a known runtime
+	AverageDuration  float64 `json:"average_duration"`
+	ShortestDuration int     `json:"shortest_duration"`
+	LongestDuration  int     `json:"longest_duration"`
+	ShortFilms       int     `json:"short_films"`
+}
+
+// RuntimeStatsDTO represents runtime statistics by genre, longest average
+// first. Movies without genres count only towards the library totals.
+type RuntimeStatsDTO struct {
+	Movies          int                `json:"movies"` // Movies with a known runtime
+	Unknown         int                `json:"unknown"`
+	AverageDuration float64            `json:"average_duration"`
+	ShortFilms      int                `json:"short_films"`
+	Genres          []*GenreRuntimeDTO `json:"genres"`
 }
 
 // CreateMovie creates a new movie
 func (s *Service) CreateMovie(ctx context.Context, cmd CreateMovieCommand) (*MovieDTO, error) {
-	// Create domain movie
-	domainMovie, err := movie.NewMovie(cmd.Title, cmd.Director, cmd.Year)
+	domainMovie, err := newMovieFromCommand(cmd, s.validationPolicy(cmd.Validation))
+	if err != nil {
+		return nil, err
+	}
+
+	// Save to repository
+	if err := s.movieRepo.Save(ctx, domainMovie); err != nil {
+		return nil, fmt.Errorf("failed to save movie: %w", err)
+	}
+	domainMovie.RecordCreated()
+	s.publishEvents(ctx, domainMovie)
+
+	return s.toDTO(domainMovie), nil
+}
+
+// CreateMovies creates many movies with a single batched insert. Commands
+// are validated one by one: movies[i] is set for each command that was
+// created and errs[i] for each that was invalid. The valid movies are
+// inserted all together or not at all, and an error means none were.
+func (s *Service) CreateMovies(ctx context.Context, cmds []CreateMovieCommand) ([]*MovieDTO, []error, error) {
+	movies := make([]*MovieDTO, len(cmds))
+	errs := make([]error, len(cmds))
+
+	valid := make([]*movie.Movie, 0, len(cmds))
+	indexes := make([]int, 0, len(cmds))
+	for i, cmd := range cmds {
+		domainMovie, err := newMovieFromCommand(cmd, s.validationPolicy(cmd.Validation))
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		valid = append(valid, domainMovie)
+		indexes = append(indexes, i)
+	}
+
+	if len(valid) > 0 {
+		if err := s.movieRepo.InsertAll(ctx, valid); err != nil {
+			return nil, nil, fmt.Errorf("failed to save movies: %w", err)
+		}
+	}
+
+	for i, domainMovie := range valid {
+		movies[indexes[i]] = s.toDTO(domainMovie)
+	}
+	return movies, errs, nil
+}
+
+// newMovieFromCommand builds a domain movie from a create command and
+// validates it under policy
+func newMovieFromCommand(cmd CreateMovieCommand, policy shared.ValidationPolicy) (*movie.Movie, error) {
+	year, err := releaseYear(cmd.Year, cmd.ReleaseDate)
+	if err != nil {
+		return nil, err
+	}
+
+	domainMovie, err := movie.NewMovie(cmd.Title, cmd.Director, year)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create movie: %w", err)
 	}
+	if err := domainMovie.SetReleaseDate(cmd.ReleaseDate); err != nil {
+		return nil, fmt.Errorf("failed to set release date: %w", err)
+	}
 
 	// Set rating if provided
 	if cmd.Rating > 0 {
@@ -84,6 +379,10 @@ func (s *Service) CreateMovie(ctx context.Context, cmd CreateMovieCommand) (*Mov
 		}
 	}
 
+	if err := domainMovie.SetDuration(cmd.Duration); err != nil {
+		return nil, fmt.Errorf("failed to set duration: %w", err)
+	}
+
 	// Add genres if provided
 	for _, genre := range cmd.Genres {
 		if err := domainMovie.AddGenre(genre); err != nil {
@@ -98,17 +397,32 @@ func (s *Service) CreateMovie(ctx context.Context, cmd CreateMovieCommand) (*Mov
 		}
 	}
 
+	// Set certifications and content warnings if provided
+	if err := applyContentAdvisory(domainMovie, cmd.Certifications, cmd.ContentWarnings); err != nil {
+		return nil, err
+	}
+
 	// Validate the movie
-	if err := domainMovie.Validate(); err != nil {
+	if err := domainMovie.ValidateWith(policy); err != nil {
 		return nil, fmt.Errorf("movie validation failed: %w", err)
 	}
 
-	// Save to repository
-	if err := s.movieRepo.Save(ctx, domainMovie); err != nil {
-		return nil, fmt.Errorf("failed to save movie: %w", err)
-	}
+	return domainMovie, nil
+}
 
-	return s.toDTO(domainMovie), nil
+// releaseYear returns the release year of a command, taking it from the
+// release date when the year is left out
+func releaseYear(year int, released time.Time) (int, error) {
+	if released.IsZero() {
+		return year, nil
+	}
+	if year == 0 {
+		return released.Year(), nil
+	}
+	if year != released.Year() {
+		return 0, shared.NewValidationError("year %d does not match release date %s", year, released.Format(time.DateOnly))
+	}
+	return year, nil
 }
 
 // GetMovie retrieves a movie by ID
@@ -126,57 +440,144 @@ func (s *Service) GetMovie(ctx context.Context, id int) (*MovieDTO, error) {
 	return s.toDTO(domainMovie), nil
 }
 
-// UpdateMovie updates an existing movie
+// GetMoviesByIDs retrieves several movies in a single query. IDs that do not
+// exist are left out of the result.
+func (s *Service) GetMoviesByIDs(ctx context.Context, ids []int) ([]*MovieDTO, error) {
+	if len(ids) == 0 {
+		return []*MovieDTO{}, nil
+	}
+
+	movieIDs := make([]shared.MovieID, 0, len(ids))
+	for _, id := range ids {
+		movieID, err := shared.NewMovieID(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid movie ID %d: %w", id, err)
+		}
+		movieIDs = append(movieIDs, movieID)
+	}
+
+	domainMovies, err := s.movieRepo.FindByCriteria(ctx, movie.SearchCriteria{
+		IDs:      movieIDs,
+		Limit:    len(movieIDs),
+		OrderBy:  movie.OrderByTitle,
+		OrderDir: movie.OrderAsc,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get movies: %w", err)
+	}
+
+	dtos := make([]*MovieDTO, 0, len(domainMovies))
+	for _, domainMovie := range domainMovies {
+		dtos = append(dtos, s.toDTO(domainMovie))
+	}
+
+	return dtos, nil
+}
+
+// UpdateMovie updates the fields of an existing movie that the command sets
 func (s *Service) UpdateMovie(ctx context.Context, cmd UpdateMovieCommand) (*MovieDTO, error) {
 	movieID, err := shared.NewMovieID(cmd.ID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid movie ID: %w", err)
 	}
 
-	// Verify movie exists
-	_, err = s.movieRepo.FindByID(ctx, movieID)
+	existing, err := s.movieRepo.FindByID(ctx, movieID)
 	if err != nil {
 		return nil, fmt.Errorf("movie not found: %w", err)
 	}
 
 	// Create new movie with updated values (immutable approach)
-	updatedMovie, err := movie.NewMovieWithID(movieID, cmd.Title, cmd.Director, cmd.Year)
+	updatedMovie, err := applyUpdate(existing, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate the updated movie
+	if err := updatedMovie.ValidateWith(s.validationPolicy(cmd.Validation)); err != nil {
+		return nil, fmt.Errorf("movie validation failed: %w", err)
+	}
+
+	// Save updated movie
+	if err := s.movieRepo.Save(ctx, updatedMovie); err != nil {
+		return nil, fmt.Errorf("failed to save updated movie: %w", err)
+	}
+	updatedMovie.RecordUpdateOf(existing)
+	s.publishEvents(ctx, updatedMovie)
+
+	return s.toDTO(updatedMovie), nil
+}
+
+// applyUpdate builds the movie an update command leaves behind, taking each
+// field the command does not set from the existing movie
+func applyUpdate(existing *movie.Movie, cmd UpdateMovieCommand) (*movie.Movie, error) {
+	title := valueOr(cmd.Title, existing.Title())
+	director := valueOr(cmd.Director, existing.Director())
+	released := valueOr(cmd.ReleaseDate, existing.ReleaseDate())
+
+	// A new release date moves the year with it unless the year is set too
+	year := existing.Year().Value()
+	if cmd.ReleaseDate != nil {
+		year = 0
+	}
+	year, err := releaseYear(valueOr(cmd.Year, year), released)
+	if err != nil {
+		return nil, err
+	}
+	if year == 0 {
+		year = existing.Year().Value()
+	}
+
+	updatedMovie, err := movie.NewMovieWithID(existing.ID(), title, director, year)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create updated movie: %w", err)
 	}
+	if err := updatedMovie.SetReleaseDate(released); err != nil {
+		return nil, fmt.Errorf("failed to set release date: %w", err)
+	}
 
-	// Set rating if provided
-	if cmd.Rating > 0 {
-		if err := updatedMovie.SetRating(cmd.Rating); err != nil {
-			return nil, fmt.Errorf("failed to set rating: %w", err)
-		}
+	if err := updatedMovie.SetRating(valueOr(cmd.Rating, existing.Rating().Value())); err != nil {
+		return nil, fmt.Errorf("failed to set rating: %w", err)
 	}
 
-	// Add genres if provided
-	for _, genre := range cmd.Genres {
+	if err := updatedMovie.SetDuration(valueOr(cmd.Duration, existing.Duration())); err != nil {
+		return nil, fmt.Errorf("failed to set duration: %w", err)
+	}
+
+	genres := existing.Genres()
+	if cmd.Genres != nil {
+		genres = cmd.Genres
+	}
+	for _, genre := range genres {
 		if err := updatedMovie.AddGenre(genre); err != nil {
 			return nil, fmt.Errorf("failed to add genre %s: %w", genre, err)
 		}
 	}
 
-	// Set poster URL if provided
-	if cmd.PosterURL != "" {
-		if err := updatedMovie.SetPosterURL(cmd.PosterURL); err != nil {
-			return nil, fmt.Errorf("failed to set poster URL: %w", err)
-		}
+	if err := updatedMovie.SetPosterURL(valueOr(cmd.PosterURL, existing.PosterURL())); err != nil {
+		return nil, fmt.Errorf("failed to set poster URL: %w", err)
 	}
 
-	// Validate the updated movie
-	if err := updatedMovie.Validate(); err != nil {
-		return nil, fmt.Errorf("movie validation failed: %w", err)
+	certifications := existing.Certifications()
+	if cmd.Certifications != nil {
+		certifications = cmd.Certifications
 	}
-
-	// Save updated movie
-	if err := s.movieRepo.Save(ctx, updatedMovie); err != nil {
-		return nil, fmt.Errorf("failed to save updated movie: %w", err)
+	warnings := existing.ContentWarnings()
+	if cmd.ContentWarnings != nil {
+		warnings = cmd.ContentWarnings
+	}
+	if err := applyContentAdvisory(updatedMovie, certifications, warnings); err != nil {
+		return nil, err
 	}
 
-	return s.toDTO(updatedMovie), nil
+	return updatedMovie, nil
+}
+
+// valueOr returns *value, or fallback when value is nil
+func valueOr[T any](value *T, fallback T) T {
+	if value == nil {
+		return fallback
+	}
+	return *value
 }
 
 // DeleteMovie deletes a movie by ID
@@ -186,15 +587,420 @@ func (s *Service) DeleteMovie(ctx context.Context, id int) error {
 		return fmt.Errorf("invalid movie ID: %w", err)
 	}
 
+	// Load the movie first so its deletion can be published with its title
+	existing, err := s.movieRepo.FindByID(ctx, movieID)
+	if err != nil {
+		return fmt.Errorf("failed to delete movie: %w", err)
+	}
+
 	if err := s.movieRepo.Delete(ctx, movieID); err != nil {
 		return fmt.Errorf("failed to delete movie: %w", err)
 	}
+	existing.RecordDeleted()
+	s.publishEvents(ctx, existing)
 
 	return nil
 }
 
-// SearchMovies searches for movies based on criteria
-func (s *Service) SearchMovies(ctx context.Context, query SearchMoviesQuery) ([]*MovieDTO, error) {
+// CountMovies returns the number of movies in the library
+func (s *Service) CountMovies(ctx context.Context) (int, error) {
+	count, err := s.movieRepo.CountAll(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count movies: %w", err)
+	}
+	return count, nil
+}
+
+// GetTopRatedMovies retrieves top-rated movies
+func (s *Service) GetTopRatedMovies(ctx context.Context, limit int) ([]*MovieDTO, error) {
+	if limit <= 0 {
+		limit = 10
+	}
+
+	domainMovies, err := s.movieRepo.FindTopRated(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top rated movies: %w", err)
+	}
+
+	dtos := make([]*MovieDTO, 0, len(domainMovies))
+	for _, domainMovie := range domainMovies {
+		dtos = append(dtos, s.toDTO(domainMovie))
+	}
+
+	return dtos, nil
+}
+
+// GetReleaseTimeline counts the movies released in each year of a range and
+// picks the best rated of each. Unrated movies are counted but never picked.
+func (s *Service) GetReleaseTimeline(ctx context.Context, query ReleaseTimelineQuery) (*ReleaseTimelineDTO, error) {
+	if query.StartYear > 0 && query.EndYear > 0 && query.StartYear > query.EndYear {
+		return nil, shared.NewValidationError("start year cannot be after end year")
+	}
+	topPicks := query.TopPicks
+	if topPicks <= 0 {
+		topPicks = 3
+	}
+
+	// Every movie in the range is read, without a limit, so the counts are
+	// exact; sorting best rated first within each year puts the picks first
+	domainMovies, err := s.movieRepo.FindByCriteria(ctx, movie.SearchCriteria{
+		Genre:   query.Genre,
+		MinYear: query.StartYear,
+		MaxYear: query.EndYear,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read release timeline: %w", err)
+	}
+	sort.Slice(domainMovies, func(i, j int) bool {
+		a, b := domainMovies[i], domainMovies[j]
+		if a.Year().Value() != b.Year().Value() {
+			return a.Year().Value() < b.Year().Value()
+		}
+		if a.Rating().Value() != b.Rating().Value() {
+			return a.Rating().Value() > b.Rating().Value()
+		}
+		return a.Title() < b.Title()
+	})
+
+	timeline := &ReleaseTimelineDTO{Total: len(domainMovies), Years: []*ReleaseYearDTO{}}
+	var current *ReleaseYearDTO
+	var rated int
+	var ratingTotal float64
+	var months [12]int
+	for _, domainMovie := range domainMovies {
+		year := domainMovie.Year().Value()
+		if current == nil || current.Year != year {
+			if current != nil {
+				current.Months = releaseMonths(months)
+			}
+			rated, ratingTotal, months = 0, 0, [12]int{}
+			current = &ReleaseYearDTO{Year: year, TopRated: []*MovieDTO{}}
+			timeline.Years = append(timeline.Years, current)
+		}
+
+		current.Count++
+		if domainMovie.HasReleaseDate() {
+			months[domainMovie.ReleaseDate().Month()-1]++
+		} else {
+			current.Undated++
+		}
+		if rating := domainMovie.Rating().Value(); rating > 0 {
+			rated++
+			ratingTotal += rating
+			current.AverageRating = ratingTotal / float64(rated)
+			if len(current.TopRated) < topPicks {
+				current.TopRated = append(current.TopRated, s.toDTO(domainMovie))
+			}
+		}
+	}
+	if current != nil {
+		current.Months = releaseMonths(months)
+	}
+
+	return timeline, nil
+}
+
+// releaseMonths lists the months of a year with dated releases, in order
+func releaseMonths(counts [12]int) []*ReleaseMonthDTO {
+	months := []*ReleaseMonthDTO{}
+	for i, count := range counts {
+		if count > 0 {
+			months = append(months, &ReleaseMonthDTO{Month: i + 1, Count: count})
+		}
+	}
+	return months
+}
+
+// GetRuntimeStats returns the average, shortest and longest runtimes of each
+// genre's movies released in the query's years. Movies with no known
+// runtime are only counted as unknown.
+func (s *Service) GetRuntimeStats(ctx context.Context, query RuntimeQuery) (*RuntimeStatsDTO, error) {
+	if query.MinYear > 0 && query.MaxYear > 0 && query.MinYear > query.MaxYear {
+		return nil, shared.NewValidationError("min year cannot be after max year")
+	}
+
+	domainMovies, err := s.movieRepo.FindByCriteria(ctx, movie.SearchCriteria{
+		MinYear: query.MinYear,
+		MaxYear: query.MaxYear,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read runtimes: %w", err)
+	}
+
+	stats := &RuntimeStatsDTO{Genres: []*GenreRuntimeDTO{}}
+	byGenre := make(map[string]*GenreRuntimeDTO)
+	var total int
+	genreTotals := make(map[string]int) // Minutes per genre
+	for _, domainMovie := range domainMovies {
+		duration := domainMovie.Duration()
+		if duration == 0 {
+			stats.Unknown++
+			continue
+		}
+
+		stats.Movies++
+		total += duration
+		if domainMovie.IsShortFilm() {
+			stats.ShortFilms++
+		}
+		for _, genre := range domainMovie.Genres() {
+			genreStats, ok := byGenre[genre]
+			if !ok {
+				genreStats = &GenreRuntimeDTO{Genre: genre, ShortestDuration: duration}
+				byGenre[genre] = genreStats
+				stats.Genres = append(stats.Genres, genreStats)
+			}
+			genreStats.Movies++
+			genreTotals[genre] += duration
+			genreStats.ShortestDuration = min(genreStats.ShortestDuration, duration)
+			genreStats.LongestDuration = max(genreStats.LongestDuration, duration)
+			if domainMovie.IsShortFilm() {
+				genreStats.ShortFilms++
+			}
+		}
+	}
+
+	if stats.Movies > 0 {
+		stats.AverageDuration = float64(total) / float64(stats.Movies)
+	}
+	for _, genreStats := range stats.Genres {
+		genreStats.AverageDuration = float64(genreTotals[genreStats.Genre]) / float64(genreStats.Movies)
+	}
+	sort.Slice(stats.Genres, func(i, j int) bool {
+		a, b := stats.Genres[i], stats.Genres[j]
+		if a.AverageDuration != b.AverageDuration {
+			return a.AverageDuration > b.AverageDuration
+		}
+		return a.Genre < b.Genre
+	})
+	return stats, nil
+}
+
+// BulkUpdateMovies applies a patch to every movie matching a filter. Without
+// a confirmation token it is a dry run: nothing is saved, and the result
+// carries the affected count, sample rows and a token. Passing the token
+// back commits the update, provided the affected movies have not changed in
+// the meantime. Every movie is patched and validated before any is saved,
+// and they are saved together or not at all.
+func (s *Service) BulkUpdateMovies(ctx context.Context, cmd BulkUpdateMoviesCommand) (*BulkUpdateResultDTO, error) {
+	if cmd.Patch.IsEmpty() {
+		return nil, shared.NewValidationError("update must change at least one field")
+	}
+	if !hasFilter(cmd.Filter) {
+		return nil, shared.NewValidationError("at least one filter is required")
+	}
+
+	filter := cmd.Filter
+	filter.Limit, filter.Offset, filter.OrderBy, filter.OrderDir, filter.Sort, filter.Fuzzy = 0, 0, "", "", nil, false
+	criteria, err := buildSearchCriteria(filter)
+	if err != nil {
+		return nil, err
+	}
+	criteria.Limit = 0 // Every match, not the first page
+
+	domainMovies, err := s.movieRepo.FindByCriteria(ctx, criteria)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find movies: %w", err)
+	}
+
+	sampleSize := cmd.SampleSize
+	if sampleSize <= 0 {
+		sampleSize = defaultBulkUpdateSamples
+	}
+	if sampleSize > maxBulkUpdateSamples {
+		sampleSize = maxBulkUpdateSamples
+	}
+
+	result := &BulkUpdateResultDTO{
+		DryRun:  cmd.ConfirmationToken == "",
+		Matched: len(domainMovies),
+		Samples: []*MovieChangeDTO{},
+	}
+
+	// The token covers the patch and the current state of every affected
+	// movie, so a commit fails if either differs from what the dry run previewed
+	patchJSON, err := json.Marshal(cmd.Patch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode update: %w", err)
+	}
+	states := make(map[int][]byte, len(domainMovies))
+
+	updates := make([]*movie.Movie, 0, len(domainMovies))
+	for _, existing := range domainMovies {
+		updated, err := patchMovie(existing, cmd.Patch)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update movie %d: %w", existing.ID().Value(), err)
+		}
+
+		before, after := s.toDTO(existing), s.toDTO(updated)
+		if sameContent(before, after) {
+			continue
+		}
+		updates = append(updates, updated)
+		beforeJSON, err := json.Marshal(withoutTimestamps(before))
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode movie %d: %w", before.ID, err)
+		}
+		states[before.ID] = beforeJSON
+
+		if len(result.Samples) < sampleSize {
+			result.Samples = append(result.Samples, &MovieChangeDTO{Before: before, After: after})
+		}
+	}
+	result.Affected = len(updates)
+
+	token := ""
+	if len(updates) > 0 {
+		token = confirmationToken(patchJSON, states)
+	}
+	if result.DryRun {
+		result.ConfirmationToken = token
+		return result, nil
+	}
+	if cmd.ConfirmationToken != token {
+		return nil, shared.NewConflictError("matching movies changed since the dry run; run it again for a new confirmation token")
+	}
+
+	// Either every affected movie is saved or none is
+	if err := s.movieRepo.UpdateAll(ctx, updates); err != nil {
+		return nil, fmt.Errorf("failed to save %d updated movies: %w", len(updates), err)
+	}
+	result.Updated = len(updates)
+
+	return result, nil
+}
+
+// confirmationToken hashes an encoded patch with the encoded state of each
+// affected movie, in ID order so the token does not depend on result order
+func confirmationToken(patchJSON []byte, states map[int][]byte) string {
+	ids := make([]int, 0, len(states))
+	for id := range states {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	hash := sha256.New()
+	hash.Write(patchJSON)
+	for _, id := range ids {
+		hash.Write(states[id])
+	}
+	return hex.EncodeToString(hash.Sum(nil))[:16]
+}
+
+// hasFilter reports whether a query narrows the movies it matches
+func hasFilter(query SearchMoviesQuery) bool {
+	return query.Title != "" || query.Director != "" || query.Genre != "" ||
+		query.MinYear > 0 || query.MaxYear > 0 || query.MinRating > 0 || query.MaxRating > 0 ||
+		query.MaxCertification != "" || len(query.ExcludeWarnings) > 0 ||
+		!query.ReleasedAfter.IsZero() || !query.ReleasedBefore.IsZero() ||
+		query.MinDuration > 0 || query.MaxDuration > 0 || query.AfterID > 0
+}
+
+// patchMovie builds an updated copy of a movie with a patch applied,
+// leaving the original untouched (the same immutable approach UpdateMovie takes)
+func patchMovie(existing *movie.Movie, patch MoviePatch) (*movie.Movie, error) {
+	director := existing.Director()
+	if patch.Director != "" {
+		director = patch.Director
+	}
+
+	updated, err := movie.NewMovieWithID(existing.ID(), existing.Title(), director, existing.Year().Value())
+	if err != nil {
+		return nil, err
+	}
+	if err := updated.SetReleaseDate(existing.ReleaseDate()); err != nil {
+		return nil, fmt.Errorf("failed to set release date: %w", err)
+	}
+
+	rating := existing.Rating().Value()
+	if patch.Rating != 0 {
+		rating = patch.Rating
+	}
+	if rating > 0 {
+		if err := updated.SetRating(rating); err != nil {
+			return nil, fmt.Errorf("failed to set rating: %w", err)
+		}
+	}
+
+	removedGenres := make(map[string]bool, len(patch.RemoveGenres))
+	for _, genre := range patch.RemoveGenres {
+		removedGenres[strings.TrimSpace(genre)] = true
+	}
+	for _, genre := range existing.Genres() {
+		if !removedGenres[genre] {
+			if err := updated.AddGenre(genre); err != nil {
+				return nil, fmt.Errorf("failed to add genre %s: %w", genre, err)
+			}
+		}
+	}
+	for _, genre := range patch.AddGenres {
+		if !updated.HasGenre(strings.TrimSpace(genre)) {
+			if err := updated.AddGenre(genre); err != nil {
+				return nil, fmt.Errorf("failed to add genre %s: %w", genre, err)
+			}
+		}
+	}
+
+	if existing.PosterURL() != "" {
+		if err := updated.SetPosterURL(existing.PosterURL()); err != nil {
+			return nil, fmt.Errorf("failed to set poster URL: %w", err)
+		}
+	}
+	if err := updated.SetDuration(existing.Duration()); err != nil {
+		return nil, fmt.Errorf("failed to set duration: %w", err)
+	}
+
+	certifications := existing.Certifications()
+	for region, certification := range patch.Certifications {
+		normalizedRegion, err := movie.NormalizeRegion(region)
+		if err != nil {
+			return nil, fmt.Errorf("failed to set certification: %w", err)
+		}
+		certifications[normalizedRegion] = certification
+	}
+
+	removedWarnings := make(map[string]bool, len(patch.RemoveContentWarnings))
+	for _, warning := range patch.RemoveContentWarnings {
+		normalized, err := movie.NormalizeContentWarning(warning)
+		if err != nil {
+			return nil, fmt.Errorf("invalid content warning to remove: %w", err)
+		}
+		removedWarnings[normalized] = true
+	}
+	warnings := make([]string, 0, len(patch.AddContentWarnings))
+	for _, warning := range existing.ContentWarnings() {
+		if !removedWarnings[warning] {
+			warnings = append(warnings, warning)
+		}
+	}
+	for _, warning := range patch.AddContentWarnings {
+		if !existing.HasContentWarning(warning) {
+			warnings = append(warnings, warning)
+		}
+	}
+
+	if err := applyContentAdvisory(updated, certifications, warnings); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// sameContent reports whether two DTOs of a movie differ only in their timestamps
+func sameContent(a, b *MovieDTO) bool {
+	return reflect.DeepEqual(withoutTimestamps(a), withoutTimestamps(b))
+}
+
+// withoutTimestamps copies a DTO with its timestamps cleared. Movies read
+// back from the repository are not guaranteed to keep their timestamps, so
+// comparisons look at content only.
+func withoutTimestamps(dto *MovieDTO) MovieDTO {
+	content := *dto
+	content.CreatedAt, content.UpdatedAt = "", ""
+	return content
+}
+
+// buildSearchCriteria converts a search query to repository criteria
+func buildSearchCriteria(query SearchMoviesQuery) (movie.SearchCriteria, error) {
 	criteria := movie.SearchCriteria{
 		Title:     query.Title,
 		Director:  query.Director,
@@ -205,6 +1011,12 @@ func (s *Service) SearchMovies(ctx context.Context, query SearchMoviesQuery) ([]
 		MaxRating: query.MaxRating,
 		Limit:     query.Limit,
 		Offset:    query.Offset,
+
+		ReleasedAfter:  query.ReleasedAfter,
+		ReleasedBefore: query.ReleasedBefore,
+		MinDuration:    query.MinDuration,
+		MaxDuration:    query.MaxDuration,
+		AfterID:        query.AfterID,
 	}
 
 	// Set default limit if not provided
@@ -212,23 +1024,8 @@ func (s *Service) SearchMovies(ctx context.Context, query SearchMoviesQuery) ([]
 		criteria.Limit = 50
 	}
 
-	// Set order by
-	switch query.OrderBy {
-	case "title":
-		criteria.OrderBy = movie.OrderByTitle
-	case "director":
-		criteria.OrderBy = movie.OrderByDirector
-	case "year":
-		criteria.OrderBy = movie.OrderByYear
-	case "rating":
-		criteria.OrderBy = movie.OrderByRating
-	case "created_at":
-		criteria.OrderBy = movie.OrderByCreatedAt
-	case "updated_at":
-		criteria.OrderBy = movie.OrderByUpdatedAt
-	default:
-		criteria.OrderBy = movie.OrderByTitle
-	}
+	// Set order by, falling back to title for unknown fields
+	criteria.OrderBy, _ = parseOrderBy(query.OrderBy)
 
 	// Set order direction
 	if query.OrderDir == "desc" {
@@ -237,36 +1034,106 @@ func (s *Service) SearchMovies(ctx context.Context, query SearchMoviesQuery) ([]
 		criteria.OrderDir = movie.OrderAsc
 	}
 
-	domainMovies, err := s.movieRepo.FindByCriteria(ctx, criteria)
-	if err != nil {
-		return nil, fmt.Errorf("failed to search movies: %w", err)
+	// Multi-key sorts are validated strictly since a silently dropped key
+	// would reorder results in a way the caller did not ask for
+	for _, key := range query.Sort {
+		field, ok := parseOrderBy(key.Field)
+		if !ok {
+			return movie.SearchCriteria{}, fmt.Errorf("unsupported sort field: %q", key.Field)
+		}
+		dir, ok := parseOrderDirection(key.Dir)
+		if !ok {
+			return movie.SearchCriteria{}, fmt.Errorf("unsupported sort direction for %s: %q", key.Field, key.Dir)
+		}
+		criteria.Sort = append(criteria.Sort, movie.SortKey{Field: field, Dir: dir})
 	}
 
-	var dtos []*MovieDTO
-	for _, domainMovie := range domainMovies {
-		dtos = append(dtos, s.toDTO(domainMovie))
+	if err := applyAdvisoryFilters(&criteria, query); err != nil {
+		return movie.SearchCriteria{}, err
 	}
+	return criteria, nil
+}
 
-	return dtos, nil
+// applyContentAdvisory sets a movie's age ratings and content warnings
+func applyContentAdvisory(domainMovie *movie.Movie, certifications map[string]string, warnings []string) error {
+	for region, certification := range certifications {
+		if err := domainMovie.SetCertification(region, certification); err != nil {
+			return fmt.Errorf("failed to set certification: %w", err)
+		}
+	}
+
+	for _, warning := range warnings {
+		if err := domainMovie.AddContentWarning(warning); err != nil {
+			return fmt.Errorf("failed to add content warning %s: %w", warning, err)
+		}
+	}
+	return nil
 }
 
-// GetTopRatedMovies retrieves top-rated movies
-func (s *Service) GetTopRatedMovies(ctx context.Context, limit int) ([]*MovieDTO, error) {
-	if limit <= 0 {
-		limit = 10
+// applyAdvisoryFilters resolves a query's certification ceiling to the
+// ratings it allows and normalizes its excluded content warnings
+func applyAdvisoryFilters(criteria *movie.SearchCriteria, query SearchMoviesQuery) error {
+	if query.MaxCertification != "" {
+		region := query.CertificationRegion
+		if region == "" {
+			region = defaultCertificationRegion
+		}
+
+		normalizedRegion, err := movie.NormalizeRegion(region)
+		if err != nil {
+			return fmt.Errorf("invalid certification region: %w", err)
+		}
+		allowed, err := movie.CertificationsUpTo(normalizedRegion, query.MaxCertification)
+		if err != nil {
+			return fmt.Errorf("invalid max certification: %w", err)
+		}
+
+		criteria.CertificationRegion = normalizedRegion
+		criteria.Certifications = allowed
 	}
 
-	domainMovies, err := s.movieRepo.FindTopRated(ctx, limit)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get top rated movies: %w", err)
+	for _, warning := range query.ExcludeWarnings {
+		normalized, err := movie.NormalizeContentWarning(warning)
+		if err != nil {
+			return fmt.Errorf("invalid excluded content warning: %w", err)
+		}
+		criteria.ExcludeWarnings = append(criteria.ExcludeWarnings, normalized)
 	}
+	return nil
+}
 
-	dtos := make([]*MovieDTO, 0, len(domainMovies))
-	for _, domainMovie := range domainMovies {
-		dtos = append(dtos, s.toDTO(domainMovie))
+// parseOrderBy maps a field name to a sort field, reporting whether it is known
+func parseOrderBy(field string) (movie.OrderBy, bool) {
+	switch field {
+	case "title":
+		return movie.OrderByTitle, true
+	case "director":
+		return movie.OrderByDirector, true
+	case "year":
+		return movie.OrderByYear, true
+	case "rating":
+		return movie.OrderByRating, true
+	case "created_at":
+		return movie.OrderByCreatedAt, true
+	case "updated_at":
+		return movie.OrderByUpdatedAt, true
+	case "id":
+		return movie.OrderByID, true
+	default:
+		return movie.OrderByTitle, false
 	}
+}
 
-	return dtos, nil
+// parseOrderDirection maps asc/desc to a sort direction, defaulting to ascending
+func parseOrderDirection(dir string) (movie.OrderDirection, bool) {
+	switch dir {
+	case "", "asc":
+		return movie.OrderAsc, true
+	case "desc":
+		return movie.OrderDesc, true
+	default:
+		return movie.OrderAsc, false
+	}
 }
 
 // toDTO converts a domain movie to a DTO
@@ -279,8 +1146,15 @@ func (s *Service) toDTO(domainMovie *movie.Movie) *MovieDTO {
 		Rating:    domainMovie.Rating().Value(),
 		Genres:    domainMovie.Genres(),
 		PosterURL: domainMovie.PosterURL(),
-		CreatedAt: domainMovie.CreatedAt().Format("2006-01-02T15:04:05Z"),
-		UpdatedAt: domainMovie.UpdatedAt().Format("2006-01-02T15:04:05Z"),
+		CreatedAt: serialization.Timestamp(domainMovie.CreatedAt()),
+		UpdatedAt: serialization.Timestamp(domainMovie.UpdatedAt()),
+		Duration:  domainMovie.Duration(),
+
+		Certifications:  domainMovie.Certifications(),
+		ContentWarnings: domainMovie.ContentWarnings(),
+	}
+	if domainMovie.HasReleaseDate() {
+		dto.ReleaseDate = domainMovie.ReleaseDate().Format(time.DateOnly)
 	}
 
 	return dto
@@ -3,7 +3,12 @@ package movie
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
 	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
@@ -15,6 +20,8 @@ type MockMovieRepository struct {
 	nextID             int
 	findByIDFunc       func(ctx context.Context, id shared.MovieID) (*movie.Movie, error)
 	saveFunc           func(ctx context.Context, m *movie.Movie) error
+	insertAllFunc      func(ctx context.Context, movies []*movie.Movie) error
+	updateAllFunc      func(ctx context.Context, movies []*movie.Movie) error
 	deleteFunc         func(ctx context.Context, id shared.MovieID) error
 	findByCriteriaFunc func(ctx context.Context, criteria movie.SearchCriteria) ([]*movie.Movie, error)
 	findTopRatedFunc   func(ctx context.Context, limit int) ([]*movie.Movie, error)
@@ -54,6 +61,33 @@ func (m *MockMovieRepository) Save(ctx context.Context, movie *movie.Movie) erro
 	return nil
 }
 
+func (m *MockMovieRepository) InsertAll(ctx context.Context, movies []*movie.Movie) error {
+	if m.insertAllFunc != nil {
+		return m.insertAllFunc(ctx, movies)
+	}
+	for _, movie := range movies {
+		if err := m.Save(ctx, movie); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MockMovieRepository) UpdateAll(ctx context.Context, movies []*movie.Movie) error {
+	if m.updateAllFunc != nil {
+		return m.updateAllFunc(ctx, movies)
+	}
+	for _, movie := range movies {
+		if _, exists := m.movies[movie.ID().Value()]; !exists {
+			return errors.New("movie not found")
+		}
+	}
+	for _, movie := range movies {
+		m.movies[movie.ID().Value()] = movie
+	}
+	return nil
+}
+
 func (m *MockMovieRepository) Delete(ctx context.Context, id shared.MovieID) error {
 	if m.deleteFunc != nil {
 		return m.deleteFunc(ctx, id)
@@ -75,6 +109,17 @@ func (m *MockMovieRepository) FindByCriteria(ctx context.Context, criteria movie
 	for _, movieItem := range m.movies {
 		match := true
 
+		// Filter by IDs
+		if len(criteria.IDs) > 0 {
+			found := false
+			for _, id := range criteria.IDs {
+				if id == movieItem.ID() {
+					found = true
+				}
+			}
+			match = found
+		}
+
 		// Filter by director
 		if criteria.Director != "" && movieItem.Director() != criteria.Director {
 			match = false
@@ -287,6 +332,144 @@ func TestService_CreateMovie_WithGenres(t *testing.T) {
 	}
 }
 
+func TestService_CreateMovie_WithContentAdvisory(t *testing.T) {
+	repo := NewMockMovieRepository()
+	service := NewService(repo)
+
+	cmd := CreateMovieCommand{
+		Title:           "The Dark Knight",
+		Director:        "Christopher Nolan",
+		Year:            2008,
+		Certifications:  map[string]string{"us": "pg-13", "UK": "12A"},
+		ContentWarnings: []string{"Violence"},
+	}
+
+	result, err := service.CreateMovie(context.Background(), cmd)
+	if err != nil {
+		t.Fatalf("CreateMovie() error = %v", err)
+	}
+
+	want := map[string]string{"US": "PG-13", "GB": "12A"}
+	if !reflect.DeepEqual(result.Certifications, want) {
+		t.Errorf("Expected certifications %v, got: %v", want, result.Certifications)
+	}
+	if !reflect.DeepEqual(result.ContentWarnings, []string{"violence"}) {
+		t.Errorf("Expected normalized content warnings, got: %v", result.ContentWarnings)
+	}
+
+	invalid := []CreateMovieCommand{
+		{Title: "A", Director: "B", Year: 2000, Certifications: map[string]string{"US": "15"}},
+		{Title: "A", Director: "B", Year: 2000, Certifications: map[string]string{"XX": "PG"}},
+		{Title: "A", Director: "B", Year: 2000, ContentWarnings: []string{"gore", "Gore"}},
+	}
+	for _, cmd := range invalid {
+		if _, err := service.CreateMovie(context.Background(), cmd); err == nil {
+			t.Errorf("CreateMovie() expected error for %+v", cmd)
+		}
+	}
+}
+
+func TestService_CreateMovie_WithReleaseDate(t *testing.T) {
+	repo := NewMockMovieRepository()
+	service := NewService(repo)
+	released := time.Date(1999, time.March, 31, 0, 0, 0, 0, time.UTC)
+
+	// The year is taken from the release date when left out
+	result, err := service.CreateMovie(context.Background(), CreateMovieCommand{
+		Title:       "The Matrix",
+		Director:    "Wachowskis",
+		ReleaseDate: released,
+	})
+	if err != nil {
+		t.Fatalf("CreateMovie() error = %v", err)
+	}
+	if result.Year != 1999 || result.ReleaseDate != "1999-03-31" {
+		t.Errorf("Expected year 1999 and release date 1999-03-31, got: %d %q", result.Year, result.ReleaseDate)
+	}
+
+	_, err = service.CreateMovie(context.Background(), CreateMovieCommand{
+		Title:       "The Matrix",
+		Director:    "Wachowskis",
+		Year:        1998,
+		ReleaseDate: released,
+	})
+	if !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("Expected a validation error for a year that contradicts the release date, got: %v", err)
+	}
+}
+
+func TestService_CreateMovies(t *testing.T) {
+	repo := NewMockMovieRepository()
+	inserted := 0
+	repo.insertAllFunc = func(ctx context.Context, movies []*movie.Movie) error {
+		inserted++
+		for _, m := range movies {
+			if err := repo.Save(ctx, m); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	service := NewService(repo)
+
+	movies, errs, err := service.CreateMovies(context.Background(), []CreateMovieCommand{
+		{Title: "Inception", Director: "Christopher Nolan", Year: 2010, Genres: []string{"Sci-Fi"}},
+		{Title: "", Director: "Christopher Nolan", Year: 2014},
+		{Title: "Heat", Director: "Michael Mann", Year: 1995, Rating: 8.3},
+	})
+
+	if err != nil {
+		t.Fatalf("CreateMovies() error = %v", err)
+	}
+	if inserted != 1 {
+		t.Errorf("Expected one batched insert, got: %d", inserted)
+	}
+	if movies[0] == nil || movies[0].ID == 0 || movies[0].Title != "Inception" || errs[0] != nil {
+		t.Errorf("Expected Inception to be created, got: %+v, %v", movies[0], errs[0])
+	}
+	if movies[1] != nil || errs[1] == nil {
+		t.Errorf("Expected the untitled movie to fail, got: %+v, %v", movies[1], errs[1])
+	}
+	if movies[2] == nil || movies[2].Rating != 8.3 || errs[2] != nil {
+		t.Errorf("Expected Heat to be created, got: %+v, %v", movies[2], errs[2])
+	}
+}
+
+func TestService_CreateMovie_ValidationPolicy(t *testing.T) {
+	service := NewService(NewMockMovieRepository())
+	unrated := CreateMovieCommand{Title: "Inception", Director: "Christopher Nolan", Year: 2010}
+
+	if _, err := service.CreateMovie(context.Background(), unrated); err != nil {
+		t.Fatalf("Expected lenient validation to accept an unrated movie, got: %v", err)
+	}
+
+	service.SetValidationPolicy(shared.ValidationStrict)
+	if _, err := service.CreateMovie(context.Background(), unrated); !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("Expected strict validation to reject an unrated movie, got: %v", err)
+	}
+
+	unrated.Validation = shared.ValidationLenient
+	if _, err := service.CreateMovie(context.Background(), unrated); err != nil {
+		t.Errorf("Expected the command's lenient policy to win, got: %v", err)
+	}
+}
+
+func TestService_CreateMovies_InsertError(t *testing.T) {
+	repo := NewMockMovieRepository()
+	repo.insertAllFunc = func(ctx context.Context, movies []*movie.Movie) error {
+		return errors.New("disk full")
+	}
+	service := NewService(repo)
+
+	_, _, err := service.CreateMovies(context.Background(), []CreateMovieCommand{
+		{Title: "Inception", Director: "Christopher Nolan", Year: 2010},
+	})
+
+	if err == nil || err.Error() != "failed to save movies: disk full" {
+		t.Errorf("Expected save error, got: %v", err)
+	}
+}
+
 func TestService_GetMovie(t *testing.T) {
 	repo := NewMockMovieRepository()
 	service := NewService(repo)
@@ -313,6 +496,39 @@ func TestService_GetMovie(t *testing.T) {
 	}
 }
 
+func TestService_GetMoviesByIDs(t *testing.T) {
+	repo := NewMockMovieRepository()
+	service := NewService(repo)
+
+	var ids []int
+	for _, title := range []string{"Inception", "Interstellar", "The Matrix"} {
+		created, err := service.CreateMovie(context.Background(), CreateMovieCommand{Title: title, Director: "Director", Year: 2010})
+		if err != nil {
+			t.Fatalf("Failed to create test movie: %v", err)
+		}
+		ids = append(ids, created.ID)
+	}
+
+	results, err := service.GetMoviesByIDs(context.Background(), []int{ids[1], 999})
+	if err != nil {
+		t.Fatalf("GetMoviesByIDs() error = %v", err)
+	}
+
+	if len(results) != 1 || results[0].Title != "Interstellar" {
+		t.Errorf("GetMoviesByIDs() = %v, want only Interstellar", results)
+	}
+}
+
+func TestService_GetMoviesByIDs_InvalidID(t *testing.T) {
+	repo := NewMockMovieRepository()
+	service := NewService(repo)
+
+	_, err := service.GetMoviesByIDs(context.Background(), []int{-1})
+	if err == nil {
+		t.Error("GetMoviesByIDs() expected error for invalid ID")
+	}
+}
+
 func TestService_GetMovie_NotFound(t *testing.T) {
 	repo := NewMockMovieRepository()
 	service := NewService(repo)
@@ -341,10 +557,10 @@ func TestService_UpdateMovie(t *testing.T) {
 	// Update the movie
 	updateCmd := UpdateMovieCommand{
 		ID:       created.ID,
-		Title:    "Updated Title",
-		Director: "Updated Director",
-		Year:     2021,
-		Rating:   8.5,
+		Title:    ptr("Updated Title"),
+		Director: ptr("Updated Director"),
+		Year:     ptr(2021),
+		Rating:   ptr(8.5),
 	}
 
 	result, err := service.UpdateMovie(context.Background(), updateCmd)
@@ -352,17 +568,145 @@ func TestService_UpdateMovie(t *testing.T) {
 		t.Fatalf("UpdateMovie() error = %v", err)
 	}
 
-	if result.Title != updateCmd.Title {
-		t.Errorf("UpdateMovie() title = %v, want %v", result.Title, updateCmd.Title)
+	if result.Title != *updateCmd.Title {
+		t.Errorf("UpdateMovie() title = %v, want %v", result.Title, *updateCmd.Title)
 	}
-	if result.Director != updateCmd.Director {
-		t.Errorf("UpdateMovie() director = %v, want %v", result.Director, updateCmd.Director)
+	if result.Director != *updateCmd.Director {
+		t.Errorf("UpdateMovie() director = %v, want %v", result.Director, *updateCmd.Director)
 	}
-	if result.Year != updateCmd.Year {
-		t.Errorf("UpdateMovie() year = %v, want %v", result.Year, updateCmd.Year)
+	if result.Year != *updateCmd.Year {
+		t.Errorf("UpdateMovie() year = %v, want %v", result.Year, *updateCmd.Year)
 	}
-	if result.Rating != updateCmd.Rating {
-		t.Errorf("UpdateMovie() rating = %v, want %v", result.Rating, updateCmd.Rating)
+	if result.Rating != *updateCmd.Rating {
+		t.Errorf("UpdateMovie() rating = %v, want %v", result.Rating, *updateCmd.Rating)
+	}
+}
+
+// recordingPublisher records the types of the domain events it is given
+type recordingPublisher struct {
+	eventTypes []string
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, events []shared.DomainEvent) {
+	for _, event := range events {
+		p.eventTypes = append(p.eventTypes, event.EventType())
+	}
+}
+
+func TestService_PublishesEvents(t *testing.T) {
+	repo := NewMockMovieRepository()
+	service := NewService(repo)
+	publisher := &recordingPublisher{}
+	service.SetEventPublisher(publisher)
+	ctx := context.Background()
+
+	created, err := service.CreateMovie(ctx, CreateMovieCommand{Title: "Heat", Director: "Michael Mann", Year: 1995, Rating: 8.3, Genres: []string{"Crime"}})
+	if err != nil {
+		t.Fatalf("CreateMovie() error = %v", err)
+	}
+	if _, err := service.UpdateMovie(ctx, UpdateMovieCommand{ID: created.ID, Title: ptr("Heat (1995)")}); err != nil {
+		t.Fatalf("UpdateMovie() error = %v", err)
+	}
+	if _, err := service.UpdateMovie(ctx, UpdateMovieCommand{ID: created.ID, Rating: ptr(8.5)}); err != nil {
+		t.Fatalf("UpdateMovie() error = %v", err)
+	}
+	if err := service.DeleteMovie(ctx, created.ID); err != nil {
+		t.Fatalf("DeleteMovie() error = %v", err)
+	}
+
+	want := []string{"MovieCreated", "MovieUpdated", "MovieRatingChanged", "MovieUpdated", "MovieDeleted"}
+	if !reflect.DeepEqual(publisher.eventTypes, want) {
+		t.Errorf("Expected events %v, got: %v", want, publisher.eventTypes)
+	}
+
+	// A change that is not saved publishes nothing
+	publisher.eventTypes = nil
+	repo.saveFunc = func(ctx context.Context, m *movie.Movie) error { return errors.New("disk full") }
+	if _, err := service.CreateMovie(ctx, CreateMovieCommand{Title: "Ronin", Director: "John Frankenheimer", Year: 1998}); err == nil {
+		t.Fatal("Expected the failed save to be returned")
+	}
+	if len(publisher.eventTypes) != 0 {
+		t.Errorf("Expected no events for a failed save, got: %v", publisher.eventTypes)
+	}
+}
+
+func TestService_UpdateMovie_PartialFields(t *testing.T) {
+	released := time.Date(1995, time.December, 15, 0, 0, 0, 0, time.UTC)
+	original := CreateMovieCommand{
+		Title:           "Heat",
+		Director:        "Michael Mann",
+		ReleaseDate:     released,
+		Rating:          8.3,
+		Duration:        170,
+		Genres:          []string{"Crime", "Drama"},
+		PosterURL:       "https://example.com/heat.jpg",
+		Certifications:  map[string]string{"US": "R"},
+		ContentWarnings: []string{"violence"},
+	}
+
+	tests := []struct {
+		name string
+		cmd  UpdateMovieCommand
+		want func(dto *MovieDTO) // Applies the expected change to the original
+	}{
+		{name: "nothing", want: func(dto *MovieDTO) {}},
+		{name: "title", cmd: UpdateMovieCommand{Title: ptr("Heat (Director's Cut)")}, want: func(dto *MovieDTO) { dto.Title = "Heat (Director's Cut)" }},
+		{name: "director", cmd: UpdateMovieCommand{Director: ptr("M. Mann")}, want: func(dto *MovieDTO) { dto.Director = "M. Mann" }},
+		{name: "year and release date", cmd: UpdateMovieCommand{Year: ptr(1996), ReleaseDate: &time.Time{}}, want: func(dto *MovieDTO) { dto.Year, dto.ReleaseDate = 1996, "" }},
+		{name: "release date moves the year", cmd: UpdateMovieCommand{ReleaseDate: ptr(time.Date(1996, time.January, 5, 0, 0, 0, 0, time.UTC))}, want: func(dto *MovieDTO) { dto.Year, dto.ReleaseDate = 1996, "1996-01-05" }},
+		{name: "clear release date", cmd: UpdateMovieCommand{ReleaseDate: &time.Time{}}, want: func(dto *MovieDTO) { dto.ReleaseDate = "" }},
+		{name: "rating", cmd: UpdateMovieCommand{Rating: ptr(9.0)}, want: func(dto *MovieDTO) { dto.Rating = 9 }},
+		{name: "clear rating", cmd: UpdateMovieCommand{Rating: ptr(0.0)}, want: func(dto *MovieDTO) { dto.Rating = 0 }},
+		{name: "duration", cmd: UpdateMovieCommand{Duration: ptr(175)}, want: func(dto *MovieDTO) { dto.Duration = 175 }},
+		{name: "clear duration", cmd: UpdateMovieCommand{Duration: ptr(0)}, want: func(dto *MovieDTO) { dto.Duration = 0 }},
+		{name: "genres", cmd: UpdateMovieCommand{Genres: []string{"Thriller"}}, want: func(dto *MovieDTO) { dto.Genres = []string{"Thriller"} }},
+		{name: "clear genres", cmd: UpdateMovieCommand{Genres: []string{}}, want: func(dto *MovieDTO) { dto.Genres = []string{} }},
+		{name: "poster URL", cmd: UpdateMovieCommand{PosterURL: ptr("https://example.com/heat-4k.jpg")}, want: func(dto *MovieDTO) { dto.PosterURL = "https://example.com/heat-4k.jpg" }},
+		{name: "clear poster URL", cmd: UpdateMovieCommand{PosterURL: ptr("")}, want: func(dto *MovieDTO) { dto.PosterURL = "" }},
+		{name: "certifications", cmd: UpdateMovieCommand{Certifications: map[string]string{"GB": "18"}}, want: func(dto *MovieDTO) { dto.Certifications = map[string]string{"GB": "18"} }},
+		{name: "clear certifications", cmd: UpdateMovieCommand{Certifications: map[string]string{}}, want: func(dto *MovieDTO) { dto.Certifications = map[string]string{} }},
+		{name: "content warnings", cmd: UpdateMovieCommand{ContentWarnings: []string{"strong language"}}, want: func(dto *MovieDTO) { dto.ContentWarnings = []string{"strong language"} }},
+		{name: "clear content warnings", cmd: UpdateMovieCommand{ContentWarnings: []string{}}, want: func(dto *MovieDTO) { dto.ContentWarnings = []string{} }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewService(NewMockMovieRepository())
+			created, err := service.CreateMovie(context.Background(), original)
+			if err != nil {
+				t.Fatalf("Failed to create movie: %v", err)
+			}
+
+			tt.cmd.ID = created.ID
+			got, err := service.UpdateMovie(context.Background(), tt.cmd)
+			if err != nil {
+				t.Fatalf("UpdateMovie() error = %v", err)
+			}
+
+			want := *created
+			tt.want(&want)
+			got.CreatedAt, got.UpdatedAt = want.CreatedAt, want.UpdatedAt
+			if !reflect.DeepEqual(*got, want) {
+				t.Errorf("UpdateMovie() = %+v, want %+v", *got, want)
+			}
+		})
+	}
+}
+
+func TestService_UpdateMovie_YearContradictsReleaseDate(t *testing.T) {
+	service := NewService(NewMockMovieRepository())
+	created, err := service.CreateMovie(context.Background(), CreateMovieCommand{
+		Title:       "Heat",
+		Director:    "Michael Mann",
+		ReleaseDate: time.Date(1995, time.December, 15, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create movie: %v", err)
+	}
+
+	_, err = service.UpdateMovie(context.Background(), UpdateMovieCommand{ID: created.ID, Year: ptr(1996)})
+	if !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("Expected a validation error for a year the release date is not in, got: %v", err)
 	}
 }
 
@@ -428,6 +772,314 @@ func TestService_SearchMovies(t *testing.T) {
 	}
 }
 
+func TestService_SearchMovies_Fuzzy(t *testing.T) {
+	repo := NewMockMovieRepository()
+	service := NewService(repo)
+
+	for _, title := range []string{"The Shawshank Redemption", "Redemption Road", "Finding Nemo"} {
+		_, err := service.CreateMovie(context.Background(), CreateMovieCommand{Title: title, Director: "Director", Year: 2000})
+		if err != nil {
+			t.Fatalf("Failed to create test movie: %v", err)
+		}
+	}
+
+	results, err := service.SearchMovies(context.Background(), SearchMoviesQuery{Title: "Shawshenk Redemption", Fuzzy: true, Limit: 10})
+	if err != nil {
+		t.Fatalf("SearchMovies() error = %v", err)
+	}
+
+	if len(results) == 0 || results[0].Title != "The Shawshank Redemption" {
+		t.Fatalf("Expected The Shawshank Redemption ranked first, got %v", results)
+	}
+	for i, result := range results {
+		if result.Title == "Finding Nemo" {
+			t.Errorf("Expected unrelated title to be filtered out")
+		}
+		if result.Similarity <= 0 || (i > 0 && result.Similarity > results[i-1].Similarity) {
+			t.Errorf("Expected descending positive similarity scores, got %v at %d", result.Similarity, i)
+		}
+	}
+}
+
+func TestService_SearchMovies_FuzzyPagination(t *testing.T) {
+	repo := NewMockMovieRepository()
+	service := NewService(repo)
+
+	for _, title := range []string{"Alien", "Aliens", "Alien 3"} {
+		_, _ = service.CreateMovie(context.Background(), CreateMovieCommand{Title: title, Director: "Director", Year: 1990})
+	}
+
+	results, err := service.SearchMovies(context.Background(), SearchMoviesQuery{Title: "Alien", Fuzzy: true, Limit: 1, Offset: 1})
+	if err != nil {
+		t.Fatalf("SearchMovies() error = %v", err)
+	}
+
+	if len(results) != 1 || results[0].Title == "Alien" {
+		t.Errorf("Expected one result after the exact match, got %v", results)
+	}
+}
+
+func TestService_SearchMovies_MultiKeySort(t *testing.T) {
+	repo := NewMockMovieRepository()
+	var gotCriteria movie.SearchCriteria
+	repo.findByCriteriaFunc = func(ctx context.Context, criteria movie.SearchCriteria) ([]*movie.Movie, error) {
+		gotCriteria = criteria
+		return nil, nil
+	}
+	service := NewService(repo)
+
+	query := SearchMoviesQuery{
+		Sort: []SortKey{
+			{Field: "rating", Dir: "desc"},
+			{Field: "year"},
+			{Field: "title", Dir: "asc"},
+		},
+	}
+
+	_, err := service.SearchMovies(context.Background(), query)
+	if err != nil {
+		t.Fatalf("SearchMovies() error = %v", err)
+	}
+
+	want := []movie.SortKey{
+		{Field: movie.OrderByRating, Dir: movie.OrderDesc},
+		{Field: movie.OrderByYear, Dir: movie.OrderAsc},
+		{Field: movie.OrderByTitle, Dir: movie.OrderAsc},
+	}
+	if !reflect.DeepEqual(gotCriteria.Sort, want) {
+		t.Errorf("SearchMovies() sort = %v, want %v", gotCriteria.Sort, want)
+	}
+}
+
+func TestService_SearchMovies_InvalidSortKey(t *testing.T) {
+	repo := NewMockMovieRepository()
+	service := NewService(repo)
+
+	tests := []SortKey{
+		{Field: "budget"},
+		{Field: "year", Dir: "sideways"},
+	}
+
+	for _, key := range tests {
+		_, err := service.SearchMovies(context.Background(), SearchMoviesQuery{Sort: []SortKey{key}})
+		if err == nil {
+			t.Errorf("SearchMovies() expected error for sort key %+v", key)
+		}
+	}
+}
+
+func TestService_SearchMovies_AdvisoryFilters(t *testing.T) {
+	var gotCriteria movie.SearchCriteria
+	repo := NewMockMovieRepository()
+	repo.findByCriteriaFunc = func(ctx context.Context, criteria movie.SearchCriteria) ([]*movie.Movie, error) {
+		gotCriteria = criteria
+		return nil, nil
+	}
+	service := NewService(repo)
+
+	_, err := service.SearchMovies(context.Background(), SearchMoviesQuery{
+		MaxCertification: "pg",
+		ExcludeWarnings:  []string{"Strong Language"},
+	})
+	if err != nil {
+		t.Fatalf("SearchMovies() error = %v", err)
+	}
+
+	if gotCriteria.CertificationRegion != "US" {
+		t.Errorf("Expected default US region, got: %q", gotCriteria.CertificationRegion)
+	}
+	if !reflect.DeepEqual(gotCriteria.Certifications, []string{"G", "PG"}) {
+		t.Errorf("Expected G and PG to be allowed, got: %v", gotCriteria.Certifications)
+	}
+	if !reflect.DeepEqual(gotCriteria.ExcludeWarnings, []string{"strong language"}) {
+		t.Errorf("Expected normalized excluded warnings, got: %v", gotCriteria.ExcludeWarnings)
+	}
+
+	invalid := []SearchMoviesQuery{
+		{MaxCertification: "12A"},
+		{MaxCertification: "PG", CertificationRegion: "Mars"},
+		{ExcludeWarnings: []string{" "}},
+	}
+	for _, query := range invalid {
+		if _, err := service.SearchMovies(context.Background(), query); err == nil {
+			t.Errorf("SearchMovies() expected error for %+v", query)
+		}
+	}
+}
+
+// ptr returns a pointer to value, for the optional fields of commands
+func ptr[T any](value T) *T {
+	return &value
+}
+
+func newBulkUpdateTestService(t *testing.T) (*Service, *MockMovieRepository) {
+	t.Helper()
+
+	repo := NewMockMovieRepository()
+	service := NewService(repo)
+	for _, cmd := range []CreateMovieCommand{
+		{Title: "Inception", Director: "C. Nolan", Year: 2010, Genres: []string{"Sci-Fi"}},
+		{Title: "Memento", Director: "C. Nolan", Year: 2000, Rating: 8.4, Genres: []string{"Thriller"}},
+		{Title: "Heat", Director: "Michael Mann", Year: 1995},
+	} {
+		if _, err := service.CreateMovie(context.Background(), cmd); err != nil {
+			t.Fatalf("CreateMovie() error = %v", err)
+		}
+	}
+	return service, repo
+}
+
+func TestService_BulkUpdateMovies(t *testing.T) {
+	service, repo := newBulkUpdateTestService(t)
+	ctx := context.Background()
+
+	cmd := BulkUpdateMoviesCommand{
+		Filter: SearchMoviesQuery{Director: "C. Nolan"},
+		Patch:  MoviePatch{Director: "Christopher Nolan", AddGenres: []string{"Sci-Fi"}},
+	}
+
+	preview, err := service.BulkUpdateMovies(ctx, cmd)
+	if err != nil {
+		t.Fatalf("BulkUpdateMovies() error = %v", err)
+	}
+
+	if !preview.DryRun || preview.Matched != 2 || preview.Affected != 2 || preview.Updated != 0 {
+		t.Errorf("Expected a dry run affecting 2 movies, got: %+v", preview)
+	}
+	if preview.ConfirmationToken == "" || len(preview.Samples) != 2 {
+		t.Fatalf("Expected a token and 2 samples, got: %q with %d", preview.ConfirmationToken, len(preview.Samples))
+	}
+	if sample := preview.Samples[0]; sample.Before.Director != "C. Nolan" || sample.After.Director != "Christopher Nolan" {
+		t.Errorf("Expected before/after directors, got: %s -> %s", sample.Before.Director, sample.After.Director)
+	}
+	if repo.movies[1].Director() != "C. Nolan" {
+		t.Error("Expected dry run to leave movies unchanged")
+	}
+
+	cmd.ConfirmationToken = preview.ConfirmationToken
+	committed, err := service.BulkUpdateMovies(ctx, cmd)
+	if err != nil {
+		t.Fatalf("BulkUpdateMovies() error = %v", err)
+	}
+
+	if committed.DryRun || committed.Updated != 2 {
+		t.Errorf("Expected 2 movies updated, got: %+v", committed)
+	}
+	for _, id := range []int{1, 2} {
+		updated := repo.movies[id]
+		if updated.Director() != "Christopher Nolan" || !updated.HasGenre("Sci-Fi") {
+			t.Errorf("Expected movie %d to be patched, got: %s %v", id, updated.Director(), updated.Genres())
+		}
+	}
+	if !reflect.DeepEqual(repo.movies[1].Genres(), []string{"Sci-Fi"}) {
+		t.Errorf("Expected existing genre not to be duplicated, got: %v", repo.movies[1].Genres())
+	}
+	if repo.movies[2].Rating().Value() != 8.4 {
+		t.Errorf("Expected rating to be kept, got: %v", repo.movies[2].Rating().Value())
+	}
+}
+
+func TestService_BulkUpdateMovies_SkipsUnchanged(t *testing.T) {
+	service, _ := newBulkUpdateTestService(t)
+
+	result, err := service.BulkUpdateMovies(context.Background(), BulkUpdateMoviesCommand{
+		Filter:     SearchMoviesQuery{Director: "C. Nolan"},
+		Patch:      MoviePatch{AddGenres: []string{"Sci-Fi"}, RemoveGenres: []string{"Drama"}},
+		SampleSize: 1,
+	})
+	if err != nil {
+		t.Fatalf("BulkUpdateMovies() error = %v", err)
+	}
+
+	if result.Matched != 2 || result.Affected != 1 || len(result.Samples) != 1 {
+		t.Errorf("Expected 1 of 2 matches affected, got: %+v", result)
+	}
+	if result.Samples[0].After.Title != "Memento" {
+		t.Errorf("Expected Memento to change, got: %s", result.Samples[0].After.Title)
+	}
+}
+
+func TestService_BulkUpdateMovies_StaleToken(t *testing.T) {
+	service, _ := newBulkUpdateTestService(t)
+	ctx := context.Background()
+
+	cmd := BulkUpdateMoviesCommand{
+		Filter: SearchMoviesQuery{Director: "C. Nolan"},
+		Patch:  MoviePatch{Rating: 9},
+	}
+	preview, _ := service.BulkUpdateMovies(ctx, cmd)
+
+	if _, err := service.UpdateMovie(ctx, UpdateMovieCommand{ID: 1, Rating: ptr(8.8)}); err != nil {
+		t.Fatalf("UpdateMovie() error = %v", err)
+	}
+
+	cmd.ConfirmationToken = preview.ConfirmationToken
+	if _, err := service.BulkUpdateMovies(ctx, cmd); err == nil {
+		t.Error("Expected error for a token from before the movies changed")
+	}
+
+	cmd.Patch.Rating = 9.5
+	if _, err := service.BulkUpdateMovies(ctx, cmd); err == nil {
+		t.Error("Expected error for a token from a different update")
+	}
+}
+
+func TestService_BulkUpdateMovies_SavesAllOrNone(t *testing.T) {
+	service, repo := newBulkUpdateTestService(t)
+	ctx := context.Background()
+	var batches [][]*movie.Movie
+	repo.updateAllFunc = func(ctx context.Context, movies []*movie.Movie) error {
+		batches = append(batches, movies)
+		return errors.New("database is locked")
+	}
+
+	cmd := BulkUpdateMoviesCommand{
+		Filter: SearchMoviesQuery{Director: "C. Nolan"},
+		Patch:  MoviePatch{Rating: 9},
+	}
+	preview, _ := service.BulkUpdateMovies(ctx, cmd)
+	cmd.ConfirmationToken = preview.ConfirmationToken
+
+	if _, err := service.BulkUpdateMovies(ctx, cmd); err == nil {
+		t.Fatal("Expected the failed save to be reported")
+	}
+	if len(batches) != 1 || len(batches[0]) != 2 {
+		t.Errorf("Expected both movies saved in one batch, got: %v", batches)
+	}
+	for _, id := range []int{1, 2} {
+		if repo.movies[id].Rating().Value() == 9 {
+			t.Errorf("Expected movie %d unchanged after the failed save", id)
+		}
+	}
+}
+
+func TestService_BulkUpdateMovies_Errors(t *testing.T) {
+	service, repo := newBulkUpdateTestService(t)
+
+	tests := []struct {
+		name string
+		cmd  BulkUpdateMoviesCommand
+	}{
+		{name: "empty update", cmd: BulkUpdateMoviesCommand{Filter: SearchMoviesQuery{Director: "C. Nolan"}}},
+		{name: "no filter", cmd: BulkUpdateMoviesCommand{Patch: MoviePatch{Rating: 9}}},
+		{name: "invalid rating", cmd: BulkUpdateMoviesCommand{Filter: SearchMoviesQuery{Director: "C. Nolan"}, Patch: MoviePatch{Rating: 11}}},
+		{name: "invalid certification", cmd: BulkUpdateMoviesCommand{Filter: SearchMoviesQuery{Director: "C. Nolan"}, Patch: MoviePatch{Certifications: map[string]string{"US": "15"}}}},
+		{name: "invalid filter", cmd: BulkUpdateMoviesCommand{Filter: SearchMoviesQuery{MaxCertification: "X"}, Patch: MoviePatch{Rating: 9}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := service.BulkUpdateMovies(context.Background(), tt.cmd); err == nil {
+				t.Error("Expected error, got nil")
+			}
+		})
+	}
+
+	if repo.movies[1].Rating().Value() != 0 {
+		t.Error("Expected failed updates to leave movies unchanged")
+	}
+}
+
 func TestService_RepositoryError(t *testing.T) {
 	repo := NewMockMovieRepository()
 	repo.saveFunc = func(ctx context.Context, m *movie.Movie) error {
@@ -473,11 +1125,8 @@ func TestService_GetTopRatedMovies(t *testing.T) {
 		// Set rating if provided
 		if movieData.rating > 0 {
 			updateCmd := UpdateMovieCommand{
-				ID:       created.ID,
-				Title:    created.Title,
-				Director: created.Director,
-				Year:     created.Year,
-				Rating:   movieData.rating,
+				ID:     created.ID,
+				Rating: ptr(movieData.rating),
 			}
 			_, err = service.UpdateMovie(context.Background(), updateCmd)
 			if err != nil {
@@ -617,10 +1266,8 @@ func TestService_UpdateMovie_InvalidID(t *testing.T) {
 	service := NewService(repo)
 
 	cmd := UpdateMovieCommand{
-		ID:       -1,
-		Title:    "Test",
-		Director: "Test",
-		Year:     2020,
+		ID:    -1,
+		Title: ptr("Test"),
 	}
 
 	_, err := service.UpdateMovie(context.Background(), cmd)
@@ -634,10 +1281,8 @@ func TestService_UpdateMovie_MovieNotFound(t *testing.T) {
 	service := NewService(repo)
 
 	cmd := UpdateMovieCommand{
-		ID:       999,
-		Title:    "Test",
-		Director: "Test",
-		Year:     2020,
+		ID:    999,
+		Title: ptr("Test"),
 	}
 
 	_, err := service.UpdateMovie(context.Background(), cmd)
@@ -663,10 +1308,8 @@ func TestService_UpdateMovie_ValidationError(t *testing.T) {
 
 	// Try to update with invalid data
 	cmd := UpdateMovieCommand{
-		ID:       created.ID,
-		Title:    "", // Empty title should fail
-		Director: "Test",
-		Year:     2020,
+		ID:    created.ID,
+		Title: ptr(""), // Empty title should fail
 	}
 
 	_, err = service.UpdateMovie(context.Background(), cmd)
@@ -696,10 +1339,8 @@ func TestService_UpdateMovie_RepositoryError(t *testing.T) {
 	}
 
 	cmd := UpdateMovieCommand{
-		ID:       created.ID,
-		Title:    "Updated Title",
-		Director: "Updated Director",
-		Year:     2021,
+		ID:    created.ID,
+		Title: ptr("Updated Title"),
 	}
 
 	_, err = service.UpdateMovie(context.Background(), cmd)
@@ -755,11 +1396,8 @@ func TestService_SearchMovies_ExtendedCriteria(t *testing.T) {
 		// Add genres
 		if len(movieData.genres) > 0 {
 			updateCmd := UpdateMovieCommand{
-				ID:       created.ID,
-				Title:    created.Title,
-				Director: created.Director,
-				Year:     created.Year,
-				Genres:   movieData.genres,
+				ID:     created.ID,
+				Genres: movieData.genres,
 			}
 			_, err = service.UpdateMovie(context.Background(), updateCmd)
 			if err != nil {
@@ -864,12 +1502,9 @@ func TestService_SearchMovies_ComprehensiveCoverage(t *testing.T) {
 
 		// Update with rating and genres
 		updateCmd := UpdateMovieCommand{
-			ID:       created.ID,
-			Title:    created.Title,
-			Director: created.Director,
-			Year:     created.Year,
-			Rating:   movieData.rating,
-			Genres:   movieData.genres,
+			ID:     created.ID,
+			Rating: ptr(movieData.rating),
+			Genres: movieData.genres,
 		}
 		_, err = service.UpdateMovie(context.Background(), updateCmd)
 		if err != nil {
@@ -1123,11 +1758,8 @@ func TestService_UpdateMovie_InvalidGenre(t *testing.T) {
 
 	// Try to update with invalid genre
 	cmd := UpdateMovieCommand{
-		ID:       created.ID,
-		Title:    "Updated Title",
-		Director: "Updated Director",
-		Year:     2021,
-		Genres:   []string{""}, // Empty genre should fail
+		ID:     created.ID,
+		Genres: []string{""}, // Empty genre should fail
 	}
 
 	_, err = service.UpdateMovie(context.Background(), cmd)
@@ -1154,10 +1786,7 @@ func TestService_UpdateMovie_InvalidPosterURL(t *testing.T) {
 	// Try to update with invalid poster URL
 	cmd := UpdateMovieCommand{
 		ID:        created.ID,
-		Title:     "Updated Title",
-		Director:  "Updated Director",
-		Year:      2021,
-		PosterURL: "invalid-url", // Invalid URL format
+		PosterURL: ptr("invalid-url"), // Invalid URL format
 	}
 
 	_, err = service.UpdateMovie(context.Background(), cmd)
@@ -1178,3 +1807,149 @@ func TestService_GetTopRatedMovies_RepositoryError(t *testing.T) {
 		t.Error("Expected error from repository")
 	}
 }
+
+func TestService_GetReleaseTimeline(t *testing.T) {
+	repo := NewMockMovieRepository()
+	service := NewService(repo)
+
+	for _, cmd := range []CreateMovieCommand{
+		{Title: "The Matrix", Director: "Wachowskis", Year: 1999, Rating: 8.7, Genres: []string{"Sci-Fi"}},
+		{Title: "Fight Club", Director: "David Fincher", Year: 1999, Rating: 8.8, Genres: []string{"Drama"}},
+		{Title: "The Mummy", Director: "Stephen Sommers", Year: 1999, Rating: 7.1, Genres: []string{"Adventure"}},
+		{Title: "Unrated Short", Director: "Someone", Year: 1999},
+		{Title: "Gladiator", Director: "Ridley Scott", Year: 2000, Rating: 8.5, Genres: []string{"Drama"}},
+		{Title: "Titanic", Director: "James Cameron", Year: 1997, Rating: 7.9, Genres: []string{"Drama"}},
+	} {
+		if _, err := service.CreateMovie(context.Background(), cmd); err != nil {
+			t.Fatalf("Failed to create test movie: %v", err)
+		}
+	}
+
+	timeline, err := service.GetReleaseTimeline(context.Background(), ReleaseTimelineQuery{StartYear: 1998, EndYear: 2000, TopPicks: 2})
+	if err != nil {
+		t.Fatalf("GetReleaseTimeline() error = %v", err)
+	}
+	if timeline.Total != 5 || len(timeline.Years) != 2 {
+		t.Fatalf("Expected 5 movies over 2 years, got: %d over %d", timeline.Total, len(timeline.Years))
+	}
+
+	year := timeline.Years[0]
+	if year.Year != 1999 || year.Count != 4 {
+		t.Errorf("Expected 4 movies in 1999 first, got: %d in %d", year.Count, year.Year)
+	}
+	if math.Abs(year.AverageRating-8.2) > 0.001 {
+		t.Errorf("Expected an average of the rated movies of 8.2, got: %v", year.AverageRating)
+	}
+	if len(year.TopRated) != 2 || year.TopRated[0].Title != "Fight Club" || year.TopRated[1].Title != "The Matrix" {
+		t.Errorf("Expected Fight Club and The Matrix as the picks, got: %v", year.TopRated)
+	}
+	if timeline.Years[1].Year != 2000 || timeline.Years[1].TopRated[0].Title != "Gladiator" {
+		t.Errorf("Expected Gladiator in 2000, got: %+v", timeline.Years[1])
+	}
+}
+
+func TestService_GetReleaseTimeline_Months(t *testing.T) {
+	repo := NewMockMovieRepository()
+	service := NewService(repo)
+	for _, cmd := range []CreateMovieCommand{
+		{Title: "The Matrix", Director: "Wachowskis", ReleaseDate: time.Date(1999, time.March, 31, 0, 0, 0, 0, time.UTC)},
+		{Title: "Office Space", Director: "Mike Judge", ReleaseDate: time.Date(1999, time.February, 19, 0, 0, 0, 0, time.UTC)},
+		{Title: "The Mummy", Director: "Stephen Sommers", ReleaseDate: time.Date(1999, time.May, 7, 0, 0, 0, 0, time.UTC)},
+		{Title: "eXistenZ", Director: "David Cronenberg", ReleaseDate: time.Date(1999, time.March, 19, 0, 0, 0, 0, time.UTC)},
+		{Title: "Fight Club", Director: "David Fincher", Year: 1999},
+	} {
+		if _, err := service.CreateMovie(context.Background(), cmd); err != nil {
+			t.Fatalf("Failed to create test movie: %v", err)
+		}
+	}
+
+	timeline, err := service.GetReleaseTimeline(context.Background(), ReleaseTimelineQuery{StartYear: 1999, EndYear: 1999})
+	if err != nil {
+		t.Fatalf("GetReleaseTimeline() error = %v", err)
+	}
+
+	year := timeline.Years[0]
+	var months []string
+	for _, month := range year.Months {
+		months = append(months, fmt.Sprintf("%d:%d", month.Month, month.Count))
+	}
+	if strings.Join(months, ",") != "2:1,3:2,5:1" || year.Undated != 1 {
+		t.Errorf("Expected February, March twice and May with one undated, got: %v and %d undated", months, year.Undated)
+	}
+}
+
+func TestService_GetReleaseTimeline_GenreAndInvalidRange(t *testing.T) {
+	repo := NewMockMovieRepository()
+	service := NewService(repo)
+	for _, cmd := range []CreateMovieCommand{
+		{Title: "The Matrix", Director: "Wachowskis", Year: 1999, Rating: 8.7, Genres: []string{"Sci-Fi"}},
+		{Title: "Fight Club", Director: "David Fincher", Year: 1999, Rating: 8.8, Genres: []string{"Drama"}},
+	} {
+		if _, err := service.CreateMovie(context.Background(), cmd); err != nil {
+			t.Fatalf("Failed to create test movie: %v", err)
+		}
+	}
+
+	timeline, err := service.GetReleaseTimeline(context.Background(), ReleaseTimelineQuery{Genre: "Sci-Fi"})
+	if err != nil {
+		t.Fatalf("GetReleaseTimeline() error = %v", err)
+	}
+	if timeline.Total != 1 || timeline.Years[0].TopRated[0].Title != "The Matrix" {
+		t.Errorf("Expected only The Matrix, got: %+v", timeline.Years)
+	}
+
+	_, err = service.GetReleaseTimeline(context.Background(), ReleaseTimelineQuery{StartYear: 2000, EndYear: 1999})
+	if !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("Expected a validation error for a reversed range, got: %v", err)
+	}
+}
+
+func TestService_GetRuntimeStats(t *testing.T) {
+	repo := NewMockMovieRepository()
+	service := NewService(repo)
+
+	for _, cmd := range []CreateMovieCommand{
+		{Title: "Heat", Director: "Michael Mann", Year: 1995, Duration: 170, Genres: []string{"Crime", "Drama"}},
+		{Title: "Se7en", Director: "David Fincher", Year: 1995, Duration: 127, Genres: []string{"Crime"}},
+		{Title: "The Red Balloon", Director: "Albert Lamorisse", Year: 1956, Duration: 34, Genres: []string{"Drama"}},
+		{Title: "No Runtime", Director: "Someone", Year: 1995, Genres: []string{"Drama"}},
+		{Title: "No Genre", Director: "Someone", Year: 1995, Duration: 90},
+	} {
+		if _, err := service.CreateMovie(context.Background(), cmd); err != nil {
+			t.Fatalf("Failed to create test movie: %v", err)
+		}
+	}
+
+	stats, err := service.GetRuntimeStats(context.Background(), RuntimeQuery{})
+	if err != nil {
+		t.Fatalf("GetRuntimeStats() error = %v", err)
+	}
+	if stats.Movies != 4 || stats.Unknown != 1 || stats.ShortFilms != 1 {
+		t.Errorf("Expected 4 timed movies, 1 unknown and 1 short, got: %+v", stats)
+	}
+	if math.Abs(stats.AverageDuration-105.25) > 0.001 {
+		t.Errorf("Expected an average of 105.25 minutes, got: %v", stats.AverageDuration)
+	}
+	if len(stats.Genres) != 2 {
+		t.Fatalf("Expected 2 genres, got: %d", len(stats.Genres))
+	}
+	crime, drama := stats.Genres[0], stats.Genres[1]
+	if crime.Genre != "Crime" || crime.Movies != 2 || crime.AverageDuration != 148.5 || crime.ShortestDuration != 127 || crime.LongestDuration != 170 {
+		t.Errorf("Expected Crime first at 148.5 minutes, got: %+v", crime)
+	}
+	if drama.Genre != "Drama" || drama.Movies != 2 || drama.AverageDuration != 102 || drama.ShortFilms != 1 {
+		t.Errorf("Expected Drama at 102 minutes with one short, got: %+v", drama)
+	}
+
+	recent, err := service.GetRuntimeStats(context.Background(), RuntimeQuery{MinYear: 1990})
+	if err != nil {
+		t.Fatalf("GetRuntimeStats() error = %v", err)
+	}
+	if recent.ShortFilms != 0 || recent.Movies != 3 {
+		t.Errorf("Expected the 1956 short to be left out, got: %+v", recent)
+	}
+
+	if _, err := service.GetRuntimeStats(context.Background(), RuntimeQuery{MinYear: 2000, MaxYear: 1990}); !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("Expected a validation error for a reversed range, got: %v", err)
+	}
+}
@@ -0,0 +1,63 @@
+package popularity
+
+import (
+	"context"
+	"fmt"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// TrendingWindowDays is the period the trending ranking signal counts
+// accesses over
+const TrendingWindowDays = 30
+
+// TrendingScorer scores the movies a search found by how often tools
+// accessed them over the last TrendingWindowDays days, relative to the
+// most accessed of them
+type TrendingScorer struct {
+	service *Service
+}
+
+// NewTrendingScorer creates a ranking scorer reading the access counts
+// service records
+func NewTrendingScorer(service *Service) *TrendingScorer {
+	return &TrendingScorer{
+		service: service,
+	}
+}
+
+// Signal returns the trending signal name
+func (s *TrendingScorer) Signal() string {
+	return movieApp.SignalTrending
+}
+
+// Score scores each movie by its recent access counts
+func (s *TrendingScorer) Score(ctx context.Context, movies []*movieApp.MovieDTO) ([]float64, error) {
+	ids := make([]shared.MovieID, 0, len(movies))
+	for _, dto := range movies {
+		id, err := shared.NewMovieID(dto.ID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid movie ID: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	counts, err := s.service.access.Counts(ctx, s.service.since(TrendingWindowDays), ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read movie access: %w", err)
+	}
+	most := 0.0
+	for _, movieCounts := range counts {
+		most = max(most, movieCounts.Score())
+	}
+
+	scores := make([]float64, len(movies))
+	if most == 0 {
+		return scores, nil
+	}
+	for i, id := range ids {
+		scores[i] = counts[id].Score() / most
+	}
+	return scores, nil
+}
@@ -0,0 +1,144 @@
+package popularity
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/popularity"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+const (
+	// MaxTrendingDays is the longest period trending movies are counted over
+	MaxTrendingDays = 365
+	// MaxTrendingMovies is the most trending movies returned at once
+	MaxTrendingMovies = 100
+)
+
+// Service provides application-level movie popularity operations
+type Service struct {
+	access    popularity.Repository
+	movieRepo movie.Reader
+	now       func() time.Time
+}
+
+// NewService creates a new popularity application service
+func NewService(access popularity.Repository, movieRepo movie.Reader) *Service {
+	return &Service{
+		access:    access,
+		movieRepo: movieRepo,
+		now:       time.Now,
+	}
+}
+
+// TrendingMovieDTO represents a movie with how often it was accessed
+type TrendingMovieDTO struct {
+	ID         int      `json:"id"`
+	Title      string   `json:"title"`
+	Director   string   `json:"director"`
+	Year       int      `json:"year"`
+	Rating     float64  `json:"rating,omitempty"`
+	Genres     []string `json:"genres"`
+	Views      int      `json:"views"`
+	SearchHits int      `json:"search_hits"`
+	Score      float64  `json:"score"` // Views plus discounted search hits
+}
+
+// TrendingDTO lists the most accessed movies of a period
+type TrendingDTO struct {
+	Days   int                 `json:"days"`
+	Since  string              `json:"since"`  // First day counted, YYYY-MM-DD in UTC
+	Movies []*TrendingMovieDTO `json:"movies"` // Highest score first
+}
+
+// RecordViews counts a view of each movie, for movies a tool returned on
+// their own
+func (s *Service) RecordViews(ctx context.Context, movieIDs ...int) error {
+	return s.record(ctx, popularity.AccessView, movieIDs)
+}
+
+// RecordSearchHits counts a search hit for each movie a search returned
+func (s *Service) RecordSearchHits(ctx context.Context, movieIDs []int) error {
+	return s.record(ctx, popularity.AccessSearch, movieIDs)
+}
+
+// record counts an access of kind to each movie today
+func (s *Service) record(ctx context.Context, kind popularity.Access, movieIDs []int) error {
+	ids := make([]shared.MovieID, 0, len(movieIDs))
+	for _, movieID := range movieIDs {
+		id, err := shared.NewMovieID(movieID)
+		if err != nil {
+			return fmt.Errorf("invalid movie ID: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := s.access.Record(ctx, s.now(), kind, ids); err != nil {
+		return fmt.Errorf("failed to record movie access: %w", err)
+	}
+	return nil
+}
+
+// Trending returns up to limit movies accessed most over the last days
+// days, counting today
+func (s *Service) Trending(ctx context.Context, days, limit int) (*TrendingDTO, error) {
+	if days < 1 || days > MaxTrendingDays {
+		return nil, shared.NewValidationError("days must be between 1 and %d", MaxTrendingDays)
+	}
+	if limit < 1 || limit > MaxTrendingMovies {
+		return nil, shared.NewValidationError("limit must be between 1 and %d", MaxTrendingMovies)
+	}
+
+	since := s.since(days)
+	top, err := s.access.Top(ctx, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find trending movies: %w", err)
+	}
+
+	result := &TrendingDTO{
+		Days:   days,
+		Since:  since.Format(time.DateOnly),
+		Movies: []*TrendingMovieDTO{},
+	}
+	if len(top) == 0 {
+		return result, nil
+	}
+
+	ids := make([]shared.MovieID, len(top))
+	for i, counts := range top {
+		ids[i] = counts.MovieID
+	}
+	movies, err := s.movieRepo.FindByCriteria(ctx, movie.SearchCriteria{IDs: ids, Limit: len(ids)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trending movies: %w", err)
+	}
+	byID := make(map[int]*movie.Movie, len(movies))
+	for _, m := range movies {
+		byID[m.ID().Value()] = m
+	}
+
+	for _, counts := range top {
+		m, exists := byID[counts.MovieID.Value()]
+		if !exists {
+			continue
+		}
+		result.Movies = append(result.Movies, &TrendingMovieDTO{
+			ID:         m.ID().Value(),
+			Title:      m.Title(),
+			Director:   m.Director(),
+			Year:       m.Year().Value(),
+			Rating:     m.Rating().Value(),
+			Genres:     m.Genres(),
+			Views:      counts.Views,
+			SearchHits: counts.SearchHits,
+			Score:      counts.Score(),
+		})
+	}
+	return result, nil
+}
+
+// since returns the first day of a period of days ending today
+func (s *Service) since(days int) time.Time {
+	return popularity.Day(s.now()).AddDate(0, 0, 1-days)
+}
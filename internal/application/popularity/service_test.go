@@ -0,0 +1,189 @@
+package popularity
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/popularity"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// MockMovieRepository implements the parts of movie.Reader the service
+// uses, for testing
+type MockMovieRepository struct {
+	movie.Reader
+	movies []*movie.Movie
+}
+
+func (m *MockMovieRepository) FindByCriteria(ctx context.Context, criteria movie.SearchCriteria) ([]*movie.Movie, error) {
+	var found []*movie.Movie
+	for _, candidate := range m.movies {
+		for _, id := range criteria.IDs {
+			if candidate.ID() == id {
+				found = append(found, candidate)
+			}
+		}
+	}
+	return found, nil
+}
+
+// accessRecord is one recorded access
+type accessRecord struct {
+	day  time.Time
+	kind popularity.Access
+	id   shared.MovieID
+}
+
+// fakeAccessRepository keeps recorded accesses in memory
+type fakeAccessRepository struct {
+	records []accessRecord
+}
+
+func (r *fakeAccessRepository) Record(ctx context.Context, day time.Time, kind popularity.Access, ids []shared.MovieID) error {
+	for _, id := range ids {
+		r.records = append(r.records, accessRecord{day: popularity.Day(day), kind: kind, id: id})
+	}
+	return nil
+}
+
+func (r *fakeAccessRepository) Counts(ctx context.Context, since time.Time, ids []shared.MovieID) (map[shared.MovieID]popularity.Counts, error) {
+	wanted := make(map[shared.MovieID]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+	counts := make(map[shared.MovieID]popularity.Counts)
+	for _, record := range r.records {
+		if !wanted[record.id] || record.day.Before(since) {
+			continue
+		}
+		movieCounts := counts[record.id]
+		movieCounts.MovieID = record.id
+		if record.kind == popularity.AccessView {
+			movieCounts.Views++
+		} else {
+			movieCounts.SearchHits++
+		}
+		counts[record.id] = movieCounts
+	}
+	return counts, nil
+}
+
+func (r *fakeAccessRepository) Top(ctx context.Context, since time.Time, limit int) ([]popularity.Counts, error) {
+	var ids []shared.MovieID
+	for _, record := range r.records {
+		ids = append(ids, record.id)
+	}
+	counts, _ := r.Counts(ctx, since, ids)
+
+	var top []popularity.Counts
+	for _, movieCounts := range counts {
+		top = append(top, movieCounts)
+	}
+	sort.Slice(top, func(i, j int) bool {
+		if top[i].Score() != top[j].Score() {
+			return top[i].Score() > top[j].Score()
+		}
+		return top[i].MovieID.Value() < top[j].MovieID.Value()
+	})
+	if len(top) > limit {
+		top = top[:limit]
+	}
+	return top, nil
+}
+
+func newTestMovie(t *testing.T, id int, title string, year int) *movie.Movie {
+	t.Helper()
+
+	movieID, _ := shared.NewMovieID(id)
+	m, err := movie.NewMovieWithID(movieID, title, "Director", year)
+	if err != nil {
+		t.Fatalf("failed to create movie: %v", err)
+	}
+	return m
+}
+
+// newTestService creates a service over Heat (1), Alien (2) and Dune (3)
+// whose today is 14 March 2026
+func newTestService(t *testing.T) (*Service, *fakeAccessRepository) {
+	t.Helper()
+
+	access := &fakeAccessRepository{}
+	service := NewService(access, &MockMovieRepository{movies: []*movie.Movie{
+		newTestMovie(t, 1, "Heat", 1995),
+		newTestMovie(t, 2, "Alien", 1979),
+		newTestMovie(t, 3, "Dune", 2021),
+	}})
+	service.now = func() time.Time { return time.Date(2026, 3, 14, 18, 0, 0, 0, time.UTC) }
+	return service, access
+}
+
+func TestService_Trending(t *testing.T) {
+	service, access := newTestService(t)
+	ctx := context.Background()
+
+	if err := service.RecordViews(ctx, 1); err != nil {
+		t.Fatalf("RecordViews() error = %v", err)
+	}
+	if err := service.RecordSearchHits(ctx, []int{2, 2, 2, 2, 2, 1, 4}); err != nil {
+		t.Fatalf("RecordSearchHits() error = %v", err)
+	}
+	// Dune was viewed three times, but a week ago
+	weekAgo, _ := shared.NewMovieID(3)
+	access.Record(ctx, time.Date(2026, 3, 7, 12, 0, 0, 0, time.UTC), popularity.AccessView, []shared.MovieID{weekAgo, weekAgo, weekAgo})
+
+	trending, err := service.Trending(ctx, 7, 10)
+	if err != nil {
+		t.Fatalf("Trending() error = %v", err)
+	}
+	if trending.Since != "2026-03-08" || trending.Days != 7 {
+		t.Errorf("Expected 7 days since 2026-03-08, got %d since %s", trending.Days, trending.Since)
+	}
+	// Movie 4 was deleted, so only Heat (1 view, 1 hit) and Alien (5 hits) remain
+	if len(trending.Movies) != 2 {
+		t.Fatalf("Expected 2 trending movies, got %+v", trending.Movies)
+	}
+	if heat := trending.Movies[0]; heat.Title != "Heat" || heat.Views != 1 || heat.SearchHits != 1 || heat.Score != 1.25 {
+		t.Errorf("Expected Heat first with 1.25, got %+v", heat)
+	}
+	if alien := trending.Movies[1]; alien.Title != "Alien" || alien.SearchHits != 5 || alien.Score != 1.25 {
+		t.Errorf("Expected Alien second with 1.25, got %+v", alien)
+	}
+
+	trending, err = service.Trending(ctx, 8, 1)
+	if err != nil {
+		t.Fatalf("Trending() error = %v", err)
+	}
+	if len(trending.Movies) != 1 || trending.Movies[0].Title != "Dune" {
+		t.Errorf("Expected Dune on top over 8 days, got %+v", trending.Movies)
+	}
+}
+
+func TestService_Trending_Invalid(t *testing.T) {
+	service, _ := newTestService(t)
+
+	for _, args := range [][2]int{{0, 10}, {MaxTrendingDays + 1, 10}, {7, 0}, {7, MaxTrendingMovies + 1}} {
+		if _, err := service.Trending(context.Background(), args[0], args[1]); !errors.Is(err, shared.ErrValidation) {
+			t.Errorf("Trending(%d, %d) error = %v, want a validation error", args[0], args[1], err)
+		}
+	}
+}
+
+func TestTrendingScorer(t *testing.T) {
+	service, _ := newTestService(t)
+	ctx := context.Background()
+	service.RecordViews(ctx, 1, 1)
+	service.RecordSearchHits(ctx, []int{2, 2})
+
+	scores, err := NewTrendingScorer(service).Score(ctx, []*movieApp.MovieDTO{{ID: 1}, {ID: 2}, {ID: 3}})
+	if err != nil {
+		t.Fatalf("Score() error = %v", err)
+	}
+	if want := []float64{1, 0.25, 0}; scores[0] != want[0] || scores[1] != want[1] || scores[2] != want[2] {
+		t.Errorf("Score() = %v, want %v", scores, want)
+	}
+}
@@ -0,0 +1,134 @@
+package poster
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// publishBatchSize is how many movies PublishPosters reads at a time
+const publishBatchSize = 500
+
+// posterExtensions names the file extension of each poster type the image
+// package stores
+var posterExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+	"image/gif":  ".gif",
+}
+
+// CDNSigner builds the CDN URLs stored poster images are served from
+type CDNSigner struct {
+	baseURL string
+	path    string // Path of baseURL, which signed paths start with
+	key     []byte
+	expiry  time.Duration
+	now     func() time.Time
+}
+
+// NewCDNSigner serves posters under baseURL. With a signing key, each URL
+// carries an expires time expiry from now and the HMAC-SHA256 of its path
+// and expires; without one, URLs are unsigned and do not expire.
+func NewCDNSigner(baseURL, signingKey string, expiry time.Duration) *CDNSigner {
+	baseURL = strings.TrimRight(baseURL, "/")
+	signer := &CDNSigner{
+		baseURL: baseURL,
+		expiry:  expiry,
+		now:     time.Now,
+	}
+	if u, err := url.Parse(baseURL); err == nil {
+		signer.path = u.EscapedPath()
+	}
+	if signingKey != "" {
+		signer.key = []byte(signingKey)
+	}
+	return signer
+}
+
+// ObjectKey returns the path a poster image is published under,
+// posters/<movie id>-<hash>.<ext>. The hash is of the image data, so a new
+// poster gets a new key and a CDN can cache each one indefinitely.
+func ObjectKey(poster *PosterImageDTO) string {
+	sum := sha256.Sum256(poster.Data)
+	return fmt.Sprintf("posters/%d-%s%s", poster.MovieID, hex.EncodeToString(sum[:8]), posterExtensions[poster.MimeType])
+}
+
+// SignedURL returns the CDN URL of a poster image and when it expires; the
+// expiry is zero for unsigned URLs
+func (s *CDNSigner) SignedURL(poster *PosterImageDTO) (string, time.Time) {
+	key := "/" + ObjectKey(poster)
+	if s.key == nil {
+		return s.baseURL + key, time.Time{}
+	}
+
+	expires := s.now().Add(s.expiry).Truncate(time.Second)
+	query := "expires=" + strconv.FormatInt(expires.Unix(), 10)
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(s.path + key + "?" + query))
+	return s.baseURL + key + "?" + query + "&signature=" + hex.EncodeToString(mac.Sum(nil)), expires
+}
+
+// PublishResult counts the poster images PublishPosters wrote and found
+// already published
+type PublishResult struct {
+	Written   int
+	Unchanged int
+}
+
+// PublishPosters writes every stored poster image to dir under its object
+// key, for copying to the storage a CDN serves. An image already in dir is
+// left alone, since its key changes whenever the image does.
+func (s *Service) PublishPosters(ctx context.Context, dir string) (*PublishResult, error) {
+	result := &PublishResult{}
+	afterID := 0
+	for {
+		movies, err := s.movieRepo.FindByCriteria(ctx, movie.SearchCriteria{
+			AfterID: afterID,
+			Limit:   publishBatchSize,
+			Sort:    []movie.SortKey{{Field: movie.OrderByID, Dir: movie.OrderAsc}},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read movies: %w", err)
+		}
+
+		for _, m := range movies {
+			afterID = m.ID().Value()
+			poster, err := s.GetPoster(ctx, afterID)
+			if errors.Is(err, shared.ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			path := filepath.Join(dir, filepath.FromSlash(ObjectKey(poster)))
+			if _, err := os.Stat(path); err == nil {
+				result.Unchanged++
+				continue
+			}
+			if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+				return nil, fmt.Errorf("failed to create %s: %w", filepath.Dir(path), err)
+			}
+			if err := os.WriteFile(path, poster.Data, 0o644); err != nil {
+				return nil, fmt.Errorf("failed to write %s: %w", path, err)
+			}
+			result.Written++
+		}
+		if len(movies) < publishBatchSize {
+			return result, nil
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package poster
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestObjectKey_FollowsImageData(t *testing.T) {
+	first := ObjectKey(&PosterImageDTO{PosterDTO: PosterDTO{MovieID: 7, MimeType: "image/png"}, Data: []byte("one")})
+	second := ObjectKey(&PosterImageDTO{PosterDTO: PosterDTO{MovieID: 7, MimeType: "image/png"}, Data: []byte("two")})
+
+	if !strings.HasPrefix(first, "posters/7-") || !strings.HasSuffix(first, ".png") || len(first) != len("posters/7-")+16+len(".png") {
+		t.Errorf("Unexpected object key %q", first)
+	}
+	if first == second {
+		t.Errorf("Expected a new image to get a new key, got %q twice", first)
+	}
+}
+
+func TestCDNSigner_SignedURL(t *testing.T) {
+	signer := NewCDNSigner("https://cdn.example.com/media/", "secret", 15*time.Minute)
+	now := time.Date(2026, 1, 2, 3, 4, 5, 600, time.UTC)
+	signer.now = func() time.Time { return now }
+	poster := &PosterImageDTO{PosterDTO: PosterDTO{MovieID: 3, MimeType: "image/jpeg"}, Data: []byte("jpeg")}
+
+	signed, expires := signer.SignedURL(poster)
+	if !expires.Equal(now.Add(15 * time.Minute).Truncate(time.Second)) {
+		t.Errorf("Unexpected expiry %v", expires)
+	}
+	u, err := url.Parse(signed)
+	if err != nil || u.Host != "cdn.example.com" || u.Path != "/media/"+ObjectKey(poster) {
+		t.Fatalf("Unexpected URL %q", signed)
+	}
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte(u.Path + "?expires=" + u.Query().Get("expires")))
+	if u.Query().Get("signature") != hex.EncodeToString(mac.Sum(nil)) {
+		t.Errorf("Expected the path and expiry signed, got %q", signed)
+	}
+}
+
+func TestCDNSigner_Unsigned(t *testing.T) {
+	poster := &PosterImageDTO{PosterDTO: PosterDTO{MovieID: 3, MimeType: "image/webp"}, Data: []byte("webp")}
+
+	signed, expires := NewCDNSigner("https://cdn.example.com", "", time.Hour).SignedURL(poster)
+	if signed != "https://cdn.example.com/"+ObjectKey(poster) || !expires.IsZero() {
+		t.Errorf("Expected an unsigned URL, got %q expiring %v", signed, expires)
+	}
+}
+
+func TestService_PublishPosters(t *testing.T) {
+	service, store := newTestService(t, 1024)
+	store.data[1], store.mimeTypes[1] = pngData, "image/png"
+	dir := t.TempDir()
+
+	result, err := service.PublishPosters(context.Background(), dir)
+	if err != nil || result.Written != 1 || result.Unchanged != 0 {
+		t.Fatalf("Expected the poster written, got %+v, %v", result, err)
+	}
+	key := ObjectKey(&PosterImageDTO{PosterDTO: PosterDTO{MovieID: 1, MimeType: "image/png"}, Data: pngData})
+	if data, err := os.ReadFile(filepath.Join(dir, key)); err != nil || string(data) != string(pngData) {
+		t.Errorf("Expected the image at %s, got %v", key, err)
+	}
+
+	result, err = service.PublishPosters(context.Background(), dir)
+	if err != nil || result.Written != 0 || result.Unchanged != 1 {
+		t.Errorf("Expected the published poster left alone, got %+v, %v", result, err)
+	}
+}
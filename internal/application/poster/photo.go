@@ -0,0 +1,166 @@
+package poster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/actor"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/image"
+)
+
+// PhotoService provides application-level actor photo operations. Photos
+// are uploaded, checked and processed the same way as movie posters.
+type PhotoService struct {
+	store     actor.PhotoStore
+	actorRepo actor.Reader
+	images    *image.ImageProcessor
+	maxSize   int64
+}
+
+// NewPhotoService creates a new actor photo application service, with the
+// same upload limits and processing as NewService
+func NewPhotoService(store actor.PhotoStore, actorRepo actor.Reader, cfg *image.ImageConfig) *PhotoService {
+	return &PhotoService{
+		store:     store,
+		actorRepo: actorRepo,
+		images:    image.NewImageProcessor(cfg),
+		maxSize:   cfg.MaxSize,
+	}
+}
+
+// UploadPhotoCommand represents the command to store an actor's photo image
+type UploadPhotoCommand struct {
+	ActorID  int
+	Data     string // Base64 image data, optionally as a data: URI
+	MimeType string // Taken from a data: URI or detected from the image when empty
+}
+
+// PhotoDTO represents a stored actor photo
+type PhotoDTO struct {
+	ActorID  int    `json:"actor_id"`
+	Name     string `json:"name"`
+	MimeType string `json:"mime_type"`
+	Size     int    `json:"size"`
+}
+
+// PhotoImageDTO represents a stored actor photo with its image data
+type PhotoImageDTO struct {
+	PhotoDTO
+	Data []byte `json:"-"`
+}
+
+// DeletePhotoDTO represents the result of deleting an actor's photo
+type DeletePhotoDTO struct {
+	ActorID int    `json:"actor_id"`
+	Name    string `json:"name"`
+	Deleted bool   `json:"deleted"`
+}
+
+// UploadPhoto decodes, validates and processes a base64 photo image and
+// stores it as the actor's photo, replacing any photo image they had
+func (s *PhotoService) UploadPhoto(ctx context.Context, cmd UploadPhotoCommand) (*PhotoDTO, error) {
+	domainActor, err := s.findActor(ctx, cmd.ActorID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, mimeType, err := decodeImage(cmd.Data, cmd.MimeType, s.maxSize)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.images.ValidateImage(data, mimeType); err != nil {
+		return nil, shared.NewValidationError("invalid photo: %w", err)
+	}
+	data, mimeType, err = s.images.Process(data, mimeType)
+	if err != nil {
+		return nil, shared.NewValidationError("invalid photo: %w", err)
+	}
+
+	if err := s.store.SavePhoto(ctx, domainActor.ID(), data, mimeType); err != nil {
+		return nil, fmt.Errorf("failed to save photo: %w", err)
+	}
+
+	return &PhotoDTO{
+		ActorID:  domainActor.ID().Value(),
+		Name:     domainActor.Name(),
+		MimeType: mimeType,
+		Size:     len(data),
+	}, nil
+}
+
+// GetPhoto returns an actor's stored photo image; it fails with
+// shared.ErrNotFound if the actor has none
+func (s *PhotoService) GetPhoto(ctx context.Context, actorID int) (*PhotoImageDTO, error) {
+	id, err := shared.NewActorID(actorID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid actor ID: %w", err)
+	}
+
+	photo, err := s.store.FindPhoto(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("photo not found: %w", err)
+	}
+
+	return &PhotoImageDTO{
+		PhotoDTO: PhotoDTO{
+			ActorID:  photo.ActorID.Value(),
+			Name:     photo.Name,
+			MimeType: photo.MimeType,
+			Size:     len(photo.Data),
+		},
+		Data: photo.Data,
+	}, nil
+}
+
+// ListPhotos describes every stored actor photo, by actor name
+func (s *PhotoService) ListPhotos(ctx context.Context) ([]*PhotoDTO, error) {
+	photos, err := s.store.ListPhotos(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list photos: %w", err)
+	}
+
+	dtos := make([]*PhotoDTO, len(photos))
+	for i, photo := range photos {
+		dtos[i] = &PhotoDTO{
+			ActorID:  photo.ActorID.Value(),
+			Name:     photo.Name,
+			MimeType: photo.MimeType,
+			Size:     photo.Size,
+		}
+	}
+	return dtos, nil
+}
+
+// DeletePhoto removes an actor's photo image. Deleting a photo the actor
+// does not have succeeds with Deleted false; their photo URL is kept.
+func (s *PhotoService) DeletePhoto(ctx context.Context, actorID int) (*DeletePhotoDTO, error) {
+	domainActor, err := s.findActor(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.store.DeletePhoto(ctx, domainActor.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete photo: %w", err)
+	}
+
+	return &DeletePhotoDTO{
+		ActorID: domainActor.ID().Value(),
+		Name:    domainActor.Name(),
+		Deleted: deleted,
+	}, nil
+}
+
+func (s *PhotoService) findActor(ctx context.Context, id int) (*actor.Actor, error) {
+	actorID, err := shared.NewActorID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid actor ID: %w", err)
+	}
+
+	domainActor, err := s.actorRepo.FindByID(ctx, actorID)
+	if err != nil {
+		return nil, fmt.Errorf("actor not found: %w", err)
+	}
+	return domainActor, nil
+}
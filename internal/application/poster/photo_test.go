@@ -0,0 +1,148 @@
+package poster
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/actor"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/image"
+)
+
+// MockActorReader implements the FindByID part of actor.Reader for testing
+type MockActorReader struct {
+	actor.Reader
+	actors map[int]*actor.Actor
+}
+
+func (m *MockActorReader) FindByID(ctx context.Context, id shared.ActorID) (*actor.Actor, error) {
+	if found, exists := m.actors[id.Value()]; exists {
+		return found, nil
+	}
+	return nil, shared.NewNotFoundError("actor not found")
+}
+
+// MockPhotoStore implements actor.PhotoStore for testing
+type MockPhotoStore struct {
+	photos map[int]*actor.Photo
+}
+
+func (m *MockPhotoStore) SavePhoto(ctx context.Context, actorID shared.ActorID, data []byte, mimeType string) error {
+	m.photos[actorID.Value()] = &actor.Photo{ActorID: actorID, Name: "Keanu Reeves", MimeType: mimeType, Data: data}
+	return nil
+}
+
+func (m *MockPhotoStore) FindPhoto(ctx context.Context, actorID shared.ActorID) (*actor.Photo, error) {
+	if photo, exists := m.photos[actorID.Value()]; exists {
+		return photo, nil
+	}
+	return nil, shared.NewNotFoundError("actor photo not found")
+}
+
+func (m *MockPhotoStore) ListPhotos(ctx context.Context) ([]actor.PhotoInfo, error) {
+	var photos []actor.PhotoInfo
+	for _, photo := range m.photos {
+		photos = append(photos, actor.PhotoInfo{ActorID: photo.ActorID, Name: photo.Name, MimeType: photo.MimeType, Size: len(photo.Data)})
+	}
+	return photos, nil
+}
+
+func (m *MockPhotoStore) DeletePhoto(ctx context.Context, actorID shared.ActorID) (bool, error) {
+	_, exists := m.photos[actorID.Value()]
+	delete(m.photos, actorID.Value())
+	return exists, nil
+}
+
+func newTestPhotoService(t *testing.T, maxSize int64) (*PhotoService, *MockPhotoStore) {
+	t.Helper()
+
+	actorID, _ := shared.NewActorID(1)
+	domainActor, err := actor.NewActorWithID(actorID, "Keanu Reeves", 1964)
+	if err != nil {
+		t.Fatalf("failed to create actor: %v", err)
+	}
+
+	store := &MockPhotoStore{photos: map[int]*actor.Photo{}}
+	cfg := &image.ImageConfig{MaxSize: maxSize, AllowedTypes: []string{image.MimeTypeJPEG, image.MimeTypePNG}}
+	return NewPhotoService(store, &MockActorReader{actors: map[int]*actor.Actor{1: domainActor}}, cfg), store
+}
+
+func TestPhotoService_UploadAndGetPhoto(t *testing.T) {
+	service, store := newTestPhotoService(t, 1024)
+	ctx := context.Background()
+
+	dto, err := service.UploadPhoto(ctx, UploadPhotoCommand{ActorID: 1, Data: "data:image/png;base64," + base64.StdEncoding.EncodeToString(pngData)})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if dto.Name != "Keanu Reeves" || dto.MimeType != image.MimeTypePNG || dto.Size != len(pngData) {
+		t.Errorf("Expected a %d byte PNG for Keanu Reeves, got: %+v", len(pngData), dto)
+	}
+	if !bytes.Equal(store.photos[1].Data, pngData) {
+		t.Errorf("Expected the decoded PNG to be stored, got: %x", store.photos[1].Data)
+	}
+
+	photo, err := service.GetPhoto(ctx, 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !bytes.Equal(photo.Data, pngData) || photo.MimeType != image.MimeTypePNG {
+		t.Errorf("Expected the stored PNG back, got: %x (%s)", photo.Data, photo.MimeType)
+	}
+
+	photos, err := service.ListPhotos(ctx)
+	if err != nil || len(photos) != 1 || photos[0].ActorID != 1 {
+		t.Errorf("Expected one listed photo, got: %+v (%v)", photos, err)
+	}
+}
+
+func TestPhotoService_UploadPhoto_Invalid(t *testing.T) {
+	encoded := base64.StdEncoding.EncodeToString(pngData)
+
+	tests := []struct {
+		name  string
+		cmd   UploadPhotoCommand
+		isErr error
+	}{
+		{name: "missing actor", cmd: UploadPhotoCommand{ActorID: 2, Data: encoded}, isErr: shared.ErrNotFound},
+		{name: "too large", cmd: UploadPhotoCommand{ActorID: 1, Data: base64.StdEncoding.EncodeToString(make([]byte, 2048))}, isErr: shared.ErrValidation},
+		{name: "disallowed type", cmd: UploadPhotoCommand{ActorID: 1, Data: encoded, MimeType: "image/gif"}, isErr: shared.ErrValidation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, store := newTestPhotoService(t, 1024)
+
+			_, err := service.UploadPhoto(context.Background(), tt.cmd)
+			if !errors.Is(err, tt.isErr) {
+				t.Errorf("Expected %v, got: %v", tt.isErr, err)
+			}
+			if len(store.photos) != 0 {
+				t.Errorf("Expected nothing stored, got: %d photos", len(store.photos))
+			}
+		})
+	}
+}
+
+func TestPhotoService_DeletePhoto(t *testing.T) {
+	service, store := newTestPhotoService(t, 1024)
+	actorID, _ := shared.NewActorID(1)
+	store.photos[1] = &actor.Photo{ActorID: actorID, Data: pngData}
+
+	for _, wantDeleted := range []bool{true, false} {
+		dto, err := service.DeletePhoto(context.Background(), 1)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if dto.Deleted != wantDeleted || dto.Name != "Keanu Reeves" {
+			t.Errorf("Expected deleted %v for Keanu Reeves, got: %+v", wantDeleted, dto)
+		}
+	}
+
+	if _, err := service.GetPhoto(context.Background(), 1); !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound once deleted, got: %v", err)
+	}
+}
@@ -0,0 +1,209 @@
+package poster
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/image"
+)
+
+// Service provides application-level movie poster operations
+type Service struct {
+	store     movie.PosterStore
+	movieRepo movie.Reader
+	images    *image.ImageProcessor
+	maxSize   int64
+}
+
+// NewService creates a new poster application service; uploads must be of
+// one of cfg's allowed types and no larger than its maximum size, and are
+// converted, resized and stripped as cfg configures
+func NewService(store movie.PosterStore, movieRepo movie.Reader, cfg *image.ImageConfig) *Service {
+	return &Service{
+		store:     store,
+		movieRepo: movieRepo,
+		images:    image.NewImageProcessor(cfg),
+		maxSize:   cfg.MaxSize,
+	}
+}
+
+// UploadPosterCommand represents the command to store a movie's poster image
+type UploadPosterCommand struct {
+	MovieID  int
+	Data     string // Base64 image data, optionally as a data: URI
+	MimeType string // Taken from a data: URI or detected from the image when empty
+}
+
+// PosterDTO represents a stored movie poster
+type PosterDTO struct {
+	MovieID  int    `json:"movie_id"`
+	Title    string `json:"title"`
+	Year     int    `json:"year"`
+	MimeType string `json:"mime_type"`
+	Size     int    `json:"size"`
+}
+
+// PosterImageDTO represents a stored movie poster with its image data
+type PosterImageDTO struct {
+	PosterDTO
+	Data []byte `json:"-"`
+}
+
+// DeletePosterDTO represents the result of deleting a movie's poster
+type DeletePosterDTO struct {
+	MovieID int    `json:"movie_id"`
+	Title   string `json:"title"`
+	Year    int    `json:"year"`
+	Deleted bool   `json:"deleted"`
+}
+
+// UploadPoster decodes, validates and processes a base64 poster image and
+// stores it as the movie's poster, replacing any poster image it had
+func (s *Service) UploadPoster(ctx context.Context, cmd UploadPosterCommand) (*PosterDTO, error) {
+	domainMovie, err := s.findMovie(ctx, cmd.MovieID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, mimeType, err := decodeImage(cmd.Data, cmd.MimeType, s.maxSize)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.images.ValidateImage(data, mimeType); err != nil {
+		return nil, shared.NewValidationError("invalid poster: %w", err)
+	}
+	data, mimeType, err = s.images.Process(data, mimeType)
+	if err != nil {
+		return nil, shared.NewValidationError("invalid poster: %w", err)
+	}
+
+	if err := s.store.SavePoster(ctx, domainMovie.ID(), data, mimeType); err != nil {
+		return nil, fmt.Errorf("failed to save poster: %w", err)
+	}
+
+	return &PosterDTO{
+		MovieID:  domainMovie.ID().Value(),
+		Title:    domainMovie.Title(),
+		Year:     domainMovie.Year().Value(),
+		MimeType: mimeType,
+		Size:     len(data),
+	}, nil
+}
+
+// GetPoster returns a movie's stored poster image; it fails with
+// shared.ErrNotFound if the movie has none
+func (s *Service) GetPoster(ctx context.Context, movieID int) (*PosterImageDTO, error) {
+	id, err := shared.NewMovieID(movieID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid movie ID: %w", err)
+	}
+
+	poster, err := s.store.FindPoster(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("poster not found: %w", err)
+	}
+
+	return &PosterImageDTO{
+		PosterDTO: PosterDTO{
+			MovieID:  poster.MovieID.Value(),
+			Title:    poster.Title,
+			Year:     poster.Year,
+			MimeType: poster.MimeType,
+			Size:     len(poster.Data),
+		},
+		Data: poster.Data,
+	}, nil
+}
+
+// DeletePoster removes a movie's poster image. Deleting a poster the movie
+// does not have succeeds with Deleted false; its poster URL is kept.
+func (s *Service) DeletePoster(ctx context.Context, movieID int) (*DeletePosterDTO, error) {
+	domainMovie, err := s.findMovie(ctx, movieID)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.store.DeletePoster(ctx, domainMovie.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete poster: %w", err)
+	}
+
+	return &DeletePosterDTO{
+		MovieID: domainMovie.ID().Value(),
+		Title:   domainMovie.Title(),
+		Year:    domainMovie.Year().Value(),
+		Deleted: deleted,
+	}, nil
+}
+
+// decodeImage reads base64 image data, with or without padding, line breaks
+// or a data: URI prefix, and settles its MIME type: the declared one, else
+// the URI's, else the one detected from the image
+func decodeImage(encoded, mimeType string, maxSize int64) ([]byte, string, error) {
+	encoded = strings.TrimSpace(encoded)
+	mimeType = normalizeMimeType(mimeType)
+
+	if rest, ok := strings.CutPrefix(encoded, "data:"); ok {
+		header, payload, found := strings.Cut(rest, ",")
+		uriType, isBase64 := strings.CutSuffix(header, ";base64")
+		if !found || !isBase64 {
+			return nil, "", shared.NewValidationError("data URI must be base64 encoded (data:<type>;base64,<data>)")
+		}
+		uriType = normalizeMimeType(uriType)
+		if mimeType != "" && uriType != "" && mimeType != uriType {
+			return nil, "", shared.NewValidationError("mime_type %s does not match the data URI's %s", mimeType, uriType)
+		}
+		if mimeType == "" {
+			mimeType = uriType
+		}
+		encoded = payload
+	}
+
+	encoded = strings.Join(strings.Fields(encoded), "")
+	if encoded == "" {
+		return nil, "", shared.NewValidationError("image data is required")
+	}
+	// Refuse oversized uploads before decoding them
+	if int64(base64.RawStdEncoding.DecodedLen(len(strings.TrimRight(encoded, "=")))) > maxSize {
+		return nil, "", shared.NewValidationError("image exceeds the maximum size of %d bytes", maxSize)
+	}
+
+	data, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(encoded, "="))
+	if err != nil {
+		return nil, "", shared.NewValidationError("image data is not valid base64: %w", err)
+	}
+
+	if mimeType == "" {
+		mimeType = http.DetectContentType(data)
+	}
+	return data, mimeType, nil
+}
+
+// normalizeMimeType lower-cases a MIME type, drops its parameters and maps
+// the common image/jpg alias to image/jpeg
+func normalizeMimeType(mimeType string) string {
+	mimeType, _, _ = strings.Cut(mimeType, ";")
+	mimeType = strings.ToLower(strings.TrimSpace(mimeType))
+	if mimeType == "image/jpg" {
+		return image.MimeTypeJPEG
+	}
+	return mimeType
+}
+
+func (s *Service) findMovie(ctx context.Context, id int) (*movie.Movie, error) {
+	movieID, err := shared.NewMovieID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid movie ID: %w", err)
+	}
+
+	domainMovie, err := s.movieRepo.FindByID(ctx, movieID)
+	if err != nil {
+		return nil, fmt.Errorf("movie not found: %w", err)
+	}
+	return domainMovie, nil
+}
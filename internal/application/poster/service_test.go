@@ -0,0 +1,226 @@
+package poster
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	stdimage "image"
+	"image/jpeg"
+	"image/png"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/image"
+)
+
+// pngData is a PNG signature followed by padding, enough to pass the
+// content checks
+var pngData = append([]byte{0x89, 'P', 'N', 'G', 0x0D, 0x0A, 0x1A, 0x0A}, make([]byte, 24)...)
+
+// MockMovieReader implements the FindByID part of movie.Reader for testing
+type MockMovieReader struct {
+	movie.Reader
+	movies map[int]*movie.Movie
+}
+
+func (m *MockMovieReader) FindByID(ctx context.Context, id shared.MovieID) (*movie.Movie, error) {
+	if found, exists := m.movies[id.Value()]; exists {
+		return found, nil
+	}
+	return nil, shared.NewNotFoundError("movie not found")
+}
+
+func (m *MockMovieReader) FindByCriteria(ctx context.Context, criteria movie.SearchCriteria) ([]*movie.Movie, error) {
+	var ids []int
+	for id := range m.movies {
+		if id > criteria.AfterID {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+
+	var found []*movie.Movie
+	for _, id := range ids {
+		if len(found) < criteria.Limit {
+			found = append(found, m.movies[id])
+		}
+	}
+	return found, nil
+}
+
+// MockPosterStore implements movie.PosterStore for testing
+type MockPosterStore struct {
+	data      map[int][]byte
+	mimeTypes map[int]string
+}
+
+func (m *MockPosterStore) SavePoster(ctx context.Context, movieID shared.MovieID, data []byte, mimeType string) error {
+	m.data[movieID.Value()] = data
+	m.mimeTypes[movieID.Value()] = mimeType
+	return nil
+}
+
+func (m *MockPosterStore) FindPoster(ctx context.Context, movieID shared.MovieID) (*movie.Poster, error) {
+	data, exists := m.data[movieID.Value()]
+	if !exists {
+		return nil, shared.NewNotFoundError("movie poster not found")
+	}
+	return &movie.Poster{MovieID: movieID, Title: "Inception", Year: 2010, MimeType: m.mimeTypes[movieID.Value()], Data: data}, nil
+}
+
+func (m *MockPosterStore) DeletePoster(ctx context.Context, movieID shared.MovieID) (bool, error) {
+	_, exists := m.data[movieID.Value()]
+	delete(m.data, movieID.Value())
+	delete(m.mimeTypes, movieID.Value())
+	return exists, nil
+}
+
+func newTestService(t *testing.T, maxSize int64) (*Service, *MockPosterStore) {
+	t.Helper()
+
+	movieID, _ := shared.NewMovieID(1)
+	domainMovie, err := movie.NewMovieWithID(movieID, "Inception", "Christopher Nolan", 2010)
+	if err != nil {
+		t.Fatalf("failed to create movie: %v", err)
+	}
+
+	store := &MockPosterStore{data: map[int][]byte{}, mimeTypes: map[int]string{}}
+	cfg := &image.ImageConfig{MaxSize: maxSize, AllowedTypes: []string{image.MimeTypeJPEG, image.MimeTypePNG}}
+	return NewService(store, &MockMovieReader{movies: map[int]*movie.Movie{1: domainMovie}}, cfg), store
+}
+
+func TestService_UploadPoster(t *testing.T) {
+	encoded := base64.StdEncoding.EncodeToString(pngData)
+
+	tests := []struct {
+		name     string
+		data     string
+		mimeType string
+	}{
+		{name: "detected type", data: encoded},
+		{name: "declared type", data: encoded, mimeType: "IMAGE/PNG"},
+		{name: "data URI", data: "data:image/png;base64," + encoded},
+		{name: "unpadded with line breaks", data: strings.TrimRight(encoded[:20]+"\n"+encoded[20:], "=")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, store := newTestService(t, 1024)
+
+			dto, err := service.UploadPoster(context.Background(), UploadPosterCommand{MovieID: 1, Data: tt.data, MimeType: tt.mimeType})
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if dto.Title != "Inception" || dto.MimeType != image.MimeTypePNG || dto.Size != len(pngData) {
+				t.Errorf("Expected a %d byte PNG for Inception, got: %+v", len(pngData), dto)
+			}
+			if !bytes.Equal(store.data[1], pngData) || store.mimeTypes[1] != image.MimeTypePNG {
+				t.Errorf("Expected the decoded PNG to be stored, got: %x (%s)", store.data[1], store.mimeTypes[1])
+			}
+		})
+	}
+}
+
+func TestService_UploadPoster_Processes(t *testing.T) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, stdimage.NewRGBA(stdimage.Rect(0, 0, 40, 20))); err != nil {
+		t.Fatalf("failed to encode PNG: %v", err)
+	}
+
+	service, store := newTestService(t, 1024*1024)
+	cfg := &image.ImageConfig{MaxSize: 1024 * 1024, AllowedTypes: []string{image.MimeTypePNG}, OutputFormat: "jpeg", MaxWidth: 20}
+	service = NewService(store, service.movieRepo, cfg)
+
+	dto, err := service.UploadPoster(context.Background(), UploadPosterCommand{MovieID: 1, Data: base64.StdEncoding.EncodeToString(buf.Bytes())})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if dto.MimeType != image.MimeTypeJPEG || dto.Size != len(store.data[1]) || store.mimeTypes[1] != image.MimeTypeJPEG {
+		t.Errorf("Expected the poster to be stored as JPEG, got: %+v (%s)", dto, store.mimeTypes[1])
+	}
+	stored, err := jpeg.DecodeConfig(bytes.NewReader(store.data[1]))
+	if err != nil || stored.Width != 20 || stored.Height != 10 {
+		t.Errorf("Expected a 20x10 JPEG, got: %+v (%v)", stored, err)
+	}
+}
+
+func TestService_UploadPoster_Invalid(t *testing.T) {
+	encoded := base64.StdEncoding.EncodeToString(pngData)
+
+	tests := []struct {
+		name  string
+		cmd   UploadPosterCommand
+		isErr error
+	}{
+		{name: "missing movie", cmd: UploadPosterCommand{MovieID: 2, Data: encoded}, isErr: shared.ErrNotFound},
+		{name: "empty data", cmd: UploadPosterCommand{MovieID: 1, Data: " "}, isErr: shared.ErrValidation},
+		{name: "not base64", cmd: UploadPosterCommand{MovieID: 1, Data: "not*base64"}, isErr: shared.ErrValidation},
+		{name: "too large", cmd: UploadPosterCommand{MovieID: 1, Data: base64.StdEncoding.EncodeToString(make([]byte, 70))}, isErr: shared.ErrValidation},
+		{name: "type not allowed", cmd: UploadPosterCommand{MovieID: 1, Data: encoded, MimeType: "image/webp"}, isErr: shared.ErrValidation},
+		{name: "type mismatch", cmd: UploadPosterCommand{MovieID: 1, Data: encoded, MimeType: "image/jpeg"}, isErr: shared.ErrValidation},
+		{name: "not an image", cmd: UploadPosterCommand{MovieID: 1, Data: base64.StdEncoding.EncodeToString([]byte("hello"))}, isErr: shared.ErrValidation},
+		{name: "data URI conflict", cmd: UploadPosterCommand{MovieID: 1, Data: "data:image/jpeg;base64," + encoded, MimeType: "image/png"}, isErr: shared.ErrValidation},
+		{name: "data URI not base64", cmd: UploadPosterCommand{MovieID: 1, Data: "data:image/png," + encoded}, isErr: shared.ErrValidation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, store := newTestService(t, 64)
+
+			_, err := service.UploadPoster(context.Background(), tt.cmd)
+			if !errors.Is(err, tt.isErr) {
+				t.Errorf("Expected %v, got: %v", tt.isErr, err)
+			}
+			if len(store.data) != 0 {
+				t.Error("Expected nothing to be stored")
+			}
+		})
+	}
+}
+
+func TestService_GetPoster(t *testing.T) {
+	service, _ := newTestService(t, 1024)
+	ctx := context.Background()
+
+	if _, err := service.GetPoster(ctx, 1); !errors.Is(err, shared.ErrNotFound) {
+		t.Fatalf("Expected ErrNotFound before an upload, got: %v", err)
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(pngData)
+	if _, err := service.UploadPoster(ctx, UploadPosterCommand{MovieID: 1, Data: encoded}); err != nil {
+		t.Fatalf("UploadPoster() error = %v", err)
+	}
+	dto, err := service.GetPoster(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetPoster() error = %v", err)
+	}
+	if dto.Title != "Inception" || dto.MimeType != image.MimeTypePNG || dto.Size != len(pngData) || !bytes.Equal(dto.Data, pngData) {
+		t.Errorf("Expected the stored PNG for Inception, got: %+v", dto.PosterDTO)
+	}
+}
+
+func TestService_DeletePoster(t *testing.T) {
+	service, store := newTestService(t, 1024)
+	store.data[1], store.mimeTypes[1] = pngData, image.MimeTypePNG
+
+	dto, err := service.DeletePoster(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !dto.Deleted || dto.Title != "Inception" {
+		t.Errorf("Expected Inception's poster to be deleted, got: %+v", dto)
+	}
+
+	dto, err = service.DeletePoster(context.Background(), 1)
+	if err != nil || dto.Deleted {
+		t.Errorf("Expected nothing left to delete, got: %+v (%v)", dto, err)
+	}
+
+	if _, err := service.DeletePoster(context.Background(), 2); !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for a missing movie, got: %v", err)
+	}
+}
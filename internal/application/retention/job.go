@@ -0,0 +1,239 @@
+package retention
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Purger deletes the records of one kind older than a cutoff
+type Purger interface {
+	PurgeBefore(ctx context.Context, cutoff time.Time) (int, error)
+}
+
+// Policy keeps the records a purger deletes for MaxDays days; 0 keeps them
+// forever
+type Policy struct {
+	Name    string
+	MaxDays int
+	Purger  Purger
+}
+
+// PolicyStatus reports a policy and how its purges went
+type PolicyStatus struct {
+	Name        string
+	MaxDays     int
+	Enabled     bool
+	LastRun     time.Time
+	LastCutoff  time.Time
+	LastPurged  int
+	TotalPurged int
+	LastError   string
+}
+
+// TenantStatus reports how a tenant library's purges went
+type TenantStatus struct {
+	Tenant   string
+	Policies []PolicyStatus
+}
+
+// Status reports the job and each of its policies, for the default library
+// and then for each tenant, in name order
+type Status struct {
+	Interval time.Duration
+	NextRun  time.Time
+	Policies []PolicyStatus
+	Tenants  []TenantStatus
+}
+
+// Tenants lists the open tenant libraries and routes a context to one
+type Tenants interface {
+	Tenants() []string
+	WithTenant(ctx context.Context, tenant string) (context.Context, error)
+}
+
+// Job purges records past their policy's retention period every interval
+type Job struct {
+	interval time.Duration
+	policies []Policy
+	now      func() time.Time
+	stop     chan struct{}
+	stopOnce sync.Once
+
+	tenants Tenants
+
+	mu       sync.Mutex
+	status   []PolicyStatus
+	byTenant map[string][]PolicyStatus
+	nextRun  time.Time
+}
+
+// NewJob creates a job running policies every interval
+func NewJob(interval time.Duration, policies ...Policy) *Job {
+	j := &Job{
+		interval: interval,
+		policies: policies,
+		now:      time.Now,
+		stop:     make(chan struct{}),
+		byTenant: make(map[string][]PolicyStatus),
+	}
+	j.status = j.newStatus()
+	return j
+}
+
+// SetTenants also runs the policies for every open tenant library, each
+// through a context routed to it. Call before Start.
+func (j *Job) SetTenants(tenants Tenants) {
+	j.tenants = tenants
+}
+
+// newStatus returns the status of policies that have never run
+func (j *Job) newStatus() []PolicyStatus {
+	status := make([]PolicyStatus, len(j.policies))
+	for i, policy := range j.policies {
+		status[i] = PolicyStatus{Name: policy.Name, MaxDays: policy.MaxDays, Enabled: policy.MaxDays > 0}
+	}
+	return status
+}
+
+// Enabled reports whether any policy has a retention period
+func (j *Job) Enabled() bool {
+	for _, policy := range j.policies {
+		if policy.MaxDays > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Start runs the policies now and then every interval in the background
+// until Stop is called or ctx is done. A job without a retention period
+// never starts.
+func (j *Job) Start(ctx context.Context) {
+	if !j.Enabled() {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			j.Run(ctx) // A failed purge is retried on the next tick
+			j.mu.Lock()
+			j.nextRun = j.now().Add(j.interval)
+			j.mu.Unlock()
+
+			select {
+			case <-ticker.C:
+			case <-j.stop:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop halts background purges
+func (j *Job) Stop() {
+	j.stopOnce.Do(func() { close(j.stop) })
+}
+
+// Run purges the records of each enabled policy older than its period, in
+// the default library and then in each open tenant's, and returns the first
+// error; a failing policy or tenant does not stop the others
+func (j *Job) Run(ctx context.Context) error {
+	firstErr := j.runPolicies(ctx, j.status)
+	if j.tenants == nil {
+		return firstErr
+	}
+
+	for _, tenant := range j.tenants.Tenants() {
+		j.mu.Lock()
+		statuses, ok := j.byTenant[tenant]
+		if !ok {
+			statuses = j.newStatus()
+			j.byTenant[tenant] = statuses
+		}
+		j.mu.Unlock()
+
+		tenantCtx, err := j.tenants.WithTenant(ctx, tenant)
+		if err == nil {
+			err = j.runPolicies(tenantCtx, statuses)
+		} else {
+			j.recordFailure(statuses, err)
+		}
+		if err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("tenant %s: %w", tenant, err)
+		}
+	}
+	return firstErr
+}
+
+// runPolicies runs each enabled policy in the library ctx is routed to,
+// recording the purges in statuses
+func (j *Job) runPolicies(ctx context.Context, statuses []PolicyStatus) error {
+	var firstErr error
+	for i, policy := range j.policies {
+		if policy.MaxDays <= 0 {
+			continue
+		}
+		now := j.now()
+		cutoff := now.AddDate(0, 0, -policy.MaxDays)
+		purged, err := policy.Purger.PurgeBefore(ctx, cutoff)
+		if err != nil {
+			err = fmt.Errorf("failed to purge %s: %w", policy.Name, err)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+
+		j.mu.Lock()
+		status := &statuses[i]
+		status.LastRun = now.UTC()
+		status.LastCutoff = cutoff.UTC()
+		status.LastPurged = purged
+		status.TotalPurged += purged
+		status.LastError = ""
+		if err != nil {
+			status.LastError = err.Error()
+		}
+		j.mu.Unlock()
+	}
+	return firstErr
+}
+
+// recordFailure records err against every enabled policy in statuses, for
+// a library whose policies could not run at all
+func (j *Job) recordFailure(statuses []PolicyStatus, err error) {
+	now := j.now().UTC()
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	for i := range statuses {
+		if statuses[i].Enabled {
+			statuses[i].LastRun = now
+			statuses[i].LastPurged = 0
+			statuses[i].LastError = err.Error()
+		}
+	}
+}
+
+// Status returns the job's policies and their last purges
+func (j *Job) Status() Status {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	tenants := make([]TenantStatus, 0, len(j.byTenant))
+	for tenant, statuses := range j.byTenant {
+		tenants = append(tenants, TenantStatus{Tenant: tenant, Policies: append([]PolicyStatus(nil), statuses...)})
+	}
+	sort.Slice(tenants, func(a, b int) bool { return tenants[a].Tenant < tenants[b].Tenant })
+	return Status{
+		Interval: j.interval,
+		NextRun:  j.nextRun,
+		Policies: append([]PolicyStatus(nil), j.status...),
+		Tenants:  tenants,
+	}
+}
@@ -0,0 +1,142 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// fakePurger records the cutoffs it was called with
+type fakePurger struct {
+	cutoffs []time.Time
+	purged  int
+	err     error
+}
+
+func (p *fakePurger) PurgeBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	p.cutoffs = append(p.cutoffs, cutoff)
+	return p.purged, p.err
+}
+
+func TestJob_Run(t *testing.T) {
+	history := &fakePurger{purged: 3}
+	access := &fakePurger{err: errors.New("database is locked")}
+	forever := &fakePurger{}
+	job := NewJob(time.Hour,
+		Policy{Name: "history", MaxDays: 30, Purger: history},
+		Policy{Name: "access", MaxDays: 7, Purger: access},
+		Policy{Name: "forever", Purger: forever},
+	)
+	now := time.Date(2026, 3, 14, 12, 0, 0, 0, time.UTC)
+	job.now = func() time.Time { return now }
+
+	err := job.Run(context.Background())
+	if err == nil || !errors.Is(err, access.err) {
+		t.Errorf("Expected the access purge error, got %v", err)
+	}
+	if len(history.cutoffs) != 1 || !history.cutoffs[0].Equal(now.AddDate(0, 0, -30)) {
+		t.Errorf("Expected history purged before 30 days ago, got %v", history.cutoffs)
+	}
+	if len(access.cutoffs) != 1 || !access.cutoffs[0].Equal(now.AddDate(0, 0, -7)) {
+		t.Errorf("Expected access purged before 7 days ago, got %v", access.cutoffs)
+	}
+	if len(forever.cutoffs) != 0 {
+		t.Error("Expected a policy without a period never to purge")
+	}
+
+	job.Run(context.Background())
+	status := job.Status()
+	if status.Interval != time.Hour || len(status.Policies) != 3 {
+		t.Fatalf("Unexpected status %+v", status)
+	}
+	if got := status.Policies[0]; got.LastPurged != 3 || got.TotalPurged != 6 || !got.LastRun.Equal(now) || got.LastError != "" {
+		t.Errorf("Unexpected history status %+v", got)
+	}
+	if got := status.Policies[1]; got.LastError == "" || got.TotalPurged != 0 {
+		t.Errorf("Expected the access status to keep the error, got %+v", got)
+	}
+	if got := status.Policies[2]; got.Enabled || !got.LastRun.IsZero() {
+		t.Errorf("Expected the forever policy disabled and never run, got %+v", got)
+	}
+}
+
+func TestJob_StartRunsImmediately(t *testing.T) {
+	purger := &fakePurger{}
+	job := NewJob(time.Hour, Policy{Name: "history", MaxDays: 1, Purger: purger})
+	job.Start(context.Background())
+	defer job.Stop()
+
+	deadline := time.Now().Add(time.Second)
+	for job.Status().NextRun.IsZero() {
+		if time.Now().After(deadline) {
+			t.Fatal("Expected a purge when the job starts")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if !job.Enabled() {
+		t.Error("Expected a job with a period to be enabled")
+	}
+	if NewJob(time.Hour, Policy{Name: "history", Purger: purger}).Enabled() {
+		t.Error("Expected a job without periods to be disabled")
+	}
+}
+
+// tenantPurger records the tenant of each purge
+type tenantPurger struct {
+	tenants []string
+}
+
+func (p *tenantPurger) PurgeBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	tenant, _ := ctx.Value(tenantKey{}).(string)
+	p.tenants = append(p.tenants, tenant)
+	return 1, nil
+}
+
+type tenantKey struct{}
+
+// fakeTenants routes contexts to tenants, failing for broken ones
+type fakeTenants struct {
+	open   []string
+	broken string
+}
+
+func (f *fakeTenants) Tenants() []string {
+	return f.open
+}
+
+func (f *fakeTenants) WithTenant(ctx context.Context, tenant string) (context.Context, error) {
+	if tenant == f.broken {
+		return nil, errors.New("failed to open database of tenant " + tenant)
+	}
+	return context.WithValue(ctx, tenantKey{}, tenant), nil
+}
+
+func TestJob_RunsForEachTenant(t *testing.T) {
+	purger := &tenantPurger{}
+	job := NewJob(time.Hour, Policy{Name: "history", MaxDays: 30, Purger: purger})
+	job.SetTenants(&fakeTenants{open: []string{"acme", "globex", "initech"}, broken: "globex"})
+
+	if err := job.Run(context.Background()); err == nil {
+		t.Error("Expected the tenant that failed to open to be reported")
+	}
+
+	if fmt.Sprint(purger.tenants) != fmt.Sprint([]string{"", "acme", "initech"}) {
+		t.Errorf("Expected the default library, acme and initech purged in turn, got %q", purger.tenants)
+	}
+	job.Run(context.Background())
+	status := job.Status()
+	if len(status.Tenants) != 3 || status.Tenants[0].Tenant != "acme" || status.Tenants[2].Tenant != "initech" {
+		t.Fatalf("Expected a status for each tenant in name order, got %+v", status.Tenants)
+	}
+	if got := status.Tenants[0].Policies[0]; got.TotalPurged != 2 || got.LastError != "" {
+		t.Errorf("Unexpected acme status %+v", got)
+	}
+	if got := status.Tenants[1].Policies[0]; got.LastError == "" || got.LastRun.IsZero() {
+		t.Errorf("Expected the globex status to record the failure, got %+v", got)
+	}
+	if got := status.Policies[0]; got.TotalPurged != 2 {
+		t.Errorf("Expected the default library purged on each run, got %+v", got)
+	}
+}
@@ -0,0 +1,151 @@
+package seed
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+)
+
+//go:embed data/*.json
+var datasetFiles embed.FS
+
+// MovieService defines the movie operations the seeder depends on
+type MovieService interface {
+	CreateMovie(ctx context.Context, cmd movieApp.CreateMovieCommand) (*movieApp.MovieDTO, error)
+	SearchMovies(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error)
+}
+
+// Movie is a single movie entry in a dataset
+type Movie struct {
+	Title    string   `json:"title"`
+	Director string   `json:"director"`
+	Year     int      `json:"year"`
+	Rating   float64  `json:"rating"`
+	Genres   []string `json:"genres"`
+}
+
+// Dataset is a named, curated set of movies
+type Dataset struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Movies      []Movie `json:"movies"`
+}
+
+// Result summarizes a seeding run
+type Result struct {
+	Dataset  string
+	Inserted int
+	Skipped  int
+	Errors   []string
+}
+
+// Datasets returns the names of the embedded datasets in sorted order
+func Datasets() []string {
+	entries, err := datasetFiles.ReadDir("data")
+	if err != nil {
+		return []string{}
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, strings.TrimSuffix(entry.Name(), ".json"))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LoadDataset parses the embedded dataset with the given name
+func LoadDataset(name string) (*Dataset, error) {
+	data, err := datasetFiles.ReadFile("data/" + name + ".json")
+	if err != nil {
+		return nil, fmt.Errorf("unknown dataset %q (available: %s)", name, strings.Join(Datasets(), ", "))
+	}
+
+	var dataset Dataset
+	if err := json.Unmarshal(data, &dataset); err != nil {
+		return nil, fmt.Errorf("failed to parse dataset %s: %w", name, err)
+	}
+	return &dataset, nil
+}
+
+// Seeder loads embedded datasets into the database through the movie service
+type Seeder struct {
+	movieService MovieService
+}
+
+// NewSeeder creates a new seeder
+func NewSeeder(movieService MovieService) *Seeder {
+	return &Seeder{
+		movieService: movieService,
+	}
+}
+
+// Seed inserts every movie of the named dataset that is not already present.
+// A movie is considered present when one with the same title (case-insensitive)
+// and year exists, so seeding the same dataset twice is a no-op.
+func (s *Seeder) Seed(ctx context.Context, name string) (*Result, error) {
+	dataset, err := LoadDataset(name)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{
+		Dataset: dataset.Name,
+		Errors:  []string{},
+	}
+
+	for _, movie := range dataset.Movies {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		exists, err := s.exists(ctx, movie)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s (%d): %v", movie.Title, movie.Year, err))
+			continue
+		}
+		if exists {
+			result.Skipped++
+			continue
+		}
+
+		_, err = s.movieService.CreateMovie(ctx, movieApp.CreateMovieCommand{
+			Title:    movie.Title,
+			Director: movie.Director,
+			Year:     movie.Year,
+			Rating:   movie.Rating,
+			Genres:   movie.Genres,
+		})
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s (%d): %v", movie.Title, movie.Year, err))
+			continue
+		}
+		result.Inserted++
+	}
+
+	return result, nil
+}
+
+// exists reports whether a movie with the same title and year is already stored
+func (s *Seeder) exists(ctx context.Context, movie Movie) (bool, error) {
+	matches, err := s.movieService.SearchMovies(ctx, movieApp.SearchMoviesQuery{
+		Title:   movie.Title,
+		MinYear: movie.Year,
+		MaxYear: movie.Year,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, match := range matches {
+		if strings.EqualFold(match.Title, movie.Title) && match.Year == movie.Year {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,162 @@
+package seed
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+)
+
+// memoryMovieService is an in-memory MovieService that matches titles like the repository
+type memoryMovieService struct {
+	movies    []*movieApp.MovieDTO
+	createErr error
+}
+
+func (m *memoryMovieService) CreateMovie(ctx context.Context, cmd movieApp.CreateMovieCommand) (*movieApp.MovieDTO, error) {
+	if m.createErr != nil {
+		return nil, m.createErr
+	}
+	movie := &movieApp.MovieDTO{
+		ID:       len(m.movies) + 1,
+		Title:    cmd.Title,
+		Director: cmd.Director,
+		Year:     cmd.Year,
+		Rating:   cmd.Rating,
+		Genres:   cmd.Genres,
+	}
+	m.movies = append(m.movies, movie)
+	return movie, nil
+}
+
+func (m *memoryMovieService) SearchMovies(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+	var matches []*movieApp.MovieDTO
+	for _, movie := range m.movies {
+		if strings.Contains(strings.ToLower(movie.Title), strings.ToLower(query.Title)) &&
+			movie.Year >= query.MinYear && movie.Year <= query.MaxYear {
+			matches = append(matches, movie)
+		}
+	}
+	return matches, nil
+}
+
+func TestDatasets_ListsEmbeddedSets(t *testing.T) {
+	names := Datasets()
+
+	expected := []string{"classics", "fixtures", "recent"}
+	if strings.Join(names, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected datasets %v, got: %v", expected, names)
+	}
+}
+
+func TestLoadDataset_AllSetsAreValid(t *testing.T) {
+	for _, name := range Datasets() {
+		dataset, err := LoadDataset(name)
+		if err != nil {
+			t.Fatalf("Expected dataset %s to load, got: %v", name, err)
+		}
+		if dataset.Name != name {
+			t.Errorf("Expected dataset name %s, got: %s", name, dataset.Name)
+		}
+		if len(dataset.Movies) == 0 {
+			t.Errorf("Expected dataset %s to contain movies", name)
+		}
+
+		seen := make(map[string]bool)
+		for _, movie := range dataset.Movies {
+			if movie.Title == "" || movie.Director == "" || movie.Year == 0 {
+				t.Errorf("Dataset %s has incomplete movie: %+v", name, movie)
+			}
+			key := strings.ToLower(movie.Title)
+			if seen[key] {
+				t.Errorf("Dataset %s contains duplicate movie %s", name, movie.Title)
+			}
+			seen[key] = true
+		}
+	}
+}
+
+func TestLoadDataset_Unknown(t *testing.T) {
+	_, err := LoadDataset("bogus")
+
+	if err == nil {
+		t.Fatal("Expected error for unknown dataset")
+	}
+	if !strings.Contains(err.Error(), "fixtures") {
+		t.Errorf("Expected error to list available datasets, got: %v", err)
+	}
+}
+
+func TestSeeder_SeedIsIdempotent(t *testing.T) {
+	// Arrange
+	service := &memoryMovieService{}
+	seeder := NewSeeder(service)
+	dataset, _ := LoadDataset("fixtures")
+
+	// Act
+	first, err := seeder.Seed(context.Background(), "fixtures")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	second, err := seeder.Seed(context.Background(), "fixtures")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	// Assert
+	if first.Inserted != len(dataset.Movies) || first.Skipped != 0 {
+		t.Errorf("Expected %d inserted on first run, got: %+v", len(dataset.Movies), first)
+	}
+	if second.Inserted != 0 || second.Skipped != len(dataset.Movies) {
+		t.Errorf("Expected all skipped on second run, got: %+v", second)
+	}
+	if len(service.movies) != len(dataset.Movies) {
+		t.Errorf("Expected %d stored movies, got: %d", len(dataset.Movies), len(service.movies))
+	}
+}
+
+func TestSeeder_PartialTitleMatchIsNotDuplicate(t *testing.T) {
+	// Arrange: "The Godfather Part II" contains "The Godfather" but is a different movie
+	service := &memoryMovieService{
+		movies: []*movieApp.MovieDTO{{ID: 1, Title: "The Godfather Part II", Year: 1972}},
+	}
+	seeder := NewSeeder(service)
+
+	// Act
+	result, err := seeder.Seed(context.Background(), "fixtures")
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.Skipped != 0 {
+		t.Errorf("Expected no skipped movies, got: %d", result.Skipped)
+	}
+}
+
+func TestSeeder_RecordsCreateErrors(t *testing.T) {
+	service := &memoryMovieService{createErr: errors.New("disk full")}
+	seeder := NewSeeder(service)
+
+	result, err := seeder.Seed(context.Background(), "fixtures")
+
+	if err != nil {
+		t.Fatalf("Expected per-movie errors rather than failure, got: %v", err)
+	}
+	if result.Inserted != 0 || len(result.Errors) == 0 {
+		t.Errorf("Expected errors to be recorded, got: %+v", result)
+	}
+	if !strings.Contains(result.Errors[0], "disk full") {
+		t.Errorf("Expected error message to include cause, got: %s", result.Errors[0])
+	}
+}
+
+func TestSeeder_UnknownDataset(t *testing.T) {
+	seeder := NewSeeder(&memoryMovieService{})
+
+	if _, err := seeder.Seed(context.Background(), "bogus"); err == nil {
+		t.Error("Expected error for unknown dataset")
+	}
+}
@@ -0,0 +1,75 @@
+package similarity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/similarity"
+)
+
+// IndexedRepository wraps a movie repository and refreshes the similar-movie
+// index after every save, so readers never score movies themselves. Deleted
+// movies leave the index through its foreign keys.
+type IndexedRepository struct {
+	movie.Repository
+	index similarity.Repository
+}
+
+// NewIndexedRepository creates a movie repository that keeps the similarity
+// index up to date
+func NewIndexedRepository(movieRepo movie.Repository, index similarity.Repository) *IndexedRepository {
+	return &IndexedRepository{
+		Repository: movieRepo,
+		index:      index,
+	}
+}
+
+// Save persists a movie and rescores it against the library
+func (r *IndexedRepository) Save(ctx context.Context, m *movie.Movie) error {
+	if err := r.Repository.Save(ctx, m); err != nil {
+		return err
+	}
+
+	all, err := r.Repository.FindByCriteria(ctx, movie.SearchCriteria{})
+	if err != nil {
+		return fmt.Errorf("movie saved but similarities not refreshed: %w", err)
+	}
+	if err := r.index.Refresh(ctx, m.ID(), similarity.Rank(m, all)); err != nil {
+		return fmt.Errorf("movie saved but similarities not refreshed: %w", err)
+	}
+	return nil
+}
+
+// InsertAll persists new movies and scores each against the library, loading
+// the library once
+func (r *IndexedRepository) InsertAll(ctx context.Context, movies []*movie.Movie) error {
+	if err := r.Repository.InsertAll(ctx, movies); err != nil {
+		return err
+	}
+
+	return r.refreshAll(ctx, movies)
+}
+
+// refreshAll rescores saved movies against the library
+func (r *IndexedRepository) refreshAll(ctx context.Context, movies []*movie.Movie) error {
+	all, err := r.Repository.FindByCriteria(ctx, movie.SearchCriteria{})
+	if err != nil {
+		return fmt.Errorf("movies saved but similarities not refreshed: %w", err)
+	}
+	for _, m := range movies {
+		if err := r.index.Refresh(ctx, m.ID(), similarity.Rank(m, all)); err != nil {
+			return fmt.Errorf("movies saved but similarities not refreshed: %w", err)
+		}
+	}
+	return nil
+}
+
+// UpdateAll persists changes to existing movies and rescores each against
+// the library, loading the library once
+func (r *IndexedRepository) UpdateAll(ctx context.Context, movies []*movie.Movie) error {
+	if err := r.Repository.UpdateAll(ctx, movies); err != nil {
+		return err
+	}
+	return r.refreshAll(ctx, movies)
+}
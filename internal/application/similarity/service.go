@@ -0,0 +1,160 @@
+package similarity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/domain/similarity"
+)
+
+// Service provides application-level similar-movie operations
+type Service struct {
+	index     similarity.Repository
+	movieRepo movie.Reader
+}
+
+// NewService creates a new similarity application service
+func NewService(index similarity.Repository, movieRepo movie.Reader) *Service {
+	return &Service{
+		index:     index,
+		movieRepo: movieRepo,
+	}
+}
+
+// SimilarMovieDTO represents a movie similar to another
+type SimilarMovieDTO struct {
+	ID       int      `json:"id"`
+	Title    string   `json:"title"`
+	Director string   `json:"director"`
+	Year     int      `json:"year"`
+	Rating   float64  `json:"rating,omitempty"`
+	Genres   []string `json:"genres"`
+	Score    float64  `json:"score"` // From similarity.MinScore to 1
+}
+
+// SimilarMoviesDTO lists the movies most similar to a movie
+type SimilarMoviesDTO struct {
+	MovieID int                `json:"movie_id"`
+	Title   string             `json:"title"`
+	Similar []*SimilarMovieDTO `json:"similar"` // Most similar first
+}
+
+// RebuildDTO reports what a full rebuild of the index stored
+type RebuildDTO struct {
+	Movies int `json:"movies"` // Movies scored
+	Pairs  int `json:"pairs"`  // Similar pairs stored
+}
+
+// GetSimilarMovies returns up to limit movies most similar to a movie, read
+// from the precomputed index
+func (s *Service) GetSimilarMovies(ctx context.Context, movieID, limit int) (*SimilarMoviesDTO, error) {
+	if limit < 1 || limit > similarity.MaxNeighbors {
+		return nil, shared.NewValidationError("limit must be between 1 and %d", similarity.MaxNeighbors)
+	}
+
+	id, err := shared.NewMovieID(movieID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid movie ID: %w", err)
+	}
+	domainMovie, err := s.movieRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("movie %d not found: %w", movieID, err)
+	}
+
+	neighbors, err := s.index.FindNeighbors(ctx, id, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find similar movies: %w", err)
+	}
+
+	result := &SimilarMoviesDTO{
+		MovieID: movieID,
+		Title:   domainMovie.Title(),
+		Similar: []*SimilarMovieDTO{},
+	}
+	if len(neighbors) == 0 {
+		return result, nil
+	}
+
+	ids := make([]shared.MovieID, len(neighbors))
+	for i, neighbor := range neighbors {
+		ids[i] = neighbor.MovieID
+	}
+	similarMovies, err := s.movieRepo.FindByCriteria(ctx, movie.SearchCriteria{IDs: ids, Limit: len(ids)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get similar movies: %w", err)
+	}
+	byID := make(map[int]*movie.Movie, len(similarMovies))
+	for _, m := range similarMovies {
+		byID[m.ID().Value()] = m
+	}
+
+	for _, neighbor := range neighbors {
+		m, exists := byID[neighbor.MovieID.Value()]
+		if !exists {
+			continue
+		}
+		result.Similar = append(result.Similar, &SimilarMovieDTO{
+			ID:       m.ID().Value(),
+			Title:    m.Title(),
+			Director: m.Director(),
+			Year:     m.Year().Value(),
+			Rating:   m.Rating().Value(),
+			Genres:   m.Genres(),
+			Score:    neighbor.Score,
+		})
+	}
+	return result, nil
+}
+
+// Rebuild rescores every pair of movies and replaces the whole index. It
+// takes time quadratic in the library size, so saves refresh the index
+// incrementally instead; a rebuild is for indexes that were never built or
+// have thinned out after deletions.
+func (s *Service) Rebuild(ctx context.Context) (*RebuildDTO, error) {
+	movies, err := s.movieRepo.FindByCriteria(ctx, movie.SearchCriteria{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get movies: %w", err)
+	}
+
+	result := &RebuildDTO{Movies: len(movies)}
+	lists := make(map[shared.MovieID][]similarity.Neighbor, len(movies))
+	for _, m := range movies {
+		neighbors := similarity.Rank(m, movies)
+		if len(neighbors) > similarity.MaxNeighbors {
+			neighbors = neighbors[:similarity.MaxNeighbors]
+		}
+		if len(neighbors) > 0 {
+			lists[m.ID()] = neighbors
+			result.Pairs += len(neighbors)
+		}
+	}
+
+	if err := s.index.ReplaceAll(ctx, lists); err != nil {
+		return nil, fmt.Errorf("failed to store similar movies: %w", err)
+	}
+	return result, nil
+}
+
+// EnsureIndexed rebuilds the index when it is empty but the library is not,
+// as after upgrading an existing database. It returns nil when the index was
+// left as is.
+func (s *Service) EnsureIndexed(ctx context.Context) (*RebuildDTO, error) {
+	pairs, err := s.index.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count similar movies: %w", err)
+	}
+	if pairs > 0 {
+		return nil, nil
+	}
+
+	movies, err := s.movieRepo.CountAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count movies: %w", err)
+	}
+	if movies < 2 {
+		return nil, nil
+	}
+	return s.Rebuild(ctx)
+}
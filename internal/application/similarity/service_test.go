@@ -0,0 +1,268 @@
+package similarity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/domain/similarity"
+)
+
+// MockMovieRepository implements the parts of movie.Repository the index
+// and service use, for testing
+type MockMovieRepository struct {
+	movie.Repository
+	movies []*movie.Movie // In ID order
+}
+
+func (m *MockMovieRepository) FindByID(ctx context.Context, id shared.MovieID) (*movie.Movie, error) {
+	for _, found := range m.movies {
+		if found.ID() == id {
+			return found, nil
+		}
+	}
+	return nil, shared.NewNotFoundError("movie not found")
+}
+
+func (m *MockMovieRepository) FindByCriteria(ctx context.Context, criteria movie.SearchCriteria) ([]*movie.Movie, error) {
+	if len(criteria.IDs) == 0 {
+		return m.movies, nil
+	}
+	var found []*movie.Movie
+	for _, candidate := range m.movies {
+		for _, id := range criteria.IDs {
+			if candidate.ID() == id {
+				found = append(found, candidate)
+			}
+		}
+	}
+	return found, nil
+}
+
+func (m *MockMovieRepository) CountAll(ctx context.Context) (int, error) {
+	return len(m.movies), nil
+}
+
+func (m *MockMovieRepository) Save(ctx context.Context, domainMovie *movie.Movie) error {
+	if domainMovie.ID().IsZero() {
+		id, _ := shared.NewMovieID(len(m.movies) + 1)
+		domainMovie.SetID(id)
+		m.movies = append(m.movies, domainMovie)
+	}
+	return nil
+}
+
+func (m *MockMovieRepository) InsertAll(ctx context.Context, movies []*movie.Movie) error {
+	for _, domainMovie := range movies {
+		if err := m.Save(ctx, domainMovie); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MockMovieRepository) UpdateAll(ctx context.Context, movies []*movie.Movie) error {
+	return nil // Saved movies are held by pointer, so their changes are already kept
+}
+
+// MockIndex stores each refreshed movie's own list, for testing
+type MockIndex struct {
+	lists      map[int][]similarity.Neighbor
+	refreshed  []int
+	refreshErr error
+}
+
+func newMockIndex() *MockIndex {
+	return &MockIndex{lists: make(map[int][]similarity.Neighbor)}
+}
+
+func (m *MockIndex) FindNeighbors(ctx context.Context, movieID shared.MovieID, limit int) ([]similarity.Neighbor, error) {
+	neighbors := m.lists[movieID.Value()]
+	if len(neighbors) > limit {
+		neighbors = neighbors[:limit]
+	}
+	return neighbors, nil
+}
+
+func (m *MockIndex) Refresh(ctx context.Context, movieID shared.MovieID, neighbors []similarity.Neighbor) error {
+	if m.refreshErr != nil {
+		return m.refreshErr
+	}
+	m.refreshed = append(m.refreshed, movieID.Value())
+	m.lists[movieID.Value()] = neighbors
+	return nil
+}
+
+func (m *MockIndex) ReplaceAll(ctx context.Context, lists map[shared.MovieID][]similarity.Neighbor) error {
+	m.lists = make(map[int][]similarity.Neighbor, len(lists))
+	for id, neighbors := range lists {
+		m.lists[id.Value()] = neighbors
+	}
+	return nil
+}
+
+func (m *MockIndex) Count(ctx context.Context) (int, error) {
+	count := 0
+	for _, neighbors := range m.lists {
+		count += len(neighbors)
+	}
+	return count, nil
+}
+
+func newTestMovie(t *testing.T, title, director string, year int, genres ...string) *movie.Movie {
+	t.Helper()
+
+	m, err := movie.NewMovie(title, director, year)
+	if err != nil {
+		t.Fatalf("failed to create movie: %v", err)
+	}
+	for _, genre := range genres {
+		if err := m.AddGenre(genre); err != nil {
+			t.Fatalf("failed to add genre: %v", err)
+		}
+	}
+	return m
+}
+
+// newTestLibrary returns a repository holding two Michael Mann crime films
+// and an unrelated comedy
+func newTestLibrary(t *testing.T) *MockMovieRepository {
+	t.Helper()
+
+	repo := &MockMovieRepository{}
+	movies := []*movie.Movie{
+		newTestMovie(t, "Heat", "Michael Mann", 1995, "Crime", "Drama"),
+		newTestMovie(t, "Collateral", "Michael Mann", 2004, "Crime", "Thriller"),
+		newTestMovie(t, "Barbie", "Greta Gerwig", 2023, "Comedy"),
+	}
+	if err := repo.InsertAll(context.Background(), movies); err != nil {
+		t.Fatalf("InsertAll() error = %v", err)
+	}
+	return repo
+}
+
+func TestIndexedRepository_Save(t *testing.T) {
+	movieRepo := newTestLibrary(t)
+	index := newMockIndex()
+	repo := NewIndexedRepository(movieRepo, index)
+
+	thief := newTestMovie(t, "Thief", "Michael Mann", 1981, "Crime", "Drama")
+	if err := repo.Save(context.Background(), thief); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	neighbors := index.lists[thief.ID().Value()]
+	if len(neighbors) != 2 || neighbors[0].MovieID.Value() != 1 || neighbors[1].MovieID.Value() != 2 {
+		t.Errorf("Expected Heat then Collateral, got: %v", neighbors)
+	}
+}
+
+func TestIndexedRepository_SaveIndexFailure(t *testing.T) {
+	index := newMockIndex()
+	index.refreshErr = errors.New("database is locked")
+	repo := NewIndexedRepository(newTestLibrary(t), index)
+
+	err := repo.Save(context.Background(), newTestMovie(t, "Thief", "Michael Mann", 1981))
+	if err == nil {
+		t.Fatal("Expected an error when the index cannot be refreshed")
+	}
+}
+
+func TestIndexedRepository_InsertAll(t *testing.T) {
+	index := newMockIndex()
+	repo := NewIndexedRepository(&MockMovieRepository{}, index)
+
+	movies := []*movie.Movie{
+		newTestMovie(t, "Heat", "Michael Mann", 1995, "Crime", "Drama"),
+		newTestMovie(t, "Thief", "Michael Mann", 1981, "Crime", "Drama"),
+	}
+	if err := repo.InsertAll(context.Background(), movies); err != nil {
+		t.Fatalf("InsertAll() error = %v", err)
+	}
+
+	if len(index.refreshed) != 2 {
+		t.Fatalf("Expected both movies to be refreshed, got: %v", index.refreshed)
+	}
+	if neighbors := index.lists[1]; len(neighbors) != 1 || neighbors[0].MovieID.Value() != 2 {
+		t.Errorf("Expected Heat to be similar to Thief, got: %v", neighbors)
+	}
+}
+
+func TestIndexedRepository_UpdateAll(t *testing.T) {
+	index := newMockIndex()
+	repo := NewIndexedRepository(&MockMovieRepository{}, index)
+	heat := newTestMovie(t, "Heat", "Michael Mann", 1995, "Crime", "Drama")
+	thief := newTestMovie(t, "Thief", "Michael Mann", 1981, "Crime", "Drama")
+	if err := repo.InsertAll(context.Background(), []*movie.Movie{heat, thief}); err != nil {
+		t.Fatalf("InsertAll() error = %v", err)
+	}
+	index.refreshed = nil
+
+	if err := thief.SetRating(7.4); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.UpdateAll(context.Background(), []*movie.Movie{thief}); err != nil {
+		t.Fatalf("UpdateAll() error = %v", err)
+	}
+
+	if len(index.refreshed) != 1 || index.refreshed[0] != thief.ID().Value() {
+		t.Errorf("Expected only Thief to be rescored, got: %v", index.refreshed)
+	}
+}
+
+func TestService_GetSimilarMovies(t *testing.T) {
+	movieRepo := newTestLibrary(t)
+	service := NewService(newMockIndex(), movieRepo)
+	if _, err := service.Rebuild(context.Background()); err != nil {
+		t.Fatalf("Rebuild() error = %v", err)
+	}
+
+	result, err := service.GetSimilarMovies(context.Background(), 1, 10)
+	if err != nil {
+		t.Fatalf("GetSimilarMovies() error = %v", err)
+	}
+	if result.Title != "Heat" || len(result.Similar) != 1 {
+		t.Fatalf("Expected Collateral only, got: %+v", result)
+	}
+	if similar := result.Similar[0]; similar.Title != "Collateral" || similar.Score < similarity.MinScore {
+		t.Errorf("Unexpected similar movie: %+v", similar)
+	}
+
+	result, err = service.GetSimilarMovies(context.Background(), 3, 10)
+	if err != nil {
+		t.Fatalf("GetSimilarMovies() error = %v", err)
+	}
+	if result.Similar == nil || len(result.Similar) != 0 {
+		t.Errorf("Expected an empty list for Barbie, got: %+v", result.Similar)
+	}
+}
+
+func TestService_GetSimilarMovies_Errors(t *testing.T) {
+	service := NewService(newMockIndex(), newTestLibrary(t))
+
+	if _, err := service.GetSimilarMovies(context.Background(), 1, similarity.MaxNeighbors+1); !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("Expected ErrValidation for a limit over the maximum, got: %v", err)
+	}
+	if _, err := service.GetSimilarMovies(context.Background(), 99, 5); !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for an unknown movie, got: %v", err)
+	}
+}
+
+func TestService_EnsureIndexed(t *testing.T) {
+	index := newMockIndex()
+	service := NewService(index, newTestLibrary(t))
+
+	result, err := service.EnsureIndexed(context.Background())
+	if err != nil {
+		t.Fatalf("EnsureIndexed() error = %v", err)
+	}
+	if result == nil || result.Movies != 3 || result.Pairs != 2 {
+		t.Fatalf("Expected a rebuild storing both directions of one pair, got: %+v", result)
+	}
+
+	if result, err = service.EnsureIndexed(context.Background()); err != nil || result != nil {
+		t.Errorf("Expected a built index to be left as is, got: %+v, %v", result, err)
+	}
+}
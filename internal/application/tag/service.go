@@ -0,0 +1,357 @@
+package tag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/domain/tag"
+	"github.com/francknouama/movies-mcp-server/pkg/serialization"
+)
+
+// Service provides application-level tag operations
+type Service struct {
+	tagRepo   tag.Repository
+	movieRepo movie.Reader
+}
+
+// NewService creates a new tag application service
+func NewService(tagRepo tag.Repository, movieRepo movie.Reader) *Service {
+	return &Service{
+		tagRepo:   tagRepo,
+		movieRepo: movieRepo,
+	}
+}
+
+// AddTagCommand represents the command to create a new tag
+type AddTagCommand struct {
+	Name        string
+	Description string
+}
+
+// TagMovieCommand represents the command to attach tags to a movie
+type TagMovieCommand struct {
+	MovieID int
+	Tags    []string // Tag names; tags that do not exist yet are created
+}
+
+// SearchByTagQuery represents a search for movies by their tags
+type SearchByTagQuery struct {
+	Tags     []string
+	MatchAll bool // Movies must carry every tag rather than any of them
+	Limit    int  // 0 returns every match
+}
+
+// TagDTO represents a tag data transfer object
+type TagDTO struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+// MovieTagsDTO lists the tags a movie carries after a change
+type MovieTagsDTO struct {
+	MovieID int      `json:"movie_id"`
+	Title   string   `json:"title"`
+	Tags    []string `json:"tags"`              // By name
+	Created []string `json:"created,omitempty"` // Tags created by the change
+	Removed bool     `json:"removed,omitempty"` // Whether an untag removed a tag the movie carried
+}
+
+// TaggedMovieDTO represents a movie found by its tags
+type TaggedMovieDTO struct {
+	ID       int      `json:"id"`
+	Title    string   `json:"title"`
+	Director string   `json:"director"`
+	Year     int      `json:"year"`
+	Rating   float64  `json:"rating,omitempty"`
+	Tags     []string `json:"tags"`
+}
+
+// TagSearchDTO represents the movies matching a tag search
+type TagSearchDTO struct {
+	Tags    []string          `json:"tags"`              // Normalized names searched for
+	Unknown []string          `json:"unknown,omitempty"` // Searched tags that do not exist
+	Movies  []*TaggedMovieDTO `json:"movies"`            // Best rated first
+	Total   int               `json:"total"`             // Matches before the limit
+}
+
+// TagCountDTO represents how many movies carry a tag
+type TagCountDTO struct {
+	Name   string `json:"name"`
+	Movies int    `json:"movies"`
+}
+
+// AddTag creates a new tag; it fails with shared.ErrConflict if a tag with
+// the same normalized name exists
+func (s *Service) AddTag(ctx context.Context, cmd AddTagCommand) (*TagDTO, error) {
+	domainTag, err := tag.NewTag(cmd.Name, cmd.Description)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tag: %w", err)
+	}
+
+	if _, err := s.tagRepo.FindByName(ctx, domainTag.Name()); err == nil {
+		return nil, shared.NewConflictError("tag %q already exists", domainTag.Name())
+	} else if !errors.Is(err, shared.ErrNotFound) {
+		return nil, fmt.Errorf("failed to find tag: %w", err)
+	}
+
+	if err := s.tagRepo.Save(ctx, domainTag); err != nil {
+		return nil, fmt.Errorf("failed to save tag: %w", err)
+	}
+
+	return s.toDTO(domainTag), nil
+}
+
+// TagMovie attaches tags to a movie, creating tags that do not exist yet.
+// Tags the movie already carries are kept.
+func (s *Service) TagMovie(ctx context.Context, cmd TagMovieCommand) (*MovieTagsDTO, error) {
+	domainMovie, err := s.findMovie(ctx, cmd.MovieID)
+	if err != nil {
+		return nil, err
+	}
+	if len(cmd.Tags) == 0 {
+		return nil, shared.NewValidationError("at least one tag is required")
+	}
+
+	names, err := normalizeNames(cmd.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	tagIDs := make([]shared.TagID, 0, len(names))
+	var created []string
+	for _, name := range names {
+		domainTag, err := s.tagRepo.FindByName(ctx, name)
+		if errors.Is(err, shared.ErrNotFound) {
+			domainTag, err = tag.NewTag(name, "")
+			if err == nil {
+				err = s.tagRepo.Save(ctx, domainTag)
+			}
+			created = append(created, name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to find or create tag %q: %w", name, err)
+		}
+		tagIDs = append(tagIDs, domainTag.ID())
+	}
+
+	if err := s.tagRepo.TagMovie(ctx, domainMovie.ID(), tagIDs); err != nil {
+		return nil, fmt.Errorf("failed to tag movie: %w", err)
+	}
+
+	result, err := s.movieTags(ctx, domainMovie)
+	if err != nil {
+		return nil, err
+	}
+	result.Created = created
+	return result, nil
+}
+
+// UntagMovie detaches a tag from a movie. Removing a tag the movie does not
+// carry succeeds with Removed false; the tag itself is kept for other movies.
+func (s *Service) UntagMovie(ctx context.Context, movieID int, name string) (*MovieTagsDTO, error) {
+	domainMovie, err := s.findMovie(ctx, movieID)
+	if err != nil {
+		return nil, err
+	}
+
+	normalized, err := tag.NormalizeName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	removed := false
+	domainTag, err := s.tagRepo.FindByName(ctx, normalized)
+	switch {
+	case err == nil:
+		removed, err = s.tagRepo.UntagMovie(ctx, domainMovie.ID(), domainTag.ID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to untag movie: %w", err)
+		}
+	case !errors.Is(err, shared.ErrNotFound):
+		return nil, fmt.Errorf("failed to find tag: %w", err)
+	}
+
+	result, err := s.movieTags(ctx, domainMovie)
+	if err != nil {
+		return nil, err
+	}
+	result.Removed = removed
+	return result, nil
+}
+
+// SearchByTag finds the movies carrying any, or with MatchAll every, one of
+// the tags, best rated first. Tags that do not exist match no movies.
+func (s *Service) SearchByTag(ctx context.Context, query SearchByTagQuery) (*TagSearchDTO, error) {
+	if len(query.Tags) == 0 {
+		return nil, shared.NewValidationError("at least one tag is required")
+	}
+	if query.Limit < 0 {
+		return nil, shared.NewValidationError("limit must be non-negative")
+	}
+
+	names, err := normalizeNames(query.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TagSearchDTO{Tags: names, Movies: []*TaggedMovieDTO{}}
+	tagIDs := make([]shared.TagID, 0, len(names))
+	for _, name := range names {
+		domainTag, err := s.tagRepo.FindByName(ctx, name)
+		if errors.Is(err, shared.ErrNotFound) {
+			result.Unknown = append(result.Unknown, name)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to find tag %q: %w", name, err)
+		}
+		tagIDs = append(tagIDs, domainTag.ID())
+	}
+	if len(tagIDs) == 0 || (query.MatchAll && len(result.Unknown) > 0) {
+		return result, nil
+	}
+
+	movieIDs, err := s.tagRepo.FindMovieIDs(ctx, tagIDs, query.MatchAll)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tagged movies: %w", err)
+	}
+	if len(movieIDs) == 0 {
+		return result, nil
+	}
+
+	domainMovies, err := s.movieRepo.FindByCriteria(ctx, movie.SearchCriteria{
+		IDs:      movieIDs,
+		Limit:    len(movieIDs),
+		OrderBy:  movie.OrderByRating,
+		OrderDir: movie.OrderDesc,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tagged movies: %w", err)
+	}
+
+	result.Total = len(domainMovies)
+	if query.Limit > 0 && len(domainMovies) > query.Limit {
+		domainMovies = domainMovies[:query.Limit]
+	}
+	for _, domainMovie := range domainMovies {
+		tags, err := s.tagNames(ctx, domainMovie.ID())
+		if err != nil {
+			return nil, err
+		}
+		result.Movies = append(result.Movies, &TaggedMovieDTO{
+			ID:       domainMovie.ID().Value(),
+			Title:    domainMovie.Title(),
+			Director: domainMovie.Director(),
+			Year:     domainMovie.Year().Value(),
+			Rating:   domainMovie.Rating().Value(),
+			Tags:     tags,
+		})
+	}
+	return result, nil
+}
+
+// AutocompleteTags suggests existing tags starting with prefix, most used
+// first. The prefix is normalized like a tag name, so "Time T" suggests
+// "time-travel"; an empty prefix suggests the most used tags.
+func (s *Service) AutocompleteTags(ctx context.Context, prefix string, limit int) ([]*TagCountDTO, error) {
+	if limit < 0 {
+		return nil, shared.NewValidationError("limit must be non-negative")
+	}
+
+	normalized := ""
+	if prefix != "" {
+		var err error
+		if normalized, err = tag.NormalizeName(prefix); err != nil {
+			return nil, err
+		}
+		// A trailing separator starts the next word, as in "based on "
+		if last := prefix[len(prefix)-1]; last == ' ' || last == '_' || last == '-' {
+			normalized += "-"
+		}
+	}
+
+	counts, err := s.tagRepo.Counts(ctx, normalized, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to autocomplete tags: %w", err)
+	}
+
+	dtos := make([]*TagCountDTO, len(counts))
+	for i, count := range counts {
+		dtos[i] = &TagCountDTO{Name: count.Name, Movies: count.Movies}
+	}
+	return dtos, nil
+}
+
+// movieTags lists the tags a movie carries by name
+func (s *Service) movieTags(ctx context.Context, domainMovie *movie.Movie) (*MovieTagsDTO, error) {
+	tags, err := s.tagNames(ctx, domainMovie.ID())
+	if err != nil {
+		return nil, err
+	}
+	return &MovieTagsDTO{
+		MovieID: domainMovie.ID().Value(),
+		Title:   domainMovie.Title(),
+		Tags:    tags,
+	}, nil
+}
+
+// tagNames returns the names of the tags a movie carries
+func (s *Service) tagNames(ctx context.Context, movieID shared.MovieID) ([]string, error) {
+	domainTags, err := s.tagRepo.FindByMovieID(ctx, movieID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get movie tags: %w", err)
+	}
+
+	names := make([]string, len(domainTags))
+	for i, domainTag := range domainTags {
+		names[i] = domainTag.Name()
+	}
+	return names, nil
+}
+
+func (s *Service) findMovie(ctx context.Context, id int) (*movie.Movie, error) {
+	movieID, err := shared.NewMovieID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid movie ID: %w", err)
+	}
+
+	domainMovie, err := s.movieRepo.FindByID(ctx, movieID)
+	if err != nil {
+		return nil, fmt.Errorf("movie %d not found: %w", id, err)
+	}
+	return domainMovie, nil
+}
+
+// normalizeNames normalizes tag names, dropping repeats
+func normalizeNames(names []string) ([]string, error) {
+	seen := make(map[string]bool, len(names))
+	normalized := make([]string, 0, len(names))
+	for _, name := range names {
+		n, err := tag.NormalizeName(name)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[n] {
+			seen[n] = true
+			normalized = append(normalized, n)
+		}
+	}
+	return normalized, nil
+}
+
+// toDTO converts a domain tag to a DTO
+func (s *Service) toDTO(domainTag *tag.Tag) *TagDTO {
+	return &TagDTO{
+		ID:          domainTag.ID().Value(),
+		Name:        domainTag.Name(),
+		Description: domainTag.Description(),
+		CreatedAt:   serialization.Timestamp(domainTag.CreatedAt()),
+		UpdatedAt:   serialization.Timestamp(domainTag.UpdatedAt()),
+	}
+}
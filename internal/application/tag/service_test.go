@@ -0,0 +1,367 @@
+package tag
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/domain/tag"
+)
+
+// MockMovieReader implements the parts of movie.Reader the service uses
+type MockMovieReader struct {
+	movie.Reader
+	movies map[int]*movie.Movie
+}
+
+func (m *MockMovieReader) FindByID(ctx context.Context, id shared.MovieID) (*movie.Movie, error) {
+	if found, exists := m.movies[id.Value()]; exists {
+		return found, nil
+	}
+	return nil, shared.NewNotFoundError("movie not found")
+}
+
+func (m *MockMovieReader) FindByCriteria(ctx context.Context, criteria movie.SearchCriteria) ([]*movie.Movie, error) {
+	var result []*movie.Movie
+	for _, id := range criteria.IDs {
+		if found, exists := m.movies[id.Value()]; exists {
+			result = append(result, found)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Rating().Value() > result[j].Rating().Value()
+	})
+	return result, nil
+}
+
+// MockTagRepository implements tag.Repository for testing
+type MockTagRepository struct {
+	tags      map[string]*tag.Tag
+	movieTags map[int]map[int]bool // Movie ID to tag IDs
+	nextID    int
+}
+
+func NewMockTagRepository() *MockTagRepository {
+	return &MockTagRepository{
+		tags:      make(map[string]*tag.Tag),
+		movieTags: make(map[int]map[int]bool),
+		nextID:    1,
+	}
+}
+
+func (m *MockTagRepository) FindByName(ctx context.Context, name string) (*tag.Tag, error) {
+	if found, exists := m.tags[name]; exists {
+		return found, nil
+	}
+	return nil, shared.NewNotFoundError("tag not found")
+}
+
+func (m *MockTagRepository) FindByMovieID(ctx context.Context, movieID shared.MovieID) ([]*tag.Tag, error) {
+	result := []*tag.Tag{}
+	for _, found := range m.tags {
+		if m.movieTags[movieID.Value()][found.ID().Value()] {
+			result = append(result, found)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name() < result[j].Name() })
+	return result, nil
+}
+
+func (m *MockTagRepository) FindMovieIDs(ctx context.Context, tagIDs []shared.TagID, matchAll bool) ([]shared.MovieID, error) {
+	result := []shared.MovieID{}
+	for id := 1; id <= 10; id++ {
+		matched := 0
+		for _, tagID := range tagIDs {
+			if m.movieTags[id][tagID.Value()] {
+				matched++
+			}
+		}
+		if (matchAll && matched == len(tagIDs)) || (!matchAll && matched > 0) {
+			movieID, _ := shared.NewMovieID(id)
+			result = append(result, movieID)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockTagRepository) Save(ctx context.Context, t *tag.Tag) error {
+	if t.ID().IsZero() {
+		id, _ := shared.NewTagID(m.nextID)
+		m.nextID++
+		t.SetID(id)
+	}
+	m.tags[t.Name()] = t
+	return nil
+}
+
+func (m *MockTagRepository) TagMovie(ctx context.Context, movieID shared.MovieID, tagIDs []shared.TagID) error {
+	if m.movieTags[movieID.Value()] == nil {
+		m.movieTags[movieID.Value()] = make(map[int]bool)
+	}
+	for _, tagID := range tagIDs {
+		m.movieTags[movieID.Value()][tagID.Value()] = true
+	}
+	return nil
+}
+
+func (m *MockTagRepository) UntagMovie(ctx context.Context, movieID shared.MovieID, tagID shared.TagID) (bool, error) {
+	if !m.movieTags[movieID.Value()][tagID.Value()] {
+		return false, nil
+	}
+	delete(m.movieTags[movieID.Value()], tagID.Value())
+	return true, nil
+}
+
+func (m *MockTagRepository) Counts(ctx context.Context, prefix string, limit int) ([]tag.Count, error) {
+	var counts []tag.Count
+	for _, found := range m.tags {
+		if !strings.HasPrefix(found.Name(), prefix) {
+			continue
+		}
+		count := tag.Count{Name: found.Name()}
+		for _, tagIDs := range m.movieTags {
+			if tagIDs[found.ID().Value()] {
+				count.Movies++
+			}
+		}
+		counts = append(counts, count)
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Movies != counts[j].Movies {
+			return counts[i].Movies > counts[j].Movies
+		}
+		return counts[i].Name < counts[j].Name
+	})
+	if limit > 0 && len(counts) > limit {
+		counts = counts[:limit]
+	}
+	return counts, nil
+}
+
+func newTestService(t *testing.T) (*Service, *MockTagRepository) {
+	t.Helper()
+
+	movies := make(map[int]*movie.Movie)
+	for i, spec := range []struct {
+		title  string
+		rating float64
+	}{
+		{"Back to the Future", 8.5},
+		{"Looper", 7.4},
+		{"Jurassic Park", 8.2},
+	} {
+		id, _ := shared.NewMovieID(i + 1)
+		domainMovie, err := movie.NewMovieWithID(id, spec.title, "Director", 1990)
+		if err != nil {
+			t.Fatalf("failed to create movie: %v", err)
+		}
+		if err := domainMovie.SetRating(spec.rating); err != nil {
+			t.Fatalf("failed to set rating: %v", err)
+		}
+		movies[i+1] = domainMovie
+	}
+
+	repo := NewMockTagRepository()
+	return NewService(repo, &MockMovieReader{movies: movies}), repo
+}
+
+func TestService_AddTag(t *testing.T) {
+	service, _ := newTestService(t)
+	ctx := context.Background()
+
+	dto, err := service.AddTag(ctx, AddTagCommand{Name: "Oscar Winner", Description: "Won an Academy Award"})
+	if err != nil {
+		t.Fatalf("AddTag() error = %v", err)
+	}
+	if dto.ID == 0 || dto.Name != "oscar-winner" || dto.Description != "Won an Academy Award" {
+		t.Errorf("Expected a normalized saved tag, got: %+v", dto)
+	}
+
+	if _, err := service.AddTag(ctx, AddTagCommand{Name: "oscar_winner"}); !errors.Is(err, shared.ErrConflict) {
+		t.Errorf("Expected ErrConflict for a duplicate tag, got: %v", err)
+	}
+	if _, err := service.AddTag(ctx, AddTagCommand{Name: "oscar!"}); !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("Expected ErrValidation for an invalid name, got: %v", err)
+	}
+}
+
+func TestService_TagMovie(t *testing.T) {
+	service, _ := newTestService(t)
+	ctx := context.Background()
+
+	if _, err := service.AddTag(ctx, AddTagCommand{Name: "time-travel"}); err != nil {
+		t.Fatalf("AddTag() error = %v", err)
+	}
+
+	dto, err := service.TagMovie(ctx, TagMovieCommand{MovieID: 1, Tags: []string{"Time Travel", "cult classic", "time-travel"}})
+	if err != nil {
+		t.Fatalf("TagMovie() error = %v", err)
+	}
+	if dto.Title != "Back to the Future" || !reflect.DeepEqual(dto.Tags, []string{"cult-classic", "time-travel"}) {
+		t.Errorf("Expected both tags on the movie, got: %+v", dto)
+	}
+	if !reflect.DeepEqual(dto.Created, []string{"cult-classic"}) {
+		t.Errorf("Expected only cult-classic to be created, got: %v", dto.Created)
+	}
+
+	if _, err := service.TagMovie(ctx, TagMovieCommand{MovieID: 99, Tags: []string{"heist"}}); !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for a missing movie, got: %v", err)
+	}
+	if _, err := service.TagMovie(ctx, TagMovieCommand{MovieID: 1}); !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("Expected ErrValidation without tags, got: %v", err)
+	}
+}
+
+func TestService_UntagMovie(t *testing.T) {
+	service, repo := newTestService(t)
+	ctx := context.Background()
+
+	if _, err := service.TagMovie(ctx, TagMovieCommand{MovieID: 2, Tags: []string{"time-travel", "hitman"}}); err != nil {
+		t.Fatalf("TagMovie() error = %v", err)
+	}
+
+	dto, err := service.UntagMovie(ctx, 2, "Hitman")
+	if err != nil {
+		t.Fatalf("UntagMovie() error = %v", err)
+	}
+	if !dto.Removed || !reflect.DeepEqual(dto.Tags, []string{"time-travel"}) {
+		t.Errorf("Expected hitman to be removed, got: %+v", dto)
+	}
+	if _, exists := repo.tags["hitman"]; !exists {
+		t.Error("Expected the tag itself to be kept")
+	}
+
+	for _, name := range []string{"hitman", "never-used"} {
+		dto, err := service.UntagMovie(ctx, 2, name)
+		if err != nil || dto.Removed {
+			t.Errorf("UntagMovie(%q) = %+v, %v; want not removed", name, dto, err)
+		}
+	}
+}
+
+func TestService_SearchByTag(t *testing.T) {
+	service, _ := newTestService(t)
+	ctx := context.Background()
+
+	_, _ = service.TagMovie(ctx, TagMovieCommand{MovieID: 1, Tags: []string{"time-travel"}})
+	_, _ = service.TagMovie(ctx, TagMovieCommand{MovieID: 2, Tags: []string{"time-travel", "dystopia"}})
+	_, _ = service.TagMovie(ctx, TagMovieCommand{MovieID: 3, Tags: []string{"dinosaurs"}})
+
+	tests := []struct {
+		name        string
+		query       SearchByTagQuery
+		wantTitles  []string
+		wantTotal   int
+		wantUnknown []string
+	}{
+		{
+			name:       "single tag, best rated first",
+			query:      SearchByTagQuery{Tags: []string{"Time Travel"}},
+			wantTitles: []string{"Back to the Future", "Looper"},
+			wantTotal:  2,
+		},
+		{
+			name:       "match any",
+			query:      SearchByTagQuery{Tags: []string{"dystopia", "dinosaurs"}},
+			wantTitles: []string{"Jurassic Park", "Looper"},
+			wantTotal:  2,
+		},
+		{
+			name:       "match all",
+			query:      SearchByTagQuery{Tags: []string{"time-travel", "dystopia"}, MatchAll: true},
+			wantTitles: []string{"Looper"},
+			wantTotal:  1,
+		},
+		{
+			name:       "limit",
+			query:      SearchByTagQuery{Tags: []string{"time-travel"}, Limit: 1},
+			wantTitles: []string{"Back to the Future"},
+			wantTotal:  2,
+		},
+		{
+			name:        "unknown tag with match any",
+			query:       SearchByTagQuery{Tags: []string{"dinosaurs", "heist"}},
+			wantTitles:  []string{"Jurassic Park"},
+			wantTotal:   1,
+			wantUnknown: []string{"heist"},
+		},
+		{
+			name:        "unknown tag with match all",
+			query:       SearchByTagQuery{Tags: []string{"dinosaurs", "heist"}, MatchAll: true},
+			wantTitles:  []string{},
+			wantUnknown: []string{"heist"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.SearchByTag(ctx, tt.query)
+			if err != nil {
+				t.Fatalf("SearchByTag() error = %v", err)
+			}
+			titles := make([]string, len(result.Movies))
+			for i, found := range result.Movies {
+				titles[i] = found.Title
+			}
+			if !reflect.DeepEqual(titles, tt.wantTitles) || result.Total != tt.wantTotal {
+				t.Errorf("Expected %v (total %d), got: %v (total %d)", tt.wantTitles, tt.wantTotal, titles, result.Total)
+			}
+			if !reflect.DeepEqual(result.Unknown, tt.wantUnknown) {
+				t.Errorf("Expected unknown tags %v, got: %v", tt.wantUnknown, result.Unknown)
+			}
+		})
+	}
+
+	result, _ := service.SearchByTag(ctx, SearchByTagQuery{Tags: []string{"dystopia"}})
+	if !reflect.DeepEqual(result.Movies[0].Tags, []string{"dystopia", "time-travel"}) {
+		t.Errorf("Expected every tag of the movie, got: %v", result.Movies[0].Tags)
+	}
+
+	if _, err := service.SearchByTag(ctx, SearchByTagQuery{}); !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("Expected ErrValidation without tags, got: %v", err)
+	}
+}
+
+func TestService_AutocompleteTags(t *testing.T) {
+	service, _ := newTestService(t)
+	ctx := context.Background()
+
+	_, _ = service.TagMovie(ctx, TagMovieCommand{MovieID: 1, Tags: []string{"based-on-book", "time-travel"}})
+	_, _ = service.TagMovie(ctx, TagMovieCommand{MovieID: 2, Tags: []string{"time-travel", "time-loop"}})
+	_, _ = service.AddTag(ctx, AddTagCommand{Name: "based-on-comic"})
+	_, _ = service.AddTag(ctx, AddTagCommand{Name: "basement"})
+
+	tests := []struct {
+		name   string
+		prefix string
+		limit  int
+		want   []string
+	}{
+		{name: "most used first", prefix: "time", want: []string{"time-travel", "time-loop"}},
+		{name: "normalized prefix", prefix: "Time T", want: []string{"time-travel"}},
+		{name: "trailing separator starts a word", prefix: "based on ", want: []string{"based-on-book", "based-on-comic"}},
+		{name: "word prefix", prefix: "base", want: []string{"based-on-book", "based-on-comic", "basement"}},
+		{name: "empty prefix with limit", limit: 1, want: []string{"time-travel"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			suggestions, err := service.AutocompleteTags(ctx, tt.prefix, tt.limit)
+			if err != nil {
+				t.Fatalf("AutocompleteTags() error = %v", err)
+			}
+			names := make([]string, len(suggestions))
+			for i, suggestion := range suggestions {
+				names[i] = suggestion.Name
+			}
+			if !reflect.DeepEqual(names, tt.want) {
+				t.Errorf("Expected %v, got: %v", tt.want, names)
+			}
+		})
+	}
+}
@@ -0,0 +1,581 @@
+// Package transfer exports and imports movies as NDJSON (JSON Lines) files in
+// batches. After each batch a checkpoint file records how far the transfer
+// got and the SHA-256 of the batch, so an interrupted transfer resumes after
+// its last committed batch and an import can verify every batch it reads.
+package transfer
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// FormatVersion is the checkpoint layout version
+const FormatVersion = 1
+
+// DefaultBatchSize is the number of movies per batch when none is given
+const DefaultBatchSize = 500
+
+// MaxBatchSize is the largest batch a transfer accepts
+const MaxBatchSize = 5000
+
+// maxLineErrors caps the failed lines an import reports in one call
+const maxLineErrors = 100
+
+// Suffixes of the checkpoint files kept next to a transfer's NDJSON file
+const (
+	exportCheckpointSuffix = ".export-checkpoint.json"
+	importCheckpointSuffix = ".import-checkpoint.json"
+)
+
+// MovieService defines the movie operations transfers depend on
+type MovieService interface {
+	SearchMovies(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error)
+	CreateMovies(ctx context.Context, cmds []movieApp.CreateMovieCommand) ([]*movieApp.MovieDTO, []error, error)
+}
+
+// Record is one line of an export: a movie with the fields an import sets.
+// ID is informational; imported movies are given new IDs.
+type Record struct {
+	ID              int               `json:"id,omitempty"`
+	Title           string            `json:"title"`
+	Director        string            `json:"director"`
+	Year            int               `json:"year,omitempty"`
+	ReleaseDate     string            `json:"release_date,omitempty"`
+	Rating          float64           `json:"rating,omitempty"`
+	Duration        int               `json:"duration,omitempty"`
+	Genres          []string          `json:"genres,omitempty"`
+	PosterURL       string            `json:"poster_url,omitempty"`
+	Certifications  map[string]string `json:"certifications,omitempty"`
+	ContentWarnings []string          `json:"content_warnings,omitempty"`
+}
+
+// Batch is a committed run of lines of an NDJSON file
+type Batch struct {
+	Lines  int    `json:"lines"`
+	Bytes  int64  `json:"bytes"`
+	SHA256 string `json:"sha256"`
+}
+
+// Checkpoint records how far a transfer of a file got. An export's
+// checkpoint doubles as the list of batch checksums an import verifies.
+type Checkpoint struct {
+	FormatVersion int       `json:"format_version"`
+	UpdatedAt     time.Time `json:"updated_at"`
+	Complete      bool      `json:"complete"`
+	Lines         int       `json:"lines"`
+	Bytes         int64     `json:"bytes"` // Offset in the file after the last committed batch
+	Batches       []Batch   `json:"batches"`
+
+	AfterID  int `json:"after_id,omitempty"` // Export: ID of the last movie written
+	Imported int `json:"imported,omitempty"` // Import: movies created so far
+	Failed   int `json:"failed,omitempty"`   // Import: lines rejected so far
+}
+
+// LineError is a line an import could not create a movie from
+type LineError struct {
+	Line  int
+	Title string
+	Error string
+}
+
+// Result reports a transfer call
+type Result struct {
+	Path           string
+	CheckpointPath string
+	Checkpoint     *Checkpoint
+	Resumed        bool
+	NewBatches     int         // Batches committed by this call
+	Verified       int         // Batches checked against a recorded checksum
+	Errors         []LineError // Import: the first failed lines of this call
+}
+
+// ExportCommand asks for the library to be written to Path
+type ExportCommand struct {
+	Path      string
+	BatchSize int  // Movies per batch; zero uses DefaultBatchSize
+	Resume    bool // Continue after the last batch of the checkpoint
+}
+
+// ImportCommand asks for the movies in Path to be created
+type ImportCommand struct {
+	Path       string
+	BatchSize  int  // Lines per batch when the file has no export checkpoint
+	Resume     bool // Continue after the last batch of the checkpoint
+	Validation shared.ValidationPolicy
+}
+
+// PathResolver maps a file name given in a command to the path the service
+// reads or writes for the tenant ctx is served for, rejecting names it must
+// not touch
+type PathResolver func(ctx context.Context, name string) (string, error)
+
+// Service exports and imports movies through the movie service
+type Service struct {
+	movieService MovieService
+	resolve      PathResolver
+}
+
+// NewService creates a new transfer service
+func NewService(movieService MovieService) *Service {
+	return &Service{
+		movieService: movieService,
+	}
+}
+
+// SetPathResolver resolves every file a transfer touches, the NDJSON file
+// and its checkpoints, through resolve; without one, paths are used as given
+func (s *Service) SetPathResolver(resolve PathResolver) {
+	s.resolve = resolve
+}
+
+// resolvePath returns the path of the file name names
+func (s *Service) resolvePath(ctx context.Context, name string) (string, error) {
+	if s.resolve == nil {
+		return filepath.Clean(name), nil
+	}
+	return s.resolve(ctx, name)
+}
+
+// resolvePaths returns the paths of the NDJSON file name names and of its
+// checkpoint with suffix
+func (s *Service) resolvePaths(ctx context.Context, name, suffix string) (string, string, error) {
+	path, err := s.resolvePath(ctx, name)
+	if err != nil {
+		return "", "", err
+	}
+	checkpointPath, err := s.resolvePath(ctx, name+suffix)
+	if err != nil {
+		return "", "", err
+	}
+	return path, checkpointPath, nil
+}
+
+// Export writes every movie, in ID order, to the NDJSON file at Path. A
+// resumed export checks the batches already written against the checkpoint,
+// drops anything written after the last of them and carries on from there.
+// Movies are paged by ID, so movies deleted meanwhile never shift the batches.
+func (s *Service) Export(ctx context.Context, cmd ExportCommand) (*Result, error) {
+	batchSize, err := batchSize(cmd.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	path, checkpointPath, err := s.resolvePaths(ctx, cmd.Path, exportCheckpointSuffix)
+	if err != nil {
+		return nil, err
+	}
+	result := &Result{Path: path, CheckpointPath: checkpointPath, Resumed: cmd.Resume, Errors: []LineError{}}
+
+	var file *os.File
+	if cmd.Resume {
+		if result.Checkpoint, err = loadCheckpoint(result.CheckpointPath); err != nil {
+			return nil, err
+		}
+		if result.Checkpoint.Complete {
+			return result, nil
+		}
+		if file, err = os.OpenFile(path, os.O_RDWR, 0); err != nil {
+			return nil, fmt.Errorf("failed to open export file: %w", err)
+		}
+		defer file.Close()
+		if result.Verified, err = verifyBatches(file, result.Checkpoint.Batches); err != nil {
+			return nil, err
+		}
+		if err := file.Truncate(result.Checkpoint.Bytes); err != nil {
+			return nil, fmt.Errorf("failed to truncate export file: %w", err)
+		}
+		if _, err := file.Seek(result.Checkpoint.Bytes, io.SeekStart); err != nil {
+			return nil, fmt.Errorf("failed to seek export file: %w", err)
+		}
+	} else {
+		if file, err = os.Create(path); err != nil {
+			return nil, fmt.Errorf("failed to create export file: %w", err)
+		}
+		defer file.Close()
+		// Replaces the checkpoint of an earlier export of the same path
+		result.Checkpoint = newCheckpoint()
+		if err := saveCheckpoint(result.CheckpointPath, result.Checkpoint); err != nil {
+			return nil, err
+		}
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("export stopped after %d movies, resume to continue: %w", result.Checkpoint.Lines, err)
+		}
+
+		movies, err := s.movieService.SearchMovies(ctx, movieApp.SearchMoviesQuery{
+			AfterID: result.Checkpoint.AfterID,
+			Limit:   batchSize,
+			Sort:    []movieApp.SortKey{{Field: "id"}},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read movies: %w", err)
+		}
+		if len(movies) == 0 {
+			break
+		}
+
+		var data bytes.Buffer
+		encoder := json.NewEncoder(&data)
+		for _, movie := range movies {
+			if err := encoder.Encode(newRecord(movie)); err != nil {
+				return nil, fmt.Errorf("failed to encode movie %d: %w", movie.ID, err)
+			}
+		}
+		if _, err := file.Write(data.Bytes()); err != nil {
+			return nil, fmt.Errorf("failed to write export file: %w", err)
+		}
+		if err := file.Sync(); err != nil {
+			return nil, fmt.Errorf("failed to write export file: %w", err)
+		}
+
+		result.Checkpoint.AfterID = movies[len(movies)-1].ID
+		result.Checkpoint.commit(len(movies), data.Bytes())
+		if err := saveCheckpoint(result.CheckpointPath, result.Checkpoint); err != nil {
+			return nil, err
+		}
+		result.NewBatches++
+
+		if len(movies) < batchSize {
+			break
+		}
+	}
+
+	result.Checkpoint.Complete = true
+	if err := saveCheckpoint(result.CheckpointPath, result.Checkpoint); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Import creates a movie from every line of the NDJSON file at Path, one
+// batch at a time. When the file has a complete export checkpoint, batches
+// follow the export's and each is checked against its checksum before any
+// of it is imported. A resumed import first checks that the lines it already
+// imported are unchanged.
+func (s *Service) Import(ctx context.Context, cmd ImportCommand) (*Result, error) {
+	batchSize, err := batchSize(cmd.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	path, checkpointPath, err := s.resolvePaths(ctx, cmd.Path, importCheckpointSuffix)
+	if err != nil {
+		return nil, err
+	}
+	result := &Result{Path: path, CheckpointPath: checkpointPath, Resumed: cmd.Resume, Errors: []LineError{}}
+
+	var manifest []Batch
+	if exportPath, err := s.resolvePath(ctx, cmd.Path+exportCheckpointSuffix); err == nil {
+		if export, err := loadCheckpoint(exportPath); err == nil && export.Complete {
+			manifest = export.Batches
+		}
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open import file: %w", err)
+	}
+	defer file.Close()
+
+	if cmd.Resume {
+		if result.Checkpoint, err = loadCheckpoint(result.CheckpointPath); err != nil {
+			return nil, err
+		}
+		if result.Checkpoint.Complete {
+			return result, nil
+		}
+		if result.Verified, err = verifyBatches(file, result.Checkpoint.Batches); err != nil {
+			return nil, err
+		}
+		if _, err := file.Seek(result.Checkpoint.Bytes, io.SeekStart); err != nil {
+			return nil, fmt.Errorf("failed to seek import file: %w", err)
+		}
+	} else {
+		result.Checkpoint = newCheckpoint()
+	}
+
+	reader := bufio.NewReader(file)
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("import stopped after %d lines, resume to continue: %w", result.Checkpoint.Lines, err)
+		}
+
+		size := batchSize
+		var expected *Batch
+		if manifest != nil {
+			if len(result.Checkpoint.Batches) == len(manifest) {
+				if _, err := reader.Peek(1); err != io.EOF {
+					return nil, shared.NewConflictError("%s has more lines than its export checkpoint lists", path)
+				}
+				break
+			}
+			expected = &manifest[len(result.Checkpoint.Batches)]
+			size = expected.Lines
+		}
+
+		lines, data, err := readLines(reader, size)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read import file: %w", err)
+		}
+		if len(lines) == 0 {
+			if expected != nil {
+				return nil, shared.NewConflictError("%s ends before batch %d of its export checkpoint", path, len(result.Checkpoint.Batches)+1)
+			}
+			break
+		}
+		if expected != nil {
+			if checksum(data) != expected.SHA256 || int64(len(data)) != expected.Bytes {
+				return nil, shared.NewConflictError("batch %d of %s does not match its checksum", len(result.Checkpoint.Batches)+1, path)
+			}
+			result.Verified++
+		}
+
+		if err := s.importBatch(ctx, cmd.Validation, lines, result); err != nil {
+			return nil, err
+		}
+		result.Checkpoint.commit(len(lines), data)
+		if err := saveCheckpoint(result.CheckpointPath, result.Checkpoint); err != nil {
+			return nil, err
+		}
+		result.NewBatches++
+
+		if expected == nil && len(lines) < size {
+			break
+		}
+	}
+
+	result.Checkpoint.Complete = true
+	if err := saveCheckpoint(result.CheckpointPath, result.Checkpoint); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// importBatch creates the movies of a batch's lines in one insert, counting
+// the lines that fail to parse or validate
+func (s *Service) importBatch(ctx context.Context, policy shared.ValidationPolicy, lines [][]byte, result *Result) error {
+	first := result.Checkpoint.Lines + 1
+	cmds := make([]movieApp.CreateMovieCommand, 0, len(lines))
+	numbers := make([]int, 0, len(lines))
+	titles := make([]string, 0, len(lines))
+	for i, line := range lines {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var record Record
+		if err := json.Unmarshal(line, &record); err != nil {
+			result.fail(first+i, "", fmt.Sprintf("invalid JSON: %v", err))
+			continue
+		}
+		cmd, err := record.command(policy)
+		if err != nil {
+			result.fail(first+i, record.Title, err.Error())
+			continue
+		}
+		cmds = append(cmds, cmd)
+		numbers = append(numbers, first+i)
+		titles = append(titles, record.Title)
+	}
+	if len(cmds) == 0 {
+		return nil
+	}
+
+	_, createErrs, err := s.movieService.CreateMovies(ctx, cmds)
+	if err != nil {
+		return fmt.Errorf("failed to import lines %d-%d, resume to retry them: %w", first, first+len(lines)-1, err)
+	}
+	for i, createErr := range createErrs {
+		if createErr != nil {
+			result.fail(numbers[i], titles[i], createErr.Error())
+			continue
+		}
+		result.Checkpoint.Imported++
+	}
+	return nil
+}
+
+// fail counts a failed line, keeping the first few for the caller
+func (r *Result) fail(line int, title, message string) {
+	r.Checkpoint.Failed++
+	if len(r.Errors) < maxLineErrors {
+		r.Errors = append(r.Errors, LineError{Line: line, Title: title, Error: message})
+	}
+}
+
+// commit records a batch of lines as done
+func (c *Checkpoint) commit(lines int, data []byte) {
+	c.Batches = append(c.Batches, Batch{Lines: lines, Bytes: int64(len(data)), SHA256: checksum(data)})
+	c.Lines += lines
+	c.Bytes += int64(len(data))
+}
+
+// newRecord converts a movie to its export line
+func newRecord(movie *movieApp.MovieDTO) Record {
+	return Record{
+		ID:              movie.ID,
+		Title:           movie.Title,
+		Director:        movie.Director,
+		Year:            movie.Year,
+		ReleaseDate:     movie.ReleaseDate,
+		Rating:          movie.Rating,
+		Duration:        movie.Duration,
+		Genres:          movie.Genres,
+		PosterURL:       movie.PosterURL,
+		Certifications:  movie.Certifications,
+		ContentWarnings: movie.ContentWarnings,
+	}
+}
+
+// command converts a line to a create command validated under policy
+func (r Record) command(policy shared.ValidationPolicy) (movieApp.CreateMovieCommand, error) {
+	var released time.Time
+	if r.ReleaseDate != "" {
+		var err error
+		if released, err = time.Parse(time.DateOnly, r.ReleaseDate); err != nil {
+			return movieApp.CreateMovieCommand{}, shared.NewValidationError("release_date must be a YYYY-MM-DD date, got %q", r.ReleaseDate)
+		}
+	}
+	return movieApp.CreateMovieCommand{
+		Title:       r.Title,
+		Director:    r.Director,
+		Year:        r.Year,
+		ReleaseDate: released,
+		Rating:      r.Rating,
+		Duration:    r.Duration,
+		Genres:      r.Genres,
+		PosterURL:   r.PosterURL,
+
+		Certifications:  r.Certifications,
+		ContentWarnings: r.ContentWarnings,
+
+		Validation: policy,
+	}, nil
+}
+
+// batchSize returns the batch size asked for, or the default
+func batchSize(size int) (int, error) {
+	if size < 0 || size > MaxBatchSize {
+		return 0, shared.NewValidationError("batch_size must be between 1 and %d", MaxBatchSize)
+	}
+	if size == 0 {
+		return DefaultBatchSize, nil
+	}
+	return size, nil
+}
+
+// readLines reads up to n lines, returning each line and the bytes they
+// span; a last line without a newline still counts
+func readLines(reader *bufio.Reader, n int) ([][]byte, []byte, error) {
+	var lines [][]byte
+	var data []byte
+	for len(lines) < n {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			lines = append(lines, line)
+			data = append(data, line...)
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return lines, data, nil
+}
+
+// verifyBatches checks the start of file against the checksums of the
+// batches already transferred, returning how many were checked
+func verifyBatches(file *os.File, batches []Batch) (int, error) {
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return 0, fmt.Errorf("failed to seek %s: %w", file.Name(), err)
+	}
+	for i, batch := range batches {
+		hash := sha256.New()
+		if _, err := io.CopyN(hash, file, batch.Bytes); err != nil {
+			return i, shared.NewConflictError("%s is shorter than its checkpoint; start the transfer over", file.Name())
+		}
+		if hex.EncodeToString(hash.Sum(nil)) != batch.SHA256 {
+			return i, shared.NewConflictError("batch %d of %s changed since the checkpoint; start the transfer over", i+1, file.Name())
+		}
+	}
+	return len(batches), nil
+}
+
+// checksum returns the hex SHA-256 of data
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// newCheckpoint creates the checkpoint of a transfer that has not started
+func newCheckpoint() *Checkpoint {
+	return &Checkpoint{FormatVersion: FormatVersion, Batches: []Batch{}}
+}
+
+// loadCheckpoint reads the checkpoint at path
+func loadCheckpoint(path string) (*Checkpoint, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, shared.NewNotFoundError("no checkpoint to resume from at %s", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+
+	var checkpoint Checkpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		return nil, fmt.Errorf("failed to parse checkpoint %s: %w", path, err)
+	}
+	if checkpoint.FormatVersion != FormatVersion {
+		return nil, shared.NewValidationError("checkpoint %s has format version %d, expected %d", path, checkpoint.FormatVersion, FormatVersion)
+	}
+	return &checkpoint, nil
+}
+
+// saveCheckpoint writes a checkpoint through a temporary file, so a crash
+// leaves either the previous checkpoint or the new one. The temporary file
+// is created exclusively, so a file or symlink already in its place is never
+// written through.
+func saveCheckpoint(path string, checkpoint *Checkpoint) error {
+	checkpoint.UpdatedAt = time.Now().UTC()
+	data, err := json.MarshalIndent(checkpoint, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode checkpoint: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+	tmpPath := tmp.Name()
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmpPath, 0o644)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to finalize checkpoint: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,291 @@
+package transfer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// memoryMovieService is an in-memory MovieService paging by ID like the repository
+type memoryMovieService struct {
+	movies  []*movieApp.MovieDTO
+	inserts int
+}
+
+func (m *memoryMovieService) SearchMovies(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+	var matches []*movieApp.MovieDTO
+	for _, movie := range m.movies {
+		if movie.ID > query.AfterID && len(matches) < query.Limit {
+			matches = append(matches, movie)
+		}
+	}
+	return matches, nil
+}
+
+func (m *memoryMovieService) CreateMovies(ctx context.Context, cmds []movieApp.CreateMovieCommand) ([]*movieApp.MovieDTO, []error, error) {
+	m.inserts++
+	movies := make([]*movieApp.MovieDTO, len(cmds))
+	errs := make([]error, len(cmds))
+	for i, cmd := range cmds {
+		if cmd.Title == "" {
+			errs[i] = shared.NewValidationError("title is required")
+			continue
+		}
+		movies[i] = &movieApp.MovieDTO{ID: len(m.movies) + 1, Title: cmd.Title, Director: cmd.Director, Year: cmd.Year}
+		m.movies = append(m.movies, movies[i])
+	}
+	return movies, errs, nil
+}
+
+// library returns a service holding n movies
+func library(n int) *memoryMovieService {
+	service := &memoryMovieService{}
+	for i := 1; i <= n; i++ {
+		service.movies = append(service.movies, &movieApp.MovieDTO{ID: i * 2, Title: "Movie " + string(rune('A'+i-1)), Director: "D", Year: 2000 + i})
+	}
+	return service
+}
+
+func TestExport_WritesBatchesAndCheckpoint(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "movies.ndjson")
+	result, err := NewService(library(5)).Export(context.Background(), ExportCommand{Path: path, BatchSize: 2})
+	if err != nil {
+		t.Fatalf("Expected the export to succeed, got %v", err)
+	}
+
+	checkpoint := result.Checkpoint
+	if !checkpoint.Complete || checkpoint.Lines != 5 || len(checkpoint.Batches) != 3 || checkpoint.AfterID != 10 {
+		t.Errorf("Expected 5 movies in 3 batches, got %+v", checkpoint)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read export: %v", err)
+	}
+	if lines := strings.Count(string(data), "\n"); lines != 5 || int64(len(data)) != checkpoint.Bytes {
+		t.Errorf("Expected 5 lines in %d bytes, got %d lines in %d", checkpoint.Bytes, lines, len(data))
+	}
+	if !strings.HasPrefix(string(data), `{"id":2,"title":"Movie A","director":"D","year":2001}`) {
+		t.Errorf("Unexpected first line %q", strings.SplitN(string(data), "\n", 2)[0])
+	}
+
+	saved, err := loadCheckpoint(result.CheckpointPath)
+	if err != nil || !saved.Complete || len(saved.Batches) != 3 {
+		t.Errorf("Expected the complete checkpoint saved, got %+v, %v", saved, err)
+	}
+}
+
+func TestExport_ResumesAfterLastBatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "movies.ndjson")
+	service := NewService(library(5))
+	if _, err := service.Export(context.Background(), ExportCommand{Path: path, BatchSize: 2}); err != nil {
+		t.Fatalf("Expected the export to succeed, got %v", err)
+	}
+	want, _ := os.ReadFile(path)
+
+	// Interrupt after the first batch, with half a line written past it
+	checkpoint, _ := loadCheckpoint(path + exportCheckpointSuffix)
+	checkpoint.Complete = false
+	checkpoint.AfterID = 4
+	checkpoint.Lines = checkpoint.Batches[0].Lines
+	checkpoint.Bytes = checkpoint.Batches[0].Bytes
+	checkpoint.Batches = checkpoint.Batches[:1]
+	if err := saveCheckpoint(path+exportCheckpointSuffix, checkpoint); err != nil {
+		t.Fatalf("failed to save checkpoint: %v", err)
+	}
+	if err := os.WriteFile(path, append(want[:checkpoint.Bytes:checkpoint.Bytes], `{"id":6,"ti`...), 0o644); err != nil {
+		t.Fatalf("failed to truncate export: %v", err)
+	}
+
+	result, err := service.Export(context.Background(), ExportCommand{Path: path, BatchSize: 2, Resume: true})
+	if err != nil {
+		t.Fatalf("Expected the export to resume, got %v", err)
+	}
+	if !result.Resumed || result.Verified != 1 || result.NewBatches != 2 || !result.Checkpoint.Complete {
+		t.Errorf("Expected 1 batch verified and 2 written, got %+v", result)
+	}
+	if got, _ := os.ReadFile(path); !bytes.Equal(got, want) {
+		t.Errorf("Expected the resumed export to match a full one, got %q", got)
+	}
+}
+
+func TestExport_ResumeWithoutCheckpoint(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "movies.ndjson")
+	_, err := NewService(library(1)).Export(context.Background(), ExportCommand{Path: path, Resume: true})
+	if !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("Expected no checkpoint to be found, got %v", err)
+	}
+}
+
+func TestImport_VerifiesExportBatches(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "movies.ndjson")
+	if _, err := NewService(library(5)).Export(context.Background(), ExportCommand{Path: path, BatchSize: 2}); err != nil {
+		t.Fatalf("Expected the export to succeed, got %v", err)
+	}
+
+	target := &memoryMovieService{}
+	result, err := NewService(target).Import(context.Background(), ImportCommand{Path: path})
+	if err != nil {
+		t.Fatalf("Expected the import to succeed, got %v", err)
+	}
+	if result.Verified != 3 || result.NewBatches != 3 || result.Checkpoint.Imported != 5 || len(target.movies) != 5 {
+		t.Errorf("Expected 5 movies in 3 verified batches, got %+v", result.Checkpoint)
+	}
+
+	// A line changed after the export fails its batch before it is imported
+	data, _ := os.ReadFile(path)
+	if err := os.WriteFile(path, bytes.Replace(data, []byte("Movie E"), []byte("Movie Z"), 1), 0o644); err != nil {
+		t.Fatalf("failed to edit export: %v", err)
+	}
+	target = &memoryMovieService{}
+	_, err = NewService(target).Import(context.Background(), ImportCommand{Path: path})
+	if !errors.Is(err, shared.ErrConflict) || !strings.Contains(err.Error(), "batch 3") {
+		t.Errorf("Expected batch 3 to fail its checksum, got %v", err)
+	}
+	if len(target.movies) != 4 {
+		t.Errorf("Expected the batches before it imported, got %d movies", len(target.movies))
+	}
+}
+
+func TestImport_ResumesAndReportsFailedLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "movies.ndjson")
+	lines := `{"title":"Heat","director":"Michael Mann","year":1995}
+not json
+{"title":"","director":"Nobody","year":2000}
+
+{"title":"Ronin","director":"John Frankenheimer","year":1998,"release_date":"1998-09-25"}
+{"title":"Thief","director":"Michael Mann","year":1981}`
+	if err := os.WriteFile(path, []byte(lines), 0o644); err != nil {
+		t.Fatalf("failed to write import file: %v", err)
+	}
+
+	// The third batch fails to insert, as when the database goes away
+	target := &memoryMovieService{}
+	service := NewService(target)
+	failing := &failAfter{memoryMovieService: target, inserts: 2}
+	_, err := NewService(failing).Import(context.Background(), ImportCommand{Path: path, BatchSize: 2})
+	if err == nil || !strings.Contains(err.Error(), "lines 5-6") {
+		t.Fatalf("Expected lines 5-6 to fail, got %v", err)
+	}
+
+	result, err := service.Import(context.Background(), ImportCommand{Path: path, BatchSize: 2, Resume: true})
+	if err != nil {
+		t.Fatalf("Expected the import to resume, got %v", err)
+	}
+	if result.Verified != 2 || result.NewBatches != 1 || !result.Checkpoint.Complete {
+		t.Errorf("Expected 2 batches verified and 1 imported, got %+v", result)
+	}
+	if result.Checkpoint.Imported != 3 || result.Checkpoint.Failed != 2 || len(target.movies) != 3 {
+		t.Errorf("Expected 3 movies imported once and 2 lines failed, got %+v with %d movies", result.Checkpoint, len(target.movies))
+	}
+
+	// A completed import does nothing more
+	result, err = service.Import(context.Background(), ImportCommand{Path: path, Resume: true})
+	if err != nil || result.NewBatches != 0 || len(target.movies) != 3 {
+		t.Errorf("Expected a completed import to be left alone, got %+v, %v", result, err)
+	}
+}
+
+func TestImport_ReportsLineErrors(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "movies.ndjson")
+	lines := "{\"title\":\"Heat\",\"director\":\"Michael Mann\",\"year\":1995}\nnot json\n{\"title\":\"\",\"director\":\"Nobody\"}\n{\"title\":\"Ronin\",\"director\":\"J\",\"release_date\":\"25/09/1998\"}\n"
+	if err := os.WriteFile(path, []byte(lines), 0o644); err != nil {
+		t.Fatalf("failed to write import file: %v", err)
+	}
+
+	result, err := NewService(&memoryMovieService{}).Import(context.Background(), ImportCommand{Path: path})
+	if err != nil {
+		t.Fatalf("Expected the import to succeed, got %v", err)
+	}
+	if len(result.Errors) != 3 {
+		t.Fatalf("Expected 3 failed lines, got %+v", result.Errors)
+	}
+	if result.Errors[0].Line != 2 || !strings.HasPrefix(result.Errors[0].Error, "invalid JSON") {
+		t.Errorf("Expected line 2 to be invalid JSON, got %+v", result.Errors[0])
+	}
+	if result.Errors[1].Line != 4 || result.Errors[1].Title != "Ronin" {
+		t.Errorf("Expected line 4's release date rejected, got %+v", result.Errors[1])
+	}
+	if result.Errors[2].Line != 3 {
+		t.Errorf("Expected line 3's empty title rejected, got %+v", result.Errors[2])
+	}
+}
+
+func TestBatchSize(t *testing.T) {
+	if size, err := batchSize(0); err != nil || size != DefaultBatchSize {
+		t.Errorf("Expected the default batch size, got %d, %v", size, err)
+	}
+	for _, size := range []int{-1, MaxBatchSize + 1} {
+		if _, err := batchSize(size); !errors.Is(err, shared.ErrValidation) {
+			t.Errorf("Expected batch size %d to be rejected, got %v", size, err)
+		}
+	}
+}
+
+// failAfter fails every insert after the first few
+type failAfter struct {
+	*memoryMovieService
+	inserts int
+}
+
+func (f *failAfter) CreateMovies(ctx context.Context, cmds []movieApp.CreateMovieCommand) ([]*movieApp.MovieDTO, []error, error) {
+	if f.memoryMovieService.inserts >= f.inserts {
+		return nil, nil, errors.New("database is locked")
+	}
+	return f.memoryMovieService.CreateMovies(ctx, cmds)
+}
+
+func TestTransfer_ResolvesEveryFile(t *testing.T) {
+	dir := t.TempDir()
+	var names []string
+	service := NewService(library(3))
+	service.SetPathResolver(func(ctx context.Context, name string) (string, error) {
+		names = append(names, name)
+		return filepath.Join(dir, name), nil
+	})
+
+	result, err := service.Export(context.Background(), ExportCommand{Path: "movies.ndjson"})
+	if err != nil {
+		t.Fatalf("Expected the export to succeed, got %v", err)
+	}
+	if result.Path != filepath.Join(dir, "movies.ndjson") || result.CheckpointPath != filepath.Join(dir, "movies.ndjson.export-checkpoint.json") {
+		t.Errorf("Expected files in %s, got %s and %s", dir, result.Path, result.CheckpointPath)
+	}
+	if _, err := service.Import(context.Background(), ImportCommand{Path: "movies.ndjson"}); err != nil {
+		t.Fatalf("Expected the import to succeed, got %v", err)
+	}
+
+	want := []string{
+		"movies.ndjson", "movies.ndjson.export-checkpoint.json",
+		"movies.ndjson", "movies.ndjson.import-checkpoint.json", "movies.ndjson.export-checkpoint.json",
+	}
+	if strings.Join(names, ",") != strings.Join(want, ",") {
+		t.Errorf("Expected every file resolved, got %v", names)
+	}
+}
+
+func TestTransfer_RejectedCheckpoint(t *testing.T) {
+	dir := t.TempDir()
+	service := NewService(library(3))
+	service.SetPathResolver(func(ctx context.Context, name string) (string, error) {
+		if strings.HasSuffix(name, ".json") {
+			return "", shared.NewValidationError("path %q leads outside the backup directory", name)
+		}
+		return filepath.Join(dir, name), nil
+	})
+
+	_, err := service.Export(context.Background(), ExportCommand{Path: "movies.ndjson"})
+
+	if !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("Expected a validation error, got %v", err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("Expected nothing written, got %v", entries)
+	}
+}
@@ -0,0 +1,239 @@
+package translation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/domain/translation"
+)
+
+// Service provides application-level movie translation operations
+type Service struct {
+	translationRepo translation.Repository
+	movieRepo       movie.Reader
+}
+
+// NewService creates a new translation application service
+func NewService(translationRepo translation.Repository, movieRepo movie.Reader) *Service {
+	return &Service{
+		translationRepo: translationRepo,
+		movieRepo:       movieRepo,
+	}
+}
+
+// AddTranslationCommand represents the command to add or replace a movie's translation in a language
+type AddTranslationCommand struct {
+	MovieID     int
+	Language    string // BCP 47 tag such as fr or pt-BR
+	Title       string // Alternative title; optional if a description is given
+	Description string // Localized description; optional if a title is given
+}
+
+// GeneratedDescriptionCommand represents the command to store a description
+// a model wrote for a movie in a language
+type GeneratedDescriptionCommand struct {
+	MovieID         int
+	Language        string // BCP 47 tag such as en or pt-BR
+	Description     string
+	Model           string // Model that wrote the description, as reported by the client
+	ReplaceImported bool   // Replace an imported description in the language
+}
+
+// TranslationDTO represents a movie translation data transfer object
+type TranslationDTO struct {
+	MovieID     int    `json:"movie_id"`
+	Language    string `json:"language"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	DescriptionSource string `json:"description_source,omitempty"` // imported or generated, when there is a description
+	DescriptionModel  string `json:"description_model,omitempty"`
+}
+
+// MovieTranslationsDTO represents all translations of a movie
+type MovieTranslationsDTO struct {
+	MovieID      int               `json:"movie_id"`
+	Title        string            `json:"title"`
+	Year         int               `json:"year"`
+	Translations []*TranslationDTO `json:"translations"`
+}
+
+// AddTranslation adds a movie's title and description in a language,
+// replacing any translation already recorded for that language
+func (s *Service) AddTranslation(ctx context.Context, cmd AddTranslationCommand) (*TranslationDTO, error) {
+	domainMovie, err := s.findMovie(ctx, cmd.MovieID)
+	if err != nil {
+		return nil, err
+	}
+
+	domainTranslation, err := translation.NewTranslation(domainMovie.ID(), cmd.Language, cmd.Title, cmd.Description)
+	if err != nil {
+		return nil, fmt.Errorf("invalid translation: %w", err)
+	}
+
+	if err := s.translationRepo.Save(ctx, domainTranslation); err != nil {
+		return nil, fmt.Errorf("failed to save translation: %w", err)
+	}
+
+	return s.toDTO(domainTranslation), nil
+}
+
+// CheckGeneratedDescription reports whether a generated description may be
+// stored for a movie in a language: the movie must exist, and an imported
+// description there is only replaced if replaceImported is set. Generated
+// descriptions can always be refreshed.
+func (s *Service) CheckGeneratedDescription(ctx context.Context, movieID int, language string, replaceImported bool) error {
+	_, _, err := s.generatedDescriptionTarget(ctx, movieID, language, replaceImported)
+	return err
+}
+
+// SaveGeneratedDescription stores a generated description for a movie in a
+// language, marked with the model that wrote it. The translated title in
+// the language is kept, and imported descriptions are protected as by
+// CheckGeneratedDescription.
+func (s *Service) SaveGeneratedDescription(ctx context.Context, cmd GeneratedDescriptionCommand) (*TranslationDTO, error) {
+	domainMovie, existing, err := s.generatedDescriptionTarget(ctx, cmd.MovieID, cmd.Language, cmd.ReplaceImported)
+	if err != nil {
+		return nil, err
+	}
+
+	var title string
+	if existing != nil {
+		title = existing.Title()
+	}
+	domainTranslation, err := translation.NewTranslation(domainMovie.ID(), cmd.Language, title, cmd.Description)
+	if err != nil {
+		return nil, fmt.Errorf("invalid translation: %w", err)
+	}
+	if err := domainTranslation.MarkDescriptionGenerated(cmd.Model); err != nil {
+		return nil, fmt.Errorf("invalid translation: %w", err)
+	}
+
+	if err := s.translationRepo.Save(ctx, domainTranslation); err != nil {
+		return nil, fmt.Errorf("failed to save translation: %w", err)
+	}
+
+	return s.toDTO(domainTranslation), nil
+}
+
+// generatedDescriptionTarget finds the movie a generated description is for
+// and its current translation in the language, if any, refusing to replace
+// an imported description unless replaceImported is set
+func (s *Service) generatedDescriptionTarget(ctx context.Context, movieID int, language string, replaceImported bool) (*movie.Movie, *translation.Translation, error) {
+	domainMovie, err := s.findMovie(ctx, movieID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	normalizedLanguage, err := translation.NormalizeLanguage(language)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid language: %w", err)
+	}
+
+	translations, err := s.translationRepo.FindByMovieIDs(ctx, []shared.MovieID{domainMovie.ID()}, []string{normalizedLanguage})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get translations: %w", err)
+	}
+	if len(translations) == 0 {
+		return domainMovie, nil, nil
+	}
+
+	existing := translations[0]
+	if existing.Description() != "" && existing.DescriptionSource() == translation.DescriptionImported && !replaceImported {
+		return nil, nil, shared.NewConflictError("movie %d already has an imported %s description", movieID, normalizedLanguage)
+	}
+	return domainMovie, existing, nil
+}
+
+// ListTranslations retrieves every translation of a movie, ordered by language
+func (s *Service) ListTranslations(ctx context.Context, movieID int) (*MovieTranslationsDTO, error) {
+	domainMovie, err := s.findMovie(ctx, movieID)
+	if err != nil {
+		return nil, err
+	}
+
+	translations, err := s.translationRepo.FindByMovieID(ctx, domainMovie.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get translations: %w", err)
+	}
+
+	dto := &MovieTranslationsDTO{
+		MovieID:      domainMovie.ID().Value(),
+		Title:        domainMovie.Title(),
+		Year:         domainMovie.Year().Value(),
+		Translations: make([]*TranslationDTO, 0, len(translations)),
+	}
+	for _, domainTranslation := range translations {
+		dto.Translations = append(dto.Translations, s.toDTO(domainTranslation))
+	}
+	return dto, nil
+}
+
+// Localize finds the best translation of each movie for a language, keyed
+// by movie ID. A regional tag such as pt-BR falls back to its base language
+// (pt); movies with no translation in either are left out.
+func (s *Service) Localize(ctx context.Context, language string, movieIDs []int) (map[int]*TranslationDTO, error) {
+	normalizedLanguage, err := translation.NormalizeLanguage(language)
+	if err != nil {
+		return nil, fmt.Errorf("invalid language: %w", err)
+	}
+
+	domainIDs := make([]shared.MovieID, 0, len(movieIDs))
+	for _, id := range movieIDs {
+		movieID, err := shared.NewMovieID(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid movie ID: %w", err)
+		}
+		domainIDs = append(domainIDs, movieID)
+	}
+
+	languages := []string{normalizedLanguage}
+	if base := translation.BaseLanguage(normalizedLanguage); base != normalizedLanguage {
+		languages = append(languages, base)
+	}
+
+	translations, err := s.translationRepo.FindByMovieIDs(ctx, domainIDs, languages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get translations: %w", err)
+	}
+
+	localized := make(map[int]*TranslationDTO, len(translations))
+	for _, domainTranslation := range translations {
+		movieID := domainTranslation.MovieID().Value()
+		if existing, ok := localized[movieID]; ok && existing.Language == normalizedLanguage {
+			continue // An exact match beats the base-language fallback
+		}
+		localized[movieID] = s.toDTO(domainTranslation)
+	}
+	return localized, nil
+}
+
+// toDTO converts a domain translation to a DTO
+func (s *Service) toDTO(domainTranslation *translation.Translation) *TranslationDTO {
+	dto := &TranslationDTO{
+		MovieID:     domainTranslation.MovieID().Value(),
+		Language:    domainTranslation.Language(),
+		Title:       domainTranslation.Title(),
+		Description: domainTranslation.Description(),
+	}
+	if dto.Description != "" {
+		dto.DescriptionSource = string(domainTranslation.DescriptionSource())
+		dto.DescriptionModel = domainTranslation.DescriptionModel()
+	}
+	return dto
+}
+
+func (s *Service) findMovie(ctx context.Context, id int) (*movie.Movie, error) {
+	movieID, err := shared.NewMovieID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid movie ID: %w", err)
+	}
+
+	domainMovie, err := s.movieRepo.FindByID(ctx, movieID)
+	if err != nil {
+		return nil, fmt.Errorf("movie not found: %w", err)
+	}
+	return domainMovie, nil
+}
@@ -0,0 +1,218 @@
+package translation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/domain/translation"
+)
+
+// MockMovieReader implements the FindByID part of movie.Reader for testing
+type MockMovieReader struct {
+	movie.Reader
+	movies map[int]*movie.Movie
+}
+
+func (m *MockMovieReader) FindByID(ctx context.Context, id shared.MovieID) (*movie.Movie, error) {
+	if found, exists := m.movies[id.Value()]; exists {
+		return found, nil
+	}
+	return nil, errors.New("movie not found")
+}
+
+// MockTranslationRepository implements translation.Repository for testing
+type MockTranslationRepository struct {
+	translations map[int]map[string]*translation.Translation // Keyed by movie ID, then language
+}
+
+func NewMockTranslationRepository() *MockTranslationRepository {
+	return &MockTranslationRepository{translations: make(map[int]map[string]*translation.Translation)}
+}
+
+func (m *MockTranslationRepository) FindByMovieID(ctx context.Context, movieID shared.MovieID) ([]*translation.Translation, error) {
+	var result []*translation.Translation
+	for _, entry := range m.translations[movieID.Value()] {
+		result = append(result, entry)
+	}
+	return result, nil
+}
+
+func (m *MockTranslationRepository) FindByMovieIDs(ctx context.Context, movieIDs []shared.MovieID, languages []string) ([]*translation.Translation, error) {
+	var result []*translation.Translation
+	for _, movieID := range movieIDs {
+		for _, language := range languages {
+			if entry, ok := m.translations[movieID.Value()][language]; ok {
+				result = append(result, entry)
+			}
+		}
+	}
+	return result, nil
+}
+
+func (m *MockTranslationRepository) Save(ctx context.Context, entry *translation.Translation) error {
+	movieID := entry.MovieID().Value()
+	if m.translations[movieID] == nil {
+		m.translations[movieID] = make(map[string]*translation.Translation)
+	}
+	m.translations[movieID][entry.Language()] = entry
+	return nil
+}
+
+func newTestService(t *testing.T) (*Service, *MockTranslationRepository) {
+	t.Helper()
+
+	movies := make(map[int]*movie.Movie)
+	for id, title := range map[int]string{1: "The Godfather", 2: "Goodfellas"} {
+		movieID, _ := shared.NewMovieID(id)
+		domainMovie, err := movie.NewMovieWithID(movieID, title, "Director", 1972)
+		if err != nil {
+			t.Fatalf("failed to create movie: %v", err)
+		}
+		movies[id] = domainMovie
+	}
+
+	repo := NewMockTranslationRepository()
+	return NewService(repo, &MockMovieReader{movies: movies}), repo
+}
+
+func TestService_AddTranslation(t *testing.T) {
+	service, repo := newTestService(t)
+
+	dto, err := service.AddTranslation(context.Background(), AddTranslationCommand{
+		MovieID:     1,
+		Language:    "FR",
+		Title:       "Le Parrain",
+		Description: "La saga de la famille Corleone",
+	})
+	if err != nil {
+		t.Fatalf("AddTranslation() error = %v", err)
+	}
+
+	if dto.Language != "fr" || dto.Title != "Le Parrain" {
+		t.Errorf("Expected normalized French translation, got: %+v", dto)
+	}
+	if _, ok := repo.translations[1]["fr"]; !ok {
+		t.Error("Expected translation to be saved")
+	}
+}
+
+func TestService_AddTranslation_Errors(t *testing.T) {
+	service, _ := newTestService(t)
+
+	tests := []struct {
+		name string
+		cmd  AddTranslationCommand
+	}{
+		{name: "unknown movie", cmd: AddTranslationCommand{MovieID: 99, Language: "fr", Title: "Le Parrain"}},
+		{name: "invalid language", cmd: AddTranslationCommand{MovieID: 1, Language: "french", Title: "Le Parrain"}},
+		{name: "nothing translated", cmd: AddTranslationCommand{MovieID: 1, Language: "fr"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := service.AddTranslation(context.Background(), tt.cmd); err == nil {
+				t.Error("Expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestService_SaveGeneratedDescription(t *testing.T) {
+	service, repo := newTestService(t)
+	ctx := context.Background()
+
+	_, _ = service.AddTranslation(ctx, AddTranslationCommand{MovieID: 1, Language: "fr", Title: "Le Parrain"})
+
+	dto, err := service.SaveGeneratedDescription(ctx, GeneratedDescriptionCommand{
+		MovieID:     1,
+		Language:    "fr",
+		Description: "La saga de la famille Corleone",
+		Model:       "test-model",
+	})
+	if err != nil {
+		t.Fatalf("SaveGeneratedDescription() error = %v", err)
+	}
+
+	if dto.Title != "Le Parrain" || dto.DescriptionSource != "generated" || dto.DescriptionModel != "test-model" {
+		t.Errorf("Expected the title kept and a generated description, got: %+v", dto)
+	}
+	if saved := repo.translations[1]["fr"]; saved.DescriptionSource() != translation.DescriptionGenerated {
+		t.Errorf("Expected the generated description to be saved, got: %s", saved.DescriptionSource())
+	}
+
+	// A generated description can be refreshed
+	if _, err := service.SaveGeneratedDescription(ctx, GeneratedDescriptionCommand{MovieID: 1, Language: "fr", Description: "Une famille mafieuse"}); err != nil {
+		t.Errorf("Expected a generated description to be refreshed, got: %v", err)
+	}
+}
+
+func TestService_SaveGeneratedDescription_ProtectsImported(t *testing.T) {
+	service, _ := newTestService(t)
+	ctx := context.Background()
+
+	_, _ = service.AddTranslation(ctx, AddTranslationCommand{MovieID: 1, Language: "en", Description: "The Corleone family saga"})
+
+	if err := service.CheckGeneratedDescription(ctx, 1, "en", false); !errors.Is(err, shared.ErrConflict) {
+		t.Errorf("Expected a conflict for an imported description, got: %v", err)
+	}
+	if err := service.CheckGeneratedDescription(ctx, 1, "de", false); err != nil {
+		t.Errorf("Expected a language without a description to be free, got: %v", err)
+	}
+	if err := service.CheckGeneratedDescription(ctx, 99, "en", false); err == nil {
+		t.Error("Expected an error for an unknown movie")
+	}
+
+	cmd := GeneratedDescriptionCommand{MovieID: 1, Language: "en", Description: "A mafia patriarch hands over his empire"}
+	if _, err := service.SaveGeneratedDescription(ctx, cmd); !errors.Is(err, shared.ErrConflict) {
+		t.Errorf("Expected a conflict without replace imported, got: %v", err)
+	}
+	cmd.ReplaceImported = true
+	if dto, err := service.SaveGeneratedDescription(ctx, cmd); err != nil || dto.DescriptionSource != "generated" {
+		t.Errorf("Expected the imported description to be replaced, got: %+v (%v)", dto, err)
+	}
+}
+
+func TestService_ListTranslations(t *testing.T) {
+	service, _ := newTestService(t)
+	ctx := context.Background()
+
+	_, _ = service.AddTranslation(ctx, AddTranslationCommand{MovieID: 1, Language: "fr", Title: "Le Parrain"})
+	_, _ = service.AddTranslation(ctx, AddTranslationCommand{MovieID: 1, Language: "de", Title: "Der Pate"})
+
+	dto, err := service.ListTranslations(ctx, 1)
+	if err != nil {
+		t.Fatalf("ListTranslations() error = %v", err)
+	}
+
+	if dto.Title != "The Godfather" || len(dto.Translations) != 2 {
+		t.Errorf("Expected 2 translations of The Godfather, got: %s with %d", dto.Title, len(dto.Translations))
+	}
+}
+
+func TestService_Localize(t *testing.T) {
+	service, _ := newTestService(t)
+	ctx := context.Background()
+
+	_, _ = service.AddTranslation(ctx, AddTranslationCommand{MovieID: 1, Language: "pt", Title: "O Padrinho"})
+	_, _ = service.AddTranslation(ctx, AddTranslationCommand{MovieID: 1, Language: "pt-BR", Title: "O Poderoso Chefão"})
+	_, _ = service.AddTranslation(ctx, AddTranslationCommand{MovieID: 2, Language: "pt", Title: "Os Bons Companheiros"})
+
+	localized, err := service.Localize(ctx, "pt-br", []int{1, 2})
+	if err != nil {
+		t.Fatalf("Localize() error = %v", err)
+	}
+
+	if got := localized[1]; got == nil || got.Title != "O Poderoso Chefão" {
+		t.Errorf("Expected exact pt-BR match for movie 1, got: %+v", got)
+	}
+	if got := localized[2]; got == nil || got.Language != "pt" {
+		t.Errorf("Expected pt fallback for movie 2, got: %+v", got)
+	}
+
+	if _, err := service.Localize(ctx, "portuguese", []int{1}); err == nil {
+		t.Error("Expected error for invalid language")
+	}
+}
@@ -0,0 +1,283 @@
+// Package writequeue provides an optional asynchronous write queue that absorbs
+// bursts of mutations and applies them to the database in ordered batches.
+package writequeue
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// ErrQueueFull is returned when the queue cannot accept more operations
+var ErrQueueFull = shared.NewUnavailableError("write queue is full")
+
+// ErrQueueClosed is returned when operations are submitted after Close
+var ErrQueueClosed = shared.NewUnavailableError("write queue is closed")
+
+// Status describes where a queued operation is in its lifecycle
+type Status string
+
+const (
+	StatusPending Status = "pending"
+	StatusApplied Status = "applied"
+	StatusFailed  Status = "failed"
+)
+
+// Operation is a single mutation submitted to the queue
+type Operation struct {
+	Kind      string // e.g. "add_movie", "update_movie"
+	EntityKey string // e.g. "movie:42"; used for reporting ordering guarantees
+	Apply     func(ctx context.Context) (int, error)
+}
+
+// Ticket is the acknowledgment handed back for a queued operation
+type Ticket struct {
+	Token       string
+	Kind        string
+	EntityKey   string
+	Status      Status
+	EntityID    int
+	Error       string
+	SubmittedAt time.Time
+	CompletedAt time.Time
+}
+
+// Config controls queue sizing and batching
+type Config struct {
+	Capacity      int           // Maximum number of pending operations
+	BatchSize     int           // Maximum operations applied per batch
+	FlushInterval time.Duration // Maximum time to wait while filling a batch
+	Retention     time.Duration // How long completed tickets stay queryable
+}
+
+type entry struct {
+	token string
+	op    Operation
+}
+
+// Queue applies operations in submission order on a single worker, which
+// preserves ordering per entity while smoothing load on the database.
+type Queue struct {
+	config  Config
+	entries chan entry
+	tickets map[string]*Ticket
+	mutex   sync.RWMutex
+	started bool
+	closed  bool
+	done    chan struct{}
+
+	// submitted and processed count operations so readers can wait for writes
+	submitted uint64
+	processed uint64
+	progress  chan struct{}
+}
+
+// New creates a new write queue; call Start to begin applying operations
+func New(cfg Config) *Queue {
+	if cfg.Capacity <= 0 {
+		cfg.Capacity = 1000
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 50
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = 100 * time.Millisecond
+	}
+	if cfg.Retention <= 0 {
+		cfg.Retention = time.Hour
+	}
+
+	return &Queue{
+		config:   cfg,
+		entries:  make(chan entry, cfg.Capacity),
+		tickets:  make(map[string]*Ticket),
+		done:     make(chan struct{}),
+		progress: make(chan struct{}),
+	}
+}
+
+// Start launches the worker; ctx is passed to every applied operation
+func (q *Queue) Start(ctx context.Context) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if q.started || q.closed {
+		return
+	}
+	q.started = true
+	go q.run(ctx)
+}
+
+// Submit enqueues an operation and returns its acknowledgment ticket
+func (q *Queue) Submit(op Operation) (Ticket, error) {
+	if op.Apply == nil {
+		return Ticket{}, fmt.Errorf("operation apply function is required")
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if q.closed {
+		return Ticket{}, ErrQueueClosed
+	}
+
+	ticket := &Ticket{
+		Token:       uuid.New().String(),
+		Kind:        op.Kind,
+		EntityKey:   op.EntityKey,
+		Status:      StatusPending,
+		SubmittedAt: time.Now(),
+	}
+
+	select {
+	case q.entries <- entry{token: ticket.Token, op: op}:
+	default:
+		return Ticket{}, ErrQueueFull
+	}
+
+	q.tickets[ticket.Token] = ticket
+	q.submitted++
+	q.pruneLocked(ticket.SubmittedAt)
+
+	return *ticket, nil
+}
+
+// Status returns the current state of a queued operation
+func (q *Queue) Status(token string) (Ticket, bool) {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	ticket, exists := q.tickets[token]
+	if !exists {
+		return Ticket{}, false
+	}
+	return *ticket, true
+}
+
+// Sync blocks until every operation submitted before the call has been applied
+func (q *Queue) Sync(ctx context.Context) error {
+	q.mutex.RLock()
+	target := q.submitted
+	stalled := q.closed && !q.started
+	q.mutex.RUnlock()
+
+	for {
+		q.mutex.RLock()
+		caughtUp := q.processed >= target
+		progress := q.progress
+		q.mutex.RUnlock()
+
+		if caughtUp {
+			return nil
+		}
+		if stalled {
+			return ErrQueueClosed
+		}
+
+		select {
+		case <-progress:
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for queued writes: %w", ctx.Err())
+		}
+	}
+}
+
+// Pending returns the number of operations waiting to be applied
+func (q *Queue) Pending() int {
+	return len(q.entries)
+}
+
+// Close stops accepting operations and waits for pending ones to be applied
+func (q *Queue) Close() {
+	q.mutex.Lock()
+	if q.closed {
+		q.mutex.Unlock()
+		<-q.done
+		return
+	}
+	q.closed = true
+	close(q.entries)
+	if !q.started {
+		close(q.done)
+	}
+	q.mutex.Unlock()
+
+	<-q.done
+}
+
+// run is the single worker loop that drains the queue in batches
+func (q *Queue) run(ctx context.Context) {
+	defer close(q.done)
+
+	for {
+		first, ok := <-q.entries
+		if !ok {
+			return
+		}
+
+		batch := q.fillBatch(first)
+		for _, e := range batch {
+			q.apply(ctx, e)
+		}
+	}
+}
+
+// fillBatch collects up to BatchSize entries, waiting at most FlushInterval
+func (q *Queue) fillBatch(first entry) []entry {
+	batch := []entry{first}
+	timer := time.NewTimer(q.config.FlushInterval)
+	defer timer.Stop()
+
+	for len(batch) < q.config.BatchSize {
+		select {
+		case e, ok := <-q.entries:
+			if !ok {
+				return batch
+			}
+			batch = append(batch, e)
+		case <-timer.C:
+			return batch
+		}
+	}
+	return batch
+}
+
+// apply executes a single operation and records its outcome
+func (q *Queue) apply(ctx context.Context, e entry) {
+	entityID, err := e.op.Apply(ctx)
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	q.processed++
+	close(q.progress)
+	q.progress = make(chan struct{})
+
+	ticket, exists := q.tickets[e.token]
+	if !exists {
+		return
+	}
+
+	ticket.CompletedAt = time.Now()
+	if err != nil {
+		ticket.Status = StatusFailed
+		ticket.Error = err.Error()
+		return
+	}
+	ticket.Status = StatusApplied
+	ticket.EntityID = entityID
+}
+
+// pruneLocked drops completed tickets older than the retention window
+func (q *Queue) pruneLocked(now time.Time) {
+	for token, ticket := range q.tickets {
+		if ticket.Status != StatusPending && now.Sub(ticket.CompletedAt) > q.config.Retention {
+			delete(q.tickets, token)
+		}
+	}
+}
@@ -0,0 +1,232 @@
+package writequeue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func waitForStatus(t *testing.T, q *Queue, token string) Ticket {
+	t.Helper()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		ticket, exists := q.Status(token)
+		if !exists {
+			t.Fatalf("Expected ticket %s to exist", token)
+		}
+		if ticket.Status != StatusPending {
+			return ticket
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("Timed out waiting for ticket %s", token)
+	return Ticket{}
+}
+
+func TestQueue_AppliesOperation(t *testing.T) {
+	// Arrange
+	q := New(Config{FlushInterval: time.Millisecond})
+	q.Start(context.Background())
+	defer q.Close()
+
+	// Act
+	ticket, err := q.Submit(Operation{
+		Kind:      "add",
+		EntityKey: "movie:new",
+		Apply: func(ctx context.Context) (int, error) {
+			return 42, nil
+		},
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if ticket.Token == "" {
+		t.Error("Expected acknowledgment token")
+	}
+	if ticket.Status != StatusPending {
+		t.Errorf("Expected pending status on submit, got: %s", ticket.Status)
+	}
+
+	result := waitForStatus(t, q, ticket.Token)
+	if result.Status != StatusApplied {
+		t.Errorf("Expected applied status, got: %s", result.Status)
+	}
+	if result.EntityID != 42 {
+		t.Errorf("Expected entity ID 42, got: %d", result.EntityID)
+	}
+	if result.CompletedAt.IsZero() {
+		t.Error("Expected completion time to be set")
+	}
+}
+
+func TestQueue_RecordsFailure(t *testing.T) {
+	q := New(Config{FlushInterval: time.Millisecond})
+	q.Start(context.Background())
+	defer q.Close()
+
+	ticket, err := q.Submit(Operation{
+		Kind: "update",
+		Apply: func(ctx context.Context) (int, error) {
+			return 0, errors.New("movie not found")
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	result := waitForStatus(t, q, ticket.Token)
+	if result.Status != StatusFailed {
+		t.Errorf("Expected failed status, got: %s", result.Status)
+	}
+	if result.Error != "movie not found" {
+		t.Errorf("Expected error message, got: %q", result.Error)
+	}
+}
+
+func TestQueue_PreservesSubmissionOrder(t *testing.T) {
+	q := New(Config{BatchSize: 3, FlushInterval: time.Millisecond})
+	q.Start(context.Background())
+
+	var mu sync.Mutex
+	var applied []int
+
+	for i := 1; i <= 10; i++ {
+		n := i
+		_, err := q.Submit(Operation{
+			Kind:      "update",
+			EntityKey: "movie:1",
+			Apply: func(ctx context.Context) (int, error) {
+				mu.Lock()
+				applied = append(applied, n)
+				mu.Unlock()
+				return 1, nil
+			},
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+	}
+
+	q.Close()
+
+	if len(applied) != 10 {
+		t.Fatalf("Expected 10 applied operations, got: %d", len(applied))
+	}
+	for i, n := range applied {
+		if n != i+1 {
+			t.Fatalf("Expected operations in submission order, got: %v", applied)
+		}
+	}
+}
+
+func TestQueue_RejectsWhenFull(t *testing.T) {
+	// Not started, so nothing drains the queue
+	q := New(Config{Capacity: 1})
+	defer q.Close()
+
+	noop := Operation{Apply: func(ctx context.Context) (int, error) { return 0, nil }}
+
+	if _, err := q.Submit(noop); err != nil {
+		t.Fatalf("Expected first submit to succeed, got: %v", err)
+	}
+	if _, err := q.Submit(noop); !errors.Is(err, ErrQueueFull) {
+		t.Errorf("Expected ErrQueueFull, got: %v", err)
+	}
+}
+
+func TestQueue_RejectsAfterClose(t *testing.T) {
+	q := New(Config{})
+	q.Start(context.Background())
+	q.Close()
+
+	_, err := q.Submit(Operation{Apply: func(ctx context.Context) (int, error) { return 0, nil }})
+	if !errors.Is(err, ErrQueueClosed) {
+		t.Errorf("Expected ErrQueueClosed, got: %v", err)
+	}
+}
+
+func TestQueue_RequiresApply(t *testing.T) {
+	q := New(Config{})
+	defer q.Close()
+
+	if _, err := q.Submit(Operation{Kind: "add"}); err == nil {
+		t.Error("Expected error for operation without apply function")
+	}
+}
+
+func TestQueue_UnknownToken(t *testing.T) {
+	q := New(Config{})
+	defer q.Close()
+
+	if _, exists := q.Status("missing"); exists {
+		t.Error("Expected unknown token to be reported as missing")
+	}
+}
+
+func TestQueue_SyncWaitsForPendingWrites(t *testing.T) {
+	q := New(Config{FlushInterval: time.Millisecond})
+	q.Start(context.Background())
+	defer q.Close()
+
+	release := make(chan struct{})
+	ticket, err := q.Submit(Operation{
+		Kind: "add",
+		Apply: func(ctx context.Context) (int, error) {
+			<-release
+			return 1, nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	synced := make(chan error, 1)
+	go func() { synced <- q.Sync(context.Background()) }()
+
+	select {
+	case <-synced:
+		t.Fatal("Expected Sync to wait for the pending write")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	if err := <-synced; err != nil {
+		t.Fatalf("Expected no error from Sync, got: %v", err)
+	}
+
+	status, _ := q.Status(ticket.Token)
+	if status.Status != StatusApplied {
+		t.Errorf("Expected write applied after Sync, got: %s", status.Status)
+	}
+}
+
+func TestQueue_SyncHonorsContext(t *testing.T) {
+	// Not started, so the write never applies
+	q := New(Config{})
+	defer q.Close()
+
+	if _, err := q.Submit(Operation{Apply: func(ctx context.Context) (int, error) { return 0, nil }}); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := q.Sync(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded, got: %v", err)
+	}
+}
+
+func TestQueue_SyncWithEmptyQueue(t *testing.T) {
+	q := New(Config{})
+	defer q.Close()
+
+	if err := q.Sync(context.Background()); err != nil {
+		t.Errorf("Expected immediate return for empty queue, got: %v", err)
+	}
+}
@@ -3,6 +3,7 @@ package config
 
 import (
 	"fmt"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
@@ -11,9 +12,15 @@ import (
 
 // Config holds all configuration for the application.
 type Config struct {
-	Database DatabaseConfig
-	Server   ServerConfig
-	Image    ImageConfig
+	Database   DatabaseConfig
+	Server     ServerConfig
+	Image      ImageConfig
+	WriteQueue WriteQueueConfig
+	TMDB       TMDBConfig
+	HTTP       HTTPConfig
+	Events     EventsConfig
+	Retention  RetentionConfig
+	Sync       SyncConfig
 }
 
 // DatabaseConfig holds database-specific configuration.
@@ -23,12 +30,89 @@ type DatabaseConfig struct {
 	MaxIdleConns    int
 	ConnMaxLifetime time.Duration
 	MigrationsPath  string
+
+	// Locking: WAL lets reads run alongside a write, and writers wait up to
+	// BusyTimeout for the lock instead of failing with "database is locked"
+	JournalMode string
+	BusyTimeout time.Duration
+
+	// Health checking
+	HealthCheckInterval time.Duration
+	HealthCheckTimeout  time.Duration
+
+	// Multi-tenancy: each tenant's library is its own SQLite file in
+	// TenantDir; when empty every request is served from Name
+	TenantDir string
+
+	// First boot: the embedded dataset loaded into an empty library on start
+	// (classics, recent or fixtures); when empty the library starts empty
+	InitSeed string
+
+	// Diagnostics: repository statements slower than SlowQueryThreshold are
+	// logged and listed by movies://server/slow-queries; zero turns it off
+	SlowQueryThreshold time.Duration
+
+	// Maintenance: the default database and open tenant databases are
+	// vacuumed, analyzed and checked every MaintenanceInterval; zero leaves
+	// it to maintain_database
+	MaintenanceInterval time.Duration
+
+	// Backups: the backup, restore and NDJSON transfer tools only read and
+	// write files inside BackupDir, named relative to it; empty is the
+	// working directory
+	BackupDir string
 }
 
 // ServerConfig holds server-specific configuration.
 type ServerConfig struct {
-	LogLevel string
-	Timeout  time.Duration
+	LogLevel        string
+	Timeout         time.Duration
+	ShutdownTimeout time.Duration
+	MaxBatchSize    int // Maximum IDs per batch get call; non-positive uses the tool default
+	MaxPageSize     int // Maximum movies per page of movies://database/all, and its default page size
+
+	// SearchRankingWeights is the default weight of each ranking signal
+	// (recency, rating, popularity or trending) in search_movies; empty
+	// keeps results in their sort order
+	SearchRankingWeights map[string]float64
+
+	// Size limits: oversized tool arguments are rejected, and list outputs
+	// over their response limit are truncated; zero disables a limit
+	MaxRequestBytes   int
+	MaxResponseBytes  int
+	ToolResponseBytes map[string]int // Response limits by tool name, replacing MaxResponseBytes
+
+	// The health report and library stats are written to StatusFile every
+	// StatusInterval for operators' tools; empty disables it
+	StatusFile     string
+	StatusInterval time.Duration
+
+	// RequestLogSize is how many tool calls movies://server/request-log
+	// keeps; zero disables it
+	RequestLogSize int
+
+	// Tool call log entries include the arguments, with the values of
+	// LogRedactFields replaced and cut to LogMaxPayloadBytes; zero leaves
+	// the arguments out
+	LogRedactFields    []string
+	LogMaxPayloadBytes int
+
+	// ValidationPolicy is lenient or strict; strict also rejects movies
+	// released in the future or rated exactly 0
+	ValidationPolicy string
+
+	// InteractiveTools lets tools ask the client's user to settle ambiguous
+	// calls, such as a delete_movie title matching several movies; turn it
+	// off for automation, so those calls fail with the candidates instead
+	InteractiveTools bool
+
+	// Locale is the language of server messages for sessions that ask for
+	// none the server has, as a language code such as en or fr
+	Locale string
+
+	// Verbosity is what tool results carry for calls that do not ask: both
+	// the JSON output and a text summary, only the json, or only the summary
+	Verbosity string
 }
 
 // ImageConfig holds image-related configuration.
@@ -37,36 +121,258 @@ type ImageConfig struct {
 	AllowedTypes     []string
 	EnableThumbnails bool
 	ThumbnailSize    string
+	OutputFormat     string // Convert downloads and uploads to "jpeg" or "png"; empty keeps their format
+	Quality          int    // JPEG quality 1-100; 0 uses the image package default
+	StripMetadata    bool   // Remove EXIF, XMP and text metadata from stored images
+	MaxWidth         int    // Scale wider images down; 0 is unlimited
+	MaxHeight        int    // Scale taller images down; 0 is unlimited
+
+	AllowedHosts         []string      // Only download posters from these hosts and their subdomains; empty allows any
+	DeniedHosts          []string      // Never download posters from these hosts or their subdomains
+	AllowPrivateNetworks bool          // Permit downloads from loopback, private and link-local addresses
+	DownloadTimeout      time.Duration // Limit on a whole poster download
+
+	CDNBaseURL    string        // Serve stored posters as URLs under this CDN base instead of image data; empty disables
+	CDNSigningKey string        // Signs CDN URLs with HMAC-SHA256 when set
+	CDNURLExpiry  time.Duration // How long a signed CDN URL stays valid
+}
+
+// WriteQueueConfig holds configuration for the optional asynchronous write queue.
+type WriteQueueConfig struct {
+	Enabled       bool
+	Capacity      int
+	BatchSize     int
+	FlushInterval time.Duration
+}
+
+// TMDBConfig holds configuration for the optional TMDB watch-providers integration.
+type TMDBConfig struct {
+	APIKey  string // TMDB v3 API key; empty disables fetching availability from TMDB
+	BaseURL string
+}
+
+// HTTPConfig holds retry and circuit breaker settings for outbound HTTP
+// requests such as poster downloads and TMDB lookups.
+type HTTPConfig struct {
+	MaxRetries       int           // Retries after a failed attempt; negative disables retrying
+	RetryBackoff     time.Duration // Delay before the first retry, doubled for each further retry
+	MaxRetryBackoff  time.Duration
+	FailureThreshold int           // Consecutive failures that stop requests to a host
+	OpenDuration     time.Duration // Time requests to a failing host are refused before trying again
+}
+
+// EventsConfig holds settings for data change events and their webhooks.
+type EventsConfig struct {
+	HistorySize        int           // Recent events kept for list_recent_events; 0 keeps 100
+	WebhookURLs        []string      // Receive every event; empty disables webhooks
+	WebhookSecret      string        // Signs webhook payloads with HMAC-SHA256 when set
+	WebhookMaxAttempts int           // Delivery attempts per event and webhook
+	WebhookBackoff     time.Duration // Delay before the first redelivery, doubled for each further one
+	WebhookQueueSize   int           // Deliveries waiting to be sent before new ones fail
+}
+
+// RetentionConfig holds how long stored records are kept before the
+// retention job purges them; 0 days keeps them forever.
+type RetentionConfig struct {
+	Interval    time.Duration // Time between purges; the first runs at startup
+	HistoryDays int           // Age of movie history entries to purge; each movie keeps its latest
+	AccessDays  int           // Age of daily movie access counters to purge
+}
+
+// SyncConfig holds the remote server sync_from_remote pulls from.
+type SyncConfig struct {
+	RemoteCommand []string // Runs the other server over stdio, e.g. ssh media-box movies-mcp-server-sdk; empty disables sync
+}
+
+// Enabled reports whether an API key is configured
+func (c *TMDBConfig) Enabled() bool {
+	return c.APIKey != ""
 }
 
 // Load reads configuration from environment variables
 func Load() (*Config, error) {
-	cfg := &Config{
+	return LoadFile("")
+}
+
+// LoadFile reads configuration from an optional YAML file and then applies
+// environment variable overrides, so env always wins over the file
+func LoadFile(path string) (*Config, error) {
+	cfg := defaults()
+
+	if path != "" {
+		if err := mergeFile(cfg, path); err != nil {
+			return nil, err
+		}
+	}
+
+	applyEnv(cfg)
+
+	// Validate required configuration
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// defaults returns the built-in configuration
+func defaults() *Config {
+	return &Config{
 		Database: DatabaseConfig{
-			Name:            getEnv("DB_NAME", "movies.db"),
-			MaxOpenConns:    getEnvAsInt("DB_MAX_OPEN_CONNS", 1), // SQLite works best with 1
-			MaxIdleConns:    getEnvAsInt("DB_MAX_IDLE_CONNS", 1),
-			ConnMaxLifetime: getEnvAsDuration("DB_CONN_MAX_LIFETIME", "0"),
-			MigrationsPath:  getEnv("MIGRATIONS_PATH", "file://migrations"),
+			Name:            "movies.db",
+			MaxOpenConns:    4, // Concurrent readers under WAL; writes still take turns
+			MaxIdleConns:    4, // Keep connections open so their pragmas are not rerun
+			ConnMaxLifetime: 0,
+			MigrationsPath:  "file://migrations",
+
+			JournalMode: "WAL",
+			BusyTimeout: 5 * time.Second,
+
+			HealthCheckInterval: 30 * time.Second,
+			HealthCheckTimeout:  5 * time.Second,
+
+			BackupDir: "backups",
 		},
 		Server: ServerConfig{
-			LogLevel: getEnv("LOG_LEVEL", "info"),
-			Timeout:  getEnvAsDuration("SERVER_TIMEOUT", "30s"),
+			LogLevel:        "info",
+			Timeout:         30 * time.Second,
+			ShutdownTimeout: 10 * time.Second,
+			MaxBatchSize:    100,
+			MaxPageSize:     100,
+
+			MaxRequestBytes:  8 * 1024 * 1024, // Room for a base64 poster at the default image size
+			MaxResponseBytes: 256 * 1024,
+
+			StatusInterval: 2 * time.Second,
+
+			RequestLogSize: 100,
+
+			LogRedactFields:    []string{"description", "bio", "biography", "bio_sections", "career_overview"},
+			LogMaxPayloadBytes: 1024,
+
+			ValidationPolicy: "lenient",
+
+			InteractiveTools: true,
+
+			Locale: "en",
+
+			Verbosity: "both",
 		},
 		Image: ImageConfig{
-			MaxSize:          getEnvAsInt64("MAX_IMAGE_SIZE", 5*1024*1024), // 5MB default
-			AllowedTypes:     getEnvAsStringSlice("ALLOWED_IMAGE_TYPES", []string{"image/jpeg", "image/png", "image/webp"}),
-			EnableThumbnails: getEnvAsBool("ENABLE_THUMBNAILS", true),
-			ThumbnailSize:    getEnv("THUMBNAIL_SIZE", "200x200"),
+			MaxSize:          5 * 1024 * 1024, // 5MB default
+			AllowedTypes:     []string{"image/jpeg", "image/png", "image/webp"},
+			EnableThumbnails: true,
+			ThumbnailSize:    "200x200",
+			DownloadTimeout:  30 * time.Second,
+			CDNURLExpiry:     time.Hour,
+		},
+		WriteQueue: WriteQueueConfig{
+			Enabled:       false,
+			Capacity:      1000,
+			BatchSize:     50,
+			FlushInterval: 100 * time.Millisecond,
+		},
+		TMDB: TMDBConfig{
+			BaseURL: "https://api.themoviedb.org/3",
+		},
+		HTTP: HTTPConfig{
+			MaxRetries:       2,
+			RetryBackoff:     200 * time.Millisecond,
+			MaxRetryBackoff:  5 * time.Second,
+			FailureThreshold: 5,
+			OpenDuration:     30 * time.Second,
+		},
+		Events: EventsConfig{
+			HistorySize:        100,
+			WebhookMaxAttempts: 5,
+			WebhookBackoff:     time.Second,
+			WebhookQueueSize:   1000,
+		},
+		Retention: RetentionConfig{
+			Interval: 24 * time.Hour,
 		},
 	}
+}
 
-	// Validate required configuration
-	if err := cfg.Validate(); err != nil {
-		return nil, err
-	}
+// applyEnv overrides configuration values with any environment variables that are set
+func applyEnv(cfg *Config) {
+	cfg.Database.Name = getEnv("DB_NAME", cfg.Database.Name)
+	cfg.Database.MaxOpenConns = getEnvAsInt("DB_MAX_OPEN_CONNS", cfg.Database.MaxOpenConns)
+	cfg.Database.MaxIdleConns = getEnvAsInt("DB_MAX_IDLE_CONNS", cfg.Database.MaxIdleConns)
+	cfg.Database.ConnMaxLifetime = getEnvAsDuration("DB_CONN_MAX_LIFETIME", cfg.Database.ConnMaxLifetime.String())
+	cfg.Database.MigrationsPath = getEnv("MIGRATIONS_PATH", cfg.Database.MigrationsPath)
+	cfg.Database.JournalMode = getEnv("DB_JOURNAL_MODE", cfg.Database.JournalMode)
+	cfg.Database.BusyTimeout = getEnvAsDuration("DB_BUSY_TIMEOUT", cfg.Database.BusyTimeout.String())
+	cfg.Database.HealthCheckInterval = getEnvAsDuration("DB_HEALTH_CHECK_INTERVAL", cfg.Database.HealthCheckInterval.String())
+	cfg.Database.HealthCheckTimeout = getEnvAsDuration("DB_HEALTH_CHECK_TIMEOUT", cfg.Database.HealthCheckTimeout.String())
+	cfg.Database.TenantDir = getEnv("TENANT_DATABASE_DIR", cfg.Database.TenantDir)
+	cfg.Database.InitSeed = getEnv("DB_INIT_SEED", cfg.Database.InitSeed)
+	cfg.Database.SlowQueryThreshold = getEnvAsDuration("DB_SLOW_QUERY_THRESHOLD", cfg.Database.SlowQueryThreshold.String())
+	cfg.Database.MaintenanceInterval = getEnvAsDuration("DB_MAINTENANCE_INTERVAL", cfg.Database.MaintenanceInterval.String())
+	cfg.Database.BackupDir = getEnv("BACKUP_DIR", cfg.Database.BackupDir)
 
-	return cfg, nil
+	cfg.Server.LogLevel = getEnv("LOG_LEVEL", cfg.Server.LogLevel)
+	cfg.Server.Timeout = getEnvAsDuration("SERVER_TIMEOUT", cfg.Server.Timeout.String())
+	cfg.Server.ShutdownTimeout = getEnvAsDuration("SERVER_SHUTDOWN_TIMEOUT", cfg.Server.ShutdownTimeout.String())
+	cfg.Server.MaxBatchSize = getEnvAsInt("MAX_BATCH_SIZE", cfg.Server.MaxBatchSize)
+	cfg.Server.MaxPageSize = getEnvAsInt("MAX_RESOURCE_PAGE_SIZE", cfg.Server.MaxPageSize)
+	cfg.Server.SearchRankingWeights = getEnvAsFloatMap("SEARCH_RANKING_WEIGHTS", cfg.Server.SearchRankingWeights)
+	cfg.Server.MaxRequestBytes = getEnvAsInt("MAX_REQUEST_BYTES", cfg.Server.MaxRequestBytes)
+	cfg.Server.MaxResponseBytes = getEnvAsInt("MAX_RESPONSE_BYTES", cfg.Server.MaxResponseBytes)
+	cfg.Server.ToolResponseBytes = getEnvAsIntMap("TOOL_MAX_RESPONSE_BYTES", cfg.Server.ToolResponseBytes)
+	cfg.Server.StatusFile = getEnv("STATUS_FILE", cfg.Server.StatusFile)
+	cfg.Server.StatusInterval = getEnvAsDuration("STATUS_INTERVAL", cfg.Server.StatusInterval.String())
+	cfg.Server.RequestLogSize = getEnvAsInt("REQUEST_LOG_SIZE", cfg.Server.RequestLogSize)
+	cfg.Server.LogRedactFields = getEnvAsStringSlice("LOG_REDACT_FIELDS", cfg.Server.LogRedactFields)
+	cfg.Server.LogMaxPayloadBytes = getEnvAsInt("LOG_MAX_PAYLOAD_BYTES", cfg.Server.LogMaxPayloadBytes)
+	cfg.Server.ValidationPolicy = getEnv("VALIDATION_POLICY", cfg.Server.ValidationPolicy)
+	cfg.Server.InteractiveTools = getEnvAsBool("INTERACTIVE_TOOLS", cfg.Server.InteractiveTools)
+	cfg.Server.Locale = getEnv("SERVER_LOCALE", cfg.Server.Locale)
+	cfg.Server.Verbosity = getEnv("RESPONSE_VERBOSITY", cfg.Server.Verbosity)
+
+	cfg.Image.MaxSize = getEnvAsInt64("MAX_IMAGE_SIZE", cfg.Image.MaxSize)
+	cfg.Image.AllowedTypes = getEnvAsStringSlice("ALLOWED_IMAGE_TYPES", cfg.Image.AllowedTypes)
+	cfg.Image.EnableThumbnails = getEnvAsBool("ENABLE_THUMBNAILS", cfg.Image.EnableThumbnails)
+	cfg.Image.ThumbnailSize = getEnv("THUMBNAIL_SIZE", cfg.Image.ThumbnailSize)
+	cfg.Image.OutputFormat = getEnv("IMAGE_OUTPUT_FORMAT", cfg.Image.OutputFormat)
+	cfg.Image.Quality = getEnvAsInt("IMAGE_QUALITY", cfg.Image.Quality)
+	cfg.Image.StripMetadata = getEnvAsBool("STRIP_IMAGE_METADATA", cfg.Image.StripMetadata)
+	cfg.Image.MaxWidth = getEnvAsInt("MAX_IMAGE_WIDTH", cfg.Image.MaxWidth)
+	cfg.Image.MaxHeight = getEnvAsInt("MAX_IMAGE_HEIGHT", cfg.Image.MaxHeight)
+	cfg.Image.AllowedHosts = getEnvAsStringSlice("IMAGE_ALLOWED_HOSTS", cfg.Image.AllowedHosts)
+	cfg.Image.DeniedHosts = getEnvAsStringSlice("IMAGE_DENIED_HOSTS", cfg.Image.DeniedHosts)
+	cfg.Image.AllowPrivateNetworks = getEnvAsBool("IMAGE_ALLOW_PRIVATE_NETWORKS", cfg.Image.AllowPrivateNetworks)
+	cfg.Image.DownloadTimeout = getEnvAsDuration("IMAGE_DOWNLOAD_TIMEOUT", cfg.Image.DownloadTimeout.String())
+	cfg.Image.CDNBaseURL = getEnv("POSTER_CDN_BASE_URL", cfg.Image.CDNBaseURL)
+	cfg.Image.CDNSigningKey = getEnv("POSTER_CDN_SIGNING_KEY", cfg.Image.CDNSigningKey)
+	cfg.Image.CDNURLExpiry = getEnvAsDuration("POSTER_CDN_URL_EXPIRY", cfg.Image.CDNURLExpiry.String())
+
+	cfg.WriteQueue.Enabled = getEnvAsBool("WRITE_QUEUE_ENABLED", cfg.WriteQueue.Enabled)
+	cfg.WriteQueue.Capacity = getEnvAsInt("WRITE_QUEUE_CAPACITY", cfg.WriteQueue.Capacity)
+	cfg.WriteQueue.BatchSize = getEnvAsInt("WRITE_QUEUE_BATCH_SIZE", cfg.WriteQueue.BatchSize)
+	cfg.WriteQueue.FlushInterval = getEnvAsDuration("WRITE_QUEUE_FLUSH_INTERVAL", cfg.WriteQueue.FlushInterval.String())
+
+	cfg.TMDB.APIKey = getEnv("TMDB_API_KEY", cfg.TMDB.APIKey)
+	cfg.TMDB.BaseURL = getEnv("TMDB_BASE_URL", cfg.TMDB.BaseURL)
+
+	cfg.HTTP.MaxRetries = getEnvAsInt("HTTP_MAX_RETRIES", cfg.HTTP.MaxRetries)
+	cfg.HTTP.RetryBackoff = getEnvAsDuration("HTTP_RETRY_BACKOFF", cfg.HTTP.RetryBackoff.String())
+	cfg.HTTP.MaxRetryBackoff = getEnvAsDuration("HTTP_MAX_RETRY_BACKOFF", cfg.HTTP.MaxRetryBackoff.String())
+	cfg.HTTP.FailureThreshold = getEnvAsInt("HTTP_CIRCUIT_FAILURE_THRESHOLD", cfg.HTTP.FailureThreshold)
+	cfg.HTTP.OpenDuration = getEnvAsDuration("HTTP_CIRCUIT_OPEN_DURATION", cfg.HTTP.OpenDuration.String())
+
+	cfg.Events.HistorySize = getEnvAsInt("EVENT_HISTORY_SIZE", cfg.Events.HistorySize)
+	cfg.Events.WebhookURLs = getEnvAsStringSlice("EVENT_WEBHOOK_URLS", cfg.Events.WebhookURLs)
+	cfg.Events.WebhookSecret = getEnv("EVENT_WEBHOOK_SECRET", cfg.Events.WebhookSecret)
+	cfg.Events.WebhookMaxAttempts = getEnvAsInt("EVENT_WEBHOOK_MAX_ATTEMPTS", cfg.Events.WebhookMaxAttempts)
+	cfg.Events.WebhookBackoff = getEnvAsDuration("EVENT_WEBHOOK_RETRY_BACKOFF", cfg.Events.WebhookBackoff.String())
+	cfg.Events.WebhookQueueSize = getEnvAsInt("EVENT_WEBHOOK_QUEUE_SIZE", cfg.Events.WebhookQueueSize)
+
+	cfg.Retention.Interval = getEnvAsDuration("RETENTION_INTERVAL", cfg.Retention.Interval.String())
+	cfg.Retention.HistoryDays = getEnvAsInt("RETENTION_HISTORY_DAYS", cfg.Retention.HistoryDays)
+	cfg.Retention.AccessDays = getEnvAsInt("RETENTION_ACCESS_DAYS", cfg.Retention.AccessDays)
+
+	cfg.Sync.RemoteCommand = getEnvAsFields("SYNC_REMOTE_COMMAND", cfg.Sync.RemoteCommand)
 }
 
 // Validate checks if all required configuration is present and valid
@@ -74,18 +380,152 @@ func (c *Config) Validate() error {
 	if c.Database.Name == "" {
 		return fmt.Errorf("DB_NAME is required")
 	}
+	if !validJournalModes[strings.ToUpper(c.Database.JournalMode)] {
+		return fmt.Errorf("DB_JOURNAL_MODE %q is not one of WAL, DELETE, TRUNCATE, PERSIST, MEMORY or OFF", c.Database.JournalMode)
+	}
+	if c.Database.BusyTimeout < 0 {
+		return fmt.Errorf("DB_BUSY_TIMEOUT cannot be negative")
+	}
+	if c.Database.SlowQueryThreshold < 0 {
+		return fmt.Errorf("DB_SLOW_QUERY_THRESHOLD cannot be negative")
+	}
+	if c.Database.MaintenanceInterval < 0 {
+		return fmt.Errorf("DB_MAINTENANCE_INTERVAL cannot be negative")
+	}
+	if c.Server.MaxPageSize < 0 {
+		return fmt.Errorf("MAX_RESOURCE_PAGE_SIZE cannot be negative")
+	}
+	for signal, weight := range c.Server.SearchRankingWeights {
+		if !validRankingSignals[signal] {
+			return fmt.Errorf("SEARCH_RANKING_WEIGHTS signal %q is not one of recency, rating, popularity or trending", signal)
+		}
+		if weight < 0 {
+			return fmt.Errorf("SEARCH_RANKING_WEIGHTS for %s cannot be negative", signal)
+		}
+	}
+	if c.Server.MaxRequestBytes < 0 {
+		return fmt.Errorf("MAX_REQUEST_BYTES cannot be negative")
+	}
+	if c.Server.MaxResponseBytes < 0 {
+		return fmt.Errorf("MAX_RESPONSE_BYTES cannot be negative")
+	}
+	for tool, limit := range c.Server.ToolResponseBytes {
+		if limit < 0 {
+			return fmt.Errorf("TOOL_MAX_RESPONSE_BYTES for %s cannot be negative", tool)
+		}
+	}
+	if c.Server.StatusFile != "" && c.Server.StatusInterval <= 0 {
+		return fmt.Errorf("STATUS_INTERVAL must be positive when STATUS_FILE is set")
+	}
+	if c.Server.RequestLogSize < 0 {
+		return fmt.Errorf("REQUEST_LOG_SIZE cannot be negative")
+	}
+	if c.Server.LogMaxPayloadBytes < 0 {
+		return fmt.Errorf("LOG_MAX_PAYLOAD_BYTES cannot be negative")
+	}
+	if !validValidationPolicies[strings.ToLower(c.Server.ValidationPolicy)] {
+		return fmt.Errorf("VALIDATION_POLICY %q is not one of strict or lenient", c.Server.ValidationPolicy)
+	}
+	if !validVerbosities[strings.ToLower(c.Server.Verbosity)] {
+		return fmt.Errorf("RESPONSE_VERBOSITY %q is not one of both, json or summary", c.Server.Verbosity)
+	}
 	if c.Image.MaxSize <= 0 {
 		return fmt.Errorf("MAX_IMAGE_SIZE must be positive")
 	}
 	if len(c.Image.AllowedTypes) == 0 {
 		return fmt.Errorf("ALLOWED_IMAGE_TYPES cannot be empty")
 	}
+	if !validImageOutputFormats[strings.ToLower(c.Image.OutputFormat)] {
+		return fmt.Errorf("IMAGE_OUTPUT_FORMAT %q is not one of jpeg or png", c.Image.OutputFormat)
+	}
+	if c.Image.Quality < 0 || c.Image.Quality > 100 {
+		return fmt.Errorf("IMAGE_QUALITY must be between 0 and 100")
+	}
+	if c.Image.MaxWidth < 0 || c.Image.MaxHeight < 0 {
+		return fmt.Errorf("MAX_IMAGE_WIDTH and MAX_IMAGE_HEIGHT cannot be negative")
+	}
+	if c.Image.DownloadTimeout < 0 {
+		return fmt.Errorf("IMAGE_DOWNLOAD_TIMEOUT cannot be negative")
+	}
+	if c.Image.CDNBaseURL != "" {
+		if u, err := url.Parse(c.Image.CDNBaseURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.RawQuery != "" {
+			return fmt.Errorf("POSTER_CDN_BASE_URL %q is not an http or https URL without a query", c.Image.CDNBaseURL)
+		}
+		if c.Image.CDNSigningKey != "" && c.Image.CDNURLExpiry <= 0 {
+			return fmt.Errorf("POSTER_CDN_URL_EXPIRY must be positive")
+		}
+	}
+	if c.HTTP.RetryBackoff < 0 || c.HTTP.MaxRetryBackoff < 0 || c.HTTP.OpenDuration < 0 || c.HTTP.FailureThreshold < 0 {
+		return fmt.Errorf("HTTP_RETRY_BACKOFF, HTTP_MAX_RETRY_BACKOFF, HTTP_CIRCUIT_FAILURE_THRESHOLD and HTTP_CIRCUIT_OPEN_DURATION cannot be negative")
+	}
+	if c.Events.HistorySize < 0 || c.Events.WebhookMaxAttempts < 0 || c.Events.WebhookQueueSize < 0 || c.Events.WebhookBackoff < 0 {
+		return fmt.Errorf("EVENT_HISTORY_SIZE, EVENT_WEBHOOK_MAX_ATTEMPTS, EVENT_WEBHOOK_QUEUE_SIZE and EVENT_WEBHOOK_RETRY_BACKOFF cannot be negative")
+	}
+	for _, webhookURL := range c.Events.WebhookURLs {
+		if u, err := url.Parse(webhookURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("EVENT_WEBHOOK_URLS entry %q is not an http or https URL", webhookURL)
+		}
+	}
+	if c.WriteQueue.Enabled && (c.WriteQueue.Capacity <= 0 || c.WriteQueue.BatchSize <= 0) {
+		return fmt.Errorf("WRITE_QUEUE_CAPACITY and WRITE_QUEUE_BATCH_SIZE must be positive")
+	}
+	if c.Retention.HistoryDays < 0 || c.Retention.AccessDays < 0 {
+		return fmt.Errorf("RETENTION_HISTORY_DAYS and RETENTION_ACCESS_DAYS cannot be negative")
+	}
+	if c.Retention.Interval <= 0 && (c.Retention.HistoryDays > 0 || c.Retention.AccessDays > 0) {
+		return fmt.Errorf("RETENTION_INTERVAL must be positive when a retention period is set")
+	}
 	return nil
 }
 
-// ConnectionString returns the SQLite database file path
+// validJournalModes are the SQLite journal modes DB_JOURNAL_MODE accepts;
+// empty keeps the database's current mode
+var validJournalModes = map[string]bool{
+	"": true, "WAL": true, "DELETE": true, "TRUNCATE": true, "PERSIST": true, "MEMORY": true, "OFF": true,
+}
+
+// validImageOutputFormats are the formats IMAGE_OUTPUT_FORMAT accepts; empty
+// keeps each image's own format
+var validImageOutputFormats = map[string]bool{"": true, "jpeg": true, "jpg": true, "png": true}
+
+// validRankingSignals are the signals SEARCH_RANKING_WEIGHTS can weight
+var validRankingSignals = map[string]bool{"recency": true, "rating": true, "popularity": true, "trending": true}
+
+// validValidationPolicies are the policies VALIDATION_POLICY accepts; empty
+// is lenient
+var validValidationPolicies = map[string]bool{"": true, "lenient": true, "strict": true}
+
+// validVerbosities are the verbosities RESPONSE_VERBOSITY accepts; empty is
+// both
+var validVerbosities = map[string]bool{"": true, "both": true, "json": true, "summary": true}
+
+// ConnectionString returns the SQLite DSN: the database file path plus the
+// modernc.org/sqlite parameters applied to every pooled connection. The busy
+// timeout is set before the journal mode, since switching to WAL needs the
+// lock, and transactions begin IMMEDIATE so two writers cannot deadlock
+// upgrading read locks. Foreign keys are enforced on every connection, since
+// SQLite leaves them off by default and ON DELETE rules would not run.
 func (c *DatabaseConfig) ConnectionString() string {
-	return c.Name
+	params := []string{}
+	if c.BusyTimeout > 0 {
+		params = append(params, fmt.Sprintf("_pragma=busy_timeout(%d)", c.BusyTimeout.Milliseconds()))
+	}
+	if c.JournalMode != "" {
+		params = append(params, fmt.Sprintf("_pragma=journal_mode(%s)", strings.ToUpper(c.JournalMode)))
+	}
+	params = append(params, "_pragma=foreign_keys(1)", "_txlock=immediate")
+
+	separator := "?"
+	if strings.Contains(c.Name, "?") {
+		separator = "&"
+	}
+	return c.Name + separator + strings.Join(params, "&")
+}
+
+// InMemory reports whether the database lives in memory, where every
+// connection opens a separate database and the pool must stay at one
+func (c *DatabaseConfig) InMemory() bool {
+	return c.Name == ":memory:" || strings.Contains(c.Name, "mode=memory")
 }
 
 // Helper functions
@@ -139,6 +579,55 @@ func getEnvAsDuration(key string, defaultValue string) time.Duration {
 	return duration
 }
 
+// getEnvAsIntMap parses a comma-separated list of name=integer pairs,
+// e.g. "search_movies=65536,search_actors=32768"; malformed pairs are ignored
+func getEnvAsIntMap(key string, defaultValue map[string]int) map[string]int {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return defaultValue
+	}
+
+	values := map[string]int{}
+	for _, pair := range strings.Split(value, ",") {
+		name, number, found := strings.Cut(pair, "=")
+		intValue, err := strconv.Atoi(strings.TrimSpace(number))
+		if !found || err != nil || strings.TrimSpace(name) == "" {
+			continue
+		}
+		values[strings.TrimSpace(name)] = intValue
+	}
+	return values
+}
+
+// getEnvAsFloatMap parses a comma-separated list of name=number pairs,
+// e.g. "recency=0.5,rating=1"; malformed pairs are ignored
+func getEnvAsFloatMap(key string, defaultValue map[string]float64) map[string]float64 {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return defaultValue
+	}
+
+	values := map[string]float64{}
+	for _, pair := range strings.Split(value, ",") {
+		name, number, found := strings.Cut(pair, "=")
+		floatValue, err := strconv.ParseFloat(strings.TrimSpace(number), 64)
+		if !found || err != nil || strings.TrimSpace(name) == "" {
+			continue
+		}
+		values[strings.TrimSpace(name)] = floatValue
+	}
+	return values
+}
+
+// getEnvAsFields splits a command line on spaces; arguments cannot be quoted
+func getEnvAsFields(key string, defaultValue []string) []string {
+	value, exists := os.LookupEnv(key)
+	if exists {
+		return strings.Fields(value)
+	}
+	return defaultValue
+}
+
 func getEnvAsStringSlice(key string, defaultValue []string) []string {
 	value, exists := os.LookupEnv(key)
 	if exists {
@@ -31,20 +31,76 @@ func TestLoad(t *testing.T) {
 			want: &Config{
 				Database: DatabaseConfig{
 					Name:            "movies.db",
-					MaxOpenConns:    1,
-					MaxIdleConns:    1,
+					MaxOpenConns:    4,
+					MaxIdleConns:    4,
 					ConnMaxLifetime: 0,
 					MigrationsPath:  "file://migrations",
+
+					JournalMode: "WAL",
+					BusyTimeout: 5 * time.Second,
+
+					HealthCheckInterval: 30 * time.Second,
+					HealthCheckTimeout:  5 * time.Second,
+
+					BackupDir: "backups",
 				},
 				Server: ServerConfig{
-					LogLevel: "info",
-					Timeout:  30 * time.Second,
+					LogLevel:        "info",
+					Timeout:         30 * time.Second,
+					ShutdownTimeout: 10 * time.Second,
+					MaxBatchSize:    100,
+					MaxPageSize:     100,
+
+					MaxRequestBytes:  8 * 1024 * 1024,
+					MaxResponseBytes: 256 * 1024,
+
+					StatusInterval: 2 * time.Second,
+
+					RequestLogSize: 100,
+
+					LogRedactFields:    []string{"description", "bio", "biography", "bio_sections", "career_overview"},
+					LogMaxPayloadBytes: 1024,
+
+					ValidationPolicy: "lenient",
+
+					InteractiveTools: true,
+
+					Locale: "en",
+
+					Verbosity: "both",
 				},
 				Image: ImageConfig{
 					MaxSize:          5 * 1024 * 1024,
 					AllowedTypes:     []string{"image/jpeg", "image/png", "image/webp"},
 					EnableThumbnails: true,
 					ThumbnailSize:    "200x200",
+					DownloadTimeout:  30 * time.Second,
+					CDNURLExpiry:     time.Hour,
+				},
+				WriteQueue: WriteQueueConfig{
+					Enabled:       false,
+					Capacity:      1000,
+					BatchSize:     50,
+					FlushInterval: 100 * time.Millisecond,
+				},
+				TMDB: TMDBConfig{
+					BaseURL: "https://api.themoviedb.org/3",
+				},
+				HTTP: HTTPConfig{
+					MaxRetries:       2,
+					RetryBackoff:     200 * time.Millisecond,
+					MaxRetryBackoff:  5 * time.Second,
+					FailureThreshold: 5,
+					OpenDuration:     30 * time.Second,
+				},
+				Events: EventsConfig{
+					HistorySize:        100,
+					WebhookMaxAttempts: 5,
+					WebhookBackoff:     time.Second,
+					WebhookQueueSize:   1000,
+				},
+				Retention: RetentionConfig{
+					Interval: 24 * time.Hour,
 				},
 			},
 			wantErr: false,
@@ -52,17 +108,75 @@ func TestLoad(t *testing.T) {
 		{
 			name: "custom values",
 			envVars: map[string]string{
-				"DB_NAME":              "custom.db",
-				"DB_MAX_OPEN_CONNS":    "2",
-				"DB_MAX_IDLE_CONNS":    "2",
-				"DB_CONN_MAX_LIFETIME": "2h",
-				"MIGRATIONS_PATH":      "file://custom/migrations",
-				"LOG_LEVEL":            "debug",
-				"SERVER_TIMEOUT":       "1m",
-				"MAX_IMAGE_SIZE":       "10485760",
-				"ALLOWED_IMAGE_TYPES":  "image/jpeg,image/png",
-				"ENABLE_THUMBNAILS":    "false",
-				"THUMBNAIL_SIZE":       "300x300",
+				"DB_NAME":                        "custom.db",
+				"DB_MAX_OPEN_CONNS":              "2",
+				"DB_MAX_IDLE_CONNS":              "2",
+				"DB_CONN_MAX_LIFETIME":           "2h",
+				"MIGRATIONS_PATH":                "file://custom/migrations",
+				"DB_JOURNAL_MODE":                "delete",
+				"DB_BUSY_TIMEOUT":                "250ms",
+				"DB_HEALTH_CHECK_INTERVAL":       "10s",
+				"DB_HEALTH_CHECK_TIMEOUT":        "1s",
+				"TENANT_DATABASE_DIR":            "/data/tenants",
+				"DB_INIT_SEED":                   "classics",
+				"DB_SLOW_QUERY_THRESHOLD":        "100ms",
+				"DB_MAINTENANCE_INTERVAL":        "168h",
+				"BACKUP_DIR":                     "/data/backups",
+				"LOG_LEVEL":                      "debug",
+				"SERVER_TIMEOUT":                 "1m",
+				"SERVER_SHUTDOWN_TIMEOUT":        "5s",
+				"MAX_BATCH_SIZE":                 "25",
+				"MAX_RESOURCE_PAGE_SIZE":         "250",
+				"SEARCH_RANKING_WEIGHTS":         "recency=0.5, rating = 1,bad",
+				"MAX_REQUEST_BYTES":              "1048576",
+				"MAX_RESPONSE_BYTES":             "65536",
+				"TOOL_MAX_RESPONSE_BYTES":        "search_movies=32768, search_actors = 0,bad",
+				"STATUS_FILE":                    "/run/movies/status.json",
+				"STATUS_INTERVAL":                "500ms",
+				"REQUEST_LOG_SIZE":               "20",
+				"LOG_REDACT_FIELDS":              "description,title",
+				"LOG_MAX_PAYLOAD_BYTES":          "0",
+				"MAX_IMAGE_SIZE":                 "10485760",
+				"ALLOWED_IMAGE_TYPES":            "image/jpeg,image/png",
+				"ENABLE_THUMBNAILS":              "false",
+				"THUMBNAIL_SIZE":                 "300x300",
+				"IMAGE_OUTPUT_FORMAT":            "jpeg",
+				"IMAGE_QUALITY":                  "70",
+				"STRIP_IMAGE_METADATA":           "true",
+				"MAX_IMAGE_WIDTH":                "1000",
+				"MAX_IMAGE_HEIGHT":               "1500",
+				"IMAGE_ALLOWED_HOSTS":            "image.tmdb.org,upload.wikimedia.org",
+				"IMAGE_DENIED_HOSTS":             "evil.test",
+				"IMAGE_ALLOW_PRIVATE_NETWORKS":   "true",
+				"IMAGE_DOWNLOAD_TIMEOUT":         "5s",
+				"POSTER_CDN_BASE_URL":            "https://cdn.example.com/media",
+				"POSTER_CDN_SIGNING_KEY":         "cdn-key",
+				"POSTER_CDN_URL_EXPIRY":          "15m",
+				"WRITE_QUEUE_ENABLED":            "true",
+				"WRITE_QUEUE_CAPACITY":           "200",
+				"WRITE_QUEUE_BATCH_SIZE":         "20",
+				"WRITE_QUEUE_FLUSH_INTERVAL":     "250ms",
+				"TMDB_API_KEY":                   "secret",
+				"TMDB_BASE_URL":                  "http://localhost:8080/3",
+				"HTTP_MAX_RETRIES":               "-1",
+				"HTTP_RETRY_BACKOFF":             "100ms",
+				"HTTP_MAX_RETRY_BACKOFF":         "1s",
+				"HTTP_CIRCUIT_FAILURE_THRESHOLD": "3",
+				"HTTP_CIRCUIT_OPEN_DURATION":     "1m",
+				"EVENT_HISTORY_SIZE":             "20",
+				"EVENT_WEBHOOK_URLS":             "https://hooks.example.com/movies,http://localhost:9000",
+				"EVENT_WEBHOOK_SECRET":           "hook-secret",
+				"EVENT_WEBHOOK_MAX_ATTEMPTS":     "3",
+				"EVENT_WEBHOOK_RETRY_BACKOFF":    "500ms",
+				"EVENT_WEBHOOK_QUEUE_SIZE":       "50",
+				"RETENTION_INTERVAL":             "6h",
+				"RETENTION_HISTORY_DAYS":         "90",
+				"RETENTION_ACCESS_DAYS":          "30",
+				"SYNC_REMOTE_COMMAND":            "ssh  media-box movies-mcp-server-sdk",
+				"VALIDATION_POLICY":              "strict",
+				"INTERACTIVE_TOOLS":              "false",
+				"SERVER_LOCALE":                  "fr",
+				"RESPONSE_VERBOSITY":             "summary",
 			},
 			want: &Config{
 				Database: DatabaseConfig{
@@ -71,16 +185,96 @@ func TestLoad(t *testing.T) {
 					MaxIdleConns:    2,
 					ConnMaxLifetime: 2 * time.Hour,
 					MigrationsPath:  "file://custom/migrations",
+
+					JournalMode: "delete",
+					BusyTimeout: 250 * time.Millisecond,
+
+					HealthCheckInterval: 10 * time.Second,
+					HealthCheckTimeout:  time.Second,
+					TenantDir:           "/data/tenants",
+					InitSeed:            "classics",
+					SlowQueryThreshold:  100 * time.Millisecond,
+					MaintenanceInterval: 168 * time.Hour,
+					BackupDir:           "/data/backups",
 				},
 				Server: ServerConfig{
-					LogLevel: "debug",
-					Timeout:  time.Minute,
+					LogLevel:        "debug",
+					Timeout:         time.Minute,
+					ShutdownTimeout: 5 * time.Second,
+					MaxBatchSize:    25,
+					MaxPageSize:     250,
+
+					SearchRankingWeights: map[string]float64{"recency": 0.5, "rating": 1},
+
+					MaxRequestBytes:   1048576,
+					MaxResponseBytes:  65536,
+					ToolResponseBytes: map[string]int{"search_movies": 32768, "search_actors": 0},
+
+					StatusFile:     "/run/movies/status.json",
+					StatusInterval: 500 * time.Millisecond,
+
+					RequestLogSize: 20,
+
+					LogRedactFields: []string{"description", "title"},
+
+					ValidationPolicy: "strict",
+
+					Locale: "fr",
+
+					Verbosity: "summary",
 				},
 				Image: ImageConfig{
 					MaxSize:          10485760,
 					AllowedTypes:     []string{"image/jpeg", "image/png"},
 					EnableThumbnails: false,
 					ThumbnailSize:    "300x300",
+					OutputFormat:     "jpeg",
+					Quality:          70,
+					StripMetadata:    true,
+					MaxWidth:         1000,
+					MaxHeight:        1500,
+
+					AllowedHosts:         []string{"image.tmdb.org", "upload.wikimedia.org"},
+					DeniedHosts:          []string{"evil.test"},
+					AllowPrivateNetworks: true,
+					DownloadTimeout:      5 * time.Second,
+
+					CDNBaseURL:    "https://cdn.example.com/media",
+					CDNSigningKey: "cdn-key",
+					CDNURLExpiry:  15 * time.Minute,
+				},
+				WriteQueue: WriteQueueConfig{
+					Enabled:       true,
+					Capacity:      200,
+					BatchSize:     20,
+					FlushInterval: 250 * time.Millisecond,
+				},
+				TMDB: TMDBConfig{
+					APIKey:  "secret",
+					BaseURL: "http://localhost:8080/3",
+				},
+				HTTP: HTTPConfig{
+					MaxRetries:       -1,
+					RetryBackoff:     100 * time.Millisecond,
+					MaxRetryBackoff:  time.Second,
+					FailureThreshold: 3,
+					OpenDuration:     time.Minute,
+				},
+				Events: EventsConfig{
+					HistorySize:        20,
+					WebhookURLs:        []string{"https://hooks.example.com/movies", "http://localhost:9000"},
+					WebhookSecret:      "hook-secret",
+					WebhookMaxAttempts: 3,
+					WebhookBackoff:     500 * time.Millisecond,
+					WebhookQueueSize:   50,
+				},
+				Retention: RetentionConfig{
+					Interval:    6 * time.Hour,
+					HistoryDays: 90,
+					AccessDays:  30,
+				},
+				Sync: SyncConfig{
+					RemoteCommand: []string{"ssh", "media-box", "movies-mcp-server-sdk"},
 				},
 			},
 			wantErr: false,
@@ -101,6 +295,15 @@ func TestLoad(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name: "enabled write queue with zero capacity",
+			envVars: map[string]string{
+				"WRITE_QUEUE_ENABLED":  "true",
+				"WRITE_QUEUE_CAPACITY": "0",
+			},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name: "empty allowed types",
 			envVars: map[string]string{
@@ -109,6 +312,30 @@ func TestLoad(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name: "invalid webhook URL",
+			envVars: map[string]string{
+				"EVENT_WEBHOOK_URLS": "ftp://hooks.example.com",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "invalid poster CDN URL",
+			envVars: map[string]string{
+				"POSTER_CDN_BASE_URL": "cdn.example.com",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "unknown journal mode",
+			envVars: map[string]string{
+				"DB_JOURNAL_MODE": "fast",
+			},
+			want:    nil,
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -181,6 +408,200 @@ func TestConfig_Validate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "MAX_IMAGE_SIZE must be positive",
 		},
+		{
+			name: "negative tool response limit",
+			config: &Config{
+				Database: DatabaseConfig{
+					Name: "test.db",
+				},
+				Server: ServerConfig{
+					ToolResponseBytes: map[string]int{"search_movies": -1},
+				},
+				Image: ImageConfig{
+					MaxSize:      1024,
+					AllowedTypes: []string{"image/jpeg"},
+				},
+			},
+			wantErr: true,
+			errMsg:  "TOOL_MAX_RESPONSE_BYTES for search_movies cannot be negative",
+		},
+		{
+			name: "status file without an interval",
+			config: &Config{
+				Database: DatabaseConfig{
+					Name: "test.db",
+				},
+				Server: ServerConfig{
+					StatusFile: "status.json",
+				},
+				Image: ImageConfig{
+					MaxSize:      1024,
+					AllowedTypes: []string{"image/jpeg"},
+				},
+			},
+			wantErr: true,
+			errMsg:  "STATUS_INTERVAL must be positive when STATUS_FILE is set",
+		},
+		{
+			name: "negative request log size",
+			config: &Config{
+				Database: DatabaseConfig{
+					Name: "test.db",
+				},
+				Server: ServerConfig{
+					RequestLogSize:   -1,
+					ValidationPolicy: "lenient",
+				},
+				Image: ImageConfig{
+					MaxSize:      1024,
+					AllowedTypes: []string{"image/jpeg"},
+				},
+			},
+			wantErr: true,
+			errMsg:  "REQUEST_LOG_SIZE cannot be negative",
+		},
+		{
+			name: "negative log payload size",
+			config: &Config{
+				Database: DatabaseConfig{
+					Name: "test.db",
+				},
+				Server: ServerConfig{
+					LogMaxPayloadBytes: -1,
+					ValidationPolicy:   "lenient",
+				},
+				Image: ImageConfig{
+					MaxSize:      1024,
+					AllowedTypes: []string{"image/jpeg"},
+				},
+			},
+			wantErr: true,
+			errMsg:  "LOG_MAX_PAYLOAD_BYTES cannot be negative",
+		},
+		{
+			name: "unknown validation policy",
+			config: &Config{
+				Database: DatabaseConfig{
+					Name: "test.db",
+				},
+				Server: ServerConfig{
+					ValidationPolicy: "paranoid",
+				},
+				Image: ImageConfig{
+					MaxSize:      1024,
+					AllowedTypes: []string{"image/jpeg"},
+				},
+			},
+			wantErr: true,
+			errMsg:  `VALIDATION_POLICY "paranoid" is not one of strict or lenient`,
+		},
+		{
+			name: "unknown verbosity",
+			config: &Config{
+				Database: DatabaseConfig{
+					Name: "test.db",
+				},
+				Server: ServerConfig{
+					Verbosity: "chatty",
+				},
+				Image: ImageConfig{
+					MaxSize:      1024,
+					AllowedTypes: []string{"image/jpeg"},
+				},
+			},
+			wantErr: true,
+			errMsg:  `RESPONSE_VERBOSITY "chatty" is not one of both, json or summary`,
+		},
+		{
+			name: "unknown ranking signal",
+			config: &Config{
+				Database: DatabaseConfig{
+					Name: "test.db",
+				},
+				Server: ServerConfig{
+					SearchRankingWeights: map[string]float64{"hype": 1},
+					ValidationPolicy:     "lenient",
+				},
+				Image: ImageConfig{
+					MaxSize:      1024,
+					AllowedTypes: []string{"image/jpeg"},
+				},
+			},
+			wantErr: true,
+			errMsg:  `SEARCH_RANKING_WEIGHTS signal "hype" is not one of recency, rating, popularity or trending`,
+		},
+		{
+			name: "negative ranking weight",
+			config: &Config{
+				Database: DatabaseConfig{
+					Name: "test.db",
+				},
+				Server: ServerConfig{
+					SearchRankingWeights: map[string]float64{"rating": -1},
+					ValidationPolicy:     "lenient",
+				},
+				Image: ImageConfig{
+					MaxSize:      1024,
+					AllowedTypes: []string{"image/jpeg"},
+				},
+			},
+			wantErr: true,
+			errMsg:  "SEARCH_RANKING_WEIGHTS for rating cannot be negative",
+		},
+		{
+			name: "enabled write queue with zero batch size",
+			config: &Config{
+				Database: DatabaseConfig{
+					Name: "test.db",
+				},
+				Image: ImageConfig{
+					MaxSize:      1024,
+					AllowedTypes: []string{"image/jpeg"},
+				},
+				WriteQueue: WriteQueueConfig{
+					Enabled:   true,
+					Capacity:  100,
+					BatchSize: 0,
+				},
+			},
+			wantErr: true,
+			errMsg:  "WRITE_QUEUE_CAPACITY and WRITE_QUEUE_BATCH_SIZE must be positive",
+		},
+		{
+			name: "negative retention period",
+			config: &Config{
+				Database: DatabaseConfig{
+					Name: "test.db",
+				},
+				Image: ImageConfig{
+					MaxSize:      1024,
+					AllowedTypes: []string{"image/jpeg"},
+				},
+				Retention: RetentionConfig{
+					Interval:   time.Hour,
+					AccessDays: -1,
+				},
+			},
+			wantErr: true,
+			errMsg:  "RETENTION_HISTORY_DAYS and RETENTION_ACCESS_DAYS cannot be negative",
+		},
+		{
+			name: "retention period without interval",
+			config: &Config{
+				Database: DatabaseConfig{
+					Name: "test.db",
+				},
+				Image: ImageConfig{
+					MaxSize:      1024,
+					AllowedTypes: []string{"image/jpeg"},
+				},
+				Retention: RetentionConfig{
+					HistoryDays: 30,
+				},
+			},
+			wantErr: true,
+			errMsg:  "RETENTION_INTERVAL must be positive when a retention period is set",
+		},
 		{
 			name: "empty allowed types",
 			config: &Config{
@@ -195,6 +616,96 @@ func TestConfig_Validate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "ALLOWED_IMAGE_TYPES cannot be empty",
 		},
+		{
+			name: "negative busy timeout",
+			config: &Config{
+				Database: DatabaseConfig{
+					Name:        "test.db",
+					BusyTimeout: -time.Second,
+				},
+				Image: ImageConfig{
+					MaxSize:      1024,
+					AllowedTypes: []string{"image/jpeg"},
+				},
+			},
+			wantErr: true,
+			errMsg:  "DB_BUSY_TIMEOUT cannot be negative",
+		},
+		{
+			name: "negative slow query threshold",
+			config: &Config{
+				Database: DatabaseConfig{
+					Name:               "test.db",
+					SlowQueryThreshold: -time.Second,
+				},
+				Image: ImageConfig{
+					MaxSize:      1024,
+					AllowedTypes: []string{"image/jpeg"},
+				},
+			},
+			wantErr: true,
+			errMsg:  "DB_SLOW_QUERY_THRESHOLD cannot be negative",
+		},
+		{
+			name: "negative maintenance interval",
+			config: &Config{
+				Database: DatabaseConfig{
+					Name:                "test.db",
+					MaintenanceInterval: -time.Hour,
+				},
+				Image: ImageConfig{
+					MaxSize:      1024,
+					AllowedTypes: []string{"image/jpeg"},
+				},
+			},
+			wantErr: true,
+			errMsg:  "DB_MAINTENANCE_INTERVAL cannot be negative",
+		},
+		{
+			name: "unsupported image output format",
+			config: &Config{
+				Database: DatabaseConfig{
+					Name: "test.db",
+				},
+				Image: ImageConfig{
+					MaxSize:      1024,
+					AllowedTypes: []string{"image/jpeg"},
+					OutputFormat: "webp",
+				},
+			},
+			wantErr: true,
+			errMsg:  `IMAGE_OUTPUT_FORMAT "webp" is not one of jpeg or png`,
+		},
+		{
+			name: "image quality out of range",
+			config: &Config{
+				Database: DatabaseConfig{
+					Name: "test.db",
+				},
+				Image: ImageConfig{
+					MaxSize:      1024,
+					AllowedTypes: []string{"image/jpeg"},
+					Quality:      101,
+				},
+			},
+			wantErr: true,
+			errMsg:  "IMAGE_QUALITY must be between 0 and 100",
+		},
+		{
+			name: "negative image dimensions",
+			config: &Config{
+				Database: DatabaseConfig{
+					Name: "test.db",
+				},
+				Image: ImageConfig{
+					MaxSize:      1024,
+					AllowedTypes: []string{"image/jpeg"},
+					MaxWidth:     -1,
+				},
+			},
+			wantErr: true,
+			errMsg:  "MAX_IMAGE_WIDTH and MAX_IMAGE_HEIGHT cannot be negative",
+		},
 	}
 
 	for _, tt := range tests {
@@ -221,14 +732,31 @@ func TestDatabaseConfig_ConnectionString(t *testing.T) {
 			config: DatabaseConfig{
 				Name: "movies.db",
 			},
-			want: "movies.db",
+			want: "movies.db?_pragma=foreign_keys(1)&_txlock=immediate",
 		},
 		{
 			name: "custom database path",
 			config: DatabaseConfig{
 				Name: "/var/data/custom.db",
 			},
-			want: "/var/data/custom.db",
+			want: "/var/data/custom.db?_pragma=foreign_keys(1)&_txlock=immediate",
+		},
+		{
+			name: "wal with busy timeout",
+			config: DatabaseConfig{
+				Name:        "movies.db",
+				JournalMode: "wal",
+				BusyTimeout: 5 * time.Second,
+			},
+			want: "movies.db?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_pragma=foreign_keys(1)&_txlock=immediate",
+		},
+		{
+			name: "name with query parameters",
+			config: DatabaseConfig{
+				Name:        "file:movies.db?cache=shared",
+				BusyTimeout: time.Second,
+			},
+			want: "file:movies.db?cache=shared&_pragma=busy_timeout(1000)&_pragma=foreign_keys(1)&_txlock=immediate",
 		},
 	}
 
@@ -384,3 +912,23 @@ func splitEnvVar(env string) []string {
 	}
 	return []string{env, ""}
 }
+
+func TestDatabaseConfig_InMemory(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{name: "movies.db", want: false},
+		{name: ":memory:", want: true},
+		{name: "file:test?mode=memory&cache=shared", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := DatabaseConfig{Name: tt.name}
+			if got := config.InMemory(); got != tt.want {
+				t.Errorf("DatabaseConfig.InMemory() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,467 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// fileConfig mirrors Config as it appears in a YAML config file. Pointer
+// fields distinguish "not set" from zero values so only keys present in the
+// file override the defaults.
+type fileConfig struct {
+	Database   *fileDatabaseConfig   `yaml:"database,omitempty"`
+	Server     *fileServerConfig     `yaml:"server,omitempty"`
+	Logging    *fileLoggingConfig    `yaml:"logging,omitempty"`
+	Image      *fileImageConfig      `yaml:"image,omitempty"`
+	WriteQueue *fileWriteQueueConfig `yaml:"write_queue,omitempty"`
+	TMDB       *fileTMDBConfig       `yaml:"tmdb,omitempty"`
+	HTTP       *fileHTTPConfig       `yaml:"http,omitempty"`
+	Events     *fileEventsConfig     `yaml:"events,omitempty"`
+	Retention  *fileRetentionConfig  `yaml:"retention,omitempty"`
+	Sync       *fileSyncConfig       `yaml:"sync,omitempty"`
+}
+
+type fileDatabaseConfig struct {
+	Name                *string `yaml:"name,omitempty"`
+	MaxOpenConns        *int    `yaml:"max_open_conns,omitempty"`
+	MaxIdleConns        *int    `yaml:"max_idle_conns,omitempty"`
+	ConnMaxLifetime     *string `yaml:"conn_max_lifetime,omitempty"`
+	MigrationsPath      *string `yaml:"migrations_path,omitempty"`
+	JournalMode         *string `yaml:"journal_mode,omitempty"`
+	BusyTimeout         *string `yaml:"busy_timeout,omitempty"`
+	HealthCheckInterval *string `yaml:"health_check_interval,omitempty"`
+	HealthCheckTimeout  *string `yaml:"health_check_timeout,omitempty"`
+	TenantDir           *string `yaml:"tenant_dir,omitempty"`
+	InitSeed            *string `yaml:"init_seed,omitempty"`
+	SlowQueryThreshold  *string `yaml:"slow_query_threshold,omitempty"`
+	MaintenanceInterval *string `yaml:"maintenance_interval,omitempty"`
+	BackupDir           *string `yaml:"backup_dir,omitempty"`
+}
+
+type fileServerConfig struct {
+	Timeout         *string `yaml:"timeout,omitempty"`
+	ShutdownTimeout *string `yaml:"shutdown_timeout,omitempty"`
+	MaxBatchSize    *int    `yaml:"max_batch_size,omitempty"`
+	MaxPageSize     *int    `yaml:"max_resource_page_size,omitempty"`
+
+	SearchRankingWeights map[string]float64 `yaml:"search_ranking_weights,omitempty"`
+
+	MaxRequestBytes   *int           `yaml:"max_request_bytes,omitempty"`
+	MaxResponseBytes  *int           `yaml:"max_response_bytes,omitempty"`
+	ToolResponseBytes map[string]int `yaml:"tool_max_response_bytes,omitempty"`
+
+	StatusFile     *string `yaml:"status_file,omitempty"`
+	StatusInterval *string `yaml:"status_interval,omitempty"`
+
+	RequestLogSize *int `yaml:"request_log_size,omitempty"`
+
+	ValidationPolicy *string `yaml:"validation_policy,omitempty"`
+
+	InteractiveTools *bool `yaml:"interactive_tools,omitempty"`
+
+	Locale *string `yaml:"locale,omitempty"`
+
+	Verbosity *string `yaml:"verbosity,omitempty"`
+}
+
+type fileLoggingConfig struct {
+	Level           *string  `yaml:"level,omitempty"`
+	RedactFields    []string `yaml:"redact_fields,omitempty"`
+	MaxPayloadBytes *int     `yaml:"max_payload_bytes,omitempty"`
+}
+
+type fileImageConfig struct {
+	MaxSize          *int64   `yaml:"max_size,omitempty"`
+	AllowedTypes     []string `yaml:"allowed_types,omitempty"`
+	EnableThumbnails *bool    `yaml:"enable_thumbnails,omitempty"`
+	ThumbnailSize    *string  `yaml:"thumbnail_size,omitempty"`
+	OutputFormat     *string  `yaml:"output_format,omitempty"`
+	Quality          *int     `yaml:"quality,omitempty"`
+	StripMetadata    *bool    `yaml:"strip_metadata,omitempty"`
+	MaxWidth         *int     `yaml:"max_width,omitempty"`
+	MaxHeight        *int     `yaml:"max_height,omitempty"`
+
+	AllowedHosts         []string `yaml:"allowed_hosts,omitempty"`
+	DeniedHosts          []string `yaml:"denied_hosts,omitempty"`
+	AllowPrivateNetworks *bool    `yaml:"allow_private_networks,omitempty"`
+	DownloadTimeout      *string  `yaml:"download_timeout,omitempty"`
+
+	CDNBaseURL    *string `yaml:"cdn_base_url,omitempty"`
+	CDNSigningKey *string `yaml:"cdn_signing_key,omitempty"`
+	CDNURLExpiry  *string `yaml:"cdn_url_expiry,omitempty"`
+}
+
+type fileWriteQueueConfig struct {
+	Enabled       *bool   `yaml:"enabled,omitempty"`
+	Capacity      *int    `yaml:"capacity,omitempty"`
+	BatchSize     *int    `yaml:"batch_size,omitempty"`
+	FlushInterval *string `yaml:"flush_interval,omitempty"`
+}
+
+type fileTMDBConfig struct {
+	APIKey  *string `yaml:"api_key,omitempty"`
+	BaseURL *string `yaml:"base_url,omitempty"`
+}
+
+type fileHTTPConfig struct {
+	MaxRetries       *int    `yaml:"max_retries,omitempty"`
+	RetryBackoff     *string `yaml:"retry_backoff,omitempty"`
+	MaxRetryBackoff  *string `yaml:"max_retry_backoff,omitempty"`
+	FailureThreshold *int    `yaml:"circuit_failure_threshold,omitempty"`
+	OpenDuration     *string `yaml:"circuit_open_duration,omitempty"`
+}
+
+type fileEventsConfig struct {
+	HistorySize        *int     `yaml:"history_size,omitempty"`
+	WebhookURLs        []string `yaml:"webhook_urls,omitempty"`
+	WebhookSecret      *string  `yaml:"webhook_secret,omitempty"`
+	WebhookMaxAttempts *int     `yaml:"webhook_max_attempts,omitempty"`
+	WebhookBackoff     *string  `yaml:"webhook_retry_backoff,omitempty"`
+	WebhookQueueSize   *int     `yaml:"webhook_queue_size,omitempty"`
+}
+
+type fileRetentionConfig struct {
+	Interval    *string `yaml:"interval,omitempty"`
+	HistoryDays *int    `yaml:"history_days,omitempty"`
+	AccessDays  *int    `yaml:"access_days,omitempty"`
+}
+
+type fileSyncConfig struct {
+	RemoteCommand []string `yaml:"remote_command,omitempty"`
+}
+
+// maskedSecret replaces secrets in the effective config output
+const maskedSecret = "********"
+
+// mergeFile overlays values from a YAML (or JSON) config file onto cfg
+func mergeFile(cfg *Config, path string) error {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var file fileConfig
+	if err := yaml.UnmarshalStrict(data, &file); err != nil {
+		return fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	if db := file.Database; db != nil {
+		setString(&cfg.Database.Name, db.Name)
+		setInt(&cfg.Database.MaxOpenConns, db.MaxOpenConns)
+		setInt(&cfg.Database.MaxIdleConns, db.MaxIdleConns)
+		setString(&cfg.Database.MigrationsPath, db.MigrationsPath)
+		setString(&cfg.Database.JournalMode, db.JournalMode)
+		setString(&cfg.Database.TenantDir, db.TenantDir)
+		setString(&cfg.Database.InitSeed, db.InitSeed)
+		setString(&cfg.Database.BackupDir, db.BackupDir)
+		if err := setDuration(&cfg.Database.ConnMaxLifetime, db.ConnMaxLifetime, "database.conn_max_lifetime"); err != nil {
+			return err
+		}
+		if err := setDuration(&cfg.Database.BusyTimeout, db.BusyTimeout, "database.busy_timeout"); err != nil {
+			return err
+		}
+		if err := setDuration(&cfg.Database.HealthCheckInterval, db.HealthCheckInterval, "database.health_check_interval"); err != nil {
+			return err
+		}
+		if err := setDuration(&cfg.Database.HealthCheckTimeout, db.HealthCheckTimeout, "database.health_check_timeout"); err != nil {
+			return err
+		}
+		if err := setDuration(&cfg.Database.SlowQueryThreshold, db.SlowQueryThreshold, "database.slow_query_threshold"); err != nil {
+			return err
+		}
+		if err := setDuration(&cfg.Database.MaintenanceInterval, db.MaintenanceInterval, "database.maintenance_interval"); err != nil {
+			return err
+		}
+	}
+
+	if server := file.Server; server != nil {
+		if err := setDuration(&cfg.Server.Timeout, server.Timeout, "server.timeout"); err != nil {
+			return err
+		}
+		if err := setDuration(&cfg.Server.ShutdownTimeout, server.ShutdownTimeout, "server.shutdown_timeout"); err != nil {
+			return err
+		}
+		setInt(&cfg.Server.MaxBatchSize, server.MaxBatchSize)
+		setInt(&cfg.Server.MaxPageSize, server.MaxPageSize)
+		if server.SearchRankingWeights != nil {
+			cfg.Server.SearchRankingWeights = server.SearchRankingWeights
+		}
+		setInt(&cfg.Server.MaxRequestBytes, server.MaxRequestBytes)
+		setInt(&cfg.Server.MaxResponseBytes, server.MaxResponseBytes)
+		if server.ToolResponseBytes != nil {
+			cfg.Server.ToolResponseBytes = server.ToolResponseBytes
+		}
+		setString(&cfg.Server.StatusFile, server.StatusFile)
+		if err := setDuration(&cfg.Server.StatusInterval, server.StatusInterval, "server.status_interval"); err != nil {
+			return err
+		}
+		setInt(&cfg.Server.RequestLogSize, server.RequestLogSize)
+		setString(&cfg.Server.ValidationPolicy, server.ValidationPolicy)
+		if server.InteractiveTools != nil {
+			cfg.Server.InteractiveTools = *server.InteractiveTools
+		}
+		setString(&cfg.Server.Locale, server.Locale)
+		setString(&cfg.Server.Verbosity, server.Verbosity)
+	}
+
+	if logging := file.Logging; logging != nil {
+		setString(&cfg.Server.LogLevel, logging.Level)
+		if logging.RedactFields != nil {
+			cfg.Server.LogRedactFields = logging.RedactFields
+		}
+		setInt(&cfg.Server.LogMaxPayloadBytes, logging.MaxPayloadBytes)
+	}
+
+	if image := file.Image; image != nil {
+		if image.MaxSize != nil {
+			cfg.Image.MaxSize = *image.MaxSize
+		}
+		if image.AllowedTypes != nil {
+			cfg.Image.AllowedTypes = image.AllowedTypes
+		}
+		if image.EnableThumbnails != nil {
+			cfg.Image.EnableThumbnails = *image.EnableThumbnails
+		}
+		setString(&cfg.Image.ThumbnailSize, image.ThumbnailSize)
+		setString(&cfg.Image.OutputFormat, image.OutputFormat)
+		setInt(&cfg.Image.Quality, image.Quality)
+		if image.StripMetadata != nil {
+			cfg.Image.StripMetadata = *image.StripMetadata
+		}
+		setInt(&cfg.Image.MaxWidth, image.MaxWidth)
+		setInt(&cfg.Image.MaxHeight, image.MaxHeight)
+		if image.AllowedHosts != nil {
+			cfg.Image.AllowedHosts = image.AllowedHosts
+		}
+		if image.DeniedHosts != nil {
+			cfg.Image.DeniedHosts = image.DeniedHosts
+		}
+		if image.AllowPrivateNetworks != nil {
+			cfg.Image.AllowPrivateNetworks = *image.AllowPrivateNetworks
+		}
+		if err := setDuration(&cfg.Image.DownloadTimeout, image.DownloadTimeout, "image.download_timeout"); err != nil {
+			return err
+		}
+		setString(&cfg.Image.CDNBaseURL, image.CDNBaseURL)
+		setString(&cfg.Image.CDNSigningKey, image.CDNSigningKey)
+		if err := setDuration(&cfg.Image.CDNURLExpiry, image.CDNURLExpiry, "image.cdn_url_expiry"); err != nil {
+			return err
+		}
+	}
+
+	if queue := file.WriteQueue; queue != nil {
+		if queue.Enabled != nil {
+			cfg.WriteQueue.Enabled = *queue.Enabled
+		}
+		setInt(&cfg.WriteQueue.Capacity, queue.Capacity)
+		setInt(&cfg.WriteQueue.BatchSize, queue.BatchSize)
+		if err := setDuration(&cfg.WriteQueue.FlushInterval, queue.FlushInterval, "write_queue.flush_interval"); err != nil {
+			return err
+		}
+	}
+
+	if tmdb := file.TMDB; tmdb != nil {
+		setString(&cfg.TMDB.APIKey, tmdb.APIKey)
+		setString(&cfg.TMDB.BaseURL, tmdb.BaseURL)
+	}
+
+	if client := file.HTTP; client != nil {
+		setInt(&cfg.HTTP.MaxRetries, client.MaxRetries)
+		setInt(&cfg.HTTP.FailureThreshold, client.FailureThreshold)
+		if err := setDuration(&cfg.HTTP.RetryBackoff, client.RetryBackoff, "http.retry_backoff"); err != nil {
+			return err
+		}
+		if err := setDuration(&cfg.HTTP.MaxRetryBackoff, client.MaxRetryBackoff, "http.max_retry_backoff"); err != nil {
+			return err
+		}
+		if err := setDuration(&cfg.HTTP.OpenDuration, client.OpenDuration, "http.circuit_open_duration"); err != nil {
+			return err
+		}
+	}
+
+	if events := file.Events; events != nil {
+		setInt(&cfg.Events.HistorySize, events.HistorySize)
+		if events.WebhookURLs != nil {
+			cfg.Events.WebhookURLs = events.WebhookURLs
+		}
+		setString(&cfg.Events.WebhookSecret, events.WebhookSecret)
+		setInt(&cfg.Events.WebhookMaxAttempts, events.WebhookMaxAttempts)
+		setInt(&cfg.Events.WebhookQueueSize, events.WebhookQueueSize)
+		if err := setDuration(&cfg.Events.WebhookBackoff, events.WebhookBackoff, "events.webhook_retry_backoff"); err != nil {
+			return err
+		}
+	}
+
+	if retention := file.Retention; retention != nil {
+		setInt(&cfg.Retention.HistoryDays, retention.HistoryDays)
+		setInt(&cfg.Retention.AccessDays, retention.AccessDays)
+		if err := setDuration(&cfg.Retention.Interval, retention.Interval, "retention.interval"); err != nil {
+			return err
+		}
+	}
+
+	if remote := file.Sync; remote != nil && remote.RemoteCommand != nil {
+		cfg.Sync.RemoteCommand = remote.RemoteCommand
+	}
+
+	return nil
+}
+
+// EffectiveYAML renders the merged configuration in config file format.
+// The TMDB API key and webhook secret are masked so the output is safe to share.
+func (c *Config) EffectiveYAML() ([]byte, error) {
+	durationString := func(d time.Duration) *string {
+		s := d.String()
+		return &s
+	}
+
+	var apiKey *string
+	if c.TMDB.APIKey != "" {
+		masked := maskedSecret
+		apiKey = &masked
+	}
+
+	var webhookSecret *string
+	if c.Events.WebhookSecret != "" {
+		masked := maskedSecret
+		webhookSecret = &masked
+	}
+
+	var cdnSigningKey *string
+	if c.Image.CDNSigningKey != "" {
+		masked := maskedSecret
+		cdnSigningKey = &masked
+	}
+
+	file := fileConfig{
+		Database: &fileDatabaseConfig{
+			Name:                &c.Database.Name,
+			MaxOpenConns:        &c.Database.MaxOpenConns,
+			MaxIdleConns:        &c.Database.MaxIdleConns,
+			ConnMaxLifetime:     durationString(c.Database.ConnMaxLifetime),
+			MigrationsPath:      &c.Database.MigrationsPath,
+			JournalMode:         &c.Database.JournalMode,
+			BusyTimeout:         durationString(c.Database.BusyTimeout),
+			HealthCheckInterval: durationString(c.Database.HealthCheckInterval),
+			HealthCheckTimeout:  durationString(c.Database.HealthCheckTimeout),
+			TenantDir:           &c.Database.TenantDir,
+			InitSeed:            &c.Database.InitSeed,
+			SlowQueryThreshold:  durationString(c.Database.SlowQueryThreshold),
+			MaintenanceInterval: durationString(c.Database.MaintenanceInterval),
+			BackupDir:           &c.Database.BackupDir,
+		},
+		Server: &fileServerConfig{
+			Timeout:         durationString(c.Server.Timeout),
+			ShutdownTimeout: durationString(c.Server.ShutdownTimeout),
+			MaxBatchSize:    &c.Server.MaxBatchSize,
+			MaxPageSize:     &c.Server.MaxPageSize,
+
+			SearchRankingWeights: c.Server.SearchRankingWeights,
+
+			MaxRequestBytes:   &c.Server.MaxRequestBytes,
+			MaxResponseBytes:  &c.Server.MaxResponseBytes,
+			ToolResponseBytes: c.Server.ToolResponseBytes,
+
+			StatusFile:     &c.Server.StatusFile,
+			StatusInterval: durationString(c.Server.StatusInterval),
+
+			RequestLogSize: &c.Server.RequestLogSize,
+
+			ValidationPolicy: &c.Server.ValidationPolicy,
+
+			InteractiveTools: &c.Server.InteractiveTools,
+
+			Locale: &c.Server.Locale,
+
+			Verbosity: &c.Server.Verbosity,
+		},
+		Logging: &fileLoggingConfig{
+			Level:           &c.Server.LogLevel,
+			RedactFields:    c.Server.LogRedactFields,
+			MaxPayloadBytes: &c.Server.LogMaxPayloadBytes,
+		},
+		Image: &fileImageConfig{
+			MaxSize:          &c.Image.MaxSize,
+			AllowedTypes:     c.Image.AllowedTypes,
+			EnableThumbnails: &c.Image.EnableThumbnails,
+			ThumbnailSize:    &c.Image.ThumbnailSize,
+			OutputFormat:     &c.Image.OutputFormat,
+			Quality:          &c.Image.Quality,
+			StripMetadata:    &c.Image.StripMetadata,
+			MaxWidth:         &c.Image.MaxWidth,
+			MaxHeight:        &c.Image.MaxHeight,
+
+			AllowedHosts:         c.Image.AllowedHosts,
+			DeniedHosts:          c.Image.DeniedHosts,
+			AllowPrivateNetworks: &c.Image.AllowPrivateNetworks,
+			DownloadTimeout:      durationString(c.Image.DownloadTimeout),
+
+			CDNBaseURL:    &c.Image.CDNBaseURL,
+			CDNSigningKey: cdnSigningKey,
+			CDNURLExpiry:  durationString(c.Image.CDNURLExpiry),
+		},
+		WriteQueue: &fileWriteQueueConfig{
+			Enabled:       &c.WriteQueue.Enabled,
+			Capacity:      &c.WriteQueue.Capacity,
+			BatchSize:     &c.WriteQueue.BatchSize,
+			FlushInterval: durationString(c.WriteQueue.FlushInterval),
+		},
+		TMDB: &fileTMDBConfig{
+			APIKey:  apiKey,
+			BaseURL: &c.TMDB.BaseURL,
+		},
+		HTTP: &fileHTTPConfig{
+			MaxRetries:       &c.HTTP.MaxRetries,
+			RetryBackoff:     durationString(c.HTTP.RetryBackoff),
+			MaxRetryBackoff:  durationString(c.HTTP.MaxRetryBackoff),
+			FailureThreshold: &c.HTTP.FailureThreshold,
+			OpenDuration:     durationString(c.HTTP.OpenDuration),
+		},
+		Events: &fileEventsConfig{
+			HistorySize:        &c.Events.HistorySize,
+			WebhookURLs:        c.Events.WebhookURLs,
+			WebhookSecret:      webhookSecret,
+			WebhookMaxAttempts: &c.Events.WebhookMaxAttempts,
+			WebhookBackoff:     durationString(c.Events.WebhookBackoff),
+			WebhookQueueSize:   &c.Events.WebhookQueueSize,
+		},
+		Retention: &fileRetentionConfig{
+			Interval:    durationString(c.Retention.Interval),
+			HistoryDays: &c.Retention.HistoryDays,
+			AccessDays:  &c.Retention.AccessDays,
+		},
+		Sync: &fileSyncConfig{
+			RemoteCommand: c.Sync.RemoteCommand,
+		},
+	}
+
+	return yaml.Marshal(file)
+}
+
+func setString(target *string, value *string) {
+	if value != nil {
+		*target = *value
+	}
+}
+
+func setInt(target *int, value *int) {
+	if value != nil {
+		*target = *value
+	}
+}
+
+func setDuration(target *time.Duration, value *string, key string) error {
+	if value == nil {
+		return nil
+	}
+	duration, err := time.ParseDuration(*value)
+	if err != nil {
+		return fmt.Errorf("invalid duration for %s: %w", key, err)
+	}
+	*target = duration
+	return nil
+}
@@ -0,0 +1,286 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+	return path
+}
+
+func TestLoadFile(t *testing.T) {
+	oldEnv := os.Environ()
+	defer func() {
+		os.Clearenv()
+		for _, e := range oldEnv {
+			pair := splitEnvVar(e)
+			os.Setenv(pair[0], pair[1])
+		}
+	}()
+
+	t.Run("file overrides defaults", func(t *testing.T) {
+		os.Clearenv()
+		path := writeConfigFile(t, `
+database:
+  name: file.db
+  health_check_interval: 1m
+server:
+  max_batch_size: 20
+  request_log_size: 500
+  search_ranking_weights: {popularity: 0.25}
+  validation_policy: strict
+  interactive_tools: false
+  locale: es
+  verbosity: json
+logging:
+  level: debug
+  redact_fields: [description, notes]
+  max_payload_bytes: 512
+image:
+  allowed_types: [image/png]
+  output_format: png
+  strip_metadata: true
+  max_width: 800
+write_queue:
+  enabled: true
+  batch_size: 10
+tmdb:
+  api_key: file-key
+events:
+  webhook_urls: [https://hooks.example.com/movies]
+  webhook_retry_backoff: 2s
+retention:
+  interval: 12h
+  history_days: 180
+sync:
+  remote_command: [ssh, media-box, movies-mcp-server-sdk]
+`)
+
+		cfg, err := LoadFile(path)
+		if err != nil {
+			t.Fatalf("LoadFile() error = %v", err)
+		}
+
+		if cfg.Database.Name != "file.db" {
+			t.Errorf("Database.Name = %s, want file.db", cfg.Database.Name)
+		}
+		if cfg.Database.HealthCheckInterval != time.Minute {
+			t.Errorf("Database.HealthCheckInterval = %v, want 1m", cfg.Database.HealthCheckInterval)
+		}
+		if cfg.Database.MaxOpenConns != 4 {
+			t.Errorf("Database.MaxOpenConns = %d, want default 4", cfg.Database.MaxOpenConns)
+		}
+		if cfg.Server.LogLevel != "debug" {
+			t.Errorf("Server.LogLevel = %s, want debug", cfg.Server.LogLevel)
+		}
+		if cfg.Server.MaxBatchSize != 20 {
+			t.Errorf("Server.MaxBatchSize = %d, want 20", cfg.Server.MaxBatchSize)
+		}
+		if len(cfg.Server.LogRedactFields) != 2 || cfg.Server.LogRedactFields[1] != "notes" || cfg.Server.LogMaxPayloadBytes != 512 {
+			t.Errorf("Server log policy = %v, %d, want [description notes] cut to 512 bytes", cfg.Server.LogRedactFields, cfg.Server.LogMaxPayloadBytes)
+		}
+		if cfg.Server.RequestLogSize != 500 {
+			t.Errorf("Server.RequestLogSize = %d, want 500", cfg.Server.RequestLogSize)
+		}
+		if cfg.Server.ValidationPolicy != "strict" {
+			t.Errorf("Server.ValidationPolicy = %s, want strict", cfg.Server.ValidationPolicy)
+		}
+		if len(cfg.Server.SearchRankingWeights) != 1 || cfg.Server.SearchRankingWeights["popularity"] != 0.25 {
+			t.Errorf("Server.SearchRankingWeights = %v, want popularity 0.25", cfg.Server.SearchRankingWeights)
+		}
+		if cfg.Server.InteractiveTools {
+			t.Error("Server.InteractiveTools = true, want false")
+		}
+		if cfg.Server.Locale != "es" {
+			t.Errorf("Server.Locale = %q, want es", cfg.Server.Locale)
+		}
+		if cfg.Server.Verbosity != "json" {
+			t.Errorf("Server.Verbosity = %q, want json", cfg.Server.Verbosity)
+		}
+		if len(cfg.Image.AllowedTypes) != 1 || cfg.Image.AllowedTypes[0] != "image/png" {
+			t.Errorf("Image.AllowedTypes = %v, want [image/png]", cfg.Image.AllowedTypes)
+		}
+		if cfg.Image.OutputFormat != "png" || !cfg.Image.StripMetadata || cfg.Image.MaxWidth != 800 || cfg.Image.MaxHeight != 0 {
+			t.Errorf("Image = %+v, want png output stripped to at most 800 pixels wide", cfg.Image)
+		}
+		if !cfg.WriteQueue.Enabled || cfg.WriteQueue.BatchSize != 10 || cfg.WriteQueue.Capacity != 1000 {
+			t.Errorf("WriteQueue = %+v, want enabled with batch size 10 and default capacity", cfg.WriteQueue)
+		}
+		if len(cfg.Events.WebhookURLs) != 1 || cfg.Events.WebhookBackoff != 2*time.Second || cfg.Events.WebhookMaxAttempts != 5 {
+			t.Errorf("Events = %+v, want one webhook retried after 2s with default attempts", cfg.Events)
+		}
+		if cfg.Retention.Interval != 12*time.Hour || cfg.Retention.HistoryDays != 180 || cfg.Retention.AccessDays != 0 {
+			t.Errorf("Retention = %+v, want history kept 180 days, purged every 12h", cfg.Retention)
+		}
+		if len(cfg.Sync.RemoteCommand) != 3 || cfg.Sync.RemoteCommand[0] != "ssh" {
+			t.Errorf("Sync = %+v, want the ssh command", cfg.Sync)
+		}
+		if cfg.TMDB.APIKey != "file-key" || cfg.TMDB.BaseURL != "https://api.themoviedb.org/3" {
+			t.Errorf("TMDB = %+v, want file key and default base URL", cfg.TMDB)
+		}
+	})
+
+	t.Run("environment overrides file", func(t *testing.T) {
+		os.Clearenv()
+		os.Setenv("DB_NAME", "env.db")
+		os.Setenv("LOG_LEVEL", "warn")
+		path := writeConfigFile(t, `
+database:
+  name: file.db
+logging:
+  level: debug
+`)
+
+		cfg, err := LoadFile(path)
+		if err != nil {
+			t.Fatalf("LoadFile() error = %v", err)
+		}
+
+		if cfg.Database.Name != "env.db" {
+			t.Errorf("Database.Name = %s, want env.db", cfg.Database.Name)
+		}
+		if cfg.Server.LogLevel != "warn" {
+			t.Errorf("Server.LogLevel = %s, want warn", cfg.Server.LogLevel)
+		}
+	})
+
+	t.Run("unknown keys are rejected", func(t *testing.T) {
+		os.Clearenv()
+		path := writeConfigFile(t, `
+database:
+  host: localhost
+`)
+
+		if _, err := LoadFile(path); err == nil {
+			t.Error("LoadFile() expected error for unknown key")
+		}
+	})
+
+	t.Run("invalid duration", func(t *testing.T) {
+		os.Clearenv()
+		path := writeConfigFile(t, `
+server:
+  timeout: soon
+`)
+
+		_, err := LoadFile(path)
+		if err == nil || !strings.Contains(err.Error(), "server.timeout") {
+			t.Errorf("LoadFile() error = %v, want invalid server.timeout", err)
+		}
+	})
+
+	t.Run("file values are validated", func(t *testing.T) {
+		os.Clearenv()
+		path := writeConfigFile(t, `
+database:
+  name: ""
+`)
+
+		if _, err := LoadFile(path); err == nil {
+			t.Error("LoadFile() expected validation error for empty database name")
+		}
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		os.Clearenv()
+
+		if _, err := LoadFile(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+			t.Error("LoadFile() expected error for missing file")
+		}
+	})
+}
+
+func TestConfig_EffectiveYAML(t *testing.T) {
+	oldEnv := os.Environ()
+	defer func() {
+		os.Clearenv()
+		for _, e := range oldEnv {
+			pair := splitEnvVar(e)
+			os.Setenv(pair[0], pair[1])
+		}
+	}()
+	os.Clearenv()
+
+	original, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	data, err := original.EffectiveYAML()
+	if err != nil {
+		t.Fatalf("EffectiveYAML() error = %v", err)
+	}
+
+	// The rendered config must load back to the same values
+	roundTripped, err := LoadFile(writeConfigFile(t, string(data)))
+	if err != nil {
+		t.Fatalf("LoadFile() of effective config error = %v\n%s", err, data)
+	}
+
+	if roundTripped.Database != original.Database || !reflect.DeepEqual(roundTripped.Server, original.Server) ||
+		roundTripped.WriteQueue != original.WriteQueue || roundTripped.TMDB != original.TMDB {
+		t.Errorf("round-tripped config differs:\n got: %+v\nwant: %+v", roundTripped, original)
+	}
+}
+
+func TestConfig_EffectiveYAML_MasksTMDBKey(t *testing.T) {
+	cfg := defaults()
+	cfg.TMDB.APIKey = "super-secret-key"
+
+	data, err := cfg.EffectiveYAML()
+	if err != nil {
+		t.Fatalf("EffectiveYAML() error = %v", err)
+	}
+
+	if strings.Contains(string(data), "super-secret-key") {
+		t.Errorf("EffectiveYAML() leaked the TMDB API key:\n%s", data)
+	}
+	if !strings.Contains(string(data), maskedSecret) {
+		t.Errorf("EffectiveYAML() did not mask the TMDB API key:\n%s", data)
+	}
+}
+
+func TestConfig_EffectiveYAML_MasksCDNSigningKey(t *testing.T) {
+	cfg := defaults()
+	cfg.Image.CDNSigningKey = "cdn-key"
+
+	data, err := cfg.EffectiveYAML()
+	if err != nil {
+		t.Fatalf("EffectiveYAML() error = %v", err)
+	}
+
+	if strings.Contains(string(data), "cdn-key") {
+		t.Errorf("EffectiveYAML() leaked the CDN signing key:\n%s", data)
+	}
+	if !strings.Contains(string(data), "cdn_signing_key: '"+maskedSecret) {
+		t.Errorf("EffectiveYAML() did not mask the CDN signing key:\n%s", data)
+	}
+}
+
+func TestConfig_EffectiveYAML_MasksWebhookSecret(t *testing.T) {
+	cfg := defaults()
+	cfg.Events.WebhookSecret = "hook-secret"
+
+	data, err := cfg.EffectiveYAML()
+	if err != nil {
+		t.Fatalf("EffectiveYAML() error = %v", err)
+	}
+
+	if strings.Contains(string(data), "hook-secret") {
+		t.Errorf("EffectiveYAML() leaked the webhook secret:\n%s", data)
+	}
+	if !strings.Contains(string(data), "webhook_secret: '"+maskedSecret) {
+		t.Errorf("EffectiveYAML() did not mask the webhook secret:\n%s", data)
+	}
+}
@@ -1,9 +1,10 @@
 package actor
 
 import (
-	"errors"
+	"net/url"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
 )
@@ -11,13 +12,18 @@ import (
 // Actor represents an actor aggregate in the domain
 type Actor struct {
 	shared.AggregateRoot
-	id        shared.ActorID
-	name      string
-	birthYear shared.Year
-	bio       string
-	movieIDs  []shared.MovieID
-	createdAt time.Time
-	updatedAt time.Time
+	id          shared.ActorID
+	name        string
+	birthYear   shared.Year
+	deathYear   shared.Year // Zero while the actor is alive
+	nationality string
+	bio         string
+	bioSections []BioSection
+	photoURL    string
+	hasPhoto    bool // Whether a photo image is stored, apart from photoURL
+	credits     []Credit
+	createdAt   time.Time
+	updatedAt   time.Time
 }
 
 // NewActor creates a new Actor with validation
@@ -35,14 +41,14 @@ func NewActor(name string, birthYear int) (*Actor, error) {
 func NewActorWithID(id shared.ActorID, name string, birthYear int) (*Actor, error) {
 	// Validate inputs
 	if strings.TrimSpace(name) == "" {
-		return nil, errors.New("name cannot be empty")
+		return nil, shared.NewValidationError("name cannot be empty")
 	}
 
 	// For birth year, we're more restrictive than movie years
 	// Actors should be born within reasonable human lifespans
 	currentYear := time.Now().Year()
 	if birthYear < 1850 || birthYear > currentYear {
-		return nil, errors.New("invalid birth year")
+		return nil, shared.NewValidationError("invalid birth year")
 	}
 
 	actorYear, err := shared.NewYear(birthYear)
@@ -56,7 +62,8 @@ func NewActorWithID(id shared.ActorID, name string, birthYear int) (*Actor, erro
 		id:            id,
 		name:          strings.TrimSpace(name),
 		birthYear:     actorYear,
-		movieIDs:      make([]shared.MovieID, 0),
+		bioSections:   make([]BioSection, 0),
+		credits:       make([]Credit, 0),
 		createdAt:     now,
 		updatedAt:     now,
 	}
@@ -85,18 +92,69 @@ func (a *Actor) BirthYear() shared.Year {
 	return a.birthYear
 }
 
+// DeathYear returns the year the actor died, or a zero year if they are alive
+func (a *Actor) DeathYear() shared.Year {
+	return a.deathYear
+}
+
+// IsAlive reports whether the actor has no death year
+func (a *Actor) IsAlive() bool {
+	return a.deathYear.IsZero()
+}
+
+// Nationality returns the actor's nationality
+func (a *Actor) Nationality() string {
+	return a.nationality
+}
+
 // Bio returns the actor's biography
 func (a *Actor) Bio() string {
 	return a.bio
 }
 
-// MovieIDs returns a copy of the actor's movie IDs
+// BioSections returns a copy of the actor's biography sections, in order
+func (a *Actor) BioSections() []BioSection {
+	sections := make([]BioSection, len(a.bioSections))
+	copy(sections, a.bioSections)
+	return sections
+}
+
+// PhotoURL returns the URL of the actor's photo
+func (a *Actor) PhotoURL() string {
+	return a.photoURL
+}
+
+// HasPhoto reports whether a photo image is stored for the actor
+func (a *Actor) HasPhoto() bool {
+	return a.hasPhoto
+}
+
+// MovieIDs returns the IDs of the movies in the actor's filmography
 func (a *Actor) MovieIDs() []shared.MovieID {
-	movieIDs := make([]shared.MovieID, len(a.movieIDs))
-	copy(movieIDs, a.movieIDs)
+	movieIDs := make([]shared.MovieID, len(a.credits))
+	for i, credit := range a.credits {
+		movieIDs[i] = credit.movieID
+	}
 	return movieIDs
 }
 
+// Credits returns a copy of the actor's credits
+func (a *Actor) Credits() []Credit {
+	credits := make([]Credit, len(a.credits))
+	copy(credits, a.credits)
+	return credits
+}
+
+// Credit returns the actor's credit for a movie, if the actor appears in it
+func (a *Actor) Credit(movieID shared.MovieID) (Credit, bool) {
+	for _, credit := range a.credits {
+		if credit.movieID.Value() == movieID.Value() {
+			return credit, true
+		}
+	}
+	return Credit{}, false
+}
+
 // CreatedAt returns when the actor was created
 func (a *Actor) CreatedAt() time.Time {
 	return a.createdAt
@@ -121,19 +179,98 @@ func (a *Actor) SetBio(bio string) {
 	a.touch()
 }
 
+// SetDeathYear sets the year the actor died; zero clears it. The year cannot
+// be before the actor's birth year or in the future.
+func (a *Actor) SetDeathYear(deathYear int) error {
+	if deathYear == 0 {
+		a.deathYear = shared.Year{}
+		a.touch()
+		return nil
+	}
+	if deathYear < a.birthYear.Value() {
+		return shared.NewValidationError("death year %d is before birth year %d", deathYear, a.birthYear.Value())
+	}
+	if deathYear > time.Now().Year() {
+		return shared.NewValidationError("death year cannot be in the future")
+	}
+
+	year, err := shared.NewYear(deathYear)
+	if err != nil {
+		return err
+	}
+	a.deathYear = year
+	a.touch()
+	return nil
+}
+
+// SetNationality sets the actor's nationality; an empty value clears it
+func (a *Actor) SetNationality(nationality string) error {
+	nationality = strings.TrimSpace(nationality)
+	if utf8.RuneCountInString(nationality) > MaxNationalityLength {
+		return shared.NewValidationError("nationality must be at most %d characters", MaxNationalityLength)
+	}
+	a.nationality = nationality
+	a.touch()
+	return nil
+}
+
+// SetBioSections replaces the actor's biography sections. Section titles
+// must be unique, ignoring case.
+func (a *Actor) SetBioSections(sections []BioSection) error {
+	seen := make(map[string]bool, len(sections))
+	for _, section := range sections {
+		key := strings.ToLower(section.title)
+		if seen[key] {
+			return shared.NewValidationError("duplicate biography section %q", section.title)
+		}
+		seen[key] = true
+	}
+
+	a.bioSections = make([]BioSection, len(sections))
+	copy(a.bioSections, sections)
+	a.touch()
+	return nil
+}
+
+// SetPhotoURL sets the URL of the actor's photo; an empty URL clears it
+func (a *Actor) SetPhotoURL(photoURL string) error {
+	if photoURL != "" {
+		parsedURL, err := url.Parse(photoURL)
+		if err != nil {
+			return shared.NewValidationError("invalid URL format")
+		}
+		if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+			return shared.NewValidationError("photo URL must use HTTP or HTTPS scheme")
+		}
+	}
+
+	a.photoURL = photoURL
+	a.touch()
+	return nil
+}
+
+// SetHasPhoto records whether a photo image is stored for the actor (used by
+// repository when loading)
+func (a *Actor) SetHasPhoto(hasPhoto bool) {
+	a.hasPhoto = hasPhoto
+}
+
 // AddMovie adds a movie ID to the actor's filmography
 func (a *Actor) AddMovie(movieID shared.MovieID) error {
+	return a.AddCredit(Credit{movieID: movieID})
+}
+
+// AddCredit adds a movie to the actor's filmography with the part played in it
+func (a *Actor) AddCredit(credit Credit) error {
 	// Check for duplicates
-	for _, id := range a.movieIDs {
-		if id.Value() == movieID.Value() {
-			return errors.New("movie already exists in actor's filmography")
-		}
+	if a.HasMovie(credit.movieID) {
+		return shared.NewConflictError("movie already exists in actor's filmography")
 	}
 
-	a.movieIDs = append(a.movieIDs, movieID)
+	a.credits = append(a.credits, credit)
 
 	// Emit domain event for actor linked to movie
-	event := NewActorLinkedToMovieEvent(a.id, movieID, a.Version()+1)
+	event := NewActorLinkedToMovieEvent(a.id, credit.movieID, a.Version()+1)
 	a.AddEvent(event)
 
 	a.touch()
@@ -142,10 +279,10 @@ func (a *Actor) AddMovie(movieID shared.MovieID) error {
 
 // RemoveMovie removes a movie ID from the actor's filmography
 func (a *Actor) RemoveMovie(movieID shared.MovieID) error {
-	for i, id := range a.movieIDs {
-		if id.Value() == movieID.Value() {
+	for i, credit := range a.credits {
+		if credit.movieID.Value() == movieID.Value() {
 			// Remove by slicing
-			a.movieIDs = append(a.movieIDs[:i], a.movieIDs[i+1:]...)
+			a.credits = append(a.credits[:i], a.credits[i+1:]...)
 
 			// Emit domain event for actor unlinked from movie
 			event := NewActorUnlinkedFromMovieEvent(a.id, movieID, a.Version()+1)
@@ -155,31 +292,30 @@ func (a *Actor) RemoveMovie(movieID shared.MovieID) error {
 			return nil
 		}
 	}
-	return errors.New("movie not found in actor's filmography")
+	return shared.NewNotFoundError("movie not found in actor's filmography")
 }
 
 // HasMovie checks if the actor has a specific movie in their filmography
 func (a *Actor) HasMovie(movieID shared.MovieID) bool {
-	for _, id := range a.movieIDs {
-		if id.Value() == movieID.Value() {
-			return true
-		}
-	}
-	return false
+	_, ok := a.Credit(movieID)
+	return ok
 }
 
 // MovieCount returns the number of movies the actor has appeared in
 func (a *Actor) MovieCount() int {
-	return len(a.movieIDs)
+	return len(a.credits)
 }
 
 // Validate performs comprehensive validation of the actor
 func (a *Actor) Validate() error {
 	if strings.TrimSpace(a.name) == "" {
-		return errors.New("name cannot be empty")
+		return shared.NewValidationError("name cannot be empty")
 	}
 	if a.birthYear.IsZero() {
-		return errors.New("birth year must be set")
+		return shared.NewValidationError("birth year must be set")
+	}
+	if !a.deathYear.IsZero() && a.deathYear.Value() < a.birthYear.Value() {
+		return shared.NewValidationError("death year cannot be before birth year")
 	}
 	// Bio is optional
 	// MovieIDs are optional
@@ -196,3 +332,24 @@ func (a *Actor) SetID(id shared.ActorID) {
 	a.id = id
 	a.touch()
 }
+
+// RecordCreated records that the actor was stored for the first time. The
+// events raised while they were built are replaced by a single ActorCreated,
+// so it must be called once the repository has assigned the actor's ID.
+func (a *Actor) RecordCreated() {
+	a.MarkEventsAsCommitted()
+	a.AddEvent(NewActorCreatedEvent(a, a.Version()+1))
+}
+
+// RecordUpdated records that the actor replaced the stored actor with the
+// same ID. The events raised while they were built, including those of the
+// links carried over, are replaced by ActorUpdated.
+func (a *Actor) RecordUpdated() {
+	a.MarkEventsAsCommitted()
+	a.AddEvent(NewActorUpdatedEvent(a, a.Version()+1))
+}
+
+// RecordDeleted records that the actor was deleted
+func (a *Actor) RecordDeleted() {
+	a.AddEvent(NewActorDeletedEvent(a.id, a.name, a.Version()+1))
+}
@@ -233,6 +233,33 @@ func TestActor_SetBio(t *testing.T) {
 	}
 }
 
+func TestActor_SetPhotoURL(t *testing.T) {
+	actor, err := NewActor("Test Actor", 1980)
+	if err != nil {
+		t.Fatalf("Failed to create test actor: %v", err)
+	}
+
+	if err := actor.SetPhotoURL("https://example.com/photo.jpg"); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if actor.PhotoURL() != "https://example.com/photo.jpg" {
+		t.Errorf("Expected the photo URL to be set, got: %s", actor.PhotoURL())
+	}
+
+	for _, invalid := range []string{"ftp://example.com/photo.jpg", "photo.jpg"} {
+		if err := actor.SetPhotoURL(invalid); err == nil {
+			t.Errorf("Expected error for photo URL %q", invalid)
+		}
+	}
+	if actor.PhotoURL() != "https://example.com/photo.jpg" {
+		t.Errorf("Expected an invalid URL to leave the photo URL, got: %s", actor.PhotoURL())
+	}
+
+	if err := actor.SetPhotoURL(""); err != nil || actor.PhotoURL() != "" {
+		t.Errorf("Expected an empty URL to clear the photo URL, got: %q (%v)", actor.PhotoURL(), err)
+	}
+}
+
 func TestActor_UpdateTimestamp(t *testing.T) {
 	actor, err := NewActor("Test Actor", 1980)
 	if err != nil {
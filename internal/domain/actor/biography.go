@@ -0,0 +1,49 @@
+package actor
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// MaxNationalityLength is the most characters a nationality may hold
+const MaxNationalityLength = 100
+
+// MaxBioSectionTitleLength is the most characters a biography section title
+// may hold
+const MaxBioSectionTitleLength = 100
+
+// BioSection is one titled part of an actor's biography, such as "Early
+// life" or "Career"
+type BioSection struct {
+	title string
+	text  string
+}
+
+// NewBioSection creates a new BioSection with validation. Both the title and
+// the text are required.
+func NewBioSection(title, text string) (BioSection, error) {
+	title = strings.TrimSpace(title)
+	text = strings.TrimSpace(text)
+	if title == "" {
+		return BioSection{}, shared.NewValidationError("biography section title cannot be empty")
+	}
+	if utf8.RuneCountInString(title) > MaxBioSectionTitleLength {
+		return BioSection{}, shared.NewValidationError("biography section title must be at most %d characters", MaxBioSectionTitleLength)
+	}
+	if text == "" {
+		return BioSection{}, shared.NewValidationError("biography section %q has no text", title)
+	}
+	return BioSection{title: title, text: text}, nil
+}
+
+// Title returns the section title
+func (s BioSection) Title() string {
+	return s.title
+}
+
+// Text returns the section text
+func (s BioSection) Text() string {
+	return s.text
+}
@@ -0,0 +1,122 @@
+package actor
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewBioSection(t *testing.T) {
+	tests := []struct {
+		name    string
+		title   string
+		text    string
+		wantErr bool
+	}{
+		{name: "valid section", title: " Early life ", text: "Born in Toronto."},
+		{name: "empty title", title: "  ", text: "Born in Toronto.", wantErr: true},
+		{name: "empty text", title: "Career", text: " ", wantErr: true},
+		{name: "title too long", title: strings.Repeat("a", MaxBioSectionTitleLength+1), text: "Text", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			section, err := NewBioSection(tt.title, tt.text)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewBioSection() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (section.Title() != "Early life" || section.Text() != "Born in Toronto.") {
+				t.Errorf("Expected a trimmed section, got: %q %q", section.Title(), section.Text())
+			}
+		})
+	}
+}
+
+func TestActor_SetDeathYear(t *testing.T) {
+	tests := []struct {
+		name      string
+		deathYear int
+		wantErr   bool
+	}{
+		{name: "after birth", deathYear: 2020},
+		{name: "same year as birth", deathYear: 1950},
+		{name: "before birth", deathYear: 1949, wantErr: true},
+		{name: "in the future", deathYear: time.Now().Year() + 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actor, _ := NewActor("Test Actor", 1950)
+			err := actor.SetDeathYear(tt.deathYear)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SetDeathYear() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !actor.IsAlive() {
+					t.Error("Expected a rejected death year to leave the actor alive")
+				}
+				return
+			}
+			if actor.IsAlive() || actor.DeathYear().Value() != tt.deathYear {
+				t.Errorf("Expected death year %d, got: %d", tt.deathYear, actor.DeathYear().Value())
+			}
+			if err := actor.Validate(); err != nil {
+				t.Errorf("Expected a valid actor, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestActor_SetDeathYear_ZeroClears(t *testing.T) {
+	actor, _ := NewActor("Test Actor", 1950)
+	if err := actor.SetDeathYear(2000); err != nil {
+		t.Fatalf("SetDeathYear() error = %v", err)
+	}
+	if err := actor.SetDeathYear(0); err != nil {
+		t.Fatalf("SetDeathYear(0) error = %v", err)
+	}
+	if !actor.IsAlive() {
+		t.Error("Expected a zero death year to clear it")
+	}
+}
+
+func TestActor_SetNationality(t *testing.T) {
+	actor, _ := NewActor("Test Actor", 1950)
+	if err := actor.SetNationality("  Canadian "); err != nil {
+		t.Fatalf("SetNationality() error = %v", err)
+	}
+	if actor.Nationality() != "Canadian" {
+		t.Errorf("Expected nationality 'Canadian', got: %q", actor.Nationality())
+	}
+	if err := actor.SetNationality(strings.Repeat("a", MaxNationalityLength+1)); err == nil {
+		t.Error("Expected an error for a nationality that is too long")
+	}
+}
+
+func TestActor_SetBioSections(t *testing.T) {
+	actor, _ := NewActor("Test Actor", 1950)
+	early, _ := NewBioSection("Early life", "Born in Toronto.")
+	career, _ := NewBioSection("Career", "Started on stage.")
+
+	if err := actor.SetBioSections([]BioSection{early, career}); err != nil {
+		t.Fatalf("SetBioSections() error = %v", err)
+	}
+	sections := actor.BioSections()
+	if len(sections) != 2 || sections[0].Title() != "Early life" || sections[1].Title() != "Career" {
+		t.Fatalf("Expected both sections in order, got: %v", sections)
+	}
+
+	// The returned slice is a copy
+	sections[0] = career
+	if actor.BioSections()[0].Title() != "Early life" {
+		t.Error("Expected BioSections() to return a copy")
+	}
+
+	duplicate, _ := NewBioSection("CAREER", "Moved to film.")
+	if err := actor.SetBioSections([]BioSection{career, duplicate}); err == nil {
+		t.Error("Expected an error for duplicate section titles")
+	}
+	if len(actor.BioSections()) != 2 {
+		t.Errorf("Expected rejected sections to leave the existing ones, got: %v", actor.BioSections())
+	}
+}
@@ -0,0 +1,86 @@
+package actor
+
+import (
+	"strings"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// RoleType represents how prominent an actor's part in a movie is
+type RoleType string
+
+const (
+	RoleLead       RoleType = "lead"
+	RoleSupporting RoleType = "supporting"
+	RoleCameo      RoleType = "cameo"
+)
+
+// RoleTypes returns all supported role types
+func RoleTypes() []RoleType {
+	return []RoleType{RoleLead, RoleSupporting, RoleCameo}
+}
+
+// ParseRoleType validates a role type. An empty value means the role type is unknown.
+func ParseRoleType(value string) (RoleType, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return "", nil
+	}
+	for _, roleType := range RoleTypes() {
+		if RoleType(value) == roleType {
+			return roleType, nil
+		}
+	}
+	return "", shared.NewValidationError("role type must be one of lead, supporting or cameo")
+}
+
+// Credit describes an actor's part in a movie: the character played, the
+// position in the billing (1 is top-billed, 0 unknown) and the role type
+type Credit struct {
+	movieID      shared.MovieID
+	character    string
+	billingOrder int
+	roleType     RoleType
+}
+
+// NewCredit creates a new Credit with validation. Only the movie ID is required.
+func NewCredit(movieID shared.MovieID, character string, billingOrder int, roleType string) (Credit, error) {
+	if movieID.IsZero() {
+		return Credit{}, shared.NewValidationError("movie ID is required")
+	}
+	if billingOrder < 0 {
+		return Credit{}, shared.NewValidationError("billing order cannot be negative")
+	}
+
+	parsedType, err := ParseRoleType(roleType)
+	if err != nil {
+		return Credit{}, err
+	}
+
+	return Credit{
+		movieID:      movieID,
+		character:    strings.TrimSpace(character),
+		billingOrder: billingOrder,
+		roleType:     parsedType,
+	}, nil
+}
+
+// MovieID returns the credited movie
+func (c Credit) MovieID() shared.MovieID {
+	return c.movieID
+}
+
+// Character returns the name of the character played, if known
+func (c Credit) Character() string {
+	return c.character
+}
+
+// BillingOrder returns the position in the movie's billing, or 0 if unknown
+func (c Credit) BillingOrder() int {
+	return c.billingOrder
+}
+
+// RoleType returns the role type, or an empty value if unknown
+func (c Credit) RoleType() RoleType {
+	return c.roleType
+}
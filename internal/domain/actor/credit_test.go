@@ -0,0 +1,69 @@
+package actor
+
+import (
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+func TestNewCredit(t *testing.T) {
+	movieID, _ := shared.NewMovieID(1)
+
+	tests := []struct {
+		name         string
+		movieID      shared.MovieID
+		character    string
+		billingOrder int
+		roleType     string
+		wantType     RoleType
+		wantErr      bool
+	}{
+		{name: "full credit", movieID: movieID, character: "Neo", billingOrder: 1, roleType: "lead", wantType: RoleLead},
+		{name: "role type is case-insensitive", movieID: movieID, roleType: " Cameo ", wantType: RoleCameo},
+		{name: "details are optional", movieID: movieID},
+		{name: "missing movie", character: "Neo", wantErr: true},
+		{name: "negative billing order", movieID: movieID, billingOrder: -1, wantErr: true},
+		{name: "invalid role type", movieID: movieID, roleType: "villain", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			credit, err := NewCredit(tt.movieID, tt.character, tt.billingOrder, tt.roleType)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewCredit() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if credit.RoleType() != tt.wantType {
+				t.Errorf("Expected role type %q, got: %q", tt.wantType, credit.RoleType())
+			}
+			if credit.Character() != tt.character {
+				t.Errorf("Expected character %q, got: %q", tt.character, credit.Character())
+			}
+		})
+	}
+}
+
+func TestActor_AddCredit(t *testing.T) {
+	actor, _ := NewActor("Keanu Reeves", 1964)
+	movieID, _ := shared.NewMovieID(1)
+	credit, _ := NewCredit(movieID, "Neo", 1, "lead")
+
+	if err := actor.AddCredit(credit); err != nil {
+		t.Fatalf("AddCredit() error = %v", err)
+	}
+	if !actor.HasMovie(movieID) {
+		t.Error("Expected actor to have the credited movie")
+	}
+
+	got, ok := actor.Credit(movieID)
+	if !ok || got.Character() != "Neo" {
+		t.Errorf("Expected credit as Neo, got: %+v", got)
+	}
+
+	if err := actor.AddMovie(movieID); err == nil {
+		t.Error("Expected error when linking the same movie twice")
+	}
+}
@@ -175,3 +175,31 @@ func TestActorEventTypes(t *testing.T) {
 		t.Errorf("Expected name 'Test Actor', got %s", updatedEvent.Name)
 	}
 }
+
+func TestActorLifecycleEvents(t *testing.T) {
+	id, _ := shared.NewActorID(42)
+	movieID, _ := shared.NewMovieID(100)
+
+	// Creation replaces the events raised while the actor was built
+	created, _ := NewActor("Robert De Niro", 1943)
+	created.SetBio("American actor")
+	created.SetID(id)
+	created.RecordCreated()
+	if events := created.UncommittedEvents(); len(events) != 1 || events[0].EventType() != "ActorCreated" {
+		t.Errorf("Expected only ActorCreated, got %d events", len(events))
+	}
+
+	// Links carried over by an update are not published as new links
+	updated, _ := NewActorWithID(id, "Robert De Niro", 1943)
+	_ = updated.AddMovie(movieID)
+	updated.RecordUpdated()
+	if events := updated.UncommittedEvents(); len(events) != 1 || events[0].EventType() != "ActorUpdated" {
+		t.Errorf("Expected only ActorUpdated, got %d events", len(events))
+	}
+
+	updated.MarkEventsAsCommitted()
+	updated.RecordDeleted()
+	if events := updated.UncommittedEvents(); len(events) != 1 || events[0].EventType() != "ActorDeleted" {
+		t.Errorf("Expected only ActorDeleted, got %d events", len(events))
+	}
+}
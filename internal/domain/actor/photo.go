@@ -0,0 +1,41 @@
+package actor
+
+import (
+	"context"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// Photo is an actor's stored photo image
+type Photo struct {
+	ActorID  shared.ActorID
+	Name     string
+	MimeType string
+	Data     []byte
+}
+
+// PhotoInfo describes a stored photo image without its data
+type PhotoInfo struct {
+	ActorID  shared.ActorID
+	Name     string
+	MimeType string
+	Size     int
+}
+
+// PhotoStore defines the interface for storing actor photo images. A photo
+// image is kept alongside the actor, apart from its photo URL.
+type PhotoStore interface {
+	// SavePhoto stores an actor's photo image, replacing any it had; it fails
+	// with shared.ErrNotFound if the actor does not exist
+	SavePhoto(ctx context.Context, actorID shared.ActorID, data []byte, mimeType string) error
+
+	// FindPhoto returns an actor's photo image; it fails with
+	// shared.ErrNotFound if the actor does not exist or has no photo image
+	FindPhoto(ctx context.Context, actorID shared.ActorID) (*Photo, error)
+
+	// ListPhotos describes every stored photo image, by actor name
+	ListPhotos(ctx context.Context) ([]PhotoInfo, error)
+
+	// DeletePhoto removes an actor's photo image, reporting whether it had one
+	DeletePhoto(ctx context.Context, actorID shared.ActorID) (bool, error)
+}
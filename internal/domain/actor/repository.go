@@ -44,14 +44,25 @@ type Writer interface {
 
 // SearchCriteria represents search parameters for actors
 type SearchCriteria struct {
+	IDs          []shared.ActorID // Restrict results to these actors
 	Name         string
 	MinBirthYear int
 	MaxBirthYear int
 	MovieID      shared.MovieID // Find actors who appeared in this movie
+	Character    string         // Find actors who played a character (partial match)
+	Nationality  string         // Exact match, ignoring case
+	AliveOnly    bool           // Leave out actors with a death year
 	Limit        int
 	Offset       int
 	OrderBy      OrderBy
 	OrderDir     OrderDirection
+	Sort         []SortKey // Ordered sort keys; when set, replaces OrderBy/OrderDir
+}
+
+// SortKey is one term of a multi-key sort
+type SortKey struct {
+	Field OrderBy
+	Dir   OrderDirection
 }
 
 // OrderBy represents fields that can be used for ordering
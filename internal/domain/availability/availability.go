@@ -0,0 +1,135 @@
+package availability
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// OfferType represents how a provider makes a movie available
+type OfferType string
+
+const (
+	OfferFlatrate OfferType = "flatrate" // Included in a subscription
+	OfferRent     OfferType = "rent"
+	OfferBuy      OfferType = "buy"
+	OfferFree     OfferType = "free"
+	OfferAds      OfferType = "ads" // Free with advertising
+)
+
+// OfferTypes returns all supported offer types
+func OfferTypes() []OfferType {
+	return []OfferType{OfferFlatrate, OfferRent, OfferBuy, OfferFree, OfferAds}
+}
+
+// ParseOfferType validates an offer type, defaulting an empty value to flatrate
+func ParseOfferType(value string) (OfferType, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return OfferFlatrate, nil
+	}
+	for _, offerType := range OfferTypes() {
+		if OfferType(value) == offerType {
+			return offerType, nil
+		}
+	}
+	return "", shared.NewValidationError("offer type must be one of flatrate, rent, buy, free or ads")
+}
+
+// NormalizeRegion validates a two-letter ISO 3166-1 region code and returns it in upper case
+func NormalizeRegion(region string) (string, error) {
+	region = strings.ToUpper(strings.TrimSpace(region))
+	if len(region) != 2 || region[0] < 'A' || region[0] > 'Z' || region[1] < 'A' || region[1] > 'Z' {
+		return "", shared.NewValidationError("region must be a two-letter country code")
+	}
+	return region, nil
+}
+
+// Availability records that a provider offers a movie in a region
+type Availability struct {
+	movieID     shared.MovieID
+	provider    string
+	region      string
+	offerType   OfferType
+	url         string
+	lastChecked time.Time
+}
+
+// NewAvailability creates a new Availability with validation. An empty
+// offer type defaults to flatrate and a zero lastChecked to the current time.
+func NewAvailability(movieID shared.MovieID, provider, region, offerType, link string, lastChecked time.Time) (*Availability, error) {
+	if movieID.IsZero() {
+		return nil, shared.NewValidationError("movie ID is required")
+	}
+
+	provider = strings.TrimSpace(provider)
+	if provider == "" {
+		return nil, shared.NewValidationError("provider cannot be empty")
+	}
+
+	normalizedRegion, err := NormalizeRegion(region)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedType, err := ParseOfferType(offerType)
+	if err != nil {
+		return nil, err
+	}
+
+	link = strings.TrimSpace(link)
+	if link != "" {
+		parsedURL, err := url.Parse(link)
+		if err != nil {
+			return nil, shared.NewValidationError("invalid URL format")
+		}
+		if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+			return nil, shared.NewValidationError("availability URL must use HTTP or HTTPS scheme")
+		}
+	}
+
+	if lastChecked.IsZero() {
+		lastChecked = time.Now()
+	}
+
+	return &Availability{
+		movieID:     movieID,
+		provider:    provider,
+		region:      normalizedRegion,
+		offerType:   parsedType,
+		url:         link,
+		lastChecked: lastChecked.UTC(),
+	}, nil
+}
+
+// MovieID returns the movie this availability belongs to
+func (a *Availability) MovieID() shared.MovieID {
+	return a.movieID
+}
+
+// Provider returns the streaming or retail provider name
+func (a *Availability) Provider() string {
+	return a.provider
+}
+
+// Region returns the upper-case two-letter region code
+func (a *Availability) Region() string {
+	return a.region
+}
+
+// OfferType returns how the provider offers the movie
+func (a *Availability) OfferType() OfferType {
+	return a.offerType
+}
+
+// URL returns the link to the movie on the provider, if known
+func (a *Availability) URL() string {
+	return a.url
+}
+
+// LastChecked returns when the availability was last confirmed
+func (a *Availability) LastChecked() time.Time {
+	return a.lastChecked
+}
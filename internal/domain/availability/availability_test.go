@@ -0,0 +1,80 @@
+package availability
+
+import (
+	"testing"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+func TestNewAvailability(t *testing.T) {
+	movieID, _ := shared.NewMovieID(1)
+	checked := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		movieID   shared.MovieID
+		provider  string
+		region    string
+		offerType string
+		url       string
+		wantErr   bool
+	}{
+		{name: "valid flatrate", movieID: movieID, provider: "Netflix", region: "US", offerType: "flatrate", url: "https://netflix.com/title/1"},
+		{name: "empty offer type defaults", movieID: movieID, provider: "Netflix", region: "us"},
+		{name: "missing movie", provider: "Netflix", region: "US", wantErr: true},
+		{name: "empty provider", movieID: movieID, provider: "  ", region: "US", wantErr: true},
+		{name: "invalid region", movieID: movieID, provider: "Netflix", region: "USA", wantErr: true},
+		{name: "invalid offer type", movieID: movieID, provider: "Netflix", region: "US", offerType: "lease", wantErr: true},
+		{name: "invalid url scheme", movieID: movieID, provider: "Netflix", region: "US", url: "ftp://example.com", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, err := NewAvailability(tt.movieID, tt.provider, tt.region, tt.offerType, tt.url, checked)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewAvailability() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if entry.Region() != "US" {
+				t.Errorf("Expected region US, got: %s", entry.Region())
+			}
+			if !entry.LastChecked().Equal(checked) {
+				t.Errorf("Expected last checked %v, got: %v", checked, entry.LastChecked())
+			}
+		})
+	}
+}
+
+func TestNewAvailability_Defaults(t *testing.T) {
+	movieID, _ := shared.NewMovieID(1)
+
+	entry, err := NewAvailability(movieID, " Netflix ", "gb", "", "", time.Time{})
+	if err != nil {
+		t.Fatalf("NewAvailability() error = %v", err)
+	}
+	if entry.Provider() != "Netflix" {
+		t.Errorf("Expected trimmed provider, got: %q", entry.Provider())
+	}
+	if entry.OfferType() != OfferFlatrate {
+		t.Errorf("Expected flatrate offer, got: %s", entry.OfferType())
+	}
+	if entry.LastChecked().IsZero() {
+		t.Error("Expected last checked to default to now")
+	}
+}
+
+func TestParseOfferType(t *testing.T) {
+	for _, offerType := range OfferTypes() {
+		parsed, err := ParseOfferType(" " + string(offerType) + " ")
+		if err != nil || parsed != offerType {
+			t.Errorf("ParseOfferType(%q) = %q, %v", offerType, parsed, err)
+		}
+	}
+	if _, err := ParseOfferType("lease"); err == nil {
+		t.Error("Expected error for unknown offer type")
+	}
+}
@@ -0,0 +1,29 @@
+package availability
+
+import (
+	"context"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// Repository defines the interface for movie availability data access
+type Repository interface {
+	// FindByMovieID retrieves a movie's availability; an empty region returns all regions
+	FindByMovieID(ctx context.Context, movieID shared.MovieID, region string) ([]*Availability, error)
+
+	// ReplaceForRegion replaces everything recorded for a movie in a region with entries
+	ReplaceForRegion(ctx context.Context, movieID shared.MovieID, region string, entries []*Availability) error
+}
+
+// Offer is one provider offering returned by a Source
+type Offer struct {
+	Provider  string
+	OfferType OfferType
+	URL       string
+}
+
+// Source looks up current availability from an external catalogue such as TMDB
+type Source interface {
+	// Lookup finds the offers for a movie in a region by title and release year
+	Lookup(ctx context.Context, title string, year int, region string) ([]Offer, error)
+}
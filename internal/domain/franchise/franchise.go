@@ -0,0 +1,180 @@
+package franchise
+
+import (
+	"strings"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// Franchise groups related movies, such as a trilogy or a film series, in
+// story order. Release order is derived from the movies' years.
+type Franchise struct {
+	id          shared.FranchiseID
+	name        string
+	description string
+	movieIDs    []shared.MovieID // In chronological (story) order
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewFranchise creates a new Franchise with validation
+func NewFranchise(name, description string) (*Franchise, error) {
+	// Use zero ID for new franchises - will be assigned by repository
+	id, err := shared.NewFranchiseID(0)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFranchiseWithID(id, name, description)
+}
+
+// NewFranchiseWithID creates a new Franchise with a specific ID (for repository reconstruction)
+func NewFranchiseWithID(id shared.FranchiseID, name, description string) (*Franchise, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, shared.NewValidationError("name cannot be empty")
+	}
+
+	now := time.Now()
+	return &Franchise{
+		id:          id,
+		name:        strings.TrimSpace(name),
+		description: strings.TrimSpace(description),
+		movieIDs:    make([]shared.MovieID, 0),
+		createdAt:   now,
+		updatedAt:   now,
+	}, nil
+}
+
+// ID returns the franchise's unique identifier
+func (f *Franchise) ID() shared.FranchiseID {
+	return f.id
+}
+
+// Name returns the franchise's name
+func (f *Franchise) Name() string {
+	return f.name
+}
+
+// Description returns the franchise's description
+func (f *Franchise) Description() string {
+	return f.description
+}
+
+// MovieIDs returns a copy of the franchise's movie IDs in chronological order
+func (f *Franchise) MovieIDs() []shared.MovieID {
+	movieIDs := make([]shared.MovieID, len(f.movieIDs))
+	copy(movieIDs, f.movieIDs)
+	return movieIDs
+}
+
+// CreatedAt returns when the franchise was created
+func (f *Franchise) CreatedAt() time.Time {
+	return f.createdAt
+}
+
+// UpdatedAt returns when the franchise was last updated
+func (f *Franchise) UpdatedAt() time.Time {
+	return f.updatedAt
+}
+
+// SetName renames the franchise
+func (f *Franchise) SetName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return shared.NewValidationError("name cannot be empty")
+	}
+	f.name = strings.TrimSpace(name)
+	f.touch()
+	return nil
+}
+
+// SetDescription sets the franchise's description
+func (f *Franchise) SetDescription(description string) {
+	f.description = strings.TrimSpace(description)
+	f.touch()
+}
+
+// PlaceMovie puts a movie at a 1-based position in chronological order,
+// shifting later movies down. A movie already in the franchise is moved.
+// A position of 0 or past the end appends the movie.
+func (f *Franchise) PlaceMovie(movieID shared.MovieID, position int) error {
+	if movieID.IsZero() {
+		return shared.NewValidationError("movie ID is required")
+	}
+	if position < 0 {
+		return shared.NewValidationError("position must be non-negative")
+	}
+
+	if i := f.indexOf(movieID); i >= 0 {
+		f.movieIDs = append(f.movieIDs[:i], f.movieIDs[i+1:]...)
+	}
+
+	index := position - 1
+	if position == 0 || index > len(f.movieIDs) {
+		index = len(f.movieIDs)
+	}
+	f.movieIDs = append(f.movieIDs, shared.MovieID{})
+	copy(f.movieIDs[index+1:], f.movieIDs[index:])
+	f.movieIDs[index] = movieID
+
+	f.touch()
+	return nil
+}
+
+// RemoveMovie removes a movie from the franchise
+func (f *Franchise) RemoveMovie(movieID shared.MovieID) error {
+	i := f.indexOf(movieID)
+	if i < 0 {
+		return shared.NewNotFoundError("movie not found in franchise")
+	}
+
+	f.movieIDs = append(f.movieIDs[:i], f.movieIDs[i+1:]...)
+	f.touch()
+	return nil
+}
+
+// HasMovie checks if the movie belongs to the franchise
+func (f *Franchise) HasMovie(movieID shared.MovieID) bool {
+	return f.indexOf(movieID) >= 0
+}
+
+// Position returns the movie's 1-based chronological position, or 0 if it is not in the franchise
+func (f *Franchise) Position(movieID shared.MovieID) int {
+	return f.indexOf(movieID) + 1
+}
+
+// MovieCount returns the number of movies in the franchise
+func (f *Franchise) MovieCount() int {
+	return len(f.movieIDs)
+}
+
+// Validate performs comprehensive validation of the franchise
+func (f *Franchise) Validate() error {
+	if strings.TrimSpace(f.name) == "" {
+		return shared.NewValidationError("name cannot be empty")
+	}
+	// Description is optional
+	// Movies are optional
+	return nil
+}
+
+// indexOf returns the movie's index in chronological order, or -1
+func (f *Franchise) indexOf(movieID shared.MovieID) int {
+	for i, id := range f.movieIDs {
+		if id.Value() == movieID.Value() {
+			return i
+		}
+	}
+	return -1
+}
+
+// touch updates the updatedAt timestamp
+func (f *Franchise) touch() {
+	f.updatedAt = time.Now()
+}
+
+// SetID sets the franchise's ID (used by repository when saving)
+func (f *Franchise) SetID(id shared.FranchiseID) {
+	f.id = id
+	f.touch()
+}
@@ -0,0 +1,146 @@
+package franchise
+
+import (
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+func movieIDs(values ...int) []shared.MovieID {
+	ids := make([]shared.MovieID, len(values))
+	for i, value := range values {
+		ids[i], _ = shared.NewMovieID(value)
+	}
+	return ids
+}
+
+func idValues(ids []shared.MovieID) []int {
+	values := make([]int, len(ids))
+	for i, id := range ids {
+		values[i] = id.Value()
+	}
+	return values
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestNewFranchise(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{name: "valid name", input: "The Lord of the Rings"},
+		{name: "empty name", input: "", wantErr: true},
+		{name: "whitespace only name", input: "   ", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			franchise, err := NewFranchise(tt.input, " Middle-earth saga ")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewFranchise() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !franchise.ID().IsZero() {
+				t.Error("Expected new franchise to have zero ID")
+			}
+			if franchise.Description() != "Middle-earth saga" {
+				t.Errorf("Expected trimmed description, got: %q", franchise.Description())
+			}
+		})
+	}
+}
+
+func TestFranchise_PlaceMovie(t *testing.T) {
+	ids := movieIDs(1, 2, 3, 4)
+
+	tests := []struct {
+		name     string
+		movie    shared.MovieID
+		position int
+		want     []int
+	}{
+		{name: "append with zero position", movie: ids[3], position: 0, want: []int{1, 2, 3, 4}},
+		{name: "append past the end", movie: ids[3], position: 10, want: []int{1, 2, 3, 4}},
+		{name: "insert at front", movie: ids[3], position: 1, want: []int{4, 1, 2, 3}},
+		{name: "insert in middle", movie: ids[3], position: 2, want: []int{1, 4, 2, 3}},
+		{name: "move existing earlier", movie: ids[2], position: 1, want: []int{3, 1, 2}},
+		{name: "move existing later", movie: ids[0], position: 3, want: []int{2, 3, 1}},
+		{name: "move existing to end", movie: ids[0], position: 0, want: []int{2, 3, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			franchise, _ := NewFranchise("Series", "")
+			for _, id := range ids[:3] {
+				if err := franchise.PlaceMovie(id, 0); err != nil {
+					t.Fatalf("PlaceMovie() error = %v", err)
+				}
+			}
+
+			if err := franchise.PlaceMovie(tt.movie, tt.position); err != nil {
+				t.Fatalf("PlaceMovie() error = %v", err)
+			}
+			if got := idValues(franchise.MovieIDs()); !equalInts(got, tt.want) {
+				t.Errorf("MovieIDs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFranchise_PlaceMovie_Invalid(t *testing.T) {
+	franchise, _ := NewFranchise("Series", "")
+
+	if err := franchise.PlaceMovie(shared.MovieID{}, 1); err == nil {
+		t.Error("Expected error for zero movie ID")
+	}
+	if err := franchise.PlaceMovie(movieIDs(1)[0], -1); err == nil {
+		t.Error("Expected error for negative position")
+	}
+}
+
+func TestFranchise_RemoveMovie(t *testing.T) {
+	franchise, _ := NewFranchise("Series", "")
+	ids := movieIDs(1, 2, 3)
+	for _, id := range ids {
+		_ = franchise.PlaceMovie(id, 0)
+	}
+
+	if err := franchise.RemoveMovie(ids[1]); err != nil {
+		t.Fatalf("RemoveMovie() error = %v", err)
+	}
+	if got := idValues(franchise.MovieIDs()); !equalInts(got, []int{1, 3}) {
+		t.Errorf("MovieIDs() = %v, want [1 3]", got)
+	}
+	if franchise.HasMovie(ids[1]) || franchise.Position(ids[2]) != 2 {
+		t.Errorf("Expected movie 2 removed and movie 3 at position 2, got position %d", franchise.Position(ids[2]))
+	}
+	if err := franchise.RemoveMovie(ids[1]); err == nil {
+		t.Error("Expected error removing a movie not in the franchise")
+	}
+}
+
+func TestFranchise_SetName(t *testing.T) {
+	franchise, _ := NewFranchise("Series", "")
+
+	if err := franchise.SetName("  "); err == nil {
+		t.Error("Expected error for empty name")
+	}
+	if err := franchise.SetName("Saga"); err != nil || franchise.Name() != "Saga" {
+		t.Errorf("SetName() = %v, name %q", err, franchise.Name())
+	}
+}
@@ -0,0 +1,25 @@
+package franchise
+
+import (
+	"context"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// Repository defines the interface for franchise data access
+type Repository interface {
+	// FindByID retrieves a franchise by its ID
+	FindByID(ctx context.Context, id shared.FranchiseID) (*Franchise, error)
+
+	// FindAll retrieves every franchise ordered by name
+	FindAll(ctx context.Context) ([]*Franchise, error)
+
+	// FindByMovieID retrieves the franchises a movie belongs to
+	FindByMovieID(ctx context.Context, movieID shared.MovieID) ([]*Franchise, error)
+
+	// Save persists a franchise and its movie order (insert or update)
+	Save(ctx context.Context, franchise *Franchise) error
+
+	// Delete removes a franchise by ID; its movies are kept
+	Delete(ctx context.Context, id shared.FranchiseID) error
+}
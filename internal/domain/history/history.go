@@ -0,0 +1,278 @@
+package history
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// Operation represents the kind of change a history entry records
+type Operation string
+
+const (
+	OperationCreate Operation = "create"
+	OperationUpdate Operation = "update"
+	OperationDelete Operation = "delete"
+	OperationRevert Operation = "revert" // An update restoring an earlier version
+)
+
+// Snapshot is the editable content of a movie at one point in time
+type Snapshot struct {
+	Title           string            `json:"title"`
+	Director        string            `json:"director"`
+	Year            int               `json:"year"`
+	Rating          float64           `json:"rating"`
+	Genres          []string          `json:"genres"`
+	PosterURL       string            `json:"poster_url"`
+	Certifications  map[string]string `json:"certifications"`
+	ContentWarnings []string          `json:"content_warnings"`
+	ReleaseDate     string            `json:"release_date,omitempty"` // YYYY-MM-DD; empty when only the year is known
+	Duration        int               `json:"duration,omitempty"`     // Runtime in minutes; zero when unknown
+}
+
+// SnapshotOf captures a movie's current content
+func SnapshotOf(m *movie.Movie) Snapshot {
+	snapshot := Snapshot{
+		Title:           m.Title(),
+		Director:        m.Director(),
+		Year:            m.Year().Value(),
+		Rating:          m.Rating().Value(),
+		Genres:          m.Genres(),
+		PosterURL:       m.PosterURL(),
+		Certifications:  m.Certifications(),
+		ContentWarnings: m.ContentWarnings(),
+		Duration:        m.Duration(),
+	}
+	if m.HasReleaseDate() {
+		snapshot.ReleaseDate = m.ReleaseDate().Format(time.DateOnly)
+	}
+	return snapshot
+}
+
+// Movie rebuilds a movie with the given ID from the snapshot, validating
+// every field as if it were entered again
+func (s Snapshot) Movie(id shared.MovieID) (*movie.Movie, error) {
+	restored, err := movie.NewMovieWithID(id, s.Title, s.Director, s.Year)
+	if err != nil {
+		return nil, err
+	}
+	if s.ReleaseDate != "" {
+		released, err := time.Parse(time.DateOnly, s.ReleaseDate)
+		if err != nil {
+			return nil, shared.NewValidationError("invalid release date %q", s.ReleaseDate)
+		}
+		if err := restored.SetReleaseDate(released); err != nil {
+			return nil, err
+		}
+	}
+	if s.Rating > 0 {
+		if err := restored.SetRating(s.Rating); err != nil {
+			return nil, err
+		}
+	}
+	if err := restored.SetDuration(s.Duration); err != nil {
+		return nil, err
+	}
+	for _, genre := range s.Genres {
+		if err := restored.AddGenre(genre); err != nil {
+			return nil, err
+		}
+	}
+	if err := restored.SetPosterURL(s.PosterURL); err != nil {
+		return nil, err
+	}
+	for region, certification := range s.Certifications {
+		if err := restored.SetCertification(region, certification); err != nil {
+			return nil, err
+		}
+	}
+	for _, warning := range s.ContentWarnings {
+		if err := restored.AddContentWarning(warning); err != nil {
+			return nil, err
+		}
+	}
+	return restored, nil
+}
+
+// Change is one field's JSON-encoded value before and after a change. Old
+// is empty when the movie was created and New when it was deleted.
+type Change struct {
+	Old json.RawMessage `json:"old,omitempty"`
+	New json.RawMessage `json:"new,omitempty"`
+}
+
+// Diff maps the JSON name of each changed snapshot field to its change
+type Diff map[string]Change
+
+// Compare records the fields that differ between two snapshots. A nil
+// before describes a creation and a nil after a deletion.
+func Compare(before, after *Snapshot) (Diff, error) {
+	oldFields, err := fields(before)
+	if err != nil {
+		return nil, err
+	}
+	newFields, err := fields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := make(Diff)
+	for name, value := range newFields {
+		if !bytes.Equal(oldFields[name], value) {
+			diff[name] = Change{Old: oldFields[name], New: value}
+		}
+	}
+	for name, value := range oldFields {
+		if _, ok := newFields[name]; !ok {
+			diff[name] = Change{Old: value}
+		}
+	}
+	return diff, nil
+}
+
+// Fields returns the names of the changed fields in alphabetical order
+func (d Diff) Fields() []string {
+	names := make([]string, 0, len(d))
+	for name := range d {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Undo returns the snapshot with every change in the diff rolled back to
+// its old value; fields that had no old value are cleared
+func (d Diff) Undo(s Snapshot) (Snapshot, error) {
+	current, err := fields(&s)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	for name, change := range d {
+		if len(change.Old) == 0 {
+			delete(current, name)
+			continue
+		}
+		current[name] = change.Old
+	}
+
+	encoded, err := json.Marshal(current)
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	var restored Snapshot
+	if err := json.Unmarshal(encoded, &restored); err != nil {
+		return Snapshot{}, fmt.Errorf("failed to decode snapshot: %w", err)
+	}
+	return restored, nil
+}
+
+// fields splits a snapshot into its JSON-encoded fields; nil has none
+func fields(s *Snapshot) (map[string]json.RawMessage, error) {
+	result := make(map[string]json.RawMessage)
+	if s == nil {
+		return result, nil
+	}
+	encoded, err := json.Marshal(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	if err := json.Unmarshal(encoded, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot: %w", err)
+	}
+	return result, nil
+}
+
+// Entry is one recorded change to a movie. Versions number a movie's
+// entries from 1 and are assigned when the entry is saved.
+type Entry struct {
+	id         int
+	movieID    shared.MovieID
+	version    int
+	operation  Operation
+	diff       Diff
+	revertedTo int
+	createdAt  time.Time
+}
+
+// NewEntry creates a new Entry with validation. revertedTo names the version
+// a revert restored and must be zero for other operations; a zero createdAt
+// defaults to the current time.
+func NewEntry(movieID shared.MovieID, operation Operation, diff Diff, revertedTo int, createdAt time.Time) (*Entry, error) {
+	if movieID.IsZero() {
+		return nil, shared.NewValidationError("movie ID is required")
+	}
+
+	switch operation {
+	case OperationCreate, OperationUpdate, OperationDelete:
+		if revertedTo != 0 {
+			return nil, shared.NewValidationError("only reverts can name a reverted version")
+		}
+	case OperationRevert:
+		if revertedTo < 1 {
+			return nil, shared.NewValidationError("a revert must name the version it restored")
+		}
+	default:
+		return nil, shared.NewValidationError("unknown history operation: %q", operation)
+	}
+
+	if diff == nil {
+		diff = make(Diff)
+	}
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
+	return &Entry{
+		movieID:    movieID,
+		operation:  operation,
+		diff:       diff,
+		revertedTo: revertedTo,
+		createdAt:  createdAt.UTC(),
+	}, nil
+}
+
+// ID returns the entry's identifier, zero until saved
+func (e *Entry) ID() int {
+	return e.id
+}
+
+// MovieID returns the movie the entry belongs to
+func (e *Entry) MovieID() shared.MovieID {
+	return e.movieID
+}
+
+// Version returns the movie version the entry produced, zero until saved
+func (e *Entry) Version() int {
+	return e.version
+}
+
+// Operation returns the kind of change
+func (e *Entry) Operation() Operation {
+	return e.operation
+}
+
+// Diff returns the fields the change touched
+func (e *Entry) Diff() Diff {
+	return e.diff
+}
+
+// RevertedTo returns the version a revert restored, or zero
+func (e *Entry) RevertedTo() int {
+	return e.revertedTo
+}
+
+// CreatedAt returns when the change was made
+func (e *Entry) CreatedAt() time.Time {
+	return e.createdAt
+}
+
+// SetSaved sets the entry's ID and version (used by repository when saving)
+func (e *Entry) SetSaved(id, version int) {
+	e.id = id
+	e.version = version
+}
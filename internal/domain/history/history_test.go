@@ -0,0 +1,120 @@
+package history
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+func TestNewEntry(t *testing.T) {
+	movieID, _ := shared.NewMovieID(1)
+
+	tests := []struct {
+		name       string
+		movieID    shared.MovieID
+		operation  Operation
+		revertedTo int
+		wantErr    bool
+	}{
+		{name: "create", movieID: movieID, operation: OperationCreate},
+		{name: "update", movieID: movieID, operation: OperationUpdate},
+		{name: "delete", movieID: movieID, operation: OperationDelete},
+		{name: "revert", movieID: movieID, operation: OperationRevert, revertedTo: 2},
+		{name: "missing movie", operation: OperationCreate, wantErr: true},
+		{name: "unknown operation", movieID: movieID, operation: "rename", wantErr: true},
+		{name: "revert without version", movieID: movieID, operation: OperationRevert, wantErr: true},
+		{name: "update naming a version", movieID: movieID, operation: OperationUpdate, revertedTo: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewEntry(tt.movieID, tt.operation, nil, tt.revertedTo, time.Time{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewEntry() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	before := Snapshot{Title: "Inception", Director: "C. Nolan", Year: 2010, Rating: 8, Genres: []string{"Sci-Fi"}}
+	after := before
+	after.Director = "Christopher Nolan"
+	after.Genres = []string{"Sci-Fi", "Thriller"}
+
+	diff, err := Compare(&before, &after)
+	if err != nil {
+		t.Fatalf("Compare() error = %v", err)
+	}
+
+	if fields := diff.Fields(); !reflect.DeepEqual(fields, []string{"director", "genres"}) {
+		t.Errorf("Expected director and genres to change, got: %v", fields)
+	}
+	if string(diff["director"].Old) != `"C. Nolan"` || string(diff["director"].New) != `"Christopher Nolan"` {
+		t.Errorf("Expected director values, got: %+v", diff["director"])
+	}
+
+	created, _ := Compare(nil, &after)
+	if len(created) != 8 || created["title"].Old != nil {
+		t.Errorf("Expected every field new on create, got: %+v", created)
+	}
+
+	deleted, _ := Compare(&before, nil)
+	if len(deleted) != 8 || deleted["title"].New != nil {
+		t.Errorf("Expected every field removed on delete, got: %+v", deleted)
+	}
+}
+
+func TestDiff_Undo(t *testing.T) {
+	before := Snapshot{Title: "Inception", Director: "C. Nolan", Year: 2010, Genres: []string{"Sci-Fi"}}
+	after := before
+	after.Director = "Christopher Nolan"
+	after.Rating = 9
+	after.Certifications = map[string]string{"US": "PG-13"}
+
+	diff, _ := Compare(&before, &after)
+	restored, err := diff.Undo(after)
+	if err != nil {
+		t.Fatalf("Undo() error = %v", err)
+	}
+	if !reflect.DeepEqual(restored, before) {
+		t.Errorf("Expected undo to restore %+v, got: %+v", before, restored)
+	}
+
+	created, _ := Compare(nil, &after)
+	empty, _ := created.Undo(after)
+	if !reflect.DeepEqual(empty, Snapshot{}) {
+		t.Errorf("Expected undoing a create to clear every field, got: %+v", empty)
+	}
+}
+
+func TestSnapshot_Movie(t *testing.T) {
+	original, _ := movie.NewMovieWithID(shared.MovieID{}, "Inception", "Christopher Nolan", 2010)
+	_ = original.SetRating(8.8)
+	_ = original.AddGenre("Sci-Fi")
+	_ = original.SetPosterURL("https://example.com/inception.jpg")
+	_ = original.SetCertification("US", "PG-13")
+	_ = original.AddContentWarning("violence")
+	_ = original.SetReleaseDate(time.Date(2010, time.July, 16, 0, 0, 0, 0, time.UTC))
+	_ = original.SetDuration(148)
+
+	movieID, _ := shared.NewMovieID(7)
+	restored, err := SnapshotOf(original).Movie(movieID)
+	if err != nil {
+		t.Fatalf("Movie() error = %v", err)
+	}
+
+	if restored.ID() != movieID {
+		t.Errorf("Expected ID %d, got: %d", movieID.Value(), restored.ID().Value())
+	}
+	if !reflect.DeepEqual(SnapshotOf(restored), SnapshotOf(original)) {
+		t.Errorf("Expected restored content %+v, got: %+v", SnapshotOf(original), SnapshotOf(restored))
+	}
+
+	if _, err := (Snapshot{Director: "Nobody", Year: 2010}).Movie(movieID); err == nil {
+		t.Error("Expected an invalid snapshot to be rejected")
+	}
+}
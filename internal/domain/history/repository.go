@@ -0,0 +1,20 @@
+package history
+
+import (
+	"context"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// Repository defines the interface for movie history data access. History
+// is kept after a movie is deleted.
+type Repository interface {
+	// FindByMovieID retrieves a movie's history, oldest version first
+	FindByMovieID(ctx context.Context, movieID shared.MovieID) ([]*Entry, error)
+
+	// Append saves an entry as the movie's next version
+	Append(ctx context.Context, entry *Entry) error
+
+	// AppendAll saves entries as their movies' next versions in one go
+	AppendAll(ctx context.Context, entries []*Entry) error
+}
@@ -0,0 +1,153 @@
+package media
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// Type represents the kind of media linked from a movie
+type Type string
+
+const (
+	TypeTrailer Type = "trailer"
+	TypeTeaser  Type = "teaser"
+	TypeClip    Type = "clip"
+	TypeStill   Type = "still" // A still image from the movie
+)
+
+// Types returns all supported media types
+func Types() []Type {
+	return []Type{TypeTrailer, TypeTeaser, TypeClip, TypeStill}
+}
+
+// ParseType validates a media type, defaulting an empty value to trailer
+func ParseType(value string) (Type, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return TypeTrailer, nil
+	}
+	for _, mediaType := range Types() {
+		if Type(value) == mediaType {
+			return mediaType, nil
+		}
+	}
+	return "", shared.NewValidationError("media type must be one of trailer, teaser, clip or still")
+}
+
+// knownProviders names the hosts media is most often linked from
+var knownProviders = map[string]string{
+	"youtube.com":   "YouTube",
+	"m.youtube.com": "YouTube",
+	"youtu.be":      "YouTube",
+	"vimeo.com":     "Vimeo",
+	"imdb.com":      "IMDb",
+}
+
+// Media is a trailer, clip or still linked from a movie
+type Media struct {
+	id        int
+	movieID   shared.MovieID
+	mediaType Type
+	url       string
+	provider  string
+	title     string
+	primary   bool
+}
+
+// NewMedia creates a new Media with validation. An empty type defaults to
+// trailer and an empty provider is derived from the URL's host. Only
+// trailers can be primary.
+func NewMedia(movieID shared.MovieID, mediaType, link, provider, title string, primary bool) (*Media, error) {
+	if movieID.IsZero() {
+		return nil, shared.NewValidationError("movie ID is required")
+	}
+
+	parsedType, err := ParseType(mediaType)
+	if err != nil {
+		return nil, err
+	}
+
+	link = strings.TrimSpace(link)
+	if link == "" {
+		return nil, shared.NewValidationError("media URL cannot be empty")
+	}
+	parsedURL, err := url.Parse(link)
+	if err != nil {
+		return nil, shared.NewValidationError("invalid URL format")
+	}
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		return nil, shared.NewValidationError("media URL must use HTTP or HTTPS scheme")
+	}
+	if parsedURL.Host == "" {
+		return nil, shared.NewValidationError("media URL must include a host")
+	}
+
+	provider = strings.TrimSpace(provider)
+	if provider == "" {
+		provider = providerFromHost(parsedURL.Hostname())
+	}
+
+	if primary && parsedType != TypeTrailer {
+		return nil, shared.NewValidationError("only trailers can be primary")
+	}
+
+	return &Media{
+		movieID:   movieID,
+		mediaType: parsedType,
+		url:       link,
+		provider:  provider,
+		title:     strings.TrimSpace(title),
+		primary:   primary,
+	}, nil
+}
+
+// providerFromHost names a well-known host, falling back to the host itself
+func providerFromHost(host string) string {
+	host = strings.TrimPrefix(strings.ToLower(host), "www.")
+	if name, ok := knownProviders[host]; ok {
+		return name
+	}
+	return host
+}
+
+// ID returns the media's identifier, zero until saved
+func (m *Media) ID() int {
+	return m.id
+}
+
+// MovieID returns the movie this media belongs to
+func (m *Media) MovieID() shared.MovieID {
+	return m.movieID
+}
+
+// Type returns the kind of media
+func (m *Media) Type() Type {
+	return m.mediaType
+}
+
+// URL returns the link to the media
+func (m *Media) URL() string {
+	return m.url
+}
+
+// Provider returns where the media is hosted, e.g. YouTube
+func (m *Media) Provider() string {
+	return m.provider
+}
+
+// Title returns the media's title, if any
+func (m *Media) Title() string {
+	return m.title
+}
+
+// IsPrimary reports whether the media is flagged as the movie's primary trailer
+func (m *Media) IsPrimary() bool {
+	return m.primary
+}
+
+// SetID sets the media's ID (used by repository when saving)
+func (m *Media) SetID(id int) {
+	m.id = id
+}
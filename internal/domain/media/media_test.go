@@ -0,0 +1,71 @@
+package media
+
+import (
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+func TestNewMedia(t *testing.T) {
+	movieID, _ := shared.NewMovieID(1)
+
+	tests := []struct {
+		name      string
+		movieID   shared.MovieID
+		mediaType string
+		url       string
+		primary   bool
+		wantErr   bool
+	}{
+		{name: "valid trailer", movieID: movieID, mediaType: "trailer", url: "https://www.youtube.com/watch?v=abc", primary: true},
+		{name: "empty type defaults", movieID: movieID, url: "https://vimeo.com/1"},
+		{name: "still", movieID: movieID, mediaType: "Still", url: "https://example.com/still.jpg"},
+		{name: "missing movie", url: "https://example.com/a", wantErr: true},
+		{name: "invalid type", movieID: movieID, mediaType: "poster", url: "https://example.com/a", wantErr: true},
+		{name: "empty url", movieID: movieID, url: "  ", wantErr: true},
+		{name: "invalid url scheme", movieID: movieID, url: "ftp://example.com/a", wantErr: true},
+		{name: "url without host", movieID: movieID, url: "https:///a", wantErr: true},
+		{name: "primary clip", movieID: movieID, mediaType: "clip", url: "https://example.com/a", primary: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewMedia(tt.movieID, tt.mediaType, tt.url, "", "", tt.primary)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewMedia() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewMedia_Defaults(t *testing.T) {
+	movieID, _ := shared.NewMovieID(1)
+
+	tests := []struct {
+		url      string
+		provider string
+		want     string
+	}{
+		{url: "https://www.youtube.com/watch?v=abc", want: "YouTube"},
+		{url: "https://youtu.be/abc", want: "YouTube"},
+		{url: "https://vimeo.com/1", want: "Vimeo"},
+		{url: "https://media.example.com/clip.mp4", want: "media.example.com"},
+		{url: "https://youtu.be/abc", provider: " Studio Channel ", want: "Studio Channel"},
+	}
+
+	for _, tt := range tests {
+		entry, err := NewMedia(movieID, "", tt.url, tt.provider, " Official Trailer ", false)
+		if err != nil {
+			t.Fatalf("NewMedia() error = %v", err)
+		}
+		if entry.Provider() != tt.want {
+			t.Errorf("Expected provider %q for %s, got: %q", tt.want, tt.url, entry.Provider())
+		}
+		if entry.Type() != TypeTrailer {
+			t.Errorf("Expected trailer type, got: %s", entry.Type())
+		}
+		if entry.Title() != "Official Trailer" {
+			t.Errorf("Expected trimmed title, got: %q", entry.Title())
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package media
+
+import (
+	"context"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// Repository defines the interface for movie media data access
+type Repository interface {
+	// FindByMovieID retrieves a movie's media ordered by type, primary first,
+	// then oldest first; an empty type returns every type
+	FindByMovieID(ctx context.Context, movieID shared.MovieID, mediaType Type) ([]*Media, error)
+
+	// FindPrimaryTrailer retrieves the trailer flagged primary, or the oldest
+	// trailer if none is; it returns nil when the movie has no trailers
+	FindPrimaryTrailer(ctx context.Context, movieID shared.MovieID) (*Media, error)
+
+	// Save inserts media or updates the movie's existing media with the same
+	// URL. Saving a primary trailer clears the flag on the movie's others.
+	Save(ctx context.Context, media *Media) error
+}
@@ -0,0 +1,92 @@
+package movie
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// maxContentWarningLength bounds a single content warning
+const maxContentWarningLength = 50
+
+// certificationScales lists each supported region's age ratings from least
+// to most restrictive, with ratings of equal severity sharing a rank: the
+// MPAA scale for the US and the BBFC scale for Great Britain.
+var certificationScales = map[string]map[string]int{
+	"US": {"G": 0, "PG": 1, "PG-13": 2, "R": 3, "NC-17": 4},
+	"GB": {"U": 0, "PG": 1, "12A": 2, "12": 2, "15": 3, "18": 4, "R18": 5},
+}
+
+// regionAliases maps common alternative region codes to the supported ones
+var regionAliases = map[string]string{"UK": "GB"}
+
+// CertificationRegions returns the regions with a supported rating scale, sorted
+func CertificationRegions() []string {
+	regions := make([]string, 0, len(certificationScales))
+	for region := range certificationScales {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	return regions
+}
+
+// NormalizeRegion upper-cases a region code and checks it has a rating scale
+func NormalizeRegion(region string) (string, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(region))
+	if alias, ok := regionAliases[normalized]; ok {
+		normalized = alias
+	}
+	if _, ok := certificationScales[normalized]; !ok {
+		return "", shared.NewValidationError("unsupported certification region %q (must be one of %s)",
+			region, strings.Join(CertificationRegions(), ", "))
+	}
+	return normalized, nil
+}
+
+// ParseCertification validates an age rating against its region's scale,
+// returning both in canonical form (e.g. "us", "pg-13" becomes "US", "PG-13")
+func ParseCertification(region, certification string) (string, string, error) {
+	normalizedRegion, err := NormalizeRegion(region)
+	if err != nil {
+		return "", "", err
+	}
+
+	normalized := strings.ToUpper(strings.TrimSpace(certification))
+	if _, ok := certificationScales[normalizedRegion][normalized]; !ok {
+		return "", "", shared.NewValidationError("invalid %s certification %q", normalizedRegion, certification)
+	}
+	return normalizedRegion, normalized, nil
+}
+
+// CertificationsUpTo returns every rating in a region that is no more
+// restrictive than maxCertification, sorted alphabetically
+func CertificationsUpTo(region, maxCertification string) ([]string, error) {
+	normalizedRegion, normalizedMax, err := ParseCertification(region, maxCertification)
+	if err != nil {
+		return nil, err
+	}
+
+	scale := certificationScales[normalizedRegion]
+	var allowed []string
+	for certification, rank := range scale {
+		if rank <= scale[normalizedMax] {
+			allowed = append(allowed, certification)
+		}
+	}
+	sort.Strings(allowed)
+	return allowed, nil
+}
+
+// NormalizeContentWarning trims and lower-cases a content warning such as
+// "Violence" or "strong language"
+func NormalizeContentWarning(warning string) (string, error) {
+	normalized := strings.ToLower(strings.Join(strings.Fields(warning), " "))
+	if normalized == "" {
+		return "", shared.NewValidationError("content warning cannot be empty")
+	}
+	if len(normalized) > maxContentWarningLength {
+		return "", shared.NewValidationError("content warning cannot exceed %d characters", maxContentWarningLength)
+	}
+	return normalized, nil
+}
@@ -0,0 +1,130 @@
+package movie
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseCertification(t *testing.T) {
+	tests := []struct {
+		name          string
+		region        string
+		certification string
+		wantRegion    string
+		wantCert      string
+		wantErr       bool
+	}{
+		{name: "MPAA rating", region: "US", certification: "PG-13", wantRegion: "US", wantCert: "PG-13"},
+		{name: "lower case", region: "us", certification: "pg-13", wantRegion: "US", wantCert: "PG-13"},
+		{name: "BBFC rating", region: "GB", certification: "12A", wantRegion: "GB", wantCert: "12A"},
+		{name: "UK alias", region: "uk", certification: "15", wantRegion: "GB", wantCert: "15"},
+		{name: "rating from another region", region: "US", certification: "15", wantErr: true},
+		{name: "unsupported region", region: "FR", certification: "U", wantErr: true},
+		{name: "empty rating", region: "US", certification: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			region, cert, err := ParseCertification(tt.region, tt.certification)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCertification() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (region != tt.wantRegion || cert != tt.wantCert) {
+				t.Errorf("Expected %s %s, got: %s %s", tt.wantRegion, tt.wantCert, region, cert)
+			}
+		})
+	}
+}
+
+func TestCertificationsUpTo(t *testing.T) {
+	tests := []struct {
+		name    string
+		region  string
+		max     string
+		want    []string
+		wantErr bool
+	}{
+		{name: "MPAA PG-13", region: "US", max: "PG-13", want: []string{"G", "PG", "PG-13"}},
+		{name: "MPAA least restrictive", region: "US", max: "G", want: []string{"G"}},
+		{name: "BBFC 12 includes 12A", region: "GB", max: "12", want: []string{"12", "12A", "PG", "U"}},
+		{name: "invalid maximum", region: "US", max: "X", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CertificationsUpTo(tt.region, tt.max)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CertificationsUpTo() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected %v, got: %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMovie_SetCertification(t *testing.T) {
+	movie, _ := NewMovie("The Dark Knight", "Christopher Nolan", 2008)
+
+	if err := movie.SetCertification("us", "pg-13"); err != nil {
+		t.Fatalf("SetCertification() error = %v", err)
+	}
+	if err := movie.SetCertification("GB", "12A"); err != nil {
+		t.Fatalf("SetCertification() error = %v", err)
+	}
+	if err := movie.SetCertification("US", "12A"); err == nil {
+		t.Error("Expected error for a rating outside the region's scale")
+	}
+
+	want := map[string]string{"US": "PG-13", "GB": "12A"}
+	if !reflect.DeepEqual(movie.Certifications(), want) {
+		t.Errorf("Expected %v, got: %v", want, movie.Certifications())
+	}
+	if movie.Certification("uk") != "12A" {
+		t.Errorf("Expected UK lookup to use GB, got: %q", movie.Certification("uk"))
+	}
+
+	if err := movie.SetCertification("GB", ""); err != nil {
+		t.Fatalf("SetCertification() error = %v", err)
+	}
+	if movie.Certification("GB") != "" {
+		t.Errorf("Expected GB rating to be removed, got: %q", movie.Certification("GB"))
+	}
+}
+
+func TestMovie_AddContentWarning(t *testing.T) {
+	movie, _ := NewMovie("The Dark Knight", "Christopher Nolan", 2008)
+
+	if err := movie.AddContentWarning("  Violence "); err != nil {
+		t.Fatalf("AddContentWarning() error = %v", err)
+	}
+	if err := movie.AddContentWarning("strong   language"); err != nil {
+		t.Fatalf("AddContentWarning() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		warning string
+	}{
+		{name: "duplicate ignoring case", warning: "VIOLENCE"},
+		{name: "empty", warning: "   "},
+		{name: "too long", warning: "this warning is far too long to be a useful content label"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := movie.AddContentWarning(tt.warning); err == nil {
+				t.Error("Expected error, got nil")
+			}
+		})
+	}
+
+	want := []string{"violence", "strong language"}
+	if !reflect.DeepEqual(movie.ContentWarnings(), want) {
+		t.Errorf("Expected %v, got: %v", want, movie.ContentWarnings())
+	}
+	if !movie.HasContentWarning("Strong Language") {
+		t.Error("Expected HasContentWarning to ignore case")
+	}
+}
@@ -0,0 +1,173 @@
+package movie
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// firstDecade is the decade of the first motion picture (1888), the earliest
+// year a movie can have
+const firstDecade = 1880
+
+// twoDigitPivot is the last two-digit decade read as 20xx: "20s" is the
+// 2020s, "40s" the 1940s
+const twoDigitPivot = 30
+
+// DecadeFormats lists examples of the accepted decade formats, for help
+// texts and error messages
+var DecadeFormats = []string{"1990s", "1990", "90s", "'90s", "the nineties", "1990-1999"}
+
+// decadeWords maps spelled-out decades to their first year. Twenties to
+// nineties also accept a "nineteen" prefix ("nineteen eighties").
+var decadeWords = func() map[string]int {
+	words := map[string]int{
+		"aughts":          2000,
+		"noughties":       2000,
+		"two thousands":   2000,
+		"tens":            2010,
+		"twenty tens":     2010,
+		"twenty twenties": 2020,
+	}
+	for i, word := range []string{"twenties", "thirties", "forties", "fifties", "sixties", "seventies", "eighties", "nineties"} {
+		start := 1920 + i*10
+		words[word] = start
+		words["nineteen "+word] = start
+	}
+	return words
+}()
+
+// Decade is a ten-year span of release years, such as 1990 to 1999
+type Decade struct {
+	start int
+}
+
+// Start returns the first year of the decade
+func (d Decade) Start() int {
+	return d.start
+}
+
+// End returns the last year of the decade
+func (d Decade) End() int {
+	return d.start + 9
+}
+
+// String returns the canonical form of the decade, e.g. "1990s"
+func (d Decade) String() string {
+	return strconv.Itoa(d.start) + "s"
+}
+
+// ParseDecade parses a decade written as a year ("1990s", "1990", "1995"),
+// a two-digit decade ("90s", "'90s", "90's"), words ("the nineties",
+// "nineteen eighties", "noughties") or an explicit range ("1990-1999",
+// "1990-99", "1990 to 1999"). Matching is case-insensitive and ignores a
+// leading "the".
+func ParseDecade(input string) (Decade, error) {
+	start, ok := parseDecadeStart(normalizeDecade(input))
+	if !ok {
+		return Decade{}, shared.NewValidationError("invalid decade %q (accepted formats: %s)",
+			input, strings.Join(DecadeFormats, ", "))
+	}
+
+	last := (time.Now().Year() + 15) / 10 * 10
+	if start < firstDecade || start > last {
+		return Decade{}, shared.NewValidationError("decade %ds is outside %ds-%ds", start, firstDecade, last)
+	}
+	return Decade{start: start}, nil
+}
+
+// normalizeDecade lower-cases input, unifies apostrophes, dashes and
+// whitespace, and drops a leading "the"
+func normalizeDecade(input string) string {
+	normalized := strings.NewReplacer("’", "'", "‘", "'", "`", "'", "–", "-", "—", "-").
+		Replace(strings.ToLower(input))
+	normalized = strings.Join(strings.Fields(normalized), " ")
+	return strings.TrimPrefix(normalized, "the ")
+}
+
+// parseDecadeStart returns the first year of a normalized decade
+func parseDecadeStart(decade string) (int, bool) {
+	// Words may be hyphenated: "nineteen-eighties", "twenty-tens"
+	if start, ok := decadeWords[strings.ReplaceAll(decade, "-", " ")]; ok {
+		return start, true
+	}
+
+	for _, separator := range []string{"-", " to "} {
+		if from, to, found := strings.Cut(decade, separator); found {
+			return parseDecadeRange(strings.TrimSpace(from), strings.TrimSpace(to))
+		}
+	}
+
+	// "90's" and "'90s" are written "90s"
+	decade = strings.Replace(decade, "'s", "s", 1)
+	decade, suffixed := strings.CutSuffix(decade, "s")
+	if strings.HasPrefix(decade, "'") {
+		if len(decade) != 3 {
+			return 0, false
+		}
+		decade = decade[1:]
+	}
+
+	year, ok := parseDigits(decade)
+	if !ok {
+		return 0, false
+	}
+	switch len(decade) {
+	case 2:
+		if year <= twoDigitPivot {
+			year += 2000
+		} else {
+			year += 1900
+		}
+	case 4:
+	default:
+		return 0, false
+	}
+
+	// "1990s" names a decade by its first year; a plain year names its decade
+	if suffixed && year%10 != 0 {
+		return 0, false
+	}
+	return year / 10 * 10, true
+}
+
+// parseDecadeRange parses "1990-1999" or "1990-99", which must span exactly
+// one decade
+func parseDecadeRange(from, to string) (int, bool) {
+	start, ok := parseDigits(from)
+	if !ok || len(from) != 4 || start%10 != 0 {
+		return 0, false
+	}
+
+	end, ok := parseDigits(to)
+	switch {
+	case !ok:
+		return 0, false
+	case len(to) == 2:
+		end += start / 100 * 100
+	case len(to) != 4:
+		return 0, false
+	}
+
+	if end != start+9 {
+		return 0, false
+	}
+	return start, true
+}
+
+// parseDigits parses an unsigned decimal number, rejecting the signs
+// strconv.Atoi accepts
+func parseDigits(s string) (int, bool) {
+	if s == "" {
+		return 0, false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return 0, false
+		}
+	}
+	n, err := strconv.Atoi(s)
+	return n, err == nil
+}
@@ -0,0 +1,117 @@
+package movie
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseDecade(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantStart int
+		wantErr   bool
+	}{
+		{name: "four-digit decade", input: "1990s", wantStart: 1990},
+		{name: "four-digit year", input: "1990", wantStart: 1990},
+		{name: "year inside the decade", input: "1995", wantStart: 1990},
+		{name: "two-digit decade", input: "90s", wantStart: 1990},
+		{name: "two-digit without suffix", input: "90", wantStart: 1990},
+		{name: "two-digit after the pivot", input: "20s", wantStart: 2020},
+		{name: "two-digit before the pivot", input: "40s", wantStart: 1940},
+		{name: "leading apostrophe", input: "'90s", wantStart: 1990},
+		{name: "apostrophe before suffix", input: "90's", wantStart: 1990},
+		{name: "curly apostrophe", input: "’80s", wantStart: 1980},
+		{name: "four-digit with apostrophe", input: "1970's", wantStart: 1970},
+		{name: "words", input: "the nineties", wantStart: 1990},
+		{name: "words without article", input: "Eighties", wantStart: 1980},
+		{name: "words with century", input: "Nineteen Seventies", wantStart: 1970},
+		{name: "hyphenated words", input: "nineteen-sixties", wantStart: 1960},
+		{name: "noughties", input: "the noughties", wantStart: 2000},
+		{name: "twenty tens", input: "twenty-tens", wantStart: 2010},
+		{name: "article with digits", input: "the 1980s", wantStart: 1980},
+		{name: "range", input: "1990-1999", wantStart: 1990},
+		{name: "range with spaces", input: "1990 - 1999", wantStart: 1990},
+		{name: "range with en dash", input: "1990–1999", wantStart: 1990},
+		{name: "range with short end", input: "1990-99", wantStart: 1990},
+		{name: "range across a century", input: "1990-2000", wantErr: true},
+		{name: "range with words", input: "1980 to 1989", wantStart: 1980},
+		{name: "surrounding whitespace", input: "  2000s  ", wantStart: 2000},
+		{name: "range longer than a decade", input: "1990-2009", wantErr: true},
+		{name: "range off the decade", input: "1995-2004", wantErr: true},
+		{name: "suffix on a year", input: "1995s", wantErr: true},
+		{name: "negative", input: "-9", wantErr: true},
+		{name: "signed year", input: "+1990", wantErr: true},
+		{name: "three digits", input: "199", wantErr: true},
+		{name: "before the first movie", input: "1870s", wantErr: true},
+		{name: "far future", input: "2990s", wantErr: true},
+		{name: "unknown words", input: "the roaring nineties", wantErr: true},
+		{name: "empty", input: "", wantErr: true},
+		{name: "doubled suffix", input: "1990ss", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decade, err := ParseDecade(tt.input)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDecade(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if decade.Start() != tt.wantStart || decade.End() != tt.wantStart+9 {
+				t.Errorf("Expected %d-%d, got: %d-%d", tt.wantStart, tt.wantStart+9, decade.Start(), decade.End())
+			}
+		})
+	}
+}
+
+func TestParseDecade_ErrorListsFormats(t *testing.T) {
+	_, err := ParseDecade("sometime")
+	if err == nil {
+		t.Fatal("Expected an error for an unknown format")
+	}
+	for _, format := range DecadeFormats {
+		if !strings.Contains(err.Error(), format) {
+			t.Errorf("Expected the error to list %q, got: %v", format, err)
+		}
+	}
+}
+
+func TestDecade_String(t *testing.T) {
+	decade, err := ParseDecade("the eighties")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if decade.String() != "1980s" {
+		t.Errorf("Expected 1980s, got: %s", decade.String())
+	}
+}
+
+func FuzzParseDecade(f *testing.F) {
+	for _, seed := range []string{"1990s", "90s", "'90s", "90's", "1990", " 80s ", "the nineties", "nineteen-sixties",
+		"1990-1999", "1990-99", "1990 to 1999", "", "s", "-9", "+990", "19x0", "199", "1990ss", "'s", "-", " to "} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		decade, err := ParseDecade(input)
+		if err != nil {
+			return
+		}
+
+		if decade.Start() < firstDecade || decade.Start()%10 != 0 {
+			t.Errorf("Expected a decade boundary from %q, got: %d", input, decade.Start())
+		}
+		if decade.End() != decade.Start()+9 {
+			t.Errorf("Expected end %d for %q, got: %d", decade.Start()+9, input, decade.End())
+		}
+
+		// The canonical form parses back to the same decade
+		again, err := ParseDecade(decade.String())
+		if err != nil || again != decade {
+			t.Errorf("Expected %s to parse back to itself, got: %s (%v)", decade, again, err)
+		}
+	})
+}
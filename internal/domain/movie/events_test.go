@@ -168,3 +168,49 @@ func TestMovieEventTypes(t *testing.T) {
 		t.Errorf("Expected title 'Test Movie', got %s", deletedEvent.Title)
 	}
 }
+
+func TestMovieLifecycleEvents(t *testing.T) {
+	previous, _ := NewMovie("Heat", "Michael Mann", 1995)
+	_ = previous.SetRating(8.3)
+	_ = previous.AddGenre("Crime")
+
+	// Creation replaces the events raised while the movie was built
+	previous.SetID(mustMovieID(t, 42))
+	previous.RecordCreated()
+	assertEventTypes(t, previous.UncommittedEvents(), "MovieCreated")
+
+	updated, _ := NewMovieWithID(previous.ID(), "Heat", "Michael Mann", 1995)
+	_ = updated.SetRating(8.3)
+	_ = updated.AddGenre("Crime")
+	updated.RecordUpdateOf(previous)
+	assertEventTypes(t, updated.UncommittedEvents(), "MovieUpdated")
+
+	_ = updated.SetRating(8.5)
+	updated.RecordUpdateOf(previous)
+	assertEventTypes(t, updated.UncommittedEvents(), "MovieRatingChanged", "MovieUpdated")
+
+	updated.MarkEventsAsCommitted()
+	updated.RecordDeleted()
+	assertEventTypes(t, updated.UncommittedEvents(), "MovieDeleted")
+}
+
+func mustMovieID(t *testing.T, id int) shared.MovieID {
+	t.Helper()
+	movieID, err := shared.NewMovieID(id)
+	if err != nil {
+		t.Fatalf("NewMovieID(%d) error = %v", id, err)
+	}
+	return movieID
+}
+
+func assertEventTypes(t *testing.T, events []shared.DomainEvent, want ...string) {
+	t.Helper()
+	if len(events) != len(want) {
+		t.Fatalf("Expected events %v, got %d events", want, len(events))
+	}
+	for i, event := range events {
+		if event.EventType() != want[i] {
+			t.Errorf("Event %d: expected %s, got %s", i, want[i], event.EventType())
+		}
+	}
+}
@@ -1,7 +1,6 @@
 package movie
 
 import (
-	"errors"
 	"net/url"
 	"strings"
 	"time"
@@ -17,10 +16,15 @@ type Movie struct {
 	director  string
 	year      shared.Year
 	rating    shared.Rating
+	released  time.Time // Release date, when known to the day; zero otherwise
+	duration  int       // Runtime in minutes; zero when unknown
 	genres    []string
 	posterURL string
 	createdAt time.Time
 	updatedAt time.Time
+
+	certifications  map[string]string // Age rating keyed by region code
+	contentWarnings []string
 }
 
 // NewMovie creates a new Movie with validation
@@ -38,10 +42,10 @@ func NewMovie(title, director string, year int) (*Movie, error) {
 func NewMovieWithID(id shared.MovieID, title, director string, year int) (*Movie, error) {
 	// Validate inputs
 	if strings.TrimSpace(title) == "" {
-		return nil, errors.New("title cannot be empty")
+		return nil, shared.NewValidationError("title cannot be empty")
 	}
 	if strings.TrimSpace(director) == "" {
-		return nil, errors.New("director cannot be empty")
+		return nil, shared.NewValidationError("director cannot be empty")
 	}
 
 	movieYear, err := shared.NewYear(year)
@@ -59,6 +63,9 @@ func NewMovieWithID(id shared.MovieID, title, director string, year int) (*Movie
 		genres:        make([]string, 0),
 		createdAt:     now,
 		updatedAt:     now,
+
+		certifications:  make(map[string]string),
+		contentWarnings: make([]string, 0),
 	}
 
 	// Emit domain event for new movie creation (only for non-zero IDs)
@@ -90,6 +97,66 @@ func (m *Movie) Year() shared.Year {
 	return m.year
 }
 
+// ReleaseDate returns the movie's release date, midnight UTC, or the zero
+// time when only the release year is known
+func (m *Movie) ReleaseDate() time.Time {
+	return m.released
+}
+
+// HasReleaseDate reports whether the release date is known to the day
+func (m *Movie) HasReleaseDate() bool {
+	return !m.released.IsZero()
+}
+
+// SetReleaseDate sets the movie's release date and, from it, the release
+// year. The zero time clears the date and keeps the year.
+func (m *Movie) SetReleaseDate(date time.Time) error {
+	if date.IsZero() {
+		m.released = time.Time{}
+		m.touch()
+		return nil
+	}
+
+	year, err := shared.NewYear(date.Year())
+	if err != nil {
+		return err
+	}
+	m.year = year
+	m.released = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	m.touch()
+	return nil
+}
+
+const (
+	// MaxDuration is the longest runtime a movie can have, in minutes
+	MaxDuration = 1440
+
+	// ShortFilmMaxDuration is the longest runtime of a short film, in
+	// minutes, as the Academy defines it
+	ShortFilmMaxDuration = 40
+)
+
+// Duration returns the movie's runtime in minutes, or zero when unknown
+func (m *Movie) Duration() int {
+	return m.duration
+}
+
+// IsShortFilm reports whether the movie's runtime is known and no longer
+// than ShortFilmMaxDuration
+func (m *Movie) IsShortFilm() bool {
+	return m.duration > 0 && m.duration <= ShortFilmMaxDuration
+}
+
+// SetDuration sets the movie's runtime in minutes; zero clears it
+func (m *Movie) SetDuration(minutes int) error {
+	if minutes < 0 || minutes > MaxDuration {
+		return shared.NewValidationError("duration must be between 1 and %d minutes", MaxDuration)
+	}
+	m.duration = minutes
+	m.touch()
+	return nil
+}
+
 // Rating returns the movie's rating
 func (m *Movie) Rating() shared.Rating {
 	return m.rating
@@ -139,13 +206,13 @@ func (m *Movie) SetRating(rating float64) error {
 func (m *Movie) AddGenre(genre string) error {
 	genre = strings.TrimSpace(genre)
 	if genre == "" {
-		return errors.New("genre cannot be empty")
+		return shared.NewValidationError("genre cannot be empty")
 	}
 
 	// Check for duplicates
 	for _, g := range m.genres {
 		if g == genre {
-			return errors.New("genre already exists")
+			return shared.NewValidationError("genre already exists")
 		}
 	}
 
@@ -169,6 +236,84 @@ func (m *Movie) HasGenre(genre string) bool {
 	return false
 }
 
+// Certifications returns a copy of the movie's age ratings keyed by region
+func (m *Movie) Certifications() map[string]string {
+	certifications := make(map[string]string, len(m.certifications))
+	for region, certification := range m.certifications {
+		certifications[region] = certification
+	}
+	return certifications
+}
+
+// Certification returns the movie's age rating in a region, or "" if unrated
+func (m *Movie) Certification(region string) string {
+	normalizedRegion, err := NormalizeRegion(region)
+	if err != nil {
+		return ""
+	}
+	return m.certifications[normalizedRegion]
+}
+
+// SetCertification sets the movie's age rating in a region, validated against
+// that region's scale. An empty certification removes the region's rating.
+func (m *Movie) SetCertification(region, certification string) error {
+	if strings.TrimSpace(certification) == "" {
+		normalizedRegion, err := NormalizeRegion(region)
+		if err != nil {
+			return err
+		}
+		delete(m.certifications, normalizedRegion)
+		m.touch()
+		return nil
+	}
+
+	normalizedRegion, normalizedCertification, err := ParseCertification(region, certification)
+	if err != nil {
+		return err
+	}
+
+	m.certifications[normalizedRegion] = normalizedCertification
+	m.touch()
+	return nil
+}
+
+// ContentWarnings returns a copy of the movie's content warnings
+func (m *Movie) ContentWarnings() []string {
+	warnings := make([]string, len(m.contentWarnings))
+	copy(warnings, m.contentWarnings)
+	return warnings
+}
+
+// AddContentWarning adds a content warning (e.g. "violence") to the movie
+func (m *Movie) AddContentWarning(warning string) error {
+	normalized, err := NormalizeContentWarning(warning)
+	if err != nil {
+		return err
+	}
+
+	if m.HasContentWarning(normalized) {
+		return shared.NewValidationError("content warning already exists")
+	}
+
+	m.contentWarnings = append(m.contentWarnings, normalized)
+	m.touch()
+	return nil
+}
+
+// HasContentWarning checks if the movie carries a content warning, ignoring case
+func (m *Movie) HasContentWarning(warning string) bool {
+	normalized, err := NormalizeContentWarning(warning)
+	if err != nil {
+		return false
+	}
+	for _, w := range m.contentWarnings {
+		if w == normalized {
+			return true
+		}
+	}
+	return false
+}
+
 // SetPosterURL sets the movie's poster URL with validation
 func (m *Movie) SetPosterURL(posterURL string) error {
 	oldPosterURL := m.posterURL
@@ -187,12 +332,12 @@ func (m *Movie) SetPosterURL(posterURL string) error {
 	// Validate URL format
 	parsedURL, err := url.Parse(posterURL)
 	if err != nil {
-		return errors.New("invalid URL format")
+		return shared.NewValidationError("invalid URL format")
 	}
 
 	// Only allow HTTP and HTTPS schemes
 	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
-		return errors.New("poster URL must use HTTP or HTTPS scheme")
+		return shared.NewValidationError("poster URL must use HTTP or HTTPS scheme")
 	}
 
 	// Emit domain event if poster URL actually changed
@@ -209,17 +354,46 @@ func (m *Movie) SetPosterURL(posterURL string) error {
 // Validate performs comprehensive validation of the movie
 func (m *Movie) Validate() error {
 	if strings.TrimSpace(m.title) == "" {
-		return errors.New("title cannot be empty")
+		return shared.NewValidationError("title cannot be empty")
 	}
 	if strings.TrimSpace(m.director) == "" {
-		return errors.New("director cannot be empty")
+		return shared.NewValidationError("director cannot be empty")
 	}
 	if m.year.IsZero() {
-		return errors.New("year must be set")
+		return shared.NewValidationError("year must be set")
+	}
+	if m.HasReleaseDate() && m.released.Year() != m.year.Value() {
+		return shared.NewValidationError("release date %s is not in year %d", m.released.Format(time.DateOnly), m.year.Value())
 	}
 	// Rating is optional, but if set, must be valid (already validated in SetRating)
+	// Duration is optional (validated in SetDuration)
 	// Genres are optional
 	// PosterURL is optional, but if set, must be valid (already validated in SetPosterURL)
+	// Certifications and content warnings are optional (validated when set)
+	return nil
+}
+
+// ValidateWith validates the movie and, under a strict policy, also rejects
+// one released in the future or rated exactly 0. An unrated movie has a
+// rating of 0, so strict validation requires a rating.
+func (m *Movie) ValidateWith(policy shared.ValidationPolicy) error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+	if !policy.IsStrict() {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	if m.year.Value() > now.Year() {
+		return shared.NewValidationError("year %d is in the future (strict validation)", m.year.Value())
+	}
+	if today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC); m.released.After(today) {
+		return shared.NewValidationError("release date %s is in the future (strict validation)", m.released.Format(time.DateOnly))
+	}
+	if m.rating.IsZero() {
+		return shared.NewValidationError("rating must be above 0 (strict validation)")
+	}
 	return nil
 }
 
@@ -233,3 +407,27 @@ func (m *Movie) SetID(id shared.MovieID) {
 	m.id = id
 	m.touch()
 }
+
+// RecordCreated records that the movie was stored for the first time. The
+// events raised while it was built are replaced by a single MovieCreated,
+// so it must be called once the repository has assigned the movie's ID.
+func (m *Movie) RecordCreated() {
+	m.MarkEventsAsCommitted()
+	m.AddEvent(NewMovieCreatedEvent(m, m.Version()+1))
+}
+
+// RecordUpdateOf records that the movie replaced previous, a stored movie
+// with the same ID. The events raised while it was built are replaced by a
+// MovieRatingChanged, when the rating differs, followed by MovieUpdated.
+func (m *Movie) RecordUpdateOf(previous *Movie) {
+	m.MarkEventsAsCommitted()
+	if previous.rating.Value() != m.rating.Value() {
+		m.AddEvent(NewMovieRatingChangedEvent(m.id, previous.rating, m.rating, m.Version()+1))
+	}
+	m.AddEvent(NewMovieUpdatedEvent(m, m.Version()+1))
+}
+
+// RecordDeleted records that the movie was deleted
+func (m *Movie) RecordDeleted() {
+	m.AddEvent(NewMovieDeletedEvent(m.id, m.title, m.Version()+1))
+}
@@ -1,6 +1,7 @@
 package movie
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -308,6 +309,50 @@ func TestMovie_Validation(t *testing.T) {
 	}
 }
 
+func TestMovie_ValidateWith(t *testing.T) {
+	nextYear := time.Now().Year() + 1
+	tomorrow := time.Now().UTC().AddDate(0, 0, 1)
+
+	tests := []struct {
+		name       string
+		year       int
+		released   time.Time
+		rating     float64
+		wantStrict bool // Whether strict validation passes
+	}{
+		{name: "rated past movie", year: 1999, rating: 8.7, wantStrict: true},
+		{name: "unrated movie", year: 1999},
+		{name: "year in the future", year: nextYear, rating: 7},
+		{name: "release date in the future", year: tomorrow.Year(), released: tomorrow, rating: 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			movie, err := NewMovie("Test Movie", "Test Director", tt.year)
+			if err != nil {
+				t.Fatalf("Failed to create test movie: %v", err)
+			}
+			if err := movie.SetReleaseDate(tt.released); err != nil {
+				t.Fatalf("SetReleaseDate() error = %v", err)
+			}
+			if err := movie.SetRating(tt.rating); err != nil {
+				t.Fatalf("SetRating() error = %v", err)
+			}
+
+			if err := movie.ValidateWith(shared.ValidationLenient); err != nil {
+				t.Errorf("Expected lenient validation to pass, got: %v", err)
+			}
+			err = movie.ValidateWith(shared.ValidationStrict)
+			if (err == nil) != tt.wantStrict {
+				t.Errorf("ValidateWith(strict) error = %v, want pass %v", err, tt.wantStrict)
+			}
+			if err != nil && !errors.Is(err, shared.ErrValidation) {
+				t.Errorf("Expected a validation error, got: %v", err)
+			}
+		})
+	}
+}
+
 func TestNewMovieWithID(t *testing.T) {
 	id, err := shared.NewMovieID(123)
 	if err != nil {
@@ -345,3 +390,63 @@ func TestMovie_HasGenre(t *testing.T) {
 		t.Error("Expected movie to not have Comedy genre")
 	}
 }
+
+func TestMovie_SetReleaseDate(t *testing.T) {
+	movie, _ := NewMovie("The Matrix", "Wachowskis", 1998)
+
+	released := time.Date(1999, time.March, 31, 18, 30, 0, 0, time.FixedZone("PST", -8*60*60))
+	if err := movie.SetReleaseDate(released); err != nil {
+		t.Fatalf("SetReleaseDate() error = %v", err)
+	}
+	if !movie.HasReleaseDate() || !movie.ReleaseDate().Equal(time.Date(1999, time.March, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected the release date at midnight UTC, got: %v", movie.ReleaseDate())
+	}
+	if movie.Year().Value() != 1999 {
+		t.Errorf("Expected the year to follow the release date, got: %d", movie.Year().Value())
+	}
+	if err := movie.Validate(); err != nil {
+		t.Errorf("Expected a valid movie, got: %v", err)
+	}
+
+	if err := movie.SetReleaseDate(time.Time{}); err != nil {
+		t.Fatalf("SetReleaseDate(zero) error = %v", err)
+	}
+	if movie.HasReleaseDate() || movie.Year().Value() != 1999 {
+		t.Errorf("Expected a zero date to clear the date and keep the year, got: %v %d", movie.ReleaseDate(), movie.Year().Value())
+	}
+
+	if err := movie.SetReleaseDate(time.Date(1800, time.January, 1, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("Expected an error for a release date before the first movies")
+	}
+}
+
+func TestMovie_SetDuration(t *testing.T) {
+	tests := []struct {
+		name      string
+		minutes   int
+		wantShort bool
+		wantErr   bool
+	}{
+		{name: "feature", minutes: 136},
+		{name: "short film", minutes: ShortFilmMaxDuration, wantShort: true},
+		{name: "unknown", minutes: 0},
+		{name: "negative", minutes: -1, wantErr: true},
+		{name: "too long", minutes: MaxDuration + 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			movie, _ := NewMovie("Test Movie", "Test Director", 2020)
+			err := movie.SetDuration(tt.minutes)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SetDuration() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if movie.Duration() != tt.minutes || movie.IsShortFilm() != tt.wantShort {
+				t.Errorf("Expected duration %d (short %v), got: %d (short %v)", tt.minutes, tt.wantShort, movie.Duration(), movie.IsShortFilm())
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package movie
+
+import (
+	"context"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// Poster is a movie's stored poster image
+type Poster struct {
+	MovieID  shared.MovieID
+	Title    string
+	Year     int
+	MimeType string
+	Data     []byte
+}
+
+// PosterStore defines the interface for storing movie poster images. A
+// poster image is kept alongside the movie, apart from its poster URL.
+type PosterStore interface {
+	// SavePoster stores a movie's poster image, replacing any it had; it
+	// fails with shared.ErrNotFound if the movie does not exist
+	SavePoster(ctx context.Context, movieID shared.MovieID, data []byte, mimeType string) error
+
+	// FindPoster returns a movie's poster image; it fails with
+	// shared.ErrNotFound if the movie does not exist or has no poster image
+	FindPoster(ctx context.Context, movieID shared.MovieID) (*Poster, error)
+
+	// DeletePoster removes a movie's poster image, reporting whether it had one
+	DeletePoster(ctx context.Context, movieID shared.MovieID) (bool, error)
+}
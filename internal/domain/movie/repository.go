@@ -2,6 +2,7 @@ package movie
 
 import (
 	"context"
+	"time"
 
 	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
 )
@@ -41,6 +42,14 @@ type Writer interface {
 	// Save persists a movie (insert or update)
 	Save(ctx context.Context, movie *Movie) error
 
+	// InsertAll persists new movies in one go, setting their IDs; either all
+	// are inserted or none are
+	InsertAll(ctx context.Context, movies []*Movie) error
+
+	// UpdateAll persists changes to existing movies in one go; either all
+	// are updated or none are
+	UpdateAll(ctx context.Context, movies []*Movie) error
+
 	// Delete removes a movie by ID
 	Delete(ctx context.Context, id shared.MovieID) error
 
@@ -50,6 +59,8 @@ type Writer interface {
 
 // SearchCriteria represents search parameters for movies
 type SearchCriteria struct {
+	IDs       []shared.MovieID // Restrict results to these movies
+	AfterID   int              // Only movies with a greater ID, for paging by ID
 	Title     string
 	Director  string
 	Genre     string
@@ -61,6 +72,43 @@ type SearchCriteria struct {
 	Offset    int
 	OrderBy   OrderBy
 	OrderDir  OrderDirection
+	Sort      []SortKey // Ordered sort keys; when set, replaces OrderBy/OrderDir
+
+	// Only movies rated one of Certifications in CertificationRegion; unrated
+	// movies are excluded
+	CertificationRegion string
+	Certifications      []string
+	ExcludeWarnings     []string // Drop movies carrying any of these content warnings
+
+	// Only movies whose runtime in minutes is at least MinDuration and at
+	// most MaxDuration; either bound excludes movies with no known runtime
+	MinDuration int
+	MaxDuration int
+
+	// Only movies released on or after ReleasedAfter and on or before
+	// ReleasedBefore. Movies with only a release year match every date of
+	// that year; zero dates are ignored.
+	ReleasedAfter  time.Time
+	ReleasedBefore time.Time
+}
+
+// YearRange returns the release years the criteria match, combining MinYear
+// and MaxYear with the release dates; zero means unbounded
+func (c SearchCriteria) YearRange() (minYear, maxYear int) {
+	minYear, maxYear = c.MinYear, c.MaxYear
+	if !c.ReleasedAfter.IsZero() && c.ReleasedAfter.Year() > minYear {
+		minYear = c.ReleasedAfter.Year()
+	}
+	if !c.ReleasedBefore.IsZero() && (maxYear == 0 || c.ReleasedBefore.Year() < maxYear) {
+		maxYear = c.ReleasedBefore.Year()
+	}
+	return minYear, maxYear
+}
+
+// SortKey is one term of a multi-key sort
+type SortKey struct {
+	Field OrderBy
+	Dir   OrderDirection
 }
 
 // OrderBy represents fields that can be used for ordering
@@ -73,6 +121,7 @@ const (
 	OrderByRating    OrderBy = "rating"
 	OrderByCreatedAt OrderBy = "created_at"
 	OrderByUpdatedAt OrderBy = "updated_at"
+	OrderByID        OrderBy = "id"
 )
 
 // OrderDirection represents sort direction
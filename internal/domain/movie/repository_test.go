@@ -0,0 +1,32 @@
+package movie
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSearchCriteria_YearRange(t *testing.T) {
+	date := func(year int) time.Time { return time.Date(year, time.July, 1, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name     string
+		criteria SearchCriteria
+		wantMin  int
+		wantMax  int
+	}{
+		{name: "unbounded", criteria: SearchCriteria{}},
+		{name: "years only", criteria: SearchCriteria{MinYear: 1990, MaxYear: 1999}, wantMin: 1990, wantMax: 1999},
+		{name: "dates only", criteria: SearchCriteria{ReleasedAfter: date(2001), ReleasedBefore: date(2004)}, wantMin: 2001, wantMax: 2004},
+		{name: "dates narrow years", criteria: SearchCriteria{MinYear: 1990, MaxYear: 2010, ReleasedAfter: date(1995), ReleasedBefore: date(2000)}, wantMin: 1995, wantMax: 2000},
+		{name: "years narrow dates", criteria: SearchCriteria{MinYear: 1997, MaxYear: 1998, ReleasedAfter: date(1995), ReleasedBefore: date(2000)}, wantMin: 1997, wantMax: 1998},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minYear, maxYear := tt.criteria.YearRange()
+			if minYear != tt.wantMin || maxYear != tt.wantMax {
+				t.Errorf("Expected %d-%d, got: %d-%d", tt.wantMin, tt.wantMax, minYear, maxYear)
+			}
+		})
+	}
+}
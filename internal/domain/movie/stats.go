@@ -0,0 +1,43 @@
+package movie
+
+import (
+	"context"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// LibraryStats are aggregates over every movie in a library
+type LibraryStats struct {
+	TotalMovies    int
+	RatedMovies    int     // Movies rated above zero
+	AverageRating  float64 // Over the rated movies
+	EarliestYear   int     // Zero for an empty library
+	LatestYear     int
+	Genres         map[string]int            // Movies per genre
+	Certifications map[string]map[string]int // Movies per age rating per region
+}
+
+// DirectorStats aggregates one director's movies
+type DirectorStats struct {
+	Director      string
+	Movies        int
+	AverageRating float64 // Over the rated movies; zero when none are rated
+}
+
+// StatsReader reads library aggregates that are kept up to date as movies
+// are written, so reads do not grow with the library
+type StatsReader interface {
+	// LibraryStats returns the aggregates over every movie
+	LibraryStats(ctx context.Context) (*LibraryStats, error)
+
+	// TopDirectors returns up to limit directors with the most movies, best
+	// rated first among equals; a non-positive limit returns every director
+	TopDirectors(ctx context.Context, limit int) ([]DirectorStats, error)
+}
+
+// PopularityReader counts how much of a library refers to its movies
+type PopularityReader interface {
+	// MovieReferences returns how many cast credits, tags and franchise
+	// entries name each of ids; movies nothing refers to are left out
+	MovieReferences(ctx context.Context, ids []shared.MovieID) (map[shared.MovieID]int, error)
+}
@@ -0,0 +1,38 @@
+package popularity
+
+import (
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// Access is a way a tool call can reach a movie
+type Access string
+
+const (
+	AccessView   Access = "view"   // The call returned the movie on its own, as get_movie does
+	AccessSearch Access = "search" // The movie was among a search's results
+)
+
+// SearchHitWeight is what a search hit counts for against a view: being
+// listed among many results says less about interest than being opened
+const SearchHitWeight = 0.25
+
+// Counts is how often a movie was accessed over a period
+type Counts struct {
+	MovieID    shared.MovieID
+	Views      int
+	SearchHits int
+}
+
+// Score combines a movie's views and search hits into one number, with
+// search hits discounted by SearchHitWeight
+func (c Counts) Score() float64 {
+	return float64(c.Views) + SearchHitWeight*float64(c.SearchHits)
+}
+
+// Day returns the UTC calendar day of t, which access counts roll up by
+func Day(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,23 @@
+package popularity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCounts_Score(t *testing.T) {
+	counts := Counts{Views: 3, SearchHits: 6}
+	if got := counts.Score(); got != 4.5 {
+		t.Errorf("Score() = %g, want 4.5", got)
+	}
+}
+
+func TestDay(t *testing.T) {
+	// 23:30 in New York is already the next day in UTC
+	newYork := time.FixedZone("EST", -5*60*60)
+	got := Day(time.Date(2026, 3, 14, 23, 30, 0, 0, newYork))
+	want := time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) || got.Location() != time.UTC {
+		t.Errorf("Day() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,24 @@
+package popularity
+
+import (
+	"context"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// Repository stores how often movies are accessed, rolled up into one
+// counter per movie and day
+type Repository interface {
+	// Record adds one access of kind to the counter of each movie on day;
+	// a movie listed twice is counted twice
+	Record(ctx context.Context, day time.Time, kind Access, ids []shared.MovieID) error
+
+	// Counts sums the counters of the given movies from since onwards.
+	// Movies that were not accessed are left out.
+	Counts(ctx context.Context, since time.Time, ids []shared.MovieID) (map[shared.MovieID]Counts, error)
+
+	// Top returns up to limit movies with the highest Score from since
+	// onwards, highest first
+	Top(ctx context.Context, since time.Time, limit int) ([]Counts, error)
+}
@@ -0,0 +1,80 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Error kinds shared by every layer. Errors of a kind are created with the
+// New*Error constructors, or by wrapping a kind with %w, and are classified
+// with errors.Is rather than by their text.
+var (
+	// ErrNotFound means the requested entity does not exist
+	ErrNotFound = errors.New("not found")
+	// ErrValidation means the input broke a rule and must be changed
+	ErrValidation = errors.New("invalid input")
+	// ErrConflict means the input clashes with the current state, such as a
+	// duplicate or a stale confirmation
+	ErrConflict = errors.New("conflict")
+	// ErrUnavailable means a dependency is temporarily down; retrying may succeed
+	ErrUnavailable = errors.New("unavailable")
+)
+
+// kindError is an error of a kind whose message is its own; the kind only
+// classifies it. The format and arguments are kept so the message can be
+// written again in another language.
+type kindError struct {
+	kind   error
+	err    error
+	format string
+	args   []any
+}
+
+func (e *kindError) Error() string {
+	return e.err.Error()
+}
+
+func (e *kindError) Unwrap() []error {
+	return []error{e.kind, e.err}
+}
+
+// newKindError creates an error of kind with the given message
+func newKindError(kind error, format string, args []any) error {
+	return &kindError{kind: kind, err: fmt.Errorf(format, args...), format: format, args: args}
+}
+
+// NewNotFoundError creates an ErrNotFound error with the given message
+func NewNotFoundError(format string, args ...any) error {
+	return newKindError(ErrNotFound, format, args)
+}
+
+// NewValidationError creates an ErrValidation error with the given message
+func NewValidationError(format string, args ...any) error {
+	return newKindError(ErrValidation, format, args)
+}
+
+// NewConflictError creates an ErrConflict error with the given message
+func NewConflictError(format string, args ...any) error {
+	return newKindError(ErrConflict, format, args)
+}
+
+// NewUnavailableError creates an ErrUnavailable error with the given message
+func NewUnavailableError(format string, args ...any) error {
+	return newKindError(ErrUnavailable, format, args)
+}
+
+// TranslateError returns err with its message formatted from translate's
+// version of its format string, keeping its kind. Only errors made by the
+// New*Error constructors are translated; others, including those wrapping
+// one with more text, are returned unchanged.
+func TranslateError(err error, translate func(format string) string) error {
+	e, ok := err.(*kindError)
+	if !ok {
+		return err
+	}
+	translated := translate(e.format)
+	if translated == e.format {
+		return err
+	}
+	return newKindError(e.kind, translated, e.args)
+}
@@ -0,0 +1,70 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestKindErrors(t *testing.T) {
+	cause := errors.New("database is locked")
+	tests := []struct {
+		name     string
+		err      error
+		kind     error
+		wantText string
+	}{
+		{name: "not found", err: NewNotFoundError("movie %d not found", 7), kind: ErrNotFound, wantText: "movie 7 not found"},
+		{name: "validation", err: NewValidationError("title cannot be empty"), kind: ErrValidation, wantText: "title cannot be empty"},
+		{name: "conflict", err: NewConflictError("genre already exists"), kind: ErrConflict, wantText: "genre already exists"},
+		{name: "unavailable with cause", err: NewUnavailableError("database is busy: %w", cause), kind: ErrUnavailable, wantText: "database is busy: database is locked"},
+		{name: "wrapped", err: fmt.Errorf("failed to get movie: %w", NewNotFoundError("movie not found")), kind: ErrNotFound, wantText: "failed to get movie: movie not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !errors.Is(tt.err, tt.kind) {
+				t.Errorf("Expected %v to be %v", tt.err, tt.kind)
+			}
+			if tt.err.Error() != tt.wantText {
+				t.Errorf("Expected message %q, got: %q", tt.wantText, tt.err.Error())
+			}
+			for _, other := range []error{ErrNotFound, ErrValidation, ErrConflict, ErrUnavailable} {
+				if other != tt.kind && errors.Is(tt.err, other) {
+					t.Errorf("Expected %v not to be %v", tt.err, other)
+				}
+			}
+		})
+	}
+
+	if wrapped := NewUnavailableError("database is busy: %w", cause); !errors.Is(wrapped, cause) {
+		t.Error("Expected the cause to stay reachable")
+	}
+}
+
+func TestTranslateError(t *testing.T) {
+	french := map[string]string{"movie %d not found": "film %d introuvable"}
+	translate := func(format string) string {
+		if translation, ok := french[format]; ok {
+			return translation
+		}
+		return format
+	}
+
+	translated := TranslateError(NewNotFoundError("movie %d not found", 7), translate)
+	if translated.Error() != "film 7 introuvable" {
+		t.Errorf("Expected a translated message, got: %q", translated.Error())
+	}
+	if !errors.Is(translated, ErrNotFound) {
+		t.Errorf("Expected the translation to keep its kind, got: %v", translated)
+	}
+
+	untranslated := NewValidationError("title cannot be empty")
+	if got := TranslateError(untranslated, translate); got != untranslated {
+		t.Errorf("Expected an error without a translation back unchanged, got: %v", got)
+	}
+	wrapped := fmt.Errorf("failed to get movie: %w", NewNotFoundError("movie %d not found", 7))
+	if got := TranslateError(wrapped, translate); got != wrapped {
+		t.Errorf("Expected a wrapped error back unchanged, got: %v", got)
+	}
+}
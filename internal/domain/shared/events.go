@@ -2,6 +2,7 @@
 package shared
 
 import (
+	"context"
 	"time"
 
 	"github.com/google/uuid"
@@ -28,6 +29,12 @@ type DomainEvent interface {
 	Version() int
 }
 
+// EventPublisher publishes the events an aggregate recorded once the change
+// that raised them has been persisted
+type EventPublisher interface {
+	Publish(ctx context.Context, events []DomainEvent)
+}
+
 // BaseDomainEvent provides common functionality for all domain events.
 type BaseDomainEvent struct {
 	eventID       string
@@ -0,0 +1,34 @@
+package shared
+
+import "strings"
+
+// ValidationPolicy decides whether well-formed but suspicious data is
+// accepted. The hard limits every value object enforces apply either way.
+type ValidationPolicy string
+
+const (
+	// ValidationLenient accepts anything within the hard limits
+	ValidationLenient ValidationPolicy = "lenient"
+
+	// ValidationStrict also rejects data that is more likely a mistake than a
+	// fact, such as a movie released in the future or rated exactly 0
+	ValidationStrict ValidationPolicy = "strict"
+)
+
+// ParseValidationPolicy parses strict or lenient, case-insensitively. An
+// empty string is lenient.
+func ParseValidationPolicy(s string) (ValidationPolicy, error) {
+	switch policy := ValidationPolicy(strings.ToLower(strings.TrimSpace(s))); policy {
+	case "", ValidationLenient:
+		return ValidationLenient, nil
+	case ValidationStrict:
+		return ValidationStrict, nil
+	default:
+		return "", NewValidationError("validation policy %q is not one of strict or lenient", s)
+	}
+}
+
+// IsStrict reports whether suspicious data should be rejected
+func (p ValidationPolicy) IsStrict() bool {
+	return p == ValidationStrict
+}
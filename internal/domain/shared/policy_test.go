@@ -0,0 +1,38 @@
+package shared
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseValidationPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    ValidationPolicy
+		wantErr bool
+	}{
+		{name: "empty is lenient", value: "", want: ValidationLenient},
+		{name: "lenient", value: "lenient", want: ValidationLenient},
+		{name: "strict in any case", value: " Strict ", want: ValidationStrict},
+		{name: "unknown policy", value: "paranoid", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseValidationPolicy(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseValidationPolicy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !errors.Is(err, ErrValidation) {
+					t.Errorf("Expected a validation error, got: %v", err)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseValidationPolicy() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
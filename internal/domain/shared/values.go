@@ -1,7 +1,6 @@
 package shared
 
 import (
-	"errors"
 	"time"
 )
 
@@ -13,7 +12,7 @@ type MovieID struct {
 // NewMovieID creates a new MovieID with validation
 func NewMovieID(id int) (MovieID, error) {
 	if id < 0 {
-		return MovieID{}, errors.New("movie ID must be non-negative")
+		return MovieID{}, NewValidationError("movie ID must be non-negative")
 	}
 	return MovieID{value: id}, nil
 }
@@ -41,7 +40,7 @@ type ActorID struct {
 // NewActorID creates a new ActorID with validation
 func NewActorID(id int) (ActorID, error) {
 	if id < 0 {
-		return ActorID{}, errors.New("actor ID must be non-negative")
+		return ActorID{}, NewValidationError("actor ID must be non-negative")
 	}
 	return ActorID{value: id}, nil
 }
@@ -56,6 +55,52 @@ func (id ActorID) IsZero() bool {
 	return id.value == 0
 }
 
+// FranchiseID represents a unique identifier for a franchise
+type FranchiseID struct {
+	value int
+}
+
+// NewFranchiseID creates a new FranchiseID with validation
+func NewFranchiseID(id int) (FranchiseID, error) {
+	if id < 0 {
+		return FranchiseID{}, NewValidationError("franchise ID must be non-negative")
+	}
+	return FranchiseID{value: id}, nil
+}
+
+// Value returns the underlying integer value
+func (id FranchiseID) Value() int {
+	return id.value
+}
+
+// IsZero returns true if this is a zero value
+func (id FranchiseID) IsZero() bool {
+	return id.value == 0
+}
+
+// TagID represents a unique identifier for a tag
+type TagID struct {
+	value int
+}
+
+// NewTagID creates a new TagID with validation
+func NewTagID(id int) (TagID, error) {
+	if id < 0 {
+		return TagID{}, NewValidationError("tag ID must be non-negative")
+	}
+	return TagID{value: id}, nil
+}
+
+// Value returns the underlying integer value
+func (id TagID) Value() int {
+	return id.value
+}
+
+// IsZero returns true if this is a zero value
+func (id TagID) IsZero() bool {
+	return id.value == 0
+}
+
 // Rating represents a movie rating between 0 and 10
 type Rating struct {
 	value float64
@@ -64,7 +109,7 @@ type Rating struct {
 // NewRating creates a new Rating with validation
 func NewRating(rating float64) (Rating, error) {
 	if rating < 0 || rating > 10 {
-		return Rating{}, errors.New("rating must be between 0 and 10")
+		return Rating{}, NewValidationError("rating must be between 0 and 10")
 	}
 	return Rating{value: rating}, nil
 }
@@ -89,7 +134,7 @@ func NewYear(year int) (Year, error) {
 	currentYear := time.Now().Year()
 	// Allow movies from 1888 (first motion picture) to 15 years in the future
 	if year < 1888 || year > currentYear+15 {
-		return Year{}, errors.New("invalid year")
+		return Year{}, NewValidationError("invalid year")
 	}
 	return Year{value: year}, nil
 }
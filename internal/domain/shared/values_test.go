@@ -95,6 +95,80 @@ func TestNewActorID(t *testing.T) {
 	}
 }
 
+func TestNewFranchiseID(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   int
+		wantErr bool
+	}{
+		{
+			name:    "valid positive ID",
+			value:   3,
+			wantErr: false,
+		},
+		{
+			name:    "valid zero ID",
+			value:   0,
+			wantErr: false,
+		},
+		{
+			name:    "invalid negative ID",
+			value:   -1,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := NewFranchiseID(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewFranchiseID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && id.Value() != tt.value {
+				t.Errorf("NewFranchiseID() value = %v, want %v", id.Value(), tt.value)
+			}
+		})
+	}
+}
+
+func TestNewTagID(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   int
+		wantErr bool
+	}{
+		{
+			name:    "valid positive ID",
+			value:   7,
+			wantErr: false,
+		},
+		{
+			name:    "valid zero ID",
+			value:   0,
+			wantErr: false,
+		},
+		{
+			name:    "invalid negative ID",
+			value:   -1,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := NewTagID(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewTagID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && id.Value() != tt.value {
+				t.Errorf("NewTagID() value = %v, want %v", id.Value(), tt.value)
+			}
+		})
+	}
+}
+
 func TestNewRating(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -0,0 +1,27 @@
+package similarity
+
+import (
+	"context"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// Repository stores each movie's most similar movies, precomputed so reads
+// do not score the whole library
+type Repository interface {
+	// FindNeighbors retrieves up to limit movies similar to a movie, most
+	// similar first
+	FindNeighbors(ctx context.Context, movieID shared.MovieID, limit int) ([]Neighbor, error)
+
+	// Refresh replaces what is stored about a movie after it changed: its own
+	// neighbors become the first MaxNeighbors of neighbors, and it is offered
+	// to each of their lists, which keep their MaxNeighbors best. Lists it no
+	// longer belongs to drop it. No neighbors removes the movie entirely.
+	Refresh(ctx context.Context, movieID shared.MovieID, neighbors []Neighbor) error
+
+	// ReplaceAll discards every stored list and stores the given ones
+	ReplaceAll(ctx context.Context, lists map[shared.MovieID][]Neighbor) error
+
+	// Count returns the number of stored similar pairs
+	Count(ctx context.Context) (int, error)
+}
@@ -0,0 +1,107 @@
+package similarity
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+const (
+	// MaxNeighbors is how many similar movies are kept for each movie
+	MaxNeighbors = 50
+
+	// MinScore is the lowest score worth keeping. Sharing only a release
+	// period and a rating adds up to 0.3 at most, so a similar movie must
+	// also share a genre or the director.
+	MinScore = 0.35
+)
+
+// Score weights; they add up to 1
+const (
+	genreWeight    = 0.4
+	directorWeight = 0.3
+	yearWeight     = 0.2
+	ratingWeight   = 0.1
+
+	// yearSpan is the release gap, in years, at which year proximity stops counting
+	yearSpan = 20.0
+)
+
+// Neighbor is a movie similar to another, with their similarity score
+type Neighbor struct {
+	MovieID shared.MovieID
+	Score   float64 // Between MinScore and 1
+}
+
+// Score rates how similar two movies are, from 0 to 1: the overlap of their
+// genres, whether they share a director, how close their release years are
+// and, when both are rated, how close their ratings are
+func Score(a, b *movie.Movie) float64 {
+	score := genreWeight * genreOverlap(a.Genres(), b.Genres())
+
+	if a.Director() != "" && strings.EqualFold(a.Director(), b.Director()) {
+		score += directorWeight
+	}
+
+	gap := math.Abs(float64(a.Year().Value() - b.Year().Value()))
+	score += yearWeight * math.Max(0, 1-gap/yearSpan)
+
+	ratingA, ratingB := a.Rating().Value(), b.Rating().Value()
+	if ratingA > 0 && ratingB > 0 {
+		score += ratingWeight * (1 - math.Abs(ratingA-ratingB)/10)
+	}
+
+	return math.Round(score*1000) / 1000
+}
+
+// Rank scores every other movie against target and returns those scoring at
+// least MinScore, most similar first and then by ID
+func Rank(target *movie.Movie, movies []*movie.Movie) []Neighbor {
+	neighbors := make([]Neighbor, 0)
+	for _, candidate := range movies {
+		if candidate.ID() == target.ID() {
+			continue
+		}
+		if score := Score(target, candidate); score >= MinScore {
+			neighbors = append(neighbors, Neighbor{MovieID: candidate.ID(), Score: score})
+		}
+	}
+
+	sort.Slice(neighbors, func(i, j int) bool {
+		if neighbors[i].Score != neighbors[j].Score {
+			return neighbors[i].Score > neighbors[j].Score
+		}
+		return neighbors[i].MovieID.Value() < neighbors[j].MovieID.Value()
+	})
+	return neighbors
+}
+
+// genreOverlap returns the Jaccard index of two genre lists, ignoring case
+func genreOverlap(a, b []string) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	union := make(map[string]bool, len(a)+len(b))
+	for _, genre := range a {
+		union[strings.ToLower(genre)] = true
+	}
+	common := 0
+	seen := make(map[string]bool, len(b))
+	for _, genre := range b {
+		genre = strings.ToLower(genre)
+		if seen[genre] {
+			continue
+		}
+		seen[genre] = true
+		if union[genre] {
+			common++
+		} else {
+			union[genre] = true
+		}
+	}
+	return float64(common) / float64(len(union))
+}
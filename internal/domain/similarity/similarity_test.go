@@ -0,0 +1,96 @@
+package similarity
+
+import (
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+func newTestMovie(t *testing.T, id int, director string, year int, rating float64, genres ...string) *movie.Movie {
+	t.Helper()
+
+	movieID, _ := shared.NewMovieID(id)
+	m, err := movie.NewMovieWithID(movieID, "Movie", director, year)
+	if err != nil {
+		t.Fatalf("failed to create movie: %v", err)
+	}
+	if err := m.SetRating(rating); err != nil {
+		t.Fatalf("failed to set rating: %v", err)
+	}
+	for _, genre := range genres {
+		if err := m.AddGenre(genre); err != nil {
+			t.Fatalf("failed to add genre: %v", err)
+		}
+	}
+	return m
+}
+
+func TestScore(t *testing.T) {
+	heat := newTestMovie(t, 1, "Michael Mann", 1995, 8.3, "Crime", "Drama")
+
+	tests := []struct {
+		name  string
+		other *movie.Movie
+		want  float64
+	}{
+		{
+			name:  "same director, genres, year and rating",
+			other: newTestMovie(t, 2, "michael mann", 1995, 8.3, "Drama", "Crime"),
+			want:  1,
+		},
+		{
+			name:  "one of three genres, ten years apart",
+			other: newTestMovie(t, 3, "Ridley Scott", 2005, 7.3, "Crime", "Thriller"),
+			want:  0.4/3 + 0.1 + 0.09,
+		},
+		{
+			name:  "unrated movies skip rating closeness",
+			other: newTestMovie(t, 4, "Michael Mann", 1975, 0),
+			want:  0.3,
+		},
+		{
+			name:  "far apart with nothing in common",
+			other: newTestMovie(t, 5, "Greta Gerwig", 2023, 0, "Comedy"),
+			want:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Score(heat, tt.other)
+			if diff := got - tt.want; diff > 0.001 || diff < -0.001 {
+				t.Errorf("Score() = %v, want %v", got, tt.want)
+			}
+			if reverse := Score(tt.other, heat); reverse != got {
+				t.Errorf("Expected a symmetric score, got %v and %v", got, reverse)
+			}
+		})
+	}
+}
+
+func TestRank(t *testing.T) {
+	heat := newTestMovie(t, 1, "Michael Mann", 1995, 8.3, "Crime", "Drama")
+	movies := []*movie.Movie{
+		heat,
+		newTestMovie(t, 2, "Ridley Scott", 2005, 7.3, "Crime", "Thriller"),
+		newTestMovie(t, 3, "Michael Mann", 2004, 7.5, "Crime", "Thriller"),
+		newTestMovie(t, 4, "Martin Scorsese", 1995, 8.2, "Crime", "Drama"),
+		newTestMovie(t, 5, "Martin Scorsese", 1995, 8.2, "Drama", "Crime"),
+		newTestMovie(t, 6, "Greta Gerwig", 2023, 7.0, "Comedy"),
+	}
+
+	neighbors := Rank(heat, movies)
+	want := []int{4, 5, 3}
+	if len(neighbors) != len(want) {
+		t.Fatalf("Expected movies %v, got: %v", want, neighbors)
+	}
+	for i, neighbor := range neighbors {
+		if neighbor.MovieID.Value() != want[i] {
+			t.Errorf("Expected movies %v, got: %v", want, neighbors)
+		}
+		if neighbor.Score < MinScore {
+			t.Errorf("Expected scores of at least %v, got: %v", MinScore, neighbor.Score)
+		}
+	}
+}
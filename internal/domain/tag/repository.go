@@ -0,0 +1,45 @@
+package tag
+
+import (
+	"context"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// Count is how many movies carry a tag
+type Count struct {
+	Name   string
+	Movies int
+}
+
+// CountReader reports how often tags are used, for autocomplete and tag clouds
+type CountReader interface {
+	// Counts retrieves the tags whose names start with prefix, most used
+	// first and then by name; an empty prefix matches every tag and a limit
+	// of 0 returns them all. Tags no movie carries are included.
+	Counts(ctx context.Context, prefix string, limit int) ([]Count, error)
+}
+
+// Repository defines the interface for tag data access
+type Repository interface {
+	CountReader
+
+	// FindByName retrieves a tag by its normalized name
+	FindByName(ctx context.Context, name string) (*Tag, error)
+
+	// FindByMovieID retrieves the tags a movie carries ordered by name
+	FindByMovieID(ctx context.Context, movieID shared.MovieID) ([]*Tag, error)
+
+	// FindMovieIDs retrieves the movies carrying every one of the tags when
+	// matchAll is set, or any of them otherwise
+	FindMovieIDs(ctx context.Context, tagIDs []shared.TagID, matchAll bool) ([]shared.MovieID, error)
+
+	// Save persists a tag (insert or update)
+	Save(ctx context.Context, tag *Tag) error
+
+	// TagMovie attaches tags to a movie; tags it already carries are kept
+	TagMovie(ctx context.Context, movieID shared.MovieID, tagIDs []shared.TagID) error
+
+	// UntagMovie detaches a tag from a movie, reporting whether it carried it
+	UntagMovie(ctx context.Context, movieID shared.MovieID, tagID shared.TagID) (bool, error)
+}
@@ -0,0 +1,115 @@
+package tag
+
+import (
+	"strings"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// MaxNameLength is the longest tag name allowed
+const MaxNameLength = 50
+
+// Tag is a freeform label such as "time-travel" or "based-on-book". Unlike
+// genres, which come from a curated taxonomy, anyone can create a tag; names
+// are normalized so "Time Travel" and "time_travel" are the same tag.
+type Tag struct {
+	id          shared.TagID
+	name        string
+	description string
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewTag creates a new Tag with validation
+func NewTag(name, description string) (*Tag, error) {
+	// Use zero ID for new tags - will be assigned by repository
+	id, err := shared.NewTagID(0)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTagWithID(id, name, description)
+}
+
+// NewTagWithID creates a new Tag with a specific ID (for repository reconstruction)
+func NewTagWithID(id shared.TagID, name, description string) (*Tag, error) {
+	normalized, err := NormalizeName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &Tag{
+		id:          id,
+		name:        normalized,
+		description: strings.TrimSpace(description),
+		createdAt:   now,
+		updatedAt:   now,
+	}, nil
+}
+
+// NormalizeName lowercases a tag name and joins its words with hyphens, so
+// "Based on Book" becomes "based-on-book". Names may only contain letters,
+// digits and hyphens once normalized.
+func NormalizeName(name string) (string, error) {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return r == ' ' || r == '_' || r == '-' || r == '\t'
+	})
+	normalized := strings.Join(words, "-")
+
+	if normalized == "" {
+		return "", shared.NewValidationError("tag name cannot be empty")
+	}
+	if len(normalized) > MaxNameLength {
+		return "", shared.NewValidationError("tag name cannot be longer than %d characters", MaxNameLength)
+	}
+	for _, r := range normalized {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+			return "", shared.NewValidationError("tag name %q may only contain letters, digits and hyphens", name)
+		}
+	}
+	return normalized, nil
+}
+
+// ID returns the tag's unique identifier
+func (t *Tag) ID() shared.TagID {
+	return t.id
+}
+
+// Name returns the tag's normalized name
+func (t *Tag) Name() string {
+	return t.name
+}
+
+// Description returns the tag's description
+func (t *Tag) Description() string {
+	return t.description
+}
+
+// CreatedAt returns when the tag was created
+func (t *Tag) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+// UpdatedAt returns when the tag was last updated
+func (t *Tag) UpdatedAt() time.Time {
+	return t.updatedAt
+}
+
+// SetDescription sets the tag's description
+func (t *Tag) SetDescription(description string) {
+	t.description = strings.TrimSpace(description)
+	t.touch()
+}
+
+// touch updates the updatedAt timestamp
+func (t *Tag) touch() {
+	t.updatedAt = time.Now()
+}
+
+// SetID sets the tag's ID (used by repository when saving)
+func (t *Tag) SetID(id shared.TagID) {
+	t.id = id
+	t.touch()
+}
@@ -0,0 +1,72 @@
+package tag
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "already normalized", input: "time-travel", want: "time-travel"},
+		{name: "spaces and case", input: "  Based on Book ", want: "based-on-book"},
+		{name: "underscores", input: "oscar_winner", want: "oscar-winner"},
+		{name: "repeated separators", input: "cult -- classic", want: "cult-classic"},
+		{name: "digits", input: "80s", want: "80s"},
+		{name: "empty", input: " - ", wantErr: true},
+		{name: "punctuation", input: "sci-fi!", wantErr: true},
+		{name: "too long", input: strings.Repeat("a", MaxNameLength+1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeName(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeName() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !errors.Is(err, shared.ErrValidation) {
+					t.Errorf("Expected a validation error, got: %v", err)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewTag(t *testing.T) {
+	tag, err := NewTag("Time Travel", "  Stories that move through time ")
+	if err != nil {
+		t.Fatalf("NewTag() error = %v", err)
+	}
+	if !tag.ID().IsZero() {
+		t.Errorf("Expected a zero ID, got: %d", tag.ID().Value())
+	}
+	if tag.Name() != "time-travel" || tag.Description() != "Stories that move through time" {
+		t.Errorf("Expected a normalized tag, got: %q %q", tag.Name(), tag.Description())
+	}
+
+	if _, err := NewTag("", ""); err == nil {
+		t.Error("Expected an error for an empty name")
+	}
+}
+
+func TestTag_SetID(t *testing.T) {
+	tag, _ := NewTag("heist", "")
+	id, _ := shared.NewTagID(4)
+
+	tag.SetID(id)
+	if tag.ID().Value() != 4 {
+		t.Errorf("Expected ID 4, got: %d", tag.ID().Value())
+	}
+}
@@ -0,0 +1,19 @@
+package translation
+
+import (
+	"context"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// Repository defines the interface for movie translation data access
+type Repository interface {
+	// FindByMovieID retrieves all of a movie's translations, ordered by language
+	FindByMovieID(ctx context.Context, movieID shared.MovieID) ([]*Translation, error)
+
+	// FindByMovieIDs retrieves the translations of many movies in any of the given languages
+	FindByMovieIDs(ctx context.Context, movieIDs []shared.MovieID, languages []string) ([]*Translation, error)
+
+	// Save inserts a translation or replaces the movie's existing one in the same language
+	Save(ctx context.Context, translation *Translation) error
+}
@@ -0,0 +1,140 @@
+package translation
+
+import (
+	"strings"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// NormalizeLanguage validates a BCP 47 language tag made of a two- or
+// three-letter language and an optional two-letter region ("fr", "pt-BR")
+// and returns it as lower-case language and upper-case region. An
+// underscore is accepted in place of the hyphen.
+func NormalizeLanguage(language string) (string, error) {
+	tag := strings.ReplaceAll(strings.TrimSpace(language), "_", "-")
+	base, region, hasRegion := strings.Cut(tag, "-")
+
+	base = strings.ToLower(base)
+	if (len(base) != 2 && len(base) != 3) || !isASCIILetters(base) {
+		return "", shared.NewValidationError("language must be a code such as en, fr or pt-BR")
+	}
+	if !hasRegion {
+		return base, nil
+	}
+
+	region = strings.ToUpper(region)
+	if len(region) != 2 || !isASCIILetters(region) {
+		return "", shared.NewValidationError("language must be a code such as en, fr or pt-BR")
+	}
+	return base + "-" + region, nil
+}
+
+// BaseLanguage returns the language subtag of a normalized tag ("pt" for "pt-BR")
+func BaseLanguage(language string) string {
+	base, _, _ := strings.Cut(language, "-")
+	return base
+}
+
+// isASCIILetters reports whether s consists only of ASCII letters
+func isASCIILetters(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i] | 0x20 // Fold to lower case
+		if c < 'a' || c > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// DescriptionSource records where a translated description came from
+type DescriptionSource string
+
+const (
+	// DescriptionImported is a description given to the server, by a tool
+	// call or an import
+	DescriptionImported DescriptionSource = "imported"
+	// DescriptionGenerated is a description a model wrote from the movie's
+	// stored metadata
+	DescriptionGenerated DescriptionSource = "generated"
+)
+
+// Translation holds a movie's title and description in one language
+type Translation struct {
+	movieID     shared.MovieID
+	language    string
+	title       string
+	description string
+
+	descriptionSource DescriptionSource
+	descriptionModel  string // Model that generated the description, if known
+}
+
+// NewTranslation creates a new Translation with validation. Either the
+// title or the description may be empty, but not both.
+func NewTranslation(movieID shared.MovieID, language, title, description string) (*Translation, error) {
+	if movieID.IsZero() {
+		return nil, shared.NewValidationError("movie ID is required")
+	}
+
+	normalizedLanguage, err := NormalizeLanguage(language)
+	if err != nil {
+		return nil, err
+	}
+
+	title = strings.TrimSpace(title)
+	description = strings.TrimSpace(description)
+	if title == "" && description == "" {
+		return nil, shared.NewValidationError("a translation needs a title or a description")
+	}
+
+	return &Translation{
+		movieID:     movieID,
+		language:    normalizedLanguage,
+		title:       title,
+		description: description,
+
+		descriptionSource: DescriptionImported,
+	}, nil
+}
+
+// MarkDescriptionGenerated records that the description was generated by
+// model, which may be empty when the model is not known
+func (t *Translation) MarkDescriptionGenerated(model string) error {
+	if t.description == "" {
+		return shared.NewValidationError("a generated translation needs a description")
+	}
+	t.descriptionSource = DescriptionGenerated
+	t.descriptionModel = strings.TrimSpace(model)
+	return nil
+}
+
+// MovieID returns the movie this translation belongs to
+func (t *Translation) MovieID() shared.MovieID {
+	return t.movieID
+}
+
+// Language returns the normalized language tag
+func (t *Translation) Language() string {
+	return t.language
+}
+
+// Title returns the alternative title in this language, if any
+func (t *Translation) Title() string {
+	return t.title
+}
+
+// Description returns the localized description, if any
+func (t *Translation) Description() string {
+	return t.description
+}
+
+// DescriptionSource returns where the description came from
+func (t *Translation) DescriptionSource() DescriptionSource {
+	return t.descriptionSource
+}
+
+// DescriptionModel returns the model that generated the description, or ""
+// for an imported description
+func (t *Translation) DescriptionModel() string {
+	return t.descriptionModel
+}
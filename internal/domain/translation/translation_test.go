@@ -0,0 +1,109 @@
+package translation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+func TestNormalizeLanguage(t *testing.T) {
+	tests := []struct {
+		language string
+		want     string
+		wantErr  bool
+	}{
+		{language: "fr", want: "fr"},
+		{language: " EN ", want: "en"},
+		{language: "pt-br", want: "pt-BR"},
+		{language: "zh_TW", want: "zh-TW"},
+		{language: "fil", want: "fil"},
+		{language: "", wantErr: true},
+		{language: "french", wantErr: true},
+		{language: "pt-BRA", wantErr: true},
+		{language: "e1", wantErr: true},
+		{language: "pt-", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.language, func(t *testing.T) {
+			got, err := NormalizeLanguage(tt.language)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NormalizeLanguage() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Expected %q, got: %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestBaseLanguage(t *testing.T) {
+	if got := BaseLanguage("pt-BR"); got != "pt" {
+		t.Errorf("Expected pt, got: %s", got)
+	}
+	if got := BaseLanguage("fr"); got != "fr" {
+		t.Errorf("Expected fr, got: %s", got)
+	}
+}
+
+func TestNewTranslation(t *testing.T) {
+	movieID, _ := shared.NewMovieID(1)
+
+	tests := []struct {
+		name        string
+		movieID     shared.MovieID
+		language    string
+		title       string
+		description string
+		wantErr     bool
+	}{
+		{name: "title and description", movieID: movieID, language: "fr", title: "Le Parrain", description: "Une famille mafieuse"},
+		{name: "title only", movieID: movieID, language: "de", title: "Der Pate"},
+		{name: "description only", movieID: movieID, language: "es", description: "Una familia mafiosa"},
+		{name: "missing movie", language: "fr", title: "Le Parrain", wantErr: true},
+		{name: "invalid language", movieID: movieID, language: "french", title: "Le Parrain", wantErr: true},
+		{name: "nothing translated", movieID: movieID, language: "fr", title: "  ", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			translation, err := NewTranslation(tt.movieID, tt.language, tt.title, tt.description)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewTranslation() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if translation.Language() != tt.language {
+				t.Errorf("Expected language %s, got: %s", tt.language, translation.Language())
+			}
+			if translation.Title() != tt.title || translation.Description() != tt.description {
+				t.Errorf("Expected %q / %q, got: %q / %q", tt.title, tt.description, translation.Title(), translation.Description())
+			}
+		})
+	}
+}
+
+func TestTranslation_MarkDescriptionGenerated(t *testing.T) {
+	movieID, _ := shared.NewMovieID(1)
+
+	imported, _ := NewTranslation(movieID, "en", "", "A mafia family")
+	if imported.DescriptionSource() != DescriptionImported || imported.DescriptionModel() != "" {
+		t.Errorf("Expected a new description to be imported, got: %s (%q)", imported.DescriptionSource(), imported.DescriptionModel())
+	}
+
+	if err := imported.MarkDescriptionGenerated(" test-model "); err != nil {
+		t.Fatalf("MarkDescriptionGenerated() error = %v", err)
+	}
+	if imported.DescriptionSource() != DescriptionGenerated || imported.DescriptionModel() != "test-model" {
+		t.Errorf("Expected a generated description, got: %s (%q)", imported.DescriptionSource(), imported.DescriptionModel())
+	}
+
+	titleOnly, _ := NewTranslation(movieID, "fr", "Le Parrain", "")
+	if err := titleOnly.MarkDescriptionGenerated("test-model"); !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("Expected a validation error without a description, got: %v", err)
+	}
+}
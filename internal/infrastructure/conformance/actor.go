@@ -0,0 +1,268 @@
+package conformance
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/actor"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// testActor describes an actor to save before a test runs
+type testActor struct {
+	name      string
+	birthYear int
+	credits   []actor.Credit
+}
+
+// saveActors saves actors in order and returns them with their IDs set
+func saveActors(t *testing.T, repo actor.Repository, actors ...testActor) []*actor.Actor {
+	t.Helper()
+
+	saved := make([]*actor.Actor, 0, len(actors))
+	for _, a := range actors {
+		domainActor, err := actor.NewActor(a.name, a.birthYear)
+		if err != nil {
+			t.Fatalf("failed to create actor %s: %v", a.name, err)
+		}
+		for _, credit := range a.credits {
+			if err := domainActor.AddCredit(credit); err != nil {
+				t.Fatalf("failed to add credit: %v", err)
+			}
+		}
+		if err := repo.Save(context.Background(), domainActor); err != nil {
+			t.Fatalf("Save(%s) error = %v", a.name, err)
+		}
+		saved = append(saved, domainActor)
+	}
+	return saved
+}
+
+// newCredit creates a credit, failing the test if it is invalid
+func newCredit(t *testing.T, movieID shared.MovieID, character string, billingOrder int, roleType string) actor.Credit {
+	t.Helper()
+
+	credit, err := actor.NewCredit(movieID, character, billingOrder, roleType)
+	if err != nil {
+		t.Fatalf("failed to create credit: %v", err)
+	}
+	return credit
+}
+
+// TestActorRepository runs the actor.Repository conformance suite. Name and
+// character searches are case-insensitive partial matches, birth year
+// bounds are inclusive and results are ordered by name unless asked
+// otherwise. Credits refer to movies saved through the movie repository.
+func TestActorRepository(t *testing.T, newRepos Factory) {
+	t.Run("SaveInsertRoundTrips", func(t *testing.T) {
+		repos := newRepos(t)
+		ctx := context.Background()
+		movies := saveMovies(t, repos.Movies,
+			testMovie{title: "Heat", director: "Michael Mann", year: 1995},
+			testMovie{title: "The Godfather Part II", director: "Francis Ford Coppola", year: 1974},
+		)
+
+		domainActor, _ := actor.NewActor("Robert De Niro", 1943)
+		domainActor.SetBio("American actor")
+		_ = domainActor.AddCredit(newCredit(t, movies[0].ID(), "Neil McCauley", 1, "lead"))
+		_ = domainActor.AddCredit(newCredit(t, movies[1].ID(), "Vito Corleone", 2, "supporting"))
+
+		if err := repos.Actors.Save(ctx, domainActor); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+		if domainActor.ID().IsZero() {
+			t.Fatal("Expected ID to be assigned on insert")
+		}
+
+		got, err := repos.Actors.FindByID(ctx, domainActor.ID())
+		if err != nil {
+			t.Fatalf("FindByID() error = %v", err)
+		}
+		if got.Name() != "Robert De Niro" || got.BirthYear().Value() != 1943 || got.Bio() != "American actor" {
+			t.Errorf("Expected Robert De Niro (1943) with bio, got: %s (%d) %q", got.Name(), got.BirthYear().Value(), got.Bio())
+		}
+		credit, ok := got.Credit(movies[1].ID())
+		if !ok || credit.Character() != "Vito Corleone" || credit.BillingOrder() != 2 || credit.RoleType() != actor.RoleSupporting {
+			t.Errorf("Expected the Vito Corleone credit to round-trip, got: %+v", got.Credits())
+		}
+		if got.MovieCount() != 2 {
+			t.Errorf("Expected 2 credits, got: %d", got.MovieCount())
+		}
+		if got.HasUncommittedEvents() {
+			t.Errorf("Expected a loaded actor to carry no events, got: %d", len(got.UncommittedEvents()))
+		}
+	})
+
+	t.Run("SaveUpdateReplacesCredits", func(t *testing.T) {
+		repos := newRepos(t)
+		ctx := context.Background()
+		movies := saveMovies(t, repos.Movies,
+			testMovie{title: "Heat", director: "Michael Mann", year: 1995},
+			testMovie{title: "Casino", director: "Martin Scorsese", year: 1995},
+		)
+		saved := saveActors(t, repos.Actors, testActor{name: "R. De Niro", birthYear: 1943, credits: []actor.Credit{
+			newCredit(t, movies[0].ID(), "Neil McCauley", 1, "lead"),
+		}})
+
+		updated, _ := actor.NewActorWithID(saved[0].ID(), "Robert De Niro", 1943)
+		_ = updated.AddCredit(newCredit(t, movies[1].ID(), "Sam Rothstein", 1, "lead"))
+		if err := repos.Actors.Save(ctx, updated); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+
+		got, err := repos.Actors.FindByID(ctx, saved[0].ID())
+		if err != nil {
+			t.Fatalf("FindByID() error = %v", err)
+		}
+		if got.Name() != "Robert De Niro" || got.HasMovie(movies[0].ID()) || !got.HasMovie(movies[1].ID()) {
+			t.Errorf("Expected the name and credits to be replaced, got: %s %+v", got.Name(), got.Credits())
+		}
+	})
+
+	t.Run("FindByIDMissingFails", func(t *testing.T) {
+		repos := newRepos(t)
+		missing, _ := shared.NewActorID(999)
+
+		if _, err := repos.Actors.FindByID(context.Background(), missing); !errors.Is(err, shared.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound finding a missing actor, got: %v", err)
+		}
+	})
+
+	t.Run("NameMatchesPartsIgnoringCase", func(t *testing.T) {
+		repos := newRepos(t)
+		saveActors(t, repos.Actors,
+			testActor{name: "Tom Hanks", birthYear: 1956},
+			testActor{name: "Tom Hardy", birthYear: 1977},
+			testActor{name: "Al Pacino", birthYear: 1940},
+		)
+
+		got, err := repos.Actors.FindByName(context.Background(), "tOM h")
+		if err != nil {
+			t.Fatalf("FindByName() error = %v", err)
+		}
+		if !equalStrings(actorNames(got), []string{"Tom Hanks", "Tom Hardy"}) {
+			t.Errorf("Expected both Toms by name, got: %v", actorNames(got))
+		}
+	})
+
+	t.Run("FindByMovieID", func(t *testing.T) {
+		repos := newRepos(t)
+		movies := saveMovies(t, repos.Movies,
+			testMovie{title: "Heat", director: "Michael Mann", year: 1995},
+			testMovie{title: "Alien", director: "Ridley Scott", year: 1979},
+		)
+		saveActors(t, repos.Actors,
+			testActor{name: "Robert De Niro", birthYear: 1943, credits: []actor.Credit{newCredit(t, movies[0].ID(), "", 0, "")}},
+			testActor{name: "Al Pacino", birthYear: 1940, credits: []actor.Credit{newCredit(t, movies[0].ID(), "", 0, "")}},
+			testActor{name: "Sigourney Weaver", birthYear: 1949, credits: []actor.Credit{newCredit(t, movies[1].ID(), "", 0, "")}},
+		)
+
+		got, err := repos.Actors.FindByMovieID(context.Background(), movies[0].ID())
+		if err != nil {
+			t.Fatalf("FindByMovieID() error = %v", err)
+		}
+		if !equalStrings(actorNames(got), []string{"Al Pacino", "Robert De Niro"}) {
+			t.Errorf("Expected the Heat cast by name, got: %v", actorNames(got))
+		}
+	})
+
+	t.Run("CriteriaFilters", func(t *testing.T) {
+		repos := newRepos(t)
+		movies := saveMovies(t, repos.Movies, testMovie{title: "The Godfather", director: "Francis Ford Coppola", year: 1972})
+		saved := saveActors(t, repos.Actors,
+			testActor{name: "Marlon Brando", birthYear: 1924, credits: []actor.Credit{newCredit(t, movies[0].ID(), "Vito Corleone", 1, "lead")}},
+			testActor{name: "Al Pacino", birthYear: 1940, credits: []actor.Credit{newCredit(t, movies[0].ID(), "Michael Corleone", 2, "lead")}},
+			testActor{name: "Robert Duvall", birthYear: 1931, credits: []actor.Credit{newCredit(t, movies[0].ID(), "Tom Hagen", 3, "supporting")}},
+			testActor{name: "Diane Keaton", birthYear: 1946},
+		)
+
+		tests := []struct {
+			name     string
+			criteria actor.SearchCriteria
+			want     []string
+		}{
+			{
+				name:     "character",
+				criteria: actor.SearchCriteria{Character: "corleone"},
+				want:     []string{"Al Pacino", "Marlon Brando"},
+			},
+			{
+				name:     "inclusive birth years",
+				criteria: actor.SearchCriteria{MinBirthYear: 1931, MaxBirthYear: 1946},
+				want:     []string{"Al Pacino", "Diane Keaton", "Robert Duvall"},
+			},
+			{
+				name:     "IDs",
+				criteria: actor.SearchCriteria{IDs: []shared.ActorID{saved[3].ID(), saved[0].ID()}},
+				want:     []string{"Diane Keaton", "Marlon Brando"},
+			},
+			{
+				name:     "movie",
+				criteria: actor.SearchCriteria{MovieID: movies[0].ID(), MinBirthYear: 1930},
+				want:     []string{"Al Pacino", "Robert Duvall"},
+			},
+		}
+		for _, tt := range tests {
+			got, err := repos.Actors.FindByCriteria(context.Background(), tt.criteria)
+			if err != nil {
+				t.Fatalf("FindByCriteria(%s) error = %v", tt.name, err)
+			}
+			if !equalStrings(actorNames(got), tt.want) {
+				t.Errorf("Expected %s to match %v, got: %v", tt.name, tt.want, actorNames(got))
+			}
+		}
+	})
+
+	t.Run("SortsAndPaginates", func(t *testing.T) {
+		repos := newRepos(t)
+		saveActors(t, repos.Actors,
+			testActor{name: "A", birthYear: 1950},
+			testActor{name: "B", birthYear: 1960},
+			testActor{name: "C", birthYear: 1950},
+			testActor{name: "D", birthYear: 1955},
+		)
+
+		got, err := repos.Actors.FindByCriteria(context.Background(), actor.SearchCriteria{
+			Sort:   []actor.SortKey{{Field: actor.OrderByBirthYear, Dir: actor.OrderDesc}, {Field: actor.OrderByName, Dir: actor.OrderDesc}},
+			Limit:  2,
+			Offset: 1,
+		})
+		if err != nil {
+			t.Fatalf("FindByCriteria() error = %v", err)
+		}
+		if !equalStrings(actorNames(got), []string{"D", "C"}) {
+			t.Errorf("Expected the second page of birth year then name descending, got: %v", actorNames(got))
+		}
+	})
+
+	t.Run("DeleteAndCount", func(t *testing.T) {
+		repos := newRepos(t)
+		ctx := context.Background()
+		movies := saveMovies(t, repos.Movies, testMovie{title: "Heat", director: "Michael Mann", year: 1995})
+		saved := saveActors(t, repos.Actors,
+			testActor{name: "Robert De Niro", birthYear: 1943, credits: []actor.Credit{newCredit(t, movies[0].ID(), "", 0, "")}},
+			testActor{name: "Al Pacino", birthYear: 1940},
+		)
+
+		if err := repos.Actors.Delete(ctx, saved[0].ID()); err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+		if err := repos.Actors.Delete(ctx, saved[0].ID()); !errors.Is(err, shared.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound deleting a missing actor, got: %v", err)
+		}
+		if cast, _ := repos.Actors.FindByMovieID(ctx, movies[0].ID()); len(cast) != 0 {
+			t.Errorf("Expected a deleted actor to leave the cast, got: %v", actorNames(cast))
+		}
+		if count, _ := repos.Actors.CountAll(ctx); count != 1 {
+			t.Errorf("Expected 1 actor after delete, got: %d", count)
+		}
+
+		if err := repos.Actors.DeleteAll(ctx); err != nil {
+			t.Fatalf("DeleteAll() error = %v", err)
+		}
+		if count, _ := repos.Actors.CountAll(ctx); count != 0 {
+			t.Errorf("Expected no actors after DeleteAll, got: %d", count)
+		}
+	})
+}
@@ -0,0 +1,118 @@
+// Package conformance holds behaviour tests that every repository
+// implementation must pass, whatever database it uses. Drivers differ in
+// details such as case sensitivity and JSON matching; running each of them
+// through the same suite keeps those differences from leaking to callers.
+//
+// A driver wires the suite into its own tests:
+//
+//	func TestMovieRepositoryConformance(t *testing.T) {
+//		conformance.TestMovieRepository(t, newConformanceRepositories)
+//	}
+package conformance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/actor"
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// Repositories are the implementations under test. They must share one
+// empty database, created afresh for every call of the factory.
+type Repositories struct {
+	Movies movie.Repository
+	Actors actor.Repository
+}
+
+// Factory creates empty repositories for a single test
+type Factory func(t *testing.T) Repositories
+
+// testMovie describes a movie to save before a test runs
+type testMovie struct {
+	title    string
+	director string
+	year     int
+	rating   float64
+	genres   []string
+	released time.Time // Release date, when known to the day
+	duration int       // Runtime in minutes
+}
+
+// saveMovies saves movies in order and returns them with their IDs set
+func saveMovies(t *testing.T, repo movie.Repository, movies ...testMovie) []*movie.Movie {
+	t.Helper()
+
+	saved := make([]*movie.Movie, 0, len(movies))
+	for _, m := range movies {
+		domainMovie, err := movie.NewMovie(m.title, m.director, m.year)
+		if err != nil {
+			t.Fatalf("failed to create movie %s: %v", m.title, err)
+		}
+		if m.rating > 0 {
+			if err := domainMovie.SetRating(m.rating); err != nil {
+				t.Fatalf("failed to set rating: %v", err)
+			}
+		}
+		for _, genre := range m.genres {
+			if err := domainMovie.AddGenre(genre); err != nil {
+				t.Fatalf("failed to add genre: %v", err)
+			}
+		}
+		if err := domainMovie.SetReleaseDate(m.released); err != nil {
+			t.Fatalf("failed to set release date: %v", err)
+		}
+		if err := domainMovie.SetDuration(m.duration); err != nil {
+			t.Fatalf("failed to set duration: %v", err)
+		}
+		if err := repo.Save(context.Background(), domainMovie); err != nil {
+			t.Fatalf("Save(%s) error = %v", m.title, err)
+		}
+		saved = append(saved, domainMovie)
+	}
+	return saved
+}
+
+// movieTitles lists the titles of movies in order
+func movieTitles(movies []*movie.Movie) []string {
+	titles := make([]string, 0, len(movies))
+	for _, m := range movies {
+		titles = append(titles, m.Title())
+	}
+	return titles
+}
+
+// actorNames lists the names of actors in order
+func actorNames(actors []*actor.Actor) []string {
+	names := make([]string, 0, len(actors))
+	for _, a := range actors {
+		names = append(names, a.Name())
+	}
+	return names
+}
+
+// equalStrings reports whether two string slices hold the same values in order
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// mustMovieID converts an int to a movie ID, failing the test if it is invalid
+func mustMovieID(t *testing.T, id int) shared.MovieID {
+	t.Helper()
+
+	movieID, err := shared.NewMovieID(id)
+	if err != nil {
+		t.Fatalf("invalid movie ID %d: %v", id, err)
+	}
+	return movieID
+}
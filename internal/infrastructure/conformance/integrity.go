@@ -0,0 +1,128 @@
+package conformance
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/actor"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// TestReferentialIntegrity runs the conformance suite for the rules between
+// movies and actors. Deleting a movie or an actor cascades to the credits
+// linking them but never deletes the other side; a credit for a movie that
+// does not exist is restricted, failing with shared.ErrNotFound and saving
+// nothing.
+func TestReferentialIntegrity(t *testing.T, newRepos Factory) {
+	t.Run("DeletingMovieRemovesCredits", func(t *testing.T) {
+		repos := newRepos(t)
+		ctx := context.Background()
+		movies := saveMovies(t, repos.Movies,
+			testMovie{title: "Heat", director: "Michael Mann", year: 1995},
+			testMovie{title: "Casino", director: "Martin Scorsese", year: 1995},
+		)
+		saved := saveActors(t, repos.Actors, testActor{name: "Robert De Niro", birthYear: 1943, credits: []actor.Credit{
+			newCredit(t, movies[0].ID(), "Neil McCauley", 1, "lead"),
+			newCredit(t, movies[1].ID(), "Sam Rothstein", 1, "lead"),
+		}})
+
+		if err := repos.Movies.Delete(ctx, movies[0].ID()); err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+
+		got, err := repos.Actors.FindByID(ctx, saved[0].ID())
+		if err != nil {
+			t.Fatalf("Expected the actor to outlive the movie, got: %v", err)
+		}
+		if got.HasMovie(movies[0].ID()) || !got.HasMovie(movies[1].ID()) {
+			t.Errorf("Expected only the deleted movie's credit to go, got: %+v", got.Credits())
+		}
+		if cast, _ := repos.Actors.FindByMovieID(ctx, movies[0].ID()); len(cast) != 0 {
+			t.Errorf("Expected no cast for a deleted movie, got: %v", actorNames(cast))
+		}
+	})
+
+	t.Run("DeletingAllMoviesRemovesCredits", func(t *testing.T) {
+		repos := newRepos(t)
+		ctx := context.Background()
+		movies := saveMovies(t, repos.Movies, testMovie{title: "Heat", director: "Michael Mann", year: 1995})
+		saved := saveActors(t, repos.Actors, testActor{name: "Al Pacino", birthYear: 1940, credits: []actor.Credit{
+			newCredit(t, movies[0].ID(), "Vincent Hanna", 1, "lead"),
+		}})
+
+		if err := repos.Movies.DeleteAll(ctx); err != nil {
+			t.Fatalf("DeleteAll() error = %v", err)
+		}
+
+		got, err := repos.Actors.FindByID(ctx, saved[0].ID())
+		if err != nil {
+			t.Fatalf("Expected the actor to outlive the movies, got: %v", err)
+		}
+		if got.MovieCount() != 0 {
+			t.Errorf("Expected no credits after deleting every movie, got: %+v", got.Credits())
+		}
+	})
+
+	t.Run("DeletingActorKeepsMovies", func(t *testing.T) {
+		repos := newRepos(t)
+		ctx := context.Background()
+		movies := saveMovies(t, repos.Movies, testMovie{title: "Heat", director: "Michael Mann", year: 1995})
+		saved := saveActors(t, repos.Actors,
+			testActor{name: "Robert De Niro", birthYear: 1943, credits: []actor.Credit{newCredit(t, movies[0].ID(), "", 0, "")}},
+			testActor{name: "Al Pacino", birthYear: 1940, credits: []actor.Credit{newCredit(t, movies[0].ID(), "", 0, "")}},
+		)
+
+		if err := repos.Actors.Delete(ctx, saved[0].ID()); err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+
+		if _, err := repos.Movies.FindByID(ctx, movies[0].ID()); err != nil {
+			t.Errorf("Expected the movie to outlive the actor, got: %v", err)
+		}
+		cast, _ := repos.Actors.FindByMovieID(ctx, movies[0].ID())
+		if !equalStrings(actorNames(cast), []string{"Al Pacino"}) {
+			t.Errorf("Expected only Al Pacino left in the cast, got: %v", actorNames(cast))
+		}
+	})
+
+	t.Run("CreditForMissingMovieFails", func(t *testing.T) {
+		repos := newRepos(t)
+		ctx := context.Background()
+		missingID, _ := shared.NewMovieID(999)
+
+		domainActor, _ := actor.NewActor("Robert De Niro", 1943)
+		_ = domainActor.AddCredit(newCredit(t, missingID, "", 0, ""))
+
+		if err := repos.Actors.Save(ctx, domainActor); !errors.Is(err, shared.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound for a credit to a missing movie, got: %v", err)
+		}
+		if count, _ := repos.Actors.CountAll(ctx); count != 0 {
+			t.Errorf("Expected the failed save to store nothing, got: %d actors", count)
+		}
+	})
+
+	t.Run("UpdateWithMissingMovieKeepsCredits", func(t *testing.T) {
+		repos := newRepos(t)
+		ctx := context.Background()
+		movies := saveMovies(t, repos.Movies, testMovie{title: "Heat", director: "Michael Mann", year: 1995})
+		saved := saveActors(t, repos.Actors, testActor{name: "Robert De Niro", birthYear: 1943, credits: []actor.Credit{
+			newCredit(t, movies[0].ID(), "Neil McCauley", 1, "lead"),
+		}})
+		missingID, _ := shared.NewMovieID(999)
+
+		updated, _ := actor.NewActorWithID(saved[0].ID(), "Robert De Niro", 1943)
+		_ = updated.AddCredit(newCredit(t, missingID, "", 0, ""))
+		if err := repos.Actors.Save(ctx, updated); !errors.Is(err, shared.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound for a credit to a missing movie, got: %v", err)
+		}
+
+		got, err := repos.Actors.FindByID(ctx, saved[0].ID())
+		if err != nil {
+			t.Fatalf("FindByID() error = %v", err)
+		}
+		if !got.HasMovie(movies[0].ID()) || got.HasMovie(missingID) {
+			t.Errorf("Expected the failed update to keep the old credits, got: %+v", got.Credits())
+		}
+	})
+}
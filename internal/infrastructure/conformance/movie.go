@@ -0,0 +1,426 @@
+package conformance
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// TestMovieRepository runs the movie.Repository conformance suite. Title and
+// director searches are case-insensitive partial matches; genres match
+// whole, case-sensitive values; year and rating bounds are inclusive; and
+// results are ordered by title unless asked otherwise.
+func TestMovieRepository(t *testing.T, newRepos Factory) {
+	t.Run("SaveInsertRoundTrips", func(t *testing.T) {
+		repo := newRepos(t).Movies
+		ctx := context.Background()
+
+		domainMovie, _ := movie.NewMovie("Inception", "Christopher Nolan", 2010)
+		_ = domainMovie.SetRating(8.8)
+		_ = domainMovie.AddGenre("Sci-Fi")
+		_ = domainMovie.AddGenre("Action")
+		_ = domainMovie.SetPosterURL("https://example.com/inception.jpg")
+		_ = domainMovie.SetCertification("US", "PG-13")
+		_ = domainMovie.AddContentWarning("violence")
+
+		if err := repo.Save(ctx, domainMovie); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+		if domainMovie.ID().IsZero() {
+			t.Fatal("Expected ID to be assigned on insert")
+		}
+
+		got, err := repo.FindByID(ctx, domainMovie.ID())
+		if err != nil {
+			t.Fatalf("FindByID() error = %v", err)
+		}
+		if got.Title() != "Inception" || got.Director() != "Christopher Nolan" || got.Year().Value() != 2010 {
+			t.Errorf("Expected Inception (2010) by Christopher Nolan, got: %s (%d) by %s", got.Title(), got.Year().Value(), got.Director())
+		}
+		if got.Rating().Value() != 8.8 {
+			t.Errorf("Expected rating 8.8, got: %v", got.Rating().Value())
+		}
+		if !equalStrings(got.Genres(), []string{"Sci-Fi", "Action"}) {
+			t.Errorf("Expected genres in saved order, got: %v", got.Genres())
+		}
+		if got.PosterURL() != "https://example.com/inception.jpg" {
+			t.Errorf("Expected poster URL to round-trip, got: %s", got.PosterURL())
+		}
+		if got.Certifications()["US"] != "PG-13" || !equalStrings(got.ContentWarnings(), []string{"violence"}) {
+			t.Errorf("Expected content advisory to round-trip, got: %v %v", got.Certifications(), got.ContentWarnings())
+		}
+		if got.HasUncommittedEvents() {
+			t.Errorf("Expected a loaded movie to carry no events, got: %d", len(got.UncommittedEvents()))
+		}
+	})
+
+	t.Run("SaveUpdateOverwrites", func(t *testing.T) {
+		repo := newRepos(t).Movies
+		ctx := context.Background()
+		saved := saveMovies(t, repo, testMovie{title: "Inception", director: "C. Nolan", year: 2010, rating: 8.0, genres: []string{"Drama"}})
+
+		updated, _ := movie.NewMovieWithID(saved[0].ID(), "Inception", "Christopher Nolan", 2010)
+		_ = updated.AddGenre("Sci-Fi")
+		if err := repo.Save(ctx, updated); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+
+		got, err := repo.FindByID(ctx, saved[0].ID())
+		if err != nil {
+			t.Fatalf("FindByID() error = %v", err)
+		}
+		if got.Director() != "Christopher Nolan" || !got.Rating().IsZero() || !equalStrings(got.Genres(), []string{"Sci-Fi"}) {
+			t.Errorf("Expected every field to be overwritten, got: %s %v %v", got.Director(), got.Rating().Value(), got.Genres())
+		}
+		if count, _ := repo.CountAll(ctx); count != 1 {
+			t.Errorf("Expected update not to insert, got: %d movies", count)
+		}
+	})
+
+	t.Run("SaveUpdateMissingFails", func(t *testing.T) {
+		repo := newRepos(t).Movies
+
+		missing, _ := movie.NewMovieWithID(mustMovieID(t, 999), "Inception", "Christopher Nolan", 2010)
+		if err := repo.Save(context.Background(), missing); !errors.Is(err, shared.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound updating a missing movie, got: %v", err)
+		}
+	})
+
+	t.Run("FindByIDMissingFails", func(t *testing.T) {
+		repo := newRepos(t).Movies
+
+		if _, err := repo.FindByID(context.Background(), mustMovieID(t, 999)); !errors.Is(err, shared.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound finding a missing movie, got: %v", err)
+		}
+	})
+
+	t.Run("TitleAndDirectorMatchPartsIgnoringCase", func(t *testing.T) {
+		repo := newRepos(t).Movies
+		ctx := context.Background()
+		saveMovies(t, repo,
+			testMovie{title: "The Dark Knight", director: "Christopher Nolan", year: 2008},
+			testMovie{title: "Dark City", director: "Alex Proyas", year: 1998},
+			testMovie{title: "Heat", director: "Michael Mann", year: 1995},
+		)
+
+		byTitle, err := repo.FindByTitle(ctx, "dARK")
+		if err != nil {
+			t.Fatalf("FindByTitle() error = %v", err)
+		}
+		if !equalStrings(movieTitles(byTitle), []string{"Dark City", "The Dark Knight"}) {
+			t.Errorf("Expected both Dark movies by title, got: %v", movieTitles(byTitle))
+		}
+
+		byDirector, err := repo.FindByDirector(ctx, "nolan")
+		if err != nil {
+			t.Fatalf("FindByDirector() error = %v", err)
+		}
+		if !equalStrings(movieTitles(byDirector), []string{"The Dark Knight"}) {
+			t.Errorf("Expected The Dark Knight by director, got: %v", movieTitles(byDirector))
+		}
+	})
+
+	t.Run("GenreMatchesWholeValues", func(t *testing.T) {
+		repo := newRepos(t).Movies
+		ctx := context.Background()
+		saveMovies(t, repo,
+			testMovie{title: "Alien", director: "Ridley Scott", year: 1979, genres: []string{"Sci-Fi", "Horror"}},
+			testMovie{title: "Heat", director: "Michael Mann", year: 1995, genres: []string{"Crime"}},
+		)
+
+		tests := []struct {
+			genre string
+			want  []string
+		}{
+			{genre: "Horror", want: []string{"Alien"}},
+			{genre: "Sci", want: []string{}},
+			{genre: "horror", want: []string{}},
+		}
+		for _, tt := range tests {
+			got, err := repo.FindByGenre(ctx, tt.genre)
+			if err != nil {
+				t.Fatalf("FindByGenre(%q) error = %v", tt.genre, err)
+			}
+			if !equalStrings(movieTitles(got), tt.want) {
+				t.Errorf("Expected genre %q to match %v, got: %v", tt.genre, tt.want, movieTitles(got))
+			}
+		}
+	})
+
+	t.Run("RangesAreInclusive", func(t *testing.T) {
+		repo := newRepos(t).Movies
+		saveMovies(t, repo,
+			testMovie{title: "A", director: "D", year: 1999, rating: 6.9},
+			testMovie{title: "B", director: "D", year: 2000, rating: 7.0},
+			testMovie{title: "C", director: "D", year: 2005, rating: 8.0},
+			testMovie{title: "D", director: "D", year: 2010, rating: 8.1},
+		)
+
+		got, err := repo.FindByCriteria(context.Background(), movie.SearchCriteria{
+			MinYear: 2000, MaxYear: 2010, MinRating: 7.0, MaxRating: 8.0, OrderBy: movie.OrderByTitle, OrderDir: movie.OrderAsc,
+		})
+		if err != nil {
+			t.Fatalf("FindByCriteria() error = %v", err)
+		}
+		if !equalStrings(movieTitles(got), []string{"B", "C"}) {
+			t.Errorf("Expected B and C on the bounds, got: %v", movieTitles(got))
+		}
+	})
+
+	t.Run("ReleaseDatesMatchWholeYears", func(t *testing.T) {
+		repo := newRepos(t).Movies
+		saveMovies(t, repo,
+			testMovie{title: "A", director: "D", year: 1999},
+			testMovie{title: "B", director: "D", year: 2000},
+			testMovie{title: "C", director: "D", year: 2005},
+			testMovie{title: "D", director: "D", year: 2006},
+		)
+
+		got, err := repo.FindByCriteria(context.Background(), movie.SearchCriteria{
+			ReleasedAfter:  time.Date(2000, time.June, 15, 0, 0, 0, 0, time.UTC),
+			ReleasedBefore: time.Date(2005, time.March, 1, 0, 0, 0, 0, time.UTC),
+			OrderBy:        movie.OrderByTitle,
+			OrderDir:       movie.OrderAsc,
+		})
+		if err != nil {
+			t.Fatalf("FindByCriteria() error = %v", err)
+		}
+		if !equalStrings(movieTitles(got), []string{"B", "C"}) {
+			t.Errorf("Expected B and C in the years of the dates, got: %v", movieTitles(got))
+		}
+	})
+
+	t.Run("ReleaseDatesMatchKnownDays", func(t *testing.T) {
+		repo := newRepos(t).Movies
+		saveMovies(t, repo,
+			testMovie{title: "A", director: "D", year: 2000, released: time.Date(2000, time.June, 14, 0, 0, 0, 0, time.UTC)},
+			testMovie{title: "B", director: "D", year: 2000, released: time.Date(2000, time.June, 15, 0, 0, 0, 0, time.UTC)},
+			testMovie{title: "C", director: "D", year: 2000},
+			testMovie{title: "D", director: "D", year: 2005, released: time.Date(2005, time.March, 2, 0, 0, 0, 0, time.UTC)},
+		)
+
+		got, err := repo.FindByCriteria(context.Background(), movie.SearchCriteria{
+			ReleasedAfter:  time.Date(2000, time.June, 15, 0, 0, 0, 0, time.UTC),
+			ReleasedBefore: time.Date(2005, time.March, 1, 0, 0, 0, 0, time.UTC),
+			OrderBy:        movie.OrderByTitle,
+			OrderDir:       movie.OrderAsc,
+		})
+		if err != nil {
+			t.Fatalf("FindByCriteria() error = %v", err)
+		}
+		if !equalStrings(movieTitles(got), []string{"B", "C"}) {
+			t.Errorf("Expected B on the date and year-only C, got: %v", movieTitles(got))
+		}
+	})
+
+	t.Run("ReleaseDateRoundTrips", func(t *testing.T) {
+		repo := newRepos(t).Movies
+		released := time.Date(1999, time.March, 31, 0, 0, 0, 0, time.UTC)
+		saved := saveMovies(t, repo,
+			testMovie{title: "The Matrix", director: "Wachowskis", year: 1999, released: released},
+			testMovie{title: "Year Only", director: "D", year: 1999},
+		)
+
+		dated, err := repo.FindByID(context.Background(), saved[0].ID())
+		if err != nil {
+			t.Fatalf("FindByID() error = %v", err)
+		}
+		if !dated.ReleaseDate().Equal(released) || dated.Year().Value() != 1999 {
+			t.Errorf("Expected release date %v in 1999, got: %v in %d", released, dated.ReleaseDate(), dated.Year().Value())
+		}
+		yearOnly, err := repo.FindByID(context.Background(), saved[1].ID())
+		if err != nil {
+			t.Fatalf("FindByID() error = %v", err)
+		}
+		if yearOnly.HasReleaseDate() {
+			t.Errorf("Expected no release date for a year-only movie, got: %v", yearOnly.ReleaseDate())
+		}
+	})
+
+	t.Run("DurationBoundsSkipUnknownRuntimes", func(t *testing.T) {
+		repo := newRepos(t).Movies
+		saveMovies(t, repo,
+			testMovie{title: "Short", director: "D", year: 2000, duration: 25},
+			testMovie{title: "Feature", director: "D", year: 2000, duration: 110},
+			testMovie{title: "Unknown", director: "D", year: 2000},
+		)
+
+		got, err := repo.FindByCriteria(context.Background(), movie.SearchCriteria{
+			MaxDuration: movie.ShortFilmMaxDuration, OrderBy: movie.OrderByTitle, OrderDir: movie.OrderAsc,
+		})
+		if err != nil {
+			t.Fatalf("FindByCriteria() error = %v", err)
+		}
+		if !equalStrings(movieTitles(got), []string{"Short"}) || got[0].Duration() != 25 {
+			t.Errorf("Expected only the 25 minute Short, got: %v", movieTitles(got))
+		}
+	})
+
+	t.Run("IDsRestrictResults", func(t *testing.T) {
+		repo := newRepos(t).Movies
+		saved := saveMovies(t, repo,
+			testMovie{title: "A", director: "D", year: 2000},
+			testMovie{title: "B", director: "D", year: 2000},
+			testMovie{title: "C", director: "D", year: 2000},
+		)
+
+		got, err := repo.FindByCriteria(context.Background(), movie.SearchCriteria{
+			IDs: []shared.MovieID{saved[2].ID(), saved[0].ID()}, OrderBy: movie.OrderByTitle, OrderDir: movie.OrderAsc,
+		})
+		if err != nil {
+			t.Fatalf("FindByCriteria() error = %v", err)
+		}
+		if !equalStrings(movieTitles(got), []string{"A", "C"}) {
+			t.Errorf("Expected only A and C, got: %v", movieTitles(got))
+		}
+	})
+
+	t.Run("AfterIDPagesByID", func(t *testing.T) {
+		repo := newRepos(t).Movies
+		saved := saveMovies(t, repo,
+			testMovie{title: "A", director: "D", year: 2000},
+			testMovie{title: "B", director: "D", year: 2000},
+			testMovie{title: "C", director: "D", year: 2000},
+		)
+
+		got, err := repo.FindByCriteria(context.Background(), movie.SearchCriteria{
+			AfterID: saved[0].ID().Value(), Limit: 1, OrderBy: movie.OrderByID, OrderDir: movie.OrderAsc,
+		})
+		if err != nil {
+			t.Fatalf("FindByCriteria() error = %v", err)
+		}
+		if !equalStrings(movieTitles(got), []string{"B"}) {
+			t.Errorf("Expected B after A, got: %v", movieTitles(got))
+		}
+	})
+
+	t.Run("SortsAndPaginates", func(t *testing.T) {
+		repo := newRepos(t).Movies
+		saveMovies(t, repo,
+			testMovie{title: "A", director: "D", year: 2001},
+			testMovie{title: "B", director: "D", year: 2003},
+			testMovie{title: "C", director: "D", year: 2001},
+			testMovie{title: "D", director: "D", year: 2002},
+		)
+
+		got, err := repo.FindByCriteria(context.Background(), movie.SearchCriteria{
+			Sort:   []movie.SortKey{{Field: movie.OrderByYear, Dir: movie.OrderDesc}, {Field: movie.OrderByTitle, Dir: movie.OrderDesc}},
+			Limit:  2,
+			Offset: 1,
+		})
+		if err != nil {
+			t.Fatalf("FindByCriteria() error = %v", err)
+		}
+		if !equalStrings(movieTitles(got), []string{"D", "C"}) {
+			t.Errorf("Expected the second page of year then title descending, got: %v", movieTitles(got))
+		}
+	})
+
+	t.Run("TopRatedSkipsUnrated", func(t *testing.T) {
+		repo := newRepos(t).Movies
+		saveMovies(t, repo,
+			testMovie{title: "Good", director: "D", year: 2000, rating: 7.5},
+			testMovie{title: "Unrated", director: "D", year: 2000},
+			testMovie{title: "Best", director: "D", year: 2000, rating: 9.0},
+		)
+
+		got, err := repo.FindTopRated(context.Background(), 10)
+		if err != nil {
+			t.Fatalf("FindTopRated() error = %v", err)
+		}
+		if !equalStrings(movieTitles(got), []string{"Best", "Good"}) {
+			t.Errorf("Expected rated movies best first, got: %v", movieTitles(got))
+		}
+	})
+
+	t.Run("InsertAllIsAtomic", func(t *testing.T) {
+		repo := newRepos(t).Movies
+		ctx := context.Background()
+		existing := saveMovies(t, repo, testMovie{title: "Existing", director: "D", year: 2000})
+
+		first, _ := movie.NewMovie("First", "D", 2001)
+		second, _ := movie.NewMovie("Second", "D", 2002)
+		if err := repo.InsertAll(ctx, []*movie.Movie{first, second}); err != nil {
+			t.Fatalf("InsertAll() error = %v", err)
+		}
+		if first.ID().IsZero() || first.ID().Value() >= second.ID().Value() {
+			t.Errorf("Expected IDs assigned in order, got: %d and %d", first.ID().Value(), second.ID().Value())
+		}
+		if got, err := repo.FindByID(ctx, second.ID()); err != nil || got.Title() != "Second" {
+			t.Errorf("Expected Second under its own ID, got: %v", err)
+		}
+
+		third, _ := movie.NewMovie("Third", "D", 2003)
+		if err := repo.InsertAll(ctx, []*movie.Movie{third, existing[0]}); !errors.Is(err, shared.ErrConflict) {
+			t.Errorf("Expected ErrConflict inserting an existing movie, got: %v", err)
+		}
+		if count, _ := repo.CountAll(ctx); count != 3 {
+			t.Errorf("Expected a failed InsertAll to insert nothing, got: %d movies", count)
+		}
+	})
+
+	t.Run("UpdateAllIsAtomic", func(t *testing.T) {
+		repo := newRepos(t).Movies
+		ctx := context.Background()
+		saved := saveMovies(t, repo,
+			testMovie{title: "First", director: "D", year: 2000},
+			testMovie{title: "Second", director: "D", year: 2001},
+		)
+
+		for _, m := range saved {
+			if err := m.SetRating(8); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := repo.UpdateAll(ctx, saved); err != nil {
+			t.Fatalf("UpdateAll() error = %v", err)
+		}
+		if got, err := repo.FindByID(ctx, saved[1].ID()); err != nil || got.Rating().Value() != 8 {
+			t.Errorf("Expected Second rated 8, got: %v", err)
+		}
+
+		missing, _ := movie.NewMovie("Missing", "D", 2002)
+		missingID, _ := shared.NewMovieID(saved[1].ID().Value() + 100)
+		missing.SetID(missingID)
+		if err := saved[0].SetRating(3); err != nil {
+			t.Fatal(err)
+		}
+		if err := repo.UpdateAll(ctx, []*movie.Movie{saved[0], missing}); !errors.Is(err, shared.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound updating a missing movie, got: %v", err)
+		}
+		if got, _ := repo.FindByID(ctx, saved[0].ID()); got.Rating().Value() != 8 {
+			t.Errorf("Expected a failed UpdateAll to update nothing, got rating %v", got.Rating().Value())
+		}
+	})
+
+	t.Run("DeleteAndCount", func(t *testing.T) {
+		repo := newRepos(t).Movies
+		ctx := context.Background()
+		saved := saveMovies(t, repo,
+			testMovie{title: "A", director: "D", year: 2000},
+			testMovie{title: "B", director: "D", year: 2000},
+			testMovie{title: "C", director: "D", year: 2000},
+		)
+
+		if err := repo.Delete(ctx, saved[0].ID()); err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+		if err := repo.Delete(ctx, saved[0].ID()); !errors.Is(err, shared.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound deleting a missing movie, got: %v", err)
+		}
+		if count, _ := repo.CountAll(ctx); count != 2 {
+			t.Errorf("Expected 2 movies after delete, got: %d", count)
+		}
+
+		if err := repo.DeleteAll(ctx); err != nil {
+			t.Fatalf("DeleteAll() error = %v", err)
+		}
+		if count, _ := repo.CountAll(ctx); count != 0 {
+			t.Errorf("Expected no movies after DeleteAll, got: %d", count)
+		}
+	})
+}
@@ -0,0 +1,265 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/actor"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// ActorRepository implements the actor.Repository interface in memory
+type ActorRepository struct {
+	store *Store
+}
+
+// NewActorRepository creates an actor repository over a store
+func NewActorRepository(store *Store) *ActorRepository {
+	return &ActorRepository{store: store}
+}
+
+// Save persists an actor (insert or update). Every credit must name a
+// stored movie; otherwise nothing is saved.
+func (r *ActorRepository) Save(ctx context.Context, domainActor *actor.Actor) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+
+	for _, credit := range domainActor.Credits() {
+		if _, ok := r.store.movies[credit.MovieID().Value()]; !ok {
+			return shared.NewNotFoundError("movie %d not found", credit.MovieID().Value())
+		}
+	}
+
+	if domainActor.ID().IsZero() {
+		id, err := shared.NewActorID(r.store.nextActorID)
+		if err != nil {
+			return fmt.Errorf("failed to create actor ID: %w", err)
+		}
+		r.store.nextActorID++
+
+		domainActor.SetID(id)
+		record := toActorRecord(domainActor)
+		r.store.actors[record.id] = record
+		return nil
+	}
+
+	existing, ok := r.store.actors[domainActor.ID().Value()]
+	if !ok {
+		return shared.NewNotFoundError("actor not found")
+	}
+	record := toActorRecord(domainActor)
+	record.createdAt = existing.createdAt
+	r.store.actors[record.id] = record
+	return nil
+}
+
+// FindByID retrieves an actor by their ID
+func (r *ActorRepository) FindByID(ctx context.Context, id shared.ActorID) (*actor.Actor, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	record, ok := r.store.actors[id.Value()]
+	if !ok {
+		return nil, shared.NewNotFoundError("actor not found")
+	}
+	return toDomainActor(record)
+}
+
+// FindByCriteria retrieves actors based on search criteria
+func (r *ActorRepository) FindByCriteria(ctx context.Context, criteria actor.SearchCriteria) ([]*actor.Actor, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	var records []*actorRecord
+	for _, record := range r.store.actors {
+		if matchesActor(record, criteria) {
+			records = append(records, record)
+		}
+	}
+
+	// Ties keep ID order, as rows come out of the database
+	slices.SortFunc(records, func(a, b *actorRecord) int { return cmp.Compare(a.id, b.id) })
+	sortKeys := criteria.Sort
+	if len(sortKeys) == 0 {
+		sortKeys = []actor.SortKey{{Field: criteria.OrderBy, Dir: criteria.OrderDir}}
+	}
+	slices.SortStableFunc(records, func(a, b *actorRecord) int {
+		for _, key := range sortKeys {
+			order := compareActors(a, b, key.Field)
+			if key.Dir == actor.OrderDesc {
+				order = -order
+			}
+			if order != 0 {
+				return order
+			}
+		}
+		return 0
+	})
+
+	records = page(records, criteria.Offset, criteria.Limit)
+	actors := make([]*actor.Actor, 0, len(records))
+	for _, record := range records {
+		domainActor, err := toDomainActor(record)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert to domain model: %w", err)
+		}
+		actors = append(actors, domainActor)
+	}
+	return actors, nil
+}
+
+// matchesActor reports whether an actor meets every filter of the criteria.
+// A movie and a character must both match the same credit.
+func matchesActor(record *actorRecord, criteria actor.SearchCriteria) bool {
+	if !criteria.MovieID.IsZero() || criteria.Character != "" {
+		matched := slices.ContainsFunc(record.credits, func(credit actor.Credit) bool {
+			if !criteria.MovieID.IsZero() && credit.MovieID().Value() != criteria.MovieID.Value() {
+				return false
+			}
+			return criteria.Character == "" || containsFold(credit.Character(), criteria.Character)
+		})
+		if !matched {
+			return false
+		}
+	}
+
+	if len(criteria.IDs) > 0 && !slices.ContainsFunc(criteria.IDs, func(id shared.ActorID) bool { return id.Value() == record.id }) {
+		return false
+	}
+	if criteria.Name != "" && !containsFold(record.name, criteria.Name) {
+		return false
+	}
+	if criteria.MinBirthYear > 0 && record.birthYear < criteria.MinBirthYear {
+		return false
+	}
+	if criteria.MaxBirthYear > 0 && record.birthYear > criteria.MaxBirthYear {
+		return false
+	}
+	if criteria.Nationality != "" && !strings.EqualFold(record.nationality, strings.TrimSpace(criteria.Nationality)) {
+		return false
+	}
+	if criteria.AliveOnly && record.deathYear != 0 {
+		return false
+	}
+	return true
+}
+
+// compareActors orders two actors by a sort field, defaulting to name
+func compareActors(a, b *actorRecord, field actor.OrderBy) int {
+	switch field {
+	case actor.OrderByBirthYear:
+		return cmp.Compare(a.birthYear, b.birthYear)
+	case actor.OrderByCreatedAt:
+		return a.createdAt.Compare(b.createdAt)
+	case actor.OrderByUpdatedAt:
+		return a.updatedAt.Compare(b.updatedAt)
+	default:
+		return strings.Compare(a.name, b.name)
+	}
+}
+
+// FindByName searches actors by name (partial match)
+func (r *ActorRepository) FindByName(ctx context.Context, name string) ([]*actor.Actor, error) {
+	criteria := actor.SearchCriteria{
+		Name:  name,
+		Limit: 100, // Default limit
+	}
+	return r.FindByCriteria(ctx, criteria)
+}
+
+// FindByMovieID retrieves actors who appeared in a specific movie
+func (r *ActorRepository) FindByMovieID(ctx context.Context, movieID shared.MovieID) ([]*actor.Actor, error) {
+	criteria := actor.SearchCriteria{
+		MovieID: movieID,
+		Limit:   100, // Default limit
+	}
+	return r.FindByCriteria(ctx, criteria)
+}
+
+// CountAll returns the total number of actors
+func (r *ActorRepository) CountAll(ctx context.Context) (int, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	return len(r.store.actors), nil
+}
+
+// Delete removes an actor by ID, leaving their movies in place
+func (r *ActorRepository) Delete(ctx context.Context, id shared.ActorID) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+
+	if _, ok := r.store.actors[id.Value()]; !ok {
+		return shared.NewNotFoundError("actor not found")
+	}
+	delete(r.store.actors, id.Value())
+	return nil
+}
+
+// DeleteAll removes all actors (for testing)
+func (r *ActorRepository) DeleteAll(ctx context.Context) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+
+	clear(r.store.actors)
+	return nil
+}
+
+// toActorRecord copies a domain actor into a record
+func toActorRecord(domainActor *actor.Actor) *actorRecord {
+	return &actorRecord{
+		id:          domainActor.ID().Value(),
+		name:        domainActor.Name(),
+		birthYear:   domainActor.BirthYear().Value(),
+		deathYear:   domainActor.DeathYear().Value(),
+		nationality: domainActor.Nationality(),
+		bio:         domainActor.Bio(),
+		bioSections: domainActor.BioSections(),
+		photoURL:    domainActor.PhotoURL(),
+		credits:     domainActor.Credits(),
+		createdAt:   domainActor.CreatedAt(),
+		updatedAt:   domainActor.UpdatedAt(),
+	}
+}
+
+// toDomainActor rebuilds a domain actor from a record
+func toDomainActor(record *actorRecord) (*actor.Actor, error) {
+	actorID, err := shared.NewActorID(record.id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid actor ID: %w", err)
+	}
+
+	domainActor, err := actor.NewActorWithID(actorID, record.name, record.birthYear)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain actor: %w", err)
+	}
+	domainActor.SetBio(record.bio)
+	if record.deathYear != 0 {
+		if err := domainActor.SetDeathYear(record.deathYear); err != nil {
+			return nil, fmt.Errorf("invalid death year: %w", err)
+		}
+	}
+	if record.nationality != "" {
+		if err := domainActor.SetNationality(record.nationality); err != nil {
+			return nil, fmt.Errorf("invalid nationality: %w", err)
+		}
+	}
+	if err := domainActor.SetBioSections(record.bioSections); err != nil {
+		return nil, fmt.Errorf("invalid biography sections: %w", err)
+	}
+	if record.photoURL != "" {
+		if err := domainActor.SetPhotoURL(record.photoURL); err != nil {
+			return nil, fmt.Errorf("invalid photo URL: %w", err)
+		}
+	}
+	for _, credit := range record.credits {
+		if err := domainActor.AddCredit(credit); err != nil {
+			return nil, fmt.Errorf("failed to add movie to actor: %w", err)
+		}
+	}
+	domainActor.MarkEventsAsCommitted()
+	return domainActor, nil
+}
@@ -0,0 +1,30 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/infrastructure/conformance"
+)
+
+// newConformanceRepositories creates repositories over a fresh store
+func newConformanceRepositories(t *testing.T) conformance.Repositories {
+	t.Helper()
+
+	store := NewStore()
+	return conformance.Repositories{
+		Movies: NewMovieRepository(store),
+		Actors: NewActorRepository(store),
+	}
+}
+
+func TestMovieRepositoryConformance(t *testing.T) {
+	conformance.TestMovieRepository(t, newConformanceRepositories)
+}
+
+func TestActorRepositoryConformance(t *testing.T) {
+	conformance.TestActorRepository(t, newConformanceRepositories)
+}
+
+func TestReferentialIntegrityConformance(t *testing.T) {
+	conformance.TestReferentialIntegrity(t, newConformanceRepositories)
+}
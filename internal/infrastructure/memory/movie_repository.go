@@ -0,0 +1,372 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// MovieRepository implements the movie.Repository interface in memory
+type MovieRepository struct {
+	store *Store
+}
+
+// NewMovieRepository creates a movie repository over a store
+func NewMovieRepository(store *Store) *MovieRepository {
+	return &MovieRepository{store: store}
+}
+
+// Save persists a movie (insert or update)
+func (r *MovieRepository) Save(ctx context.Context, domainMovie *movie.Movie) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+
+	if domainMovie.ID().IsZero() {
+		return r.insert(domainMovie)
+	}
+
+	existing, ok := r.store.movies[domainMovie.ID().Value()]
+	if !ok {
+		return shared.NewNotFoundError("movie not found")
+	}
+	record := toMovieRecord(domainMovie)
+	record.createdAt = existing.createdAt
+	r.store.movies[record.id] = record
+	return nil
+}
+
+// InsertAll persists new movies in one go, setting their IDs; either all are
+// inserted or none are
+func (r *MovieRepository) InsertAll(ctx context.Context, movies []*movie.Movie) error {
+	for _, domainMovie := range movies {
+		if !domainMovie.ID().IsZero() {
+			return shared.NewConflictError("movie %d already exists", domainMovie.ID().Value())
+		}
+	}
+
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+
+	for _, domainMovie := range movies {
+		if err := r.insert(domainMovie); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateAll persists changes to existing movies in one go; either all are
+// updated or none are
+func (r *MovieRepository) UpdateAll(ctx context.Context, movies []*movie.Movie) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+
+	for _, domainMovie := range movies {
+		if domainMovie.ID().IsZero() {
+			return shared.NewValidationError("movie %q has no ID to update", domainMovie.Title())
+		}
+		if _, ok := r.store.movies[domainMovie.ID().Value()]; !ok {
+			return shared.NewNotFoundError("movie not found")
+		}
+	}
+	for _, domainMovie := range movies {
+		record := toMovieRecord(domainMovie)
+		record.createdAt = r.store.movies[record.id].createdAt
+		r.store.movies[record.id] = record
+	}
+	return nil
+}
+
+// insert stores a new movie under the next ID; the caller holds the lock
+func (r *MovieRepository) insert(domainMovie *movie.Movie) error {
+	id, err := shared.NewMovieID(r.store.nextMovieID)
+	if err != nil {
+		return fmt.Errorf("failed to create movie ID: %w", err)
+	}
+	r.store.nextMovieID++
+
+	domainMovie.SetID(id)
+	record := toMovieRecord(domainMovie)
+	r.store.movies[record.id] = record
+	return nil
+}
+
+// FindByID retrieves a movie by its ID
+func (r *MovieRepository) FindByID(ctx context.Context, id shared.MovieID) (*movie.Movie, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	record, ok := r.store.movies[id.Value()]
+	if !ok {
+		return nil, shared.NewNotFoundError("movie not found")
+	}
+	return toDomainMovie(record)
+}
+
+// FindByCriteria retrieves movies based on search criteria
+func (r *MovieRepository) FindByCriteria(ctx context.Context, criteria movie.SearchCriteria) ([]*movie.Movie, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	var records []*movieRecord
+	for _, record := range r.store.movies {
+		if matchesMovie(record, criteria) {
+			records = append(records, record)
+		}
+	}
+
+	// Ties keep ID order, as rows come out of the database
+	slices.SortFunc(records, func(a, b *movieRecord) int { return cmp.Compare(a.id, b.id) })
+	sortKeys := criteria.Sort
+	if len(sortKeys) == 0 {
+		sortKeys = []movie.SortKey{{Field: criteria.OrderBy, Dir: criteria.OrderDir}}
+	}
+	slices.SortStableFunc(records, func(a, b *movieRecord) int {
+		for _, key := range sortKeys {
+			order := compareMovies(a, b, key.Field)
+			if key.Dir == movie.OrderDesc {
+				order = -order
+			}
+			if order != 0 {
+				return order
+			}
+		}
+		return 0
+	})
+
+	records = page(records, criteria.Offset, criteria.Limit)
+	movies := make([]*movie.Movie, 0, len(records))
+	for _, record := range records {
+		domainMovie, err := toDomainMovie(record)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert to domain model: %w", err)
+		}
+		movies = append(movies, domainMovie)
+	}
+	return movies, nil
+}
+
+// matchesMovie reports whether a movie meets every filter of the criteria.
+// Text matches ignore case, except genres, which must match whole values.
+func matchesMovie(record *movieRecord, criteria movie.SearchCriteria) bool {
+	if len(criteria.IDs) > 0 && !slices.ContainsFunc(criteria.IDs, func(id shared.MovieID) bool { return id.Value() == record.id }) {
+		return false
+	}
+	if criteria.AfterID > 0 && record.id <= criteria.AfterID {
+		return false
+	}
+	if criteria.Title != "" && !containsFold(record.title, criteria.Title) {
+		return false
+	}
+	if criteria.Director != "" && !containsFold(record.director, criteria.Director) {
+		return false
+	}
+	if criteria.Genre != "" && !slices.Contains(record.genres, criteria.Genre) {
+		return false
+	}
+
+	minYear, maxYear := criteria.YearRange()
+	if minYear > 0 && record.year < minYear {
+		return false
+	}
+	if maxYear > 0 && record.year > maxYear {
+		return false
+	}
+
+	// Within the years, movies with a full release date must fall between
+	// the dates; year-only movies match the whole year
+	if !record.released.IsZero() {
+		if !criteria.ReleasedAfter.IsZero() && record.released.Before(dateOf(criteria.ReleasedAfter)) {
+			return false
+		}
+		if !criteria.ReleasedBefore.IsZero() && record.released.After(dateOf(criteria.ReleasedBefore)) {
+			return false
+		}
+	}
+
+	// An unrated movie or unknown runtime fails every bound on it
+	if criteria.MinRating > 0 && (record.rating == 0 || record.rating < criteria.MinRating) {
+		return false
+	}
+	if criteria.MaxRating > 0 && (record.rating == 0 || record.rating > criteria.MaxRating) {
+		return false
+	}
+	if criteria.MinDuration > 0 && (record.duration == 0 || record.duration < criteria.MinDuration) {
+		return false
+	}
+	if criteria.MaxDuration > 0 && (record.duration == 0 || record.duration > criteria.MaxDuration) {
+		return false
+	}
+
+	if criteria.CertificationRegion != "" && len(criteria.Certifications) > 0 {
+		certification, ok := record.certifications[criteria.CertificationRegion]
+		if !ok || !slices.Contains(criteria.Certifications, certification) {
+			return false
+		}
+	}
+	for _, warning := range criteria.ExcludeWarnings {
+		if slices.Contains(record.contentWarnings, warning) {
+			return false
+		}
+	}
+	return true
+}
+
+// compareMovies orders two movies by a sort field, defaulting to title
+func compareMovies(a, b *movieRecord, field movie.OrderBy) int {
+	switch field {
+	case movie.OrderByDirector:
+		return strings.Compare(a.director, b.director)
+	case movie.OrderByYear:
+		return cmp.Compare(a.year, b.year)
+	case movie.OrderByRating:
+		return cmp.Compare(a.rating, b.rating)
+	case movie.OrderByCreatedAt:
+		return a.createdAt.Compare(b.createdAt)
+	case movie.OrderByUpdatedAt:
+		return a.updatedAt.Compare(b.updatedAt)
+	case movie.OrderByID:
+		return cmp.Compare(a.id, b.id)
+	default:
+		return strings.Compare(a.title, b.title)
+	}
+}
+
+// FindByTitle searches movies by title (partial match)
+func (r *MovieRepository) FindByTitle(ctx context.Context, title string) ([]*movie.Movie, error) {
+	criteria := movie.SearchCriteria{
+		Title: title,
+		Limit: 100, // Default limit
+	}
+	return r.FindByCriteria(ctx, criteria)
+}
+
+// FindByDirector retrieves movies by director
+func (r *MovieRepository) FindByDirector(ctx context.Context, director string) ([]*movie.Movie, error) {
+	criteria := movie.SearchCriteria{
+		Director: director,
+		Limit:    100, // Default limit
+	}
+	return r.FindByCriteria(ctx, criteria)
+}
+
+// FindByGenre retrieves movies that have a specific genre
+func (r *MovieRepository) FindByGenre(ctx context.Context, genre string) ([]*movie.Movie, error) {
+	criteria := movie.SearchCriteria{
+		Genre: genre,
+		Limit: 100, // Default limit
+	}
+	return r.FindByCriteria(ctx, criteria)
+}
+
+// FindTopRated retrieves top-rated movies
+func (r *MovieRepository) FindTopRated(ctx context.Context, limit int) ([]*movie.Movie, error) {
+	criteria := movie.SearchCriteria{
+		MinRating: 0.1, // Only movies with ratings
+		Limit:     limit,
+		OrderBy:   movie.OrderByRating,
+		OrderDir:  movie.OrderDesc,
+	}
+	return r.FindByCriteria(ctx, criteria)
+}
+
+// CountAll returns the total number of movies
+func (r *MovieRepository) CountAll(ctx context.Context) (int, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	return len(r.store.movies), nil
+}
+
+// Delete removes a movie by ID, along with its actors' credits for it
+func (r *MovieRepository) Delete(ctx context.Context, id shared.MovieID) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+
+	if _, ok := r.store.movies[id.Value()]; !ok {
+		return shared.NewNotFoundError("movie not found")
+	}
+	delete(r.store.movies, id.Value())
+	r.store.removeCredits(id.Value())
+	return nil
+}
+
+// DeleteAll removes all movies and every credit (for testing)
+func (r *MovieRepository) DeleteAll(ctx context.Context) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+
+	clear(r.store.movies)
+	for _, record := range r.store.actors {
+		record.credits = nil
+	}
+	return nil
+}
+
+// toMovieRecord copies a domain movie into a record
+func toMovieRecord(domainMovie *movie.Movie) *movieRecord {
+	return &movieRecord{
+		id:              domainMovie.ID().Value(),
+		title:           domainMovie.Title(),
+		director:        domainMovie.Director(),
+		year:            domainMovie.Year().Value(),
+		released:        domainMovie.ReleaseDate(),
+		rating:          domainMovie.Rating().Value(),
+		duration:        domainMovie.Duration(),
+		genres:          domainMovie.Genres(),
+		posterURL:       domainMovie.PosterURL(),
+		certifications:  domainMovie.Certifications(),
+		contentWarnings: domainMovie.ContentWarnings(),
+		createdAt:       domainMovie.CreatedAt(),
+		updatedAt:       domainMovie.UpdatedAt(),
+	}
+}
+
+// toDomainMovie rebuilds a domain movie from a record
+func toDomainMovie(record *movieRecord) (*movie.Movie, error) {
+	movieID, err := shared.NewMovieID(record.id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid movie ID: %w", err)
+	}
+
+	domainMovie, err := movie.NewMovieWithID(movieID, record.title, record.director, record.year)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain movie: %w", err)
+	}
+	if err := domainMovie.SetReleaseDate(record.released); err != nil {
+		return nil, fmt.Errorf("failed to set release date: %w", err)
+	}
+	if record.rating > 0 {
+		if err := domainMovie.SetRating(record.rating); err != nil {
+			return nil, fmt.Errorf("failed to set rating: %w", err)
+		}
+	}
+	if err := domainMovie.SetDuration(record.duration); err != nil {
+		return nil, fmt.Errorf("failed to set duration: %w", err)
+	}
+	for _, genre := range record.genres {
+		if err := domainMovie.AddGenre(genre); err != nil {
+			return nil, fmt.Errorf("failed to add genre: %w", err)
+		}
+	}
+	if err := domainMovie.SetPosterURL(record.posterURL); err != nil {
+		return nil, fmt.Errorf("failed to set poster URL: %w", err)
+	}
+	for region, certification := range record.certifications {
+		if err := domainMovie.SetCertification(region, certification); err != nil {
+			return nil, fmt.Errorf("failed to set certification: %w", err)
+		}
+	}
+	for _, warning := range record.contentWarnings {
+		if err := domainMovie.AddContentWarning(warning); err != nil {
+			return nil, fmt.Errorf("failed to add content warning: %w", err)
+		}
+	}
+	domainMovie.MarkEventsAsCommitted()
+	return domainMovie, nil
+}
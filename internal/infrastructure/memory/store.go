@@ -0,0 +1,107 @@
+// Package memory implements the repositories over maps held in memory. It
+// needs no database, so it backs the server's demo mode and tests; nothing
+// is kept once the process exits.
+//
+// Repositories keep copies of the aggregates they are given and hand out
+// fresh copies, so callers never share state with the store or with each
+// other.
+package memory
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/actor"
+)
+
+// Store holds the movies and actors that its repositories share. It is safe
+// for concurrent use; every write happens under one lock, so a write is
+// atomic with respect to every other read and write.
+type Store struct {
+	mutex       sync.RWMutex
+	movies      map[int]*movieRecord
+	actors      map[int]*actorRecord
+	nextMovieID int
+	nextActorID int
+}
+
+// NewStore creates an empty store
+func NewStore() *Store {
+	return &Store{
+		movies:      make(map[int]*movieRecord),
+		actors:      make(map[int]*actorRecord),
+		nextMovieID: 1,
+		nextActorID: 1,
+	}
+}
+
+// movieRecord is a stored movie
+type movieRecord struct {
+	id              int
+	title           string
+	director        string
+	year            int
+	released        time.Time // Zero when only the year is known
+	rating          float64   // Zero when unrated
+	duration        int       // Zero when unknown
+	genres          []string
+	posterURL       string
+	certifications  map[string]string
+	contentWarnings []string
+	createdAt       time.Time
+	updatedAt       time.Time
+}
+
+// actorRecord is a stored actor with their credits
+type actorRecord struct {
+	id          int
+	name        string
+	birthYear   int
+	deathYear   int // Zero while the actor is alive
+	nationality string
+	bio         string
+	bioSections []actor.BioSection
+	photoURL    string
+	credits     []actor.Credit
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// removeCredits drops every credit for a movie, as deleting a movie does
+func (s *Store) removeCredits(movieID int) {
+	for _, record := range s.actors {
+		kept := record.credits[:0]
+		for _, credit := range record.credits {
+			if credit.MovieID().Value() != movieID {
+				kept = append(kept, credit)
+			}
+		}
+		record.credits = kept
+	}
+}
+
+// page applies an offset and a limit to search results; a limit of zero
+// or less means no limit
+func page[T any](results []T, offset, limit int) []T {
+	if offset > 0 {
+		if offset >= len(results) {
+			return nil
+		}
+		results = results[offset:]
+	}
+	if limit > 0 && limit < len(results) {
+		results = results[:limit]
+	}
+	return results
+}
+
+// containsFold reports whether s contains substr, ignoring case
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+// dateOf returns midnight UTC on the day of t, as release dates are stored
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
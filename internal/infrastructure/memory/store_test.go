@@ -0,0 +1,125 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/actor"
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+)
+
+func TestStore_ConcurrentWritesAndReads(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	movies := NewMovieRepository(store)
+	actors := NewActorRepository(store)
+
+	const workers, perWorker = 8, 25
+	var wg sync.WaitGroup
+	errs := make(chan error, workers*perWorker)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				domainMovie, err := movie.NewMovie(fmt.Sprintf("Movie %d-%d", w, i), "Director", 2000)
+				if err != nil {
+					errs <- err
+					return
+				}
+				if err := movies.Save(ctx, domainMovie); err != nil {
+					errs <- err
+					return
+				}
+
+				domainActor, err := actor.NewActor(fmt.Sprintf("Actor %d-%d", w, i), 1970)
+				if err != nil {
+					errs <- err
+					return
+				}
+				if err := domainActor.AddMovie(domainMovie.ID()); err != nil {
+					errs <- err
+					return
+				}
+				if err := actors.Save(ctx, domainActor); err != nil {
+					errs <- err
+					return
+				}
+
+				if _, err := movies.FindByCriteria(ctx, movie.SearchCriteria{Director: "director", Limit: 10}); err != nil {
+					errs <- err
+					return
+				}
+				if _, err := actors.FindByMovieID(ctx, domainMovie.ID()); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatalf("concurrent access failed: %v", err)
+	}
+
+	movieCount, _ := movies.CountAll(ctx)
+	actorCount, _ := actors.CountAll(ctx)
+	if movieCount != workers*perWorker || actorCount != workers*perWorker {
+		t.Errorf("counts = %d movies, %d actors, want %d of each", movieCount, actorCount, workers*perWorker)
+	}
+
+	// IDs are handed out exactly once
+	seen := make(map[int]bool)
+	all, err := movies.FindByCriteria(ctx, movie.SearchCriteria{})
+	if err != nil {
+		t.Fatalf("FindByCriteria() error = %v", err)
+	}
+	for _, m := range all {
+		if seen[m.ID().Value()] {
+			t.Errorf("movie ID %d assigned twice", m.ID().Value())
+		}
+		seen[m.ID().Value()] = true
+	}
+}
+
+func TestStore_KeepsCopies(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMovieRepository(NewStore())
+
+	saved, err := movie.NewMovie("The Matrix", "The Wachowskis", 1999)
+	if err != nil {
+		t.Fatalf("NewMovie() error = %v", err)
+	}
+	if err := saved.AddGenre("Sci-Fi"); err != nil {
+		t.Fatalf("AddGenre() error = %v", err)
+	}
+	if err := repo.Save(ctx, saved); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	// Changing the saved movie or a found one leaves the store untouched
+	if err := saved.AddGenre("Action"); err != nil {
+		t.Fatalf("AddGenre() error = %v", err)
+	}
+	found, err := repo.FindByID(ctx, saved.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if err := found.SetRating(9); err != nil {
+		t.Fatalf("SetRating() error = %v", err)
+	}
+
+	again, err := repo.FindByID(ctx, saved.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if genres := again.Genres(); len(genres) != 1 || genres[0] != "Sci-Fi" {
+		t.Errorf("Genres() = %v, want [Sci-Fi]", genres)
+	}
+	if !again.Rating().IsZero() {
+		t.Errorf("Rating() = %v, want unrated", again.Rating().Value())
+	}
+}
@@ -0,0 +1,114 @@
+// Package remote reads the library of another movies-mcp-server over MCP,
+// through the same resources any client reads it with.
+package remote
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// firstPageURI is the first page of the remote library, one movie per line
+const firstPageURI = "movies://database/all?format=ndjson"
+
+// Library is the library of a remote server reached over an MCP session
+type Library struct {
+	session *mcp.ClientSession
+}
+
+// NewLibrary reads the library of the server at the other end of session
+func NewLibrary(session *mcp.ClientSession) *Library {
+	return &Library{
+		session: session,
+	}
+}
+
+// Dial starts command, which must run a movies-mcp-server on its stdin and
+// stdout, e.g. "ssh media-box movies-mcp-server-sdk", and connects to it.
+// The server's stderr is discarded.
+func Dial(ctx context.Context, command []string, version string) (*Library, error) {
+	if len(command) == 0 {
+		return nil, shared.NewUnavailableError("no remote server command is configured")
+	}
+
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Stderr = io.Discard
+	client := mcp.NewClient(&mcp.Implementation{Name: "movies-mcp-server-sync", Version: version}, nil)
+	session, err := client.Connect(ctx, &mcp.CommandTransport{Command: cmd}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start %s: %w", command[0], err)
+	}
+	return NewLibrary(session), nil
+}
+
+// EachMovie passes every remote movie to fn in ID order, reading a page at a
+// time and following each page's next link
+func (l *Library) EachMovie(ctx context.Context, fn func(*movieApp.MovieDTO) error) error {
+	for uri := firstPageURI; uri != ""; {
+		page, err := l.session.ReadResource(ctx, &mcp.ReadResourceParams{URI: uri})
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", uri, err)
+		}
+		if len(page.Contents) == 0 {
+			return fmt.Errorf("%s has no contents", uri)
+		}
+
+		scanner := bufio.NewScanner(strings.NewReader(page.Contents[0].Text))
+		scanner.Buffer(nil, 1024*1024)
+		for scanner.Scan() {
+			var movie movieApp.MovieDTO
+			if err := json.Unmarshal(scanner.Bytes(), &movie); err != nil {
+				return fmt.Errorf("invalid movie in %s: %w", uri, err)
+			}
+			if err := fn(&movie); err != nil {
+				return err
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return fmt.Errorf("failed to read %s: %w", uri, err)
+		}
+
+		next, _ := page.Meta["next"].(string)
+		if next == uri {
+			return fmt.Errorf("%s links to itself", uri)
+		}
+		uri = next
+	}
+	return nil
+}
+
+// Poster returns a remote movie's stored poster image; it fails with
+// shared.ErrNotFound if the movie has none
+func (l *Library) Poster(ctx context.Context, movieID int) ([]byte, string, error) {
+	uri := "movies://posters/" + strconv.Itoa(movieID)
+	result, err := l.session.ReadResource(ctx, &mcp.ReadResourceParams{URI: uri})
+	if errors.Is(err, mcp.ResourceNotFoundError(uri)) {
+		return nil, "", shared.NewNotFoundError("remote movie %d has no poster image", movieID)
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read %s: %w", uri, err)
+	}
+	if len(result.Contents) > 0 && result.Contents[0].MIMEType == "text/uri-list" {
+		return nil, "", fmt.Errorf("%s is served from a CDN rather than as image data", uri)
+	}
+	if len(result.Contents) == 0 || len(result.Contents[0].Blob) == 0 {
+		return nil, "", fmt.Errorf("%s has no image", uri)
+	}
+	return result.Contents[0].Blob, result.Contents[0].MIMEType, nil
+}
+
+// Close ends the session, stopping a server Dial started
+func (l *Library) Close() error {
+	return l.session.Close()
+}
@@ -0,0 +1,121 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	posterApp "github.com/francknouama/movies-mcp-server/internal/application/poster"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/infrastructure/memory"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/resources"
+)
+
+// posterReader serves one movie's poster
+type posterReader struct {
+	movieID int
+	data    []byte
+}
+
+func (p *posterReader) GetPoster(ctx context.Context, movieID int) (*posterApp.PosterImageDTO, error) {
+	if movieID != p.movieID {
+		return nil, shared.NewNotFoundError("poster not found")
+	}
+	return &posterApp.PosterImageDTO{PosterDTO: posterApp.PosterDTO{MovieID: movieID, MimeType: "image/png"}, Data: p.data}, nil
+}
+
+// connect serves movies, two to a page, and a poster of the first, and
+// returns the library of that server
+func connect(t *testing.T, titles ...string) *Library {
+	t.Helper()
+	ctx := context.Background()
+
+	movieService := movieApp.NewService(memory.NewMovieRepository(memory.NewStore()))
+	var firstID int
+	for i, title := range titles {
+		movie, err := movieService.CreateMovie(ctx, movieApp.CreateMovieCommand{Title: title, Director: "Someone", Year: 1990 + i})
+		if err != nil {
+			t.Fatalf("failed to create movie: %v", err)
+		}
+		if firstID == 0 {
+			firstID = movie.ID
+		}
+	}
+
+	dbResources := resources.NewDatabaseResources(movieService)
+	dbResources.SetMaxPageSize(2)
+	posterResources := resources.NewMoviePosterResources(&posterReader{movieID: firstID, data: []byte("png")})
+	server := mcp.NewServer(&mcp.Implementation{Name: "remote", Version: "test"}, nil)
+	server.AddResource(dbResources.AllMoviesResource(), dbResources.HandleAllMovies)
+	server.AddResourceTemplate(dbResources.AllMoviesTemplate(), dbResources.HandleAllMovies)
+	server.AddResourceTemplate(posterResources.PosterTemplate(), posterResources.HandlePoster)
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	if _, err := server.Connect(ctx, serverTransport, nil); err != nil {
+		t.Fatalf("failed to start server: %v", err)
+	}
+	session, err := mcp.NewClient(&mcp.Implementation{Name: "sync", Version: "test"}, nil).Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	library := NewLibrary(session)
+	t.Cleanup(func() { library.Close() })
+	return library
+}
+
+func TestLibrary_EachMovieFollowsPages(t *testing.T) {
+	library := connect(t, "Alien", "Brazil", "Casablanca", "Dune", "Eraserhead")
+
+	var titles []string
+	err := library.EachMovie(context.Background(), func(movie *movieApp.MovieDTO) error {
+		titles = append(titles, movie.Title)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Expected the movies to be read, got %v", err)
+	}
+	if len(titles) != 5 || titles[0] != "Alien" || titles[4] != "Eraserhead" {
+		t.Errorf("Expected every movie across 3 pages in ID order, got %v", titles)
+	}
+}
+
+func TestLibrary_EachMovieStopsOnError(t *testing.T) {
+	library := connect(t, "Alien", "Brazil", "Casablanca")
+
+	stop := errors.New("stop")
+	count := 0
+	err := library.EachMovie(context.Background(), func(movie *movieApp.MovieDTO) error {
+		count++
+		return stop
+	})
+	if !errors.Is(err, stop) || count != 1 {
+		t.Errorf("Expected the first movie's error, got %v after %d movies", err, count)
+	}
+}
+
+func TestLibrary_Poster(t *testing.T) {
+	library := connect(t, "Alien", "Brazil")
+
+	var ids []int
+	_ = library.EachMovie(context.Background(), func(movie *movieApp.MovieDTO) error {
+		ids = append(ids, movie.ID)
+		return nil
+	})
+
+	data, mimeType, err := library.Poster(context.Background(), ids[0])
+	if err != nil || string(data) != "png" || mimeType != "image/png" {
+		t.Errorf("Expected the stored poster, got %q %q, %v", data, mimeType, err)
+	}
+	if _, _, err := library.Poster(context.Background(), ids[1]); !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("Expected a movie without a poster not to be found, got %v", err)
+	}
+}
+
+func TestDial_RequiresCommand(t *testing.T) {
+	if _, err := Dial(context.Background(), nil, "test"); !errors.Is(err, shared.ErrUnavailable) {
+		t.Errorf("Expected no command to be unavailable, got %v", err)
+	}
+}
@@ -0,0 +1,155 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/popularity"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+// AccessRepository implements the popularity.Repository interface for SQLite
+type AccessRepository struct {
+	*database.BaseRepository
+	txManager *database.TransactionManager
+}
+
+// NewAccessRepository creates a new SQLite movie access repository
+func NewAccessRepository(db *sql.DB) *AccessRepository {
+	return &AccessRepository{
+		BaseRepository: database.NewBaseRepository(db),
+		txManager:      database.NewTransactionManager(db),
+	}
+}
+
+// recordAccessQuery adds to a movie's counters for a day, creating them on
+// its first access that day. Selecting from movies skips movies deleted
+// since they were read instead of failing the whole batch.
+const recordAccessQuery = `
+	INSERT INTO movie_access (movie_id, day, views, search_hits)
+	SELECT id, ?, ?, ? FROM movies WHERE id = ?
+	ON CONFLICT (movie_id, day) DO UPDATE SET
+		views = views + excluded.views,
+		search_hits = search_hits + excluded.search_hits`
+
+// Record adds one access of kind to the counter of each movie on day, in
+// one transaction
+func (r *AccessRepository) Record(ctx context.Context, day time.Time, kind popularity.Access, ids []shared.MovieID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	views, searchHits := 0, 0
+	switch kind {
+	case popularity.AccessView:
+		views = 1
+	case popularity.AccessSearch:
+		searchHits = 1
+	default:
+		return fmt.Errorf("unknown access kind %q", kind)
+	}
+
+	return writeTransaction(ctx, r.txManager, func(tx *sql.Tx) error {
+		record, err := tx.PrepareContext(ctx, recordAccessQuery)
+		if err != nil {
+			return fmt.Errorf("failed to prepare access record: %w", err)
+		}
+		defer record.Close()
+
+		for _, id := range ids {
+			if _, err := record.ExecContext(ctx, accessDay(day), views, searchHits, id.Value()); err != nil {
+				return fmt.Errorf("failed to record movie access: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// Counts sums the counters of the given movies from since onwards
+func (r *AccessRepository) Counts(ctx context.Context, since time.Time, ids []shared.MovieID) (map[shared.MovieID]popularity.Counts, error) {
+	counts := make(map[shared.MovieID]popularity.Counts, len(ids))
+	if len(ids) == 0 {
+		return counts, nil
+	}
+
+	query := `
+		SELECT movie_id, SUM(views), SUM(search_hits)
+		FROM movie_access
+		WHERE day >= ? AND movie_id IN (` + placeholders(len(ids)) + `)
+		GROUP BY movie_id`
+	args := make([]any, 0, len(ids)+1)
+	args = append(args, accessDay(since))
+	for _, id := range ids {
+		args = append(args, id.Value())
+	}
+
+	found, err := r.queryCounts(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	for _, movieCounts := range found {
+		counts[movieCounts.MovieID] = movieCounts
+	}
+	return counts, nil
+}
+
+// Top returns up to limit movies with the highest score from since onwards;
+// equal scores are ordered by movie ID
+func (r *AccessRepository) Top(ctx context.Context, since time.Time, limit int) ([]popularity.Counts, error) {
+	query := `
+		SELECT movie_id, SUM(views), SUM(search_hits)
+		FROM movie_access
+		WHERE day >= ?
+		GROUP BY movie_id
+		ORDER BY SUM(views) + ? * SUM(search_hits) DESC, movie_id ASC
+		LIMIT ?`
+	return r.queryCounts(ctx, query, accessDay(since), popularity.SearchHitWeight, limit)
+}
+
+// PurgeBefore deletes the counters of days before cutoff's day and returns
+// how many it deleted
+func (r *AccessRepository) PurgeBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	var deleted int64
+	err := retryOnBusy(ctx, func() error {
+		result, err := r.ExecContext(ctx, "DELETE FROM movie_access WHERE day < ?", accessDay(cutoff))
+		if err != nil {
+			return fmt.Errorf("failed to purge movie access: %w", err)
+		}
+		deleted, _ = result.RowsAffected()
+		return nil
+	})
+	return int(deleted), err
+}
+
+// queryCounts scans rows of movie ID, views and search hits
+func (r *AccessRepository) queryCounts(ctx context.Context, query string, args ...any) ([]popularity.Counts, error) {
+	rows, err := r.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query movie access: %w", err)
+	}
+	defer rows.Close()
+
+	var counts []popularity.Counts
+	for rows.Next() {
+		var id int
+		var movieCounts popularity.Counts
+		if err := rows.Scan(&id, &movieCounts.Views, &movieCounts.SearchHits); err != nil {
+			return nil, fmt.Errorf("failed to scan movie access: %w", err)
+		}
+		if movieCounts.MovieID, err = shared.NewMovieID(id); err != nil {
+			return nil, fmt.Errorf("failed to create movie ID: %w", err)
+		}
+		counts = append(counts, movieCounts)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query movie access: %w", err)
+	}
+	return counts, nil
+}
+
+// accessDay formats the UTC day counters are stored under
+func accessDay(t time.Time) string {
+	return popularity.Day(t).Format(time.DateOnly)
+}
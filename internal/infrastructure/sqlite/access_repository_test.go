@@ -0,0 +1,95 @@
+package sqlite
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/popularity"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+func TestAccessRepository_RecordAndRead(t *testing.T) {
+	db := setupStatsTestDB(t)
+	movies := NewMovieRepository(db)
+	access := NewAccessRepository(db)
+	ctx := context.Background()
+
+	var ids []shared.MovieID
+	for _, title := range []string{"Heat", "Collateral", "Alien"} {
+		m := newStatsTestMovie(t, title, "Michael Mann", 1995, 8)
+		if err := movies.Save(ctx, m); err != nil {
+			t.Fatalf("failed to save movie: %v", err)
+		}
+		ids = append(ids, m.ID())
+	}
+	heat, collateral, alien := ids[0], ids[1], ids[2]
+
+	lastWeek := time.Date(2026, 3, 7, 12, 0, 0, 0, time.UTC)
+	today := time.Date(2026, 3, 14, 9, 0, 0, 0, time.UTC)
+	records := []struct {
+		day  time.Time
+		kind popularity.Access
+		ids  []shared.MovieID
+	}{
+		{lastWeek, popularity.AccessView, []shared.MovieID{alien, alien, alien}},
+		{today, popularity.AccessView, []shared.MovieID{heat}},
+		{today.Add(time.Hour), popularity.AccessView, []shared.MovieID{heat}},
+		{today, popularity.AccessSearch, []shared.MovieID{heat, collateral, testMovieID(999)}},
+		{today, popularity.AccessSearch, []shared.MovieID{collateral}},
+	}
+	for _, record := range records {
+		if err := access.Record(ctx, record.day, record.kind, record.ids); err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+	}
+
+	counts, err := access.Counts(ctx, today, []shared.MovieID{heat, collateral, alien})
+	if err != nil {
+		t.Fatalf("Counts() error = %v", err)
+	}
+	want := map[shared.MovieID]popularity.Counts{
+		heat:       {MovieID: heat, Views: 2, SearchHits: 1},
+		collateral: {MovieID: collateral, SearchHits: 2},
+	}
+	if !reflect.DeepEqual(counts, want) {
+		t.Errorf("Expected today's counts %+v, got %+v", want, counts)
+	}
+
+	top, err := access.Top(ctx, lastWeek, 10)
+	if err != nil {
+		t.Fatalf("Top() error = %v", err)
+	}
+	if len(top) != 3 || top[0].MovieID != alien || top[1].MovieID != heat || top[2].MovieID != collateral {
+		t.Errorf("Expected Alien, Heat then Collateral over the week, got %+v", top)
+	}
+	if top, err := access.Top(ctx, today, 1); err != nil || len(top) != 1 || top[0].MovieID != heat {
+		t.Errorf("Expected Heat alone on top today, got %+v (error %v)", top, err)
+	}
+	deleted, err := access.PurgeBefore(ctx, today)
+	if err != nil || deleted != 1 {
+		t.Errorf("Expected last week's counter purged, got %d (error %v)", deleted, err)
+	}
+	if top, _ := access.Top(ctx, lastWeek, 10); len(top) != 2 || top[0].MovieID != heat {
+		t.Errorf("Expected only today's counters after the purge, got %+v", top)
+	}
+}
+
+func TestAccessRepository_RecordSkipsUnknownMovies(t *testing.T) {
+	db := setupStatsTestDB(t)
+	access := NewAccessRepository(db)
+	ctx := context.Background()
+
+	// A movie deleted after a search read it must not fail the record
+	if err := access.Record(ctx, time.Now(), popularity.AccessView, []shared.MovieID{testMovieID(42)}); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+	var rows int
+	if err := db.QueryRow("SELECT COUNT(*) FROM movie_access").Scan(&rows); err != nil || rows != 0 {
+		t.Errorf("Expected no counters, got %d (error %v)", rows, err)
+	}
+	if err := access.Record(ctx, time.Now(), popularity.Access("like"), []shared.MovieID{testMovieID(42)}); err == nil {
+		t.Error("Expected an unknown access kind to be rejected")
+	}
+}
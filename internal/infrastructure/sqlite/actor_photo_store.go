@@ -0,0 +1,105 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/actor"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+// ActorPhotoStore implements the actor.PhotoStore interface for SQLite,
+// keeping images in the photo_data and photo_type columns of actors
+type ActorPhotoStore struct {
+	*database.BaseRepository
+}
+
+// NewActorPhotoStore creates a new SQLite actor photo store
+func NewActorPhotoStore(db *sql.DB) *ActorPhotoStore {
+	return &ActorPhotoStore{
+		BaseRepository: database.NewBaseRepository(db),
+	}
+}
+
+// SavePhoto stores an actor's photo image, replacing any it had
+func (s *ActorPhotoStore) SavePhoto(ctx context.Context, actorID shared.ActorID, data []byte, mimeType string) error {
+	query := "UPDATE actors SET photo_data = ?, photo_type = ? WHERE id = ?"
+	return retryOnBusy(ctx, func() error {
+		return notFound(s.Update(ctx, query, "actor", data, mimeType, actorID.Value()))
+	})
+}
+
+// FindPhoto returns an actor's photo image
+func (s *ActorPhotoStore) FindPhoto(ctx context.Context, actorID shared.ActorID) (*actor.Photo, error) {
+	query := `
+		SELECT name, photo_type, photo_data
+		FROM actors
+		WHERE id = ? AND photo_data IS NOT NULL`
+
+	photo := &actor.Photo{ActorID: actorID}
+	var mimeType sql.NullString
+	err := s.QueryRowContext(ctx, query, actorID.Value()).Scan(&photo.Name, &mimeType, &photo.Data)
+	if err != nil {
+		return nil, notFound(s.WrapNotFound(err, "actor photo"))
+	}
+	photo.MimeType = mimeType.String
+	return photo, nil
+}
+
+// ListPhotos describes every stored photo image, by actor name
+func (s *ActorPhotoStore) ListPhotos(ctx context.Context) ([]actor.PhotoInfo, error) {
+	query := `
+		SELECT id, name, photo_type, LENGTH(photo_data)
+		FROM actors
+		WHERE photo_data IS NOT NULL
+		ORDER BY name, id`
+
+	rows, err := s.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list actor photos: %w", err)
+	}
+	defer rows.Close()
+
+	var photos []actor.PhotoInfo
+	for rows.Next() {
+		var id int
+		var info actor.PhotoInfo
+		var mimeType sql.NullString
+		if err := rows.Scan(&id, &info.Name, &mimeType, &info.Size); err != nil {
+			return nil, fmt.Errorf("failed to scan actor photo: %w", err)
+		}
+		if info.ActorID, err = shared.NewActorID(id); err != nil {
+			return nil, fmt.Errorf("failed to create actor ID: %w", err)
+		}
+		info.MimeType = mimeType.String
+		photos = append(photos, info)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list actor photos: %w", err)
+	}
+	return photos, nil
+}
+
+// DeletePhoto removes an actor's photo image, reporting whether it had one
+func (s *ActorPhotoStore) DeletePhoto(ctx context.Context, actorID shared.ActorID) (bool, error) {
+	query := `
+		UPDATE actors SET photo_data = NULL, photo_type = NULL
+		WHERE id = ? AND photo_data IS NOT NULL`
+
+	var deleted bool
+	err := retryOnBusy(ctx, func() error {
+		result, err := s.ExecContext(ctx, query, actorID.Value())
+		if err != nil {
+			return fmt.Errorf("failed to delete actor photo: %w", err)
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		deleted = rows > 0
+		return nil
+	})
+	return deleted, err
+}
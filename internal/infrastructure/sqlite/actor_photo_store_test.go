@@ -0,0 +1,125 @@
+package sqlite
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/actor"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// insertPhotoTestActor saves an actor and returns its ID
+func insertPhotoTestActor(t *testing.T, repo *ActorRepository, name string) shared.ActorID {
+	t.Helper()
+
+	domainActor, err := actor.NewActor(name, 1964)
+	if err != nil {
+		t.Fatalf("failed to create actor: %v", err)
+	}
+	if err := repo.Save(context.Background(), domainActor); err != nil {
+		t.Fatalf("failed to save actor: %v", err)
+	}
+	return domainActor.ID()
+}
+
+func TestActorPhotoStore_SaveFindAndList(t *testing.T) {
+	db := setupActorTestDB(t)
+	defer db.Close()
+
+	repo := NewActorRepository(db)
+	store := NewActorPhotoStore(db)
+	ctx := context.Background()
+	keanu := insertPhotoTestActor(t, repo, "Keanu Reeves")
+	insertPhotoTestActor(t, repo, "Laurence Fishburne")
+
+	for _, data := range [][]byte{{0x89, 'P', 'N', 'G'}, {0xFF, 0xD8, 0xFF}} {
+		if err := store.SavePhoto(ctx, keanu, data, "image/jpeg"); err != nil {
+			t.Fatalf("SavePhoto() error = %v", err)
+		}
+	}
+
+	photo, err := store.FindPhoto(ctx, keanu)
+	if err != nil {
+		t.Fatalf("FindPhoto() error = %v", err)
+	}
+	if !bytes.Equal(photo.Data, []byte{0xFF, 0xD8, 0xFF}) || photo.MimeType != "image/jpeg" || photo.Name != "Keanu Reeves" {
+		t.Errorf("Expected the second photo to replace the first, got: %+v", photo)
+	}
+
+	photos, err := store.ListPhotos(ctx)
+	if err != nil {
+		t.Fatalf("ListPhotos() error = %v", err)
+	}
+	if len(photos) != 1 || photos[0].ActorID.Value() != keanu.Value() || photos[0].Size != 3 {
+		t.Errorf("Expected only Keanu Reeves' 3 byte photo, got: %+v", photos)
+	}
+
+	found, err := repo.FindByID(ctx, keanu)
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if !found.HasPhoto() {
+		t.Error("Expected the actor to report a stored photo")
+	}
+}
+
+func TestActorPhotoStore_Missing(t *testing.T) {
+	db := setupActorTestDB(t)
+	defer db.Close()
+
+	store := NewActorPhotoStore(db)
+	ctx := context.Background()
+	missingID, _ := shared.NewActorID(999)
+
+	if err := store.SavePhoto(ctx, missingID, []byte{0xFF}, "image/jpeg"); !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound saving for a missing actor, got: %v", err)
+	}
+
+	withoutPhoto := insertPhotoTestActor(t, NewActorRepository(db), "Carrie-Anne Moss")
+	if _, err := store.FindPhoto(ctx, withoutPhoto); !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for an actor without a photo, got: %v", err)
+	}
+}
+
+func TestActorPhotoStore_DeletePhoto(t *testing.T) {
+	db := setupActorTestDB(t)
+	defer db.Close()
+
+	repo := NewActorRepository(db)
+	store := NewActorPhotoStore(db)
+	ctx := context.Background()
+	actorID := insertPhotoTestActor(t, repo, "Keanu Reeves")
+	if err := store.SavePhoto(ctx, actorID, []byte{0xFF}, "image/jpeg"); err != nil {
+		t.Fatalf("SavePhoto() error = %v", err)
+	}
+
+	for _, wantDeleted := range []bool{true, false} {
+		deleted, err := store.DeletePhoto(ctx, actorID)
+		if err != nil {
+			t.Fatalf("DeletePhoto() error = %v", err)
+		}
+		if deleted != wantDeleted {
+			t.Errorf("Expected deleted %v, got: %v", wantDeleted, deleted)
+		}
+	}
+
+	// Saving the actor again leaves the stored photo columns alone
+	if err := store.SavePhoto(ctx, actorID, []byte{0xFF}, "image/jpeg"); err != nil {
+		t.Fatalf("SavePhoto() error = %v", err)
+	}
+	found, _ := repo.FindByID(ctx, actorID)
+	if err := found.SetPhotoURL("https://example.com/keanu.jpg"); err != nil {
+		t.Fatalf("SetPhotoURL() error = %v", err)
+	}
+	if err := repo.Save(ctx, found); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if _, err := store.FindPhoto(ctx, actorID); err != nil {
+		t.Errorf("Expected the photo to survive an actor update, got: %v", err)
+	}
+	if reloaded, _ := repo.FindByID(ctx, actorID); reloaded.PhotoURL() != "https://example.com/keanu.jpg" {
+		t.Errorf("Expected the photo URL to be saved, got: %q", reloaded.PhotoURL())
+	}
+}
@@ -3,7 +3,9 @@ package sqlite
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/francknouama/movies-mcp-server/internal/domain/actor"
 	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
@@ -26,17 +28,31 @@ func NewActorRepository(db *sql.DB) *ActorRepository {
 
 // dbActor represents the database model for actors
 type dbActor struct {
-	ID        int            `db:"id"`
-	Name      string         `db:"name"`
-	BirthYear sql.NullInt64  `db:"birth_year"`
-	Bio       sql.NullString `db:"bio"`
-	CreatedAt sql.NullTime   `db:"created_at"`
-	UpdatedAt sql.NullTime   `db:"updated_at"`
+	ID          int            `db:"id"`
+	Name        string         `db:"name"`
+	BirthYear   sql.NullInt64  `db:"birth_year"`
+	DeathYear   sql.NullInt64  `db:"death_year"`
+	Nationality sql.NullString `db:"nationality"`
+	Bio         sql.NullString `db:"bio"`
+	BioSections string         `db:"bio_sections"` // JSON-encoded array of dbBioSection
+	PhotoURL    sql.NullString `db:"photo_url"`
+	HasPhoto    bool           `db:"has_photo"` // photo_data IS NOT NULL; photo_data itself is only read by PhotoStore
+	CreatedAt   sql.NullTime   `db:"created_at"`
+	UpdatedAt   sql.NullTime   `db:"updated_at"`
+}
+
+// dbBioSection is one element of the bio_sections column
+type dbBioSection struct {
+	Title string `json:"title"`
+	Text  string `json:"text"`
 }
 
 // Save persists an actor (insert or update)
 func (r *ActorRepository) Save(ctx context.Context, domainActor *actor.Actor) error {
-	dbActor := r.toDBModel(domainActor)
+	dbActor, err := r.toDBModel(domainActor)
+	if err != nil {
+		return err
+	}
 
 	if domainActor.ID().IsZero() {
 		return r.insert(ctx, dbActor, domainActor)
@@ -45,19 +61,23 @@ func (r *ActorRepository) Save(ctx context.Context, domainActor *actor.Actor) er
 }
 
 func (r *ActorRepository) insert(ctx context.Context, dbActor *dbActor, domainActor *actor.Actor) error {
-	return r.txManager.WithTransaction(ctx, func(tx *sql.Tx) error {
+	return writeTransaction(ctx, r.txManager, func(tx *sql.Tx) error {
 		helper := database.NewTransactionHelper(tx)
 
 		// Insert actor
 		query := `
-			INSERT INTO actors (name, birth_year, bio, created_at, updated_at)
-			VALUES (?, ?, ?, ?, ?)
+			INSERT INTO actors (name, birth_year, death_year, nationality, bio, bio_sections, photo_url, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
 			RETURNING id`
 
 		id, err := helper.InsertWithID(ctx, query,
 			dbActor.Name,
 			dbActor.BirthYear,
+			dbActor.DeathYear,
+			dbActor.Nationality,
 			dbActor.Bio,
+			dbActor.BioSections,
+			dbActor.PhotoURL,
 			dbActor.CreatedAt.Time,
 			dbActor.UpdatedAt.Time,
 		)
@@ -83,25 +103,30 @@ func (r *ActorRepository) insert(ctx context.Context, dbActor *dbActor, domainAc
 }
 
 func (r *ActorRepository) update(ctx context.Context, dbActor *dbActor, domainActor *actor.Actor) error {
-	return r.txManager.WithTransaction(ctx, func(tx *sql.Tx) error {
+	return writeTransaction(ctx, r.txManager, func(tx *sql.Tx) error {
 		helper := database.NewTransactionHelper(tx)
 
 		// Update actor
 		query := `
 			UPDATE actors
-			SET name = ?, birth_year = ?, bio = ?, updated_at = ?
+			SET name = ?, birth_year = ?, death_year = ?, nationality = ?, bio = ?, bio_sections = ?,
+			    photo_url = ?, updated_at = ?
 			WHERE id = ?`
 
 		err := helper.Update(ctx, query, "actor",
 			dbActor.Name,
 			dbActor.BirthYear,
+			dbActor.DeathYear,
+			dbActor.Nationality,
 			dbActor.Bio,
+			dbActor.BioSections,
+			dbActor.PhotoURL,
 			dbActor.UpdatedAt.Time,
 			domainActor.ID().Value(),
 		)
 
 		if err != nil {
-			return err
+			return notFound(err)
 		}
 
 		// Update movie relationships
@@ -114,15 +139,25 @@ func (r *ActorRepository) update(ctx context.Context, dbActor *dbActor, domainAc
 }
 
 func (r *ActorRepository) insertMovieRelationships(ctx context.Context, tx *sql.Tx, domainActor *actor.Actor) error {
-	for _, movieID := range domainActor.MovieIDs() {
+	for _, credit := range domainActor.Credits() {
+		character := sql.NullString{String: credit.Character(), Valid: credit.Character() != ""}
+		billingOrder := sql.NullInt64{Int64: int64(credit.BillingOrder()), Valid: credit.BillingOrder() > 0}
+		roleType := sql.NullString{String: string(credit.RoleType()), Valid: credit.RoleType() != ""}
+
 		query := `
-			INSERT INTO movie_actors (movie_id, actor_id, created_at)
-			VALUES (?, ?, CURRENT_TIMESTAMP)
+			INSERT INTO movie_actors (movie_id, actor_id, role, billing_order, role_type, created_at)
+			VALUES (?, ?, ?, ?, ?, CURRENT_TIMESTAMP)
 			ON CONFLICT (movie_id, actor_id) DO NOTHING`
 
-		_, err := tx.ExecContext(ctx, query, movieID.Value(), domainActor.ID().Value())
+		_, err := tx.ExecContext(ctx, query,
+			credit.MovieID().Value(),
+			domainActor.ID().Value(),
+			character,
+			billingOrder,
+			roleType,
+		)
 		if err != nil {
-			return fmt.Errorf("failed to insert movie relationship: %w", err)
+			return missingReference(fmt.Errorf("failed to insert movie relationship: %w", err), "movie", credit.MovieID().Value())
 		}
 	}
 	return nil
@@ -143,7 +178,8 @@ func (r *ActorRepository) updateMovieRelationships(ctx context.Context, tx *sql.
 // FindByID retrieves an actor by their ID
 func (r *ActorRepository) FindByID(ctx context.Context, id shared.ActorID) (*actor.Actor, error) {
 	query := `
-		SELECT id, name, birth_year, bio, created_at, updated_at
+		SELECT id, name, birth_year, death_year, nationality, bio, bio_sections, photo_url, photo_data IS NOT NULL,
+		       created_at, updated_at
 		FROM actors
 		WHERE id = ?`
 
@@ -152,49 +188,56 @@ func (r *ActorRepository) FindByID(ctx context.Context, id shared.ActorID) (*act
 		&dbActor.ID,
 		&dbActor.Name,
 		&dbActor.BirthYear,
+		&dbActor.DeathYear,
+		&dbActor.Nationality,
 		&dbActor.Bio,
-		&dbActor.CreatedAt,
-		&dbActor.UpdatedAt,
+		&dbActor.BioSections,
+		&dbActor.PhotoURL,
+		&dbActor.HasPhoto,
+		(*textTime)(&dbActor.CreatedAt),
+		(*textTime)(&dbActor.UpdatedAt),
 	)
 
 	if err != nil {
-		return nil, r.WrapNotFound(err, "actor")
+		return nil, notFound(r.WrapNotFound(err, "actor"))
 	}
 
 	// Get movie relationships
-	movieIDs, err := r.getActorMovieIDs(ctx, id)
+	credits, err := r.getActorCredits(ctx, id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get actor movie IDs: %w", err)
+		return nil, fmt.Errorf("failed to get actor credits: %w", err)
 	}
 
-	return r.toDomainModel(&dbActor, movieIDs)
+	return r.toDomainModel(&dbActor, credits)
 }
 
-func (r *ActorRepository) getActorMovieIDs(ctx context.Context, actorID shared.ActorID) ([]shared.MovieID, error) {
+func (r *ActorRepository) getActorCredits(ctx context.Context, actorID shared.ActorID) ([]actor.Credit, error) {
 	// First check if movie_actors table exists
 	var tableExists int
 	checkQuery := "SELECT COUNT(*) FROM sqlite_master WHERE type='table' AND name='movie_actors'"
-	if err := r.DB().QueryRowContext(ctx, checkQuery).Scan(&tableExists); err != nil {
+	if err := r.QueryRowContext(ctx, checkQuery).Scan(&tableExists); err != nil {
 		return nil, fmt.Errorf("failed to check if movie_actors table exists: %w", err)
 	}
 
 	// If table doesn't exist, return empty list (this can happen in some test scenarios)
 	if tableExists == 0 {
-		return []shared.MovieID{}, nil
+		return []actor.Credit{}, nil
 	}
 
-	query := "SELECT movie_id FROM movie_actors WHERE actor_id = ?"
+	query := "SELECT movie_id, role, billing_order, role_type FROM movie_actors WHERE actor_id = ?"
 	rows, err := r.QueryContext(ctx, query, actorID.Value())
 	if err != nil {
 		return nil, fmt.Errorf("failed to query movie relationships: %w", err)
 	}
 	defer rows.Close()
 
-	var movieIDs []shared.MovieID
+	var credits []actor.Credit
 	for rows.Next() {
 		var movieIDValue int
-		if err := rows.Scan(&movieIDValue); err != nil {
-			return nil, fmt.Errorf("failed to scan movie ID: %w", err)
+		var character, roleType sql.NullString
+		var billingOrder sql.NullInt64
+		if err := rows.Scan(&movieIDValue, &character, &billingOrder, &roleType); err != nil {
+			return nil, fmt.Errorf("failed to scan movie relationship: %w", err)
 		}
 
 		movieID, err := shared.NewMovieID(movieIDValue)
@@ -202,49 +245,45 @@ func (r *ActorRepository) getActorMovieIDs(ctx context.Context, actorID shared.A
 			return nil, fmt.Errorf("failed to create movie ID: %w", err)
 		}
 
-		movieIDs = append(movieIDs, movieID)
+		credit, err := actor.NewCredit(movieID, character.String, int(billingOrder.Int64), roleType.String)
+		if err != nil {
+			return nil, fmt.Errorf("invalid credit for movie %d: %w", movieIDValue, err)
+		}
+
+		credits = append(credits, credit)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query movie relationships: %w", err)
 	}
 
-	return movieIDs, nil
+	return credits, nil
 }
 
 // FindByCriteria retrieves actors based on search criteria
 func (r *ActorRepository) FindByCriteria(ctx context.Context, criteria actor.SearchCriteria) ([]*actor.Actor, error) {
 	query, args := r.buildSearchQuery(criteria)
 
-	rows, err := r.QueryContext(ctx, query, args...)
+	dbActors, err := r.queryActors(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to search actors: %w", err)
+		return nil, err
 	}
-	defer rows.Close()
 
+	// Credits are loaded once the search rows are closed, so the nested
+	// queries do not wait on a connection the search is still holding
 	var actors []*actor.Actor
-	for rows.Next() {
-		var dbActor dbActor
-		err := rows.Scan(
-			&dbActor.ID,
-			&dbActor.Name,
-			&dbActor.BirthYear,
-			&dbActor.Bio,
-			&dbActor.CreatedAt,
-			&dbActor.UpdatedAt,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan actor: %w", err)
-		}
-
+	for _, dbActor := range dbActors {
 		actorID, err := shared.NewActorID(dbActor.ID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create actor ID: %w", err)
 		}
 
 		// Get movie relationships
-		movieIDs, err := r.getActorMovieIDs(ctx, actorID)
+		credits, err := r.getActorCredits(ctx, actorID)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get actor movie IDs: %w", err)
+			return nil, fmt.Errorf("failed to get actor credits: %w", err)
 		}
 
-		domainActor, err := r.toDomainModel(&dbActor, movieIDs)
+		domainActor, err := r.toDomainModel(&dbActor, credits)
 		if err != nil {
 			return nil, fmt.Errorf("failed to convert to domain model: %w", err)
 		}
@@ -255,22 +294,72 @@ func (r *ActorRepository) FindByCriteria(ctx context.Context, criteria actor.Sea
 	return actors, nil
 }
 
+// queryActors runs an actor search query and scans every row
+func (r *ActorRepository) queryActors(ctx context.Context, query string, args ...interface{}) ([]dbActor, error) {
+	rows, err := r.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search actors: %w", err)
+	}
+	defer rows.Close()
+
+	var dbActors []dbActor
+	for rows.Next() {
+		var dbActor dbActor
+		err := rows.Scan(
+			&dbActor.ID,
+			&dbActor.Name,
+			&dbActor.BirthYear,
+			&dbActor.DeathYear,
+			&dbActor.Nationality,
+			&dbActor.Bio,
+			&dbActor.BioSections,
+			&dbActor.PhotoURL,
+			&dbActor.HasPhoto,
+			(*textTime)(&dbActor.CreatedAt),
+			(*textTime)(&dbActor.UpdatedAt),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan actor: %w", err)
+		}
+		dbActors = append(dbActors, dbActor)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to search actors: %w", err)
+	}
+
+	return dbActors, nil
+}
+
 func (r *ActorRepository) buildSearchQuery(criteria actor.SearchCriteria) (string, []interface{}) {
 	query := `
-		SELECT DISTINCT a.id, a.name, a.birth_year, a.bio, a.created_at, a.updated_at
+		SELECT DISTINCT a.id, a.name, a.birth_year, a.death_year, a.nationality, a.bio, a.bio_sections, a.photo_url,
+		       a.photo_data IS NOT NULL, a.created_at, a.updated_at
 		FROM actors a`
 
 	var args []interface{}
 	var conditions []string
 
-	// Join with movie_actors if searching by movie
-	if !criteria.MovieID.IsZero() {
+	// Join with movie_actors if searching by movie or character
+	if !criteria.MovieID.IsZero() || criteria.Character != "" {
 		query += " INNER JOIN movie_actors ma ON a.id = ma.actor_id"
+	}
+	if !criteria.MovieID.IsZero() {
 		conditions = append(conditions, "ma.movie_id = ?")
 		args = append(args, criteria.MovieID.Value())
 	}
+	if criteria.Character != "" {
+		conditions = append(conditions, "ma.role LIKE ? COLLATE NOCASE")
+		args = append(args, "%"+criteria.Character+"%")
+	}
 
 	// Add WHERE conditions
+	if len(criteria.IDs) > 0 {
+		conditions = append(conditions, "a.id IN ("+placeholders(len(criteria.IDs))+")")
+		for _, id := range criteria.IDs {
+			args = append(args, id.Value())
+		}
+	}
+
 	if criteria.Name != "" {
 		conditions = append(conditions, "a.name LIKE ? COLLATE NOCASE")
 		args = append(args, "%"+criteria.Name+"%")
@@ -286,6 +375,15 @@ func (r *ActorRepository) buildSearchQuery(criteria actor.SearchCriteria) (strin
 		args = append(args, criteria.MaxBirthYear)
 	}
 
+	if criteria.Nationality != "" {
+		conditions = append(conditions, "a.nationality = ? COLLATE NOCASE")
+		args = append(args, strings.TrimSpace(criteria.Nationality))
+	}
+
+	if criteria.AliveOnly {
+		conditions = append(conditions, "a.death_year IS NULL")
+	}
+
 	if len(conditions) > 0 {
 		query += " WHERE " + conditions[0]
 		for i := 1; i < len(conditions); i++ {
@@ -294,22 +392,16 @@ func (r *ActorRepository) buildSearchQuery(criteria actor.SearchCriteria) (strin
 	}
 
 	// Add ORDER BY
-	orderField := "a.name"
-	switch criteria.OrderBy {
-	case actor.OrderByBirthYear:
-		orderField = "a.birth_year"
-	case actor.OrderByCreatedAt:
-		orderField = "a.created_at"
-	case actor.OrderByUpdatedAt:
-		orderField = "a.updated_at"
+	sortKeys := criteria.Sort
+	if len(sortKeys) == 0 {
+		sortKeys = []actor.SortKey{{Field: criteria.OrderBy, Dir: criteria.OrderDir}}
 	}
 
-	orderDir := "ASC"
-	if criteria.OrderDir == actor.OrderDesc {
-		orderDir = "DESC"
+	terms := make([]string, len(sortKeys))
+	for i, key := range sortKeys {
+		terms[i] = actorOrderColumn(key.Field) + " " + sqlOrderDirection(key.Dir == actor.OrderDesc)
 	}
-
-	query += fmt.Sprintf(" ORDER BY %s %s", orderField, orderDir)
+	query += " ORDER BY " + strings.Join(terms, ", ")
 
 	// Add LIMIT and OFFSET
 	if criteria.Limit > 0 {
@@ -325,6 +417,20 @@ func (r *ActorRepository) buildSearchQuery(criteria actor.SearchCriteria) (strin
 	return query, args
 }
 
+// actorOrderColumn maps a sort field to its column, defaulting to name
+func actorOrderColumn(field actor.OrderBy) string {
+	switch field {
+	case actor.OrderByBirthYear:
+		return "a.birth_year"
+	case actor.OrderByCreatedAt:
+		return "a.created_at"
+	case actor.OrderByUpdatedAt:
+		return "a.updated_at"
+	default:
+		return "a.name"
+	}
+}
+
 // FindByName searches actors by name (partial match)
 func (r *ActorRepository) FindByName(ctx context.Context, name string) ([]*actor.Actor, error) {
 	criteria := actor.SearchCriteria{
@@ -351,7 +457,7 @@ func (r *ActorRepository) CountAll(ctx context.Context) (int, error) {
 
 // Delete removes an actor by ID
 func (r *ActorRepository) Delete(ctx context.Context, id shared.ActorID) error {
-	return r.txManager.WithTransaction(ctx, func(tx *sql.Tx) error {
+	return writeTransaction(ctx, r.txManager, func(tx *sql.Tx) error {
 		helper := database.NewTransactionHelper(tx)
 
 		// Delete movie relationships first (foreign key constraints)
@@ -363,13 +469,13 @@ func (r *ActorRepository) Delete(ctx context.Context, id shared.ActorID) error {
 
 		// Delete actor
 		deleteQuery := "DELETE FROM actors WHERE id = ?"
-		return helper.Delete(ctx, deleteQuery, "actor", id.Value())
+		return notFound(helper.Delete(ctx, deleteQuery, "actor", id.Value()))
 	})
 }
 
 // DeleteAll removes all actors (for testing)
 func (r *ActorRepository) DeleteAll(ctx context.Context) error {
-	return r.txManager.WithTransaction(ctx, func(tx *sql.Tx) error {
+	return writeTransaction(ctx, r.txManager, func(tx *sql.Tx) error {
 		// Delete all movie relationships first
 		_, err := tx.ExecContext(ctx, "DELETE FROM movie_actors")
 		if err != nil {
@@ -387,10 +493,21 @@ func (r *ActorRepository) DeleteAll(ctx context.Context) error {
 }
 
 // toDBModel converts a domain actor to a database model
-func (r *ActorRepository) toDBModel(domainActor *actor.Actor) *dbActor {
+func (r *ActorRepository) toDBModel(domainActor *actor.Actor) (*dbActor, error) {
+	// Encode biography sections as JSON
+	sections := make([]dbBioSection, 0, len(domainActor.BioSections()))
+	for _, section := range domainActor.BioSections() {
+		sections = append(sections, dbBioSection{Title: section.Title(), Text: section.Text()})
+	}
+	sectionsJSON, err := json.Marshal(sections)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal biography sections: %w", err)
+	}
+
 	dbActor := &dbActor{
-		ID:   domainActor.ID().Value(),
-		Name: domainActor.Name(),
+		ID:          domainActor.ID().Value(),
+		Name:        domainActor.Name(),
+		BioSections: string(sectionsJSON),
 	}
 
 	// Handle optional birth year
@@ -401,6 +518,20 @@ func (r *ActorRepository) toDBModel(domainActor *actor.Actor) *dbActor {
 		}
 	}
 
+	// Handle optional death year and nationality
+	if !domainActor.DeathYear().IsZero() {
+		dbActor.DeathYear = sql.NullInt64{
+			Int64: int64(domainActor.DeathYear().Value()),
+			Valid: true,
+		}
+	}
+	if domainActor.Nationality() != "" {
+		dbActor.Nationality = sql.NullString{
+			String: domainActor.Nationality(),
+			Valid:  true,
+		}
+	}
+
 	// Handle optional bio
 	if domainActor.Bio() != "" {
 		dbActor.Bio = sql.NullString{
@@ -409,6 +540,14 @@ func (r *ActorRepository) toDBModel(domainActor *actor.Actor) *dbActor {
 		}
 	}
 
+	// Handle optional photo URL
+	if domainActor.PhotoURL() != "" {
+		dbActor.PhotoURL = sql.NullString{
+			String: domainActor.PhotoURL(),
+			Valid:  true,
+		}
+	}
+
 	// Handle timestamps
 	dbActor.CreatedAt = sql.NullTime{
 		Time:  domainActor.CreatedAt(),
@@ -419,11 +558,11 @@ func (r *ActorRepository) toDBModel(domainActor *actor.Actor) *dbActor {
 		Valid: true,
 	}
 
-	return dbActor
+	return dbActor, nil
 }
 
 // toDomainModel converts a database model to a domain actor
-func (r *ActorRepository) toDomainModel(dbActor *dbActor, movieIDs []shared.MovieID) (*actor.Actor, error) {
+func (r *ActorRepository) toDomainModel(dbActor *dbActor, credits []actor.Credit) (*actor.Actor, error) {
 	actorID, err := shared.NewActorID(dbActor.ID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid actor ID: %w", err)
@@ -444,12 +583,53 @@ func (r *ActorRepository) toDomainModel(dbActor *dbActor, movieIDs []shared.Movi
 		domainActor.SetBio(dbActor.Bio.String)
 	}
 
+	// Set death year and nationality if present
+	if dbActor.DeathYear.Valid {
+		if err := domainActor.SetDeathYear(int(dbActor.DeathYear.Int64)); err != nil {
+			return nil, fmt.Errorf("invalid death year: %w", err)
+		}
+	}
+	if dbActor.Nationality.Valid {
+		if err := domainActor.SetNationality(dbActor.Nationality.String); err != nil {
+			return nil, fmt.Errorf("invalid nationality: %w", err)
+		}
+	}
+
+	// Decode biography sections
+	if dbActor.BioSections != "" {
+		var sections []dbBioSection
+		if err := json.Unmarshal([]byte(dbActor.BioSections), &sections); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal biography sections: %w", err)
+		}
+		bioSections := make([]actor.BioSection, 0, len(sections))
+		for _, section := range sections {
+			bioSection, err := actor.NewBioSection(section.Title, section.Text)
+			if err != nil {
+				return nil, fmt.Errorf("invalid biography section: %w", err)
+			}
+			bioSections = append(bioSections, bioSection)
+		}
+		if err := domainActor.SetBioSections(bioSections); err != nil {
+			return nil, fmt.Errorf("invalid biography sections: %w", err)
+		}
+	}
+
+	// Set photo URL if present
+	if dbActor.PhotoURL.Valid {
+		if err := domainActor.SetPhotoURL(dbActor.PhotoURL.String); err != nil {
+			return nil, fmt.Errorf("invalid photo URL: %w", err)
+		}
+	}
+	domainActor.SetHasPhoto(dbActor.HasPhoto)
+
 	// Add movie relationships
-	for _, movieID := range movieIDs {
-		if err := domainActor.AddMovie(movieID); err != nil {
+	for _, credit := range credits {
+		if err := domainActor.AddCredit(credit); err != nil {
 			return nil, fmt.Errorf("failed to add movie to actor: %w", err)
 		}
 	}
 
+	// Rebuilding a stored actor is not a change to publish
+	domainActor.MarkEventsAsCommitted()
 	return domainActor, nil
 }
@@ -21,6 +21,7 @@ func setupActorTestDB(t *testing.T) *sql.DB {
 	if err != nil {
 		t.Fatalf("failed to open test database: %v", err)
 	}
+	db.SetMaxOpenConns(1) // Catches nested queries, which deadlock a one-connection pool
 
 	// Create schema for actors and movies (matching repository expectations)
 	schema := `
@@ -28,7 +29,13 @@ func setupActorTestDB(t *testing.T) *sql.DB {
 		id INTEGER PRIMARY KEY AUTOINCREMENT,
 		name TEXT NOT NULL,
 		birth_year INTEGER,
+		death_year INTEGER,
+		nationality TEXT,
 		bio TEXT,
+		bio_sections TEXT NOT NULL DEFAULT '[]',
+		photo_url TEXT,
+		photo_data BLOB,
+		photo_type TEXT,
 		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 		updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
 	);
@@ -39,8 +46,12 @@ func setupActorTestDB(t *testing.T) *sql.DB {
 		director TEXT NOT NULL,
 		year INTEGER NOT NULL,
 		rating REAL,
+		duration INTEGER,
 		genre TEXT NOT NULL DEFAULT '[]',
 		poster_url TEXT,
+		certifications TEXT NOT NULL DEFAULT '{}',
+		content_warnings TEXT NOT NULL DEFAULT '[]',
+		release_date TEXT,
 		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 		updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
 	);
@@ -49,6 +60,8 @@ func setupActorTestDB(t *testing.T) *sql.DB {
 		movie_id INTEGER NOT NULL,
 		actor_id INTEGER NOT NULL,
 		role TEXT,
+		billing_order INTEGER,
+		role_type TEXT,
 		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 		PRIMARY KEY (movie_id, actor_id),
 		FOREIGN KEY (movie_id) REFERENCES movies(id) ON DELETE CASCADE,
@@ -262,6 +275,84 @@ func TestActorRepository_FindByMovieID(t *testing.T) {
 	}
 }
 
+func TestActorRepository_Save_WithCredits(t *testing.T) {
+	db := setupActorTestDB(t)
+	defer db.Close()
+
+	actorRepo := NewActorRepository(db)
+	movieRepo := NewMovieRepository(db)
+	ctx := context.Background()
+
+	domainMovie, _ := movie.NewMovie("Inception", "Christopher Nolan", 2010)
+	_ = movieRepo.Save(ctx, domainMovie)
+	otherMovie, _ := movie.NewMovie("Titanic", "James Cameron", 1997)
+	_ = movieRepo.Save(ctx, otherMovie)
+
+	domainActor, _ := actor.NewActor("Leonardo DiCaprio", 1974)
+	credit, err := actor.NewCredit(domainMovie.ID(), "Dom Cobb", 1, "lead")
+	if err != nil {
+		t.Fatalf("failed to create credit: %v", err)
+	}
+	_ = domainActor.AddCredit(credit)
+	_ = domainActor.AddMovie(otherMovie.ID())
+	if err := actorRepo.Save(ctx, domainActor); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	retrieved, err := actorRepo.FindByID(ctx, domainActor.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+
+	saved, ok := retrieved.Credit(domainMovie.ID())
+	if !ok {
+		t.Fatal("Expected credit for Inception")
+	}
+	if saved.Character() != "Dom Cobb" || saved.BillingOrder() != 1 || saved.RoleType() != actor.RoleLead {
+		t.Errorf("Expected Dom Cobb billed 1st as lead, got: %q %d %q", saved.Character(), saved.BillingOrder(), saved.RoleType())
+	}
+
+	bare, ok := retrieved.Credit(otherMovie.ID())
+	if !ok {
+		t.Fatal("Expected credit for Titanic")
+	}
+	if bare.Character() != "" || bare.BillingOrder() != 0 || bare.RoleType() != "" {
+		t.Errorf("Expected empty credit details, got: %q %d %q", bare.Character(), bare.BillingOrder(), bare.RoleType())
+	}
+}
+
+func TestActorRepository_FindByCriteria_ByCharacter(t *testing.T) {
+	db := setupActorTestDB(t)
+	defer db.Close()
+
+	actorRepo := NewActorRepository(db)
+	movieRepo := NewMovieRepository(db)
+	ctx := context.Background()
+
+	domainMovie, _ := movie.NewMovie("The Matrix", "The Wachowskis", 1999)
+	_ = movieRepo.Save(ctx, domainMovie)
+
+	characters := map[string]string{
+		"Keanu Reeves":       "Neo",
+		"Laurence Fishburne": "Morpheus",
+	}
+	for name, character := range characters {
+		domainActor, _ := actor.NewActor(name, 1964)
+		credit, _ := actor.NewCredit(domainMovie.ID(), character, 0, "")
+		_ = domainActor.AddCredit(credit)
+		_ = actorRepo.Save(ctx, domainActor)
+	}
+
+	results, err := actorRepo.FindByCriteria(ctx, actor.SearchCriteria{Character: "morph", Limit: 10})
+	if err != nil {
+		t.Fatalf("FindByCriteria() error = %v", err)
+	}
+
+	if len(results) != 1 || results[0].Name() != "Laurence Fishburne" {
+		t.Errorf("Expected only Laurence Fishburne, got: %d actors", len(results))
+	}
+}
+
 func TestActorRepository_FindByCriteria_ByBirthYearRange(t *testing.T) {
 	db := setupActorTestDB(t)
 	defer db.Close()
@@ -302,6 +393,87 @@ func TestActorRepository_FindByCriteria_ByBirthYearRange(t *testing.T) {
 	}
 }
 
+func TestActorRepository_FindByCriteria_ByIDs(t *testing.T) {
+	db := setupActorTestDB(t)
+	defer db.Close()
+
+	repo := NewActorRepository(db)
+	ctx := context.Background()
+
+	var ids []shared.ActorID
+	for _, name := range []string{"Actor 1", "Actor 2", "Actor 3"} {
+		domainActor, _ := actor.NewActor(name, 1970)
+		_ = repo.Save(ctx, domainActor)
+		ids = append(ids, domainActor.ID())
+	}
+
+	criteria := actor.SearchCriteria{
+		IDs:   []shared.ActorID{ids[1], ids[2]},
+		Limit: 10,
+	}
+
+	results, err := repo.FindByCriteria(ctx, criteria)
+	if err != nil {
+		t.Fatalf("FindByCriteria() error = %v", err)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 actors, got %d", len(results))
+	}
+	if results[0].Name() != "Actor 2" || results[1].Name() != "Actor 3" {
+		t.Errorf("Expected Actor 2 and Actor 3, got %s and %s", results[0].Name(), results[1].Name())
+	}
+}
+
+func TestActorRepository_FindByCriteria_MultiKeySort(t *testing.T) {
+	db := setupActorTestDB(t)
+	defer db.Close()
+
+	repo := NewActorRepository(db)
+	ctx := context.Background()
+
+	actors := []struct {
+		name      string
+		birthYear int
+	}{
+		{"Carrie-Anne Moss", 1967},
+		{"Keanu Reeves", 1964},
+		{"Al Pacino", 1940},
+		{"Hugo Weaving", 1960},
+		{"Laurence Fishburne", 1961},
+		{"Joe Pantoliano", 1951},
+		{"Anna Example", 1964},
+	}
+	for _, a := range actors {
+		domainActor, _ := actor.NewActor(a.name, a.birthYear)
+		_ = repo.Save(ctx, domainActor)
+	}
+
+	criteria := actor.SearchCriteria{
+		MinBirthYear: 1960,
+		Limit:        10,
+		Sort: []actor.SortKey{
+			{Field: actor.OrderByBirthYear, Dir: actor.OrderDesc},
+			{Field: actor.OrderByName, Dir: actor.OrderAsc},
+		},
+	}
+
+	results, err := repo.FindByCriteria(ctx, criteria)
+	if err != nil {
+		t.Fatalf("FindByCriteria() error = %v", err)
+	}
+
+	want := []string{"Carrie-Anne Moss", "Anna Example", "Keanu Reeves", "Laurence Fishburne", "Hugo Weaving"}
+	if len(results) != len(want) {
+		t.Fatalf("Expected %d actors, got %d", len(want), len(results))
+	}
+	for i, name := range want {
+		if results[i].Name() != name {
+			t.Errorf("Position %d: expected %s, got %s", i, name, results[i].Name())
+		}
+	}
+}
+
 func TestActorRepository_CountAll(t *testing.T) {
 	db := setupActorTestDB(t)
 	defer db.Close()
@@ -1127,3 +1299,110 @@ func TestActorRepository_ComplexQueryScenarios(t *testing.T) {
 		t.Errorf("Expected bio 'Rising star', got '%s'", results5[0].Bio())
 	}
 }
+
+func TestActorRepository_Save_Biography(t *testing.T) {
+	db := setupActorTestDB(t)
+	defer db.Close()
+
+	repo := NewActorRepository(db)
+	ctx := context.Background()
+
+	domainActor, _ := actor.NewActor("Audrey Hepburn", 1929)
+	early, _ := actor.NewBioSection("Early life", "Born in Brussels.")
+	career, _ := actor.NewBioSection("Career", "Won an Academy Award for Roman Holiday.")
+	if err := domainActor.SetDeathYear(1993); err != nil {
+		t.Fatalf("SetDeathYear() error = %v", err)
+	}
+	if err := domainActor.SetNationality("British"); err != nil {
+		t.Fatalf("SetNationality() error = %v", err)
+	}
+	if err := domainActor.SetBioSections([]actor.BioSection{early, career}); err != nil {
+		t.Fatalf("SetBioSections() error = %v", err)
+	}
+	if err := repo.Save(ctx, domainActor); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	retrieved, err := repo.FindByID(ctx, domainActor.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if retrieved.IsAlive() || retrieved.DeathYear().Value() != 1993 {
+		t.Errorf("Expected death year 1993, got: %d", retrieved.DeathYear().Value())
+	}
+	if retrieved.Nationality() != "British" {
+		t.Errorf("Expected nationality 'British', got: %q", retrieved.Nationality())
+	}
+	sections := retrieved.BioSections()
+	if len(sections) != 2 || sections[0].Title() != "Early life" || sections[1].Text() != "Won an Academy Award for Roman Holiday." {
+		t.Errorf("Expected both biography sections in order, got: %v", sections)
+	}
+
+	// Clearing the death year stores NULL
+	if err := retrieved.SetDeathYear(0); err != nil {
+		t.Fatalf("SetDeathYear(0) error = %v", err)
+	}
+	if err := repo.Save(ctx, retrieved); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	var deathYear sql.NullInt64
+	if err := db.QueryRow("SELECT death_year FROM actors WHERE id = ?", retrieved.ID().Value()).Scan(&deathYear); err != nil {
+		t.Fatalf("Failed to read death year: %v", err)
+	}
+	if deathYear.Valid {
+		t.Errorf("Expected a NULL death year, got: %d", deathYear.Int64)
+	}
+}
+
+func TestActorRepository_FindByCriteria_NationalityAndAliveOnly(t *testing.T) {
+	db := setupActorTestDB(t)
+	defer db.Close()
+
+	repo := NewActorRepository(db)
+	ctx := context.Background()
+
+	for _, a := range []struct {
+		name        string
+		nationality string
+		deathYear   int
+	}{
+		{"Keanu Reeves", "Canadian", 0},
+		{"Raymond Burr", "Canadian", 1993},
+		{"Tom Hanks", "American", 0},
+		{"Unknown Actor", "", 0},
+	} {
+		domainActor, _ := actor.NewActor(a.name, 1917)
+		if err := domainActor.SetNationality(a.nationality); err != nil {
+			t.Fatalf("SetNationality() error = %v", err)
+		}
+		if err := domainActor.SetDeathYear(a.deathYear); err != nil {
+			t.Fatalf("SetDeathYear() error = %v", err)
+		}
+		if err := repo.Save(ctx, domainActor); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+
+	names := func(criteria actor.SearchCriteria) []string {
+		t.Helper()
+		results, err := repo.FindByCriteria(ctx, criteria)
+		if err != nil {
+			t.Fatalf("FindByCriteria() error = %v", err)
+		}
+		var found []string
+		for _, result := range results {
+			found = append(found, result.Name())
+		}
+		return found
+	}
+
+	if found := names(actor.SearchCriteria{Nationality: " canadian ", OrderBy: actor.OrderByName}); fmt.Sprint(found) != "[Keanu Reeves Raymond Burr]" {
+		t.Errorf("Expected both Canadian actors, got: %v", found)
+	}
+	if found := names(actor.SearchCriteria{AliveOnly: true, OrderBy: actor.OrderByName}); fmt.Sprint(found) != "[Keanu Reeves Tom Hanks Unknown Actor]" {
+		t.Errorf("Expected the living actors, got: %v", found)
+	}
+	if found := names(actor.SearchCriteria{Nationality: "Canadian", AliveOnly: true}); fmt.Sprint(found) != "[Keanu Reeves]" {
+		t.Errorf("Expected the living Canadian actor, got: %v", found)
+	}
+}
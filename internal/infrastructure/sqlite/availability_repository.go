@@ -0,0 +1,134 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/availability"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+// AvailabilityRepository implements the availability.Repository interface for SQLite
+type AvailabilityRepository struct {
+	*database.BaseRepository
+	txManager *database.TransactionManager
+}
+
+// NewAvailabilityRepository creates a new SQLite availability repository
+func NewAvailabilityRepository(db *sql.DB) *AvailabilityRepository {
+	return &AvailabilityRepository{
+		BaseRepository: database.NewBaseRepository(db),
+		txManager:      database.NewTransactionManager(db),
+	}
+}
+
+// dbAvailability represents the database model for movie availability
+type dbAvailability struct {
+	MovieID     int            `db:"movie_id"`
+	Provider    string         `db:"provider"`
+	Region      string         `db:"region"`
+	OfferType   string         `db:"offer_type"`
+	URL         sql.NullString `db:"url"`
+	LastChecked sql.NullTime   `db:"last_checked"`
+}
+
+// FindByMovieID retrieves a movie's availability; an empty region returns all regions
+func (r *AvailabilityRepository) FindByMovieID(ctx context.Context, movieID shared.MovieID, region string) ([]*availability.Availability, error) {
+	query := `
+		SELECT movie_id, provider, region, offer_type, url, last_checked
+		FROM movie_availability
+		WHERE movie_id = ?`
+	args := []interface{}{movieID.Value()}
+
+	if region != "" {
+		query += " AND region = ?"
+		args = append(args, region)
+	}
+	query += " ORDER BY region ASC, offer_type ASC, provider COLLATE NOCASE ASC"
+
+	rows, err := r.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query availability: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []*availability.Availability{}
+	for rows.Next() {
+		var row dbAvailability
+		if err := rows.Scan(
+			&row.MovieID,
+			&row.Provider,
+			&row.Region,
+			&row.OfferType,
+			&row.URL,
+			(*textTime)(&row.LastChecked),
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan availability: %w", err)
+		}
+
+		entry, err := r.toDomainModel(&row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert to domain model: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query availability: %w", err)
+	}
+
+	return entries, nil
+}
+
+// ReplaceForRegion replaces everything recorded for a movie in a region with entries
+func (r *AvailabilityRepository) ReplaceForRegion(
+	ctx context.Context,
+	movieID shared.MovieID,
+	region string,
+	entries []*availability.Availability,
+) error {
+	return writeTransaction(ctx, r.txManager, func(tx *sql.Tx) error {
+		deleteQuery := "DELETE FROM movie_availability WHERE movie_id = ? AND region = ?"
+		if _, err := tx.ExecContext(ctx, deleteQuery, movieID.Value(), region); err != nil {
+			return fmt.Errorf("failed to delete existing availability: %w", err)
+		}
+
+		insertQuery := `
+			INSERT INTO movie_availability (movie_id, provider, region, offer_type, url, last_checked)
+			VALUES (?, ?, ?, ?, ?, ?)
+			ON CONFLICT (movie_id, provider, region, offer_type) DO UPDATE
+			SET url = excluded.url, last_checked = excluded.last_checked`
+
+		for _, entry := range entries {
+			if entry.MovieID() != movieID || entry.Region() != region {
+				return fmt.Errorf("availability for %s in %s does not match movie %d in %s",
+					entry.Provider(), entry.Region(), movieID.Value(), region)
+			}
+
+			url := sql.NullString{String: entry.URL(), Valid: entry.URL() != ""}
+			if _, err := tx.ExecContext(ctx, insertQuery,
+				movieID.Value(),
+				entry.Provider(),
+				entry.Region(),
+				string(entry.OfferType()),
+				url,
+				entry.LastChecked(),
+			); err != nil {
+				return missingReference(fmt.Errorf("failed to insert availability: %w", err), "movie", movieID.Value())
+			}
+		}
+
+		return nil
+	})
+}
+
+// toDomainModel converts a database row to a domain availability
+func (r *AvailabilityRepository) toDomainModel(row *dbAvailability) (*availability.Availability, error) {
+	movieID, err := shared.NewMovieID(row.MovieID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create movie ID: %w", err)
+	}
+
+	return availability.NewAvailability(movieID, row.Provider, row.Region, row.OfferType, row.URL.String, row.LastChecked.Time)
+}
@@ -0,0 +1,193 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/availability"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	_ "modernc.org/sqlite"
+)
+
+// setupAvailabilityTestDB creates an in-memory SQLite database with one
+// movie, enforcing foreign keys as the server does
+func setupAvailabilityTestDB(t *testing.T) (*sql.DB, shared.MovieID) {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", ":memory:?_time_format=sqlite&_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatalf("failed to open test database: %v", err)
+	}
+	db.SetMaxOpenConns(1) // Each in-memory connection is its own database
+
+	schema := `
+	CREATE TABLE movies (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		title TEXT NOT NULL
+	);
+
+	CREATE TABLE movie_availability (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		movie_id INTEGER NOT NULL,
+		provider TEXT NOT NULL,
+		region TEXT NOT NULL,
+		offer_type TEXT NOT NULL DEFAULT 'flatrate',
+		url TEXT,
+		last_checked TEXT NOT NULL,
+		created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE (movie_id, provider, region, offer_type),
+		FOREIGN KEY (movie_id) REFERENCES movies(id) ON DELETE CASCADE
+	);
+
+	INSERT INTO movies (id, title) VALUES (1, 'The Matrix');`
+
+	if _, err := db.Exec(schema); err != nil {
+		t.Fatalf("failed to create test schema: %v", err)
+	}
+
+	movieID, _ := shared.NewMovieID(1)
+	return db, movieID
+}
+
+func newTestAvailability(t *testing.T, movieID shared.MovieID, provider, region, offerType string) *availability.Availability {
+	t.Helper()
+
+	entry, err := availability.NewAvailability(movieID, provider, region, offerType,
+		"https://example.com/"+provider, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("failed to create availability: %v", err)
+	}
+	return entry
+}
+
+func TestAvailabilityRepository_ReplaceForRegion(t *testing.T) {
+	db, movieID := setupAvailabilityTestDB(t)
+	defer db.Close()
+
+	repo := NewAvailabilityRepository(db)
+	ctx := context.Background()
+
+	if err := repo.ReplaceForRegion(ctx, movieID, "US", []*availability.Availability{
+		newTestAvailability(t, movieID, "Netflix", "US", "flatrate"),
+		newTestAvailability(t, movieID, "Apple TV", "US", "rent"),
+	}); err != nil {
+		t.Fatalf("ReplaceForRegion() error = %v", err)
+	}
+	if err := repo.ReplaceForRegion(ctx, movieID, "GB", []*availability.Availability{
+		newTestAvailability(t, movieID, "BBC iPlayer", "GB", "free"),
+	}); err != nil {
+		t.Fatalf("ReplaceForRegion() error = %v", err)
+	}
+
+	// Replacing US keeps GB intact
+	if err := repo.ReplaceForRegion(ctx, movieID, "US", []*availability.Availability{
+		newTestAvailability(t, movieID, "Max", "US", "flatrate"),
+	}); err != nil {
+		t.Fatalf("ReplaceForRegion() error = %v", err)
+	}
+
+	us, err := repo.FindByMovieID(ctx, movieID, "US")
+	if err != nil {
+		t.Fatalf("FindByMovieID() error = %v", err)
+	}
+	if len(us) != 1 || us[0].Provider() != "Max" {
+		t.Fatalf("Expected only Max in US, got: %d entries", len(us))
+	}
+	if us[0].URL() != "https://example.com/Max" {
+		t.Errorf("Expected URL to round-trip, got: %s", us[0].URL())
+	}
+	if !us[0].LastChecked().Equal(time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected last checked to round-trip, got: %v", us[0].LastChecked())
+	}
+
+	all, err := repo.FindByMovieID(ctx, movieID, "")
+	if err != nil {
+		t.Fatalf("FindByMovieID() error = %v", err)
+	}
+	if len(all) != 2 || all[0].Region() != "GB" || all[1].Region() != "US" {
+		t.Errorf("Expected GB then US entries, got: %d entries", len(all))
+	}
+}
+
+func TestAvailabilityRepository_ReplaceForRegion_Clears(t *testing.T) {
+	db, movieID := setupAvailabilityTestDB(t)
+	defer db.Close()
+
+	repo := NewAvailabilityRepository(db)
+	ctx := context.Background()
+
+	if err := repo.ReplaceForRegion(ctx, movieID, "US", []*availability.Availability{
+		newTestAvailability(t, movieID, "Netflix", "US", "flatrate"),
+	}); err != nil {
+		t.Fatalf("ReplaceForRegion() error = %v", err)
+	}
+	if err := repo.ReplaceForRegion(ctx, movieID, "US", nil); err != nil {
+		t.Fatalf("ReplaceForRegion() error = %v", err)
+	}
+
+	entries, err := repo.FindByMovieID(ctx, movieID, "US")
+	if err != nil {
+		t.Fatalf("FindByMovieID() error = %v", err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("Expected no entries after clearing, got: %d", len(entries))
+	}
+}
+
+func TestAvailabilityRepository_ReplaceForRegion_RejectsMismatchedRegion(t *testing.T) {
+	db, movieID := setupAvailabilityTestDB(t)
+	defer db.Close()
+
+	repo := NewAvailabilityRepository(db)
+	ctx := context.Background()
+
+	err := repo.ReplaceForRegion(ctx, movieID, "US", []*availability.Availability{
+		newTestAvailability(t, movieID, "BBC iPlayer", "GB", "free"),
+	})
+	if err == nil {
+		t.Fatal("Expected error for entry in another region")
+	}
+}
+
+func TestAvailabilityRepository_ReplaceForRegion_MissingMovie(t *testing.T) {
+	db, _ := setupAvailabilityTestDB(t)
+	defer db.Close()
+
+	repo := NewAvailabilityRepository(db)
+	missingID, _ := shared.NewMovieID(999)
+
+	err := repo.ReplaceForRegion(context.Background(), missingID, "US", []*availability.Availability{
+		newTestAvailability(t, missingID, "Netflix", "US", "flatrate"),
+	})
+	if !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for a missing movie, got: %v", err)
+	}
+}
+
+func TestAvailabilityRepository_DeletedMovieCascades(t *testing.T) {
+	db, movieID := setupAvailabilityTestDB(t)
+	defer db.Close()
+
+	repo := NewAvailabilityRepository(db)
+	ctx := context.Background()
+
+	if err := repo.ReplaceForRegion(ctx, movieID, "US", []*availability.Availability{
+		newTestAvailability(t, movieID, "Netflix", "US", "flatrate"),
+	}); err != nil {
+		t.Fatalf("ReplaceForRegion() error = %v", err)
+	}
+	if _, err := db.Exec("DELETE FROM movies WHERE id = ?", movieID.Value()); err != nil {
+		t.Fatalf("failed to delete movie: %v", err)
+	}
+
+	entries, err := repo.FindByMovieID(ctx, movieID, "")
+	if err != nil {
+		t.Fatalf("FindByMovieID() error = %v", err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("Expected the movie's availability to be deleted with it, got: %d", len(entries))
+	}
+}
@@ -0,0 +1,63 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+// Retry policy for writes that still hit a lock after the connection's busy
+// timeout, e.g. when another process holds the database for longer
+const (
+	busyRetries      = 4
+	busyInitialDelay = 25 * time.Millisecond
+)
+
+// retryOnBusy runs a write, running it again with exponential backoff while
+// it fails because the database is busy or locked. Failed writes are rolled
+// back by SQLite, so repeating one is safe.
+func retryOnBusy(ctx context.Context, write func() error) error {
+	delay := busyInitialDelay
+	for attempt := 0; ; attempt++ {
+		err := write()
+		if err == nil || !isBusy(err) {
+			return err
+		}
+		if attempt == busyRetries {
+			return shared.NewUnavailableError("database is busy: %w", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return shared.NewUnavailableError("database is busy: %w", err)
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// writeTransaction runs fn in a transaction, starting the transaction over
+// while the database is busy; fn must not depend on state from a failed run
+func writeTransaction(ctx context.Context, txManager *database.TransactionManager, fn func(*sql.Tx) error) error {
+	return retryOnBusy(ctx, func() error {
+		return txManager.WithTransaction(ctx, fn)
+	})
+}
+
+// isBusy reports whether an error is SQLITE_BUSY or SQLITE_LOCKED, including
+// their extended codes
+func isBusy(err error) bool {
+	var sqliteErr *sqlite.Error
+	if !errors.As(err, &sqliteErr) {
+		return false
+	}
+	code := sqliteErr.Code() & 0xff
+	return code == sqlite3.SQLITE_BUSY || code == sqlite3.SQLITE_LOCKED
+}
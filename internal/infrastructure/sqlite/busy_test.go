@@ -0,0 +1,161 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/history"
+	"github.com/francknouama/movies-mcp-server/internal/domain/media"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	_ "modernc.org/sqlite"
+)
+
+// openFileTestDB opens a WAL database file with the given busy timeout and
+// pool size, creating schema on first use
+func openFileTestDB(t *testing.T, path string, busyTimeout time.Duration, maxOpen int, schema string) *sql.DB {
+	t.Helper()
+
+	dsn := fmt.Sprintf("%s?_pragma=busy_timeout(%d)&_pragma=journal_mode(WAL)&_txlock=immediate&_time_format=sqlite",
+		path, busyTimeout.Milliseconds())
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		t.Fatalf("failed to open test database: %v", err)
+	}
+	db.SetMaxOpenConns(maxOpen)
+	t.Cleanup(func() { db.Close() })
+
+	if schema != "" {
+		if _, err := db.Exec(schema); err != nil {
+			t.Fatalf("failed to create test schema: %v", err)
+		}
+	}
+	return db
+}
+
+func TestRetryOnBusy(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "busy.db")
+	db := openFileTestDB(t, path, 0, 1, historyTestSchema)
+
+	// A second handle holds the write lock, standing in for another process
+	holder := openFileTestDB(t, path, 0, 1, "")
+	lock, err := holder.Begin()
+	if err != nil {
+		t.Fatalf("failed to take write lock: %v", err)
+	}
+
+	write := func() error {
+		_, err := db.Exec("INSERT INTO movie_history (movie_id, version, operation, diff) VALUES (1, 1, 'create', '{}')")
+		return err
+	}
+	if err := write(); !isBusy(err) {
+		t.Fatalf("Expected a busy error while the lock is held, got: %v", err)
+	}
+
+	time.AfterFunc(60*time.Millisecond, func() { _ = lock.Rollback() })
+	if err := retryOnBusy(context.Background(), write); err != nil {
+		t.Errorf("Expected the write to succeed once the lock is released, got: %v", err)
+	}
+}
+
+func TestRetryOnBusy_StopsOnOtherErrors(t *testing.T) {
+	attempts := 0
+	err := retryOnBusy(context.Background(), func() error {
+		attempts++
+		return errors.New("constraint failed")
+	})
+
+	if err == nil || attempts != 1 {
+		t.Errorf("Expected a single failed attempt, got %d attempts and error %v", attempts, err)
+	}
+}
+
+func TestRetryOnBusy_StopsWhenCancelled(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "busy.db")
+	db := openFileTestDB(t, path, 0, 1, historyTestSchema)
+	holder := openFileTestDB(t, path, 0, 1, "")
+	lock, err := holder.Begin()
+	if err != nil {
+		t.Fatalf("failed to take write lock: %v", err)
+	}
+	defer func() { _ = lock.Rollback() }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	attempts := 0
+	err = retryOnBusy(ctx, func() error {
+		attempts++
+		_, err := db.Exec("INSERT INTO movie_history (movie_id, version, operation, diff) VALUES (1, 1, 'create', '{}')")
+		return err
+	})
+
+	if !isBusy(err) || attempts != 1 {
+		t.Errorf("Expected one busy attempt after cancellation, got %d attempts and error %v", attempts, err)
+	}
+}
+
+func TestConcurrentWriters(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "concurrent.db")
+	db := openFileTestDB(t, path, 5*time.Second, 4, historyTestSchema+mediaTestSchema)
+
+	historyRepo := NewHistoryRepository(db)
+	mediaRepo := NewMediaRepository(db)
+	ctx := context.Background()
+	movieID, _ := shared.NewMovieID(1)
+
+	const writers = 8
+	const writesPerWriter = 10
+
+	var wg sync.WaitGroup
+	errs := make(chan error, writers*writesPerWriter*2)
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < writesPerWriter; i++ {
+				entry, _ := history.NewEntry(movieID, history.OperationUpdate, history.Diff{}, 0, time.Time{})
+				errs <- historyRepo.Append(ctx, entry)
+
+				link := fmt.Sprintf("https://example.com/%d/%d.jpg", w, i)
+				still, err := media.NewMedia(movieID, "still", link, "", "", false)
+				if err != nil {
+					errs <- err
+					continue
+				}
+				errs <- mediaRepo.Save(ctx, still)
+			}
+		}(w)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("Expected concurrent writes to succeed, got: %v", err)
+		}
+	}
+
+	entries, err := historyRepo.FindByMovieID(ctx, movieID)
+	if err != nil {
+		t.Fatalf("FindByMovieID() error = %v", err)
+	}
+	if len(entries) != writers*writesPerWriter {
+		t.Fatalf("Expected %d history entries, got: %d", writers*writesPerWriter, len(entries))
+	}
+	for i, entry := range entries {
+		if entry.Version() != i+1 {
+			t.Fatalf("Expected contiguous versions, got version %d at position %d", entry.Version(), i)
+		}
+	}
+
+	media, _ := mediaRepo.FindByMovieID(ctx, movieID, "")
+	if len(media) != writers*writesPerWriter {
+		t.Errorf("Expected %d media, got: %d", writers*writesPerWriter, len(media))
+	}
+}
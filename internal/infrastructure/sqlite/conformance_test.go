@@ -0,0 +1,59 @@
+package sqlite
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/infrastructure/conformance"
+	_ "modernc.org/sqlite"
+)
+
+// migrationsDir holds the migrations the server applies at startup
+const migrationsDir = "../../../migrations"
+
+// newConformanceRepositories creates repositories over an in-memory database
+// built from the real migrations, so the suite checks the schema as well.
+// Foreign keys are enforced as on the server's connections.
+func newConformanceRepositories(t *testing.T) conformance.Repositories {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", ":memory:?_time_format=sqlite&_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatalf("failed to open test database: %v", err)
+	}
+	db.SetMaxOpenConns(1) // Each in-memory connection is its own database
+	t.Cleanup(func() { db.Close() })
+
+	migrations, err := filepath.Glob(filepath.Join(migrationsDir, "*.up.sql"))
+	if err != nil || len(migrations) == 0 {
+		t.Fatalf("failed to find migrations: %v", err)
+	}
+	for _, path := range migrations {
+		migration, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("failed to read migration: %v", err)
+		}
+		if _, err := db.Exec(string(migration)); err != nil {
+			t.Fatalf("failed to apply %s: %v", filepath.Base(path), err)
+		}
+	}
+
+	return conformance.Repositories{
+		Movies: NewMovieRepository(db),
+		Actors: NewActorRepository(db),
+	}
+}
+
+func TestMovieRepositoryConformance(t *testing.T) {
+	conformance.TestMovieRepository(t, newConformanceRepositories)
+}
+
+func TestActorRepositoryConformance(t *testing.T) {
+	conformance.TestActorRepository(t, newConformanceRepositories)
+}
+
+func TestReferentialIntegrityConformance(t *testing.T) {
+	conformance.TestReferentialIntegrity(t, newConformanceRepositories)
+}
@@ -0,0 +1,36 @@
+package sqlite
+
+import (
+	"errors"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+// notFound classifies a missing row reported by the database helpers as
+// shared.ErrNotFound, keeping the helper's message
+func notFound(err error) error {
+	if errors.Is(err, database.ErrNotFound) {
+		return shared.NewNotFoundError("%w", err)
+	}
+	return err
+}
+
+// missingReference classifies a foreign key violation, a row referring to
+// an entity that does not exist, as shared.ErrNotFound naming that entity
+func missingReference(err error, entity string, id int) error {
+	if isForeignKeyViolation(err) {
+		return shared.NewNotFoundError("%s %d not found: %w", entity, id, err)
+	}
+	return err
+}
+
+// isForeignKeyViolation reports whether an error is
+// SQLITE_CONSTRAINT_FOREIGNKEY
+func isForeignKeyViolation(err error) bool {
+	var sqliteErr *sqlite.Error
+	return errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY
+}
@@ -0,0 +1,219 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/franchise"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+// FranchiseRepository implements the franchise.Repository interface for SQLite
+type FranchiseRepository struct {
+	*database.BaseRepository
+	txManager *database.TransactionManager
+}
+
+// NewFranchiseRepository creates a new SQLite franchise repository
+func NewFranchiseRepository(db *sql.DB) *FranchiseRepository {
+	return &FranchiseRepository{
+		BaseRepository: database.NewBaseRepository(db),
+		txManager:      database.NewTransactionManager(db),
+	}
+}
+
+// dbFranchise represents the database model for franchises
+type dbFranchise struct {
+	ID          int            `db:"id"`
+	Name        string         `db:"name"`
+	Description sql.NullString `db:"description"`
+}
+
+// Save persists a franchise and its movie order (insert or update)
+func (r *FranchiseRepository) Save(ctx context.Context, domainFranchise *franchise.Franchise) error {
+	description := sql.NullString{String: domainFranchise.Description(), Valid: domainFranchise.Description() != ""}
+	isNew := domainFranchise.ID().IsZero() // Decided once; a retried insert has already set the ID
+
+	return writeTransaction(ctx, r.txManager, func(tx *sql.Tx) error {
+		helper := database.NewTransactionHelper(tx)
+
+		if isNew {
+			query := `
+				INSERT INTO franchises (name, description, created_at, updated_at)
+				VALUES (?, ?, ?, ?)
+				RETURNING id`
+
+			id, err := helper.InsertWithID(ctx, query,
+				domainFranchise.Name(),
+				description,
+				domainFranchise.CreatedAt(),
+				domainFranchise.UpdatedAt(),
+			)
+			if err != nil {
+				return fmt.Errorf("failed to insert franchise: %w", err)
+			}
+
+			franchiseID, err := shared.NewFranchiseID(id)
+			if err != nil {
+				return fmt.Errorf("failed to create franchise ID: %w", err)
+			}
+			domainFranchise.SetID(franchiseID)
+		} else {
+			query := `
+				UPDATE franchises
+				SET name = ?, description = ?, updated_at = ?
+				WHERE id = ?`
+
+			if err := helper.Update(ctx, query, "franchise",
+				domainFranchise.Name(),
+				description,
+				domainFranchise.UpdatedAt(),
+				domainFranchise.ID().Value(),
+			); err != nil {
+				return notFound(err)
+			}
+		}
+
+		if err := r.replaceMovies(ctx, tx, domainFranchise); err != nil {
+			return fmt.Errorf("failed to save franchise movies: %w", err)
+		}
+		return nil
+	})
+}
+
+// replaceMovies rewrites the franchise's movie links with their current positions
+func (r *FranchiseRepository) replaceMovies(ctx context.Context, tx *sql.Tx, domainFranchise *franchise.Franchise) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM franchise_movies WHERE franchise_id = ?", domainFranchise.ID().Value()); err != nil {
+		return fmt.Errorf("failed to delete existing franchise movies: %w", err)
+	}
+
+	query := `
+		INSERT INTO franchise_movies (franchise_id, movie_id, position, created_at)
+		VALUES (?, ?, ?, CURRENT_TIMESTAMP)`
+
+	for i, movieID := range domainFranchise.MovieIDs() {
+		if _, err := tx.ExecContext(ctx, query, domainFranchise.ID().Value(), movieID.Value(), i+1); err != nil {
+			return missingReference(fmt.Errorf("failed to insert franchise movie: %w", err), "movie", movieID.Value())
+		}
+	}
+	return nil
+}
+
+// FindByID retrieves a franchise by its ID
+func (r *FranchiseRepository) FindByID(ctx context.Context, id shared.FranchiseID) (*franchise.Franchise, error) {
+	query := "SELECT id, name, description FROM franchises WHERE id = ?"
+
+	var row dbFranchise
+	if err := r.QueryRowContext(ctx, query, id.Value()).Scan(&row.ID, &row.Name, &row.Description); err != nil {
+		return nil, notFound(r.WrapNotFound(err, "franchise"))
+	}
+
+	return r.loadFranchise(ctx, &row)
+}
+
+// FindAll retrieves every franchise ordered by name
+func (r *FranchiseRepository) FindAll(ctx context.Context) ([]*franchise.Franchise, error) {
+	return r.findFranchises(ctx, `
+		SELECT id, name, description
+		FROM franchises
+		ORDER BY name COLLATE NOCASE ASC`)
+}
+
+// FindByMovieID retrieves the franchises a movie belongs to
+func (r *FranchiseRepository) FindByMovieID(ctx context.Context, movieID shared.MovieID) ([]*franchise.Franchise, error) {
+	return r.findFranchises(ctx, `
+		SELECT f.id, f.name, f.description
+		FROM franchises f
+		INNER JOIN franchise_movies fm ON f.id = fm.franchise_id
+		WHERE fm.movie_id = ?
+		ORDER BY f.name COLLATE NOCASE ASC`, movieID.Value())
+}
+
+// Delete removes a franchise by ID; its movies are kept
+func (r *FranchiseRepository) Delete(ctx context.Context, id shared.FranchiseID) error {
+	return writeTransaction(ctx, r.txManager, func(tx *sql.Tx) error {
+		helper := database.NewTransactionHelper(tx)
+
+		// Delete movie links first (foreign key constraints)
+		if _, err := tx.ExecContext(ctx, "DELETE FROM franchise_movies WHERE franchise_id = ?", id.Value()); err != nil {
+			return fmt.Errorf("failed to delete franchise movies: %w", err)
+		}
+
+		return notFound(helper.Delete(ctx, "DELETE FROM franchises WHERE id = ?", "franchise", id.Value()))
+	})
+}
+
+// findFranchises runs a franchise query and loads each result's movies
+func (r *FranchiseRepository) findFranchises(ctx context.Context, query string, args ...interface{}) ([]*franchise.Franchise, error) {
+	rows, err := r.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query franchises: %w", err)
+	}
+
+	var found []dbFranchise
+	for rows.Next() {
+		var row dbFranchise
+		if err := rows.Scan(&row.ID, &row.Name, &row.Description); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan franchise: %w", err)
+		}
+		found = append(found, row)
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return nil, fmt.Errorf("failed to query franchises: %w", err)
+	}
+	rows.Close() // Release the connection before loading movies; SQLite uses a single one
+
+	franchises := make([]*franchise.Franchise, 0, len(found))
+	for i := range found {
+		domainFranchise, err := r.loadFranchise(ctx, &found[i])
+		if err != nil {
+			return nil, err
+		}
+		franchises = append(franchises, domainFranchise)
+	}
+	return franchises, nil
+}
+
+// loadFranchise converts a database row to a domain franchise with its movies in order
+func (r *FranchiseRepository) loadFranchise(ctx context.Context, row *dbFranchise) (*franchise.Franchise, error) {
+	franchiseID, err := shared.NewFranchiseID(row.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid franchise ID: %w", err)
+	}
+
+	domainFranchise, err := franchise.NewFranchiseWithID(franchiseID, row.Name, row.Description.String)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain franchise: %w", err)
+	}
+
+	query := "SELECT movie_id FROM franchise_movies WHERE franchise_id = ? ORDER BY position ASC"
+	rows, err := r.QueryContext(ctx, query, franchiseID.Value())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query franchise movies: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var movieIDValue int
+		if err := rows.Scan(&movieIDValue); err != nil {
+			return nil, fmt.Errorf("failed to scan movie ID: %w", err)
+		}
+
+		movieID, err := shared.NewMovieID(movieIDValue)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create movie ID: %w", err)
+		}
+		if err := domainFranchise.PlaceMovie(movieID, 0); err != nil {
+			return nil, fmt.Errorf("failed to add movie to franchise: %w", err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query franchise movies: %w", err)
+	}
+
+	return domainFranchise, nil
+}
@@ -0,0 +1,202 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/franchise"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	_ "modernc.org/sqlite"
+)
+
+// setupFranchiseTestDB creates an in-memory SQLite database with three movies
+func setupFranchiseTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", ":memory:?_time_format=sqlite")
+	if err != nil {
+		t.Fatalf("failed to open test database: %v", err)
+	}
+	db.SetMaxOpenConns(1) // Catches nested queries, which deadlock a one-connection pool
+
+	schema := `
+	CREATE TABLE movies (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		title TEXT NOT NULL
+	);
+
+	CREATE TABLE franchises (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		name TEXT NOT NULL UNIQUE COLLATE NOCASE,
+		description TEXT,
+		created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+		updated_at TEXT DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE TABLE franchise_movies (
+		franchise_id INTEGER NOT NULL,
+		movie_id INTEGER NOT NULL,
+		position INTEGER NOT NULL,
+		created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (franchise_id, movie_id)
+	);
+
+	INSERT INTO movies (id, title) VALUES (1, 'The Fellowship of the Ring'), (2, 'The Two Towers'), (3, 'The Hobbit');`
+
+	if _, err := db.Exec(schema); err != nil {
+		t.Fatalf("failed to create test schema: %v", err)
+	}
+
+	return db
+}
+
+func newTestFranchise(t *testing.T, name string, movieIDs ...int) *franchise.Franchise {
+	t.Helper()
+
+	domainFranchise, err := franchise.NewFranchise(name, "")
+	if err != nil {
+		t.Fatalf("failed to create franchise: %v", err)
+	}
+	for _, id := range movieIDs {
+		movieID, _ := shared.NewMovieID(id)
+		if err := domainFranchise.PlaceMovie(movieID, 0); err != nil {
+			t.Fatalf("failed to place movie: %v", err)
+		}
+	}
+	return domainFranchise
+}
+
+func TestFranchiseRepository_SaveAndFind(t *testing.T) {
+	db := setupFranchiseTestDB(t)
+	defer db.Close()
+
+	repo := NewFranchiseRepository(db)
+	ctx := context.Background()
+
+	middleEarth := newTestFranchise(t, "Middle-earth", 3, 1, 2)
+	middleEarth.SetDescription("Tolkien adaptations")
+	if err := repo.Save(ctx, middleEarth); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if middleEarth.ID().IsZero() {
+		t.Fatal("Expected franchise ID to be assigned")
+	}
+
+	found, err := repo.FindByID(ctx, middleEarth.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found.Name() != "Middle-earth" || found.Description() != "Tolkien adaptations" {
+		t.Errorf("Expected saved fields, got: %s / %s", found.Name(), found.Description())
+	}
+	if got := found.MovieIDs(); len(got) != 3 || got[0].Value() != 3 || got[1].Value() != 1 || got[2].Value() != 2 {
+		t.Errorf("Expected movies in saved order [3 1 2], got: %v", got)
+	}
+}
+
+func TestFranchiseRepository_Update(t *testing.T) {
+	db := setupFranchiseTestDB(t)
+	defer db.Close()
+
+	repo := NewFranchiseRepository(db)
+	ctx := context.Background()
+
+	domainFranchise := newTestFranchise(t, "Rings", 1, 2)
+	if err := repo.Save(ctx, domainFranchise); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	_ = domainFranchise.SetName("The Lord of the Rings")
+	movieID, _ := shared.NewMovieID(2)
+	_ = domainFranchise.RemoveMovie(movieID)
+	if err := repo.Save(ctx, domainFranchise); err != nil {
+		t.Fatalf("Save() update error = %v", err)
+	}
+
+	found, err := repo.FindByID(ctx, domainFranchise.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found.Name() != "The Lord of the Rings" || found.MovieCount() != 1 {
+		t.Errorf("Expected renamed franchise with 1 movie, got: %s with %d", found.Name(), found.MovieCount())
+	}
+}
+
+func TestFranchiseRepository_DuplicateName(t *testing.T) {
+	db := setupFranchiseTestDB(t)
+	defer db.Close()
+
+	repo := NewFranchiseRepository(db)
+	ctx := context.Background()
+
+	if err := repo.Save(ctx, newTestFranchise(t, "Middle-earth")); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if err := repo.Save(ctx, newTestFranchise(t, "middle-EARTH")); err == nil {
+		t.Error("Expected error for duplicate name")
+	}
+}
+
+func TestFranchiseRepository_FindAllAndByMovie(t *testing.T) {
+	db := setupFranchiseTestDB(t)
+	defer db.Close()
+
+	repo := NewFranchiseRepository(db)
+	ctx := context.Background()
+
+	for _, domainFranchise := range []*franchise.Franchise{
+		newTestFranchise(t, "The Lord of the Rings", 1, 2),
+		newTestFranchise(t, "Middle-earth", 3, 1, 2),
+		newTestFranchise(t, "Hobbit", 3),
+	} {
+		if err := repo.Save(ctx, domainFranchise); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+
+	all, err := repo.FindAll(ctx)
+	if err != nil {
+		t.Fatalf("FindAll() error = %v", err)
+	}
+	if len(all) != 3 || all[0].Name() != "Hobbit" || all[2].MovieCount() != 2 {
+		t.Errorf("Expected 3 franchises by name with movies loaded, got: %d", len(all))
+	}
+
+	movieID, _ := shared.NewMovieID(1)
+	withMovie, err := repo.FindByMovieID(ctx, movieID)
+	if err != nil {
+		t.Fatalf("FindByMovieID() error = %v", err)
+	}
+	if len(withMovie) != 2 || withMovie[0].Name() != "Middle-earth" {
+		t.Errorf("Expected 2 franchises containing movie 1, got: %d", len(withMovie))
+	}
+}
+
+func TestFranchiseRepository_Delete(t *testing.T) {
+	db := setupFranchiseTestDB(t)
+	defer db.Close()
+
+	repo := NewFranchiseRepository(db)
+	ctx := context.Background()
+
+	domainFranchise := newTestFranchise(t, "Rings", 1)
+	if err := repo.Save(ctx, domainFranchise); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	if err := repo.Delete(ctx, domainFranchise.ID()); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := repo.FindByID(ctx, domainFranchise.ID()); err == nil {
+		t.Error("Expected error finding deleted franchise")
+	}
+
+	var links int
+	if err := db.QueryRow("SELECT COUNT(*) FROM franchise_movies").Scan(&links); err != nil || links != 0 {
+		t.Errorf("Expected franchise movies to be deleted, got: %d (err %v)", links, err)
+	}
+	if err := repo.Delete(ctx, domainFranchise.ID()); err == nil {
+		t.Error("Expected error deleting a missing franchise")
+	}
+}
@@ -0,0 +1,247 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/history"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+// appendQuery inserts a history entry, assigning the version in the insert
+// itself so concurrent appends cannot share one
+const appendQuery = `
+	INSERT INTO movie_history (movie_id, version, operation, diff, reverted_to, created_at)
+	VALUES (?, (SELECT COALESCE(MAX(version), 0) + 1 FROM movie_history WHERE movie_id = ?), ?, ?, ?, ?)
+	RETURNING id, version`
+
+// HistoryRepository implements the history.Repository interface for SQLite
+type HistoryRepository struct {
+	*database.BaseRepository
+	txManager *database.TransactionManager
+}
+
+// NewHistoryRepository creates a new SQLite history repository
+func NewHistoryRepository(db *sql.DB) *HistoryRepository {
+	return &HistoryRepository{
+		BaseRepository: database.NewBaseRepository(db),
+		txManager:      database.NewTransactionManager(db),
+	}
+}
+
+// dbHistoryEntry represents the database model for a movie history entry
+type dbHistoryEntry struct {
+	ID         int           `db:"id"`
+	MovieID    int           `db:"movie_id"`
+	Version    int           `db:"version"`
+	Operation  string        `db:"operation"`
+	Diff       string        `db:"diff"`
+	RevertedTo sql.NullInt64 `db:"reverted_to"`
+	CreatedAt  sql.NullTime  `db:"created_at"`
+}
+
+// FindByMovieID retrieves a movie's history, oldest version first
+func (r *HistoryRepository) FindByMovieID(ctx context.Context, movieID shared.MovieID) ([]*history.Entry, error) {
+	query := `
+		SELECT id, movie_id, version, operation, diff, reverted_to, created_at
+		FROM movie_history
+		WHERE movie_id = ?
+		ORDER BY version ASC`
+
+	rows, err := r.QueryContext(ctx, query, movieID.Value())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query history: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []*history.Entry{}
+	for rows.Next() {
+		var row dbHistoryEntry
+		if err := rows.Scan(
+			&row.ID,
+			&row.MovieID,
+			&row.Version,
+			&row.Operation,
+			&row.Diff,
+			&row.RevertedTo,
+			(*textTime)(&row.CreatedAt),
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan history: %w", err)
+		}
+
+		entry, err := r.toDomainModel(&row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert to domain model: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query history: %w", err)
+	}
+
+	return entries, nil
+}
+
+// Append saves an entry as the movie's next version
+func (r *HistoryRepository) Append(ctx context.Context, entry *history.Entry) error {
+	args, err := appendArgs(entry)
+	if err != nil {
+		return err
+	}
+
+	return retryOnBusy(ctx, func() error {
+		var id, version int
+		if err := r.QueryRowContext(ctx, appendQuery, args...).Scan(&id, &version); err != nil {
+			return fmt.Errorf("failed to save history: %w", err)
+		}
+
+		entry.SetSaved(id, version)
+		return nil
+	})
+}
+
+// AppendAll saves entries in a single transaction with one prepared
+// statement, for recording bulk imports
+func (r *HistoryRepository) AppendAll(ctx context.Context, entries []*history.Entry) error {
+	allArgs := make([][]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		args, err := appendArgs(entry)
+		if err != nil {
+			return err
+		}
+		allArgs = append(allArgs, args)
+	}
+
+	var ids, versions []int
+	err := writeTransaction(ctx, r.txManager, func(tx *sql.Tx) error {
+		stmt, err := tx.PrepareContext(ctx, appendQuery)
+		if err != nil {
+			return fmt.Errorf("failed to prepare history insert: %w", err)
+		}
+		defer stmt.Close()
+
+		ids, versions = make([]int, len(entries)), make([]int, len(entries))
+		for i, args := range allArgs {
+			if err := stmt.QueryRowContext(ctx, args...).Scan(&ids[i], &versions[i]); err != nil {
+				return fmt.Errorf("failed to save history: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, entry := range entries {
+		entry.SetSaved(ids[i], versions[i])
+	}
+	return nil
+}
+
+// PurgeBefore deletes history entries created before cutoff and returns
+// how many it deleted. Each movie keeps its latest entry, so versions keep
+// counting up from it. Timestamps are compared after reading them back, as
+// the stored text keeps the zone of whoever wrote it.
+func (r *HistoryRepository) PurgeBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	ids, err := r.purgeableIDs(ctx, cutoff)
+	if err != nil || len(ids) == 0 {
+		return 0, err
+	}
+
+	deleted := 0
+	err = writeTransaction(ctx, r.txManager, func(tx *sql.Tx) error {
+		stmt, err := tx.PrepareContext(ctx, "DELETE FROM movie_history WHERE id = ?")
+		if err != nil {
+			return fmt.Errorf("failed to prepare history purge: %w", err)
+		}
+		defer stmt.Close()
+
+		deleted = 0
+		for _, id := range ids {
+			result, err := stmt.ExecContext(ctx, id)
+			if err != nil {
+				return fmt.Errorf("failed to purge history: %w", err)
+			}
+			n, _ := result.RowsAffected()
+			deleted += int(n)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return deleted, nil
+}
+
+// purgeableIDs returns the IDs of entries created before cutoff that are
+// not their movie's latest
+func (r *HistoryRepository) purgeableIDs(ctx context.Context, cutoff time.Time) ([]int, error) {
+	query := `
+		SELECT id, created_at
+		FROM movie_history h
+		WHERE version < (SELECT MAX(version) FROM movie_history WHERE movie_id = h.movie_id)`
+
+	rows, err := r.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query history: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		var createdAt sql.NullTime
+		if err := rows.Scan(&id, (*textTime)(&createdAt)); err != nil {
+			return nil, fmt.Errorf("failed to scan history: %w", err)
+		}
+		if createdAt.Valid && createdAt.Time.Before(cutoff) {
+			ids = append(ids, id)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query history: %w", err)
+	}
+	return ids, nil
+}
+
+// appendArgs returns the appendQuery arguments for an entry
+func appendArgs(entry *history.Entry) ([]interface{}, error) {
+	diff, err := json.Marshal(entry.Diff())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode history diff: %w", err)
+	}
+
+	revertedTo := sql.NullInt64{Int64: int64(entry.RevertedTo()), Valid: entry.RevertedTo() != 0}
+	return []interface{}{
+		entry.MovieID().Value(),
+		entry.MovieID().Value(),
+		string(entry.Operation()),
+		string(diff),
+		revertedTo,
+		entry.CreatedAt(),
+	}, nil
+}
+
+// toDomainModel converts a database row to a domain history entry
+func (r *HistoryRepository) toDomainModel(row *dbHistoryEntry) (*history.Entry, error) {
+	movieID, err := shared.NewMovieID(row.MovieID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create movie ID: %w", err)
+	}
+
+	var diff history.Diff
+	if err := json.Unmarshal([]byte(row.Diff), &diff); err != nil {
+		return nil, fmt.Errorf("failed to decode history diff: %w", err)
+	}
+
+	entry, err := history.NewEntry(movieID, history.Operation(row.Operation), diff, int(row.RevertedTo.Int64), row.CreatedAt.Time)
+	if err != nil {
+		return nil, err
+	}
+	entry.SetSaved(row.ID, row.Version)
+	return entry, nil
+}
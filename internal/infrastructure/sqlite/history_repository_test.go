@@ -0,0 +1,211 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/history"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	_ "modernc.org/sqlite"
+)
+
+// historyTestSchema is the movie_history table from the migrations
+const historyTestSchema = `
+	CREATE TABLE movie_history (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		movie_id INTEGER NOT NULL,
+		version INTEGER NOT NULL,
+		operation TEXT NOT NULL,
+		diff TEXT NOT NULL,
+		reverted_to INTEGER,
+		created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE (movie_id, version)
+	);`
+
+// setupHistoryTestDB creates an in-memory SQLite database for history testing
+func setupHistoryTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", ":memory:?_time_format=sqlite")
+	if err != nil {
+		t.Fatalf("failed to open test database: %v", err)
+	}
+	db.SetMaxOpenConns(1) // Each in-memory connection is its own database
+
+	if _, err := db.Exec(historyTestSchema); err != nil {
+		t.Fatalf("failed to create test schema: %v", err)
+	}
+
+	return db
+}
+
+func newTestHistoryEntry(t *testing.T, movieID int, operation history.Operation, diff history.Diff, revertedTo int) *history.Entry {
+	t.Helper()
+
+	id, _ := shared.NewMovieID(movieID)
+	entry, err := history.NewEntry(id, operation, diff, revertedTo, time.Time{})
+	if err != nil {
+		t.Fatalf("failed to create history entry: %v", err)
+	}
+	return entry
+}
+
+func TestHistoryRepository_AppendAndFindByMovieID(t *testing.T) {
+	db := setupHistoryTestDB(t)
+	defer db.Close()
+
+	repo := NewHistoryRepository(db)
+	ctx := context.Background()
+
+	ratingChange := history.Diff{"rating": {Old: []byte("8"), New: []byte("9")}}
+	entries := []*history.Entry{
+		newTestHistoryEntry(t, 1, history.OperationCreate, history.Diff{"title": {New: []byte(`"Inception"`)}}, 0),
+		newTestHistoryEntry(t, 2, history.OperationCreate, history.Diff{"title": {New: []byte(`"Heat"`)}}, 0),
+		newTestHistoryEntry(t, 1, history.OperationUpdate, ratingChange, 0),
+		newTestHistoryEntry(t, 1, history.OperationRevert, history.Diff{"rating": {Old: []byte("9"), New: []byte("8")}}, 1),
+	}
+	for _, entry := range entries {
+		if err := repo.Append(ctx, entry); err != nil {
+			t.Fatalf("Append() error = %v", err)
+		}
+	}
+
+	wantVersions := []int{1, 1, 2, 3}
+	for i, entry := range entries {
+		if entry.ID() == 0 {
+			t.Error("Expected ID to be set after append")
+		}
+		if entry.Version() != wantVersions[i] {
+			t.Errorf("Expected entry %d to get version %d, got: %d", i, wantVersions[i], entry.Version())
+		}
+	}
+
+	movieID, _ := shared.NewMovieID(1)
+	results, err := repo.FindByMovieID(ctx, movieID)
+	if err != nil {
+		t.Fatalf("FindByMovieID() error = %v", err)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 entries, got: %d", len(results))
+	}
+	if results[0].Operation() != history.OperationCreate || results[2].Operation() != history.OperationRevert {
+		t.Errorf("Expected entries oldest first, got: %s ... %s", results[0].Operation(), results[2].Operation())
+	}
+	if string(results[1].Diff()["rating"].Old) != "8" || string(results[1].Diff()["rating"].New) != "9" {
+		t.Errorf("Expected rating diff to round-trip, got: %+v", results[1].Diff())
+	}
+	if results[2].RevertedTo() != 1 || results[1].RevertedTo() != 0 {
+		t.Errorf("Expected only the revert to name a version, got: %d and %d", results[1].RevertedTo(), results[2].RevertedTo())
+	}
+	if results[0].CreatedAt().IsZero() {
+		t.Error("Expected created_at to be read back")
+	}
+}
+
+func TestHistoryRepository_FindByMovieID_NoHistory(t *testing.T) {
+	db := setupHistoryTestDB(t)
+	defer db.Close()
+
+	repo := NewHistoryRepository(db)
+	movieID, _ := shared.NewMovieID(1)
+
+	results, err := repo.FindByMovieID(context.Background(), movieID)
+	if err != nil {
+		t.Fatalf("FindByMovieID() error = %v", err)
+	}
+	if results == nil || len(results) != 0 {
+		t.Errorf("Expected empty non-nil history, got: %v", results)
+	}
+}
+
+func TestHistoryRepository_AppendAll(t *testing.T) {
+	db := setupHistoryTestDB(t)
+	defer db.Close()
+
+	repo := NewHistoryRepository(db)
+	ctx := context.Background()
+
+	if err := repo.Append(ctx, newTestHistoryEntry(t, 1, history.OperationCreate, history.Diff{"title": {New: []byte(`"Inception"`)}}, 0)); err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+
+	ratingChange := history.Diff{"rating": {Old: []byte("8"), New: []byte("9")}}
+	entries := []*history.Entry{
+		newTestHistoryEntry(t, 1, history.OperationUpdate, ratingChange, 0),
+		newTestHistoryEntry(t, 2, history.OperationCreate, history.Diff{"title": {New: []byte(`"Heat"`)}}, 0),
+		newTestHistoryEntry(t, 1, history.OperationUpdate, ratingChange, 0),
+	}
+	if err := repo.AppendAll(ctx, entries); err != nil {
+		t.Fatalf("AppendAll() error = %v", err)
+	}
+
+	wantVersions := []int{2, 1, 3}
+	for i, entry := range entries {
+		if entry.ID() == 0 || entry.Version() != wantVersions[i] {
+			t.Errorf("Expected entry %d to be saved as version %d, got: id %d version %d", i, wantVersions[i], entry.ID(), entry.Version())
+		}
+	}
+
+	movieID, _ := shared.NewMovieID(1)
+	results, err := repo.FindByMovieID(ctx, movieID)
+	if err != nil {
+		t.Fatalf("FindByMovieID() error = %v", err)
+	}
+	if len(results) != 3 {
+		t.Errorf("Expected 3 entries, got: %d", len(results))
+	}
+}
+
+func TestHistoryRepository_PurgeBefore(t *testing.T) {
+	db := setupHistoryTestDB(t)
+	defer db.Close()
+
+	repo := NewHistoryRepository(db)
+	ctx := context.Background()
+
+	cutoff := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	old := cutoff.Add(-48 * time.Hour).In(time.FixedZone("CET", 3600))
+	ratingChange := history.Diff{"rating": {Old: []byte("8"), New: []byte("9")}}
+	for _, args := range []struct {
+		movieID   int
+		createdAt time.Time
+	}{
+		{1, old}, {1, old}, {1, cutoff.Add(time.Hour)}, {1, cutoff.Add(2 * time.Hour)},
+		{2, old}, // Heat's only entry is its latest, so it stays
+	} {
+		id, _ := shared.NewMovieID(args.movieID)
+		entry, err := history.NewEntry(id, history.OperationUpdate, ratingChange, 0, args.createdAt)
+		if err != nil {
+			t.Fatalf("failed to create history entry: %v", err)
+		}
+		if err := repo.Append(ctx, entry); err != nil {
+			t.Fatalf("Append() error = %v", err)
+		}
+	}
+
+	deleted, err := repo.PurgeBefore(ctx, cutoff)
+	if err != nil {
+		t.Fatalf("PurgeBefore() error = %v", err)
+	}
+	if deleted != 2 {
+		t.Errorf("Expected 2 entries purged, got: %d", deleted)
+	}
+
+	inception, _ := shared.NewMovieID(1)
+	results, _ := repo.FindByMovieID(ctx, inception)
+	if len(results) != 2 || results[0].Version() != 3 || results[1].Version() != 4 {
+		t.Errorf("Expected versions 3 and 4 to remain, got: %d entries", len(results))
+	}
+	heat, _ := shared.NewMovieID(2)
+	if results, _ := repo.FindByMovieID(ctx, heat); len(results) != 1 {
+		t.Errorf("Expected Heat's latest entry to remain, got: %d entries", len(results))
+	}
+
+	next := newTestHistoryEntry(t, 1, history.OperationUpdate, ratingChange, 0)
+	if err := repo.Append(ctx, next); err != nil || next.Version() != 5 {
+		t.Errorf("Expected the next version to be 5, got: %d (error %v)", next.Version(), err)
+	}
+}
@@ -0,0 +1,168 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/media"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+// MediaRepository implements the media.Repository interface for SQLite
+type MediaRepository struct {
+	*database.BaseRepository
+	txManager *database.TransactionManager
+}
+
+// NewMediaRepository creates a new SQLite media repository
+func NewMediaRepository(db *sql.DB) *MediaRepository {
+	return &MediaRepository{
+		BaseRepository: database.NewBaseRepository(db),
+		txManager:      database.NewTransactionManager(db),
+	}
+}
+
+// dbMedia represents the database model for movie media
+type dbMedia struct {
+	ID        int            `db:"id"`
+	MovieID   int            `db:"movie_id"`
+	MediaType string         `db:"media_type"`
+	URL       string         `db:"url"`
+	Provider  string         `db:"provider"`
+	Title     sql.NullString `db:"title"`
+	IsPrimary bool           `db:"is_primary"`
+}
+
+// FindByMovieID retrieves a movie's media ordered by type, primary first,
+// then oldest first; an empty type returns every type
+func (r *MediaRepository) FindByMovieID(ctx context.Context, movieID shared.MovieID, mediaType media.Type) ([]*media.Media, error) {
+	query := `
+		SELECT id, movie_id, media_type, url, provider, title, is_primary
+		FROM movie_media
+		WHERE movie_id = ?`
+	args := []interface{}{movieID.Value()}
+
+	if mediaType != "" {
+		query += " AND media_type = ?"
+		args = append(args, string(mediaType))
+	}
+	query += " ORDER BY media_type ASC, is_primary DESC, id ASC"
+
+	rows, err := r.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query media: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []*media.Media{}
+	for rows.Next() {
+		var row dbMedia
+		if err := rows.Scan(
+			&row.ID,
+			&row.MovieID,
+			&row.MediaType,
+			&row.URL,
+			&row.Provider,
+			&row.Title,
+			&row.IsPrimary,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan media: %w", err)
+		}
+
+		entry, err := r.toDomainModel(&row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert to domain model: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query media: %w", err)
+	}
+
+	return entries, nil
+}
+
+// FindPrimaryTrailer retrieves the trailer flagged primary, or the oldest
+// trailer if none is; it returns nil when the movie has no trailers
+func (r *MediaRepository) FindPrimaryTrailer(ctx context.Context, movieID shared.MovieID) (*media.Media, error) {
+	query := `
+		SELECT id, movie_id, media_type, url, provider, title, is_primary
+		FROM movie_media
+		WHERE movie_id = ? AND media_type = ?
+		ORDER BY is_primary DESC, id ASC
+		LIMIT 1`
+
+	var row dbMedia
+	err := r.QueryRowContext(ctx, query, movieID.Value(), string(media.TypeTrailer)).Scan(
+		&row.ID,
+		&row.MovieID,
+		&row.MediaType,
+		&row.URL,
+		&row.Provider,
+		&row.Title,
+		&row.IsPrimary,
+	)
+	if r.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query primary trailer: %w", err)
+	}
+
+	return r.toDomainModel(&row)
+}
+
+// Save inserts media or updates the movie's existing media with the same
+// URL. Saving a primary trailer clears the flag on the movie's others.
+func (r *MediaRepository) Save(ctx context.Context, entry *media.Media) error {
+	return writeTransaction(ctx, r.txManager, func(tx *sql.Tx) error {
+		if entry.IsPrimary() {
+			clearQuery := "UPDATE movie_media SET is_primary = 0 WHERE movie_id = ? AND url <> ?"
+			if _, err := tx.ExecContext(ctx, clearQuery, entry.MovieID().Value(), entry.URL()); err != nil {
+				return fmt.Errorf("failed to clear primary trailer: %w", err)
+			}
+		}
+
+		query := `
+			INSERT INTO movie_media (movie_id, media_type, url, provider, title, is_primary, created_at)
+			VALUES (?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP)
+			ON CONFLICT (movie_id, url) DO UPDATE
+			SET media_type = excluded.media_type, provider = excluded.provider,
+			    title = excluded.title, is_primary = excluded.is_primary
+			RETURNING id`
+
+		title := sql.NullString{String: entry.Title(), Valid: entry.Title() != ""}
+
+		var id int
+		if err := tx.QueryRowContext(ctx, query,
+			entry.MovieID().Value(),
+			string(entry.Type()),
+			entry.URL(),
+			entry.Provider(),
+			title,
+			entry.IsPrimary(),
+		).Scan(&id); err != nil {
+			return missingReference(fmt.Errorf("failed to save media: %w", err), "movie", entry.MovieID().Value())
+		}
+
+		entry.SetID(id)
+		return nil
+	})
+}
+
+// toDomainModel converts a database row to domain media
+func (r *MediaRepository) toDomainModel(row *dbMedia) (*media.Media, error) {
+	movieID, err := shared.NewMovieID(row.MovieID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create movie ID: %w", err)
+	}
+
+	entry, err := media.NewMedia(movieID, row.MediaType, row.URL, row.Provider, row.Title.String, row.IsPrimary)
+	if err != nil {
+		return nil, err
+	}
+	entry.SetID(row.ID)
+	return entry, nil
+}
@@ -0,0 +1,176 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/media"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	_ "modernc.org/sqlite"
+)
+
+// mediaTestSchema is the movie_media table from the migrations
+const mediaTestSchema = `
+	CREATE TABLE movie_media (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		movie_id INTEGER NOT NULL,
+		media_type TEXT NOT NULL DEFAULT 'trailer',
+		url TEXT NOT NULL,
+		provider TEXT NOT NULL,
+		title TEXT,
+		is_primary INTEGER NOT NULL DEFAULT 0,
+		created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE (movie_id, url)
+	);`
+
+// setupMediaTestDB creates an in-memory SQLite database for media testing
+func setupMediaTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", ":memory:?_time_format=sqlite")
+	if err != nil {
+		t.Fatalf("failed to open test database: %v", err)
+	}
+	db.SetMaxOpenConns(1) // Each in-memory connection is its own database
+
+	if _, err := db.Exec(mediaTestSchema); err != nil {
+		t.Fatalf("failed to create test schema: %v", err)
+	}
+
+	return db
+}
+
+func newTestMedia(t *testing.T, movieID int, mediaType, link string, primary bool) *media.Media {
+	t.Helper()
+
+	id, _ := shared.NewMovieID(movieID)
+	entry, err := media.NewMedia(id, mediaType, link, "", "", primary)
+	if err != nil {
+		t.Fatalf("failed to create media: %v", err)
+	}
+	return entry
+}
+
+func TestMediaRepository_SaveAndFindByMovieID(t *testing.T) {
+	db := setupMediaTestDB(t)
+	defer db.Close()
+
+	repo := NewMediaRepository(db)
+	ctx := context.Background()
+
+	for _, entry := range []*media.Media{
+		newTestMedia(t, 1, "still", "https://example.com/still.jpg", false),
+		newTestMedia(t, 1, "trailer", "https://youtu.be/first", false),
+		newTestMedia(t, 1, "trailer", "https://youtu.be/second", true),
+		newTestMedia(t, 2, "trailer", "https://vimeo.com/1", false),
+	} {
+		if err := repo.Save(ctx, entry); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+		if entry.ID() == 0 {
+			t.Error("Expected ID to be set after save")
+		}
+	}
+
+	movieID, _ := shared.NewMovieID(1)
+	results, err := repo.FindByMovieID(ctx, movieID, "")
+	if err != nil {
+		t.Fatalf("FindByMovieID() error = %v", err)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 media, got: %d", len(results))
+	}
+	if results[0].Type() != media.TypeStill {
+		t.Errorf("Expected media ordered by type, got: %s first", results[0].Type())
+	}
+	if results[1].URL() != "https://youtu.be/second" || !results[1].IsPrimary() {
+		t.Errorf("Expected primary trailer before other trailers, got: %s", results[1].URL())
+	}
+	if results[1].Provider() != "YouTube" {
+		t.Errorf("Expected provider YouTube, got: %s", results[1].Provider())
+	}
+
+	trailers, err := repo.FindByMovieID(ctx, movieID, media.TypeTrailer)
+	if err != nil {
+		t.Fatalf("FindByMovieID() error = %v", err)
+	}
+	if len(trailers) != 2 {
+		t.Errorf("Expected 2 trailers, got: %d", len(trailers))
+	}
+}
+
+func TestMediaRepository_SaveUpdatesExistingURL(t *testing.T) {
+	db := setupMediaTestDB(t)
+	defer db.Close()
+
+	repo := NewMediaRepository(db)
+	ctx := context.Background()
+
+	first := newTestMedia(t, 1, "teaser", "https://youtu.be/abc", false)
+	_ = repo.Save(ctx, first)
+
+	second := newTestMedia(t, 1, "trailer", "https://youtu.be/abc", true)
+	if err := repo.Save(ctx, second); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if second.ID() != first.ID() {
+		t.Errorf("Expected existing media ID %d to be reused, got: %d", first.ID(), second.ID())
+	}
+
+	movieID, _ := shared.NewMovieID(1)
+	results, _ := repo.FindByMovieID(ctx, movieID, "")
+
+	if len(results) != 1 {
+		t.Fatalf("Expected 1 media, got: %d", len(results))
+	}
+	if results[0].Type() != media.TypeTrailer || !results[0].IsPrimary() {
+		t.Errorf("Expected media to be updated to a primary trailer, got: %s (primary %v)", results[0].Type(), results[0].IsPrimary())
+	}
+}
+
+func TestMediaRepository_FindPrimaryTrailer(t *testing.T) {
+	db := setupMediaTestDB(t)
+	defer db.Close()
+
+	repo := NewMediaRepository(db)
+	ctx := context.Background()
+	movieID, _ := shared.NewMovieID(1)
+
+	trailer, err := repo.FindPrimaryTrailer(ctx, movieID)
+	if err != nil {
+		t.Fatalf("FindPrimaryTrailer() error = %v", err)
+	}
+	if trailer != nil {
+		t.Errorf("Expected no trailer, got: %s", trailer.URL())
+	}
+
+	_ = repo.Save(ctx, newTestMedia(t, 1, "clip", "https://example.com/clip.mp4", false))
+	_ = repo.Save(ctx, newTestMedia(t, 1, "trailer", "https://youtu.be/oldest", false))
+	_ = repo.Save(ctx, newTestMedia(t, 1, "trailer", "https://youtu.be/newest", false))
+
+	trailer, _ = repo.FindPrimaryTrailer(ctx, movieID)
+	if trailer == nil || trailer.URL() != "https://youtu.be/oldest" {
+		t.Fatalf("Expected oldest trailer without a primary flag, got: %v", trailer)
+	}
+
+	_ = repo.Save(ctx, newTestMedia(t, 1, "trailer", "https://youtu.be/newest", true))
+	_ = repo.Save(ctx, newTestMedia(t, 1, "trailer", "https://youtu.be/latest", true))
+
+	trailer, _ = repo.FindPrimaryTrailer(ctx, movieID)
+	if trailer == nil || trailer.URL() != "https://youtu.be/latest" {
+		t.Fatalf("Expected latest primary trailer, got: %v", trailer)
+	}
+
+	trailers, _ := repo.FindByMovieID(ctx, movieID, media.TypeTrailer)
+	primaries := 0
+	for _, entry := range trailers {
+		if entry.IsPrimary() {
+			primaries++
+		}
+	}
+	if primaries != 1 {
+		t.Errorf("Expected exactly 1 primary trailer, got: %d", primaries)
+	}
+}
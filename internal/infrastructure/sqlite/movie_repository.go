@@ -5,22 +5,30 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
 	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
 	"github.com/francknouama/movies-mcp-server/pkg/database"
 )
 
+// insertBatchSize is the number of movies per multi-row INSERT; at twelve
+// columns a row it stays well under SQLite's bound parameter limit
+const insertBatchSize = 500
+
 // MovieRepository implements the movie.Repository interface for SQLite
 type MovieRepository struct {
 	*database.BaseRepository
+	txManager *database.TransactionManager
 }
 
 // NewMovieRepository creates a new SQLite movie repository
 func NewMovieRepository(db *sql.DB) *MovieRepository {
 	return &MovieRepository{
 		BaseRepository: database.NewBaseRepository(db),
+		txManager:      database.NewTransactionManager(db),
 	}
 }
 
@@ -30,10 +38,11 @@ type dbMovie struct {
 	Title       string          `db:"title"`
 	Director    string          `db:"director"`
 	Year        int             `db:"year"`
+	ReleaseDate sql.NullString  `db:"release_date"` // YYYY-MM-DD, or YYYY when only the year is known
 	Rating      sql.NullFloat64 `db:"rating"`
 	Genres      string          `db:"genre"` // JSON-encoded array
 	Description sql.NullString  `db:"description"`
-	Duration    sql.NullInt64   `db:"duration"`
+	Duration    sql.NullInt64   `db:"duration"` // Minutes
 	Language    sql.NullString  `db:"language"`
 	Country     sql.NullString  `db:"country"`
 	PosterData  []byte          `db:"poster_data"`
@@ -41,6 +50,9 @@ type dbMovie struct {
 	PosterURL   sql.NullString  `db:"poster_url"`
 	CreatedAt   sql.NullTime    `db:"created_at"`
 	UpdatedAt   sql.NullTime    `db:"updated_at"`
+
+	Certifications  string `db:"certifications"`   // JSON-encoded object keyed by region
+	ContentWarnings string `db:"content_warnings"` // JSON-encoded array
 }
 
 // Save persists a movie (insert or update)
@@ -50,25 +62,31 @@ func (r *MovieRepository) Save(ctx context.Context, domainMovie *movie.Movie) er
 		return fmt.Errorf("failed to convert to DB model: %w", err)
 	}
 
-	if domainMovie.ID().IsZero() {
-		return r.insert(ctx, dbMovie, domainMovie)
-	}
-	return r.update(ctx, dbMovie, domainMovie)
+	return retryOnBusy(ctx, func() error {
+		if domainMovie.ID().IsZero() {
+			return r.insert(ctx, dbMovie, domainMovie)
+		}
+		return r.update(ctx, dbMovie, domainMovie)
+	})
 }
 
 func (r *MovieRepository) insert(ctx context.Context, dbMovie *dbMovie, domainMovie *movie.Movie) error {
 	query := `
-		INSERT INTO movies (title, director, year, rating, genre, poster_url, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		INSERT INTO movies (title, director, year, release_date, rating, duration, genre, poster_url, certifications, content_warnings, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 		RETURNING id`
 
 	id, err := r.InsertWithID(ctx, query,
 		dbMovie.Title,
 		dbMovie.Director,
 		dbMovie.Year,
+		dbMovie.ReleaseDate,
 		dbMovie.Rating,
+		dbMovie.Duration,
 		dbMovie.Genres,
 		dbMovie.PosterURL,
+		dbMovie.Certifications,
+		dbMovie.ContentWarnings,
 		dbMovie.CreatedAt.Time,
 		dbMovie.UpdatedAt.Time,
 	)
@@ -87,29 +105,172 @@ func (r *MovieRepository) insert(ctx context.Context, dbMovie *dbMovie, domainMo
 	return nil
 }
 
-func (r *MovieRepository) update(ctx context.Context, dbMovie *dbMovie, domainMovie *movie.Movie) error {
-	query := `
+// InsertAll inserts new movies with multi-row INSERTs in a single
+// transaction, which is far faster than saving them one at a time
+func (r *MovieRepository) InsertAll(ctx context.Context, domainMovies []*movie.Movie) error {
+	dbMovies := make([]*dbMovie, 0, len(domainMovies))
+	for _, domainMovie := range domainMovies {
+		if !domainMovie.ID().IsZero() {
+			return shared.NewConflictError("movie %d already exists", domainMovie.ID().Value())
+		}
+		dbMovie, err := r.toDBModel(domainMovie)
+		if err != nil {
+			return fmt.Errorf("failed to convert to DB model: %w", err)
+		}
+		dbMovies = append(dbMovies, dbMovie)
+	}
+
+	var ids []int
+	err := writeTransaction(ctx, r.txManager, func(tx *sql.Tx) error {
+		ids = make([]int, 0, len(dbMovies))
+		for start := 0; start < len(dbMovies); start += insertBatchSize {
+			end := min(start+insertBatchSize, len(dbMovies))
+			batchIDs, err := r.insertBatch(ctx, tx, dbMovies[start:end])
+			if err != nil {
+				return err
+			}
+			ids = append(ids, batchIDs...)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, domainMovie := range domainMovies {
+		movieID, err := shared.NewMovieID(ids[i])
+		if err != nil {
+			return fmt.Errorf("failed to create movie ID: %w", err)
+		}
+		domainMovie.SetID(movieID)
+	}
+	return nil
+}
+
+// insertBatch inserts movies with one statement and returns their IDs in
+// insert order
+func (r *MovieRepository) insertBatch(ctx context.Context, tx *sql.Tx, dbMovies []*dbMovie) ([]int, error) {
+	var query strings.Builder
+	query.WriteString(`
+		INSERT INTO movies (title, director, year, release_date, rating, duration, genre, poster_url, certifications, content_warnings, created_at, updated_at)
+		VALUES `)
+	args := make([]interface{}, 0, len(dbMovies)*12)
+	for i, dbMovie := range dbMovies {
+		if i > 0 {
+			query.WriteString(", ")
+		}
+		query.WriteString("(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+		args = append(args,
+			dbMovie.Title,
+			dbMovie.Director,
+			dbMovie.Year,
+			dbMovie.ReleaseDate,
+			dbMovie.Rating,
+			dbMovie.Duration,
+			dbMovie.Genres,
+			dbMovie.PosterURL,
+			dbMovie.Certifications,
+			dbMovie.ContentWarnings,
+			dbMovie.CreatedAt.Time,
+			dbMovie.UpdatedAt.Time,
+		)
+	}
+	query.WriteString(" RETURNING id")
+
+	rows, err := tx.QueryContext(ctx, query.String(), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert movies: %w", err)
+	}
+	defer rows.Close()
+
+	ids := make([]int, 0, len(dbMovies))
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan movie ID: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to insert movies: %w", err)
+	}
+	if len(ids) != len(dbMovies) {
+		return nil, fmt.Errorf("failed to insert movies: expected %d IDs, got %d", len(dbMovies), len(ids))
+	}
+
+	// RETURNING order is unspecified, but rows are numbered in VALUES order
+	sort.Ints(ids)
+	return ids, nil
+}
+
+// updateMovieQuery overwrites a movie's fields, taking updateArgs
+const updateMovieQuery = `
 		UPDATE movies
-		SET title = ?, director = ?, year = ?, rating = ?, genre = ?,
-		    poster_url = ?, updated_at = ?
+		SET title = ?, director = ?, year = ?, release_date = ?, rating = ?, duration = ?, genre = ?,
+		    poster_url = ?, certifications = ?, content_warnings = ?, updated_at = ?
 		WHERE id = ?`
 
-	return r.Update(ctx, query, "movie",
+func (r *MovieRepository) update(ctx context.Context, dbMovie *dbMovie, domainMovie *movie.Movie) error {
+	return notFound(r.Update(ctx, updateMovieQuery, "movie", updateArgs(dbMovie, domainMovie)...))
+}
+
+// UpdateAll updates existing movies in a single transaction; when any of
+// them does not exist, none are updated
+func (r *MovieRepository) UpdateAll(ctx context.Context, domainMovies []*movie.Movie) error {
+	dbMovies := make([]*dbMovie, 0, len(domainMovies))
+	for _, domainMovie := range domainMovies {
+		if domainMovie.ID().IsZero() {
+			return shared.NewValidationError("movie %q has no ID to update", domainMovie.Title())
+		}
+		dbMovie, err := r.toDBModel(domainMovie)
+		if err != nil {
+			return fmt.Errorf("failed to convert to DB model: %w", err)
+		}
+		dbMovies = append(dbMovies, dbMovie)
+	}
+
+	return writeTransaction(ctx, r.txManager, func(tx *sql.Tx) error {
+		stmt, err := tx.PrepareContext(ctx, updateMovieQuery)
+		if err != nil {
+			return fmt.Errorf("failed to prepare movie update: %w", err)
+		}
+		defer stmt.Close()
+
+		for i, dbMovie := range dbMovies {
+			result, err := stmt.ExecContext(ctx, updateArgs(dbMovie, domainMovies[i])...)
+			if err != nil {
+				return fmt.Errorf("failed to update movie %d: %w", domainMovies[i].ID().Value(), err)
+			}
+			if err := r.CheckRowsAffected(result, "movie"); err != nil {
+				return notFound(err)
+			}
+		}
+		return nil
+	})
+}
+
+// updateArgs returns the arguments of updateMovieQuery for a movie
+func updateArgs(dbMovie *dbMovie, domainMovie *movie.Movie) []interface{} {
+	return []interface{}{
 		dbMovie.Title,
 		dbMovie.Director,
 		dbMovie.Year,
+		dbMovie.ReleaseDate,
 		dbMovie.Rating,
+		dbMovie.Duration,
 		dbMovie.Genres,
 		dbMovie.PosterURL,
+		dbMovie.Certifications,
+		dbMovie.ContentWarnings,
 		dbMovie.UpdatedAt.Time,
 		domainMovie.ID().Value(),
-	)
+	}
 }
 
 // FindByID retrieves a movie by its ID
 func (r *MovieRepository) FindByID(ctx context.Context, id shared.MovieID) (*movie.Movie, error) {
 	query := `
-		SELECT id, title, director, year, rating, genre, poster_url, created_at, updated_at
+		SELECT id, title, director, year, release_date, rating, duration, genre, poster_url, certifications, content_warnings, created_at, updated_at
 		FROM movies
 		WHERE id = ?`
 
@@ -119,15 +280,19 @@ func (r *MovieRepository) FindByID(ctx context.Context, id shared.MovieID) (*mov
 		&dbMovie.Title,
 		&dbMovie.Director,
 		&dbMovie.Year,
+		&dbMovie.ReleaseDate,
 		&dbMovie.Rating,
+		&dbMovie.Duration,
 		&dbMovie.Genres,
 		&dbMovie.PosterURL,
-		&dbMovie.CreatedAt,
-		&dbMovie.UpdatedAt,
+		&dbMovie.Certifications,
+		&dbMovie.ContentWarnings,
+		(*textTime)(&dbMovie.CreatedAt),
+		(*textTime)(&dbMovie.UpdatedAt),
 	)
 
 	if err != nil {
-		return nil, r.WrapNotFound(err, "movie")
+		return nil, notFound(r.WrapNotFound(err, "movie"))
 	}
 
 	return r.toDomainModel(&dbMovie)
@@ -151,11 +316,15 @@ func (r *MovieRepository) FindByCriteria(ctx context.Context, criteria movie.Sea
 			&dbMovie.Title,
 			&dbMovie.Director,
 			&dbMovie.Year,
+			&dbMovie.ReleaseDate,
 			&dbMovie.Rating,
+			&dbMovie.Duration,
 			&dbMovie.Genres,
 			&dbMovie.PosterURL,
-			&dbMovie.CreatedAt,
-			&dbMovie.UpdatedAt,
+			&dbMovie.Certifications,
+			&dbMovie.ContentWarnings,
+			(*textTime)(&dbMovie.CreatedAt),
+			(*textTime)(&dbMovie.UpdatedAt),
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan movie: %w", err)
@@ -168,18 +337,33 @@ func (r *MovieRepository) FindByCriteria(ctx context.Context, criteria movie.Sea
 
 		movies = append(movies, domainMovie)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to search movies: %w", err)
+	}
 
 	return movies, nil
 }
 
 func (r *MovieRepository) buildSearchQuery(criteria movie.SearchCriteria) (string, []interface{}) {
 	query := `
-		SELECT id, title, director, year, rating, genre, poster_url, created_at, updated_at
+		SELECT id, title, director, year, release_date, rating, duration, genre, poster_url, certifications, content_warnings, created_at, updated_at
 		FROM movies WHERE 1=1`
 
 	var args []interface{}
 
 	// Add WHERE conditions using ? placeholders
+	if len(criteria.IDs) > 0 {
+		query += " AND id IN (" + placeholders(len(criteria.IDs)) + ")"
+		for _, id := range criteria.IDs {
+			args = append(args, id.Value())
+		}
+	}
+
+	if criteria.AfterID > 0 {
+		query += " AND id > ?"
+		args = append(args, criteria.AfterID)
+	}
+
 	if criteria.Title != "" {
 		query += " AND title LIKE ? COLLATE NOCASE"
 		args = append(args, "%"+criteria.Title+"%")
@@ -196,14 +380,27 @@ func (r *MovieRepository) buildSearchQuery(criteria movie.SearchCriteria) (strin
 		args = append(args, criteria.Genre)
 	}
 
-	if criteria.MinYear > 0 {
+	minYear, maxYear := criteria.YearRange()
+	if minYear > 0 {
 		query += " AND year >= ?"
-		args = append(args, criteria.MinYear)
+		args = append(args, minYear)
 	}
 
-	if criteria.MaxYear > 0 {
+	if maxYear > 0 {
 		query += " AND year <= ?"
-		args = append(args, criteria.MaxYear)
+		args = append(args, maxYear)
+	}
+
+	// Within the years, movies with a full release date must fall between
+	// the dates; year-only movies match the whole year
+	if !criteria.ReleasedAfter.IsZero() {
+		query += " AND (length(release_date) IS NOT 10 OR release_date >= ?)"
+		args = append(args, criteria.ReleasedAfter.Format(time.DateOnly))
+	}
+
+	if !criteria.ReleasedBefore.IsZero() {
+		query += " AND (length(release_date) IS NOT 10 OR release_date <= ?)"
+		args = append(args, criteria.ReleasedBefore.Format(time.DateOnly))
 	}
 
 	if criteria.MinRating > 0 {
@@ -216,27 +413,45 @@ func (r *MovieRepository) buildSearchQuery(criteria movie.SearchCriteria) (strin
 		args = append(args, criteria.MaxRating)
 	}
 
-	// Add ORDER BY
-	orderField := "title"
-	switch criteria.OrderBy {
-	case movie.OrderByDirector:
-		orderField = "director"
-	case movie.OrderByYear:
-		orderField = "year"
-	case movie.OrderByRating:
-		orderField = "rating"
-	case movie.OrderByCreatedAt:
-		orderField = "created_at"
-	case movie.OrderByUpdatedAt:
-		orderField = "updated_at"
+	// Comparisons are never true for a NULL duration, so unknown runtimes drop out
+	if criteria.MinDuration > 0 {
+		query += " AND duration >= ?"
+		args = append(args, criteria.MinDuration)
 	}
 
-	orderDir := "ASC"
-	if criteria.OrderDir == movie.OrderDesc {
-		orderDir = "DESC"
+	if criteria.MaxDuration > 0 {
+		query += " AND duration <= ?"
+		args = append(args, criteria.MaxDuration)
 	}
 
-	query += fmt.Sprintf(" ORDER BY %s %s", orderField, orderDir)
+	if criteria.CertificationRegion != "" && len(criteria.Certifications) > 0 {
+		// Region codes are validated upper-case letters, so they are safe in a JSON path
+		query += " AND json_extract(certifications, ?) IN (" + placeholders(len(criteria.Certifications)) + ")"
+		args = append(args, "$."+criteria.CertificationRegion)
+		for _, certification := range criteria.Certifications {
+			args = append(args, certification)
+		}
+	}
+
+	if len(criteria.ExcludeWarnings) > 0 {
+		query += " AND NOT EXISTS (SELECT 1 FROM json_each(content_warnings) WHERE value IN (" +
+			placeholders(len(criteria.ExcludeWarnings)) + "))"
+		for _, warning := range criteria.ExcludeWarnings {
+			args = append(args, warning)
+		}
+	}
+
+	// Add ORDER BY
+	sortKeys := criteria.Sort
+	if len(sortKeys) == 0 {
+		sortKeys = []movie.SortKey{{Field: criteria.OrderBy, Dir: criteria.OrderDir}}
+	}
+
+	terms := make([]string, len(sortKeys))
+	for i, key := range sortKeys {
+		terms[i] = movieOrderColumn(key.Field) + " " + sqlOrderDirection(key.Dir == movie.OrderDesc)
+	}
+	query += " ORDER BY " + strings.Join(terms, ", ")
 
 	// Add LIMIT and OFFSET
 	if criteria.Limit > 0 {
@@ -252,6 +467,26 @@ func (r *MovieRepository) buildSearchQuery(criteria movie.SearchCriteria) (strin
 	return query, args
 }
 
+// movieOrderColumn maps a sort field to its column, defaulting to title
+func movieOrderColumn(field movie.OrderBy) string {
+	switch field {
+	case movie.OrderByDirector:
+		return "director"
+	case movie.OrderByYear:
+		return "year"
+	case movie.OrderByRating:
+		return "rating"
+	case movie.OrderByCreatedAt:
+		return "created_at"
+	case movie.OrderByUpdatedAt:
+		return "updated_at"
+	case movie.OrderByID:
+		return "id"
+	default:
+		return "title"
+	}
+}
+
 // FindByTitle searches movies by title (partial match)
 func (r *MovieRepository) FindByTitle(ctx context.Context, title string) ([]*movie.Movie, error) {
 	criteria := movie.SearchCriteria{
@@ -299,17 +534,20 @@ func (r *MovieRepository) CountAll(ctx context.Context) (int, error) {
 // Delete removes a movie by ID
 func (r *MovieRepository) Delete(ctx context.Context, id shared.MovieID) error {
 	query := "DELETE FROM movies WHERE id = ?"
-	return r.BaseRepository.Delete(ctx, query, "movie", id.Value())
+	return retryOnBusy(ctx, func() error {
+		return notFound(r.BaseRepository.Delete(ctx, query, "movie", id.Value()))
+	})
 }
 
 // DeleteAll removes all movies (for testing)
 func (r *MovieRepository) DeleteAll(ctx context.Context) error {
 	query := "DELETE FROM movies"
-	_, err := r.ExecContext(ctx, query)
-	if err != nil {
-		return fmt.Errorf("failed to delete all movies: %w", err)
-	}
-	return nil
+	return retryOnBusy(ctx, func() error {
+		if _, err := r.ExecContext(ctx, query); err != nil {
+			return fmt.Errorf("failed to delete all movies: %w", err)
+		}
+		return nil
+	})
 }
 
 // toDBModel converts a domain movie to a database model
@@ -320,12 +558,29 @@ func (r *MovieRepository) toDBModel(domainMovie *movie.Movie) (*dbMovie, error)
 		return nil, fmt.Errorf("failed to marshal genres: %w", err)
 	}
 
+	// Encode certifications and content warnings as JSON
+	certificationsJSON, err := json.Marshal(domainMovie.Certifications())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal certifications: %w", err)
+	}
+	warningsJSON, err := json.Marshal(domainMovie.ContentWarnings())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal content warnings: %w", err)
+	}
+
 	dbMovie := &dbMovie{
-		ID:       domainMovie.ID().Value(),
-		Title:    domainMovie.Title(),
-		Director: domainMovie.Director(),
-		Year:     domainMovie.Year().Value(),
-		Genres:   string(genresJSON),
+		ID:              domainMovie.ID().Value(),
+		Title:           domainMovie.Title(),
+		Director:        domainMovie.Director(),
+		Year:            domainMovie.Year().Value(),
+		ReleaseDate:     sql.NullString{String: fmt.Sprintf("%04d", domainMovie.Year().Value()), Valid: true},
+		Genres:          string(genresJSON),
+		Certifications:  string(certificationsJSON),
+		ContentWarnings: string(warningsJSON),
+	}
+
+	if domainMovie.HasReleaseDate() {
+		dbMovie.ReleaseDate.String = domainMovie.ReleaseDate().Format(time.DateOnly)
 	}
 
 	// Handle optional rating
@@ -336,6 +591,14 @@ func (r *MovieRepository) toDBModel(domainMovie *movie.Movie) (*dbMovie, error)
 		}
 	}
 
+	// Handle optional duration
+	if domainMovie.Duration() > 0 {
+		dbMovie.Duration = sql.NullInt64{
+			Int64: int64(domainMovie.Duration()),
+			Valid: true,
+		}
+	}
+
 	// Handle optional poster URL
 	if domainMovie.PosterURL() != "" {
 		dbMovie.PosterURL = sql.NullString{
@@ -369,6 +632,15 @@ func (r *MovieRepository) toDomainModel(dbMovie *dbMovie) (*movie.Movie, error)
 		return nil, fmt.Errorf("failed to create domain movie: %w", err)
 	}
 
+	// Set the release date if the day is known. A date outside the year was
+	// left behind by a server that changed the year without knowing the
+	// column, so the year wins.
+	if released, err := time.Parse(time.DateOnly, dbMovie.ReleaseDate.String); err == nil && released.Year() == dbMovie.Year {
+		if err := domainMovie.SetReleaseDate(released); err != nil {
+			return nil, fmt.Errorf("failed to set release date: %w", err)
+		}
+	}
+
 	// Set rating if present
 	if dbMovie.Rating.Valid {
 		if err := domainMovie.SetRating(dbMovie.Rating.Float64); err != nil {
@@ -376,22 +648,21 @@ func (r *MovieRepository) toDomainModel(dbMovie *dbMovie) (*movie.Movie, error)
 		}
 	}
 
-	// Decode and add genres
-	if dbMovie.Genres != "" && dbMovie.Genres != "null" {
-		var genres []string
-		if err := json.Unmarshal([]byte(dbMovie.Genres), &genres); err != nil {
-			// Handle legacy non-JSON genre data gracefully
-			if strings.HasPrefix(dbMovie.Genres, "[") {
-				return nil, fmt.Errorf("failed to unmarshal genres: %w", err)
-			}
-			// Treat as single genre if not JSON
-			genres = []string{dbMovie.Genres}
+	// Set duration if present
+	if dbMovie.Duration.Valid {
+		if err := domainMovie.SetDuration(int(dbMovie.Duration.Int64)); err != nil {
+			return nil, fmt.Errorf("failed to set duration: %w", err)
 		}
+	}
 
-		for _, genre := range genres {
-			if err := domainMovie.AddGenre(genre); err != nil {
-				return nil, fmt.Errorf("failed to add genre: %w", err)
-			}
+	// Decode and add genres
+	genres, err := decodeGenres(dbMovie.Genres)
+	if err != nil {
+		return nil, err
+	}
+	for _, genre := range genres {
+		if err := domainMovie.AddGenre(genre); err != nil {
+			return nil, fmt.Errorf("failed to add genre: %w", err)
 		}
 	}
 
@@ -402,5 +673,64 @@ func (r *MovieRepository) toDomainModel(dbMovie *dbMovie) (*movie.Movie, error)
 		}
 	}
 
+	// Decode certifications and content warnings
+	if dbMovie.Certifications != "" {
+		var certifications map[string]string
+		if err := json.Unmarshal([]byte(dbMovie.Certifications), &certifications); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal certifications: %w", err)
+		}
+		for region, certification := range certifications {
+			if err := domainMovie.SetCertification(region, certification); err != nil {
+				return nil, fmt.Errorf("failed to set certification: %w", err)
+			}
+		}
+	}
+
+	if dbMovie.ContentWarnings != "" {
+		var warnings []string
+		if err := json.Unmarshal([]byte(dbMovie.ContentWarnings), &warnings); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal content warnings: %w", err)
+		}
+		for _, warning := range warnings {
+			if err := domainMovie.AddContentWarning(warning); err != nil {
+				return nil, fmt.Errorf("failed to add content warning: %w", err)
+			}
+		}
+	}
+
+	// Rebuilding a stored movie is not a change to publish
+	domainMovie.MarkEventsAsCommitted()
 	return domainMovie, nil
 }
+
+// decodeGenres decodes the genre column. It holds a JSON array, or a single
+// plain genre in legacy rows. Blank and repeated genres are dropped so that a
+// malformed row does not make the movie unreadable.
+func decodeGenres(raw string) ([]string, error) {
+	trimmed := strings.TrimSpace(raw)
+	if trimmed == "" || trimmed == "null" {
+		return nil, nil
+	}
+
+	var decoded []string
+	if err := json.Unmarshal([]byte(trimmed), &decoded); err != nil {
+		// Handle legacy non-JSON genre data gracefully
+		if strings.HasPrefix(trimmed, "[") {
+			return nil, fmt.Errorf("failed to unmarshal genres: %w", err)
+		}
+		// Treat as single genre if not JSON
+		decoded = []string{trimmed}
+	}
+
+	genres := make([]string, 0, len(decoded))
+	seen := make(map[string]bool, len(decoded))
+	for _, genre := range decoded {
+		genre = strings.TrimSpace(genre)
+		if genre == "" || seen[genre] {
+			continue
+		}
+		seen[genre] = true
+		genres = append(genres, genre)
+	}
+	return genres, nil
+}
@@ -3,9 +3,12 @@ package sqlite
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
 	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
@@ -38,6 +41,9 @@ func setupTestDB(t *testing.T) *sql.DB {
 		poster_data BLOB,
 		poster_type TEXT,
 		poster_url TEXT,
+		certifications TEXT NOT NULL DEFAULT '{}',
+		content_warnings TEXT NOT NULL DEFAULT '[]',
+		release_date TEXT,
 		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 		updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
 	);`
@@ -121,6 +127,75 @@ func TestMovieRepository_Save_Update(t *testing.T) {
 	}
 }
 
+func TestMovieRepository_InsertAll(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	db.SetMaxOpenConns(1) // Each in-memory connection is its own database
+
+	repo := NewMovieRepository(db)
+	ctx := context.Background()
+
+	// Spans several batches, the last one partial
+	count := insertBatchSize*2 + 3
+	movies := make([]*movie.Movie, 0, count)
+	for i := 0; i < count; i++ {
+		domainMovie, err := movie.NewMovie(fmt.Sprintf("Movie %d", i), "Director", 2000+i%20)
+		if err != nil {
+			t.Fatalf("failed to create domain movie: %v", err)
+		}
+		_ = domainMovie.AddGenre("Drama")
+		movies = append(movies, domainMovie)
+	}
+
+	if err := repo.InsertAll(ctx, movies); err != nil {
+		t.Fatalf("InsertAll() error = %v", err)
+	}
+
+	total, err := repo.CountAll(ctx)
+	if err != nil {
+		t.Fatalf("CountAll() error = %v", err)
+	}
+	if total != count {
+		t.Errorf("Expected %d movies, got: %d", count, total)
+	}
+
+	for _, i := range []int{0, insertBatchSize, count - 1} {
+		if movies[i].ID().IsZero() {
+			t.Fatalf("Expected movie %d to have ID assigned", i)
+		}
+		retrieved, err := repo.FindByID(ctx, movies[i].ID())
+		if err != nil {
+			t.Fatalf("FindByID() error = %v", err)
+		}
+		if retrieved.Title() != movies[i].Title() || !reflect.DeepEqual(retrieved.Genres(), []string{"Drama"}) {
+			t.Errorf("Expected movie %d to get its own ID, got: %s %v", i, retrieved.Title(), retrieved.Genres())
+		}
+	}
+}
+
+func TestMovieRepository_InsertAll_ExistingMovie(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewMovieRepository(db)
+	ctx := context.Background()
+
+	existing, _ := movie.NewMovie("Inception", "Christopher Nolan", 2010)
+	if err := repo.Save(ctx, existing); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	added, _ := movie.NewMovie("Heat", "Michael Mann", 1995)
+
+	if err := repo.InsertAll(ctx, []*movie.Movie{added, existing}); err == nil {
+		t.Fatal("Expected error inserting an existing movie")
+	}
+
+	total, _ := repo.CountAll(ctx)
+	if total != 1 || !added.ID().IsZero() {
+		t.Errorf("Expected nothing to be inserted, got: %d movies", total)
+	}
+}
+
 func TestMovieRepository_Save_WithGenres(t *testing.T) {
 	db := setupTestDB(t)
 	defer db.Close()
@@ -159,6 +234,247 @@ func TestMovieRepository_Save_WithGenres(t *testing.T) {
 	}
 }
 
+func TestMovieRepository_Save_WithCertifications(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewMovieRepository(db)
+	ctx := context.Background()
+
+	domainMovie, _ := movie.NewMovie("The Dark Knight", "Christopher Nolan", 2008)
+	_ = domainMovie.SetCertification("US", "PG-13")
+	_ = domainMovie.SetCertification("GB", "12A")
+	_ = domainMovie.AddContentWarning("violence")
+
+	if err := repo.Save(ctx, domainMovie); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	retrieved, err := repo.FindByID(ctx, domainMovie.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+
+	if retrieved.Certification("US") != "PG-13" || retrieved.Certification("GB") != "12A" {
+		t.Errorf("Expected US PG-13 and GB 12A, got: %v", retrieved.Certifications())
+	}
+	if warnings := retrieved.ContentWarnings(); len(warnings) != 1 || warnings[0] != "violence" {
+		t.Errorf("Expected violence warning, got: %v", warnings)
+	}
+}
+
+func TestMovieRepository_Save_WithReleaseDate(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewMovieRepository(db)
+	ctx := context.Background()
+
+	dated, _ := movie.NewMovie("The Matrix", "Wachowskis", 1999)
+	_ = dated.SetReleaseDate(time.Date(1999, time.March, 31, 0, 0, 0, 0, time.UTC))
+	undated, _ := movie.NewMovie("Fight Club", "David Fincher", 1999)
+	for _, m := range []*movie.Movie{dated, undated} {
+		if err := repo.Save(ctx, m); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+
+	var stored string
+	if err := db.QueryRow("SELECT release_date FROM movies WHERE id = ?", undated.ID().Value()).Scan(&stored); err != nil {
+		t.Fatalf("failed to read release date: %v", err)
+	}
+	if stored != "1999" {
+		t.Errorf("Expected a year-only release date '1999', got %q", stored)
+	}
+
+	retrieved, err := repo.FindByID(ctx, dated.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if got := retrieved.ReleaseDate().Format(time.DateOnly); got != "1999-03-31" {
+		t.Errorf("Expected release date 1999-03-31, got %s", got)
+	}
+
+	// Servers that predate release dates update the year and leave the date
+	if _, err := db.Exec("UPDATE movies SET year = 2000 WHERE id = ?", dated.ID().Value()); err != nil {
+		t.Fatalf("failed to update year: %v", err)
+	}
+	retrieved, err = repo.FindByID(ctx, dated.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if retrieved.HasReleaseDate() || retrieved.Year().Value() != 2000 {
+		t.Errorf("Expected a stale release date to give way to the year, got %v %d", retrieved.ReleaseDate(), retrieved.Year().Value())
+	}
+}
+
+func TestMovieRepository_FindByCriteria_ByReleaseDate(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewMovieRepository(db)
+	ctx := context.Background()
+
+	march, _ := movie.NewMovie("The Matrix", "Wachowskis", 1999)
+	_ = march.SetReleaseDate(time.Date(1999, time.March, 31, 0, 0, 0, 0, time.UTC))
+	october, _ := movie.NewMovie("Fight Club", "David Fincher", 1999)
+	_ = october.SetReleaseDate(time.Date(1999, time.October, 15, 0, 0, 0, 0, time.UTC))
+	undated, _ := movie.NewMovie("Magnolia", "Paul Thomas Anderson", 1999)
+	for _, m := range []*movie.Movie{march, october, undated} {
+		if err := repo.Save(ctx, m); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+
+	results, err := repo.FindByCriteria(ctx, movie.SearchCriteria{
+		ReleasedAfter:  time.Date(1999, time.June, 1, 0, 0, 0, 0, time.UTC),
+		ReleasedBefore: time.Date(1999, time.December, 31, 0, 0, 0, 0, time.UTC),
+		OrderBy:        movie.OrderByTitle,
+	})
+	if err != nil {
+		t.Fatalf("FindByCriteria() error = %v", err)
+	}
+
+	// The year-only movie matches every date in its year
+	if len(results) != 2 || results[0].Title() != "Fight Club" || results[1].Title() != "Magnolia" {
+		titles := make([]string, len(results))
+		for i, m := range results {
+			titles[i] = m.Title()
+		}
+		t.Errorf("Expected Fight Club and Magnolia, got: %v", titles)
+	}
+}
+
+func TestMovieRepository_FindByCriteria_ByDuration(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewMovieRepository(db)
+	ctx := context.Background()
+
+	for title, minutes := range map[string]int{"La Jetée": 28, "Alien": 117, "Heat": 170, "Unknown Runtime": 0} {
+		m, _ := movie.NewMovie(title, "Director", 1980)
+		_ = m.SetDuration(minutes)
+		if err := repo.Save(ctx, m); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		criteria movie.SearchCriteria
+		want     []string
+	}{
+		{name: "minimum", criteria: movie.SearchCriteria{MinDuration: 100}, want: []string{"Alien", "Heat"}},
+		{name: "maximum", criteria: movie.SearchCriteria{MaxDuration: movie.ShortFilmMaxDuration}, want: []string{"La Jetée"}},
+		{name: "range", criteria: movie.SearchCriteria{MinDuration: 30, MaxDuration: 150}, want: []string{"Alien"}},
+		{name: "no bounds", criteria: movie.SearchCriteria{}, want: []string{"Alien", "Heat", "La Jetée", "Unknown Runtime"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.criteria.OrderBy = movie.OrderByTitle
+			results, err := repo.FindByCriteria(ctx, tt.criteria)
+			if err != nil {
+				t.Fatalf("FindByCriteria() error = %v", err)
+			}
+			titles := make([]string, len(results))
+			for i, m := range results {
+				titles[i] = m.Title()
+			}
+			if !reflect.DeepEqual(titles, tt.want) {
+				t.Errorf("Expected %v, got: %v", tt.want, titles)
+			}
+		})
+	}
+
+	heat, _ := repo.FindByCriteria(ctx, movie.SearchCriteria{Title: "Heat"})
+	if len(heat) != 1 || heat[0].Duration() != 170 {
+		t.Errorf("Expected Heat to keep its 170 minute runtime, got: %v", heat)
+	}
+}
+
+func TestMovieRepository_FindByCriteria_ByCertification(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewMovieRepository(db)
+	ctx := context.Background()
+
+	movies := []struct {
+		title         string
+		certification string
+		warnings      []string
+	}{
+		{"Toy Story", "G", nil},
+		{"The Dark Knight", "PG-13", []string{"violence"}},
+		{"Jurassic Park", "PG-13", []string{"peril"}},
+		{"Pulp Fiction", "R", []string{"violence", "drug use"}},
+		{"Unrated Short", "", nil},
+	}
+
+	for _, m := range movies {
+		domainMovie, _ := movie.NewMovie(m.title, "Director", 2000)
+		if m.certification != "" {
+			_ = domainMovie.SetCertification("US", m.certification)
+		}
+		for _, warning := range m.warnings {
+			_ = domainMovie.AddContentWarning(warning)
+		}
+		_ = repo.Save(ctx, domainMovie)
+	}
+
+	tests := []struct {
+		name     string
+		criteria movie.SearchCriteria
+		want     []string
+	}{
+		{
+			name:     "up to PG-13",
+			criteria: movie.SearchCriteria{CertificationRegion: "US", Certifications: []string{"G", "PG", "PG-13"}},
+			want:     []string{"Jurassic Park", "The Dark Knight", "Toy Story"},
+		},
+		{
+			name:     "no rating in region",
+			criteria: movie.SearchCriteria{CertificationRegion: "GB", Certifications: []string{"U"}},
+			want:     []string{},
+		},
+		{
+			name:     "exclude warnings",
+			criteria: movie.SearchCriteria{ExcludeWarnings: []string{"violence"}},
+			want:     []string{"Jurassic Park", "Toy Story", "Unrated Short"},
+		},
+		{
+			name: "both",
+			criteria: movie.SearchCriteria{
+				CertificationRegion: "US",
+				Certifications:      []string{"G", "PG", "PG-13"},
+				ExcludeWarnings:     []string{"peril"},
+			},
+			want: []string{"The Dark Knight", "Toy Story"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.criteria.Limit = 10
+			tt.criteria.OrderBy = movie.OrderByTitle
+			results, err := repo.FindByCriteria(ctx, tt.criteria)
+			if err != nil {
+				t.Fatalf("FindByCriteria() error = %v", err)
+			}
+
+			titles := make([]string, 0, len(results))
+			for _, result := range results {
+				titles = append(titles, result.Title())
+			}
+			if !reflect.DeepEqual(titles, tt.want) {
+				t.Errorf("Expected %v, got: %v", tt.want, titles)
+			}
+		})
+	}
+}
+
 func TestMovieRepository_Save_WithPosterURL(t *testing.T) {
 	db := setupTestDB(t)
 	defer db.Close()
@@ -244,6 +560,105 @@ func TestMovieRepository_FindByCriteria_ByTitle(t *testing.T) {
 	}
 }
 
+func TestMovieRepository_FindByCriteria_ByIDs(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewMovieRepository(db)
+	ctx := context.Background()
+
+	var ids []shared.MovieID
+	for _, title := range []string{"Inception", "Interstellar", "The Matrix"} {
+		domainMovie, _ := movie.NewMovie(title, "Director", 2010)
+		_ = repo.Save(ctx, domainMovie)
+		ids = append(ids, domainMovie.ID())
+	}
+
+	missingID, _ := shared.NewMovieID(9999)
+	criteria := movie.SearchCriteria{
+		IDs:   []shared.MovieID{ids[0], ids[2], missingID},
+		Limit: 10,
+	}
+
+	results, err := repo.FindByCriteria(ctx, criteria)
+	if err != nil {
+		t.Fatalf("FindByCriteria() error = %v", err)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 movies, got %d", len(results))
+	}
+	if results[0].Title() != "Inception" || results[1].Title() != "The Matrix" {
+		t.Errorf("Expected Inception and The Matrix, got %s and %s", results[0].Title(), results[1].Title())
+	}
+}
+
+func TestMovieRepository_FindByCriteria_MultiKeySort(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewMovieRepository(db)
+	ctx := context.Background()
+
+	movies := []struct {
+		title  string
+		year   int
+		rating float64
+	}{
+		{"Zodiac", 2007, 7.7},
+		{"Heat", 1995, 8.3},
+		{"Alien", 1979, 8.5},
+		{"Casino", 1995, 8.3},
+		{"Collateral", 2004, 7.5},
+	}
+	for _, m := range movies {
+		domainMovie, _ := movie.NewMovie(m.title, "Director", m.year)
+		_ = domainMovie.SetRating(m.rating)
+		_ = repo.Save(ctx, domainMovie)
+	}
+
+	criteria := movie.SearchCriteria{
+		Limit: 10,
+		Sort: []movie.SortKey{
+			{Field: movie.OrderByRating, Dir: movie.OrderDesc},
+			{Field: movie.OrderByYear, Dir: movie.OrderAsc},
+			{Field: movie.OrderByTitle, Dir: movie.OrderAsc},
+		},
+	}
+
+	results, err := repo.FindByCriteria(ctx, criteria)
+	if err != nil {
+		t.Fatalf("FindByCriteria() error = %v", err)
+	}
+
+	want := []string{"Alien", "Casino", "Heat", "Zodiac", "Collateral"}
+	if len(results) != len(want) {
+		t.Fatalf("Expected %d movies, got %d", len(want), len(results))
+	}
+	for i, title := range want {
+		if results[i].Title() != title {
+			t.Errorf("Position %d: expected %s, got %s", i, title, results[i].Title())
+		}
+	}
+}
+
+func TestMovieRepository_FindByCriteria_CancelledContext(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewMovieRepository(db)
+	domainMovie, _ := movie.NewMovie("Inception", "Christopher Nolan", 2010)
+	_ = repo.Save(context.Background(), domainMovie)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results, err := repo.FindByCriteria(ctx, movie.SearchCriteria{Limit: 10})
+	if err == nil {
+		t.Fatalf("FindByCriteria() expected cancellation error, got %d results", len(results))
+	}
+}
+
 func TestMovieRepository_FindByCriteria_ByDirector(t *testing.T) {
 	db := setupTestDB(t)
 	defer db.Close()
@@ -1447,6 +1862,37 @@ func TestMovieRepository_FindByCriteria_OrderByUpdatedAt(t *testing.T) {
 	}
 }
 
+func TestMovieRepository_FindByCriteria_OrderByIDPages(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewMovieRepository(db)
+	ctx := context.Background()
+
+	// Titles out of ID order, so a title sort would page differently
+	for _, title := range []string{"Zodiac", "Alien", "Memento"} {
+		m, _ := movie.NewMovie(title, "Director", 2000)
+		repo.Save(ctx, m)
+	}
+
+	sortByID := []movie.SortKey{{Field: movie.OrderByID, Dir: movie.OrderAsc}}
+	first, err := repo.FindByCriteria(ctx, movie.SearchCriteria{Sort: sortByID, Limit: 2})
+	if err != nil {
+		t.Fatalf("FindByCriteria() error = %v", err)
+	}
+	second, err := repo.FindByCriteria(ctx, movie.SearchCriteria{Sort: sortByID, Limit: 2, Offset: 2})
+	if err != nil {
+		t.Fatalf("FindByCriteria() error = %v", err)
+	}
+
+	if len(first) != 2 || first[0].Title() != "Zodiac" || first[1].Title() != "Alien" {
+		t.Errorf("Expected the first page in insertion order, got %d movies", len(first))
+	}
+	if len(second) != 1 || second[0].Title() != "Memento" {
+		t.Errorf("Expected the last movie on the second page, got %d movies", len(second))
+	}
+}
+
 func TestMovieRepository_DeleteAll_Comprehensive(t *testing.T) {
 	db := setupTestDB(t)
 	defer db.Close()
@@ -1647,3 +2093,78 @@ func TestMovieRepository_ComplexSearchScenarios(t *testing.T) {
 		t.Errorf("Expected 'The Shawshank Redemption' as top rated, got '%s'", topRated[0].Title())
 	}
 }
+
+func TestDecodeGenres(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		expected []string
+		wantErr  bool
+	}{
+		{name: "json array", raw: `["Drama","Crime"]`, expected: []string{"Drama", "Crime"}},
+		{name: "empty", raw: "", expected: nil},
+		{name: "null", raw: " null ", expected: nil},
+		{name: "legacy plain genre", raw: "Drama", expected: []string{"Drama"}},
+		{name: "blank and repeated genres", raw: `["Drama"," ","Drama "]`, expected: []string{"Drama"}},
+		{name: "whitespace only", raw: "   ", expected: nil},
+		{name: "malformed array", raw: ` ["Drama"`, wantErr: true},
+		{name: "array of numbers", raw: `[1,2]`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			genres, err := decodeGenres(tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got: %v", tt.wantErr, err)
+			}
+			if !tt.wantErr && len(genres) != len(tt.expected) {
+				t.Fatalf("Expected %v, got: %v", tt.expected, genres)
+			}
+			for i := range tt.expected {
+				if genres[i] != tt.expected[i] {
+					t.Errorf("Expected %v, got: %v", tt.expected, genres)
+				}
+			}
+		})
+	}
+}
+
+func FuzzDecodeGenres(f *testing.F) {
+	for _, seed := range []string{`["Drama","Crime"]`, `[]`, "null", "Drama", `["",""]`, `["a","a"]`, `[1]`, `{"genre":"x"}`, `"Drama"`, "[", ""} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, raw string) {
+		genres, err := decodeGenres(raw)
+		if err != nil {
+			return
+		}
+
+		// Every decoded genre must be accepted by the domain, so a stored row
+		// never makes its movie unreadable
+		movieID, _ := shared.NewMovieID(1)
+		m, err := movie.NewMovieWithID(movieID, "Fuzz", "Director", 2000)
+		if err != nil {
+			t.Fatalf("failed to create movie: %v", err)
+		}
+		for _, genre := range genres {
+			if err := m.AddGenre(genre); err != nil {
+				t.Errorf("Expected genre %q from %q to be accepted, got: %v", genre, raw, err)
+			}
+		}
+
+		// Genres written back as JSON decode to the same list. Encoding
+		// replaces invalid UTF-8, so only valid input round-trips exactly.
+		if !utf8.ValidString(raw) {
+			return
+		}
+		encoded, err := json.Marshal(genres)
+		if err != nil {
+			t.Fatalf("failed to encode genres: %v", err)
+		}
+		again, err := decodeGenres(string(encoded))
+		if err != nil || !reflect.DeepEqual(again, genres) {
+			t.Errorf("Expected %s to decode to %v, got: %v (%v)", encoded, genres, again, err)
+		}
+	})
+}
@@ -0,0 +1,71 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+// PosterStore implements the movie.PosterStore interface for SQLite,
+// keeping images in the poster_data and poster_type columns of movies
+type PosterStore struct {
+	*database.BaseRepository
+}
+
+// NewPosterStore creates a new SQLite poster store
+func NewPosterStore(db *sql.DB) *PosterStore {
+	return &PosterStore{
+		BaseRepository: database.NewBaseRepository(db),
+	}
+}
+
+// SavePoster stores a movie's poster image, replacing any it had
+func (s *PosterStore) SavePoster(ctx context.Context, movieID shared.MovieID, data []byte, mimeType string) error {
+	query := "UPDATE movies SET poster_data = ?, poster_type = ? WHERE id = ?"
+	return retryOnBusy(ctx, func() error {
+		return notFound(s.Update(ctx, query, "movie", data, mimeType, movieID.Value()))
+	})
+}
+
+// FindPoster returns a movie's poster image
+func (s *PosterStore) FindPoster(ctx context.Context, movieID shared.MovieID) (*movie.Poster, error) {
+	query := `
+		SELECT title, year, poster_type, poster_data
+		FROM movies
+		WHERE id = ? AND poster_data IS NOT NULL`
+
+	poster := &movie.Poster{MovieID: movieID}
+	var mimeType sql.NullString
+	err := s.QueryRowContext(ctx, query, movieID.Value()).Scan(&poster.Title, &poster.Year, &mimeType, &poster.Data)
+	if err != nil {
+		return nil, notFound(s.WrapNotFound(err, "movie poster"))
+	}
+	poster.MimeType = mimeType.String
+	return poster, nil
+}
+
+// DeletePoster removes a movie's poster image, reporting whether it had one
+func (s *PosterStore) DeletePoster(ctx context.Context, movieID shared.MovieID) (bool, error) {
+	query := `
+		UPDATE movies SET poster_data = NULL, poster_type = NULL
+		WHERE id = ? AND poster_data IS NOT NULL`
+
+	var deleted bool
+	err := retryOnBusy(ctx, func() error {
+		result, err := s.ExecContext(ctx, query, movieID.Value())
+		if err != nil {
+			return fmt.Errorf("failed to delete poster: %w", err)
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		deleted = rows > 0
+		return nil
+	})
+	return deleted, err
+}
@@ -0,0 +1,112 @@
+package sqlite
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// insertPosterTestMovie inserts a movie and returns its ID
+func insertPosterTestMovie(t *testing.T, store *PosterStore) shared.MovieID {
+	t.Helper()
+
+	result, err := store.ExecContext(context.Background(),
+		"INSERT INTO movies (title, director, year) VALUES ('Heat', 'Michael Mann', 1995)")
+	if err != nil {
+		t.Fatalf("failed to insert movie: %v", err)
+	}
+	id, _ := result.LastInsertId()
+	movieID, _ := shared.NewMovieID(int(id))
+	return movieID
+}
+
+func TestPosterStore_SavePoster(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewPosterStore(db)
+	ctx := context.Background()
+	movieID := insertPosterTestMovie(t, store)
+
+	for _, data := range [][]byte{{0x89, 'P', 'N', 'G'}, {0xFF, 0xD8, 0xFF}} {
+		if err := store.SavePoster(ctx, movieID, data, "image/png"); err != nil {
+			t.Fatalf("SavePoster() error = %v", err)
+		}
+	}
+
+	var data []byte
+	var mimeType string
+	if err := db.QueryRow("SELECT poster_data, poster_type FROM movies WHERE id = ?", movieID.Value()).
+		Scan(&data, &mimeType); err != nil {
+		t.Fatalf("failed to read poster: %v", err)
+	}
+	if !bytes.Equal(data, []byte{0xFF, 0xD8, 0xFF}) || mimeType != "image/png" {
+		t.Errorf("Expected the second poster to replace the first, got: %x (%s)", data, mimeType)
+	}
+}
+
+func TestPosterStore_FindPoster(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewPosterStore(db)
+	ctx := context.Background()
+	movieID := insertPosterTestMovie(t, store)
+
+	if _, err := store.FindPoster(ctx, movieID); !errors.Is(err, shared.ErrNotFound) {
+		t.Fatalf("Expected ErrNotFound for a movie without a poster, got: %v", err)
+	}
+
+	if err := store.SavePoster(ctx, movieID, []byte{0xFF, 0xD8, 0xFF}, "image/jpeg"); err != nil {
+		t.Fatalf("SavePoster() error = %v", err)
+	}
+	poster, err := store.FindPoster(ctx, movieID)
+	if err != nil {
+		t.Fatalf("FindPoster() error = %v", err)
+	}
+	if poster.Title != "Heat" || poster.Year != 1995 || poster.MimeType != "image/jpeg" || !bytes.Equal(poster.Data, []byte{0xFF, 0xD8, 0xFF}) {
+		t.Errorf("Expected the Heat (1995) JPEG, got: %+v", poster)
+	}
+}
+
+func TestPosterStore_SavePoster_MissingMovie(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	missingID, _ := shared.NewMovieID(999)
+	err := NewPosterStore(db).SavePoster(context.Background(), missingID, []byte{0xFF}, "image/jpeg")
+	if !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for a missing movie, got: %v", err)
+	}
+}
+
+func TestPosterStore_DeletePoster(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewPosterStore(db)
+	ctx := context.Background()
+	movieID := insertPosterTestMovie(t, store)
+
+	if err := store.SavePoster(ctx, movieID, []byte{0xFF, 0xD8, 0xFF}, "image/jpeg"); err != nil {
+		t.Fatalf("SavePoster() error = %v", err)
+	}
+
+	deleted, err := store.DeletePoster(ctx, movieID)
+	if err != nil || !deleted {
+		t.Fatalf("Expected the poster to be deleted, got: %v (%v)", deleted, err)
+	}
+	var remaining int
+	_ = db.QueryRow("SELECT COUNT(*) FROM movies WHERE poster_data IS NOT NULL OR poster_type IS NOT NULL").Scan(&remaining)
+	if remaining != 0 {
+		t.Errorf("Expected no poster data left, got: %d movies", remaining)
+	}
+
+	deleted, err = store.DeletePoster(ctx, movieID)
+	if err != nil || deleted {
+		t.Errorf("Expected nothing to delete the second time, got: %v (%v)", deleted, err)
+	}
+}
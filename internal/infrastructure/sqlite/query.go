@@ -0,0 +1,19 @@
+package sqlite
+
+import "strings"
+
+// placeholders returns n comma-separated bind parameters for an IN clause
+func placeholders(n int) string {
+	if n <= 0 {
+		return ""
+	}
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+// sqlOrderDirection returns the ORDER BY keyword for a sort direction
+func sqlOrderDirection(desc bool) string {
+	if desc {
+		return "DESC"
+	}
+	return "ASC"
+}
@@ -0,0 +1,152 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/domain/similarity"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+// SimilarityRepository implements the similarity.Repository interface for SQLite
+type SimilarityRepository struct {
+	*database.BaseRepository
+	txManager *database.TransactionManager
+}
+
+// NewSimilarityRepository creates a new SQLite movie similarity repository
+func NewSimilarityRepository(db *sql.DB) *SimilarityRepository {
+	return &SimilarityRepository{
+		BaseRepository: database.NewBaseRepository(db),
+		txManager:      database.NewTransactionManager(db),
+	}
+}
+
+const (
+	insertSimilarityQuery = `
+		INSERT INTO movie_similarities (movie_id, similar_id, score, updated_at)
+		VALUES (?, ?, ?, CURRENT_TIMESTAMP)`
+
+	// pruneSimilaritiesQuery drops a movie's neighbors past its best ones
+	pruneSimilaritiesQuery = `
+		DELETE FROM movie_similarities
+		WHERE movie_id = ? AND similar_id NOT IN (
+			SELECT similar_id FROM movie_similarities
+			WHERE movie_id = ?
+			ORDER BY score DESC, similar_id ASC
+			LIMIT ?
+		)`
+)
+
+// FindNeighbors retrieves up to limit movies similar to a movie, most
+// similar first
+func (r *SimilarityRepository) FindNeighbors(ctx context.Context, movieID shared.MovieID, limit int) ([]similarity.Neighbor, error) {
+	query := `
+		SELECT similar_id, score
+		FROM movie_similarities
+		WHERE movie_id = ?
+		ORDER BY score DESC, similar_id ASC
+		LIMIT ?`
+
+	rows, err := r.QueryContext(ctx, query, movieID.Value(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query similar movies: %w", err)
+	}
+	defer rows.Close()
+
+	neighbors := make([]similarity.Neighbor, 0, limit)
+	for rows.Next() {
+		var id int
+		var neighbor similarity.Neighbor
+		if err := rows.Scan(&id, &neighbor.Score); err != nil {
+			return nil, fmt.Errorf("failed to scan similar movie: %w", err)
+		}
+		if neighbor.MovieID, err = shared.NewMovieID(id); err != nil {
+			return nil, fmt.Errorf("failed to create movie ID: %w", err)
+		}
+		neighbors = append(neighbors, neighbor)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query similar movies: %w", err)
+	}
+	return neighbors, nil
+}
+
+// Refresh replaces what is stored about a movie after it changed, in one
+// transaction
+func (r *SimilarityRepository) Refresh(ctx context.Context, movieID shared.MovieID, neighbors []similarity.Neighbor) error {
+	return writeTransaction(ctx, r.txManager, func(tx *sql.Tx) error {
+		query := "DELETE FROM movie_similarities WHERE movie_id = ? OR similar_id = ?"
+		if _, err := tx.ExecContext(ctx, query, movieID.Value(), movieID.Value()); err != nil {
+			return fmt.Errorf("failed to delete similar movies: %w", err)
+		}
+		if len(neighbors) == 0 {
+			return nil
+		}
+
+		insert, err := tx.PrepareContext(ctx, insertSimilarityQuery)
+		if err != nil {
+			return fmt.Errorf("failed to prepare similar movie insert: %w", err)
+		}
+		defer insert.Close()
+		prune, err := tx.PrepareContext(ctx, pruneSimilaritiesQuery)
+		if err != nil {
+			return fmt.Errorf("failed to prepare similar movie pruning: %w", err)
+		}
+		defer prune.Close()
+
+		for i, neighbor := range neighbors {
+			if i < similarity.MaxNeighbors {
+				if _, err := insert.ExecContext(ctx, movieID.Value(), neighbor.MovieID.Value(), neighbor.Score); err != nil {
+					return missingReference(fmt.Errorf("failed to insert similar movie: %w", err), "movie", movieID.Value())
+				}
+			}
+			if _, err := insert.ExecContext(ctx, neighbor.MovieID.Value(), movieID.Value(), neighbor.Score); err != nil {
+				return missingReference(fmt.Errorf("failed to insert similar movie: %w", err), "movie", neighbor.MovieID.Value())
+			}
+			if _, err := prune.ExecContext(ctx, neighbor.MovieID.Value(), neighbor.MovieID.Value(), similarity.MaxNeighbors); err != nil {
+				return fmt.Errorf("failed to prune similar movies: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// ReplaceAll discards every stored list and stores the first MaxNeighbors of
+// each given one
+func (r *SimilarityRepository) ReplaceAll(ctx context.Context, lists map[shared.MovieID][]similarity.Neighbor) error {
+	return writeTransaction(ctx, r.txManager, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM movie_similarities"); err != nil {
+			return fmt.Errorf("failed to delete similar movies: %w", err)
+		}
+
+		insert, err := tx.PrepareContext(ctx, insertSimilarityQuery)
+		if err != nil {
+			return fmt.Errorf("failed to prepare similar movie insert: %w", err)
+		}
+		defer insert.Close()
+
+		for movieID, neighbors := range lists {
+			if len(neighbors) > similarity.MaxNeighbors {
+				neighbors = neighbors[:similarity.MaxNeighbors]
+			}
+			for _, neighbor := range neighbors {
+				if _, err := insert.ExecContext(ctx, movieID.Value(), neighbor.MovieID.Value(), neighbor.Score); err != nil {
+					return missingReference(fmt.Errorf("failed to insert similar movie: %w", err), "movie", movieID.Value())
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// Count returns the number of stored similar pairs
+func (r *SimilarityRepository) Count(ctx context.Context) (int, error) {
+	var count int
+	if err := r.QueryRowContext(ctx, "SELECT COUNT(*) FROM movie_similarities").Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count similar movies: %w", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,199 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/domain/similarity"
+	_ "modernc.org/sqlite"
+)
+
+// setupSimilarityTestDB creates an in-memory SQLite database with movies
+// 1 to count
+func setupSimilarityTestDB(t *testing.T, count int) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", ":memory:?_time_format=sqlite&_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatalf("failed to open test database: %v", err)
+	}
+	db.SetMaxOpenConns(1) // Catches nested queries, which deadlock a one-connection pool
+
+	values := make([]string, count)
+	for i := range values {
+		values[i] = fmt.Sprintf("(%d, 'Movie %d')", i+1, i+1)
+	}
+	schema := `
+	CREATE TABLE movies (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		title TEXT NOT NULL
+	);
+
+	CREATE TABLE movie_similarities (
+		movie_id INTEGER NOT NULL,
+		similar_id INTEGER NOT NULL,
+		score REAL NOT NULL,
+		updated_at TEXT DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (movie_id, similar_id),
+		FOREIGN KEY (movie_id) REFERENCES movies(id) ON DELETE CASCADE,
+		FOREIGN KEY (similar_id) REFERENCES movies(id) ON DELETE CASCADE
+	);
+
+	INSERT INTO movies (id, title) VALUES ` + strings.Join(values, ", ") + ";"
+
+	if _, err := db.Exec(schema); err != nil {
+		t.Fatalf("failed to create test schema: %v", err)
+	}
+
+	return db
+}
+
+func testNeighbor(id int, score float64) similarity.Neighbor {
+	return similarity.Neighbor{MovieID: testMovieID(id), Score: score}
+}
+
+func neighborIDs(neighbors []similarity.Neighbor) []int {
+	ids := make([]int, len(neighbors))
+	for i, neighbor := range neighbors {
+		ids[i] = neighbor.MovieID.Value()
+	}
+	return ids
+}
+
+func TestSimilarityRepository_Refresh(t *testing.T) {
+	db := setupSimilarityTestDB(t, 4)
+	defer db.Close()
+
+	repo := NewSimilarityRepository(db)
+	ctx := context.Background()
+
+	if err := repo.Refresh(ctx, testMovieID(1), []similarity.Neighbor{testNeighbor(3, 0.9), testNeighbor(2, 0.5)}); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	if err := repo.Refresh(ctx, testMovieID(4), []similarity.Neighbor{testNeighbor(2, 0.7)}); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+
+	neighbors, err := repo.FindNeighbors(ctx, testMovieID(1), 10)
+	if err != nil {
+		t.Fatalf("FindNeighbors() error = %v", err)
+	}
+	if ids := neighborIDs(neighbors); fmt.Sprint(ids) != "[3 2]" || neighbors[0].Score != 0.9 {
+		t.Errorf("Expected movies 3 and 2 most similar first, got: %v", neighbors)
+	}
+
+	// Scores are symmetric, so movie 2 lists both movies that found it similar
+	neighbors, err = repo.FindNeighbors(ctx, testMovieID(2), 10)
+	if err != nil {
+		t.Fatalf("FindNeighbors() error = %v", err)
+	}
+	if ids := neighborIDs(neighbors); fmt.Sprint(ids) != "[4 1]" {
+		t.Errorf("Expected movies 4 and 1, got: %v", ids)
+	}
+
+	// Once movie 1 has nothing in common with the others, it drops out everywhere
+	if err := repo.Refresh(ctx, testMovieID(1), nil); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	neighbors, err = repo.FindNeighbors(ctx, testMovieID(2), 10)
+	if err != nil {
+		t.Fatalf("FindNeighbors() error = %v", err)
+	}
+	if ids := neighborIDs(neighbors); fmt.Sprint(ids) != "[4]" {
+		t.Errorf("Expected only movie 4, got: %v", ids)
+	}
+
+	count, err := repo.Count(ctx)
+	if err != nil {
+		t.Fatalf("Count() error = %v", err)
+	}
+	if count != 2 {
+		t.Errorf("Expected 2 stored pairs, got %d", count)
+	}
+}
+
+func TestSimilarityRepository_RefreshPrunesNeighbors(t *testing.T) {
+	db := setupSimilarityTestDB(t, similarity.MaxNeighbors+2)
+	defer db.Close()
+
+	repo := NewSimilarityRepository(db)
+	ctx := context.Background()
+
+	// Fill movie 1's list with movies 2 to MaxNeighbors+1
+	for id := 2; id <= similarity.MaxNeighbors+1; id++ {
+		if err := repo.Refresh(ctx, testMovieID(id), []similarity.Neighbor{testNeighbor(1, 0.5)}); err != nil {
+			t.Fatalf("Refresh() error = %v", err)
+		}
+	}
+
+	last := similarity.MaxNeighbors + 2
+	if err := repo.Refresh(ctx, testMovieID(last), []similarity.Neighbor{testNeighbor(1, 0.8)}); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+
+	neighbors, err := repo.FindNeighbors(ctx, testMovieID(1), similarity.MaxNeighbors+10)
+	if err != nil {
+		t.Fatalf("FindNeighbors() error = %v", err)
+	}
+	if len(neighbors) != similarity.MaxNeighbors {
+		t.Fatalf("Expected %d neighbors, got %d", similarity.MaxNeighbors, len(neighbors))
+	}
+	if neighbors[0].MovieID.Value() != last {
+		t.Errorf("Expected movie %d first, got: %v", last, neighbors[0])
+	}
+	if lowest := neighbors[len(neighbors)-1].MovieID.Value(); lowest != similarity.MaxNeighbors {
+		t.Errorf("Expected the highest-ID tie to be pruned, got %d last", lowest)
+	}
+}
+
+func TestSimilarityRepository_RefreshUnknownMovie(t *testing.T) {
+	db := setupSimilarityTestDB(t, 2)
+	defer db.Close()
+
+	repo := NewSimilarityRepository(db)
+
+	err := repo.Refresh(context.Background(), testMovieID(1), []similarity.Neighbor{testNeighbor(99, 0.5)})
+	if !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got: %v", err)
+	}
+}
+
+func TestSimilarityRepository_ReplaceAll(t *testing.T) {
+	db := setupSimilarityTestDB(t, 3)
+	defer db.Close()
+
+	repo := NewSimilarityRepository(db)
+	ctx := context.Background()
+
+	if err := repo.Refresh(ctx, testMovieID(1), []similarity.Neighbor{testNeighbor(2, 0.5)}); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+
+	lists := map[shared.MovieID][]similarity.Neighbor{
+		testMovieID(2): {testNeighbor(3, 0.6)},
+		testMovieID(3): {testNeighbor(2, 0.6)},
+	}
+	if err := repo.ReplaceAll(ctx, lists); err != nil {
+		t.Fatalf("ReplaceAll() error = %v", err)
+	}
+
+	count, err := repo.Count(ctx)
+	if err != nil {
+		t.Fatalf("Count() error = %v", err)
+	}
+	if count != 2 {
+		t.Errorf("Expected 2 stored pairs, got %d", count)
+	}
+	neighbors, err := repo.FindNeighbors(ctx, testMovieID(1), 10)
+	if err != nil {
+		t.Fatalf("FindNeighbors() error = %v", err)
+	}
+	if len(neighbors) != 0 {
+		t.Errorf("Expected movie 1's old list to be discarded, got: %v", neighbors)
+	}
+}
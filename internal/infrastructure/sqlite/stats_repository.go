@@ -0,0 +1,161 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+// StatsRepository implements the movie.StatsReader interface for SQLite,
+// reading the summary tables that triggers on movies keep up to date. It
+// also implements movie.PopularityReader.
+type StatsRepository struct {
+	*database.BaseRepository
+}
+
+// NewStatsRepository creates a new SQLite stats repository
+func NewStatsRepository(db *sql.DB) *StatsRepository {
+	return &StatsRepository{
+		BaseRepository: database.NewBaseRepository(db),
+	}
+}
+
+// LibraryStats returns the aggregates over every movie. The year range comes
+// from the movies year index, which answers MIN and MAX without a scan.
+func (r *StatsRepository) LibraryStats(ctx context.Context) (*movie.LibraryStats, error) {
+	stats := &movie.LibraryStats{
+		Genres:         make(map[string]int),
+		Certifications: make(map[string]map[string]int),
+	}
+
+	var ratingTotal float64
+	err := r.QueryRowContext(ctx, `SELECT total_movies, rated_movies, rating_total FROM movie_library_stats WHERE id = 1`).
+		Scan(&stats.TotalMovies, &stats.RatedMovies, &ratingTotal)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read library stats: %w", err)
+	}
+	if stats.RatedMovies > 0 {
+		stats.AverageRating = ratingTotal / float64(stats.RatedMovies)
+	}
+
+	var earliest, latest sql.NullInt64
+	if err := r.QueryRowContext(ctx, `SELECT MIN(year), MAX(year) FROM movies`).Scan(&earliest, &latest); err != nil {
+		return nil, fmt.Errorf("failed to read year range: %w", err)
+	}
+	stats.EarliestYear, stats.LatestYear = int(earliest.Int64), int(latest.Int64)
+
+	rows, err := r.QueryContext(ctx, `SELECT genre, movies FROM movie_genre_counts`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read genre counts: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var genre string
+		var count int
+		if err := rows.Scan(&genre, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan genre count: %w", err)
+		}
+		stats.Genres[genre] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read genre counts: %w", err)
+	}
+
+	certRows, err := r.QueryContext(ctx, `SELECT region, certification, movies FROM movie_certification_counts`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read certification counts: %w", err)
+	}
+	defer certRows.Close()
+	for certRows.Next() {
+		var region, certification string
+		var count int
+		if err := certRows.Scan(&region, &certification, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan certification count: %w", err)
+		}
+		if stats.Certifications[region] == nil {
+			stats.Certifications[region] = make(map[string]int)
+		}
+		stats.Certifications[region][certification] = count
+	}
+	if err := certRows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read certification counts: %w", err)
+	}
+
+	return stats, nil
+}
+
+// TopDirectors returns the directors with the most movies, best average
+// rating first among equals
+func (r *StatsRepository) TopDirectors(ctx context.Context, limit int) ([]movie.DirectorStats, error) {
+	if limit <= 0 {
+		limit = -1 // SQLite reads a negative limit as no limit
+	}
+	rows, err := r.QueryContext(ctx, `
+		SELECT director, movies, iif(rated_movies > 0, rating_total / rated_movies, 0) AS average_rating
+		FROM movie_director_stats
+		ORDER BY movies DESC, average_rating DESC, director
+		LIMIT ?`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read director stats: %w", err)
+	}
+	defer rows.Close()
+
+	var directors []movie.DirectorStats
+	for rows.Next() {
+		var director movie.DirectorStats
+		if err := rows.Scan(&director.Director, &director.Movies, &director.AverageRating); err != nil {
+			return nil, fmt.Errorf("failed to scan director stats: %w", err)
+		}
+		directors = append(directors, director)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read director stats: %w", err)
+	}
+	return directors, nil
+}
+
+// MovieReferences counts the cast credits, tags and franchise entries of
+// each of ids. The counts are grouped over the link tables, which are small
+// next to movies, and filtered here so long ID lists need no bind parameters.
+func (r *StatsRepository) MovieReferences(ctx context.Context, ids []shared.MovieID) (map[shared.MovieID]int, error) {
+	wanted := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		wanted[id.Value()] = true
+	}
+
+	rows, err := r.QueryContext(ctx, `
+		SELECT movie_id, COUNT(*) FROM (
+			SELECT movie_id FROM movie_actors
+			UNION ALL SELECT movie_id FROM movie_tags
+			UNION ALL SELECT movie_id FROM franchise_movies
+		)
+		GROUP BY movie_id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count movie references: %w", err)
+	}
+	defer rows.Close()
+
+	references := make(map[shared.MovieID]int)
+	for rows.Next() {
+		var movieID, count int
+		if err := rows.Scan(&movieID, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan movie references: %w", err)
+		}
+		if !wanted[movieID] {
+			continue
+		}
+		id, err := shared.NewMovieID(movieID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid movie ID %d in references: %w", movieID, err)
+		}
+		references[id] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to count movie references: %w", err)
+	}
+	return references, nil
+}
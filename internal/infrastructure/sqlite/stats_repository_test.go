@@ -0,0 +1,186 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/migrations"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+	_ "modernc.org/sqlite"
+)
+
+// setupStatsTestDB creates an in-memory SQLite database with every migration
+// applied, as the summary triggers span several tables
+func setupStatsTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", ":memory:?_time_format=sqlite")
+	if err != nil {
+		t.Fatalf("failed to open test database: %v", err)
+	}
+	db.SetMaxOpenConns(1) // Each in-memory connection is its own database
+	t.Cleanup(func() { db.Close() })
+
+	if _, err := database.Migrate(context.Background(), db, migrations.FS); err != nil {
+		t.Fatalf("failed to apply migrations: %v", err)
+	}
+	return db
+}
+
+func newStatsTestMovie(t *testing.T, title, director string, year int, rating float64, genres ...string) *movie.Movie {
+	t.Helper()
+
+	m, err := movie.NewMovie(title, director, year)
+	if err != nil {
+		t.Fatalf("failed to create movie: %v", err)
+	}
+	if rating > 0 {
+		if err := m.SetRating(rating); err != nil {
+			t.Fatalf("failed to set rating: %v", err)
+		}
+	}
+	for _, genre := range genres {
+		m.AddGenre(genre)
+	}
+	return m
+}
+
+func TestStatsRepository_MaintainedOnWrite(t *testing.T) {
+	db := setupStatsTestDB(t)
+	movies := NewMovieRepository(db)
+	stats := NewStatsRepository(db)
+	ctx := context.Background()
+
+	heat := newStatsTestMovie(t, "Heat", "Michael Mann", 1995, 8.3, "Crime", "Drama")
+	heat.SetCertification("US", "R")
+	collateral := newStatsTestMovie(t, "Collateral", "Michael Mann", 2004, 7.5, "Crime")
+	alien := newStatsTestMovie(t, "Alien", "Ridley Scott", 1979, 0, "Sci-Fi")
+	for _, m := range []*movie.Movie{heat, collateral, alien} {
+		if err := movies.Save(ctx, m); err != nil {
+			t.Fatalf("failed to save movie: %v", err)
+		}
+	}
+
+	library, err := stats.LibraryStats(ctx)
+	if err != nil {
+		t.Fatalf("LibraryStats() error = %v", err)
+	}
+	if library.TotalMovies != 3 || library.RatedMovies != 2 || library.AverageRating != 7.9 {
+		t.Errorf("Expected 3 movies, 2 rated at 7.9, got %+v", library)
+	}
+	if library.EarliestYear != 1979 || library.LatestYear != 2004 {
+		t.Errorf("Expected 1979 to 2004, got %d to %d", library.EarliestYear, library.LatestYear)
+	}
+	if want := map[string]int{"Crime": 2, "Drama": 1, "Sci-Fi": 1}; !reflect.DeepEqual(library.Genres, want) {
+		t.Errorf("Expected genres %v, got %v", want, library.Genres)
+	}
+	if library.Certifications["US"]["R"] != 1 {
+		t.Errorf("Expected 1 R in the US, got %v", library.Certifications)
+	}
+
+	// Rate Alien, and move Heat to another director out of Drama
+	if err := alien.SetRating(8.5); err != nil {
+		t.Fatalf("failed to set rating: %v", err)
+	}
+	retitled, err := movie.NewMovieWithID(heat.ID(), "Heat", "Quentin Tarantino", 1995)
+	if err != nil {
+		t.Fatalf("failed to create movie: %v", err)
+	}
+	retitled.SetRating(8.3)
+	retitled.AddGenre("Crime")
+	for _, m := range []*movie.Movie{alien, retitled} {
+		if err := movies.Save(ctx, m); err != nil {
+			t.Fatalf("failed to update movie: %v", err)
+		}
+	}
+	if err := movies.Delete(ctx, collateral.ID()); err != nil {
+		t.Fatalf("failed to delete movie: %v", err)
+	}
+
+	library, err = stats.LibraryStats(ctx)
+	if err != nil {
+		t.Fatalf("LibraryStats() error = %v", err)
+	}
+	if library.TotalMovies != 2 || library.RatedMovies != 2 || library.AverageRating != 8.4 {
+		t.Errorf("Expected 2 movies rated at 8.4, got %+v", library)
+	}
+	if want := map[string]int{"Crime": 1, "Sci-Fi": 1}; !reflect.DeepEqual(library.Genres, want) {
+		t.Errorf("Expected emptied genres to be dropped, got %v", library.Genres)
+	}
+
+	directors, err := stats.TopDirectors(ctx, 10)
+	if err != nil {
+		t.Fatalf("TopDirectors() error = %v", err)
+	}
+	want := []movie.DirectorStats{
+		{Director: "Ridley Scott", Movies: 1, AverageRating: 8.5},
+		{Director: "Quentin Tarantino", Movies: 1, AverageRating: 8.3},
+	}
+	if !reflect.DeepEqual(directors, want) {
+		t.Errorf("Expected %+v, got %+v", want, directors)
+	}
+
+	if top, err := stats.TopDirectors(ctx, 1); err != nil || len(top) != 1 || top[0].Director != "Ridley Scott" {
+		t.Errorf("Expected the top director only, got %+v (error %v)", top, err)
+	}
+	if all, err := stats.TopDirectors(ctx, 0); err != nil || !reflect.DeepEqual(all, want) {
+		t.Errorf("Expected every director for no limit, got %+v (error %v)", all, err)
+	}
+}
+
+func TestStatsRepository_EmptyLibrary(t *testing.T) {
+	stats := NewStatsRepository(setupStatsTestDB(t))
+
+	library, err := stats.LibraryStats(context.Background())
+	if err != nil {
+		t.Fatalf("LibraryStats() error = %v", err)
+	}
+	if library.TotalMovies != 0 || library.EarliestYear != 0 || len(library.Genres) != 0 {
+		t.Errorf("Expected empty stats, got %+v", library)
+	}
+}
+
+func TestStatsRepository_MovieReferences(t *testing.T) {
+	db := setupStatsTestDB(t)
+	movies := NewMovieRepository(db)
+	stats := NewStatsRepository(db)
+	ctx := context.Background()
+
+	heat := newStatsTestMovie(t, "Heat", "Michael Mann", 1995, 8.3)
+	alien := newStatsTestMovie(t, "Alien", "Ridley Scott", 1979, 8.5)
+	thief := newStatsTestMovie(t, "Thief", "Michael Mann", 1981, 7.4)
+	for _, m := range []*movie.Movie{heat, alien, thief} {
+		if err := movies.Save(ctx, m); err != nil {
+			t.Fatalf("failed to save movie: %v", err)
+		}
+	}
+
+	heatID, alienID := heat.ID().Value(), alien.ID().Value()
+	for _, link := range []struct {
+		statement string
+		args      []any
+	}{
+		{`INSERT INTO actors (id, name) VALUES (1, 'Al Pacino'), (2, 'Robert De Niro'), (3, 'Sigourney Weaver')`, nil},
+		{`INSERT INTO movie_actors (movie_id, actor_id) VALUES (?, 1), (?, 2), (?, 3)`, []any{heatID, heatID, alienID}},
+		{`INSERT INTO tags (id, name) VALUES (1, 'heist')`, nil},
+		{`INSERT INTO movie_tags (movie_id, tag_id) VALUES (?, 1)`, []any{heatID}},
+		{`INSERT INTO franchises (id, name) VALUES (1, 'Alien')`, nil},
+		{`INSERT INTO franchise_movies (franchise_id, movie_id, position) VALUES (1, ?, 1)`, []any{alienID}},
+	} {
+		if _, err := db.ExecContext(ctx, link.statement, link.args...); err != nil {
+			t.Fatalf("failed to link movies: %v", err)
+		}
+	}
+
+	references, err := stats.MovieReferences(ctx, []shared.MovieID{heat.ID(), thief.ID()})
+	if err != nil {
+		t.Fatalf("MovieReferences() error = %v", err)
+	}
+	if want := map[shared.MovieID]int{heat.ID(): 3}; !reflect.DeepEqual(references, want) {
+		t.Errorf("Expected Heat's two credits and tag only, got %v", references)
+	}
+}
@@ -0,0 +1,264 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/domain/tag"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+// TagRepository implements the tag.Repository interface for SQLite
+type TagRepository struct {
+	*database.BaseRepository
+	txManager *database.TransactionManager
+}
+
+// NewTagRepository creates a new SQLite tag repository
+func NewTagRepository(db *sql.DB) *TagRepository {
+	return &TagRepository{
+		BaseRepository: database.NewBaseRepository(db),
+		txManager:      database.NewTransactionManager(db),
+	}
+}
+
+// dbTag represents the database model for tags
+type dbTag struct {
+	ID          int            `db:"id"`
+	Name        string         `db:"name"`
+	Description sql.NullString `db:"description"`
+}
+
+// Save persists a tag (insert or update)
+func (r *TagRepository) Save(ctx context.Context, domainTag *tag.Tag) error {
+	description := sql.NullString{String: domainTag.Description(), Valid: domainTag.Description() != ""}
+	isNew := domainTag.ID().IsZero() // Decided once; a retried insert has already set the ID
+
+	return writeTransaction(ctx, r.txManager, func(tx *sql.Tx) error {
+		helper := database.NewTransactionHelper(tx)
+
+		if !isNew {
+			query := `
+				UPDATE tags
+				SET name = ?, description = ?, updated_at = ?
+				WHERE id = ?`
+
+			return notFound(helper.Update(ctx, query, "tag",
+				domainTag.Name(),
+				description,
+				domainTag.UpdatedAt(),
+				domainTag.ID().Value(),
+			))
+		}
+
+		query := `
+			INSERT INTO tags (name, description, created_at, updated_at)
+			VALUES (?, ?, ?, ?)
+			RETURNING id`
+
+		id, err := helper.InsertWithID(ctx, query,
+			domainTag.Name(),
+			description,
+			domainTag.CreatedAt(),
+			domainTag.UpdatedAt(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert tag: %w", err)
+		}
+
+		tagID, err := shared.NewTagID(id)
+		if err != nil {
+			return fmt.Errorf("failed to create tag ID: %w", err)
+		}
+		domainTag.SetID(tagID)
+		return nil
+	})
+}
+
+// FindByName retrieves a tag by its normalized name
+func (r *TagRepository) FindByName(ctx context.Context, name string) (*tag.Tag, error) {
+	query := "SELECT id, name, description FROM tags WHERE name = ?"
+
+	var row dbTag
+	if err := r.QueryRowContext(ctx, query, name).Scan(&row.ID, &row.Name, &row.Description); err != nil {
+		return nil, notFound(r.WrapNotFound(err, "tag"))
+	}
+
+	return row.toDomain()
+}
+
+// FindByMovieID retrieves the tags a movie carries ordered by name
+func (r *TagRepository) FindByMovieID(ctx context.Context, movieID shared.MovieID) ([]*tag.Tag, error) {
+	query := `
+		SELECT t.id, t.name, t.description
+		FROM tags t
+		INNER JOIN movie_tags mt ON t.id = mt.tag_id
+		WHERE mt.movie_id = ?
+		ORDER BY t.name ASC`
+
+	rows, err := r.QueryContext(ctx, query, movieID.Value())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query movie tags: %w", err)
+	}
+	defer rows.Close()
+
+	tags := make([]*tag.Tag, 0)
+	for rows.Next() {
+		var row dbTag
+		if err := rows.Scan(&row.ID, &row.Name, &row.Description); err != nil {
+			return nil, fmt.Errorf("failed to scan tag: %w", err)
+		}
+		domainTag, err := row.toDomain()
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, domainTag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query movie tags: %w", err)
+	}
+	return tags, nil
+}
+
+// FindMovieIDs retrieves the movies carrying every one of the tags when
+// matchAll is set, or any of them otherwise, in ID order
+func (r *TagRepository) FindMovieIDs(ctx context.Context, tagIDs []shared.TagID, matchAll bool) ([]shared.MovieID, error) {
+	movieIDs := make([]shared.MovieID, 0)
+	if len(tagIDs) == 0 {
+		return movieIDs, nil
+	}
+
+	args := make([]interface{}, 0, len(tagIDs)+1)
+	for _, id := range tagIDs {
+		args = append(args, id.Value())
+	}
+
+	query := "SELECT movie_id FROM movie_tags WHERE tag_id IN (" + placeholders(len(tagIDs)) + ") GROUP BY movie_id"
+	if matchAll {
+		query += " HAVING COUNT(DISTINCT tag_id) = ?"
+		args = append(args, len(uniqueTagIDs(tagIDs)))
+	}
+	query += " ORDER BY movie_id ASC"
+
+	rows, err := r.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tagged movies: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var value int
+		if err := rows.Scan(&value); err != nil {
+			return nil, fmt.Errorf("failed to scan movie ID: %w", err)
+		}
+		movieID, err := shared.NewMovieID(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create movie ID: %w", err)
+		}
+		movieIDs = append(movieIDs, movieID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query tagged movies: %w", err)
+	}
+	return movieIDs, nil
+}
+
+// TagMovie attaches tags to a movie; tags it already carries are kept
+func (r *TagRepository) TagMovie(ctx context.Context, movieID shared.MovieID, tagIDs []shared.TagID) error {
+	return writeTransaction(ctx, r.txManager, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO movie_tags (movie_id, tag_id, created_at)
+			VALUES (?, ?, CURRENT_TIMESTAMP)
+			ON CONFLICT (movie_id, tag_id) DO NOTHING`
+
+		for _, tagID := range tagIDs {
+			if _, err := tx.ExecContext(ctx, query, movieID.Value(), tagID.Value()); err != nil {
+				return missingReference(fmt.Errorf("failed to tag movie: %w", err), "movie", movieID.Value())
+			}
+		}
+		return nil
+	})
+}
+
+// UntagMovie detaches a tag from a movie, reporting whether it carried it
+func (r *TagRepository) UntagMovie(ctx context.Context, movieID shared.MovieID, tagID shared.TagID) (bool, error) {
+	var removed bool
+	err := writeTransaction(ctx, r.txManager, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, "DELETE FROM movie_tags WHERE movie_id = ? AND tag_id = ?", movieID.Value(), tagID.Value())
+		if err != nil {
+			return fmt.Errorf("failed to untag movie: %w", err)
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to untag movie: %w", err)
+		}
+		removed = affected > 0
+		return nil
+	})
+	return removed, err
+}
+
+// Counts retrieves the tags whose names start with prefix, most used first
+// and then by name; a limit of 0 returns them all
+func (r *TagRepository) Counts(ctx context.Context, prefix string, limit int) ([]tag.Count, error) {
+	query := `
+		SELECT t.name, COUNT(mt.movie_id)
+		FROM tags t
+		LEFT JOIN movie_tags mt ON t.id = mt.tag_id
+		WHERE substr(t.name, 1, length(?)) = ?
+		GROUP BY t.id
+		ORDER BY COUNT(mt.movie_id) DESC, t.name ASC`
+	args := []interface{}{prefix, prefix}
+	if limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, limit)
+	}
+
+	rows, err := r.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tag counts: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make([]tag.Count, 0)
+	for rows.Next() {
+		var count tag.Count
+		if err := rows.Scan(&count.Name, &count.Movies); err != nil {
+			return nil, fmt.Errorf("failed to scan tag count: %w", err)
+		}
+		counts = append(counts, count)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query tag counts: %w", err)
+	}
+	return counts, nil
+}
+
+// toDomain converts a database row to a domain tag
+func (row *dbTag) toDomain() (*tag.Tag, error) {
+	tagID, err := shared.NewTagID(row.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tag ID: %w", err)
+	}
+
+	domainTag, err := tag.NewTagWithID(tagID, row.Name, row.Description.String)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain tag: %w", err)
+	}
+	return domainTag, nil
+}
+
+// uniqueTagIDs drops repeated tag IDs, keeping the first of each
+func uniqueTagIDs(tagIDs []shared.TagID) []shared.TagID {
+	seen := make(map[int]bool, len(tagIDs))
+	unique := make([]shared.TagID, 0, len(tagIDs))
+	for _, id := range tagIDs {
+		if !seen[id.Value()] {
+			seen[id.Value()] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,229 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/domain/tag"
+	_ "modernc.org/sqlite"
+)
+
+// setupTagTestDB creates an in-memory SQLite database with three movies
+func setupTagTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", ":memory:?_time_format=sqlite&_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatalf("failed to open test database: %v", err)
+	}
+	db.SetMaxOpenConns(1) // Catches nested queries, which deadlock a one-connection pool
+
+	schema := `
+	CREATE TABLE movies (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		title TEXT NOT NULL
+	);
+
+	CREATE TABLE tags (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		name TEXT NOT NULL UNIQUE,
+		description TEXT,
+		created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+		updated_at TEXT DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE TABLE movie_tags (
+		movie_id INTEGER NOT NULL,
+		tag_id INTEGER NOT NULL,
+		created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (movie_id, tag_id),
+		FOREIGN KEY (movie_id) REFERENCES movies(id) ON DELETE CASCADE,
+		FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE
+	);
+
+	INSERT INTO movies (id, title) VALUES (1, 'Back to the Future'), (2, 'Looper'), (3, 'Jurassic Park');`
+
+	if _, err := db.Exec(schema); err != nil {
+		t.Fatalf("failed to create test schema: %v", err)
+	}
+
+	return db
+}
+
+func saveTestTag(t *testing.T, repo *TagRepository, name string) *tag.Tag {
+	t.Helper()
+
+	domainTag, err := tag.NewTag(name, "")
+	if err != nil {
+		t.Fatalf("failed to create tag: %v", err)
+	}
+	if err := repo.Save(context.Background(), domainTag); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	return domainTag
+}
+
+func testMovieID(id int) shared.MovieID {
+	movieID, _ := shared.NewMovieID(id)
+	return movieID
+}
+
+func TestTagRepository_SaveAndFindByName(t *testing.T) {
+	db := setupTagTestDB(t)
+	defer db.Close()
+
+	repo := NewTagRepository(db)
+	ctx := context.Background()
+
+	timeTravel, _ := tag.NewTag("Time Travel", "Stories that move through time")
+	if err := repo.Save(ctx, timeTravel); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if timeTravel.ID().IsZero() {
+		t.Fatal("Expected tag ID to be assigned")
+	}
+
+	found, err := repo.FindByName(ctx, "time-travel")
+	if err != nil {
+		t.Fatalf("FindByName() error = %v", err)
+	}
+	if found.ID() != timeTravel.ID() || found.Description() != "Stories that move through time" {
+		t.Errorf("Expected the saved tag, got: %d %q", found.ID().Value(), found.Description())
+	}
+
+	found.SetDescription("Time loops and paradoxes")
+	if err := repo.Save(ctx, found); err != nil {
+		t.Fatalf("Save() update error = %v", err)
+	}
+	updated, _ := repo.FindByName(ctx, "time-travel")
+	if updated.Description() != "Time loops and paradoxes" {
+		t.Errorf("Expected the updated description, got: %q", updated.Description())
+	}
+
+	if _, err := repo.FindByName(ctx, "heist"); !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for an unknown tag, got: %v", err)
+	}
+}
+
+func TestTagRepository_TagAndUntagMovie(t *testing.T) {
+	db := setupTagTestDB(t)
+	defer db.Close()
+
+	repo := NewTagRepository(db)
+	ctx := context.Background()
+
+	timeTravel := saveTestTag(t, repo, "time-travel")
+	comedy := saveTestTag(t, repo, "comedy-classic")
+
+	if err := repo.TagMovie(ctx, testMovieID(1), []shared.TagID{timeTravel.ID(), comedy.ID()}); err != nil {
+		t.Fatalf("TagMovie() error = %v", err)
+	}
+	// Tagging again keeps the existing link
+	if err := repo.TagMovie(ctx, testMovieID(1), []shared.TagID{timeTravel.ID()}); err != nil {
+		t.Fatalf("TagMovie() repeat error = %v", err)
+	}
+
+	tags, err := repo.FindByMovieID(ctx, testMovieID(1))
+	if err != nil {
+		t.Fatalf("FindByMovieID() error = %v", err)
+	}
+	if len(tags) != 2 || tags[0].Name() != "comedy-classic" || tags[1].Name() != "time-travel" {
+		t.Fatalf("Expected both tags by name, got: %v", tags)
+	}
+
+	removed, err := repo.UntagMovie(ctx, testMovieID(1), comedy.ID())
+	if err != nil || !removed {
+		t.Fatalf("UntagMovie() = %v, %v; want true", removed, err)
+	}
+	removed, err = repo.UntagMovie(ctx, testMovieID(1), comedy.ID())
+	if err != nil || removed {
+		t.Errorf("UntagMovie() of a missing link = %v, %v; want false", removed, err)
+	}
+
+	err = repo.TagMovie(ctx, testMovieID(99), []shared.TagID{timeTravel.ID()})
+	if !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for a missing movie, got: %v", err)
+	}
+}
+
+func TestTagRepository_FindMovieIDs(t *testing.T) {
+	db := setupTagTestDB(t)
+	defer db.Close()
+
+	repo := NewTagRepository(db)
+	ctx := context.Background()
+
+	timeTravel := saveTestTag(t, repo, "time-travel")
+	dinosaurs := saveTestTag(t, repo, "dinosaurs")
+	_ = repo.TagMovie(ctx, testMovieID(1), []shared.TagID{timeTravel.ID()})
+	_ = repo.TagMovie(ctx, testMovieID(2), []shared.TagID{timeTravel.ID(), dinosaurs.ID()})
+	_ = repo.TagMovie(ctx, testMovieID(3), []shared.TagID{dinosaurs.ID()})
+
+	tests := []struct {
+		name     string
+		tagIDs   []shared.TagID
+		matchAll bool
+		want     []int
+	}{
+		{name: "any", tagIDs: []shared.TagID{timeTravel.ID(), dinosaurs.ID()}, want: []int{1, 2, 3}},
+		{name: "all", tagIDs: []shared.TagID{timeTravel.ID(), dinosaurs.ID()}, matchAll: true, want: []int{2}},
+		{name: "all with a repeated tag", tagIDs: []shared.TagID{dinosaurs.ID(), dinosaurs.ID()}, matchAll: true, want: []int{2, 3}},
+		{name: "no tags", want: []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			movieIDs, err := repo.FindMovieIDs(ctx, tt.tagIDs, tt.matchAll)
+			if err != nil {
+				t.Fatalf("FindMovieIDs() error = %v", err)
+			}
+			if len(movieIDs) != len(tt.want) {
+				t.Fatalf("Expected movies %v, got: %v", tt.want, movieIDs)
+			}
+			for i, id := range movieIDs {
+				if id.Value() != tt.want[i] {
+					t.Errorf("Expected movies %v, got: %v", tt.want, movieIDs)
+				}
+			}
+		})
+	}
+}
+
+func TestTagRepository_Counts(t *testing.T) {
+	db := setupTagTestDB(t)
+	defer db.Close()
+
+	repo := NewTagRepository(db)
+	ctx := context.Background()
+
+	timeTravel := saveTestTag(t, repo, "time-travel")
+	thriller := saveTestTag(t, repo, "tense-thriller")
+	saveTestTag(t, repo, "tearjerker")
+	_ = repo.TagMovie(ctx, testMovieID(1), []shared.TagID{timeTravel.ID()})
+	_ = repo.TagMovie(ctx, testMovieID(2), []shared.TagID{timeTravel.ID(), thriller.ID()})
+
+	counts, err := repo.Counts(ctx, "", 0)
+	if err != nil {
+		t.Fatalf("Counts() error = %v", err)
+	}
+	want := []tag.Count{{Name: "time-travel", Movies: 2}, {Name: "tense-thriller", Movies: 1}, {Name: "tearjerker", Movies: 0}}
+	if len(counts) != len(want) {
+		t.Fatalf("Expected %v, got: %v", want, counts)
+	}
+	for i := range want {
+		if counts[i] != want[i] {
+			t.Errorf("Expected %v, got: %v", want, counts)
+		}
+	}
+
+	prefixed, err := repo.Counts(ctx, "te", 1)
+	if err != nil {
+		t.Fatalf("Counts() with prefix error = %v", err)
+	}
+	if len(prefixed) != 1 || prefixed[0].Name != "tense-thriller" {
+		t.Errorf("Expected only tense-thriller, got: %v", prefixed)
+	}
+}
@@ -0,0 +1,153 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+// tenantIDPattern limits tenant identifiers to names that are safe as file
+// names, so a tenant can never address a file outside the tenant directory
+var tenantIDPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]{0,63}$`)
+
+// errTenantsClosed is returned for tenants first requested after Close
+var errTenantsClosed = errors.New("tenant databases are closed")
+
+// TenantRouter keeps one SQLite database file per tenant, <dir>/<tenant>.db,
+// opening and migrating each the first time the tenant is used. Requests
+// routed to a tenant read and write only its file; the repositories find the
+// file through the request context.
+type TenantRouter struct {
+	dir         string
+	migrationFS fs.FS
+	phase       database.MigrationPhase
+	open        func(path string) (*sql.DB, error)
+
+	mutex  sync.Mutex
+	dbs    map[string]*sql.DB
+	closed bool
+}
+
+// NewTenantRouter creates a router for the tenant databases in dir, which is
+// created on first use, applying the migrations in migrationFS to each
+// database; open connects to one file with the server's settings
+func NewTenantRouter(dir string, migrationFS fs.FS, open func(path string) (*sql.DB, error)) *TenantRouter {
+	return &TenantRouter{
+		dir:         dir,
+		migrationFS: migrationFS,
+		open:        open,
+		dbs:         make(map[string]*sql.DB),
+	}
+}
+
+// SetMigrationPhase limits the migrations applied to tenant databases to one
+// phase of an expand/contract rollout
+func (r *TenantRouter) SetMigrationPhase(phase database.MigrationPhase) {
+	r.phase = phase
+}
+
+// WithTenant returns ctx routed to the tenant's database
+func (r *TenantRouter) WithTenant(ctx context.Context, tenant string) (context.Context, error) {
+	db, err := r.DB(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	return database.WithTenant(ctx, tenant, db), nil
+}
+
+// DB returns the tenant's database, creating and migrating its file if it
+// is not open yet. Opening holds the router's lock, so concurrent first
+// requests for a tenant wait for one migration instead of racing.
+func (r *TenantRouter) DB(ctx context.Context, tenant string) (*sql.DB, error) {
+	if !tenantIDPattern.MatchString(tenant) {
+		return nil, shared.NewValidationError("invalid tenant %q (use up to 64 letters, digits, '-' or '_')", tenant)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if db, ok := r.dbs[tenant]; ok {
+		return db, nil
+	}
+	if r.closed {
+		return nil, shared.NewUnavailableError("%v", errTenantsClosed)
+	}
+
+	if err := os.MkdirAll(r.dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create tenant directory: %w", err)
+	}
+	db, err := r.open(filepath.Join(r.dir, tenant+".db"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database of tenant %s: %w", tenant, err)
+	}
+	if _, err := database.MigratePhase(ctx, db, r.migrationFS, r.phase); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to migrate database of tenant %s: %w", tenant, err)
+	}
+
+	r.dbs[tenant] = db
+	return db, nil
+}
+
+// MigrateExisting opens every tenant database already in the directory,
+// applying pending migrations, and returns the tenants in name order
+func (r *TenantRouter) MigrateExisting(ctx context.Context) ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(r.dir, "*.db"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tenant databases: %w", err)
+	}
+
+	tenants := []string{}
+	for _, path := range paths {
+		tenant := strings.TrimSuffix(filepath.Base(path), ".db")
+		if !tenantIDPattern.MatchString(tenant) {
+			continue
+		}
+		if _, err := r.DB(ctx, tenant); err != nil {
+			return tenants, err
+		}
+		tenants = append(tenants, tenant)
+	}
+	return tenants, nil
+}
+
+// Tenants returns the tenants whose databases are open, in name order
+func (r *TenantRouter) Tenants() []string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	tenants := make([]string, 0, len(r.dbs))
+	for tenant := range r.dbs {
+		tenants = append(tenants, tenant)
+	}
+	sort.Strings(tenants)
+	return tenants
+}
+
+// Close closes every tenant database; tenants requested afterwards are
+// reported unavailable
+func (r *TenantRouter) Close() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.closed = true
+	var errs []error
+	for tenant, db := range r.dbs {
+		if err := db.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("tenant %s: %w", tenant, err))
+		}
+		delete(r.dbs, tenant)
+	}
+	return errors.Join(errs...)
+}
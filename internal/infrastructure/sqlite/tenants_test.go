@@ -0,0 +1,116 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/migrations"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+func newTestTenantRouter(t *testing.T, dir string) *TenantRouter {
+	t.Helper()
+
+	router := NewTenantRouter(dir, migrations.FS, func(path string) (*sql.DB, error) {
+		return sql.Open("sqlite", path+"?_time_format=sqlite&_pragma=foreign_keys(1)")
+	})
+	t.Cleanup(func() { router.Close() })
+	return router
+}
+
+func TestTenantRouter_IsolatesLibraries(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	router := newTestTenantRouter(t, t.TempDir())
+	repo := NewMovieRepository(db)
+
+	acme, err := router.WithTenant(context.Background(), "acme")
+	if err != nil {
+		t.Fatalf("WithTenant() error = %v", err)
+	}
+	globex, err := router.WithTenant(context.Background(), "globex")
+	if err != nil {
+		t.Fatalf("WithTenant() error = %v", err)
+	}
+
+	for _, title := range []string{"Inception", "Memento"} {
+		domainMovie, _ := movie.NewMovie(title, "Christopher Nolan", 2010)
+		if err := repo.Save(acme, domainMovie); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+	domainMovie, _ := movie.NewMovie("Heat", "Michael Mann", 1995)
+	if err := repo.Save(globex, domainMovie); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	for ctx, want := range map[context.Context]int{acme: 2, globex: 1, context.Background(): 0} {
+		if count, err := repo.CountAll(ctx); err != nil || count != want {
+			t.Errorf("Expected %d movies for tenant %q, got: %d (%v)", want, database.TenantFromContext(ctx), count, err)
+		}
+	}
+
+	if tenants := router.Tenants(); !reflect.DeepEqual(tenants, []string{"acme", "globex"}) {
+		t.Errorf("Expected both tenants to be open, got: %v", tenants)
+	}
+}
+
+func TestTenantRouter_RejectsInvalidTenant(t *testing.T) {
+	dir := t.TempDir()
+	router := newTestTenantRouter(t, dir)
+
+	for _, tenant := range []string{"", "../movies", "a/b", "-leading", "has space"} {
+		if _, err := router.WithTenant(context.Background(), tenant); !errors.Is(err, shared.ErrValidation) {
+			t.Errorf("Expected a validation error for tenant %q, got: %v", tenant, err)
+		}
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("Expected no tenant files, got: %v", entries)
+	}
+}
+
+func TestTenantRouter_MigrateExisting(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"acme.db", "globex.db", "notes.txt"} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0o600); err != nil {
+			t.Fatalf("failed to create file: %v", err)
+		}
+	}
+	router := newTestTenantRouter(t, dir)
+
+	tenants, err := router.MigrateExisting(context.Background())
+	if err != nil {
+		t.Fatalf("MigrateExisting() error = %v", err)
+	}
+	if !reflect.DeepEqual(tenants, []string{"acme", "globex"}) {
+		t.Errorf("Expected acme and globex to be migrated, got: %v", tenants)
+	}
+
+	tenantDB, _ := router.DB(context.Background(), "globex")
+	status, err := database.NewMigrationChecker(tenantDB, migrations.FS).Status(context.Background())
+	if err != nil || !status.UpToDate {
+		t.Errorf("Expected the tenant schema to be up to date, got: %+v (%v)", status, err)
+	}
+}
+
+func TestTenantRouter_Close(t *testing.T) {
+	router := newTestTenantRouter(t, t.TempDir())
+
+	if _, err := router.DB(context.Background(), "acme"); err != nil {
+		t.Fatalf("DB() error = %v", err)
+	}
+	if err := router.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if _, err := router.DB(context.Background(), "acme"); !errors.Is(err, shared.ErrUnavailable) {
+		t.Errorf("Expected tenants to be unavailable after Close, got: %v", err)
+	}
+}
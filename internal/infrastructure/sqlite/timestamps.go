@@ -0,0 +1,56 @@
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// timestampLayouts are the text formats timestamps may be stored in: the
+// driver's sqlite format, time.Time.String() (the driver default), SQLite's
+// CURRENT_TIMESTAMP, and RFC 3339
+var timestampLayouts = []string{
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+	"2006-01-02 15:04:05",
+	time.RFC3339Nano,
+}
+
+// textTime scans a timestamp column into a sql.NullTime. The migrations
+// declare created_at/updated_at as TEXT, so the driver hands them back as
+// strings rather than time.Time values.
+type textTime sql.NullTime
+
+// Scan implements sql.Scanner
+func (t *textTime) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		*t = textTime{}
+		return nil
+	case time.Time:
+		*t = textTime{Time: v, Valid: true}
+		return nil
+	case []byte:
+		return t.parse(string(v))
+	case string:
+		return t.parse(v)
+	default:
+		return fmt.Errorf("unsupported timestamp type %T", value)
+	}
+}
+
+// parse decodes a text timestamp, dropping any monotonic clock suffix
+func (t *textTime) parse(value string) error {
+	if i := strings.Index(value, " m="); i >= 0 {
+		value = value[:i]
+	}
+
+	for _, layout := range timestampLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			*t = textTime{Time: parsed, Valid: true}
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid timestamp %q", value)
+}
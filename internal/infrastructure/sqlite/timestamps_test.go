@@ -0,0 +1,44 @@
+package sqlite
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTextTime_Scan(t *testing.T) {
+	expected := time.Date(2024, 3, 1, 12, 30, 45, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		value any
+	}{
+		{"native time", expected},
+		{"sqlite format", "2024-03-01 12:30:45+00:00"},
+		{"time.String format", "2024-03-01 12:30:45 +0000 UTC m=+0.722914718"},
+		{"current timestamp", "2024-03-01 12:30:45"},
+		{"rfc3339 bytes", []byte("2024-03-01T12:30:45Z")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var scanned textTime
+			if err := scanned.Scan(tt.value); err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if !scanned.Valid || !scanned.Time.Equal(expected) {
+				t.Errorf("Expected %v, got: %+v", expected, scanned)
+			}
+		})
+	}
+}
+
+func TestTextTime_ScanNullAndInvalid(t *testing.T) {
+	var scanned textTime
+	if err := scanned.Scan(nil); err != nil || scanned.Valid {
+		t.Errorf("Expected invalid time for NULL, got: %+v (err %v)", scanned, err)
+	}
+
+	if err := scanned.Scan("not a time"); err == nil {
+		t.Error("Expected error for malformed timestamp")
+	}
+}
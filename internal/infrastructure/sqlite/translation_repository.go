@@ -0,0 +1,149 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/domain/translation"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+// TranslationRepository implements the translation.Repository interface for SQLite
+type TranslationRepository struct {
+	*database.BaseRepository
+}
+
+// NewTranslationRepository creates a new SQLite translation repository
+func NewTranslationRepository(db *sql.DB) *TranslationRepository {
+	return &TranslationRepository{
+		BaseRepository: database.NewBaseRepository(db),
+	}
+}
+
+// dbTranslation represents the database model for movie translations
+type dbTranslation struct {
+	MovieID     int            `db:"movie_id"`
+	Language    string         `db:"language"`
+	Title       sql.NullString `db:"title"`
+	Description sql.NullString `db:"description"`
+
+	DescriptionSource string         `db:"description_source"`
+	DescriptionModel  sql.NullString `db:"description_model"`
+}
+
+// FindByMovieID retrieves all of a movie's translations, ordered by language
+func (r *TranslationRepository) FindByMovieID(ctx context.Context, movieID shared.MovieID) ([]*translation.Translation, error) {
+	query := `
+		SELECT movie_id, language, title, description, description_source, description_model
+		FROM movie_translations
+		WHERE movie_id = ?
+		ORDER BY language ASC`
+
+	return r.findTranslations(ctx, query, movieID.Value())
+}
+
+// FindByMovieIDs retrieves the translations of many movies in any of the given languages
+func (r *TranslationRepository) FindByMovieIDs(
+	ctx context.Context,
+	movieIDs []shared.MovieID,
+	languages []string,
+) ([]*translation.Translation, error) {
+	if len(movieIDs) == 0 || len(languages) == 0 {
+		return []*translation.Translation{}, nil
+	}
+
+	query := `
+		SELECT movie_id, language, title, description, description_source, description_model
+		FROM movie_translations
+		WHERE movie_id IN (` + placeholders(len(movieIDs)) + `)
+		AND language IN (` + placeholders(len(languages)) + `)
+		ORDER BY movie_id ASC, language ASC`
+
+	args := make([]interface{}, 0, len(movieIDs)+len(languages))
+	for _, movieID := range movieIDs {
+		args = append(args, movieID.Value())
+	}
+	for _, language := range languages {
+		args = append(args, language)
+	}
+
+	return r.findTranslations(ctx, query, args...)
+}
+
+// Save inserts a translation or replaces the movie's existing one in the same language
+func (r *TranslationRepository) Save(ctx context.Context, domainTranslation *translation.Translation) error {
+	query := `
+		INSERT INTO movie_translations (movie_id, language, title, description, description_source, description_model, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		ON CONFLICT (movie_id, language) DO UPDATE
+		SET title = excluded.title, description = excluded.description,
+			description_source = excluded.description_source, description_model = excluded.description_model,
+			updated_at = excluded.updated_at`
+
+	title := sql.NullString{String: domainTranslation.Title(), Valid: domainTranslation.Title() != ""}
+	description := sql.NullString{String: domainTranslation.Description(), Valid: domainTranslation.Description() != ""}
+	model := sql.NullString{String: domainTranslation.DescriptionModel(), Valid: domainTranslation.DescriptionModel() != ""}
+
+	return retryOnBusy(ctx, func() error {
+		if _, err := r.ExecContext(ctx, query,
+			domainTranslation.MovieID().Value(),
+			domainTranslation.Language(),
+			title,
+			description,
+			string(domainTranslation.DescriptionSource()),
+			model,
+		); err != nil {
+			return missingReference(fmt.Errorf("failed to save translation: %w", err), "movie", domainTranslation.MovieID().Value())
+		}
+		return nil
+	})
+}
+
+// findTranslations runs a translation query and converts every row
+func (r *TranslationRepository) findTranslations(ctx context.Context, query string, args ...interface{}) ([]*translation.Translation, error) {
+	rows, err := r.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query translations: %w", err)
+	}
+	defer rows.Close()
+
+	translations := []*translation.Translation{}
+	for rows.Next() {
+		var row dbTranslation
+		if err := rows.Scan(&row.MovieID, &row.Language, &row.Title, &row.Description, &row.DescriptionSource, &row.DescriptionModel); err != nil {
+			return nil, fmt.Errorf("failed to scan translation: %w", err)
+		}
+
+		domainTranslation, err := r.toDomainModel(&row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert to domain model: %w", err)
+		}
+		translations = append(translations, domainTranslation)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query translations: %w", err)
+	}
+
+	return translations, nil
+}
+
+// toDomainModel converts a database row to a domain translation
+func (r *TranslationRepository) toDomainModel(row *dbTranslation) (*translation.Translation, error) {
+	movieID, err := shared.NewMovieID(row.MovieID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create movie ID: %w", err)
+	}
+
+	domainTranslation, err := translation.NewTranslation(movieID, row.Language, row.Title.String, row.Description.String)
+	if err != nil {
+		return nil, err
+	}
+	if translation.DescriptionSource(row.DescriptionSource) == translation.DescriptionGenerated {
+		if err := domainTranslation.MarkDescriptionGenerated(row.DescriptionModel.String); err != nil {
+			return nil, err
+		}
+	}
+	return domainTranslation, nil
+}
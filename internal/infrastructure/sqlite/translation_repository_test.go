@@ -0,0 +1,177 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/domain/translation"
+	_ "modernc.org/sqlite"
+)
+
+// setupTranslationTestDB creates an in-memory SQLite database for translation testing
+func setupTranslationTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", ":memory:?_time_format=sqlite")
+	if err != nil {
+		t.Fatalf("failed to open test database: %v", err)
+	}
+
+	schema := `
+	CREATE TABLE movie_translations (
+		movie_id INTEGER NOT NULL,
+		language TEXT NOT NULL,
+		title TEXT,
+		description TEXT,
+		description_source TEXT NOT NULL DEFAULT 'imported',
+		description_model TEXT,
+		created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+		updated_at TEXT DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (movie_id, language)
+	);`
+
+	if _, err := db.Exec(schema); err != nil {
+		t.Fatalf("failed to create test schema: %v", err)
+	}
+
+	return db
+}
+
+func newTestTranslation(t *testing.T, movieID int, language, title, description string) *translation.Translation {
+	t.Helper()
+
+	id, _ := shared.NewMovieID(movieID)
+	entry, err := translation.NewTranslation(id, language, title, description)
+	if err != nil {
+		t.Fatalf("failed to create translation: %v", err)
+	}
+	return entry
+}
+
+func TestTranslationRepository_SaveAndFindByMovieID(t *testing.T) {
+	db := setupTranslationTestDB(t)
+	defer db.Close()
+
+	repo := NewTranslationRepository(db)
+	ctx := context.Background()
+
+	for _, entry := range []*translation.Translation{
+		newTestTranslation(t, 1, "fr", "Le Parrain", ""),
+		newTestTranslation(t, 1, "de", "Der Pate", "Die Geschichte einer Mafiafamilie"),
+		newTestTranslation(t, 2, "fr", "Les Affranchis", ""),
+	} {
+		if err := repo.Save(ctx, entry); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+
+	movieID, _ := shared.NewMovieID(1)
+	results, err := repo.FindByMovieID(ctx, movieID)
+	if err != nil {
+		t.Fatalf("FindByMovieID() error = %v", err)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 translations, got: %d", len(results))
+	}
+	if results[0].Language() != "de" || results[1].Language() != "fr" {
+		t.Errorf("Expected translations ordered by language, got: %s, %s", results[0].Language(), results[1].Language())
+	}
+	if results[0].Description() != "Die Geschichte einer Mafiafamilie" {
+		t.Errorf("Expected German description, got: %s", results[0].Description())
+	}
+}
+
+func TestTranslationRepository_SaveReplacesLanguage(t *testing.T) {
+	db := setupTranslationTestDB(t)
+	defer db.Close()
+
+	repo := NewTranslationRepository(db)
+	ctx := context.Background()
+
+	_ = repo.Save(ctx, newTestTranslation(t, 1, "fr", "Le Parrain", "Ancienne description"))
+	if err := repo.Save(ctx, newTestTranslation(t, 1, "fr", "Le Parrain", "")); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	movieID, _ := shared.NewMovieID(1)
+	results, _ := repo.FindByMovieID(ctx, movieID)
+
+	if len(results) != 1 {
+		t.Fatalf("Expected 1 translation, got: %d", len(results))
+	}
+	if results[0].Description() != "" {
+		t.Errorf("Expected description to be replaced, got: %s", results[0].Description())
+	}
+}
+
+func TestTranslationRepository_FindByMovieIDs(t *testing.T) {
+	db := setupTranslationTestDB(t)
+	defer db.Close()
+
+	repo := NewTranslationRepository(db)
+	ctx := context.Background()
+
+	_ = repo.Save(ctx, newTestTranslation(t, 1, "pt", "O Poderoso Chefão", ""))
+	_ = repo.Save(ctx, newTestTranslation(t, 1, "pt-BR", "O Poderoso Chefão", "Uma família mafiosa"))
+	_ = repo.Save(ctx, newTestTranslation(t, 2, "pt", "Os Bons Companheiros", ""))
+	_ = repo.Save(ctx, newTestTranslation(t, 2, "fr", "Les Affranchis", ""))
+	_ = repo.Save(ctx, newTestTranslation(t, 3, "pt", "Cidade de Deus", ""))
+
+	first, _ := shared.NewMovieID(1)
+	second, _ := shared.NewMovieID(2)
+	results, err := repo.FindByMovieIDs(ctx, []shared.MovieID{first, second}, []string{"pt-BR", "pt"})
+	if err != nil {
+		t.Fatalf("FindByMovieIDs() error = %v", err)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 translations, got: %d", len(results))
+	}
+	for _, result := range results {
+		if result.Language() == "fr" || result.MovieID().Value() == 3 {
+			t.Errorf("Unexpected translation for movie %d in %s", result.MovieID().Value(), result.Language())
+		}
+	}
+
+	empty, err := repo.FindByMovieIDs(ctx, nil, []string{"pt"})
+	if err != nil || len(empty) != 0 {
+		t.Errorf("Expected no translations for no movies, got: %d (%v)", len(empty), err)
+	}
+}
+
+func TestTranslationRepository_DescriptionProvenance(t *testing.T) {
+	db := setupTranslationTestDB(t)
+	defer db.Close()
+
+	repo := NewTranslationRepository(db)
+	ctx := context.Background()
+
+	generated := newTestTranslation(t, 1, "en", "", "A mafia family's patriarch hands over his empire")
+	if err := generated.MarkDescriptionGenerated("test-model"); err != nil {
+		t.Fatalf("MarkDescriptionGenerated() error = %v", err)
+	}
+	_ = repo.Save(ctx, generated)
+	_ = repo.Save(ctx, newTestTranslation(t, 1, "fr", "Le Parrain", "Une famille mafieuse"))
+
+	movieID, _ := shared.NewMovieID(1)
+	results, err := repo.FindByMovieID(ctx, movieID)
+	if err != nil || len(results) != 2 {
+		t.Fatalf("Expected 2 translations, got: %d (%v)", len(results), err)
+	}
+	if results[0].DescriptionSource() != translation.DescriptionGenerated || results[0].DescriptionModel() != "test-model" {
+		t.Errorf("Expected the English description to be generated by test-model, got: %s (%q)", results[0].DescriptionSource(), results[0].DescriptionModel())
+	}
+	if results[1].DescriptionSource() != translation.DescriptionImported || results[1].DescriptionModel() != "" {
+		t.Errorf("Expected the French description to be imported, got: %s (%q)", results[1].DescriptionSource(), results[1].DescriptionModel())
+	}
+
+	// Replacing a generated description with an imported one clears its model
+	_ = repo.Save(ctx, newTestTranslation(t, 1, "en", "", "The Corleone family saga"))
+	results, _ = repo.FindByMovieID(ctx, movieID)
+	if results[0].DescriptionSource() != translation.DescriptionImported || results[0].DescriptionModel() != "" {
+		t.Errorf("Expected the replaced description to be imported, got: %s (%q)", results[0].DescriptionSource(), results[0].DescriptionModel())
+	}
+}
@@ -0,0 +1,142 @@
+// Package tmdb looks up streaming availability from The Movie Database (TMDB) API.
+package tmdb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/availability"
+	"github.com/francknouama/movies-mcp-server/pkg/httpclient"
+)
+
+// DefaultBaseURL is the TMDB v3 API endpoint
+const DefaultBaseURL = "https://api.themoviedb.org/3"
+
+// ErrMovieNotFound is returned when TMDB has no movie matching the title and year
+var ErrMovieNotFound = errors.New("movie not found on TMDB")
+
+// Client implements availability.Source using the TMDB search and
+// watch-providers endpoints (provider data courtesy of JustWatch)
+type Client struct {
+	BaseURL    string
+	apiKey     string
+	httpClient *httpclient.Client
+}
+
+// NewClient creates a TMDB client. A nil httpClient uses one with a 10
+// second timeout and the default retries and circuit breaking.
+func NewClient(apiKey string, httpClient *httpclient.Client) *Client {
+	if httpClient == nil {
+		httpClient = httpclient.New(&http.Client{Timeout: 10 * time.Second}, httpclient.Config{})
+	}
+	return &Client{
+		BaseURL:    DefaultBaseURL,
+		apiKey:     apiKey,
+		httpClient: httpClient,
+	}
+}
+
+// searchResponse is the subset of /search/movie the client reads
+type searchResponse struct {
+	Results []struct {
+		ID int `json:"id"`
+	} `json:"results"`
+}
+
+// provider is one entry in a watch-providers offer list
+type provider struct {
+	ProviderName string `json:"provider_name"`
+}
+
+// regionProviders lists providers in one region by offer type
+type regionProviders struct {
+	Link     string     `json:"link"`
+	Flatrate []provider `json:"flatrate"`
+	Rent     []provider `json:"rent"`
+	Buy      []provider `json:"buy"`
+	Free     []provider `json:"free"`
+	Ads      []provider `json:"ads"`
+}
+
+// watchProvidersResponse is the /movie/{id}/watch/providers payload
+type watchProvidersResponse struct {
+	Results map[string]regionProviders `json:"results"`
+}
+
+// Lookup finds the movie on TMDB by title and year and returns its offers in region
+func (c *Client) Lookup(ctx context.Context, title string, year int, region string) ([]availability.Offer, error) {
+	query := url.Values{"query": {title}}
+	if year > 0 {
+		query.Set("year", strconv.Itoa(year))
+	}
+
+	var search searchResponse
+	if err := c.get(ctx, "/search/movie", query, &search); err != nil {
+		return nil, fmt.Errorf("failed to search TMDB: %w", err)
+	}
+	if len(search.Results) == 0 {
+		return nil, ErrMovieNotFound
+	}
+
+	var providers watchProvidersResponse
+	path := fmt.Sprintf("/movie/%d/watch/providers", search.Results[0].ID)
+	if err := c.get(ctx, path, url.Values{}, &providers); err != nil {
+		return nil, fmt.Errorf("failed to get TMDB watch providers: %w", err)
+	}
+
+	regional, ok := providers.Results[region]
+	if !ok {
+		return []availability.Offer{}, nil
+	}
+
+	offers := []availability.Offer{}
+	for _, group := range []struct {
+		offerType availability.OfferType
+		providers []provider
+	}{
+		{availability.OfferFlatrate, regional.Flatrate},
+		{availability.OfferFree, regional.Free},
+		{availability.OfferAds, regional.Ads},
+		{availability.OfferRent, regional.Rent},
+		{availability.OfferBuy, regional.Buy},
+	} {
+		for _, p := range group.providers {
+			offers = append(offers, availability.Offer{
+				Provider:  p.ProviderName,
+				OfferType: group.offerType,
+				URL:       regional.Link,
+			})
+		}
+	}
+
+	return offers, nil
+}
+
+// get issues an authenticated GET request and decodes the JSON response into out
+func (c *Client) get(ctx context.Context, path string, query url.Values, out any) error {
+	query.Set("api_key", c.apiKey)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.BaseURL+path+"?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,127 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/availability"
+	"github.com/francknouama/movies-mcp-server/pkg/httpclient"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/search/movie", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("api_key") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Query().Get("query") != "The Matrix" || r.URL.Query().Get("year") != "1999" {
+			_, _ = w.Write([]byte(`{"results": []}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"results": [{"id": 603}, {"id": 604}]}`))
+	})
+	mux.HandleFunc("/movie/603/watch/providers", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id": 603, "results": {
+			"US": {
+				"link": "https://www.themoviedb.org/movie/603/watch?locale=US",
+				"flatrate": [{"provider_name": "Max"}],
+				"rent": [{"provider_name": "Apple TV"}, {"provider_name": "Google Play Movies"}]
+			}
+		}}`))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestClient_Lookup(t *testing.T) {
+	server := newTestServer(t)
+	client := NewClient("secret", httpclient.New(server.Client(), httpclient.Config{}))
+	client.BaseURL = server.URL
+
+	offers, err := client.Lookup(context.Background(), "The Matrix", 1999, "US")
+	if err != nil {
+		t.Fatalf("Lookup() error = %v", err)
+	}
+
+	if len(offers) != 3 {
+		t.Fatalf("Expected 3 offers, got: %d", len(offers))
+	}
+	if offers[0].Provider != "Max" || offers[0].OfferType != availability.OfferFlatrate {
+		t.Errorf("Expected Max flatrate first, got: %+v", offers[0])
+	}
+	if offers[1].OfferType != availability.OfferRent {
+		t.Errorf("Expected rent offer, got: %s", offers[1].OfferType)
+	}
+	if offers[0].URL != "https://www.themoviedb.org/movie/603/watch?locale=US" {
+		t.Errorf("Expected region link, got: %s", offers[0].URL)
+	}
+}
+
+func TestClient_Lookup_UnknownRegion(t *testing.T) {
+	server := newTestServer(t)
+	client := NewClient("secret", httpclient.New(server.Client(), httpclient.Config{}))
+	client.BaseURL = server.URL
+
+	offers, err := client.Lookup(context.Background(), "The Matrix", 1999, "FR")
+	if err != nil {
+		t.Fatalf("Lookup() error = %v", err)
+	}
+	if len(offers) != 0 {
+		t.Errorf("Expected no offers, got: %d", len(offers))
+	}
+}
+
+func TestClient_Lookup_NotFound(t *testing.T) {
+	server := newTestServer(t)
+	client := NewClient("secret", httpclient.New(server.Client(), httpclient.Config{}))
+	client.BaseURL = server.URL
+
+	_, err := client.Lookup(context.Background(), "Unknown Film", 2001, "US")
+	if !errors.Is(err, ErrMovieNotFound) {
+		t.Errorf("Expected ErrMovieNotFound, got: %v", err)
+	}
+}
+
+func TestClient_Lookup_HTTPError(t *testing.T) {
+	server := newTestServer(t)
+	client := NewClient("wrong", httpclient.New(server.Client(), httpclient.Config{}))
+	client.BaseURL = server.URL
+
+	if _, err := client.Lookup(context.Background(), "The Matrix", 1999, "US"); err == nil {
+		t.Error("Expected error for unauthorized request")
+	}
+}
+
+func TestClient_Lookup_RetriesTransientErrors(t *testing.T) {
+	server := newTestServer(t)
+	failures := 1
+	flaky := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		server.Config.Handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(flaky.Close)
+
+	client := NewClient("secret", httpclient.New(flaky.Client(), httpclient.Config{InitialBackoff: time.Millisecond}))
+	client.BaseURL = flaky.URL
+
+	offers, err := client.Lookup(context.Background(), "The Matrix", 1999, "US")
+	if err != nil {
+		t.Fatalf("Lookup() error = %v", err)
+	}
+	if len(offers) != 3 {
+		t.Errorf("Expected 3 offers after the retry, got: %d", len(offers))
+	}
+}
@@ -0,0 +1,263 @@
+// Package i18n translates the messages the server writes itself, such as
+// tool summaries and errors, into the language a session negotiates.
+//
+// Messages are written in English and looked up by their English format
+// string, so untranslated messages stay readable. Each other language is a
+// bundle, locales/<code>.json, mapping format strings to translations; adding
+// a language is adding a bundle.
+package i18n
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultLocale is the language messages are written in
+const DefaultLocale = "en"
+
+//go:embed locales/*.json
+var localeFiles embed.FS
+
+// Bundle is a language's translations, as stored in locales/<code>.json
+type Bundle struct {
+	Language string `json:"language"` // Name of the language in itself
+	// ZeroIsSingular counts zero with the singular noun, as French does
+	ZeroIsSingular bool              `json:"zero_is_singular,omitempty"`
+	Messages       map[string]string `json:"messages"`
+}
+
+// Catalog holds a bundle per supported language
+type Catalog struct {
+	bundles map[string]*Bundle
+}
+
+// NewCatalog loads the bundles built into the server. It fails if a bundle
+// is malformed or a translation's verbs differ from its message's.
+func NewCatalog() (*Catalog, error) {
+	return LoadCatalog(localeFiles, "locales")
+}
+
+// LoadCatalog loads every <code>.json bundle in dir of fsys
+func LoadCatalog(fsys fs.FS, dir string) (*Catalog, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read locales: %w", err)
+	}
+
+	catalog := &Catalog{bundles: map[string]*Bundle{DefaultLocale: {Language: "English"}}}
+	for _, entry := range entries {
+		code, ok := strings.CutSuffix(entry.Name(), ".json")
+		if !ok || entry.IsDir() {
+			continue
+		}
+		data, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read locale %s: %w", code, err)
+		}
+		var bundle Bundle
+		if err := json.Unmarshal(data, &bundle); err != nil {
+			return nil, fmt.Errorf("failed to parse locale %s: %w", code, err)
+		}
+		for message, translation := range bundle.Messages {
+			if err := checkVerbs(message, translation); err != nil {
+				return nil, fmt.Errorf("locale %s: %w", code, err)
+			}
+		}
+		catalog.bundles[strings.ToLower(code)] = &bundle
+	}
+	return catalog, nil
+}
+
+// Locales returns the supported language codes in order
+func (c *Catalog) Locales() []string {
+	codes := make([]string, 0, len(c.bundles))
+	for code := range c.bundles {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// Supports reports whether locale, or the language it is a region of, has
+// a bundle
+func (c *Catalog) Supports(locale string) bool {
+	_, ok := c.match(locale)
+	return ok
+}
+
+// Negotiate returns the supported language a session gets, from its
+// preferences in order. Each preference is a language code such as fr or
+// pt-BR, or an Accept-Language list of them such as "fr-CA, es;q=0.8";
+// unsupported and empty preferences are skipped. With no match it is
+// DefaultLocale.
+func (c *Catalog) Negotiate(preferences ...string) string {
+	for _, preference := range preferences {
+		for _, locale := range parseAcceptLanguage(preference) {
+			if code, ok := c.match(locale); ok {
+				return code
+			}
+		}
+	}
+	return DefaultLocale
+}
+
+// match returns the bundle code for locale: its own, or its language's
+func (c *Catalog) match(locale string) (string, bool) {
+	locale = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+	if _, ok := c.bundles[locale]; ok {
+		return locale, true
+	}
+	language, _, _ := strings.Cut(locale, "-")
+	if _, ok := c.bundles[language]; ok {
+		return language, true
+	}
+	return "", false
+}
+
+// Printer returns the printer of a supported locale, or of DefaultLocale
+func (c *Catalog) Printer(locale string) *Printer {
+	code, ok := c.match(locale)
+	if !ok {
+		code = DefaultLocale
+	}
+	return &Printer{locale: code, bundle: c.bundles[code]}
+}
+
+// parseAcceptLanguage returns the locales of an Accept-Language list, most
+// preferred first; q=0 entries are left out
+func parseAcceptLanguage(list string) []string {
+	type weighted struct {
+		locale string
+		q      float64
+	}
+	var entries []weighted
+	for _, part := range strings.Split(list, ",") {
+		locale, params, _ := strings.Cut(part, ";")
+		locale = strings.TrimSpace(locale)
+		if locale == "" || locale == "*" {
+			continue
+		}
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q > 0 {
+			entries = append(entries, weighted{locale, q})
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].q > entries[j].q })
+
+	locales := make([]string, len(entries))
+	for i, entry := range entries {
+		locales[i] = entry.locale
+	}
+	return locales
+}
+
+// verbPattern matches the fmt verbs of a format string, with any explicit
+// argument index, flags, width and precision
+var verbPattern = regexp.MustCompile(`%(?:\[(\d+)\])?[-+# 0]*\d*(?:\.\d+)?([a-zA-Z%])`)
+
+// checkVerbs fails when a translation does not format the same arguments
+// with the same verbs as its message. Translations may reorder arguments
+// with explicit indexes such as %[2]s.
+func checkVerbs(message, translation string) error {
+	want, got := formatVerbs(message), formatVerbs(translation)
+	if !slices.Equal(want, got) {
+		return fmt.Errorf("translation %q of %q formats %v, want %v", translation, message, got, want)
+	}
+	return nil
+}
+
+// formatVerbs returns the verb formatting each argument of format, in the
+// order of the arguments
+func formatVerbs(format string) []string {
+	byArg := make(map[int]string)
+	next := 1
+	for _, match := range verbPattern.FindAllStringSubmatch(format, -1) {
+		if match[2] == "%" {
+			continue
+		}
+		if match[1] != "" {
+			next, _ = strconv.Atoi(match[1])
+		}
+		byArg[next] = match[2]
+		next++
+	}
+
+	verbs := make([]string, len(byArg))
+	for arg, verb := range byArg {
+		if arg < 1 || arg > len(verbs) {
+			return append(verbs, "!"+strconv.Itoa(arg))
+		}
+		verbs[arg-1] = verb
+	}
+	return verbs
+}
+
+// Printer formats messages in one language. A nil Printer writes English.
+type Printer struct {
+	locale string
+	bundle *Bundle
+}
+
+// Locale returns the printer's language code
+func (p *Printer) Locale() string {
+	if p == nil {
+		return DefaultLocale
+	}
+	return p.locale
+}
+
+// Translate returns the translation of a message's format string, or the
+// format string itself when it has none
+func (p *Printer) Translate(format string) string {
+	if p == nil || p.bundle == nil {
+		return format
+	}
+	if translation, ok := p.bundle.Messages[format]; ok {
+		return translation
+	}
+	return format
+}
+
+// Sprintf formats a message in the printer's language
+func (p *Printer) Sprintf(format string, args ...any) string {
+	return fmt.Sprintf(p.Translate(format), args...)
+}
+
+// Count formats a count with its noun in the printer's language, choosing
+// between the English singular and plural ("1 movie", "3 movies")
+func (p *Printer) Count(count int, singular, plural string) string {
+	noun := plural
+	if count == 1 || (count == 0 && p != nil && p.bundle != nil && p.bundle.ZeroIsSingular) {
+		noun = singular
+	}
+	return fmt.Sprintf("%d %s", count, p.Translate(noun))
+}
+
+type printerKey struct{}
+
+// WithPrinter returns a context whose messages are written by p
+func WithPrinter(ctx context.Context, p *Printer) context.Context {
+	return context.WithValue(ctx, printerKey{}, p)
+}
+
+// FromContext returns the printer of ctx, or nil for English
+func FromContext(ctx context.Context) *Printer {
+	p, _ := ctx.Value(printerKey{}).(*Printer)
+	return p
+}
@@ -0,0 +1,120 @@
+package i18n
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"testing/fstest"
+)
+
+func TestNewCatalog_LoadsBuiltInBundles(t *testing.T) {
+	catalog, err := NewCatalog()
+	if err != nil {
+		t.Fatalf("Expected the built-in bundles to load, got: %v", err)
+	}
+
+	if got := catalog.Locales(); !slices.Equal(got, []string{"en", "es", "fr"}) {
+		t.Errorf("Expected locales [en es fr], got: %v", got)
+	}
+	if got := catalog.Printer("fr").Sprintf("Deleted movie %d", 7); got != "Film 7 supprimé" {
+		t.Errorf("Expected a French message, got: %q", got)
+	}
+}
+
+func TestCatalog_Negotiate(t *testing.T) {
+	catalog, err := NewCatalog()
+	if err != nil {
+		t.Fatalf("Failed to load catalog: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		preferences []string
+		want        string
+	}{
+		{"no preferences", nil, "en"},
+		{"exact language", []string{"es"}, "es"},
+		{"region of a language", []string{"fr-CA"}, "fr"},
+		{"underscore and case", []string{"ES_mx"}, "es"},
+		{"unsupported then supported", []string{"de", "fr"}, "fr"},
+		{"empty preference skipped", []string{"", "es"}, "es"},
+		{"accept-language weights", []string{"de, es;q=0.5, fr;q=0.8"}, "fr"},
+		{"zero weight excluded", []string{"fr;q=0, es;q=0.1"}, "es"},
+		{"nothing supported", []string{"de, ja"}, "en"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := catalog.Negotiate(tt.preferences...); got != tt.want {
+				t.Errorf("Expected %s, got: %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLoadCatalog_RejectsMismatchedVerbs(t *testing.T) {
+	tests := []struct {
+		name        string
+		translation string
+		wantErr     bool
+	}{
+		{"same verbs", "%s a %d films", false},
+		{"reordered with indexes", "%[2]d films pour %[1]s", false},
+		{"different verb", "%s a %s films", true},
+		{"missing argument", "%s a des films", true},
+		{"extra argument", "%s a %d films %s", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsys := fstest.MapFS{
+				"locales/fr.json": {Data: []byte(`{"language": "Français", "messages": {"%s has %d movies": "` + tt.translation + `"}}`)},
+			}
+			_, err := LoadCatalog(fsys, "locales")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Expected error %v, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestPrinter_Count(t *testing.T) {
+	catalog, err := NewCatalog()
+	if err != nil {
+		t.Fatalf("Failed to load catalog: %v", err)
+	}
+
+	tests := []struct {
+		printer *Printer
+		count   int
+		want    string
+	}{
+		{nil, 0, "0 movies"},
+		{nil, 1, "1 movie"},
+		{catalog.Printer("en"), 2, "2 movies"},
+		{catalog.Printer("fr"), 0, "0 film"},
+		{catalog.Printer("fr"), 2, "2 films"},
+		{catalog.Printer("es"), 0, "0 películas"},
+		{catalog.Printer("es"), 1, "1 película"},
+	}
+	for _, tt := range tests {
+		if got := tt.printer.Count(tt.count, "movie", "movies"); got != tt.want {
+			t.Errorf("Expected %q in %s, got: %q", tt.want, tt.printer.Locale(), got)
+		}
+	}
+}
+
+func TestPrinter_FallsBackToEnglish(t *testing.T) {
+	catalog, err := NewCatalog()
+	if err != nil {
+		t.Fatalf("Failed to load catalog: %v", err)
+	}
+
+	if got := catalog.Printer("de").Locale(); got != DefaultLocale {
+		t.Errorf("Expected an unsupported locale to print %s, got: %s", DefaultLocale, got)
+	}
+	if got := catalog.Printer("fr").Sprintf("No translation for %d", 3); got != "No translation for 3" {
+		t.Errorf("Expected an untranslated message in English, got: %q", got)
+	}
+	if got := FromContext(context.Background()).Sprintf("Deleted movie %d", 7); got != "Deleted movie 7" {
+		t.Errorf("Expected a context without a printer to print English, got: %q", got)
+	}
+}
@@ -0,0 +1,35 @@
+// Package middleware provides MCP request middleware for the SDK server.
+package middleware
+
+import (
+	"context"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// HealthChecker reports and refreshes database availability
+type HealthChecker interface {
+	Healthy() bool
+	Check(ctx context.Context) error
+}
+
+// RequireDatabase short-circuits tool calls while the database is degraded.
+// Callers get a retryable unavailable error instead of a failure deep inside a handler;
+// each rejected call also re-checks the database so recovery is picked up promptly.
+func RequireDatabase(checker HealthChecker) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			if method != "tools/call" || checker.Healthy() {
+				return next(ctx, method, req)
+			}
+
+			if err := checker.Check(ctx); err != nil {
+				return nil, mapError("", shared.NewUnavailableError("%v; the request can be retried once the database recovers", err))
+			}
+
+			return next(ctx, method, req)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// MockHealthChecker is a mock implementation of HealthChecker
+type MockHealthChecker struct {
+	healthy    bool
+	checkErr   error
+	checkCalls int
+}
+
+func (m *MockHealthChecker) Healthy() bool {
+	return m.healthy
+}
+
+func (m *MockHealthChecker) Check(ctx context.Context) error {
+	m.checkCalls++
+	return m.checkErr
+}
+
+func newRecordingHandler(called *bool) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		*called = true
+		return &mcp.CallToolResult{}, nil
+	}
+}
+
+func TestRequireDatabase_HealthyPassesThrough(t *testing.T) {
+	checker := &MockHealthChecker{healthy: true}
+	called := false
+
+	handler := RequireDatabase(checker)(newRecordingHandler(&called))
+	_, err := handler(context.Background(), "tools/call", nil)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !called {
+		t.Error("Expected next handler to be called")
+	}
+	if checker.checkCalls != 0 {
+		t.Errorf("Expected no re-check while healthy, got %d", checker.checkCalls)
+	}
+}
+
+func TestRequireDatabase_DegradedReturnsRetryableError(t *testing.T) {
+	checker := &MockHealthChecker{healthy: false, checkErr: errors.New("database unavailable: database is closed")}
+	called := false
+
+	handler := RequireDatabase(checker)(newRecordingHandler(&called))
+	result, err := handler(context.Background(), "tools/call", nil)
+
+	if result != nil {
+		t.Errorf("Expected no result, got: %+v", result)
+	}
+	if called {
+		t.Error("Expected next handler not to be called while degraded")
+	}
+
+	code, data := wireErrorOf(t, err)
+	if code != codeUnavailable || data["kind"] != "unavailable" || data["retryable"] != true {
+		t.Errorf("Expected a retryable unavailable error, got: code %d, data %v", code, data)
+	}
+	if err == nil || !strings.Contains(err.Error(), "retried") {
+		t.Errorf("Expected retry hint in message, got: %v", err)
+	}
+}
+
+func TestRequireDatabase_RecoveredPassesThrough(t *testing.T) {
+	checker := &MockHealthChecker{healthy: false}
+	called := false
+
+	handler := RequireDatabase(checker)(newRecordingHandler(&called))
+	_, err := handler(context.Background(), "tools/call", nil)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !called {
+		t.Error("Expected next handler to be called after successful re-check")
+	}
+	if checker.checkCalls != 1 {
+		t.Errorf("Expected 1 re-check, got %d", checker.checkCalls)
+	}
+}
+
+func TestRequireDatabase_IgnoresOtherMethods(t *testing.T) {
+	checker := &MockHealthChecker{healthy: false, checkErr: errors.New("down")}
+	called := false
+
+	handler := RequireDatabase(checker)(newRecordingHandler(&called))
+	_, _ = handler(context.Background(), "resources/read", nil)
+
+	if !called {
+		t.Error("Expected non-tool methods to pass through so health stays readable")
+	}
+}
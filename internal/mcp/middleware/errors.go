@@ -0,0 +1,112 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+
+	"github.com/modelcontextprotocol/go-sdk/jsonrpc"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// JSON-RPC codes for domain errors. Invalid input uses the standard invalid
+// params code; the others come from the range reserved for servers.
+const (
+	codeInvalidParams = -32602
+	codeUnavailable   = -32003
+	codeNotFound      = -32004
+	codeConflict      = -32009
+	codeInternalError = -32603
+)
+
+// errorKind describes how one domain error kind is reported to clients
+type errorKind struct {
+	err       error
+	code      int
+	name      string
+	retryable bool
+}
+
+// errorKinds lists the domain error kinds in the order they are checked
+var errorKinds = []errorKind{
+	{err: shared.ErrValidation, code: codeInvalidParams, name: "validation"},
+	{err: shared.ErrNotFound, code: codeNotFound, name: "not_found"},
+	{err: shared.ErrConflict, code: codeConflict, name: "conflict"},
+	{err: shared.ErrUnavailable, code: codeUnavailable, name: "unavailable", retryable: true},
+}
+
+// ErrorCodes returns every JSON-RPC code a tool call can fail with, in
+// ascending order
+func ErrorCodes() []int {
+	codes := []int{codeInternalError}
+	for _, kind := range errorKinds {
+		codes = append(codes, kind.code)
+	}
+	sort.Ints(codes)
+	return codes
+}
+
+// MapErrors reports tool errors of a domain kind as JSON-RPC errors, so every
+// tool uses the same code for the same failure. The data payload names the
+// kind, the tool and whether a retry may succeed:
+//
+//	{"kind": "not_found", "tool": "get_movie", "retryable": false}
+//
+// Errors of no kind stay tool errors.
+func MapErrors() ToolMiddleware {
+	return func(next ToolHandler) ToolHandler {
+		return func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error) {
+			result, err := next(ctx, call)
+			if err != nil {
+				return nil, mapError(call.Name(), err)
+			}
+			return result, nil
+		}
+	}
+}
+
+// mapError converts an error of a domain kind into its JSON-RPC error,
+// returning other errors unchanged
+func mapError(tool string, err error) error {
+	for _, kind := range errorKinds {
+		if errors.Is(err, kind.err) {
+			data := map[string]any{
+				"kind":      kind.name,
+				"retryable": kind.retryable,
+			}
+			if tool != "" {
+				data["tool"] = tool
+			}
+			return jsonRPCError(kind.code, err.Error(), data)
+		}
+	}
+	return err
+}
+
+// jsonRPCError builds an error the SDK sends to the client as a JSON-RPC
+// error with the given code and data. The SDK passes its own error type
+// through unchanged but only exposes it on decoded messages, so the error is
+// decoded from its wire form.
+func jsonRPCError(code int, message string, data any) error {
+	wire, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      0,
+		"error": map[string]any{
+			"code":    code,
+			"message": message,
+			"data":    data,
+		},
+	})
+	if err == nil {
+		var decoded jsonrpc.Message
+		if decoded, err = jsonrpc.DecodeMessage(wire); err == nil {
+			if response, ok := decoded.(*jsonrpc.Response); ok && response.Error != nil {
+				return response.Error
+			}
+		}
+	}
+	return errors.New(message)
+}
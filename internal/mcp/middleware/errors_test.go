@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+func TestMapErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		err           error
+		wantCode      int
+		wantKind      string
+		wantRetryable bool
+	}{
+		{name: "validation", err: shared.NewValidationError("title cannot be empty"), wantCode: codeInvalidParams, wantKind: "validation"},
+		{name: "wrapped not found", err: fmt.Errorf("failed to get movie: %w", shared.NewNotFoundError("movie not found")), wantCode: codeNotFound, wantKind: "not_found"},
+		{name: "conflict", err: shared.NewConflictError("actor is already linked to this movie"), wantCode: codeConflict, wantKind: "conflict"},
+		{name: "unavailable", err: shared.NewUnavailableError("write queue is full"), wantCode: codeUnavailable, wantKind: "unavailable", wantRetryable: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := MapErrors()(func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error) {
+				return nil, tt.err
+			})
+			_, err := handler(context.Background(), &ToolCall{Request: &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: "get_movie"}}})
+
+			if err == nil || err.Error() != tt.err.Error() {
+				t.Fatalf("Expected message %q, got: %v", tt.err.Error(), err)
+			}
+			code, data := wireErrorOf(t, err)
+			if code != tt.wantCode {
+				t.Errorf("Expected code %d, got: %d", tt.wantCode, code)
+			}
+			if data["kind"] != tt.wantKind || data["retryable"] != tt.wantRetryable || data["tool"] != "get_movie" {
+				t.Errorf("Expected kind %s, retryable %v and tool get_movie, got: %v", tt.wantKind, tt.wantRetryable, data)
+			}
+		})
+	}
+}
+
+func TestMapErrors_LeavesOtherErrors(t *testing.T) {
+	want := errors.New("failed to render report")
+	handler := MapErrors()(func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error) {
+		return nil, want
+	})
+
+	if _, err := handler(context.Background(), &ToolCall{}); err != want {
+		t.Errorf("Expected the error unchanged, got: %v", err)
+	}
+}
+
+func TestAddTool_DomainErrorReachesClientAsJSONRPCError(t *testing.T) {
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil)
+	registrar := NewToolRegistrar(server, MapErrors())
+
+	AddTool(registrar, &mcp.Tool{Name: "echo"}, func(ctx context.Context, req *mcp.CallToolRequest, input echoInput) (*mcp.CallToolResult, echoOutput, error) {
+		return nil, echoOutput{}, shared.NewNotFoundError("movie not found")
+	})
+
+	session := connectClient(t, server)
+	_, err := session.CallTool(context.Background(), &mcp.CallToolParams{Name: "echo", Arguments: map[string]any{"text": "hello"}})
+	if err == nil || !strings.Contains(err.Error(), "movie not found") {
+		t.Errorf("Expected a JSON-RPC error rather than a tool error for the missing movie, got: %v", err)
+	}
+}
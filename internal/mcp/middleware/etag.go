@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	// ETagKey names the content hash in the _meta of every resources/read result
+	ETagKey = "etag"
+	// IfNoneMatchKey names, in the _meta of a resources/read request, the
+	// ETag of the copy the client already holds
+	IfNoneMatchKey = "if_none_match"
+	// NotModifiedKey is set in the _meta of a resources/read result that
+	// leaves out its contents because they still match if_none_match
+	NotModifiedKey = "not_modified"
+)
+
+// ResourceETags sets an ETag, the SHA-256 of the contents and _meta, on
+// every resource read. A read whose _meta names the current ETag in
+// if_none_match gets its _meta back, with not_modified set, but no contents,
+// so a client or proxy can keep serving its cached copy.
+func ResourceETags() mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			if method != "resources/read" {
+				return next(ctx, method, req)
+			}
+
+			ifNoneMatch, err := requestString(req, IfNoneMatchKey)
+			if err != nil {
+				return nil, mapError("", err)
+			}
+			result, err := next(ctx, method, req)
+			if err != nil {
+				return result, err
+			}
+			read, ok := result.(*mcp.ReadResourceResult)
+			if !ok || read == nil {
+				return result, nil
+			}
+
+			etag, err := resourceETag(read)
+			if err != nil {
+				return nil, err
+			}
+			if read.Meta == nil {
+				read.Meta = mcp.Meta{}
+			}
+			read.Meta[ETagKey] = etag
+			if ifNoneMatch == etag {
+				read.Meta[NotModifiedKey] = true
+				read.Contents = []*mcp.ResourceContents{}
+			}
+			return read, nil
+		}
+	}
+}
+
+// resourceETag hashes a read result's contents and _meta. Both are marshaled
+// as JSON, which writes map keys in sorted order, so equal results hash alike.
+func resourceETag(read *mcp.ReadResourceResult) (string, error) {
+	hash := sha256.New()
+	encoder := json.NewEncoder(hash)
+	if err := encoder.Encode(read.Contents); err != nil {
+		return "", err
+	}
+	if err := encoder.Encode(read.Meta); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func newETagServer(t *testing.T, text *string) *mcp.ClientSession {
+	t.Helper()
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil)
+	server.AddReceivingMiddleware(ResourceETags())
+	server.AddResource(&mcp.Resource{URI: "test://stats", Name: "stats"}, func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		return &mcp.ReadResourceResult{
+			Meta:     mcp.Meta{"total": 3},
+			Contents: []*mcp.ResourceContents{{URI: req.Params.URI, MIMEType: "application/json", Text: *text}},
+		}, nil
+	})
+	return connectClient(t, server)
+}
+
+func TestResourceETags_NotModified(t *testing.T) {
+	text := `{"movies": 3}`
+	session := newETagServer(t, &text)
+	ctx := context.Background()
+
+	first, err := session.ReadResource(ctx, &mcp.ReadResourceParams{URI: "test://stats"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	etag, _ := first.Meta[ETagKey].(string)
+	if len(etag) != 64 || first.Meta["total"] != float64(3) || first.Contents[0].Text != text {
+		t.Fatalf("Expected the contents with an ETag, got: %+v", first)
+	}
+
+	again, err := session.ReadResource(ctx, &mcp.ReadResourceParams{Meta: mcp.Meta{IfNoneMatchKey: etag}, URI: "test://stats"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if again.Meta[NotModifiedKey] != true || again.Meta[ETagKey] != etag || again.Meta["total"] != float64(3) || len(again.Contents) != 0 {
+		t.Errorf("Expected a not-modified result, got: %+v", again)
+	}
+
+	text = `{"movies": 4}`
+	changed, err := session.ReadResource(ctx, &mcp.ReadResourceParams{Meta: mcp.Meta{IfNoneMatchKey: etag}, URI: "test://stats"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if changed.Meta[ETagKey] == etag || changed.Meta[NotModifiedKey] != nil || changed.Contents[0].Text != text {
+		t.Errorf("Expected the changed contents with a new ETag, got: %+v", changed)
+	}
+}
+
+func TestResourceETags_RejectsNonStringETag(t *testing.T) {
+	text := "{}"
+	session := newETagServer(t, &text)
+
+	if _, err := session.ReadResource(context.Background(), &mcp.ReadResourceParams{Meta: mcp.Meta{IfNoneMatchKey: 7}, URI: "test://stats"}); err == nil {
+		t.Error("Expected a non-string if_none_match to be rejected")
+	}
+}
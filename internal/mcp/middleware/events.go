@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/application/events"
+)
+
+// EventPublisher publishes a data change event for the tenant ctx is served for
+type EventPublisher interface {
+	Publish(ctx context.Context, eventType, tool string, data any)
+}
+
+// PublishEvents publishes an event after each successful call of a tool in
+// eventTypes, which maps tool names to event types such as "movie.created".
+// The event carries the tool's structured output; failed calls publish nothing.
+// Every call's context names its tool, for the domain events published by
+// the services it calls.
+func PublishEvents(publisher EventPublisher, eventTypes map[string]string) ToolMiddleware {
+	return func(next ToolHandler) ToolHandler {
+		return func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error) {
+			result, err := next(events.WithTool(ctx, call.Name()), call)
+			if eventType, ok := eventTypes[call.Name()]; ok && err == nil && (result == nil || !result.IsError) {
+				publisher.Publish(ctx, eventType, call.Name(), call.Output)
+			}
+			return result, err
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type publishedEvent struct {
+	eventType, tool string
+	data            any
+}
+
+// MockEventPublisher records published events
+type MockEventPublisher struct {
+	events []publishedEvent
+}
+
+func (m *MockEventPublisher) Publish(ctx context.Context, eventType, tool string, data any) {
+	m.events = append(m.events, publishedEvent{eventType: eventType, tool: tool, data: data})
+}
+
+func TestPublishEvents(t *testing.T) {
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil)
+	publisher := &MockEventPublisher{}
+	registrar := NewToolRegistrar(server, PublishEvents(publisher, map[string]string{
+		"echo": "echo.said",
+		"fail": "fail.never",
+	}))
+
+	echo := func(ctx context.Context, req *mcp.CallToolRequest, input echoInput) (*mcp.CallToolResult, echoOutput, error) {
+		return nil, echoOutput(input), nil
+	}
+	AddTool(registrar, &mcp.Tool{Name: "echo"}, echo)
+	AddTool(registrar, &mcp.Tool{Name: "quiet"}, echo)
+	AddTool(registrar, &mcp.Tool{Name: "fail"}, func(ctx context.Context, req *mcp.CallToolRequest, input echoInput) (*mcp.CallToolResult, echoOutput, error) {
+		return nil, echoOutput{}, errors.New("failed")
+	})
+
+	session := connectClient(t, server)
+	for _, name := range []string{"echo", "quiet", "fail"} {
+		if _, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+			Name:      name,
+			Arguments: map[string]any{"text": "hello"},
+		}); err != nil {
+			t.Fatalf("Expected no protocol error calling %s, got: %v", name, err)
+		}
+	}
+
+	if len(publisher.events) != 1 {
+		t.Fatalf("Expected only the successful mapped call to publish, got: %+v", publisher.events)
+	}
+	event := publisher.events[0]
+	if event.eventType != "echo.said" || event.tool != "echo" || event.data != (echoOutput{Text: "hello"}) {
+		t.Errorf("Expected echo.said with the tool's output, got: %+v", event)
+	}
+}
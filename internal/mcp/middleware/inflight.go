@@ -0,0 +1,124 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// InFlightTracker counts running requests so shutdown can wait for them.
+// Tracked requests are detached from cancellation caused by shutdown: closing
+// the session when the server context ends does not abort a write halfway
+// through, only a drain timeout does. Cancellation by the client while the
+// server is running still aborts the request.
+type InFlightTracker struct {
+	server   context.Context
+	mutex    sync.Mutex
+	active   int
+	draining bool
+	idle     chan struct{}
+	abort    context.Context
+	abortAll context.CancelFunc
+}
+
+// NewInFlightTracker creates a new in-flight request tracker; server is the
+// context whose cancellation starts shutdown
+func NewInFlightTracker(server context.Context) *InFlightTracker {
+	abort, abortAll := context.WithCancel(context.Background())
+	return &InFlightTracker{
+		server:   server,
+		idle:     make(chan struct{}),
+		abort:    abort,
+		abortAll: abortAll,
+	}
+}
+
+// Middleware tracks tool calls and rejects new ones once draining has started
+func (t *InFlightTracker) Middleware() mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			if method != "tools/call" {
+				return next(ctx, method, req)
+			}
+
+			if !t.begin() {
+				return nil, fmt.Errorf("server is shutting down")
+			}
+			defer t.end()
+
+			callCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+			defer cancel()
+			stopAbort := context.AfterFunc(t.abort, cancel)
+			defer stopAbort()
+
+			// Forward client cancellation; the server context is cancelled
+			// first on shutdown, so session teardown is ignored
+			stopClient := context.AfterFunc(ctx, func() {
+				if t.server.Err() == nil {
+					cancel()
+				}
+			})
+			defer stopClient()
+
+			return next(callCtx, method, req)
+		}
+	}
+}
+
+// Active returns the number of requests currently running
+func (t *InFlightTracker) Active() int {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.active
+}
+
+// Drain stops accepting requests and waits up to timeout for running ones.
+// Requests still running at the deadline have their contexts cancelled.
+func (t *InFlightTracker) Drain(timeout time.Duration) error {
+	t.mutex.Lock()
+	t.draining = true
+	if t.active == 0 {
+		t.mutex.Unlock()
+		return nil
+	}
+	idle := t.idle
+	t.mutex.Unlock()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-idle:
+		return nil
+	case <-timer.C:
+		t.abortAll()
+		return fmt.Errorf("timed out after %s with %d request(s) still running", timeout, t.Active())
+	}
+}
+
+// begin registers a request unless the tracker is draining
+func (t *InFlightTracker) begin() bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.draining {
+		return false
+	}
+	t.active++
+	return true
+}
+
+// end unregisters a request and signals waiters when none remain
+func (t *InFlightTracker) end() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.active--
+	if t.active == 0 {
+		close(t.idle)
+		t.idle = make(chan struct{})
+	}
+}
@@ -0,0 +1,133 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestInFlightTracker_DrainWaitsForRunningCalls(t *testing.T) {
+	tracker := NewInFlightTracker(context.Background())
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	handler := tracker.Middleware()(func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		close(started)
+		<-release
+		return &mcp.CallToolResult{}, nil
+	})
+
+	go func() { _, _ = handler(context.Background(), "tools/call", nil) }()
+	<-started
+
+	if tracker.Active() != 1 {
+		t.Fatalf("Expected 1 active call, got %d", tracker.Active())
+	}
+
+	drained := make(chan error, 1)
+	go func() { drained <- tracker.Drain(time.Second) }()
+
+	select {
+	case <-drained:
+		t.Fatal("Expected Drain to wait for the running call")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	if err := <-drained; err != nil {
+		t.Errorf("Expected clean drain, got: %v", err)
+	}
+}
+
+func TestInFlightTracker_CallSurvivesShutdownCancellation(t *testing.T) {
+	serverCtx, shutdown := context.WithCancel(context.Background())
+	tracker := NewInFlightTracker(serverCtx)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	handler := tracker.Middleware()(func(callCtx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		shutdown()
+		cancel()
+		if callCtx.Err() != nil {
+			return nil, errors.New("call context was cancelled with the session")
+		}
+		return &mcp.CallToolResult{}, nil
+	})
+
+	if _, err := handler(ctx, "tools/call", nil); err != nil {
+		t.Errorf("Expected call to finish after session cancellation, got: %v", err)
+	}
+}
+
+func TestInFlightTracker_ClientCancellationAbortsCall(t *testing.T) {
+	tracker := NewInFlightTracker(context.Background())
+	ctx, cancel := context.WithCancel(context.Background())
+
+	handler := tracker.Middleware()(func(callCtx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		cancel()
+		select {
+		case <-callCtx.Done():
+			return nil, callCtx.Err()
+		case <-time.After(time.Second):
+			return &mcp.CallToolResult{}, nil
+		}
+	})
+
+	if _, err := handler(ctx, "tools/call", nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected client cancellation to abort the call, got: %v", err)
+	}
+}
+
+func TestInFlightTracker_DrainTimeoutCancelsCalls(t *testing.T) {
+	tracker := NewInFlightTracker(context.Background())
+	started := make(chan struct{})
+	finished := make(chan error, 1)
+
+	handler := tracker.Middleware()(func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		close(started)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	go func() {
+		_, err := handler(context.Background(), "tools/call", nil)
+		finished <- err
+	}()
+	<-started
+
+	if err := tracker.Drain(10 * time.Millisecond); err == nil {
+		t.Error("Expected drain timeout error")
+	}
+
+	select {
+	case err := <-finished:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected cancelled call, got: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected call to be cancelled after drain timeout")
+	}
+}
+
+func TestInFlightTracker_RejectsCallsWhileDraining(t *testing.T) {
+	tracker := NewInFlightTracker(context.Background())
+	if err := tracker.Drain(time.Second); err != nil {
+		t.Fatalf("Expected immediate drain with no calls, got: %v", err)
+	}
+
+	called := false
+	handler := tracker.Middleware()(newRecordingHandler(&called))
+
+	if _, err := handler(context.Background(), "tools/call", nil); err == nil {
+		t.Error("Expected new tool calls to be rejected during shutdown")
+	}
+	if called {
+		t.Error("Expected next handler not to be called")
+	}
+
+	if _, err := handler(context.Background(), "resources/read", nil); err != nil {
+		t.Errorf("Expected non-tool methods to pass through, got: %v", err)
+	}
+}
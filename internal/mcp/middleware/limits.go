@@ -0,0 +1,116 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/i18n"
+)
+
+// LimitRequestSize rejects tool calls whose arguments exceed maxBytes before
+// they are decoded, so a huge import fails fast with advice instead of
+// exhausting memory. A non-positive maxBytes disables the limit.
+func LimitRequestSize(maxBytes int) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			call, ok := req.(*mcp.CallToolRequest)
+			if method != "tools/call" || maxBytes <= 0 || !ok || call.Params == nil {
+				return next(ctx, method, req)
+			}
+
+			if size := len(call.Params.Arguments); size > maxBytes {
+				return nil, mapError(call.Params.Name, shared.NewValidationError(
+					"arguments are %d bytes, over the %d-byte request limit; split bulk changes into smaller calls",
+					size, maxBytes))
+			}
+			return next(ctx, method, req)
+		}
+	}
+}
+
+// Truncation describes a list response that was cut short to fit the
+// response size limit
+type Truncation struct {
+	Returned  int    `json:"returned" jsonschema:"Items returned"`
+	Available int    `json:"available" jsonschema:"Items the tool found"`
+	MaxBytes  int    `json:"max_bytes" jsonschema:"Response size limit in bytes"`
+	Hint      string `json:"hint,omitempty" jsonschema:"How to get the full set"`
+}
+
+// Truncatable is implemented by tool outputs holding a list that can be cut
+// short. Truncate returns a value of the output's own type with the first
+// keep items and the truncation, to which it may add a hint. It is only
+// called with keep < Len(), so the item at keep can name where to continue.
+type Truncatable interface {
+	Len() int
+	Truncate(keep int, truncation Truncation) any
+}
+
+// ResponseLimits bounds the encoded size of tool outputs
+type ResponseLimits struct {
+	MaxBytes int            // Limit for every tool; non-positive is unlimited
+	PerTool  map[string]int // Limits by tool name, replacing MaxBytes; non-positive is unlimited
+}
+
+// forTool returns the limit for a tool
+func (l ResponseLimits) forTool(tool string) int {
+	if limit, ok := l.PerTool[tool]; ok {
+		return limit
+	}
+	return l.MaxBytes
+}
+
+// LimitResponses keeps tool outputs within their size limit. An output over
+// the limit that is Truncatable keeps as many leading items as fit, so the
+// same call always returns the same prefix, and records the truncation with
+// a hint for getting the rest. Other outputs are returned whole.
+func LimitResponses(limits ResponseLimits) ToolMiddleware {
+	return func(next ToolHandler) ToolHandler {
+		return func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error) {
+			result, err := next(ctx, call)
+			limit := limits.forTool(call.Name())
+			if err != nil || (result != nil && result.IsError) || limit <= 0 {
+				return result, err
+			}
+
+			output, ok := call.Output.(Truncatable)
+			if !ok || output.Len() == 0 || encodedSize(output) <= limit {
+				return result, err
+			}
+
+			available := output.Len()
+			truncate := func(keep int) any {
+				return output.Truncate(keep, Truncation{Returned: keep, Available: available, MaxBytes: limit})
+			}
+			// Find how many leading items fit; the whole list is already
+			// known not to, and when not even one does the list is sent empty
+			keep := sort.Search(available-1, func(n int) bool {
+				return encodedSize(truncate(n+1)) > limit
+			})
+			truncated := truncate(keep)
+
+			content, err := SummaryContent(ctx, truncated, i18n.FromContext(ctx).Sprintf(
+				"Showing the first %d of %d results to stay within the %d-byte response limit; see truncation for the rest",
+				keep, available, limit))
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode truncated output: %w", err)
+			}
+			call.Output = truncated
+			return &mcp.CallToolResult{Content: content}, nil
+		}
+	}
+}
+
+// encodedSize returns the size of a value encoded as JSON
+func encodedSize(value any) int {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return 0
+	}
+	return len(data)
+}
@@ -0,0 +1,147 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type listInput struct {
+	Count int `json:"count"`
+}
+
+// listOutput is a Truncatable list of names
+type listOutput struct {
+	Names      []string    `json:"names"`
+	Truncation *Truncation `json:"truncation,omitempty"`
+}
+
+func (o listOutput) Len() int {
+	return len(o.Names)
+}
+
+func (o listOutput) Truncate(keep int, truncation Truncation) any {
+	if keep >= len(o.Names) {
+		panic(fmt.Sprintf("Truncate called with keep %d of %d", keep, len(o.Names)))
+	}
+	truncation.Hint = "page"
+	o.Names = o.Names[:keep]
+	o.Truncation = &truncation
+	return o
+}
+
+func newListServer(t *testing.T, limits ResponseLimits) *mcp.ClientSession {
+	t.Helper()
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil)
+	registrar := NewToolRegistrar(server, LimitResponses(limits))
+	list := func(ctx context.Context, req *mcp.CallToolRequest, input listInput) (*mcp.CallToolResult, listOutput, error) {
+		output := listOutput{Names: []string{}}
+		for i := range input.Count {
+			output.Names = append(output.Names, fmt.Sprintf("name-%03d", i))
+		}
+		return nil, output, nil
+	}
+	AddTool(registrar, &mcp.Tool{Name: "list"}, list)
+	AddTool(registrar, &mcp.Tool{Name: "unlimited"}, list)
+	AddTool(registrar, &mcp.Tool{Name: "echo"}, func(ctx context.Context, req *mcp.CallToolRequest, input echoInput) (*mcp.CallToolResult, echoOutput, error) {
+		return nil, echoOutput(input), nil
+	})
+	return connectClient(t, server)
+}
+
+func callList(t *testing.T, session *mcp.ClientSession, tool string, count int) (*mcp.CallToolResult, listOutput) {
+	t.Helper()
+
+	result, err := session.CallTool(context.Background(), &mcp.CallToolParams{Name: tool, Arguments: map[string]any{"count": count}})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	var output listOutput
+	data, _ := json.Marshal(result.StructuredContent)
+	if err := json.Unmarshal(data, &output); err != nil {
+		t.Fatalf("failed to decode output: %v", err)
+	}
+	return result, output
+}
+
+func TestLimitResponses_TruncatesDeterministically(t *testing.T) {
+	session := newListServer(t, ResponseLimits{MaxBytes: 200, PerTool: map[string]int{"unlimited": 0}})
+
+	_, small := callList(t, session, "list", 3)
+	if len(small.Names) != 3 || small.Truncation != nil {
+		t.Errorf("Expected a small list to be returned whole, got: %+v", small)
+	}
+
+	result, first := callList(t, session, "list", 100)
+	_, second := callList(t, session, "list", 100)
+	if first.Truncation == nil || first.Truncation.Available != 100 || first.Truncation.Returned != len(first.Names) {
+		t.Fatalf("Expected the truncation to be recorded, got: %+v", first.Truncation)
+	}
+	if first.Truncation.Hint != "page" || first.Names[0] != "name-000" {
+		t.Errorf("Expected the leading names and the output's hint, got: %+v", first)
+	}
+	if size := len(result.Content[0].(*mcp.TextContent).Text); size > 200 {
+		t.Errorf("Expected the response within 200 bytes, got: %d", size)
+	}
+	if fmt.Sprint(first.Names) != fmt.Sprint(second.Names) {
+		t.Errorf("Expected the same call to return the same names, got: %v and %v", first.Names, second.Names)
+	}
+	if summary := result.Content[1].(*mcp.TextContent).Text; !strings.Contains(summary, fmt.Sprintf("first %d of 100", len(first.Names))) {
+		t.Errorf("Expected the summary to describe the truncation, got: %q", summary)
+	}
+
+	if _, whole := callList(t, session, "unlimited", 100); len(whole.Names) != 100 {
+		t.Errorf("Expected the per-tool override to disable the limit, got %d names", len(whole.Names))
+	}
+}
+
+func TestLimitResponses_NeverKeepsEveryItem(t *testing.T) {
+	session := newListServer(t, ResponseLimits{MaxBytes: 10})
+
+	for _, count := range []int{1, 2} {
+		_, output := callList(t, session, "list", count)
+		if len(output.Names) != 0 || output.Truncation == nil || output.Truncation.Available != count {
+			t.Errorf("Expected %d names that do not fit to be sent empty, got: %+v", count, output)
+		}
+	}
+}
+
+func TestLimitResponses_LeavesOtherOutputs(t *testing.T) {
+	session := newListServer(t, ResponseLimits{MaxBytes: 10})
+
+	result, err := session.CallTool(context.Background(), &mcp.CallToolParams{Name: "echo", Arguments: map[string]any{"text": strings.Repeat("x", 50)}})
+	if err != nil || result.IsError {
+		t.Fatalf("Expected the call to succeed, got: %v", err)
+	}
+	if text := result.StructuredContent.(map[string]any)["text"]; text != strings.Repeat("x", 50) {
+		t.Errorf("Expected an output that cannot be truncated to be returned whole, got: %v", text)
+	}
+}
+
+func TestLimitRequestSize(t *testing.T) {
+	called := false
+	handler := LimitRequestSize(16)(newRecordingHandler(&called))
+
+	request := func(arguments string) *mcp.CallToolRequest {
+		return &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: "bulk", Arguments: json.RawMessage(arguments)}}
+	}
+
+	if _, err := handler(context.Background(), "tools/call", request(`{"ids":[1,2]}`)); err != nil || !called {
+		t.Fatalf("Expected a small call to pass through, got: %v", err)
+	}
+
+	called = false
+	_, err := handler(context.Background(), "tools/call", request(`{"ids":[1,2,3,4,5,6,7,8,9]}`))
+	if called {
+		t.Error("Expected an oversized call to be rejected before the handler")
+	}
+	code, data := wireErrorOf(t, err)
+	if code != codeInvalidParams || data["tool"] != "bulk" || !strings.Contains(err.Error(), "smaller calls") {
+		t.Errorf("Expected an actionable validation error, got: code %d, data %v, %v", code, data, err)
+	}
+}
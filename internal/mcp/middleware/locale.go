@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/i18n"
+)
+
+// LocaleKey names the languages a client prefers, as an Accept-Language
+// list such as "fr-CA, es;q=0.8", in the _meta of its initialize request
+const LocaleKey = "locale"
+
+// Localize writes the messages of each tool call in the language its session
+// negotiates. preferences returns the languages a call's session asks for,
+// most preferred first; the first one catalog supports is used. Handlers
+// and inner middleware find the printer with i18n.FromContext, and errors
+// of a domain kind are translated on their way out.
+func Localize(catalog *i18n.Catalog, preferences func(req *mcp.CallToolRequest) []string) ToolMiddleware {
+	return func(next ToolHandler) ToolHandler {
+		return func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error) {
+			printer := catalog.Printer(catalog.Negotiate(preferences(call.Request)...))
+			result, err := next(i18n.WithPrinter(ctx, printer), call)
+			if err != nil {
+				return result, shared.TranslateError(err, printer.Translate)
+			}
+			return result, nil
+		}
+	}
+}
+
+// ClientLocale returns the languages the client of a request asked for in
+// the _meta.locale of its initialize request, or "" when it named none
+func ClientLocale(req *mcp.CallToolRequest) string {
+	if req == nil || req.Session == nil {
+		return ""
+	}
+	params := req.Session.InitializeParams()
+	if params == nil {
+		return ""
+	}
+	locale, _ := params.GetMeta()[LocaleKey].(string)
+	return strings.TrimSpace(locale)
+}
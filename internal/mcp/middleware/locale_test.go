@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/i18n"
+)
+
+// connectLocaleClient connects a client that asks for locale in the _meta
+// of its initialize request
+func connectLocaleClient(t *testing.T, server *mcp.Server, locale string) *mcp.ClientSession {
+	t.Helper()
+
+	ctx := context.Background()
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	if err != nil {
+		t.Fatalf("failed to connect server: %v", err)
+	}
+	t.Cleanup(func() { serverSession.Close() })
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "1.0.0"}, nil)
+	client.AddSendingMiddleware(func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			if params, ok := req.GetParams().(*mcp.InitializeParams); ok && locale != "" {
+				params.SetMeta(map[string]any{LocaleKey: locale})
+			}
+			return next(ctx, method, req)
+		}
+	})
+	session, err := client.Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("failed to connect client: %v", err)
+	}
+	t.Cleanup(func() { session.Close() })
+	return session
+}
+
+func TestLocalize(t *testing.T) {
+	catalog, err := i18n.NewCatalog()
+	if err != nil {
+		t.Fatalf("Failed to load catalog: %v", err)
+	}
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil)
+	registrar := NewToolRegistrar(server, Localize(catalog, func(req *mcp.CallToolRequest) []string {
+		return []string{ClientLocale(req), "es"}
+	}))
+	AddTool(registrar, &mcp.Tool{Name: "echo"}, func(ctx context.Context, req *mcp.CallToolRequest, input echoInput) (*mcp.CallToolResult, echoOutput, error) {
+		if input.Text == "missing" {
+			return nil, echoOutput{}, shared.NewNotFoundError("movie %d not found", 7)
+		}
+		return nil, echoOutput{Text: i18n.FromContext(ctx).Sprintf("Deleted movie %d", 7)}, nil
+	})
+
+	tests := []struct {
+		name        string
+		locale      string
+		wantText    string
+		wantMissing string
+	}{
+		{name: "client locale", locale: "fr-CA, es;q=0.5", wantText: "Film 7 supprimé", wantMissing: "film 7 introuvable"},
+		{name: "fallback preference", locale: "de", wantText: "Película 7 eliminada", wantMissing: "película 7 no encontrada"},
+		{name: "no client locale", wantText: "Película 7 eliminada", wantMissing: "película 7 no encontrada"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := connectLocaleClient(t, server, tt.locale)
+
+			result, err := session.CallTool(context.Background(), &mcp.CallToolParams{Name: "echo", Arguments: map[string]any{"text": "hi"}})
+			if err != nil || result.IsError {
+				t.Fatalf("Expected a successful call, got: %v", err)
+			}
+			if text := result.StructuredContent.(map[string]any)["text"]; text != tt.wantText {
+				t.Errorf("Expected %q, got: %v", tt.wantText, text)
+			}
+
+			result, err = session.CallTool(context.Background(), &mcp.CallToolParams{Name: "echo", Arguments: map[string]any{"text": "missing"}})
+			if err != nil || !result.IsError {
+				t.Fatalf("Expected an error result, got: %v", err)
+			}
+			if text := result.Content[0].(*mcp.TextContent).Text; !strings.Contains(text, tt.wantMissing) {
+				t.Errorf("Expected a translated error containing %q, got: %q", tt.wantMissing, text)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/sirupsen/logrus"
+)
+
+// LogPolicy controls what the log entry of a tool call records of its
+// arguments
+type LogPolicy struct {
+	RedactFields    []string // Argument keys, at any depth, whose values are replaced; matched case-insensitively
+	MaxPayloadBytes int      // Logged arguments are cut to this size; zero leaves them out
+}
+
+// Logging writes one structured entry per tool call with the tool name,
+// duration, outcome and the arguments allowed by policy; failed calls are
+// logged as warnings
+func Logging(logger logrus.FieldLogger, policy LogPolicy) ToolMiddleware {
+	redacted := make(map[string]bool, len(policy.RedactFields))
+	for _, field := range policy.RedactFields {
+		if field = strings.ToLower(strings.TrimSpace(field)); field != "" {
+			redacted[field] = true
+		}
+	}
+
+	return func(next ToolHandler) ToolHandler {
+		return func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error) {
+			start := time.Now()
+			result, err := next(ctx, call)
+
+			fields := logrus.Fields{
+				"tool":        call.Name(),
+				"duration_ms": time.Since(start).Milliseconds(),
+			}
+			if policy.MaxPayloadBytes > 0 {
+				arguments := encodeArguments(call, func(value any) any { return redactFields(value, redacted) }, policy.MaxPayloadBytes)
+				if arguments != "" {
+					fields["arguments"] = arguments
+				}
+			}
+			entry := logger.WithFields(fields)
+			if err != nil {
+				entry.WithError(err).Warn("Tool call failed")
+			} else {
+				entry.Info("Tool call completed")
+			}
+			return result, err
+		}
+	}
+}
+
+// encodeArguments returns a call's arguments as JSON after transform, cut to
+// limit bytes
+func encodeArguments(call *ToolCall, transform func(any) any, limit int) string {
+	if call.Request == nil || call.Request.Params == nil || len(call.Request.Params.Arguments) == 0 {
+		return ""
+	}
+
+	var arguments any
+	if err := json.Unmarshal(call.Request.Params.Arguments, &arguments); err != nil {
+		return redactedValue
+	}
+	data, err := json.Marshal(transform(arguments))
+	if err != nil {
+		return redactedValue
+	}
+	return truncate(string(data), limit)
+}
+
+// redactFields replaces the values of redacted keys in a decoded JSON value,
+// whatever their type
+func redactFields(value any, redacted map[string]bool) any {
+	switch value := value.(type) {
+	case map[string]any:
+		for key, v := range value {
+			if redacted[strings.ToLower(key)] {
+				value[key] = redactedValue
+			} else {
+				value[key] = redactFields(v, redacted)
+			}
+		}
+	case []any:
+		for i, v := range value {
+			value[i] = redactFields(v, redacted)
+		}
+	}
+	return value
+}
@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestLogging_LogsEachCall(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		wantLevel logrus.Level
+		wantMsg   string
+	}{
+		{name: "success", wantLevel: logrus.InfoLevel, wantMsg: "Tool call completed"},
+		{name: "failure", err: errors.New("movie not found"), wantLevel: logrus.WarnLevel, wantMsg: "Tool call failed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, hook := test.NewNullLogger()
+
+			handler := Logging(logger, LogPolicy{})(func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error) {
+				return &mcp.CallToolResult{}, tt.err
+			})
+			_, err := handler(context.Background(), &ToolCall{Request: &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: "get_movie"}}})
+
+			if !errors.Is(err, tt.err) {
+				t.Errorf("Expected the handler's error, got: %v", err)
+			}
+			entry := hook.LastEntry()
+			if entry == nil || entry.Level != tt.wantLevel || entry.Message != tt.wantMsg {
+				t.Fatalf("Expected %s %q, got: %+v", tt.wantLevel, tt.wantMsg, entry)
+			}
+			if entry.Data["tool"] != "get_movie" {
+				t.Errorf("Expected tool get_movie, got: %v", entry.Data["tool"])
+			}
+			if _, ok := entry.Data["duration_ms"]; !ok {
+				t.Error("Expected duration_ms to be logged")
+			}
+		})
+	}
+}
+
+func TestLogging_AppliesPolicyToArguments(t *testing.T) {
+	arguments := `{"title":"Alien","Description":"A crew meets a creature","cast":[{"name":"Sigourney Weaver","bio":{"born":1949}}]}`
+
+	tests := []struct {
+		name   string
+		policy LogPolicy
+		want   string
+	}{
+		{
+			name:   "left out by default",
+			policy: LogPolicy{},
+		},
+		{
+			name:   "redacted fields at any depth",
+			policy: LogPolicy{RedactFields: []string{"description", " bio"}, MaxPayloadBytes: 1024},
+			want:   `{"Description":"[redacted]","cast":[{"bio":"[redacted]","name":"Sigourney Weaver"}],"title":"Alien"}`,
+		},
+		{
+			name:   "capped",
+			policy: LogPolicy{MaxPayloadBytes: 20},
+			want:   `{"Description":"A cr…`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, hook := test.NewNullLogger()
+
+			handler := Logging(logger, tt.policy)(func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error) {
+				return &mcp.CallToolResult{}, nil
+			})
+			_, _ = handler(context.Background(), &ToolCall{Request: &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: "add_movie", Arguments: json.RawMessage(arguments)}}})
+
+			got, logged := hook.LastEntry().Data["arguments"]
+			if tt.want == "" {
+				if logged {
+					t.Errorf("Expected no arguments, got: %v", got)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Expected arguments %s, got: %v", tt.want, got)
+			}
+			if strings.Contains(got.(string), "creature") {
+				t.Errorf("Expected the description to stay out of the log, got: %v", got)
+			}
+		})
+	}
+}
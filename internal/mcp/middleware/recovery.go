@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/google/uuid"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/sirupsen/logrus"
+)
+
+// Recover turns a panicking tool handler into a JSON-RPC internal error, so
+// one bad call cannot take down the server. The panic and its stack are
+// logged under a correlation ID that is also sent to the client.
+func Recover(logger logrus.FieldLogger) ToolMiddleware {
+	return func(next ToolHandler) ToolHandler {
+		return func(ctx context.Context, call *ToolCall) (result *mcp.CallToolResult, err error) {
+			defer func() {
+				if recovered := recover(); recovered != nil {
+					result, err = nil, logPanic(logger, "tools/call", call.Name(), recovered)
+				}
+			}()
+			return next(ctx, call)
+		}
+	}
+}
+
+// RecoverPanics is the request-level counterpart of Recover. It catches
+// panics in any method handler, such as resource reads, that tool middleware
+// does not wrap.
+func RecoverPanics(logger logrus.FieldLogger) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (result mcp.Result, err error) {
+			defer func() {
+				if recovered := recover(); recovered != nil {
+					result, err = nil, logPanic(logger, method, "", recovered)
+				}
+			}()
+			return next(ctx, method, req)
+		}
+	}
+}
+
+// logPanic logs a recovered panic with its stack and returns the internal
+// error reported for it
+func logPanic(logger logrus.FieldLogger, method, tool string, recovered any) error {
+	correlationID := uuid.New().String()
+	fields := logrus.Fields{
+		"method":         method,
+		"correlation_id": correlationID,
+		"panic":          recovered,
+		"stack":          string(debug.Stack()),
+	}
+	if tool != "" {
+		fields["tool"] = tool
+	}
+	logger.WithFields(fields).Error("Handler panicked")
+	return internalError(correlationID)
+}
+
+// internalError builds a JSON-RPC internal error carrying a correlation ID
+func internalError(correlationID string) error {
+	message := fmt.Sprintf("internal error (correlation ID %s); the failure has been logged", correlationID)
+	return jsonRPCError(codeInternalError, message, map[string]string{"correlation_id": correlationID})
+}
@@ -0,0 +1,131 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/jsonrpc"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+// wireErrorOf encodes err as a JSON-RPC error response and decodes its
+// code and data, as the client would see them
+func wireErrorOf(t *testing.T, err error) (code int, data map[string]any) {
+	t.Helper()
+
+	id, _ := jsonrpc.MakeID(int64(1))
+	encoded, encodeErr := jsonrpc.EncodeMessage(&jsonrpc.Response{ID: id, Error: err})
+	if encodeErr != nil {
+		t.Fatalf("failed to encode response: %v", encodeErr)
+	}
+	var wire struct {
+		Error struct {
+			Code int            `json:"code"`
+			Data map[string]any `json:"data"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(encoded, &wire); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	return wire.Error.Code, wire.Error.Data
+}
+
+func TestRecover_PanicBecomesInternalError(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+
+	handler := Recover(logger)(func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error) {
+		panic("boom")
+	})
+	result, err := handler(context.Background(), &ToolCall{Request: &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: "explode"}}})
+
+	if err == nil || !strings.Contains(err.Error(), "internal error") {
+		t.Fatalf("Expected internal error, got: %v", err)
+	}
+	if result != nil {
+		t.Errorf("Expected no result, got: %+v", result)
+	}
+
+	code, data := wireErrorOf(t, err)
+	correlationID, _ := data["correlation_id"].(string)
+	if code != codeInternalError {
+		t.Errorf("Expected code %d, got: %d", codeInternalError, code)
+	}
+
+	entry := hook.LastEntry()
+	if entry == nil || entry.Level != logrus.ErrorLevel {
+		t.Fatalf("Expected the panic to be logged as an error, got: %+v", entry)
+	}
+	if entry.Data["panic"] != "boom" || entry.Data["stack"] == "" || entry.Data["tool"] != "explode" {
+		t.Errorf("Expected tool, panic value and stack in the log, got: %v", entry.Data)
+	}
+	if correlationID == "" || entry.Data["correlation_id"] != correlationID || !strings.Contains(err.Error(), correlationID) {
+		t.Errorf("Expected the logged correlation ID %v in the error, got: %q (data %q)", entry.Data["correlation_id"], err.Error(), correlationID)
+	}
+}
+
+func TestRecover_PassesThroughWithoutPanic(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+	want := &mcp.CallToolResult{}
+
+	handler := Recover(logger)(func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error) {
+		return want, nil
+	})
+	result, err := handler(context.Background(), &ToolCall{})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result != want {
+		t.Errorf("Expected the handler's result, got: %+v", result)
+	}
+	if len(hook.AllEntries()) != 0 {
+		t.Errorf("Expected nothing logged, got: %d entries", len(hook.AllEntries()))
+	}
+}
+
+func TestRecoverPanics_PanicBecomesInternalError(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+
+	handler := RecoverPanics(logger)(func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		panic("boom")
+	})
+	result, err := handler(context.Background(), "resources/read", nil)
+
+	if result != nil {
+		t.Errorf("Expected no result, got: %+v", result)
+	}
+	if code, data := wireErrorOf(t, err); code != codeInternalError || data["correlation_id"] == nil {
+		t.Errorf("Expected internal error with a correlation ID, got: code %d, data %v", code, data)
+	}
+	if entry := hook.LastEntry(); entry == nil || entry.Data["method"] != "resources/read" {
+		t.Errorf("Expected the panic to be logged with its method, got: %+v", entry)
+	}
+}
+
+func TestAddTool_PanicKeepsServerAlive(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil)
+	registrar := NewToolRegistrar(server, Recover(logger))
+
+	AddTool(registrar, &mcp.Tool{Name: "echo"}, func(ctx context.Context, req *mcp.CallToolRequest, input echoInput) (*mcp.CallToolResult, echoOutput, error) {
+		if input.Text == "panic" {
+			panic("boom")
+		}
+		return nil, echoOutput(input), nil
+	})
+
+	session := connectClient(t, server)
+	_, err := session.CallTool(context.Background(), &mcp.CallToolParams{Name: "echo", Arguments: map[string]any{"text": "panic"}})
+	if err == nil || !strings.Contains(err.Error(), "correlation ID") {
+		t.Fatalf("Expected a JSON-RPC internal error with a correlation ID, got: %v", err)
+	}
+
+	result, err := session.CallTool(context.Background(), &mcp.CallToolParams{Name: "echo", Arguments: map[string]any{"text": "hello"}})
+	if err != nil || result.IsError {
+		t.Errorf("Expected the server to keep serving after a panic, got: %v", err)
+	}
+}
@@ -0,0 +1,160 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/modelcontextprotocol/go-sdk/jsonrpc"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Outcomes of a logged tool call
+const (
+	RequestOK        = "ok"         // The call succeeded
+	RequestToolError = "tool_error" // The call returned an error result
+	RequestError     = "error"      // The call failed with a JSON-RPC error
+)
+
+// MaxLoggedArgumentBytes bounds the arguments kept for one logged call
+const MaxLoggedArgumentBytes = 256
+
+// redactedValue stands in for argument values kept out of logs
+const redactedValue = "[redacted]"
+
+// RequestRecord is one tool call in the request log
+type RequestRecord struct {
+	At        time.Time
+	Tool      string
+	Duration  time.Duration
+	Status    string // RequestOK, RequestToolError or RequestError
+	Code      int    // JSON-RPC error code when Status is RequestError
+	Arguments string // Redacted arguments as JSON, cut to MaxLoggedArgumentBytes
+}
+
+// RequestLog keeps the most recent tool calls. Argument values are redacted
+// before they are stored: strings are replaced and only keys, numbers,
+// booleans and the shape of lists survive, so the log can be shown to
+// operators without exposing titles, names or other user input.
+type RequestLog struct {
+	mutex   sync.Mutex
+	size    int
+	records []RequestRecord // Oldest first, at most size
+}
+
+// NewRequestLog creates a request log keeping the last size calls
+func NewRequestLog(size int) *RequestLog {
+	return &RequestLog{size: max(size, 1)}
+}
+
+// Size returns how many calls the log keeps
+func (l *RequestLog) Size() int {
+	return l.size
+}
+
+// Middleware records every tool call with its latency and outcome. Placed
+// outside MapErrors, it sees the JSON-RPC code a domain error is sent with.
+func (l *RequestLog) Middleware() ToolMiddleware {
+	return func(next ToolHandler) ToolHandler {
+		return func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error) {
+			start := time.Now()
+			result, err := next(ctx, call)
+
+			record := RequestRecord{
+				At:        start,
+				Tool:      call.Name(),
+				Duration:  time.Since(start),
+				Status:    RequestOK,
+				Arguments: encodeArguments(call, redact, MaxLoggedArgumentBytes),
+			}
+			switch {
+			case err != nil:
+				record.Status = RequestToolError
+				if code := errorCode(err); code != 0 {
+					record.Status, record.Code = RequestError, code
+				}
+			case result != nil && result.IsError:
+				record.Status = RequestToolError
+			}
+			l.add(record)
+			return result, err
+		}
+	}
+}
+
+// add appends a record, dropping the oldest past the log's size
+func (l *RequestLog) add(record RequestRecord) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.records = append(l.records, record)
+	if len(l.records) > l.size {
+		l.records = l.records[len(l.records)-l.size:]
+	}
+}
+
+// Recent returns the logged calls, newest first
+func (l *RequestLog) Recent() []RequestRecord {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	records := make([]RequestRecord, len(l.records))
+	for i, record := range l.records {
+		records[len(records)-1-i] = record
+	}
+	return records
+}
+
+// errorCode returns the JSON-RPC code err is sent with, or 0 for an error
+// the SDK reports as a tool error
+func errorCode(err error) int {
+	id, _ := jsonrpc.MakeID(int64(0))
+	encoded, encodeErr := jsonrpc.EncodeMessage(&jsonrpc.Response{ID: id, Error: err})
+	if encodeErr != nil {
+		return 0
+	}
+	var wire struct {
+		Error struct {
+			Code int `json:"code"`
+		} `json:"error"`
+	}
+	if json.Unmarshal(encoded, &wire) != nil {
+		return 0
+	}
+	return wire.Error.Code
+}
+
+// redact replaces the strings in a decoded JSON value, keeping its keys and
+// structure
+func redact(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		for key, v := range value {
+			value[key] = redact(v)
+		}
+	case []any:
+		for i, v := range value {
+			value[i] = redact(v)
+		}
+	case string:
+		if value != "" {
+			return redactedValue
+		}
+	}
+	return value
+}
+
+// truncate cuts text to at most limit bytes on a rune boundary, marking the
+// cut with an ellipsis
+func truncate(text string, limit int) string {
+	if len(text) <= limit {
+		return text
+	}
+	cut := limit
+	for cut > 0 && !utf8.RuneStart(text[cut]) {
+		cut--
+	}
+	return text[:cut] + "…"
+}
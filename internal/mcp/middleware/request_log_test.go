@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// requestLogCall builds a call of tool with JSON arguments
+func requestLogCall(tool, arguments string) *ToolCall {
+	return &ToolCall{Request: &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: tool, Arguments: json.RawMessage(arguments)}}}
+}
+
+func TestRequestLog_RecordsOutcomes(t *testing.T) {
+	log := NewRequestLog(10)
+	handler := NewToolRegistrar(nil, log.Middleware(), MapErrors()).chain(func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error) {
+		switch call.Name() {
+		case "missing_tool":
+			return nil, shared.NewNotFoundError("movie not found")
+		case "failing_tool":
+			return nil, errors.New("failed")
+		case "error_result_tool":
+			return &mcp.CallToolResult{IsError: true}, nil
+		}
+		return &mcp.CallToolResult{}, nil
+	})
+
+	for _, name := range []string{"ok_tool", "missing_tool", "failing_tool", "error_result_tool"} {
+		_, _ = handler(context.Background(), requestLogCall(name, `{}`))
+	}
+
+	records := log.Recent()
+	if len(records) != 4 {
+		t.Fatalf("Expected 4 records, got: %v", records)
+	}
+	want := []struct {
+		tool   string
+		status string
+		code   int
+	}{
+		{"error_result_tool", RequestToolError, 0},
+		{"failing_tool", RequestToolError, 0},
+		{"missing_tool", RequestError, codeNotFound},
+		{"ok_tool", RequestOK, 0},
+	}
+	for i, w := range want {
+		if records[i].Tool != w.tool || records[i].Status != w.status || records[i].Code != w.code {
+			t.Errorf("Record %d: expected %s %s %d, got: %+v", i, w.tool, w.status, w.code, records[i])
+		}
+		if records[i].At.IsZero() {
+			t.Errorf("Record %d: expected a time, got none", i)
+		}
+	}
+}
+
+func TestRequestLog_RedactsArguments(t *testing.T) {
+	log := NewRequestLog(10)
+	handler := log.Middleware()(func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error) {
+		return &mcp.CallToolResult{}, nil
+	})
+
+	_, _ = handler(context.Background(), requestLogCall("add_movie", `{"title":"Alien","director":"","year":1979,"genres":["Horror","Sci-Fi"],"owner":{"email":"someone@example.com","verified":true},"note":null}`))
+
+	got := log.Recent()[0].Arguments
+	want := `{"director":"","genres":["[redacted]","[redacted]"],"note":null,"owner":{"email":"[redacted]","verified":true},"title":"[redacted]","year":1979}`
+	if got != want {
+		t.Errorf("Expected redacted arguments %s, got: %s", want, got)
+	}
+	for _, secret := range []string{"Alien", "Horror", "example.com"} {
+		if strings.Contains(got, secret) {
+			t.Errorf("Expected %q to be redacted, got: %s", secret, got)
+		}
+	}
+}
+
+func TestRequestLog_TruncatesArguments(t *testing.T) {
+	log := NewRequestLog(10)
+	handler := log.Middleware()(func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error) {
+		return &mcp.CallToolResult{}, nil
+	})
+
+	ids := make([]string, 200)
+	for i := range ids {
+		ids[i] = "12345"
+	}
+	_, _ = handler(context.Background(), requestLogCall("get_movies_batch", `{"ids":[`+strings.Join(ids, ",")+`]}`))
+	_, _ = handler(context.Background(), requestLogCall("list_movies", ``))
+
+	records := log.Recent()
+	if records[0].Arguments != "" {
+		t.Errorf("Expected no arguments for a call without any, got: %q", records[0].Arguments)
+	}
+	truncated := records[1].Arguments
+	if !strings.HasSuffix(truncated, "…") || len(truncated) > MaxLoggedArgumentBytes+len("…") {
+		t.Errorf("Expected arguments cut to %d bytes, got %d: %s", MaxLoggedArgumentBytes, len(truncated), truncated)
+	}
+}
+
+func TestRequestLog_KeepsMostRecent(t *testing.T) {
+	log := NewRequestLog(3)
+	handler := log.Middleware()(func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error) {
+		return &mcp.CallToolResult{}, nil
+	})
+
+	for _, name := range []string{"first", "second", "third", "fourth", "fifth"} {
+		_, _ = handler(context.Background(), requestLogCall(name, `{}`))
+	}
+
+	records := log.Recent()
+	if len(records) != 3 || log.Size() != 3 {
+		t.Fatalf("Expected the log to keep 3 records, got: %v", records)
+	}
+	if records[0].Tool != "fifth" || records[2].Tool != "third" {
+		t.Errorf("Expected the newest calls first, got: %v", records)
+	}
+}
@@ -0,0 +1,109 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// TenantKey names the tenant of a request, either as a tools/call argument or
+// in the _meta of a tools/call, resources/read, resources/subscribe or
+// resources/unsubscribe request
+const TenantKey = "tenant"
+
+// TenantResolver routes a context to a tenant's library
+type TenantResolver interface {
+	WithTenant(ctx context.Context, tenant string) (context.Context, error)
+}
+
+// tenantMethods are the requests that may name a tenant
+var tenantMethods = map[string]bool{
+	"tools/call":            true,
+	"resources/read":        true,
+	"resources/subscribe":   true,
+	"resources/unsubscribe": true,
+}
+
+// Tenants serves tool calls, resource reads and subscriptions from the
+// library of the tenant they name. The "tenant" argument is removed before
+// the tool decodes its input, so no tool needs it in its schema. Requests
+// naming no tenant use the default library; an invalid or conflicting
+// tenant fails the request.
+func Tenants(resolver TenantResolver) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			if !tenantMethods[method] {
+				return next(ctx, method, req)
+			}
+
+			tool := ""
+			if call, ok := req.(*mcp.CallToolRequest); ok && call.Params != nil {
+				tool = call.Params.Name
+			}
+			tenant, err := requestString(req, TenantKey)
+			if err != nil {
+				return nil, mapError(tool, err)
+			}
+			if tenant == "" {
+				return next(ctx, method, req)
+			}
+
+			tenantCtx, err := resolver.WithTenant(ctx, tenant)
+			if err != nil {
+				return nil, mapError(tool, err)
+			}
+			return next(tenantCtx, method, req)
+		}
+	}
+}
+
+// requestString returns the string a request names key for in its _meta
+// or, for a tool call, its arguments, removing the argument. Both may name
+// it only if they agree.
+func requestString(req mcp.Request, key string) (string, error) {
+	params := req.GetParams()
+	if params == nil {
+		return "", nil
+	}
+
+	value := ""
+	if meta, ok := params.GetMeta()[key]; ok {
+		name, ok := meta.(string)
+		if !ok {
+			return "", shared.NewValidationError("_meta.%s must be a string", key)
+		}
+		value = name
+	}
+
+	call, ok := params.(*mcp.CallToolParamsRaw)
+	if !ok || len(call.Arguments) == 0 {
+		return value, nil
+	}
+	var arguments map[string]json.RawMessage
+	if err := json.Unmarshal(call.Arguments, &arguments); err != nil {
+		return value, nil // The tool reports malformed arguments itself
+	}
+	raw, ok := arguments[key]
+	if !ok {
+		return value, nil
+	}
+
+	var name string
+	if err := json.Unmarshal(raw, &name); err != nil {
+		return "", shared.NewValidationError("%s must be a string", key)
+	}
+	if value != "" && name != value {
+		return "", shared.NewValidationError("%s argument %q conflicts with _meta.%s %q", key, name, key, value)
+	}
+
+	delete(arguments, key)
+	stripped, err := json.Marshal(arguments)
+	if err != nil {
+		return "", err
+	}
+	call.Arguments = stripped
+	return name, nil
+}
@@ -0,0 +1,108 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+type testTenantKey struct{}
+
+// MockTenantResolver records the tenant in the context, rejecting "bad"
+type MockTenantResolver struct{}
+
+func (MockTenantResolver) WithTenant(ctx context.Context, tenant string) (context.Context, error) {
+	if tenant == "bad" {
+		return nil, shared.NewValidationError("invalid tenant %q", tenant)
+	}
+	return context.WithValue(ctx, testTenantKey{}, tenant), nil
+}
+
+func contextTenant(ctx context.Context) string {
+	tenant, _ := ctx.Value(testTenantKey{}).(string)
+	return tenant
+}
+
+func newTenantServer(t *testing.T) *mcp.ClientSession {
+	t.Helper()
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil)
+	server.AddReceivingMiddleware(Tenants(MockTenantResolver{}))
+	mcp.AddTool(server, &mcp.Tool{Name: "echo"}, func(ctx context.Context, req *mcp.CallToolRequest, input echoInput) (*mcp.CallToolResult, echoOutput, error) {
+		return nil, echoOutput{Text: input.Text + "@" + contextTenant(ctx)}, nil
+	})
+	server.AddResource(&mcp.Resource{URI: "test://tenant", Name: "tenant"}, func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		return &mcp.ReadResourceResult{Contents: []*mcp.ResourceContents{{URI: req.Params.URI, Text: contextTenant(ctx)}}}, nil
+	})
+	return connectClient(t, server)
+}
+
+func TestTenants_ToolCalls(t *testing.T) {
+	session := newTenantServer(t)
+
+	tests := []struct {
+		name      string
+		arguments map[string]any
+		meta      mcp.Meta
+		want      string
+	}{
+		{name: "no tenant", arguments: map[string]any{"text": "hi"}, want: "hi@"},
+		{name: "argument", arguments: map[string]any{"text": "hi", "tenant": "acme"}, want: "hi@acme"},
+		{name: "meta", arguments: map[string]any{"text": "hi"}, meta: mcp.Meta{"tenant": "globex"}, want: "hi@globex"},
+		{name: "both agree", arguments: map[string]any{"text": "hi", "tenant": "acme"}, meta: mcp.Meta{"tenant": "acme"}, want: "hi@acme"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := session.CallTool(context.Background(), &mcp.CallToolParams{Meta: tt.meta, Name: "echo", Arguments: tt.arguments})
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if result.IsError {
+				t.Fatalf("Expected a successful call, got: %+v", result.Content)
+			}
+			if text := result.StructuredContent.(map[string]any)["text"]; text != tt.want {
+				t.Errorf("Expected %q, got: %v", tt.want, text)
+			}
+		})
+	}
+}
+
+func TestTenants_RejectsInvalidTenant(t *testing.T) {
+	session := newTenantServer(t)
+
+	tests := []struct {
+		name      string
+		arguments map[string]any
+		meta      mcp.Meta
+	}{
+		{name: "rejected by resolver", arguments: map[string]any{"text": "hi", "tenant": "bad"}},
+		{name: "not a string", arguments: map[string]any{"text": "hi", "tenant": 7}},
+		{name: "conflict", arguments: map[string]any{"text": "hi", "tenant": "acme"}, meta: mcp.Meta{"tenant": "globex"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := session.CallTool(context.Background(), &mcp.CallToolParams{Meta: tt.meta, Name: "echo", Arguments: tt.arguments})
+			if err == nil || !strings.Contains(err.Error(), "tenant") {
+				t.Errorf("Expected a tenant error, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestTenants_ResourceReads(t *testing.T) {
+	session := newTenantServer(t)
+
+	result, err := session.ReadResource(context.Background(), &mcp.ReadResourceParams{Meta: mcp.Meta{"tenant": "acme"}, URI: "test://tenant"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if text := result.Contents[0].Text; text != "acme" {
+		t.Errorf("Expected the read to be served for acme, got: %q", text)
+	}
+}
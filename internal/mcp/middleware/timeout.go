@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Timeout bounds each tool call with a deadline so slow queries and downloads
+// are aborted instead of running indefinitely. A non-positive timeout disables it.
+// The untimed tools, such as backups and imports whose run time grows with the
+// library, get no deadline and run until they finish or the client cancels.
+func Timeout(timeout time.Duration, untimed ...string) mcp.Middleware {
+	exempt := make(map[string]bool, len(untimed))
+	for _, name := range untimed {
+		exempt[name] = true
+	}
+
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			if method != "tools/call" || timeout <= 0 {
+				return next(ctx, method, req)
+			}
+			if call, ok := req.(*mcp.CallToolRequest); ok && call.Params != nil && exempt[call.Params.Name] {
+				return next(ctx, method, req)
+			}
+
+			callCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			return next(callCtx, method, req)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestTimeout_SetsDeadlineOnToolCalls(t *testing.T) {
+	handler := Timeout(10 * time.Millisecond)(func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	if _, err := handler(context.Background(), "tools/call", nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded, got: %v", err)
+	}
+}
+
+func TestTimeout_SkipsOtherMethodsAndZeroTimeout(t *testing.T) {
+	hasDeadline := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		if _, ok := ctx.Deadline(); ok {
+			return nil, errors.New("unexpected deadline")
+		}
+		return &mcp.CallToolResult{}, nil
+	}
+
+	if _, err := Timeout(time.Second)(hasDeadline)(context.Background(), "resources/read", nil); err != nil {
+		t.Errorf("Expected non-tool methods to pass through, got: %v", err)
+	}
+	if _, err := Timeout(0)(hasDeadline)(context.Background(), "tools/call", nil); err != nil {
+		t.Errorf("Expected zero timeout to disable deadlines, got: %v", err)
+	}
+}
+
+func TestTimeout_LeavesUntimedToolsRunning(t *testing.T) {
+	run := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(50 * time.Millisecond):
+			return &mcp.CallToolResult{}, nil
+		}
+	}
+	handler := Timeout(10*time.Millisecond, "backup_database")(run)
+	call := func(name string) error {
+		_, err := handler(context.Background(), "tools/call", &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: name}})
+		return err
+	}
+
+	if err := call("backup_database"); err != nil {
+		t.Errorf("Expected the long-running tool to finish, got: %v", err)
+	}
+	if err := call("search_movies"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected other tools to keep their deadline, got: %v", err)
+	}
+}
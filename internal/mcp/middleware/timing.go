@@ -0,0 +1,133 @@
+package middleware
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// ToolStat summarizes the calls of one tool
+type ToolStat struct {
+	Tool    string
+	Calls   int
+	Errors  int // Calls that returned an error or an error result
+	Total   time.Duration
+	Slowest time.Duration
+}
+
+// Average returns the mean call duration
+func (s ToolStat) Average() time.Duration {
+	if s.Calls == 0 {
+		return 0
+	}
+	return s.Total / time.Duration(s.Calls)
+}
+
+// ToolFailure is one failed tool call
+type ToolFailure struct {
+	At      time.Time
+	Tool    string
+	Message string
+}
+
+// RecentFailureLimit is how many failed calls ToolTimings remembers
+const RecentFailureLimit = 20
+
+// ToolTimings records how long each tool's calls take and the most recent
+// failures
+type ToolTimings struct {
+	mutex    sync.Mutex
+	stats    map[string]*ToolStat
+	failures []ToolFailure // Oldest first, at most RecentFailureLimit
+}
+
+// NewToolTimings creates an empty timing recorder
+func NewToolTimings() *ToolTimings {
+	return &ToolTimings{
+		stats: make(map[string]*ToolStat),
+	}
+}
+
+// Middleware times every tool call, counting failed calls as errors
+func (t *ToolTimings) Middleware() ToolMiddleware {
+	return func(next ToolHandler) ToolHandler {
+		return func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error) {
+			start := time.Now()
+			result, err := next(ctx, call)
+			t.record(call.Name(), time.Since(start), failureMessage(result, err))
+			return result, err
+		}
+	}
+}
+
+// failureMessage describes a failed call, or returns "" for a successful one
+func failureMessage(result *mcp.CallToolResult, err error) string {
+	if err != nil {
+		return err.Error()
+	}
+	if result == nil || !result.IsError {
+		return ""
+	}
+
+	var texts []string
+	for _, content := range result.Content {
+		if text, ok := content.(*mcp.TextContent); ok {
+			texts = append(texts, text.Text)
+		}
+	}
+	if len(texts) == 0 {
+		return "error result"
+	}
+	return strings.Join(texts, "\n")
+}
+
+// record adds one call to a tool's stats; a non-empty failure marks it failed
+func (t *ToolTimings) record(tool string, duration time.Duration, failure string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	stat, exists := t.stats[tool]
+	if !exists {
+		stat = &ToolStat{Tool: tool}
+		t.stats[tool] = stat
+	}
+	stat.Calls++
+	if failure != "" {
+		stat.Errors++
+		t.failures = append(t.failures, ToolFailure{At: time.Now(), Tool: tool, Message: failure})
+		if len(t.failures) > RecentFailureLimit {
+			t.failures = t.failures[len(t.failures)-RecentFailureLimit:]
+		}
+	}
+	stat.Total += duration
+	stat.Slowest = max(stat.Slowest, duration)
+}
+
+// Snapshot returns the stats of every called tool, sorted by tool name
+func (t *ToolTimings) Snapshot() []ToolStat {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	stats := make([]ToolStat, 0, len(t.stats))
+	for _, stat := range t.stats {
+		stats = append(stats, *stat)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Tool < stats[j].Tool })
+	return stats
+}
+
+// RecentFailures returns the most recent failed calls, newest first
+func (t *ToolTimings) RecentFailures() []ToolFailure {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	failures := make([]ToolFailure, len(t.failures))
+	for i, failure := range t.failures {
+		failures[len(failures)-1-i] = failure
+	}
+	return failures
+}
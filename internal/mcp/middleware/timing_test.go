@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestToolTimings_RecordsCallsPerTool(t *testing.T) {
+	timings := NewToolTimings()
+	handler := timings.Middleware()(func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error) {
+		switch call.Name() {
+		case "slow_tool":
+			time.Sleep(5 * time.Millisecond)
+		case "failing_tool":
+			return nil, errors.New("failed")
+		case "error_result_tool":
+			return &mcp.CallToolResult{IsError: true}, nil
+		}
+		return &mcp.CallToolResult{}, nil
+	})
+
+	for _, name := range []string{"slow_tool", "slow_tool", "failing_tool", "error_result_tool"} {
+		_, _ = handler(context.Background(), &ToolCall{Request: &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: name}}})
+	}
+
+	stats := timings.Snapshot()
+	if len(stats) != 3 {
+		t.Fatalf("Expected 3 tools, got: %d", len(stats))
+	}
+	if stats[0].Tool != "error_result_tool" || stats[1].Tool != "failing_tool" || stats[2].Tool != "slow_tool" {
+		t.Errorf("Expected tools sorted by name, got: %v", stats)
+	}
+	if stats[0].Errors != 1 || stats[1].Errors != 1 {
+		t.Errorf("Expected errors and error results to count as errors, got: %v", stats)
+	}
+
+	slow := stats[2]
+	if slow.Calls != 2 || slow.Errors != 0 {
+		t.Errorf("Expected 2 clean calls, got: %d calls, %d errors", slow.Calls, slow.Errors)
+	}
+	if slow.Slowest < 5*time.Millisecond || slow.Average() > slow.Slowest {
+		t.Errorf("Expected slowest >= 5ms and average <= slowest, got: %v and %v", slow.Slowest, slow.Average())
+	}
+}
+
+func TestToolTimings_RecentFailures(t *testing.T) {
+	timings := NewToolTimings()
+	handler := timings.Middleware()(func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error) {
+		switch call.Name() {
+		case "failing_tool":
+			return nil, errors.New("database is locked")
+		case "error_result_tool":
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "movie not found"}}}, nil
+		}
+		return &mcp.CallToolResult{}, nil
+	})
+	call := func(name string) {
+		_, _ = handler(context.Background(), &ToolCall{Request: &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: name}}})
+	}
+
+	call("failing_tool")
+	call("ok_tool")
+	call("error_result_tool")
+	failures := timings.RecentFailures()
+	if len(failures) != 2 {
+		t.Fatalf("Expected 2 failures, got: %v", failures)
+	}
+	if failures[0].Tool != "error_result_tool" || failures[0].Message != "movie not found" {
+		t.Errorf("Expected the error result first with its text, got: %+v", failures[0])
+	}
+	if failures[1].Tool != "failing_tool" || failures[1].Message != "database is locked" || failures[1].At.IsZero() {
+		t.Errorf("Expected the failed call with its error, got: %+v", failures[1])
+	}
+
+	for range RecentFailureLimit {
+		call("failing_tool")
+	}
+	if failures := timings.RecentFailures(); len(failures) != RecentFailureLimit || failures[len(failures)-1].Tool != "failing_tool" {
+		t.Errorf("Expected only the last %d failures to be kept, got: %d", RecentFailureLimit, len(failures))
+	}
+}
+
+func TestToolStat_AverageWithoutCalls(t *testing.T) {
+	if got := (ToolStat{}).Average(); got != 0 {
+		t.Errorf("Expected 0, got: %v", got)
+	}
+}
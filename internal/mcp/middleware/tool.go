@@ -0,0 +1,99 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// ToolCall is a tool call as tool middleware sees it: the request and the
+// arguments the SDK has already decoded and checked against the input schema.
+// Input is read-only. Output holds the handler's typed output once the next
+// handler returns; middleware may replace it with another value of that type.
+type ToolCall struct {
+	Request *mcp.CallToolRequest
+	Input   any
+	Output  any
+}
+
+// Name returns the name of the called tool
+func (c *ToolCall) Name() string {
+	if c.Request == nil || c.Request.Params == nil {
+		return ""
+	}
+	return c.Request.Params.Name
+}
+
+// ToolHandler handles a tool call. A returned error is reported to the client
+// as a tool error, exactly as an error from the tool's own handler is.
+type ToolHandler func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error)
+
+// ToolMiddleware adds cross-cutting behaviour to every tool handler
+type ToolMiddleware func(next ToolHandler) ToolHandler
+
+// ToolRegistrar registers tools on a server with a middleware chain wrapped
+// around each handler. Unlike mcp.Middleware, which sees raw requests, tool
+// middleware runs once per call with the decoded input.
+type ToolRegistrar struct {
+	server      *mcp.Server
+	middlewares []ToolMiddleware
+	registered  int
+}
+
+// NewToolRegistrar creates a registrar for server. The first middleware is
+// the outermost, so it runs first and sees the final result.
+func NewToolRegistrar(server *mcp.Server, middlewares ...ToolMiddleware) *ToolRegistrar {
+	return &ToolRegistrar{
+		server:      server,
+		middlewares: middlewares,
+	}
+}
+
+// chain wraps a handler in the registrar's middleware
+func (r *ToolRegistrar) chain(handler ToolHandler) ToolHandler {
+	for i := len(r.middlewares) - 1; i >= 0; i-- {
+		handler = r.middlewares[i](handler)
+	}
+	return handler
+}
+
+// Registered returns how many tools have been registered through r
+func (r *ToolRegistrar) Registered() int {
+	return r.registered
+}
+
+// AddTool registers a typed tool handler wrapped in the registrar's middleware.
+// It is used in place of mcp.AddTool, which it calls. The tool is listed with
+// its version, 1 unless set by Versioned, and results of a tool marked
+// Deprecated carry its deprecation.
+func AddTool[In, Out any](r *ToolRegistrar, tool *mcp.Tool, handler mcp.ToolHandlerFor[In, Out]) {
+	if _, ok := tool.Meta[VersionKey]; !ok {
+		setToolMeta(tool, VersionKey, 1)
+	}
+	deprecation, deprecated := toolDeprecation(tool)
+	r.registered++
+
+	mcp.AddTool(r.server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input In) (*mcp.CallToolResult, Out, error) {
+		var output Out
+		call := &ToolCall{Request: req, Input: input}
+		result, err := r.chain(func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error) {
+			result, out, err := handler(ctx, call.Request, input)
+			output = out
+			call.Output = out
+			return result, err
+		})(ctx, call)
+		if out, ok := call.Output.(Out); ok {
+			output = out
+		}
+		if deprecated && err == nil {
+			if result == nil {
+				result = &mcp.CallToolResult{}
+			}
+			if result.Meta == nil {
+				result.Meta = mcp.Meta{}
+			}
+			result.Meta[DeprecationKey] = deprecation
+		}
+		return result, output, err
+	})
+}
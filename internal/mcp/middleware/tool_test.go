@@ -0,0 +1,149 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type echoInput struct {
+	Text string `json:"text"`
+}
+
+type echoOutput struct {
+	Text string `json:"text"`
+}
+
+// recordingMiddleware appends its name to calls before and after next runs
+func recordingMiddleware(name string, calls *[]string) ToolMiddleware {
+	return func(next ToolHandler) ToolHandler {
+		return func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error) {
+			*calls = append(*calls, name+" before")
+			result, err := next(ctx, call)
+			*calls = append(*calls, name+" after")
+			return result, err
+		}
+	}
+}
+
+// connectClient connects a client to server over in-memory transports
+func connectClient(t *testing.T, server *mcp.Server) *mcp.ClientSession {
+	t.Helper()
+
+	ctx := context.Background()
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	if err != nil {
+		t.Fatalf("failed to connect server: %v", err)
+	}
+	t.Cleanup(func() { serverSession.Close() })
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "1.0.0"}, nil)
+	session, err := client.Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("failed to connect client: %v", err)
+	}
+	t.Cleanup(func() { session.Close() })
+	return session
+}
+
+func TestToolRegistrar_ChainsMiddlewareInOrder(t *testing.T) {
+	var calls []string
+	registrar := NewToolRegistrar(nil, recordingMiddleware("outer", &calls), recordingMiddleware("inner", &calls))
+
+	handler := registrar.chain(func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error) {
+		calls = append(calls, "handler")
+		return &mcp.CallToolResult{}, nil
+	})
+	if _, err := handler(context.Background(), &ToolCall{}); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	want := []string{"outer before", "inner before", "handler", "inner after", "outer after"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("Expected %v, got: %v", want, calls)
+	}
+}
+
+func TestAddTool_RunsMiddlewareAroundHandler(t *testing.T) {
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil)
+	var seen *ToolCall
+	capture := func(next ToolHandler) ToolHandler {
+		return func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error) {
+			seen = call
+			return next(ctx, call)
+		}
+	}
+	registrar := NewToolRegistrar(server, capture)
+
+	AddTool(registrar, &mcp.Tool{Name: "echo"}, func(ctx context.Context, req *mcp.CallToolRequest, input echoInput) (*mcp.CallToolResult, echoOutput, error) {
+		return nil, echoOutput(input), nil
+	})
+
+	session := connectClient(t, server)
+	result, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "echo",
+		Arguments: map[string]any{"text": "hello"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %+v", result.Content)
+	}
+	if output, ok := result.StructuredContent.(map[string]any); !ok || output["text"] != "hello" {
+		t.Errorf("Expected the handler's output to reach the client, got: %v", result.StructuredContent)
+	}
+	if seen == nil || seen.Name() != "echo" || seen.Input != (echoInput{Text: "hello"}) {
+		t.Errorf("Expected middleware to see the decoded call, got: %+v", seen)
+	}
+}
+
+func TestToolRegistrar_CountsRegisteredTools(t *testing.T) {
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil)
+	registrar := NewToolRegistrar(server)
+	echo := func(ctx context.Context, req *mcp.CallToolRequest, input echoInput) (*mcp.CallToolResult, echoOutput, error) {
+		return nil, echoOutput(input), nil
+	}
+
+	AddTool(registrar, &mcp.Tool{Name: "echo"}, echo)
+	AddTool(registrar, &mcp.Tool{Name: "echo_again"}, echo)
+
+	if got := registrar.Registered(); got != 2 {
+		t.Errorf("Expected 2 registered tools, got %d", got)
+	}
+}
+
+func TestAddTool_MiddlewareErrorBecomesToolError(t *testing.T) {
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil)
+	reject := func(next ToolHandler) ToolHandler {
+		return func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error) {
+			return nil, errors.New("rejected")
+		}
+	}
+	registrar := NewToolRegistrar(server, reject)
+	handlerCalled := false
+
+	AddTool(registrar, &mcp.Tool{Name: "echo"}, func(ctx context.Context, req *mcp.CallToolRequest, input echoInput) (*mcp.CallToolResult, echoOutput, error) {
+		handlerCalled = true
+		return nil, echoOutput(input), nil
+	})
+
+	session := connectClient(t, server)
+	result, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "echo",
+		Arguments: map[string]any{"text": "hello"},
+	})
+	if err != nil {
+		t.Fatalf("Expected a tool error rather than a protocol error, got: %v", err)
+	}
+	if !result.IsError {
+		t.Error("Expected an error result")
+	}
+	if handlerCalled {
+		t.Error("Expected the handler not to run")
+	}
+}
@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Validator is implemented by tool inputs with rules the input schema cannot
+// express, such as ranges or fields required only in some combinations
+type Validator interface {
+	Validate() error
+}
+
+// Validate rejects calls whose input fails its Validate method before the
+// handler runs. Inputs that are not Validators pass through.
+func Validate() ToolMiddleware {
+	return func(next ToolHandler) ToolHandler {
+		return func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error) {
+			if validator, ok := call.Input.(Validator); ok {
+				if err := validator.Validate(); err != nil {
+					return nil, err
+				}
+			}
+			return next(ctx, call)
+		}
+	}
+}
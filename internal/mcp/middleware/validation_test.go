@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type rangeInput struct {
+	Min, Max int
+}
+
+func (in rangeInput) Validate() error {
+	if in.Min > in.Max {
+		return errors.New("min cannot be greater than max")
+	}
+	return nil
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      any
+		wantErr    bool
+		wantCalled bool
+	}{
+		{name: "valid input", input: rangeInput{Min: 1, Max: 2}, wantCalled: true},
+		{name: "invalid input", input: rangeInput{Min: 3, Max: 2}, wantErr: true},
+		{name: "not a validator", input: echoInput{}, wantCalled: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handler := Validate()(func(ctx context.Context, call *ToolCall) (*mcp.CallToolResult, error) {
+				called = true
+				return &mcp.CallToolResult{}, nil
+			})
+
+			_, err := handler(context.Background(), &ToolCall{Input: tt.input})
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Expected error %v, got: %v", tt.wantErr, err)
+			}
+			if called != tt.wantCalled {
+				t.Errorf("Expected handler called %v, got: %v", tt.wantCalled, called)
+			}
+		})
+	}
+}
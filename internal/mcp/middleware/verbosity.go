@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// VerbosityKey names the verbosity of a tool call, either as an argument or
+// in the _meta of the tools/call request
+const VerbosityKey = "verbosity"
+
+// Verbosity is what the text content of a tool result carries. The
+// structured content always holds the whole output.
+type Verbosity string
+
+const (
+	// VerbosityBoth carries the output as JSON followed by a text summary
+	VerbosityBoth Verbosity = "both"
+	// VerbosityJSON carries only the output as compact JSON
+	VerbosityJSON Verbosity = "json"
+	// VerbositySummary carries only the text summary, or the JSON for tools
+	// that write none
+	VerbositySummary Verbosity = "summary"
+)
+
+// ParseVerbosity parses a verbosity name; empty is VerbosityBoth
+func ParseVerbosity(name string) (Verbosity, error) {
+	switch verbosity := Verbosity(strings.ToLower(strings.TrimSpace(name))); verbosity {
+	case "":
+		return VerbosityBoth, nil
+	case VerbosityBoth, VerbosityJSON, VerbositySummary:
+		return verbosity, nil
+	default:
+		return "", shared.NewValidationError("%s %q is not one of both, json or summary", VerbosityKey, name)
+	}
+}
+
+// Verbosities sets the verbosity of each tool call: the one it names, or
+// fallback. The "verbosity" argument is removed before the tool decodes its
+// input, so no tool needs it in its schema; an invalid verbosity fails the
+// call.
+func Verbosities(fallback Verbosity) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			call, ok := req.(*mcp.CallToolRequest)
+			if method != "tools/call" || !ok || call.Params == nil {
+				return next(ctx, method, req)
+			}
+
+			name, err := requestString(req, VerbosityKey)
+			if err != nil {
+				return nil, mapError(call.Params.Name, err)
+			}
+			verbosity := fallback
+			if name != "" {
+				if verbosity, err = ParseVerbosity(name); err != nil {
+					return nil, mapError(call.Params.Name, err)
+				}
+			}
+			return next(WithVerbosity(ctx, verbosity), method, req)
+		}
+	}
+}
+
+type verbosityKey struct{}
+
+// WithVerbosity returns a context whose tool results have verbosity
+func WithVerbosity(ctx context.Context, verbosity Verbosity) context.Context {
+	return context.WithValue(ctx, verbosityKey{}, verbosity)
+}
+
+// VerbosityFromContext returns the verbosity of ctx, VerbosityBoth if unset
+func VerbosityFromContext(ctx context.Context) Verbosity {
+	if verbosity, ok := ctx.Value(verbosityKey{}).(Verbosity); ok {
+		return verbosity
+	}
+	return VerbosityBoth
+}
+
+// SummaryContent returns the text content of a result with output and a
+// summary of it, as the verbosity of ctx asks for
+func SummaryContent(ctx context.Context, output any, summary string) ([]mcp.Content, error) {
+	if VerbosityFromContext(ctx) == VerbositySummary {
+		return []mcp.Content{&mcp.TextContent{Text: summary}}, nil
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode output: %w", err)
+	}
+	content := []mcp.Content{&mcp.TextContent{Text: string(data)}}
+	if VerbosityFromContext(ctx) == VerbosityBoth {
+		content = append(content, &mcp.TextContent{Text: summary})
+	}
+	return content, nil
+}
@@ -0,0 +1,101 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestParseVerbosity(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    Verbosity
+		wantErr bool
+	}{
+		{name: "", want: VerbosityBoth},
+		{name: "both", want: VerbosityBoth},
+		{name: " JSON ", want: VerbosityJSON},
+		{name: "summary", want: VerbositySummary},
+		{name: "chatty", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseVerbosity(tt.name)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseVerbosity(%q) = %q, %v; want %q, error %v", tt.name, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func newVerbosityServer(t *testing.T, fallback Verbosity) *mcp.ClientSession {
+	t.Helper()
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil)
+	server.AddReceivingMiddleware(Verbosities(fallback))
+	mcp.AddTool(server, &mcp.Tool{Name: "echo"}, func(ctx context.Context, req *mcp.CallToolRequest, input echoInput) (*mcp.CallToolResult, echoOutput, error) {
+		output := echoOutput(input)
+		content, err := SummaryContent(ctx, output, "Echoed "+input.Text)
+		if err != nil {
+			return nil, echoOutput{}, err
+		}
+		return &mcp.CallToolResult{Content: content}, output, nil
+	})
+	return connectClient(t, server)
+}
+
+func TestVerbosities_ShapesContent(t *testing.T) {
+	tests := []struct {
+		name      string
+		fallback  Verbosity
+		arguments map[string]any
+		meta      mcp.Meta
+		want      []string
+	}{
+		{name: "server default", fallback: VerbosityBoth, arguments: map[string]any{"text": "hi"}, want: []string{`{"text":"hi"}`, "Echoed hi"}},
+		{name: "server json", fallback: VerbosityJSON, arguments: map[string]any{"text": "hi"}, want: []string{`{"text":"hi"}`}},
+		{name: "argument", fallback: VerbosityBoth, arguments: map[string]any{"text": "hi", "verbosity": "summary"}, want: []string{"Echoed hi"}},
+		{name: "meta", fallback: VerbositySummary, arguments: map[string]any{"text": "hi"}, meta: mcp.Meta{"verbosity": "json"}, want: []string{`{"text":"hi"}`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := newVerbosityServer(t, tt.fallback)
+			result, err := session.CallTool(context.Background(), &mcp.CallToolParams{Meta: tt.meta, Name: "echo", Arguments: tt.arguments})
+			if err != nil || result.IsError {
+				t.Fatalf("Expected a successful call, got: %v, %+v", err, result)
+			}
+			if len(result.Content) != len(tt.want) {
+				t.Fatalf("Expected %d content blocks, got: %d", len(tt.want), len(result.Content))
+			}
+			for i, want := range tt.want {
+				if text := result.Content[i].(*mcp.TextContent).Text; text != want {
+					t.Errorf("Expected content %d to be %q, got: %q", i, want, text)
+				}
+			}
+			if text := result.StructuredContent.(map[string]any)["text"]; text != "hi" {
+				t.Errorf("Expected the structured content whatever the verbosity, got: %v", result.StructuredContent)
+			}
+		})
+	}
+}
+
+func TestVerbosities_RejectsInvalidVerbosity(t *testing.T) {
+	session := newVerbosityServer(t, VerbosityBoth)
+
+	tests := []struct {
+		name      string
+		arguments map[string]any
+		meta      mcp.Meta
+	}{
+		{name: "unknown", arguments: map[string]any{"text": "hi", "verbosity": "chatty"}},
+		{name: "not a string", arguments: map[string]any{"text": "hi", "verbosity": 2}},
+		{name: "conflict", arguments: map[string]any{"text": "hi", "verbosity": "json"}, meta: mcp.Meta{"verbosity": "summary"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := session.CallTool(context.Background(), &mcp.CallToolParams{Meta: tt.meta, Name: "echo", Arguments: tt.arguments}); err == nil {
+				t.Error("Expected the call to fail")
+			}
+		})
+	}
+}
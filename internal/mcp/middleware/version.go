@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	// VersionKey names a tool's version in its _meta in tools/list
+	VersionKey = "version"
+	// DeprecationKey names a deprecated tool's Deprecation in its _meta in
+	// tools/list and in the _meta of every result it returns
+	DeprecationKey = "deprecation"
+)
+
+// Deprecation tells clients a tool is going away and what to call instead
+type Deprecation struct {
+	Replacement string `json:"replacement,omitempty"` // Name of the tool to call instead
+	Message     string `json:"message"`
+}
+
+// VersionedName returns the name a tool is registered under at version: its
+// base name at version 1 and the base name with a _v<version> suffix after
+// that, so every version of a tool can be registered side by side
+func VersionedName(name string, version int) string {
+	if version <= 1 {
+		return name
+	}
+	return fmt.Sprintf("%s_v%d", name, version)
+}
+
+// Versioned makes tool, whose Name is the tool's base name, the given version
+// of that tool. Tools registered without a version are version 1.
+func Versioned(tool *mcp.Tool, version int) *mcp.Tool {
+	tool.Name = VersionedName(tool.Name, version)
+	setToolMeta(tool, VersionKey, version)
+	return tool
+}
+
+// Deprecated marks tool as deprecated, announcing it at the start of its
+// description. The tool keeps working; its results carry the deprecation.
+func Deprecated(tool *mcp.Tool, deprecation Deprecation) *mcp.Tool {
+	notice := "Deprecated: " + deprecation.Message
+	if deprecation.Replacement != "" {
+		notice += "; use " + deprecation.Replacement
+	}
+	tool.Description = notice + ". " + tool.Description
+	setToolMeta(tool, DeprecationKey, deprecation)
+	return tool
+}
+
+// toolDeprecation returns the deprecation of a registered tool, if any
+func toolDeprecation(tool *mcp.Tool) (Deprecation, bool) {
+	deprecation, ok := tool.Meta[DeprecationKey].(Deprecation)
+	return deprecation, ok
+}
+
+// setToolMeta sets a key of tool's _meta
+func setToolMeta(tool *mcp.Tool, key string, value any) {
+	if tool.Meta == nil {
+		tool.Meta = mcp.Meta{}
+	}
+	tool.Meta[key] = value
+}
@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestVersionedName(t *testing.T) {
+	if got := VersionedName("search_movies", 1); got != "search_movies" {
+		t.Errorf("Expected version 1 to keep the base name, got: %s", got)
+	}
+	if got := VersionedName("search_movies", 2); got != "search_movies_v2" {
+		t.Errorf("Expected search_movies_v2, got: %s", got)
+	}
+}
+
+func TestAddTool_ListsVersionsAndDeprecations(t *testing.T) {
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil)
+	registrar := NewToolRegistrar(server)
+	echo := func(ctx context.Context, req *mcp.CallToolRequest, input echoInput) (*mcp.CallToolResult, echoOutput, error) {
+		return nil, echoOutput(input), nil
+	}
+
+	AddTool(registrar, Deprecated(&mcp.Tool{Name: "echo", Description: "Echo text"}, Deprecation{
+		Replacement: "echo_v2",
+		Message:     "echo is replaced",
+	}), echo)
+	AddTool(registrar, Versioned(&mcp.Tool{Name: "echo", Description: "Echo text again"}, 2), echo)
+
+	session := connectClient(t, server)
+	ctx := context.Background()
+	listed, err := session.ListTools(ctx, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	tools := make(map[string]*mcp.Tool)
+	for _, tool := range listed.Tools {
+		tools[tool.Name] = tool
+	}
+
+	v1, v2 := tools["echo"], tools["echo_v2"]
+	if v1 == nil || v2 == nil {
+		t.Fatalf("Expected echo and echo_v2 to be listed, got: %v", tools)
+	}
+	if v1.Meta[VersionKey] != float64(1) || v2.Meta[VersionKey] != float64(2) {
+		t.Errorf("Expected versions 1 and 2, got: %v and %v", v1.Meta[VersionKey], v2.Meta[VersionKey])
+	}
+	if deprecation, ok := v1.Meta[DeprecationKey].(map[string]any); !ok || deprecation["replacement"] != "echo_v2" {
+		t.Errorf("Expected echo to be deprecated for echo_v2, got: %v", v1.Meta[DeprecationKey])
+	}
+	if !strings.HasPrefix(v1.Description, "Deprecated: echo is replaced; use echo_v2. ") {
+		t.Errorf("Expected the description to announce the deprecation, got: %q", v1.Description)
+	}
+	if _, ok := v2.Meta[DeprecationKey]; ok {
+		t.Error("Expected echo_v2 not to be deprecated")
+	}
+
+	for name, deprecated := range map[string]bool{"echo": true, "echo_v2": false} {
+		result, err := session.CallTool(ctx, &mcp.CallToolParams{Name: name, Arguments: map[string]any{"text": "hi"}})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if _, ok := result.Meta[DeprecationKey]; ok != deprecated {
+			t.Errorf("Expected %s results to carry a deprecation: %v, got _meta %v", name, deprecated, result.Meta)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+// Package recording captures MCP sessions for replay. A Recorder wraps the
+// server's transport and appends every JSON-RPC message it reads or writes
+// to a JSON lines file, one Entry per line, so cmd/replay can re-send the
+// client's messages to another server build and compare the responses.
+package recording
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/jsonrpc"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Direction says which side of a session sent a message
+type Direction string
+
+const (
+	// FromClient marks requests and notifications the server received
+	FromClient Direction = "client"
+
+	// FromServer marks responses and notifications the server sent
+	FromServer Direction = "server"
+)
+
+// Entry is one recorded JSON-RPC message
+type Entry struct {
+	Direction Direction       `json:"direction"`
+	ElapsedMS int64           `json:"elapsed_ms"` // Since the recording started
+	Message   json.RawMessage `json:"message"`    // The message as sent on the wire
+}
+
+// Decode parses the entry's message
+func (e Entry) Decode() (jsonrpc.Message, error) {
+	return jsonrpc.DecodeMessage(e.Message)
+}
+
+// Recorder appends the messages of a session to a writer. It is safe for
+// concurrent use; each entry is written whole, so a recording cut short by
+// a crash still ends on a complete line.
+type Recorder struct {
+	mutex   sync.Mutex
+	out     io.Writer
+	closer  io.Closer
+	started time.Time
+	err     error // First write error; later entries are dropped
+}
+
+// NewRecorder creates a recorder writing entries to out
+func NewRecorder(out io.Writer) *Recorder {
+	return &Recorder{out: out, started: time.Now()}
+}
+
+// Create creates or truncates the file at path and records to it
+func Create(path string) (*Recorder, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create recording: %w", err)
+	}
+	recorder := NewRecorder(file)
+	recorder.closer = file
+	return recorder, nil
+}
+
+// Transport wraps transport so that every message on its connection is
+// recorded
+func (r *Recorder) Transport(transport mcp.Transport) mcp.Transport {
+	return &recordingTransport{transport: transport, recorder: r}
+}
+
+// Err returns the first error writing an entry, if any
+func (r *Recorder) Err() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.err
+}
+
+// Close closes the file a recorder from Create writes to and returns the
+// first write error, if any
+func (r *Recorder) Close() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.closer != nil {
+		if err := r.closer.Close(); err != nil && r.err == nil {
+			r.err = err
+		}
+		r.closer = nil
+	}
+	return r.err
+}
+
+// record appends a message. Encoding failures are kept for Err rather than
+// returned, so a full disk never breaks the session being recorded.
+func (r *Recorder) record(direction Direction, msg jsonrpc.Message) {
+	data, err := jsonrpc.EncodeMessage(msg)
+	if err == nil {
+		data, err = json.Marshal(Entry{
+			Direction: direction,
+			ElapsedMS: time.Since(r.started).Milliseconds(),
+			Message:   data,
+		})
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.err != nil {
+		return
+	}
+	if err == nil {
+		_, err = r.out.Write(append(data, '\n'))
+	}
+	if err != nil {
+		r.err = fmt.Errorf("failed to record message: %w", err)
+	}
+}
+
+// recordingTransport connects through another transport and records the
+// connection
+type recordingTransport struct {
+	transport mcp.Transport
+	recorder  *Recorder
+}
+
+// Connect implements mcp.Transport
+func (t *recordingTransport) Connect(ctx context.Context) (mcp.Connection, error) {
+	conn, err := t.transport.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &recordingConnection{Connection: conn, recorder: t.recorder}, nil
+}
+
+// recordingConnection records each message read from or written to the
+// connection it embeds
+type recordingConnection struct {
+	mcp.Connection
+	recorder *Recorder
+}
+
+// Read implements mcp.Connection
+func (c *recordingConnection) Read(ctx context.Context) (jsonrpc.Message, error) {
+	msg, err := c.Connection.Read(ctx)
+	if err == nil {
+		c.recorder.record(FromClient, msg)
+	}
+	return msg, err
+}
+
+// Write implements mcp.Connection
+func (c *recordingConnection) Write(ctx context.Context, msg jsonrpc.Message) error {
+	err := c.Connection.Write(ctx, msg)
+	if err == nil {
+		c.recorder.record(FromServer, msg)
+	}
+	return err
+}
+
+// Read parses a recording. Blank lines are skipped.
+func Read(in io.Reader) ([]Entry, error) {
+	var entries []Entry
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024) // Responses can be large
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("line %d: invalid entry: %w", line, err)
+		}
+		if entry.Direction != FromClient && entry.Direction != FromServer {
+			return nil, fmt.Errorf("line %d: unknown direction %q", line, entry.Direction)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read recording: %w", err)
+	}
+	return entries, nil
+}
+
+// ReadFile parses the recording at path
+func ReadFile(path string) ([]Entry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open recording: %w", err)
+	}
+	defer file.Close()
+
+	return Read(file)
+}
@@ -0,0 +1,107 @@
+package recording
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/jsonrpc"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/pkg/mcptest"
+)
+
+type echoInput struct {
+	Text string `json:"text"`
+}
+
+type echoOutput struct {
+	Text string `json:"text"`
+}
+
+func TestRecorder_RecordsEveryMessage(t *testing.T) {
+	ctx := context.Background()
+	var out bytes.Buffer
+	recorder := NewRecorder(&out)
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "1.0.0"}, nil)
+	mcp.AddTool(server, &mcp.Tool{Name: "echo"}, func(ctx context.Context, req *mcp.CallToolRequest, input echoInput) (*mcp.CallToolResult, echoOutput, error) {
+		return nil, echoOutput(input), nil
+	})
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	session, err := server.Connect(ctx, recorder.Transport(serverTransport), nil)
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	client, err := mcptest.Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("mcptest.Connect() error = %v", err)
+	}
+	if _, err := client.CallTool(ctx, "echo", map[string]any{"text": "hello"}); err != nil {
+		t.Fatalf("CallTool() error = %v", err)
+	}
+	client.Close()
+	session.Wait()
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	entries, err := Read(&out)
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+
+	// initialize and its response, the initialized notification, then the
+	// tool call and its response
+	var methods []string
+	responses := 0
+	for _, entry := range entries {
+		msg, err := entry.Decode()
+		if err != nil {
+			t.Fatalf("Decode() error = %v", err)
+		}
+		switch msg := msg.(type) {
+		case *jsonrpc.Request:
+			if entry.Direction != FromClient {
+				t.Errorf("request %s recorded as from %s", msg.Method, entry.Direction)
+			}
+			methods = append(methods, msg.Method)
+		case *jsonrpc.Response:
+			if entry.Direction != FromServer {
+				t.Errorf("response recorded as from %s", entry.Direction)
+			}
+			responses++
+		}
+	}
+	if got := strings.Join(methods, ","); got != "initialize,notifications/initialized,tools/call" {
+		t.Errorf("recorded requests = %s", got)
+	}
+	if responses != 2 {
+		t.Errorf("recorded %d responses, want 2", responses)
+	}
+	last := entries[len(entries)-1]
+	if !strings.Contains(string(last.Message), `"hello"`) {
+		t.Errorf("last entry = %s, want the echo response", last.Message)
+	}
+}
+
+func TestRead_RejectsInvalidEntries(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{name: "not JSON", input: "{", wantErr: "line 1"},
+		{name: "unknown direction", input: "\n" + `{"direction":"sideways","message":{}}`, wantErr: "line 2: unknown direction"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Read(strings.NewReader(tt.input))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Read() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	posterApp "github.com/francknouama/movies-mcp-server/internal/application/poster"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// actorPhotosURI is the collection of stored actor photos; each photo is
+// served under it by actor ID
+const actorPhotosURI = "movies://actors/photos"
+
+// ActorPhotoReader provides the stored actor photos
+type ActorPhotoReader interface {
+	ListPhotos(ctx context.Context) ([]*posterApp.PhotoDTO, error)
+	GetPhoto(ctx context.Context, actorID int) (*posterApp.PhotoImageDTO, error)
+}
+
+// ActorPhotoResources handles the actor photo resources
+type ActorPhotoResources struct {
+	photos ActorPhotoReader
+}
+
+// NewActorPhotoResources creates an actor photo resource handler
+func NewActorPhotoResources(photos ActorPhotoReader) *ActorPhotoResources {
+	return &ActorPhotoResources{photos: photos}
+}
+
+// PhotoCollectionResource returns the actor photos collection resource definition
+func (ar *ActorPhotoResources) PhotoCollectionResource() *mcp.Resource {
+	return &mcp.Resource{
+		URI:         actorPhotosURI,
+		Name:        "Actor Photos Collection",
+		Description: "Actors with a stored photo, with the type, size and URI of each photo",
+		MIMEType:    "application/json",
+	}
+}
+
+// PhotoTemplate returns the template of a single actor's photo
+func (ar *ActorPhotoResources) PhotoTemplate() *mcp.ResourceTemplate {
+	return &mcp.ResourceTemplate{
+		URITemplate: actorPhotosURI + "/{id}",
+		Name:        "Actor Photo",
+		Description: "An actor's stored photo image",
+	}
+}
+
+// HandlePhotoCollection handles the movies://actors/photos resource request
+func (ar *ActorPhotoResources) HandlePhotoCollection(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	photoDTOs, err := ar.photos.ListPhotos(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch actor photos: %w", err)
+	}
+
+	photos := make([]map[string]interface{}, 0, len(photoDTOs))
+	for _, photo := range photoDTOs {
+		photos = append(photos, map[string]interface{}{
+			"actor_id":  photo.ActorID,
+			"name":      photo.Name,
+			"mime_type": photo.MimeType,
+			"size":      photo.Size,
+			"uri":       fmt.Sprintf("%s/%d", actorPhotosURI, photo.ActorID),
+		})
+	}
+
+	collection := map[string]interface{}{
+		"total":  len(photos),
+		"photos": photos,
+	}
+
+	collectionJSON, err := json.MarshalIndent(collection, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal actor photos to JSON: %w", err)
+	}
+
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{
+			{
+				URI:      actorPhotosURI,
+				MIMEType: "application/json",
+				Text:     string(collectionJSON),
+			},
+		},
+	}, nil
+}
+
+// HandlePhoto handles a movies://actors/photos/{id} resource request,
+// returning the photo image as a blob; actors without a stored photo are
+// not found
+func (ar *ActorPhotoResources) HandlePhoto(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	uri := req.Params.URI
+	rawID, ok := strings.CutPrefix(uri, actorPhotosURI+"/")
+	actorID, err := strconv.Atoi(rawID)
+	if !ok || err != nil || actorID <= 0 {
+		return nil, mcp.ResourceNotFoundError(uri)
+	}
+
+	photo, err := ar.photos.GetPhoto(ctx, actorID)
+	if errors.Is(err, shared.ErrNotFound) {
+		return nil, mcp.ResourceNotFoundError(uri)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read actor photo: %w", err)
+	}
+
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{
+			{
+				URI:      uri,
+				MIMEType: photo.MimeType,
+				Blob:     photo.Data,
+			},
+		},
+	}, nil
+}
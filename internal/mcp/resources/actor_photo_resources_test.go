@@ -0,0 +1,75 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	posterApp "github.com/francknouama/movies-mcp-server/internal/application/poster"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// fakeActorPhotos serves one PNG photo for actor 7
+type fakeActorPhotos struct{}
+
+var fakePhotoData = []byte{0x89, 'P', 'N', 'G'}
+
+func (f *fakeActorPhotos) ListPhotos(ctx context.Context) ([]*posterApp.PhotoDTO, error) {
+	return []*posterApp.PhotoDTO{{ActorID: 7, Name: "Keanu Reeves", MimeType: "image/png", Size: len(fakePhotoData)}}, nil
+}
+
+func (f *fakeActorPhotos) GetPhoto(ctx context.Context, actorID int) (*posterApp.PhotoImageDTO, error) {
+	if actorID != 7 {
+		return nil, shared.NewNotFoundError("actor photo not found")
+	}
+	return &posterApp.PhotoImageDTO{
+		PhotoDTO: posterApp.PhotoDTO{ActorID: 7, Name: "Keanu Reeves", MimeType: "image/png", Size: len(fakePhotoData)},
+		Data:     fakePhotoData,
+	}, nil
+}
+
+func TestHandlePhotoCollection(t *testing.T) {
+	ar := NewActorPhotoResources(&fakeActorPhotos{})
+
+	result, err := ar.HandlePhotoCollection(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("HandlePhotoCollection() error = %v", err)
+	}
+	var collection map[string]interface{}
+	if err := json.Unmarshal([]byte(result.Contents[0].Text), &collection); err != nil {
+		t.Fatalf("Failed to unmarshal JSON: %v", err)
+	}
+
+	photos := collection["photos"].([]interface{})
+	if collection["total"].(float64) != 1 || len(photos) != 1 {
+		t.Fatalf("Expected 1 photo, got: %v", collection)
+	}
+	if photo := photos[0].(map[string]interface{}); photo["uri"] != "movies://actors/photos/7" || photo["mime_type"] != "image/png" {
+		t.Errorf("Expected the photo's URI and type, got: %v", photo)
+	}
+}
+
+func TestHandlePhoto(t *testing.T) {
+	ar := NewActorPhotoResources(&fakeActorPhotos{})
+	read := func(uri string) (*mcp.ReadResourceResult, error) {
+		return ar.HandlePhoto(context.Background(), &mcp.ReadResourceRequest{Params: &mcp.ReadResourceParams{URI: uri}})
+	}
+
+	result, err := read("movies://actors/photos/7")
+	if err != nil {
+		t.Fatalf("HandlePhoto() error = %v", err)
+	}
+	if contents := result.Contents[0]; !bytes.Equal(contents.Blob, fakePhotoData) || contents.MIMEType != "image/png" {
+		t.Errorf("Expected the PNG as a blob, got: %x (%s)", contents.Blob, contents.MIMEType)
+	}
+
+	for _, uri := range []string{"movies://actors/photos/8", "movies://actors/photos/keanu", "movies://actors/photos/0"} {
+		if _, err := read(uri); err == nil || !strings.Contains(err.Error(), "Resource not found") {
+			t.Errorf("Expected a resource not found error for %s, got: %v", uri, err)
+		}
+	}
+}
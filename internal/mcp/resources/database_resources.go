@@ -4,34 +4,73 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 
 	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/tag"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+	"github.com/francknouama/movies-mcp-server/pkg/serialization"
 )
 
 // DatabaseResources handles movie database resource operations
 type DatabaseResources struct {
 	movieService *movieApp.Service
+	stats        movie.StatsReader
+	tags         tag.CountReader
+	maxPageSize  int
 }
 
 // NewDatabaseResources creates a new database resources handler
 func NewDatabaseResources(movieService *movieApp.Service) *DatabaseResources {
 	return &DatabaseResources{
 		movieService: movieService,
+		maxPageSize:  DefaultMaxPageSize,
 	}
 }
 
+// SetMaxPageSize caps the movies in one page of movies://database/all; a
+// non-positive size keeps DefaultMaxPageSize
+func (dr *DatabaseResources) SetMaxPageSize(size int) {
+	if size <= 0 {
+		size = DefaultMaxPageSize
+	}
+	dr.maxPageSize = size
+}
+
+// SetStatsReader serves movies://database/stats from precomputed aggregates
+// instead of reading every movie
+func (dr *DatabaseResources) SetStatsReader(stats movie.StatsReader) {
+	dr.stats = stats
+}
+
+// SetTagCounts adds a cloud of the most used tags to movies://database/stats
+func (dr *DatabaseResources) SetTagCounts(tags tag.CountReader) {
+	dr.tags = tags
+}
+
 // AllMoviesResource returns the complete movie database resource definition
 func (dr *DatabaseResources) AllMoviesResource() *mcp.Resource {
 	return &mcp.Resource{
-		URI:         "movies://database/all",
+		URI:         allMoviesURI,
 		Name:        "All Movies",
-		Description: "Complete movie database in JSON format",
+		Description: fmt.Sprintf("Complete movie database in JSON format, %d movies per page; follow next for the rest", dr.maxPageSize),
 		MIMEType:    "application/json",
 	}
 }
 
+// AllMoviesTemplate returns the paged form of movies://database/all
+func (dr *DatabaseResources) AllMoviesTemplate() *mcp.ResourceTemplate {
+	return &mcp.ResourceTemplate{
+		URITemplate: allMoviesURI + "{?offset,limit,format,fields}",
+		Name:        "All Movies (paged)",
+		Description: fmt.Sprintf("A page of the movie database in ID order: skip offset movies, return up to limit (at most %d), as json or ndjson, keeping only the comma-separated fields if given", dr.maxPageSize),
+	}
+}
+
 // DatabaseStatsResource returns the database statistics resource definition
 func (dr *DatabaseResources) DatabaseStatsResource() *mcp.Resource {
 	return &mcp.Resource{
@@ -42,6 +81,26 @@ func (dr *DatabaseResources) DatabaseStatsResource() *mcp.Resource {
 	}
 }
 
+// GenresResource returns the genre breakdown resource definition
+func (dr *DatabaseResources) GenresResource() *mcp.Resource {
+	return &mcp.Resource{
+		URI:         "movies://database/genres",
+		Name:        "Movie Genres",
+		Description: "Every genre in the library with its movie count, by name",
+		MIMEType:    "application/json",
+	}
+}
+
+// DirectorsResource returns the director breakdown resource definition
+func (dr *DatabaseResources) DirectorsResource() *mcp.Resource {
+	return &mcp.Resource{
+		URI:         "movies://database/directors",
+		Name:        "Movie Directors",
+		Description: "Every director in the library with their movie count and average rating, most movies first",
+		MIMEType:    "application/json",
+	}
+}
+
 // PosterCollectionResource returns the movie posters collection resource definition
 func (dr *DatabaseResources) PosterCollectionResource() *mcp.Resource {
 	return &mcp.Resource{
@@ -52,93 +111,135 @@ func (dr *DatabaseResources) PosterCollectionResource() *mcp.Resource {
 	}
 }
 
-// HandleAllMovies handles the movies://database/all resource request
+// HandleAllMovies handles movies://database/all and its paged form from
+// AllMoviesTemplate, returning one page of movies in ID order. Pages hold at
+// most the maximum page size, and each page but the last links to the next.
 func (dr *DatabaseResources) HandleAllMovies(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
-	// Fetch all movies from the database
-	movies, err := dr.movieService.SearchMovies(ctx, movieApp.SearchMoviesQuery{
-		// Empty query returns all movies
-	})
+	uri := allMoviesURI
+	if req != nil && req.Params != nil && req.Params.URI != "" {
+		uri = req.Params.URI
+	}
+	page, err := dr.parseMoviePage(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := dr.movieService.CountMovies(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch all movies: %w", err)
 	}
 
-	// Convert movies to JSON
-	moviesJSON, err := json.MarshalIndent(map[string]interface{}{
-		"total_movies": len(movies),
-		"movies":       movies,
-	}, "", "  ")
+	// Movies are encoded into the page text as they are read, so a page
+	// holds no more than a batch of them in memory at once
+	var text strings.Builder
+	var count int
+	mimeType := "application/json"
+	if page.Format == formatNDJSON {
+		mimeType = "application/x-ndjson"
+		encoder := json.NewEncoder(&text)
+		count, err = dr.eachMovie(ctx, page.Offset, page.Limit, func(movie *movieApp.MovieDTO) error {
+			selected, err := page.selectFields(movie)
+			if err != nil {
+				return err
+			}
+			return encoder.Encode(selected)
+		})
+	} else {
+		fmt.Fprintf(&text, "{\n  \"total_movies\": %d,\n  \"offset\": %d,\n  \"limit\": %d,\n  \"movies\": ", total, page.Offset, page.Limit)
+		movies := newJSONArrayWriter(&text, 2)
+		count, err = dr.eachMovie(ctx, page.Offset, page.Limit, func(movie *movieApp.MovieDTO) error {
+			selected, err := page.selectFields(movie)
+			if err != nil {
+				return err
+			}
+			return movies.write(selected)
+		})
+		movies.close()
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal movies to JSON: %w", err)
+		return nil, fmt.Errorf("failed to fetch all movies: %w", err)
+	}
+
+	next := ""
+	if count > 0 && page.Offset+count < total {
+		next = page.next(count)
+	}
+	meta := mcp.Meta{
+		"total_movies": total,
+		"offset":       page.Offset,
+		"limit":        page.Limit,
+	}
+	if next != "" {
+		meta["next"] = next
+	}
+
+	if page.Format != formatNDJSON {
+		fmt.Fprintf(&text, ",\n  \"count\": %d", count)
+		if next != "" {
+			link, _ := json.Marshal(next)
+			fmt.Fprintf(&text, ",\n  \"next\": %s", link)
+		}
+		text.WriteString("\n}")
 	}
 
 	return &mcp.ReadResourceResult{
+		Meta: meta,
 		Contents: []*mcp.ResourceContents{
 			{
-				URI:      "movies://database/all",
-				MIMEType: "application/json",
-				Text:     string(moviesJSON),
+				URI:      uri,
+				MIMEType: mimeType,
+				Text:     text.String(),
 			},
 		},
 	}, nil
 }
 
+// topDirectorCount is how many directors movies://database/stats lists
+const topDirectorCount = 10
+
+// tagCloudSize is how many tags the movies://database/stats tag cloud lists,
+// and maxTagWeight the weight of the most used one
+const (
+	tagCloudSize = 50
+	maxTagWeight = 5
+)
+
 // HandleDatabaseStats handles the movies://database/stats resource request
 func (dr *DatabaseResources) HandleDatabaseStats(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
-	// Fetch all movies to compute statistics
-	movies, err := dr.movieService.SearchMovies(ctx, movieApp.SearchMoviesQuery{})
+	library, directors, err := dr.libraryStats(ctx, topDirectorCount)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch movies for stats: %w", err)
+		return nil, err
 	}
 
-	// Compute statistics
-	totalMovies := len(movies)
-	genreSet := make(map[string]bool)
-	var earliestYear, latestYear *int
-	var totalRating float64
-	ratingCount := 0
-
-	for _, movie := range movies {
-		// Collect genres
-		for _, genre := range movie.Genres {
-			genreSet[genre] = true
-		}
-
-		// Track year range
-		if earliestYear == nil || movie.Year < *earliestYear {
-			earliestYear = &movie.Year
-		}
-		if latestYear == nil || movie.Year > *latestYear {
-			latestYear = &movie.Year
-		}
+	genres := serialization.SortedKeys(library.Genres)
 
-		// Track ratings
-		if movie.Rating > 0 {
-			totalRating += movie.Rating
-			ratingCount++
-		}
-	}
-
-	// Convert genre set to slice
-	genres := make([]string, 0, len(genreSet))
-	for genre := range genreSet {
-		genres = append(genres, genre)
-	}
-
-	// Calculate average rating
-	var avgRating float64
-	if ratingCount > 0 {
-		avgRating = totalRating / float64(ratingCount)
+	var earliestYear, latestYear *int
+	if library.TotalMovies > 0 {
+		earliestYear, latestYear = &library.EarliestYear, &library.LatestYear
 	}
 
 	stats := map[string]interface{}{
-		"total_movies":   totalMovies,
+		"total_movies":   library.TotalMovies,
 		"total_genres":   len(genres),
 		"genres":         genres,
-		"average_rating": fmt.Sprintf("%.1f", avgRating),
+		"genre_counts":   library.Genres,
+		"average_rating": fmt.Sprintf("%.1f", library.AverageRating),
 		"year_range": map[string]interface{}{
 			"earliest": earliestYear,
 			"latest":   latestYear,
 		},
+		"certifications": library.Certifications,
+		"top_directors":  directorReport(directors),
+	}
+	if dr.tags != nil {
+		cloud, err := dr.tagCloud(ctx)
+		if err != nil {
+			return nil, err
+		}
+		stats["tag_cloud"] = cloud
+	}
+	if tenant := database.TenantFromContext(ctx); tenant != "" {
+		stats["tenant"] = tenant
 	}
 
 	statsJSON, err := json.MarshalIndent(stats, "", "  ")
@@ -157,41 +258,246 @@ func (dr *DatabaseResources) HandleDatabaseStats(ctx context.Context, req *mcp.R
 	}, nil
 }
 
-// HandlePosterCollection handles the movies://posters/collection resource request
-func (dr *DatabaseResources) HandlePosterCollection(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
-	// Fetch all movies to get poster information
+// HandleGenres handles the movies://database/genres resource request
+func (dr *DatabaseResources) HandleGenres(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	library, _, err := dr.libraryStats(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	genres := make([]map[string]interface{}, 0, len(library.Genres))
+	for _, genre := range serialization.SortedKeys(library.Genres) {
+		genres = append(genres, map[string]interface{}{
+			"genre":  genre,
+			"movies": library.Genres[genre],
+		})
+	}
+
+	breakdown := map[string]interface{}{
+		"total":  len(genres),
+		"genres": genres,
+	}
+
+	breakdownJSON, err := json.MarshalIndent(breakdown, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal genres to JSON: %w", err)
+	}
+
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{
+			{
+				URI:      "movies://database/genres",
+				MIMEType: "application/json",
+				Text:     string(breakdownJSON),
+			},
+		},
+	}, nil
+}
+
+// HandleDirectors handles the movies://database/directors resource request
+func (dr *DatabaseResources) HandleDirectors(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	_, directors, err := dr.libraryStats(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	breakdown := map[string]interface{}{
+		"total":     len(directors),
+		"directors": directorReport(directors),
+	}
+
+	breakdownJSON, err := json.MarshalIndent(breakdown, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal directors to JSON: %w", err)
+	}
+
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{
+			{
+				URI:      "movies://database/directors",
+				MIMEType: "application/json",
+				Text:     string(breakdownJSON),
+			},
+		},
+	}, nil
+}
+
+// directorReport formats director stats, keeping their order
+func directorReport(directors []movie.DirectorStats) []map[string]interface{} {
+	report := make([]map[string]interface{}, 0, len(directors))
+	for _, director := range directors {
+		report = append(report, map[string]interface{}{
+			"director":       director.Director,
+			"movies":         director.Movies,
+			"average_rating": fmt.Sprintf("%.1f", director.AverageRating),
+		})
+	}
+	return report
+}
+
+// tagCloud lists the most used tags with a weight from 1 to maxTagWeight,
+// scaled between the least and most used of them; tags no movie carries are
+// left out
+func (dr *DatabaseResources) tagCloud(ctx context.Context) ([]map[string]interface{}, error) {
+	counts, err := dr.tags.Counts(ctx, "", tagCloudSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tag counts: %w", err)
+	}
+
+	used := make([]tag.Count, 0, len(counts))
+	for _, count := range counts {
+		if count.Movies > 0 {
+			used = append(used, count)
+		}
+	}
+
+	cloud := make([]map[string]interface{}, 0, len(used))
+	if len(used) == 0 {
+		return cloud, nil
+	}
+	most, least := used[0].Movies, used[len(used)-1].Movies // Counts are most used first
+	for _, count := range used {
+		weight := maxTagWeight
+		if most > least {
+			weight = 1 + (count.Movies-least)*(maxTagWeight-1)/(most-least)
+		}
+		cloud = append(cloud, map[string]interface{}{
+			"tag":    count.Name,
+			"movies": count.Movies,
+			"weight": weight,
+		})
+	}
+	return cloud, nil
+}
+
+// libraryStats reads the precomputed aggregates when a stats reader is set,
+// and otherwise computes them from every movie. Up to directorLimit
+// directors are returned, or all of them for a non-positive limit.
+func (dr *DatabaseResources) libraryStats(ctx context.Context, directorLimit int) (*movie.LibraryStats, []movie.DirectorStats, error) {
+	if dr.stats != nil {
+		library, err := dr.stats.LibraryStats(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read library stats: %w", err)
+		}
+		directors, err := dr.stats.TopDirectors(ctx, directorLimit)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read director stats: %w", err)
+		}
+		return library, directors, nil
+	}
+
 	movies, err := dr.movieService.SearchMovies(ctx, movieApp.SearchMoviesQuery{})
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch movies for poster collection: %w", err)
+		return nil, nil, fmt.Errorf("failed to fetch movies for stats: %w", err)
+	}
+	library, directors := computeLibraryStats(movies, directorLimit)
+	return library, directors, nil
+}
+
+// computeLibraryStats aggregates movies the way the summary tables do:
+// averages cover ratings above zero, and directors are ranked by movie count
+// and then average rating, keeping up to directorLimit of them if positive
+func computeLibraryStats(movies []*movieApp.MovieDTO, directorLimit int) (*movie.LibraryStats, []movie.DirectorStats) {
+	library := &movie.LibraryStats{
+		TotalMovies:    len(movies),
+		Genres:         make(map[string]int),
+		Certifications: make(map[string]map[string]int),
+	}
+	type directorTotals struct {
+		movies, rated int
+		ratingTotal   float64
+	}
+	totals := make(map[string]*directorTotals)
+	var ratingTotal float64
+
+	for i, m := range movies {
+		if i == 0 || m.Year < library.EarliestYear {
+			library.EarliestYear = m.Year
+		}
+		if m.Year > library.LatestYear {
+			library.LatestYear = m.Year
+		}
+
+		seen := make(map[string]bool, len(m.Genres))
+		for _, genre := range m.Genres {
+			if !seen[genre] {
+				seen[genre] = true
+				library.Genres[genre]++
+			}
+		}
+		for region, certification := range m.Certifications {
+			if library.Certifications[region] == nil {
+				library.Certifications[region] = make(map[string]int)
+			}
+			library.Certifications[region][certification]++
+		}
+
+		director := totals[m.Director]
+		if director == nil {
+			director = &directorTotals{}
+			totals[m.Director] = director
+		}
+		director.movies++
+		if m.Rating > 0 {
+			library.RatedMovies++
+			ratingTotal += m.Rating
+			director.rated++
+			director.ratingTotal += m.Rating
+		}
+	}
+	if library.RatedMovies > 0 {
+		library.AverageRating = ratingTotal / float64(library.RatedMovies)
+	}
+
+	directors := make([]movie.DirectorStats, 0, len(totals))
+	for name, t := range totals {
+		stats := movie.DirectorStats{Director: name, Movies: t.movies}
+		if t.rated > 0 {
+			stats.AverageRating = t.ratingTotal / float64(t.rated)
+		}
+		directors = append(directors, stats)
+	}
+	sort.Slice(directors, func(i, j int) bool {
+		if directors[i].Movies != directors[j].Movies {
+			return directors[i].Movies > directors[j].Movies
+		}
+		if directors[i].AverageRating != directors[j].AverageRating {
+			return directors[i].AverageRating > directors[j].AverageRating
+		}
+		return directors[i].Director < directors[j].Director
+	})
+	if directorLimit > 0 && len(directors) > directorLimit {
+		directors = directors[:directorLimit]
 	}
+	return library, directors
+}
 
-	// Build poster collection (placeholder for now)
-	posters := make([]map[string]interface{}, 0, len(movies))
-	for _, movie := range movies {
-		posters = append(posters, map[string]interface{}{
+// HandlePosterCollection handles the movies://posters/collection resource request
+func (dr *DatabaseResources) HandlePosterCollection(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	// Posters are encoded as the movies are read, a batch at a time
+	var text strings.Builder
+	text.WriteString("{\n  \"posters\": ")
+	posters := newJSONArrayWriter(&text, 2)
+	total, err := dr.eachMovie(ctx, 0, 0, func(movie *movieApp.MovieDTO) error {
+		return posters.write(map[string]interface{}{
 			"movie_id": movie.ID,
 			"title":    movie.Title,
 			"year":     movie.Year,
 			"uri":      fmt.Sprintf("movies://posters/%d", movie.ID),
 		})
-	}
-
-	collection := map[string]interface{}{
-		"total":   len(posters),
-		"posters": posters,
-	}
-
-	collectionJSON, err := json.MarshalIndent(collection, "", "  ")
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal poster collection to JSON: %w", err)
+		return nil, fmt.Errorf("failed to fetch movies for poster collection: %w", err)
 	}
+	posters.close()
+	fmt.Fprintf(&text, ",\n  \"total\": %d\n}", total)
 
 	return &mcp.ReadResourceResult{
 		Contents: []*mcp.ResourceContents{
 			{
 				URI:      "movies://posters/collection",
 				MIMEType: "application/json",
-				Text:     string(collectionJSON),
+				Text:     text.String(),
 			},
 		},
 	}, nil
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -12,6 +13,8 @@ import (
 	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
 	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
 	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/domain/tag"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
 )
 
 // MockMovieService is a mock implementation for testing resources
@@ -398,12 +401,21 @@ func TestPosterURIGeneration(t *testing.T) {
 // MockMovieRepository is a mock implementation of the movie repository for testing
 type MockMovieRepository struct {
 	FindByCriteriaFunc func(ctx context.Context, criteria movie.SearchCriteria) ([]*movie.Movie, error)
+	CountAllFunc       func(ctx context.Context) (int, error)
 }
 
 func (m *MockMovieRepository) Save(ctx context.Context, mov *movie.Movie) error {
 	return errors.New("not implemented")
 }
 
+func (m *MockMovieRepository) InsertAll(ctx context.Context, movies []*movie.Movie) error {
+	return errors.New("not implemented")
+}
+
+func (m *MockMovieRepository) UpdateAll(ctx context.Context, movies []*movie.Movie) error {
+	return errors.New("not implemented")
+}
+
 func (m *MockMovieRepository) FindByID(ctx context.Context, id shared.MovieID) (*movie.Movie, error) {
 	return nil, errors.New("not implemented")
 }
@@ -440,6 +452,9 @@ func (m *MockMovieRepository) DeleteAll(ctx context.Context) error {
 }
 
 func (m *MockMovieRepository) CountAll(ctx context.Context) (int, error) {
+	if m.CountAllFunc != nil {
+		return m.CountAllFunc(ctx)
+	}
 	return 0, errors.New("not implemented")
 }
 
@@ -461,6 +476,9 @@ func TestHandleAllMovies_Success(t *testing.T) {
 
 			return []*movie.Movie{movie1, movie2}, nil
 		},
+		CountAllFunc: func(ctx context.Context) (int, error) {
+			return 2, nil
+		},
 	}
 
 	// Create service with mock repository
@@ -518,6 +536,9 @@ func TestHandleAllMovies_EmptyDatabase(t *testing.T) {
 		FindByCriteriaFunc: func(ctx context.Context, criteria movie.SearchCriteria) ([]*movie.Movie, error) {
 			return []*movie.Movie{}, nil
 		},
+		CountAllFunc: func(ctx context.Context) (int, error) {
+			return 0, nil
+		},
 	}
 
 	service := movieApp.NewService(mockRepo)
@@ -585,6 +606,11 @@ func TestHandleDatabaseStats_Success(t *testing.T) {
 			movie3.AddGenre("Sci-Fi")
 			movie3.AddGenre("Action")
 
+			movie1.SetCertification("US", "R")
+			movie2.SetCertification("US", "R")
+			movie3.SetCertification("US", "PG-13")
+			movie3.SetCertification("GB", "12A")
+
 			return []*movie.Movie{movie1, movie2, movie3}, nil
 		},
 	}
@@ -631,6 +657,41 @@ func TestHandleDatabaseStats_Success(t *testing.T) {
 	if avgRating != "9.1" {
 		t.Errorf("Expected average_rating='9.1', got '%s'", avgRating)
 	}
+
+	certifications := data["certifications"].(map[string]interface{})
+	us := certifications["US"].(map[string]interface{})
+	if us["R"].(float64) != 2 || us["PG-13"].(float64) != 1 {
+		t.Errorf("Expected 2 R and 1 PG-13 in the US, got %v", us)
+	}
+	if _, ok := certifications["GB"]; !ok {
+		t.Errorf("Expected GB certifications, got %v", certifications)
+	}
+}
+
+func TestHandleDatabaseStats_Tenant(t *testing.T) {
+	mockRepo := &MockMovieRepository{
+		FindByCriteriaFunc: func(ctx context.Context, criteria movie.SearchCriteria) ([]*movie.Movie, error) {
+			return []*movie.Movie{}, nil
+		},
+	}
+	resources := NewDatabaseResources(movieApp.NewService(mockRepo))
+
+	for ctx, want := range map[context.Context]any{
+		context.Background(): nil,
+		database.WithTenant(context.Background(), "acme", nil): "acme",
+	} {
+		result, err := resources.HandleDatabaseStats(ctx, nil)
+		if err != nil {
+			t.Fatalf("HandleDatabaseStats() error = %v", err)
+		}
+		var data map[string]interface{}
+		if err := json.Unmarshal([]byte(result.Contents[0].Text), &data); err != nil {
+			t.Fatalf("Failed to unmarshal JSON: %v", err)
+		}
+		if data["tenant"] != want {
+			t.Errorf("Expected tenant %v, got %v", want, data["tenant"])
+		}
+	}
 }
 
 func TestHandleDatabaseStats_EmptyDatabase(t *testing.T) {
@@ -844,3 +905,221 @@ func TestHandlePosterCollection_RepositoryError(t *testing.T) {
 		t.Errorf("Expected error message to contain 'failed to fetch movies for poster collection', got '%s'", err.Error())
 	}
 }
+
+// fakeStatsReader serves fixed precomputed stats
+type fakeStatsReader struct {
+	library   *movie.LibraryStats
+	directors []movie.DirectorStats
+	err       error
+	limit     int
+}
+
+func (f *fakeStatsReader) LibraryStats(ctx context.Context) (*movie.LibraryStats, error) {
+	return f.library, f.err
+}
+
+func (f *fakeStatsReader) TopDirectors(ctx context.Context, limit int) ([]movie.DirectorStats, error) {
+	f.limit = limit
+	return f.directors, f.err
+}
+
+func TestHandleDatabaseStats_StatsReader(t *testing.T) {
+	mockRepo := &MockMovieRepository{
+		FindByCriteriaFunc: func(ctx context.Context, criteria movie.SearchCriteria) ([]*movie.Movie, error) {
+			t.Error("Expected precomputed stats instead of a scan")
+			return nil, nil
+		},
+	}
+	resources := NewDatabaseResources(movieApp.NewService(mockRepo))
+	resources.SetStatsReader(&fakeStatsReader{
+		library: &movie.LibraryStats{
+			TotalMovies:    3,
+			RatedMovies:    3,
+			AverageRating:  9.1,
+			EarliestYear:   1972,
+			LatestYear:     2010,
+			Genres:         map[string]int{"Drama": 2, "Crime": 1},
+			Certifications: map[string]map[string]int{"US": {"R": 2}},
+		},
+		directors: []movie.DirectorStats{{Director: "Christopher Nolan", Movies: 2, AverageRating: 8.65}},
+	})
+
+	result, err := resources.HandleDatabaseStats(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("HandleDatabaseStats() error = %v", err)
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(result.Contents[0].Text), &data); err != nil {
+		t.Fatalf("Failed to unmarshal JSON: %v", err)
+	}
+
+	if data["total_movies"].(float64) != 3 || data["average_rating"] != "9.1" {
+		t.Errorf("Expected the precomputed totals, got %v", data)
+	}
+	genres := data["genres"].([]interface{})
+	if len(genres) != 2 || genres[0] != "Crime" || genres[1] != "Drama" {
+		t.Errorf("Expected sorted genres, got %v", genres)
+	}
+	if counts := data["genre_counts"].(map[string]interface{}); counts["Drama"].(float64) != 2 {
+		t.Errorf("Expected 2 Drama movies, got %v", counts)
+	}
+	directors := data["top_directors"].([]interface{})
+	if len(directors) != 1 || directors[0].(map[string]interface{})["average_rating"] != "8.7" {
+		t.Errorf("Expected Christopher Nolan at 8.7, got %v", directors)
+	}
+
+	resources.SetStatsReader(&fakeStatsReader{err: errors.New("no such table")})
+	if _, err := resources.HandleDatabaseStats(context.Background(), nil); err == nil || !strings.Contains(err.Error(), "failed to read library stats") {
+		t.Errorf("Expected a library stats error, got %v", err)
+	}
+}
+
+func TestHandleGenres(t *testing.T) {
+	mockRepo := &MockMovieRepository{
+		FindByCriteriaFunc: func(ctx context.Context, criteria movie.SearchCriteria) ([]*movie.Movie, error) {
+			movie1, _ := movie.NewMovie("The Godfather", "Francis Ford Coppola", 1972)
+			movie1.AddGenre("Crime")
+			movie1.AddGenre("Drama")
+
+			movie2, _ := movie.NewMovie("The Shawshank Redemption", "Frank Darabont", 1994)
+			movie2.AddGenre("Drama")
+
+			return []*movie.Movie{movie1, movie2}, nil
+		},
+	}
+
+	result, err := NewDatabaseResources(movieApp.NewService(mockRepo)).HandleGenres(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("HandleGenres() error = %v", err)
+	}
+	if result.Contents[0].URI != "movies://database/genres" {
+		t.Errorf("Expected the genres URI, got %s", result.Contents[0].URI)
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(result.Contents[0].Text), &data); err != nil {
+		t.Fatalf("Failed to unmarshal JSON: %v", err)
+	}
+
+	genres := data["genres"].([]interface{})
+	if data["total"].(float64) != 2 || len(genres) != 2 {
+		t.Fatalf("Expected 2 genres, got %v", data)
+	}
+	first, second := genres[0].(map[string]interface{}), genres[1].(map[string]interface{})
+	if first["genre"] != "Crime" || first["movies"].(float64) != 1 || second["genre"] != "Drama" || second["movies"].(float64) != 2 {
+		t.Errorf("Expected Crime (1) then Drama (2), got %v", genres)
+	}
+}
+
+func TestHandleDirectors(t *testing.T) {
+	movies := make([]*movie.Movie, 0, topDirectorCount+1)
+	for i := 0; i <= topDirectorCount; i++ {
+		m, _ := movie.NewMovie(fmt.Sprintf("Movie %d", i), fmt.Sprintf("Director %02d", i), 2000+i)
+		movies = append(movies, m)
+	}
+	extra, _ := movie.NewMovie("Sequel", "Director 03", 2020)
+	extra.SetRating(7.25)
+	movies = append(movies, extra)
+	mockRepo := &MockMovieRepository{
+		FindByCriteriaFunc: func(ctx context.Context, criteria movie.SearchCriteria) ([]*movie.Movie, error) {
+			return movies, nil
+		},
+	}
+
+	result, err := NewDatabaseResources(movieApp.NewService(mockRepo)).HandleDirectors(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("HandleDirectors() error = %v", err)
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(result.Contents[0].Text), &data); err != nil {
+		t.Fatalf("Failed to unmarshal JSON: %v", err)
+	}
+
+	directors := data["directors"].([]interface{})
+	if data["total"].(float64) != topDirectorCount+1 || len(directors) != topDirectorCount+1 {
+		t.Fatalf("Expected every director, not just the top %d, got %v", topDirectorCount, data["total"])
+	}
+	top := directors[0].(map[string]interface{})
+	if top["director"] != "Director 03" || top["movies"].(float64) != 2 || top["average_rating"] != "7.2" {
+		t.Errorf("Expected Director 03 first with 2 movies at 7.2, got %v", top)
+	}
+}
+
+func TestHandleDirectors_StatsReader(t *testing.T) {
+	stats := &fakeStatsReader{directors: []movie.DirectorStats{{Director: "Michael Mann", Movies: 2, AverageRating: 7.9}}}
+	resources := NewDatabaseResources(movieApp.NewService(&MockMovieRepository{}))
+	resources.SetStatsReader(stats)
+
+	if _, err := resources.HandleDirectors(context.Background(), nil); err != nil {
+		t.Fatalf("HandleDirectors() error = %v", err)
+	}
+	if stats.limit > 0 {
+		t.Errorf("Expected every director to be read, got a limit of %d", stats.limit)
+	}
+}
+
+// fakeTagCounts serves fixed tag counts
+type fakeTagCounts struct {
+	counts []tag.Count
+	err    error
+}
+
+func (f *fakeTagCounts) Counts(ctx context.Context, prefix string, limit int) ([]tag.Count, error) {
+	return f.counts, f.err
+}
+
+func TestHandleDatabaseStats_TagCloud(t *testing.T) {
+	resources := NewDatabaseResources(movieApp.NewService(&MockMovieRepository{}))
+	resources.SetStatsReader(&fakeStatsReader{library: &movie.LibraryStats{}})
+	resources.SetTagCounts(&fakeTagCounts{counts: []tag.Count{
+		{Name: "time-travel", Movies: 9},
+		{Name: "heist", Movies: 5},
+		{Name: "based-on-book", Movies: 1},
+		{Name: "never-used", Movies: 0},
+	}})
+
+	result, err := resources.HandleDatabaseStats(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("HandleDatabaseStats() error = %v", err)
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(result.Contents[0].Text), &data); err != nil {
+		t.Fatalf("Failed to unmarshal JSON: %v", err)
+	}
+
+	cloud := data["tag_cloud"].([]interface{})
+	if len(cloud) != 3 {
+		t.Fatalf("Expected the three used tags, got %v", cloud)
+	}
+	wantWeights := map[string]float64{"time-travel": 5, "heist": 3, "based-on-book": 1}
+	for _, entry := range cloud {
+		entry := entry.(map[string]interface{})
+		if entry["weight"].(float64) != wantWeights[entry["tag"].(string)] {
+			t.Errorf("Unexpected weight for %v", entry)
+		}
+	}
+
+	resources.SetTagCounts(&fakeTagCounts{err: errors.New("no such table")})
+	if _, err := resources.HandleDatabaseStats(context.Background(), nil); err == nil || !strings.Contains(err.Error(), "failed to read tag counts") {
+		t.Errorf("Expected a tag counts error, got %v", err)
+	}
+}
+
+func TestComputeLibraryStats_TopDirectors(t *testing.T) {
+	movies := []*movieApp.MovieDTO{
+		{Title: "Heat", Director: "Michael Mann", Year: 1995, Rating: 8.3},
+		{Title: "Collateral", Director: "Michael Mann", Year: 2004, Rating: 7.5},
+		{Title: "Unrated", Director: "Michael Mann", Year: 2020},
+		{Title: "Alien", Director: "Ridley Scott", Year: 1979, Rating: 8.5},
+	}
+
+	library, directors := computeLibraryStats(movies, topDirectorCount)
+	if library.RatedMovies != 3 || library.EarliestYear != 1979 || library.LatestYear != 2020 {
+		t.Errorf("Expected 3 rated movies from 1979 to 2020, got %+v", library)
+	}
+	if len(directors) != 2 || directors[0].Director != "Michael Mann" || directors[0].Movies != 3 {
+		t.Fatalf("Expected Michael Mann first with 3 movies, got %+v", directors)
+	}
+	if directors[0].AverageRating != 7.9 {
+		t.Errorf("Expected unrated movies to be left out of the average, got %v", directors[0].AverageRating)
+	}
+}
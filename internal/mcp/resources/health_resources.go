@@ -0,0 +1,162 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/mcp/middleware"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+	"github.com/francknouama/movies-mcp-server/pkg/serialization"
+)
+
+// DatabaseHealth provides the current database health snapshot
+type DatabaseHealth interface {
+	Snapshot() database.HealthSnapshot
+}
+
+// MigrationStatusChecker reports whether the schema is up to date
+type MigrationStatusChecker interface {
+	Status(ctx context.Context) (database.MigrationStatus, error)
+}
+
+// ToolTimings provides per-tool call statistics and recent failures
+type ToolTimings interface {
+	Snapshot() []middleware.ToolStat
+	RecentFailures() []middleware.ToolFailure
+}
+
+// TenantLister lists the tenants whose libraries are open
+type TenantLister interface {
+	Tenants() []string
+}
+
+// HealthResources handles server health resource operations
+type HealthResources struct {
+	databaseHealth DatabaseHealth
+	migrations     MigrationStatusChecker
+	toolTimings    ToolTimings
+	tenants        TenantLister
+	startedAt      time.Time
+}
+
+// NewHealthResources creates a new health resources handler
+func NewHealthResources(databaseHealth DatabaseHealth, migrations MigrationStatusChecker) *HealthResources {
+	return &HealthResources{
+		databaseHealth: databaseHealth,
+		migrations:     migrations,
+		startedAt:      time.Now(),
+	}
+}
+
+// SetToolTimings adds per-tool call counts, durations and recent failures to
+// the health report
+func (hr *HealthResources) SetToolTimings(timings ToolTimings) {
+	hr.toolTimings = timings
+}
+
+// SetTenants adds the open tenant libraries to the health report
+func (hr *HealthResources) SetTenants(tenants TenantLister) {
+	hr.tenants = tenants
+}
+
+// ServerHealthResource returns the server health resource definition
+func (hr *HealthResources) ServerHealthResource() *mcp.Resource {
+	return &mcp.Resource{
+		URI:         "movies://server/health",
+		Name:        "Server Health",
+		Description: "Server liveness and readiness: database connectivity, migration status and uptime",
+		MIMEType:    "application/json",
+	}
+}
+
+// HandleServerHealth handles the movies://server/health resource request
+func (hr *HealthResources) HandleServerHealth(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	snapshot := hr.databaseHealth.Snapshot()
+	databaseHealthy := snapshot.State == database.HealthStateHealthy
+
+	status := "ok"
+	if !databaseHealthy {
+		status = "degraded"
+	}
+
+	// Readiness additionally requires the schema to be fully migrated
+	migrations := map[string]interface{}{}
+	migrationsReady := false
+	migrationStatus, err := hr.migrations.Status(ctx)
+	if err != nil {
+		migrations["error"] = err.Error()
+	} else {
+		migrations["current_version"] = migrationStatus.CurrentVersion
+		migrations["latest_version"] = migrationStatus.LatestVersion
+		migrations["pending"] = migrationStatus.Pending
+		migrations["pending_contract"] = migrationStatus.PendingContract
+		migrations["up_to_date"] = migrationStatus.UpToDate
+		migrationsReady = migrationStatus.UpToDate
+	}
+
+	uptime := time.Since(hr.startedAt)
+	health := map[string]interface{}{
+		"status":         status,
+		"live":           true,
+		"ready":          databaseHealthy && migrationsReady,
+		"started_at":     serialization.Timestamp(hr.startedAt),
+		"uptime_seconds": int64(uptime.Seconds()),
+		"uptime":         uptime.Truncate(time.Second).String(),
+		"database":       snapshot,
+		"migrations":     migrations,
+	}
+	if hr.toolTimings != nil {
+		health["tools"] = toolTimingsReport(hr.toolTimings.Snapshot())
+		health["recent_errors"] = toolFailuresReport(hr.toolTimings.RecentFailures())
+	}
+	if hr.tenants != nil {
+		health["tenants"] = hr.tenants.Tenants()
+	}
+
+	healthJSON, err := json.MarshalIndent(health, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal health to JSON: %w", err)
+	}
+
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{
+			{
+				URI:      "movies://server/health",
+				MIMEType: "application/json",
+				Text:     string(healthJSON),
+			},
+		},
+	}, nil
+}
+
+// toolTimingsReport formats tool stats for the health report
+func toolTimingsReport(stats []middleware.ToolStat) []map[string]interface{} {
+	report := make([]map[string]interface{}, 0, len(stats))
+	for _, stat := range stats {
+		report = append(report, map[string]interface{}{
+			"tool":       stat.Tool,
+			"calls":      stat.Calls,
+			"errors":     stat.Errors,
+			"average_ms": float64(stat.Average().Microseconds()) / 1000,
+			"slowest_ms": float64(stat.Slowest.Microseconds()) / 1000,
+		})
+	}
+	return report
+}
+
+// toolFailuresReport formats recent tool failures for the health report
+func toolFailuresReport(failures []middleware.ToolFailure) []map[string]interface{} {
+	report := make([]map[string]interface{}, 0, len(failures))
+	for _, failure := range failures {
+		report = append(report, map[string]interface{}{
+			"at":      serialization.Timestamp(failure.At),
+			"tool":    failure.Tool,
+			"message": failure.Message,
+		})
+	}
+	return report
+}
@@ -0,0 +1,212 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/mcp/middleware"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+// MockDatabaseHealth is a mock implementation of DatabaseHealth
+type MockDatabaseHealth struct {
+	snapshot database.HealthSnapshot
+}
+
+func (m *MockDatabaseHealth) Snapshot() database.HealthSnapshot {
+	return m.snapshot
+}
+
+// MockMigrationStatusChecker is a mock implementation of MigrationStatusChecker
+type MockMigrationStatusChecker struct {
+	status database.MigrationStatus
+	err    error
+}
+
+func (m *MockMigrationStatusChecker) Status(ctx context.Context) (database.MigrationStatus, error) {
+	return m.status, m.err
+}
+
+func readHealth(t *testing.T, resources *HealthResources) map[string]interface{} {
+	t.Helper()
+
+	result, err := resources.HandleServerHealth(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(result.Contents) != 1 {
+		t.Fatalf("Expected 1 content item, got: %d", len(result.Contents))
+	}
+
+	var health map[string]interface{}
+	if err := json.Unmarshal([]byte(result.Contents[0].Text), &health); err != nil {
+		t.Fatalf("Failed to parse health JSON: %v", err)
+	}
+	return health
+}
+
+func TestServerHealthResource(t *testing.T) {
+	resources := NewHealthResources(&MockDatabaseHealth{}, &MockMigrationStatusChecker{})
+
+	resource := resources.ServerHealthResource()
+
+	if resource.URI != "movies://server/health" {
+		t.Errorf("Expected URI 'movies://server/health', got: %s", resource.URI)
+	}
+	if resource.MIMEType != "application/json" {
+		t.Errorf("Expected MIMEType 'application/json', got: %s", resource.MIMEType)
+	}
+}
+
+func TestHandleServerHealth(t *testing.T) {
+	upToDate := database.MigrationStatus{CurrentVersion: 5, LatestVersion: 5, UpToDate: true}
+	pending := database.MigrationStatus{CurrentVersion: 4, LatestVersion: 5, Pending: 1}
+
+	tests := []struct {
+		name       string
+		state      database.HealthState
+		lastError  string
+		migrations database.MigrationStatus
+		wantStatus string
+		wantReady  bool
+	}{
+		{name: "healthy and migrated", state: database.HealthStateHealthy, migrations: upToDate, wantStatus: "ok", wantReady: true},
+		{name: "healthy with pending migrations", state: database.HealthStateHealthy, migrations: pending, wantStatus: "ok", wantReady: false},
+		{name: "degraded database", state: database.HealthStateDegraded, lastError: "database is locked", migrations: upToDate, wantStatus: "degraded", wantReady: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resources := NewHealthResources(
+				&MockDatabaseHealth{snapshot: database.HealthSnapshot{State: tt.state, LastError: tt.lastError}},
+				&MockMigrationStatusChecker{status: tt.migrations},
+			)
+
+			health := readHealth(t, resources)
+
+			if health["status"] != tt.wantStatus {
+				t.Errorf("Expected status %q, got: %v", tt.wantStatus, health["status"])
+			}
+			if health["ready"] != tt.wantReady {
+				t.Errorf("Expected ready=%v, got: %v", tt.wantReady, health["ready"])
+			}
+			if health["live"] != true {
+				t.Errorf("Expected live=true, got: %v", health["live"])
+			}
+			if _, ok := health["uptime_seconds"]; !ok {
+				t.Error("Expected uptime_seconds")
+			}
+
+			db, ok := health["database"].(map[string]interface{})
+			if !ok {
+				t.Fatal("Expected database section")
+			}
+			if db["state"] != string(tt.state) {
+				t.Errorf("Expected database state %q, got: %v", tt.state, db["state"])
+			}
+		})
+	}
+}
+
+func TestHandleServerHealth_MigrationCheckError(t *testing.T) {
+	resources := NewHealthResources(
+		&MockDatabaseHealth{snapshot: database.HealthSnapshot{State: database.HealthStateHealthy}},
+		&MockMigrationStatusChecker{err: errors.New("failed to read migrations directory")},
+	)
+
+	health := readHealth(t, resources)
+
+	if health["ready"] != false {
+		t.Errorf("Expected not ready when migration status is unknown, got: %v", health["ready"])
+	}
+	migrations, ok := health["migrations"].(map[string]interface{})
+	if !ok {
+		t.Fatal("Expected migrations section")
+	}
+	if migrations["error"] == nil {
+		t.Error("Expected migration error to be reported")
+	}
+}
+
+// MockToolTimings is a mock implementation of ToolTimings
+type MockToolTimings struct {
+	stats    []middleware.ToolStat
+	failures []middleware.ToolFailure
+}
+
+func (m *MockToolTimings) Snapshot() []middleware.ToolStat {
+	return m.stats
+}
+
+func (m *MockToolTimings) RecentFailures() []middleware.ToolFailure {
+	return m.failures
+}
+
+func TestHandleServerHealth_ToolTimings(t *testing.T) {
+	resources := NewHealthResources(
+		&MockDatabaseHealth{snapshot: database.HealthSnapshot{State: database.HealthStateHealthy}},
+		&MockMigrationStatusChecker{},
+	)
+	if _, ok := readHealth(t, resources)["tools"]; ok {
+		t.Error("Expected no tools section without timings")
+	}
+
+	resources.SetToolTimings(&MockToolTimings{
+		stats: []middleware.ToolStat{
+			{Tool: "get_movie", Calls: 4, Errors: 1, Total: 10 * time.Millisecond, Slowest: 5 * time.Millisecond},
+		},
+		failures: []middleware.ToolFailure{
+			{At: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC), Tool: "get_movie", Message: "movie not found"},
+		},
+	})
+
+	health := readHealth(t, resources)
+	tools, ok := health["tools"].([]interface{})
+	if !ok || len(tools) != 1 {
+		t.Fatalf("Expected one tool in the tools section, got: %v", tools)
+	}
+	stat := tools[0].(map[string]interface{})
+	if stat["tool"] != "get_movie" || stat["calls"] != 4.0 || stat["errors"] != 1.0 {
+		t.Errorf("Expected get_movie with 4 calls and 1 error, got: %v", stat)
+	}
+	if stat["average_ms"] != 2.5 || stat["slowest_ms"] != 5.0 {
+		t.Errorf("Expected 2.5ms average and 5ms slowest, got: %v", stat)
+	}
+
+	failures, ok := health["recent_errors"].([]interface{})
+	if !ok || len(failures) != 1 {
+		t.Fatalf("Expected one recent error, got: %v", health["recent_errors"])
+	}
+	failure := failures[0].(map[string]interface{})
+	if failure["tool"] != "get_movie" || failure["message"] != "movie not found" || failure["at"] != "2024-05-01T12:00:00Z" {
+		t.Errorf("Expected the get_movie failure, got: %v", failure)
+	}
+}
+
+// MockTenantLister is a mock implementation of TenantLister
+type MockTenantLister struct {
+	tenants []string
+}
+
+func (m *MockTenantLister) Tenants() []string {
+	return m.tenants
+}
+
+func TestHandleServerHealth_Tenants(t *testing.T) {
+	resources := NewHealthResources(
+		&MockDatabaseHealth{snapshot: database.HealthSnapshot{State: database.HealthStateHealthy}},
+		&MockMigrationStatusChecker{},
+	)
+	if _, ok := readHealth(t, resources)["tenants"]; ok {
+		t.Error("Expected no tenants section without multi-tenancy")
+	}
+
+	resources.SetTenants(&MockTenantLister{tenants: []string{"acme", "globex"}})
+	tenants, ok := readHealth(t, resources)["tenants"].([]interface{})
+	if !ok || len(tenants) != 2 || tenants[0] != "acme" {
+		t.Errorf("Expected acme and globex, got: %v", tenants)
+	}
+}
@@ -0,0 +1,220 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// DefaultMaxPageSize is the page size of movies://database/all when no
+// maximum is set
+const DefaultMaxPageSize = 100
+
+const allMoviesURI = "movies://database/all"
+
+// Page formats of movies://database/all
+const (
+	formatJSON   = "json"
+	formatNDJSON = "ndjson"
+)
+
+// movieFields are the fields the fields parameter of movies://database/all
+// can select
+var movieFields = []string{
+	"id", "title", "director", "year", "rating", "genres", "poster_url", "created_at", "updated_at",
+	"release_date", "duration", "certifications", "content_warnings",
+}
+
+// moviePage is a page of movies://database/all as requested by its URI
+type moviePage struct {
+	Offset int
+	Limit  int
+	Format string
+	Fields []string // Fields kept in each movie; nil keeps every field
+}
+
+// parseMoviePage reads the offset, limit, format and fields of a
+// movies://database/all URI. A missing limit, or one above the maximum,
+// becomes the maximum.
+func (dr *DatabaseResources) parseMoviePage(uri string) (moviePage, error) {
+	page := moviePage{Limit: dr.maxPageSize, Format: formatJSON}
+
+	base, rawQuery, _ := strings.Cut(uri, "?")
+	if base != allMoviesURI {
+		return page, shared.NewNotFoundError("resource not found: %s", uri)
+	}
+	params, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return page, shared.NewValidationError("invalid query in %s", uri)
+	}
+
+	for name, values := range params {
+		value := values[len(values)-1]
+		switch name {
+		case "offset":
+			offset, err := strconv.Atoi(value)
+			if err != nil || offset < 0 {
+				return page, shared.NewValidationError("offset must be a non-negative integer, got %q", value)
+			}
+			page.Offset = offset
+		case "limit":
+			limit, err := strconv.Atoi(value)
+			if err != nil || limit <= 0 {
+				return page, shared.NewValidationError("limit must be a positive integer, got %q", value)
+			}
+			page.Limit = min(limit, dr.maxPageSize)
+		case "format":
+			if value != formatJSON && value != formatNDJSON {
+				return page, shared.NewValidationError("format must be json or ndjson, got %q", value)
+			}
+			page.Format = value
+		case "fields":
+			fields, err := parseMovieFields(value)
+			if err != nil {
+				return page, err
+			}
+			page.Fields = fields
+		default:
+			return page, shared.NewValidationError("unknown parameter %q (use offset, limit, format or fields)", name)
+		}
+	}
+	return page, nil
+}
+
+// parseMovieFields reads a comma-separated list of movie fields, dropping
+// repeats
+func parseMovieFields(value string) ([]string, error) {
+	var fields []string
+	seen := make(map[string]bool)
+	for _, field := range strings.Split(value, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" || seen[field] {
+			continue
+		}
+		if !slices.Contains(movieFields, field) {
+			return nil, shared.NewValidationError("unknown field %q (use %s)", field, strings.Join(movieFields, ", "))
+		}
+		seen[field] = true
+		fields = append(fields, field)
+	}
+	if len(fields) == 0 {
+		return nil, shared.NewValidationError("fields must name at least one field")
+	}
+	return fields, nil
+}
+
+// next returns the URI of the page after this one, which returned count movies
+func (p moviePage) next(count int) string {
+	next := allMoviesURI + "?offset=" + strconv.Itoa(p.Offset+count) + "&limit=" + strconv.Itoa(p.Limit)
+	if p.Format != formatJSON {
+		next += "&format=" + p.Format
+	}
+	if p.Fields != nil {
+		next += "&fields=" + strings.Join(p.Fields, ",")
+	}
+	return next
+}
+
+// selectFields reduces a movie to the page's fields, or keeps it whole when
+// the page names none
+func (p moviePage) selectFields(movie *movieApp.MovieDTO) (any, error) {
+	if p.Fields == nil {
+		return movie, nil
+	}
+
+	data, err := json.Marshal(movie)
+	if err != nil {
+		return nil, err
+	}
+	var all map[string]any
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+	kept := make(map[string]any, len(p.Fields))
+	for _, field := range p.Fields {
+		if value, ok := all[field]; ok { // Empty optional fields stay absent
+			kept[field] = value
+		}
+	}
+	return kept, nil
+}
+
+// streamBatchSize is how many movies a resource reads from the library at a
+// time while it encodes them
+const streamBatchSize = 500
+
+// eachMovie passes up to limit movies in ID order to fn, skipping offset of
+// them, or every movie from offset on if limit is not positive. Movies are
+// read streamBatchSize at a time and dropped once fn has encoded them, so
+// the memory a resource needs beyond its text stays the same however many
+// movies it holds. It returns how many movies fn was passed.
+func (dr *DatabaseResources) eachMovie(ctx context.Context, offset, limit int, fn func(*movieApp.MovieDTO) error) (int, error) {
+	count := 0
+	for limit <= 0 || count < limit {
+		batch := streamBatchSize
+		if limit > 0 {
+			batch = min(batch, limit-count)
+		}
+		movies, err := dr.movieService.SearchMovies(ctx, movieApp.SearchMoviesQuery{
+			Limit:  batch,
+			Offset: offset + count,
+			Sort:   []movieApp.SortKey{{Field: "id"}},
+		})
+		if err != nil {
+			return count, err
+		}
+		for _, movie := range movies {
+			if err := fn(movie); err != nil {
+				return count, err
+			}
+			count++
+		}
+		if len(movies) < batch {
+			break
+		}
+	}
+	return count, nil
+}
+
+// jsonArrayWriter writes the elements of an indented JSON array one at a
+// time, matching the layout of json.MarshalIndent at the given depth
+type jsonArrayWriter struct {
+	out    *strings.Builder
+	prefix string
+	count  int
+}
+
+// newJSONArrayWriter opens an array whose elements sit depth levels deep
+func newJSONArrayWriter(out *strings.Builder, depth int) *jsonArrayWriter {
+	out.WriteString("[")
+	return &jsonArrayWriter{out: out, prefix: strings.Repeat("  ", depth)}
+}
+
+// write appends one element
+func (w *jsonArrayWriter) write(value any) error {
+	data, err := json.MarshalIndent(value, w.prefix, "  ")
+	if err != nil {
+		return err
+	}
+	if w.count > 0 {
+		w.out.WriteString(",")
+	}
+	w.out.WriteString("\n" + w.prefix)
+	w.out.Write(data)
+	w.count++
+	return nil
+}
+
+// close ends the array
+func (w *jsonArrayWriter) close() {
+	if w.count > 0 {
+		w.out.WriteString("\n" + w.prefix[2:])
+	}
+	w.out.WriteString("]")
+}
@@ -0,0 +1,273 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// newPagedResources returns resources over a library of total movies,
+// recording the criteria of the last search
+func newPagedResources(t *testing.T, total, maxPageSize int) (*DatabaseResources, *movie.SearchCriteria) {
+	t.Helper()
+
+	var last movie.SearchCriteria
+	repo := &MockMovieRepository{
+		FindByCriteriaFunc: func(ctx context.Context, criteria movie.SearchCriteria) ([]*movie.Movie, error) {
+			last = criteria
+			movies := []*movie.Movie{}
+			for i := criteria.Offset; i < min(criteria.Offset+criteria.Limit, total); i++ {
+				id, _ := shared.NewMovieID(i + 1)
+				m, _ := movie.NewMovieWithID(id, "Movie", "Director", 2000)
+				movies = append(movies, m)
+			}
+			return movies, nil
+		},
+		CountAllFunc: func(ctx context.Context) (int, error) {
+			return total, nil
+		},
+	}
+	resources := NewDatabaseResources(movieApp.NewService(repo))
+	resources.SetMaxPageSize(maxPageSize)
+	return resources, &last
+}
+
+func readPage(t *testing.T, resources *DatabaseResources, uri string) (*mcp.ReadResourceResult, map[string]interface{}) {
+	t.Helper()
+
+	result, err := resources.HandleAllMovies(context.Background(), &mcp.ReadResourceRequest{
+		Params: &mcp.ReadResourceParams{URI: uri},
+	})
+	if err != nil {
+		t.Fatalf("HandleAllMovies(%s) error = %v", uri, err)
+	}
+	var body map[string]interface{}
+	if result.Contents[0].MIMEType == "application/json" {
+		if err := json.Unmarshal([]byte(result.Contents[0].Text), &body); err != nil {
+			t.Fatalf("Failed to unmarshal JSON: %v", err)
+		}
+	}
+	return result, body
+}
+
+func TestHandleAllMovies_Pages(t *testing.T) {
+	resources, criteria := newPagedResources(t, 5, 2)
+
+	// First page: the maximum page size, in ID order, linking to the next
+	result, body := readPage(t, resources, "movies://database/all")
+	if criteria.Limit != 2 || criteria.Offset != 0 || len(criteria.Sort) != 1 || criteria.Sort[0].Field != movie.OrderByID {
+		t.Errorf("Expected the first 2 movies by ID, got criteria: %+v", criteria)
+	}
+	if body["count"].(float64) != 2 || body["total_movies"].(float64) != 5 {
+		t.Errorf("Expected 2 of 5 movies, got: %v", body)
+	}
+	if body["next"] != "movies://database/all?offset=2&limit=2" {
+		t.Errorf("Expected a link to the second page, got: %v", body["next"])
+	}
+	if result.Meta["next"] != body["next"] {
+		t.Errorf("Expected the next link in _meta, got: %v", result.Meta)
+	}
+
+	// Last page: no further link
+	_, body = readPage(t, resources, "movies://database/all?offset=4&limit=2")
+	if body["count"].(float64) != 1 {
+		t.Errorf("Expected 1 movie on the last page, got: %v", body["count"])
+	}
+	if _, ok := body["next"]; ok {
+		t.Errorf("Expected no next link on the last page, got: %v", body["next"])
+	}
+}
+
+func TestHandleAllMovies_LimitCappedAtMaximum(t *testing.T) {
+	resources, criteria := newPagedResources(t, 10, 3)
+
+	_, body := readPage(t, resources, "movies://database/all?limit=1000")
+
+	if criteria.Limit != 3 || body["limit"].(float64) != 3 {
+		t.Errorf("Expected the limit to be capped at 3, got criteria %d and body %v", criteria.Limit, body["limit"])
+	}
+	if body["next"] != "movies://database/all?offset=3&limit=3" {
+		t.Errorf("Expected the next link to use the capped limit, got: %v", body["next"])
+	}
+}
+
+func TestHandleAllMovies_NDJSON(t *testing.T) {
+	resources, _ := newPagedResources(t, 3, 2)
+
+	result, _ := readPage(t, resources, "movies://database/all?format=ndjson")
+
+	content := result.Contents[0]
+	if content.MIMEType != "application/x-ndjson" {
+		t.Errorf("Expected NDJSON content, got: %s", content.MIMEType)
+	}
+	lines := strings.Split(strings.TrimSuffix(content.Text, "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected one line per movie, got: %q", content.Text)
+	}
+	for _, line := range lines {
+		var movie map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &movie); err != nil {
+			t.Errorf("Expected each line to be a movie object, got %q: %v", line, err)
+		}
+	}
+	if result.Meta["next"] != "movies://database/all?offset=2&limit=2&format=ndjson" {
+		t.Errorf("Expected the next link to keep the format, got: %v", result.Meta["next"])
+	}
+	if result.Meta["total_movies"] != 3 {
+		t.Errorf("Expected total_movies in _meta, got: %v", result.Meta)
+	}
+}
+
+func TestHandleAllMovies_Fields(t *testing.T) {
+	resources, _ := newPagedResources(t, 3, 2)
+
+	_, body := readPage(t, resources, "movies://database/all?fields=id,title,%20id,poster_url")
+
+	movies := body["movies"].([]interface{})
+	if len(movies) != 2 {
+		t.Fatalf("Expected 2 movies, got: %v", movies)
+	}
+	first := movies[0].(map[string]interface{})
+	if len(first) != 2 || first["id"].(float64) != 1 || first["title"] != "Movie" {
+		t.Errorf("Expected only the id and title, with the missing poster absent, got: %v", first)
+	}
+	if body["next"] != "movies://database/all?offset=2&limit=2&fields=id,title,poster_url" {
+		t.Errorf("Expected the next link to keep the fields, got: %v", body["next"])
+	}
+
+	result, _ := readPage(t, resources, "movies://database/all?format=ndjson&fields=year")
+	lines := strings.Split(strings.TrimSuffix(result.Contents[0].Text, "\n"), "\n")
+	if len(lines) != 2 || lines[0] != `{"year":2000}` {
+		t.Errorf("Expected NDJSON lines with only the year, got: %q", result.Contents[0].Text)
+	}
+}
+
+func TestHandleAllMovies_StreamsLargePages(t *testing.T) {
+	const total = 100_000
+
+	// Sample the live heap as batches are read, with how many movies had
+	// been encoded by then
+	type sample struct {
+		encoded int
+		heap    uint64
+	}
+	var samples []sample
+	var stats runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&stats)
+	baseline := stats.HeapAlloc
+
+	largestBatch := 0
+	repo := &MockMovieRepository{
+		FindByCriteriaFunc: func(ctx context.Context, criteria movie.SearchCriteria) ([]*movie.Movie, error) {
+			largestBatch = max(largestBatch, criteria.Limit)
+			if criteria.Offset%(20*streamBatchSize) == 0 {
+				runtime.GC()
+				runtime.ReadMemStats(&stats)
+				samples = append(samples, sample{encoded: criteria.Offset, heap: stats.HeapAlloc})
+			}
+			movies := make([]*movie.Movie, 0, criteria.Limit)
+			for i := criteria.Offset; i < min(criteria.Offset+criteria.Limit, total); i++ {
+				id, _ := shared.NewMovieID(i + 1)
+				m, _ := movie.NewMovieWithID(id, "Movie", "Director", 2000)
+				m.AddGenre("Drama")
+				movies = append(movies, m)
+			}
+			return movies, nil
+		},
+		CountAllFunc: func(ctx context.Context) (int, error) {
+			return total, nil
+		},
+	}
+	resources := NewDatabaseResources(movieApp.NewService(repo))
+	resources.SetMaxPageSize(total)
+
+	result, body := readPage(t, resources, "movies://database/all")
+
+	if largestBatch > streamBatchSize {
+		t.Errorf("Expected movies read at most %d at a time, got a batch of %d", streamBatchSize, largestBatch)
+	}
+	movies := body["movies"].([]interface{})
+	if len(movies) != total || body["count"].(float64) != total {
+		t.Fatalf("Expected all %d movies, got %d (count %v)", total, len(movies), body["count"])
+	}
+	if last := movies[total-1].(map[string]interface{}); last["id"].(float64) != total {
+		t.Errorf("Expected the movies in ID order, got %v last", last["id"])
+	}
+	if _, ok := body["next"]; ok {
+		t.Errorf("Expected no next link on a page holding the whole library, got: %v", body["next"])
+	}
+
+	// Besides the text written so far, which a growing builder holds up to
+	// twice over, memory must not grow with the movies encoded
+	const budget = 32 << 20
+	perMovie := uint64(len(result.Contents[0].Text) / total)
+	for _, s := range samples {
+		text := 2 * perMovie * uint64(s.encoded)
+		if s.heap > baseline+text && s.heap-baseline-text > budget {
+			t.Errorf("Expected under %d MB beyond the text after %d movies, got %d MB",
+				budget>>20, s.encoded, (s.heap-baseline-text)>>20)
+		}
+	}
+	if len(samples) < 2 {
+		t.Errorf("Expected the heap sampled while the page was encoded, got %d samples", len(samples))
+	}
+}
+
+func TestHandleAllMovies_InvalidParameters(t *testing.T) {
+	resources, _ := newPagedResources(t, 3, 2)
+
+	for _, uri := range []string{
+		"movies://database/all?offset=-1",
+		"movies://database/all?limit=0",
+		"movies://database/all?limit=ten",
+		"movies://database/all?format=xml",
+		"movies://database/all?page=2",
+		"movies://database/all?fields=id,budget",
+		"movies://database/all?fields=,",
+	} {
+		_, err := resources.HandleAllMovies(context.Background(), &mcp.ReadResourceRequest{
+			Params: &mcp.ReadResourceParams{URI: uri},
+		})
+		if !errors.Is(err, shared.ErrValidation) {
+			t.Errorf("Expected a validation error for %s, got: %v", uri, err)
+		}
+	}
+}
+
+func TestAllMoviesTemplate_ReadThroughServer(t *testing.T) {
+	resources, criteria := newPagedResources(t, 5, 2)
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil)
+	server.AddResource(resources.AllMoviesResource(), resources.HandleAllMovies)
+	server.AddResourceTemplate(resources.AllMoviesTemplate(), resources.HandleAllMovies)
+
+	ctx := context.Background()
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	if err != nil {
+		t.Fatalf("failed to connect server: %v", err)
+	}
+	defer serverSession.Close()
+	session, err := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "1.0.0"}, nil).Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("failed to connect client: %v", err)
+	}
+	defer session.Close()
+
+	result, err := session.ReadResource(ctx, &mcp.ReadResourceParams{URI: "movies://database/all?offset=2&limit=2"})
+	if err != nil {
+		t.Fatalf("Expected the paged URI to be served, got: %v", err)
+	}
+	if criteria.Offset != 2 || result.Contents[0].URI != "movies://database/all?offset=2&limit=2" {
+		t.Errorf("Expected the second page, got criteria %+v and contents %+v", criteria, result.Contents[0])
+	}
+}
@@ -0,0 +1,103 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	posterApp "github.com/francknouama/movies-mcp-server/internal/application/poster"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// moviePostersURI prefixes the URI of each stored movie poster, which is
+// served by movie ID
+const moviePostersURI = "movies://posters"
+
+// MoviePosterReader provides the stored movie posters
+type MoviePosterReader interface {
+	GetPoster(ctx context.Context, movieID int) (*posterApp.PosterImageDTO, error)
+}
+
+// PosterURLSigner builds the CDN URL a stored poster is served from
+type PosterURLSigner interface {
+	SignedURL(poster *posterApp.PosterImageDTO) (string, time.Time)
+}
+
+// MoviePosterResources handles the movie poster image resources
+type MoviePosterResources struct {
+	posters MoviePosterReader
+	signer  PosterURLSigner
+}
+
+// NewMoviePosterResources creates a movie poster resource handler
+func NewMoviePosterResources(posters MoviePosterReader) *MoviePosterResources {
+	return &MoviePosterResources{posters: posters}
+}
+
+// SetCDNSigner makes poster reads return the poster's CDN URL instead of
+// the image
+func (mr *MoviePosterResources) SetCDNSigner(signer PosterURLSigner) {
+	mr.signer = signer
+}
+
+// PosterTemplate returns the template of a single movie's poster
+func (mr *MoviePosterResources) PosterTemplate() *mcp.ResourceTemplate {
+	return &mcp.ResourceTemplate{
+		URITemplate: moviePostersURI + "/{id}",
+		Name:        "Movie Poster",
+		Description: "A movie's stored poster image",
+	}
+}
+
+// HandlePoster handles a movies://posters/{id} resource request, returning
+// the poster image as a blob, or as a text/uri-list holding its CDN URL
+// when a CDN signer is set; movies without a stored poster are not found
+func (mr *MoviePosterResources) HandlePoster(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	uri := req.Params.URI
+	rawID, ok := strings.CutPrefix(uri, moviePostersURI+"/")
+	movieID, err := strconv.Atoi(rawID)
+	if !ok || err != nil || movieID <= 0 {
+		return nil, mcp.ResourceNotFoundError(uri)
+	}
+
+	poster, err := mr.posters.GetPoster(ctx, movieID)
+	if errors.Is(err, shared.ErrNotFound) {
+		return nil, mcp.ResourceNotFoundError(uri)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read movie poster: %w", err)
+	}
+
+	if mr.signer != nil {
+		signedURL, expiresAt := mr.signer.SignedURL(poster)
+		meta := mcp.Meta{"url": signedURL, "mime_type": poster.MimeType, "size": poster.Size}
+		if !expiresAt.IsZero() {
+			meta["expires_at"] = expiresAt.UTC().Format(time.RFC3339)
+		}
+		return &mcp.ReadResourceResult{
+			Meta: meta,
+			Contents: []*mcp.ResourceContents{
+				{
+					URI:      uri,
+					MIMEType: "text/uri-list",
+					Text:     signedURL,
+				},
+			},
+		}, nil
+	}
+
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{
+			{
+				URI:      uri,
+				MIMEType: poster.MimeType,
+				Blob:     poster.Data,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,67 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	posterApp "github.com/francknouama/movies-mcp-server/internal/application/poster"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// fakeMoviePosters serves one JPEG poster for movie 42
+type fakeMoviePosters struct{}
+
+var fakePosterData = []byte{0xFF, 0xD8, 0xFF}
+
+func (f *fakeMoviePosters) GetPoster(ctx context.Context, movieID int) (*posterApp.PosterImageDTO, error) {
+	if movieID != 42 {
+		return nil, shared.NewNotFoundError("movie poster not found")
+	}
+	return &posterApp.PosterImageDTO{
+		PosterDTO: posterApp.PosterDTO{MovieID: 42, Title: "The Matrix", Year: 1999, MimeType: "image/jpeg", Size: len(fakePosterData)},
+		Data:      fakePosterData,
+	}, nil
+}
+
+func TestHandlePoster(t *testing.T) {
+	mr := NewMoviePosterResources(&fakeMoviePosters{})
+	read := func(uri string) (*mcp.ReadResourceResult, error) {
+		return mr.HandlePoster(context.Background(), &mcp.ReadResourceRequest{Params: &mcp.ReadResourceParams{URI: uri}})
+	}
+
+	result, err := read("movies://posters/42")
+	if err != nil {
+		t.Fatalf("HandlePoster() error = %v", err)
+	}
+	if contents := result.Contents[0]; !bytes.Equal(contents.Blob, fakePosterData) || contents.MIMEType != "image/jpeg" || contents.URI != "movies://posters/42" {
+		t.Errorf("Expected the JPEG as a blob, got: %x (%s)", contents.Blob, contents.MIMEType)
+	}
+
+	for _, uri := range []string{"movies://posters/43", "movies://posters/matrix", "movies://posters/0"} {
+		if _, err := read(uri); err == nil || !strings.Contains(err.Error(), "Resource not found") {
+			t.Errorf("Expected a resource not found error for %s, got: %v", uri, err)
+		}
+	}
+}
+
+func TestHandlePoster_CDN(t *testing.T) {
+	mr := NewMoviePosterResources(&fakeMoviePosters{})
+	mr.SetCDNSigner(posterApp.NewCDNSigner("https://cdn.example.com", "secret", time.Hour))
+
+	result, err := mr.HandlePoster(context.Background(), &mcp.ReadResourceRequest{Params: &mcp.ReadResourceParams{URI: "movies://posters/42"}})
+	if err != nil {
+		t.Fatalf("HandlePoster() error = %v", err)
+	}
+	contents := result.Contents[0]
+	if contents.Blob != nil || contents.MIMEType != "text/uri-list" || !strings.HasPrefix(contents.Text, "https://cdn.example.com/posters/42-") {
+		t.Errorf("Expected the CDN URL instead of the image, got: %+v", contents)
+	}
+	if result.Meta["url"] != contents.Text || result.Meta["mime_type"] != "image/jpeg" || result.Meta["expires_at"] == nil {
+		t.Errorf("Unexpected _meta %v", result.Meta)
+	}
+}
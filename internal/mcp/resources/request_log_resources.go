@@ -0,0 +1,85 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/mcp/middleware"
+	"github.com/francknouama/movies-mcp-server/pkg/serialization"
+)
+
+// RequestLogReader provides the most recent tool calls
+type RequestLogReader interface {
+	Size() int
+	Recent() []middleware.RequestRecord
+}
+
+// RequestLogResources handles the request log diagnostic resource
+type RequestLogResources struct {
+	log RequestLogReader
+}
+
+// NewRequestLogResources creates a request log resource handler; a nil log
+// reports the request log as disabled
+func NewRequestLogResources(log RequestLogReader) *RequestLogResources {
+	return &RequestLogResources{log: log}
+}
+
+// RequestLogResource returns the request log resource definition
+func (rr *RequestLogResources) RequestLogResource() *mcp.Resource {
+	return &mcp.Resource{
+		URI:         "movies://server/request-log",
+		Name:        "Request Log",
+		Description: "The most recent tool calls with their latency, outcome and redacted arguments",
+		MIMEType:    "application/json",
+	}
+}
+
+// HandleRequestLog handles the movies://server/request-log resource request
+func (rr *RequestLogResources) HandleRequestLog(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	report := map[string]interface{}{
+		"enabled":  rr.log != nil,
+		"requests": []map[string]interface{}{},
+	}
+	if rr.log != nil {
+		report["size"] = rr.log.Size()
+		report["requests"] = requestLogReport(rr.log.Recent())
+	}
+
+	reportJSON, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request log to JSON: %w", err)
+	}
+
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{
+			{
+				URI:      "movies://server/request-log",
+				MIMEType: "application/json",
+				Text:     string(reportJSON),
+			},
+		},
+	}, nil
+}
+
+// requestLogReport formats logged tool calls, keeping their order
+func requestLogReport(records []middleware.RequestRecord) []map[string]interface{} {
+	report := make([]map[string]interface{}, 0, len(records))
+	for _, record := range records {
+		entry := map[string]interface{}{
+			"at":          serialization.Timestamp(record.At),
+			"tool":        record.Tool,
+			"duration_ms": float64(record.Duration.Microseconds()) / 1000,
+			"status":      record.Status,
+			"arguments":   record.Arguments,
+		}
+		if record.Code != 0 {
+			entry["code"] = record.Code
+		}
+		report = append(report, entry)
+	}
+	return report
+}
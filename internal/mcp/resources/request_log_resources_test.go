@@ -0,0 +1,74 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/mcp/middleware"
+)
+
+// fakeRequestLog reports fixed tool calls
+type fakeRequestLog struct {
+	records []middleware.RequestRecord
+}
+
+func (f *fakeRequestLog) Size() int {
+	return 50
+}
+
+func (f *fakeRequestLog) Recent() []middleware.RequestRecord {
+	return f.records
+}
+
+func readRequestLog(t *testing.T, rr *RequestLogResources) map[string]interface{} {
+	t.Helper()
+
+	result, err := rr.HandleRequestLog(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("HandleRequestLog() error = %v", err)
+	}
+	var report map[string]interface{}
+	if err := json.Unmarshal([]byte(result.Contents[0].Text), &report); err != nil {
+		t.Fatalf("Failed to unmarshal JSON: %v", err)
+	}
+	return report
+}
+
+func TestHandleRequestLog_ListsCalls(t *testing.T) {
+	rr := NewRequestLogResources(&fakeRequestLog{records: []middleware.RequestRecord{
+		{At: time.Now(), Tool: "get_movie", Duration: 2500 * time.Microsecond, Status: middleware.RequestError, Code: -32004, Arguments: `{"movie_id":7}`},
+		{At: time.Now(), Tool: "search_movies", Duration: time.Millisecond, Status: middleware.RequestOK, Arguments: `{"title":"[redacted]"}`},
+	}})
+
+	report := readRequestLog(t, rr)
+	if report["enabled"] != true || report["size"].(float64) != 50 {
+		t.Errorf("Expected an enabled log of 50 calls, got: %v", report)
+	}
+	requests := report["requests"].([]interface{})
+	if len(requests) != 2 {
+		t.Fatalf("Expected 2 requests, got: %v", requests)
+	}
+	failed := requests[0].(map[string]interface{})
+	if failed["tool"] != "get_movie" || failed["status"] != "error" || failed["code"].(float64) != -32004 || failed["duration_ms"].(float64) != 2.5 {
+		t.Errorf("Expected the failed call with its code, got: %v", failed)
+	}
+	if failed["arguments"] != `{"movie_id":7}` {
+		t.Errorf("Expected the logged arguments, got: %v", failed["arguments"])
+	}
+	if _, ok := requests[1].(map[string]interface{})["code"]; ok {
+		t.Errorf("Expected no code for a successful call, got: %v", requests[1])
+	}
+}
+
+func TestHandleRequestLog_Disabled(t *testing.T) {
+	report := readRequestLog(t, NewRequestLogResources(nil))
+
+	if report["enabled"] != false || len(report["requests"].([]interface{})) != 0 {
+		t.Errorf("Expected a disabled log with no requests, got: %v", report)
+	}
+	if _, ok := report["size"]; ok {
+		t.Errorf("Expected no size when disabled, got: %v", report["size"])
+	}
+}
@@ -0,0 +1,97 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/application/retention"
+	"github.com/francknouama/movies-mcp-server/pkg/serialization"
+)
+
+// RetentionReporter provides the retention job's policies and purges
+type RetentionReporter interface {
+	Enabled() bool
+	Status() retention.Status
+}
+
+// RetentionResources handles the retention status resource
+type RetentionResources struct {
+	reporter RetentionReporter
+}
+
+// NewRetentionResources creates a retention status resource handler
+func NewRetentionResources(reporter RetentionReporter) *RetentionResources {
+	return &RetentionResources{reporter: reporter}
+}
+
+// RetentionStatusResource returns the retention status resource definition
+func (rr *RetentionResources) RetentionStatusResource() *mcp.Resource {
+	return &mcp.Resource{
+		URI:         "movies://server/retention",
+		Name:        "Retention Status",
+		Description: "Retention periods of stored records and how their scheduled purges went",
+		MIMEType:    "application/json",
+	}
+}
+
+// HandleRetentionStatus handles the movies://server/retention resource
+// request. Policies are those of the default library; with multi-tenancy,
+// tenants lists each open tenant's.
+func (rr *RetentionResources) HandleRetentionStatus(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	status := rr.reporter.Status()
+	report := map[string]interface{}{
+		"enabled":          rr.reporter.Enabled(),
+		"interval_seconds": status.Interval.Seconds(),
+		"next_run":         serialization.Timestamp(status.NextRun),
+		"policies":         retentionPolicies(status.Policies),
+	}
+	if len(status.Tenants) > 0 {
+		tenants := make([]map[string]interface{}, len(status.Tenants))
+		for i, tenant := range status.Tenants {
+			tenants[i] = map[string]interface{}{
+				"tenant":   tenant.Tenant,
+				"policies": retentionPolicies(tenant.Policies),
+			}
+		}
+		report["tenants"] = tenants
+	}
+
+	reportJSON, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal retention status to JSON: %w", err)
+	}
+
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{
+			{
+				URI:      "movies://server/retention",
+				MIMEType: "application/json",
+				Text:     string(reportJSON),
+			},
+		},
+	}, nil
+}
+
+// retentionPolicies reports each policy and its last purge
+func retentionPolicies(statuses []retention.PolicyStatus) []map[string]interface{} {
+	policies := make([]map[string]interface{}, len(statuses))
+	for i, policy := range statuses {
+		entry := map[string]interface{}{
+			"name":         policy.Name,
+			"max_days":     policy.MaxDays,
+			"enabled":      policy.Enabled,
+			"last_run":     serialization.Timestamp(policy.LastRun),
+			"last_cutoff":  serialization.Timestamp(policy.LastCutoff),
+			"last_purged":  policy.LastPurged,
+			"total_purged": policy.TotalPurged,
+		}
+		if policy.LastError != "" {
+			entry["last_error"] = policy.LastError
+		}
+		policies[i] = entry
+	}
+	return policies
+}
@@ -0,0 +1,95 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/internal/application/retention"
+)
+
+// fakeRetention reports a fixed retention status
+type fakeRetention struct {
+	status retention.Status
+}
+
+func (f *fakeRetention) Enabled() bool {
+	return true
+}
+
+func (f *fakeRetention) Status() retention.Status {
+	return f.status
+}
+
+func TestHandleRetentionStatus(t *testing.T) {
+	lastRun := time.Date(2026, 3, 14, 12, 0, 0, 0, time.UTC)
+	rr := NewRetentionResources(&fakeRetention{status: retention.Status{
+		Interval: 24 * time.Hour,
+		NextRun:  lastRun.Add(24 * time.Hour),
+		Policies: []retention.PolicyStatus{
+			{Name: "movie_history", MaxDays: 90, Enabled: true, LastRun: lastRun, LastPurged: 4, TotalPurged: 10},
+			{Name: "movie_access", LastError: "failed to purge movie_access: database is locked"},
+		},
+	}})
+
+	result, err := rr.HandleRetentionStatus(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("HandleRetentionStatus() error = %v", err)
+	}
+	var report map[string]interface{}
+	if err := json.Unmarshal([]byte(result.Contents[0].Text), &report); err != nil {
+		t.Fatalf("Failed to unmarshal JSON: %v", err)
+	}
+
+	if report["enabled"] != true || report["interval_seconds"].(float64) != 86400 || report["next_run"] == "" {
+		t.Errorf("Expected a daily enabled job, got: %v", report)
+	}
+	policies := report["policies"].([]interface{})
+	if len(policies) != 2 {
+		t.Fatalf("Expected 2 policies, got: %v", policies)
+	}
+	history := policies[0].(map[string]interface{})
+	if history["max_days"].(float64) != 90 || history["total_purged"].(float64) != 10 || history["last_run"] == "" {
+		t.Errorf("Expected the history policy's purges, got: %v", history)
+	}
+	if _, hasError := history["last_error"]; hasError {
+		t.Errorf("Expected no error on the history policy, got: %v", history)
+	}
+	if access := policies[1].(map[string]interface{}); access["last_error"] == nil || access["last_run"] != "" {
+		t.Errorf("Expected the access policy's error and no run, got: %v", access)
+	}
+	if _, hasTenants := report["tenants"]; hasTenants {
+		t.Errorf("Expected no tenants section without multi-tenancy, got: %v", report)
+	}
+}
+
+func TestHandleRetentionStatus_Tenants(t *testing.T) {
+	rr := NewRetentionResources(&fakeRetention{status: retention.Status{
+		Interval: time.Hour,
+		Policies: []retention.PolicyStatus{{Name: "movie_history", MaxDays: 90, Enabled: true, TotalPurged: 1}},
+		Tenants: []retention.TenantStatus{
+			{Tenant: "acme", Policies: []retention.PolicyStatus{{Name: "movie_history", MaxDays: 90, Enabled: true, TotalPurged: 3}}},
+		},
+	}})
+
+	result, err := rr.HandleRetentionStatus(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("HandleRetentionStatus() error = %v", err)
+	}
+	var report struct {
+		Tenants []struct {
+			Tenant   string `json:"tenant"`
+			Policies []struct {
+				TotalPurged int `json:"total_purged"`
+			} `json:"policies"`
+		} `json:"tenants"`
+	}
+	if err := json.Unmarshal([]byte(result.Contents[0].Text), &report); err != nil {
+		t.Fatalf("Failed to unmarshal JSON: %v", err)
+	}
+
+	if len(report.Tenants) != 1 || report.Tenants[0].Tenant != "acme" || report.Tenants[0].Policies[0].TotalPurged != 3 {
+		t.Errorf("Expected acme's purges, got: %+v", report.Tenants)
+	}
+}
@@ -0,0 +1,89 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+	"github.com/francknouama/movies-mcp-server/pkg/serialization"
+)
+
+// SlowQueryReporter provides the recently recorded slow statements
+type SlowQueryReporter interface {
+	Threshold() time.Duration
+	Recent(ctx context.Context) []database.SlowQuery
+}
+
+// SlowQueryResources handles the slow query diagnostic resource
+type SlowQueryResources struct {
+	reporter SlowQueryReporter
+}
+
+// NewSlowQueryResources creates a slow query resource handler; a nil reporter
+// reports slow query logging as disabled
+func NewSlowQueryResources(reporter SlowQueryReporter) *SlowQueryResources {
+	return &SlowQueryResources{reporter: reporter}
+}
+
+// SlowQueriesResource returns the slow query resource definition
+func (sr *SlowQueryResources) SlowQueriesResource() *mcp.Resource {
+	return &mcp.Resource{
+		URI:         "movies://server/slow-queries",
+		Name:        "Slow Queries",
+		Description: "Recent repository statements slower than DB_SLOW_QUERY_THRESHOLD, with their query plans",
+		MIMEType:    "application/json",
+	}
+}
+
+// HandleSlowQueries handles the movies://server/slow-queries resource request
+func (sr *SlowQueryResources) HandleSlowQueries(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	report := map[string]interface{}{
+		"enabled": sr.reporter != nil,
+		"queries": []map[string]interface{}{},
+	}
+	if sr.reporter != nil {
+		report["threshold_ms"] = float64(sr.reporter.Threshold().Microseconds()) / 1000
+		report["queries"] = slowQueriesReport(sr.reporter.Recent(ctx))
+	}
+
+	reportJSON, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal slow queries to JSON: %w", err)
+	}
+
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{
+			{
+				URI:      "movies://server/slow-queries",
+				MIMEType: "application/json",
+				Text:     string(reportJSON),
+			},
+		},
+	}, nil
+}
+
+// slowQueriesReport formats slow statements, newest first
+func slowQueriesReport(queries []database.SlowQuery) []map[string]interface{} {
+	report := make([]map[string]interface{}, 0, len(queries))
+	for i := len(queries) - 1; i >= 0; i-- {
+		query := queries[i]
+		entry := map[string]interface{}{
+			"at":          serialization.Timestamp(query.At),
+			"query":       query.Query,
+			"duration_ms": float64(query.Duration.Microseconds()) / 1000,
+			"plan":        query.Plan,
+		}
+		if query.Tenant != "" {
+			entry["tenant"] = query.Tenant
+		}
+		if query.PlanErr != "" {
+			entry["plan_error"] = query.PlanErr
+		}
+		report = append(report, entry)
+	}
+	return report
+}
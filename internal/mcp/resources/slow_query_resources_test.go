@@ -0,0 +1,71 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+// fakeSlowQueries reports fixed slow statements
+type fakeSlowQueries struct {
+	queries []database.SlowQuery
+}
+
+func (f *fakeSlowQueries) Threshold() time.Duration {
+	return 100 * time.Millisecond
+}
+
+func (f *fakeSlowQueries) Recent(ctx context.Context) []database.SlowQuery {
+	return f.queries
+}
+
+func readSlowQueries(t *testing.T, sr *SlowQueryResources) map[string]interface{} {
+	t.Helper()
+
+	result, err := sr.HandleSlowQueries(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("HandleSlowQueries() error = %v", err)
+	}
+	var report map[string]interface{}
+	if err := json.Unmarshal([]byte(result.Contents[0].Text), &report); err != nil {
+		t.Fatalf("Failed to unmarshal JSON: %v", err)
+	}
+	return report
+}
+
+func TestHandleSlowQueries_NewestFirst(t *testing.T) {
+	sr := NewSlowQueryResources(&fakeSlowQueries{queries: []database.SlowQuery{
+		{Query: "SELECT 1", Duration: 150 * time.Millisecond, Plan: []string{}},
+		{Query: "SELECT * FROM movies", Duration: 1500 * time.Microsecond, Tenant: "acme", Plan: []string{"SCAN movies"}},
+	}})
+
+	report := readSlowQueries(t, sr)
+	if report["enabled"] != true || report["threshold_ms"].(float64) != 100 {
+		t.Errorf("Expected an enabled log at 100ms, got: %v", report)
+	}
+	queries := report["queries"].([]interface{})
+	if len(queries) != 2 {
+		t.Fatalf("Expected 2 queries, got: %v", queries)
+	}
+	newest := queries[0].(map[string]interface{})
+	if newest["query"] != "SELECT * FROM movies" || newest["tenant"] != "acme" || newest["duration_ms"].(float64) != 1.5 {
+		t.Errorf("Expected the newest statement first, got: %v", newest)
+	}
+	if plan := newest["plan"].([]interface{}); len(plan) != 1 || plan[0] != "SCAN movies" {
+		t.Errorf("Expected the query plan, got: %v", plan)
+	}
+}
+
+func TestHandleSlowQueries_Disabled(t *testing.T) {
+	report := readSlowQueries(t, NewSlowQueryResources(nil))
+
+	if report["enabled"] != false || len(report["queries"].([]interface{})) != 0 {
+		t.Errorf("Expected a disabled log with no queries, got: %v", report)
+	}
+	if _, ok := report["threshold_ms"]; ok {
+		t.Errorf("Expected no threshold when disabled, got: %v", report["threshold_ms"])
+	}
+}
@@ -0,0 +1,115 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// StatusWriter periodically writes resources to a status file. A stdio
+// server's pipes belong to its client, so this is how operators' tools such
+// as cmd/movies-tui inspect a running server.
+type StatusWriter struct {
+	path      string
+	interval  time.Duration
+	resources map[string]mcp.ResourceHandler
+	stop      chan struct{}
+	stopOnce  sync.Once
+}
+
+// Status is the contents of a status file: the JSON of each resource under
+// its name
+type Status struct {
+	WrittenAt time.Time                  `json:"written_at"`
+	Resources map[string]json.RawMessage `json:"resources"`
+	Errors    map[string]string          `json:"errors,omitempty"`
+}
+
+// NewStatusWriter creates a writer of the named resources to path every interval
+func NewStatusWriter(path string, interval time.Duration, resources map[string]mcp.ResourceHandler) *StatusWriter {
+	return &StatusWriter{
+		path:      path,
+		interval:  interval,
+		resources: resources,
+		stop:      make(chan struct{}),
+	}
+}
+
+// Start writes the status file now and then every interval in the background
+// until Stop is called or ctx is done
+func (w *StatusWriter) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			_ = w.Write(ctx) // A missed write is retried on the next tick
+			select {
+			case <-ticker.C:
+			case <-w.stop:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop halts background writes
+func (w *StatusWriter) Stop() {
+	w.stopOnce.Do(func() { close(w.stop) })
+}
+
+// Write reads every resource and replaces the status file with the results.
+// A resource that fails is recorded under errors instead; the file is
+// renamed into place so readers never see a partial write.
+func (w *StatusWriter) Write(ctx context.Context) error {
+	status := Status{WrittenAt: time.Now().UTC(), Resources: make(map[string]json.RawMessage)}
+	for name, handler := range w.resources {
+		result, err := handler(ctx, &mcp.ReadResourceRequest{})
+		switch {
+		case err != nil:
+			status.addError(name, err.Error())
+		case len(result.Contents) == 0 || !json.Valid([]byte(result.Contents[0].Text)):
+			status.addError(name, "resource returned no JSON")
+		default:
+			status.Resources[name] = json.RawMessage(result.Contents[0].Text)
+		}
+	}
+
+	data, err := json.MarshalIndent(status, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal status: %w", err)
+	}
+
+	temp, err := os.CreateTemp(filepath.Dir(w.path), filepath.Base(w.path)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to create status file: %w", err)
+	}
+	defer os.Remove(temp.Name()) // No-op once renamed
+	if _, err := temp.Write(data); err != nil {
+		temp.Close()
+		return fmt.Errorf("failed to write status file: %w", err)
+	}
+	if err := temp.Close(); err != nil {
+		return fmt.Errorf("failed to write status file: %w", err)
+	}
+	if err := os.Rename(temp.Name(), w.path); err != nil {
+		return fmt.Errorf("failed to replace status file: %w", err)
+	}
+	return nil
+}
+
+// addError records a resource that could not be read
+func (s *Status) addError(name, message string) {
+	if s.Errors == nil {
+		s.Errors = make(map[string]string)
+	}
+	s.Errors[name] = message
+}
@@ -0,0 +1,85 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// jsonResource returns a handler serving text
+func jsonResource(text string) mcp.ResourceHandler {
+	return func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		return &mcp.ReadResourceResult{Contents: []*mcp.ResourceContents{{URI: "test://status", Text: text}}}, nil
+	}
+}
+
+func readStatus(t *testing.T, path string) Status {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read status file: %v", err)
+	}
+	var status Status
+	if err := json.Unmarshal(data, &status); err != nil {
+		t.Fatalf("failed to decode status file: %v", err)
+	}
+	return status
+}
+
+func TestStatusWriter_Write(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "status.json")
+	writer := NewStatusWriter(path, time.Hour, map[string]mcp.ResourceHandler{
+		"health": jsonResource(`{"status":"ok"}`),
+		"stats":  jsonResource("not json"),
+		"broken": func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+			return nil, errors.New("database is locked")
+		},
+	})
+
+	if err := writer.Write(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	status := readStatus(t, path)
+	var health map[string]string
+	if err := json.Unmarshal(status.Resources["health"], &health); err != nil || health["status"] != "ok" || status.WrittenAt.IsZero() {
+		t.Errorf("Expected the health resource and a write time, got: %+v", status)
+	}
+	if status.Errors["broken"] != "database is locked" || status.Errors["stats"] == "" {
+		t.Errorf("Expected failed reads to be recorded, got: %v", status.Errors)
+	}
+	if entries, _ := os.ReadDir(filepath.Dir(path)); len(entries) != 1 {
+		t.Errorf("Expected only the status file to be left, got: %d entries", len(entries))
+	}
+}
+
+func TestStatusWriter_Start(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "status.json")
+	writer := NewStatusWriter(path, 10*time.Millisecond, map[string]mcp.ResourceHandler{"health": jsonResource(`{}`)})
+	writer.Start(context.Background())
+	defer writer.Stop()
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		if _, err := os.Stat(path); err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Expected the status file to be written on start")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	first := readStatus(t, path).WrittenAt
+
+	time.Sleep(50 * time.Millisecond)
+	if second := readStatus(t, path).WrittenAt; !second.After(first) {
+		t.Errorf("Expected the status file to be rewritten, got: %v then %v", first, second)
+	}
+}
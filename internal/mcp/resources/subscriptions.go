@@ -0,0 +1,155 @@
+package resources
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/application/events"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/middleware"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+// movieResourceURIs are the resources built from the movie table, which
+// change whenever a movie is written
+var movieResourceURIs = []string{
+	"movies://database/all",
+	"movies://database/stats",
+	"movies://database/genres",
+	"movies://database/directors",
+	"movies://posters/collection",
+}
+
+// movieChangeEvents are the event types published after movies are written
+var movieChangeEvents = map[string]bool{
+	"movie.created":     true,
+	"movie.updated":     true,
+	"movie.deleted":     true,
+	"movie.reverted":    true,
+	"movies.imported":   true,
+	"movies.updated":    true,
+	"database.seeded":   true,
+	"database.restored": true,
+}
+
+// ChangeNotifier tells subscribed clients that the movie resources changed.
+// Subscribe it to the event bus and use its handlers as the server's
+// subscribe handlers. The SDK tracks which sessions subscribed to which
+// URI; the notifier also records the tenant each subscription was made for,
+// so a change to one tenant's library is only announced to that tenant's
+// subscribers.
+type ChangeNotifier struct {
+	mutex         sync.Mutex
+	server        *mcp.Server
+	subscriptions map[*mcp.ServerSession]map[string]map[string]bool // Session -> URI -> tenants
+}
+
+// NewChangeNotifier creates a notifier; Attach it to the server before
+// serving
+func NewChangeNotifier() *ChangeNotifier {
+	return &ChangeNotifier{
+		subscriptions: make(map[*mcp.ServerSession]map[string]map[string]bool),
+	}
+}
+
+// Attach sends the notifier's updates through server, holding each one back
+// from the sessions that did not subscribe for the tenant it is about
+func (n *ChangeNotifier) Attach(server *mcp.Server) {
+	n.mutex.Lock()
+	n.server = server
+	n.mutex.Unlock()
+	server.AddSendingMiddleware(n.filterUpdates)
+}
+
+// HandleSubscribe is the server's subscribe handler: it accepts the resources
+// that send updates, for the tenant the request is served for, and rejects
+// any other URI as not found
+func (n *ChangeNotifier) HandleSubscribe(ctx context.Context, req *mcp.SubscribeRequest) error {
+	if !slices.Contains(movieResourceURIs, req.Params.URI) {
+		return mcp.ResourceNotFoundError(req.Params.URI)
+	}
+	if req.Session == nil {
+		return nil
+	}
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	uris, ok := n.subscriptions[req.Session]
+	if !ok {
+		uris = make(map[string]map[string]bool)
+		n.subscriptions[req.Session] = uris
+		go n.forget(req.Session)
+	}
+	if uris[req.Params.URI] == nil {
+		uris[req.Params.URI] = make(map[string]bool)
+	}
+	uris[req.Params.URI][database.TenantFromContext(ctx)] = true
+	return nil
+}
+
+// HandleUnsubscribe rejects URIs that cannot be subscribed to. Like the SDK,
+// it ends the session's subscription to the URI for every tenant.
+func (n *ChangeNotifier) HandleUnsubscribe(ctx context.Context, req *mcp.UnsubscribeRequest) error {
+	if !slices.Contains(movieResourceURIs, req.Params.URI) {
+		return mcp.ResourceNotFoundError(req.Params.URI)
+	}
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	delete(n.subscriptions[req.Session], req.Params.URI)
+	return nil
+}
+
+// forget drops a session's subscriptions once its client disconnects
+func (n *ChangeNotifier) forget(session *mcp.ServerSession) {
+	_ = session.Wait()
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	delete(n.subscriptions, session)
+}
+
+// subscribed reports whether session subscribed to uri for tenant
+func (n *ChangeNotifier) subscribed(session *mcp.ServerSession, uri, tenant string) bool {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.subscriptions[session][uri][tenant]
+}
+
+// filterUpdates drops the resource updated notifications about a tenant,
+// named in their _meta, that the receiving session did not subscribe for
+func (n *ChangeNotifier) filterUpdates(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		if update, ok := req.(*mcp.ServerRequest[*mcp.ResourceUpdatedNotificationParams]); ok {
+			tenant, _ := update.Params.Meta[middleware.TenantKey].(string)
+			if !n.subscribed(update.Session, update.Params.URI, tenant) {
+				return nil, nil
+			}
+		}
+		return next(ctx, method, req)
+	}
+}
+
+// Notify sends an update for each movie resource after a movie change
+// event, naming the event's tenant in _meta. The notifications are sent in
+// the background so a slow client does not hold up the tool call that made
+// the change.
+func (n *ChangeNotifier) Notify(event events.Event) {
+	n.mutex.Lock()
+	server := n.server
+	n.mutex.Unlock()
+	if server == nil || !movieChangeEvents[event.Type] {
+		return
+	}
+	go func() {
+		for _, uri := range movieResourceURIs {
+			params := &mcp.ResourceUpdatedNotificationParams{URI: uri}
+			if event.Tenant != "" {
+				params.Meta = mcp.Meta{middleware.TenantKey: event.Tenant}
+			}
+			_ = server.ResourceUpdated(context.Background(), params)
+		}
+	}()
+}
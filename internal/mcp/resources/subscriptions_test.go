@@ -0,0 +1,198 @@
+package resources
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/application/events"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/middleware"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+// MockTenantResolver routes a context to a tenant without a database
+type MockTenantResolver struct{}
+
+func (MockTenantResolver) WithTenant(ctx context.Context, tenant string) (context.Context, error) {
+	return database.WithTenant(ctx, tenant, nil), nil
+}
+
+// notifyingServer creates a server with the notifier's subscription
+// handlers, publishing the bus's changes and routing requests to the
+// tenants they name
+func notifyingServer(t *testing.T) (*mcp.Server, *events.Bus) {
+	t.Helper()
+
+	notifier := NewChangeNotifier()
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, &mcp.ServerOptions{
+		SubscribeHandler:   notifier.HandleSubscribe,
+		UnsubscribeHandler: notifier.HandleUnsubscribe,
+		HasResources:       true,
+	})
+	server.AddReceivingMiddleware(middleware.Tenants(MockTenantResolver{}))
+	notifier.Attach(server)
+
+	bus := events.NewBus(10)
+	bus.Subscribe(notifier)
+	return server, bus
+}
+
+// subscribedClient connects a client to server and collects the URIs of
+// the updates it receives
+func subscribedClient(t *testing.T, server *mcp.Server) (*mcp.ClientSession, func() []string) {
+	t.Helper()
+
+	var mutex sync.Mutex
+	var updated []string
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "1.0.0"}, &mcp.ClientOptions{
+		ResourceUpdatedHandler: func(ctx context.Context, req *mcp.ResourceUpdatedNotificationRequest) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			updated = append(updated, req.Params.URI)
+		},
+	})
+
+	ctx := context.Background()
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	if err != nil {
+		t.Fatalf("failed to connect server: %v", err)
+	}
+	t.Cleanup(func() { serverSession.Close() })
+	session, err := client.Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("failed to connect client: %v", err)
+	}
+	t.Cleanup(func() { session.Close() })
+
+	return session, func() []string {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return append([]string(nil), updated...)
+	}
+}
+
+func waitForUpdates(t *testing.T, updates func() []string, count int) []string {
+	t.Helper()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if got := updates(); len(got) >= count {
+			return got
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("Timed out waiting for %d updates, got: %v", count, updates())
+	return nil
+}
+
+func TestChangeNotifier_NotifiesSubscribers(t *testing.T) {
+	// Arrange
+	server, bus := notifyingServer(t)
+	session, updates := subscribedClient(t, server)
+
+	for _, uri := range []string{"movies://database/stats", "movies://database/all"} {
+		if err := session.Subscribe(context.Background(), &mcp.SubscribeParams{URI: uri}); err != nil {
+			t.Fatalf("Expected to subscribe to %s, got: %v", uri, err)
+		}
+	}
+
+	// Act
+	bus.Publish(context.Background(), "actor.created", "add_actor", nil)
+	bus.Publish(context.Background(), "movie.created", "add_movie", nil)
+
+	// Assert: only the movie change is announced, for the subscribed URIs
+	waitForUpdates(t, updates, 2)
+	time.Sleep(20 * time.Millisecond)
+	got := updates()
+	sort.Strings(got)
+	if len(got) != 2 || got[0] != "movies://database/all" || got[1] != "movies://database/stats" {
+		t.Errorf("Expected updates for the two subscribed resources, got: %v", got)
+	}
+}
+
+func TestChangeNotifier_Unsubscribe(t *testing.T) {
+	server, bus := notifyingServer(t)
+	session, updates := subscribedClient(t, server)
+
+	ctx := context.Background()
+	if err := session.Subscribe(ctx, &mcp.SubscribeParams{URI: "movies://database/stats"}); err != nil {
+		t.Fatalf("Expected to subscribe, got: %v", err)
+	}
+	if err := session.Subscribe(ctx, &mcp.SubscribeParams{URI: "movies://posters/collection"}); err != nil {
+		t.Fatalf("Expected to subscribe, got: %v", err)
+	}
+	if err := session.Unsubscribe(ctx, &mcp.UnsubscribeParams{URI: "movies://database/stats"}); err != nil {
+		t.Fatalf("Expected to unsubscribe, got: %v", err)
+	}
+
+	bus.Publish(context.Background(), "movie.deleted", "delete_movie", nil)
+
+	waitForUpdates(t, updates, 1)
+	time.Sleep(20 * time.Millisecond)
+	if got := updates(); len(got) != 1 || got[0] != "movies://posters/collection" {
+		t.Errorf("Expected only the still subscribed resource to update, got: %v", got)
+	}
+}
+
+func TestChangeNotifier_TenantIsolation(t *testing.T) {
+	// Arrange: one client subscribes for acme, the other for the default library
+	server, bus := notifyingServer(t)
+	acmeSession, acmeUpdates := subscribedClient(t, server)
+	defaultSession, defaultUpdates := subscribedClient(t, server)
+
+	ctx := context.Background()
+	uri := "movies://database/stats"
+	if err := acmeSession.Subscribe(ctx, &mcp.SubscribeParams{Meta: mcp.Meta{"tenant": "acme"}, URI: uri}); err != nil {
+		t.Fatalf("Expected to subscribe for acme, got: %v", err)
+	}
+	if err := defaultSession.Subscribe(ctx, &mcp.SubscribeParams{URI: uri}); err != nil {
+		t.Fatalf("Expected to subscribe, got: %v", err)
+	}
+
+	// Act: movie 7 changes in each library in turn
+	bus.Publish(database.WithTenant(ctx, "acme", nil), "movie.updated", "update_movie", map[string]any{"id": 7})
+	waitForUpdates(t, acmeUpdates, 1)
+	time.Sleep(20 * time.Millisecond)
+	if got := defaultUpdates(); len(got) != 0 {
+		t.Errorf("Expected no update for the default library's subscriber, got: %v", got)
+	}
+
+	bus.Publish(database.WithTenant(ctx, "globex", nil), "movie.updated", "update_movie", map[string]any{"id": 7})
+	bus.Publish(ctx, "movie.deleted", "delete_movie", map[string]any{"id": 7})
+	waitForUpdates(t, defaultUpdates, 1)
+	time.Sleep(20 * time.Millisecond)
+
+	// Assert: each client heard only about its own tenant
+	if got := acmeUpdates(); len(got) != 1 {
+		t.Errorf("Expected acme's subscriber to hear only acme's change, got: %v", got)
+	}
+	if got := defaultUpdates(); len(got) != 1 {
+		t.Errorf("Expected the default subscriber to hear only the default change, got: %v", got)
+	}
+}
+
+func TestHandleSubscribe_UnknownResource(t *testing.T) {
+	server, _ := notifyingServer(t)
+	session, _ := subscribedClient(t, server)
+
+	err := session.Subscribe(context.Background(), &mcp.SubscribeParams{URI: "movies://server/health"})
+
+	if err == nil {
+		t.Error("Expected an error subscribing to a resource without updates")
+	}
+}
+
+func TestSubscribeCapability(t *testing.T) {
+	server, _ := notifyingServer(t)
+	session, _ := subscribedClient(t, server)
+
+	capabilities := session.InitializeResult().Capabilities
+	if capabilities.Resources == nil || !capabilities.Resources.Subscribe {
+		t.Errorf("Expected the server to advertise resource subscriptions, got: %+v", capabilities.Resources)
+	}
+}
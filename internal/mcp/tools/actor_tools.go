@@ -2,12 +2,17 @@ package tools
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 
 	actorApp "github.com/francknouama/movies-mcp-server/internal/application/actor"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/i18n"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/middleware"
 )
 
 // ActorService defines the interface for actor operations
@@ -16,7 +21,7 @@ type ActorService interface {
 	GetActor(ctx context.Context, id int) (*actorApp.ActorDTO, error)
 	UpdateActor(ctx context.Context, cmd actorApp.UpdateActorCommand) (*actorApp.ActorDTO, error)
 	DeleteActor(ctx context.Context, id int) error
-	LinkActorToMovie(ctx context.Context, actorID, movieID int) error
+	LinkActorToMovie(ctx context.Context, cmd actorApp.LinkActorCommand) (*actorApp.CreditDTO, error)
 	UnlinkActorFromMovie(ctx context.Context, actorID, movieID int) error
 	GetActorsByMovie(ctx context.Context, movieID int) ([]*actorApp.ActorDTO, error)
 	SearchActors(ctx context.Context, query actorApp.SearchActorsQuery) ([]*actorApp.ActorDTO, error)
@@ -25,6 +30,7 @@ type ActorService interface {
 // ActorTools provides SDK-based MCP handlers for actor operations
 type ActorTools struct {
 	actorService ActorService
+	movies       MovieBatchGetter
 }
 
 // NewActorTools creates a new actor tools instance
@@ -38,20 +44,101 @@ func NewActorTools(actorService ActorService) *ActorTools {
 
 // ActorOutput defines the common output schema for actor data
 type ActorOutput struct {
-	ID        int    `json:"id" jsonschema:"description=Actor ID"`
-	Name      string `json:"name" jsonschema:"description=Actor name"`
-	BirthYear int    `json:"birth_year,omitempty" jsonschema:"description=Birth year"`
-	Bio       string `json:"bio,omitempty" jsonschema:"description=Biography"`
-	MovieIDs  []int  `json:"movie_ids" jsonschema:"description=List of movie IDs the actor appears in"`
-	CreatedAt string `json:"created_at" jsonschema:"description=Creation timestamp"`
-	UpdatedAt string `json:"updated_at" jsonschema:"description=Last update timestamp"`
+	ID          int                `json:"id" jsonschema:"Actor ID"`
+	Name        string             `json:"name" jsonschema:"Actor name"`
+	BirthYear   int                `json:"birth_year,omitempty" jsonschema:"Birth year"`
+	DeathYear   int                `json:"death_year,omitempty" jsonschema:"Death year; omitted while the actor is alive"`
+	Nationality string             `json:"nationality,omitempty" jsonschema:"Nationality"`
+	Bio         string             `json:"bio,omitempty" jsonschema:"Biography"`
+	BioSections []BioSectionOutput `json:"bio_sections,omitempty" jsonschema:"Titled biography sections, in order"`
+	PhotoURL    string             `json:"photo_url,omitempty" jsonschema:"URL of the actor's photo"`
+	PhotoURI    string             `json:"photo_uri,omitempty" jsonschema:"Resource URI of the stored photo image, if one was uploaded"`
+	MovieIDs    []int              `json:"movie_ids" jsonschema:"List of movie IDs the actor appears in"`
+	CreatedAt   string             `json:"created_at" jsonschema:"Creation timestamp"`
+	UpdatedAt   string             `json:"updated_at" jsonschema:"Last update timestamp"`
+
+	Similarity float64 `json:"similarity,omitempty" jsonschema:"Fuzzy match score (0-1), only set by fuzzy searches"`
+}
+
+// newActorOutput converts an actor DTO to the shared output format
+func newActorOutput(actorDTO *actorApp.ActorDTO) ActorOutput {
+	movieIDs := actorDTO.MovieIDs
+	if movieIDs == nil {
+		movieIDs = []int{}
+	}
+	var sections []BioSectionOutput
+	for _, section := range actorDTO.BioSections {
+		sections = append(sections, BioSectionOutput(section))
+	}
+	output := ActorOutput{
+		ID:          actorDTO.ID,
+		Name:        actorDTO.Name,
+		BirthYear:   actorDTO.BirthYear,
+		DeathYear:   actorDTO.DeathYear,
+		Nationality: actorDTO.Nationality,
+		Bio:         actorDTO.Bio,
+		BioSections: sections,
+		PhotoURL:    actorDTO.PhotoURL,
+		MovieIDs:    movieIDs,
+		CreatedAt:   actorDTO.CreatedAt,
+		UpdatedAt:   actorDTO.UpdatedAt,
+
+		Similarity: actorDTO.Similarity,
+	}
+	if actorDTO.HasPhoto {
+		output.PhotoURI = actorPhotoURI(actorDTO.ID)
+	}
+	return output
+}
+
+// BioSectionOutput defines the output schema for a biography section
+type BioSectionOutput struct {
+	Title string `json:"title" jsonschema:"Section title"`
+	Text  string `json:"text" jsonschema:"Section text"`
+}
+
+// BioSectionInput defines the input schema for a biography section
+type BioSectionInput struct {
+	Title string `json:"title" jsonschema:"Section title, e.g. Early life or Career; unique per actor"`
+	Text  string `json:"text" jsonschema:"Section text"`
+}
+
+// newBioSectionDTOs converts biography section input to the actor command format
+func newBioSectionDTOs(sections []BioSectionInput) []actorApp.BioSectionDTO {
+	dtos := make([]actorApp.BioSectionDTO, len(sections))
+	for i, section := range sections {
+		dtos[i] = actorApp.BioSectionDTO(section)
+	}
+	return dtos
+}
+
+// checkBiographyLength limits the biography and each biography section
+func checkBiographyLength(bio string, sections []BioSectionInput) error {
+	if err := checkTextLength("bio", bio); err != nil {
+		return err
+	}
+	for i, section := range sections {
+		if err := checkTextLength(fmt.Sprintf("bio_sections[%d].text", i), section.Text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newActorOutputs converts a list of actor DTOs to the shared output format
+func newActorOutputs(actorDTOs []*actorApp.ActorDTO) []ActorOutput {
+	actors := make([]ActorOutput, len(actorDTOs))
+	for i, actorDTO := range actorDTOs {
+		actors[i] = newActorOutput(actorDTO)
+	}
+	return actors
 }
 
 // ===== get_actor Tool =====
 
 // GetActorInput defines the input schema for get_actor tool
 type GetActorInput struct {
-	ActorID int `json:"actor_id" jsonschema:"required,description=The actor ID to retrieve"`
+	ActorID int `json:"actor_id" jsonschema:"The actor ID to retrieve"`
 }
 
 // GetActor handles the get_actor tool call
@@ -62,32 +149,33 @@ func (t *ActorTools) GetActor(
 ) (*mcp.CallToolResult, ActorOutput, error) {
 	actorDTO, err := t.actorService.GetActor(ctx, input.ActorID)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return nil, ActorOutput{}, fmt.Errorf("actor not found")
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil, ActorOutput{}, shared.NewNotFoundError("actor not found")
 		}
 		return nil, ActorOutput{}, fmt.Errorf("failed to get actor: %w", err)
 	}
 
-	output := ActorOutput{
-		ID:        actorDTO.ID,
-		Name:      actorDTO.Name,
-		BirthYear: actorDTO.BirthYear,
-		Bio:       actorDTO.Bio,
-		MovieIDs:  actorDTO.MovieIDs,
-		CreatedAt: actorDTO.CreatedAt,
-		UpdatedAt: actorDTO.UpdatedAt,
-	}
+	output := newActorOutput(actorDTO)
 
-	return nil, output, nil
+	return summaryResult(ctx, output, "Actor %d: %s, in %s", output.ID, output.Name, countNoun(ctx, len(output.MovieIDs), "movie", "movies")), output, nil
 }
 
 // ===== add_actor Tool =====
 
 // AddActorInput defines the input schema for add_actor tool
 type AddActorInput struct {
-	Name      string `json:"name" jsonschema:"required,description=Actor name"`
-	BirthYear int    `json:"birth_year,omitempty" jsonschema:"description=Birth year"`
-	Bio       string `json:"bio,omitempty" jsonschema:"description=Biography"`
+	Name        string            `json:"name" jsonschema:"Actor name"`
+	BirthYear   int               `json:"birth_year,omitempty" jsonschema:"Birth year"`
+	DeathYear   int               `json:"death_year,omitempty" jsonschema:"Death year, no earlier than the birth year; omit while the actor is alive"`
+	Nationality string            `json:"nationality,omitempty" jsonschema:"Nationality, e.g. Canadian"`
+	Bio         string            `json:"bio,omitempty" jsonschema:"Biography"`
+	BioSections []BioSectionInput `json:"bio_sections,omitempty" jsonschema:"Titled biography sections, in display order"`
+	PhotoURL    string            `json:"photo_url,omitempty" jsonschema:"URL of the actor's photo (http or https)"`
+}
+
+// Validate limits the biography
+func (in AddActorInput) Validate() error {
+	return checkBiographyLength(in.Bio, in.BioSections)
 }
 
 // AddActor handles the add_actor tool call
@@ -97,9 +185,13 @@ func (t *ActorTools) AddActor(
 	input AddActorInput,
 ) (*mcp.CallToolResult, ActorOutput, error) {
 	cmd := actorApp.CreateActorCommand{
-		Name:      input.Name,
-		BirthYear: input.BirthYear,
-		Bio:       input.Bio,
+		Name:        input.Name,
+		BirthYear:   input.BirthYear,
+		DeathYear:   input.DeathYear,
+		Nationality: input.Nationality,
+		Bio:         input.Bio,
+		BioSections: newBioSectionDTOs(input.BioSections),
+		PhotoURL:    input.PhotoURL,
 	}
 
 	actorDTO, err := t.actorService.CreateActor(ctx, cmd)
@@ -107,27 +199,28 @@ func (t *ActorTools) AddActor(
 		return nil, ActorOutput{}, fmt.Errorf("failed to create actor: %w", err)
 	}
 
-	output := ActorOutput{
-		ID:        actorDTO.ID,
-		Name:      actorDTO.Name,
-		BirthYear: actorDTO.BirthYear,
-		Bio:       actorDTO.Bio,
-		MovieIDs:  actorDTO.MovieIDs,
-		CreatedAt: actorDTO.CreatedAt,
-		UpdatedAt: actorDTO.UpdatedAt,
-	}
+	output := newActorOutput(actorDTO)
 
-	return nil, output, nil
+	return summaryResult(ctx, output, "Added actor %d: %s", output.ID, output.Name), output, nil
 }
 
 // ===== update_actor Tool =====
 
 // UpdateActorInput defines the input schema for update_actor tool
 type UpdateActorInput struct {
-	ID        int    `json:"id" jsonschema:"required,description=Actor ID"`
-	Name      string `json:"name" jsonschema:"required,description=Actor name"`
-	BirthYear int    `json:"birth_year,omitempty" jsonschema:"description=Birth year"`
-	Bio       string `json:"bio,omitempty" jsonschema:"description=Biography"`
+	ID          int               `json:"id" jsonschema:"Actor ID"`
+	Name        string            `json:"name" jsonschema:"Actor name"`
+	BirthYear   int               `json:"birth_year,omitempty" jsonschema:"Birth year"`
+	DeathYear   int               `json:"death_year,omitempty" jsonschema:"Death year, no earlier than the birth year; omit while the actor is alive"`
+	Nationality string            `json:"nationality,omitempty" jsonschema:"Nationality, e.g. Canadian"`
+	Bio         string            `json:"bio,omitempty" jsonschema:"Biography"`
+	BioSections []BioSectionInput `json:"bio_sections,omitempty" jsonschema:"Titled biography sections, in display order; replaces the existing sections"`
+	PhotoURL    string            `json:"photo_url,omitempty" jsonschema:"URL of the actor's photo (http or https)"`
+}
+
+// Validate limits the biography
+func (in UpdateActorInput) Validate() error {
+	return checkBiographyLength(in.Bio, in.BioSections)
 }
 
 // UpdateActor handles the update_actor tool call
@@ -137,43 +230,39 @@ func (t *ActorTools) UpdateActor(
 	input UpdateActorInput,
 ) (*mcp.CallToolResult, ActorOutput, error) {
 	cmd := actorApp.UpdateActorCommand{
-		ID:        input.ID,
-		Name:      input.Name,
-		BirthYear: input.BirthYear,
-		Bio:       input.Bio,
+		ID:          input.ID,
+		Name:        input.Name,
+		BirthYear:   input.BirthYear,
+		DeathYear:   input.DeathYear,
+		Nationality: input.Nationality,
+		Bio:         input.Bio,
+		BioSections: newBioSectionDTOs(input.BioSections),
+		PhotoURL:    input.PhotoURL,
 	}
 
 	actorDTO, err := t.actorService.UpdateActor(ctx, cmd)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return nil, ActorOutput{}, fmt.Errorf("actor not found")
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil, ActorOutput{}, shared.NewNotFoundError("actor not found")
 		}
 		return nil, ActorOutput{}, fmt.Errorf("failed to update actor: %w", err)
 	}
 
-	output := ActorOutput{
-		ID:        actorDTO.ID,
-		Name:      actorDTO.Name,
-		BirthYear: actorDTO.BirthYear,
-		Bio:       actorDTO.Bio,
-		MovieIDs:  actorDTO.MovieIDs,
-		CreatedAt: actorDTO.CreatedAt,
-		UpdatedAt: actorDTO.UpdatedAt,
-	}
+	output := newActorOutput(actorDTO)
 
-	return nil, output, nil
+	return summaryResult(ctx, output, "Updated actor %d: %s", output.ID, output.Name), output, nil
 }
 
 // ===== delete_actor Tool =====
 
 // DeleteActorInput defines the input schema for delete_actor tool
 type DeleteActorInput struct {
-	ActorID int `json:"actor_id" jsonschema:"required,description=The actor ID to delete"`
+	ActorID int `json:"actor_id" jsonschema:"The actor ID to delete"`
 }
 
 // DeleteActorOutput defines the output schema for delete_actor tool
 type DeleteActorOutput struct {
-	Message string `json:"message" jsonschema:"description=Success message"`
+	Message string `json:"message" jsonschema:"Success message"`
 }
 
 // DeleteActor handles the delete_actor tool call
@@ -184,8 +273,8 @@ func (t *ActorTools) DeleteActor(
 ) (*mcp.CallToolResult, DeleteActorOutput, error) {
 	err := t.actorService.DeleteActor(ctx, input.ActorID)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return nil, DeleteActorOutput{}, fmt.Errorf("actor not found")
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil, DeleteActorOutput{}, shared.NewNotFoundError("actor not found")
 		}
 		return nil, DeleteActorOutput{}, fmt.Errorf("failed to delete actor: %w", err)
 	}
@@ -194,20 +283,32 @@ func (t *ActorTools) DeleteActor(
 		Message: "Actor deleted successfully",
 	}
 
-	return nil, output, nil
+	return summaryResult(ctx, output, "Deleted actor %d", input.ActorID), output, nil
 }
 
 // ===== link_actor_to_movie Tool =====
 
 // LinkActorToMovieInput defines the input schema for link_actor_to_movie tool
 type LinkActorToMovieInput struct {
-	ActorID int `json:"actor_id" jsonschema:"required,description=Actor ID"`
-	MovieID int `json:"movie_id" jsonschema:"required,description=Movie ID"`
+	ActorID      int    `json:"actor_id" jsonschema:"Actor ID"`
+	MovieID      int    `json:"movie_id" jsonschema:"Movie ID"`
+	Character    string `json:"character,omitempty" jsonschema:"Name of the character played"`
+	BillingOrder int    `json:"billing_order,omitempty" jsonschema:"Position in the credits (1 is top-billed)"`
+	RoleType     string `json:"role_type,omitempty" jsonschema:"Role type (lead/supporting/cameo)"`
+}
+
+// CreditOutput defines the output schema for an actor's part in a movie
+type CreditOutput struct {
+	MovieID      int    `json:"movie_id" jsonschema:"Movie ID"`
+	Character    string `json:"character,omitempty" jsonschema:"Character played"`
+	BillingOrder int    `json:"billing_order,omitempty" jsonschema:"Position in the credits (1 is top-billed)"`
+	RoleType     string `json:"role_type,omitempty" jsonschema:"Role type (lead/supporting/cameo)"`
 }
 
 // LinkActorToMovieOutput defines the output schema for link_actor_to_movie tool
 type LinkActorToMovieOutput struct {
-	Message string `json:"message" jsonschema:"description=Success message"`
+	Message string       `json:"message" jsonschema:"Success message"`
+	Credit  CreditOutput `json:"credit" jsonschema:"Recorded credit"`
 }
 
 // LinkActorToMovie handles the link_actor_to_movie tool call
@@ -216,35 +317,47 @@ func (t *ActorTools) LinkActorToMovie(
 	req *mcp.CallToolRequest,
 	input LinkActorToMovieInput,
 ) (*mcp.CallToolResult, LinkActorToMovieOutput, error) {
-	err := t.actorService.LinkActorToMovie(ctx, input.ActorID, input.MovieID)
+	creditDTO, err := t.actorService.LinkActorToMovie(ctx, actorApp.LinkActorCommand{
+		ActorID:      input.ActorID,
+		MovieID:      input.MovieID,
+		Character:    input.Character,
+		BillingOrder: input.BillingOrder,
+		RoleType:     input.RoleType,
+	})
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return nil, LinkActorToMovieOutput{}, fmt.Errorf("actor or movie not found")
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil, LinkActorToMovieOutput{}, shared.NewNotFoundError("actor or movie not found")
 		}
-		if strings.Contains(err.Error(), "already exists") {
-			return nil, LinkActorToMovieOutput{}, fmt.Errorf("actor is already linked to this movie")
+		if errors.Is(err, shared.ErrConflict) {
+			return nil, LinkActorToMovieOutput{}, shared.NewConflictError("actor is already linked to this movie")
 		}
 		return nil, LinkActorToMovieOutput{}, fmt.Errorf("failed to link actor to movie: %w", err)
 	}
 
 	output := LinkActorToMovieOutput{
 		Message: "Actor linked to movie successfully",
+		Credit: CreditOutput{
+			MovieID:      creditDTO.MovieID,
+			Character:    creditDTO.Character,
+			BillingOrder: creditDTO.BillingOrder,
+			RoleType:     creditDTO.RoleType,
+		},
 	}
 
-	return nil, output, nil
+	return summaryResult(ctx, output, "Linked actor %d to movie %d%s", input.ActorID, input.MovieID, characterSummary(output.Credit.Character)), output, nil
 }
 
 // ===== unlink_actor_from_movie Tool =====
 
 // UnlinkActorFromMovieInput defines the input schema for unlink_actor_from_movie tool
 type UnlinkActorFromMovieInput struct {
-	ActorID int `json:"actor_id" jsonschema:"required,description=Actor ID"`
-	MovieID int `json:"movie_id" jsonschema:"required,description=Movie ID"`
+	ActorID int `json:"actor_id" jsonschema:"Actor ID"`
+	MovieID int `json:"movie_id" jsonschema:"Movie ID"`
 }
 
 // UnlinkActorFromMovieOutput defines the output schema for unlink_actor_from_movie tool
 type UnlinkActorFromMovieOutput struct {
-	Message string `json:"message" jsonschema:"description=Success message"`
+	Message string `json:"message" jsonschema:"Success message"`
 }
 
 // UnlinkActorFromMovie handles the unlink_actor_from_movie tool call
@@ -255,8 +368,8 @@ func (t *ActorTools) UnlinkActorFromMovie(
 ) (*mcp.CallToolResult, UnlinkActorFromMovieOutput, error) {
 	err := t.actorService.UnlinkActorFromMovie(ctx, input.ActorID, input.MovieID)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return nil, UnlinkActorFromMovieOutput{}, fmt.Errorf("actor, movie, or link not found")
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil, UnlinkActorFromMovieOutput{}, shared.NewNotFoundError("actor, movie, or link not found")
 		}
 		return nil, UnlinkActorFromMovieOutput{}, fmt.Errorf("failed to unlink actor from movie: %w", err)
 	}
@@ -265,21 +378,70 @@ func (t *ActorTools) UnlinkActorFromMovie(
 		Message: "Actor unlinked from movie successfully",
 	}
 
-	return nil, output, nil
+	return summaryResult(ctx, output, "Unlinked actor %d from movie %d", input.ActorID, input.MovieID), output, nil
 }
 
 // ===== get_movie_cast Tool =====
 
 // GetMovieCastInput defines the input schema for get_movie_cast tool
 type GetMovieCastInput struct {
-	MovieID int `json:"movie_id" jsonschema:"required,description=Movie ID to get cast for"`
+	MovieID int `json:"movie_id" jsonschema:"Movie ID to get cast for"`
+}
+
+// CastMemberOutput defines the output schema for an actor's credit in a movie
+type CastMemberOutput struct {
+	ActorID      int    `json:"actor_id" jsonschema:"Actor ID"`
+	Name         string `json:"name" jsonschema:"Actor name"`
+	MovieID      int    `json:"movie_id" jsonschema:"Movie ID"`
+	Character    string `json:"character,omitempty" jsonschema:"Character played"`
+	BillingOrder int    `json:"billing_order,omitempty" jsonschema:"Position in the credits (1 is top-billed)"`
+	RoleType     string `json:"role_type,omitempty" jsonschema:"Role type (lead/supporting/cameo)"`
+	PhotoURI     string `json:"photo_uri,omitempty" jsonschema:"Resource URI of the actor's stored photo image"`
+
+	AgeAtRelease *int   `json:"age_at_release,omitempty" jsonschema:"Age the actor turned in the movie's release year; they may have been a year younger on the release date. Only get_movie_cast sets it"`
+	AgeUnknown   string `json:"age_unknown,omitempty" jsonschema:"Why get_movie_cast has no age_at_release: birth_year_unknown, release_year_unknown, born_after_release (a likely data error) or posthumous"`
+}
+
+// newCastMemberOutput combines an actor with their credit for a movie
+func newCastMemberOutput(actorDTO *actorApp.ActorDTO, credit actorApp.CreditDTO) CastMemberOutput {
+	output := CastMemberOutput{
+		ActorID:      actorDTO.ID,
+		Name:         actorDTO.Name,
+		MovieID:      credit.MovieID,
+		Character:    credit.Character,
+		BillingOrder: credit.BillingOrder,
+		RoleType:     credit.RoleType,
+	}
+	if actorDTO.HasPhoto {
+		output.PhotoURI = actorPhotoURI(actorDTO.ID)
+	}
+	return output
+}
+
+// movieCredit returns an actor's credit for a movie, with no details if none was recorded
+func movieCredit(actorDTO *actorApp.ActorDTO, movieID int) actorApp.CreditDTO {
+	for _, credit := range actorDTO.Credits {
+		if credit.MovieID == movieID {
+			return credit
+		}
+	}
+	return actorApp.CreditDTO{MovieID: movieID}
+}
+
+// characterSummary describes the character played, if known
+func characterSummary(character string) string {
+	if character == "" {
+		return ""
+	}
+	return " as " + character
 }
 
 // GetMovieCastOutput defines the output schema for get_movie_cast tool
 type GetMovieCastOutput struct {
-	Actors      []ActorOutput `json:"actors" jsonschema:"description=List of actors in the movie"`
-	Total       int           `json:"total" jsonschema:"description=Total number of actors"`
-	Description string        `json:"description" jsonschema:"description=Description of results"`
+	Actors      []ActorOutput      `json:"actors" jsonschema:"List of actors in the movie, in billing order"`
+	Cast        []CastMemberOutput `json:"cast" jsonschema:"Characters and billing for each actor, in the same order"`
+	Total       int                `json:"total" jsonschema:"Total number of actors"`
+	Description string             `json:"description" jsonschema:"Description of results"`
 }
 
 // GetMovieCast handles the get_movie_cast tool call
@@ -293,41 +455,70 @@ func (t *ActorTools) GetMovieCast(
 		return nil, GetMovieCastOutput{}, fmt.Errorf("failed to get movie cast: %w", err)
 	}
 
-	actors := make([]ActorOutput, len(actorDTOs))
+	// Billed actors come first in billing order; the rest keep the service order
+	sort.SliceStable(actorDTOs, func(i, j int) bool {
+		left := movieCredit(actorDTOs[i], input.MovieID).BillingOrder
+		right := movieCredit(actorDTOs[j], input.MovieID).BillingOrder
+		return left > 0 && (right == 0 || left < right)
+	})
+
+	year, err := t.castReleaseYear(ctx, input.MovieID, len(actorDTOs))
+	if err != nil {
+		return nil, GetMovieCastOutput{}, err
+	}
+
+	actors := newActorOutputs(actorDTOs)
+	cast := make([]CastMemberOutput, len(actorDTOs))
 	for i, actorDTO := range actorDTOs {
-		actors[i] = ActorOutput{
-			ID:        actorDTO.ID,
-			Name:      actorDTO.Name,
-			BirthYear: actorDTO.BirthYear,
-			Bio:       actorDTO.Bio,
-			MovieIDs:  actorDTO.MovieIDs,
-			CreatedAt: actorDTO.CreatedAt,
-			UpdatedAt: actorDTO.UpdatedAt,
+		cast[i] = newCastMemberOutput(actorDTO, movieCredit(actorDTO, input.MovieID))
+		if t.movies != nil {
+			age, reason := ageAtRelease(actorDTO, year)
+			if reason == "" {
+				cast[i].AgeAtRelease = &age
+			}
+			cast[i].AgeUnknown = reason
 		}
 	}
 
 	output := GetMovieCastOutput{
 		Actors:      actors,
+		Cast:        cast,
 		Total:       len(actors),
 		Description: fmt.Sprintf("Cast of movie %d", input.MovieID),
 	}
 
-	return nil, output, nil
+	return summaryResult(ctx, output, "%s", actorListSummary(ctx, i18n.FromContext(ctx).Sprintf("Movie %d cast:", input.MovieID), output.Actors)), output, nil
+}
+
+// castReleaseYear reads the release year of a movie with a cast of
+// castSize, or 0 when it has no cast, is unknown or there is no movie reader
+func (t *ActorTools) castReleaseYear(ctx context.Context, movieID, castSize int) (int, error) {
+	if t.movies == nil || castSize == 0 {
+		return 0, nil
+	}
+	movies, err := t.movies.GetMoviesByIDs(ctx, []int{movieID})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get movie: %w", err)
+	}
+	if len(movies) == 0 {
+		return 0, nil
+	}
+	return releaseYear(movies[0]), nil
 }
 
 // ===== get_actor_movies Tool =====
 
 // GetActorMoviesInput defines the input schema for get_actor_movies tool
 type GetActorMoviesInput struct {
-	ActorID int `json:"actor_id" jsonschema:"required,description=Actor ID to get movies for"`
+	ActorID int `json:"actor_id" jsonschema:"Actor ID to get movies for"`
 }
 
 // GetActorMoviesOutput defines the output schema for get_actor_movies tool
 type GetActorMoviesOutput struct {
-	ActorID     int    `json:"actor_id" jsonschema:"description=Actor ID"`
-	ActorName   string `json:"actor_name" jsonschema:"description=Actor name"`
-	MovieIDs    []int  `json:"movie_ids" jsonschema:"description=List of movie IDs"`
-	TotalMovies int    `json:"total_movies" jsonschema:"description=Total number of movies"`
+	ActorID     int    `json:"actor_id" jsonschema:"Actor ID"`
+	ActorName   string `json:"actor_name" jsonschema:"Actor name"`
+	MovieIDs    []int  `json:"movie_ids" jsonschema:"List of movie IDs"`
+	TotalMovies int    `json:"total_movies" jsonschema:"Total number of movies"`
 }
 
 // GetActorMovies handles the get_actor_movies tool call
@@ -338,41 +529,72 @@ func (t *ActorTools) GetActorMovies(
 ) (*mcp.CallToolResult, GetActorMoviesOutput, error) {
 	actorDTO, err := t.actorService.GetActor(ctx, input.ActorID)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return nil, GetActorMoviesOutput{}, fmt.Errorf("actor not found")
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil, GetActorMoviesOutput{}, shared.NewNotFoundError("actor not found")
 		}
 		return nil, GetActorMoviesOutput{}, fmt.Errorf("failed to get actor: %w", err)
 	}
 
+	actor := newActorOutput(actorDTO)
 	output := GetActorMoviesOutput{
-		ActorID:     actorDTO.ID,
-		ActorName:   actorDTO.Name,
-		MovieIDs:    actorDTO.MovieIDs,
-		TotalMovies: len(actorDTO.MovieIDs),
+		ActorID:     actor.ID,
+		ActorName:   actor.Name,
+		MovieIDs:    actor.MovieIDs,
+		TotalMovies: len(actor.MovieIDs),
 	}
 
-	return nil, output, nil
+	return summaryResult(ctx, output, "%s appears in %s", output.ActorName, countNoun(ctx, output.TotalMovies, "movie", "movies")), output, nil
 }
 
 // ===== search_actors Tool =====
 
 // SearchActorsInput defines the input schema for search_actors tool
 type SearchActorsInput struct {
-	Name         string `json:"name,omitempty" jsonschema:"description=Search by actor name"`
-	MinBirthYear int    `json:"min_birth_year,omitempty" jsonschema:"description=Minimum birth year"`
-	MaxBirthYear int    `json:"max_birth_year,omitempty" jsonschema:"description=Maximum birth year"`
-	MovieID      int    `json:"movie_id,omitempty" jsonschema:"description=Filter actors by movie ID"`
-	Limit        int    `json:"limit,omitempty" jsonschema:"description=Maximum number of results,default=20"`
-	Offset       int    `json:"offset,omitempty" jsonschema:"description=Number of results to skip for pagination,default=0"`
-	OrderBy      string `json:"order_by,omitempty" jsonschema:"description=Field to order by (name/birth_year),default=name"`
-	OrderDir     string `json:"order_dir,omitempty" jsonschema:"description=Order direction (asc/desc),default=asc"`
+	Name         string         `json:"name,omitempty" jsonschema:"Search by actor name"`
+	MinBirthYear int            `json:"min_birth_year,omitempty" jsonschema:"Minimum birth year"`
+	MaxBirthYear int            `json:"max_birth_year,omitempty" jsonschema:"Maximum birth year"`
+	MovieID      int            `json:"movie_id,omitempty" jsonschema:"Filter actors by movie ID"`
+	Nationality  string         `json:"nationality,omitempty" jsonschema:"Filter by nationality (exact, case-insensitive)"`
+	AliveOnly    bool           `json:"alive_only,omitempty" jsonschema:"Only actors without a death year"`
+	Limit        int            `json:"limit,omitempty" jsonschema:"Maximum number of results (default 20)"`
+	Offset       int            `json:"offset,omitempty" jsonschema:"Number of results to skip for pagination (default 0)"`
+	OrderBy      string         `json:"order_by,omitempty" jsonschema:"Field to order by (name/birth_year; default name)"`
+	OrderDir     string         `json:"order_dir,omitempty" jsonschema:"Order direction (asc/desc; default asc)"`
+	Sort         []SortKeyInput `json:"sort,omitempty" jsonschema:"Ordered sort keys on name/birth_year/created_at/updated_at, e.g. birth_year desc then name asc; overrides order_by/order_dir"`
+	Fuzzy        bool           `json:"fuzzy,omitempty" jsonschema:"Typo-tolerant name matching; results are ranked by similarity"`
 }
 
 // SearchActorsOutput defines the output schema for search_actors tool
 type SearchActorsOutput struct {
-	Actors      []ActorOutput `json:"actors" jsonschema:"description=List of matching actors"`
-	Total       int           `json:"total" jsonschema:"description=Total number of actors found"`
-	Description string        `json:"description" jsonschema:"description=Description of search results"`
+	Actors      []ActorOutput          `json:"actors" jsonschema:"List of matching actors"`
+	Total       int                    `json:"total" jsonschema:"Total number of actors found"`
+	Description string                 `json:"description" jsonschema:"Description of search results"`
+	Truncation  *middleware.Truncation `json:"truncation,omitempty" jsonschema:"Set when actors was cut short to fit the response size limit"`
+}
+
+// Len returns the number of actors, for response truncation
+func (o SearchActorsOutput) Len() int {
+	return len(o.Actors)
+}
+
+// Truncate keeps the first keep actors, pointing to offset paging for the rest
+func (o SearchActorsOutput) Truncate(keep int, truncation middleware.Truncation) any {
+	truncation.Hint = fmt.Sprintf("repeat the search with a limit of %d and increasing offsets to page through every match", max(keep, 1))
+	o.Actors = o.Actors[:keep]
+	o.Truncation = &truncation
+	return o
+}
+
+// newActorSortKeys converts sort input to the actor query format
+func newActorSortKeys(keys []SortKeyInput) []actorApp.SortKey {
+	if len(keys) == 0 {
+		return nil
+	}
+	sortKeys := make([]actorApp.SortKey, len(keys))
+	for i, key := range keys {
+		sortKeys[i] = actorApp.SortKey{Field: key.Field, Dir: key.Direction}
+	}
+	return sortKeys
 }
 
 // SearchActors handles the search_actors tool call
@@ -386,10 +608,14 @@ func (t *ActorTools) SearchActors(
 		MinBirthYear: input.MinBirthYear,
 		MaxBirthYear: input.MaxBirthYear,
 		MovieID:      input.MovieID,
+		Nationality:  input.Nationality,
+		AliveOnly:    input.AliveOnly,
 		Limit:        input.Limit,
 		Offset:       input.Offset,
 		OrderBy:      input.OrderBy,
 		OrderDir:     input.OrderDir,
+		Sort:         newActorSortKeys(input.Sort),
+		Fuzzy:        input.Fuzzy,
 	}
 
 	// Set default limit
@@ -402,18 +628,7 @@ func (t *ActorTools) SearchActors(
 		return nil, SearchActorsOutput{}, fmt.Errorf("failed to search actors: %w", err)
 	}
 
-	actors := make([]ActorOutput, len(actorDTOs))
-	for i, actorDTO := range actorDTOs {
-		actors[i] = ActorOutput{
-			ID:        actorDTO.ID,
-			Name:      actorDTO.Name,
-			BirthYear: actorDTO.BirthYear,
-			Bio:       actorDTO.Bio,
-			MovieIDs:  actorDTO.MovieIDs,
-			CreatedAt: actorDTO.CreatedAt,
-			UpdatedAt: actorDTO.UpdatedAt,
-		}
-	}
+	actors := newActorOutputs(actorDTOs)
 
 	output := SearchActorsOutput{
 		Actors:      actors,
@@ -421,5 +636,71 @@ func (t *ActorTools) SearchActors(
 		Description: "Search results",
 	}
 
-	return nil, output, nil
+	return summaryResult(ctx, output, "%s", actorListSummary(ctx, "Found", output.Actors)), output, nil
+}
+
+// ===== search_by_character Tool =====
+
+// SearchByCharacterInput defines the input schema for search_by_character tool
+type SearchByCharacterInput struct {
+	Character string `json:"character" jsonschema:"Character name to search for (partial, case-insensitive)"`
+	Limit     int    `json:"limit,omitempty" jsonschema:"Maximum number of actors (default 20)"`
+}
+
+// Validate requires a non-blank character
+func (in SearchByCharacterInput) Validate() error {
+	if strings.TrimSpace(in.Character) == "" {
+		return shared.NewValidationError("character is required")
+	}
+	return nil
+}
+
+// SearchByCharacterOutput defines the output schema for search_by_character tool
+type SearchByCharacterOutput struct {
+	Credits     []CastMemberOutput `json:"credits" jsonschema:"Matching credits, grouped by actor"`
+	Total       int                `json:"total" jsonschema:"Total number of matching credits"`
+	Description string             `json:"description" jsonschema:"Description of search results"`
+}
+
+// SearchByCharacter handles the search_by_character tool call
+func (t *ActorTools) SearchByCharacter(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input SearchByCharacterInput,
+) (*mcp.CallToolResult, SearchByCharacterOutput, error) {
+	character := strings.TrimSpace(input.Character)
+
+	query := actorApp.SearchActorsQuery{
+		Character: character,
+		Limit:     input.Limit,
+	}
+	if query.Limit == 0 {
+		query.Limit = 20
+	}
+
+	actorDTOs, err := t.actorService.SearchActors(ctx, query)
+	if err != nil {
+		return nil, SearchByCharacterOutput{}, fmt.Errorf("failed to search by character: %w", err)
+	}
+
+	// An actor matches on any of their credits, so keep only the matching ones
+	needle := strings.ToLower(character)
+	credits := make([]CastMemberOutput, 0, len(actorDTOs))
+	names := make([]string, 0, len(actorDTOs))
+	for _, actorDTO := range actorDTOs {
+		for _, credit := range actorDTO.Credits {
+			if strings.Contains(strings.ToLower(credit.Character), needle) {
+				credits = append(credits, newCastMemberOutput(actorDTO, credit))
+				names = append(names, fmt.Sprintf("%s as %s (movie %d)", actorDTO.Name, credit.Character, credit.MovieID))
+			}
+		}
+	}
+
+	output := SearchByCharacterOutput{
+		Credits:     credits,
+		Total:       len(credits),
+		Description: fmt.Sprintf("Credits for characters matching %q", character),
+	}
+
+	return summaryResult(ctx, output, "Found %s%s", countNoun(ctx, output.Total, "credit", "credits"), listSummary(ctx, names)), output, nil
 }
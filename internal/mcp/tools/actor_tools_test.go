@@ -6,7 +6,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
 	actorApp "github.com/francknouama/movies-mcp-server/internal/application/actor"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
 )
 
 // MockActorService implements ActorService for testing
@@ -15,7 +18,7 @@ type MockActorService struct {
 	GetActorFunc             func(ctx context.Context, id int) (*actorApp.ActorDTO, error)
 	UpdateActorFunc          func(ctx context.Context, cmd actorApp.UpdateActorCommand) (*actorApp.ActorDTO, error)
 	DeleteActorFunc          func(ctx context.Context, id int) error
-	LinkActorToMovieFunc     func(ctx context.Context, actorID, movieID int) error
+	LinkActorToMovieFunc     func(ctx context.Context, cmd actorApp.LinkActorCommand) (*actorApp.CreditDTO, error)
 	UnlinkActorFromMovieFunc func(ctx context.Context, actorID, movieID int) error
 	GetActorsByMovieFunc     func(ctx context.Context, movieID int) ([]*actorApp.ActorDTO, error)
 	SearchActorsFunc         func(ctx context.Context, query actorApp.SearchActorsQuery) ([]*actorApp.ActorDTO, error)
@@ -49,11 +52,11 @@ func (m *MockActorService) DeleteActor(ctx context.Context, id int) error {
 	return errors.New("DeleteActorFunc not implemented")
 }
 
-func (m *MockActorService) LinkActorToMovie(ctx context.Context, actorID, movieID int) error {
+func (m *MockActorService) LinkActorToMovie(ctx context.Context, cmd actorApp.LinkActorCommand) (*actorApp.CreditDTO, error) {
 	if m.LinkActorToMovieFunc != nil {
-		return m.LinkActorToMovieFunc(ctx, actorID, movieID)
+		return m.LinkActorToMovieFunc(ctx, cmd)
 	}
-	return errors.New("LinkActorToMovieFunc not implemented")
+	return nil, errors.New("LinkActorToMovieFunc not implemented")
 }
 
 func (m *MockActorService) UnlinkActorFromMovie(ctx context.Context, actorID, movieID int) error {
@@ -117,7 +120,7 @@ func TestGetActor_Success(t *testing.T) {
 func TestGetActor_NotFound(t *testing.T) {
 	mockService := &MockActorService{
 		GetActorFunc: func(ctx context.Context, id int) (*actorApp.ActorDTO, error) {
-			return nil, errors.New("actor not found")
+			return nil, shared.NewNotFoundError("actor not found")
 		},
 	}
 
@@ -170,6 +173,54 @@ func TestAddActor_Success(t *testing.T) {
 	}
 }
 
+func TestAddActor_BiographyDetails(t *testing.T) {
+	var captured actorApp.CreateActorCommand
+	mockService := &MockActorService{
+		CreateActorFunc: func(ctx context.Context, cmd actorApp.CreateActorCommand) (*actorApp.ActorDTO, error) {
+			captured = cmd
+			return &actorApp.ActorDTO{
+				ID:          1,
+				Name:        cmd.Name,
+				BirthYear:   cmd.BirthYear,
+				DeathYear:   cmd.DeathYear,
+				Nationality: cmd.Nationality,
+				BioSections: cmd.BioSections,
+				MovieIDs:    []int{},
+			}, nil
+		},
+	}
+
+	tools := NewActorTools(mockService)
+	_, output, err := tools.AddActor(context.Background(), nil, AddActorInput{
+		Name:        "Audrey Hepburn",
+		BirthYear:   1929,
+		DeathYear:   1993,
+		Nationality: "British",
+		BioSections: []BioSectionInput{{Title: "Early life", Text: "Born in Brussels."}},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if captured.DeathYear != 1993 || captured.Nationality != "British" || len(captured.BioSections) != 1 || captured.BioSections[0].Title != "Early life" {
+		t.Errorf("Expected the biography details in the command, got: %+v", captured)
+	}
+	if output.DeathYear != 1993 || output.Nationality != "British" || len(output.BioSections) != 1 || output.BioSections[0].Text != "Born in Brussels." {
+		t.Errorf("Expected the biography details in the output, got: %+v", output)
+	}
+}
+
+func TestAddActorInput_Validate_BioSectionLength(t *testing.T) {
+	input := AddActorInput{
+		Name:        "Test Actor",
+		BioSections: []BioSectionInput{{Title: "Career", Text: strings.Repeat("a", MaxTextLength+1)}},
+	}
+	err := input.Validate()
+	if err == nil || !strings.Contains(err.Error(), "bio_sections[0].text") {
+		t.Errorf("Expected an error naming the section, got: %v", err)
+	}
+}
+
 func TestAddActor_ServiceError(t *testing.T) {
 	mockService := &MockActorService{
 		CreateActorFunc: func(ctx context.Context, cmd actorApp.CreateActorCommand) (*actorApp.ActorDTO, error) {
@@ -224,7 +275,7 @@ func TestUpdateActor_Success(t *testing.T) {
 func TestUpdateActor_NotFound(t *testing.T) {
 	mockService := &MockActorService{
 		UpdateActorFunc: func(ctx context.Context, cmd actorApp.UpdateActorCommand) (*actorApp.ActorDTO, error) {
-			return nil, errors.New("actor not found")
+			return nil, shared.NewNotFoundError("actor not found")
 		},
 	}
 
@@ -263,7 +314,7 @@ func TestDeleteActor_Success(t *testing.T) {
 func TestDeleteActor_NotFound(t *testing.T) {
 	mockService := &MockActorService{
 		DeleteActorFunc: func(ctx context.Context, id int) error {
-			return errors.New("actor not found")
+			return shared.NewNotFoundError("actor not found")
 		},
 	}
 
@@ -279,8 +330,8 @@ func TestDeleteActor_NotFound(t *testing.T) {
 
 func TestLinkActorToMovie_Success(t *testing.T) {
 	mockService := &MockActorService{
-		LinkActorToMovieFunc: func(ctx context.Context, actorID, movieID int) error {
-			return nil
+		LinkActorToMovieFunc: func(ctx context.Context, cmd actorApp.LinkActorCommand) (*actorApp.CreditDTO, error) {
+			return &actorApp.CreditDTO{MovieID: cmd.MovieID}, nil
 		},
 	}
 
@@ -299,10 +350,46 @@ func TestLinkActorToMovie_Success(t *testing.T) {
 	}
 }
 
+func TestLinkActorToMovie_WithCredit(t *testing.T) {
+	var received actorApp.LinkActorCommand
+	mockService := &MockActorService{
+		LinkActorToMovieFunc: func(ctx context.Context, cmd actorApp.LinkActorCommand) (*actorApp.CreditDTO, error) {
+			received = cmd
+			return &actorApp.CreditDTO{MovieID: cmd.MovieID, Character: "Neo", BillingOrder: 1, RoleType: "lead"}, nil
+		},
+	}
+
+	tools := NewActorTools(mockService)
+	result, output, err := tools.LinkActorToMovie(context.Background(), nil, LinkActorToMovieInput{
+		ActorID:      1,
+		MovieID:      42,
+		Character:    "Neo",
+		BillingOrder: 1,
+		RoleType:     "Lead",
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if received.Character != "Neo" || received.BillingOrder != 1 || received.RoleType != "Lead" {
+		t.Errorf("Expected credit details to reach the service, got: %+v", received)
+	}
+
+	if output.Credit.RoleType != "lead" {
+		t.Errorf("Expected normalized role type 'lead', got: %s", output.Credit.RoleType)
+	}
+
+	assertSummaryResult(t, result)
+	if summary := result.Content[1].(*mcp.TextContent).Text; summary != "Linked actor 1 to movie 42 as Neo" {
+		t.Errorf("Expected summary with character, got: %s", summary)
+	}
+}
+
 func TestLinkActorToMovie_ServiceError(t *testing.T) {
 	mockService := &MockActorService{
-		LinkActorToMovieFunc: func(ctx context.Context, actorID, movieID int) error {
-			return errors.New("link failed")
+		LinkActorToMovieFunc: func(ctx context.Context, cmd actorApp.LinkActorCommand) (*actorApp.CreditDTO, error) {
+			return nil, errors.New("link failed")
 		},
 	}
 
@@ -319,8 +406,8 @@ func TestLinkActorToMovie_ServiceError(t *testing.T) {
 
 func TestLinkActorToMovie_NotFound(t *testing.T) {
 	mockService := &MockActorService{
-		LinkActorToMovieFunc: func(ctx context.Context, actorID, movieID int) error {
-			return errors.New("actor not found")
+		LinkActorToMovieFunc: func(ctx context.Context, cmd actorApp.LinkActorCommand) (*actorApp.CreditDTO, error) {
+			return nil, shared.NewNotFoundError("actor not found")
 		},
 	}
 
@@ -341,8 +428,8 @@ func TestLinkActorToMovie_NotFound(t *testing.T) {
 
 func TestLinkActorToMovie_AlreadyLinked(t *testing.T) {
 	mockService := &MockActorService{
-		LinkActorToMovieFunc: func(ctx context.Context, actorID, movieID int) error {
-			return errors.New("link already exists")
+		LinkActorToMovieFunc: func(ctx context.Context, cmd actorApp.LinkActorCommand) (*actorApp.CreditDTO, error) {
+			return nil, shared.NewConflictError("link already exists")
 		},
 	}
 
@@ -447,6 +534,72 @@ func TestGetMovieCast_Success(t *testing.T) {
 	}
 }
 
+func TestGetMovieCast_PhotoURIs(t *testing.T) {
+	mockService := &MockActorService{
+		GetActorsByMovieFunc: func(ctx context.Context, movieID int) ([]*actorApp.ActorDTO, error) {
+			return []*actorApp.ActorDTO{
+				{ID: 1, Name: "Keanu Reeves", HasPhoto: true, PhotoURL: "https://example.com/keanu.jpg"},
+				{ID: 2, Name: "Laurence Fishburne"},
+			}, nil
+		},
+	}
+
+	tools := NewActorTools(mockService)
+	_, output, err := tools.GetMovieCast(context.Background(), nil, GetMovieCastInput{MovieID: 42})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if output.Actors[0].PhotoURI != "movies://actors/photos/1" || output.Cast[0].PhotoURI != "movies://actors/photos/1" {
+		t.Errorf("Expected the stored photo URI, got: %q and %q", output.Actors[0].PhotoURI, output.Cast[0].PhotoURI)
+	}
+	if output.Actors[0].PhotoURL != "https://example.com/keanu.jpg" {
+		t.Errorf("Expected the photo URL, got: %q", output.Actors[0].PhotoURL)
+	}
+	if output.Actors[1].PhotoURI != "" || output.Cast[1].PhotoURI != "" {
+		t.Errorf("Expected no photo URI without a stored photo, got: %+v", output.Actors[1])
+	}
+}
+
+func TestGetMovieCast_BillingOrder(t *testing.T) {
+	mockService := &MockActorService{
+		GetActorsByMovieFunc: func(ctx context.Context, movieID int) ([]*actorApp.ActorDTO, error) {
+			return []*actorApp.ActorDTO{
+				{ID: 1, Name: "Carrie-Anne Moss", Credits: []actorApp.CreditDTO{{MovieID: 42, Character: "Trinity", BillingOrder: 3}}},
+				{ID: 2, Name: "Gloria Foster", Credits: []actorApp.CreditDTO{{MovieID: 42, Character: "Oracle"}}},
+				{ID: 3, Name: "Keanu Reeves", Credits: []actorApp.CreditDTO{
+					{MovieID: 7, Character: "John Wick", BillingOrder: 1},
+					{MovieID: 42, Character: "Neo", BillingOrder: 1, RoleType: "lead"},
+				}},
+			}, nil
+		},
+	}
+
+	tools := NewActorTools(mockService)
+	_, output, err := tools.GetMovieCast(context.Background(), nil, GetMovieCastInput{MovieID: 42})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	wantCharacters := []string{"Neo", "Trinity", "Oracle"}
+	if len(output.Cast) != len(wantCharacters) {
+		t.Fatalf("Expected %d cast members, got: %d", len(wantCharacters), len(output.Cast))
+	}
+	for i, want := range wantCharacters {
+		if output.Cast[i].Character != want {
+			t.Errorf("Expected cast[%d] to play %s, got: %s", i, want, output.Cast[i].Character)
+		}
+		if output.Actors[i].ID != output.Cast[i].ActorID {
+			t.Errorf("Expected actors and cast in the same order at %d", i)
+		}
+	}
+
+	if output.Cast[0].RoleType != "lead" {
+		t.Errorf("Expected lead role type, got: %s", output.Cast[0].RoleType)
+	}
+}
+
 func TestGetMovieCast_EmptyResult(t *testing.T) {
 	mockService := &MockActorService{
 		GetActorsByMovieFunc: func(ctx context.Context, movieID int) ([]*actorApp.ActorDTO, error) {
@@ -502,7 +655,7 @@ func TestGetActorMovies_Success(t *testing.T) {
 func TestGetActorMovies_NotFound(t *testing.T) {
 	mockService := &MockActorService{
 		GetActorFunc: func(ctx context.Context, id int) (*actorApp.ActorDTO, error) {
-			return nil, errors.New("actor not found")
+			return nil, shared.NewNotFoundError("actor not found")
 		},
 	}
 
@@ -585,6 +738,8 @@ func TestSearchActors_WithBirthYearFilter(t *testing.T) {
 		Name:         "Tom",
 		MinBirthYear: 1950,
 		MaxBirthYear: 1960,
+		Nationality:  "American",
+		AliveOnly:    true,
 	})
 
 	if err != nil {
@@ -599,6 +754,10 @@ func TestSearchActors_WithBirthYearFilter(t *testing.T) {
 		t.Errorf("Expected max birth year filter 1960, got: %d", capturedQuery.MaxBirthYear)
 	}
 
+	if capturedQuery.Nationality != "American" || !capturedQuery.AliveOnly {
+		t.Errorf("Expected the nationality and alive-only filters, got: %q %v", capturedQuery.Nationality, capturedQuery.AliveOnly)
+	}
+
 	if len(output.Actors) != 1 {
 		t.Errorf("Expected 1 actor, got: %d", len(output.Actors))
 	}
@@ -624,3 +783,49 @@ func TestSearchActors_EmptyResults(t *testing.T) {
 		t.Errorf("Expected 0 actors, got: %d", len(output.Actors))
 	}
 }
+
+// ===== SearchByCharacter Tests =====
+
+func TestSearchByCharacter_Success(t *testing.T) {
+	var received actorApp.SearchActorsQuery
+	mockService := &MockActorService{
+		SearchActorsFunc: func(ctx context.Context, query actorApp.SearchActorsQuery) ([]*actorApp.ActorDTO, error) {
+			received = query
+			return []*actorApp.ActorDTO{
+				{ID: 1, Name: "Hugh Jackman", Credits: []actorApp.CreditDTO{
+					{MovieID: 1, Character: "Logan / Wolverine", RoleType: "lead"},
+					{MovieID: 2, Character: "Jean Valjean"},
+					{MovieID: 3, Character: "Wolverine", RoleType: "cameo"},
+				}},
+			}, nil
+		},
+	}
+
+	tools := NewActorTools(mockService)
+	_, output, err := tools.SearchByCharacter(context.Background(), nil, SearchByCharacterInput{Character: "wolverine"})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if received.Character != "wolverine" || received.Limit != 20 {
+		t.Errorf("Expected character query with default limit, got: %+v", received)
+	}
+
+	if output.Total != 2 {
+		t.Fatalf("Expected 2 matching credits, got: %d", output.Total)
+	}
+
+	if output.Credits[0].MovieID != 1 || output.Credits[1].MovieID != 3 {
+		t.Errorf("Expected credits for movies 1 and 3, got: %+v", output.Credits)
+	}
+}
+
+func TestSearchByCharacter_MissingCharacter(t *testing.T) {
+	tools := NewActorTools(&MockActorService{})
+	_, _, err := tools.SearchByCharacter(context.Background(), nil, SearchByCharacterInput{Character: "  "})
+
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+}
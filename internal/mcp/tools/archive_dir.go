@@ -0,0 +1,94 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+// tenantArchiveDir is the subdirectory of an archive directory holding one
+// directory of archives per tenant
+const tenantArchiveDir = "tenants"
+
+// ArchiveDir confines the files tool calls read and write to one directory
+// on the server. Calls name files relative to it; each tenant's files live
+// in tenants/<tenant> within it, which the default library cannot name.
+type ArchiveDir struct {
+	root string
+}
+
+// NewArchiveDir creates an archive directory rooted at root, created on
+// first use; empty is the working directory
+func NewArchiveDir(root string) *ArchiveDir {
+	if root == "" {
+		root = "."
+	}
+	return &ArchiveDir{root: root}
+}
+
+// Resolve returns the path of the file name names for the tenant ctx is
+// served for. Absolute names, names climbing out with .. and names that
+// reach outside the directory through a symlink are rejected, so a call can
+// neither overwrite the server's own files nor read another tenant's.
+func (d *ArchiveDir) Resolve(ctx context.Context, name string) (string, error) {
+	if !filepath.IsLocal(name) {
+		return "", shared.NewValidationError("path %q must be relative to the backup directory and stay inside it", name)
+	}
+
+	dir := d.root
+	if tenant := database.TenantFromContext(ctx); tenant != "" {
+		dir = filepath.Join(dir, tenantArchiveDir, tenant)
+	} else if first, _, _ := strings.Cut(filepath.ToSlash(filepath.Clean(name)), "/"); first == tenantArchiveDir {
+		return "", shared.NewValidationError("path %q is in the tenants' backup directory", name)
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return "", fmt.Errorf("failed to create backup directory: %w", err)
+	}
+	root, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve backup directory: %w", err)
+	}
+	if root, err = filepath.Abs(root); err != nil {
+		return "", fmt.Errorf("failed to resolve backup directory: %w", err)
+	}
+
+	path := filepath.Join(root, name)
+	resolved, err := filepath.EvalSymlinks(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		// A file yet to be written: a dangling symlink in its place would
+		// be followed, and its directory must already exist inside root
+		if _, lstatErr := os.Lstat(path); lstatErr == nil {
+			return "", shared.NewValidationError("path %q is a broken symlink", name)
+		}
+		parent, parentErr := filepath.EvalSymlinks(filepath.Dir(path))
+		if parentErr != nil {
+			return "", shared.NewNotFoundError("directory of %q does not exist in the backup directory", name)
+		}
+		resolved, err = filepath.Join(parent, filepath.Base(path)), nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", name, err)
+	}
+
+	if rel, relErr := filepath.Rel(root, resolved); relErr != nil || !filepath.IsLocal(rel) {
+		return "", shared.NewValidationError("path %q leads outside the backup directory", name)
+	}
+	return resolved, nil
+}
+
+// resolveArchive resolves name in dir, or returns it as given without a
+// directory, for callers such as movies-cli whose paths come from the
+// operator rather than a client
+func resolveArchive(ctx context.Context, dir *ArchiveDir, name string) (string, error) {
+	if dir == nil {
+		return name, nil
+	}
+	return dir.Resolve(ctx, name)
+}
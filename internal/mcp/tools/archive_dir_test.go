@@ -0,0 +1,96 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+func TestArchiveDir_ResolvesInsideRoot(t *testing.T) {
+	root := t.TempDir()
+	dir := NewArchiveDir(root)
+
+	path, err := dir.Resolve(context.Background(), "nightly/../movies.zip")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	want, _ := filepath.EvalSymlinks(root)
+	if path != filepath.Join(want, "movies.zip") {
+		t.Errorf("Expected path inside %s, got: %s", want, path)
+	}
+}
+
+func TestArchiveDir_RejectsEscapes(t *testing.T) {
+	root := t.TempDir()
+	outside := t.TempDir()
+	if err := os.Symlink(outside, filepath.Join(root, "out")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(outside, "missing.zip"), filepath.Join(root, "dangling.zip")); err != nil {
+		t.Fatal(err)
+	}
+	dir := NewArchiveDir(root)
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{"absolute path", filepath.Join(outside, "movies.zip")},
+		{"parent directory", "../movies.zip"},
+		{"nested parent directory", "nightly/../../movies.zip"},
+		{"symlinked directory", "out/movies.zip"},
+		{"dangling symlink", "dangling.zip"},
+		{"tenant directory", "tenants/acme/movies.zip"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := dir.Resolve(context.Background(), tt.path)
+
+			if !errors.Is(err, shared.ErrValidation) {
+				t.Errorf("Expected validation error for %s, got: %v", tt.path, err)
+			}
+		})
+	}
+	if entries, _ := os.ReadDir(outside); len(entries) != 0 {
+		t.Errorf("Expected nothing written outside the backup directory, got: %v", entries)
+	}
+}
+
+func TestArchiveDir_SeparatesTenants(t *testing.T) {
+	root := t.TempDir()
+	dir := NewArchiveDir(root)
+	acme := database.WithTenant(context.Background(), "acme", nil)
+	globex := database.WithTenant(context.Background(), "globex", nil)
+
+	acmePath, err := dir.Resolve(acme, "movies.zip")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	globexPath, err := dir.Resolve(globex, "movies.zip")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if acmePath == globexPath {
+		t.Errorf("Expected tenants to get separate archives, both got: %s", acmePath)
+	}
+	if _, err := dir.Resolve(globex, "../acme/movies.zip"); !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("Expected validation error reaching another tenant, got: %v", err)
+	}
+}
+
+func TestArchiveDir_MissingDirectory(t *testing.T) {
+	dir := NewArchiveDir(t.TempDir())
+
+	_, err := dir.Resolve(context.Background(), "nightly/movies.zip")
+
+	if !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("Expected not found error, got: %v", err)
+	}
+}
@@ -0,0 +1,168 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	availabilityApp "github.com/francknouama/movies-mcp-server/internal/application/availability"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/i18n"
+)
+
+// AvailabilityService defines the interface for movie availability operations
+type AvailabilityService interface {
+	UpdateAvailability(ctx context.Context, cmd availabilityApp.UpdateAvailabilityCommand) (*availabilityApp.AvailabilityDTO, error)
+	GetAvailability(ctx context.Context, movieID int, region string) (*availabilityApp.AvailabilityDTO, error)
+}
+
+// AvailabilityTools provides SDK-based MCP handlers for streaming availability
+type AvailabilityTools struct {
+	service AvailabilityService
+}
+
+// NewAvailabilityTools creates a new availability tools instance
+func NewAvailabilityTools(service AvailabilityService) *AvailabilityTools {
+	return &AvailabilityTools{
+		service: service,
+	}
+}
+
+// OfferOutput defines the output schema for a provider offering
+type OfferOutput struct {
+	Provider    string `json:"provider" jsonschema:"Streaming or retail provider"`
+	Region      string `json:"region" jsonschema:"Two-letter country code"`
+	OfferType   string `json:"offer_type" jsonschema:"How the movie is offered (flatrate/rent/buy/free/ads)"`
+	URL         string `json:"url,omitempty" jsonschema:"Link to the movie on the provider"`
+	LastChecked string `json:"last_checked" jsonschema:"Time the offer was last confirmed"`
+}
+
+// AvailabilityOutput defines the common output schema for availability tools
+type AvailabilityOutput struct {
+	MovieID int           `json:"movie_id" jsonschema:"Movie ID"`
+	Title   string        `json:"title" jsonschema:"Movie title"`
+	Year    int           `json:"year" jsonschema:"Release year"`
+	Region  string        `json:"region,omitempty" jsonschema:"Region the offers are for; empty when all regions were requested"`
+	Offers  []OfferOutput `json:"offers" jsonschema:"Where the movie can be watched"`
+}
+
+// newAvailabilityOutput converts an availability DTO to the output format
+func newAvailabilityOutput(dto *availabilityApp.AvailabilityDTO) AvailabilityOutput {
+	offers := make([]OfferOutput, 0, len(dto.Offers))
+	for _, offer := range dto.Offers {
+		offers = append(offers, OfferOutput{
+			Provider:    offer.Provider,
+			Region:      offer.Region,
+			OfferType:   offer.OfferType,
+			URL:         offer.URL,
+			LastChecked: offer.LastChecked,
+		})
+	}
+
+	return AvailabilityOutput{
+		MovieID: dto.MovieID,
+		Title:   dto.Title,
+		Year:    dto.Year,
+		Region:  dto.Region,
+		Offers:  offers,
+	}
+}
+
+// availabilitySummary describes a movie's offers in one line
+func availabilitySummary(ctx context.Context, output AvailabilityOutput) string {
+	p := i18n.FromContext(ctx)
+	where := p.Sprintf("in %s", output.Region)
+	if output.Region == "" {
+		where = p.Translate("across all regions")
+	}
+
+	names := make([]string, 0, len(output.Offers))
+	for _, offer := range output.Offers {
+		label := fmt.Sprintf("%s (%s)", offer.Provider, offer.OfferType)
+		if output.Region == "" {
+			label = fmt.Sprintf("%s (%s, %s)", offer.Provider, offer.OfferType, offer.Region)
+		}
+		names = append(names, label)
+	}
+
+	return fmt.Sprintf("%s (%d): %s %s%s", output.Title, output.Year,
+		countNoun(ctx, len(output.Offers), "offer", "offers"), where, listSummary(ctx, names))
+}
+
+// ===== update_availability Tool =====
+
+// OfferInput defines the input schema for one provider offering
+type OfferInput struct {
+	Provider  string `json:"provider" jsonschema:"Streaming or retail provider name"`
+	OfferType string `json:"offer_type,omitempty" jsonschema:"How the movie is offered: flatrate (default), rent, buy, free or ads"`
+	URL       string `json:"url,omitempty" jsonschema:"Link to the movie on the provider"`
+}
+
+// UpdateAvailabilityInput defines the input schema for update_availability tool
+type UpdateAvailabilityInput struct {
+	MovieID   int          `json:"movie_id" jsonschema:"Movie ID"`
+	Region    string       `json:"region" jsonschema:"Two-letter country code (e.g. US, GB)"`
+	Offers    []OfferInput `json:"offers,omitempty" jsonschema:"Offers that replace everything recorded for the region; empty clears it"`
+	FetchTMDB bool         `json:"fetch_tmdb,omitempty" jsonschema:"Replace the region with current watch providers from TMDB instead of offers"`
+}
+
+// Validate requires a region
+func (in UpdateAvailabilityInput) Validate() error {
+	if in.Region == "" {
+		return shared.NewValidationError("region is required")
+	}
+	return nil
+}
+
+// UpdateAvailability handles the update_availability tool call
+func (t *AvailabilityTools) UpdateAvailability(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input UpdateAvailabilityInput,
+) (*mcp.CallToolResult, AvailabilityOutput, error) {
+	cmd := availabilityApp.UpdateAvailabilityCommand{
+		MovieID:         input.MovieID,
+		Region:          input.Region,
+		Offers:          make([]availabilityApp.OfferCommand, 0, len(input.Offers)),
+		FetchFromSource: input.FetchTMDB,
+	}
+	for _, offer := range input.Offers {
+		cmd.Offers = append(cmd.Offers, availabilityApp.OfferCommand{
+			Provider:  offer.Provider,
+			OfferType: offer.OfferType,
+			URL:       offer.URL,
+		})
+	}
+
+	dto, err := t.service.UpdateAvailability(ctx, cmd)
+	if err != nil {
+		return nil, AvailabilityOutput{}, fmt.Errorf("failed to update availability: %w", err)
+	}
+
+	output := newAvailabilityOutput(dto)
+	return summaryResult(ctx, output, "Updated %s", availabilitySummary(ctx, output)), output, nil
+}
+
+// ===== where_to_watch Tool =====
+
+// WhereToWatchInput defines the input schema for where_to_watch tool
+type WhereToWatchInput struct {
+	MovieID int    `json:"movie_id" jsonschema:"Movie ID"`
+	Region  string `json:"region,omitempty" jsonschema:"Two-letter country code; omit for all regions"`
+}
+
+// WhereToWatch handles the where_to_watch tool call
+func (t *AvailabilityTools) WhereToWatch(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input WhereToWatchInput,
+) (*mcp.CallToolResult, AvailabilityOutput, error) {
+	dto, err := t.service.GetAvailability(ctx, input.MovieID, input.Region)
+	if err != nil {
+		return nil, AvailabilityOutput{}, fmt.Errorf("failed to get availability: %w", err)
+	}
+
+	output := newAvailabilityOutput(dto)
+	return summaryResult(ctx, output, "%s", availabilitySummary(ctx, output)), output, nil
+}
@@ -0,0 +1,158 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	availabilityApp "github.com/francknouama/movies-mcp-server/internal/application/availability"
+)
+
+// MockAvailabilityService is a mock implementation of AvailabilityService
+type MockAvailabilityService struct {
+	UpdateAvailabilityFunc func(ctx context.Context, cmd availabilityApp.UpdateAvailabilityCommand) (*availabilityApp.AvailabilityDTO, error)
+	GetAvailabilityFunc    func(ctx context.Context, movieID int, region string) (*availabilityApp.AvailabilityDTO, error)
+}
+
+func (m *MockAvailabilityService) UpdateAvailability(ctx context.Context, cmd availabilityApp.UpdateAvailabilityCommand) (*availabilityApp.AvailabilityDTO, error) {
+	if m.UpdateAvailabilityFunc != nil {
+		return m.UpdateAvailabilityFunc(ctx, cmd)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockAvailabilityService) GetAvailability(ctx context.Context, movieID int, region string) (*availabilityApp.AvailabilityDTO, error) {
+	if m.GetAvailabilityFunc != nil {
+		return m.GetAvailabilityFunc(ctx, movieID, region)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func TestUpdateAvailability_Success(t *testing.T) {
+	var gotCmd availabilityApp.UpdateAvailabilityCommand
+	service := &MockAvailabilityService{
+		UpdateAvailabilityFunc: func(ctx context.Context, cmd availabilityApp.UpdateAvailabilityCommand) (*availabilityApp.AvailabilityDTO, error) {
+			gotCmd = cmd
+			return &availabilityApp.AvailabilityDTO{
+				MovieID: 1,
+				Title:   "The Matrix",
+				Year:    1999,
+				Region:  "US",
+				Offers: []*availabilityApp.OfferDTO{
+					{Provider: "Netflix", Region: "US", OfferType: "flatrate", LastChecked: "2024-05-01T12:00:00Z"},
+				},
+			}, nil
+		},
+	}
+	tools := NewAvailabilityTools(service)
+
+	result, output, err := tools.UpdateAvailability(context.Background(), nil, UpdateAvailabilityInput{
+		MovieID: 1,
+		Region:  "us",
+		Offers:  []OfferInput{{Provider: "Netflix", URL: "https://netflix.com/title/1"}},
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	assertSummaryResult(t, result)
+	if gotCmd.MovieID != 1 || gotCmd.Region != "us" || len(gotCmd.Offers) != 1 || gotCmd.Offers[0].URL != "https://netflix.com/title/1" {
+		t.Errorf("Expected offers to be passed through, got: %+v", gotCmd)
+	}
+	if len(output.Offers) != 1 || output.Offers[0].Provider != "Netflix" {
+		t.Errorf("Expected Netflix offer, got: %+v", output.Offers)
+	}
+}
+
+func TestUpdateAvailability_FetchTMDB(t *testing.T) {
+	var gotCmd availabilityApp.UpdateAvailabilityCommand
+	service := &MockAvailabilityService{
+		UpdateAvailabilityFunc: func(ctx context.Context, cmd availabilityApp.UpdateAvailabilityCommand) (*availabilityApp.AvailabilityDTO, error) {
+			gotCmd = cmd
+			return nil, availabilityApp.ErrNoSource
+		},
+	}
+	tools := NewAvailabilityTools(service)
+
+	_, _, err := tools.UpdateAvailability(context.Background(), nil, UpdateAvailabilityInput{MovieID: 1, Region: "US", FetchTMDB: true})
+
+	if !errors.Is(err, availabilityApp.ErrNoSource) {
+		t.Errorf("Expected ErrNoSource, got: %v", err)
+	}
+	if !gotCmd.FetchFromSource {
+		t.Error("Expected fetch to be requested")
+	}
+}
+
+func TestUpdateAvailability_MissingRegion(t *testing.T) {
+	err := UpdateAvailabilityInput{MovieID: 1}.Validate()
+
+	if err == nil {
+		t.Fatal("Expected error for missing region")
+	}
+}
+
+func TestWhereToWatch_AllRegions(t *testing.T) {
+	var gotRegion string
+	service := &MockAvailabilityService{
+		GetAvailabilityFunc: func(ctx context.Context, movieID int, region string) (*availabilityApp.AvailabilityDTO, error) {
+			gotRegion = region
+			return &availabilityApp.AvailabilityDTO{
+				MovieID: movieID,
+				Title:   "The Matrix",
+				Year:    1999,
+				Offers: []*availabilityApp.OfferDTO{
+					{Provider: "BBC iPlayer", Region: "GB", OfferType: "free"},
+					{Provider: "Netflix", Region: "US", OfferType: "flatrate"},
+				},
+			}, nil
+		},
+	}
+	tools := NewAvailabilityTools(service)
+
+	result, output, err := tools.WhereToWatch(context.Background(), nil, WhereToWatchInput{MovieID: 1})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	assertSummaryResult(t, result)
+	if gotRegion != "" {
+		t.Errorf("Expected all regions to be requested, got: %q", gotRegion)
+	}
+	if len(output.Offers) != 2 {
+		t.Errorf("Expected 2 offers, got: %d", len(output.Offers))
+	}
+}
+
+func TestWhereToWatch_ServiceError(t *testing.T) {
+	service := &MockAvailabilityService{
+		GetAvailabilityFunc: func(ctx context.Context, movieID int, region string) (*availabilityApp.AvailabilityDTO, error) {
+			return nil, errors.New("movie not found")
+		},
+	}
+	tools := NewAvailabilityTools(service)
+
+	_, _, err := tools.WhereToWatch(context.Background(), nil, WhereToWatchInput{MovieID: 99})
+
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+}
+
+func TestAvailabilitySummary(t *testing.T) {
+	output := AvailabilityOutput{
+		Title:  "The Matrix",
+		Year:   1999,
+		Region: "US",
+		Offers: []OfferOutput{{Provider: "Netflix", OfferType: "flatrate"}},
+	}
+
+	if got := availabilitySummary(context.Background(), output); got != "The Matrix (1999): 1 offer in US: Netflix (flatrate)" {
+		t.Errorf("Unexpected summary: %s", got)
+	}
+
+	output.Offers = nil
+	if got := availabilitySummary(context.Background(), output); got != "The Matrix (1999): 0 offers in US" {
+		t.Errorf("Unexpected empty summary: %s", got)
+	}
+}
@@ -0,0 +1,178 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	similarityApp "github.com/francknouama/movies-mcp-server/internal/application/similarity"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+	"github.com/francknouama/movies-mcp-server/pkg/serialization"
+)
+
+// DatabaseArchiver defines the interface for backing up and restoring the database
+type DatabaseArchiver interface {
+	BackupFile(ctx context.Context, path string, progress database.BackupProgress) (*database.BackupManifest, error)
+	RestoreFile(ctx context.Context, path string, progress database.BackupProgress) (*database.BackupManifest, error)
+}
+
+// SimilarityRebuilder defines the interface for rebuilding the similar-movie index
+type SimilarityRebuilder interface {
+	Rebuild(ctx context.Context) (*similarityApp.RebuildDTO, error)
+}
+
+// BackupTools provides SDK-based MCP handlers for backup and restore
+type BackupTools struct {
+	archiver     DatabaseArchiver
+	similarities SimilarityRebuilder
+	archives     *ArchiveDir
+}
+
+// NewBackupTools creates a new backup tools instance
+func NewBackupTools(archiver DatabaseArchiver) *BackupTools {
+	return &BackupTools{
+		archiver: archiver,
+	}
+}
+
+// SetSimilarityRebuilder makes restore_database rebuild the similar-movie
+// index, which archives leave out because it is derived from the movies
+func (t *BackupTools) SetSimilarityRebuilder(rebuilder SimilarityRebuilder) {
+	t.similarities = rebuilder
+}
+
+// SetArchiveDir confines the archives backup_database and restore_database
+// name to dir; without one, paths are used as given
+func (t *BackupTools) SetArchiveDir(dir *ArchiveDir) {
+	t.archives = dir
+}
+
+// BackupTableOutput defines the output schema for a table in a backup
+type BackupTableOutput struct {
+	Name string `json:"name" jsonschema:"Table name"`
+	Rows int    `json:"rows" jsonschema:"Number of rows in the archive"`
+}
+
+// BackupOutput defines the common output schema for backup and restore
+type BackupOutput struct {
+	Path          string              `json:"path" jsonschema:"Archive path on the server"`
+	FormatVersion int                 `json:"format_version" jsonschema:"Archive format version"`
+	CreatedAt     string              `json:"created_at" jsonschema:"Time the backup was taken"`
+	Tables        []BackupTableOutput `json:"tables" jsonschema:"Row counts per table"`
+	TotalRows     int                 `json:"total_rows" jsonschema:"Total number of rows"`
+	VerifiedFiles int                 `json:"verified_files" jsonschema:"Number of archive entries with SHA-256 checksums"`
+}
+
+// newBackupOutput converts a backup manifest to the output format
+func newBackupOutput(path string, manifest *database.BackupManifest) BackupOutput {
+	tables := make([]BackupTableOutput, 0, len(manifest.Tables))
+	for _, table := range manifest.Tables {
+		tables = append(tables, BackupTableOutput{Name: table.Name, Rows: table.Rows})
+	}
+
+	return BackupOutput{
+		Path:          path,
+		FormatVersion: manifest.FormatVersion,
+		CreatedAt:     serialization.Timestamp(manifest.CreatedAt),
+		Tables:        tables,
+		TotalRows:     manifest.TotalRows(),
+		VerifiedFiles: len(manifest.Checksums),
+	}
+}
+
+// progressNotifier reports progress to the client when the request carries a progress token
+func progressNotifier(ctx context.Context, req *mcp.CallToolRequest) database.BackupProgress {
+	if req == nil || req.Session == nil || req.Params == nil {
+		return nil
+	}
+	token := req.Params.GetProgressToken()
+	if token == nil {
+		return nil
+	}
+
+	return func(done, total int, message string) {
+		_ = req.Session.NotifyProgress(ctx, &mcp.ProgressNotificationParams{
+			ProgressToken: token,
+			Progress:      float64(done),
+			Total:         float64(total),
+			Message:       message,
+		}) // Progress is best effort
+	}
+}
+
+// ===== backup_database Tool =====
+
+// BackupDatabaseInput defines the input schema for backup_database tool
+type BackupDatabaseInput struct {
+	Path string `json:"path" jsonschema:"Destination archive path (.zip), relative to the server's backup directory"`
+}
+
+// Validate requires a destination path
+func (in BackupDatabaseInput) Validate() error {
+	if in.Path == "" {
+		return shared.NewValidationError("path is required")
+	}
+	return nil
+}
+
+// BackupDatabase handles the backup_database tool call
+func (t *BackupTools) BackupDatabase(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input BackupDatabaseInput,
+) (*mcp.CallToolResult, BackupOutput, error) {
+	path, err := resolveArchive(ctx, t.archives, input.Path)
+	if err != nil {
+		return nil, BackupOutput{}, err
+	}
+	manifest, err := t.archiver.BackupFile(ctx, path, progressNotifier(ctx, req))
+	if err != nil {
+		return nil, BackupOutput{}, fmt.Errorf("failed to back up database: %w", err)
+	}
+
+	output := newBackupOutput(path, manifest)
+	return summaryResult(ctx, output, "Backed up %s to %s", countNoun(ctx, output.TotalRows, "row", "rows"), output.Path), output, nil
+}
+
+// ===== restore_database Tool =====
+
+// RestoreDatabaseInput defines the input schema for restore_database tool
+type RestoreDatabaseInput struct {
+	Path string `json:"path" jsonschema:"Path of an archive created by backup_database, relative to the server's backup directory"`
+}
+
+// Validate requires an archive path
+func (in RestoreDatabaseInput) Validate() error {
+	if in.Path == "" {
+		return shared.NewValidationError("path is required")
+	}
+	return nil
+}
+
+// RestoreDatabase handles the restore_database tool call. All existing
+// movies, actors, cast links, availability and franchises are replaced by
+// the archive contents.
+func (t *BackupTools) RestoreDatabase(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input RestoreDatabaseInput,
+) (*mcp.CallToolResult, BackupOutput, error) {
+	path, err := resolveArchive(ctx, t.archives, input.Path)
+	if err != nil {
+		return nil, BackupOutput{}, err
+	}
+	manifest, err := t.archiver.RestoreFile(ctx, path, progressNotifier(ctx, req))
+	if err != nil {
+		return nil, BackupOutput{}, fmt.Errorf("failed to restore database: %w", err)
+	}
+	if t.similarities != nil {
+		if _, err := t.similarities.Rebuild(ctx); err != nil {
+			return nil, BackupOutput{}, fmt.Errorf("database restored but similarities not rebuilt: %w", err)
+		}
+	}
+
+	output := newBackupOutput(path, manifest)
+	return summaryResult(ctx, output, "Restored %s from %s (checksums verified)", countNoun(ctx, output.TotalRows, "row", "rows"), output.Path), output, nil
+}
@@ -0,0 +1,188 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	similarityApp "github.com/francknouama/movies-mcp-server/internal/application/similarity"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+// MockDatabaseArchiver is a mock implementation of DatabaseArchiver
+type MockDatabaseArchiver struct {
+	BackupFileFunc  func(ctx context.Context, path string, progress database.BackupProgress) (*database.BackupManifest, error)
+	RestoreFileFunc func(ctx context.Context, path string, progress database.BackupProgress) (*database.BackupManifest, error)
+}
+
+func (m *MockDatabaseArchiver) BackupFile(ctx context.Context, path string, progress database.BackupProgress) (*database.BackupManifest, error) {
+	if m.BackupFileFunc != nil {
+		return m.BackupFileFunc(ctx, path, progress)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockDatabaseArchiver) RestoreFile(ctx context.Context, path string, progress database.BackupProgress) (*database.BackupManifest, error) {
+	if m.RestoreFileFunc != nil {
+		return m.RestoreFileFunc(ctx, path, progress)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func testManifest() *database.BackupManifest {
+	return &database.BackupManifest{
+		FormatVersion: database.BackupFormatVersion,
+		CreatedAt:     time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Tables: []database.BackupTable{
+			{Name: "movies", Rows: 3},
+			{Name: "actors", Rows: 2},
+		},
+		Checksums: map[string]string{"tables/movies.jsonl": "abc", "tables/actors.jsonl": "def"},
+	}
+}
+
+func TestBackupDatabase_Success(t *testing.T) {
+	var gotPath string
+	archiver := &MockDatabaseArchiver{
+		BackupFileFunc: func(ctx context.Context, path string, progress database.BackupProgress) (*database.BackupManifest, error) {
+			gotPath = path
+			return testManifest(), nil
+		},
+	}
+	tools := NewBackupTools(archiver)
+
+	result, output, err := tools.BackupDatabase(context.Background(), nil, BackupDatabaseInput{Path: "/tmp/movies.zip"})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	assertSummaryResult(t, result)
+	if gotPath != "/tmp/movies.zip" {
+		t.Errorf("Expected path /tmp/movies.zip, got: %s", gotPath)
+	}
+	if output.TotalRows != 5 || len(output.Tables) != 2 || output.VerifiedFiles != 2 {
+		t.Errorf("Unexpected output: %+v", output)
+	}
+	if output.CreatedAt != "2024-01-02T03:04:05Z" {
+		t.Errorf("Expected RFC3339 created_at, got: %s", output.CreatedAt)
+	}
+}
+
+func TestBackupDatabase_MissingPath(t *testing.T) {
+	err := BackupDatabaseInput{}.Validate()
+
+	if err == nil {
+		t.Error("Expected error for missing path")
+	}
+}
+
+func TestRestoreDatabase_Success(t *testing.T) {
+	archiver := &MockDatabaseArchiver{
+		RestoreFileFunc: func(ctx context.Context, path string, progress database.BackupProgress) (*database.BackupManifest, error) {
+			return testManifest(), nil
+		},
+	}
+	tools := NewBackupTools(archiver)
+
+	_, output, err := tools.RestoreDatabase(context.Background(), nil, RestoreDatabaseInput{Path: "/tmp/movies.zip"})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.TotalRows != 5 {
+		t.Errorf("Expected 5 restored rows, got: %d", output.TotalRows)
+	}
+}
+
+// MockSimilarityRebuilder is a mock implementation of SimilarityRebuilder
+type MockSimilarityRebuilder struct {
+	calls int
+}
+
+func (m *MockSimilarityRebuilder) Rebuild(ctx context.Context) (*similarityApp.RebuildDTO, error) {
+	m.calls++
+	return &similarityApp.RebuildDTO{}, nil
+}
+
+func TestRestoreDatabase_RebuildsSimilarities(t *testing.T) {
+	tools := NewBackupTools(&MockDatabaseArchiver{
+		RestoreFileFunc: func(ctx context.Context, path string, progress database.BackupProgress) (*database.BackupManifest, error) {
+			return testManifest(), nil
+		},
+	})
+	rebuilder := &MockSimilarityRebuilder{}
+	tools.SetSimilarityRebuilder(rebuilder)
+
+	if _, _, err := tools.RestoreDatabase(context.Background(), nil, RestoreDatabaseInput{Path: "/tmp/movies.zip"}); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if rebuilder.calls != 1 {
+		t.Errorf("Expected the similarity index to be rebuilt once, got %d", rebuilder.calls)
+	}
+}
+
+func TestRestoreDatabase_ChecksumFailure(t *testing.T) {
+	archiver := &MockDatabaseArchiver{
+		RestoreFileFunc: func(ctx context.Context, path string, progress database.BackupProgress) (*database.BackupManifest, error) {
+			return nil, database.ErrBackupChecksum
+		},
+	}
+	tools := NewBackupTools(archiver)
+
+	_, _, err := tools.RestoreDatabase(context.Background(), nil, RestoreDatabaseInput{Path: "/tmp/movies.zip"})
+
+	if !errors.Is(err, database.ErrBackupChecksum) {
+		t.Errorf("Expected checksum error, got: %v", err)
+	}
+}
+
+func TestBackupDatabase_ResolvesInArchiveDir(t *testing.T) {
+	root := t.TempDir()
+	var gotPath string
+	archiver := &MockDatabaseArchiver{
+		BackupFileFunc: func(ctx context.Context, path string, progress database.BackupProgress) (*database.BackupManifest, error) {
+			gotPath = path
+			return testManifest(), nil
+		},
+	}
+	tools := NewBackupTools(archiver)
+	tools.SetArchiveDir(NewArchiveDir(root))
+
+	_, output, err := tools.BackupDatabase(context.Background(), nil, BackupDatabaseInput{Path: "movies.zip"})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	want, _ := filepath.EvalSymlinks(root)
+	if gotPath != filepath.Join(want, "movies.zip") || output.Path != gotPath {
+		t.Errorf("Expected archive in %s, got: %s (reported %s)", want, gotPath, output.Path)
+	}
+}
+
+func TestBackupTools_RejectPathsOutsideArchiveDir(t *testing.T) {
+	archiver := &MockDatabaseArchiver{
+		BackupFileFunc: func(ctx context.Context, path string, progress database.BackupProgress) (*database.BackupManifest, error) {
+			t.Errorf("Expected no backup, got one to %s", path)
+			return testManifest(), nil
+		},
+		RestoreFileFunc: func(ctx context.Context, path string, progress database.BackupProgress) (*database.BackupManifest, error) {
+			t.Errorf("Expected no restore, got one from %s", path)
+			return testManifest(), nil
+		},
+	}
+	tools := NewBackupTools(archiver)
+	tools.SetArchiveDir(NewArchiveDir(t.TempDir()))
+	ctx := database.WithTenant(context.Background(), "acme", nil)
+
+	for _, path := range []string{"/data/movies.db", "../movies.db", "../globex.db"} {
+		if _, _, err := tools.BackupDatabase(ctx, nil, BackupDatabaseInput{Path: path}); !errors.Is(err, shared.ErrValidation) {
+			t.Errorf("Expected backup to %s to be rejected, got: %v", path, err)
+		}
+		if _, _, err := tools.RestoreDatabase(ctx, nil, RestoreDatabaseInput{Path: path}); !errors.Is(err, shared.ErrValidation) {
+			t.Errorf("Expected restore from %s to be rejected, got: %v", path, err)
+		}
+	}
+}
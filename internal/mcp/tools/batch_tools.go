@@ -0,0 +1,192 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	actorApp "github.com/francknouama/movies-mcp-server/internal/application/actor"
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/i18n"
+)
+
+// DefaultMaxBatchSize is the number of IDs a batch get accepts when no limit is configured
+const DefaultMaxBatchSize = 100
+
+// MovieBatchGetter defines the interface for retrieving many movies at once
+type MovieBatchGetter interface {
+	GetMoviesByIDs(ctx context.Context, ids []int) ([]*movieApp.MovieDTO, error)
+}
+
+// ActorBatchGetter defines the interface for retrieving many actors at once
+type ActorBatchGetter interface {
+	GetActorsByIDs(ctx context.Context, ids []int) ([]*actorApp.ActorDTO, error)
+}
+
+// BatchTools provides SDK-based MCP handlers for batch retrieval
+type BatchTools struct {
+	movies       MovieBatchGetter
+	actors       ActorBatchGetter
+	maxBatchSize int
+}
+
+// NewBatchTools creates a new batch tools instance. A non-positive
+// maxBatchSize falls back to DefaultMaxBatchSize.
+func NewBatchTools(movies MovieBatchGetter, actors ActorBatchGetter, maxBatchSize int) *BatchTools {
+	if maxBatchSize <= 0 {
+		maxBatchSize = DefaultMaxBatchSize
+	}
+	return &BatchTools{
+		movies:       movies,
+		actors:       actors,
+		maxBatchSize: maxBatchSize,
+	}
+}
+
+// MaxBatchSize returns the number of IDs a single batch call accepts
+func (t *BatchTools) MaxBatchSize() int {
+	return t.maxBatchSize
+}
+
+// uniqueIDs validates a requested ID list and removes duplicates, keeping request order
+func (t *BatchTools) uniqueIDs(ids []int, entity string) ([]int, error) {
+	if len(ids) == 0 {
+		return nil, shared.NewValidationError("ids are required")
+	}
+	if len(ids) > t.maxBatchSize {
+		return nil, shared.NewValidationError("too many ids: %d requested, maximum is %d", len(ids), t.maxBatchSize)
+	}
+
+	seen := make(map[int]bool, len(ids))
+	unique := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, shared.NewValidationError("invalid %s ID: %d", entity, id)
+		}
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique, nil
+}
+
+// missingSummary lists requested IDs that were not found
+func missingSummary(ctx context.Context, missing []int) string {
+	if len(missing) == 0 {
+		return ""
+	}
+	names := make([]string, len(missing))
+	for i, id := range missing {
+		names[i] = strconv.Itoa(id)
+	}
+	return i18n.FromContext(ctx).Translate("; missing") + listSummary(ctx, names)
+}
+
+// ===== get_movies_by_ids Tool =====
+
+// GetMoviesByIDsInput defines the input schema for get_movies_by_ids tool
+type GetMoviesByIDsInput struct {
+	IDs    []int    `json:"ids" jsonschema:"Movie IDs to retrieve"`
+	Fields []string `json:"fields,omitempty" jsonschema:"Movie fields to return, e.g. title, year and rating; id is always returned (default every field)"`
+}
+
+// Validate checks the selected fields exist
+func (in GetMoviesByIDsInput) Validate() error {
+	return validateMovieFields(in.Fields)
+}
+
+// GetMoviesByIDsOutput defines the output schema for get_movies_by_ids tool
+type GetMoviesByIDsOutput struct {
+	Movies  []MovieOutput `json:"movies" jsonschema:"Movies that were found, in request order"`
+	Missing []int         `json:"missing" jsonschema:"Requested IDs with no matching movie"`
+}
+
+// GetMoviesByIDs handles the get_movies_by_ids tool call
+func (t *BatchTools) GetMoviesByIDs(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input GetMoviesByIDsInput,
+) (*mcp.CallToolResult, GetMoviesByIDsOutput, error) {
+	ids, err := t.uniqueIDs(input.IDs, "movie")
+	if err != nil {
+		return nil, GetMoviesByIDsOutput{}, err
+	}
+
+	movieDTOs, err := t.movies.GetMoviesByIDs(ctx, ids)
+	if err != nil {
+		return nil, GetMoviesByIDsOutput{}, fmt.Errorf("failed to get movies: %w", err)
+	}
+
+	byID := make(map[int]*movieApp.MovieDTO, len(movieDTOs))
+	for _, movieDTO := range movieDTOs {
+		byID[movieDTO.ID] = movieDTO
+	}
+
+	output := GetMoviesByIDsOutput{
+		Movies:  make([]MovieOutput, 0, len(ids)),
+		Missing: []int{},
+	}
+	for _, id := range ids {
+		if movieDTO, ok := byID[id]; ok {
+			output.Movies = append(output.Movies, newMovieOutput(movieDTO))
+		} else {
+			output.Missing = append(output.Missing, id)
+		}
+	}
+
+	selectMovieFields(output.Movies, input.Fields)
+	return summaryResult(ctx, output, "%s%s", movieListSummary(ctx, "Found", output.Movies), missingSummary(ctx, output.Missing)), output, nil
+}
+
+// ===== get_actors_by_ids Tool =====
+
+// GetActorsByIDsInput defines the input schema for get_actors_by_ids tool
+type GetActorsByIDsInput struct {
+	IDs []int `json:"ids" jsonschema:"Actor IDs to retrieve"`
+}
+
+// GetActorsByIDsOutput defines the output schema for get_actors_by_ids tool
+type GetActorsByIDsOutput struct {
+	Actors  []ActorOutput `json:"actors" jsonschema:"Actors that were found, in request order"`
+	Missing []int         `json:"missing" jsonschema:"Requested IDs with no matching actor"`
+}
+
+// GetActorsByIDs handles the get_actors_by_ids tool call
+func (t *BatchTools) GetActorsByIDs(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input GetActorsByIDsInput,
+) (*mcp.CallToolResult, GetActorsByIDsOutput, error) {
+	ids, err := t.uniqueIDs(input.IDs, "actor")
+	if err != nil {
+		return nil, GetActorsByIDsOutput{}, err
+	}
+
+	actorDTOs, err := t.actors.GetActorsByIDs(ctx, ids)
+	if err != nil {
+		return nil, GetActorsByIDsOutput{}, fmt.Errorf("failed to get actors: %w", err)
+	}
+
+	byID := make(map[int]*actorApp.ActorDTO, len(actorDTOs))
+	for _, actorDTO := range actorDTOs {
+		byID[actorDTO.ID] = actorDTO
+	}
+
+	output := GetActorsByIDsOutput{
+		Actors:  make([]ActorOutput, 0, len(ids)),
+		Missing: []int{},
+	}
+	for _, id := range ids {
+		if actorDTO, ok := byID[id]; ok {
+			output.Actors = append(output.Actors, newActorOutput(actorDTO))
+		} else {
+			output.Missing = append(output.Missing, id)
+		}
+	}
+
+	return summaryResult(ctx, output, "%s%s", actorListSummary(ctx, "Found", output.Actors), missingSummary(ctx, output.Missing)), output, nil
+}
@@ -0,0 +1,130 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	actorApp "github.com/francknouama/movies-mcp-server/internal/application/actor"
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+)
+
+// MockMovieBatchGetter is a mock implementation of MovieBatchGetter
+type MockMovieBatchGetter struct {
+	GetMoviesByIDsFunc func(ctx context.Context, ids []int) ([]*movieApp.MovieDTO, error)
+}
+
+func (m *MockMovieBatchGetter) GetMoviesByIDs(ctx context.Context, ids []int) ([]*movieApp.MovieDTO, error) {
+	if m.GetMoviesByIDsFunc != nil {
+		return m.GetMoviesByIDsFunc(ctx, ids)
+	}
+	return nil, errors.New("not implemented")
+}
+
+// MockActorBatchGetter is a mock implementation of ActorBatchGetter
+type MockActorBatchGetter struct {
+	GetActorsByIDsFunc func(ctx context.Context, ids []int) ([]*actorApp.ActorDTO, error)
+}
+
+func (m *MockActorBatchGetter) GetActorsByIDs(ctx context.Context, ids []int) ([]*actorApp.ActorDTO, error) {
+	if m.GetActorsByIDsFunc != nil {
+		return m.GetActorsByIDsFunc(ctx, ids)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func TestGetMoviesByIDs_FoundAndMissing(t *testing.T) {
+	var gotIDs []int
+	movies := &MockMovieBatchGetter{
+		GetMoviesByIDsFunc: func(ctx context.Context, ids []int) ([]*movieApp.MovieDTO, error) {
+			gotIDs = ids
+			// Repository order differs from request order
+			return []*movieApp.MovieDTO{
+				{ID: 3, Title: "The Matrix", Year: 1999},
+				{ID: 1, Title: "Inception", Year: 2010},
+			}, nil
+		},
+	}
+	tools := NewBatchTools(movies, &MockActorBatchGetter{}, 10)
+
+	result, output, err := tools.GetMoviesByIDs(context.Background(), nil, GetMoviesByIDsInput{IDs: []int{1, 2, 3, 1}})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	assertSummaryResult(t, result)
+	if !reflect.DeepEqual(gotIDs, []int{1, 2, 3}) {
+		t.Errorf("Expected deduplicated IDs [1 2 3], got: %v", gotIDs)
+	}
+	if len(output.Movies) != 2 || output.Movies[0].ID != 1 || output.Movies[1].ID != 3 {
+		t.Errorf("Expected movies 1 and 3 in request order, got: %+v", output.Movies)
+	}
+	if !reflect.DeepEqual(output.Missing, []int{2}) {
+		t.Errorf("Expected missing [2], got: %v", output.Missing)
+	}
+}
+
+func TestGetMoviesByIDs_ExceedsMaxBatchSize(t *testing.T) {
+	tools := NewBatchTools(&MockMovieBatchGetter{}, &MockActorBatchGetter{}, 2)
+
+	_, _, err := tools.GetMoviesByIDs(context.Background(), nil, GetMoviesByIDsInput{IDs: []int{1, 2, 3}})
+
+	if err == nil {
+		t.Error("Expected error when exceeding max batch size")
+	}
+}
+
+func TestGetMoviesByIDs_InvalidInput(t *testing.T) {
+	tools := NewBatchTools(&MockMovieBatchGetter{}, &MockActorBatchGetter{}, 0)
+
+	inputs := map[string][]int{
+		"empty":    {},
+		"zero":     {1, 0},
+		"negative": {-5},
+	}
+
+	for name, ids := range inputs {
+		_, _, err := tools.GetMoviesByIDs(context.Background(), nil, GetMoviesByIDsInput{IDs: ids})
+		if err == nil {
+			t.Errorf("Expected error for %s ids", name)
+		}
+	}
+}
+
+func TestGetMoviesByIDs_ServiceError(t *testing.T) {
+	movies := &MockMovieBatchGetter{
+		GetMoviesByIDsFunc: func(ctx context.Context, ids []int) ([]*movieApp.MovieDTO, error) {
+			return nil, errors.New("database error")
+		},
+	}
+	tools := NewBatchTools(movies, &MockActorBatchGetter{}, 10)
+
+	_, _, err := tools.GetMoviesByIDs(context.Background(), nil, GetMoviesByIDsInput{IDs: []int{1}})
+
+	if err == nil {
+		t.Error("Expected error from service")
+	}
+}
+
+func TestGetActorsByIDs_FoundAndMissing(t *testing.T) {
+	actors := &MockActorBatchGetter{
+		GetActorsByIDsFunc: func(ctx context.Context, ids []int) ([]*actorApp.ActorDTO, error) {
+			return []*actorApp.ActorDTO{{ID: 7, Name: "Keanu Reeves"}}, nil
+		},
+	}
+	tools := NewBatchTools(&MockMovieBatchGetter{}, actors, 10)
+
+	_, output, err := tools.GetActorsByIDs(context.Background(), nil, GetActorsByIDsInput{IDs: []int{8, 7}})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(output.Actors) != 1 || output.Actors[0].Name != "Keanu Reeves" {
+		t.Errorf("Expected Keanu Reeves, got: %+v", output.Actors)
+	}
+	if !reflect.DeepEqual(output.Missing, []int{8}) {
+		t.Errorf("Expected missing [8], got: %v", output.Missing)
+	}
+	validateAgainstSchema(t, OutputSchema[GetActorsByIDsOutput](), output)
+}
@@ -0,0 +1,152 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+)
+
+// MovieBulkUpdater defines the interface for filter-based movie updates
+type MovieBulkUpdater interface {
+	BulkUpdateMovies(ctx context.Context, cmd movieApp.BulkUpdateMoviesCommand) (*movieApp.BulkUpdateResultDTO, error)
+}
+
+// BulkUpdateTools provides SDK-based MCP handlers for bulk movie updates
+type BulkUpdateTools struct {
+	updater MovieBulkUpdater
+}
+
+// NewBulkUpdateTools creates a new bulk update tools instance
+func NewBulkUpdateTools(updater MovieBulkUpdater) *BulkUpdateTools {
+	return &BulkUpdateTools{
+		updater: updater,
+	}
+}
+
+// ===== bulk_update_movies Tool =====
+
+// BulkUpdateFilterInput selects the movies a bulk update applies to
+type BulkUpdateFilterInput struct {
+	Title     string  `json:"title,omitempty" jsonschema:"Title contains (case-insensitive)"`
+	Director  string  `json:"director,omitempty" jsonschema:"Director contains (case-insensitive)"`
+	Genre     string  `json:"genre,omitempty" jsonschema:"Has this genre"`
+	MinYear   int     `json:"min_year,omitempty" jsonschema:"Minimum release year"`
+	MaxYear   int     `json:"max_year,omitempty" jsonschema:"Maximum release year"`
+	MinRating float64 `json:"min_rating,omitempty" jsonschema:"Minimum rating (0-10)"`
+	MaxRating float64 `json:"max_rating,omitempty" jsonschema:"Maximum rating (0-10)"`
+
+	MaxCertification       string   `json:"max_certification,omitempty" jsonschema:"Most restrictive age rating to include (e.g. PG-13)"`
+	CertificationRegion    string   `json:"certification_region,omitempty" jsonschema:"Region whose rating scale max_certification uses (US or GB; default US)"`
+	ExcludeContentWarnings []string `json:"exclude_content_warnings,omitempty" jsonschema:"Leave out movies carrying any of these content warnings"`
+}
+
+// MoviePatchInput defines the changes a bulk update makes; omitted fields are left as they are
+type MoviePatchInput struct {
+	Director     string   `json:"director,omitempty" jsonschema:"Replace the director"`
+	Rating       float64  `json:"rating,omitempty" jsonschema:"Replace the rating (0-10)"`
+	AddGenres    []string `json:"add_genres,omitempty" jsonschema:"Genres to add"`
+	RemoveGenres []string `json:"remove_genres,omitempty" jsonschema:"Genres to remove"`
+
+	Certifications        map[string]string `json:"certifications,omitempty" jsonschema:"Age ratings to set keyed by region; an empty rating removes it"`
+	AddContentWarnings    []string          `json:"add_content_warnings,omitempty" jsonschema:"Content warnings to add"`
+	RemoveContentWarnings []string          `json:"remove_content_warnings,omitempty" jsonschema:"Content warnings to remove"`
+}
+
+// BulkUpdateMoviesInput defines the input schema for bulk_update_movies tool
+type BulkUpdateMoviesInput struct {
+	Filter            BulkUpdateFilterInput `json:"filter" jsonschema:"Movies to update; at least one filter is required"`
+	Update            MoviePatchInput       `json:"update" jsonschema:"Changes to apply to every matching movie"`
+	ConfirmationToken string                `json:"confirmation_token,omitempty" jsonschema:"Token from a dry run of the same filter and update; omit to preview without saving"`
+	SampleSize        int                   `json:"sample_size,omitempty" jsonschema:"Before/after rows to return (default 5, max 20)"`
+}
+
+// MovieChangeOutput shows a movie before and after a bulk update
+type MovieChangeOutput struct {
+	Before MovieOutput `json:"before" jsonschema:"Movie as it is now"`
+	After  MovieOutput `json:"after" jsonschema:"Movie with the update applied"`
+}
+
+// BulkUpdateMoviesOutput defines the output schema for bulk_update_movies tool
+type BulkUpdateMoviesOutput struct {
+	DryRun            bool                `json:"dry_run" jsonschema:"True when nothing was saved"`
+	Matched           int                 `json:"matched" jsonschema:"Movies matching the filter"`
+	Affected          int                 `json:"affected" jsonschema:"Matching movies the update changes"`
+	Updated           int                 `json:"updated" jsonschema:"Movies saved (zero for a dry run)"`
+	ConfirmationToken string              `json:"confirmation_token,omitempty" jsonschema:"Pass back with the same filter and update to commit"`
+	Samples           []MovieChangeOutput `json:"samples" jsonschema:"Sample of affected movies before and after"`
+}
+
+// BulkUpdateMovies handles the bulk_update_movies tool call. Calls without a
+// confirmation token are dry runs, so every commit follows a preview.
+func (t *BulkUpdateTools) BulkUpdateMovies(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input BulkUpdateMoviesInput,
+) (*mcp.CallToolResult, BulkUpdateMoviesOutput, error) {
+	result, err := t.updater.BulkUpdateMovies(ctx, movieApp.BulkUpdateMoviesCommand{
+		Filter: movieApp.SearchMoviesQuery{
+			Title:     input.Filter.Title,
+			Director:  input.Filter.Director,
+			Genre:     input.Filter.Genre,
+			MinYear:   input.Filter.MinYear,
+			MaxYear:   input.Filter.MaxYear,
+			MinRating: input.Filter.MinRating,
+			MaxRating: input.Filter.MaxRating,
+
+			MaxCertification:    input.Filter.MaxCertification,
+			CertificationRegion: input.Filter.CertificationRegion,
+			ExcludeWarnings:     input.Filter.ExcludeContentWarnings,
+		},
+		Patch: movieApp.MoviePatch{
+			Director:              input.Update.Director,
+			Rating:                input.Update.Rating,
+			AddGenres:             input.Update.AddGenres,
+			RemoveGenres:          input.Update.RemoveGenres,
+			Certifications:        input.Update.Certifications,
+			AddContentWarnings:    input.Update.AddContentWarnings,
+			RemoveContentWarnings: input.Update.RemoveContentWarnings,
+		},
+		ConfirmationToken: input.ConfirmationToken,
+		SampleSize:        input.SampleSize,
+	})
+	if err != nil {
+		return nil, BulkUpdateMoviesOutput{}, fmt.Errorf("failed to bulk update movies: %w", err)
+	}
+
+	output := BulkUpdateMoviesOutput{
+		DryRun:            result.DryRun,
+		Matched:           result.Matched,
+		Affected:          result.Affected,
+		Updated:           result.Updated,
+		ConfirmationToken: result.ConfirmationToken,
+		Samples:           make([]MovieChangeOutput, 0, len(result.Samples)),
+	}
+	changed := make([]MovieOutput, 0, len(result.Samples))
+	for _, sample := range result.Samples {
+		change := MovieChangeOutput{Before: newMovieOutput(sample.Before), After: newMovieOutput(sample.After)}
+		output.Samples = append(output.Samples, change)
+		changed = append(changed, change.After)
+	}
+
+	if !output.DryRun {
+		return summaryResult(ctx, output, "Updated %s of %d matching", countNoun(ctx, output.Updated, "movie", "movies"), output.Matched), output, nil
+	}
+	if output.Affected == 0 {
+		return summaryResult(ctx, output, "Dry run: none of %s would change", countNoun(ctx, output.Matched, "matching movie", "matching movies")), output, nil
+	}
+	return summaryResult(ctx, output, "Dry run: %d of %s would change%s; commit with confirmation_token %s",
+		output.Affected, countNoun(ctx, output.Matched, "matching movie", "matching movies"),
+		listSummary(ctx, movieLabels(changed)), output.ConfirmationToken), output, nil
+}
+
+// movieLabels formats each movie as "Title (Year)"
+func movieLabels(movies []MovieOutput) []string {
+	labels := make([]string, 0, len(movies))
+	for _, movie := range movies {
+		labels = append(labels, movieLabel(movie))
+	}
+	return labels
+}
@@ -0,0 +1,146 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+)
+
+// MockMovieBulkUpdater is a mock implementation of MovieBulkUpdater
+type MockMovieBulkUpdater struct {
+	BulkUpdateMoviesFunc func(ctx context.Context, cmd movieApp.BulkUpdateMoviesCommand) (*movieApp.BulkUpdateResultDTO, error)
+}
+
+func (m *MockMovieBulkUpdater) BulkUpdateMovies(ctx context.Context, cmd movieApp.BulkUpdateMoviesCommand) (*movieApp.BulkUpdateResultDTO, error) {
+	if m.BulkUpdateMoviesFunc != nil {
+		return m.BulkUpdateMoviesFunc(ctx, cmd)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func TestBulkUpdateMovies_DryRun(t *testing.T) {
+	var gotCmd movieApp.BulkUpdateMoviesCommand
+	updater := &MockMovieBulkUpdater{
+		BulkUpdateMoviesFunc: func(ctx context.Context, cmd movieApp.BulkUpdateMoviesCommand) (*movieApp.BulkUpdateResultDTO, error) {
+			gotCmd = cmd
+			return &movieApp.BulkUpdateResultDTO{
+				DryRun:            true,
+				Matched:           3,
+				Affected:          2,
+				ConfirmationToken: "abc123",
+				Samples: []*movieApp.MovieChangeDTO{
+					{
+						Before: &movieApp.MovieDTO{ID: 1, Title: "Inception", Director: "C. Nolan", Year: 2010},
+						After:  &movieApp.MovieDTO{ID: 1, Title: "Inception", Director: "Christopher Nolan", Year: 2010},
+					},
+				},
+			}, nil
+		},
+	}
+	tools := NewBulkUpdateTools(updater)
+
+	result, output, err := tools.BulkUpdateMovies(context.Background(), nil, BulkUpdateMoviesInput{
+		Filter: BulkUpdateFilterInput{Director: "C. Nolan", MinYear: 2000},
+		Update: MoviePatchInput{Director: "Christopher Nolan", AddGenres: []string{"Sci-Fi"}},
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if gotCmd.Filter.Director != "C. Nolan" || gotCmd.Filter.MinYear != 2000 {
+		t.Errorf("Expected filter to reach the service, got: %+v", gotCmd.Filter)
+	}
+	if gotCmd.Patch.Director != "Christopher Nolan" || len(gotCmd.Patch.AddGenres) != 1 {
+		t.Errorf("Expected update to reach the service, got: %+v", gotCmd.Patch)
+	}
+	if gotCmd.ConfirmationToken != "" {
+		t.Errorf("Expected no confirmation token, got: %q", gotCmd.ConfirmationToken)
+	}
+	if !output.DryRun || output.Affected != 2 || output.ConfirmationToken != "abc123" {
+		t.Errorf("Expected dry run output, got: %+v", output)
+	}
+	if output.Samples[0].After.Director != "Christopher Nolan" || output.Samples[0].After.Genres == nil {
+		t.Errorf("Expected sample with non-nil genres, got: %+v", output.Samples[0].After)
+	}
+	assertSummaryResult(t, result)
+	summary := result.Content[1].(*mcp.TextContent).Text
+	if summary != "Dry run: 2 of 3 matching movies would change: Inception (2010); commit with confirmation_token abc123" {
+		t.Errorf("Unexpected summary, got: %s", summary)
+	}
+}
+
+func TestBulkUpdateMovies_Commit(t *testing.T) {
+	var gotToken string
+	updater := &MockMovieBulkUpdater{
+		BulkUpdateMoviesFunc: func(ctx context.Context, cmd movieApp.BulkUpdateMoviesCommand) (*movieApp.BulkUpdateResultDTO, error) {
+			gotToken = cmd.ConfirmationToken
+			return &movieApp.BulkUpdateResultDTO{Matched: 3, Affected: 2, Updated: 2, Samples: []*movieApp.MovieChangeDTO{}}, nil
+		},
+	}
+	tools := NewBulkUpdateTools(updater)
+
+	result, output, err := tools.BulkUpdateMovies(context.Background(), nil, BulkUpdateMoviesInput{
+		Filter:            BulkUpdateFilterInput{Director: "C. Nolan"},
+		Update:            MoviePatchInput{Director: "Christopher Nolan"},
+		ConfirmationToken: "abc123",
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if gotToken != "abc123" {
+		t.Errorf("Expected token to reach the service, got: %q", gotToken)
+	}
+	if output.DryRun || output.Updated != 2 {
+		t.Errorf("Expected 2 movies updated, got: %+v", output)
+	}
+	assertSummaryResult(t, result)
+	summary := result.Content[1].(*mcp.TextContent).Text
+	if summary != "Updated 2 movies of 3 matching" {
+		t.Errorf("Unexpected summary, got: %s", summary)
+	}
+}
+
+func TestBulkUpdateMovies_NothingToChange(t *testing.T) {
+	updater := &MockMovieBulkUpdater{
+		BulkUpdateMoviesFunc: func(ctx context.Context, cmd movieApp.BulkUpdateMoviesCommand) (*movieApp.BulkUpdateResultDTO, error) {
+			return &movieApp.BulkUpdateResultDTO{DryRun: true, Matched: 1, Samples: []*movieApp.MovieChangeDTO{}}, nil
+		},
+	}
+	tools := NewBulkUpdateTools(updater)
+
+	result, _, err := tools.BulkUpdateMovies(context.Background(), nil, BulkUpdateMoviesInput{
+		Filter: BulkUpdateFilterInput{Genre: "Drama"},
+		Update: MoviePatchInput{AddGenres: []string{"Drama"}},
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	summary := result.Content[1].(*mcp.TextContent).Text
+	if summary != "Dry run: none of 1 matching movie would change" {
+		t.Errorf("Unexpected summary, got: %s", summary)
+	}
+}
+
+func TestBulkUpdateMovies_ServiceError(t *testing.T) {
+	updater := &MockMovieBulkUpdater{
+		BulkUpdateMoviesFunc: func(ctx context.Context, cmd movieApp.BulkUpdateMoviesCommand) (*movieApp.BulkUpdateResultDTO, error) {
+			return nil, errors.New("at least one filter is required")
+		},
+	}
+	tools := NewBulkUpdateTools(updater)
+
+	_, _, err := tools.BulkUpdateMovies(context.Background(), nil, BulkUpdateMoviesInput{
+		Update: MoviePatchInput{Rating: 9},
+	})
+
+	if err == nil || !strings.Contains(err.Error(), "failed to bulk update movies") {
+		t.Errorf("Expected bulk update error, got: %v", err)
+	}
+}
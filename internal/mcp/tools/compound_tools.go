@@ -9,11 +9,19 @@ import (
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 
 	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/domain/similarity"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/i18n"
+	"github.com/francknouama/movies-mcp-server/pkg/serialization"
 )
 
 // CompoundTools provides SDK-based MCP handlers for compound operations
 type CompoundTools struct {
 	movieService MovieService
+	preferences  *PreferenceStore
+	similar      SimilarMoviesService
+	cast         MovieCastReader
 }
 
 // NewCompoundTools creates a new compound tools instance
@@ -23,69 +31,142 @@ func NewCompoundTools(movieService MovieService) *CompoundTools {
 	}
 }
 
+// SetPreferenceStore makes movie_recommendation_engine and pick_movie_for_me
+// default to the session's favorite genres and leave out its disliked
+// directors
+func (t *CompoundTools) SetPreferenceStore(store *PreferenceStore) {
+	t.preferences = store
+}
+
+// SetSimilarMovies lets movie_recommendation_engine recommend movies similar
+// to ones the user liked, read from the precomputed similarity index
+func (t *CompoundTools) SetSimilarMovies(service SimilarMoviesService) {
+	t.similar = service
+}
+
+// SetCastReader lets director_career_analysis_v2 find the actors a director
+// keeps casting
+func (t *CompoundTools) SetCastReader(cast MovieCastReader) {
+	t.cast = cast
+}
+
 // ===== bulk_movie_import Tool =====
 
 // BulkMovieImportInput defines the input schema for bulk_movie_import tool
 type BulkMovieImportInput struct {
-	Movies []MovieImportItem `json:"movies" jsonschema:"required,description=Array of movies to import"`
+	Movies     []MovieImportItem `json:"movies" jsonschema:"Array of movies to import"`
+	Validation string            `json:"validation,omitempty" jsonschema:"Validation policy for this import: strict (also rejects future release dates and a rating of 0) or lenient; defaults to the server's policy"`
 }
 
 // MovieImportItem defines a single movie for bulk import
 type MovieImportItem struct {
-	Title     string   `json:"title" jsonschema:"required,description=Movie title"`
-	Director  string   `json:"director" jsonschema:"required,description=Movie director"`
-	Year      int      `json:"year" jsonschema:"required,description=Release year"`
-	Rating    float64  `json:"rating" jsonschema:"required,description=Movie rating (0-10)"`
-	Genres    []string `json:"genres,omitempty" jsonschema:"description=List of genres"`
-	PosterURL string   `json:"poster_url,omitempty" jsonschema:"description=URL to movie poster"`
+	Title     string   `json:"title" jsonschema:"Movie title"`
+	Director  string   `json:"director" jsonschema:"Movie director"`
+	Year      int      `json:"year,omitempty" jsonschema:"Release year; may be left out when release_date is given"`
+	Rating    float64  `json:"rating" jsonschema:"Movie rating (0-10)"`
+	Genres    []string `json:"genres,omitempty" jsonschema:"List of genres"`
+	PosterURL string   `json:"poster_url,omitempty" jsonschema:"URL to movie poster"`
+
+	Certifications  map[string]string `json:"certifications,omitempty" jsonschema:"Age ratings keyed by region (US: MPAA G/PG/PG-13/R/NC-17; GB: BBFC U/PG/12A/12/15/18/R18)"`
+	ContentWarnings []string          `json:"content_warnings,omitempty" jsonschema:"Content warnings such as violence or strong language"`
+	ReleaseDate     string            `json:"release_date,omitempty" jsonschema:"Release date (YYYY-MM-DD), in year when both are given"`
+	Duration        int               `json:"duration,omitempty" jsonschema:"Runtime in minutes"`
+}
+
+// Validate checks the validation policy and that every release date is a
+// YYYY-MM-DD date; other problems are reported per movie
+func (in BulkMovieImportInput) Validate() error {
+	if _, err := parseValidationOverride(in.Validation); err != nil {
+		return err
+	}
+	for i, movie := range in.Movies {
+		if _, err := parseMovieReleaseDate(movie.ReleaseDate); err != nil {
+			return shared.NewValidationError("movies[%d]: %w", i, err)
+		}
+	}
+	return nil
 }
 
 // BulkMovieImportOutput defines the output schema for bulk_movie_import tool
 type BulkMovieImportOutput struct {
-	Imported    int            `json:"imported" jsonschema:"description=Number of successfully imported movies"`
-	Failed      int            `json:"failed" jsonschema:"description=Number of failed imports"`
-	Total       int            `json:"total" jsonschema:"description=Total movies attempted"`
-	SuccessRate string         `json:"success_rate" jsonschema:"description=Success rate percentage"`
-	Results     []ImportResult `json:"results" jsonschema:"description=Successful import results"`
-	Errors      []ImportError  `json:"errors" jsonschema:"description=Failed import errors"`
+	Imported    int            `json:"imported" jsonschema:"Number of successfully imported movies"`
+	Failed      int            `json:"failed" jsonschema:"Number of failed imports"`
+	Total       int            `json:"total" jsonschema:"Total movies attempted"`
+	SuccessRate string         `json:"success_rate" jsonschema:"Success rate percentage"`
+	Results     []ImportResult `json:"results" jsonschema:"Successful import results"`
+	Errors      []ImportError  `json:"errors" jsonschema:"Failed import errors"`
 }
 
 // ImportResult represents a successful import
 type ImportResult struct {
-	Index int    `json:"index" jsonschema:"description=Index in original array"`
-	ID    int    `json:"id" jsonschema:"description=Created movie ID"`
-	Title string `json:"title" jsonschema:"description=Movie title"`
+	Index int    `json:"index" jsonschema:"Index in original array"`
+	ID    int    `json:"id" jsonschema:"Created movie ID"`
+	Title string `json:"title" jsonschema:"Movie title"`
 }
 
 // ImportError represents a failed import
 type ImportError struct {
-	Index int    `json:"index" jsonschema:"description=Index in original array"`
-	Title string `json:"title,omitempty" jsonschema:"description=Movie title if available"`
-	Error string `json:"error" jsonschema:"description=Error message"`
+	Index int    `json:"index" jsonschema:"Index in original array"`
+	Title string `json:"title,omitempty" jsonschema:"Movie title if available"`
+	Error string `json:"error" jsonschema:"Error message"`
 }
 
-// BulkMovieImport handles the bulk_movie_import tool call
+// batchImportThreshold is the import size above which movies are written
+// with batched inserts instead of one at a time
+const batchImportThreshold = 100
+
+// BulkMovieImport handles the bulk_movie_import tool call. Small imports
+// create movies one by one; larger ones insert every valid movie in one batch.
 func (t *CompoundTools) BulkMovieImport(
 	ctx context.Context,
 	req *mcp.CallToolRequest,
 	input BulkMovieImportInput,
 ) (*mcp.CallToolResult, BulkMovieImportOutput, error) {
-	var results []ImportResult
-	var errors []ImportError
-
-	for i, movie := range input.Movies {
-		// Create movie command
-		cmd := movieApp.CreateMovieCommand{
-			Title:     movie.Title,
-			Director:  movie.Director,
-			Year:      movie.Year,
-			Rating:    movie.Rating,
-			Genres:    movie.Genres,
-			PosterURL: movie.PosterURL,
+	policy, err := parseValidationOverride(input.Validation)
+	if err != nil {
+		return nil, BulkMovieImportOutput{}, err
+	}
+
+	importMovies := t.importOneByOne
+	if len(input.Movies) > batchImportThreshold {
+		importMovies = t.importInBatch
+	}
+
+	results, errors, err := importMovies(ctx, input.Movies, policy)
+	if err != nil {
+		return nil, BulkMovieImportOutput{}, err
+	}
+
+	successRate := 0.0
+	if len(input.Movies) > 0 {
+		successRate = float64(len(results)) / float64(len(input.Movies)) * 100
+	}
+
+	output := BulkMovieImportOutput{
+		Imported:    len(results),
+		Failed:      len(errors),
+		Total:       len(input.Movies),
+		SuccessRate: fmt.Sprintf("%.1f%%", successRate),
+		Results:     results,
+		Errors:      errors,
+	}
+
+	return summaryResult(ctx, output, "Imported %d of %d movies (%s), %d failed", output.Imported, output.Total, output.SuccessRate, output.Failed), output, nil
+}
+
+// importOneByOne creates movies one at a time, stopping if ctx is done
+func (t *CompoundTools) importOneByOne(ctx context.Context, movies []MovieImportItem, policy shared.ValidationPolicy) ([]ImportResult, []ImportError, error) {
+	results := []ImportResult{}
+	errors := []ImportError{}
+
+	for i, movie := range movies {
+		// Stop importing once the client cancels or the deadline passes
+		if err := ctx.Err(); err != nil {
+			return nil, nil, fmt.Errorf("bulk import cancelled after %d of %d movies: %w", i, len(movies), err)
 		}
 
 		// Create movie
-		movieDTO, err := t.movieService.CreateMovie(ctx, cmd)
+		movieDTO, err := t.movieService.CreateMovie(ctx, newCreateMovieCommand(movie, policy))
 		if err != nil {
 			errors = append(errors, ImportError{
 				Index: i,
@@ -101,66 +182,111 @@ func (t *CompoundTools) BulkMovieImport(
 		}
 	}
 
-	successRate := 0.0
-	if len(input.Movies) > 0 {
-		successRate = float64(len(results)) / float64(len(input.Movies)) * 100
+	return results, errors, nil
+}
+
+// importInBatch creates all valid movies with one batched insert
+func (t *CompoundTools) importInBatch(ctx context.Context, movies []MovieImportItem, policy shared.ValidationPolicy) ([]ImportResult, []ImportError, error) {
+	cmds := make([]movieApp.CreateMovieCommand, 0, len(movies))
+	for _, movie := range movies {
+		cmds = append(cmds, newCreateMovieCommand(movie, policy))
 	}
 
-	output := BulkMovieImportOutput{
-		Imported:    len(results),
-		Failed:      len(errors),
-		Total:       len(input.Movies),
-		SuccessRate: fmt.Sprintf("%.1f%%", successRate),
-		Results:     results,
-		Errors:      errors,
+	movieDTOs, createErrs, err := t.movieService.CreateMovies(ctx, cmds)
+	if err != nil {
+		return nil, nil, fmt.Errorf("bulk import of %d movies failed: %w", len(movies), err)
 	}
 
-	return nil, output, nil
+	results := []ImportResult{}
+	errors := []ImportError{}
+	for i, movie := range movies {
+		if createErrs[i] != nil {
+			errors = append(errors, ImportError{Index: i, Title: movie.Title, Error: createErrs[i].Error()})
+			continue
+		}
+		results = append(results, ImportResult{Index: i, ID: movieDTOs[i].ID, Title: movieDTOs[i].Title})
+	}
+
+	return results, errors, nil
+}
+
+// newCreateMovieCommand converts an import item to a create command
+// validated under policy, or the service's policy when it is empty
+func newCreateMovieCommand(movie MovieImportItem, policy shared.ValidationPolicy) movieApp.CreateMovieCommand {
+	return movieApp.CreateMovieCommand{
+		Title:       movie.Title,
+		Director:    movie.Director,
+		Year:        movie.Year,
+		ReleaseDate: movieReleaseDate(movie.ReleaseDate),
+		Rating:      movie.Rating,
+		Duration:    movie.Duration,
+		Genres:      movie.Genres,
+		PosterURL:   movie.PosterURL,
+
+		Certifications:  movie.Certifications,
+		ContentWarnings: movie.ContentWarnings,
+
+		Validation: policy,
+	}
+}
+
+// parseValidationOverride parses a per-call validation policy; empty
+// leaves the service's policy in place
+func parseValidationOverride(value string) (shared.ValidationPolicy, error) {
+	if value == "" {
+		return "", nil
+	}
+	return shared.ParseValidationPolicy(value)
 }
 
 // ===== movie_recommendation_engine Tool =====
 
 // MovieRecommendationInput defines the input schema for movie_recommendation_engine tool
 type MovieRecommendationInput struct {
-	Preferences UserPreferences `json:"preferences,omitempty" jsonschema:"description=User preferences for recommendations"`
-	Limit       int             `json:"limit,omitempty" jsonschema:"description=Maximum number of recommendations,default=10"`
+	Preferences UserPreferences `json:"preferences,omitempty" jsonschema:"User preferences for recommendations"`
+	Limit       int             `json:"limit,omitempty" jsonschema:"Maximum number of recommendations (default 10)"`
 }
 
 // UserPreferences defines user preferences for recommendations
 type UserPreferences struct {
-	Genres        []string `json:"genres,omitempty" jsonschema:"description=Preferred genres"`
-	MinRating     float64  `json:"min_rating,omitempty" jsonschema:"description=Minimum rating"`
-	YearFrom      int      `json:"year_from,omitempty" jsonschema:"description=Start of year range"`
-	YearTo        int      `json:"year_to,omitempty" jsonschema:"description=End of year range"`
-	ExcludeMovies []string `json:"exclude_movies,omitempty" jsonschema:"description=Movie titles to exclude"`
+	Genres        []string `json:"genres,omitempty" jsonschema:"Preferred genres"`
+	MinRating     float64  `json:"min_rating,omitempty" jsonschema:"Minimum rating"`
+	YearFrom      int      `json:"year_from,omitempty" jsonschema:"Start of year range"`
+	YearTo        int      `json:"year_to,omitempty" jsonschema:"End of year range"`
+	ExcludeMovies []string `json:"exclude_movies,omitempty" jsonschema:"Movie titles to exclude"`
+	SimilarTo     []int    `json:"similar_to,omitempty" jsonschema:"IDs of movies the user liked; candidates become the movies most similar to them"`
 }
 
 // MovieRecommendationOutput defines the output schema for movie_recommendation_engine tool
 type MovieRecommendationOutput struct {
-	Recommendations []Recommendation  `json:"recommendations" jsonschema:"description=List of recommended movies"`
-	TotalFound      int               `json:"total_found" jsonschema:"description=Total recommendations found"`
-	PreferencesUsed PreferenceSummary `json:"preferences_used" jsonschema:"description=Summary of preferences used"`
+	Recommendations []Recommendation  `json:"recommendations" jsonschema:"List of recommended movies"`
+	TotalFound      int               `json:"total_found" jsonschema:"Total recommendations found"`
+	PreferencesUsed PreferenceSummary `json:"preferences_used" jsonschema:"Summary of preferences used"`
 }
 
 // Recommendation represents a single movie recommendation
 type Recommendation struct {
-	Rank                 int      `json:"rank" jsonschema:"description=Recommendation rank"`
-	MovieID              int      `json:"movie_id" jsonschema:"description=Movie ID"`
-	Title                string   `json:"title" jsonschema:"description=Movie title"`
-	Director             string   `json:"director" jsonschema:"description=Director name"`
-	Year                 int      `json:"year" jsonschema:"description=Release year"`
-	Rating               float64  `json:"rating" jsonschema:"description=Movie rating"`
-	Genres               []string `json:"genres" jsonschema:"description=List of genres"`
-	MatchScore           string   `json:"match_score" jsonschema:"description=Match percentage"`
-	RecommendationReason string   `json:"recommendation_reason" jsonschema:"description=Why this was recommended"`
+	Rank                 int      `json:"rank" jsonschema:"Recommendation rank"`
+	MovieID              int      `json:"movie_id" jsonschema:"Movie ID"`
+	Title                string   `json:"title" jsonschema:"Movie title"`
+	Director             string   `json:"director" jsonschema:"Director name"`
+	Year                 int      `json:"year" jsonschema:"Release year"`
+	Rating               float64  `json:"rating" jsonschema:"Movie rating"`
+	Genres               []string `json:"genres" jsonschema:"List of genres"`
+	MatchScore           string   `json:"match_score" jsonschema:"Match percentage"`
+	RecommendationReason string   `json:"recommendation_reason" jsonschema:"Why this was recommended"`
 }
 
 // PreferenceSummary summarizes the preferences used
 type PreferenceSummary struct {
-	Genres        []string `json:"genres" jsonschema:"description=Genres used"`
-	MinRating     float64  `json:"min_rating" jsonschema:"description=Minimum rating used"`
-	YearRange     string   `json:"year_range" jsonschema:"description=Year range used"`
-	ExcludedCount int      `json:"excluded_count" jsonschema:"description=Number of excluded movies"`
+	Genres        []string `json:"genres" jsonschema:"Genres used"`
+	MinRating     float64  `json:"min_rating" jsonschema:"Minimum rating used"`
+	YearRange     string   `json:"year_range" jsonschema:"Year range used"`
+	ExcludedCount int      `json:"excluded_count" jsonschema:"Number of excluded movies"`
+
+	GenresFromSession bool     `json:"genres_from_session,omitempty" jsonschema:"Set when genres came from the session's favorite genres"`
+	ExcludedDirectors []string `json:"excluded_directors,omitempty" jsonschema:"The session's disliked directors, whose movies were left out"`
+	SimilarTo         []int    `json:"similar_to,omitempty" jsonschema:"Liked movies the candidates were drawn from"`
 }
 
 // MovieRecommendationEngine handles the movie_recommendation_engine tool call
@@ -175,13 +301,29 @@ func (t *CompoundTools) MovieRecommendationEngine(
 		limit = 10
 	}
 
-	// Build query to get candidate movies
+	// Fill in the session's preferences the call leaves out
+	session := t.preferences.Get(req)
+	genresFromSession := len(input.Preferences.Genres) == 0 && len(session.FavoriteGenres) > 0
+	if genresFromSession {
+		input.Preferences.Genres = session.FavoriteGenres
+	}
+
+	// Build query to get candidate movies; the server's ranking weights, such
+	// as trending, decide which movies are considered
 	query := movieApp.SearchMoviesQuery{
-		Limit: limit * 3, // Get more to filter
+		Limit:  limit * 3, // Get more to filter
+		Ranked: true,
 	}
 
-	// Get all movies
-	movies, err := t.movieService.SearchMovies(ctx, query)
+	// Get candidates: movies similar to the liked ones, or the library
+	var movies []*movieApp.MovieDTO
+	var similarTo map[int]similarMatch
+	var err error
+	if len(input.Preferences.SimilarTo) > 0 {
+		movies, similarTo, err = t.similarCandidates(ctx, input.Preferences.SimilarTo)
+	} else {
+		movies, err = t.movieService.SearchMovies(ctx, query)
+	}
 	if err != nil {
 		return nil, MovieRecommendationOutput{}, fmt.Errorf("failed to search movies: %w", err)
 	}
@@ -200,12 +342,15 @@ func (t *CompoundTools) MovieRecommendationEngine(
 
 	for _, movie := range movies {
 		// Skip excluded movies
-		if excludeMap[strings.ToLower(movie.Title)] {
+		if excludeMap[strings.ToLower(movie.Title)] || session.dislikes(movie.Director) {
 			continue
 		}
 
 		// Calculate recommendation score
 		score := calculateRecommendationScore(movie, input.Preferences)
+		if match, exists := similarTo[movie.ID]; exists {
+			score = (score + match.score) / 2
+		}
 
 		if score > 0.3 { // Minimum threshold
 			scoredMovies = append(scoredMovies, scoredMovie{
@@ -215,9 +360,12 @@ func (t *CompoundTools) MovieRecommendationEngine(
 		}
 	}
 
-	// Sort by score
+	// Sort by score, then ID so equal scores keep a fixed order
 	sort.Slice(scoredMovies, func(i, j int) bool {
-		return scoredMovies[i].score > scoredMovies[j].score
+		if scoredMovies[i].score != scoredMovies[j].score {
+			return scoredMovies[i].score > scoredMovies[j].score
+		}
+		return scoredMovies[i].movie.ID < scoredMovies[j].movie.ID
 	})
 
 	// Prepare recommendations
@@ -227,6 +375,11 @@ func (t *CompoundTools) MovieRecommendationEngine(
 			break
 		}
 
+		reason := generateRecommendationReason(sm.movie, input.Preferences, sm.score)
+		if match, exists := similarTo[sm.movie.ID]; exists {
+			reason = strings.TrimSuffix("Similar to "+match.title+"; "+reason, "; ")
+		}
+
 		recommendations = append(recommendations, Recommendation{
 			Rank:                 i + 1,
 			MovieID:              sm.movie.ID,
@@ -234,9 +387,9 @@ func (t *CompoundTools) MovieRecommendationEngine(
 			Director:             sm.movie.Director,
 			Year:                 sm.movie.Year,
 			Rating:               sm.movie.Rating,
-			Genres:               sm.movie.Genres,
+			Genres:               nonNilStrings(sm.movie.Genres),
 			MatchScore:           fmt.Sprintf("%.1f%%", sm.score*100),
-			RecommendationReason: generateRecommendationReason(sm.movie, input.Preferences, sm.score),
+			RecommendationReason: reason,
 		})
 	}
 
@@ -244,80 +397,376 @@ func (t *CompoundTools) MovieRecommendationEngine(
 		Recommendations: recommendations,
 		TotalFound:      len(recommendations),
 		PreferencesUsed: PreferenceSummary{
-			Genres:        input.Preferences.Genres,
+			Genres:        nonNilStrings(input.Preferences.Genres),
 			MinRating:     input.Preferences.MinRating,
 			YearRange:     fmt.Sprintf("%d-%d", input.Preferences.YearFrom, input.Preferences.YearTo),
 			ExcludedCount: len(input.Preferences.ExcludeMovies),
+
+			GenresFromSession: genresFromSession,
+			ExcludedDirectors: session.DislikedDirectors,
+			SimilarTo:         input.Preferences.SimilarTo,
 		},
 	}
 
-	return nil, output, nil
+	return summaryResult(ctx, output, "%s", recommendationSummary(ctx, output.Recommendations)), output, nil
+}
+
+// similarMatch is how similar a candidate is to the liked movie it is most
+// similar to
+type similarMatch struct {
+	title string // Liked movie's title
+	score float64
+}
+
+// similarCandidates gathers the movies most similar to the liked ones,
+// leaving out the liked movies themselves
+func (t *CompoundTools) similarCandidates(ctx context.Context, liked []int) ([]*movieApp.MovieDTO, map[int]similarMatch, error) {
+	if t.similar == nil {
+		return nil, nil, shared.NewUnavailableError("similar_to needs the similarity index")
+	}
+
+	likedIDs := make(map[int]bool, len(liked))
+	for _, id := range liked {
+		likedIDs[id] = true
+	}
+
+	var candidates []*movieApp.MovieDTO
+	matches := make(map[int]similarMatch)
+	for _, id := range liked {
+		dto, err := t.similar.GetSimilarMovies(ctx, id, similarity.MaxNeighbors)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, similar := range dto.Similar {
+			if likedIDs[similar.ID] {
+				continue
+			}
+			match, seen := matches[similar.ID]
+			if !seen {
+				candidates = append(candidates, &movieApp.MovieDTO{
+					ID:       similar.ID,
+					Title:    similar.Title,
+					Director: similar.Director,
+					Year:     similar.Year,
+					Rating:   similar.Rating,
+					Genres:   similar.Genres,
+				})
+			}
+			if !seen || similar.Score > match.score {
+				matches[similar.ID] = similarMatch{title: dto.Title, score: similar.Score}
+			}
+		}
+	}
+	return candidates, matches, nil
+}
+
+// ===== pick_movie_for_me Tool =====
+
+const (
+	defaultPicks = 5
+	maxPicks     = 20
+	// pickCandidates caps how many movies matching the search constraints
+	// are weighed against the rest
+	pickCandidates = 500
+)
+
+// moodGenres maps the moods pick_movie_for_me understands to the genres
+// that suit them
+var moodGenres = map[string][]string{
+	"adventurous":  {"Adventure", "Action", "Fantasy", "Western"},
+	"dark":         {"Crime", "Thriller", "War"},
+	"exciting":     {"Action", "Thriller", "Adventure"},
+	"feel-good":    {"Comedy", "Family", "Romance", "Animation"},
+	"funny":        {"Comedy"},
+	"mind-bending": {"Sci-Fi", "Mystery"},
+	"romantic":     {"Romance"},
+	"scary":        {"Horror"},
+	"tense":        {"Thriller", "Mystery", "Crime"},
+	"thoughtful":   {"Drama", "Biography", "History", "Documentary"},
+}
+
+// moods returns the moods pick_movie_for_me understands, in order
+func moods() []string {
+	names := make([]string, 0, len(moodGenres))
+	for name := range moodGenres {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// PickMovieInput defines the input schema for pick_movie_for_me tool
+type PickMovieInput struct {
+	Mood        string   `json:"mood,omitempty" jsonschema:"What the user is in the mood for: adventurous, dark, exciting, feel-good, funny, mind-bending, romantic, scary, tense or thoughtful"`
+	Genres      []string `json:"genres,omitempty" jsonschema:"Genres to pick from, with the mood's (default the session's favorite genres when no mood is given)"`
+	MaxRuntime  int      `json:"max_runtime,omitempty" jsonschema:"Longest runtime in minutes; movies with no known runtime are left out"`
+	Decade      string   `json:"decade,omitempty" jsonschema:"Decade to pick from (e.g. '1990s', '90s' or 'the nineties')"`
+	MinRating   float64  `json:"min_rating,omitempty" jsonschema:"Minimum rating (0-10)"`
+	ExcludeSeen []int    `json:"exclude_seen,omitempty" jsonschema:"IDs of movies the user has already seen"`
+	Limit       int      `json:"limit,omitempty" jsonschema:"Size of the shortlist (default 5, max 20)"`
+}
+
+// Validate checks the mood, runtime, decade, rating and limit
+func (in PickMovieInput) Validate() error {
+	if in.Mood != "" {
+		if _, ok := moodGenres[strings.ToLower(strings.TrimSpace(in.Mood))]; !ok {
+			return shared.NewValidationError("unknown mood %q (must be one of %s)", in.Mood, strings.Join(moods(), ", "))
+		}
+	}
+	if in.MaxRuntime < 0 {
+		return shared.NewValidationError("max_runtime cannot be negative")
+	}
+	if in.Decade != "" {
+		if _, err := movie.ParseDecade(in.Decade); err != nil {
+			return err
+		}
+	}
+	if in.MinRating < 0 || in.MinRating > 10 {
+		return shared.NewValidationError("min_rating must be between 0 and 10")
+	}
+	if in.Limit < 0 || in.Limit > maxPicks {
+		return shared.NewValidationError("limit must be between 1 and %d", maxPicks)
+	}
+	return nil
+}
+
+// PickMovieOutput defines the output schema for pick_movie_for_me tool
+type PickMovieOutput struct {
+	Picks       []MoviePick     `json:"picks" jsonschema:"Shortlist of movies, the best pick first"`
+	Considered  int             `json:"considered" jsonschema:"Movies that met every constraint"`
+	Constraints PickConstraints `json:"constraints" jsonschema:"The constraints the picks were made with"`
+}
+
+// MoviePick is one movie on a pick_movie_for_me shortlist
+type MoviePick struct {
+	Rank           int      `json:"rank" jsonschema:"Position on the shortlist"`
+	MovieID        int      `json:"movie_id" jsonschema:"Movie ID"`
+	Title          string   `json:"title" jsonschema:"Movie title"`
+	Director       string   `json:"director" jsonschema:"Director name"`
+	Year           int      `json:"year" jsonschema:"Release year"`
+	Rating         float64  `json:"rating" jsonschema:"Movie rating"`
+	Genres         []string `json:"genres" jsonschema:"List of genres"`
+	Runtime        int      `json:"runtime,omitempty" jsonschema:"Runtime in minutes, when known"`
+	MatchScore     string   `json:"match_score" jsonschema:"Match percentage"`
+	Justifications []string `json:"justifications" jsonschema:"Why this movie fits the constraints"`
+}
+
+// PickConstraints summarizes the constraints picks were made with
+type PickConstraints struct {
+	Mood       string   `json:"mood,omitempty" jsonschema:"Mood picked for"`
+	Genres     []string `json:"genres" jsonschema:"Genres picked from, the mood's included; empty allows any"`
+	MaxRuntime int      `json:"max_runtime,omitempty" jsonschema:"Longest runtime in minutes"`
+	Decade     string   `json:"decade,omitempty" jsonschema:"Decade picked from, e.g. 1990s"`
+	MinRating  float64  `json:"min_rating,omitempty" jsonschema:"Minimum rating"`
+	SeenCount  int      `json:"seen_count" jsonschema:"Number of seen movies left out"`
+
+	GenresFromSession bool     `json:"genres_from_session,omitempty" jsonschema:"Set when genres came from the session's favorite genres"`
+	ExcludedDirectors []string `json:"excluded_directors,omitempty" jsonschema:"The session's disliked directors, whose movies were left out"`
+}
+
+// PickMovieForMe handles the pick_movie_for_me tool call: it searches the
+// library within the constraints, leaves out seen movies and the session's
+// disliked directors, and ranks the rest as movie_recommendation_engine does
+func (t *CompoundTools) PickMovieForMe(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input PickMovieInput,
+) (*mcp.CallToolResult, PickMovieOutput, error) {
+	limit := input.Limit
+	if limit == 0 {
+		limit = defaultPicks
+	}
+
+	mood := strings.ToLower(strings.TrimSpace(input.Mood))
+	genres := append(append([]string{}, moodGenres[mood]...), input.Genres...)
+	session := t.preferences.Get(req)
+	genresFromSession := len(genres) == 0 && len(session.FavoriteGenres) > 0
+	if genresFromSession {
+		genres = session.FavoriteGenres
+	}
+
+	query := movieApp.SearchMoviesQuery{
+		MinRating: input.MinRating,
+		Limit:     pickCandidates,
+		Ranked:    true,
+	}
+	constraints := PickConstraints{
+		Mood:       mood,
+		Genres:     nonNilStrings(genres),
+		MaxRuntime: input.MaxRuntime,
+		MinRating:  input.MinRating,
+		SeenCount:  len(input.ExcludeSeen),
+
+		GenresFromSession: genresFromSession,
+		ExcludedDirectors: session.DislikedDirectors,
+	}
+	if input.Decade != "" {
+		decade, err := movie.ParseDecade(input.Decade)
+		if err != nil {
+			return nil, PickMovieOutput{}, err
+		}
+		query.MinYear, query.MaxYear = decade.Start(), decade.End()
+		constraints.Decade = decade.String()
+	}
+
+	candidates, err := t.movieService.SearchMovies(ctx, query)
+	if err != nil {
+		return nil, PickMovieOutput{}, fmt.Errorf("failed to search movies: %w", err)
+	}
+
+	seen := make(map[int]bool, len(input.ExcludeSeen))
+	for _, id := range input.ExcludeSeen {
+		seen[id] = true
+	}
+	prefs := UserPreferences{Genres: genres, MinRating: input.MinRating, YearFrom: query.MinYear, YearTo: query.MaxYear}
+	type scoredMovie struct {
+		movie *movieApp.MovieDTO
+		score float64
+	}
+	var scored []scoredMovie
+	for _, candidate := range candidates {
+		if seen[candidate.ID] || session.dislikes(candidate.Director) {
+			continue
+		}
+		if input.MaxRuntime > 0 && (candidate.Duration == 0 || candidate.Duration > input.MaxRuntime) {
+			continue
+		}
+		if len(genres) > 0 && calculateGenreScore(candidate.Genres, genres) == 0 {
+			continue
+		}
+		scored = append(scored, scoredMovie{movie: candidate, score: calculateRecommendationScore(candidate, prefs)})
+	}
+
+	// Sort by score, then ID so equal scores keep a fixed order
+	sort.Slice(scored, func(i, j int) bool {
+		if scored[i].score != scored[j].score {
+			return scored[i].score > scored[j].score
+		}
+		return scored[i].movie.ID < scored[j].movie.ID
+	})
+
+	output := PickMovieOutput{Picks: []MoviePick{}, Considered: len(scored), Constraints: constraints}
+	for i, sm := range scored {
+		if i >= limit {
+			break
+		}
+		output.Picks = append(output.Picks, MoviePick{
+			Rank:           i + 1,
+			MovieID:        sm.movie.ID,
+			Title:          sm.movie.Title,
+			Director:       sm.movie.Director,
+			Year:           sm.movie.Year,
+			Rating:         sm.movie.Rating,
+			Genres:         nonNilStrings(sm.movie.Genres),
+			Runtime:        sm.movie.Duration,
+			MatchScore:     fmt.Sprintf("%.1f%%", sm.score*100),
+			Justifications: pickJustifications(sm.movie, constraints, sm.score),
+		})
+	}
+
+	labels := make([]string, len(output.Picks))
+	for i, pick := range output.Picks {
+		labels[i] = fmt.Sprintf("%s (%d)", pick.Title, pick.Year)
+	}
+	return summaryResult(ctx, output, "Picked %s of %d that fit%s",
+		countNoun(ctx, len(output.Picks), "movie", "movies"), output.Considered, listSummary(ctx, labels)), output, nil
+}
+
+// pickJustifications explains why a picked movie fits the constraints
+func pickJustifications(candidate *movieApp.MovieDTO, constraints PickConstraints, score float64) []string {
+	var reasons []string
+	suits := moodGenres[constraints.Mood]
+	for _, genre := range candidate.Genres {
+		switch {
+		case calculateGenreScore([]string{genre}, suits) > 0:
+			reasons = append(reasons, fmt.Sprintf("Suits a %s mood as %s", constraints.Mood, genre))
+		case calculateGenreScore([]string{genre}, constraints.Genres) > 0 && constraints.GenresFromSession:
+			reasons = append(reasons, fmt.Sprintf("%s is one of your favorite genres", genre))
+		case calculateGenreScore([]string{genre}, constraints.Genres) > 0:
+			reasons = append(reasons, fmt.Sprintf("Matches your interest in %s", genre))
+		}
+	}
+	if constraints.MaxRuntime > 0 {
+		reasons = append(reasons, fmt.Sprintf("Runs %d minutes, within your %d", candidate.Duration, constraints.MaxRuntime))
+	}
+	if constraints.Decade != "" {
+		reasons = append(reasons, fmt.Sprintf("Released in %d, in the %s", candidate.Year, constraints.Decade))
+	}
+	if constraints.MinRating > 0 {
+		reasons = append(reasons, fmt.Sprintf("Rated %.1f, at least your %.1f", candidate.Rating, constraints.MinRating))
+	} else if candidate.Rating >= 8.0 {
+		reasons = append(reasons, "Highly rated")
+	}
+	if score > 0.8 {
+		reasons = append(reasons, "Excellent match overall")
+	}
+	return nonNilStrings(reasons)
 }
 
 // ===== director_career_analysis Tool =====
 
 // DirectorCareerAnalysisInput defines the input schema for director_career_analysis tool
 type DirectorCareerAnalysisInput struct {
-	Director string `json:"director" jsonschema:"required,description=Director name to analyze"`
+	Director string `json:"director" jsonschema:"Director name to analyze"`
 }
 
 // DirectorCareerAnalysisOutput defines the output schema for director_career_analysis tool
 type DirectorCareerAnalysisOutput struct {
-	Director            string             `json:"director" jsonschema:"description=Director name"`
-	CareerOverview      CareerOverview     `json:"career_overview" jsonschema:"description=Overall career statistics"`
-	CareerPhases        CareerPhases       `json:"career_phases" jsonschema:"description=Career broken into phases"`
-	CareerTrajectory    string             `json:"career_trajectory" jsonschema:"description=Description of career trajectory"`
-	GenreSpecialization []GenreFrequency   `json:"genre_specialization" jsonschema:"description=Top genres by count"`
-	NotableWorks        NotableWorks       `json:"notable_works" jsonschema:"description=Highest and lowest rated works"`
-	Filmography         []FilmographyEntry `json:"filmography" jsonschema:"description=Complete filmography"`
+	Director            string             `json:"director" jsonschema:"Director name"`
+	CareerOverview      CareerOverview     `json:"career_overview" jsonschema:"Overall career statistics"`
+	CareerPhases        CareerPhases       `json:"career_phases" jsonschema:"Career broken into phases"`
+	CareerTrajectory    string             `json:"career_trajectory" jsonschema:"Description of career trajectory"`
+	GenreSpecialization []GenreFrequency   `json:"genre_specialization" jsonschema:"Top genres by count"`
+	NotableWorks        NotableWorks       `json:"notable_works" jsonschema:"Highest and lowest rated works"`
+	Filmography         []FilmographyEntry `json:"filmography" jsonschema:"Complete filmography"`
 }
 
 // CareerOverview provides overall career stats
 type CareerOverview struct {
-	TotalMovies   int    `json:"total_movies" jsonschema:"description=Total number of movies"`
-	CareerSpan    string `json:"career_span" jsonschema:"description=Career span in years"`
-	AverageRating string `json:"average_rating" jsonschema:"description=Average rating across all movies"`
+	TotalMovies   int    `json:"total_movies" jsonschema:"Total number of movies"`
+	CareerSpan    string `json:"career_span" jsonschema:"Career span in years"`
+	AverageRating string `json:"average_rating" jsonschema:"Average rating across all movies"`
 }
 
 // CareerPhases breaks career into early/mid/late phases
 type CareerPhases struct {
-	Early PhaseInfo `json:"early" jsonschema:"description=Early career phase"`
-	Mid   PhaseInfo `json:"mid" jsonschema:"description=Mid career phase"`
-	Late  PhaseInfo `json:"late" jsonschema:"description=Late career phase"`
+	Early PhaseInfo `json:"early" jsonschema:"Early career phase"`
+	Mid   PhaseInfo `json:"mid" jsonschema:"Mid career phase"`
+	Late  PhaseInfo `json:"late" jsonschema:"Late career phase"`
 }
 
 // PhaseInfo provides info about a career phase
 type PhaseInfo struct {
-	Period        string `json:"period" jsonschema:"description=Year range of this phase"`
-	MovieCount    int    `json:"movie_count" jsonschema:"description=Number of movies in this phase"`
-	AverageRating string `json:"average_rating" jsonschema:"description=Average rating in this phase"`
+	Period        string `json:"period" jsonschema:"Year range of this phase"`
+	MovieCount    int    `json:"movie_count" jsonschema:"Number of movies in this phase"`
+	AverageRating string `json:"average_rating" jsonschema:"Average rating in this phase"`
 }
 
 // GenreFrequency represents a genre and its count
 type GenreFrequency struct {
-	Genre string `json:"genre" jsonschema:"description=Genre name"`
-	Count int    `json:"count" jsonschema:"description=Number of movies in this genre"`
+	Genre string `json:"genre" jsonschema:"Genre name"`
+	Count int    `json:"count" jsonschema:"Number of movies in this genre"`
 }
 
 // NotableWorks highlights best and worst movies
 type NotableWorks struct {
-	HighestRated MovieSummary `json:"highest_rated" jsonschema:"description=Highest rated movie"`
-	LowestRated  MovieSummary `json:"lowest_rated" jsonschema:"description=Lowest rated movie"`
+	HighestRated MovieSummary `json:"highest_rated" jsonschema:"Highest rated movie"`
+	LowestRated  MovieSummary `json:"lowest_rated" jsonschema:"Lowest rated movie"`
 }
 
 // MovieSummary provides brief movie info
 type MovieSummary struct {
-	Title  string  `json:"title" jsonschema:"description=Movie title"`
-	Year   int     `json:"year" jsonschema:"description=Release year"`
-	Rating float64 `json:"rating" jsonschema:"description=Movie rating"`
+	Title  string  `json:"title" jsonschema:"Movie title"`
+	Year   int     `json:"year" jsonschema:"Release year"`
+	Rating float64 `json:"rating" jsonschema:"Movie rating"`
 }
 
 // FilmographyEntry represents one movie in filmography
 type FilmographyEntry struct {
-	Year   int      `json:"year" jsonschema:"description=Release year"`
-	Title  string   `json:"title" jsonschema:"description=Movie title"`
-	Rating float64  `json:"rating" jsonschema:"description=Movie rating"`
-	Genres []string `json:"genres" jsonschema:"description=Movie genres"`
+	Year   int      `json:"year" jsonschema:"Release year"`
+	Title  string   `json:"title" jsonschema:"Movie title"`
+	Rating float64  `json:"rating" jsonschema:"Movie rating"`
+	Genres []string `json:"genres" jsonschema:"Movie genres"`
 }
 
 // DirectorCareerAnalysis handles the director_career_analysis tool call
@@ -338,12 +787,15 @@ func (t *CompoundTools) DirectorCareerAnalysis(
 	}
 
 	if len(movies) == 0 {
-		return nil, DirectorCareerAnalysisOutput{}, fmt.Errorf("no movies found for director: %s", input.Director)
+		return nil, DirectorCareerAnalysisOutput{}, shared.NewNotFoundError("no movies found for director: %s", input.Director)
 	}
 
 	// Sort movies by year
 	sort.Slice(movies, func(i, j int) bool {
-		return movies[i].Year < movies[j].Year
+		if movies[i].Year != movies[j].Year {
+			return movies[i].Year < movies[j].Year
+		}
+		return movies[i].ID < movies[j].ID
 	})
 
 	// Analyze career metrics
@@ -401,7 +853,7 @@ func (t *CompoundTools) DirectorCareerAnalysis(
 			Year:   m.Year,
 			Title:  m.Title,
 			Rating: m.Rating,
-			Genres: m.Genres,
+			Genres: nonNilStrings(m.Genres),
 		})
 	}
 
@@ -446,11 +898,21 @@ func (t *CompoundTools) DirectorCareerAnalysis(
 		Filmography: filmography,
 	}
 
-	return nil, output, nil
+	return summaryResult(ctx, output, "%s directed %s over %s; trajectory: %s", output.Director, countNoun(ctx, output.CareerOverview.TotalMovies, "movie", "movies"), output.CareerOverview.CareerSpan, output.CareerTrajectory), output, nil
 }
 
 // Helper functions
 
+// recommendationSummary lists recommended titles with their match scores
+func recommendationSummary(ctx context.Context, recommendations []Recommendation) string {
+	p := i18n.FromContext(ctx)
+	names := make([]string, 0, len(recommendations))
+	for _, r := range recommendations {
+		names = append(names, p.Sprintf("%s (%s match)", r.Title, r.MatchScore))
+	}
+	return p.Translate("Recommended") + " " + countNoun(ctx, len(recommendations), "movie", "movies") + listSummary(ctx, names)
+}
+
 func calculateRecommendationScore(movie *movieApp.MovieDTO, prefs UserPreferences) float64 {
 	score := 0.0
 
@@ -605,11 +1067,12 @@ func findTopGenres(genreCount map[string]int, limit int) []GenreFrequency {
 	}
 
 	frequencies := []genreFreq{}
-	for g, c := range genreCount {
-		frequencies = append(frequencies, genreFreq{g, c})
+	for _, g := range serialization.SortedKeys(genreCount) {
+		frequencies = append(frequencies, genreFreq{g, genreCount[g]})
 	}
 
-	sort.Slice(frequencies, func(i, j int) bool {
+	// Genres as common as each other are listed by name
+	sort.SliceStable(frequencies, func(i, j int) bool {
 		return frequencies[i].count > frequencies[j].count
 	})
 
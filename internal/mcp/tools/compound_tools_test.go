@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"testing"
 
 	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	similarityApp "github.com/francknouama/movies-mcp-server/internal/application/similarity"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
 )
 
 // ===== BulkMovieImport Tests =====
@@ -156,6 +159,125 @@ func TestBulkMovieImport_AllFailed(t *testing.T) {
 	}
 }
 
+func TestBulkMovieImport_StopsOnCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	created := 0
+	mockService := &MockMovieService{
+		CreateMovieFunc: func(ctx context.Context, cmd movieApp.CreateMovieCommand) (*movieApp.MovieDTO, error) {
+			created++
+			cancel() // Client cancels while the first movie is being imported
+			return &movieApp.MovieDTO{ID: created, Title: cmd.Title}, nil
+		},
+	}
+
+	tools := NewCompoundTools(mockService)
+	_, _, err := tools.BulkMovieImport(ctx, nil, BulkMovieImportInput{
+		Movies: []MovieImportItem{
+			{Title: "Movie 1", Director: "Director 1", Year: 2000},
+			{Title: "Movie 2", Director: "Director 2", Year: 2001},
+			{Title: "Movie 3", Director: "Director 3", Year: 2002},
+		},
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected cancellation error, got: %v", err)
+	}
+	if created != 1 {
+		t.Errorf("Expected import to stop after 1 movie, got: %d", created)
+	}
+}
+
+func TestBulkMovieImport_LargeImportUsesBatch(t *testing.T) {
+	batches := 0
+	mockService := &MockMovieService{
+		CreateMovieFunc: func(ctx context.Context, cmd movieApp.CreateMovieCommand) (*movieApp.MovieDTO, error) {
+			t.Fatal("Expected no one-by-one creates for a large import")
+			return nil, nil
+		},
+		CreateMoviesFunc: func(ctx context.Context, cmds []movieApp.CreateMovieCommand) ([]*movieApp.MovieDTO, []error, error) {
+			batches++
+			movies := make([]*movieApp.MovieDTO, len(cmds))
+			errs := make([]error, len(cmds))
+			for i, cmd := range cmds {
+				if cmd.Title == "" {
+					errs[i] = errors.New("title cannot be empty")
+					continue
+				}
+				movies[i] = &movieApp.MovieDTO{ID: i + 1, Title: cmd.Title}
+			}
+			return movies, errs, nil
+		},
+	}
+
+	items := make([]MovieImportItem, batchImportThreshold+1)
+	for i := range items {
+		items[i] = MovieImportItem{Title: fmt.Sprintf("Movie %d", i), Director: "Director", Year: 2000}
+	}
+	items[3].Title = ""
+
+	tools := NewCompoundTools(mockService)
+	_, output, err := tools.BulkMovieImport(context.Background(), nil, BulkMovieImportInput{Movies: items})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if batches != 1 {
+		t.Errorf("Expected one batch, got: %d", batches)
+	}
+	if output.Imported != batchImportThreshold || output.Failed != 1 || output.Errors[0].Index != 3 {
+		t.Errorf("Expected all but movie 3 to import, got: %d imported, errors %+v", output.Imported, output.Errors)
+	}
+	if output.Results[3].Index != 4 || output.Results[3].ID != 5 {
+		t.Errorf("Expected results to keep their input index, got: %+v", output.Results[3])
+	}
+}
+
+func TestBulkMovieImport_ValidationOverride(t *testing.T) {
+	var policies []shared.ValidationPolicy
+	mockService := &MockMovieService{
+		CreateMovieFunc: func(ctx context.Context, cmd movieApp.CreateMovieCommand) (*movieApp.MovieDTO, error) {
+			policies = append(policies, cmd.Validation)
+			return &movieApp.MovieDTO{ID: 1, Title: cmd.Title}, nil
+		},
+	}
+	tools := NewCompoundTools(mockService)
+	movies := []MovieImportItem{{Title: "Heat", Director: "Michael Mann", Year: 1995}}
+
+	for _, validation := range []string{"", "Strict"} {
+		input := BulkMovieImportInput{Movies: movies, Validation: validation}
+		if err := input.Validate(); err != nil {
+			t.Fatalf("Validate(%q) error = %v", validation, err)
+		}
+		if _, _, err := tools.BulkMovieImport(context.Background(), nil, input); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+	}
+	if len(policies) != 2 || policies[0] != "" || policies[1] != shared.ValidationStrict {
+		t.Errorf("Expected the service's policy, then strict, got: %q", policies)
+	}
+
+	input := BulkMovieImportInput{Movies: movies, Validation: "paranoid"}
+	if err := input.Validate(); !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("Expected a validation error for an unknown policy, got: %v", err)
+	}
+}
+
+func TestBulkMovieImport_BatchError(t *testing.T) {
+	mockService := &MockMovieService{
+		CreateMoviesFunc: func(ctx context.Context, cmds []movieApp.CreateMovieCommand) ([]*movieApp.MovieDTO, []error, error) {
+			return nil, nil, errors.New("failed to save movies: disk full")
+		},
+	}
+
+	items := make([]MovieImportItem, batchImportThreshold+1)
+	tools := NewCompoundTools(mockService)
+	_, _, err := tools.BulkMovieImport(context.Background(), nil, BulkMovieImportInput{Movies: items})
+
+	if err == nil || !strings.Contains(err.Error(), "bulk import of 101 movies failed") {
+		t.Errorf("Expected batch error, got: %v", err)
+	}
+}
+
 // ===== MovieRecommendationEngine Tests =====
 
 func TestMovieRecommendationEngine_Success(t *testing.T) {
@@ -346,6 +468,183 @@ func TestMovieRecommendationEngine_NoResults(t *testing.T) {
 	}
 }
 
+func TestMovieRecommendationEngine_SimilarTo(t *testing.T) {
+	tools := NewCompoundTools(&MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			t.Error("Expected candidates to come from the similarity index")
+			return nil, nil
+		},
+	})
+	tools.SetSimilarMovies(&MockSimilarMoviesService{
+		GetSimilarMoviesFunc: func(ctx context.Context, movieID, limit int) (*similarityApp.SimilarMoviesDTO, error) {
+			similar := map[int][]*similarityApp.SimilarMovieDTO{
+				1: {
+					{ID: 2, Title: "Collateral", Director: "Michael Mann", Year: 2004, Rating: 7.5, Genres: []string{"Crime"}, Score: 0.8},
+					{ID: 3, Title: "Thief", Director: "Michael Mann", Year: 1981, Rating: 7.4, Genres: []string{"Crime"}, Score: 0.5},
+				},
+				3: {
+					{ID: 1, Title: "Heat", Director: "Michael Mann", Year: 1995, Rating: 8.3, Genres: []string{"Crime"}, Score: 0.5},
+				},
+			}
+			titles := map[int]string{1: "Heat", 3: "Thief"}
+			return &similarityApp.SimilarMoviesDTO{MovieID: movieID, Title: titles[movieID], Similar: similar[movieID]}, nil
+		},
+	})
+
+	_, output, err := tools.MovieRecommendationEngine(context.Background(), nil, MovieRecommendationInput{
+		Preferences: UserPreferences{SimilarTo: []int{1, 3}},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(output.Recommendations) != 1 || output.Recommendations[0].MovieID != 2 {
+		t.Fatalf("Expected only Collateral, leaving out the liked movies, got: %+v", output.Recommendations)
+	}
+	if reason := output.Recommendations[0].RecommendationReason; !strings.HasPrefix(reason, "Similar to Heat") {
+		t.Errorf("Expected the reason to name the liked movie, got: %q", reason)
+	}
+	if len(output.PreferencesUsed.SimilarTo) != 2 {
+		t.Errorf("Expected the liked movies in the preferences used, got: %+v", output.PreferencesUsed)
+	}
+}
+
+func TestMovieRecommendationEngine_SimilarToWithoutIndex(t *testing.T) {
+	tools := NewCompoundTools(&MockMovieService{})
+
+	_, _, err := tools.MovieRecommendationEngine(context.Background(), nil, MovieRecommendationInput{
+		Preferences: UserPreferences{SimilarTo: []int{1}},
+	})
+	if !errors.Is(err, shared.ErrUnavailable) {
+		t.Errorf("Expected ErrUnavailable, got: %v", err)
+	}
+}
+
+// ===== PickMovieForMe Tests =====
+
+// pickLibrary is a library to pick from, returned whatever the query
+func pickLibrary(queries *[]movieApp.SearchMoviesQuery) *MockMovieService {
+	return &MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			*queries = append(*queries, query)
+			return []*movieApp.MovieDTO{
+				{ID: 1, Title: "Heat", Director: "Michael Mann", Year: 1995, Rating: 8.3, Genres: []string{"Crime", "Thriller"}, Duration: 170},
+				{ID: 2, Title: "Se7en", Director: "David Fincher", Year: 1995, Rating: 8.6, Genres: []string{"Crime", "Mystery"}, Duration: 127},
+				{ID: 3, Title: "The Usual Suspects", Director: "Bryan Singer", Year: 1995, Rating: 8.5, Genres: []string{"Crime", "Mystery"}, Duration: 106},
+				{ID: 4, Title: "Clueless", Director: "Amy Heckerling", Year: 1995, Rating: 6.9, Genres: []string{"Comedy"}, Duration: 97},
+				{ID: 5, Title: "Fargo", Director: "Joel Coen", Year: 1996, Rating: 8.1, Genres: []string{"Crime", "Thriller"}},
+			}, nil
+		},
+	}
+}
+
+func TestPickMovieForMe_AppliesConstraints(t *testing.T) {
+	var queries []movieApp.SearchMoviesQuery
+	tools := NewCompoundTools(pickLibrary(&queries))
+
+	_, output, err := tools.PickMovieForMe(context.Background(), nil, PickMovieInput{
+		Mood:        "Tense",
+		MaxRuntime:  130,
+		Decade:      "the nineties",
+		MinRating:   8,
+		ExcludeSeen: []int{3},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(queries) != 1 || queries[0].MinYear != 1990 || queries[0].MaxYear != 1999 || queries[0].MinRating != 8 || !queries[0].Ranked {
+		t.Errorf("Expected a ranked search of the 1990s rated 8 or more, got: %+v", queries)
+	}
+	// Heat runs too long, Fargo has no known runtime, Clueless does not suit
+	// the mood and The Usual Suspects was seen
+	if len(output.Picks) != 1 || output.Picks[0].MovieID != 2 || output.Considered != 1 {
+		t.Fatalf("Expected only Se7en, got: %+v", output)
+	}
+	pick := output.Picks[0]
+	if pick.Rank != 1 || pick.Runtime != 127 {
+		t.Errorf("Expected Se7en first with its runtime, got: %+v", pick)
+	}
+	for _, want := range []string{"Suits a tense mood as Crime", "Runs 127 minutes, within your 130", "Released in 1995, in the 1990s", "Rated 8.6, at least your 8.0"} {
+		if !slices.Contains(pick.Justifications, want) {
+			t.Errorf("Expected justification %q, got: %v", want, pick.Justifications)
+		}
+	}
+	if c := output.Constraints; c.Mood != "tense" || c.Decade != "1990s" || c.SeenCount != 1 || !slices.Contains(c.Genres, "Thriller") {
+		t.Errorf("Expected the constraints used, got: %+v", c)
+	}
+}
+
+func TestPickMovieForMe_RanksAndLimits(t *testing.T) {
+	var queries []movieApp.SearchMoviesQuery
+	tools := NewCompoundTools(pickLibrary(&queries))
+
+	_, output, err := tools.PickMovieForMe(context.Background(), nil, PickMovieInput{Genres: []string{"crime"}, Limit: 2})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if output.Considered != 4 || len(output.Picks) != 2 {
+		t.Fatalf("Expected 2 picks of the 4 crime movies, got: %+v", output)
+	}
+	if output.Picks[0].MovieID != 2 || output.Picks[1].MovieID != 3 {
+		t.Errorf("Expected the best rated crime movies first, got: %+v", output.Picks)
+	}
+	if !slices.Contains(output.Picks[0].Justifications, "Matches your interest in Crime") {
+		t.Errorf("Expected the genre to be justified, got: %v", output.Picks[0].Justifications)
+	}
+}
+
+func TestPickMovieForMe_UsesSessionPreferences(t *testing.T) {
+	var queries []movieApp.SearchMoviesQuery
+	tools := NewCompoundTools(pickLibrary(&queries))
+	store := NewPreferenceStore()
+	store.set(nil, Preferences{FavoriteGenres: []string{"Mystery"}, DislikedDirectors: []string{"david fincher"}})
+	tools.SetPreferenceStore(store)
+
+	_, output, err := tools.PickMovieForMe(context.Background(), nil, PickMovieInput{})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(output.Picks) != 1 || output.Picks[0].MovieID != 3 {
+		t.Fatalf("Expected only The Usual Suspects, leaving out Fincher, got: %+v", output.Picks)
+	}
+	if !output.Constraints.GenresFromSession || len(output.Constraints.ExcludedDirectors) != 1 {
+		t.Errorf("Expected the session's preferences in the constraints, got: %+v", output.Constraints)
+	}
+	if !slices.Contains(output.Picks[0].Justifications, "Mystery is one of your favorite genres") {
+		t.Errorf("Expected the favorite genre to be justified, got: %v", output.Picks[0].Justifications)
+	}
+}
+
+func TestPickMovieInput_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   PickMovieInput
+		wantErr bool
+	}{
+		{name: "no constraints", input: PickMovieInput{}},
+		{name: "every constraint", input: PickMovieInput{Mood: "feel-good", MaxRuntime: 120, Decade: "80s", MinRating: 7, Limit: 20}},
+		{name: "unknown mood", input: PickMovieInput{Mood: "hungry"}, wantErr: true},
+		{name: "negative runtime", input: PickMovieInput{MaxRuntime: -1}, wantErr: true},
+		{name: "bad decade", input: PickMovieInput{Decade: "someday"}, wantErr: true},
+		{name: "rating above 10", input: PickMovieInput{MinRating: 11}, wantErr: true},
+		{name: "limit too large", input: PickMovieInput{Limit: 21}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Expected error %v, got: %v", tt.wantErr, err)
+			}
+			if err != nil && !errors.Is(err, shared.ErrValidation) {
+				t.Errorf("Expected a validation error, got: %v", err)
+			}
+		})
+	}
+}
+
 // ===== DirectorCareerAnalysis Tests =====
 
 func TestDirectorCareerAnalysis_Success(t *testing.T) {
@@ -522,6 +821,17 @@ func TestDirectorCareerAnalysis_ServiceError(t *testing.T) {
 	}
 }
 
+func TestFindTopGenres_TiesInNameOrder(t *testing.T) {
+	counts := map[string]int{"Western": 2, "Drama": 3, "Comedy": 2, "Action": 2, "Horror": 1}
+
+	for range 20 {
+		genres := findTopGenres(counts, 3)
+		if len(genres) != 3 || genres[0].Genre != "Drama" || genres[1].Genre != "Action" || genres[2].Genre != "Comedy" {
+			t.Fatalf("Expected Drama, then the tied Action and Comedy by name, got: %v", genres)
+		}
+	}
+}
+
 // ===== Helper Function Tests =====
 
 func TestCalculateYearScore(t *testing.T) {
@@ -0,0 +1,40 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// Consistency modes accepted by read tools
+const (
+	// ConsistencyRelaxed reads whatever is currently stored, without waiting for queued writes
+	ConsistencyRelaxed = "relaxed"
+	// ConsistencyStrong waits for all previously queued writes to be applied before reading
+	ConsistencyStrong = "strong"
+)
+
+// WriteBarrier lets read tools wait for pending asynchronous writes
+type WriteBarrier interface {
+	Sync(ctx context.Context) error
+}
+
+// ensureConsistency validates the requested mode and, for strong reads,
+// waits on the barrier so read-after-write flows observe their own writes
+func ensureConsistency(ctx context.Context, barrier WriteBarrier, mode string) error {
+	switch mode {
+	case "", ConsistencyRelaxed:
+		return nil
+	case ConsistencyStrong:
+		if barrier == nil {
+			return nil
+		}
+		if err := barrier.Sync(ctx); err != nil {
+			return fmt.Errorf("failed to ensure strong consistency: %w", err)
+		}
+		return nil
+	default:
+		return shared.NewValidationError("invalid consistency mode: %s (must be strong or relaxed)", mode)
+	}
+}
@@ -0,0 +1,107 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+)
+
+// MockWriteBarrier is a mock implementation of WriteBarrier
+type MockWriteBarrier struct {
+	SyncFunc func(ctx context.Context) error
+	calls    int
+}
+
+func (m *MockWriteBarrier) Sync(ctx context.Context) error {
+	m.calls++
+	if m.SyncFunc != nil {
+		return m.SyncFunc(ctx)
+	}
+	return nil
+}
+
+func TestEnsureConsistency(t *testing.T) {
+	tests := []struct {
+		name      string
+		mode      string
+		wantSync  bool
+		wantError bool
+	}{
+		{name: "default is relaxed", mode: "", wantSync: false},
+		{name: "relaxed skips barrier", mode: ConsistencyRelaxed, wantSync: false},
+		{name: "strong waits on barrier", mode: ConsistencyStrong, wantSync: true},
+		{name: "invalid mode", mode: "eventual", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			barrier := &MockWriteBarrier{}
+
+			err := ensureConsistency(context.Background(), barrier, tt.mode)
+
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Expected error=%v, got: %v", tt.wantError, err)
+			}
+			if (barrier.calls > 0) != tt.wantSync {
+				t.Errorf("Expected sync=%v, got %d calls", tt.wantSync, barrier.calls)
+			}
+		})
+	}
+}
+
+func TestEnsureConsistency_StrongWithoutBarrier(t *testing.T) {
+	if err := ensureConsistency(context.Background(), nil, ConsistencyStrong); err != nil {
+		t.Errorf("Expected strong read without a write queue to succeed, got: %v", err)
+	}
+}
+
+func TestGetMovie_StrongConsistencyWaitsForWrites(t *testing.T) {
+	var order []string
+	barrier := &MockWriteBarrier{
+		SyncFunc: func(ctx context.Context) error {
+			order = append(order, "sync")
+			return nil
+		},
+	}
+	mockService := &MockMovieService{
+		GetMovieFunc: func(ctx context.Context, id int) (*movieApp.MovieDTO, error) {
+			order = append(order, "read")
+			return &movieApp.MovieDTO{ID: id, Title: "Fresh"}, nil
+		},
+	}
+
+	tools := NewMovieTools(mockService)
+	tools.SetWriteBarrier(barrier)
+
+	_, _, err := tools.GetMovie(context.Background(), nil, GetMovieInput{MovieID: 1, Consistency: ConsistencyStrong})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if strings.Join(order, ",") != "sync,read" {
+		t.Errorf("Expected sync before read, got: %v", order)
+	}
+}
+
+func TestSearchMovies_StrongConsistencyFailure(t *testing.T) {
+	barrier := &MockWriteBarrier{
+		SyncFunc: func(ctx context.Context) error {
+			return errors.New("write queue is closed")
+		},
+	}
+
+	tools := NewMovieTools(&MockMovieService{})
+	tools.SetWriteBarrier(barrier)
+
+	_, _, err := tools.SearchMovies(context.Background(), nil, SearchMoviesInput{Consistency: ConsistencyStrong})
+
+	if err == nil {
+		t.Fatal("Expected error when barrier fails")
+	}
+	if !strings.Contains(err.Error(), "strong consistency") {
+		t.Errorf("Expected strong consistency error, got: %v", err)
+	}
+}
@@ -10,6 +10,9 @@ import (
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 
 	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+	"github.com/francknouama/movies-mcp-server/pkg/serialization"
 )
 
 // ContextTools provides SDK-based MCP handlers for context management
@@ -18,11 +21,13 @@ type ContextTools struct {
 	contexts     map[string]*DataContext
 	mutex        sync.RWMutex
 	ttl          time.Duration
+	writeBarrier WriteBarrier
 }
 
 // DataContext represents a paginated data context
 type DataContext struct {
 	ID        string
+	Tenant    string // Only requests served for this tenant can read the context
 	Query     movieApp.SearchMoviesQuery
 	Total     int
 	PageSize  int
@@ -40,22 +45,27 @@ func NewContextTools(movieService MovieService) *ContextTools {
 	}
 }
 
+// SetWriteBarrier enables strong-consistency contexts that wait for queued writes
+func (t *ContextTools) SetWriteBarrier(barrier WriteBarrier) {
+	t.writeBarrier = barrier
+}
+
 // ===== create_search_context Tool =====
 
 // CreateSearchContextInput defines the input schema for create_search_context tool
 type CreateSearchContextInput struct {
-	Query    SearchMoviesInput `json:"query" jsonschema:"required,description=Search query for movies"`
-	PageSize int               `json:"page_size,omitempty" jsonschema:"description=Number of items per page,default=50"`
+	Query    SearchMoviesInput `json:"query" jsonschema:"Search query for movies"`
+	PageSize int               `json:"page_size,omitempty" jsonschema:"Number of items per page (default 50)"`
 }
 
 // CreateSearchContextOutput defines the output schema for create_search_context tool
 type CreateSearchContextOutput struct {
-	ContextID  string `json:"context_id" jsonschema:"description=Unique context identifier"`
-	Total      int    `json:"total" jsonschema:"description=Total number of results"`
-	PageSize   int    `json:"page_size" jsonschema:"description=Items per page"`
-	TotalPages int    `json:"total_pages" jsonschema:"description=Total number of pages"`
-	CreatedAt  string `json:"created_at" jsonschema:"description=Context creation time"`
-	ExpiresAt  string `json:"expires_at" jsonschema:"description=Context expiration time"`
+	ContextID  string `json:"context_id" jsonschema:"Unique context identifier"`
+	Total      int    `json:"total" jsonschema:"Total number of results"`
+	PageSize   int    `json:"page_size" jsonschema:"Items per page"`
+	TotalPages int    `json:"total_pages" jsonschema:"Total number of pages"`
+	CreatedAt  string `json:"created_at" jsonschema:"Context creation time"`
+	ExpiresAt  string `json:"expires_at" jsonschema:"Context expiration time"`
 }
 
 // CreateSearchContext handles the create_search_context tool call
@@ -64,6 +74,10 @@ func (t *ContextTools) CreateSearchContext(
 	req *mcp.CallToolRequest,
 	input CreateSearchContextInput,
 ) (*mcp.CallToolResult, CreateSearchContextOutput, error) {
+	if err := ensureConsistency(ctx, t.writeBarrier, input.Query.Consistency); err != nil {
+		return nil, CreateSearchContextOutput{}, err
+	}
+
 	// Set defaults
 	pageSize := input.PageSize
 	if pageSize == 0 {
@@ -82,7 +96,16 @@ func (t *ContextTools) CreateSearchContext(
 		MaxYear:   input.Query.MaxYear,
 		MinRating: input.Query.MinRating,
 		MaxRating: input.Query.MaxRating,
+		OrderBy:   input.Query.OrderBy,
+		OrderDir:  input.Query.OrderDir,
+		Sort:      newMovieSortKeys(input.Query.Sort),
 		Limit:     10000, // Large limit to get all results
+		Ranked:    true,
+
+		RankingWeights: input.Query.RankingWeights,
+	}
+	if err := applyReleaseFilters(&query, input.Query, time.Now().Year()); err != nil {
+		return nil, CreateSearchContextOutput{}, err
 	}
 
 	// Get all movies
@@ -100,6 +123,7 @@ func (t *ContextTools) CreateSearchContext(
 	// Create context
 	dataContext := &DataContext{
 		ID:        contextID,
+		Tenant:    database.TenantFromContext(ctx),
 		Query:     query,
 		Total:     total,
 		PageSize:  pageSize,
@@ -121,32 +145,32 @@ func (t *ContextTools) CreateSearchContext(
 		Total:      total,
 		PageSize:   pageSize,
 		TotalPages: totalPages,
-		CreatedAt:  dataContext.CreatedAt.Format(time.RFC3339),
-		ExpiresAt:  dataContext.ExpiresAt.Format(time.RFC3339),
+		CreatedAt:  serialization.Timestamp(dataContext.CreatedAt),
+		ExpiresAt:  serialization.Timestamp(dataContext.ExpiresAt),
 	}
 
-	return nil, output, nil
+	return summaryResult(ctx, output, "Created context %s with %s across %s", output.ContextID, countNoun(ctx, output.Total, "result", "results"), countNoun(ctx, output.TotalPages, "page", "pages")), output, nil
 }
 
 // ===== get_context_page Tool =====
 
 // GetContextPageInput defines the input schema for get_context_page tool
 type GetContextPageInput struct {
-	ContextID string `json:"context_id" jsonschema:"required,description=Context identifier"`
-	Page      int    `json:"page" jsonschema:"required,description=Page number (1-based)"`
-	PageSize  int    `json:"page_size,omitempty" jsonschema:"description=Override page size"`
+	ContextID string `json:"context_id" jsonschema:"Context identifier"`
+	Page      int    `json:"page" jsonschema:"Page number (1-based)"`
+	PageSize  int    `json:"page_size,omitempty" jsonschema:"Override page size"`
 }
 
 // GetContextPageOutput defines the output schema for get_context_page tool
 type GetContextPageOutput struct {
-	ContextID   string           `json:"context_id" jsonschema:"description=Context identifier"`
-	Page        int              `json:"page" jsonschema:"description=Current page number"`
-	PageSize    int              `json:"page_size" jsonschema:"description=Items per page"`
-	Total       int              `json:"total" jsonschema:"description=Total items"`
-	TotalPages  int              `json:"total_pages" jsonschema:"description=Total pages"`
-	HasNext     bool             `json:"has_next" jsonschema:"description=Has next page"`
-	HasPrevious bool             `json:"has_previous" jsonschema:"description=Has previous page"`
-	Data        []GetMovieOutput `json:"data" jsonschema:"description=Page data"`
+	ContextID   string        `json:"context_id" jsonschema:"Context identifier"`
+	Page        int           `json:"page" jsonschema:"Current page number"`
+	PageSize    int           `json:"page_size" jsonschema:"Items per page"`
+	Total       int           `json:"total" jsonschema:"Total items"`
+	TotalPages  int           `json:"total_pages" jsonschema:"Total pages"`
+	HasNext     bool          `json:"has_next" jsonschema:"Has next page"`
+	HasPrevious bool          `json:"has_previous" jsonschema:"Has previous page"`
+	Data        []MovieOutput `json:"data" jsonschema:"Page data"`
 }
 
 // GetContextPage handles the get_context_page tool call
@@ -155,19 +179,9 @@ func (t *ContextTools) GetContextPage(
 	req *mcp.CallToolRequest,
 	input GetContextPageInput,
 ) (*mcp.CallToolResult, GetContextPageOutput, error) {
-	t.mutex.RLock()
-	dataContext, exists := t.contexts[input.ContextID]
-	t.mutex.RUnlock()
-
-	if !exists {
-		return nil, GetContextPageOutput{}, fmt.Errorf("context not found: %s", input.ContextID)
-	}
-
-	if time.Now().After(dataContext.ExpiresAt) {
-		t.mutex.Lock()
-		delete(t.contexts, input.ContextID)
-		t.mutex.Unlock()
-		return nil, GetContextPageOutput{}, fmt.Errorf("context expired: %s", input.ContextID)
+	dataContext, err := t.lookupContext(ctx, input.ContextID)
+	if err != nil {
+		return nil, GetContextPageOutput{}, err
 	}
 
 	// Override page size if provided
@@ -183,7 +197,7 @@ func (t *ContextTools) GetContextPage(
 	if page < 1 {
 		page = 1
 	}
-	if page > totalPages {
+	if page > totalPages && totalPages > 0 {
 		page = totalPages
 	}
 
@@ -195,21 +209,9 @@ func (t *ContextTools) GetContextPage(
 	}
 
 	// Get page data
-	var pageData []GetMovieOutput
+	pageData := []MovieOutput{}
 	if offset < len(dataContext.Data) {
-		for _, movie := range dataContext.Data[offset:end] {
-			pageData = append(pageData, GetMovieOutput{
-				ID:        movie.ID,
-				Title:     movie.Title,
-				Director:  movie.Director,
-				Year:      movie.Year,
-				Rating:    movie.Rating,
-				Genres:    movie.Genres,
-				PosterURL: movie.PosterURL,
-				CreatedAt: movie.CreatedAt,
-				UpdatedAt: movie.UpdatedAt,
-			})
-		}
+		pageData = newMovieOutputs(dataContext.Data[offset:end])
 	}
 
 	output := GetContextPageOutput{
@@ -223,24 +225,24 @@ func (t *ContextTools) GetContextPage(
 		Data:        pageData,
 	}
 
-	return nil, output, nil
+	return summaryResult(ctx, output, "Page %d of %d for context %s: %s", output.Page, output.TotalPages, output.ContextID, countNoun(ctx, len(output.Data), "movie", "movies")), output, nil
 }
 
 // ===== get_context_info Tool =====
 
 // GetContextInfoInput defines the input schema for get_context_info tool
 type GetContextInfoInput struct {
-	ContextID string `json:"context_id" jsonschema:"required,description=Context identifier"`
+	ContextID string `json:"context_id" jsonschema:"Context identifier"`
 }
 
 // GetContextInfoOutput defines the output schema for get_context_info tool
 type GetContextInfoOutput struct {
-	ContextID  string `json:"context_id" jsonschema:"description=Context identifier"`
-	Total      int    `json:"total" jsonschema:"description=Total items"`
-	PageSize   int    `json:"page_size" jsonschema:"description=Items per page"`
-	TotalPages int    `json:"total_pages" jsonschema:"description=Total pages"`
-	CreatedAt  string `json:"created_at" jsonschema:"description=Creation time"`
-	ExpiresAt  string `json:"expires_at" jsonschema:"description=Expiration time"`
+	ContextID  string `json:"context_id" jsonschema:"Context identifier"`
+	Total      int    `json:"total" jsonschema:"Total items"`
+	PageSize   int    `json:"page_size" jsonschema:"Items per page"`
+	TotalPages int    `json:"total_pages" jsonschema:"Total pages"`
+	CreatedAt  string `json:"created_at" jsonschema:"Creation time"`
+	ExpiresAt  string `json:"expires_at" jsonschema:"Expiration time"`
 }
 
 // GetContextInfo handles the get_context_info tool call
@@ -249,19 +251,9 @@ func (t *ContextTools) GetContextInfo(
 	req *mcp.CallToolRequest,
 	input GetContextInfoInput,
 ) (*mcp.CallToolResult, GetContextInfoOutput, error) {
-	t.mutex.RLock()
-	dataContext, exists := t.contexts[input.ContextID]
-	t.mutex.RUnlock()
-
-	if !exists {
-		return nil, GetContextInfoOutput{}, fmt.Errorf("context not found: %s", input.ContextID)
-	}
-
-	if time.Now().After(dataContext.ExpiresAt) {
-		t.mutex.Lock()
-		delete(t.contexts, input.ContextID)
-		t.mutex.Unlock()
-		return nil, GetContextInfoOutput{}, fmt.Errorf("context expired: %s", input.ContextID)
+	dataContext, err := t.lookupContext(ctx, input.ContextID)
+	if err != nil {
+		return nil, GetContextInfoOutput{}, err
 	}
 
 	totalPages := (dataContext.Total + dataContext.PageSize - 1) / dataContext.PageSize
@@ -271,11 +263,31 @@ func (t *ContextTools) GetContextInfo(
 		Total:      dataContext.Total,
 		PageSize:   dataContext.PageSize,
 		TotalPages: totalPages,
-		CreatedAt:  dataContext.CreatedAt.Format(time.RFC3339),
-		ExpiresAt:  dataContext.ExpiresAt.Format(time.RFC3339),
+		CreatedAt:  serialization.Timestamp(dataContext.CreatedAt),
+		ExpiresAt:  serialization.Timestamp(dataContext.ExpiresAt),
+	}
+
+	return summaryResult(ctx, output, "Context %s holds %s across %s, expires %s", output.ContextID, countNoun(ctx, output.Total, "result", "results"), countNoun(ctx, output.TotalPages, "page", "pages"), output.ExpiresAt), output, nil
+}
+
+// lookupContext returns a context created for the tenant ctx is served for;
+// other tenants' contexts are reported as not found
+func (t *ContextTools) lookupContext(ctx context.Context, contextID string) (*DataContext, error) {
+	t.mutex.RLock()
+	dataContext, exists := t.contexts[contextID]
+	t.mutex.RUnlock()
+
+	if !exists || dataContext.Tenant != database.TenantFromContext(ctx) {
+		return nil, shared.NewNotFoundError("context not found: %s", contextID)
 	}
 
-	return nil, output, nil
+	if time.Now().After(dataContext.ExpiresAt) {
+		t.mutex.Lock()
+		delete(t.contexts, contextID)
+		t.mutex.Unlock()
+		return nil, shared.NewNotFoundError("context expired: %s", contextID)
+	}
+	return dataContext, nil
 }
 
 // Helper method to clean up expired contexts
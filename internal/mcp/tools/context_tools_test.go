@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
 )
 
 // ===== CreateSearchContext Tests =====
@@ -410,6 +412,34 @@ func TestGetContextInfo_InvalidContextID(t *testing.T) {
 	}
 }
 
+func TestContextTools_TenantIsolation(t *testing.T) {
+	mockService := &MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			return []*movieApp.MovieDTO{{ID: 7, Title: "Thief"}}, nil
+		},
+	}
+	tools := NewContextTools(mockService)
+	acme := database.WithTenant(context.Background(), "acme", nil)
+	globex := database.WithTenant(context.Background(), "globex", nil)
+
+	_, created, err := tools.CreateSearchContext(acme, nil, CreateSearchContextInput{Query: SearchMoviesInput{Title: "Thief"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if _, page, err := tools.GetContextPage(acme, nil, GetContextPageInput{ContextID: created.ContextID, Page: 1}); err != nil || len(page.Data) != 1 {
+		t.Errorf("Expected acme to read its own context, got %+v, %v", page, err)
+	}
+	for name, ctx := range map[string]context.Context{"globex": globex, "default": context.Background()} {
+		if _, _, err := tools.GetContextPage(ctx, nil, GetContextPageInput{ContextID: created.ContextID, Page: 1}); !errors.Is(err, shared.ErrNotFound) {
+			t.Errorf("Expected acme's context not to be found for %s, got %v", name, err)
+		}
+		if _, _, err := tools.GetContextInfo(ctx, nil, GetContextInfoInput{ContextID: created.ContextID}); !errors.Is(err, shared.ErrNotFound) {
+			t.Errorf("Expected acme's context info not to be found for %s, got %v", name, err)
+		}
+	}
+}
+
 // ===== Integration Test: Full Workflow =====
 
 func TestContextTools_FullWorkflow(t *testing.T) {
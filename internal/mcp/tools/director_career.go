@@ -0,0 +1,448 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/i18n"
+	"github.com/francknouama/movies-mcp-server/pkg/serialization"
+)
+
+// careerPageSize is how many of a director's movies are read at a time
+const careerPageSize = 500
+
+// maxCollaborators bounds the frequent collaborators of a career analysis
+const maxCollaborators = 10
+
+// steadySpread is the largest gap between era averages still called steady
+const steadySpread = 0.5
+
+// Career era names, in chronological order
+const (
+	EraEarly = "early"
+	EraPeak  = "peak"
+	EraLate  = "late"
+)
+
+// Career trajectories, from how the peak sits among the eras
+const (
+	TrajectoryRising    = "rising"
+	TrajectoryDeclining = "declining"
+	TrajectoryPeaked    = "peaked"
+	TrajectorySteady    = "steady"
+	TrajectoryUnrated   = "unrated"
+)
+
+// ===== director_career_analysis_v2 Tool =====
+
+// DirectorCareerAnalysisV2Input defines the input schema for director_career_analysis_v2 tool
+type DirectorCareerAnalysisV2Input struct {
+	Director string `json:"director" jsonschema:"Director name to analyze"`
+}
+
+// Validate requires a director
+func (in DirectorCareerAnalysisV2Input) Validate() error {
+	if strings.TrimSpace(in.Director) == "" {
+		return shared.NewValidationError("director is required")
+	}
+	return nil
+}
+
+// DirectorCareerAnalysisV2Output defines the output schema for director_career_analysis_v2 tool
+type DirectorCareerAnalysisV2Output struct {
+	Director      string               `json:"director" jsonschema:"Director name"`
+	Overview      CareerSummary        `json:"overview" jsonschema:"Career-wide statistics"`
+	Trajectory    string               `json:"trajectory" jsonschema:"rising (the peak is the latest work), declining (the peak is the earliest), peaked (the peak falls between earlier and later work), steady (era averages within 0.5 of each other) or unrated (no rated movies)"`
+	Eras          []CareerEra          `json:"eras" jsonschema:"Early, peak and late eras in order; eras without movies are left out"`
+	Collaborators []CareerCollaborator `json:"frequent_collaborators" jsonschema:"Actors cast in at least two of the director's movies, most frequent first"`
+	Films         []CareerFilm         `json:"films" jsonschema:"Every movie with its metrics, in release order"`
+	Narrative     string               `json:"narrative" jsonschema:"The analysis in a few sentences"`
+}
+
+// CareerSummary provides career-wide statistics
+type CareerSummary struct {
+	TotalMovies   int              `json:"total_movies" jsonschema:"Number of movies"`
+	RatedMovies   int              `json:"rated_movies" jsonschema:"Number of movies with a rating"`
+	FirstYear     int              `json:"first_year" jsonschema:"Release year of the first movie"`
+	LastYear      int              `json:"last_year" jsonschema:"Release year of the latest movie"`
+	CareerYears   int              `json:"career_years" jsonschema:"Years between the first and latest movie"`
+	AverageRating float64          `json:"average_rating" jsonschema:"Average rating of the rated movies; 0 when none are rated"`
+	TopGenres     []GenreFrequency `json:"top_genres" jsonschema:"Most frequent genres across the career"`
+}
+
+// CareerEra describes one era of a career
+type CareerEra struct {
+	Name          string           `json:"name" jsonschema:"early, peak or late"`
+	StartYear     int              `json:"start_year" jsonschema:"Release year of the era's first movie"`
+	EndYear       int              `json:"end_year" jsonschema:"Release year of the era's last movie"`
+	MovieCount    int              `json:"movie_count" jsonschema:"Number of movies in the era"`
+	RatedMovies   int              `json:"rated_movies" jsonschema:"Number of rated movies in the era"`
+	AverageRating float64          `json:"average_rating" jsonschema:"Average rating of the era's rated movies; 0 when none are rated"`
+	TopGenres     []GenreFrequency `json:"top_genres" jsonschema:"Most frequent genres in the era"`
+	NewGenres     []string         `json:"new_genres" jsonschema:"Genres first taken up in this era"`
+}
+
+// CareerCollaborator describes an actor the director keeps casting
+type CareerCollaborator struct {
+	ActorID    int      `json:"actor_id" jsonschema:"Actor ID"`
+	Name       string   `json:"name" jsonschema:"Actor name"`
+	MovieCount int      `json:"movie_count" jsonschema:"Number of the director's movies the actor is cast in"`
+	Titles     []string `json:"titles" jsonschema:"Titles of those movies, in release order"`
+	FirstYear  int      `json:"first_year" jsonschema:"Release year of their first movie together"`
+	LastYear   int      `json:"last_year" jsonschema:"Release year of their latest movie together"`
+}
+
+// CareerFilm describes one movie of a career with its metrics
+type CareerFilm struct {
+	ID                 int      `json:"id" jsonschema:"Movie ID"`
+	Title              string   `json:"title" jsonschema:"Movie title"`
+	Year               int      `json:"year" jsonschema:"Release year"`
+	Rating             float64  `json:"rating" jsonschema:"Movie rating; 0 when unrated"`
+	Duration           int      `json:"duration,omitempty" jsonschema:"Runtime in minutes, when known"`
+	Genres             []string `json:"genres" jsonschema:"Movie genres"`
+	Era                string   `json:"era" jsonschema:"Era the movie belongs to"`
+	RatingRank         int      `json:"rating_rank,omitempty" jsonschema:"Rank among the director's rated movies, 1 being the best; absent when unrated"`
+	RatingVsAverage    float64  `json:"rating_vs_average" jsonschema:"Rating minus the career average; 0 when unrated"`
+	YearsSincePrevious int      `json:"years_since_previous" jsonschema:"Years since the previous movie; 0 for the first"`
+}
+
+// DirectorCareerAnalysisV2 handles the director_career_analysis_v2 tool
+// call. The peak is the run of consecutive rated movies, a third of them,
+// with the highest average rating; the movies before and after it make up
+// the early and late eras.
+func (t *CompoundTools) DirectorCareerAnalysisV2(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input DirectorCareerAnalysisV2Input,
+) (*mcp.CallToolResult, DirectorCareerAnalysisV2Output, error) {
+	if err := input.Validate(); err != nil {
+		return nil, DirectorCareerAnalysisV2Output{}, err
+	}
+
+	movies, err := t.directorMovies(ctx, input.Director)
+	if err != nil {
+		return nil, DirectorCareerAnalysisV2Output{}, err
+	}
+	if len(movies) == 0 {
+		return nil, DirectorCareerAnalysisV2Output{}, shared.NewNotFoundError("no movies found for director: %s", input.Director)
+	}
+
+	collaborators, err := t.frequentCollaborators(ctx, movies)
+	if err != nil {
+		return nil, DirectorCareerAnalysisV2Output{}, err
+	}
+
+	average := calculateAverageRating(movies)
+	eras, filmEras := careerEras(movies)
+	output := DirectorCareerAnalysisV2Output{
+		Director: input.Director,
+		Overview: CareerSummary{
+			TotalMovies:   len(movies),
+			RatedMovies:   countRated(movies),
+			FirstYear:     movies[0].Year,
+			LastYear:      movies[len(movies)-1].Year,
+			CareerYears:   movies[len(movies)-1].Year - movies[0].Year,
+			AverageRating: roundRating(average),
+			TopGenres:     findTopGenres(genreCounts(movies), 3),
+		},
+		Trajectory:    careerTrajectory(eras),
+		Eras:          eras,
+		Collaborators: collaborators,
+		Films:         careerFilms(movies, filmEras, average),
+	}
+	output.Narrative = careerNarrative(ctx, output)
+
+	return summaryResult(ctx, output, "%s", output.Narrative), output, nil
+}
+
+// directorMovies reads every movie by director in release order
+func (t *CompoundTools) directorMovies(ctx context.Context, director string) ([]*movieApp.MovieDTO, error) {
+	var movies []*movieApp.MovieDTO
+	afterID := 0
+	for {
+		page, err := t.movieService.SearchMovies(ctx, movieApp.SearchMoviesQuery{
+			Director: director,
+			AfterID:  afterID,
+			Limit:    careerPageSize,
+			Sort:     []movieApp.SortKey{{Field: "id"}},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to search movies: %w", err)
+		}
+		movies = append(movies, page...)
+		if len(page) < careerPageSize {
+			break
+		}
+		afterID = page[len(page)-1].ID
+	}
+
+	sort.SliceStable(movies, func(i, j int) bool {
+		if movies[i].Year != movies[j].Year {
+			return movies[i].Year < movies[j].Year
+		}
+		return movies[i].ID < movies[j].ID
+	})
+	return movies, nil
+}
+
+// frequentCollaborators lists the actors cast in at least two of movies,
+// which are in release order; it lists none without a cast reader
+func (t *CompoundTools) frequentCollaborators(ctx context.Context, movies []*movieApp.MovieDTO) ([]CareerCollaborator, error) {
+	collaborators := []CareerCollaborator{}
+	if t.cast == nil {
+		return collaborators, nil
+	}
+
+	byActor := make(map[int]*CareerCollaborator)
+	for _, movie := range movies {
+		actors, err := t.cast.GetActorsByMovie(ctx, movie.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get cast of movie %d: %w", movie.ID, err)
+		}
+		for _, actor := range actors {
+			collaborator, ok := byActor[actor.ID]
+			if !ok {
+				collaborator = &CareerCollaborator{ActorID: actor.ID, Name: actor.Name, FirstYear: movie.Year}
+				byActor[actor.ID] = collaborator
+			}
+			collaborator.MovieCount++
+			collaborator.Titles = append(collaborator.Titles, movie.Title)
+			collaborator.LastYear = movie.Year
+		}
+	}
+
+	for _, collaborator := range byActor {
+		if collaborator.MovieCount >= 2 {
+			collaborators = append(collaborators, *collaborator)
+		}
+	}
+	sort.Slice(collaborators, func(i, j int) bool {
+		if collaborators[i].MovieCount != collaborators[j].MovieCount {
+			return collaborators[i].MovieCount > collaborators[j].MovieCount
+		}
+		if collaborators[i].Name != collaborators[j].Name {
+			return collaborators[i].Name < collaborators[j].Name
+		}
+		return collaborators[i].ActorID < collaborators[j].ActorID
+	})
+	if len(collaborators) > maxCollaborators {
+		collaborators = collaborators[:maxCollaborators]
+	}
+	return collaborators, nil
+}
+
+// careerEras splits movies, in release order, into the eras around their
+// peak and returns the eras with each movie's era name. Without rated
+// movies there is no peak, and every movie is in no era.
+func careerEras(movies []*movieApp.MovieDTO) ([]CareerEra, []string) {
+	filmEras := make([]string, len(movies))
+	start, end, ok := peakRun(movies)
+	if !ok {
+		return []CareerEra{}, filmEras
+	}
+
+	eras := []CareerEra{}
+	seen := make(map[string]bool)
+	for _, span := range []struct {
+		name     string
+		from, to int
+	}{
+		{EraEarly, 0, start},
+		{EraPeak, start, end + 1},
+		{EraLate, end + 1, len(movies)},
+	} {
+		if span.from == span.to {
+			continue
+		}
+		eraMovies := movies[span.from:span.to]
+		for i := span.from; i < span.to; i++ {
+			filmEras[i] = span.name
+		}
+
+		counts := genreCounts(eraMovies)
+		newGenres := []string{}
+		for _, genre := range serialization.SortedKeys(counts) {
+			if !seen[genre] {
+				seen[genre] = true
+				newGenres = append(newGenres, genre)
+			}
+		}
+		eras = append(eras, CareerEra{
+			Name:          span.name,
+			StartYear:     eraMovies[0].Year,
+			EndYear:       eraMovies[len(eraMovies)-1].Year,
+			MovieCount:    len(eraMovies),
+			RatedMovies:   countRated(eraMovies),
+			AverageRating: roundRating(calculateAverageRating(eraMovies)),
+			TopGenres:     findTopGenres(counts, 3),
+			NewGenres:     newGenres,
+		})
+	}
+	return eras, filmEras
+}
+
+// peakRun finds the indexes of the first and last movie of the run of
+// consecutive rated movies, a third of them and at least one, with the
+// highest average rating; the earliest run wins a tie
+func peakRun(movies []*movieApp.MovieDTO) (int, int, bool) {
+	var rated []int
+	for i, movie := range movies {
+		if movie.Rating > 0 {
+			rated = append(rated, i)
+		}
+	}
+	if len(rated) == 0 {
+		return 0, 0, false
+	}
+
+	size := (len(rated) + 2) / 3
+	best, bestTotal := 0, -1.0
+	for i := 0; i+size <= len(rated); i++ {
+		var total float64
+		for _, index := range rated[i : i+size] {
+			total += movies[index].Rating
+		}
+		if total > bestTotal {
+			best, bestTotal = i, total
+		}
+	}
+	return rated[best], rated[best+size-1], true
+}
+
+// careerTrajectory names the shape of a career from its rated eras
+func careerTrajectory(eras []CareerEra) string {
+	var rated []CareerEra
+	for _, era := range eras {
+		if era.RatedMovies > 0 {
+			rated = append(rated, era)
+		}
+	}
+	if len(rated) == 0 {
+		return TrajectoryUnrated
+	}
+
+	low, high := rated[0].AverageRating, rated[0].AverageRating
+	for _, era := range rated {
+		low = min(low, era.AverageRating)
+		high = max(high, era.AverageRating)
+	}
+	first, last := rated[0].Name, rated[len(rated)-1].Name
+	switch {
+	case high-low < steadySpread:
+		return TrajectorySteady
+	case first != EraPeak && last != EraPeak:
+		return TrajectoryPeaked
+	case first != EraPeak:
+		return TrajectoryRising
+	default:
+		return TrajectoryDeclining
+	}
+}
+
+// careerFilms lists movies, in release order, with their metrics
+func careerFilms(movies []*movieApp.MovieDTO, filmEras []string, average float64) []CareerFilm {
+	ranked := make([]*movieApp.MovieDTO, 0, len(movies))
+	for _, movie := range movies {
+		if movie.Rating > 0 {
+			ranked = append(ranked, movie)
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Rating > ranked[j].Rating
+	})
+	ranks := make(map[int]int, len(ranked))
+	for i, movie := range ranked {
+		ranks[movie.ID] = i + 1
+	}
+
+	films := make([]CareerFilm, 0, len(movies))
+	for i, movie := range movies {
+		film := CareerFilm{
+			ID:         movie.ID,
+			Title:      movie.Title,
+			Year:       movie.Year,
+			Rating:     movie.Rating,
+			Duration:   movie.Duration,
+			Genres:     nonNilStrings(movie.Genres),
+			Era:        filmEras[i],
+			RatingRank: ranks[movie.ID],
+		}
+		if movie.Rating > 0 {
+			film.RatingVsAverage = roundRating(movie.Rating - average)
+		}
+		if i > 0 {
+			film.YearsSincePrevious = movie.Year - movies[i-1].Year
+		}
+		films = append(films, film)
+	}
+	return films
+}
+
+// careerNarrative describes a career analysis in a few sentences
+func careerNarrative(ctx context.Context, output DirectorCareerAnalysisV2Output) string {
+	p := i18n.FromContext(ctx)
+	overview := output.Overview
+	movies := countNoun(ctx, overview.TotalMovies, "movie", "movies")
+	if overview.RatedMovies == 0 {
+		return p.Sprintf("%s directed %s between %d and %d, none of them rated.", output.Director, movies, overview.FirstYear, overview.LastYear)
+	}
+
+	sentences := []string{
+		p.Sprintf("%s directed %s between %d and %d, averaging %.1f.", output.Director, movies, overview.FirstYear, overview.LastYear, overview.AverageRating),
+	}
+	for _, era := range output.Eras {
+		if era.Name == EraPeak {
+			best := bestFilm(output.Films, EraPeak)
+			sentences = append(sentences, p.Sprintf("The peak ran from %d to %d, averaging %.1f, led by %s.",
+				era.StartYear, era.EndYear, era.AverageRating, fmt.Sprintf("%s (%d)", best.Title, best.Year)))
+		}
+	}
+	if first, last := output.Eras[0], output.Eras[len(output.Eras)-1]; len(first.TopGenres) > 0 && len(last.TopGenres) > 0 &&
+		first.TopGenres[0].Genre != last.TopGenres[0].Genre {
+		sentences = append(sentences, p.Sprintf("The focus moved from %s to %s.", first.TopGenres[0].Genre, last.TopGenres[0].Genre))
+	}
+	if len(output.Collaborators) > 0 {
+		top := output.Collaborators[0]
+		sentences = append(sentences, p.Sprintf("Most frequent collaborator: %s, in %s.", top.Name, countNoun(ctx, top.MovieCount, "movie", "movies")))
+	}
+	return strings.Join(sentences, " ")
+}
+
+// bestFilm returns the best rated film of an era
+func bestFilm(films []CareerFilm, era string) CareerFilm {
+	var best CareerFilm
+	for _, film := range films {
+		if film.Era == era && film.Rating > best.Rating {
+			best = film
+		}
+	}
+	return best
+}
+
+// countRated counts the movies with a rating
+func countRated(movies []*movieApp.MovieDTO) int {
+	count := 0
+	for _, movie := range movies {
+		if movie.Rating > 0 {
+			count++
+		}
+	}
+	return count
+}
+
+// genreCounts counts the movies in each genre
+func genreCounts(movies []*movieApp.MovieDTO) map[string]int {
+	counts := make(map[string]int)
+	for _, movie := range movies {
+		for _, genre := range movie.Genres {
+			counts[genre]++
+		}
+	}
+	return counts
+}
@@ -0,0 +1,190 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	actorApp "github.com/francknouama/movies-mcp-server/internal/application/actor"
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// nolanMovies is a career that rises to a peak and tails off
+func nolanMovies() []*movieApp.MovieDTO {
+	return []*movieApp.MovieDTO{
+		{ID: 6, Title: "Dunkirk", Director: "Christopher Nolan", Year: 2017, Rating: 7.8, Genres: []string{"War", "Drama"}, Duration: 106},
+		{ID: 1, Title: "Following", Director: "Christopher Nolan", Year: 1998, Rating: 7.0, Genres: []string{"Thriller"}},
+		{ID: 2, Title: "Memento", Director: "Christopher Nolan", Year: 2000, Rating: 8.4, Genres: []string{"Thriller", "Mystery"}},
+		{ID: 3, Title: "The Dark Knight", Director: "Christopher Nolan", Year: 2008, Rating: 9.0, Genres: []string{"Action", "Crime"}},
+		{ID: 4, Title: "Inception", Director: "Christopher Nolan", Year: 2010, Rating: 8.8, Genres: []string{"Sci-Fi", "Thriller"}},
+		{ID: 5, Title: "Interstellar", Director: "Christopher Nolan", Year: 2014, Rating: 8.6, Genres: []string{"Sci-Fi", "Drama"}},
+		{ID: 7, Title: "Tenet", Director: "Christopher Nolan", Year: 2020, Genres: []string{"Sci-Fi", "Action"}},
+	}
+}
+
+// careerTools serves movies and casts Michael Caine in every movie from
+// 2008 on and Cillian Murphy in Inception and Dunkirk
+func careerTools(movies []*movieApp.MovieDTO) *CompoundTools {
+	compound := NewCompoundTools(&MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			return movies, nil
+		},
+	})
+	compound.SetCastReader(&MockActorService{
+		GetActorsByMovieFunc: func(ctx context.Context, movieID int) ([]*actorApp.ActorDTO, error) {
+			var cast []*actorApp.ActorDTO
+			if movieID >= 3 {
+				cast = append(cast, &actorApp.ActorDTO{ID: 10, Name: "Michael Caine"})
+			}
+			if movieID == 4 || movieID == 6 {
+				cast = append(cast, &actorApp.ActorDTO{ID: 11, Name: "Cillian Murphy"})
+			}
+			return cast, nil
+		},
+	})
+	return compound
+}
+
+func TestDirectorCareerAnalysisV2_Eras(t *testing.T) {
+	result, output, err := careerTools(nolanMovies()).DirectorCareerAnalysisV2(context.Background(), nil, DirectorCareerAnalysisV2Input{Director: "Christopher Nolan"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	assertSummaryResult(t, result)
+
+	overview := output.Overview
+	if overview.TotalMovies != 7 || overview.RatedMovies != 6 || overview.FirstYear != 1998 || overview.LastYear != 2020 || overview.CareerYears != 22 || overview.AverageRating != 8.27 {
+		t.Errorf("Unexpected overview %+v", overview)
+	}
+
+	// Six rated movies make a peak of two: The Dark Knight and Inception
+	if len(output.Eras) != 3 {
+		t.Fatalf("Expected early, peak and late eras, got %+v", output.Eras)
+	}
+	early, peak, late := output.Eras[0], output.Eras[1], output.Eras[2]
+	if early.Name != EraEarly || early.StartYear != 1998 || early.EndYear != 2000 || early.MovieCount != 2 || early.AverageRating != 7.7 {
+		t.Errorf("Unexpected early era %+v", early)
+	}
+	if peak.Name != EraPeak || peak.StartYear != 2008 || peak.EndYear != 2010 || peak.AverageRating != 8.9 {
+		t.Errorf("Unexpected peak era %+v", peak)
+	}
+	if late.Name != EraLate || late.MovieCount != 3 || late.RatedMovies != 2 || late.AverageRating != 8.2 {
+		t.Errorf("Unexpected late era %+v", late)
+	}
+	if early.TopGenres[0].Genre != "Thriller" || len(peak.NewGenres) != 3 || late.NewGenres[0] != "Drama" || late.NewGenres[1] != "War" {
+		t.Errorf("Unexpected genre evolution %+v, %v, %v", early.TopGenres, peak.NewGenres, late.NewGenres)
+	}
+	if output.Trajectory != TrajectoryPeaked {
+		t.Errorf("Expected a peaked trajectory, got %s", output.Trajectory)
+	}
+}
+
+func TestDirectorCareerAnalysisV2_FilmsAndCollaborators(t *testing.T) {
+	_, output, err := careerTools(nolanMovies()).DirectorCareerAnalysisV2(context.Background(), nil, DirectorCareerAnalysisV2Input{Director: "Christopher Nolan"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(output.Films) != 7 || output.Films[0].Title != "Following" || output.Films[6].Title != "Tenet" {
+		t.Fatalf("Expected the films in release order, got %+v", output.Films)
+	}
+	knight := output.Films[2]
+	if knight.Era != EraPeak || knight.RatingRank != 1 || knight.RatingVsAverage != 0.73 || knight.YearsSincePrevious != 8 {
+		t.Errorf("Unexpected metrics %+v", knight)
+	}
+	if tenet := output.Films[6]; tenet.Era != EraLate || tenet.RatingRank != 0 || tenet.RatingVsAverage != 0 {
+		t.Errorf("Expected the unrated film unranked, got %+v", tenet)
+	}
+	if output.Films[5].Duration != 106 {
+		t.Errorf("Expected Dunkirk's runtime, got %+v", output.Films[5])
+	}
+
+	if len(output.Collaborators) != 2 {
+		t.Fatalf("Expected two frequent collaborators, got %+v", output.Collaborators)
+	}
+	caine := output.Collaborators[0]
+	if caine.Name != "Michael Caine" || caine.MovieCount != 5 || caine.FirstYear != 2008 || caine.LastYear != 2020 || caine.Titles[0] != "The Dark Knight" {
+		t.Errorf("Unexpected collaborator %+v", caine)
+	}
+
+	want := "Christopher Nolan directed 7 movies between 1998 and 2020, averaging 8.3. " +
+		"The peak ran from 2008 to 2010, averaging 8.9, led by The Dark Knight (2008). " +
+		"The focus moved from Thriller to Drama. " +
+		"Most frequent collaborator: Michael Caine, in 5 movies."
+	if output.Narrative != want {
+		t.Errorf("Unexpected narrative %q", output.Narrative)
+	}
+}
+
+func TestDirectorCareerAnalysisV2_Trajectories(t *testing.T) {
+	rated := func(ratings ...float64) []*movieApp.MovieDTO {
+		movies := make([]*movieApp.MovieDTO, len(ratings))
+		for i, rating := range ratings {
+			movies[i] = &movieApp.MovieDTO{ID: i + 1, Title: "Film", Year: 2000 + i, Rating: rating}
+		}
+		return movies
+	}
+
+	for name, tc := range map[string]struct {
+		movies []*movieApp.MovieDTO
+		want   string
+	}{
+		"rising":    {rated(6, 7, 9), TrajectoryRising},
+		"declining": {rated(9, 7, 6), TrajectoryDeclining},
+		"steady":    {rated(7, 7.2, 7.1), TrajectorySteady},
+		"single":    {rated(8), TrajectorySteady},
+		"unrated":   {rated(0, 0), TrajectoryUnrated},
+	} {
+		_, output, err := careerTools(tc.movies).DirectorCareerAnalysisV2(context.Background(), nil, DirectorCareerAnalysisV2Input{Director: "Someone"})
+		if err != nil {
+			t.Fatalf("%s: expected no error, got: %v", name, err)
+		}
+		if output.Trajectory != tc.want {
+			t.Errorf("%s: expected %s, got %s", name, tc.want, output.Trajectory)
+		}
+	}
+}
+
+func TestDirectorCareerAnalysisV2_Unrated(t *testing.T) {
+	movies := []*movieApp.MovieDTO{{ID: 1, Title: "Draft", Year: 2024}}
+
+	_, output, err := careerTools(movies).DirectorCareerAnalysisV2(context.Background(), nil, DirectorCareerAnalysisV2Input{Director: "Newcomer"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(output.Eras) != 0 || output.Films[0].Era != "" {
+		t.Errorf("Expected no eras without ratings, got %+v", output.Eras)
+	}
+	if output.Narrative != "Newcomer directed 1 movie between 2024 and 2024, none of them rated." {
+		t.Errorf("Unexpected narrative %q", output.Narrative)
+	}
+}
+
+func TestDirectorCareerAnalysisV2_WithoutCastReader(t *testing.T) {
+	compound := NewCompoundTools(&MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			return nolanMovies(), nil
+		},
+	})
+
+	_, output, err := compound.DirectorCareerAnalysisV2(context.Background(), nil, DirectorCareerAnalysisV2Input{Director: "Christopher Nolan"})
+	if err != nil || output.Collaborators == nil || len(output.Collaborators) != 0 {
+		t.Errorf("Expected no collaborators, got %+v, %v", output.Collaborators, err)
+	}
+}
+
+func TestDirectorCareerAnalysisV2_Errors(t *testing.T) {
+	if _, _, err := careerTools(nil).DirectorCareerAnalysisV2(context.Background(), nil, DirectorCareerAnalysisV2Input{Director: "Nobody"}); !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("Expected a director without movies not to be found, got %v", err)
+	}
+	if err := (DirectorCareerAnalysisV2Input{Director: " "}).Validate(); !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("Expected a blank director to be rejected, got %v", err)
+	}
+
+	compound := careerTools(nolanMovies())
+	compound.SetCastReader(&MockActorService{})
+	if _, _, err := compound.DirectorCareerAnalysisV2(context.Background(), nil, DirectorCareerAnalysisV2Input{Director: "Christopher Nolan"}); err == nil {
+		t.Error("Expected a cast read failure to be returned")
+	}
+}
@@ -0,0 +1,118 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/i18n"
+)
+
+// moviesTitled returns the movies whose title is title, ignoring case and
+// extra space, optionally released in year
+func moviesTitled(ctx context.Context, movies MovieService, title string, year int) ([]*movieApp.MovieDTO, error) {
+	query := movieApp.SearchMoviesQuery{Title: normalizeTitle(title)}
+	if year > 0 {
+		query.MinYear, query.MaxYear = year, year
+	}
+	found, err := movies.SearchMovies(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []*movieApp.MovieDTO
+	for _, movie := range found {
+		if strings.EqualFold(normalizeTitle(movie.Title), query.Title) {
+			matches = append(matches, movie)
+		}
+	}
+	return matches, nil
+}
+
+// normalizeTitle trims a title and collapses the spaces inside it
+func normalizeTitle(title string) string {
+	return strings.Join(strings.Fields(title), " ")
+}
+
+// chooseMovie returns the one movie of candidates a call means. When there
+// are several and interactive is set, the client's user is asked to pick
+// one through elicitation; otherwise, or when no movie is picked, a
+// conflict error lists the candidates so the call can be retried by ID.
+func chooseMovie(ctx context.Context, req *mcp.CallToolRequest, interactive bool, action string, candidates []*movieApp.MovieDTO) (*movieApp.MovieDTO, error) {
+	if len(candidates) == 1 {
+		return candidates[0], nil
+	}
+
+	p := i18n.FromContext(ctx)
+	ambiguous := p.Sprintf("%q matches %d movies (%s); pass movie_id to choose one",
+		candidates[0].Title, len(candidates), candidateList(p, candidates))
+	session := requestSession(req)
+	if !interactive || session == nil || !canElicit(session) {
+		return nil, shared.NewConflictError("%s", ambiguous)
+	}
+
+	ids := make([]any, len(candidates))
+	names := make([]any, len(candidates))
+	for i, candidate := range candidates {
+		ids[i] = strconv.Itoa(candidate.ID)
+		names[i] = candidateName(p, candidate)
+	}
+	result, err := session.Elicit(ctx, &mcp.ElicitParams{
+		Message: p.Sprintf("%q matches %d movies. Which one do you want to %s?", candidates[0].Title, len(candidates), p.Translate(action)),
+		RequestedSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"movie_id": map[string]any{
+					"type":      "string",
+					"title":     "Movie",
+					"enum":      ids,
+					"enumNames": names,
+				},
+			},
+			"required": []string{"movie_id"},
+		},
+	})
+	if err != nil {
+		return nil, shared.NewConflictError("%s (the client could not be asked: %v)", ambiguous, err)
+	}
+	if result.Action != "accept" {
+		return nil, shared.NewConflictError("%s (no movie was chosen)", ambiguous)
+	}
+
+	chosen, _ := result.Content["movie_id"].(string)
+	for _, candidate := range candidates {
+		if strconv.Itoa(candidate.ID) == chosen {
+			return candidate, nil
+		}
+	}
+	return nil, shared.NewConflictError("%s (the client chose %q, which is not one of them)", ambiguous, chosen)
+}
+
+// canElicit reports whether the client of session accepts elicitation
+// requests
+func canElicit(session *mcp.ServerSession) bool {
+	params := session.InitializeParams()
+	return params != nil && params.Capabilities != nil && params.Capabilities.Elicitation != nil
+}
+
+// candidateList formats the IDs and years of movies sharing a title
+func candidateList(p *i18n.Printer, candidates []*movieApp.MovieDTO) string {
+	parts := make([]string, len(candidates))
+	for i, candidate := range candidates {
+		parts[i] = p.Sprintf("ID %d from %d", candidate.ID, candidate.Year)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// candidateName labels a movie among others with the same title
+func candidateName(p *i18n.Printer, movie *movieApp.MovieDTO) string {
+	if movie.Director == "" {
+		return fmt.Sprintf("%s (%d)", movie.Title, movie.Year)
+	}
+	return p.Sprintf("%s (%d), directed by %s", movie.Title, movie.Year, movie.Director)
+}
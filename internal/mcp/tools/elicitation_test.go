@@ -0,0 +1,138 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// twoHeats is a movie service with two movies titled Heat
+func twoHeats(deleted *int) *MockMovieService {
+	return &MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, q movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			return []*movieApp.MovieDTO{
+				{ID: 3, Title: "Heat", Year: 1986, Director: "Dick Richards"},
+				{ID: 7, Title: "Heat", Year: 1995, Director: "Michael Mann"},
+			}, nil
+		},
+		DeleteMovieFunc: func(ctx context.Context, id int) error {
+			*deleted = id
+			return nil
+		},
+	}
+}
+
+// callDeleteMovie calls delete_movie from a client that answers elicitation
+// requests with elicit, or a client without elicitation when elicit is nil
+func callDeleteMovie(t *testing.T, tools *MovieTools, elicit func(context.Context, *mcp.ElicitRequest) (*mcp.ElicitResult, error), args map[string]any) *mcp.CallToolResult {
+	t.Helper()
+
+	ctx := context.Background()
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil)
+	mcp.AddTool(server, &mcp.Tool{Name: "delete_movie"}, tools.DeleteMovie)
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	if err != nil {
+		t.Fatalf("failed to connect server: %v", err)
+	}
+	t.Cleanup(func() { serverSession.Close() })
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "1.0.0"}, &mcp.ClientOptions{ElicitationHandler: elicit})
+	session, err := client.Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("failed to connect client: %v", err)
+	}
+	t.Cleanup(func() { session.Close() })
+
+	result, err := session.CallTool(ctx, &mcp.CallToolParams{Name: "delete_movie", Arguments: args})
+	if err != nil {
+		t.Fatalf("CallTool() error = %v", err)
+	}
+	return result
+}
+
+func TestDeleteMovie_AmbiguousTitle_Elicits(t *testing.T) {
+	var deleted int
+	tools := NewMovieTools(twoHeats(&deleted))
+	tools.SetInteractive(true)
+
+	var message string
+	result := callDeleteMovie(t, tools, func(ctx context.Context, req *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+		message = req.Params.Message
+		return &mcp.ElicitResult{Action: "accept", Content: map[string]any{"movie_id": "7"}}, nil
+	}, map[string]any{"title": "Heat"})
+
+	if result.IsError {
+		t.Fatalf("Expected the chosen movie to be deleted, got: %v", result.Content)
+	}
+	if deleted != 7 {
+		t.Errorf("Expected movie 7 to be deleted, got: %d", deleted)
+	}
+	if !strings.Contains(message, `"Heat" matches 2 movies`) {
+		t.Errorf("Expected the user to be asked about both movies, got: %q", message)
+	}
+}
+
+func TestDeleteMovie_AmbiguousTitle_Declined(t *testing.T) {
+	var deleted int
+	tools := NewMovieTools(twoHeats(&deleted))
+	tools.SetInteractive(true)
+
+	result := callDeleteMovie(t, tools, func(ctx context.Context, req *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+		return &mcp.ElicitResult{Action: "decline"}, nil
+	}, map[string]any{"title": "Heat"})
+
+	if !result.IsError || !strings.Contains(result.Content[0].(*mcp.TextContent).Text, "no movie was chosen") {
+		t.Errorf("Expected a conflict error, got: %v", result.Content)
+	}
+	if deleted != 0 {
+		t.Errorf("Expected nothing to be deleted, got: %d", deleted)
+	}
+}
+
+func TestDeleteMovie_AmbiguousTitle_NotAsked(t *testing.T) {
+	asked := false
+	elicit := func(ctx context.Context, req *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+		asked = true
+		return &mcp.ElicitResult{Action: "accept", Content: map[string]any{"movie_id": "7"}}, nil
+	}
+
+	tests := []struct {
+		name        string
+		interactive bool
+		elicit      func(context.Context, *mcp.ElicitRequest) (*mcp.ElicitResult, error)
+	}{
+		{"interactive flows disabled", false, elicit},
+		{"client without elicitation", true, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deleted int
+			tools := NewMovieTools(twoHeats(&deleted))
+			tools.SetInteractive(tt.interactive)
+
+			result := callDeleteMovie(t, tools, tt.elicit, map[string]any{"title": "Heat"})
+
+			if !result.IsError || !strings.Contains(result.Content[0].(*mcp.TextContent).Text, "ID 3 from 1986, ID 7 from 1995") {
+				t.Errorf("Expected a conflict listing the candidates, got: %v", result.Content)
+			}
+			if asked || deleted != 0 {
+				t.Errorf("Expected no question and no deletion, got asked=%v deleted=%d", asked, deleted)
+			}
+		})
+	}
+}
+
+func TestChooseMovie_NoSession(t *testing.T) {
+	_, err := chooseMovie(context.Background(), nil, true, "delete", []*movieApp.MovieDTO{{ID: 3, Title: "Heat"}, {ID: 7, Title: "Heat"}})
+	if !errors.Is(err, shared.ErrConflict) {
+		t.Errorf("Expected a conflict error, got: %v", err)
+	}
+}
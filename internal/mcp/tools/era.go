@@ -0,0 +1,244 @@
+package tools
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// recentYears is how far back "recent" reaches
+const recentYears = 3
+
+// eraExamples lists phrases parseEra understands, for help texts and errors
+var eraExamples = []string{
+	"last 5 years", "this year", "recent", "pre-war", "silent era", "golden age",
+	"early 90s", "the nineties", "before 1980", "since 2010", "1995 to 2003",
+}
+
+// namedEras are film-history periods, by name after normalization
+var namedEras = map[string]yearRange{
+	"silent":                  {min: 1888, max: 1929},
+	"pre-war":                 {max: 1938},
+	"prewar":                  {max: 1938},
+	"before the war":          {max: 1938},
+	"war":                     {min: 1939, max: 1945},
+	"wartime":                 {min: 1939, max: 1945},
+	"during the war":          {min: 1939, max: 1945},
+	"ww2":                     {min: 1939, max: 1945},
+	"wwii":                    {min: 1939, max: 1945},
+	"world war ii":            {min: 1939, max: 1945},
+	"world war 2":             {min: 1939, max: 1945},
+	"post-war":                {min: 1945, max: 1959},
+	"postwar":                 {min: 1945, max: 1959},
+	"after the war":           {min: 1945, max: 1959},
+	"golden age":              {min: 1927, max: 1960},
+	"golden age of hollywood": {min: 1927, max: 1960},
+	"new hollywood":           {min: 1965, max: 1982},
+}
+
+// countWords are the spelled-out counts "last two decades" may use
+var countWords = map[string]int{
+	"a": 1, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5,
+	"six": 6, "seven": 7, "eight": 8, "nine": 9, "ten": 10, "twenty": 20,
+}
+
+var (
+	// relativeEraPattern matches "last 5 years", "past two decades"
+	relativeEraPattern = regexp.MustCompile(`^(?:last|past) (?:(\d+|[a-z]+) )?(years?|decades?)$`)
+	// boundedEraPattern matches "before 1980", "pre-1980", "since the 90s"
+	boundedEraPattern = regexp.MustCompile(`^(before|pre-?|until|up to|through|after|post-?|since|from) ?(.+)$`)
+	// onwardsEraPattern matches "1980 onwards", "the 90s on"
+	onwardsEraPattern = regexp.MustCompile(`^(.+?) (?:onwards?|on|and later|or later)$`)
+	// rangeEraPattern matches "1995 to 2003", "between 1995 and 2003"
+	rangeEraPattern = regexp.MustCompile(`^(?:between |from )?(\d{4}) ?(?:-|to|and|through) ?(\d{4})$`)
+	// partialDecadePattern matches "early 90s", "mid-eighties", "late 1970s"
+	partialDecadePattern = regexp.MustCompile(`^(early|mid|late)[- ](.+)$`)
+)
+
+// yearRange is an inclusive span of release years; zero bounds are open
+type yearRange struct {
+	min int
+	max int
+}
+
+// narrow intersects the range with explicit year bounds, keeping the
+// tighter of each
+func (r yearRange) narrow(minYear, maxYear int) (int, int) {
+	if r.min > minYear {
+		minYear = r.min
+	}
+	if r.max > 0 && (maxYear == 0 || r.max < maxYear) {
+		maxYear = r.max
+	}
+	return minYear, maxYear
+}
+
+// parseEra turns a phrase such as "last 5 years", "pre-war" or "early 90s"
+// into the release years it covers, relative to currentYear
+func parseEra(phrase string, currentYear int) (yearRange, error) {
+	era, ok := parseEraPhrase(normalizeEra(phrase), currentYear)
+	if !ok {
+		return yearRange{}, shared.NewValidationError("unrecognized era %q (examples: %s)",
+			phrase, strings.Join(eraExamples, ", "))
+	}
+	if era.max > 0 && era.min > era.max {
+		return yearRange{}, shared.NewValidationError("era %q ends before it starts", phrase)
+	}
+	return era, nil
+}
+
+// normalizeEra lower-cases a phrase, unifies dashes and whitespace and drops
+// a leading "the" and trailing "era", "period" or "movies"
+func normalizeEra(phrase string) string {
+	normalized := strings.NewReplacer("–", "-", "—", "-").Replace(strings.ToLower(phrase))
+	normalized = strings.Join(strings.Fields(normalized), " ")
+	normalized = strings.TrimPrefix(normalized, "the ")
+	for _, suffix := range []string{" movies", " films", " era", " period"} {
+		normalized = strings.TrimSuffix(normalized, suffix)
+	}
+	return normalized
+}
+
+// parseEraPhrase matches a normalized phrase against the supported forms
+func parseEraPhrase(era string, currentYear int) (yearRange, bool) {
+	if named, ok := namedEras[era]; ok {
+		return named, true
+	}
+
+	switch era {
+	case "this year":
+		return yearRange{min: currentYear, max: currentYear}, true
+	case "last year":
+		return yearRange{min: currentYear - 1, max: currentYear - 1}, true
+	case "recent", "recently released", "new releases":
+		return yearRange{min: currentYear - recentYears, max: currentYear}, true
+	case "this decade":
+		start := currentYear / 10 * 10
+		return yearRange{min: start, max: start + 9}, true
+	}
+
+	if m := relativeEraPattern.FindStringSubmatch(era); m != nil {
+		count := 1
+		if m[1] != "" {
+			n, err := strconv.Atoi(m[1])
+			if err != nil {
+				if n = countWords[m[1]]; n == 0 {
+					return yearRange{}, false
+				}
+			}
+			count = n
+		}
+		if strings.HasPrefix(m[2], "decade") {
+			count *= 10
+		}
+		return yearRange{min: currentYear - count, max: currentYear}, count > 0
+	}
+
+	if m := rangeEraPattern.FindStringSubmatch(era); m != nil {
+		from, _ := strconv.Atoi(m[1])
+		to, _ := strconv.Atoi(m[2])
+		return yearRange{min: from, max: to}, true
+	}
+
+	if m := onwardsEraPattern.FindStringSubmatch(era); m != nil {
+		span, ok := parseEraSpan(m[1])
+		return yearRange{min: span.min}, ok
+	}
+
+	if m := boundedEraPattern.FindStringSubmatch(era); m != nil {
+		span, ok := parseEraSpan(m[2])
+		if !ok {
+			return yearRange{}, false
+		}
+		switch strings.TrimSuffix(m[1], "-") {
+		case "before", "pre":
+			return yearRange{max: span.min - 1}, true
+		case "until", "up to", "through":
+			return yearRange{max: span.max}, true
+		case "after", "post":
+			return yearRange{min: span.max + 1}, true
+		default: // since, from
+			return yearRange{min: span.min}, true
+		}
+	}
+
+	if m := partialDecadePattern.FindStringSubmatch(era); m != nil {
+		decade, err := movie.ParseDecade(m[2])
+		if err != nil {
+			return yearRange{}, false
+		}
+		switch m[1] {
+		case "early":
+			return yearRange{min: decade.Start(), max: decade.Start() + 3}, true
+		case "mid":
+			return yearRange{min: decade.Start() + 3, max: decade.Start() + 6}, true
+		default:
+			return yearRange{min: decade.Start() + 6, max: decade.End()}, true
+		}
+	}
+
+	return parseEraSpan(era)
+}
+
+// parseEraSpan reads a single year ("1994") or a decade ("the 90s")
+func parseEraSpan(span string) (yearRange, bool) {
+	span = strings.TrimPrefix(span, "the ")
+	if len(span) == 4 {
+		if year, err := strconv.Atoi(span); err == nil && year > 0 {
+			return yearRange{min: year, max: year}, true
+		}
+	}
+
+	decade, err := movie.ParseDecade(span)
+	if err != nil {
+		return yearRange{}, false
+	}
+	return yearRange{min: decade.Start(), max: decade.End()}, true
+}
+
+// releaseDateLayouts are the accepted released_after/released_before formats
+var releaseDateLayouts = []string{time.RFC3339, "2006-01-02", "2006-01", "2006"}
+
+// parseReleaseDate parses a released_after or released_before date; an
+// empty value is the zero time
+func parseReleaseDate(field, value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range releaseDateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, shared.NewValidationError("invalid %s %q (use YYYY-MM-DD, YYYY-MM or YYYY)", field, value)
+}
+
+// applyReleaseFilters adds a search's era and release dates to its query
+func applyReleaseFilters(query *movieApp.SearchMoviesQuery, input SearchMoviesInput, currentYear int) error {
+	var err error
+	if query.ReleasedAfter, err = parseReleaseDate("released_after", input.ReleasedAfter); err != nil {
+		return err
+	}
+	if query.ReleasedBefore, err = parseReleaseDate("released_before", input.ReleasedBefore); err != nil {
+		return err
+	}
+	if !query.ReleasedAfter.IsZero() && !query.ReleasedBefore.IsZero() && query.ReleasedAfter.After(query.ReleasedBefore) {
+		return shared.NewValidationError("released_after must not be later than released_before")
+	}
+
+	if input.Era == "" {
+		return nil
+	}
+	era, err := parseEra(input.Era, currentYear)
+	if err != nil {
+		return err
+	}
+	query.MinYear, query.MaxYear = era.narrow(query.MinYear, query.MaxYear)
+	return nil
+}
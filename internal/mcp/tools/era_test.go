@@ -0,0 +1,190 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+func TestParseEra(t *testing.T) {
+	const currentYear = 2026
+
+	tests := []struct {
+		phrase  string
+		want    yearRange
+		wantErr bool
+	}{
+		{phrase: "last 5 years", want: yearRange{min: 2021, max: 2026}},
+		{phrase: "Past two decades", want: yearRange{min: 2006, max: 2026}},
+		{phrase: "the last decade", want: yearRange{min: 2016, max: 2026}},
+		{phrase: "past year", want: yearRange{min: 2025, max: 2026}},
+		{phrase: "last year", want: yearRange{min: 2025, max: 2025}},
+		{phrase: "this year", want: yearRange{min: 2026, max: 2026}},
+		{phrase: "this decade", want: yearRange{min: 2020, max: 2029}},
+		{phrase: "recent", want: yearRange{min: 2023, max: 2026}},
+		{phrase: "pre-war", want: yearRange{max: 1938}},
+		{phrase: "Post–war era", want: yearRange{min: 1945, max: 1959}},
+		{phrase: "WWII movies", want: yearRange{min: 1939, max: 1945}},
+		{phrase: "the silent era", want: yearRange{min: 1888, max: 1929}},
+		{phrase: "golden age of Hollywood", want: yearRange{min: 1927, max: 1960}},
+		{phrase: "the nineties", want: yearRange{min: 1990, max: 1999}},
+		{phrase: "90s", want: yearRange{min: 1990, max: 1999}},
+		{phrase: "early 90s", want: yearRange{min: 1990, max: 1993}},
+		{phrase: "mid-eighties", want: yearRange{min: 1983, max: 1986}},
+		{phrase: "late 1970s", want: yearRange{min: 1976, max: 1979}},
+		{phrase: "1994", want: yearRange{min: 1994, max: 1994}},
+		{phrase: "before 1980", want: yearRange{max: 1979}},
+		{phrase: "pre-1980", want: yearRange{max: 1979}},
+		{phrase: "before the 80s", want: yearRange{max: 1979}},
+		{phrase: "until 1980", want: yearRange{max: 1980}},
+		{phrase: "up to the 80s", want: yearRange{max: 1989}},
+		{phrase: "after 2000", want: yearRange{min: 2001}},
+		{phrase: "post-2000", want: yearRange{min: 2001}},
+		{phrase: "after the 80s", want: yearRange{min: 1990}},
+		{phrase: "since 2010", want: yearRange{min: 2010}},
+		{phrase: "2010 onwards", want: yearRange{min: 2010}},
+		{phrase: "the 90s on", want: yearRange{min: 1990}},
+		{phrase: "1995 to 2003", want: yearRange{min: 1995, max: 2003}},
+		{phrase: "between 1995 and 2003", want: yearRange{min: 1995, max: 2003}},
+		{phrase: "from 1995 to 2003", want: yearRange{min: 1995, max: 2003}},
+		{phrase: "1995-2003", want: yearRange{min: 1995, max: 2003}},
+		{phrase: "2003 to 1995", wantErr: true},
+		{phrase: "last 0 years", wantErr: true},
+		{phrase: "last many years", wantErr: true},
+		{phrase: "before lunch", wantErr: true},
+		{phrase: "sometime", wantErr: true},
+		{phrase: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.phrase, func(t *testing.T) {
+			got, err := parseEra(tt.phrase, currentYear)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseEra(%q) error = %v, wantErr %v", tt.phrase, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !errors.Is(err, shared.ErrValidation) {
+					t.Errorf("Expected a validation error, got: %v", err)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Expected %d-%d, got: %d-%d", tt.want.min, tt.want.max, got.min, got.max)
+			}
+		})
+	}
+}
+
+func TestParseEra_ErrorListsExamples(t *testing.T) {
+	_, err := parseEra("whenever", 2026)
+	if err == nil || !strings.Contains(err.Error(), "last 5 years") || !strings.Contains(err.Error(), "pre-war") {
+		t.Errorf("Expected the error to list example phrases, got: %v", err)
+	}
+}
+
+func TestYearRange_Narrow(t *testing.T) {
+	tests := []struct {
+		name             string
+		era              yearRange
+		minYear, maxYear int
+		wantMin, wantMax int
+	}{
+		{name: "no explicit bounds", era: yearRange{min: 1990, max: 1999}, wantMin: 1990, wantMax: 1999},
+		{name: "era inside bounds", era: yearRange{min: 1990, max: 1999}, minYear: 1980, maxYear: 2010, wantMin: 1990, wantMax: 1999},
+		{name: "bounds inside era", era: yearRange{min: 1990, max: 1999}, minYear: 1993, maxYear: 1995, wantMin: 1993, wantMax: 1995},
+		{name: "open era end", era: yearRange{min: 2010}, maxYear: 2015, wantMin: 2010, wantMax: 2015},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minYear, maxYear := tt.era.narrow(tt.minYear, tt.maxYear)
+			if minYear != tt.wantMin || maxYear != tt.wantMax {
+				t.Errorf("Expected %d-%d, got: %d-%d", tt.wantMin, tt.wantMax, minYear, maxYear)
+			}
+		})
+	}
+}
+
+func TestParseReleaseDate(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{value: "", want: time.Time{}},
+		{value: "2010-06-15", want: time.Date(2010, time.June, 15, 0, 0, 0, 0, time.UTC)},
+		{value: "2010-06", want: time.Date(2010, time.June, 1, 0, 0, 0, 0, time.UTC)},
+		{value: " 2010 ", want: time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{value: "2010-06-15T12:00:00Z", want: time.Date(2010, time.June, 15, 12, 0, 0, 0, time.UTC)},
+		{value: "15/06/2010", wantErr: true},
+		{value: "last year", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseReleaseDate("released_after", tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseReleaseDate(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("Expected %v, got: %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSearchMovies_EraAndReleaseDates(t *testing.T) {
+	var got movieApp.SearchMoviesQuery
+	mockService := &MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			got = query
+			return []*movieApp.MovieDTO{}, nil
+		},
+	}
+	tools := NewMovieTools(mockService)
+
+	_, _, err := tools.SearchMovies(context.Background(), nil, SearchMoviesInput{
+		Era:            "the nineties",
+		MinYear:        1995,
+		ReleasedAfter:  "1996-03-01",
+		ReleasedBefore: "1999-12-31",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if got.MinYear != 1995 || got.MaxYear != 1999 {
+		t.Errorf("Expected the era narrowed to 1995-1999, got: %d-%d", got.MinYear, got.MaxYear)
+	}
+	if got.ReleasedAfter.Year() != 1996 || got.ReleasedBefore.Year() != 1999 {
+		t.Errorf("Expected the release dates to pass through, got: %v and %v", got.ReleasedAfter, got.ReleasedBefore)
+	}
+}
+
+func TestSearchMovies_InvalidReleaseFilters(t *testing.T) {
+	tools := NewMovieTools(&MockMovieService{})
+
+	tests := []struct {
+		name  string
+		input SearchMoviesInput
+	}{
+		{name: "unknown era", input: SearchMoviesInput{Era: "whenever"}},
+		{name: "bad date", input: SearchMoviesInput{ReleasedAfter: "June 2010"}},
+		{name: "dates reversed", input: SearchMoviesInput{ReleasedAfter: "2010-01-01", ReleasedBefore: "2009-01-01"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := tools.SearchMovies(context.Background(), nil, tt.input)
+			if !errors.Is(err, shared.ErrValidation) {
+				t.Errorf("Expected a validation error, got: %v", err)
+			}
+		})
+	}
+}
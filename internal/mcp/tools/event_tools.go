@@ -0,0 +1,119 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/application/events"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+	"github.com/francknouama/movies-mcp-server/pkg/serialization"
+)
+
+// EventService defines the interface for reading published events
+type EventService interface {
+	Recent(tenant string, limit int, eventType string) []events.Record
+}
+
+// EventTools provides SDK-based MCP handlers for data change events
+type EventTools struct {
+	eventService EventService
+}
+
+// NewEventTools creates a new event tools instance
+func NewEventTools(eventService EventService) *EventTools {
+	return &EventTools{
+		eventService: eventService,
+	}
+}
+
+// ===== list_recent_events Tool =====
+
+// ListRecentEventsInput defines the input schema for list_recent_events tool
+type ListRecentEventsInput struct {
+	Limit int    `json:"limit,omitempty" jsonschema:"Most recent events to return (default all kept)"`
+	Type  string `json:"type,omitempty" jsonschema:"Only events of this type, e.g. movie.created"`
+}
+
+// Validate rejects a negative limit
+func (in ListRecentEventsInput) Validate() error {
+	if in.Limit < 0 {
+		return shared.NewValidationError("limit must not be negative")
+	}
+	return nil
+}
+
+// EventDeliveryOutput defines the output schema for an event's delivery to one webhook
+type EventDeliveryOutput struct {
+	URL        string `json:"url" jsonschema:"Webhook URL"`
+	Status     string `json:"status" jsonschema:"Delivery status (pending/delivered/failed)"`
+	Attempts   int    `json:"attempts" jsonschema:"Delivery attempts made so far"`
+	StatusCode int    `json:"status_code,omitempty" jsonschema:"HTTP status of the last response"`
+	LastError  string `json:"last_error,omitempty" jsonschema:"Why the last attempt failed"`
+}
+
+// EventOutput defines the output schema for a published event
+type EventOutput struct {
+	ID         string                `json:"id" jsonschema:"Event ID, sent as the X-Movies-Delivery header"`
+	Type       string                `json:"type" jsonschema:"Event type, e.g. movie.created"`
+	Tool       string                `json:"tool" jsonschema:"Tool whose successful call published the event"`
+	Data       any                   `json:"data" jsonschema:"The tool's structured output"`
+	OccurredAt string                `json:"occurred_at" jsonschema:"Time the event was published"`
+	Deliveries []EventDeliveryOutput `json:"deliveries" jsonschema:"Delivery state for each webhook"`
+}
+
+// ListRecentEventsOutput defines the output schema for list_recent_events tool
+type ListRecentEventsOutput struct {
+	Events []EventOutput `json:"events" jsonschema:"Events newest first"`
+	Count  int           `json:"count" jsonschema:"Number of events returned"`
+}
+
+// ListRecentEvents handles the list_recent_events tool call
+func (t *EventTools) ListRecentEvents(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input ListRecentEventsInput,
+) (*mcp.CallToolResult, ListRecentEventsOutput, error) {
+	records := t.eventService.Recent(database.TenantFromContext(ctx), input.Limit, input.Type)
+
+	output := ListRecentEventsOutput{
+		Events: make([]EventOutput, 0, len(records)),
+		Count:  len(records),
+	}
+	for _, record := range records {
+		output.Events = append(output.Events, newEventOutput(record))
+	}
+
+	if input.Type != "" {
+		return summaryResult(ctx, output, "Found %s of type %s", countNoun(ctx, output.Count, "event", "events"), input.Type), output, nil
+	}
+	return summaryResult(ctx, output, "Found %s", countNoun(ctx, output.Count, "event", "events")), output, nil
+}
+
+// newEventOutput converts an event record to the output format
+func newEventOutput(record events.Record) EventOutput {
+	var data any
+	_ = json.Unmarshal(record.Data, &data)
+
+	deliveries := make([]EventDeliveryOutput, 0, len(record.Deliveries))
+	for _, delivery := range record.Deliveries {
+		deliveries = append(deliveries, EventDeliveryOutput{
+			URL:        delivery.URL,
+			Status:     string(delivery.Status),
+			Attempts:   delivery.Attempts,
+			StatusCode: delivery.StatusCode,
+			LastError:  delivery.LastError,
+		})
+	}
+
+	return EventOutput{
+		ID:         record.ID,
+		Type:       record.Type,
+		Tool:       record.Tool,
+		Data:       data,
+		OccurredAt: serialization.Timestamp(record.OccurredAt),
+		Deliveries: deliveries,
+	}
+}
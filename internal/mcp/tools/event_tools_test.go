@@ -0,0 +1,106 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/application/events"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+// MockEventService is a mock implementation of EventService
+type MockEventService struct {
+	RecentFunc func(tenant string, limit int, eventType string) []events.Record
+}
+
+func (m *MockEventService) Recent(tenant string, limit int, eventType string) []events.Record {
+	if m.RecentFunc != nil {
+		return m.RecentFunc(tenant, limit, eventType)
+	}
+	return nil
+}
+
+func TestListRecentEvents_Success(t *testing.T) {
+	var gotTenant, gotType string
+	var gotLimit int
+	service := &MockEventService{
+		RecentFunc: func(tenant string, limit int, eventType string) []events.Record {
+			gotTenant, gotLimit, gotType = tenant, limit, eventType
+			return []events.Record{{
+				Event: events.Event{
+					ID:         "evt-1",
+					Type:       "movie.created",
+					Tool:       "add_movie",
+					Data:       json.RawMessage(`{"id":7,"title":"Inception"}`),
+					OccurredAt: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+				},
+				Deliveries: []events.Delivery{{
+					URL:        "https://hooks.example.com",
+					Status:     events.DeliveryFailed,
+					Attempts:   5,
+					StatusCode: 503,
+					LastError:  "webhook responded with HTTP 503",
+				}},
+			}}
+		},
+	}
+	tools := NewEventTools(service)
+
+	ctx := database.WithTenant(context.Background(), "acme", nil)
+	result, output, err := tools.ListRecentEvents(ctx, nil, ListRecentEventsInput{Limit: 10, Type: "movie.created"})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if gotTenant != "acme" || gotLimit != 10 || gotType != "movie.created" {
+		t.Errorf("Expected the caller's tenant, limit and type to reach the service, got: %q, %d, %q", gotTenant, gotLimit, gotType)
+	}
+	if output.Count != 1 || len(output.Events) != 1 {
+		t.Fatalf("Expected 1 event, got: %+v", output)
+	}
+	event := output.Events[0]
+	if event.ID != "evt-1" || event.Tool != "add_movie" || event.OccurredAt != "2024-05-01T12:00:00Z" {
+		t.Errorf("Unexpected event: %+v", event)
+	}
+	if data, ok := event.Data.(map[string]any); !ok || data["title"] != "Inception" {
+		t.Errorf("Expected the event data to be decoded, got: %#v", event.Data)
+	}
+	if len(event.Deliveries) != 1 || event.Deliveries[0].Status != "failed" || event.Deliveries[0].Attempts != 5 {
+		t.Errorf("Expected the failed delivery, got: %+v", event.Deliveries)
+	}
+	assertSummaryResult(t, result)
+	if summary := result.Content[1].(*mcp.TextContent).Text; summary != "Found 1 event of type movie.created" {
+		t.Errorf("Unexpected summary, got: %s", summary)
+	}
+}
+
+func TestListRecentEvents_Empty(t *testing.T) {
+	tools := NewEventTools(&MockEventService{})
+
+	result, output, err := tools.ListRecentEvents(context.Background(), nil, ListRecentEventsInput{})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Count != 0 || output.Events == nil {
+		t.Errorf("Expected an empty event list, got: %+v", output)
+	}
+	if summary := result.Content[1].(*mcp.TextContent).Text; summary != "Found 0 events" {
+		t.Errorf("Unexpected summary, got: %s", summary)
+	}
+}
+
+func TestListRecentEventsInput_Validate(t *testing.T) {
+	if err := (ListRecentEventsInput{Limit: -1}).Validate(); !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("Expected a validation error, got: %v", err)
+	}
+	if err := (ListRecentEventsInput{}).Validate(); err != nil {
+		t.Errorf("Expected no error, got: %v", err)
+	}
+}
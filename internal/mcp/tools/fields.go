@@ -0,0 +1,93 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// movieOutputFields are the fields a fields parameter can select, in
+// MovieOutput order
+var movieOutputFields = jsonFieldNames(reflect.TypeFor[MovieOutput]())
+
+// jsonFieldNames returns the JSON names of a struct's serialized fields
+func jsonFieldNames(t reflect.Type) []string {
+	var names []string
+	for i := range t.NumField() {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if field.IsExported() && name != "-" && name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// validateMovieFields rejects field names MovieOutput does not have
+func validateMovieFields(fields []string) error {
+	for _, field := range fields {
+		if !slices.Contains(movieOutputFields, field) {
+			return shared.NewValidationError("unknown field %q (use %s)", field, strings.Join(movieOutputFields, ", "))
+		}
+	}
+	return nil
+}
+
+// selectMovieFields makes each movie serialize only the given fields, its
+// ID and any explanation a search with explain added; no fields keeps every
+// field
+func selectMovieFields(movies []MovieOutput, fields []string) {
+	if len(fields) == 0 {
+		return
+	}
+	for i := range movies {
+		movies[i].fields = fields
+	}
+}
+
+// MarshalJSON serializes the movie, keeping only its selected fields when
+// a tool call named some. Empty optional fields stay absent.
+func (o MovieOutput) MarshalJSON() ([]byte, error) {
+	type movieOutput MovieOutput // Without this method
+	data, err := json.Marshal(movieOutput(o))
+	if err != nil || o.fields == nil {
+		return data, err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+	kept := map[string]json.RawMessage{"id": all["id"]}
+	if explanation, ok := all["explanation"]; ok {
+		kept["explanation"] = explanation
+	}
+	for _, field := range o.fields {
+		if value, ok := all[field]; ok {
+			kept[field] = value
+		}
+	}
+	return json.Marshal(kept)
+}
+
+// SelectableOutputSchema is OutputSchema for tools taking a fields
+// parameter: their movies only promise an ID, as the other fields may not
+// have been selected
+func SelectableOutputSchema[T any]() *jsonschema.Schema {
+	movieSchema := OutputSchema[MovieOutput]()
+	movieSchema.Required = []string{"id"}
+
+	schema, err := jsonschema.For[T](&jsonschema.ForOptions{
+		TypeSchemas: map[reflect.Type]*jsonschema.Schema{reflect.TypeFor[MovieOutput](): movieSchema},
+	})
+	if err != nil {
+		panic(fmt.Sprintf("failed to derive output schema for %T: %v", *new(T), err))
+	}
+	return schema
+}
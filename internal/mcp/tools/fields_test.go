@@ -0,0 +1,105 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+func TestMovieOutput_MarshalSelectedFields(t *testing.T) {
+	movie := MovieOutput{ID: 7, Title: "Heat", Director: "Michael Mann", Year: 1995, Genres: []string{"Crime"}}
+
+	data, err := json.Marshal(movie)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var all map[string]any
+	json.Unmarshal(data, &all)
+	if len(all) != 7 {
+		t.Errorf("Expected every field without a selection, got: %s", data)
+	}
+
+	movies := []MovieOutput{movie}
+	selectMovieFields(movies, []string{"title", "rating", "year"})
+	data, err = json.Marshal(movies[0])
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	// id is always kept, and the empty rating stays absent
+	if string(data) != `{"id":7,"title":"Heat","year":1995}` {
+		t.Errorf("Expected id, title and year, got: %s", data)
+	}
+
+	movies[0].Explanation = &ExplanationOutput{Matched: []CriterionMatchOutput{}, Sort: []SortValueOutput{}, Rank: 1}
+	data, _ = json.Marshal(movies[0])
+	var kept map[string]any
+	json.Unmarshal(data, &kept)
+	if _, ok := kept["explanation"]; !ok {
+		t.Errorf("Expected an explanation to be kept, got: %s", data)
+	}
+}
+
+func TestValidateMovieFields(t *testing.T) {
+	if err := validateMovieFields([]string{"title", "certifications", "score"}); err != nil {
+		t.Errorf("Expected known fields to be accepted, got: %v", err)
+	}
+	if err := (GetMovieInput{MovieID: 1, Fields: []string{"title", "budget"}}).Validate(); !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("Expected an unknown field to be a validation error, got: %v", err)
+	}
+}
+
+func TestSearchMovies_SelectedFieldsMatchSchema(t *testing.T) {
+	mockService := &MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			return []*movieApp.MovieDTO{
+				{ID: 1, Title: "Inception", Director: "Christopher Nolan", Year: 2010, Rating: 8.8},
+				{ID: 2, Title: "Heat", Director: "Michael Mann", Year: 1995},
+			}, nil
+		},
+	}
+
+	_, output, err := NewMovieTools(mockService).SearchMovies(context.Background(), nil, SearchMoviesInput{Fields: []string{"title", "rating"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	data, _ := json.Marshal(output.Movies)
+	var movies []map[string]any
+	json.Unmarshal(data, &movies)
+	want := []map[string]any{{"id": 1.0, "title": "Inception", "rating": 8.8}, {"id": 2.0, "title": "Heat"}}
+	if !reflect.DeepEqual(movies, want) {
+		t.Errorf("Expected only id, title and rating, got: %s", data)
+	}
+	validateAgainstSchema(t, SelectableOutputSchema[SearchMoviesOutput](), output)
+}
+
+func TestGetMoviesByIDs_SelectedFields(t *testing.T) {
+	movies := &MockMovieBatchGetter{
+		GetMoviesByIDsFunc: func(ctx context.Context, ids []int) ([]*movieApp.MovieDTO, error) {
+			return []*movieApp.MovieDTO{{ID: 1, Title: "Inception", Director: "Christopher Nolan", Year: 2010}}, nil
+		},
+	}
+
+	_, output, err := NewBatchTools(movies, &MockActorBatchGetter{}, 10).GetMoviesByIDs(context.Background(), nil, GetMoviesByIDsInput{IDs: []int{1}, Fields: []string{"director"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if data, _ := json.Marshal(output.Movies); string(data) != `[{"director":"Christopher Nolan","id":1}]` {
+		t.Errorf("Expected only id and director, got: %s", data)
+	}
+	validateAgainstSchema(t, SelectableOutputSchema[GetMoviesByIDsOutput](), output)
+}
+
+func TestSelectableOutputSchema_OnlyRequiresMovieIDs(t *testing.T) {
+	if required := SelectableOutputSchema[GetMovieOutput]().Required; !reflect.DeepEqual(required, []string{"id"}) {
+		t.Errorf("Expected get_movie to require only id, got: %v", required)
+	}
+	if required := OutputSchema[GetMovieOutput]().Required; len(required) < 2 {
+		t.Errorf("Expected OutputSchema to keep the movie's required fields, got: %v", required)
+	}
+}
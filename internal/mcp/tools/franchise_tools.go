@@ -0,0 +1,330 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	franchiseApp "github.com/francknouama/movies-mcp-server/internal/application/franchise"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/i18n"
+)
+
+// FranchiseService defines the interface for franchise operations
+type FranchiseService interface {
+	CreateFranchise(ctx context.Context, cmd franchiseApp.CreateFranchiseCommand) (*franchiseApp.FranchiseDTO, error)
+	UpdateFranchise(ctx context.Context, cmd franchiseApp.UpdateFranchiseCommand) (*franchiseApp.FranchiseDTO, error)
+	DeleteFranchise(ctx context.Context, id int) error
+	ListFranchises(ctx context.Context, movieID int) ([]*franchiseApp.FranchiseDTO, error)
+	AddMovie(ctx context.Context, cmd franchiseApp.AddMovieCommand) (*franchiseApp.FranchiseDTO, error)
+	RemoveMovie(ctx context.Context, franchiseID, movieID int) (*franchiseApp.FranchiseDTO, error)
+	GetTimeline(ctx context.Context, id int) (*franchiseApp.TimelineDTO, error)
+}
+
+// FranchiseTools provides SDK-based MCP handlers for franchises
+type FranchiseTools struct {
+	service FranchiseService
+}
+
+// NewFranchiseTools creates a new franchise tools instance
+func NewFranchiseTools(service FranchiseService) *FranchiseTools {
+	return &FranchiseTools{
+		service: service,
+	}
+}
+
+// FranchiseOutput defines the output schema for a franchise
+type FranchiseOutput struct {
+	ID          int    `json:"id" jsonschema:"Franchise ID"`
+	Name        string `json:"name" jsonschema:"Franchise name"`
+	Description string `json:"description,omitempty" jsonschema:"Franchise description"`
+	MovieIDs    []int  `json:"movie_ids" jsonschema:"Movie IDs in chronological (story) order"`
+	CreatedAt   string `json:"created_at" jsonschema:"Creation timestamp"`
+	UpdatedAt   string `json:"updated_at" jsonschema:"Last update timestamp"`
+}
+
+// newFranchiseOutput converts a franchise DTO to the output format
+func newFranchiseOutput(dto *franchiseApp.FranchiseDTO) FranchiseOutput {
+	return FranchiseOutput{
+		ID:          dto.ID,
+		Name:        dto.Name,
+		Description: dto.Description,
+		MovieIDs:    nonNilInts(dto.MovieIDs),
+		CreatedAt:   dto.CreatedAt,
+		UpdatedAt:   dto.UpdatedAt,
+	}
+}
+
+// franchiseLabel describes a franchise as `franchise 1 "Name" with N movies`
+func franchiseLabel(ctx context.Context, output FranchiseOutput) string {
+	return i18n.FromContext(ctx).Sprintf("franchise %d %q with %s", output.ID, output.Name,
+		countNoun(ctx, len(output.MovieIDs), "movie", "movies"))
+}
+
+// ===== create_franchise Tool =====
+
+// CreateFranchiseInput defines the input schema for create_franchise tool
+type CreateFranchiseInput struct {
+	Name        string `json:"name" jsonschema:"Franchise name (e.g. The Lord of the Rings)"`
+	Description string `json:"description,omitempty" jsonschema:"Franchise description"`
+	MovieIDs    []int  `json:"movie_ids,omitempty" jsonschema:"Initial movie IDs in chronological (story) order"`
+}
+
+// Validate requires a franchise name and limits the description
+func (in CreateFranchiseInput) Validate() error {
+	if in.Name == "" {
+		return shared.NewValidationError("name is required")
+	}
+	return checkTextLength("description", in.Description)
+}
+
+// CreateFranchise handles the create_franchise tool call
+func (t *FranchiseTools) CreateFranchise(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input CreateFranchiseInput,
+) (*mcp.CallToolResult, FranchiseOutput, error) {
+	dto, err := t.service.CreateFranchise(ctx, franchiseApp.CreateFranchiseCommand{
+		Name:        input.Name,
+		Description: input.Description,
+		MovieIDs:    input.MovieIDs,
+	})
+	if err != nil {
+		return nil, FranchiseOutput{}, fmt.Errorf("failed to create franchise: %w", err)
+	}
+
+	output := newFranchiseOutput(dto)
+	return summaryResult(ctx, output, "Created %s", franchiseLabel(ctx, output)), output, nil
+}
+
+// ===== update_franchise Tool =====
+
+// UpdateFranchiseInput defines the input schema for update_franchise tool
+type UpdateFranchiseInput struct {
+	ID          int    `json:"id" jsonschema:"Franchise ID"`
+	Name        string `json:"name" jsonschema:"Franchise name"`
+	Description string `json:"description,omitempty" jsonschema:"Franchise description; omit to clear it"`
+}
+
+// Validate requires a franchise name and limits the description
+func (in UpdateFranchiseInput) Validate() error {
+	if in.Name == "" {
+		return shared.NewValidationError("name is required")
+	}
+	return checkTextLength("description", in.Description)
+}
+
+// UpdateFranchise handles the update_franchise tool call
+func (t *FranchiseTools) UpdateFranchise(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input UpdateFranchiseInput,
+) (*mcp.CallToolResult, FranchiseOutput, error) {
+	dto, err := t.service.UpdateFranchise(ctx, franchiseApp.UpdateFranchiseCommand{
+		ID:          input.ID,
+		Name:        input.Name,
+		Description: input.Description,
+	})
+	if err != nil {
+		return nil, FranchiseOutput{}, fmt.Errorf("failed to update franchise: %w", err)
+	}
+
+	output := newFranchiseOutput(dto)
+	return summaryResult(ctx, output, "Updated %s", franchiseLabel(ctx, output)), output, nil
+}
+
+// ===== delete_franchise Tool =====
+
+// DeleteFranchiseInput defines the input schema for delete_franchise tool
+type DeleteFranchiseInput struct {
+	ID int `json:"id" jsonschema:"The franchise ID to delete"`
+}
+
+// DeleteFranchiseOutput defines the output schema for delete_franchise tool
+type DeleteFranchiseOutput struct {
+	Message string `json:"message" jsonschema:"Success message"`
+}
+
+// DeleteFranchise handles the delete_franchise tool call. The franchise's
+// movies are kept.
+func (t *FranchiseTools) DeleteFranchise(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input DeleteFranchiseInput,
+) (*mcp.CallToolResult, DeleteFranchiseOutput, error) {
+	if err := t.service.DeleteFranchise(ctx, input.ID); err != nil {
+		return nil, DeleteFranchiseOutput{}, fmt.Errorf("failed to delete franchise: %w", err)
+	}
+
+	output := DeleteFranchiseOutput{
+		Message: "Franchise deleted successfully",
+	}
+
+	return summaryResult(ctx, output, "Deleted franchise %d", input.ID), output, nil
+}
+
+// ===== list_franchises Tool =====
+
+// ListFranchisesInput defines the input schema for list_franchises tool
+type ListFranchisesInput struct {
+	MovieID int `json:"movie_id,omitempty" jsonschema:"Only list franchises containing this movie"`
+}
+
+// ListFranchisesOutput defines the output schema for list_franchises tool
+type ListFranchisesOutput struct {
+	Franchises []FranchiseOutput `json:"franchises" jsonschema:"Franchises ordered by name"`
+	Total      int               `json:"total" jsonschema:"Number of franchises"`
+}
+
+// ListFranchises handles the list_franchises tool call
+func (t *FranchiseTools) ListFranchises(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input ListFranchisesInput,
+) (*mcp.CallToolResult, ListFranchisesOutput, error) {
+	dtos, err := t.service.ListFranchises(ctx, input.MovieID)
+	if err != nil {
+		return nil, ListFranchisesOutput{}, fmt.Errorf("failed to list franchises: %w", err)
+	}
+
+	output := ListFranchisesOutput{
+		Franchises: make([]FranchiseOutput, 0, len(dtos)),
+		Total:      len(dtos),
+	}
+	names := make([]string, 0, len(dtos))
+	for _, dto := range dtos {
+		output.Franchises = append(output.Franchises, newFranchiseOutput(dto))
+		names = append(names, dto.Name)
+	}
+
+	return summaryResult(ctx, output, "Found %s%s", countNoun(ctx, output.Total, "franchise", "franchises"), listSummary(ctx, names)), output, nil
+}
+
+// ===== add_movie_to_franchise Tool =====
+
+// AddMovieToFranchiseInput defines the input schema for add_movie_to_franchise tool
+type AddMovieToFranchiseInput struct {
+	FranchiseID int `json:"franchise_id" jsonschema:"Franchise ID"`
+	MovieID     int `json:"movie_id" jsonschema:"Movie ID"`
+	Position    int `json:"position,omitempty" jsonschema:"1-based chronological position; omit to append. A movie already in the franchise is moved"`
+}
+
+// AddMovieToFranchise handles the add_movie_to_franchise tool call
+func (t *FranchiseTools) AddMovieToFranchise(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input AddMovieToFranchiseInput,
+) (*mcp.CallToolResult, FranchiseOutput, error) {
+	dto, err := t.service.AddMovie(ctx, franchiseApp.AddMovieCommand{
+		FranchiseID: input.FranchiseID,
+		MovieID:     input.MovieID,
+		Position:    input.Position,
+	})
+	if err != nil {
+		return nil, FranchiseOutput{}, fmt.Errorf("failed to add movie to franchise: %w", err)
+	}
+
+	output := newFranchiseOutput(dto)
+	return summaryResult(ctx, output, "Placed movie %d at position %d in %s", input.MovieID,
+		moviePosition(output.MovieIDs, input.MovieID), franchiseLabel(ctx, output)), output, nil
+}
+
+// moviePosition returns the 1-based position of a movie ID, or 0 if it is absent
+func moviePosition(movieIDs []int, movieID int) int {
+	for i, id := range movieIDs {
+		if id == movieID {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// ===== remove_movie_from_franchise Tool =====
+
+// RemoveMovieFromFranchiseInput defines the input schema for remove_movie_from_franchise tool
+type RemoveMovieFromFranchiseInput struct {
+	FranchiseID int `json:"franchise_id" jsonschema:"Franchise ID"`
+	MovieID     int `json:"movie_id" jsonschema:"Movie ID"`
+}
+
+// RemoveMovieFromFranchise handles the remove_movie_from_franchise tool call
+func (t *FranchiseTools) RemoveMovieFromFranchise(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input RemoveMovieFromFranchiseInput,
+) (*mcp.CallToolResult, FranchiseOutput, error) {
+	dto, err := t.service.RemoveMovie(ctx, input.FranchiseID, input.MovieID)
+	if err != nil {
+		return nil, FranchiseOutput{}, fmt.Errorf("failed to remove movie from franchise: %w", err)
+	}
+
+	output := newFranchiseOutput(dto)
+	return summaryResult(ctx, output, "Removed movie %d; %s", input.MovieID, franchiseLabel(ctx, output)), output, nil
+}
+
+// ===== get_franchise_timeline Tool =====
+
+// GetFranchiseTimelineInput defines the input schema for get_franchise_timeline tool
+type GetFranchiseTimelineInput struct {
+	ID int `json:"id" jsonschema:"Franchise ID"`
+}
+
+// TimelineEntryOutput defines the output schema for a movie in a franchise timeline
+type TimelineEntryOutput struct {
+	Position int     `json:"position" jsonschema:"Chronological (story) position"`
+	MovieID  int     `json:"movie_id" jsonschema:"Movie ID"`
+	Title    string  `json:"title" jsonschema:"Movie title"`
+	Director string  `json:"director" jsonschema:"Movie director"`
+	Year     int     `json:"year" jsonschema:"Release year"`
+	Rating   float64 `json:"rating,omitempty" jsonschema:"Movie rating"`
+}
+
+// GetFranchiseTimelineOutput defines the output schema for get_franchise_timeline tool
+type GetFranchiseTimelineOutput struct {
+	Franchise     FranchiseOutput       `json:"franchise" jsonschema:"The franchise"`
+	Chronological []TimelineEntryOutput `json:"chronological" jsonschema:"Movies in story order"`
+	Release       []TimelineEntryOutput `json:"release" jsonschema:"Movies in release order"`
+}
+
+// newTimelineEntries converts timeline DTO entries to the output format
+func newTimelineEntries(dtos []*franchiseApp.TimelineEntryDTO) []TimelineEntryOutput {
+	entries := make([]TimelineEntryOutput, 0, len(dtos))
+	for _, dto := range dtos {
+		entries = append(entries, TimelineEntryOutput{
+			Position: dto.Position,
+			MovieID:  dto.MovieID,
+			Title:    dto.Title,
+			Director: dto.Director,
+			Year:     dto.Year,
+			Rating:   dto.Rating,
+		})
+	}
+	return entries
+}
+
+// GetFranchiseTimeline handles the get_franchise_timeline tool call
+func (t *FranchiseTools) GetFranchiseTimeline(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input GetFranchiseTimelineInput,
+) (*mcp.CallToolResult, GetFranchiseTimelineOutput, error) {
+	dto, err := t.service.GetTimeline(ctx, input.ID)
+	if err != nil {
+		return nil, GetFranchiseTimelineOutput{}, fmt.Errorf("failed to get franchise timeline: %w", err)
+	}
+
+	output := GetFranchiseTimelineOutput{
+		Franchise:     newFranchiseOutput(dto.Franchise),
+		Chronological: newTimelineEntries(dto.Chronological),
+		Release:       newTimelineEntries(dto.Release),
+	}
+
+	names := make([]string, 0, len(output.Chronological))
+	for _, entry := range output.Chronological {
+		names = append(names, fmt.Sprintf("%s (%d)", entry.Title, entry.Year))
+	}
+
+	return summaryResult(ctx, output, "%s timeline: %s in story order%s", output.Franchise.Name,
+		countNoun(ctx, len(output.Chronological), "movie", "movies"), listSummary(ctx, names)), output, nil
+}
@@ -0,0 +1,218 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	franchiseApp "github.com/francknouama/movies-mcp-server/internal/application/franchise"
+)
+
+// MockFranchiseService is a mock implementation of FranchiseService
+type MockFranchiseService struct {
+	CreateFranchiseFunc func(ctx context.Context, cmd franchiseApp.CreateFranchiseCommand) (*franchiseApp.FranchiseDTO, error)
+	UpdateFranchiseFunc func(ctx context.Context, cmd franchiseApp.UpdateFranchiseCommand) (*franchiseApp.FranchiseDTO, error)
+	DeleteFranchiseFunc func(ctx context.Context, id int) error
+	ListFranchisesFunc  func(ctx context.Context, movieID int) ([]*franchiseApp.FranchiseDTO, error)
+	AddMovieFunc        func(ctx context.Context, cmd franchiseApp.AddMovieCommand) (*franchiseApp.FranchiseDTO, error)
+	RemoveMovieFunc     func(ctx context.Context, franchiseID, movieID int) (*franchiseApp.FranchiseDTO, error)
+	GetTimelineFunc     func(ctx context.Context, id int) (*franchiseApp.TimelineDTO, error)
+}
+
+func (m *MockFranchiseService) CreateFranchise(ctx context.Context, cmd franchiseApp.CreateFranchiseCommand) (*franchiseApp.FranchiseDTO, error) {
+	if m.CreateFranchiseFunc != nil {
+		return m.CreateFranchiseFunc(ctx, cmd)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockFranchiseService) UpdateFranchise(ctx context.Context, cmd franchiseApp.UpdateFranchiseCommand) (*franchiseApp.FranchiseDTO, error) {
+	if m.UpdateFranchiseFunc != nil {
+		return m.UpdateFranchiseFunc(ctx, cmd)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockFranchiseService) DeleteFranchise(ctx context.Context, id int) error {
+	if m.DeleteFranchiseFunc != nil {
+		return m.DeleteFranchiseFunc(ctx, id)
+	}
+	return errors.New("not implemented")
+}
+
+func (m *MockFranchiseService) ListFranchises(ctx context.Context, movieID int) ([]*franchiseApp.FranchiseDTO, error) {
+	if m.ListFranchisesFunc != nil {
+		return m.ListFranchisesFunc(ctx, movieID)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockFranchiseService) AddMovie(ctx context.Context, cmd franchiseApp.AddMovieCommand) (*franchiseApp.FranchiseDTO, error) {
+	if m.AddMovieFunc != nil {
+		return m.AddMovieFunc(ctx, cmd)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockFranchiseService) RemoveMovie(ctx context.Context, franchiseID, movieID int) (*franchiseApp.FranchiseDTO, error) {
+	if m.RemoveMovieFunc != nil {
+		return m.RemoveMovieFunc(ctx, franchiseID, movieID)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockFranchiseService) GetTimeline(ctx context.Context, id int) (*franchiseApp.TimelineDTO, error) {
+	if m.GetTimelineFunc != nil {
+		return m.GetTimelineFunc(ctx, id)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func TestCreateFranchise_Success(t *testing.T) {
+	var gotCmd franchiseApp.CreateFranchiseCommand
+	service := &MockFranchiseService{
+		CreateFranchiseFunc: func(ctx context.Context, cmd franchiseApp.CreateFranchiseCommand) (*franchiseApp.FranchiseDTO, error) {
+			gotCmd = cmd
+			return &franchiseApp.FranchiseDTO{ID: 1, Name: cmd.Name, MovieIDs: cmd.MovieIDs}, nil
+		},
+	}
+	tools := NewFranchiseTools(service)
+
+	result, output, err := tools.CreateFranchise(context.Background(), nil, CreateFranchiseInput{
+		Name:     "The Lord of the Rings",
+		MovieIDs: []int{1, 2, 3},
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	assertSummaryResult(t, result)
+	if !reflect.DeepEqual(gotCmd.MovieIDs, []int{1, 2, 3}) {
+		t.Errorf("Expected movie IDs to be passed through, got: %v", gotCmd.MovieIDs)
+	}
+	if output.ID != 1 || len(output.MovieIDs) != 3 {
+		t.Errorf("Expected franchise 1 with 3 movies, got: %+v", output)
+	}
+}
+
+func TestCreateFranchise_MissingName(t *testing.T) {
+	err := CreateFranchiseInput{}.Validate()
+
+	if err == nil {
+		t.Fatal("Expected error for missing name")
+	}
+}
+
+func TestAddMovieToFranchise_Success(t *testing.T) {
+	var gotCmd franchiseApp.AddMovieCommand
+	service := &MockFranchiseService{
+		AddMovieFunc: func(ctx context.Context, cmd franchiseApp.AddMovieCommand) (*franchiseApp.FranchiseDTO, error) {
+			gotCmd = cmd
+			return &franchiseApp.FranchiseDTO{ID: 1, Name: "Star Wars", MovieIDs: []int{3, 1, 2}}, nil
+		},
+	}
+	tools := NewFranchiseTools(service)
+
+	result, output, err := tools.AddMovieToFranchise(context.Background(), nil, AddMovieToFranchiseInput{
+		FranchiseID: 1,
+		MovieID:     3,
+		Position:    1,
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	assertSummaryResult(t, result)
+	if gotCmd.Position != 1 || gotCmd.MovieID != 3 {
+		t.Errorf("Expected position and movie to be passed through, got: %+v", gotCmd)
+	}
+	if output.MovieIDs[0] != 3 {
+		t.Errorf("Expected movie 3 first, got: %v", output.MovieIDs)
+	}
+}
+
+func TestRemoveMovieFromFranchise_Error(t *testing.T) {
+	service := &MockFranchiseService{
+		RemoveMovieFunc: func(ctx context.Context, franchiseID, movieID int) (*franchiseApp.FranchiseDTO, error) {
+			return nil, errors.New("movie not found in franchise")
+		},
+	}
+	tools := NewFranchiseTools(service)
+
+	_, _, err := tools.RemoveMovieFromFranchise(context.Background(), nil, RemoveMovieFromFranchiseInput{FranchiseID: 1, MovieID: 9})
+
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+}
+
+func TestListFranchises_Empty(t *testing.T) {
+	service := &MockFranchiseService{
+		ListFranchisesFunc: func(ctx context.Context, movieID int) ([]*franchiseApp.FranchiseDTO, error) {
+			return []*franchiseApp.FranchiseDTO{}, nil
+		},
+	}
+	tools := NewFranchiseTools(service)
+
+	result, output, err := tools.ListFranchises(context.Background(), nil, ListFranchisesInput{})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	assertSummaryResult(t, result)
+	if output.Franchises == nil || output.Total != 0 {
+		t.Errorf("Expected empty non-nil list, got: %+v", output)
+	}
+	validateAgainstSchema(t, OutputSchema[ListFranchisesOutput](), output)
+}
+
+func TestDeleteFranchise_Success(t *testing.T) {
+	var gotID int
+	service := &MockFranchiseService{
+		DeleteFranchiseFunc: func(ctx context.Context, id int) error {
+			gotID = id
+			return nil
+		},
+	}
+	tools := NewFranchiseTools(service)
+
+	result, _, err := tools.DeleteFranchise(context.Background(), nil, DeleteFranchiseInput{ID: 4})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	assertSummaryResult(t, result)
+	if gotID != 4 {
+		t.Errorf("Expected franchise 4 to be deleted, got: %d", gotID)
+	}
+}
+
+func TestGetFranchiseTimeline_Success(t *testing.T) {
+	service := &MockFranchiseService{
+		GetTimelineFunc: func(ctx context.Context, id int) (*franchiseApp.TimelineDTO, error) {
+			phantom := &franchiseApp.TimelineEntryDTO{Position: 1, MovieID: 3, Title: "The Phantom Menace", Year: 1999}
+			newHope := &franchiseApp.TimelineEntryDTO{Position: 2, MovieID: 1, Title: "A New Hope", Year: 1977}
+			return &franchiseApp.TimelineDTO{
+				Franchise:     &franchiseApp.FranchiseDTO{ID: id, Name: "Star Wars", MovieIDs: []int{3, 1}},
+				Chronological: []*franchiseApp.TimelineEntryDTO{phantom, newHope},
+				Release:       []*franchiseApp.TimelineEntryDTO{newHope, phantom},
+			}, nil
+		},
+	}
+	tools := NewFranchiseTools(service)
+
+	result, output, err := tools.GetFranchiseTimeline(context.Background(), nil, GetFranchiseTimelineInput{ID: 1})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	assertSummaryResult(t, result)
+	if output.Chronological[0].MovieID != 3 || output.Release[0].MovieID != 1 {
+		t.Errorf("Expected story and release orders to differ, got: %+v / %+v", output.Chronological, output.Release)
+	}
+	if output.Release[0].Position != 2 {
+		t.Errorf("Expected release entries to keep story position, got: %d", output.Release[0].Position)
+	}
+	validateAgainstSchema(t, OutputSchema[GetFranchiseTimelineOutput](), output)
+}
@@ -0,0 +1,168 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	historyApp "github.com/francknouama/movies-mcp-server/internal/application/history"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/i18n"
+)
+
+// HistoryService defines the interface for movie history operations
+type HistoryService interface {
+	GetMovieHistory(ctx context.Context, movieID, limit int) (*historyApp.MovieHistoryDTO, error)
+	RevertMovie(ctx context.Context, movieID, version int) (*historyApp.RevertResultDTO, error)
+}
+
+// HistoryTools provides SDK-based MCP handlers for movie history
+type HistoryTools struct {
+	historyService HistoryService
+}
+
+// NewHistoryTools creates a new history tools instance
+func NewHistoryTools(historyService HistoryService) *HistoryTools {
+	return &HistoryTools{
+		historyService: historyService,
+	}
+}
+
+// FieldChangeOutput defines the output schema for one changed movie field
+type FieldChangeOutput struct {
+	Field string `json:"field" jsonschema:"Changed field (e.g. director, rating, genres)"`
+	Old   any    `json:"old,omitempty" jsonschema:"Value before the change; absent when the movie was created"`
+	New   any    `json:"new,omitempty" jsonschema:"Value after the change; absent when the movie was deleted"`
+}
+
+// newFieldChangeOutputs converts change DTOs to the output format
+func newFieldChangeOutputs(dtos []*historyApp.ChangeDTO) []FieldChangeOutput {
+	changes := make([]FieldChangeOutput, 0, len(dtos))
+	for _, dto := range dtos {
+		changes = append(changes, FieldChangeOutput{Field: dto.Field, Old: dto.Old, New: dto.New})
+	}
+	return changes
+}
+
+// fieldNames lists the fields named by a set of changes
+func fieldNames(changes []FieldChangeOutput) []string {
+	names := make([]string, 0, len(changes))
+	for _, change := range changes {
+		names = append(names, change.Field)
+	}
+	return names
+}
+
+// ===== get_movie_history Tool =====
+
+// GetMovieHistoryInput defines the input schema for get_movie_history tool
+type GetMovieHistoryInput struct {
+	MovieID int `json:"movie_id" jsonschema:"Movie ID"`
+	Limit   int `json:"limit,omitempty" jsonschema:"Most recent versions to return (default all)"`
+}
+
+// HistoryEntryOutput defines the output schema for one recorded version
+type HistoryEntryOutput struct {
+	Version    int                 `json:"version" jsonschema:"Version number, counting from 1"`
+	Operation  string              `json:"operation" jsonschema:"What happened (create/update/delete/revert)"`
+	RevertedTo int                 `json:"reverted_to,omitempty" jsonschema:"Version a revert restored"`
+	Changes    []FieldChangeOutput `json:"changes" jsonschema:"Fields changed by this version"`
+	CreatedAt  string              `json:"created_at" jsonschema:"When the change was made"`
+}
+
+// GetMovieHistoryOutput defines the output schema for get_movie_history tool
+type GetMovieHistoryOutput struct {
+	MovieID int                  `json:"movie_id" jsonschema:"Movie ID"`
+	Title   string               `json:"title,omitempty" jsonschema:"Movie title; absent once the movie is deleted"`
+	Deleted bool                 `json:"deleted" jsonschema:"Whether the movie has been deleted"`
+	Entries []HistoryEntryOutput `json:"entries" jsonschema:"Versions, newest first"`
+	Total   int                  `json:"total" jsonschema:"Number of versions recorded"`
+}
+
+// GetMovieHistory handles the get_movie_history tool call
+func (t *HistoryTools) GetMovieHistory(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input GetMovieHistoryInput,
+) (*mcp.CallToolResult, GetMovieHistoryOutput, error) {
+	dto, err := t.historyService.GetMovieHistory(ctx, input.MovieID, input.Limit)
+	if err != nil {
+		return nil, GetMovieHistoryOutput{}, fmt.Errorf("failed to get movie history: %w", err)
+	}
+
+	output := GetMovieHistoryOutput{
+		MovieID: dto.MovieID,
+		Title:   dto.Title,
+		Deleted: dto.Deleted,
+		Entries: make([]HistoryEntryOutput, 0, len(dto.Entries)),
+		Total:   dto.Total,
+	}
+	for _, entry := range dto.Entries {
+		output.Entries = append(output.Entries, HistoryEntryOutput{
+			Version:    entry.Version,
+			Operation:  entry.Operation,
+			RevertedTo: entry.RevertedTo,
+			Changes:    newFieldChangeOutputs(entry.Changes),
+			CreatedAt:  entry.CreatedAt,
+		})
+	}
+
+	name := output.Title
+	if output.Deleted {
+		name = i18n.FromContext(ctx).Sprintf("Deleted movie %d", output.MovieID)
+	}
+	if len(output.Entries) == 0 {
+		return summaryResult(ctx, output, "%s has no recorded history", name), output, nil
+	}
+	latest := output.Entries[0]
+	return summaryResult(ctx, output, "%s has %s; latest is version %d (%s%s)", name,
+		countNoun(ctx, output.Total, "version", "versions"), latest.Version, latest.Operation,
+		listSummary(ctx, fieldNames(latest.Changes))), output, nil
+}
+
+// ===== revert_movie_to_version Tool =====
+
+// RevertMovieToVersionInput defines the input schema for revert_movie_to_version tool
+type RevertMovieToVersionInput struct {
+	MovieID int `json:"movie_id" jsonschema:"Movie ID"`
+	Version int `json:"version" jsonschema:"Earlier version to restore, from get_movie_history"`
+}
+
+// RevertMovieToVersionOutput defines the output schema for revert_movie_to_version tool
+type RevertMovieToVersionOutput struct {
+	MovieID    int                 `json:"movie_id" jsonschema:"Movie ID"`
+	Title      string              `json:"title" jsonschema:"Movie title after the revert"`
+	Year       int                 `json:"year" jsonschema:"Release year after the revert"`
+	Version    int                 `json:"version" jsonschema:"Version recorded for the revert, so it can be undone too"`
+	RevertedTo int                 `json:"reverted_to" jsonschema:"Version that was restored"`
+	Changes    []FieldChangeOutput `json:"changes" jsonschema:"Fields the revert changed"`
+}
+
+// RevertMovieToVersion handles the revert_movie_to_version tool call
+func (t *HistoryTools) RevertMovieToVersion(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input RevertMovieToVersionInput,
+) (*mcp.CallToolResult, RevertMovieToVersionOutput, error) {
+	dto, err := t.historyService.RevertMovie(ctx, input.MovieID, input.Version)
+	if err != nil {
+		return nil, RevertMovieToVersionOutput{}, fmt.Errorf("failed to revert movie: %w", err)
+	}
+
+	output := RevertMovieToVersionOutput{
+		MovieID:    dto.MovieID,
+		Title:      dto.Title,
+		Year:       dto.Year,
+		Version:    dto.Version,
+		RevertedTo: dto.RevertedTo,
+		Changes:    newFieldChangeOutputs(dto.Changes),
+	}
+
+	if len(output.Changes) == 0 {
+		return summaryResult(ctx, output, "%s (%d) already matches version %d; nothing changed",
+			output.Title, output.Year, output.RevertedTo), output, nil
+	}
+	return summaryResult(ctx, output, "Reverted %s (%d) to version %d as version %d, restoring %s%s",
+		output.Title, output.Year, output.RevertedTo, output.Version,
+		countNoun(ctx, len(output.Changes), "field", "fields"), listSummary(ctx, fieldNames(output.Changes))), output, nil
+}
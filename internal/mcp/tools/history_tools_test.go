@@ -0,0 +1,179 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	historyApp "github.com/francknouama/movies-mcp-server/internal/application/history"
+)
+
+// MockHistoryService is a mock implementation of HistoryService
+type MockHistoryService struct {
+	GetMovieHistoryFunc func(ctx context.Context, movieID, limit int) (*historyApp.MovieHistoryDTO, error)
+	RevertMovieFunc     func(ctx context.Context, movieID, version int) (*historyApp.RevertResultDTO, error)
+}
+
+func (m *MockHistoryService) GetMovieHistory(ctx context.Context, movieID, limit int) (*historyApp.MovieHistoryDTO, error) {
+	if m.GetMovieHistoryFunc != nil {
+		return m.GetMovieHistoryFunc(ctx, movieID, limit)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockHistoryService) RevertMovie(ctx context.Context, movieID, version int) (*historyApp.RevertResultDTO, error) {
+	if m.RevertMovieFunc != nil {
+		return m.RevertMovieFunc(ctx, movieID, version)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func TestGetMovieHistory_Success(t *testing.T) {
+	var gotLimit int
+	service := &MockHistoryService{
+		GetMovieHistoryFunc: func(ctx context.Context, movieID, limit int) (*historyApp.MovieHistoryDTO, error) {
+			gotLimit = limit
+			return &historyApp.MovieHistoryDTO{
+				MovieID: movieID,
+				Title:   "Inception",
+				Total:   2,
+				Entries: []*historyApp.EntryDTO{
+					{Version: 2, Operation: "update", Changes: []*historyApp.ChangeDTO{
+						{Field: "director", Old: "C. Nolan", New: "Christopher Nolan"},
+						{Field: "rating", Old: 8.0, New: 8.8},
+					}},
+					{Version: 1, Operation: "create", Changes: []*historyApp.ChangeDTO{{Field: "title", New: "Inception"}}},
+				},
+			}, nil
+		},
+	}
+	tools := NewHistoryTools(service)
+
+	result, output, err := tools.GetMovieHistory(context.Background(), nil, GetMovieHistoryInput{MovieID: 1, Limit: 5})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if gotLimit != 5 {
+		t.Errorf("Expected limit to reach the service, got: %d", gotLimit)
+	}
+	if len(output.Entries) != 2 || output.Entries[0].Changes[0].New != "Christopher Nolan" {
+		t.Errorf("Expected 2 entries with changes, got: %+v", output.Entries)
+	}
+	assertSummaryResult(t, result)
+	summary := result.Content[1].(*mcp.TextContent).Text
+	if summary != "Inception has 2 versions; latest is version 2 (update: director, rating)" {
+		t.Errorf("Unexpected summary, got: %s", summary)
+	}
+}
+
+func TestGetMovieHistory_DeletedMovie(t *testing.T) {
+	service := &MockHistoryService{
+		GetMovieHistoryFunc: func(ctx context.Context, movieID, limit int) (*historyApp.MovieHistoryDTO, error) {
+			return &historyApp.MovieHistoryDTO{
+				MovieID: movieID,
+				Deleted: true,
+				Total:   1,
+				Entries: []*historyApp.EntryDTO{{Version: 1, Operation: "delete", Changes: []*historyApp.ChangeDTO{}}},
+			}, nil
+		},
+	}
+	tools := NewHistoryTools(service)
+
+	result, output, err := tools.GetMovieHistory(context.Background(), nil, GetMovieHistoryInput{MovieID: 7})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Deleted || output.Entries[0].Changes == nil {
+		t.Errorf("Expected deleted movie with non-nil changes, got: %+v", output)
+	}
+	summary := result.Content[1].(*mcp.TextContent).Text
+	if summary != "Deleted movie 7 has 1 version; latest is version 1 (delete)" {
+		t.Errorf("Unexpected summary, got: %s", summary)
+	}
+}
+
+func TestGetMovieHistory_ServiceError(t *testing.T) {
+	service := &MockHistoryService{
+		GetMovieHistoryFunc: func(ctx context.Context, movieID, limit int) (*historyApp.MovieHistoryDTO, error) {
+			return nil, errors.New("movie not found")
+		},
+	}
+	tools := NewHistoryTools(service)
+
+	_, _, err := tools.GetMovieHistory(context.Background(), nil, GetMovieHistoryInput{MovieID: 99})
+
+	if err == nil || !strings.Contains(err.Error(), "failed to get movie history") {
+		t.Errorf("Expected get movie history error, got: %v", err)
+	}
+}
+
+func TestRevertMovieToVersion_Success(t *testing.T) {
+	var gotVersion int
+	service := &MockHistoryService{
+		RevertMovieFunc: func(ctx context.Context, movieID, version int) (*historyApp.RevertResultDTO, error) {
+			gotVersion = version
+			return &historyApp.RevertResultDTO{
+				MovieID:    movieID,
+				Title:      "Inception",
+				Year:       2010,
+				Version:    4,
+				RevertedTo: version,
+				Changes:    []*historyApp.ChangeDTO{{Field: "director", Old: "Someone Else", New: "Christopher Nolan"}},
+			}, nil
+		},
+	}
+	tools := NewHistoryTools(service)
+
+	result, output, err := tools.RevertMovieToVersion(context.Background(), nil, RevertMovieToVersionInput{MovieID: 1, Version: 2})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if gotVersion != 2 || output.Version != 4 || len(output.Changes) != 1 {
+		t.Errorf("Expected revert to version 2 recorded as 4, got: %+v", output)
+	}
+	assertSummaryResult(t, result)
+	summary := result.Content[1].(*mcp.TextContent).Text
+	if summary != "Reverted Inception (2010) to version 2 as version 4, restoring 1 field: director" {
+		t.Errorf("Unexpected summary, got: %s", summary)
+	}
+}
+
+func TestRevertMovieToVersion_NothingChanged(t *testing.T) {
+	service := &MockHistoryService{
+		RevertMovieFunc: func(ctx context.Context, movieID, version int) (*historyApp.RevertResultDTO, error) {
+			return &historyApp.RevertResultDTO{MovieID: movieID, Title: "Inception", Year: 2010, Version: 3, RevertedTo: version, Changes: []*historyApp.ChangeDTO{}}, nil
+		},
+	}
+	tools := NewHistoryTools(service)
+
+	result, _, err := tools.RevertMovieToVersion(context.Background(), nil, RevertMovieToVersionInput{MovieID: 1, Version: 1})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	summary := result.Content[1].(*mcp.TextContent).Text
+	if summary != "Inception (2010) already matches version 1; nothing changed" {
+		t.Errorf("Unexpected summary, got: %s", summary)
+	}
+}
+
+func TestRevertMovieToVersion_ServiceError(t *testing.T) {
+	service := &MockHistoryService{
+		RevertMovieFunc: func(ctx context.Context, movieID, version int) (*historyApp.RevertResultDTO, error) {
+			return nil, errors.New("version must be between 1 and 2")
+		},
+	}
+	tools := NewHistoryTools(service)
+
+	_, _, err := tools.RevertMovieToVersion(context.Background(), nil, RevertMovieToVersionInput{MovieID: 1, Version: 3})
+
+	if err == nil || !strings.Contains(err.Error(), "failed to revert movie") {
+		t.Errorf("Expected revert error, got: %v", err)
+	}
+}
@@ -0,0 +1,20 @@
+package tools
+
+import (
+	"unicode/utf8"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// MaxTextLength is the most characters a free-text field, such as a
+// biography or description, may hold
+const MaxTextLength = 10000
+
+// checkTextLength rejects free text longer than MaxTextLength
+func checkTextLength(field, text string) error {
+	if length := utf8.RuneCountInString(text); length > MaxTextLength {
+		return shared.NewValidationError("%s is %d characters, over the %d-character limit; shorten it to a summary",
+			field, length, MaxTextLength)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package tools
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/middleware"
+)
+
+func TestFreeTextLimits(t *testing.T) {
+	long := strings.Repeat("é", MaxTextLength+1)
+	limit := strings.Repeat("é", MaxTextLength)
+
+	tests := []struct {
+		name  string
+		input middleware.Validator
+		field string
+	}{
+		{name: "add_actor", input: AddActorInput{Name: "Actor", Bio: long}, field: "bio"},
+		{name: "update_actor", input: UpdateActorInput{ID: 1, Name: "Actor", Bio: long}, field: "bio"},
+		{name: "create_franchise", input: CreateFranchiseInput{Name: "Saga", Description: long}, field: "description"},
+		{name: "update_franchise", input: UpdateFranchiseInput{ID: 1, Name: "Saga", Description: long}, field: "description"},
+		{name: "add_translation", input: AddTranslationInput{MovieID: 1, Language: "fr", Description: long}, field: "description"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input.Validate()
+			if !errors.Is(err, shared.ErrValidation) || !strings.Contains(err.Error(), tt.field) {
+				t.Errorf("Expected a validation error naming %s, got: %v", tt.field, err)
+			}
+		})
+	}
+
+	if err := (AddActorInput{Name: "Actor", Bio: limit}).Validate(); err != nil {
+		t.Errorf("Expected text at the limit to be accepted, got: %v", err)
+	}
+}
+
+func TestSearchMoviesOutput_Truncate(t *testing.T) {
+	output := SearchMoviesOutput{
+		Movies: []MovieOutput{{ID: 1}, {ID: 2}, {ID: 3}},
+		Total:  3,
+	}
+
+	truncated := output.Truncate(2, middleware.Truncation{Returned: 2, Available: 3, MaxBytes: 100}).(SearchMoviesOutput)
+	if len(truncated.Movies) != 2 || truncated.Movies[1].ID != 2 || truncated.Total != 3 {
+		t.Errorf("Expected the first 2 of 3 movies, got: %+v", truncated)
+	}
+	if truncated.Truncation == nil || !strings.Contains(truncated.Truncation.Hint, "create_search_context") {
+		t.Errorf("Expected a hint pointing to search contexts, got: %+v", truncated.Truncation)
+	}
+	if len(output.Movies) != 3 || output.Truncation != nil {
+		t.Errorf("Expected the original output to be unchanged, got: %+v", output)
+	}
+}
@@ -0,0 +1,130 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/i18n"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+	"github.com/francknouama/movies-mcp-server/pkg/serialization"
+)
+
+// DatabaseMaintainer defines the interface for vacuuming and checking the database
+type DatabaseMaintainer interface {
+	Maintain(ctx context.Context, opts database.MaintenanceOptions) (*database.MaintenanceReport, error)
+	Last(ctx context.Context) *database.MaintenanceReport
+}
+
+// MaintenanceTools provides SDK-based MCP handlers for database maintenance
+type MaintenanceTools struct {
+	maintainer DatabaseMaintainer
+}
+
+// NewMaintenanceTools creates a new maintenance tools instance
+func NewMaintenanceTools(maintainer DatabaseMaintainer) *MaintenanceTools {
+	return &MaintenanceTools{
+		maintainer: maintainer,
+	}
+}
+
+// ===== maintain_database Tool =====
+
+// MaintainDatabaseInput defines the input schema for maintain_database tool
+type MaintainDatabaseInput struct {
+	SkipVacuum bool `json:"skip_vacuum,omitempty" jsonschema:"Check and analyze only; VACUUM rewrites the whole file and blocks writes while it runs"`
+	StatusOnly bool `json:"status_only,omitempty" jsonschema:"Report the last run of this library, scheduled or not, without running"`
+}
+
+// MaintenanceRunOutput defines the output schema for one maintenance run
+type MaintenanceRunOutput struct {
+	StartedAt       string   `json:"started_at" jsonschema:"Time the run started"`
+	DurationMS      int64    `json:"duration_ms" jsonschema:"How long the run took in milliseconds"`
+	Tenant          string   `json:"tenant,omitempty" jsonschema:"Tenant whose library was maintained; absent for the default database"`
+	Scheduled       bool     `json:"scheduled" jsonschema:"Whether DB_MAINTENANCE_INTERVAL started the run"`
+	SizeBefore      int64    `json:"size_before" jsonschema:"Database size in bytes before the run"`
+	SizeAfter       int64    `json:"size_after" jsonschema:"Database size in bytes after the run"`
+	SpaceReclaimed  int64    `json:"space_reclaimed" jsonschema:"Bytes the run freed"`
+	FreePagesBefore int      `json:"free_pages_before" jsonschema:"Unused pages before the run"`
+	FreePagesAfter  int      `json:"free_pages_after" jsonschema:"Unused pages after the run"`
+	IntegrityOK     bool     `json:"integrity_ok" jsonschema:"Whether integrity_check and foreign_key_check found nothing"`
+	Issues          []string `json:"issues" jsonschema:"Problems the checks found; the database is not vacuumed when there are any"`
+	Analyzed        bool     `json:"analyzed" jsonschema:"Whether ANALYZE refreshed the query planner statistics"`
+	Vacuumed        bool     `json:"vacuumed" jsonschema:"Whether VACUUM rebuilt the file"`
+	Error           string   `json:"error,omitempty" jsonschema:"Why the run stopped early"`
+}
+
+// MaintainDatabaseOutput defines the output schema for maintain_database tool
+type MaintainDatabaseOutput struct {
+	Run      *MaintenanceRunOutput `json:"run,omitempty" jsonschema:"This call's run; absent with status_only"`
+	Previous *MaintenanceRunOutput `json:"previous,omitempty" jsonschema:"The run of this library before this call; absent when there was none"`
+}
+
+// newMaintenanceRunOutput converts a maintenance report to the output format
+func newMaintenanceRunOutput(report *database.MaintenanceReport) *MaintenanceRunOutput {
+	if report == nil {
+		return nil
+	}
+	return &MaintenanceRunOutput{
+		StartedAt:       serialization.Timestamp(report.StartedAt),
+		DurationMS:      report.Duration.Milliseconds(),
+		Tenant:          report.Tenant,
+		Scheduled:       report.Scheduled,
+		SizeBefore:      report.SizeBefore,
+		SizeAfter:       report.SizeAfter,
+		SpaceReclaimed:  report.Reclaimed(),
+		FreePagesBefore: report.FreePagesBefore,
+		FreePagesAfter:  report.FreePagesAfter,
+		IntegrityOK:     report.IntegrityOK,
+		Issues:          nonNilStrings(report.Issues),
+		Analyzed:        report.Analyzed,
+		Vacuumed:        report.Vacuumed,
+		Error:           report.Error,
+	}
+}
+
+// MaintainDatabase handles the maintain_database tool call. The library the
+// call is routed to is maintained, so tenants are maintained one at a time.
+func (t *MaintenanceTools) MaintainDatabase(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input MaintainDatabaseInput,
+) (*mcp.CallToolResult, MaintainDatabaseOutput, error) {
+	output := MaintainDatabaseOutput{Previous: newMaintenanceRunOutput(t.maintainer.Last(ctx))}
+	if input.StatusOnly {
+		if output.Previous == nil {
+			return summaryResult(ctx, output, "Database maintenance has not run yet"), output, nil
+		}
+		return summaryResult(ctx, output, "%s", maintenanceSummary(ctx, "Last maintenance", output.Previous)), output, nil
+	}
+
+	report, err := t.maintainer.Maintain(ctx, database.MaintenanceOptions{SkipVacuum: input.SkipVacuum})
+	if errors.Is(err, database.ErrMaintenanceRunning) {
+		return nil, MaintainDatabaseOutput{}, shared.NewConflictError("database maintenance is already running")
+	}
+	if err != nil {
+		return nil, MaintainDatabaseOutput{}, fmt.Errorf("failed to maintain database: %w", err)
+	}
+
+	output.Run = newMaintenanceRunOutput(report)
+	return summaryResult(ctx, output, "%s", maintenanceSummary(ctx, "Maintained database", output.Run)), output, nil
+}
+
+// maintenanceSummary describes a run in one line after a translated label
+func maintenanceSummary(ctx context.Context, label string, run *MaintenanceRunOutput) string {
+	p := i18n.FromContext(ctx)
+	if run.Error != "" {
+		return p.Sprintf("%s failed: %s", p.Translate(label), run.Error)
+	}
+	summary := p.Sprintf("%s: reclaimed %d bytes", p.Translate(label), run.SpaceReclaimed)
+	if !run.Vacuumed {
+		summary += p.Translate(", not vacuumed")
+	}
+	if !run.IntegrityOK {
+		summary += p.Sprintf("; %s found", countNoun(ctx, len(run.Issues), "issue", "issues"))
+	}
+	return summary
+}
@@ -0,0 +1,107 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+)
+
+// MockDatabaseMaintainer is a mock implementation of DatabaseMaintainer
+type MockDatabaseMaintainer struct {
+	MaintainFunc func(ctx context.Context, opts database.MaintenanceOptions) (*database.MaintenanceReport, error)
+	LastReport   *database.MaintenanceReport
+}
+
+func (m *MockDatabaseMaintainer) Maintain(ctx context.Context, opts database.MaintenanceOptions) (*database.MaintenanceReport, error) {
+	if m.MaintainFunc != nil {
+		return m.MaintainFunc(ctx, opts)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockDatabaseMaintainer) Last(ctx context.Context) *database.MaintenanceReport {
+	return m.LastReport
+}
+
+func TestMaintainDatabase_ReportsRun(t *testing.T) {
+	var got database.MaintenanceOptions
+	maintainer := &MockDatabaseMaintainer{
+		MaintainFunc: func(ctx context.Context, opts database.MaintenanceOptions) (*database.MaintenanceReport, error) {
+			got = opts
+			return &database.MaintenanceReport{
+				StartedAt:   time.Date(2026, 3, 14, 12, 0, 0, 0, time.UTC),
+				Duration:    1500 * time.Millisecond,
+				SizeBefore:  8192,
+				SizeAfter:   4096,
+				IntegrityOK: true,
+				Analyzed:    true,
+				Vacuumed:    true,
+			}, nil
+		},
+		LastReport: &database.MaintenanceReport{Scheduled: true, IntegrityOK: true},
+	}
+	tools := NewMaintenanceTools(maintainer)
+
+	result, output, err := tools.MaintainDatabase(context.Background(), nil, MaintainDatabaseInput{SkipVacuum: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !got.SkipVacuum || got.Scheduled {
+		t.Errorf("Expected an unscheduled run skipping the vacuum, got: %+v", got)
+	}
+	if output.Run == nil || output.Run.SpaceReclaimed != 4096 || output.Run.DurationMS != 1500 || output.Run.Issues == nil {
+		t.Errorf("Unexpected run %+v", output.Run)
+	}
+	if output.Previous == nil || !output.Previous.Scheduled {
+		t.Errorf("Expected the scheduled run as previous, got: %+v", output.Previous)
+	}
+	if text := result.Content[1].(*mcp.TextContent).Text; text != "Maintained database: reclaimed 4096 bytes" {
+		t.Errorf("Unexpected summary %q", text)
+	}
+}
+
+func TestMaintainDatabase_StatusOnly(t *testing.T) {
+	maintainer := &MockDatabaseMaintainer{
+		MaintainFunc: func(ctx context.Context, opts database.MaintenanceOptions) (*database.MaintenanceReport, error) {
+			t.Fatal("Expected status_only not to run maintenance")
+			return nil, nil
+		},
+	}
+	tools := NewMaintenanceTools(maintainer)
+
+	result, output, err := tools.MaintainDatabase(context.Background(), nil, MaintainDatabaseInput{StatusOnly: true})
+	if err != nil || output.Run != nil || output.Previous != nil {
+		t.Fatalf("Expected an empty status, got: %+v, %v", output, err)
+	}
+	if text := result.Content[1].(*mcp.TextContent).Text; text != "Database maintenance has not run yet" {
+		t.Errorf("Unexpected summary %q", text)
+	}
+
+	maintainer.LastReport = &database.MaintenanceReport{Analyzed: true, Issues: []string{"movie_actors row 1 references a missing movies row"}}
+	result, output, err = tools.MaintainDatabase(context.Background(), nil, MaintainDatabaseInput{StatusOnly: true})
+	if err != nil || output.Previous == nil {
+		t.Fatalf("Expected the last run, got: %+v, %v", output, err)
+	}
+	if text := result.Content[1].(*mcp.TextContent).Text; text != "Last maintenance: reclaimed 0 bytes, not vacuumed; 1 issue found" {
+		t.Errorf("Unexpected summary %q", text)
+	}
+}
+
+func TestMaintainDatabase_AlreadyRunning(t *testing.T) {
+	maintainer := &MockDatabaseMaintainer{
+		MaintainFunc: func(ctx context.Context, opts database.MaintenanceOptions) (*database.MaintenanceReport, error) {
+			return nil, database.ErrMaintenanceRunning
+		},
+	}
+
+	_, _, err := NewMaintenanceTools(maintainer).MaintainDatabase(context.Background(), nil, MaintainDatabaseInput{})
+	if !errors.Is(err, shared.ErrConflict) {
+		t.Errorf("Expected a conflict error, got: %v", err)
+	}
+}
@@ -0,0 +1,155 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	mediaApp "github.com/francknouama/movies-mcp-server/internal/application/media"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/i18n"
+)
+
+// MediaService defines the interface for movie media operations
+type MediaService interface {
+	AddMedia(ctx context.Context, cmd mediaApp.AddMediaCommand) (*mediaApp.MediaDTO, error)
+	GetMovieMedia(ctx context.Context, movieID int, mediaType string) (*mediaApp.MovieMediaDTO, error)
+}
+
+// TrailerFinder looks up the URL of a movie's primary trailer
+type TrailerFinder interface {
+	PrimaryTrailerURL(ctx context.Context, movieID int) (string, error)
+}
+
+// MediaTools provides SDK-based MCP handlers for movie media
+type MediaTools struct {
+	mediaService MediaService
+}
+
+// NewMediaTools creates a new media tools instance
+func NewMediaTools(mediaService MediaService) *MediaTools {
+	return &MediaTools{
+		mediaService: mediaService,
+	}
+}
+
+// MediaOutput defines the output schema for a trailer, clip or still
+type MediaOutput struct {
+	ID       int    `json:"id" jsonschema:"Media ID"`
+	MovieID  int    `json:"movie_id" jsonschema:"Movie ID"`
+	Type     string `json:"type" jsonschema:"Media type (trailer/teaser/clip/still)"`
+	URL      string `json:"url" jsonschema:"Link to the media"`
+	Provider string `json:"provider" jsonschema:"Where the media is hosted (e.g. YouTube)"`
+	Title    string `json:"title,omitempty" jsonschema:"Media title"`
+	Primary  bool   `json:"primary" jsonschema:"Whether this is the movie's primary trailer"`
+}
+
+// newMediaOutput converts a media DTO to the output format
+func newMediaOutput(dto *mediaApp.MediaDTO) MediaOutput {
+	return MediaOutput{
+		ID:       dto.ID,
+		MovieID:  dto.MovieID,
+		Type:     dto.Type,
+		URL:      dto.URL,
+		Provider: dto.Provider,
+		Title:    dto.Title,
+		Primary:  dto.Primary,
+	}
+}
+
+// ===== add_movie_media Tool =====
+
+// AddMovieMediaInput defines the input schema for add_movie_media tool
+type AddMovieMediaInput struct {
+	MovieID  int    `json:"movie_id" jsonschema:"Movie ID"`
+	URL      string `json:"url" jsonschema:"HTTP(S) link to the media"`
+	Type     string `json:"type,omitempty" jsonschema:"Media type (trailer/teaser/clip/still; default trailer)"`
+	Provider string `json:"provider,omitempty" jsonschema:"Where the media is hosted; derived from the URL if omitted"`
+	Title    string `json:"title,omitempty" jsonschema:"Media title (e.g. Official Trailer)"`
+	Primary  bool   `json:"primary,omitempty" jsonschema:"Make this the movie's primary trailer (trailers only)"`
+}
+
+// Validate requires a media URL
+func (in AddMovieMediaInput) Validate() error {
+	if in.URL == "" {
+		return shared.NewValidationError("url is required")
+	}
+	return nil
+}
+
+// AddMovieMedia handles the add_movie_media tool call
+func (t *MediaTools) AddMovieMedia(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input AddMovieMediaInput,
+) (*mcp.CallToolResult, MediaOutput, error) {
+	dto, err := t.mediaService.AddMedia(ctx, mediaApp.AddMediaCommand{
+		MovieID:  input.MovieID,
+		Type:     input.Type,
+		URL:      input.URL,
+		Provider: input.Provider,
+		Title:    input.Title,
+		Primary:  input.Primary,
+	})
+	if err != nil {
+		return nil, MediaOutput{}, fmt.Errorf("failed to add media: %w", err)
+	}
+
+	output := newMediaOutput(dto)
+	primary := ""
+	if output.Primary {
+		primary = i18n.FromContext(ctx).Translate(" (primary)")
+	}
+	return summaryResult(ctx, output, "Saved %s %s for movie %d on %s%s",
+		output.Type, output.URL, output.MovieID, output.Provider, primary), output, nil
+}
+
+// ===== get_movie_media Tool =====
+
+// GetMovieMediaInput defines the input schema for get_movie_media tool
+type GetMovieMediaInput struct {
+	MovieID int    `json:"movie_id" jsonschema:"Movie ID"`
+	Type    string `json:"type,omitempty" jsonschema:"Only return this media type (trailer/teaser/clip/still)"`
+}
+
+// GetMovieMediaOutput defines the output schema for get_movie_media tool
+type GetMovieMediaOutput struct {
+	MovieID           int           `json:"movie_id" jsonschema:"Movie ID"`
+	Title             string        `json:"title" jsonschema:"Movie title"`
+	Year              int           `json:"year" jsonschema:"Release year"`
+	PrimaryTrailerURL string        `json:"primary_trailer_url,omitempty" jsonschema:"URL of the movie's primary trailer"`
+	Media             []MediaOutput `json:"media" jsonschema:"Media ordered by type, primary trailer first"`
+	Total             int           `json:"total" jsonschema:"Number of media returned"`
+}
+
+// GetMovieMedia handles the get_movie_media tool call
+func (t *MediaTools) GetMovieMedia(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input GetMovieMediaInput,
+) (*mcp.CallToolResult, GetMovieMediaOutput, error) {
+	dto, err := t.mediaService.GetMovieMedia(ctx, input.MovieID, input.Type)
+	if err != nil {
+		return nil, GetMovieMediaOutput{}, fmt.Errorf("failed to get movie media: %w", err)
+	}
+
+	output := GetMovieMediaOutput{
+		MovieID:           dto.MovieID,
+		Title:             dto.Title,
+		Year:              dto.Year,
+		PrimaryTrailerURL: dto.PrimaryTrailerURL,
+		Media:             make([]MediaOutput, 0, len(dto.Media)),
+	}
+	for _, entry := range dto.Media {
+		output.Media = append(output.Media, newMediaOutput(entry))
+	}
+	output.Total = len(output.Media)
+
+	trailer := ""
+	if output.PrimaryTrailerURL != "" {
+		trailer = i18n.FromContext(ctx).Sprintf("; primary trailer %s", output.PrimaryTrailerURL)
+	}
+	return summaryResult(ctx, output, "%s (%d) has %s%s", output.Title, output.Year,
+		countNoun(ctx, output.Total, "media link", "media links"), trailer), output, nil
+}
@@ -0,0 +1,187 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	mediaApp "github.com/francknouama/movies-mcp-server/internal/application/media"
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+)
+
+// MockMediaService is a mock implementation of MediaService and TrailerFinder
+type MockMediaService struct {
+	AddMediaFunc          func(ctx context.Context, cmd mediaApp.AddMediaCommand) (*mediaApp.MediaDTO, error)
+	GetMovieMediaFunc     func(ctx context.Context, movieID int, mediaType string) (*mediaApp.MovieMediaDTO, error)
+	PrimaryTrailerURLFunc func(ctx context.Context, movieID int) (string, error)
+}
+
+func (m *MockMediaService) AddMedia(ctx context.Context, cmd mediaApp.AddMediaCommand) (*mediaApp.MediaDTO, error) {
+	if m.AddMediaFunc != nil {
+		return m.AddMediaFunc(ctx, cmd)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockMediaService) GetMovieMedia(ctx context.Context, movieID int, mediaType string) (*mediaApp.MovieMediaDTO, error) {
+	if m.GetMovieMediaFunc != nil {
+		return m.GetMovieMediaFunc(ctx, movieID, mediaType)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockMediaService) PrimaryTrailerURL(ctx context.Context, movieID int) (string, error) {
+	if m.PrimaryTrailerURLFunc != nil {
+		return m.PrimaryTrailerURLFunc(ctx, movieID)
+	}
+	return "", errors.New("not implemented")
+}
+
+func TestAddMovieMedia_Success(t *testing.T) {
+	var gotCmd mediaApp.AddMediaCommand
+	service := &MockMediaService{
+		AddMediaFunc: func(ctx context.Context, cmd mediaApp.AddMediaCommand) (*mediaApp.MediaDTO, error) {
+			gotCmd = cmd
+			return &mediaApp.MediaDTO{ID: 1, MovieID: cmd.MovieID, Type: "trailer", URL: cmd.URL, Provider: "YouTube", Primary: true}, nil
+		},
+	}
+	tools := NewMediaTools(service)
+
+	result, output, err := tools.AddMovieMedia(context.Background(), nil, AddMovieMediaInput{
+		MovieID: 1,
+		URL:     "https://youtu.be/abc",
+		Primary: true,
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if gotCmd.URL != "https://youtu.be/abc" || !gotCmd.Primary {
+		t.Errorf("Expected command to carry the input, got: %+v", gotCmd)
+	}
+	if output.Provider != "YouTube" || !output.Primary {
+		t.Errorf("Expected primary YouTube trailer, got: %+v", output)
+	}
+	assertSummaryResult(t, result)
+	summary := result.Content[1].(*mcp.TextContent).Text
+	if summary != "Saved trailer https://youtu.be/abc for movie 1 on YouTube (primary)" {
+		t.Errorf("Unexpected summary, got: %s", summary)
+	}
+}
+
+func TestAddMovieMedia_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input AddMovieMediaInput
+		err   error
+	}{
+		{name: "missing url", input: AddMovieMediaInput{MovieID: 1}},
+		{name: "service error", input: AddMovieMediaInput{MovieID: 1, URL: "ftp://example.com/a"}, err: errors.New("invalid media")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &MockMediaService{
+				AddMediaFunc: func(ctx context.Context, cmd mediaApp.AddMediaCommand) (*mediaApp.MediaDTO, error) {
+					return nil, tt.err
+				},
+			}
+			tools := NewMediaTools(service)
+
+			err := tt.input.Validate() // Run by the validation middleware before the handler
+			if err == nil {
+				_, _, err = tools.AddMovieMedia(context.Background(), nil, tt.input)
+			}
+			if err == nil {
+				t.Error("Expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestGetMovieMedia_Success(t *testing.T) {
+	var gotType string
+	service := &MockMediaService{
+		GetMovieMediaFunc: func(ctx context.Context, movieID int, mediaType string) (*mediaApp.MovieMediaDTO, error) {
+			gotType = mediaType
+			return &mediaApp.MovieMediaDTO{
+				MovieID:           movieID,
+				Title:             "Inception",
+				Year:              2010,
+				PrimaryTrailerURL: "https://youtu.be/abc",
+				Media: []*mediaApp.MediaDTO{
+					{ID: 1, MovieID: movieID, Type: "trailer", URL: "https://youtu.be/abc", Provider: "YouTube", Primary: true},
+					{ID: 2, MovieID: movieID, Type: "trailer", URL: "https://vimeo.com/1", Provider: "Vimeo"},
+				},
+			}, nil
+		},
+	}
+	tools := NewMediaTools(service)
+
+	result, output, err := tools.GetMovieMedia(context.Background(), nil, GetMovieMediaInput{MovieID: 1, Type: "trailer"})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if gotType != "trailer" {
+		t.Errorf("Expected type filter to reach the service, got: %s", gotType)
+	}
+	if output.Total != 2 || output.Media[1].Provider != "Vimeo" {
+		t.Errorf("Expected 2 media, got: %+v", output)
+	}
+	assertSummaryResult(t, result)
+	summary := result.Content[1].(*mcp.TextContent).Text
+	if summary != "Inception (2010) has 2 media links; primary trailer https://youtu.be/abc" {
+		t.Errorf("Unexpected summary, got: %s", summary)
+	}
+}
+
+func TestGetMovieMedia_ServiceError(t *testing.T) {
+	service := &MockMediaService{
+		GetMovieMediaFunc: func(ctx context.Context, movieID int, mediaType string) (*mediaApp.MovieMediaDTO, error) {
+			return nil, errors.New("movie not found")
+		},
+	}
+	tools := NewMediaTools(service)
+
+	_, _, err := tools.GetMovieMedia(context.Background(), nil, GetMovieMediaInput{MovieID: 99})
+
+	if err == nil || !strings.Contains(err.Error(), "failed to get movie media") {
+		t.Errorf("Expected get movie media error, got: %v", err)
+	}
+}
+
+func TestGetMovie_WithTrailer(t *testing.T) {
+	trailers := &MockMediaService{
+		PrimaryTrailerURLFunc: func(ctx context.Context, movieID int) (string, error) {
+			return "https://youtu.be/abc", nil
+		},
+	}
+	mockService := &MockMovieService{
+		GetMovieFunc: func(ctx context.Context, id int) (*movieApp.MovieDTO, error) {
+			return &movieApp.MovieDTO{ID: id, Title: "Inception", Director: "Christopher Nolan", Year: 2010}, nil
+		},
+	}
+
+	tools := NewMovieTools(mockService)
+	tools.SetTrailerFinder(trailers)
+
+	_, output, err := tools.GetMovie(context.Background(), nil, GetMovieInput{MovieID: 1})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.TrailerURL != "https://youtu.be/abc" {
+		t.Errorf("Expected primary trailer URL, got: %q", output.TrailerURL)
+	}
+
+	trailers.PrimaryTrailerURLFunc = func(ctx context.Context, movieID int) (string, error) {
+		return "", errors.New("database locked")
+	}
+	if _, _, err := tools.GetMovie(context.Background(), nil, GetMovieInput{MovieID: 1}); err == nil {
+		t.Error("Expected error when the trailer lookup fails")
+	}
+}
@@ -2,19 +2,30 @@ package tools
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"hash/fnv"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 
 	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	posterApp "github.com/francknouama/movies-mcp-server/internal/application/poster"
+	"github.com/francknouama/movies-mcp-server/internal/domain/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/i18n"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/middleware"
 )
 
 // MovieService defines the interface for movie operations
 type MovieService interface {
 	GetMovie(ctx context.Context, id int) (*movieApp.MovieDTO, error)
 	CreateMovie(ctx context.Context, cmd movieApp.CreateMovieCommand) (*movieApp.MovieDTO, error)
+	CreateMovies(ctx context.Context, cmds []movieApp.CreateMovieCommand) ([]*movieApp.MovieDTO, []error, error)
 	UpdateMovie(ctx context.Context, cmd movieApp.UpdateMovieCommand) (*movieApp.MovieDTO, error)
 	DeleteMovie(ctx context.Context, id int) error
 	SearchMovies(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error)
@@ -24,6 +35,19 @@ type MovieService interface {
 // MovieTools provides SDK-based MCP handlers for movie operations
 type MovieTools struct {
 	movieService MovieService
+	writeBarrier WriteBarrier
+	localizer    MovieLocalizer
+	trailers     TrailerFinder
+	preferences  *PreferenceStore
+	access       AccessRecorder
+	interactive  bool
+	posters      StoredPosterReader
+	posterSigner PosterURLSigner
+}
+
+// StoredPosterReader provides movies' stored poster images
+type StoredPosterReader interface {
+	GetPoster(ctx context.Context, movieID int) (*posterApp.PosterImageDTO, error)
 }
 
 // NewMovieTools creates a new movie tools instance
@@ -33,82 +57,289 @@ func NewMovieTools(movieService MovieService) *MovieTools {
 	}
 }
 
+// SetWriteBarrier enables strong-consistency reads that wait for queued writes
+func (t *MovieTools) SetWriteBarrier(barrier WriteBarrier) {
+	t.writeBarrier = barrier
+}
+
+// SetLocalizer enables the language parameter on get_movie and search_movies
+func (t *MovieTools) SetLocalizer(localizer MovieLocalizer) {
+	t.localizer = localizer
+}
+
+// SetTrailerFinder adds the primary trailer URL to get_movie output
+func (t *MovieTools) SetTrailerFinder(trailers TrailerFinder) {
+	t.trailers = trailers
+}
+
+// SetPreferenceStore makes search_movies default to the session's language
+// and leave out its disliked directors
+func (t *MovieTools) SetPreferenceStore(store *PreferenceStore) {
+	t.preferences = store
+}
+
+// SetAccessRecorder counts the movies get_movie, search_movies,
+// search_by_decade and search_by_rating_range return towards their popularity
+func (t *MovieTools) SetAccessRecorder(access AccessRecorder) {
+	t.access = access
+}
+
+// SetPosterCDN adds the CDN URL of a movie's stored poster to get_movie
+// output
+func (t *MovieTools) SetPosterCDN(posters StoredPosterReader, signer PosterURLSigner) {
+	t.posters = posters
+	t.posterSigner = signer
+}
+
+// SetInteractive lets delete_movie ask the client's user which movie a
+// title means when it matches several
+func (t *MovieTools) SetInteractive(enabled bool) {
+	t.interactive = enabled
+}
+
+// ===== Movie Output Type (shared) =====
+
+// MovieOutput defines the common output schema for movie data. Every tool that
+// returns a movie uses this struct so their published output schemas stay in sync.
+type MovieOutput struct {
+	ID        int      `json:"id" jsonschema:"Movie ID"`
+	Title     string   `json:"title" jsonschema:"Movie title"`
+	Director  string   `json:"director" jsonschema:"Movie director"`
+	Year      int      `json:"year" jsonschema:"Release year"`
+	Rating    float64  `json:"rating,omitempty" jsonschema:"Movie rating (0-10)"`
+	Genres    []string `json:"genres" jsonschema:"List of genres"`
+	PosterURL string   `json:"poster_url,omitempty" jsonschema:"URL to movie poster"`
+	CreatedAt string   `json:"created_at" jsonschema:"Creation timestamp"`
+	UpdatedAt string   `json:"updated_at" jsonschema:"Last update timestamp"`
+
+	ReleaseDate string `json:"release_date,omitempty" jsonschema:"Release date (YYYY-MM-DD), when known to the day; year always holds the release year"`
+	Duration    int    `json:"duration,omitempty" jsonschema:"Runtime in minutes, when known"`
+
+	Certifications  map[string]string `json:"certifications,omitempty" jsonschema:"Age ratings keyed by region code (e.g. US: PG-13)"`
+	ContentWarnings []string          `json:"content_warnings,omitempty" jsonschema:"Content warnings such as violence or strong language"`
+
+	Similarity float64 `json:"similarity,omitempty" jsonschema:"Fuzzy match score (0-1), only set by fuzzy searches"`
+	Score      float64 `json:"score,omitempty" jsonschema:"Weighted ranking score the results are ordered by, only set by searches weighting ranking signals"`
+	TrailerURL string  `json:"trailer_url,omitempty" jsonschema:"URL of the primary trailer, only set by get_movie"`
+
+	// Set only by get_movie in poster CDN mode, when the movie has a stored poster
+	StoredPosterURL       string `json:"stored_poster_url,omitempty" jsonschema:"CDN URL of the stored poster image"`
+	StoredPosterExpiresAt string `json:"stored_poster_expires_at,omitempty" jsonschema:"When a signed stored_poster_url stops working"`
+
+	// Set only by add_movie and update_movie when the rating was given on a 5- or 100-point scale
+	RatingScale  int     `json:"rating_scale,omitempty" jsonschema:"Scale the rating was given in (5 or 100)"`
+	ScaledRating float64 `json:"scaled_rating,omitempty" jsonschema:"Rating on rating_scale"`
+
+	// Set only when a language was requested and the movie has a translation
+	Description       string `json:"description,omitempty" jsonschema:"Localized description"`
+	DescriptionSource string `json:"description_source,omitempty" jsonschema:"Where the description came from: imported or generated"`
+	Language          string `json:"language,omitempty" jsonschema:"Language of the localized title and description"`
+	OriginalTitle     string `json:"original_title,omitempty" jsonschema:"Title before localization, when the localized title differs"`
+
+	Explanation *ExplanationOutput `json:"explanation,omitempty" jsonschema:"Why the movie matched and where it sorts, only set by searches with explain"`
+
+	fields []string // Fields the tool call selected; nil serializes every field
+}
+
+// ExplanationOutput defines the output schema for why a search result matched
+type ExplanationOutput struct {
+	Matched []CriterionMatchOutput `json:"matched" jsonschema:"How the movie satisfies each criterion the search applied"`
+	Sort    []SortValueOutput      `json:"sort" jsonschema:"The movie's value for each sort key, in order"`
+	Score   float64                `json:"score,omitempty" jsonschema:"Score the results are ranked by: the fuzzy title similarity plus each weighted ranking signal"`
+	Signals []SignalScoreOutput    `json:"signals,omitempty" jsonschema:"The movie's score for each weighted ranking signal, only set by searches weighting ranking signals"`
+	Rank    int                    `json:"rank" jsonschema:"1-based position in the results, counting skipped pages"`
+}
+
+// SignalScoreOutput defines the output schema for a movie's score for one ranking signal
+type SignalScoreOutput struct {
+	Signal string  `json:"signal" jsonschema:"Ranking signal (similarity/recency/rating/popularity/trending)"`
+	Weight float64 `json:"weight" jsonschema:"Weight the signal counts for"`
+	Value  float64 `json:"value" jsonschema:"The movie's score for the signal (0-1), before weighting"`
+}
+
+// CriterionMatchOutput defines the output schema for one matched criterion
+type CriterionMatchOutput struct {
+	Criterion string `json:"criterion" jsonschema:"Criterion (title/director/genre/year/rating/release_date/duration/certification/content_warnings)"`
+	Detail    string `json:"detail" jsonschema:"How the movie matched, e.g. 8.7 is at least 8"`
+}
+
+// SortValueOutput defines the output schema for a movie's value of one sort key
+type SortValueOutput struct {
+	Field     string `json:"field" jsonschema:"Sort field"`
+	Direction string `json:"direction" jsonschema:"Sort direction (asc/desc)"`
+	Value     string `json:"value" jsonschema:"The movie's value for the field"`
+}
+
+// newExplanationOutput converts a search explanation to the output format
+func newExplanationOutput(dto *movieApp.ExplanationDTO) *ExplanationOutput {
+	if dto == nil {
+		return nil
+	}
+
+	output := &ExplanationOutput{
+		Matched: make([]CriterionMatchOutput, len(dto.Matched)),
+		Sort:    make([]SortValueOutput, len(dto.Sort)),
+		Score:   dto.Score,
+		Rank:    dto.Rank,
+	}
+	for i, match := range dto.Matched {
+		output.Matched[i] = CriterionMatchOutput{Criterion: match.Criterion, Detail: match.Detail}
+	}
+	for i, value := range dto.Sort {
+		output.Sort[i] = SortValueOutput{Field: value.Field, Direction: value.Direction, Value: value.Value}
+	}
+	for _, signal := range dto.Signals {
+		output.Signals = append(output.Signals, SignalScoreOutput{Signal: signal.Signal, Weight: signal.Weight, Value: signal.Value})
+	}
+	return output
+}
+
+// newMovieOutput converts a movie DTO to the shared output format
+func newMovieOutput(movieDTO *movieApp.MovieDTO) MovieOutput {
+	return MovieOutput{
+		ID:        movieDTO.ID,
+		Title:     movieDTO.Title,
+		Director:  movieDTO.Director,
+		Year:      movieDTO.Year,
+		Rating:    movieDTO.Rating,
+		Genres:    nonNilStrings(movieDTO.Genres),
+		PosterURL: movieDTO.PosterURL,
+		CreatedAt: movieDTO.CreatedAt,
+		UpdatedAt: movieDTO.UpdatedAt,
+
+		Certifications:  movieDTO.Certifications,
+		ContentWarnings: movieDTO.ContentWarnings,
+		ReleaseDate:     movieDTO.ReleaseDate,
+		Duration:        movieDTO.Duration,
+
+		Similarity:  movieDTO.Similarity,
+		Score:       movieDTO.Score,
+		Explanation: newExplanationOutput(movieDTO.Explanation),
+	}
+}
+
+// newMovieOutputs converts a list of movie DTOs to the shared output format
+func newMovieOutputs(movieDTOs []*movieApp.MovieDTO) []MovieOutput {
+	movies := make([]MovieOutput, len(movieDTOs))
+	for i, movieDTO := range movieDTOs {
+		movies[i] = newMovieOutput(movieDTO)
+	}
+	return movies
+}
+
 // ===== get_movie Tool =====
 
 // GetMovieInput defines the input schema for get_movie tool
 type GetMovieInput struct {
-	MovieID int `json:"movie_id" jsonschema:"required,description=The movie ID to retrieve"`
+	MovieID     int      `json:"movie_id" jsonschema:"The movie ID to retrieve"`
+	Language    string   `json:"language,omitempty" jsonschema:"Preferred language code (e.g. fr or pt-BR) for the title and description"`
+	Consistency string   `json:"consistency,omitempty" jsonschema:"Read consistency (strong/relaxed; default relaxed)"`
+	Fields      []string `json:"fields,omitempty" jsonschema:"Movie fields to return, e.g. title, year and rating; id is always returned (default every field)"`
 }
 
-// GetMovieOutput defines the output schema for get_movie tool
-type GetMovieOutput struct {
-	ID        int      `json:"id" jsonschema:"description=Movie ID"`
-	Title     string   `json:"title" jsonschema:"description=Movie title"`
-	Director  string   `json:"director" jsonschema:"description=Movie director"`
-	Year      int      `json:"year" jsonschema:"description=Release year"`
-	Rating    float64  `json:"rating,omitempty" jsonschema:"description=Movie rating (0-10)"`
-	Genres    []string `json:"genres" jsonschema:"description=List of genres"`
-	PosterURL string   `json:"poster_url,omitempty" jsonschema:"description=URL to movie poster"`
-	CreatedAt string   `json:"created_at" jsonschema:"description=Creation timestamp"`
-	UpdatedAt string   `json:"updated_at" jsonschema:"description=Last update timestamp"`
+// Validate checks the selected fields exist
+func (in GetMovieInput) Validate() error {
+	return validateMovieFields(in.Fields)
 }
 
+// GetMovieOutput defines the output schema for get_movie tool
+type GetMovieOutput = MovieOutput
+
 // GetMovie handles the get_movie tool call with SDK-compatible signature
 func (t *MovieTools) GetMovie(
 	ctx context.Context,
 	req *mcp.CallToolRequest,
 	input GetMovieInput,
 ) (*mcp.CallToolResult, GetMovieOutput, error) {
+	if err := ensureConsistency(ctx, t.writeBarrier, input.Consistency); err != nil {
+		return nil, GetMovieOutput{}, err
+	}
+
 	// Get movie from service
 	movieDTO, err := t.movieService.GetMovie(ctx, input.MovieID)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return nil, GetMovieOutput{}, fmt.Errorf("movie not found")
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil, GetMovieOutput{}, shared.NewNotFoundError("movie not found")
 		}
 		return nil, GetMovieOutput{}, fmt.Errorf("failed to get movie: %w", err)
 	}
 
 	// Convert to output format
-	output := GetMovieOutput{
-		ID:        movieDTO.ID,
-		Title:     movieDTO.Title,
-		Director:  movieDTO.Director,
-		Year:      movieDTO.Year,
-		Rating:    movieDTO.Rating,
-		Genres:    movieDTO.Genres,
-		PosterURL: movieDTO.PosterURL,
-		CreatedAt: movieDTO.CreatedAt,
-		UpdatedAt: movieDTO.UpdatedAt,
+	output := newMovieOutput(movieDTO)
+
+	localized := []MovieOutput{output}
+	if err := localizeMovies(ctx, t.localizer, input.Language, localized); err != nil {
+		return nil, GetMovieOutput{}, err
+	}
+	output = localized[0]
+
+	if t.trailers != nil {
+		output.TrailerURL, err = t.trailers.PrimaryTrailerURL(ctx, output.ID)
+		if err != nil {
+			return nil, GetMovieOutput{}, fmt.Errorf("failed to get trailer: %w", err)
+		}
+	}
+	if t.posterSigner != nil {
+		if err := t.addStoredPosterURL(ctx, &output); err != nil {
+			return nil, GetMovieOutput{}, err
+		}
+	}
+
+	recordView(ctx, t.access, output.ID)
+	if len(input.Fields) > 0 {
+		output.fields = input.Fields
+	}
+	return summaryResult(ctx, output, "Movie %d: %s directed by %s", output.ID, movieLabel(output), output.Director), output, nil
+}
+
+// addStoredPosterURL sets the CDN URL of the movie's stored poster, if it
+// has one
+func (t *MovieTools) addStoredPosterURL(ctx context.Context, output *MovieOutput) error {
+	poster, err := t.posters.GetPoster(ctx, output.ID)
+	if errors.Is(err, shared.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get poster: %w", err)
 	}
 
-	return nil, output, nil
+	var expiresAt time.Time
+	output.StoredPosterURL, expiresAt = t.posterSigner.SignedURL(poster)
+	if !expiresAt.IsZero() {
+		output.StoredPosterExpiresAt = expiresAt.UTC().Format(time.RFC3339)
+	}
+	return nil
 }
 
 // ===== add_movie Tool =====
 
 // AddMovieInput defines the input schema for add_movie tool
 type AddMovieInput struct {
-	Title     string   `json:"title" jsonschema:"required,description=Movie title"`
-	Director  string   `json:"director" jsonschema:"required,description=Movie director"`
-	Year      int      `json:"year" jsonschema:"required,description=Release year"`
-	Rating    float64  `json:"rating,omitempty" jsonschema:"description=Movie rating (0-10),minimum=0,maximum=10"`
-	Genres    []string `json:"genres,omitempty" jsonschema:"description=List of genres"`
-	PosterURL string   `json:"poster_url,omitempty" jsonschema:"description=URL to movie poster"`
+	Title     string   `json:"title" jsonschema:"Movie title"`
+	Director  string   `json:"director" jsonschema:"Movie director"`
+	Year      int      `json:"year,omitempty" jsonschema:"Release year; may be left out when release_date is given"`
+	Rating    float64  `json:"rating,omitempty" jsonschema:"Movie rating, on scale"`
+	Scale     int      `json:"scale,omitempty" jsonschema:"Scale rating is given in: 5 (stars), 10 or 100 (percent); default 10. Ratings are stored on 0-10"`
+	Genres    []string `json:"genres,omitempty" jsonschema:"List of genres"`
+	PosterURL string   `json:"poster_url,omitempty" jsonschema:"URL to movie poster"`
+
+	Certifications  map[string]string `json:"certifications,omitempty" jsonschema:"Age ratings keyed by region (US: MPAA G/PG/PG-13/R/NC-17; GB: BBFC U/PG/12A/12/15/18/R18)"`
+	ContentWarnings []string          `json:"content_warnings,omitempty" jsonschema:"Content warnings such as violence or strong language"`
+	ReleaseDate     string            `json:"release_date,omitempty" jsonschema:"Release date (YYYY-MM-DD), in year when both are given"`
+	Duration        int               `json:"duration,omitempty" jsonschema:"Runtime in minutes"`
 }
 
-// AddMovieOutput defines the output schema for add_movie tool
-type AddMovieOutput struct {
-	ID        int      `json:"id" jsonschema:"description=Created movie ID"`
-	Title     string   `json:"title" jsonschema:"description=Movie title"`
-	Director  string   `json:"director" jsonschema:"description=Movie director"`
-	Year      int      `json:"year" jsonschema:"description=Release year"`
-	Rating    float64  `json:"rating,omitempty" jsonschema:"description=Movie rating"`
-	Genres    []string `json:"genres" jsonschema:"description=List of genres"`
-	PosterURL string   `json:"poster_url,omitempty" jsonschema:"description=URL to movie poster"`
-	CreatedAt string   `json:"created_at" jsonschema:"description=Creation timestamp"`
-	UpdatedAt string   `json:"updated_at" jsonschema:"description=Last update timestamp"`
+// Validate checks the release date and the rating against its scale
+func (in AddMovieInput) Validate() error {
+	if _, err := parseMovieReleaseDate(in.ReleaseDate); err != nil {
+		return err
+	}
+	return validateRatingScale(in.Rating, in.Scale)
 }
 
+// AddMovieOutput defines the output schema for add_movie tool
+type AddMovieOutput = MovieOutput
+
 // AddMovie handles the add_movie tool call
 func (t *MovieTools) AddMovie(
 	ctx context.Context,
@@ -117,12 +348,17 @@ func (t *MovieTools) AddMovie(
 ) (*mcp.CallToolResult, AddMovieOutput, error) {
 	// Create movie command
 	cmd := movieApp.CreateMovieCommand{
-		Title:     input.Title,
-		Director:  input.Director,
-		Year:      input.Year,
-		Rating:    input.Rating,
-		Genres:    input.Genres,
-		PosterURL: input.PosterURL,
+		Title:       input.Title,
+		Director:    input.Director,
+		Year:        input.Year,
+		ReleaseDate: movieReleaseDate(input.ReleaseDate),
+		Rating:      normalizeRating(input.Rating, input.Scale),
+		Duration:    input.Duration,
+		Genres:      input.Genres,
+		PosterURL:   input.PosterURL,
+
+		Certifications:  input.Certifications,
+		ContentWarnings: input.ContentWarnings,
 	}
 
 	// Create movie
@@ -131,100 +367,258 @@ func (t *MovieTools) AddMovie(
 		return nil, AddMovieOutput{}, fmt.Errorf("failed to create movie: %w", err)
 	}
 
-	// Convert to output format
-	output := AddMovieOutput{
-		ID:        movieDTO.ID,
-		Title:     movieDTO.Title,
-		Director:  movieDTO.Director,
-		Year:      movieDTO.Year,
-		Rating:    movieDTO.Rating,
-		Genres:    movieDTO.Genres,
-		PosterURL: movieDTO.PosterURL,
-		CreatedAt: movieDTO.CreatedAt,
-		UpdatedAt: movieDTO.UpdatedAt,
+	// Convert to output format, echoing the rating on the caller's scale
+	output := withRatingScale(newMovieOutput(movieDTO), input.Scale)
+
+	return summaryResult(ctx, output, "Added movie %d: %s directed by %s", output.ID, movieLabel(output), output.Director), output, nil
+}
+
+// parseMovieReleaseDate parses a movie's YYYY-MM-DD release date; an empty
+// date is the zero time
+func parseMovieReleaseDate(releaseDate string) (time.Time, error) {
+	if releaseDate == "" {
+		return time.Time{}, nil
 	}
+	date, err := time.Parse(time.DateOnly, releaseDate)
+	if err != nil {
+		return time.Time{}, shared.NewValidationError("invalid release_date %q (use YYYY-MM-DD)", releaseDate)
+	}
+	return date, nil
+}
 
-	return nil, output, nil
+// movieReleaseDate parses a release date already checked by Validate
+func movieReleaseDate(releaseDate string) time.Time {
+	date, _ := parseMovieReleaseDate(releaseDate)
+	return date
 }
 
 // ===== update_movie Tool =====
 
-// UpdateMovieInput defines the input schema for update_movie tool
+// UpdateMovieInput defines the input schema for update_movie tool. Fields
+// left out keep their current value; fields sent empty are cleared.
 type UpdateMovieInput struct {
-	ID        int      `json:"id" jsonschema:"required,description=Movie ID"`
-	Title     string   `json:"title" jsonschema:"required,description=Movie title"`
-	Director  string   `json:"director" jsonschema:"required,description=Movie director"`
-	Year      int      `json:"year" jsonschema:"required,description=Release year"`
-	Rating    float64  `json:"rating,omitempty" jsonschema:"description=Movie rating (0-10),minimum=0,maximum=10"`
-	Genres    []string `json:"genres,omitempty" jsonschema:"description=List of genres"`
-	PosterURL string   `json:"poster_url,omitempty" jsonschema:"description=URL to movie poster"`
+	ID        int      `json:"id" jsonschema:"Movie ID"`
+	Title     *string  `json:"title,omitempty" jsonschema:"Movie title"`
+	Director  *string  `json:"director,omitempty" jsonschema:"Movie director"`
+	Year      *int     `json:"year,omitempty" jsonschema:"Release year; follows release_date when only the date is given"`
+	Rating    *float64 `json:"rating,omitempty" jsonschema:"Movie rating, on scale; 0 clears it"`
+	Scale     int      `json:"scale,omitempty" jsonschema:"Scale rating is given in: 5 (stars), 10 or 100 (percent); default 10. Ratings are stored on 0-10"`
+	Genres    []string `json:"genres,omitzero" jsonschema:"List of genres, replacing the current ones; [] clears them"`
+	PosterURL *string  `json:"poster_url,omitempty" jsonschema:"URL to movie poster; empty removes it"`
+
+	Certifications  map[string]string `json:"certifications,omitzero" jsonschema:"Age ratings keyed by region (US: MPAA G/PG/PG-13/R/NC-17; GB: BBFC U/PG/12A/12/15/18/R18), replacing the current ones; {} clears them"`
+	ContentWarnings []string          `json:"content_warnings,omitzero" jsonschema:"Content warnings such as violence or strong language, replacing the current ones; [] clears them"`
+	ReleaseDate     *string           `json:"release_date,omitempty" jsonschema:"Release date (YYYY-MM-DD), in year when both are given; empty clears it"`
+	Duration        *int              `json:"duration,omitempty" jsonschema:"Runtime in minutes; 0 clears it"`
 }
 
-// UpdateMovieOutput defines the output schema for update_movie tool
-type UpdateMovieOutput struct {
-	ID        int      `json:"id" jsonschema:"description=Updated movie ID"`
-	Title     string   `json:"title" jsonschema:"description=Movie title"`
-	Director  string   `json:"director" jsonschema:"description=Movie director"`
-	Year      int      `json:"year" jsonschema:"description=Release year"`
-	Rating    float64  `json:"rating,omitempty" jsonschema:"description=Movie rating"`
-	Genres    []string `json:"genres" jsonschema:"description=List of genres"`
-	PosterURL string   `json:"poster_url,omitempty" jsonschema:"description=URL to movie poster"`
-	CreatedAt string   `json:"created_at" jsonschema:"description=Creation timestamp"`
-	UpdatedAt string   `json:"updated_at" jsonschema:"description=Last update timestamp"`
+// Validate checks the release date and the rating against its scale
+func (in UpdateMovieInput) Validate() error {
+	if in.ReleaseDate != nil {
+		if _, err := parseMovieReleaseDate(*in.ReleaseDate); err != nil {
+			return err
+		}
+	}
+	if in.Rating != nil {
+		return validateRatingScale(*in.Rating, in.Scale)
+	}
+	return validateRatingScale(0, in.Scale)
 }
 
+// UpdateMovieOutput defines the output schema for update_movie tool
+type UpdateMovieOutput = MovieOutput
+
 // UpdateMovie handles the update_movie tool call
 func (t *MovieTools) UpdateMovie(
 	ctx context.Context,
 	req *mcp.CallToolRequest,
 	input UpdateMovieInput,
 ) (*mcp.CallToolResult, UpdateMovieOutput, error) {
-	// Create update command
+	// Create update command, leaving out the fields the caller left out
 	cmd := movieApp.UpdateMovieCommand{
 		ID:        input.ID,
 		Title:     input.Title,
 		Director:  input.Director,
 		Year:      input.Year,
-		Rating:    input.Rating,
+		Duration:  input.Duration,
 		Genres:    input.Genres,
 		PosterURL: input.PosterURL,
+
+		Certifications:  input.Certifications,
+		ContentWarnings: input.ContentWarnings,
+	}
+	if input.ReleaseDate != nil {
+		releaseDate := movieReleaseDate(*input.ReleaseDate)
+		cmd.ReleaseDate = &releaseDate
+	}
+	if input.Rating != nil {
+		rating := normalizeRating(*input.Rating, input.Scale)
+		cmd.Rating = &rating
 	}
 
 	// Update movie
 	movieDTO, err := t.movieService.UpdateMovie(ctx, cmd)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return nil, UpdateMovieOutput{}, fmt.Errorf("movie not found")
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil, UpdateMovieOutput{}, shared.NewNotFoundError("movie not found")
 		}
 		return nil, UpdateMovieOutput{}, fmt.Errorf("failed to update movie: %w", err)
 	}
 
-	// Convert to output format
-	output := UpdateMovieOutput{
-		ID:        movieDTO.ID,
-		Title:     movieDTO.Title,
-		Director:  movieDTO.Director,
-		Year:      movieDTO.Year,
-		Rating:    movieDTO.Rating,
-		Genres:    movieDTO.Genres,
-		PosterURL: movieDTO.PosterURL,
-		CreatedAt: movieDTO.CreatedAt,
-		UpdatedAt: movieDTO.UpdatedAt,
+	// Convert to output format, echoing the rating on the caller's scale
+	output := withRatingScale(newMovieOutput(movieDTO), input.Scale)
+
+	return summaryResult(ctx, output, "Updated movie %d: %s directed by %s", output.ID, movieLabel(output), output.Director), output, nil
+}
+
+// ===== add_or_update_movie Tool =====
+
+// AddOrUpdateMovieInput defines the input schema for add_or_update_movie
+// tool. The movie is matched by title and year; when it exists, fields left
+// out keep their current value.
+type AddOrUpdateMovieInput struct {
+	Title     string   `json:"title" jsonschema:"Movie title, matched ignoring case and extra space"`
+	Director  string   `json:"director,omitempty" jsonschema:"Movie director; required when the movie is created"`
+	Year      int      `json:"year,omitempty" jsonschema:"Release year, matched along with the title; may be left out when release_date is given"`
+	Rating    float64  `json:"rating,omitempty" jsonschema:"Movie rating, on scale"`
+	Scale     int      `json:"scale,omitempty" jsonschema:"Scale rating is given in: 5 (stars), 10 or 100 (percent); default 10. Ratings are stored on 0-10"`
+	Genres    []string `json:"genres,omitempty" jsonschema:"List of genres, replacing the current ones"`
+	PosterURL string   `json:"poster_url,omitempty" jsonschema:"URL to movie poster"`
+
+	Certifications  map[string]string `json:"certifications,omitempty" jsonschema:"Age ratings keyed by region (US: MPAA G/PG/PG-13/R/NC-17; GB: BBFC U/PG/12A/12/15/18/R18), replacing the current ones"`
+	ContentWarnings []string          `json:"content_warnings,omitempty" jsonschema:"Content warnings such as violence or strong language, replacing the current ones"`
+	ReleaseDate     string            `json:"release_date,omitempty" jsonschema:"Release date (YYYY-MM-DD), in year when both are given"`
+	Duration        int               `json:"duration,omitempty" jsonschema:"Runtime in minutes"`
+}
+
+// Validate requires a title and a year or release date to match by, and
+// checks the release date and the rating against its scale
+func (in AddOrUpdateMovieInput) Validate() error {
+	if normalizeTitle(in.Title) == "" {
+		return shared.NewValidationError("title is required")
+	}
+	if in.Year == 0 && in.ReleaseDate == "" {
+		return shared.NewValidationError("year or release_date is required to match the movie")
+	}
+	if _, err := parseMovieReleaseDate(in.ReleaseDate); err != nil {
+		return err
 	}
+	return validateRatingScale(in.Rating, in.Scale)
+}
+
+// year returns the year the movie is matched by
+func (in AddOrUpdateMovieInput) year() int {
+	if in.Year != 0 {
+		return in.Year
+	}
+	return movieReleaseDate(in.ReleaseDate).Year()
+}
+
+// AddOrUpdateMovieOutput defines the output schema for add_or_update_movie tool
+type AddOrUpdateMovieOutput struct {
+	Action string      `json:"action" jsonschema:"created when no movie had the title and year, updated otherwise"`
+	Movie  MovieOutput `json:"movie" jsonschema:"The created or updated movie"`
+}
+
+// AddOrUpdateMovie handles the add_or_update_movie tool call, creating the
+// movie unless one with the same title and year exists, which it updates
+func (t *MovieTools) AddOrUpdateMovie(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input AddOrUpdateMovieInput,
+) (*mcp.CallToolResult, AddOrUpdateMovieOutput, error) {
+	candidates, err := moviesTitled(ctx, t.movieService, input.Title, input.year())
+	if err != nil {
+		return nil, AddOrUpdateMovieOutput{}, fmt.Errorf("failed to find movie: %w", err)
+	}
+
+	if len(candidates) == 0 {
+		_, created, err := t.AddMovie(ctx, req, AddMovieInput{
+			Title:     normalizeTitle(input.Title),
+			Director:  input.Director,
+			Year:      input.year(),
+			Rating:    input.Rating,
+			Scale:     input.Scale,
+			Genres:    input.Genres,
+			PosterURL: input.PosterURL,
+
+			Certifications:  input.Certifications,
+			ContentWarnings: input.ContentWarnings,
+			ReleaseDate:     input.ReleaseDate,
+			Duration:        input.Duration,
+		})
+		if err != nil {
+			return nil, AddOrUpdateMovieOutput{}, err
+		}
+		output := AddOrUpdateMovieOutput{Action: "created", Movie: created}
+		return summaryResult(ctx, output, "Added movie %d: %s directed by %s", created.ID, movieLabel(created), created.Director), output, nil
+	}
+
+	existing, err := chooseMovie(ctx, req, t.interactive, "update", candidates)
+	if err != nil {
+		return nil, AddOrUpdateMovieOutput{}, err
+	}
+	_, updated, err := t.UpdateMovie(ctx, req, input.update(existing.ID))
+	if err != nil {
+		return nil, AddOrUpdateMovieOutput{}, err
+	}
+	output := AddOrUpdateMovieOutput{Action: "updated", Movie: updated}
+	return summaryResult(ctx, output, "Updated movie %d: %s directed by %s", updated.ID, movieLabel(updated), updated.Director), output, nil
+}
 
-	return nil, output, nil
+// update returns the update_movie input that sets the fields the call gave
+// on movie id, leaving the title it was matched by as it is
+func (in AddOrUpdateMovieInput) update(id int) UpdateMovieInput {
+	update := UpdateMovieInput{
+		ID:     id,
+		Scale:  in.Scale,
+		Genres: in.Genres,
+
+		Certifications:  in.Certifications,
+		ContentWarnings: in.ContentWarnings,
+	}
+	if in.Director != "" {
+		update.Director = &in.Director
+	}
+	if in.Rating != 0 {
+		update.Rating = &in.Rating
+	}
+	if in.PosterURL != "" {
+		update.PosterURL = &in.PosterURL
+	}
+	if in.ReleaseDate != "" {
+		update.ReleaseDate = &in.ReleaseDate
+	}
+	if in.Duration != 0 {
+		update.Duration = &in.Duration
+	}
+	return update
 }
 
 // ===== delete_movie Tool =====
 
 // DeleteMovieInput defines the input schema for delete_movie tool
 type DeleteMovieInput struct {
-	MovieID int `json:"movie_id" jsonschema:"required,description=The movie ID to delete"`
+	MovieID int    `json:"movie_id,omitempty" jsonschema:"The movie ID to delete"`
+	Title   string `json:"title,omitempty" jsonschema:"Delete the movie with this exact title instead (case-insensitive)"`
+	Year    int    `json:"year,omitempty" jsonschema:"Release year of the titled movie, when several share the title"`
+}
+
+// Validate requires the movie to be named by ID or by title
+func (in DeleteMovieInput) Validate() error {
+	if in.MovieID != 0 && in.Title != "" {
+		return shared.NewValidationError("pass movie_id or title, not both")
+	}
+	if in.MovieID == 0 && strings.TrimSpace(in.Title) == "" {
+		return shared.NewValidationError("movie_id or title is required")
+	}
+	return nil
 }
 
 // DeleteMovieOutput defines the output schema for delete_movie tool
 type DeleteMovieOutput struct {
-	Message string `json:"message" jsonschema:"description=Success message"`
+	Message string `json:"message" jsonschema:"Success message"`
+	ID      int    `json:"id,omitempty" jsonschema:"ID of the deleted movie, when it was named by title"`
 }
 
 // DeleteMovie handles the delete_movie tool call
@@ -233,34 +627,52 @@ func (t *MovieTools) DeleteMovie(
 	req *mcp.CallToolRequest,
 	input DeleteMovieInput,
 ) (*mcp.CallToolResult, DeleteMovieOutput, error) {
+	output := DeleteMovieOutput{
+		Message: "Movie deleted successfully",
+	}
+
+	movieID := input.MovieID
+	if input.Title != "" {
+		candidates, err := moviesTitled(ctx, t.movieService, input.Title, input.Year)
+		if err != nil {
+			return nil, DeleteMovieOutput{}, fmt.Errorf("failed to find movie: %w", err)
+		}
+		if len(candidates) == 0 {
+			return nil, DeleteMovieOutput{}, shared.NewNotFoundError("no movie is titled %q", input.Title)
+		}
+		chosen, err := chooseMovie(ctx, req, t.interactive, "delete", candidates)
+		if err != nil {
+			return nil, DeleteMovieOutput{}, err
+		}
+		movieID = chosen.ID
+		output.ID = chosen.ID
+	}
+
 	// Delete movie
-	err := t.movieService.DeleteMovie(ctx, input.MovieID)
+	err := t.movieService.DeleteMovie(ctx, movieID)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return nil, DeleteMovieOutput{}, fmt.Errorf("movie not found")
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil, DeleteMovieOutput{}, shared.NewNotFoundError("movie not found")
 		}
 		return nil, DeleteMovieOutput{}, fmt.Errorf("failed to delete movie: %w", err)
 	}
 
-	output := DeleteMovieOutput{
-		Message: "Movie deleted successfully",
-	}
-
-	return nil, output, nil
+	return summaryResult(ctx, output, "Deleted movie %d", movieID), output, nil
 }
 
 // ===== list_top_movies Tool =====
 
 // ListTopMoviesInput defines the input schema for list_top_movies tool
 type ListTopMoviesInput struct {
-	Limit int `json:"limit,omitempty" jsonschema:"description=Number of movies to return,default=10"`
+	Limit       int    `json:"limit,omitempty" jsonschema:"Number of movies to return (default 10)"`
+	Consistency string `json:"consistency,omitempty" jsonschema:"Read consistency (strong/relaxed; default relaxed)"`
 }
 
 // ListTopMoviesOutput defines the output schema for list_top_movies tool
 type ListTopMoviesOutput struct {
-	Movies      []GetMovieOutput `json:"movies" jsonschema:"description=List of top-rated movies"`
-	Total       int              `json:"total" jsonschema:"description=Total number of movies returned"`
-	Description string           `json:"description" jsonschema:"description=Description of results"`
+	Movies      []MovieOutput `json:"movies" jsonschema:"List of top-rated movies"`
+	Total       int           `json:"total" jsonschema:"Total number of movies returned"`
+	Description string        `json:"description" jsonschema:"Description of results"`
 }
 
 // ListTopMovies handles the list_top_movies tool call
@@ -269,6 +681,10 @@ func (t *MovieTools) ListTopMovies(
 	req *mcp.CallToolRequest,
 	input ListTopMoviesInput,
 ) (*mcp.CallToolResult, ListTopMoviesOutput, error) {
+	if err := ensureConsistency(ctx, t.writeBarrier, input.Consistency); err != nil {
+		return nil, ListTopMoviesOutput{}, err
+	}
+
 	// Set default limit
 	limit := input.Limit
 	if limit == 0 {
@@ -282,20 +698,7 @@ func (t *MovieTools) ListTopMovies(
 	}
 
 	// Convert to output format
-	movies := make([]GetMovieOutput, len(movieDTOs))
-	for i, movieDTO := range movieDTOs {
-		movies[i] = GetMovieOutput{
-			ID:        movieDTO.ID,
-			Title:     movieDTO.Title,
-			Director:  movieDTO.Director,
-			Year:      movieDTO.Year,
-			Rating:    movieDTO.Rating,
-			Genres:    movieDTO.Genres,
-			PosterURL: movieDTO.PosterURL,
-			CreatedAt: movieDTO.CreatedAt,
-			UpdatedAt: movieDTO.UpdatedAt,
-		}
-	}
+	movies := newMovieOutputs(movieDTOs)
 
 	output := ListTopMoviesOutput{
 		Movies:      movies,
@@ -303,31 +706,111 @@ func (t *MovieTools) ListTopMovies(
 		Description: fmt.Sprintf("Top %d rated movies", limit),
 	}
 
-	return nil, output, nil
+	return summaryResult(ctx, output, "%s", movieListSummary(ctx, "Top rated:", output.Movies)), output, nil
 }
 
 // ===== search_movies Tool =====
 
+// SortKeyInput defines one term of a multi-key sort for the search tools
+type SortKeyInput struct {
+	Field     string `json:"field" jsonschema:"Field to sort by"`
+	Direction string `json:"direction,omitempty" jsonschema:"Sort direction (asc/desc; default asc)"`
+}
+
+// newMovieSortKeys converts sort input to the movie query format
+func newMovieSortKeys(keys []SortKeyInput) []movieApp.SortKey {
+	if len(keys) == 0 {
+		return nil
+	}
+	sortKeys := make([]movieApp.SortKey, len(keys))
+	for i, key := range keys {
+		sortKeys[i] = movieApp.SortKey{Field: key.Field, Dir: key.Direction}
+	}
+	return sortKeys
+}
+
 // SearchMoviesInput defines the input schema for search_movies tool
 type SearchMoviesInput struct {
-	Title     string  `json:"title,omitempty" jsonschema:"description=Search by movie title"`
-	Director  string  `json:"director,omitempty" jsonschema:"description=Search by director name"`
-	Genre     string  `json:"genre,omitempty" jsonschema:"description=Search by genre"`
-	MinYear   int     `json:"min_year,omitempty" jsonschema:"description=Minimum release year"`
-	MaxYear   int     `json:"max_year,omitempty" jsonschema:"description=Maximum release year"`
-	MinRating float64 `json:"min_rating,omitempty" jsonschema:"description=Minimum rating (0-10)"`
-	MaxRating float64 `json:"max_rating,omitempty" jsonschema:"description=Maximum rating (0-10)"`
-	Limit     int     `json:"limit,omitempty" jsonschema:"description=Maximum number of results,default=20"`
-	Offset    int     `json:"offset,omitempty" jsonschema:"description=Number of results to skip for pagination,default=0"`
-	OrderBy   string  `json:"order_by,omitempty" jsonschema:"description=Field to order by (title/year/rating),default=title"`
-	OrderDir  string  `json:"order_dir,omitempty" jsonschema:"description=Order direction (asc/desc),default=asc"`
+	Title       string         `json:"title,omitempty" jsonschema:"Search by movie title"`
+	Director    string         `json:"director,omitempty" jsonschema:"Search by director name"`
+	Genre       string         `json:"genre,omitempty" jsonschema:"Search by genre"`
+	MinYear     int            `json:"min_year,omitempty" jsonschema:"Minimum release year"`
+	MaxYear     int            `json:"max_year,omitempty" jsonschema:"Maximum release year"`
+	MinRating   float64        `json:"min_rating,omitempty" jsonschema:"Minimum rating (0-10)"`
+	MaxRating   float64        `json:"max_rating,omitempty" jsonschema:"Maximum rating (0-10)"`
+	Era         string         `json:"era,omitempty" jsonschema:"Release period in plain words, e.g. 'last 5 years', 'pre-war', 'early 90s' or 'since 2010'; narrows min_year/max_year"`
+	Limit       int            `json:"limit,omitempty" jsonschema:"Maximum number of results (default 20)"`
+	Offset      int            `json:"offset,omitempty" jsonschema:"Number of results to skip for pagination (default 0)"`
+	OrderBy     string         `json:"order_by,omitempty" jsonschema:"Field to order by (title/year/rating; default title)"`
+	OrderDir    string         `json:"order_dir,omitempty" jsonschema:"Order direction (asc/desc; default asc)"`
+	Sort        []SortKeyInput `json:"sort,omitempty" jsonschema:"Ordered sort keys on title/director/year/rating/created_at/updated_at, e.g. rating desc then year asc; overrides order_by/order_dir"`
+	Fuzzy       bool           `json:"fuzzy,omitempty" jsonschema:"Typo-tolerant title matching; results are ranked by similarity"`
+	Explain     bool           `json:"explain,omitempty" jsonschema:"Add an explanation to each result: which criteria it matched, its sort values and rank"`
+	Language    string         `json:"language,omitempty" jsonschema:"Preferred language code (e.g. fr or pt-BR) for titles and descriptions; filters still match original titles"`
+	Consistency string         `json:"consistency,omitempty" jsonschema:"Read consistency (strong/relaxed; default relaxed)"`
+
+	MaxCertification       string   `json:"max_certification,omitempty" jsonschema:"Most restrictive age rating to include (e.g. PG-13); movies unrated in the region are left out"`
+	CertificationRegion    string   `json:"certification_region,omitempty" jsonschema:"Region whose rating scale max_certification uses (US or GB; default US)"`
+	ExcludeContentWarnings []string `json:"exclude_content_warnings,omitempty" jsonschema:"Leave out movies carrying any of these content warnings"`
+
+	ReleasedAfter  string `json:"released_after,omitempty" jsonschema:"Only movies released on or after this date (YYYY-MM-DD, YYYY-MM or YYYY); movies without a full release date match on their year"`
+	ReleasedBefore string `json:"released_before,omitempty" jsonschema:"Only movies released on or before this date (YYYY-MM-DD, YYYY-MM or YYYY); movies without a full release date match on their year"`
+
+	MinDuration    int  `json:"min_duration,omitempty" jsonschema:"Minimum runtime in minutes; movies with no known runtime are left out"`
+	MaxDuration    int  `json:"max_duration,omitempty" jsonschema:"Maximum runtime in minutes; movies with no known runtime are left out"`
+	ShortFilmsOnly bool `json:"short_films_only,omitempty" jsonschema:"Only short films, running 40 minutes or less"`
+
+	RankingWeights map[string]float64 `json:"ranking_weights,omitempty" jsonschema:"Weight of each ranking signal (recency/rating/popularity/trending), overriding the server's defaults; 0 turns a signal off. Results are ordered by weighted score, with the sort keys breaking ties"`
+
+	Fields []string `json:"fields,omitempty" jsonschema:"Movie fields to return, e.g. title, year and rating; id is always returned (default every field)"`
+}
+
+// Validate checks the runtime bounds, including against short_films_only
+func (in SearchMoviesInput) Validate() error {
+	if in.MinDuration < 0 || in.MaxDuration < 0 {
+		return shared.NewValidationError("min_duration and max_duration cannot be negative")
+	}
+	minDuration, maxDuration := in.durationRange()
+	if maxDuration > 0 && minDuration > maxDuration {
+		if in.ShortFilmsOnly {
+			return shared.NewValidationError("min_duration cannot be above %d minutes with short_films_only", movie.ShortFilmMaxDuration)
+		}
+		return shared.NewValidationError("min_duration cannot be above max_duration")
+	}
+	return validateMovieFields(in.Fields)
+}
+
+// durationRange returns the runtime bounds of a search, with
+// short_films_only capping the maximum
+func (in SearchMoviesInput) durationRange() (minDuration, maxDuration int) {
+	maxDuration = in.MaxDuration
+	if in.ShortFilmsOnly && (maxDuration == 0 || maxDuration > movie.ShortFilmMaxDuration) {
+		maxDuration = movie.ShortFilmMaxDuration
+	}
+	return in.MinDuration, maxDuration
 }
 
 // SearchMoviesOutput defines the output schema for search_movies tool
 type SearchMoviesOutput struct {
-	Movies      []GetMovieOutput `json:"movies" jsonschema:"description=List of matching movies"`
-	Total       int              `json:"total" jsonschema:"description=Total number of movies found"`
-	Description string           `json:"description" jsonschema:"description=Description of search results"`
+	Movies      []MovieOutput          `json:"movies" jsonschema:"List of matching movies"`
+	Total       int                    `json:"total" jsonschema:"Total number of movies found"`
+	Description string                 `json:"description" jsonschema:"Description of search results"`
+	Truncation  *middleware.Truncation `json:"truncation,omitempty" jsonschema:"Set when movies was cut short to fit the response size limit"`
+
+	ExcludedByPreferences int `json:"excluded_by_preferences,omitempty" jsonschema:"Matches left out because the session dislikes their director"`
+}
+
+// Len returns the number of movies, for response truncation
+func (o SearchMoviesOutput) Len() int {
+	return len(o.Movies)
+}
+
+// Truncate keeps the first keep movies, pointing to search contexts for the rest
+func (o SearchMoviesOutput) Truncate(keep int, truncation middleware.Truncation) any {
+	truncation.Hint = "create_search_context with the same query returns every match, page by page with get_context_page"
+	o.Movies = o.Movies[:keep]
+	o.Truncation = &truncation
+	return o
 }
 
 // SearchMovies handles the search_movies tool call
@@ -336,6 +819,33 @@ func (t *MovieTools) SearchMovies(
 	req *mcp.CallToolRequest,
 	input SearchMoviesInput,
 ) (*mcp.CallToolResult, SearchMoviesOutput, error) {
+	movieDTOs, err := t.findMovies(ctx, input)
+	if err != nil {
+		return nil, SearchMoviesOutput{}, err
+	}
+	movies, _, excluded, err := t.presentMovies(ctx, req, input, movieDTOs)
+	if err != nil {
+		return nil, SearchMoviesOutput{}, err
+	}
+
+	output := SearchMoviesOutput{
+		Movies:                movies,
+		Total:                 len(movies),
+		Description:           "Search results",
+		ExcludedByPreferences: excluded,
+	}
+
+	recordSearchHits(ctx, t.access, output.Movies)
+	selectMovieFields(output.Movies, input.Fields)
+	return summaryResult(ctx, output, "%s", movieListSummary(ctx, "Found", output.Movies)), output, nil
+}
+
+// findMovies runs the search a search_movies input describes
+func (t *MovieTools) findMovies(ctx context.Context, input SearchMoviesInput) ([]*movieApp.MovieDTO, error) {
+	if err := ensureConsistency(ctx, t.writeBarrier, input.Consistency); err != nil {
+		return nil, err
+	}
+
 	// Create search query
 	query := movieApp.SearchMoviesQuery{
 		Title:     input.Title,
@@ -349,6 +859,21 @@ func (t *MovieTools) SearchMovies(
 		Offset:    input.Offset,
 		OrderBy:   input.OrderBy,
 		OrderDir:  input.OrderDir,
+		Sort:      newMovieSortKeys(input.Sort),
+		Fuzzy:     input.Fuzzy,
+		Explain:   input.Explain,
+		Ranked:    true,
+
+		RankingWeights: input.RankingWeights,
+
+		MaxCertification:    input.MaxCertification,
+		CertificationRegion: input.CertificationRegion,
+		ExcludeWarnings:     input.ExcludeContentWarnings,
+	}
+	query.MinDuration, query.MaxDuration = input.durationRange()
+
+	if err := applyReleaseFilters(&query, input, time.Now().Year()); err != nil {
+		return nil, err
 	}
 
 	// Set default limit
@@ -359,39 +884,272 @@ func (t *MovieTools) SearchMovies(
 	// Search movies
 	movieDTOs, err := t.movieService.SearchMovies(ctx, query)
 	if err != nil {
-		return nil, SearchMoviesOutput{}, fmt.Errorf("failed to search movies: %w", err)
+		return nil, fmt.Errorf("failed to search movies: %w", err)
 	}
+	return movieDTOs, nil
+}
 
-	// Convert to output format
-	movies := make([]GetMovieOutput, len(movieDTOs))
-	for i, movieDTO := range movieDTOs {
-		movies[i] = GetMovieOutput{
-			ID:        movieDTO.ID,
-			Title:     movieDTO.Title,
-			Director:  movieDTO.Director,
-			Year:      movieDTO.Year,
-			Rating:    movieDTO.Rating,
-			Genres:    movieDTO.Genres,
-			PosterURL: movieDTO.PosterURL,
-			CreatedAt: movieDTO.CreatedAt,
-			UpdatedAt: movieDTO.UpdatedAt,
+// presentMovies converts the movies a search found into its results,
+// applying the session's preferences and language. It returns the results,
+// the position of each among the movies found, and how many movies the
+// preferences left out.
+func (t *MovieTools) presentMovies(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input SearchMoviesInput,
+	movieDTOs []*movieApp.MovieDTO,
+) ([]MovieOutput, []int, int, error) {
+	// Apply the session's preferences where the call does not say otherwise;
+	// searching for a director overrides disliking them
+	prefs := t.preferences.Get(req)
+	positions := make([]int, 0, len(movieDTOs))
+	kept := movieDTOs[:0]
+	for i, movie := range movieDTOs {
+		if input.Director == "" && prefs.dislikes(movie.Director) {
+			continue
 		}
+		kept = append(kept, movie)
+		positions = append(positions, i)
+	}
+	excluded := len(movieDTOs) - len(kept)
+	language := input.Language
+	if language == "" {
+		language = prefs.Language
 	}
 
-	output := SearchMoviesOutput{
-		Movies:      movies,
-		Total:       len(movies),
-		Description: "Search results",
+	// Convert to output format
+	movies := newMovieOutputs(kept)
+	if err := localizeMovies(ctx, t.localizer, language, movies); err != nil {
+		return nil, nil, 0, err
+	}
+	return movies, positions, excluded, nil
+}
+
+// ===== search_movies_v2 Tool =====
+
+const (
+	// defaultSearchPageSize is the page size of search_movies_v2 by default
+	defaultSearchPageSize = 20
+	// maxSearchPageSize is the largest page search_movies_v2 returns
+	maxSearchPageSize = 100
+)
+
+// SearchMoviesV2Input defines the input schema for search_movies_v2 tool. It
+// takes the filters of search_movies, with cursor pagination in place of
+// limit and offset.
+type SearchMoviesV2Input struct {
+	Title       string         `json:"title,omitempty" jsonschema:"Search by movie title"`
+	Director    string         `json:"director,omitempty" jsonschema:"Search by director name"`
+	Genre       string         `json:"genre,omitempty" jsonschema:"Search by genre"`
+	MinYear     int            `json:"min_year,omitempty" jsonschema:"Minimum release year"`
+	MaxYear     int            `json:"max_year,omitempty" jsonschema:"Maximum release year"`
+	MinRating   float64        `json:"min_rating,omitempty" jsonschema:"Minimum rating (0-10)"`
+	MaxRating   float64        `json:"max_rating,omitempty" jsonschema:"Maximum rating (0-10)"`
+	Era         string         `json:"era,omitempty" jsonschema:"Release period in plain words, e.g. 'last 5 years', 'pre-war', 'early 90s' or 'since 2010'; narrows min_year/max_year"`
+	PageSize    int            `json:"page_size,omitempty" jsonschema:"Maximum number of results per page (default 20, max 100)"`
+	Cursor      string         `json:"cursor,omitempty" jsonschema:"next_cursor of the previous page, with the same filters; omit for the first page"`
+	OrderBy     string         `json:"order_by,omitempty" jsonschema:"Field to order by (title/year/rating; default title)"`
+	OrderDir    string         `json:"order_dir,omitempty" jsonschema:"Order direction (asc/desc; default asc)"`
+	Sort        []SortKeyInput `json:"sort,omitempty" jsonschema:"Ordered sort keys on title/director/year/rating/created_at/updated_at, e.g. rating desc then year asc; overrides order_by/order_dir"`
+	Fuzzy       bool           `json:"fuzzy,omitempty" jsonschema:"Typo-tolerant title matching; results are ranked by similarity"`
+	Explain     bool           `json:"explain,omitempty" jsonschema:"Add an explanation to each result: which criteria it matched, its sort values and rank"`
+	Language    string         `json:"language,omitempty" jsonschema:"Preferred language code (e.g. fr or pt-BR) for titles and descriptions; filters still match original titles"`
+	Consistency string         `json:"consistency,omitempty" jsonschema:"Read consistency (strong/relaxed; default relaxed)"`
+
+	MaxCertification       string   `json:"max_certification,omitempty" jsonschema:"Most restrictive age rating to include (e.g. PG-13); movies unrated in the region are left out"`
+	CertificationRegion    string   `json:"certification_region,omitempty" jsonschema:"Region whose rating scale max_certification uses (US or GB; default US)"`
+	ExcludeContentWarnings []string `json:"exclude_content_warnings,omitempty" jsonschema:"Leave out movies carrying any of these content warnings"`
+
+	ReleasedAfter  string `json:"released_after,omitempty" jsonschema:"Only movies released on or after this date (YYYY-MM-DD, YYYY-MM or YYYY); movies without a full release date match on their year"`
+	ReleasedBefore string `json:"released_before,omitempty" jsonschema:"Only movies released on or before this date (YYYY-MM-DD, YYYY-MM or YYYY); movies without a full release date match on their year"`
+
+	MinDuration    int  `json:"min_duration,omitempty" jsonschema:"Minimum runtime in minutes; movies with no known runtime are left out"`
+	MaxDuration    int  `json:"max_duration,omitempty" jsonschema:"Maximum runtime in minutes; movies with no known runtime are left out"`
+	ShortFilmsOnly bool `json:"short_films_only,omitempty" jsonschema:"Only short films, running 40 minutes or less"`
+
+	RankingWeights map[string]float64 `json:"ranking_weights,omitempty" jsonschema:"Weight of each ranking signal (recency/rating/popularity/trending), overriding the server's defaults; 0 turns a signal off. Results are ordered by weighted score, with the sort keys breaking ties"`
+
+	Fields []string `json:"fields,omitempty" jsonschema:"Movie fields to return, e.g. title, year and rating; id is always returned (default every field)"`
+}
+
+// Validate bounds the page size, checks the cursor belongs to this search
+// and checks the filters as search_movies does
+func (in SearchMoviesV2Input) Validate() error {
+	if in.PageSize < 0 || in.PageSize > maxSearchPageSize {
+		return shared.NewValidationError("page_size must be between 1 and %d", maxSearchPageSize)
+	}
+	if _, err := in.offset(); err != nil {
+		return err
+	}
+	return in.search(0, 0).Validate()
+}
+
+// search returns the search_movies input for a page of this search
+func (in SearchMoviesV2Input) search(offset, limit int) SearchMoviesInput {
+	return SearchMoviesInput{
+		Title:       in.Title,
+		Director:    in.Director,
+		Genre:       in.Genre,
+		MinYear:     in.MinYear,
+		MaxYear:     in.MaxYear,
+		MinRating:   in.MinRating,
+		MaxRating:   in.MaxRating,
+		Era:         in.Era,
+		Limit:       limit,
+		Offset:      offset,
+		OrderBy:     in.OrderBy,
+		OrderDir:    in.OrderDir,
+		Sort:        in.Sort,
+		Fuzzy:       in.Fuzzy,
+		Explain:     in.Explain,
+		Language:    in.Language,
+		Consistency: in.Consistency,
+
+		MaxCertification:       in.MaxCertification,
+		CertificationRegion:    in.CertificationRegion,
+		ExcludeContentWarnings: in.ExcludeContentWarnings,
+
+		ReleasedAfter:  in.ReleasedAfter,
+		ReleasedBefore: in.ReleasedBefore,
+
+		MinDuration:    in.MinDuration,
+		MaxDuration:    in.MaxDuration,
+		ShortFilmsOnly: in.ShortFilmsOnly,
+
+		RankingWeights: in.RankingWeights,
+
+		Fields: in.Fields,
+	}
+}
+
+// fingerprint identifies the movies and the order a search returns, so a
+// cursor cannot be replayed against other filters
+func (in SearchMoviesV2Input) fingerprint() string {
+	query := in.search(0, 0)
+	query.Explain, query.Language, query.Consistency, query.Fields = false, "", "", nil
+	data, _ := json.Marshal(query)
+	hash := fnv.New32a()
+	hash.Write(data)
+	return strconv.FormatUint(uint64(hash.Sum32()), 36)
+}
+
+// cursor returns the cursor of the page starting at offset
+func (in SearchMoviesV2Input) cursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset) + ":" + in.fingerprint()))
+}
+
+// offset returns the offset the input's cursor starts at, 0 without one
+func (in SearchMoviesV2Input) offset() (int, error) {
+	if in.Cursor == "" {
+		return 0, nil
+	}
+	invalid := shared.NewValidationError("cursor is not a next_cursor of this search; omit it to start over")
+	data, err := base64.RawURLEncoding.DecodeString(in.Cursor)
+	if err != nil {
+		return 0, invalid
+	}
+	position, fingerprint, _ := strings.Cut(string(data), ":")
+	offset, err := strconv.Atoi(position)
+	if err != nil || offset < 0 || fingerprint != in.fingerprint() {
+		return 0, invalid
 	}
+	return offset, nil
+}
+
+// SearchMoviesV2Output defines the output schema for search_movies_v2 tool
+type SearchMoviesV2Output struct {
+	Movies     []MovieOutput          `json:"movies" jsonschema:"Matching movies on this page"`
+	NextCursor string                 `json:"next_cursor,omitempty" jsonschema:"Cursor of the next page; absent on the last page"`
+	Truncation *middleware.Truncation `json:"truncation,omitempty" jsonschema:"Set when movies was cut short to fit the response size limit; next_cursor then resumes after the last movie returned"`
+
+	ExcludedByPreferences int `json:"excluded_by_preferences,omitempty" jsonschema:"Matches on this page left out because the session dislikes their director"`
+
+	// cursors holds the cursor of a page starting at each movie, for truncation
+	cursors []string
+}
 
-	return nil, output, nil
+// Len returns the number of movies, for response truncation
+func (o SearchMoviesV2Output) Len() int {
+	return len(o.Movies)
+}
+
+// Truncate keeps the first keep movies, pointing next_cursor at the first
+// movie left out
+func (o SearchMoviesV2Output) Truncate(keep int, truncation middleware.Truncation) any {
+	truncation.Hint = "next_cursor resumes with the first movie left out"
+	if keep < len(o.cursors) {
+		o.NextCursor = o.cursors[keep]
+	}
+	o.Movies = o.Movies[:keep]
+	o.Truncation = &truncation
+	return o
+}
+
+// SearchMoviesV2 handles the search_movies_v2 tool call
+func (t *MovieTools) SearchMoviesV2(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input SearchMoviesV2Input,
+) (*mcp.CallToolResult, SearchMoviesV2Output, error) {
+	offset, err := input.offset()
+	if err != nil {
+		return nil, SearchMoviesV2Output{}, err
+	}
+	pageSize := input.PageSize
+	if pageSize == 0 {
+		pageSize = defaultSearchPageSize
+	}
+
+	// Fetch one movie past the page to learn whether another follows
+	search := input.search(offset, pageSize+1)
+	movieDTOs, err := t.findMovies(ctx, search)
+	if err != nil {
+		return nil, SearchMoviesV2Output{}, err
+	}
+	more := len(movieDTOs) > pageSize
+	if more {
+		movieDTOs = movieDTOs[:pageSize]
+	}
+	found := len(movieDTOs)
+	movies, positions, excluded, err := t.presentMovies(ctx, req, search, movieDTOs)
+	if err != nil {
+		return nil, SearchMoviesV2Output{}, err
+	}
+
+	output := SearchMoviesV2Output{
+		Movies:                movies,
+		ExcludedByPreferences: excluded,
+		cursors:               make([]string, len(movies)),
+	}
+	if more {
+		output.NextCursor = input.cursor(offset + found)
+	}
+	for i, position := range positions {
+		output.cursors[i] = input.cursor(offset + position)
+	}
+
+	recordSearchHits(ctx, t.access, output.Movies)
+	selectMovieFields(output.Movies, input.Fields)
+	summary := movieListSummary(ctx, "Found", output.Movies)
+	if more {
+		summary += i18n.FromContext(ctx).Translate("; pass next_cursor for more")
+	}
+	return summaryResult(ctx, output, "%s", summary), output, nil
 }
 
 // ===== search_by_decade Tool =====
 
 // SearchByDecadeInput defines the input schema for search_by_decade tool
 type SearchByDecadeInput struct {
-	Decade string `json:"decade" jsonschema:"required,description=Decade to search (e.g. '1990s' or '90s' or '1990')"`
+	Decade      string   `json:"decade" jsonschema:"Decade to search (e.g. '1990s', '90s', 'the nineties' or '1990-1999')"`
+	Explain     bool     `json:"explain,omitempty" jsonschema:"Add an explanation to each result: which criteria it matched, its sort values and rank"`
+	Consistency string   `json:"consistency,omitempty" jsonschema:"Read consistency (strong/relaxed; default relaxed)"`
+	Fields      []string `json:"fields,omitempty" jsonschema:"Movie fields to return, e.g. title, year and rating; id is always returned (default every field)"`
+}
+
+// Validate checks the selected fields exist
+func (in SearchByDecadeInput) Validate() error {
+	return validateMovieFields(in.Fields)
 }
 
 // SearchByDecade handles the search_by_decade tool call
@@ -400,19 +1158,24 @@ func (t *MovieTools) SearchByDecade(
 	req *mcp.CallToolRequest,
 	input SearchByDecadeInput,
 ) (*mcp.CallToolResult, SearchMoviesOutput, error) {
+	if err := ensureConsistency(ctx, t.writeBarrier, input.Consistency); err != nil {
+		return nil, SearchMoviesOutput{}, err
+	}
+
 	// Parse decade to year range
-	minYear, maxYear, err := parseDecade(input.Decade)
+	decade, err := movie.ParseDecade(input.Decade)
 	if err != nil {
 		return nil, SearchMoviesOutput{}, fmt.Errorf("invalid decade format: %w", err)
 	}
 
 	// Create search query
 	query := movieApp.SearchMoviesQuery{
-		MinYear:  minYear,
-		MaxYear:  maxYear,
+		MinYear:  decade.Start(),
+		MaxYear:  decade.End(),
 		Limit:    50,
 		OrderBy:  "year",
 		OrderDir: "asc",
+		Explain:  input.Explain,
 	}
 
 	// Search movies
@@ -422,36 +1185,45 @@ func (t *MovieTools) SearchByDecade(
 	}
 
 	// Convert to output format
-	movies := make([]GetMovieOutput, len(movieDTOs))
-	for i, movieDTO := range movieDTOs {
-		movies[i] = GetMovieOutput{
-			ID:        movieDTO.ID,
-			Title:     movieDTO.Title,
-			Director:  movieDTO.Director,
-			Year:      movieDTO.Year,
-			Rating:    movieDTO.Rating,
-			Genres:    movieDTO.Genres,
-			PosterURL: movieDTO.PosterURL,
-			CreatedAt: movieDTO.CreatedAt,
-			UpdatedAt: movieDTO.UpdatedAt,
-		}
-	}
+	movies := newMovieOutputs(movieDTOs)
 
 	output := SearchMoviesOutput{
 		Movies:      movies,
 		Total:       len(movies),
-		Description: fmt.Sprintf("Movies from the %s", input.Decade),
+		Description: fmt.Sprintf("Movies from the %s", decade),
 	}
 
-	return nil, output, nil
+	recordSearchHits(ctx, t.access, output.Movies)
+	selectMovieFields(output.Movies, input.Fields)
+	return summaryResult(ctx, output, "%s from the %s", movieListSummary(ctx, "Found", output.Movies), decade), output, nil
 }
 
 // ===== search_by_rating_range Tool =====
 
 // SearchByRatingRangeInput defines the input schema for search_by_rating_range tool
 type SearchByRatingRangeInput struct {
-	MinRating float64 `json:"min_rating,omitempty" jsonschema:"description=Minimum rating (0-10)"`
-	MaxRating float64 `json:"max_rating,omitempty" jsonschema:"description=Maximum rating (0-10)"`
+	MinRating   float64  `json:"min_rating,omitempty" jsonschema:"Minimum rating (0-10)"`
+	MaxRating   float64  `json:"max_rating,omitempty" jsonschema:"Maximum rating (0-10)"`
+	Explain     bool     `json:"explain,omitempty" jsonschema:"Add an explanation to each result: which criteria it matched, its sort values and rank"`
+	Consistency string   `json:"consistency,omitempty" jsonschema:"Read consistency (strong/relaxed; default relaxed)"`
+	Fields      []string `json:"fields,omitempty" jsonschema:"Movie fields to return, e.g. title, year and rating; id is always returned (default every field)"`
+}
+
+// Validate requires a rating bound and checks both are between 0 and 10
+func (in SearchByRatingRangeInput) Validate() error {
+	if in.MinRating == 0 && in.MaxRating == 0 {
+		return shared.NewValidationError("at least one of min_rating or max_rating is required")
+	}
+	if in.MinRating < 0 || in.MinRating > 10 {
+		return shared.NewValidationError("min_rating must be between 0 and 10")
+	}
+	if in.MaxRating < 0 || in.MaxRating > 10 {
+		return shared.NewValidationError("max_rating must be between 0 and 10")
+	}
+	if in.MinRating > 0 && in.MaxRating > 0 && in.MinRating > in.MaxRating {
+		return shared.NewValidationError("min_rating cannot be greater than max_rating")
+	}
+	return validateMovieFields(in.Fields)
 }
 
 // SearchByRatingRange handles the search_by_rating_range tool call
@@ -460,20 +1232,8 @@ func (t *MovieTools) SearchByRatingRange(
 	req *mcp.CallToolRequest,
 	input SearchByRatingRangeInput,
 ) (*mcp.CallToolResult, SearchMoviesOutput, error) {
-	// Validate that at least one rating is provided
-	if input.MinRating == 0 && input.MaxRating == 0 {
-		return nil, SearchMoviesOutput{}, fmt.Errorf("at least one of min_rating or max_rating is required")
-	}
-
-	// Validate rating ranges
-	if input.MinRating < 0 || input.MinRating > 10 {
-		return nil, SearchMoviesOutput{}, fmt.Errorf("min_rating must be between 0 and 10")
-	}
-	if input.MaxRating < 0 || input.MaxRating > 10 {
-		return nil, SearchMoviesOutput{}, fmt.Errorf("max_rating must be between 0 and 10")
-	}
-	if input.MinRating > 0 && input.MaxRating > 0 && input.MinRating > input.MaxRating {
-		return nil, SearchMoviesOutput{}, fmt.Errorf("min_rating cannot be greater than max_rating")
+	if err := ensureConsistency(ctx, t.writeBarrier, input.Consistency); err != nil {
+		return nil, SearchMoviesOutput{}, err
 	}
 
 	// Create search query
@@ -483,6 +1243,7 @@ func (t *MovieTools) SearchByRatingRange(
 		Limit:     50,
 		OrderBy:   "rating",
 		OrderDir:  "desc",
+		Explain:   input.Explain,
 	}
 
 	// Search movies
@@ -492,20 +1253,7 @@ func (t *MovieTools) SearchByRatingRange(
 	}
 
 	// Convert to output format
-	movies := make([]GetMovieOutput, len(movieDTOs))
-	for i, movieDTO := range movieDTOs {
-		movies[i] = GetMovieOutput{
-			ID:        movieDTO.ID,
-			Title:     movieDTO.Title,
-			Director:  movieDTO.Director,
-			Year:      movieDTO.Year,
-			Rating:    movieDTO.Rating,
-			Genres:    movieDTO.Genres,
-			PosterURL: movieDTO.PosterURL,
-			CreatedAt: movieDTO.CreatedAt,
-			UpdatedAt: movieDTO.UpdatedAt,
-		}
-	}
+	movies := newMovieOutputs(movieDTOs)
 
 	// Create description
 	var description string
@@ -523,40 +1271,7 @@ func (t *MovieTools) SearchByRatingRange(
 		Description: description,
 	}
 
-	return nil, output, nil
-}
-
-// Helper function to parse decade string
-func parseDecade(decade string) (int, int, error) {
-	decade = strings.TrimSpace(decade)
-	decade = strings.TrimSuffix(decade, "s")
-
-	var baseYear int
-	if len(decade) == 2 {
-		// Handle "90" -> 1990
-		year, err := strconv.Atoi(decade)
-		if err != nil {
-			return 0, 0, fmt.Errorf("invalid decade format")
-		}
-		if year >= 0 && year <= 30 {
-			baseYear = 2000 + year
-		} else {
-			baseYear = 1900 + year
-		}
-	} else if len(decade) == 4 {
-		// Handle "1990" -> 1990
-		year, err := strconv.Atoi(decade)
-		if err != nil {
-			return 0, 0, fmt.Errorf("invalid decade format")
-		}
-		baseYear = year
-	} else {
-		return 0, 0, fmt.Errorf("invalid decade format")
-	}
-
-	// Convert to decade boundaries
-	decadeStart := (baseYear / 10) * 10
-	decadeEnd := decadeStart + 9
-
-	return decadeStart, decadeEnd, nil
+	recordSearchHits(ctx, t.access, output.Movies)
+	selectMovieFields(output.Movies, input.Fields)
+	return summaryResult(ctx, output, "%s (%s)", movieListSummary(ctx, "Found", output.Movies), output.Description), output, nil
 }
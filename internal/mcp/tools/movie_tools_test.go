@@ -2,16 +2,26 @@ package tools
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"reflect"
+	"sort"
+	"strings"
 	"testing"
 
+	"github.com/google/jsonschema-go/jsonschema"
+
 	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	posterApp "github.com/francknouama/movies-mcp-server/internal/application/poster"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/middleware"
 )
 
 // MockMovieService is a mock implementation for testing
 type MockMovieService struct {
 	GetMovieFunc          func(ctx context.Context, id int) (*movieApp.MovieDTO, error)
 	CreateMovieFunc       func(ctx context.Context, cmd movieApp.CreateMovieCommand) (*movieApp.MovieDTO, error)
+	CreateMoviesFunc      func(ctx context.Context, cmds []movieApp.CreateMovieCommand) ([]*movieApp.MovieDTO, []error, error)
 	UpdateMovieFunc       func(ctx context.Context, cmd movieApp.UpdateMovieCommand) (*movieApp.MovieDTO, error)
 	DeleteMovieFunc       func(ctx context.Context, id int) error
 	SearchMoviesFunc      func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error)
@@ -32,6 +42,13 @@ func (m *MockMovieService) CreateMovie(ctx context.Context, cmd movieApp.CreateM
 	return nil, errors.New("not implemented")
 }
 
+func (m *MockMovieService) CreateMovies(ctx context.Context, cmds []movieApp.CreateMovieCommand) ([]*movieApp.MovieDTO, []error, error) {
+	if m.CreateMoviesFunc != nil {
+		return m.CreateMoviesFunc(ctx, cmds)
+	}
+	return nil, nil, errors.New("not implemented")
+}
+
 func (m *MockMovieService) UpdateMovie(ctx context.Context, cmd movieApp.UpdateMovieCommand) (*movieApp.MovieDTO, error) {
 	if m.UpdateMovieFunc != nil {
 		return m.UpdateMovieFunc(ctx, cmd)
@@ -93,9 +110,7 @@ func TestGetMovie_Success(t *testing.T) {
 		t.Fatalf("Expected no error, got: %v", err)
 	}
 
-	if result != nil {
-		t.Errorf("Expected result to be nil, got: %v", result)
-	}
+	assertSummaryResult(t, result)
 
 	// Verify output
 	if output.ID != 42 {
@@ -135,11 +150,42 @@ func TestGetMovie_Success(t *testing.T) {
 	}
 }
 
+func TestGetMovie_StoredPosterURL(t *testing.T) {
+	mockService := &MockMovieService{
+		GetMovieFunc: func(ctx context.Context, id int) (*movieApp.MovieDTO, error) {
+			return &movieApp.MovieDTO{ID: id, Title: "Heat", Year: 1995}, nil
+		},
+	}
+	posters := &MockPosterService{
+		GetPosterFunc: func(ctx context.Context, movieID int) (*posterApp.PosterImageDTO, error) {
+			if movieID != 1 {
+				return nil, shared.NewNotFoundError("movie poster not found")
+			}
+			return &posterApp.PosterImageDTO{PosterDTO: posterApp.PosterDTO{MovieID: 1, MimeType: "image/png"}, Data: []byte("png")}, nil
+		},
+	}
+	tools := NewMovieTools(mockService)
+	tools.SetPosterCDN(posters, posterApp.NewCDNSigner("https://cdn.example.com", "", 0))
+
+	_, output, err := tools.GetMovie(context.Background(), nil, GetMovieInput{MovieID: 1})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !strings.HasPrefix(output.StoredPosterURL, "https://cdn.example.com/posters/1-") || output.StoredPosterExpiresAt != "" {
+		t.Errorf("Expected the unsigned CDN URL of the poster, got: %+v", output)
+	}
+
+	_, output, err = tools.GetMovie(context.Background(), nil, GetMovieInput{MovieID: 2})
+	if err != nil || output.StoredPosterURL != "" {
+		t.Errorf("Expected no CDN URL for a movie without a poster, got: %q, %v", output.StoredPosterURL, err)
+	}
+}
+
 func TestGetMovie_NotFound(t *testing.T) {
 	// Arrange
 	mockService := &MockMovieService{
 		GetMovieFunc: func(ctx context.Context, id int) (*movieApp.MovieDTO, error) {
-			return nil, errors.New("movie not found")
+			return nil, shared.NewNotFoundError("movie not found")
 		},
 	}
 
@@ -291,9 +337,7 @@ func TestAddMovie_Success(t *testing.T) {
 		t.Fatalf("Expected no error, got: %v", err)
 	}
 
-	if result != nil {
-		t.Errorf("Expected result to be nil, got: %v", result)
-	}
+	assertSummaryResult(t, result)
 
 	if output.ID != 1 {
 		t.Errorf("Expected ID to be 1, got: %d", output.ID)
@@ -349,17 +393,22 @@ func TestAddMovie_ServiceError(t *testing.T) {
 
 // ===== UpdateMovie Tests =====
 
+// ptr returns a pointer to value, for the optional fields of update inputs
+func ptr[T any](value T) *T {
+	return &value
+}
+
 func TestUpdateMovie_Success(t *testing.T) {
 	mockService := &MockMovieService{
 		UpdateMovieFunc: func(ctx context.Context, cmd movieApp.UpdateMovieCommand) (*movieApp.MovieDTO, error) {
 			return &movieApp.MovieDTO{
 				ID:        cmd.ID,
-				Title:     cmd.Title,
-				Director:  cmd.Director,
-				Year:      cmd.Year,
-				Rating:    cmd.Rating,
+				Title:     *cmd.Title,
+				Director:  *cmd.Director,
+				Year:      *cmd.Year,
+				Rating:    *cmd.Rating,
 				Genres:    cmd.Genres,
-				PosterURL: cmd.PosterURL,
+				PosterURL: *cmd.PosterURL,
 				CreatedAt: "2025-01-01T00:00:00Z",
 				UpdatedAt: "2025-01-02T00:00:00Z",
 			}, nil
@@ -371,12 +420,12 @@ func TestUpdateMovie_Success(t *testing.T) {
 
 	input := UpdateMovieInput{
 		ID:        1,
-		Title:     "Updated Title",
-		Director:  "Updated Director",
-		Year:      2021,
-		Rating:    9.0,
+		Title:     ptr("Updated Title"),
+		Director:  ptr("Updated Director"),
+		Year:      ptr(2021),
+		Rating:    ptr(9.0),
 		Genres:    []string{"Drama"},
-		PosterURL: "https://example.com/updated.jpg",
+		PosterURL: ptr("https://example.com/updated.jpg"),
 	}
 
 	result, output, err := tools.UpdateMovie(ctx, nil, input)
@@ -385,9 +434,7 @@ func TestUpdateMovie_Success(t *testing.T) {
 		t.Fatalf("Expected no error, got: %v", err)
 	}
 
-	if result != nil {
-		t.Errorf("Expected result to be nil, got: %v", result)
-	}
+	assertSummaryResult(t, result)
 
 	if output.Title != "Updated Title" {
 		t.Errorf("Expected title 'Updated Title', got: %s", output.Title)
@@ -402,10 +449,45 @@ func TestUpdateMovie_Success(t *testing.T) {
 	}
 }
 
+func TestUpdateMovie_LeavesOutUnsentFields(t *testing.T) {
+	var got movieApp.UpdateMovieCommand
+	mockService := &MockMovieService{
+		UpdateMovieFunc: func(ctx context.Context, cmd movieApp.UpdateMovieCommand) (*movieApp.MovieDTO, error) {
+			got = cmd
+			return &movieApp.MovieDTO{ID: cmd.ID, Title: "Heat"}, nil
+		},
+	}
+	tools := NewMovieTools(mockService)
+
+	var input UpdateMovieInput
+	if err := json.Unmarshal([]byte(`{"id": 1, "rating": 4, "scale": 5, "release_date": "", "genres": []}`), &input); err != nil {
+		t.Fatalf("Failed to decode input: %v", err)
+	}
+	if _, _, err := tools.UpdateMovie(context.Background(), nil, input); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if got.Title != nil || got.Director != nil || got.Year != nil || got.Duration != nil || got.PosterURL != nil {
+		t.Errorf("Expected unsent fields to be left out, got: %+v", got)
+	}
+	if got.Certifications != nil || got.ContentWarnings != nil {
+		t.Errorf("Expected unsent lists to be nil, got: %+v", got)
+	}
+	if got.Rating == nil || *got.Rating != 8 {
+		t.Errorf("Expected the rating converted to 8, got: %v", got.Rating)
+	}
+	if got.ReleaseDate == nil || !got.ReleaseDate.IsZero() {
+		t.Errorf("Expected an empty release date to clear it, got: %v", got.ReleaseDate)
+	}
+	if got.Genres == nil || len(got.Genres) != 0 {
+		t.Errorf("Expected empty genres to clear them, got: %#v", got.Genres)
+	}
+}
+
 func TestUpdateMovie_NotFound(t *testing.T) {
 	mockService := &MockMovieService{
 		UpdateMovieFunc: func(ctx context.Context, cmd movieApp.UpdateMovieCommand) (*movieApp.MovieDTO, error) {
-			return nil, errors.New("movie not found")
+			return nil, shared.NewNotFoundError("movie not found")
 		},
 	}
 
@@ -413,10 +495,8 @@ func TestUpdateMovie_NotFound(t *testing.T) {
 	ctx := context.Background()
 
 	input := UpdateMovieInput{
-		ID:       999,
-		Title:    "Test",
-		Director: "Test",
-		Year:     2020,
+		ID:    999,
+		Title: ptr("Test"),
 	}
 
 	_, _, err := tools.UpdateMovie(ctx, nil, input)
@@ -441,10 +521,8 @@ func TestUpdateMovie_ServiceError(t *testing.T) {
 	ctx := context.Background()
 
 	input := UpdateMovieInput{
-		ID:       1,
-		Title:    "Test",
-		Director: "Test",
-		Year:     2020,
+		ID:    1,
+		Title: ptr("Test"),
 	}
 
 	_, _, err := tools.UpdateMovie(ctx, nil, input)
@@ -460,6 +538,96 @@ func TestUpdateMovie_ServiceError(t *testing.T) {
 
 // ===== DeleteMovie Tests =====
 
+func TestAddOrUpdateMovie_CreatesMissingMovie(t *testing.T) {
+	var created movieApp.CreateMovieCommand
+	mockService := &MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, q movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			return []*movieApp.MovieDTO{{ID: 3, Title: "Heat Wave", Year: 1995}}, nil
+		},
+		CreateMovieFunc: func(ctx context.Context, cmd movieApp.CreateMovieCommand) (*movieApp.MovieDTO, error) {
+			created = cmd
+			return &movieApp.MovieDTO{ID: 9, Title: cmd.Title, Director: cmd.Director, Year: cmd.Year}, nil
+		},
+	}
+
+	input := AddOrUpdateMovieInput{Title: "  Heat ", Director: "Michael Mann", ReleaseDate: "1995-12-15"}
+	result, output, err := NewMovieTools(mockService).AddOrUpdateMovie(context.Background(), nil, input)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	assertSummaryResult(t, result)
+	if output.Action != "created" || output.Movie.ID != 9 {
+		t.Errorf("Expected movie 9 created, got: %+v", output)
+	}
+	if created.Title != "Heat" || created.Year != 1995 {
+		t.Errorf("Expected Heat from 1995 created, got: %+v", created)
+	}
+}
+
+func TestAddOrUpdateMovie_UpdatesMatchingMovie(t *testing.T) {
+	var query movieApp.SearchMoviesQuery
+	var updated movieApp.UpdateMovieCommand
+	mockService := &MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, q movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			query = q
+			return []*movieApp.MovieDTO{{ID: 7, Title: "The  Insider", Year: 1999}}, nil
+		},
+		UpdateMovieFunc: func(ctx context.Context, cmd movieApp.UpdateMovieCommand) (*movieApp.MovieDTO, error) {
+			updated = cmd
+			return &movieApp.MovieDTO{ID: cmd.ID, Title: "The  Insider", Year: 1999, Rating: *cmd.Rating}, nil
+		},
+	}
+
+	input := AddOrUpdateMovieInput{Title: "the insider", Year: 1999, Rating: 4, Scale: 5}
+	_, output, err := NewMovieTools(mockService).AddOrUpdateMovie(context.Background(), nil, input)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Action != "updated" || output.Movie.ID != 7 || output.Movie.ScaledRating != 4 {
+		t.Errorf("Expected movie 7 updated, got: %+v", output)
+	}
+	if query.Title != "the insider" || query.MinYear != 1999 || query.MaxYear != 1999 {
+		t.Errorf("Expected a search for the title in 1999, got: %+v", query)
+	}
+	if updated.Title != nil || updated.Director != nil || updated.Genres != nil || *updated.Rating != 8 {
+		t.Errorf("Expected only the rating updated, got: %+v", updated)
+	}
+}
+
+func TestAddOrUpdateMovie_AmbiguousMatch(t *testing.T) {
+	mockService := &MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, q movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			return []*movieApp.MovieDTO{{ID: 1, Title: "Solaris", Year: 1972}, {ID: 2, Title: "Solaris", Year: 1972}}, nil
+		},
+	}
+
+	_, _, err := NewMovieTools(mockService).AddOrUpdateMovie(context.Background(), nil, AddOrUpdateMovieInput{Title: "Solaris", Year: 1972})
+	if !errors.Is(err, shared.ErrConflict) {
+		t.Errorf("Expected a conflict error, got: %v", err)
+	}
+}
+
+func TestAddOrUpdateMovieInput_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		input AddOrUpdateMovieInput
+		valid bool
+	}{
+		{"with year", AddOrUpdateMovieInput{Title: "Heat", Year: 1995}, true},
+		{"with release date", AddOrUpdateMovieInput{Title: "Heat", ReleaseDate: "1995-12-15"}, true},
+		{"no title", AddOrUpdateMovieInput{Title: " ", Year: 1995}, false},
+		{"no year", AddOrUpdateMovieInput{Title: "Heat"}, false},
+		{"bad release date", AddOrUpdateMovieInput{Title: "Heat", ReleaseDate: "1995"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.input.Validate(); (err == nil) != tt.valid {
+				t.Errorf("Validate() = %v, want valid %v", err, tt.valid)
+			}
+		})
+	}
+}
+
 func TestDeleteMovie_Success(t *testing.T) {
 	mockService := &MockMovieService{
 		DeleteMovieFunc: func(ctx context.Context, id int) error {
@@ -480,9 +648,7 @@ func TestDeleteMovie_Success(t *testing.T) {
 		t.Fatalf("Expected no error, got: %v", err)
 	}
 
-	if result != nil {
-		t.Errorf("Expected result to be nil, got: %v", result)
-	}
+	assertSummaryResult(t, result)
 
 	if output.Message != "Movie deleted successfully" {
 		t.Errorf("Expected success message, got: %s", output.Message)
@@ -492,7 +658,7 @@ func TestDeleteMovie_Success(t *testing.T) {
 func TestDeleteMovie_NotFound(t *testing.T) {
 	mockService := &MockMovieService{
 		DeleteMovieFunc: func(ctx context.Context, id int) error {
-			return errors.New("movie not found")
+			return shared.NewNotFoundError("movie not found")
 		},
 	}
 
@@ -539,6 +705,72 @@ func TestDeleteMovie_ServiceError(t *testing.T) {
 	}
 }
 
+func TestDeleteMovie_ByTitle(t *testing.T) {
+	var deleted int
+	var query movieApp.SearchMoviesQuery
+	mockService := &MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, q movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			query = q
+			return []*movieApp.MovieDTO{
+				{ID: 3, Title: "Heat Wave", Year: 1995},
+				{ID: 7, Title: "heat", Year: 1995},
+			}, nil
+		},
+		DeleteMovieFunc: func(ctx context.Context, id int) error {
+			deleted = id
+			return nil
+		},
+	}
+
+	_, output, err := NewMovieTools(mockService).DeleteMovie(context.Background(), nil, DeleteMovieInput{Title: " Heat ", Year: 1995})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if deleted != 7 || output.ID != 7 {
+		t.Errorf("Expected the exact title match to be deleted, got: %d (output %d)", deleted, output.ID)
+	}
+	if query.Title != "Heat" || query.MinYear != 1995 || query.MaxYear != 1995 {
+		t.Errorf("Expected a search for Heat from 1995, got: %+v", query)
+	}
+}
+
+func TestDeleteMovie_TitleNotFound(t *testing.T) {
+	mockService := &MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, q movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			return []*movieApp.MovieDTO{{ID: 3, Title: "Heat Wave", Year: 1995}}, nil
+		},
+	}
+
+	_, _, err := NewMovieTools(mockService).DeleteMovie(context.Background(), nil, DeleteMovieInput{Title: "Heat"})
+	if !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("Expected a not found error, got: %v", err)
+	}
+}
+
+func TestDeleteMovieInput_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		input DeleteMovieInput
+		valid bool
+	}{
+		{"by ID", DeleteMovieInput{MovieID: 1}, true},
+		{"by title", DeleteMovieInput{Title: "Heat", Year: 1995}, true},
+		{"neither", DeleteMovieInput{Title: "  "}, false},
+		{"both", DeleteMovieInput{MovieID: 1, Title: "Heat"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input.Validate()
+			if tt.valid && err != nil {
+				t.Errorf("Expected a valid input, got: %v", err)
+			}
+			if !tt.valid && !errors.Is(err, shared.ErrValidation) {
+				t.Errorf("Expected a validation error, got: %v", err)
+			}
+		})
+	}
+}
+
 // ===== ListTopMovies Tests =====
 
 func TestListTopMovies_Success(t *testing.T) {
@@ -582,9 +814,7 @@ func TestListTopMovies_Success(t *testing.T) {
 		t.Fatalf("Expected no error, got: %v", err)
 	}
 
-	if result != nil {
-		t.Errorf("Expected result to be nil, got: %v", result)
-	}
+	assertSummaryResult(t, result)
 
 	if len(output.Movies) != 2 {
 		t.Errorf("Expected 2 movies, got: %d", len(output.Movies))
@@ -693,9 +923,7 @@ func TestSearchMovies_Success(t *testing.T) {
 		t.Fatalf("Expected no error, got: %v", err)
 	}
 
-	if result != nil {
-		t.Errorf("Expected result to be nil, got: %v", result)
-	}
+	assertSummaryResult(t, result)
 
 	if len(output.Movies) != 1 {
 		t.Errorf("Expected 1 movie, got: %d", len(output.Movies))
@@ -710,6 +938,278 @@ func TestSearchMovies_Success(t *testing.T) {
 	}
 }
 
+func TestSearchMovies_PassesSortKeys(t *testing.T) {
+	var gotQuery movieApp.SearchMoviesQuery
+	mockService := &MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			gotQuery = query
+			return nil, nil
+		},
+	}
+
+	tools := NewMovieTools(mockService)
+	input := SearchMoviesInput{
+		Sort: []SortKeyInput{{Field: "rating", Direction: "desc"}, {Field: "year"}},
+	}
+
+	_, _, err := tools.SearchMovies(context.Background(), nil, input)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	want := []movieApp.SortKey{{Field: "rating", Dir: "desc"}, {Field: "year"}}
+	if !reflect.DeepEqual(gotQuery.Sort, want) {
+		t.Errorf("Expected sort keys %v, got: %v", want, gotQuery.Sort)
+	}
+}
+
+func TestAddMovie_WithContentAdvisory(t *testing.T) {
+	var gotCmd movieApp.CreateMovieCommand
+	mockService := &MockMovieService{
+		CreateMovieFunc: func(ctx context.Context, cmd movieApp.CreateMovieCommand) (*movieApp.MovieDTO, error) {
+			gotCmd = cmd
+			return &movieApp.MovieDTO{
+				ID:              1,
+				Title:           cmd.Title,
+				Certifications:  map[string]string{"US": "R"},
+				ContentWarnings: []string{"violence"},
+			}, nil
+		},
+	}
+
+	tools := NewMovieTools(mockService)
+	input := AddMovieInput{
+		Title:           "Pulp Fiction",
+		Director:        "Quentin Tarantino",
+		Year:            1994,
+		Certifications:  map[string]string{"US": "R"},
+		ContentWarnings: []string{"violence"},
+	}
+
+	_, output, err := tools.AddMovie(context.Background(), nil, input)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if gotCmd.Certifications["US"] != "R" || len(gotCmd.ContentWarnings) != 1 {
+		t.Errorf("Expected advisory to reach the command, got: %+v", gotCmd)
+	}
+	if output.Certifications["US"] != "R" || output.ContentWarnings[0] != "violence" {
+		t.Errorf("Expected advisory in output, got: %v %v", output.Certifications, output.ContentWarnings)
+	}
+}
+
+func TestAddMovie_WithReleaseDate(t *testing.T) {
+	var gotCmd movieApp.CreateMovieCommand
+	mockService := &MockMovieService{
+		CreateMovieFunc: func(ctx context.Context, cmd movieApp.CreateMovieCommand) (*movieApp.MovieDTO, error) {
+			gotCmd = cmd
+			return &movieApp.MovieDTO{ID: 1, Title: cmd.Title, Year: 1999, ReleaseDate: "1999-03-31"}, nil
+		},
+	}
+
+	tools := NewMovieTools(mockService)
+	input := AddMovieInput{Title: "The Matrix", Director: "Wachowskis", ReleaseDate: "1999-03-31"}
+
+	_, output, err := tools.AddMovie(context.Background(), nil, input)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if gotCmd.Year != 0 || gotCmd.ReleaseDate.Format("2006-01-02") != "1999-03-31" {
+		t.Errorf("Expected the release date to reach the command, got: %+v", gotCmd)
+	}
+	if output.Year != 1999 || output.ReleaseDate != "1999-03-31" {
+		t.Errorf("Expected year and release date in output, got: %d %q", output.Year, output.ReleaseDate)
+	}
+}
+
+func TestAddMovieInput_Validate_ReleaseDate(t *testing.T) {
+	for _, releaseDate := range []string{"1999", "1999-03", "31/03/1999", "1999-02-30"} {
+		input := AddMovieInput{Title: "The Matrix", Director: "Wachowskis", ReleaseDate: releaseDate}
+		if err := input.Validate(); !errors.Is(err, shared.ErrValidation) {
+			t.Errorf("Expected a validation error for release_date %q, got: %v", releaseDate, err)
+		}
+	}
+}
+
+func TestSearchMovies_PassesDurationFilters(t *testing.T) {
+	var gotQuery movieApp.SearchMoviesQuery
+	mockService := &MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			gotQuery = query
+			return nil, nil
+		},
+	}
+	tools := NewMovieTools(mockService)
+
+	tests := []struct {
+		name    string
+		input   SearchMoviesInput
+		wantMin int
+		wantMax int
+	}{
+		{name: "bounds", input: SearchMoviesInput{MinDuration: 90, MaxDuration: 120}, wantMin: 90, wantMax: 120},
+		{name: "short films", input: SearchMoviesInput{ShortFilmsOnly: true}, wantMax: 40},
+		{name: "short films under a lower maximum", input: SearchMoviesInput{ShortFilmsOnly: true, MaxDuration: 20}, wantMax: 20},
+		{name: "short films cap a higher maximum", input: SearchMoviesInput{ShortFilmsOnly: true, MinDuration: 10, MaxDuration: 90}, wantMin: 10, wantMax: 40},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.input.Validate(); err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+			if _, _, err := tools.SearchMovies(context.Background(), nil, tt.input); err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if gotQuery.MinDuration != tt.wantMin || gotQuery.MaxDuration != tt.wantMax {
+				t.Errorf("Expected durations %d-%d, got: %d-%d", tt.wantMin, tt.wantMax, gotQuery.MinDuration, gotQuery.MaxDuration)
+			}
+		})
+	}
+}
+
+func TestSearchMoviesInput_Validate_Durations(t *testing.T) {
+	invalid := []SearchMoviesInput{
+		{MinDuration: -1},
+		{MinDuration: 120, MaxDuration: 90},
+		{MinDuration: 60, ShortFilmsOnly: true},
+	}
+	for _, input := range invalid {
+		if err := input.Validate(); !errors.Is(err, shared.ErrValidation) {
+			t.Errorf("Expected a validation error for %+v, got: %v", input, err)
+		}
+	}
+}
+
+func TestSearchMovies_PassesAdvisoryFilters(t *testing.T) {
+	var gotQuery movieApp.SearchMoviesQuery
+	mockService := &MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			gotQuery = query
+			return nil, nil
+		},
+	}
+
+	tools := NewMovieTools(mockService)
+	input := SearchMoviesInput{
+		MaxCertification:       "12A",
+		CertificationRegion:    "GB",
+		ExcludeContentWarnings: []string{"violence"},
+	}
+
+	_, _, err := tools.SearchMovies(context.Background(), nil, input)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if gotQuery.MaxCertification != "12A" || gotQuery.CertificationRegion != "GB" {
+		t.Errorf("Expected GB 12A ceiling, got: %s %s", gotQuery.CertificationRegion, gotQuery.MaxCertification)
+	}
+	if !reflect.DeepEqual(gotQuery.ExcludeWarnings, []string{"violence"}) {
+		t.Errorf("Expected excluded warnings to be passed, got: %v", gotQuery.ExcludeWarnings)
+	}
+}
+
+func TestSearchMovies_FuzzyPassesScores(t *testing.T) {
+	var gotQuery movieApp.SearchMoviesQuery
+	mockService := &MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			gotQuery = query
+			return []*movieApp.MovieDTO{
+				{ID: 1, Title: "The Shawshank Redemption", Director: "Frank Darabont", Year: 1994, Similarity: 0.792},
+			}, nil
+		},
+	}
+
+	tools := NewMovieTools(mockService)
+
+	_, output, err := tools.SearchMovies(context.Background(), nil, SearchMoviesInput{Title: "Shawshenk Redemption", Fuzzy: true})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !gotQuery.Fuzzy || gotQuery.Title != "Shawshenk Redemption" {
+		t.Errorf("Expected fuzzy title query, got: %+v", gotQuery)
+	}
+	if len(output.Movies) != 1 || output.Movies[0].Similarity != 0.792 {
+		t.Errorf("Expected similarity score in output, got: %+v", output.Movies)
+	}
+	validateAgainstSchema(t, OutputSchema[SearchMoviesOutput](), output)
+}
+
+func TestSearchMovies_ExplainPassesExplanations(t *testing.T) {
+	var gotQuery movieApp.SearchMoviesQuery
+	mockService := &MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			gotQuery = query
+			return []*movieApp.MovieDTO{
+				{
+					ID: 1, Title: "Inception", Director: "Christopher Nolan", Year: 2010, Rating: 8.8,
+					Explanation: &movieApp.ExplanationDTO{
+						Matched: []movieApp.MatchDTO{{Criterion: "rating", Detail: "8.8 is at least 8"}},
+						Sort:    []movieApp.SortValueDTO{{Field: "rating", Direction: "desc", Value: "8.8"}},
+						Rank:    1,
+					},
+				},
+			}, nil
+		},
+	}
+
+	tools := NewMovieTools(mockService)
+
+	_, output, err := tools.SearchMovies(context.Background(), nil, SearchMoviesInput{MinRating: 8, OrderBy: "rating", OrderDir: "desc", Explain: true})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !gotQuery.Explain {
+		t.Errorf("Expected explain to be passed through, got: %+v", gotQuery)
+	}
+	explanation := output.Movies[0].Explanation
+	if explanation == nil || len(explanation.Matched) != 1 || explanation.Sort[0].Value != "8.8" || explanation.Rank != 1 {
+		t.Errorf("Expected the explanation in the output, got: %+v", explanation)
+	}
+	validateAgainstSchema(t, OutputSchema[SearchMoviesOutput](), output)
+}
+
+func TestSearchMovies_RankingWeightsPassScores(t *testing.T) {
+	var gotQuery movieApp.SearchMoviesQuery
+	mockService := &MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			gotQuery = query
+			return []*movieApp.MovieDTO{
+				{
+					ID: 1, Title: "Inception", Director: "Christopher Nolan", Year: 2010, Rating: 8.8, Score: 0.88,
+					Explanation: &movieApp.ExplanationDTO{
+						Sort:    []movieApp.SortValueDTO{{Field: "score", Direction: "desc", Value: "0.88"}},
+						Score:   0.88,
+						Signals: []movieApp.SignalScoreDTO{{Signal: "rating", Weight: 1, Value: 0.88}},
+						Rank:    1,
+					},
+				},
+			}, nil
+		},
+	}
+
+	tools := NewMovieTools(mockService)
+
+	_, output, err := tools.SearchMovies(context.Background(), nil, SearchMoviesInput{RankingWeights: map[string]float64{"rating": 1}, Explain: true})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !gotQuery.Ranked || gotQuery.RankingWeights["rating"] != 1 {
+		t.Errorf("Expected a ranked query with the weights, got: %+v", gotQuery)
+	}
+	movie := output.Movies[0]
+	if movie.Score != 0.88 || len(movie.Explanation.Signals) != 1 || movie.Explanation.Signals[0].Signal != "rating" {
+		t.Errorf("Expected the score and its signals in the output, got: %+v", movie)
+	}
+	validateAgainstSchema(t, OutputSchema[SearchMoviesOutput](), output)
+}
+
 func TestSearchMovies_DefaultLimit(t *testing.T) {
 	mockService := &MockMovieService{
 		SearchMoviesFunc: func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
@@ -824,6 +1324,126 @@ func TestSearchMovies_ServiceError(t *testing.T) {
 	}
 }
 
+// ===== SearchMoviesV2 Tests =====
+
+// pagedMovieService serves a library of count movies, honouring offset and limit
+func pagedMovieService(count int) *MockMovieService {
+	return &MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			var movies []*movieApp.MovieDTO
+			for id := query.Offset + 1; id <= count && len(movies) < query.Limit; id++ {
+				movies = append(movies, &movieApp.MovieDTO{ID: id, Title: "Movie", Director: "Director", Year: 2000})
+			}
+			return movies, nil
+		},
+	}
+}
+
+func TestSearchMoviesV2_PagesWithCursors(t *testing.T) {
+	tools := NewMovieTools(pagedMovieService(5))
+	input := SearchMoviesV2Input{Genre: "Drama", PageSize: 2}
+
+	var ids []int
+	pages := 0
+	for {
+		result, output, err := tools.SearchMoviesV2(context.Background(), nil, input)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		assertSummaryResult(t, result)
+		pages++
+		for _, movie := range output.Movies {
+			ids = append(ids, movie.ID)
+		}
+		if output.NextCursor == "" {
+			break
+		}
+		input.Cursor = output.NextCursor
+	}
+
+	if pages != 3 || !reflect.DeepEqual(ids, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("Expected movies 1 to 5 over 3 pages, got %v over %d", ids, pages)
+	}
+}
+
+func TestSearchMoviesV2_TruncationResumes(t *testing.T) {
+	tools := NewMovieTools(pagedMovieService(5))
+	input := SearchMoviesV2Input{PageSize: 4}
+
+	_, output, err := tools.SearchMoviesV2(context.Background(), nil, input)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	truncated := output.Truncate(1, middleware.Truncation{}).(SearchMoviesV2Output)
+
+	input.Cursor = truncated.NextCursor
+	_, output, err = tools.SearchMoviesV2(context.Background(), nil, input)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(output.Movies) == 0 || output.Movies[0].ID != 2 {
+		t.Errorf("Expected the next page to start with the first movie left out, got %+v", output.Movies)
+	}
+}
+
+func TestSearchMoviesV2Input_Validate(t *testing.T) {
+	firstPage := SearchMoviesV2Input{Title: "Heat", PageSize: 1}
+	next := firstPage.cursor(1)
+
+	tests := []struct {
+		name    string
+		input   SearchMoviesV2Input
+		wantErr bool
+	}{
+		{name: "first page", input: firstPage},
+		{name: "next page", input: SearchMoviesV2Input{Title: "Heat", PageSize: 1, Cursor: next}},
+		{name: "next page in another language", input: SearchMoviesV2Input{Title: "Heat", Language: "fr", Cursor: next}},
+		{name: "cursor of another search", input: SearchMoviesV2Input{Title: "Alien", Cursor: next}, wantErr: true},
+		{name: "malformed cursor", input: SearchMoviesV2Input{Cursor: "not a cursor"}, wantErr: true},
+		{name: "page too large", input: SearchMoviesV2Input{PageSize: maxSearchPageSize + 1}, wantErr: true},
+		{name: "invalid filters", input: SearchMoviesV2Input{MinDuration: -1}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, shared.ErrValidation) {
+				t.Errorf("Expected a validation error, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestSearchMoviesV2Input_KeepsSearchMoviesFilters(t *testing.T) {
+	properties := func(schema *jsonschema.Schema) []string {
+		var names []string
+		for name := range schema.Properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return names
+	}
+	v1, err := jsonschema.For[SearchMoviesInput](nil)
+	if err != nil {
+		t.Fatalf("failed to derive the search_movies schema: %v", err)
+	}
+	v2, err := jsonschema.For[SearchMoviesV2Input](nil)
+	if err != nil {
+		t.Fatalf("failed to derive the search_movies_v2 schema: %v", err)
+	}
+
+	delete(v1.Properties, "limit")
+	delete(v1.Properties, "offset")
+	delete(v2.Properties, "page_size")
+	delete(v2.Properties, "cursor")
+	if got, want := properties(v2), properties(v1); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected search_movies_v2 to take the filters of search_movies %v, got %v", want, got)
+	}
+}
+
 // ===== SearchByDecade Tests =====
 
 func TestSearchByDecade_Success_1990s(t *testing.T) {
@@ -857,9 +1477,7 @@ func TestSearchByDecade_Success_1990s(t *testing.T) {
 		t.Fatalf("Expected no error, got: %v", err)
 	}
 
-	if result != nil {
-		t.Errorf("Expected result to be nil, got: %v", result)
-	}
+	assertSummaryResult(t, result)
 
 	if len(output.Movies) != 1 {
 		t.Errorf("Expected 1 movie, got: %d", len(output.Movies))
@@ -918,6 +1536,34 @@ func TestSearchByDecade_Success_2000s(t *testing.T) {
 	}
 }
 
+func TestSearchByDecade_Success_Words(t *testing.T) {
+	mockService := &MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			if query.MinYear != 1980 || query.MaxYear != 1989 {
+				t.Errorf("Expected year range 1980-1989, got: %d-%d", query.MinYear, query.MaxYear)
+			}
+			return []*movieApp.MovieDTO{}, nil
+		},
+	}
+
+	tools := NewMovieTools(mockService)
+	ctx := context.Background()
+
+	input := SearchByDecadeInput{
+		Decade: "the Eighties",
+	}
+
+	_, output, err := tools.SearchByDecade(ctx, nil, input)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if output.Description != "Movies from the 1980s" {
+		t.Errorf("Expected description 'Movies from the 1980s', got: %s", output.Description)
+	}
+}
+
 func TestSearchByDecade_InvalidFormat(t *testing.T) {
 	mockService := &MockMovieService{}
 
@@ -998,9 +1644,7 @@ func TestSearchByRatingRange_Success_BothLimits(t *testing.T) {
 		t.Fatalf("Expected no error, got: %v", err)
 	}
 
-	if result != nil {
-		t.Errorf("Expected result to be nil, got: %v", result)
-	}
+	assertSummaryResult(t, result)
 
 	if len(output.Movies) != 1 {
 		t.Errorf("Expected 1 movie, got: %d", len(output.Movies))
@@ -1068,14 +1712,7 @@ func TestSearchByRatingRange_MaxOnly(t *testing.T) {
 }
 
 func TestSearchByRatingRange_NoRating(t *testing.T) {
-	mockService := &MockMovieService{}
-
-	tools := NewMovieTools(mockService)
-	ctx := context.Background()
-
-	input := SearchByRatingRangeInput{}
-
-	_, _, err := tools.SearchByRatingRange(ctx, nil, input)
+	err := SearchByRatingRangeInput{}.Validate()
 
 	if err == nil {
 		t.Fatal("Expected error for no rating provided, got nil")
@@ -1087,11 +1724,6 @@ func TestSearchByRatingRange_NoRating(t *testing.T) {
 }
 
 func TestSearchByRatingRange_InvalidMinRating(t *testing.T) {
-	mockService := &MockMovieService{}
-
-	tools := NewMovieTools(mockService)
-	ctx := context.Background()
-
 	tests := []struct {
 		name      string
 		minRating float64
@@ -1137,7 +1769,7 @@ func TestSearchByRatingRange_InvalidMinRating(t *testing.T) {
 				MaxRating: tt.maxRating,
 			}
 
-			_, _, err := tools.SearchByRatingRange(ctx, nil, input)
+			err := input.Validate()
 
 			if err == nil {
 				t.Fatal("Expected error, got nil")
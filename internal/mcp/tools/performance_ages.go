@@ -0,0 +1,281 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	actorApp "github.com/francknouama/movies-mcp-server/internal/application/actor"
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/i18n"
+)
+
+// Reasons an age at release is unknown
+const (
+	AgeUnknownBirthYear   = "birth_year_unknown"
+	AgeUnknownReleaseYear = "release_year_unknown"
+	AgeUnknownBornAfter   = "born_after_release"
+	AgeUnknownPosthumous  = "posthumous"
+)
+
+// performanceAgePageSize is how many actors or movies are read at a time
+const performanceAgePageSize = 500
+
+// maxPerformanceAges bounds the youngest and oldest performances listed
+const maxPerformanceAges = 20
+
+// releaseYear returns a movie's release year, read from its release date
+// when the year is missing; 0 when neither is known
+func releaseYear(movie *movieApp.MovieDTO) int {
+	if movie.Year > 0 {
+		return movie.Year
+	}
+	if date, err := time.Parse(time.DateOnly, movie.ReleaseDate); err == nil {
+		return date.Year()
+	}
+	return 0
+}
+
+// ageAtRelease returns the age an actor turns in a movie's release year.
+// Only birth years are recorded, so the actor may have been a year younger
+// on the release date. Without an age it returns why: a birth or release
+// year is unknown, the actor was born after the release, which is a data
+// error, or the movie came out after their death.
+func ageAtRelease(actorDTO *actorApp.ActorDTO, year int) (int, string) {
+	switch {
+	case actorDTO.BirthYear <= 0:
+		return 0, AgeUnknownBirthYear
+	case year <= 0:
+		return 0, AgeUnknownReleaseYear
+	case year < actorDTO.BirthYear:
+		return 0, AgeUnknownBornAfter
+	case actorDTO.DeathYear > 0 && year > actorDTO.DeathYear:
+		return 0, AgeUnknownPosthumous
+	}
+	return year - actorDTO.BirthYear, ""
+}
+
+// SetMovieReader lets get_movie_cast report each actor's age at release
+// and enables analyze_performance_ages
+func (t *ActorTools) SetMovieReader(movies MovieBatchGetter) {
+	t.movies = movies
+}
+
+// ===== analyze_performance_ages Tool =====
+
+// AnalyzePerformanceAgesInput defines the input schema for analyze_performance_ages tool
+type AnalyzePerformanceAgesInput struct {
+	ActorID int `json:"actor_id,omitempty" jsonschema:"Only this actor's performances (default every actor's)"`
+	Limit   int `json:"limit,omitempty" jsonschema:"Performances to list at each end (default 5, max 20)"`
+}
+
+// Validate bounds the limit
+func (in AnalyzePerformanceAgesInput) Validate() error {
+	if in.ActorID < 0 {
+		return shared.NewValidationError("actor_id must be positive")
+	}
+	if in.Limit < 0 || in.Limit > maxPerformanceAges {
+		return shared.NewValidationError("limit must be between 1 and %d", maxPerformanceAges)
+	}
+	return nil
+}
+
+// PerformanceAgeOutput defines the output schema for a performance and the actor's age in it
+type PerformanceAgeOutput struct {
+	ActorID      int    `json:"actor_id" jsonschema:"Actor ID"`
+	Name         string `json:"name" jsonschema:"Actor name"`
+	MovieID      int    `json:"movie_id" jsonschema:"Movie ID"`
+	Title        string `json:"title" jsonschema:"Movie title"`
+	Year         int    `json:"year" jsonschema:"Release year"`
+	Character    string `json:"character,omitempty" jsonschema:"Character played"`
+	AgeAtRelease int    `json:"age_at_release" jsonschema:"Age the actor turned in the release year; they may have been a year younger on the release date"`
+}
+
+// UnknownAgesOutput counts the performances without an age, by reason
+type UnknownAgesOutput struct {
+	BirthYearUnknown   int `json:"birth_year_unknown" jsonschema:"The actor has no birth year"`
+	ReleaseYearUnknown int `json:"release_year_unknown" jsonschema:"The movie has no release year or no longer exists"`
+	BornAfterRelease   int `json:"born_after_release" jsonschema:"The movie came out before the actor's birth year, which suggests a data error"`
+	Posthumous         int `json:"posthumous" jsonschema:"The movie came out after the actor's death"`
+}
+
+// AnalyzePerformanceAgesOutput defines the output schema for analyze_performance_ages tool
+type AnalyzePerformanceAgesOutput struct {
+	Performances int                    `json:"performances" jsonschema:"Performances considered"`
+	KnownAges    int                    `json:"known_ages" jsonschema:"Performances with an age at release"`
+	AverageAge   float64                `json:"average_age" jsonschema:"Average age at release, rounded to one decimal; 0 when no age is known"`
+	Youngest     []PerformanceAgeOutput `json:"youngest" jsonschema:"Youngest performances, youngest first"`
+	Oldest       []PerformanceAgeOutput `json:"oldest" jsonschema:"Oldest performances, oldest first"`
+	Unknown      UnknownAgesOutput      `json:"unknown" jsonschema:"Performances without an age, by reason"`
+}
+
+// AnalyzePerformanceAges handles the analyze_performance_ages tool call.
+// Performances tied on age are listed by release year, then actor name.
+func (t *ActorTools) AnalyzePerformanceAges(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input AnalyzePerformanceAgesInput,
+) (*mcp.CallToolResult, AnalyzePerformanceAgesOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, AnalyzePerformanceAgesOutput{}, err
+	}
+	if t.movies == nil {
+		return nil, AnalyzePerformanceAgesOutput{}, shared.NewUnavailableError("movie lookups are not configured")
+	}
+	limit := input.Limit
+	if limit == 0 {
+		limit = 5
+	}
+
+	actorDTOs, err := t.performingActors(ctx, input.ActorID)
+	if err != nil {
+		return nil, AnalyzePerformanceAgesOutput{}, err
+	}
+	movies, err := t.creditedMovies(ctx, actorDTOs)
+	if err != nil {
+		return nil, AnalyzePerformanceAgesOutput{}, err
+	}
+
+	output := AnalyzePerformanceAgesOutput{}
+	var known []PerformanceAgeOutput
+	var totalAge int
+	for _, actorDTO := range actorDTOs {
+		for _, movieID := range actorDTO.MovieIDs {
+			output.Performances++
+			movie := movies[movieID]
+			year := 0
+			if movie != nil {
+				year = releaseYear(movie)
+			}
+
+			age, reason := ageAtRelease(actorDTO, year)
+			switch reason {
+			case AgeUnknownBirthYear:
+				output.Unknown.BirthYearUnknown++
+			case AgeUnknownReleaseYear:
+				output.Unknown.ReleaseYearUnknown++
+			case AgeUnknownBornAfter:
+				output.Unknown.BornAfterRelease++
+			case AgeUnknownPosthumous:
+				output.Unknown.Posthumous++
+			default:
+				known = append(known, PerformanceAgeOutput{
+					ActorID:      actorDTO.ID,
+					Name:         actorDTO.Name,
+					MovieID:      movieID,
+					Title:        movie.Title,
+					Year:         year,
+					Character:    movieCredit(actorDTO, movieID).Character,
+					AgeAtRelease: age,
+				})
+				totalAge += age
+			}
+		}
+	}
+
+	output.KnownAges = len(known)
+	if len(known) > 0 {
+		output.AverageAge = math.Round(float64(totalAge)/float64(len(known))*10) / 10
+	}
+	output.Youngest = agesOrdered(known, limit, func(a, b PerformanceAgeOutput) bool { return a.AgeAtRelease < b.AgeAtRelease })
+	output.Oldest = agesOrdered(known, limit, func(a, b PerformanceAgeOutput) bool { return a.AgeAtRelease > b.AgeAtRelease })
+
+	return summaryResult(ctx, output, "%s", performanceAgesSummary(ctx, output)), output, nil
+}
+
+// performingActors reads the actor with actorID, or every actor when it is 0
+func (t *ActorTools) performingActors(ctx context.Context, actorID int) ([]*actorApp.ActorDTO, error) {
+	if actorID > 0 {
+		actorDTO, err := t.actorService.GetActor(ctx, actorID)
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil, shared.NewNotFoundError("actor not found")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get actor: %w", err)
+		}
+		return []*actorApp.ActorDTO{actorDTO}, nil
+	}
+
+	var actorDTOs []*actorApp.ActorDTO
+	for offset := 0; ; offset += performanceAgePageSize {
+		page, err := t.actorService.SearchActors(ctx, actorApp.SearchActorsQuery{
+			Limit:  performanceAgePageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list actors: %w", err)
+		}
+		actorDTOs = append(actorDTOs, page...)
+		if len(page) < performanceAgePageSize {
+			return actorDTOs, nil
+		}
+	}
+}
+
+// creditedMovies reads the movies the actors appear in, keyed by ID;
+// movies that no longer exist are left out
+func (t *ActorTools) creditedMovies(ctx context.Context, actorDTOs []*actorApp.ActorDTO) (map[int]*movieApp.MovieDTO, error) {
+	seen := make(map[int]bool)
+	var ids []int
+	for _, actorDTO := range actorDTOs {
+		for _, movieID := range actorDTO.MovieIDs {
+			if !seen[movieID] {
+				seen[movieID] = true
+				ids = append(ids, movieID)
+			}
+		}
+	}
+
+	movies := make(map[int]*movieApp.MovieDTO, len(ids))
+	for start := 0; start < len(ids); start += performanceAgePageSize {
+		end := min(start+performanceAgePageSize, len(ids))
+		page, err := t.movies.GetMoviesByIDs(ctx, ids[start:end])
+		if err != nil {
+			return nil, fmt.Errorf("failed to get movies: %w", err)
+		}
+		for _, movie := range page {
+			movies[movie.ID] = movie
+		}
+	}
+	return movies, nil
+}
+
+// agesOrdered returns up to limit performances ordered by less, then by
+// release year and actor name
+func agesOrdered(performances []PerformanceAgeOutput, limit int, less func(a, b PerformanceAgeOutput) bool) []PerformanceAgeOutput {
+	ordered := append([]PerformanceAgeOutput{}, performances...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		a, b := ordered[i], ordered[j]
+		if a.AgeAtRelease != b.AgeAtRelease {
+			return less(a, b)
+		}
+		if a.Year != b.Year {
+			return a.Year < b.Year
+		}
+		return a.Name < b.Name
+	})
+	if len(ordered) > limit {
+		ordered = ordered[:limit]
+	}
+	return ordered
+}
+
+// performanceAgesSummary describes the youngest and oldest performances in one line
+func performanceAgesSummary(ctx context.Context, output AnalyzePerformanceAgesOutput) string {
+	p := i18n.FromContext(ctx)
+	performances := countNoun(ctx, output.Performances, "performance", "performances")
+	if output.KnownAges == 0 {
+		return p.Sprintf("No known ages at release across %s", performances)
+	}
+	youngest, oldest := output.Youngest[0], output.Oldest[0]
+	return p.Sprintf("Youngest: %s at %d in %s; oldest: %s at %d in %s (%d of %s with a known age)",
+		youngest.Name, youngest.AgeAtRelease, fmt.Sprintf("%s (%d)", youngest.Title, youngest.Year),
+		oldest.Name, oldest.AgeAtRelease, fmt.Sprintf("%s (%d)", oldest.Title, oldest.Year),
+		output.KnownAges, performances)
+}
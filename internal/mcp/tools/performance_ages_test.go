@@ -0,0 +1,150 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	actorApp "github.com/francknouama/movies-mcp-server/internal/application/actor"
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// agesMovies has The Matrix by year, John Wick by release date only and a
+// 1940 movie; movie 99 no longer exists
+func agesMovies() *MockMovieBatchGetter {
+	movies := map[int]*movieApp.MovieDTO{
+		1: {ID: 1, Title: "The Matrix", Year: 1999},
+		2: {ID: 2, Title: "John Wick", ReleaseDate: "2014-10-24"},
+		3: {ID: 3, Title: "Old Film", Year: 1940},
+	}
+	return &MockMovieBatchGetter{
+		GetMoviesByIDsFunc: func(ctx context.Context, ids []int) ([]*movieApp.MovieDTO, error) {
+			var found []*movieApp.MovieDTO
+			for _, id := range ids {
+				if movie, ok := movies[id]; ok {
+					found = append(found, movie)
+				}
+			}
+			return found, nil
+		},
+	}
+}
+
+// agesActors covers every way an age can be known or not
+func agesActors() []*actorApp.ActorDTO {
+	return []*actorApp.ActorDTO{
+		{ID: 1, Name: "Keanu Reeves", BirthYear: 1964, MovieIDs: []int{1, 2, 99},
+			Credits: []actorApp.CreditDTO{{MovieID: 1, Character: "Neo"}}},
+		{ID: 2, Name: "Carrie-Anne Moss", BirthYear: 1967, MovieIDs: []int{1}},
+		{ID: 3, Name: "Unknown Extra", MovieIDs: []int{1}},
+		{ID: 4, Name: "Late Star", BirthYear: 1900, DeathYear: 1935, MovieIDs: []int{3}},
+		{ID: 5, Name: "Young Star", BirthYear: 1950, MovieIDs: []int{3}},
+	}
+}
+
+func TestAnalyzePerformanceAges(t *testing.T) {
+	actorTools := NewActorTools(&MockActorService{
+		SearchActorsFunc: func(ctx context.Context, query actorApp.SearchActorsQuery) ([]*actorApp.ActorDTO, error) {
+			return agesActors(), nil
+		},
+	})
+	actorTools.SetMovieReader(agesMovies())
+
+	result, output, err := actorTools.AnalyzePerformanceAges(context.Background(), nil, AnalyzePerformanceAgesInput{Limit: 2})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	assertSummaryResult(t, result)
+
+	if output.Performances != 7 || output.KnownAges != 3 || output.AverageAge != 39 {
+		t.Errorf("Unexpected totals %+v", output)
+	}
+	want := UnknownAgesOutput{BirthYearUnknown: 1, ReleaseYearUnknown: 1, BornAfterRelease: 1, Posthumous: 1}
+	if output.Unknown != want {
+		t.Errorf("Expected every reason counted once, got %+v", output.Unknown)
+	}
+
+	if len(output.Youngest) != 2 || output.Youngest[0].Name != "Carrie-Anne Moss" || output.Youngest[0].AgeAtRelease != 32 {
+		t.Errorf("Unexpected youngest %+v", output.Youngest)
+	}
+	if neo := output.Youngest[1]; neo.Name != "Keanu Reeves" || neo.AgeAtRelease != 35 || neo.Character != "Neo" || neo.Title != "The Matrix" {
+		t.Errorf("Unexpected second youngest %+v", neo)
+	}
+	if oldest := output.Oldest[0]; oldest.Title != "John Wick" || oldest.Year != 2014 || oldest.AgeAtRelease != 50 {
+		t.Errorf("Expected the year read from the release date, got %+v", oldest)
+	}
+}
+
+func TestAnalyzePerformanceAges_OneActor(t *testing.T) {
+	actorTools := NewActorTools(&MockActorService{
+		GetActorFunc: func(ctx context.Context, id int) (*actorApp.ActorDTO, error) {
+			if id != 1 {
+				return nil, shared.NewNotFoundError("actor not found")
+			}
+			return agesActors()[0], nil
+		},
+	})
+	actorTools.SetMovieReader(agesMovies())
+
+	_, output, err := actorTools.AnalyzePerformanceAges(context.Background(), nil, AnalyzePerformanceAgesInput{ActorID: 1})
+	if err != nil || output.Performances != 3 || output.KnownAges != 2 || output.Unknown.ReleaseYearUnknown != 1 {
+		t.Errorf("Expected Keanu Reeves' performances, got %+v, %v", output, err)
+	}
+	if _, _, err := actorTools.AnalyzePerformanceAges(context.Background(), nil, AnalyzePerformanceAgesInput{ActorID: 2}); !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("Expected an unknown actor not to be found, got %v", err)
+	}
+}
+
+func TestAnalyzePerformanceAges_NoKnownAges(t *testing.T) {
+	actorTools := NewActorTools(&MockActorService{
+		SearchActorsFunc: func(ctx context.Context, query actorApp.SearchActorsQuery) ([]*actorApp.ActorDTO, error) {
+			return agesActors()[2:3], nil
+		},
+	})
+	actorTools.SetMovieReader(agesMovies())
+
+	result, output, err := actorTools.AnalyzePerformanceAges(context.Background(), nil, AnalyzePerformanceAgesInput{})
+	if err != nil || output.Youngest == nil || output.Oldest == nil || output.AverageAge != 0 {
+		t.Fatalf("Expected empty lists, got %+v, %v", output, err)
+	}
+	if text := result.Content[1].(*mcp.TextContent).Text; text != "No known ages at release across 1 performance" {
+		t.Errorf("Unexpected summary %q", text)
+	}
+}
+
+func TestAnalyzePerformanceAges_Errors(t *testing.T) {
+	if err := (AnalyzePerformanceAgesInput{Limit: 21}).Validate(); !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("Expected a limit over 20 to be rejected, got %v", err)
+	}
+	if _, _, err := NewActorTools(&MockActorService{}).AnalyzePerformanceAges(context.Background(), nil, AnalyzePerformanceAgesInput{}); !errors.Is(err, shared.ErrUnavailable) {
+		t.Errorf("Expected the tool to be unavailable without a movie reader, got %v", err)
+	}
+}
+
+func TestGetMovieCast_AgeAtRelease(t *testing.T) {
+	actorTools := NewActorTools(&MockActorService{
+		GetActorsByMovieFunc: func(ctx context.Context, movieID int) ([]*actorApp.ActorDTO, error) {
+			return agesActors()[:3], nil
+		},
+	})
+
+	_, output, err := actorTools.GetMovieCast(context.Background(), nil, GetMovieCastInput{MovieID: 1})
+	if err != nil || output.Cast[0].AgeAtRelease != nil || output.Cast[0].AgeUnknown != "" {
+		t.Fatalf("Expected no ages without a movie reader, got %+v, %v", output.Cast, err)
+	}
+
+	actorTools.SetMovieReader(agesMovies())
+	_, output, err = actorTools.GetMovieCast(context.Background(), nil, GetMovieCastInput{MovieID: 1})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if age := output.Cast[0].AgeAtRelease; age == nil || *age != 35 {
+		t.Errorf("Expected Keanu Reeves at 35, got %+v", output.Cast[0])
+	}
+	if extra := output.Cast[2]; extra.AgeAtRelease != nil || extra.AgeUnknown != AgeUnknownBirthYear {
+		t.Errorf("Expected the extra's age unknown, got %+v", extra)
+	}
+}
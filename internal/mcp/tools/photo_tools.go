@@ -0,0 +1,161 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	posterApp "github.com/francknouama/movies-mcp-server/internal/application/poster"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// PhotoService defines the interface for actor photo operations
+type PhotoService interface {
+	UploadPhoto(ctx context.Context, cmd posterApp.UploadPhotoCommand) (*posterApp.PhotoDTO, error)
+	GetPhoto(ctx context.Context, actorID int) (*posterApp.PhotoImageDTO, error)
+	DeletePhoto(ctx context.Context, actorID int) (*posterApp.DeletePhotoDTO, error)
+}
+
+// PhotoTools provides SDK-based MCP handlers for actor photo images
+type PhotoTools struct {
+	photoService PhotoService
+}
+
+// NewPhotoTools creates a new photo tools instance
+func NewPhotoTools(photoService PhotoService) *PhotoTools {
+	return &PhotoTools{
+		photoService: photoService,
+	}
+}
+
+// actorPhotoURI is the resource serving an actor's stored photo image
+func actorPhotoURI(actorID int) string {
+	return fmt.Sprintf("movies://actors/photos/%d", actorID)
+}
+
+// ActorPhotoOutput defines the output schema for a stored actor photo
+type ActorPhotoOutput struct {
+	ActorID  int    `json:"actor_id" jsonschema:"Actor ID"`
+	Name     string `json:"name" jsonschema:"Actor name"`
+	MimeType string `json:"mime_type" jsonschema:"Stored image type"`
+	Size     int    `json:"size" jsonschema:"Stored image size in bytes"`
+	URI      string `json:"uri" jsonschema:"Resource URI serving the photo image"`
+}
+
+// newActorPhotoOutput converts a photo DTO to the output format
+func newActorPhotoOutput(dto *posterApp.PhotoDTO) ActorPhotoOutput {
+	return ActorPhotoOutput{
+		ActorID:  dto.ActorID,
+		Name:     dto.Name,
+		MimeType: dto.MimeType,
+		Size:     dto.Size,
+		URI:      actorPhotoURI(dto.ActorID),
+	}
+}
+
+// ===== upload_actor_photo Tool =====
+
+// UploadActorPhotoInput defines the input schema for upload_actor_photo tool
+type UploadActorPhotoInput struct {
+	ActorID  int    `json:"actor_id" jsonschema:"Actor ID"`
+	Data     string `json:"data" jsonschema:"Base64 image data, optionally as a data URI (data:image/png;base64,...)"`
+	MimeType string `json:"mime_type,omitempty" jsonschema:"Image type (e.g. image/jpeg); detected from the data if omitted"`
+}
+
+// Validate requires the image data
+func (in UploadActorPhotoInput) Validate() error {
+	if strings.TrimSpace(in.Data) == "" {
+		return shared.NewValidationError("data is required")
+	}
+	return nil
+}
+
+// UploadActorPhoto handles the upload_actor_photo tool call
+func (t *PhotoTools) UploadActorPhoto(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input UploadActorPhotoInput,
+) (*mcp.CallToolResult, ActorPhotoOutput, error) {
+	dto, err := t.photoService.UploadPhoto(ctx, posterApp.UploadPhotoCommand{
+		ActorID:  input.ActorID,
+		Data:     input.Data,
+		MimeType: input.MimeType,
+	})
+	if err != nil {
+		return nil, ActorPhotoOutput{}, fmt.Errorf("failed to upload photo: %w", err)
+	}
+
+	output := newActorPhotoOutput(dto)
+	return summaryResult(ctx, output, "Stored a %d byte %s photo for %s",
+		output.Size, output.MimeType, output.Name), output, nil
+}
+
+// ===== download_actor_photo Tool =====
+
+// DownloadActorPhotoInput defines the input schema for download_actor_photo tool
+type DownloadActorPhotoInput struct {
+	ActorID int `json:"actor_id" jsonschema:"Actor ID"`
+}
+
+// DownloadActorPhoto handles the download_actor_photo tool call. The image
+// itself is returned as image content after the text content.
+func (t *PhotoTools) DownloadActorPhoto(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input DownloadActorPhotoInput,
+) (*mcp.CallToolResult, ActorPhotoOutput, error) {
+	dto, err := t.photoService.GetPhoto(ctx, input.ActorID)
+	if err != nil {
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil, ActorPhotoOutput{}, shared.NewNotFoundError("actor %d has no photo image", input.ActorID)
+		}
+		return nil, ActorPhotoOutput{}, fmt.Errorf("failed to download photo: %w", err)
+	}
+
+	output := newActorPhotoOutput(&dto.PhotoDTO)
+	result := summaryResult(ctx, output, "%s photo of %s (%d bytes)", output.MimeType, output.Name, output.Size)
+	if result == nil {
+		result = &mcp.CallToolResult{}
+	}
+	result.Content = append(result.Content, &mcp.ImageContent{Data: dto.Data, MIMEType: dto.MimeType})
+	return result, output, nil
+}
+
+// ===== delete_actor_photo Tool =====
+
+// DeleteActorPhotoInput defines the input schema for delete_actor_photo tool
+type DeleteActorPhotoInput struct {
+	ActorID int `json:"actor_id" jsonschema:"Actor ID"`
+}
+
+// DeleteActorPhotoOutput defines the output schema for delete_actor_photo tool
+type DeleteActorPhotoOutput struct {
+	ActorID int    `json:"actor_id" jsonschema:"Actor ID"`
+	Name    string `json:"name" jsonschema:"Actor name"`
+	Deleted bool   `json:"deleted" jsonschema:"Whether the actor had a photo image to delete"`
+}
+
+// DeleteActorPhoto handles the delete_actor_photo tool call
+func (t *PhotoTools) DeleteActorPhoto(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input DeleteActorPhotoInput,
+) (*mcp.CallToolResult, DeleteActorPhotoOutput, error) {
+	dto, err := t.photoService.DeletePhoto(ctx, input.ActorID)
+	if err != nil {
+		return nil, DeleteActorPhotoOutput{}, fmt.Errorf("failed to delete photo: %w", err)
+	}
+
+	output := DeleteActorPhotoOutput{
+		ActorID: dto.ActorID,
+		Name:    dto.Name,
+		Deleted: dto.Deleted,
+	}
+	if !output.Deleted {
+		return summaryResult(ctx, output, "%s has no photo image to delete", output.Name), output, nil
+	}
+	return summaryResult(ctx, output, "Deleted the photo image of %s", output.Name), output, nil
+}
@@ -0,0 +1,153 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	posterApp "github.com/francknouama/movies-mcp-server/internal/application/poster"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// MockPhotoService is a mock implementation of PhotoService
+type MockPhotoService struct {
+	UploadPhotoFunc func(ctx context.Context, cmd posterApp.UploadPhotoCommand) (*posterApp.PhotoDTO, error)
+	GetPhotoFunc    func(ctx context.Context, actorID int) (*posterApp.PhotoImageDTO, error)
+	DeletePhotoFunc func(ctx context.Context, actorID int) (*posterApp.DeletePhotoDTO, error)
+}
+
+func (m *MockPhotoService) UploadPhoto(ctx context.Context, cmd posterApp.UploadPhotoCommand) (*posterApp.PhotoDTO, error) {
+	if m.UploadPhotoFunc != nil {
+		return m.UploadPhotoFunc(ctx, cmd)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockPhotoService) GetPhoto(ctx context.Context, actorID int) (*posterApp.PhotoImageDTO, error) {
+	if m.GetPhotoFunc != nil {
+		return m.GetPhotoFunc(ctx, actorID)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockPhotoService) DeletePhoto(ctx context.Context, actorID int) (*posterApp.DeletePhotoDTO, error) {
+	if m.DeletePhotoFunc != nil {
+		return m.DeletePhotoFunc(ctx, actorID)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func TestUploadActorPhoto_Success(t *testing.T) {
+	var gotCmd posterApp.UploadPhotoCommand
+	tools := NewPhotoTools(&MockPhotoService{
+		UploadPhotoFunc: func(ctx context.Context, cmd posterApp.UploadPhotoCommand) (*posterApp.PhotoDTO, error) {
+			gotCmd = cmd
+			return &posterApp.PhotoDTO{ActorID: cmd.ActorID, Name: "Keanu Reeves", MimeType: "image/png", Size: 2048}, nil
+		},
+	})
+
+	result, output, err := tools.UploadActorPhoto(context.Background(), nil, UploadActorPhotoInput{
+		ActorID:  1,
+		Data:     "iVBORw0KGgo=",
+		MimeType: "image/png",
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if gotCmd.Data != "iVBORw0KGgo=" || gotCmd.MimeType != "image/png" {
+		t.Errorf("Expected command to carry the input, got: %+v", gotCmd)
+	}
+	if output.URI != "movies://actors/photos/1" || output.Size != 2048 {
+		t.Errorf("Expected a 2048 byte photo at movies://actors/photos/1, got: %+v", output)
+	}
+	assertSummaryResult(t, result)
+	if summary := result.Content[1].(*mcp.TextContent).Text; summary != "Stored a 2048 byte image/png photo for Keanu Reeves" {
+		t.Errorf("Unexpected summary, got: %s", summary)
+	}
+}
+
+func TestUploadActorPhotoInput_Validate(t *testing.T) {
+	if err := (UploadActorPhotoInput{ActorID: 1, Data: "  "}).Validate(); !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("Expected a validation error for blank data, got: %v", err)
+	}
+	if err := (UploadActorPhotoInput{ActorID: 1, Data: "AAAA"}).Validate(); err != nil {
+		t.Errorf("Expected no error, got: %v", err)
+	}
+}
+
+func TestDownloadActorPhoto_Success(t *testing.T) {
+	data := []byte{0x89, 'P', 'N', 'G'}
+	tools := NewPhotoTools(&MockPhotoService{
+		GetPhotoFunc: func(ctx context.Context, actorID int) (*posterApp.PhotoImageDTO, error) {
+			return &posterApp.PhotoImageDTO{
+				PhotoDTO: posterApp.PhotoDTO{ActorID: actorID, Name: "Keanu Reeves", MimeType: "image/png", Size: len(data)},
+				Data:     data,
+			}, nil
+		},
+	})
+
+	result, output, err := tools.DownloadActorPhoto(context.Background(), nil, DownloadActorPhotoInput{ActorID: 1})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.URI != "movies://actors/photos/1" || output.MimeType != "image/png" {
+		t.Errorf("Unexpected output, got: %+v", output)
+	}
+	if len(result.Content) != 3 {
+		t.Fatalf("Expected JSON, summary and image content, got: %d blocks", len(result.Content))
+	}
+	image, ok := result.Content[2].(*mcp.ImageContent)
+	if !ok || !bytes.Equal(image.Data, data) || image.MIMEType != "image/png" {
+		t.Errorf("Expected the photo as image content, got: %v", result.Content[2])
+	}
+}
+
+func TestDownloadActorPhoto_NotFound(t *testing.T) {
+	tools := NewPhotoTools(&MockPhotoService{
+		GetPhotoFunc: func(ctx context.Context, actorID int) (*posterApp.PhotoImageDTO, error) {
+			return nil, shared.NewNotFoundError("actor photo not found")
+		},
+	})
+
+	_, _, err := tools.DownloadActorPhoto(context.Background(), nil, DownloadActorPhotoInput{ActorID: 1})
+	if !errors.Is(err, shared.ErrNotFound) || err.Error() != "actor 1 has no photo image" {
+		t.Errorf("Expected a not found error, got: %v", err)
+	}
+}
+
+func TestDeleteActorPhoto(t *testing.T) {
+	tests := []struct {
+		name    string
+		deleted bool
+		summary string
+	}{
+		{name: "had a photo", deleted: true, summary: "Deleted the photo image of Keanu Reeves"},
+		{name: "no photo", deleted: false, summary: "Keanu Reeves has no photo image to delete"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tools := NewPhotoTools(&MockPhotoService{
+				DeletePhotoFunc: func(ctx context.Context, actorID int) (*posterApp.DeletePhotoDTO, error) {
+					return &posterApp.DeletePhotoDTO{ActorID: actorID, Name: "Keanu Reeves", Deleted: tt.deleted}, nil
+				},
+			})
+
+			result, output, err := tools.DeleteActorPhoto(context.Background(), nil, DeleteActorPhotoInput{ActorID: 1})
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if output.Deleted != tt.deleted {
+				t.Errorf("Expected deleted %v, got: %v", tt.deleted, output.Deleted)
+			}
+			assertSummaryResult(t, result)
+			if summary := result.Content[1].(*mcp.TextContent).Text; summary != tt.summary {
+				t.Errorf("Unexpected summary, got: %s", summary)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	posterApp "github.com/francknouama/movies-mcp-server/internal/application/poster"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// PosterService defines the interface for movie poster operations
+type PosterService interface {
+	UploadPoster(ctx context.Context, cmd posterApp.UploadPosterCommand) (*posterApp.PosterDTO, error)
+	GetPoster(ctx context.Context, movieID int) (*posterApp.PosterImageDTO, error)
+	DeletePoster(ctx context.Context, movieID int) (*posterApp.DeletePosterDTO, error)
+}
+
+// PosterURLSigner builds the CDN URL a stored poster is served from
+type PosterURLSigner interface {
+	SignedURL(poster *posterApp.PosterImageDTO) (string, time.Time)
+}
+
+// PosterTools provides SDK-based MCP handlers for movie poster images
+type PosterTools struct {
+	posterService PosterService
+	signer        PosterURLSigner
+}
+
+// NewPosterTools creates a new poster tools instance
+func NewPosterTools(posterService PosterService) *PosterTools {
+	return &PosterTools{
+		posterService: posterService,
+	}
+}
+
+// SetCDNSigner makes get_movie_poster link to the poster's CDN URL instead
+// of returning the image
+func (t *PosterTools) SetCDNSigner(signer PosterURLSigner) {
+	t.signer = signer
+}
+
+// ===== upload_movie_poster Tool =====
+
+// UploadMoviePosterInput defines the input schema for upload_movie_poster tool
+type UploadMoviePosterInput struct {
+	MovieID  int    `json:"movie_id" jsonschema:"Movie ID"`
+	Data     string `json:"data" jsonschema:"Base64 image data, optionally as a data URI (data:image/png;base64,...)"`
+	MimeType string `json:"mime_type,omitempty" jsonschema:"Image type (e.g. image/jpeg); detected from the data if omitted"`
+}
+
+// Validate requires the image data
+func (in UploadMoviePosterInput) Validate() error {
+	if strings.TrimSpace(in.Data) == "" {
+		return shared.NewValidationError("data is required")
+	}
+	return nil
+}
+
+// UploadMoviePosterOutput defines the output schema for upload_movie_poster tool
+type UploadMoviePosterOutput struct {
+	MovieID  int    `json:"movie_id" jsonschema:"Movie ID"`
+	Title    string `json:"title" jsonschema:"Movie title"`
+	Year     int    `json:"year" jsonschema:"Release year"`
+	MimeType string `json:"mime_type" jsonschema:"Stored image type"`
+	Size     int    `json:"size" jsonschema:"Stored image size in bytes"`
+}
+
+// UploadMoviePoster handles the upload_movie_poster tool call
+func (t *PosterTools) UploadMoviePoster(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input UploadMoviePosterInput,
+) (*mcp.CallToolResult, UploadMoviePosterOutput, error) {
+	dto, err := t.posterService.UploadPoster(ctx, posterApp.UploadPosterCommand{
+		MovieID:  input.MovieID,
+		Data:     input.Data,
+		MimeType: input.MimeType,
+	})
+	if err != nil {
+		return nil, UploadMoviePosterOutput{}, fmt.Errorf("failed to upload poster: %w", err)
+	}
+
+	output := UploadMoviePosterOutput{
+		MovieID:  dto.MovieID,
+		Title:    dto.Title,
+		Year:     dto.Year,
+		MimeType: dto.MimeType,
+		Size:     dto.Size,
+	}
+	return summaryResult(ctx, output, "Stored a %d byte %s poster for %s (%d)",
+		output.Size, output.MimeType, output.Title, output.Year), output, nil
+}
+
+// ===== get_movie_poster Tool =====
+
+// GetMoviePosterInput defines the input schema for get_movie_poster tool
+type GetMoviePosterInput struct {
+	MovieID int `json:"movie_id" jsonschema:"Movie ID"`
+}
+
+// GetMoviePosterOutput defines the output schema for get_movie_poster tool
+type GetMoviePosterOutput struct {
+	MovieID  int    `json:"movie_id" jsonschema:"Movie ID"`
+	Title    string `json:"title" jsonschema:"Movie title"`
+	Year     int    `json:"year" jsonschema:"Release year"`
+	MimeType string `json:"mime_type" jsonschema:"Stored image type"`
+	Size     int    `json:"size" jsonschema:"Stored image size in bytes"`
+
+	URL       string `json:"url,omitempty" jsonschema:"CDN URL of the image, only set in poster CDN mode"`
+	ExpiresAt string `json:"expires_at,omitempty" jsonschema:"When a signed url stops working"`
+}
+
+// GetMoviePoster handles the get_movie_poster tool call. The image itself
+// is returned as image content after the text content, or in poster CDN
+// mode as a link to its CDN URL.
+func (t *PosterTools) GetMoviePoster(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input GetMoviePosterInput,
+) (*mcp.CallToolResult, GetMoviePosterOutput, error) {
+	dto, err := t.posterService.GetPoster(ctx, input.MovieID)
+	if err != nil {
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil, GetMoviePosterOutput{}, shared.NewNotFoundError("movie %d has no poster image", input.MovieID)
+		}
+		return nil, GetMoviePosterOutput{}, fmt.Errorf("failed to get poster: %w", err)
+	}
+
+	output := GetMoviePosterOutput{
+		MovieID:  dto.MovieID,
+		Title:    dto.Title,
+		Year:     dto.Year,
+		MimeType: dto.MimeType,
+		Size:     dto.Size,
+	}
+	if t.signer != nil {
+		var expiresAt time.Time
+		output.URL, expiresAt = t.signer.SignedURL(dto)
+		if !expiresAt.IsZero() {
+			output.ExpiresAt = expiresAt.UTC().Format(time.RFC3339)
+		}
+	}
+
+	result := summaryResult(ctx, output, "%s poster of %s (%d, %d bytes)", output.MimeType, output.Title, output.Year, output.Size)
+	if result == nil {
+		result = &mcp.CallToolResult{}
+	}
+	if output.URL != "" {
+		result.Content = append(result.Content, &mcp.ResourceLink{URI: output.URL, Name: "poster", MIMEType: dto.MimeType})
+	} else {
+		result.Content = append(result.Content, &mcp.ImageContent{Data: dto.Data, MIMEType: dto.MimeType})
+	}
+	return result, output, nil
+}
+
+// ===== delete_movie_poster Tool =====
+
+// DeleteMoviePosterInput defines the input schema for delete_movie_poster tool
+type DeleteMoviePosterInput struct {
+	MovieID int `json:"movie_id" jsonschema:"Movie ID"`
+}
+
+// DeleteMoviePosterOutput defines the output schema for delete_movie_poster tool
+type DeleteMoviePosterOutput struct {
+	MovieID int    `json:"movie_id" jsonschema:"Movie ID"`
+	Title   string `json:"title" jsonschema:"Movie title"`
+	Year    int    `json:"year" jsonschema:"Release year"`
+	Deleted bool   `json:"deleted" jsonschema:"Whether the movie had a poster image to delete"`
+}
+
+// DeleteMoviePoster handles the delete_movie_poster tool call
+func (t *PosterTools) DeleteMoviePoster(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input DeleteMoviePosterInput,
+) (*mcp.CallToolResult, DeleteMoviePosterOutput, error) {
+	dto, err := t.posterService.DeletePoster(ctx, input.MovieID)
+	if err != nil {
+		return nil, DeleteMoviePosterOutput{}, fmt.Errorf("failed to delete poster: %w", err)
+	}
+
+	output := DeleteMoviePosterOutput{
+		MovieID: dto.MovieID,
+		Title:   dto.Title,
+		Year:    dto.Year,
+		Deleted: dto.Deleted,
+	}
+	if !output.Deleted {
+		return summaryResult(ctx, output, "%s (%d) has no poster image to delete", output.Title, output.Year), output, nil
+	}
+	return summaryResult(ctx, output, "Deleted the poster image of %s (%d)", output.Title, output.Year), output, nil
+}
@@ -0,0 +1,196 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	posterApp "github.com/francknouama/movies-mcp-server/internal/application/poster"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// MockPosterService is a mock implementation of PosterService
+type MockPosterService struct {
+	UploadPosterFunc func(ctx context.Context, cmd posterApp.UploadPosterCommand) (*posterApp.PosterDTO, error)
+	GetPosterFunc    func(ctx context.Context, movieID int) (*posterApp.PosterImageDTO, error)
+	DeletePosterFunc func(ctx context.Context, movieID int) (*posterApp.DeletePosterDTO, error)
+}
+
+func (m *MockPosterService) UploadPoster(ctx context.Context, cmd posterApp.UploadPosterCommand) (*posterApp.PosterDTO, error) {
+	if m.UploadPosterFunc != nil {
+		return m.UploadPosterFunc(ctx, cmd)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockPosterService) GetPoster(ctx context.Context, movieID int) (*posterApp.PosterImageDTO, error) {
+	if m.GetPosterFunc != nil {
+		return m.GetPosterFunc(ctx, movieID)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockPosterService) DeletePoster(ctx context.Context, movieID int) (*posterApp.DeletePosterDTO, error) {
+	if m.DeletePosterFunc != nil {
+		return m.DeletePosterFunc(ctx, movieID)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func TestUploadMoviePoster_Success(t *testing.T) {
+	var gotCmd posterApp.UploadPosterCommand
+	tools := NewPosterTools(&MockPosterService{
+		UploadPosterFunc: func(ctx context.Context, cmd posterApp.UploadPosterCommand) (*posterApp.PosterDTO, error) {
+			gotCmd = cmd
+			return &posterApp.PosterDTO{MovieID: cmd.MovieID, Title: "Inception", Year: 2010, MimeType: "image/png", Size: 2048}, nil
+		},
+	})
+
+	result, output, err := tools.UploadMoviePoster(context.Background(), nil, UploadMoviePosterInput{
+		MovieID:  1,
+		Data:     "iVBORw0KGgo=",
+		MimeType: "image/png",
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if gotCmd.Data != "iVBORw0KGgo=" || gotCmd.MimeType != "image/png" {
+		t.Errorf("Expected command to carry the input, got: %+v", gotCmd)
+	}
+	if output.Size != 2048 || output.MimeType != "image/png" {
+		t.Errorf("Expected a 2048 byte PNG, got: %+v", output)
+	}
+	assertSummaryResult(t, result)
+	summary := result.Content[1].(*mcp.TextContent).Text
+	if summary != "Stored a 2048 byte image/png poster for Inception (2010)" {
+		t.Errorf("Unexpected summary, got: %s", summary)
+	}
+}
+
+func TestUploadMoviePoster_Error(t *testing.T) {
+	tools := NewPosterTools(&MockPosterService{
+		UploadPosterFunc: func(ctx context.Context, cmd posterApp.UploadPosterCommand) (*posterApp.PosterDTO, error) {
+			return nil, shared.NewValidationError("invalid poster")
+		},
+	})
+
+	_, _, err := tools.UploadMoviePoster(context.Background(), nil, UploadMoviePosterInput{MovieID: 1, Data: "AAAA"})
+	if !errors.Is(err, shared.ErrValidation) || !strings.Contains(err.Error(), "failed to upload poster") {
+		t.Errorf("Expected a wrapped validation error, got: %v", err)
+	}
+}
+
+func TestUploadMoviePosterInput_Validate(t *testing.T) {
+	if err := (UploadMoviePosterInput{MovieID: 1, Data: "  "}).Validate(); !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("Expected a validation error for blank data, got: %v", err)
+	}
+	if err := (UploadMoviePosterInput{MovieID: 1, Data: "AAAA"}).Validate(); err != nil {
+		t.Errorf("Expected no error, got: %v", err)
+	}
+}
+
+func TestGetMoviePoster_Success(t *testing.T) {
+	data := []byte{0xFF, 0xD8, 0xFF}
+	tools := NewPosterTools(&MockPosterService{
+		GetPosterFunc: func(ctx context.Context, movieID int) (*posterApp.PosterImageDTO, error) {
+			return &posterApp.PosterImageDTO{
+				PosterDTO: posterApp.PosterDTO{MovieID: movieID, Title: "Inception", Year: 2010, MimeType: "image/jpeg", Size: len(data)},
+				Data:      data,
+			}, nil
+		},
+	})
+
+	result, output, err := tools.GetMoviePoster(context.Background(), nil, GetMoviePosterInput{MovieID: 1})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.MovieID != 1 || output.Size != 3 || output.MimeType != "image/jpeg" {
+		t.Errorf("Unexpected output, got: %+v", output)
+	}
+	if len(result.Content) != 3 {
+		t.Fatalf("Expected JSON, summary and image content, got: %d blocks", len(result.Content))
+	}
+	if summary := result.Content[1].(*mcp.TextContent).Text; summary != "image/jpeg poster of Inception (2010, 3 bytes)" {
+		t.Errorf("Unexpected summary, got: %s", summary)
+	}
+	image, ok := result.Content[2].(*mcp.ImageContent)
+	if !ok || !bytes.Equal(image.Data, data) || image.MIMEType != "image/jpeg" {
+		t.Errorf("Expected the poster as image content, got: %v", result.Content[2])
+	}
+}
+
+func TestGetMoviePoster_CDN(t *testing.T) {
+	tools := NewPosterTools(&MockPosterService{
+		GetPosterFunc: func(ctx context.Context, movieID int) (*posterApp.PosterImageDTO, error) {
+			return &posterApp.PosterImageDTO{
+				PosterDTO: posterApp.PosterDTO{MovieID: movieID, Title: "Inception", Year: 2010, MimeType: "image/jpeg", Size: 3},
+				Data:      []byte{0xFF, 0xD8, 0xFF},
+			}, nil
+		},
+	})
+	tools.SetCDNSigner(posterApp.NewCDNSigner("https://cdn.example.com", "secret", time.Hour))
+
+	result, output, err := tools.GetMoviePoster(context.Background(), nil, GetMoviePosterInput{MovieID: 1})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !strings.HasPrefix(output.URL, "https://cdn.example.com/posters/1-") || output.ExpiresAt == "" {
+		t.Errorf("Expected a signed CDN URL, got: %+v", output)
+	}
+	link, ok := result.Content[2].(*mcp.ResourceLink)
+	if !ok || link.URI != output.URL || link.MIMEType != "image/jpeg" {
+		t.Errorf("Expected a link to the CDN URL instead of the image, got: %v", result.Content[2])
+	}
+}
+
+func TestGetMoviePoster_NotFound(t *testing.T) {
+	tools := NewPosterTools(&MockPosterService{
+		GetPosterFunc: func(ctx context.Context, movieID int) (*posterApp.PosterImageDTO, error) {
+			return nil, shared.NewNotFoundError("movie poster not found")
+		},
+	})
+
+	_, _, err := tools.GetMoviePoster(context.Background(), nil, GetMoviePosterInput{MovieID: 1})
+	if !errors.Is(err, shared.ErrNotFound) || err.Error() != "movie 1 has no poster image" {
+		t.Errorf("Expected a not found error, got: %v", err)
+	}
+}
+
+func TestDeleteMoviePoster(t *testing.T) {
+	tests := []struct {
+		name    string
+		deleted bool
+		summary string
+	}{
+		{name: "had a poster", deleted: true, summary: "Deleted the poster image of Inception (2010)"},
+		{name: "no poster", deleted: false, summary: "Inception (2010) has no poster image to delete"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tools := NewPosterTools(&MockPosterService{
+				DeletePosterFunc: func(ctx context.Context, movieID int) (*posterApp.DeletePosterDTO, error) {
+					return &posterApp.DeletePosterDTO{MovieID: movieID, Title: "Inception", Year: 2010, Deleted: tt.deleted}, nil
+				},
+			})
+
+			result, output, err := tools.DeleteMoviePoster(context.Background(), nil, DeleteMoviePosterInput{MovieID: 1})
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if output.Deleted != tt.deleted {
+				t.Errorf("Expected deleted %v, got: %v", tt.deleted, output.Deleted)
+			}
+			assertSummaryResult(t, result)
+			if summary := result.Content[1].(*mcp.TextContent).Text; summary != tt.summary {
+				t.Errorf("Unexpected summary, got: %s", summary)
+			}
+		})
+	}
+}
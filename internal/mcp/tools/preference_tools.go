@@ -0,0 +1,199 @@
+package tools
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/domain/translation"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/i18n"
+)
+
+// Preferences are what a session has said it likes; search and
+// recommendation tools use them where a call leaves the matching argument
+// unset
+type Preferences struct {
+	FavoriteGenres    []string `json:"favorite_genres" jsonschema:"Genres recommendations favor when no genres are given"`
+	DislikedDirectors []string `json:"disliked_directors" jsonschema:"Directors whose movies search and recommendations leave out unless a director is searched for"`
+	Language          string   `json:"language,omitempty" jsonschema:"Language code search results are localized to when no language is given"`
+}
+
+// PreferenceStore holds preferences in memory keyed by session, so clients
+// never see each other's and nothing outlives the server process
+type PreferenceStore struct {
+	mu       sync.RWMutex
+	sessions map[*mcp.ServerSession]Preferences
+}
+
+// NewPreferenceStore creates an empty preference store
+func NewPreferenceStore() *PreferenceStore {
+	return &PreferenceStore{sessions: make(map[*mcp.ServerSession]Preferences)}
+}
+
+// Get returns the preferences of the session making a request; a nil store
+// or request has none
+func (s *PreferenceStore) Get(req *mcp.CallToolRequest) Preferences {
+	if s == nil {
+		return Preferences{}
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.sessions[requestSession(req)]
+}
+
+// set replaces the preferences of the session making a request
+func (s *PreferenceStore) set(req *mcp.CallToolRequest, prefs Preferences) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions[requestSession(req)] = prefs
+}
+
+// requestSession returns the session of a request, or nil when a handler is
+// called directly
+func requestSession(req *mcp.CallToolRequest) *mcp.ServerSession {
+	if req == nil {
+		return nil
+	}
+	return req.Session
+}
+
+// dislikes reports whether director is one of the disliked directors
+func (p Preferences) dislikes(director string) bool {
+	for _, disliked := range p.DislikedDirectors {
+		if strings.EqualFold(strings.TrimSpace(director), disliked) {
+			return true
+		}
+	}
+	return false
+}
+
+// PreferenceTools provides SDK-based MCP handlers for session preferences
+type PreferenceTools struct {
+	store *PreferenceStore
+}
+
+// NewPreferenceTools creates preference tools over a store shared with the
+// search and recommendation tools
+func NewPreferenceTools(store *PreferenceStore) *PreferenceTools {
+	return &PreferenceTools{store: store}
+}
+
+// ===== set_preferences Tool =====
+
+// SetPreferencesInput defines the input schema for set_preferences tool
+type SetPreferencesInput struct {
+	FavoriteGenres    []string `json:"favorite_genres,omitempty" jsonschema:"Genres to favor in recommendations; replaces the current list, [] clears it"`
+	DislikedDirectors []string `json:"disliked_directors,omitempty" jsonschema:"Directors to leave out of search and recommendations; replaces the current list, [] clears it"`
+	Language          string   `json:"language,omitempty" jsonschema:"Language code (e.g. fr or pt-BR) to localize search results to"`
+	Reset             bool     `json:"reset,omitempty" jsonschema:"Clear every preference before applying the others"`
+}
+
+// Validate checks the language code
+func (in SetPreferencesInput) Validate() error {
+	if in.Language != "" {
+		if _, err := translation.NormalizeLanguage(in.Language); err != nil {
+			return err
+		}
+	}
+	for _, genre := range in.FavoriteGenres {
+		if strings.TrimSpace(genre) == "" {
+			return shared.NewValidationError("favorite_genres cannot contain empty names")
+		}
+	}
+	for _, director := range in.DislikedDirectors {
+		if strings.TrimSpace(director) == "" {
+			return shared.NewValidationError("disliked_directors cannot contain empty names")
+		}
+	}
+	return nil
+}
+
+// PreferencesOutput defines the output schema for set_preferences and
+// get_preferences tools
+type PreferencesOutput struct {
+	Preferences Preferences `json:"preferences" jsonschema:"The session's preferences"`
+}
+
+// SetPreferences handles the set_preferences tool call. Lists that are given
+// replace the stored ones and omitted fields are kept, so a client can change
+// one preference at a time.
+func (t *PreferenceTools) SetPreferences(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input SetPreferencesInput,
+) (*mcp.CallToolResult, PreferencesOutput, error) {
+	prefs := t.store.Get(req)
+	if input.Reset {
+		prefs = Preferences{}
+	}
+	if input.FavoriteGenres != nil {
+		prefs.FavoriteGenres = trimNames(input.FavoriteGenres)
+	}
+	if input.DislikedDirectors != nil {
+		prefs.DislikedDirectors = trimNames(input.DislikedDirectors)
+	}
+	if input.Language != "" {
+		language, err := translation.NormalizeLanguage(input.Language)
+		if err != nil {
+			return nil, PreferencesOutput{}, err
+		}
+		prefs.Language = language
+	}
+	t.store.set(req, prefs)
+
+	output := newPreferencesOutput(prefs)
+	return summaryResult(ctx, output, "%s", preferenceSummary(ctx, "Saved preferences: %s", output.Preferences)), output, nil
+}
+
+// ===== get_preferences Tool =====
+
+// GetPreferencesInput defines the input schema for get_preferences tool
+type GetPreferencesInput struct{}
+
+// GetPreferences handles the get_preferences tool call
+func (t *PreferenceTools) GetPreferences(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input GetPreferencesInput,
+) (*mcp.CallToolResult, PreferencesOutput, error) {
+	output := newPreferencesOutput(t.store.Get(req))
+	return summaryResult(ctx, output, "%s", preferenceSummary(ctx, "Current preferences: %s", output.Preferences)), output, nil
+}
+
+// newPreferencesOutput returns preferences with empty lists rather than null
+func newPreferencesOutput(prefs Preferences) PreferencesOutput {
+	prefs.FavoriteGenres = nonNilStrings(prefs.FavoriteGenres)
+	prefs.DislikedDirectors = nonNilStrings(prefs.DislikedDirectors)
+	return PreferencesOutput{Preferences: prefs}
+}
+
+// preferenceSummary describes preferences in one line
+func preferenceSummary(ctx context.Context, format string, prefs Preferences) string {
+	p := i18n.FromContext(ctx)
+	var parts []string
+	if len(prefs.FavoriteGenres) > 0 {
+		parts = append(parts, p.Sprintf("favorite genres %s", strings.Join(prefs.FavoriteGenres, ", ")))
+	}
+	if len(prefs.DislikedDirectors) > 0 {
+		parts = append(parts, p.Sprintf("disliked directors %s", strings.Join(prefs.DislikedDirectors, ", ")))
+	}
+	if prefs.Language != "" {
+		parts = append(parts, p.Sprintf("language %s", prefs.Language))
+	}
+	if len(parts) == 0 {
+		return p.Sprintf(format, p.Translate("none"))
+	}
+	return p.Sprintf(format, strings.Join(parts, "; "))
+}
+
+// trimNames trims the surrounding spaces of each name
+func trimNames(names []string) []string {
+	trimmed := make([]string, len(names))
+	for i, name := range names {
+		trimmed[i] = strings.TrimSpace(name)
+	}
+	return trimmed
+}
@@ -0,0 +1,180 @@
+package tools
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	translationApp "github.com/francknouama/movies-mcp-server/internal/application/translation"
+)
+
+// sessionRequest returns a tool call request from a new session
+func sessionRequest() *mcp.CallToolRequest {
+	return &mcp.CallToolRequest{Session: &mcp.ServerSession{}}
+}
+
+func TestSetPreferences_MergesAndResets(t *testing.T) {
+	tools := NewPreferenceTools(NewPreferenceStore())
+	req := sessionRequest()
+
+	_, _, err := tools.SetPreferences(context.Background(), req, SetPreferencesInput{
+		FavoriteGenres: []string{" Sci-Fi ", "Thriller"},
+		Language:       "pt_br",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	_, output, err := tools.SetPreferences(context.Background(), req, SetPreferencesInput{DislikedDirectors: []string{"Michael Bay"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	want := Preferences{
+		FavoriteGenres:    []string{"Sci-Fi", "Thriller"},
+		DislikedDirectors: []string{"Michael Bay"},
+		Language:          "pt-BR",
+	}
+	if !reflect.DeepEqual(output.Preferences, want) {
+		t.Errorf("Expected %+v, got: %+v", want, output.Preferences)
+	}
+
+	_, output, _ = tools.SetPreferences(context.Background(), req, SetPreferencesInput{FavoriteGenres: []string{}})
+	if len(output.Preferences.FavoriteGenres) != 0 || output.Preferences.Language != "pt-BR" {
+		t.Errorf("Expected an empty list to clear only the genres, got: %+v", output.Preferences)
+	}
+
+	_, output, _ = tools.SetPreferences(context.Background(), req, SetPreferencesInput{Reset: true, Language: "fr"})
+	if len(output.Preferences.DislikedDirectors) != 0 || output.Preferences.Language != "fr" {
+		t.Errorf("Expected reset to clear before applying, got: %+v", output.Preferences)
+	}
+}
+
+func TestGetPreferences_PerSession(t *testing.T) {
+	store := NewPreferenceStore()
+	tools := NewPreferenceTools(store)
+	first, second := sessionRequest(), sessionRequest()
+
+	tools.SetPreferences(context.Background(), first, SetPreferencesInput{Language: "de"})
+
+	_, output, err := tools.GetPreferences(context.Background(), second, GetPreferencesInput{})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Preferences.Language != "" || output.Preferences.FavoriteGenres == nil {
+		t.Errorf("Expected a new session to have empty preferences, got: %+v", output.Preferences)
+	}
+	if got := store.Get(first).Language; got != "de" {
+		t.Errorf("Expected the first session to keep its language, got: %q", got)
+	}
+}
+
+func TestSetPreferences_Validate(t *testing.T) {
+	for name, input := range map[string]SetPreferencesInput{
+		"bad language":   {Language: "french"},
+		"empty genre":    {FavoriteGenres: []string{"Drama", " "}},
+		"empty director": {DislikedDirectors: []string{""}},
+	} {
+		if err := input.Validate(); err == nil {
+			t.Errorf("%s: Expected a validation error", name)
+		}
+	}
+	if err := (SetPreferencesInput{Language: "en", Reset: true}).Validate(); err != nil {
+		t.Errorf("Expected no error, got: %v", err)
+	}
+}
+
+func TestSearchMovies_UsesSessionPreferences(t *testing.T) {
+	var gotLanguage string
+	localizer := &MockTranslationService{
+		LocalizeFunc: func(ctx context.Context, language string, movieIDs []int) (map[int]*translationApp.TranslationDTO, error) {
+			gotLanguage = language
+			return map[int]*translationApp.TranslationDTO{}, nil
+		},
+	}
+	mockService := &MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			return []*movieApp.MovieDTO{
+				{ID: 1, Title: "Armageddon", Director: "Michael Bay"},
+				{ID: 2, Title: "Heat", Director: "Michael Mann"},
+			}, nil
+		},
+	}
+	store := NewPreferenceStore()
+	req := sessionRequest()
+	NewPreferenceTools(store).SetPreferences(context.Background(), req, SetPreferencesInput{
+		DislikedDirectors: []string{"michael bay"},
+		Language:          "fr",
+	})
+
+	tools := NewMovieTools(mockService)
+	tools.SetLocalizer(localizer)
+	tools.SetPreferenceStore(store)
+
+	_, output, err := tools.SearchMovies(context.Background(), req, SearchMoviesInput{})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Total != 1 || output.Movies[0].Title != "Heat" || output.ExcludedByPreferences != 1 {
+		t.Errorf("Expected the disliked director to be left out, got: %+v", output)
+	}
+	if gotLanguage != "fr" {
+		t.Errorf("Expected the preferred language, got: %q", gotLanguage)
+	}
+
+	// Explicit arguments win over preferences
+	_, output, _ = tools.SearchMovies(context.Background(), req, SearchMoviesInput{Director: "Michael Bay", Language: "de"})
+	if output.Total != 2 || output.ExcludedByPreferences != 0 {
+		t.Errorf("Expected a director search to keep every match, got: %+v", output)
+	}
+	if gotLanguage != "de" {
+		t.Errorf("Expected the requested language, got: %q", gotLanguage)
+	}
+}
+
+func TestMovieRecommendationEngine_UsesSessionPreferences(t *testing.T) {
+	mockService := &MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			return []*movieApp.MovieDTO{
+				{ID: 1, Title: "Transformers", Director: "Michael Bay", Rating: 7.0, Genres: []string{"Sci-Fi"}},
+				{ID: 2, Title: "Arrival", Director: "Denis Villeneuve", Rating: 7.9, Genres: []string{"Sci-Fi"}},
+				{ID: 3, Title: "Amelie", Director: "Jean-Pierre Jeunet", Rating: 8.3, Genres: []string{"Romance"}},
+			}, nil
+		},
+	}
+	store := NewPreferenceStore()
+	req := sessionRequest()
+	NewPreferenceTools(store).SetPreferences(context.Background(), req, SetPreferencesInput{
+		FavoriteGenres:    []string{"Sci-Fi"},
+		DislikedDirectors: []string{"Michael Bay"},
+	})
+
+	tools := NewCompoundTools(mockService)
+	tools.SetPreferenceStore(store)
+
+	_, output, err := tools.MovieRecommendationEngine(context.Background(), req, MovieRecommendationInput{})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(output.Recommendations) == 0 || output.Recommendations[0].Title != "Arrival" {
+		t.Errorf("Expected the favorite genre to rank first, got: %+v", output.Recommendations)
+	}
+	for _, r := range output.Recommendations {
+		if r.Director == "Michael Bay" {
+			t.Errorf("Expected disliked directors to be left out, got: %s", r.Title)
+		}
+	}
+	used := output.PreferencesUsed
+	if !used.GenresFromSession || !reflect.DeepEqual(used.Genres, []string{"Sci-Fi"}) || len(used.ExcludedDirectors) != 1 {
+		t.Errorf("Expected the session's preferences to be reported, got: %+v", used)
+	}
+
+	_, output, _ = tools.MovieRecommendationEngine(context.Background(), req, MovieRecommendationInput{
+		Preferences: UserPreferences{Genres: []string{"Romance"}},
+	})
+	if output.PreferencesUsed.GenresFromSession || output.Recommendations[0].Title != "Amelie" {
+		t.Errorf("Expected given genres to override the session's, got: %+v", output)
+	}
+}
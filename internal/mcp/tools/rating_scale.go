@@ -0,0 +1,54 @@
+package tools
+
+import (
+	"math"
+
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// storedRatingScale is the scale ratings are stored and returned in
+const storedRatingScale = 10
+
+// ratingScales are the scales add_movie and update_movie accept ratings in:
+// 5 stars, 10 points or a percentage
+var ratingScales = map[int]bool{5: true, storedRatingScale: true, 100: true}
+
+// validateRatingScale checks that scale is supported and rating fits it; a
+// zero scale is the stored 0-10 scale
+func validateRatingScale(rating float64, scale int) error {
+	if scale == 0 {
+		return nil
+	}
+	if !ratingScales[scale] {
+		return shared.NewValidationError("scale must be 5, 10 or 100")
+	}
+	if rating < 0 || rating > float64(scale) {
+		return shared.NewValidationError("rating must be between 0 and %d on a %d-point scale", scale, scale)
+	}
+	return nil
+}
+
+// normalizeRating converts a rating on scale to the stored 0-10 scale
+func normalizeRating(rating float64, scale int) float64 {
+	if scale == 0 || scale == storedRatingScale {
+		return rating
+	}
+	return roundRating(rating * storedRatingScale / float64(scale))
+}
+
+// withRatingScale adds a movie's rating on the scale a caller wrote it in,
+// so the caller reads back what it sent; the stored 0-10 scale adds nothing
+func withRatingScale(output MovieOutput, scale int) MovieOutput {
+	if scale == 0 || scale == storedRatingScale {
+		return output
+	}
+	output.RatingScale = scale
+	output.ScaledRating = roundRating(output.Rating * float64(scale) / storedRatingScale)
+	return output
+}
+
+// roundRating rounds to two decimals, hiding floating point noise from the
+// conversions such as 8.700000000000001
+func roundRating(rating float64) float64 {
+	return math.Round(rating*100) / 100
+}
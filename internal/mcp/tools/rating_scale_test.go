@@ -0,0 +1,104 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+func TestNormalizeRating(t *testing.T) {
+	tests := []struct {
+		rating float64
+		scale  int
+		want   float64
+	}{
+		{rating: 8.8, scale: 0, want: 8.8},
+		{rating: 8.8, scale: 10, want: 8.8},
+		{rating: 4.5, scale: 5, want: 9},
+		{rating: 3.33, scale: 5, want: 6.66},
+		{rating: 87, scale: 100, want: 8.7},
+		{rating: 100, scale: 100, want: 10},
+	}
+
+	for _, tt := range tests {
+		if got := normalizeRating(tt.rating, tt.scale); got != tt.want {
+			t.Errorf("normalizeRating(%v, %d): Expected %v, got: %v", tt.rating, tt.scale, tt.want, got)
+		}
+	}
+}
+
+func TestValidateRatingScale(t *testing.T) {
+	tests := []struct {
+		rating  float64
+		scale   int
+		wantErr bool
+	}{
+		{rating: 9.5, scale: 0},
+		{rating: 5, scale: 5},
+		{rating: 5.5, scale: 5, wantErr: true},
+		{rating: 95, scale: 100},
+		{rating: -1, scale: 100, wantErr: true},
+		{rating: 3, scale: 4, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		err := validateRatingScale(tt.rating, tt.scale)
+		if tt.wantErr != (err != nil) {
+			t.Errorf("validateRatingScale(%v, %d): Expected error %v, got: %v", tt.rating, tt.scale, tt.wantErr, err)
+		}
+		if err != nil && !errors.Is(err, shared.ErrValidation) {
+			t.Errorf("Expected a validation error, got: %v", err)
+		}
+	}
+}
+
+func TestAddMovie_RatingScale(t *testing.T) {
+	var stored float64
+	mockService := &MockMovieService{
+		CreateMovieFunc: func(ctx context.Context, cmd movieApp.CreateMovieCommand) (*movieApp.MovieDTO, error) {
+			stored = cmd.Rating
+			return &movieApp.MovieDTO{ID: 1, Title: cmd.Title, Rating: cmd.Rating}, nil
+		},
+	}
+	tools := NewMovieTools(mockService)
+
+	_, output, err := tools.AddMovie(context.Background(), nil, AddMovieInput{Title: "Heat", Year: 1995, Rating: 87, Scale: 100})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if stored != 8.7 || output.Rating != 8.7 {
+		t.Errorf("Expected a 0-10 rating of 8.7, got: stored %v, output %v", stored, output.Rating)
+	}
+	if output.RatingScale != 100 || output.ScaledRating != 87 {
+		t.Errorf("Expected the percentage back, got: %v on %d", output.ScaledRating, output.RatingScale)
+	}
+
+	_, output, _ = tools.AddMovie(context.Background(), nil, AddMovieInput{Title: "Heat", Year: 1995, Rating: 8.7})
+	if output.RatingScale != 0 || output.ScaledRating != 0 {
+		t.Errorf("Expected no scaled rating on the default scale, got: %v on %d", output.ScaledRating, output.RatingScale)
+	}
+}
+
+func TestUpdateMovie_RatingScale(t *testing.T) {
+	mockService := &MockMovieService{
+		UpdateMovieFunc: func(ctx context.Context, cmd movieApp.UpdateMovieCommand) (*movieApp.MovieDTO, error) {
+			return &movieApp.MovieDTO{ID: cmd.ID, Title: *cmd.Title, Rating: *cmd.Rating}, nil
+		},
+	}
+	tools := NewMovieTools(mockService)
+
+	_, output, err := tools.UpdateMovie(context.Background(), nil, UpdateMovieInput{ID: 3, Title: ptr("Heat"), Rating: ptr(4.5), Scale: 5})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Rating != 9 || output.RatingScale != 5 || output.ScaledRating != 4.5 {
+		t.Errorf("Expected 9 stored and 4.5 stars back, got: %+v", output)
+	}
+
+	if err := (UpdateMovieInput{ID: 3, Rating: ptr(6.0), Scale: 5}).Validate(); err == nil {
+		t.Error("Expected a rating above the scale to be rejected")
+	}
+}
@@ -0,0 +1,126 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/i18n"
+)
+
+// RuntimeStatsService defines the interface for runtime statistics
+type RuntimeStatsService interface {
+	GetRuntimeStats(ctx context.Context, query movieApp.RuntimeQuery) (*movieApp.RuntimeStatsDTO, error)
+}
+
+// RuntimeTools provides SDK-based MCP handlers for runtime analysis
+type RuntimeTools struct {
+	runtimes RuntimeStatsService
+}
+
+// NewRuntimeTools creates a new runtime tools instance
+func NewRuntimeTools(runtimes RuntimeStatsService) *RuntimeTools {
+	return &RuntimeTools{
+		runtimes: runtimes,
+	}
+}
+
+// ===== get_runtime_by_genre Tool =====
+
+// GetRuntimeByGenreInput defines the input schema for get_runtime_by_genre tool
+type GetRuntimeByGenreInput struct {
+	MinYear int `json:"min_year,omitempty" jsonschema:"Only movies released in or after this year"`
+	MaxYear int `json:"max_year,omitempty" jsonschema:"Only movies released in or before this year"`
+}
+
+// Validate checks the year range
+func (in GetRuntimeByGenreInput) Validate() error {
+	if in.MinYear < 0 || in.MaxYear < 0 {
+		return shared.NewValidationError("years cannot be negative")
+	}
+	if in.MinYear > 0 && in.MaxYear > 0 && in.MinYear > in.MaxYear {
+		return shared.NewValidationError("min_year cannot be after max_year")
+	}
+	return nil
+}
+
+// GenreRuntimeOutput defines the output schema for one genre's runtimes
+type GenreRuntimeOutput struct {
+	Genre            string  `json:"genre" jsonschema:"Genre name"`
+	Movies           int     `json:"movies" jsonschema:"Movies of the genre with a known runtime"`
+	AverageDuration  float64 `json:"average_duration" jsonschema:"Average runtime in minutes, to one decimal"`
+	ShortestDuration int     `json:"shortest_duration" jsonschema:"Shortest runtime in minutes"`
+	LongestDuration  int     `json:"longest_duration" jsonschema:"Longest runtime in minutes"`
+	ShortFilms       int     `json:"short_films" jsonschema:"Movies running 40 minutes or less"`
+}
+
+// GetRuntimeByGenreOutput defines the output schema for get_runtime_by_genre tool
+type GetRuntimeByGenreOutput struct {
+	Genres          []GenreRuntimeOutput `json:"genres" jsonschema:"Runtimes per genre, longest average first"`
+	Movies          int                  `json:"movies" jsonschema:"Movies with a known runtime"`
+	Unknown         int                  `json:"unknown" jsonschema:"Movies left out because their runtime is not known"`
+	AverageDuration float64              `json:"average_duration" jsonschema:"Average runtime in minutes over every movie with one, to one decimal"`
+	ShortFilms      int                  `json:"short_films" jsonschema:"Movies running 40 minutes or less"`
+}
+
+// GetRuntimeByGenre handles the get_runtime_by_genre tool call
+func (t *RuntimeTools) GetRuntimeByGenre(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input GetRuntimeByGenreInput,
+) (*mcp.CallToolResult, GetRuntimeByGenreOutput, error) {
+	stats, err := t.runtimes.GetRuntimeStats(ctx, movieApp.RuntimeQuery{
+		MinYear: input.MinYear,
+		MaxYear: input.MaxYear,
+	})
+	if err != nil {
+		return nil, GetRuntimeByGenreOutput{}, fmt.Errorf("failed to get runtimes: %w", err)
+	}
+
+	output := GetRuntimeByGenreOutput{
+		Genres:          make([]GenreRuntimeOutput, len(stats.Genres)),
+		Movies:          stats.Movies,
+		Unknown:         stats.Unknown,
+		AverageDuration: roundMinutes(stats.AverageDuration),
+		ShortFilms:      stats.ShortFilms,
+	}
+	for i, genre := range stats.Genres {
+		output.Genres[i] = GenreRuntimeOutput{
+			Genre:            genre.Genre,
+			Movies:           genre.Movies,
+			AverageDuration:  roundMinutes(genre.AverageDuration),
+			ShortestDuration: genre.ShortestDuration,
+			LongestDuration:  genre.LongestDuration,
+			ShortFilms:       genre.ShortFilms,
+		}
+	}
+
+	if output.Movies == 0 {
+		return summaryResult(ctx, output, "No movies with a known runtime (%s without one)",
+			countNoun(ctx, output.Unknown, "movie", "movies")), output, nil
+	}
+	return summaryResult(ctx, output, "Average runtime %.1f minutes over %s (%d without a runtime)%s",
+		output.AverageDuration, countNoun(ctx, output.Movies, "movie", "movies"), output.Unknown,
+		longestGenresSummary(ctx, output.Genres)), output, nil
+}
+
+// roundMinutes rounds an average runtime to one decimal
+func roundMinutes(minutes float64) float64 {
+	return math.Round(minutes*10) / 10
+}
+
+// longestGenresSummary lists genres by average runtime after a semicolon
+func longestGenresSummary(ctx context.Context, genres []GenreRuntimeOutput) string {
+	if len(genres) == 0 {
+		return ""
+	}
+	labels := make([]string, len(genres))
+	for i, genre := range genres {
+		labels[i] = fmt.Sprintf("%s (%g min)", genre.Genre, genre.AverageDuration)
+	}
+	return i18n.FromContext(ctx).Translate("; longest") + listSummary(ctx, labels)
+}
@@ -0,0 +1,83 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+)
+
+// MockRuntimeStatsService is a mock implementation of RuntimeStatsService
+type MockRuntimeStatsService struct {
+	GetRuntimeStatsFunc func(ctx context.Context, query movieApp.RuntimeQuery) (*movieApp.RuntimeStatsDTO, error)
+}
+
+func (m *MockRuntimeStatsService) GetRuntimeStats(ctx context.Context, query movieApp.RuntimeQuery) (*movieApp.RuntimeStatsDTO, error) {
+	if m.GetRuntimeStatsFunc != nil {
+		return m.GetRuntimeStatsFunc(ctx, query)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func TestGetRuntimeByGenre(t *testing.T) {
+	var gotQuery movieApp.RuntimeQuery
+	tools := NewRuntimeTools(&MockRuntimeStatsService{
+		GetRuntimeStatsFunc: func(ctx context.Context, query movieApp.RuntimeQuery) (*movieApp.RuntimeStatsDTO, error) {
+			gotQuery = query
+			return &movieApp.RuntimeStatsDTO{
+				Movies:          3,
+				Unknown:         2,
+				AverageDuration: 110.3333,
+				ShortFilms:      1,
+				Genres: []*movieApp.GenreRuntimeDTO{
+					{Genre: "Crime", Movies: 2, AverageDuration: 148.5, ShortestDuration: 127, LongestDuration: 170},
+					{Genre: "Drama", Movies: 2, AverageDuration: 102, ShortestDuration: 34, LongestDuration: 170, ShortFilms: 1},
+				},
+			}, nil
+		},
+	})
+
+	result, output, err := tools.GetRuntimeByGenre(context.Background(), nil, GetRuntimeByGenreInput{MinYear: 1990})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if gotQuery.MinYear != 1990 || gotQuery.MaxYear != 0 {
+		t.Errorf("Expected the year range to reach the query, got: %+v", gotQuery)
+	}
+	if output.AverageDuration != 110.3 || len(output.Genres) != 2 || output.Genres[0].LongestDuration != 170 {
+		t.Errorf("Unexpected output: %+v", output)
+	}
+	summary := result.Content[1].(*mcp.TextContent).Text
+	if summary != "Average runtime 110.3 minutes over 3 movies (2 without a runtime); longest: Crime (148.5 min), Drama (102 min)" {
+		t.Errorf("Unexpected summary: %q", summary)
+	}
+}
+
+func TestGetRuntimeByGenre_NoRuntimes(t *testing.T) {
+	tools := NewRuntimeTools(&MockRuntimeStatsService{
+		GetRuntimeStatsFunc: func(ctx context.Context, query movieApp.RuntimeQuery) (*movieApp.RuntimeStatsDTO, error) {
+			return &movieApp.RuntimeStatsDTO{Unknown: 4, Genres: []*movieApp.GenreRuntimeDTO{}}, nil
+		},
+	})
+
+	result, _, err := tools.GetRuntimeByGenre(context.Background(), nil, GetRuntimeByGenreInput{})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if summary := result.Content[1].(*mcp.TextContent).Text; summary != "No movies with a known runtime (4 movies without one)" {
+		t.Errorf("Unexpected summary: %q", summary)
+	}
+}
+
+func TestGetRuntimeByGenreInput_Validate(t *testing.T) {
+	if err := (GetRuntimeByGenreInput{MinYear: 1990, MaxYear: 2000}).Validate(); err != nil {
+		t.Errorf("Expected a valid range, got: %v", err)
+	}
+	if err := (GetRuntimeByGenreInput{MinYear: 2000, MaxYear: 1990}).Validate(); err == nil {
+		t.Error("Expected an error for a reversed range")
+	}
+}
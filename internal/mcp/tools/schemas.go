@@ -0,0 +1,35 @@
+package tools
+
+import (
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+)
+
+// OutputSchema derives the published output schema for a tool from its output DTO.
+// Deriving schemas from the same structs the handlers return keeps the declared
+// contract and the actual responses in sync.
+func OutputSchema[T any]() *jsonschema.Schema {
+	schema, err := jsonschema.For[T](nil)
+	if err != nil {
+		panic(fmt.Sprintf("failed to derive output schema for %T: %v", *new(T), err))
+	}
+	return schema
+}
+
+// nonNilStrings returns an empty slice instead of nil so array fields
+// serialize as [] and validate against their output schema
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// nonNilInts is nonNilStrings for integer arrays
+func nonNilInts(values []int) []int {
+	if values == nil {
+		return []int{}
+	}
+	return values
+}
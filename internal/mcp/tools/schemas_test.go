@@ -0,0 +1,190 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/jsonschema-go/jsonschema"
+
+	actorApp "github.com/francknouama/movies-mcp-server/internal/application/actor"
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+)
+
+// validateAgainstSchema marshals output and validates it the same way the SDK does
+func validateAgainstSchema(t *testing.T, schema *jsonschema.Schema, output any) {
+	t.Helper()
+
+	resolved, err := schema.Resolve(nil)
+	if err != nil {
+		t.Fatalf("Failed to resolve schema: %v", err)
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		t.Fatalf("Failed to marshal output: %v", err)
+	}
+
+	var value map[string]any
+	if err := json.Unmarshal(data, &value); err != nil {
+		t.Fatalf("Failed to unmarshal output: %v", err)
+	}
+
+	if err := resolved.Validate(value); err != nil {
+		t.Errorf("Output does not match schema: %v\noutput: %s", err, data)
+	}
+}
+
+func TestOutputSchema_AllToolOutputsAreObjects(t *testing.T) {
+	schemas := map[string]*jsonschema.Schema{
+		"MovieOutput":                  OutputSchema[MovieOutput](),
+		"DeleteMovieOutput":            OutputSchema[DeleteMovieOutput](),
+		"ListTopMoviesOutput":          OutputSchema[ListTopMoviesOutput](),
+		"SearchMoviesOutput":           OutputSchema[SearchMoviesOutput](),
+		"ActorOutput":                  OutputSchema[ActorOutput](),
+		"DeleteActorOutput":            OutputSchema[DeleteActorOutput](),
+		"LinkActorToMovieOutput":       OutputSchema[LinkActorToMovieOutput](),
+		"UnlinkActorFromMovieOutput":   OutputSchema[UnlinkActorFromMovieOutput](),
+		"GetMovieCastOutput":           OutputSchema[GetMovieCastOutput](),
+		"GetActorMoviesOutput":         OutputSchema[GetActorMoviesOutput](),
+		"SearchActorsOutput":           OutputSchema[SearchActorsOutput](),
+		"SearchByCharacterOutput":      OutputSchema[SearchByCharacterOutput](),
+		"BulkMovieImportOutput":        OutputSchema[BulkMovieImportOutput](),
+		"MovieRecommendationOutput":    OutputSchema[MovieRecommendationOutput](),
+		"DirectorCareerAnalysisOutput": OutputSchema[DirectorCareerAnalysisOutput](),
+		"CreateSearchContextOutput":    OutputSchema[CreateSearchContextOutput](),
+		"GetContextPageOutput":         OutputSchema[GetContextPageOutput](),
+		"GetContextInfoOutput":         OutputSchema[GetContextInfoOutput](),
+		"GetMoviesByIDsOutput":         OutputSchema[GetMoviesByIDsOutput](),
+		"GetActorsByIDsOutput":         OutputSchema[GetActorsByIDsOutput](),
+		"FranchiseOutput":              OutputSchema[FranchiseOutput](),
+		"DeleteFranchiseOutput":        OutputSchema[DeleteFranchiseOutput](),
+		"ListFranchisesOutput":         OutputSchema[ListFranchisesOutput](),
+		"GetFranchiseTimelineOutput":   OutputSchema[GetFranchiseTimelineOutput](),
+		"TranslationOutput":            OutputSchema[TranslationOutput](),
+		"ListTranslationsOutput":       OutputSchema[ListTranslationsOutput](),
+		"MediaOutput":                  OutputSchema[MediaOutput](),
+		"GetMovieMediaOutput":          OutputSchema[GetMovieMediaOutput](),
+		"BulkUpdateMoviesOutput":       OutputSchema[BulkUpdateMoviesOutput](),
+		"GetMovieHistoryOutput":        OutputSchema[GetMovieHistoryOutput](),
+		"RevertMovieToVersionOutput":   OutputSchema[RevertMovieToVersionOutput](),
+	}
+
+	for name, schema := range schemas {
+		if schema.Type != "object" {
+			t.Errorf("Expected %s schema type 'object', got: %q", name, schema.Type)
+		}
+	}
+}
+
+func TestOutputSchema_PropertiesFollowJSONTags(t *testing.T) {
+	schema := OutputSchema[MovieOutput]()
+
+	for _, field := range []string{"id", "title", "director", "year", "genres", "created_at", "updated_at"} {
+		if _, ok := schema.Properties[field]; !ok {
+			t.Errorf("Expected property %q in movie output schema", field)
+		}
+	}
+}
+
+func TestOutputSchema_EmptySearchResultsValidate(t *testing.T) {
+	mockService := &MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			return nil, nil
+		},
+	}
+
+	tools := NewMovieTools(mockService)
+	_, output, err := tools.SearchMovies(context.Background(), nil, SearchMoviesInput{Title: "nothing"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	validateAgainstSchema(t, OutputSchema[SearchMoviesOutput](), output)
+}
+
+func TestOutputSchema_MovieWithCertificationsValidates(t *testing.T) {
+	mockService := &MockMovieService{
+		GetMovieFunc: func(ctx context.Context, id int) (*movieApp.MovieDTO, error) {
+			return &movieApp.MovieDTO{
+				ID: id, Title: "The Dark Knight", Director: "Christopher Nolan", Year: 2008,
+				Certifications:  map[string]string{"US": "PG-13", "GB": "12A"},
+				ContentWarnings: []string{"violence"},
+			}, nil
+		},
+	}
+
+	tools := NewMovieTools(mockService)
+	_, output, err := tools.GetMovie(context.Background(), nil, GetMovieInput{MovieID: 1})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	validateAgainstSchema(t, OutputSchema[GetMovieOutput](), output)
+}
+
+func TestOutputSchema_MovieWithoutGenresValidates(t *testing.T) {
+	mockService := &MockMovieService{
+		GetMovieFunc: func(ctx context.Context, id int) (*movieApp.MovieDTO, error) {
+			return &movieApp.MovieDTO{ID: id, Title: "Untitled", Director: "Unknown", Year: 2000}, nil
+		},
+	}
+
+	tools := NewMovieTools(mockService)
+	_, output, err := tools.GetMovie(context.Background(), nil, GetMovieInput{MovieID: 1})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	validateAgainstSchema(t, OutputSchema[GetMovieOutput](), output)
+}
+
+func TestOutputSchema_ActorWithoutMoviesValidates(t *testing.T) {
+	mockService := &MockActorService{
+		GetActorFunc: func(ctx context.Context, id int) (*actorApp.ActorDTO, error) {
+			return &actorApp.ActorDTO{ID: id, Name: "Newcomer", BirthYear: 2000}, nil
+		},
+	}
+
+	tools := NewActorTools(mockService)
+	_, output, err := tools.GetActorMovies(context.Background(), nil, GetActorMoviesInput{ActorID: 1})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	validateAgainstSchema(t, OutputSchema[GetActorMoviesOutput](), output)
+}
+
+func TestOutputSchema_EmptyBulkImportValidates(t *testing.T) {
+	tools := NewCompoundTools(&MockMovieService{})
+	_, output, err := tools.BulkMovieImport(context.Background(), nil, BulkMovieImportInput{})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	validateAgainstSchema(t, OutputSchema[BulkMovieImportOutput](), output)
+}
+
+func TestOutputSchema_EmptyContextPageValidates(t *testing.T) {
+	mockService := &MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			return []*movieApp.MovieDTO{}, nil
+		},
+	}
+
+	tools := NewContextTools(mockService)
+	_, created, err := tools.CreateSearchContext(context.Background(), nil, CreateSearchContextInput{})
+	if err != nil {
+		t.Fatalf("Expected no error creating context, got: %v", err)
+	}
+
+	_, output, err := tools.GetContextPage(context.Background(), nil, GetContextPageInput{
+		ContextID: created.ContextID,
+		Page:      1,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	validateAgainstSchema(t, OutputSchema[GetContextPageOutput](), output)
+}
@@ -0,0 +1,341 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	actorApp "github.com/francknouama/movies-mcp-server/internal/application/actor"
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/i18n"
+	"github.com/francknouama/movies-mcp-server/pkg/fuzzy"
+)
+
+const (
+	// defaultSearchAllLimit is how many results each group returns by default
+	defaultSearchAllLimit = 5
+	// maxSearchAllLimit caps the results per group
+	maxSearchAllLimit = 50
+	// searchAllCandidates is how many matches each group fetches to rank and
+	// count; group counts stop at this number
+	searchAllCandidates = 200
+)
+
+// Search groups, in the order they are listed when equally relevant
+const (
+	searchGroupMovies    = "movies"
+	searchGroupActors    = "actors"
+	searchGroupDirectors = "directors"
+)
+
+// searchGroups are the groups search_all searches by default
+var searchGroups = []string{searchGroupMovies, searchGroupActors, searchGroupDirectors}
+
+// MovieSearcher defines the interface for searching movies
+type MovieSearcher interface {
+	SearchMovies(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error)
+}
+
+// ActorSearcher defines the interface for searching actors
+type ActorSearcher interface {
+	SearchActors(ctx context.Context, query actorApp.SearchActorsQuery) ([]*actorApp.ActorDTO, error)
+}
+
+// SearchAllTools provides SDK-based MCP handlers for searching movies and
+// people in one call
+type SearchAllTools struct {
+	movies MovieSearcher
+	actors ActorSearcher
+}
+
+// NewSearchAllTools creates a new universal search tools instance
+func NewSearchAllTools(movies MovieSearcher, actors ActorSearcher) *SearchAllTools {
+	return &SearchAllTools{
+		movies: movies,
+		actors: actors,
+	}
+}
+
+// ===== search_all Tool =====
+
+// SearchAllInput defines the input schema for search_all tool
+type SearchAllInput struct {
+	Query string   `json:"query" jsonschema:"Text to look for in movie titles, actor names and director names"`
+	Types []string `json:"types,omitempty" jsonschema:"Groups to search (movies/actors/directors; default all)"`
+	Limit int      `json:"limit,omitempty" jsonschema:"Maximum results per group (default 5, max 50)"`
+	Fuzzy bool     `json:"fuzzy,omitempty" jsonschema:"Typo-tolerant matching of movie titles and actor names"`
+}
+
+// Validate requires a query and checks the groups and limit
+func (in SearchAllInput) Validate() error {
+	if strings.TrimSpace(in.Query) == "" {
+		return shared.NewValidationError("query is required")
+	}
+	for _, groupType := range in.Types {
+		if !isSearchGroup(groupType) {
+			return shared.NewValidationError("unsupported search type %q (must be one of %s)",
+				groupType, strings.Join(searchGroups, ", "))
+		}
+	}
+	if in.Limit < 0 || in.Limit > maxSearchAllLimit {
+		return shared.NewValidationError("limit must be between 1 and %d", maxSearchAllLimit)
+	}
+	return nil
+}
+
+// SearchAllOutput defines the output schema for search_all tool
+type SearchAllOutput struct {
+	Query  string           `json:"query" jsonschema:"The query searched for"`
+	Total  int              `json:"total" jsonschema:"Number of matches across all groups"`
+	Groups []SearchAllGroup `json:"groups" jsonschema:"Result groups, the group with the most relevant match first"`
+}
+
+// SearchAllGroup is the results of one kind of entity
+type SearchAllGroup struct {
+	Type    string         `json:"type" jsonschema:"Group type (movies/actors/directors)"`
+	Count   int            `json:"count" jsonschema:"Number of matches in the group, up to 200; results holds the most relevant"`
+	Results []SearchAllHit `json:"results" jsonschema:"Matches, the most relevant first"`
+}
+
+// SearchAllHit is one match; exactly one of Movie, Actor and Director is set
+type SearchAllHit struct {
+	Type     string          `json:"type" jsonschema:"Entity type (movie/actor/director)"`
+	ID       int             `json:"id,omitempty" jsonschema:"Movie or actor ID; directors have none"`
+	Name     string          `json:"name" jsonschema:"Movie title or person name"`
+	Score    float64         `json:"score" jsonschema:"Relevance to the query (0-1)"`
+	Movie    *MovieOutput    `json:"movie,omitempty" jsonschema:"The movie, for movie matches"`
+	Actor    *ActorOutput    `json:"actor,omitempty" jsonschema:"The actor, for actor matches"`
+	Director *DirectorOutput `json:"director,omitempty" jsonschema:"The director, for director matches"`
+}
+
+// DirectorOutput summarizes a director from the movies they directed
+type DirectorOutput struct {
+	Name       string `json:"name" jsonschema:"Director name"`
+	MovieCount int    `json:"movie_count" jsonschema:"Number of movies directed"`
+	MovieIDs   []int  `json:"movie_ids" jsonschema:"IDs of the movies directed"`
+	FirstYear  int    `json:"first_year" jsonschema:"Release year of the earliest movie"`
+	LastYear   int    `json:"last_year" jsonschema:"Release year of the latest movie"`
+}
+
+// SearchAll handles the search_all tool call
+func (t *SearchAllTools) SearchAll(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input SearchAllInput,
+) (*mcp.CallToolResult, SearchAllOutput, error) {
+	query := strings.TrimSpace(input.Query)
+	limit := input.Limit
+	if limit == 0 {
+		limit = defaultSearchAllLimit
+	}
+
+	output := SearchAllOutput{Query: query, Groups: []SearchAllGroup{}}
+	for _, groupType := range requestedSearchGroups(input.Types) {
+		var hits []SearchAllHit
+		var err error
+		switch groupType {
+		case searchGroupMovies:
+			hits, err = t.searchMovies(ctx, query, input.Fuzzy)
+		case searchGroupActors:
+			hits, err = t.searchActors(ctx, query, input.Fuzzy)
+		case searchGroupDirectors:
+			hits, err = t.searchDirectors(ctx, query)
+		}
+		if err != nil {
+			return nil, SearchAllOutput{}, fmt.Errorf("failed to search %s: %w", groupType, err)
+		}
+
+		sortHits(hits)
+		group := SearchAllGroup{Type: groupType, Count: len(hits), Results: hits}
+		if len(group.Results) > limit {
+			group.Results = group.Results[:limit]
+		}
+		output.Total += group.Count
+		output.Groups = append(output.Groups, group)
+	}
+	sortGroups(output.Groups)
+
+	return summaryResult(ctx, output, "%s", searchAllSummary(ctx, output)), output, nil
+}
+
+// searchMovies matches the query against movie titles
+func (t *SearchAllTools) searchMovies(ctx context.Context, query string, useFuzzy bool) ([]SearchAllHit, error) {
+	movieDTOs, err := t.movies.SearchMovies(ctx, movieApp.SearchMoviesQuery{
+		Title: query,
+		Fuzzy: useFuzzy,
+		Limit: searchAllCandidates,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]SearchAllHit, 0, len(movieDTOs))
+	for _, movieDTO := range movieDTOs {
+		movie := newMovieOutput(movieDTO)
+		hits = append(hits, SearchAllHit{
+			Type:  "movie",
+			ID:    movie.ID,
+			Name:  movie.Title,
+			Score: max(relevance(query, movie.Title), movieDTO.Similarity),
+			Movie: &movie,
+		})
+	}
+	return hits, nil
+}
+
+// searchActors matches the query against actor names
+func (t *SearchAllTools) searchActors(ctx context.Context, query string, useFuzzy bool) ([]SearchAllHit, error) {
+	actorDTOs, err := t.actors.SearchActors(ctx, actorApp.SearchActorsQuery{
+		Name:  query,
+		Fuzzy: useFuzzy,
+		Limit: searchAllCandidates,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]SearchAllHit, 0, len(actorDTOs))
+	for _, actorDTO := range actorDTOs {
+		actor := newActorOutput(actorDTO)
+		hits = append(hits, SearchAllHit{
+			Type:  "actor",
+			ID:    actor.ID,
+			Name:  actor.Name,
+			Score: max(relevance(query, actor.Name), actorDTO.Similarity),
+			Actor: &actor,
+		})
+	}
+	return hits, nil
+}
+
+// searchDirectors matches the query against the directors of movies,
+// one result per director
+func (t *SearchAllTools) searchDirectors(ctx context.Context, query string) ([]SearchAllHit, error) {
+	movieDTOs, err := t.movies.SearchMovies(ctx, movieApp.SearchMoviesQuery{
+		Director: query,
+		Limit:    searchAllCandidates,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	directors := make(map[string]*DirectorOutput)
+	var names []string
+	for _, movieDTO := range movieDTOs {
+		director, ok := directors[movieDTO.Director]
+		if !ok {
+			director = &DirectorOutput{Name: movieDTO.Director, FirstYear: movieDTO.Year, LastYear: movieDTO.Year}
+			directors[movieDTO.Director] = director
+			names = append(names, movieDTO.Director)
+		}
+		director.MovieCount++
+		director.MovieIDs = append(director.MovieIDs, movieDTO.ID)
+		director.FirstYear = min(director.FirstYear, movieDTO.Year)
+		director.LastYear = max(director.LastYear, movieDTO.Year)
+	}
+
+	hits := make([]SearchAllHit, 0, len(names))
+	for _, name := range names {
+		director := directors[name]
+		sort.Ints(director.MovieIDs)
+		hits = append(hits, SearchAllHit{
+			Type:     "director",
+			Name:     name,
+			Score:    relevance(query, name),
+			Director: director,
+		})
+	}
+	return hits, nil
+}
+
+// relevance scores how well text matches query, from 0 to 1: an exact match
+// scores 1, then a prefix, a word prefix and a substring, with fuzzy
+// similarity as the floor so close misspellings still rank
+func relevance(query, text string) float64 {
+	q, lowered := strings.ToLower(strings.TrimSpace(query)), strings.ToLower(text)
+
+	var score float64
+	switch {
+	case q == "":
+	case lowered == q:
+		score = 1
+	case strings.HasPrefix(lowered, q):
+		score = 0.9
+	case strings.Contains(" "+lowered, " "+q):
+		score = 0.8
+	case strings.Contains(lowered, q):
+		score = 0.7
+	}
+	return math.Round(max(score, fuzzy.Similarity(query, text))*1000) / 1000
+}
+
+// sortHits orders hits by relevance, then by name
+func sortHits(hits []SearchAllHit) {
+	sort.SliceStable(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].Name < hits[j].Name
+	})
+}
+
+// sortGroups orders groups by their most relevant hit; empty groups go last
+func sortGroups(groups []SearchAllGroup) {
+	topScore := func(group SearchAllGroup) float64 {
+		if len(group.Results) == 0 {
+			return -1
+		}
+		return group.Results[0].Score
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		return topScore(groups[i]) > topScore(groups[j])
+	})
+}
+
+// requestedSearchGroups returns the requested groups in their canonical
+// order without duplicates, or every group when none is requested
+func requestedSearchGroups(types []string) []string {
+	if len(types) == 0 {
+		return searchGroups
+	}
+	var groups []string
+	for _, group := range searchGroups {
+		for _, requested := range types {
+			if strings.EqualFold(strings.TrimSpace(requested), group) {
+				groups = append(groups, group)
+				break
+			}
+		}
+	}
+	return groups
+}
+
+// isSearchGroup reports whether groupType names a search group
+func isSearchGroup(groupType string) bool {
+	for _, group := range searchGroups {
+		if strings.EqualFold(strings.TrimSpace(groupType), group) {
+			return true
+		}
+	}
+	return false
+}
+
+// searchAllSummary describes the matches of every group in one line
+func searchAllSummary(ctx context.Context, output SearchAllOutput) string {
+	nouns := map[string][2]string{
+		searchGroupMovies:    {"movie", "movies"},
+		searchGroupActors:    {"actor", "actors"},
+		searchGroupDirectors: {"director", "directors"},
+	}
+	counts := make([]string, 0, len(output.Groups))
+	for _, group := range output.Groups {
+		noun := nouns[group.Type]
+		counts = append(counts, countNoun(ctx, group.Count, noun[0], noun[1]))
+	}
+	return i18n.FromContext(ctx).Sprintf("Found %s for %q", strings.Join(counts, ", "), output.Query)
+}
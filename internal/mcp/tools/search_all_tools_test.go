@@ -0,0 +1,192 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	actorApp "github.com/francknouama/movies-mcp-server/internal/application/actor"
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// newSearchAllTestTools serves a small catalogue from mocks: movies match
+// by title or director substring and actors by name substring
+func newSearchAllTestTools() *SearchAllTools {
+	catalogue := []*movieApp.MovieDTO{
+		{ID: 1, Title: "Inception", Director: "Christopher Nolan", Year: 2010},
+		{ID: 2, Title: "Interstellar", Director: "Christopher Nolan", Year: 2014},
+		{ID: 3, Title: "Memento", Director: "Christopher Nolan", Year: 2000},
+		{ID: 4, Title: "The Nolan Sisters Story", Director: "Jane Doe", Year: 1995},
+	}
+	cast := []*actorApp.ActorDTO{
+		{ID: 10, Name: "John Nolan", BirthYear: 1938},
+		{ID: 11, Name: "Leonardo DiCaprio", BirthYear: 1974},
+	}
+
+	movies := &MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			var found []*movieApp.MovieDTO
+			for _, movie := range catalogue {
+				if query.Title != "" && strings.Contains(strings.ToLower(movie.Title), strings.ToLower(query.Title)) ||
+					query.Director != "" && strings.Contains(strings.ToLower(movie.Director), strings.ToLower(query.Director)) {
+					found = append(found, movie)
+				}
+			}
+			return found, nil
+		},
+	}
+	actors := &MockActorService{
+		SearchActorsFunc: func(ctx context.Context, query actorApp.SearchActorsQuery) ([]*actorApp.ActorDTO, error) {
+			var found []*actorApp.ActorDTO
+			for _, actor := range cast {
+				if strings.Contains(strings.ToLower(actor.Name), strings.ToLower(query.Name)) {
+					found = append(found, actor)
+				}
+			}
+			return found, nil
+		},
+	}
+	return NewSearchAllTools(movies, actors)
+}
+
+func TestSearchAll_GroupsAndCounts(t *testing.T) {
+	tools := newSearchAllTestTools()
+
+	result, output, err := tools.SearchAll(context.Background(), nil, SearchAllInput{Query: "nolan"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	assertSummaryResult(t, result)
+
+	if output.Total != 3 {
+		t.Errorf("Expected 3 matches in total, got: %d", output.Total)
+	}
+	if len(output.Groups) != 3 {
+		t.Fatalf("Expected 3 groups, got: %d", len(output.Groups))
+	}
+
+	groups := make(map[string]SearchAllGroup)
+	for _, group := range output.Groups {
+		groups[group.Type] = group
+	}
+	if groups["movies"].Count != 1 || groups["movies"].Results[0].Movie == nil {
+		t.Errorf("Expected one typed movie match, got: %+v", groups["movies"])
+	}
+	if groups["actors"].Count != 1 || groups["actors"].Results[0].Actor == nil {
+		t.Errorf("Expected one typed actor match, got: %+v", groups["actors"])
+	}
+
+	director := groups["directors"].Results[0].Director
+	if director == nil || director.Name != "Christopher Nolan" || director.MovieCount != 3 {
+		t.Fatalf("Expected Christopher Nolan with 3 movies, got: %+v", director)
+	}
+	if director.FirstYear != 2000 || director.LastYear != 2014 {
+		t.Errorf("Expected a 2000-2014 career, got: %d-%d", director.FirstYear, director.LastYear)
+	}
+	if len(director.MovieIDs) != 3 || director.MovieIDs[0] != 1 || director.MovieIDs[2] != 3 {
+		t.Errorf("Expected sorted movie IDs, got: %v", director.MovieIDs)
+	}
+}
+
+func TestSearchAll_RelevanceOrdering(t *testing.T) {
+	tools := newSearchAllTestTools()
+
+	_, output, err := tools.SearchAll(context.Background(), nil, SearchAllInput{Query: "Inception"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	// The exact title match puts movies first; groups without matches go last
+	if output.Groups[0].Type != "movies" || output.Groups[0].Results[0].Score != 1 {
+		t.Errorf("Expected the exact movie match first, got: %+v", output.Groups[0])
+	}
+	for _, group := range output.Groups[1:] {
+		if group.Count != 0 {
+			t.Errorf("Expected no %s matches, got: %d", group.Type, group.Count)
+		}
+	}
+}
+
+func TestSearchAll_TypesAndLimit(t *testing.T) {
+	tools := newSearchAllTestTools()
+
+	_, output, err := tools.SearchAll(context.Background(), nil, SearchAllInput{Query: "in", Types: []string{"Movies"}, Limit: 1})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(output.Groups) != 1 || output.Groups[0].Type != "movies" {
+		t.Fatalf("Expected only the movies group, got: %+v", output.Groups)
+	}
+	group := output.Groups[0]
+	if group.Count != 2 || len(group.Results) != 1 {
+		t.Errorf("Expected 2 matches with 1 returned, got: %d with %d", group.Count, len(group.Results))
+	}
+	if group.Results[0].Name != "Inception" {
+		t.Errorf("Expected Inception first among equal prefix matches, got: %s", group.Results[0].Name)
+	}
+}
+
+func TestSearchAll_ServiceError(t *testing.T) {
+	tools := NewSearchAllTools(&MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			return nil, errors.New("database error")
+		},
+	}, &MockActorService{})
+
+	_, _, err := tools.SearchAll(context.Background(), nil, SearchAllInput{Query: "nolan"})
+	if err == nil || !strings.Contains(err.Error(), "failed to search movies") {
+		t.Errorf("Expected a movies search error, got: %v", err)
+	}
+}
+
+func TestSearchAllInput_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   SearchAllInput
+		wantErr bool
+	}{
+		{name: "query only", input: SearchAllInput{Query: "nolan"}},
+		{name: "types and limit", input: SearchAllInput{Query: "nolan", Types: []string{"actors", "directors"}, Limit: 50}},
+		{name: "blank query", input: SearchAllInput{Query: "  "}, wantErr: true},
+		{name: "unknown type", input: SearchAllInput{Query: "nolan", Types: []string{"studios"}}, wantErr: true},
+		{name: "limit too high", input: SearchAllInput{Query: "nolan", Limit: 51}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, shared.ErrValidation) {
+				t.Errorf("Expected a validation error, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestRelevance(t *testing.T) {
+	tests := []struct {
+		query, text string
+		want        float64
+	}{
+		{query: "inception", text: "Inception", want: 1},
+		{query: "inter", text: "Interstellar", want: 0.9},
+		{query: "nolan", text: "Christopher Nolan", want: 0.8},
+		{query: "stell", text: "Interstellar", want: 0.7},
+		{query: "", text: "Interstellar", want: 0},
+	}
+
+	for _, tt := range tests {
+		if got := relevance(tt.query, tt.text); got < tt.want {
+			t.Errorf("Expected relevance(%q, %q) of at least %v, got: %v", tt.query, tt.text, tt.want, got)
+		}
+	}
+
+	if relevance("nolan", "Christopher Nolan") <= relevance("stell", "Interstellar") {
+		t.Error("Expected a word prefix to outrank a substring")
+	}
+}
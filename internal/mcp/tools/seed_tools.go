@@ -0,0 +1,70 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/application/seed"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// SeedTools provides SDK-based MCP handlers for loading curated datasets
+type SeedTools struct {
+	seeder *seed.Seeder
+}
+
+// NewSeedTools creates a new seed tools instance
+func NewSeedTools(movieService MovieService) *SeedTools {
+	return &SeedTools{
+		seeder: seed.NewSeeder(movieService),
+	}
+}
+
+// ===== seed_database Tool =====
+
+// SeedDatabaseInput defines the input schema for seed_database tool
+type SeedDatabaseInput struct {
+	Dataset string `json:"dataset" jsonschema:"Dataset to load (classics/recent/fixtures)"`
+}
+
+// Validate requires a dataset name
+func (in SeedDatabaseInput) Validate() error {
+	if in.Dataset == "" {
+		return shared.NewValidationError("dataset is required")
+	}
+	return nil
+}
+
+// SeedDatabaseOutput defines the output schema for seed_database tool
+type SeedDatabaseOutput struct {
+	Dataset   string   `json:"dataset" jsonschema:"Dataset that was loaded"`
+	Inserted  int      `json:"inserted" jsonschema:"Number of movies inserted"`
+	Skipped   int      `json:"skipped" jsonschema:"Number of movies already present"`
+	Errors    []string `json:"errors" jsonschema:"Movies that could not be inserted"`
+	Available []string `json:"available" jsonschema:"All embedded datasets"`
+}
+
+// SeedDatabase handles the seed_database tool call
+func (t *SeedTools) SeedDatabase(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input SeedDatabaseInput,
+) (*mcp.CallToolResult, SeedDatabaseOutput, error) {
+	result, err := t.seeder.Seed(ctx, input.Dataset)
+	if err != nil {
+		return nil, SeedDatabaseOutput{}, fmt.Errorf("failed to seed database: %w", err)
+	}
+
+	output := SeedDatabaseOutput{
+		Dataset:   result.Dataset,
+		Inserted:  result.Inserted,
+		Skipped:   result.Skipped,
+		Errors:    nonNilStrings(result.Errors),
+		Available: seed.Datasets(),
+	}
+
+	return summaryResult(ctx, output, "Seeded %s: %d inserted, %d already present, %d failed",
+		output.Dataset, output.Inserted, output.Skipped, len(output.Errors)), output, nil
+}
@@ -0,0 +1,64 @@
+package tools
+
+import (
+	"context"
+	"testing"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+)
+
+func TestSeedDatabase_Success(t *testing.T) {
+	created := 0
+	mockService := &MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			if query.Title == "Casablanca" {
+				return []*movieApp.MovieDTO{{ID: 1, Title: "Casablanca", Year: 1942}}, nil
+			}
+			return []*movieApp.MovieDTO{}, nil
+		},
+		CreateMovieFunc: func(ctx context.Context, cmd movieApp.CreateMovieCommand) (*movieApp.MovieDTO, error) {
+			created++
+			return &movieApp.MovieDTO{ID: created + 1, Title: cmd.Title}, nil
+		},
+	}
+	tools := NewSeedTools(mockService)
+
+	_, output, err := tools.SeedDatabase(context.Background(), nil, SeedDatabaseInput{Dataset: "fixtures"})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Dataset != "fixtures" {
+		t.Errorf("Expected dataset fixtures, got: %s", output.Dataset)
+	}
+	if output.Skipped != 1 {
+		t.Errorf("Expected 1 skipped movie, got: %d", output.Skipped)
+	}
+	if output.Inserted != created {
+		t.Errorf("Expected %d inserted movies, got: %d", created, output.Inserted)
+	}
+	if output.Errors == nil {
+		t.Error("Expected non-nil errors slice")
+	}
+	if len(output.Available) == 0 {
+		t.Error("Expected available datasets to be listed")
+	}
+}
+
+func TestSeedDatabase_MissingDataset(t *testing.T) {
+	err := SeedDatabaseInput{}.Validate()
+
+	if err == nil {
+		t.Error("Expected error for missing dataset")
+	}
+}
+
+func TestSeedDatabase_UnknownDataset(t *testing.T) {
+	tools := NewSeedTools(&MockMovieService{})
+
+	_, _, err := tools.SeedDatabase(context.Background(), nil, SeedDatabaseInput{Dataset: "bogus"})
+
+	if err == nil {
+		t.Error("Expected error for unknown dataset")
+	}
+}
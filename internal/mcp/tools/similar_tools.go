@@ -0,0 +1,113 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	similarityApp "github.com/francknouama/movies-mcp-server/internal/application/similarity"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/domain/similarity"
+)
+
+// defaultSimilarMovies is how many movies get_similar_movies returns by
+// default; it returns at most similarity.MaxNeighbors
+const defaultSimilarMovies = 10
+
+// SimilarMoviesService defines the interface for similar-movie lookups
+type SimilarMoviesService interface {
+	GetSimilarMovies(ctx context.Context, movieID, limit int) (*similarityApp.SimilarMoviesDTO, error)
+}
+
+// SimilarTools provides SDK-based MCP handlers for precomputed movie
+// similarities
+type SimilarTools struct {
+	service SimilarMoviesService
+}
+
+// NewSimilarTools creates a new similar tools instance
+func NewSimilarTools(service SimilarMoviesService) *SimilarTools {
+	return &SimilarTools{
+		service: service,
+	}
+}
+
+// ===== get_similar_movies Tool =====
+
+// GetSimilarMoviesInput defines the input schema for get_similar_movies tool
+type GetSimilarMoviesInput struct {
+	MovieID int `json:"movie_id" jsonschema:"Movie to find similar movies for"`
+	Limit   int `json:"limit,omitempty" jsonschema:"Maximum similar movies (default 10, max 50)"`
+}
+
+// Validate requires a movie and bounds the limit
+func (in GetSimilarMoviesInput) Validate() error {
+	if in.MovieID <= 0 {
+		return shared.NewValidationError("movie_id is required")
+	}
+	if in.Limit < 0 || in.Limit > similarity.MaxNeighbors {
+		return shared.NewValidationError("limit must be between 1 and %d", similarity.MaxNeighbors)
+	}
+	return nil
+}
+
+// SimilarMovieOutput defines the output schema for a similar movie
+type SimilarMovieOutput struct {
+	ID       int      `json:"id" jsonschema:"Movie ID"`
+	Title    string   `json:"title" jsonschema:"Movie title"`
+	Director string   `json:"director" jsonschema:"Movie director"`
+	Year     int      `json:"year" jsonschema:"Release year"`
+	Rating   float64  `json:"rating,omitempty" jsonschema:"Movie rating (0-10)"`
+	Genres   []string `json:"genres" jsonschema:"Movie genres"`
+	Score    float64  `json:"score" jsonschema:"Similarity to the movie, from 0.35 to 1"`
+}
+
+// GetSimilarMoviesOutput defines the output schema for get_similar_movies tool
+type GetSimilarMoviesOutput struct {
+	MovieID int                  `json:"movie_id" jsonschema:"Movie ID"`
+	Title   string               `json:"title" jsonschema:"Movie title"`
+	Similar []SimilarMovieOutput `json:"similar" jsonschema:"Most similar movies first"`
+}
+
+// GetSimilarMovies handles the get_similar_movies tool call
+func (t *SimilarTools) GetSimilarMovies(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input GetSimilarMoviesInput,
+) (*mcp.CallToolResult, GetSimilarMoviesOutput, error) {
+	limit := input.Limit
+	if limit == 0 {
+		limit = defaultSimilarMovies
+	}
+
+	dto, err := t.service.GetSimilarMovies(ctx, input.MovieID, limit)
+	if err != nil {
+		return nil, GetSimilarMoviesOutput{}, fmt.Errorf("failed to get similar movies: %w", err)
+	}
+
+	output := GetSimilarMoviesOutput{
+		MovieID: dto.MovieID,
+		Title:   dto.Title,
+		Similar: make([]SimilarMovieOutput, len(dto.Similar)),
+	}
+	names := make([]string, len(dto.Similar))
+	for i, similar := range dto.Similar {
+		output.Similar[i] = SimilarMovieOutput{
+			ID:       similar.ID,
+			Title:    similar.Title,
+			Director: similar.Director,
+			Year:     similar.Year,
+			Rating:   similar.Rating,
+			Genres:   nonNilStrings(similar.Genres),
+			Score:    similar.Score,
+		}
+		names[i] = fmt.Sprintf("%s (%d, %g)", similar.Title, similar.Year, similar.Score)
+	}
+
+	if len(names) == 0 {
+		return summaryResult(ctx, output, "No movies similar to %d %q", output.MovieID, output.Title), output, nil
+	}
+	return summaryResult(ctx, output, "%s similar to %d %q%s", countNoun(ctx, len(names), "movie", "movies"),
+		output.MovieID, output.Title, listSummary(ctx, names)), output, nil
+}
@@ -0,0 +1,112 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	similarityApp "github.com/francknouama/movies-mcp-server/internal/application/similarity"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/domain/similarity"
+)
+
+// MockSimilarMoviesService is a mock implementation of SimilarMoviesService
+type MockSimilarMoviesService struct {
+	GetSimilarMoviesFunc func(ctx context.Context, movieID, limit int) (*similarityApp.SimilarMoviesDTO, error)
+}
+
+func (m *MockSimilarMoviesService) GetSimilarMovies(ctx context.Context, movieID, limit int) (*similarityApp.SimilarMoviesDTO, error) {
+	if m.GetSimilarMoviesFunc != nil {
+		return m.GetSimilarMoviesFunc(ctx, movieID, limit)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func TestGetSimilarMovies_Success(t *testing.T) {
+	var gotLimit int
+	tools := NewSimilarTools(&MockSimilarMoviesService{
+		GetSimilarMoviesFunc: func(ctx context.Context, movieID, limit int) (*similarityApp.SimilarMoviesDTO, error) {
+			gotLimit = limit
+			return &similarityApp.SimilarMoviesDTO{
+				MovieID: movieID,
+				Title:   "Heat",
+				Similar: []*similarityApp.SimilarMovieDTO{
+					{ID: 2, Title: "Collateral", Director: "Michael Mann", Year: 2004, Genres: []string{"Crime"}, Score: 0.62},
+					{ID: 3, Title: "The Town", Director: "Ben Affleck", Year: 2010, Score: 0.41},
+				},
+			}, nil
+		},
+	})
+
+	result, output, err := tools.GetSimilarMovies(context.Background(), nil, GetSimilarMoviesInput{MovieID: 1})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if gotLimit != defaultSimilarMovies {
+		t.Errorf("Expected the default limit, got %d", gotLimit)
+	}
+	if len(output.Similar) != 2 || output.Similar[0].Score != 0.62 || output.Similar[1].Genres == nil {
+		t.Errorf("Unexpected output: %+v", output)
+	}
+	summary := result.Content[1].(*mcp.TextContent).Text
+	want := `2 movies similar to 1 "Heat": Collateral (2004, 0.62), The Town (2010, 0.41)`
+	if summary != want {
+		t.Errorf("Unexpected summary: %q", summary)
+	}
+}
+
+func TestGetSimilarMovies_NoneSimilar(t *testing.T) {
+	tools := NewSimilarTools(&MockSimilarMoviesService{
+		GetSimilarMoviesFunc: func(ctx context.Context, movieID, limit int) (*similarityApp.SimilarMoviesDTO, error) {
+			return &similarityApp.SimilarMoviesDTO{MovieID: movieID, Title: "Barbie"}, nil
+		},
+	})
+
+	result, output, err := tools.GetSimilarMovies(context.Background(), nil, GetSimilarMoviesInput{MovieID: 4})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Similar == nil {
+		t.Error("Expected an empty list rather than null")
+	}
+	if summary := result.Content[1].(*mcp.TextContent).Text; summary != `No movies similar to 4 "Barbie"` {
+		t.Errorf("Unexpected summary: %q", summary)
+	}
+}
+
+func TestGetSimilarMovies_NotFound(t *testing.T) {
+	tools := NewSimilarTools(&MockSimilarMoviesService{
+		GetSimilarMoviesFunc: func(ctx context.Context, movieID, limit int) (*similarityApp.SimilarMoviesDTO, error) {
+			return nil, shared.NewNotFoundError("movie %d not found", movieID)
+		},
+	})
+
+	_, _, err := tools.GetSimilarMovies(context.Background(), nil, GetSimilarMoviesInput{MovieID: 99})
+	if !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got: %v", err)
+	}
+}
+
+func TestGetSimilarMoviesInput_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   GetSimilarMoviesInput
+		wantErr bool
+	}{
+		{name: "movie only", input: GetSimilarMoviesInput{MovieID: 1}},
+		{name: "maximum limit", input: GetSimilarMoviesInput{MovieID: 1, Limit: similarity.MaxNeighbors}},
+		{name: "no movie", input: GetSimilarMoviesInput{}, wantErr: true},
+		{name: "over the limit", input: GetSimilarMoviesInput{MovieID: 1, Limit: similarity.MaxNeighbors + 1}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
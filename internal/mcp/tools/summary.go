@@ -0,0 +1,68 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/mcp/i18n"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/middleware"
+)
+
+// maxSummaryItems caps how many names a list summary spells out
+const maxSummaryItems = 5
+
+// summaryResult builds a tool result whose text content carries the
+// serialized output, for clients that only read text, followed by a short
+// human-readable summary; the call's verbosity may keep only one of them.
+// The SDK fills StructuredContent from the typed output itself; if the
+// output cannot be serialized, nil is returned so the SDK falls back to its
+// default content. The summary is written in the language of ctx's printer.
+func summaryResult(ctx context.Context, output any, format string, args ...any) *mcp.CallToolResult {
+	content, err := middleware.SummaryContent(ctx, output, i18n.FromContext(ctx).Sprintf(format, args...))
+	if err != nil {
+		return nil
+	}
+	return &mcp.CallToolResult{Content: content}
+}
+
+// countNoun formats a count with a singular or plural noun ("1 movie", "3 movies")
+func countNoun(ctx context.Context, count int, singular, plural string) string {
+	return i18n.FromContext(ctx).Count(count, singular, plural)
+}
+
+// listSummary joins up to maxSummaryItems names, noting how many were left out
+func listSummary(ctx context.Context, names []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	if len(names) <= maxSummaryItems {
+		return ": " + strings.Join(names, ", ")
+	}
+	return i18n.FromContext(ctx).Sprintf(": %s and %d more", strings.Join(names[:maxSummaryItems], ", "), len(names)-maxSummaryItems)
+}
+
+// movieLabel formats a movie as "Title (Year)"
+func movieLabel(movie MovieOutput) string {
+	return fmt.Sprintf("%s (%d)", movie.Title, movie.Year)
+}
+
+// movieListSummary describes a list of movies in one line
+func movieListSummary(ctx context.Context, verb string, movies []MovieOutput) string {
+	names := make([]string, 0, len(movies))
+	for _, movie := range movies {
+		names = append(names, movieLabel(movie))
+	}
+	return i18n.FromContext(ctx).Translate(verb) + " " + countNoun(ctx, len(movies), "movie", "movies") + listSummary(ctx, names)
+}
+
+// actorListSummary describes a list of actors in one line
+func actorListSummary(ctx context.Context, verb string, actors []ActorOutput) string {
+	names := make([]string, 0, len(actors))
+	for _, actor := range actors {
+		names = append(names, actor.Name)
+	}
+	return i18n.FromContext(ctx).Translate(verb) + " " + countNoun(ctx, len(actors), "actor", "actors") + listSummary(ctx, names)
+}
@@ -0,0 +1,98 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/mcp/middleware"
+)
+
+// assertSummaryResult checks that a result carries the JSON output followed by a text summary
+func assertSummaryResult(t *testing.T, result *mcp.CallToolResult) {
+	t.Helper()
+
+	if result == nil {
+		t.Fatal("Expected a result with summary content, got nil")
+	}
+	if len(result.Content) != 2 {
+		t.Fatalf("Expected 2 content blocks, got: %d", len(result.Content))
+	}
+	data, ok := result.Content[0].(*mcp.TextContent)
+	if !ok || !json.Valid([]byte(data.Text)) {
+		t.Errorf("Expected first content block to be JSON text, got: %v", result.Content[0])
+	}
+	summary, ok := result.Content[1].(*mcp.TextContent)
+	if !ok || summary.Text == "" {
+		t.Errorf("Expected second content block to be a text summary, got: %v", result.Content[1])
+	}
+}
+
+func TestSummaryResult(t *testing.T) {
+	output := MovieOutput{ID: 7, Title: "Heat", Year: 1995}
+
+	result := summaryResult(context.Background(), output, "Movie %d: %s", output.ID, movieLabel(output))
+
+	assertSummaryResult(t, result)
+	var decoded MovieOutput
+	if err := json.Unmarshal([]byte(result.Content[0].(*mcp.TextContent).Text), &decoded); err != nil {
+		t.Fatalf("Expected JSON content to decode, got: %v", err)
+	}
+	if decoded.ID != 7 || decoded.Title != "Heat" {
+		t.Errorf("Expected decoded output to match, got: %+v", decoded)
+	}
+	if got := result.Content[1].(*mcp.TextContent).Text; got != "Movie 7: Heat (1995)" {
+		t.Errorf("Expected summary 'Movie 7: Heat (1995)', got: %s", got)
+	}
+}
+
+func TestSummaryResult_Verbosity(t *testing.T) {
+	output := MovieOutput{ID: 7, Title: "Heat", Year: 1995}
+
+	summary := summaryResult(middleware.WithVerbosity(context.Background(), middleware.VerbositySummary), output, "Movie %d", output.ID)
+	if len(summary.Content) != 1 || summary.Content[0].(*mcp.TextContent).Text != "Movie 7" {
+		t.Errorf("Expected only the summary, got: %+v", summary.Content)
+	}
+
+	compact := summaryResult(middleware.WithVerbosity(context.Background(), middleware.VerbosityJSON), output, "Movie %d", output.ID)
+	if len(compact.Content) != 1 || !strings.HasPrefix(compact.Content[0].(*mcp.TextContent).Text, `{"id":7`) {
+		t.Errorf("Expected only the JSON output, got: %+v", compact.Content)
+	}
+}
+
+func TestCountNoun(t *testing.T) {
+	if got := countNoun(context.Background(), 1, "movie", "movies"); got != "1 movie" {
+		t.Errorf("Expected '1 movie', got: %s", got)
+	}
+	if got := countNoun(context.Background(), 0, "movie", "movies"); got != "0 movies" {
+		t.Errorf("Expected '0 movies', got: %s", got)
+	}
+}
+
+func TestListSummary(t *testing.T) {
+	if got := listSummary(context.Background(), nil); got != "" {
+		t.Errorf("Expected empty summary, got: %s", got)
+	}
+	if got := listSummary(context.Background(), []string{"a", "b"}); got != ": a, b" {
+		t.Errorf("Expected ': a, b', got: %s", got)
+	}
+
+	got := listSummary(context.Background(), []string{"a", "b", "c", "d", "e", "f", "g"})
+
+	if !strings.HasSuffix(got, "e and 2 more") {
+		t.Errorf("Expected truncated list, got: %s", got)
+	}
+}
+
+func TestMovieListSummary(t *testing.T) {
+	movies := []MovieOutput{{Title: "Alien", Year: 1979}}
+
+	got := movieListSummary(context.Background(), "Found", movies)
+
+	if got != "Found 1 movie: Alien (1979)" {
+		t.Errorf("Expected 'Found 1 movie: Alien (1979)', got: %s", got)
+	}
+}
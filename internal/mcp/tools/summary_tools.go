@@ -0,0 +1,221 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	actorApp "github.com/francknouama/movies-mcp-server/internal/application/actor"
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	translationApp "github.com/francknouama/movies-mcp-server/internal/application/translation"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+const (
+	// defaultSummaryWords is the length asked of a summary when none is given
+	defaultSummaryWords = 120
+	// maxSummaryWords is the longest summary that can be asked for
+	maxSummaryWords = 400
+	// summaryCastSize is the number of cast members named in the prompt
+	summaryCastSize = 5
+)
+
+// MovieGetter provides a single movie
+type MovieGetter interface {
+	GetMovie(ctx context.Context, id int) (*movieApp.MovieDTO, error)
+}
+
+// MovieCastReader provides the actors credited on a movie
+type MovieCastReader interface {
+	GetActorsByMovie(ctx context.Context, movieID int) ([]*actorApp.ActorDTO, error)
+}
+
+// DescriptionService stores the descriptions generated for movies
+type DescriptionService interface {
+	CheckGeneratedDescription(ctx context.Context, movieID int, language string, replaceImported bool) error
+	SaveGeneratedDescription(ctx context.Context, cmd translationApp.GeneratedDescriptionCommand) (*translationApp.TranslationDTO, error)
+}
+
+// SummaryTools provides SDK-based MCP handlers that write movie
+// descriptions with the client's model
+type SummaryTools struct {
+	movies       MovieGetter
+	descriptions DescriptionService
+	cast         MovieCastReader
+}
+
+// NewSummaryTools creates a new summary tools instance
+func NewSummaryTools(movies MovieGetter, descriptions DescriptionService) *SummaryTools {
+	return &SummaryTools{
+		movies:       movies,
+		descriptions: descriptions,
+	}
+}
+
+// SetCastReader names the top-billed actors in the summary prompt
+func (t *SummaryTools) SetCastReader(cast MovieCastReader) {
+	t.cast = cast
+}
+
+// ===== summarize_movie Tool =====
+
+// SummarizeMovieInput defines the input schema for summarize_movie tool
+type SummarizeMovieInput struct {
+	MovieID         int    `json:"movie_id" jsonschema:"Movie ID"`
+	Language        string `json:"language,omitempty" jsonschema:"Language code of the summary (e.g. en or pt-BR); defaults to en"`
+	MaxWords        int    `json:"max_words,omitempty" jsonschema:"Longest summary to ask for, in words (default 120, max 400)"`
+	ReplaceImported bool   `json:"replace_imported,omitempty" jsonschema:"Replace an imported description in the language; generated ones are always refreshed"`
+}
+
+// Validate limits the summary length
+func (in SummarizeMovieInput) Validate() error {
+	if in.MaxWords < 0 || in.MaxWords > maxSummaryWords {
+		return shared.NewValidationError("max_words must be between 1 and %d", maxSummaryWords)
+	}
+	return nil
+}
+
+// SummarizeMovieOutput defines the output schema for summarize_movie tool
+type SummarizeMovieOutput struct {
+	MovieID           int    `json:"movie_id" jsonschema:"Movie ID"`
+	Title             string `json:"title" jsonschema:"Movie title"`
+	Year              int    `json:"year" jsonschema:"Release year"`
+	Language          string `json:"language" jsonschema:"Language code of the summary"`
+	Description       string `json:"description" jsonschema:"Generated description, stored as the movie's description in the language"`
+	DescriptionSource string `json:"description_source" jsonschema:"Always generated"`
+	Model             string `json:"model,omitempty" jsonschema:"Model that wrote the description, as reported by the client"`
+}
+
+// SummarizeMovie handles the summarize_movie tool call. It asks the client
+// to sample a summary from the movie's stored metadata and stores it as the
+// movie's description in the language, marked as generated.
+func (t *SummaryTools) SummarizeMovie(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input SummarizeMovieInput,
+) (*mcp.CallToolResult, SummarizeMovieOutput, error) {
+	session, err := samplingSession(req)
+	if err != nil {
+		return nil, SummarizeMovieOutput{}, err
+	}
+
+	language := input.Language
+	if language == "" {
+		language = "en"
+	}
+	maxWords := input.MaxWords
+	if maxWords == 0 {
+		maxWords = defaultSummaryWords
+	}
+
+	// Refuse before sampling, so the client's model is not asked for text
+	// that would not be stored
+	if err := t.descriptions.CheckGeneratedDescription(ctx, input.MovieID, language, input.ReplaceImported); err != nil {
+		return nil, SummarizeMovieOutput{}, fmt.Errorf("failed to summarize movie: %w", err)
+	}
+
+	movie, err := t.movies.GetMovie(ctx, input.MovieID)
+	if err != nil {
+		return nil, SummarizeMovieOutput{}, fmt.Errorf("failed to summarize movie: %w", err)
+	}
+	var cast []string
+	if t.cast != nil {
+		actors, err := t.cast.GetActorsByMovie(ctx, input.MovieID)
+		if err != nil {
+			return nil, SummarizeMovieOutput{}, fmt.Errorf("failed to read movie cast: %w", err)
+		}
+		for i := 0; i < len(actors) && i < summaryCastSize; i++ {
+			cast = append(cast, actors[i].Name)
+		}
+	}
+
+	sampled, err := session.CreateMessage(ctx, &mcp.CreateMessageParams{
+		SystemPrompt: "You write short, factual movie descriptions for a catalogue. Use only the details given and general knowledge of the film; do not invent plot points. Reply with the description alone.",
+		Messages: []*mcp.SamplingMessage{{
+			Role:    "user",
+			Content: &mcp.TextContent{Text: summaryPrompt(movie, cast, language, maxWords)},
+		}},
+		MaxTokens:      int64(maxWords) * 2,
+		IncludeContext: "none",
+	})
+	if err != nil {
+		return nil, SummarizeMovieOutput{}, shared.NewUnavailableError("the client did not sample a summary: %v", err)
+	}
+	text, ok := sampled.Content.(*mcp.TextContent)
+	if !ok || strings.TrimSpace(text.Text) == "" {
+		return nil, SummarizeMovieOutput{}, shared.NewUnavailableError("the client's model returned no summary text")
+	}
+	if err := checkTextLength("generated description", text.Text); err != nil {
+		return nil, SummarizeMovieOutput{}, err
+	}
+
+	dto, err := t.descriptions.SaveGeneratedDescription(ctx, translationApp.GeneratedDescriptionCommand{
+		MovieID:         input.MovieID,
+		Language:        language,
+		Description:     text.Text,
+		Model:           sampled.Model,
+		ReplaceImported: input.ReplaceImported,
+	})
+	if err != nil {
+		return nil, SummarizeMovieOutput{}, fmt.Errorf("failed to save summary: %w", err)
+	}
+
+	output := SummarizeMovieOutput{
+		MovieID:           movie.ID,
+		Title:             movie.Title,
+		Year:              movie.Year,
+		Language:          dto.Language,
+		Description:       dto.Description,
+		DescriptionSource: dto.DescriptionSource,
+		Model:             dto.DescriptionModel,
+	}
+	return summaryResult(ctx, output, "Generated a %s description of %s (%d)%s", output.Language, output.Title, output.Year,
+		generatedBy(output.Model)), output, nil
+}
+
+// samplingSession returns the session of a request if its client accepts
+// sampling requests
+func samplingSession(req *mcp.CallToolRequest) (*mcp.ServerSession, error) {
+	session := requestSession(req)
+	if session == nil {
+		return nil, shared.NewUnavailableError("summarize_movie needs a client session that supports sampling")
+	}
+	params := session.InitializeParams()
+	if params == nil || params.Capabilities == nil || params.Capabilities.Sampling == nil {
+		return nil, shared.NewUnavailableError("the client does not support sampling, which summarize_movie needs to write a summary")
+	}
+	return session, nil
+}
+
+// summaryPrompt asks for a description of a movie from its stored metadata
+func summaryPrompt(movie *movieApp.MovieDTO, cast []string, language string, maxWords int) string {
+	var prompt strings.Builder
+	fmt.Fprintf(&prompt, "Write a description of this movie in at most %d words, in the language with the code %q.\n\n", maxWords, language)
+	fmt.Fprintf(&prompt, "Title: %s\nYear: %d\nDirector: %s\n", movie.Title, movie.Year, movie.Director)
+	if len(movie.Genres) > 0 {
+		fmt.Fprintf(&prompt, "Genres: %s\n", strings.Join(movie.Genres, ", "))
+	}
+	if movie.Duration > 0 {
+		fmt.Fprintf(&prompt, "Runtime: %d minutes\n", movie.Duration)
+	}
+	if movie.ReleaseDate != "" {
+		fmt.Fprintf(&prompt, "Released: %s\n", movie.ReleaseDate)
+	}
+	if len(cast) > 0 {
+		fmt.Fprintf(&prompt, "Starring: %s\n", strings.Join(cast, ", "))
+	}
+	if len(movie.ContentWarnings) > 0 {
+		fmt.Fprintf(&prompt, "Content warnings: %s\n", strings.Join(movie.ContentWarnings, ", "))
+	}
+	return prompt.String()
+}
+
+// generatedBy formats the model that wrote a summary, if it is known
+func generatedBy(model string) string {
+	if model == "" {
+		return ""
+	}
+	return " with " + model
+}
@@ -0,0 +1,177 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	actorApp "github.com/francknouama/movies-mcp-server/internal/application/actor"
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	translationApp "github.com/francknouama/movies-mcp-server/internal/application/translation"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// fakeMovieGetter serves The Godfather as movie 1
+type fakeMovieGetter struct{}
+
+func (f *fakeMovieGetter) GetMovie(ctx context.Context, id int) (*movieApp.MovieDTO, error) {
+	if id != 1 {
+		return nil, shared.NewNotFoundError("movie not found")
+	}
+	return &movieApp.MovieDTO{ID: 1, Title: "The Godfather", Director: "Francis Ford Coppola", Year: 1972, Genres: []string{"Crime", "Drama"}, Duration: 175}, nil
+}
+
+// fakeMovieCast credits two actors on every movie
+type fakeMovieCast struct{}
+
+func (f *fakeMovieCast) GetActorsByMovie(ctx context.Context, movieID int) ([]*actorApp.ActorDTO, error) {
+	return []*actorApp.ActorDTO{{ID: 1, Name: "Marlon Brando"}, {ID: 2, Name: "Al Pacino"}}, nil
+}
+
+// fakeDescriptions records the generated description it is given
+type fakeDescriptions struct {
+	checkErr error
+	saved    *translationApp.GeneratedDescriptionCommand
+}
+
+func (f *fakeDescriptions) CheckGeneratedDescription(ctx context.Context, movieID int, language string, replaceImported bool) error {
+	return f.checkErr
+}
+
+func (f *fakeDescriptions) SaveGeneratedDescription(ctx context.Context, cmd translationApp.GeneratedDescriptionCommand) (*translationApp.TranslationDTO, error) {
+	f.saved = &cmd
+	return &translationApp.TranslationDTO{
+		MovieID:           cmd.MovieID,
+		Language:          cmd.Language,
+		Description:       cmd.Description,
+		DescriptionSource: "generated",
+		DescriptionModel:  cmd.Model,
+	}, nil
+}
+
+// callSummarizeMovie calls summarize_movie from a client that samples with
+// sample, or a client without sampling when sample is nil
+func callSummarizeMovie(t *testing.T, tools *SummaryTools, sample func(context.Context, *mcp.CreateMessageRequest) (*mcp.CreateMessageResult, error), args map[string]any) *mcp.CallToolResult {
+	t.Helper()
+
+	ctx := context.Background()
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil)
+	mcp.AddTool(server, &mcp.Tool{Name: "summarize_movie"}, tools.SummarizeMovie)
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	if err != nil {
+		t.Fatalf("failed to connect server: %v", err)
+	}
+	t.Cleanup(func() { serverSession.Close() })
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "1.0.0"}, &mcp.ClientOptions{CreateMessageHandler: sample})
+	session, err := client.Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("failed to connect client: %v", err)
+	}
+	t.Cleanup(func() { session.Close() })
+
+	result, err := session.CallTool(ctx, &mcp.CallToolParams{Name: "summarize_movie", Arguments: args})
+	if err != nil {
+		t.Fatalf("CallTool() error = %v", err)
+	}
+	return result
+}
+
+func TestSummarizeMovie_Success(t *testing.T) {
+	descriptions := &fakeDescriptions{}
+	tools := NewSummaryTools(&fakeMovieGetter{}, descriptions)
+	tools.SetCastReader(&fakeMovieCast{})
+
+	var prompt string
+	result := callSummarizeMovie(t, tools, func(ctx context.Context, req *mcp.CreateMessageRequest) (*mcp.CreateMessageResult, error) {
+		prompt = req.Params.Messages[0].Content.(*mcp.TextContent).Text
+		return &mcp.CreateMessageResult{
+			Content: &mcp.TextContent{Text: " Le patriarche d'une famille mafieuse cède son empire. "},
+			Model:   "test-model",
+			Role:    "assistant",
+		}, nil
+	}, map[string]any{"movie_id": 1, "language": "fr", "max_words": 60})
+
+	if result.IsError {
+		t.Fatalf("Expected a summary, got: %v", result.Content)
+	}
+	for _, want := range []string{"at most 60 words", `"fr"`, "Title: The Godfather", "Genres: Crime, Drama", "Runtime: 175 minutes", "Starring: Marlon Brando, Al Pacino"} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("Expected the prompt to contain %q, got: %s", want, prompt)
+		}
+	}
+	if saved := descriptions.saved; saved == nil || saved.Language != "fr" || saved.Model != "test-model" || saved.Description != " Le patriarche d'une famille mafieuse cède son empire. " {
+		t.Errorf("Expected the sampled description to be saved, got: %+v", saved)
+	}
+	output := result.StructuredContent.(map[string]any)
+	if output["description_source"] != "generated" || output["model"] != "test-model" || output["title"] != "The Godfather" {
+		t.Errorf("Unexpected output, got: %v", output)
+	}
+}
+
+func TestSummarizeMovie_ClientWithoutSampling(t *testing.T) {
+	descriptions := &fakeDescriptions{}
+	result := callSummarizeMovie(t, NewSummaryTools(&fakeMovieGetter{}, descriptions), nil, map[string]any{"movie_id": 1})
+
+	if !result.IsError || !strings.Contains(result.Content[0].(*mcp.TextContent).Text, "does not support sampling") {
+		t.Errorf("Expected a sampling support error, got: %v", result.Content)
+	}
+	if descriptions.saved != nil {
+		t.Error("Expected nothing to be saved")
+	}
+}
+
+func TestSummarizeMovie_ImportedDescriptionKept(t *testing.T) {
+	descriptions := &fakeDescriptions{checkErr: shared.NewConflictError("movie 1 already has an imported en description")}
+	sampled := false
+	result := callSummarizeMovie(t, NewSummaryTools(&fakeMovieGetter{}, descriptions), func(ctx context.Context, req *mcp.CreateMessageRequest) (*mcp.CreateMessageResult, error) {
+		sampled = true
+		return &mcp.CreateMessageResult{Content: &mcp.TextContent{Text: "A summary"}}, nil
+	}, map[string]any{"movie_id": 1})
+
+	if !result.IsError || !strings.Contains(result.Content[0].(*mcp.TextContent).Text, "imported en description") {
+		t.Errorf("Expected a conflict error, got: %v", result.Content)
+	}
+	if sampled {
+		t.Error("Expected the client not to be asked for a summary")
+	}
+}
+
+func TestSummarizeMovie_SamplingFails(t *testing.T) {
+	tools := NewSummaryTools(&fakeMovieGetter{}, &fakeDescriptions{})
+
+	result := callSummarizeMovie(t, tools, func(ctx context.Context, req *mcp.CreateMessageRequest) (*mcp.CreateMessageResult, error) {
+		return nil, errors.New("user declined")
+	}, map[string]any{"movie_id": 1})
+	if !result.IsError || !strings.Contains(result.Content[0].(*mcp.TextContent).Text, "did not sample a summary") {
+		t.Errorf("Expected a sampling error, got: %v", result.Content)
+	}
+
+	result = callSummarizeMovie(t, tools, func(ctx context.Context, req *mcp.CreateMessageRequest) (*mcp.CreateMessageResult, error) {
+		return &mcp.CreateMessageResult{Content: &mcp.TextContent{Text: "  "}}, nil
+	}, map[string]any{"movie_id": 1})
+	if !result.IsError || !strings.Contains(result.Content[0].(*mcp.TextContent).Text, "no summary text") {
+		t.Errorf("Expected an empty summary error, got: %v", result.Content)
+	}
+}
+
+func TestSummarizeMovieInput_Validate(t *testing.T) {
+	if err := (SummarizeMovieInput{MovieID: 1, MaxWords: 120}).Validate(); err != nil {
+		t.Errorf("Expected a valid input, got: %v", err)
+	}
+	if err := (SummarizeMovieInput{MovieID: 1, MaxWords: 401}).Validate(); !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("Expected a validation error, got: %v", err)
+	}
+}
+
+func TestSummarizeMovie_NoSession(t *testing.T) {
+	_, _, err := NewSummaryTools(&fakeMovieGetter{}, &fakeDescriptions{}).SummarizeMovie(context.Background(), nil, SummarizeMovieInput{MovieID: 1})
+	if !errors.Is(err, shared.ErrUnavailable) {
+		t.Errorf("Expected an unavailable error, got: %v", err)
+	}
+}
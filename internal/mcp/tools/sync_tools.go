@@ -0,0 +1,151 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/application/librarysync"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/i18n"
+)
+
+// LibrarySyncer defines the interface for pulling a remote library into this one
+type LibrarySyncer interface {
+	Sync(ctx context.Context, cmd librarysync.SyncCommand) (*librarysync.Result, error)
+}
+
+// SyncTools provides SDK-based MCP handlers for syncing from a remote server
+type SyncTools struct {
+	syncer LibrarySyncer
+}
+
+// NewSyncTools creates a new sync tools instance
+func NewSyncTools(syncer LibrarySyncer) *SyncTools {
+	return &SyncTools{
+		syncer: syncer,
+	}
+}
+
+// ===== sync_from_remote Tool =====
+
+// SyncFromRemoteInput defines the input schema for sync_from_remote tool
+type SyncFromRemoteInput struct {
+	Strategy    string `json:"strategy,omitempty" jsonschema:"Which side wins when a movie differs: prefer-local (default) keeps this library's, prefer-remote overwrites it, newest keeps whichever was updated last"`
+	DryRun      bool   `json:"dry_run,omitempty" jsonschema:"Report what would change without writing anything; posters are not checked"`
+	SkipPosters bool   `json:"skip_posters,omitempty" jsonschema:"Pull movies only, leaving posters as they are"`
+}
+
+// Validate requires a known strategy
+func (in SyncFromRemoteInput) Validate() error {
+	_, err := librarysync.ParseStrategy(in.Strategy)
+	return err
+}
+
+// SyncChangeOutput defines the output schema for a movie a sync created, updated or kept
+type SyncChangeOutput struct {
+	Action   string   `json:"action" jsonschema:"created, updated (overwritten by the remote movie) or kept (differs, local movie kept)"`
+	LocalID  int      `json:"local_id,omitempty" jsonschema:"Movie ID in this library; absent for a movie a dry run would create"`
+	RemoteID int      `json:"remote_id" jsonschema:"Movie ID in the remote library"`
+	Title    string   `json:"title" jsonschema:"Movie title"`
+	Year     int      `json:"year" jsonschema:"Release year"`
+	Fields   []string `json:"fields,omitempty" jsonschema:"Fields that differ between the libraries"`
+}
+
+// SyncErrorOutput defines the output schema for a remote movie a sync could not pull
+type SyncErrorOutput struct {
+	RemoteID int    `json:"remote_id" jsonschema:"Movie ID in the remote library"`
+	Title    string `json:"title" jsonschema:"Movie title"`
+	Error    string `json:"error" jsonschema:"Why the movie or its poster was not pulled"`
+}
+
+// SyncFromRemoteOutput defines the output schema for sync_from_remote tool
+type SyncFromRemoteOutput struct {
+	Strategy      string             `json:"strategy" jsonschema:"Strategy the sync used"`
+	DryRun        bool               `json:"dry_run" jsonschema:"Whether nothing was written"`
+	RemoteMovies  int                `json:"remote_movies" jsonschema:"Movies in the remote library"`
+	LocalMovies   int                `json:"local_movies" jsonschema:"Movies in this library before the sync"`
+	Created       int                `json:"created" jsonschema:"Remote movies this library lacked, matched by title and year"`
+	Updated       int                `json:"updated" jsonschema:"Movies overwritten with the remote version"`
+	Kept          int                `json:"kept" jsonschema:"Movies that differ but kept the local version"`
+	Unchanged     int                `json:"unchanged" jsonschema:"Movies that are the same in both libraries"`
+	PostersCopied int                `json:"posters_copied" jsonschema:"Poster images pulled from the remote library"`
+	Changes       []SyncChangeOutput `json:"changes" jsonschema:"The first 100 movies created, updated or kept"`
+	Errors        []SyncErrorOutput  `json:"errors" jsonschema:"Remote movies or posters that could not be pulled"`
+}
+
+// SyncFromRemote handles the sync_from_remote tool call, pulling the
+// library of the server SYNC_REMOTE_COMMAND runs into this one
+func (t *SyncTools) SyncFromRemote(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input SyncFromRemoteInput,
+) (*mcp.CallToolResult, SyncFromRemoteOutput, error) {
+	strategy, err := librarysync.ParseStrategy(input.Strategy)
+	if err != nil {
+		return nil, SyncFromRemoteOutput{}, err
+	}
+
+	result, err := t.syncer.Sync(ctx, librarysync.SyncCommand{
+		Strategy:    strategy,
+		DryRun:      input.DryRun,
+		SkipPosters: input.SkipPosters,
+	})
+	if err != nil {
+		return nil, SyncFromRemoteOutput{}, fmt.Errorf("failed to sync from remote: %w", err)
+	}
+
+	output := newSyncFromRemoteOutput(result)
+	return summaryResult(ctx, output, "%s", syncSummary(ctx, output)), output, nil
+}
+
+// newSyncFromRemoteOutput converts a sync result to the output format
+func newSyncFromRemoteOutput(result *librarysync.Result) SyncFromRemoteOutput {
+	changes := make([]SyncChangeOutput, 0, len(result.Changes))
+	for _, change := range result.Changes {
+		changes = append(changes, SyncChangeOutput{
+			Action:   change.Action,
+			LocalID:  change.LocalID,
+			RemoteID: change.RemoteID,
+			Title:    change.Title,
+			Year:     change.Year,
+			Fields:   change.Fields,
+		})
+	}
+	errs := make([]SyncErrorOutput, 0, len(result.Errors))
+	for _, itemErr := range result.Errors {
+		errs = append(errs, SyncErrorOutput{RemoteID: itemErr.RemoteID, Title: itemErr.Title, Error: itemErr.Error})
+	}
+
+	return SyncFromRemoteOutput{
+		Strategy:      string(result.Strategy),
+		DryRun:        result.DryRun,
+		RemoteMovies:  result.RemoteMovies,
+		LocalMovies:   result.LocalMovies,
+		Created:       result.Created,
+		Updated:       result.Updated,
+		Kept:          result.Kept,
+		Unchanged:     result.Unchanged,
+		PostersCopied: result.PostersCopied,
+		Changes:       changes,
+		Errors:        errs,
+	}
+}
+
+// syncSummary describes a sync in one line
+func syncSummary(ctx context.Context, output SyncFromRemoteOutput) string {
+	p := i18n.FromContext(ctx)
+	remote := countNoun(ctx, output.RemoteMovies, "movie", "movies")
+	var summary string
+	if output.DryRun {
+		summary = p.Sprintf("Dry run over %s in the remote library: %d to create, %d to update, %d to keep local",
+			remote, output.Created, output.Updated, output.Kept)
+	} else {
+		summary = p.Sprintf("Synced %s from the remote library: %d created, %d updated, %d kept local, %s copied",
+			remote, output.Created, output.Updated, output.Kept, countNoun(ctx, output.PostersCopied, "poster", "posters"))
+	}
+	if len(output.Errors) > 0 {
+		summary += p.Sprintf("; %s failed", countNoun(ctx, len(output.Errors), "movie", "movies"))
+	}
+	return summary
+}
@@ -0,0 +1,87 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/application/librarysync"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// MockLibrarySyncer is a mock implementation of LibrarySyncer
+type MockLibrarySyncer struct {
+	SyncFunc func(ctx context.Context, cmd librarysync.SyncCommand) (*librarysync.Result, error)
+}
+
+func (m *MockLibrarySyncer) Sync(ctx context.Context, cmd librarysync.SyncCommand) (*librarysync.Result, error) {
+	if m.SyncFunc != nil {
+		return m.SyncFunc(ctx, cmd)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func TestSyncFromRemote_ReportsChanges(t *testing.T) {
+	var got librarysync.SyncCommand
+	syncer := &MockLibrarySyncer{
+		SyncFunc: func(ctx context.Context, cmd librarysync.SyncCommand) (*librarysync.Result, error) {
+			got = cmd
+			return &librarysync.Result{
+				Strategy:      cmd.Strategy,
+				RemoteMovies:  3,
+				LocalMovies:   2,
+				Created:       1,
+				Updated:       1,
+				Unchanged:     1,
+				PostersCopied: 2,
+				Changes: []librarysync.Change{
+					{Action: librarysync.ActionCreated, LocalID: 12, RemoteID: 9, Title: "Thief", Year: 1981},
+					{Action: librarysync.ActionUpdated, LocalID: 1, RemoteID: 7, Title: "Heat", Year: 1995, Fields: []string{"rating"}},
+				},
+				Errors: []librarysync.ItemError{{RemoteID: 11, Title: "Manhunter", Error: "director is required"}},
+			}, nil
+		},
+	}
+
+	result, output, err := NewSyncTools(syncer).SyncFromRemote(context.Background(), nil, SyncFromRemoteInput{Strategy: "newest", SkipPosters: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if got.Strategy != librarysync.StrategyNewest || !got.SkipPosters || got.DryRun {
+		t.Errorf("Expected the input passed through, got: %+v", got)
+	}
+	if output.Strategy != "newest" || len(output.Changes) != 2 || output.Changes[1].Fields[0] != "rating" || len(output.Errors) != 1 {
+		t.Errorf("Unexpected output %+v", output)
+	}
+	want := "Synced 3 movies from the remote library: 1 created, 1 updated, 0 kept local, 2 posters copied; 1 movie failed"
+	if text := result.Content[1].(*mcp.TextContent).Text; text != want {
+		t.Errorf("Unexpected summary %q", text)
+	}
+}
+
+func TestSyncFromRemote_DryRun(t *testing.T) {
+	syncer := &MockLibrarySyncer{
+		SyncFunc: func(ctx context.Context, cmd librarysync.SyncCommand) (*librarysync.Result, error) {
+			return &librarysync.Result{Strategy: cmd.Strategy, DryRun: cmd.DryRun, RemoteMovies: 1, Kept: 1}, nil
+		},
+	}
+
+	result, output, err := NewSyncTools(syncer).SyncFromRemote(context.Background(), nil, SyncFromRemoteInput{DryRun: true})
+	if err != nil || !output.DryRun || output.Strategy != "prefer-local" || output.Changes == nil || output.Errors == nil {
+		t.Fatalf("Expected a prefer-local dry run, got: %+v, %v", output, err)
+	}
+	if text := result.Content[1].(*mcp.TextContent).Text; text != "Dry run over 1 movie in the remote library: 0 to create, 0 to update, 1 to keep local" {
+		t.Errorf("Unexpected summary %q", text)
+	}
+}
+
+func TestSyncFromRemoteInput_Validate(t *testing.T) {
+	if err := (SyncFromRemoteInput{Strategy: "oldest"}).Validate(); !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("Expected an unknown strategy to be rejected, got %v", err)
+	}
+	if err := (SyncFromRemoteInput{Strategy: "prefer-remote"}).Validate(); err != nil {
+		t.Errorf("Expected prefer-remote to be accepted, got %v", err)
+	}
+}
@@ -0,0 +1,373 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	tagApp "github.com/francknouama/movies-mcp-server/internal/application/tag"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/i18n"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/middleware"
+)
+
+const (
+	// defaultTagSearchLimit and maxTagSearchLimit bound the movies search_by_tag returns
+	defaultTagSearchLimit = 20
+	maxTagSearchLimit     = 100
+
+	// defaultTagSuggestions and maxTagSuggestions bound the tags autocomplete_tags suggests
+	defaultTagSuggestions = 10
+	maxTagSuggestions     = 50
+)
+
+// TagService defines the interface for tag operations
+type TagService interface {
+	AddTag(ctx context.Context, cmd tagApp.AddTagCommand) (*tagApp.TagDTO, error)
+	TagMovie(ctx context.Context, cmd tagApp.TagMovieCommand) (*tagApp.MovieTagsDTO, error)
+	UntagMovie(ctx context.Context, movieID int, name string) (*tagApp.MovieTagsDTO, error)
+	SearchByTag(ctx context.Context, query tagApp.SearchByTagQuery) (*tagApp.TagSearchDTO, error)
+	AutocompleteTags(ctx context.Context, prefix string, limit int) ([]*tagApp.TagCountDTO, error)
+}
+
+// TagTools provides SDK-based MCP handlers for freeform movie tags
+type TagTools struct {
+	service TagService
+}
+
+// NewTagTools creates a new tag tools instance
+func NewTagTools(service TagService) *TagTools {
+	return &TagTools{
+		service: service,
+	}
+}
+
+// MovieTagsOutput defines the output schema for the tags a movie carries
+type MovieTagsOutput struct {
+	MovieID int      `json:"movie_id" jsonschema:"Movie ID"`
+	Title   string   `json:"title" jsonschema:"Movie title"`
+	Tags    []string `json:"tags" jsonschema:"Every tag the movie now carries, by name"`
+}
+
+// newMovieTagsOutput converts a movie tags DTO to the output format
+func newMovieTagsOutput(dto *tagApp.MovieTagsDTO) MovieTagsOutput {
+	return MovieTagsOutput{
+		MovieID: dto.MovieID,
+		Title:   dto.Title,
+		Tags:    nonNilStrings(dto.Tags),
+	}
+}
+
+// ===== add_tag Tool =====
+
+// AddTagInput defines the input schema for add_tag tool
+type AddTagInput struct {
+	Name        string `json:"name" jsonschema:"Tag name, e.g. time-travel; lowercased with words joined by hyphens"`
+	Description string `json:"description,omitempty" jsonschema:"What the tag means"`
+}
+
+// Validate requires a tag name and limits the description
+func (in AddTagInput) Validate() error {
+	if strings.TrimSpace(in.Name) == "" {
+		return shared.NewValidationError("name is required")
+	}
+	return checkTextLength("description", in.Description)
+}
+
+// TagOutput defines the output schema for add_tag tool
+type TagOutput struct {
+	ID          int    `json:"id" jsonschema:"Tag ID"`
+	Name        string `json:"name" jsonschema:"Normalized tag name"`
+	Description string `json:"description,omitempty" jsonschema:"Tag description"`
+	CreatedAt   string `json:"created_at" jsonschema:"Creation timestamp"`
+	UpdatedAt   string `json:"updated_at" jsonschema:"Last update timestamp"`
+}
+
+// AddTag handles the add_tag tool call
+func (t *TagTools) AddTag(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input AddTagInput,
+) (*mcp.CallToolResult, TagOutput, error) {
+	dto, err := t.service.AddTag(ctx, tagApp.AddTagCommand{
+		Name:        input.Name,
+		Description: input.Description,
+	})
+	if err != nil {
+		return nil, TagOutput{}, fmt.Errorf("failed to add tag: %w", err)
+	}
+
+	output := TagOutput{
+		ID:          dto.ID,
+		Name:        dto.Name,
+		Description: dto.Description,
+		CreatedAt:   dto.CreatedAt,
+		UpdatedAt:   dto.UpdatedAt,
+	}
+	return summaryResult(ctx, output, "Created tag %q", output.Name), output, nil
+}
+
+// ===== tag_movie Tool =====
+
+// TagMovieInput defines the input schema for tag_movie tool
+type TagMovieInput struct {
+	MovieID int      `json:"movie_id" jsonschema:"Movie ID"`
+	Tags    []string `json:"tags" jsonschema:"Tags to attach, e.g. [\"based-on-book\", \"oscar-winner\"]; unknown tags are created"`
+}
+
+// Validate requires a movie and at least one tag
+func (in TagMovieInput) Validate() error {
+	if in.MovieID <= 0 {
+		return shared.NewValidationError("movie_id is required")
+	}
+	if len(in.Tags) == 0 {
+		return shared.NewValidationError("at least one tag is required")
+	}
+	return nil
+}
+
+// TagMovieOutput defines the output schema for tag_movie tool
+type TagMovieOutput struct {
+	MovieTagsOutput
+	Created []string `json:"created,omitempty" jsonschema:"Tags that did not exist and were created"`
+}
+
+// TagMovie handles the tag_movie tool call
+func (t *TagTools) TagMovie(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input TagMovieInput,
+) (*mcp.CallToolResult, TagMovieOutput, error) {
+	dto, err := t.service.TagMovie(ctx, tagApp.TagMovieCommand{
+		MovieID: input.MovieID,
+		Tags:    input.Tags,
+	})
+	if err != nil {
+		return nil, TagMovieOutput{}, fmt.Errorf("failed to tag movie: %w", err)
+	}
+
+	output := TagMovieOutput{
+		MovieTagsOutput: newMovieTagsOutput(dto),
+		Created:         dto.Created,
+	}
+	created := ""
+	if len(output.Created) > 0 {
+		created = i18n.FromContext(ctx).Sprintf(" (new: %s)", strings.Join(output.Created, ", "))
+	}
+	return summaryResult(ctx, output, "Tagged movie %d %q; it now has %s%s%s", output.MovieID, output.Title,
+		countNoun(ctx, len(output.Tags), "tag", "tags"), listSummary(ctx, output.Tags), created), output, nil
+}
+
+// ===== untag_movie Tool =====
+
+// UntagMovieInput defines the input schema for untag_movie tool
+type UntagMovieInput struct {
+	MovieID int    `json:"movie_id" jsonschema:"Movie ID"`
+	Tag     string `json:"tag" jsonschema:"Tag to remove from the movie; the tag itself is kept"`
+}
+
+// Validate requires a movie and a tag
+func (in UntagMovieInput) Validate() error {
+	if in.MovieID <= 0 {
+		return shared.NewValidationError("movie_id is required")
+	}
+	if strings.TrimSpace(in.Tag) == "" {
+		return shared.NewValidationError("tag is required")
+	}
+	return nil
+}
+
+// UntagMovieOutput defines the output schema for untag_movie tool
+type UntagMovieOutput struct {
+	MovieTagsOutput
+	Removed bool `json:"removed" jsonschema:"False when the movie did not carry the tag"`
+}
+
+// UntagMovie handles the untag_movie tool call
+func (t *TagTools) UntagMovie(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input UntagMovieInput,
+) (*mcp.CallToolResult, UntagMovieOutput, error) {
+	dto, err := t.service.UntagMovie(ctx, input.MovieID, input.Tag)
+	if err != nil {
+		return nil, UntagMovieOutput{}, fmt.Errorf("failed to untag movie: %w", err)
+	}
+
+	output := UntagMovieOutput{
+		MovieTagsOutput: newMovieTagsOutput(dto),
+		Removed:         dto.Removed,
+	}
+	if !output.Removed {
+		return summaryResult(ctx, output, "Movie %d %q was not tagged %q", output.MovieID, output.Title, input.Tag), output, nil
+	}
+	return summaryResult(ctx, output, "Removed tag %q from movie %d %q; %s left%s", input.Tag, output.MovieID, output.Title,
+		countNoun(ctx, len(output.Tags), "tag", "tags"), listSummary(ctx, output.Tags)), output, nil
+}
+
+// ===== search_by_tag Tool =====
+
+// SearchByTagInput defines the input schema for search_by_tag tool
+type SearchByTagInput struct {
+	Tags     []string `json:"tags" jsonschema:"Tags to search for"`
+	MatchAll bool     `json:"match_all,omitempty" jsonschema:"Only movies carrying every tag (default: any of them)"`
+	Limit    int      `json:"limit,omitempty" jsonschema:"Maximum movies to return (default 20, max 100)"`
+}
+
+// Validate requires a tag and bounds the limit
+func (in SearchByTagInput) Validate() error {
+	if len(in.Tags) == 0 {
+		return shared.NewValidationError("at least one tag is required")
+	}
+	if in.Limit < 0 || in.Limit > maxTagSearchLimit {
+		return shared.NewValidationError("limit must be between 1 and %d", maxTagSearchLimit)
+	}
+	return nil
+}
+
+// TaggedMovieOutput defines the output schema for a movie found by its tags
+type TaggedMovieOutput struct {
+	ID       int      `json:"id" jsonschema:"Movie ID"`
+	Title    string   `json:"title" jsonschema:"Movie title"`
+	Director string   `json:"director" jsonschema:"Movie director"`
+	Year     int      `json:"year" jsonschema:"Release year"`
+	Rating   float64  `json:"rating,omitempty" jsonschema:"Movie rating"`
+	Tags     []string `json:"tags" jsonschema:"Every tag the movie carries"`
+}
+
+// SearchByTagOutput defines the output schema for search_by_tag tool
+type SearchByTagOutput struct {
+	Movies     []TaggedMovieOutput    `json:"movies" jsonschema:"Matching movies, best rated first"`
+	Total      int                    `json:"total" jsonschema:"Matching movies before the limit"`
+	Tags       []string               `json:"tags" jsonschema:"Normalized tags searched for"`
+	Unknown    []string               `json:"unknown,omitempty" jsonschema:"Searched tags that do not exist; autocomplete_tags suggests existing ones"`
+	Truncation *middleware.Truncation `json:"truncation,omitempty" jsonschema:"Set when movies was cut short to fit the response size limit"`
+}
+
+// Len returns the number of movies, for response truncation
+func (o SearchByTagOutput) Len() int {
+	return len(o.Movies)
+}
+
+// Truncate keeps the first keep movies, pointing to a narrower search for the rest
+func (o SearchByTagOutput) Truncate(keep int, truncation middleware.Truncation) any {
+	truncation.Hint = "narrow the search with more tags and match_all, or lower the limit"
+	o.Movies = o.Movies[:keep]
+	o.Truncation = &truncation
+	return o
+}
+
+// SearchByTag handles the search_by_tag tool call
+func (t *TagTools) SearchByTag(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input SearchByTagInput,
+) (*mcp.CallToolResult, SearchByTagOutput, error) {
+	limit := input.Limit
+	if limit == 0 {
+		limit = defaultTagSearchLimit
+	}
+
+	result, err := t.service.SearchByTag(ctx, tagApp.SearchByTagQuery{
+		Tags:     input.Tags,
+		MatchAll: input.MatchAll,
+		Limit:    limit,
+	})
+	if err != nil {
+		return nil, SearchByTagOutput{}, fmt.Errorf("failed to search by tag: %w", err)
+	}
+
+	output := SearchByTagOutput{
+		Movies:  make([]TaggedMovieOutput, len(result.Movies)),
+		Total:   result.Total,
+		Tags:    nonNilStrings(result.Tags),
+		Unknown: result.Unknown,
+	}
+	labels := make([]string, len(result.Movies))
+	for i, dto := range result.Movies {
+		output.Movies[i] = TaggedMovieOutput{
+			ID:       dto.ID,
+			Title:    dto.Title,
+			Director: dto.Director,
+			Year:     dto.Year,
+			Rating:   dto.Rating,
+			Tags:     nonNilStrings(dto.Tags),
+		}
+		labels[i] = fmt.Sprintf("%s (%d)", dto.Title, dto.Year)
+	}
+
+	joiner := i18n.FromContext(ctx).Translate(" or ")
+	if input.MatchAll {
+		joiner = i18n.FromContext(ctx).Translate(" and ")
+	}
+	unknown := ""
+	if len(output.Unknown) > 0 {
+		unknown = i18n.FromContext(ctx).Sprintf("; unknown tags: %s", strings.Join(output.Unknown, ", "))
+	}
+	return summaryResult(ctx, output, "Found %s tagged %s%s%s", countNoun(ctx, output.Total, "movie", "movies"),
+		strings.Join(output.Tags, joiner), listSummary(ctx, labels), unknown), output, nil
+}
+
+// ===== autocomplete_tags Tool =====
+
+// AutocompleteTagsInput defines the input schema for autocomplete_tags tool
+type AutocompleteTagsInput struct {
+	Prefix string `json:"prefix,omitempty" jsonschema:"Start of a tag name, e.g. time; omit for the most used tags"`
+	Limit  int    `json:"limit,omitempty" jsonschema:"Maximum suggestions (default 10, max 50)"`
+}
+
+// Validate bounds the limit
+func (in AutocompleteTagsInput) Validate() error {
+	if in.Limit < 0 || in.Limit > maxTagSuggestions {
+		return shared.NewValidationError("limit must be between 1 and %d", maxTagSuggestions)
+	}
+	return nil
+}
+
+// TagSuggestionOutput defines the output schema for a suggested tag
+type TagSuggestionOutput struct {
+	Name   string `json:"name" jsonschema:"Tag name"`
+	Movies int    `json:"movies" jsonschema:"Movies carrying the tag"`
+}
+
+// AutocompleteTagsOutput defines the output schema for autocomplete_tags tool
+type AutocompleteTagsOutput struct {
+	Suggestions []TagSuggestionOutput `json:"suggestions" jsonschema:"Existing tags starting with the prefix, most used first"`
+}
+
+// AutocompleteTags handles the autocomplete_tags tool call
+func (t *TagTools) AutocompleteTags(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input AutocompleteTagsInput,
+) (*mcp.CallToolResult, AutocompleteTagsOutput, error) {
+	limit := input.Limit
+	if limit == 0 {
+		limit = defaultTagSuggestions
+	}
+
+	dtos, err := t.service.AutocompleteTags(ctx, input.Prefix, limit)
+	if err != nil {
+		return nil, AutocompleteTagsOutput{}, fmt.Errorf("failed to autocomplete tags: %w", err)
+	}
+
+	output := AutocompleteTagsOutput{
+		Suggestions: make([]TagSuggestionOutput, len(dtos)),
+	}
+	names := make([]string, len(dtos))
+	for i, dto := range dtos {
+		output.Suggestions[i] = TagSuggestionOutput{Name: dto.Name, Movies: dto.Movies}
+		names[i] = dto.Name
+	}
+
+	if input.Prefix == "" {
+		if len(names) == 0 {
+			return summaryResult(ctx, output, "No tags yet; tag_movie creates tags as it attaches them"), output, nil
+		}
+		return summaryResult(ctx, output, "Most used tags%s", listSummary(ctx, names)), output, nil
+	}
+	return summaryResult(ctx, output, "%s starting with %q%s", countNoun(ctx, len(names), "tag", "tags"), input.Prefix,
+		listSummary(ctx, names)), output, nil
+}
@@ -0,0 +1,235 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	tagApp "github.com/francknouama/movies-mcp-server/internal/application/tag"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/middleware"
+)
+
+// MockTagService is a mock implementation of TagService
+type MockTagService struct {
+	AddTagFunc           func(ctx context.Context, cmd tagApp.AddTagCommand) (*tagApp.TagDTO, error)
+	TagMovieFunc         func(ctx context.Context, cmd tagApp.TagMovieCommand) (*tagApp.MovieTagsDTO, error)
+	UntagMovieFunc       func(ctx context.Context, movieID int, name string) (*tagApp.MovieTagsDTO, error)
+	SearchByTagFunc      func(ctx context.Context, query tagApp.SearchByTagQuery) (*tagApp.TagSearchDTO, error)
+	AutocompleteTagsFunc func(ctx context.Context, prefix string, limit int) ([]*tagApp.TagCountDTO, error)
+}
+
+func (m *MockTagService) AddTag(ctx context.Context, cmd tagApp.AddTagCommand) (*tagApp.TagDTO, error) {
+	if m.AddTagFunc != nil {
+		return m.AddTagFunc(ctx, cmd)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockTagService) TagMovie(ctx context.Context, cmd tagApp.TagMovieCommand) (*tagApp.MovieTagsDTO, error) {
+	if m.TagMovieFunc != nil {
+		return m.TagMovieFunc(ctx, cmd)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockTagService) UntagMovie(ctx context.Context, movieID int, name string) (*tagApp.MovieTagsDTO, error) {
+	if m.UntagMovieFunc != nil {
+		return m.UntagMovieFunc(ctx, movieID, name)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockTagService) SearchByTag(ctx context.Context, query tagApp.SearchByTagQuery) (*tagApp.TagSearchDTO, error) {
+	if m.SearchByTagFunc != nil {
+		return m.SearchByTagFunc(ctx, query)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockTagService) AutocompleteTags(ctx context.Context, prefix string, limit int) ([]*tagApp.TagCountDTO, error) {
+	if m.AutocompleteTagsFunc != nil {
+		return m.AutocompleteTagsFunc(ctx, prefix, limit)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func TestAddTag_Success(t *testing.T) {
+	tools := NewTagTools(&MockTagService{
+		AddTagFunc: func(ctx context.Context, cmd tagApp.AddTagCommand) (*tagApp.TagDTO, error) {
+			return &tagApp.TagDTO{ID: 1, Name: "oscar-winner", Description: cmd.Description}, nil
+		},
+	})
+
+	result, output, err := tools.AddTag(context.Background(), nil, AddTagInput{Name: "Oscar Winner", Description: "Won an Academy Award"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.ID != 1 || output.Name != "oscar-winner" {
+		t.Errorf("Unexpected output: %+v", output)
+	}
+	if summary := result.Content[1].(*mcp.TextContent).Text; summary != `Created tag "oscar-winner"` {
+		t.Errorf("Unexpected summary: %q", summary)
+	}
+}
+
+func TestAddTag_Conflict(t *testing.T) {
+	tools := NewTagTools(&MockTagService{
+		AddTagFunc: func(ctx context.Context, cmd tagApp.AddTagCommand) (*tagApp.TagDTO, error) {
+			return nil, shared.NewConflictError("tag %q already exists", "oscar-winner")
+		},
+	})
+
+	_, _, err := tools.AddTag(context.Background(), nil, AddTagInput{Name: "oscar-winner"})
+	if !errors.Is(err, shared.ErrConflict) {
+		t.Errorf("Expected ErrConflict, got: %v", err)
+	}
+}
+
+func TestTagMovie_Success(t *testing.T) {
+	var gotCmd tagApp.TagMovieCommand
+	tools := NewTagTools(&MockTagService{
+		TagMovieFunc: func(ctx context.Context, cmd tagApp.TagMovieCommand) (*tagApp.MovieTagsDTO, error) {
+			gotCmd = cmd
+			return &tagApp.MovieTagsDTO{
+				MovieID: 1,
+				Title:   "Back to the Future",
+				Tags:    []string{"cult-classic", "time-travel"},
+				Created: []string{"cult-classic"},
+			}, nil
+		},
+	})
+
+	result, output, err := tools.TagMovie(context.Background(), nil, TagMovieInput{MovieID: 1, Tags: []string{"time-travel", "Cult Classic"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if gotCmd.MovieID != 1 || len(gotCmd.Tags) != 2 {
+		t.Errorf("Expected the movie and tags to be passed through, got: %+v", gotCmd)
+	}
+	if len(output.Tags) != 2 || len(output.Created) != 1 {
+		t.Errorf("Unexpected output: %+v", output)
+	}
+	summary := result.Content[1].(*mcp.TextContent).Text
+	want := `Tagged movie 1 "Back to the Future"; it now has 2 tags: cult-classic, time-travel (new: cult-classic)`
+	if summary != want {
+		t.Errorf("Unexpected summary: %q", summary)
+	}
+}
+
+func TestUntagMovie_NotTagged(t *testing.T) {
+	tools := NewTagTools(&MockTagService{
+		UntagMovieFunc: func(ctx context.Context, movieID int, name string) (*tagApp.MovieTagsDTO, error) {
+			return &tagApp.MovieTagsDTO{MovieID: movieID, Title: "Looper"}, nil
+		},
+	})
+
+	result, output, err := tools.UntagMovie(context.Background(), nil, UntagMovieInput{MovieID: 2, Tag: "heist"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Removed || output.Tags == nil {
+		t.Errorf("Expected nothing removed and an empty tag list, got: %+v", output)
+	}
+	if summary := result.Content[1].(*mcp.TextContent).Text; summary != `Movie 2 "Looper" was not tagged "heist"` {
+		t.Errorf("Unexpected summary: %q", summary)
+	}
+}
+
+func TestSearchByTag_Success(t *testing.T) {
+	var gotQuery tagApp.SearchByTagQuery
+	tools := NewTagTools(&MockTagService{
+		SearchByTagFunc: func(ctx context.Context, query tagApp.SearchByTagQuery) (*tagApp.TagSearchDTO, error) {
+			gotQuery = query
+			return &tagApp.TagSearchDTO{
+				Tags:    []string{"time-travel", "heist"},
+				Unknown: []string{"heist"},
+				Movies: []*tagApp.TaggedMovieDTO{
+					{ID: 1, Title: "Back to the Future", Year: 1985, Rating: 8.5, Tags: []string{"time-travel"}},
+				},
+				Total: 1,
+			}, nil
+		},
+	})
+
+	result, output, err := tools.SearchByTag(context.Background(), nil, SearchByTagInput{Tags: []string{"time-travel", "heist"}, MatchAll: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if gotQuery.Limit != defaultTagSearchLimit || !gotQuery.MatchAll {
+		t.Errorf("Expected the default limit and match_all, got: %+v", gotQuery)
+	}
+	if output.Total != 1 || len(output.Movies) != 1 || len(output.Unknown) != 1 {
+		t.Errorf("Unexpected output: %+v", output)
+	}
+	summary := result.Content[1].(*mcp.TextContent).Text
+	want := "Found 1 movie tagged time-travel and heist: Back to the Future (1985); unknown tags: heist"
+	if summary != want {
+		t.Errorf("Unexpected summary: %q", summary)
+	}
+}
+
+func TestSearchByTagOutput_Truncate(t *testing.T) {
+	output := SearchByTagOutput{Movies: []TaggedMovieOutput{{ID: 1}, {ID: 2}, {ID: 3}}, Total: 3}
+
+	truncated := output.Truncate(2, middleware.Truncation{Returned: 2, Available: 3}).(SearchByTagOutput)
+	if len(truncated.Movies) != 2 || truncated.Truncation == nil || truncated.Truncation.Hint == "" {
+		t.Errorf("Expected two movies and a hint, got: %+v", truncated)
+	}
+}
+
+func TestAutocompleteTags_Success(t *testing.T) {
+	var gotLimit int
+	tools := NewTagTools(&MockTagService{
+		AutocompleteTagsFunc: func(ctx context.Context, prefix string, limit int) ([]*tagApp.TagCountDTO, error) {
+			gotLimit = limit
+			return []*tagApp.TagCountDTO{{Name: "time-travel", Movies: 4}, {Name: "time-loop", Movies: 1}}, nil
+		},
+	})
+
+	result, output, err := tools.AutocompleteTags(context.Background(), nil, AutocompleteTagsInput{Prefix: "time"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if gotLimit != defaultTagSuggestions || len(output.Suggestions) != 2 || output.Suggestions[0].Movies != 4 {
+		t.Errorf("Unexpected output: %+v (limit %d)", output, gotLimit)
+	}
+	summary := result.Content[1].(*mcp.TextContent).Text
+	if !strings.HasPrefix(summary, `2 tags starting with "time": time-travel, time-loop`) {
+		t.Errorf("Unexpected summary: %q", summary)
+	}
+}
+
+func TestTagInputs_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   interface{ Validate() error }
+		wantErr bool
+	}{
+		{name: "add tag", input: AddTagInput{Name: "heist"}},
+		{name: "add tag without a name", input: AddTagInput{Name: " "}, wantErr: true},
+		{name: "tag movie", input: TagMovieInput{MovieID: 1, Tags: []string{"heist"}}},
+		{name: "tag movie without tags", input: TagMovieInput{MovieID: 1}, wantErr: true},
+		{name: "tag movie without a movie", input: TagMovieInput{Tags: []string{"heist"}}, wantErr: true},
+		{name: "untag movie without a tag", input: UntagMovieInput{MovieID: 1}, wantErr: true},
+		{name: "search without tags", input: SearchByTagInput{}, wantErr: true},
+		{name: "search over the limit", input: SearchByTagInput{Tags: []string{"heist"}, Limit: maxTagSearchLimit + 1}, wantErr: true},
+		{name: "autocomplete", input: AutocompleteTagsInput{}},
+		{name: "autocomplete over the limit", input: AutocompleteTagsInput{Limit: maxTagSuggestions + 1}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, shared.ErrValidation) {
+				t.Errorf("Expected a validation error, got: %v", err)
+			}
+		})
+	}
+}
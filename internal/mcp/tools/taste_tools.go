@@ -0,0 +1,267 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/i18n"
+)
+
+const (
+	// maxTasteRatings is the number of personal ratings taste_profile compares at once
+	maxTasteRatings = 500
+	// defaultTasteMinMovies is the number of rated movies a genre or director
+	// needs before taste_profile profiles it
+	defaultTasteMinMovies = 2
+	// tasteThreshold is how many points a genre or director's bias must differ
+	// from the user's average bias to count as over- or under-rated
+	tasteThreshold = 0.5
+)
+
+// TasteTools provides SDK-based MCP handlers for comparing a user's own
+// ratings with the library's
+type TasteTools struct {
+	movies      MovieBatchGetter
+	preferences *PreferenceStore
+}
+
+// NewTasteTools creates a new taste tools instance
+func NewTasteTools(movies MovieBatchGetter) *TasteTools {
+	return &TasteTools{
+		movies: movies,
+	}
+}
+
+// SetPreferenceStore lets taste_profile make the genres a user over-rates the
+// session's favorite genres
+func (t *TasteTools) SetPreferenceStore(store *PreferenceStore) {
+	t.preferences = store
+}
+
+// ===== taste_profile Tool =====
+
+// PersonalRating is a user's own rating of a movie
+type PersonalRating struct {
+	MovieID int     `json:"movie_id" jsonschema:"ID of a movie in the library"`
+	Rating  float64 `json:"rating" jsonschema:"The user's rating on the stored 0-10 scale"`
+}
+
+// TasteProfileInput defines the input schema for taste_profile tool
+type TasteProfileInput struct {
+	Ratings   []PersonalRating `json:"ratings" jsonschema:"The user's own ratings, one per movie"`
+	MinMovies int              `json:"min_movies,omitempty" jsonschema:"Rated movies a genre or director needs to be profiled (default 2)"`
+	Apply     bool             `json:"apply,omitempty" jsonschema:"Make the genres the user over-rates the session's favorite genres, which recommendations then favor"`
+}
+
+// Validate checks the ratings and the minimum
+func (in TasteProfileInput) Validate() error {
+	if len(in.Ratings) == 0 {
+		return shared.NewValidationError("ratings are required")
+	}
+	if len(in.Ratings) > maxTasteRatings {
+		return shared.NewValidationError("too many ratings: %d given, maximum is %d", len(in.Ratings), maxTasteRatings)
+	}
+	seen := make(map[int]bool, len(in.Ratings))
+	for _, rating := range in.Ratings {
+		if rating.MovieID <= 0 {
+			return shared.NewValidationError("invalid movie ID: %d", rating.MovieID)
+		}
+		if rating.Rating < 0 || rating.Rating > storedRatingScale {
+			return shared.NewValidationError("rating of movie %d must be between 0 and 10", rating.MovieID)
+		}
+		if seen[rating.MovieID] {
+			return shared.NewValidationError("movie %d is rated more than once", rating.MovieID)
+		}
+		seen[rating.MovieID] = true
+	}
+	if in.MinMovies < 0 {
+		return shared.NewValidationError("min_movies cannot be negative")
+	}
+	return nil
+}
+
+// TasteBiasOutput defines the output schema for how a user rates one genre
+// or director
+type TasteBiasOutput struct {
+	Name            string  `json:"name" jsonschema:"Genre or director"`
+	Movies          int     `json:"movies" jsonschema:"Rated movies of the genre or director"`
+	PersonalAverage float64 `json:"personal_average" jsonschema:"Average of the user's ratings"`
+	GlobalAverage   float64 `json:"global_average" jsonschema:"Average of the stored ratings of the same movies"`
+	Bias            float64 `json:"bias" jsonschema:"Personal average minus global average"`
+	RelativeBias    float64 `json:"relative_bias" jsonschema:"Bias minus the user's average bias, so a generous rater does not over-rate everything"`
+	Verdict         string  `json:"verdict" jsonschema:"over-rates, under-rates or agrees"`
+}
+
+// TasteProfileOutput defines the output schema for taste_profile tool
+type TasteProfileOutput struct {
+	Compared      int               `json:"compared" jsonschema:"Rated movies compared with their stored rating"`
+	AverageBias   float64           `json:"average_bias" jsonschema:"Average of the user's rating minus the stored rating; positive for a generous rater"`
+	Genres        []TasteBiasOutput `json:"genres" jsonschema:"Genres with enough rated movies, most over-rated first"`
+	Directors     []TasteBiasOutput `json:"directors" jsonschema:"Directors with enough rated movies, most over-rated first"`
+	OverRated     []string          `json:"over_rated_genres" jsonschema:"Genres the user rates above the library by more than their average bias"`
+	UnderRated    []string          `json:"under_rated_genres" jsonschema:"Genres the user rates below the library by more than their average bias"`
+	Unrated       []int             `json:"unrated" jsonschema:"Rated movies with no stored rating to compare to"`
+	Missing       []int             `json:"missing" jsonschema:"Rated movie IDs not in the library"`
+	AppliedGenres []string          `json:"applied_genres,omitempty" jsonschema:"Favorite genres saved to the session's preferences, when apply was set"`
+}
+
+// tasteGroup sums the ratings of one genre or director
+type tasteGroup struct {
+	name     string
+	movies   int
+	personal float64
+	global   float64
+}
+
+// TasteProfile handles the taste_profile tool call. Movies without a stored
+// rating are left out, since a zero there means unknown rather than bad.
+func (t *TasteTools) TasteProfile(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input TasteProfileInput,
+) (*mcp.CallToolResult, TasteProfileOutput, error) {
+	if input.Apply && t.preferences == nil {
+		return nil, TasteProfileOutput{}, shared.NewUnavailableError("session preferences are not available")
+	}
+	minMovies := input.MinMovies
+	if minMovies == 0 {
+		minMovies = defaultTasteMinMovies
+	}
+
+	ids := make([]int, len(input.Ratings))
+	for i, rating := range input.Ratings {
+		ids[i] = rating.MovieID
+	}
+	movieDTOs, err := t.movies.GetMoviesByIDs(ctx, ids)
+	if err != nil {
+		return nil, TasteProfileOutput{}, fmt.Errorf("failed to get movies: %w", err)
+	}
+	byID := make(map[int]*movieApp.MovieDTO, len(movieDTOs))
+	for _, movieDTO := range movieDTOs {
+		byID[movieDTO.ID] = movieDTO
+	}
+
+	output := TasteProfileOutput{
+		Genres:     []TasteBiasOutput{},
+		Directors:  []TasteBiasOutput{},
+		OverRated:  []string{},
+		UnderRated: []string{},
+		Unrated:    []int{},
+		Missing:    []int{},
+	}
+	genres := make(map[string]*tasteGroup)
+	directors := make(map[string]*tasteGroup)
+	var totalBias float64
+	for _, rating := range input.Ratings {
+		movieDTO, ok := byID[rating.MovieID]
+		if !ok {
+			output.Missing = append(output.Missing, rating.MovieID)
+			continue
+		}
+		if movieDTO.Rating == 0 {
+			output.Unrated = append(output.Unrated, rating.MovieID)
+			continue
+		}
+
+		output.Compared++
+		totalBias += rating.Rating - movieDTO.Rating
+		for _, genre := range movieDTO.Genres {
+			addTaste(genres, genre, rating.Rating, movieDTO.Rating)
+		}
+		addTaste(directors, movieDTO.Director, rating.Rating, movieDTO.Rating)
+	}
+	if output.Compared > 0 {
+		output.AverageBias = roundRating(totalBias / float64(output.Compared))
+	}
+
+	output.Genres = tasteBiases(genres, minMovies, output.AverageBias)
+	output.Directors = tasteBiases(directors, minMovies, output.AverageBias)
+	for _, genre := range output.Genres {
+		switch genre.Verdict {
+		case "over-rates":
+			output.OverRated = append(output.OverRated, genre.Name)
+		case "under-rates":
+			output.UnderRated = append(output.UnderRated, genre.Name)
+		}
+	}
+
+	if input.Apply && len(output.OverRated) > 0 {
+		prefs := t.preferences.Get(req)
+		prefs.FavoriteGenres = append([]string(nil), output.OverRated...)
+		t.preferences.set(req, prefs)
+		output.AppliedGenres = prefs.FavoriteGenres
+	}
+
+	return summaryResult(ctx, output, "Compared %s with the library, %+.1f on average%s%s",
+		countNoun(ctx, output.Compared, "rating", "ratings"), output.AverageBias,
+		tasteGenresSummary(ctx, "; over-rates ", output.OverRated),
+		tasteGenresSummary(ctx, "; under-rates ", output.UnderRated)), output, nil
+}
+
+// addTaste adds one rated movie to the group of a genre or director,
+// matching names case-insensitively
+func addTaste(groups map[string]*tasteGroup, name string, personal, global float64) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return
+	}
+	key := strings.ToLower(name)
+	group, ok := groups[key]
+	if !ok {
+		group = &tasteGroup{name: name}
+		groups[key] = group
+	}
+	group.movies++
+	group.personal += personal
+	group.global += global
+}
+
+// tasteBiases returns the groups with at least minMovies rated movies, most
+// over-rated first and then by name
+func tasteBiases(groups map[string]*tasteGroup, minMovies int, averageBias float64) []TasteBiasOutput {
+	biases := []TasteBiasOutput{}
+	for _, group := range groups {
+		if group.movies < minMovies {
+			continue
+		}
+		personal := group.personal / float64(group.movies)
+		global := group.global / float64(group.movies)
+		bias := TasteBiasOutput{
+			Name:            group.name,
+			Movies:          group.movies,
+			PersonalAverage: roundRating(personal),
+			GlobalAverage:   roundRating(global),
+			Bias:            roundRating(personal - global),
+			RelativeBias:    roundRating(personal - global - averageBias),
+			Verdict:         "agrees",
+		}
+		if bias.RelativeBias > tasteThreshold {
+			bias.Verdict = "over-rates"
+		} else if bias.RelativeBias < -tasteThreshold {
+			bias.Verdict = "under-rates"
+		}
+		biases = append(biases, bias)
+	}
+	sort.Slice(biases, func(i, j int) bool {
+		if biases[i].RelativeBias != biases[j].RelativeBias {
+			return biases[i].RelativeBias > biases[j].RelativeBias
+		}
+		return biases[i].Name < biases[j].Name
+	})
+	return biases
+}
+
+// tasteGenresSummary lists genres after a translated prefix, or nothing when
+// there are none
+func tasteGenresSummary(ctx context.Context, prefix string, genres []string) string {
+	if len(genres) == 0 {
+		return ""
+	}
+	return i18n.FromContext(ctx).Translate(prefix) + strings.Join(genres, ", ")
+}
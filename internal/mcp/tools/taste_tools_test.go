@@ -0,0 +1,164 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// tasteLibrary returns a batch getter over a small library rated by others
+func tasteLibrary() *MockMovieBatchGetter {
+	library := []*movieApp.MovieDTO{
+		{ID: 1, Title: "Heat", Director: "Michael Mann", Rating: 8.0, Genres: []string{"Crime", "Thriller"}},
+		{ID: 2, Title: "Collateral", Director: "Michael Mann", Rating: 7.5, Genres: []string{"Crime", "Thriller"}},
+		{ID: 3, Title: "Step Brothers", Director: "Adam McKay", Rating: 7.0, Genres: []string{"Comedy"}},
+		{ID: 4, Title: "Anchorman", Director: "Adam McKay", Rating: 7.2, Genres: []string{"comedy"}},
+		{ID: 5, Title: "Unreleased", Director: "Nobody", Genres: []string{"Drama"}},
+	}
+	return &MockMovieBatchGetter{
+		GetMoviesByIDsFunc: func(ctx context.Context, ids []int) ([]*movieApp.MovieDTO, error) {
+			var found []*movieApp.MovieDTO
+			for _, movieDTO := range library {
+				if slices.Contains(ids, movieDTO.ID) {
+					found = append(found, movieDTO)
+				}
+			}
+			return found, nil
+		},
+	}
+}
+
+func TestTasteProfile_ComparesRatings(t *testing.T) {
+	tools := NewTasteTools(tasteLibrary())
+
+	result, output, err := tools.TasteProfile(context.Background(), nil, TasteProfileInput{
+		Ratings: []PersonalRating{
+			{MovieID: 1, Rating: 10},
+			{MovieID: 2, Rating: 9.5},
+			{MovieID: 3, Rating: 5},
+			{MovieID: 4, Rating: 5.2},
+			{MovieID: 5, Rating: 8},
+			{MovieID: 99, Rating: 6},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result == nil {
+		t.Fatal("Expected a result")
+	}
+
+	if output.Compared != 4 || output.AverageBias != 0 {
+		t.Errorf("Expected 4 ratings with no average bias, got: %d, %v", output.Compared, output.AverageBias)
+	}
+	if !slices.Equal(output.Unrated, []int{5}) || !slices.Equal(output.Missing, []int{99}) {
+		t.Errorf("Expected movie 5 unrated and 99 missing, got: %v, %v", output.Unrated, output.Missing)
+	}
+	if !slices.Equal(output.OverRated, []string{"Crime", "Thriller"}) || !slices.Equal(output.UnderRated, []string{"Comedy"}) {
+		t.Errorf("Expected Crime and Thriller over-rated and Comedy under-rated, got: %v, %v", output.OverRated, output.UnderRated)
+	}
+	if len(output.Directors) != 2 || output.Directors[0].Name != "Michael Mann" || output.Directors[0].Bias != 2 {
+		t.Fatalf("Expected Michael Mann over-rated by 2 first, got: %+v", output.Directors)
+	}
+	if comedy := output.Genres[2]; comedy.Movies != 2 || comedy.PersonalAverage != 5.1 || comedy.GlobalAverage != 7.1 || comedy.Verdict != "under-rates" {
+		t.Errorf("Expected both comedies grouped and under-rated, got: %+v", comedy)
+	}
+}
+
+func TestTasteProfile_BiasIsRelativeToTheUser(t *testing.T) {
+	tools := NewTasteTools(tasteLibrary())
+
+	// Every rating is two points above the library, so no genre stands out
+	_, output, err := tools.TasteProfile(context.Background(), nil, TasteProfileInput{
+		Ratings: []PersonalRating{
+			{MovieID: 1, Rating: 10},
+			{MovieID: 2, Rating: 9.5},
+			{MovieID: 3, Rating: 9},
+			{MovieID: 4, Rating: 9.2},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.AverageBias != 2 {
+		t.Errorf("Expected an average bias of 2, got: %v", output.AverageBias)
+	}
+	if len(output.OverRated) != 0 || len(output.UnderRated) != 0 {
+		t.Errorf("Expected a generous rater to over-rate nothing, got: %v, %v", output.OverRated, output.UnderRated)
+	}
+}
+
+func TestTasteProfile_MinMovies(t *testing.T) {
+	tools := NewTasteTools(tasteLibrary())
+
+	_, output, err := tools.TasteProfile(context.Background(), nil, TasteProfileInput{
+		Ratings:   []PersonalRating{{MovieID: 1, Rating: 9}, {MovieID: 3, Rating: 6}},
+		MinMovies: 1,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(output.Genres) != 3 || len(output.Directors) != 2 {
+		t.Errorf("Expected every genre and director of a single movie, got: %+v, %+v", output.Genres, output.Directors)
+	}
+
+	_, output, err = tools.TasteProfile(context.Background(), nil, TasteProfileInput{
+		Ratings: []PersonalRating{{MovieID: 1, Rating: 9}, {MovieID: 3, Rating: 6}},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(output.Genres) != 0 || len(output.Directors) != 0 {
+		t.Errorf("Expected no profile from one movie each by default, got: %+v, %+v", output.Genres, output.Directors)
+	}
+}
+
+func TestTasteProfile_ApplySetsFavoriteGenres(t *testing.T) {
+	store := NewPreferenceStore()
+	store.set(nil, Preferences{FavoriteGenres: []string{"Western"}, DislikedDirectors: []string{"Adam McKay"}})
+	tools := NewTasteTools(tasteLibrary())
+	ratings := []PersonalRating{{MovieID: 1, Rating: 10}, {MovieID: 2, Rating: 9.5}, {MovieID: 3, Rating: 5}, {MovieID: 4, Rating: 5.2}}
+
+	_, _, err := tools.TasteProfile(context.Background(), nil, TasteProfileInput{Ratings: ratings, Apply: true})
+	if !errors.Is(err, shared.ErrUnavailable) {
+		t.Errorf("Expected apply without preferences to be unavailable, got: %v", err)
+	}
+
+	tools.SetPreferenceStore(store)
+	_, output, err := tools.TasteProfile(context.Background(), nil, TasteProfileInput{Ratings: ratings, Apply: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	prefs := store.Get(nil)
+	if !slices.Equal(prefs.FavoriteGenres, []string{"Crime", "Thriller"}) || !slices.Equal(output.AppliedGenres, prefs.FavoriteGenres) {
+		t.Errorf("Expected the over-rated genres saved, got: %v, %v", prefs.FavoriteGenres, output.AppliedGenres)
+	}
+	if !slices.Equal(prefs.DislikedDirectors, []string{"Adam McKay"}) {
+		t.Errorf("Expected other preferences kept, got: %v", prefs.DislikedDirectors)
+	}
+}
+
+func TestTasteProfileInput_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		input TasteProfileInput
+	}{
+		{"no ratings", TasteProfileInput{}},
+		{"too many ratings", TasteProfileInput{Ratings: make([]PersonalRating, maxTasteRatings+1)}},
+		{"invalid movie", TasteProfileInput{Ratings: []PersonalRating{{MovieID: 0, Rating: 5}}}},
+		{"rating out of range", TasteProfileInput{Ratings: []PersonalRating{{MovieID: 1, Rating: 11}}}},
+		{"duplicate movie", TasteProfileInput{Ratings: []PersonalRating{{MovieID: 1, Rating: 5}, {MovieID: 1, Rating: 6}}}},
+		{"negative minimum", TasteProfileInput{Ratings: []PersonalRating{{MovieID: 1, Rating: 5}}, MinMovies: -1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.input.Validate(); !errors.Is(err, shared.ErrValidation) {
+				t.Errorf("Expected a validation error, got: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/i18n"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/middleware"
+)
+
+// maxTimelinePicks is the most top-rated movies get_release_timeline lists per year
+const maxTimelinePicks = 10
+
+// ReleaseTimelineService defines the interface for release timeline queries
+type ReleaseTimelineService interface {
+	GetReleaseTimeline(ctx context.Context, query movieApp.ReleaseTimelineQuery) (*movieApp.ReleaseTimelineDTO, error)
+}
+
+// TimelineTools provides SDK-based MCP handlers for release timelines
+type TimelineTools struct {
+	timelines ReleaseTimelineService
+}
+
+// NewTimelineTools creates a new timeline tools instance
+func NewTimelineTools(timelines ReleaseTimelineService) *TimelineTools {
+	return &TimelineTools{
+		timelines: timelines,
+	}
+}
+
+// ===== get_release_timeline Tool =====
+
+// GetReleaseTimelineInput defines the input schema for get_release_timeline tool
+type GetReleaseTimelineInput struct {
+	Year      int    `json:"year,omitempty" jsonschema:"Single release year, e.g. 1999; cannot be combined with start_year or end_year"`
+	StartYear int    `json:"start_year,omitempty" jsonschema:"First year of the timeline (default: earliest movie)"`
+	EndYear   int    `json:"end_year,omitempty" jsonschema:"Last year of the timeline (default: latest movie)"`
+	Genre     string `json:"genre,omitempty" jsonschema:"Only movies with this genre"`
+	TopPicks  int    `json:"top_picks,omitempty" jsonschema:"Top-rated movies listed per year (default 3, max 10)"`
+}
+
+// Validate checks the year range and the number of picks
+func (in GetReleaseTimelineInput) Validate() error {
+	if in.Year != 0 && (in.StartYear != 0 || in.EndYear != 0) {
+		return shared.NewValidationError("year cannot be combined with start_year or end_year")
+	}
+	if in.Year < 0 || in.StartYear < 0 || in.EndYear < 0 {
+		return shared.NewValidationError("years cannot be negative")
+	}
+	if in.StartYear > 0 && in.EndYear > 0 && in.StartYear > in.EndYear {
+		return shared.NewValidationError("start_year cannot be after end_year")
+	}
+	if in.TopPicks < 0 || in.TopPicks > maxTimelinePicks {
+		return shared.NewValidationError("top_picks must be between 1 and %d", maxTimelinePicks)
+	}
+	return nil
+}
+
+// ReleaseYearOutput defines the output schema for one year of a release timeline
+type ReleaseYearOutput struct {
+	Year          int                  `json:"year" jsonschema:"Release year"`
+	Count         int                  `json:"count" jsonschema:"Movies released that year"`
+	AverageRating float64              `json:"average_rating,omitempty" jsonschema:"Average rating of the year's rated movies"`
+	TopRated      []MovieOutput        `json:"top_rated" jsonschema:"Best-rated movies of the year, best first; unrated movies are not picked"`
+	Months        []ReleaseMonthOutput `json:"months" jsonschema:"Months with releases known to the day, January first"`
+	Undated       int                  `json:"undated,omitempty" jsonschema:"Movies of the year with no release date, left out of months"`
+}
+
+// ReleaseMonthOutput defines the output schema for one month of a release year
+type ReleaseMonthOutput struct {
+	Month int `json:"month" jsonschema:"Release month, 1 for January"`
+	Count int `json:"count" jsonschema:"Movies released that month"`
+}
+
+// GetReleaseTimelineOutput defines the output schema for get_release_timeline tool
+type GetReleaseTimelineOutput struct {
+	Years       []ReleaseYearOutput    `json:"years" jsonschema:"Years with releases, oldest first"`
+	Total       int                    `json:"total" jsonschema:"Movies released over the timeline"`
+	Description string                 `json:"description" jsonschema:"Description of the timeline"`
+	Truncation  *middleware.Truncation `json:"truncation,omitempty" jsonschema:"Set when years was cut short to fit the response size limit"`
+}
+
+// Len returns the number of years, for response truncation
+func (o GetReleaseTimelineOutput) Len() int {
+	return len(o.Years)
+}
+
+// Truncate keeps the first keep years, pointing to a later start_year for the rest
+func (o GetReleaseTimelineOutput) Truncate(keep int, truncation middleware.Truncation) any {
+	if keep < len(o.Years) {
+		truncation.Hint = fmt.Sprintf("call again with start_year %d for the later years", o.Years[keep].Year)
+	}
+	o.Years = o.Years[:keep]
+	o.Truncation = &truncation
+	return o
+}
+
+// GetReleaseTimeline handles the get_release_timeline tool call. Years are
+// broken down by month for the movies whose release date is known; the rest
+// are counted as undated.
+func (t *TimelineTools) GetReleaseTimeline(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input GetReleaseTimelineInput,
+) (*mcp.CallToolResult, GetReleaseTimelineOutput, error) {
+	query := movieApp.ReleaseTimelineQuery{
+		StartYear: input.StartYear,
+		EndYear:   input.EndYear,
+		Genre:     input.Genre,
+		TopPicks:  input.TopPicks,
+	}
+	if input.Year != 0 {
+		query.StartYear, query.EndYear = input.Year, input.Year
+	}
+
+	timeline, err := t.timelines.GetReleaseTimeline(ctx, query)
+	if err != nil {
+		return nil, GetReleaseTimelineOutput{}, fmt.Errorf("failed to get release timeline: %w", err)
+	}
+
+	output := GetReleaseTimelineOutput{
+		Years:       make([]ReleaseYearOutput, len(timeline.Years)),
+		Total:       timeline.Total,
+		Description: timelineDescription(query),
+	}
+	for i, year := range timeline.Years {
+		output.Years[i] = ReleaseYearOutput{
+			Year:          year.Year,
+			Count:         year.Count,
+			AverageRating: year.AverageRating,
+			TopRated:      newMovieOutputs(year.TopRated),
+			Months:        make([]ReleaseMonthOutput, len(year.Months)),
+			Undated:       year.Undated,
+		}
+		for j, month := range year.Months {
+			output.Years[i].Months[j] = ReleaseMonthOutput{Month: month.Month, Count: month.Count}
+		}
+	}
+
+	if query.StartYear > 0 && query.StartYear == query.EndYear {
+		var picks []MovieOutput
+		if len(output.Years) > 0 {
+			picks = output.Years[0].TopRated
+		}
+		return summaryResult(ctx, output, "%s released in %d%s", countNoun(ctx, output.Total, "movie", "movies"), query.StartYear,
+			topPicksSummary(ctx, picks)), output, nil
+	}
+	return summaryResult(ctx, output, "%s released over %s (%s)", countNoun(ctx, output.Total, "movie", "movies"),
+		countNoun(ctx, len(output.Years), "year", "years"), output.Description), output, nil
+}
+
+// timelineDescription describes the years a timeline query covers
+func timelineDescription(query movieApp.ReleaseTimelineQuery) string {
+	description := "Movies released"
+	if query.Genre != "" {
+		description = query.Genre + " movies released"
+	}
+	switch {
+	case query.StartYear > 0 && query.StartYear == query.EndYear:
+		return fmt.Sprintf("%s in %d", description, query.StartYear)
+	case query.StartYear > 0 && query.EndYear > 0:
+		return fmt.Sprintf("%s from %d to %d", description, query.StartYear, query.EndYear)
+	case query.StartYear > 0:
+		return fmt.Sprintf("%s since %d", description, query.StartYear)
+	case query.EndYear > 0:
+		return fmt.Sprintf("%s up to %d", description, query.EndYear)
+	}
+	return description + " by year"
+}
+
+// topPicksSummary lists a year's top-rated movies after a semicolon
+func topPicksSummary(ctx context.Context, movies []MovieOutput) string {
+	if len(movies) == 0 {
+		return ""
+	}
+	return i18n.FromContext(ctx).Translate("; top rated") + listSummary(ctx, movieLabels(movies))
+}
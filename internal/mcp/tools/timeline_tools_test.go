@@ -0,0 +1,162 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/middleware"
+)
+
+// MockReleaseTimelineService is a mock implementation of ReleaseTimelineService
+type MockReleaseTimelineService struct {
+	GetReleaseTimelineFunc func(ctx context.Context, query movieApp.ReleaseTimelineQuery) (*movieApp.ReleaseTimelineDTO, error)
+}
+
+func (m *MockReleaseTimelineService) GetReleaseTimeline(ctx context.Context, query movieApp.ReleaseTimelineQuery) (*movieApp.ReleaseTimelineDTO, error) {
+	if m.GetReleaseTimelineFunc != nil {
+		return m.GetReleaseTimelineFunc(ctx, query)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func TestGetReleaseTimeline_SingleYear(t *testing.T) {
+	var gotQuery movieApp.ReleaseTimelineQuery
+	tools := NewTimelineTools(&MockReleaseTimelineService{
+		GetReleaseTimelineFunc: func(ctx context.Context, query movieApp.ReleaseTimelineQuery) (*movieApp.ReleaseTimelineDTO, error) {
+			gotQuery = query
+			return &movieApp.ReleaseTimelineDTO{
+				Total: 3,
+				Years: []*movieApp.ReleaseYearDTO{{
+					Year:          1999,
+					Count:         3,
+					AverageRating: 8.2,
+					TopRated: []*movieApp.MovieDTO{
+						{ID: 1, Title: "Fight Club", Director: "David Fincher", Year: 1999, Rating: 8.8},
+						{ID: 2, Title: "The Matrix", Director: "Wachowskis", Year: 1999, Rating: 8.7},
+					},
+				}},
+			}, nil
+		},
+	})
+
+	result, output, err := tools.GetReleaseTimeline(context.Background(), nil, GetReleaseTimelineInput{Year: 1999, TopPicks: 2})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if gotQuery.StartYear != 1999 || gotQuery.EndYear != 1999 || gotQuery.TopPicks != 2 {
+		t.Errorf("Expected year 1999 as the range, got: %+v", gotQuery)
+	}
+	if output.Total != 3 || len(output.Years) != 1 || len(output.Years[0].TopRated) != 2 {
+		t.Fatalf("Expected one year with two picks, got: %+v", output)
+	}
+	if output.Description != "Movies released in 1999" {
+		t.Errorf("Expected the year in the description, got: %q", output.Description)
+	}
+	summary := result.Content[1].(*mcp.TextContent).Text
+	if summary != "3 movies released in 1999; top rated: Fight Club (1999), The Matrix (1999)" {
+		t.Errorf("Unexpected summary: %q", summary)
+	}
+}
+
+func TestGetReleaseTimeline_Range(t *testing.T) {
+	tools := NewTimelineTools(&MockReleaseTimelineService{
+		GetReleaseTimelineFunc: func(ctx context.Context, query movieApp.ReleaseTimelineQuery) (*movieApp.ReleaseTimelineDTO, error) {
+			return &movieApp.ReleaseTimelineDTO{
+				Total: 3,
+				Years: []*movieApp.ReleaseYearDTO{
+					{Year: 1999, Count: 2, TopRated: []*movieApp.MovieDTO{}},
+					{Year: 2001, Count: 1, TopRated: []*movieApp.MovieDTO{}},
+				},
+			}, nil
+		},
+	})
+
+	result, output, err := tools.GetReleaseTimeline(context.Background(), nil, GetReleaseTimelineInput{StartYear: 1999, EndYear: 2001, Genre: "Drama"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if output.Description != "Drama movies released from 1999 to 2001" {
+		t.Errorf("Expected the genre and range in the description, got: %q", output.Description)
+	}
+	summary := result.Content[1].(*mcp.TextContent).Text
+	if !strings.HasPrefix(summary, "3 movies released over 2 years") {
+		t.Errorf("Unexpected summary: %q", summary)
+	}
+}
+
+func TestGetReleaseTimelineInput_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   GetReleaseTimelineInput
+		wantErr bool
+	}{
+		{name: "whole library", input: GetReleaseTimelineInput{}},
+		{name: "single year", input: GetReleaseTimelineInput{Year: 1999}},
+		{name: "year with range", input: GetReleaseTimelineInput{Year: 1999, EndYear: 2000}, wantErr: true},
+		{name: "reversed range", input: GetReleaseTimelineInput{StartYear: 2001, EndYear: 1999}, wantErr: true},
+		{name: "too many picks", input: GetReleaseTimelineInput{TopPicks: maxTimelinePicks + 1}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.input.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetReleaseTimelineOutput_Truncate(t *testing.T) {
+	output := GetReleaseTimelineOutput{Years: []ReleaseYearOutput{{Year: 1999}, {Year: 2001}, {Year: 2004}}}
+
+	truncated := output.Truncate(1, middleware.Truncation{Returned: 1, Available: 3}).(GetReleaseTimelineOutput)
+	if len(truncated.Years) != 1 || truncated.Truncation == nil {
+		t.Fatalf("Expected one year and a truncation, got: %+v", truncated)
+	}
+	if !strings.Contains(truncated.Truncation.Hint, "start_year 2001") {
+		t.Errorf("Expected a hint to continue from 2001, got: %q", truncated.Truncation.Hint)
+	}
+}
+
+func TestGetReleaseTimelineOutput_TruncateKeepingEveryYear(t *testing.T) {
+	output := GetReleaseTimelineOutput{Years: []ReleaseYearOutput{{Year: 1999}, {Year: 2001}}}
+
+	truncated := output.Truncate(2, middleware.Truncation{Returned: 2, Available: 2}).(GetReleaseTimelineOutput)
+	if len(truncated.Years) != 2 || truncated.Truncation == nil {
+		t.Fatalf("Expected both years and a truncation, got: %+v", truncated)
+	}
+	if truncated.Truncation.Hint != "" {
+		t.Errorf("Expected no start_year hint with no later years, got: %q", truncated.Truncation.Hint)
+	}
+}
+
+func TestGetReleaseTimeline_Months(t *testing.T) {
+	tools := NewTimelineTools(&MockReleaseTimelineService{
+		GetReleaseTimelineFunc: func(ctx context.Context, query movieApp.ReleaseTimelineQuery) (*movieApp.ReleaseTimelineDTO, error) {
+			return &movieApp.ReleaseTimelineDTO{
+				Total: 3,
+				Years: []*movieApp.ReleaseYearDTO{{
+					Year: 1999, Count: 3, TopRated: []*movieApp.MovieDTO{}, Undated: 1,
+					Months: []*movieApp.ReleaseMonthDTO{{Month: 3, Count: 2}},
+				}},
+			}, nil
+		},
+	})
+
+	_, output, err := tools.GetReleaseTimeline(context.Background(), nil, GetReleaseTimelineInput{Year: 1999})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	year := output.Years[0]
+	if len(year.Months) != 1 || year.Months[0] != (ReleaseMonthOutput{Month: 3, Count: 2}) || year.Undated != 1 {
+		t.Errorf("Expected 2 movies in March and 1 undated, got: %+v", year)
+	}
+}
@@ -0,0 +1,177 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/application/transfer"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/internal/mcp/i18n"
+)
+
+// MovieTransferer defines the interface for NDJSON exports and imports
+type MovieTransferer interface {
+	Export(ctx context.Context, cmd transfer.ExportCommand) (*transfer.Result, error)
+	Import(ctx context.Context, cmd transfer.ImportCommand) (*transfer.Result, error)
+}
+
+// TransferTools provides SDK-based MCP handlers for NDJSON exports and imports
+type TransferTools struct {
+	transferer MovieTransferer
+}
+
+// NewTransferTools creates a new transfer tools instance
+func NewTransferTools(transferer MovieTransferer) *TransferTools {
+	return &TransferTools{
+		transferer: transferer,
+	}
+}
+
+// TransferLineError describes a line an import could not create a movie from
+type TransferLineError struct {
+	Line  int    `json:"line" jsonschema:"Line number in the file, starting at 1"`
+	Title string `json:"title,omitempty" jsonschema:"Title on the line, when it parsed"`
+	Error string `json:"error" jsonschema:"Why the line was rejected"`
+}
+
+// TransferOutput defines the common output schema for NDJSON exports and imports
+type TransferOutput struct {
+	Path            string              `json:"path" jsonschema:"NDJSON file path on the server"`
+	CheckpointPath  string              `json:"checkpoint_path" jsonschema:"Checkpoint file kept next to it; resume reads it"`
+	Complete        bool                `json:"complete" jsonschema:"Whether the whole file has been transferred"`
+	Resumed         bool                `json:"resumed" jsonschema:"Whether this call continued an earlier transfer"`
+	Lines           int                 `json:"lines" jsonschema:"Lines transferred so far, across calls"`
+	Bytes           int64               `json:"bytes" jsonschema:"Bytes of the file transferred so far"`
+	Batches         int                 `json:"batches" jsonschema:"Batches committed so far"`
+	NewBatches      int                 `json:"new_batches" jsonschema:"Batches this call committed"`
+	VerifiedBatches int                 `json:"verified_batches" jsonschema:"Batches checked against their recorded SHA-256 by this call"`
+	Imported        int                 `json:"imported,omitempty" jsonschema:"Import: movies created so far"`
+	Failed          int                 `json:"failed,omitempty" jsonschema:"Import: lines rejected so far"`
+	Errors          []TransferLineError `json:"errors" jsonschema:"Import: the first lines this call rejected"`
+}
+
+// newTransferOutput converts a transfer result to the output format
+func newTransferOutput(result *transfer.Result) TransferOutput {
+	errs := make([]TransferLineError, 0, len(result.Errors))
+	for _, lineErr := range result.Errors {
+		errs = append(errs, TransferLineError{Line: lineErr.Line, Title: lineErr.Title, Error: lineErr.Error})
+	}
+
+	checkpoint := result.Checkpoint
+	return TransferOutput{
+		Path:            result.Path,
+		CheckpointPath:  result.CheckpointPath,
+		Complete:        checkpoint.Complete,
+		Resumed:         result.Resumed,
+		Lines:           checkpoint.Lines,
+		Bytes:           checkpoint.Bytes,
+		Batches:         len(checkpoint.Batches),
+		NewBatches:      result.NewBatches,
+		VerifiedBatches: result.Verified,
+		Imported:        checkpoint.Imported,
+		Failed:          checkpoint.Failed,
+		Errors:          errs,
+	}
+}
+
+// ===== export_movies_ndjson Tool =====
+
+// ExportMoviesNDJSONInput defines the input schema for export_movies_ndjson tool
+type ExportMoviesNDJSONInput struct {
+	Path      string `json:"path" jsonschema:"Destination NDJSON file path, relative to the server's backup directory"`
+	BatchSize int    `json:"batch_size,omitempty" jsonschema:"Movies per checkpointed batch (default 500, max 5000)"`
+	Resume    bool   `json:"resume,omitempty" jsonschema:"Continue an interrupted export of path after its last committed batch"`
+}
+
+// Validate requires a destination path and a batch size in range
+func (in ExportMoviesNDJSONInput) Validate() error {
+	if in.Path == "" {
+		return shared.NewValidationError("path is required")
+	}
+	return validateTransferBatchSize(in.BatchSize)
+}
+
+// ExportMoviesNDJSON handles the export_movies_ndjson tool call
+func (t *TransferTools) ExportMoviesNDJSON(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input ExportMoviesNDJSONInput,
+) (*mcp.CallToolResult, TransferOutput, error) {
+	result, err := t.transferer.Export(ctx, transfer.ExportCommand{
+		Path:      input.Path,
+		BatchSize: input.BatchSize,
+		Resume:    input.Resume,
+	})
+	if err != nil {
+		return nil, TransferOutput{}, fmt.Errorf("failed to export movies: %w", err)
+	}
+
+	output := newTransferOutput(result)
+	return summaryResult(ctx, output, "Exported %s to %s in %s",
+		countNoun(ctx, output.Lines, "movie", "movies"), output.Path, countNoun(ctx, output.Batches, "batch", "batches")), output, nil
+}
+
+// ===== import_movies_ndjson Tool =====
+
+// ImportMoviesNDJSONInput defines the input schema for import_movies_ndjson tool
+type ImportMoviesNDJSONInput struct {
+	Path       string `json:"path" jsonschema:"NDJSON file path, relative to the server's backup directory, one movie per line"`
+	BatchSize  int    `json:"batch_size,omitempty" jsonschema:"Lines per checkpointed batch (default 500, max 5000); files from export_movies_ndjson keep the export's batches"`
+	Resume     bool   `json:"resume,omitempty" jsonschema:"Continue an interrupted import of path after its last committed batch"`
+	Validation string `json:"validation,omitempty" jsonschema:"Validation policy for this import: strict (also rejects future release dates and a rating of 0) or lenient; defaults to the server's policy"`
+}
+
+// Validate requires a file path, a batch size in range and a known policy
+func (in ImportMoviesNDJSONInput) Validate() error {
+	if in.Path == "" {
+		return shared.NewValidationError("path is required")
+	}
+	if err := validateTransferBatchSize(in.BatchSize); err != nil {
+		return err
+	}
+	_, err := parseValidationOverride(in.Validation)
+	return err
+}
+
+// ImportMoviesNDJSON handles the import_movies_ndjson tool call. Movies are
+// created with new IDs; lines that fail to parse or validate are counted
+// and skipped, while a batch that fails to insert stops the import so a
+// resumed call retries it.
+func (t *TransferTools) ImportMoviesNDJSON(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input ImportMoviesNDJSONInput,
+) (*mcp.CallToolResult, TransferOutput, error) {
+	policy, err := parseValidationOverride(input.Validation)
+	if err != nil {
+		return nil, TransferOutput{}, err
+	}
+
+	result, err := t.transferer.Import(ctx, transfer.ImportCommand{
+		Path:       input.Path,
+		BatchSize:  input.BatchSize,
+		Resume:     input.Resume,
+		Validation: policy,
+	})
+	if err != nil {
+		return nil, TransferOutput{}, fmt.Errorf("failed to import movies: %w", err)
+	}
+
+	output := newTransferOutput(result)
+	p := i18n.FromContext(ctx)
+	summary := p.Sprintf("Imported %s from %s", countNoun(ctx, output.Imported, "movie", "movies"), output.Path)
+	if output.Failed > 0 {
+		summary += p.Sprintf("; %s failed", countNoun(ctx, output.Failed, "line", "lines"))
+	}
+	return summaryResult(ctx, output, "%s", summary), output, nil
+}
+
+// validateTransferBatchSize rejects batch sizes a transfer does not accept
+func validateTransferBatchSize(size int) error {
+	if size < 0 || size > transfer.MaxBatchSize {
+		return shared.NewValidationError("batch_size must be between 1 and %d", transfer.MaxBatchSize)
+	}
+	return nil
+}
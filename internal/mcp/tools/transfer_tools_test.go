@@ -0,0 +1,147 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/internal/application/transfer"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// MockMovieTransferer is a mock implementation of MovieTransferer
+type MockMovieTransferer struct {
+	ExportFunc func(ctx context.Context, cmd transfer.ExportCommand) (*transfer.Result, error)
+	ImportFunc func(ctx context.Context, cmd transfer.ImportCommand) (*transfer.Result, error)
+}
+
+func (m *MockMovieTransferer) Export(ctx context.Context, cmd transfer.ExportCommand) (*transfer.Result, error) {
+	if m.ExportFunc != nil {
+		return m.ExportFunc(ctx, cmd)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockMovieTransferer) Import(ctx context.Context, cmd transfer.ImportCommand) (*transfer.Result, error) {
+	if m.ImportFunc != nil {
+		return m.ImportFunc(ctx, cmd)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func TestExportMoviesNDJSON_ReportsCheckpoint(t *testing.T) {
+	var got transfer.ExportCommand
+	transferer := &MockMovieTransferer{
+		ExportFunc: func(ctx context.Context, cmd transfer.ExportCommand) (*transfer.Result, error) {
+			got = cmd
+			return &transfer.Result{
+				Path:           cmd.Path,
+				CheckpointPath: cmd.Path + ".export-checkpoint.json",
+				Resumed:        true,
+				Verified:       2,
+				NewBatches:     1,
+				Errors:         []transfer.LineError{},
+				Checkpoint: &transfer.Checkpoint{
+					Complete: true,
+					Lines:    1200,
+					Bytes:    96000,
+					Batches:  []transfer.Batch{{Lines: 500}, {Lines: 500}, {Lines: 200}},
+				},
+			}, nil
+		},
+	}
+
+	input := ExportMoviesNDJSONInput{Path: "/tmp/movies.ndjson", BatchSize: 500, Resume: true}
+	result, output, err := NewTransferTools(transferer).ExportMoviesNDJSON(context.Background(), nil, input)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if got.Path != input.Path || got.BatchSize != 500 || !got.Resume {
+		t.Errorf("Expected the input passed through, got: %+v", got)
+	}
+	if !output.Complete || output.Lines != 1200 || output.Batches != 3 || output.NewBatches != 1 || output.VerifiedBatches != 2 {
+		t.Errorf("Unexpected output %+v", output)
+	}
+	if text := result.Content[1].(*mcp.TextContent).Text; text != "Exported 1200 movies to /tmp/movies.ndjson in 3 batches" {
+		t.Errorf("Unexpected summary %q", text)
+	}
+}
+
+func TestImportMoviesNDJSON_ReportsFailedLines(t *testing.T) {
+	var got transfer.ImportCommand
+	transferer := &MockMovieTransferer{
+		ImportFunc: func(ctx context.Context, cmd transfer.ImportCommand) (*transfer.Result, error) {
+			got = cmd
+			return &transfer.Result{
+				Path:       cmd.Path,
+				NewBatches: 1,
+				Errors:     []transfer.LineError{{Line: 2, Error: "invalid JSON"}},
+				Checkpoint: &transfer.Checkpoint{Complete: true, Lines: 3, Batches: []transfer.Batch{{Lines: 3}}, Imported: 2, Failed: 1},
+			}, nil
+		},
+	}
+
+	input := ImportMoviesNDJSONInput{Path: "/tmp/movies.ndjson", Validation: "strict"}
+	result, output, err := NewTransferTools(transferer).ImportMoviesNDJSON(context.Background(), nil, input)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if got.Validation != shared.ValidationStrict {
+		t.Errorf("Expected the strict policy, got: %v", got.Validation)
+	}
+	if output.Imported != 2 || output.Failed != 1 || len(output.Errors) != 1 || output.Errors[0].Line != 2 {
+		t.Errorf("Unexpected output %+v", output)
+	}
+	if text := result.Content[1].(*mcp.TextContent).Text; text != "Imported 2 movies from /tmp/movies.ndjson; 1 line failed" {
+		t.Errorf("Unexpected summary %q", text)
+	}
+}
+
+func TestTransferInputs_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		input interface{ Validate() error }
+	}{
+		{"export without path", ExportMoviesNDJSONInput{}},
+		{"export batch too large", ExportMoviesNDJSONInput{Path: "a.ndjson", BatchSize: transfer.MaxBatchSize + 1}},
+		{"import without path", ImportMoviesNDJSONInput{}},
+		{"import negative batch", ImportMoviesNDJSONInput{Path: "a.ndjson", BatchSize: -1}},
+		{"import unknown policy", ImportMoviesNDJSONInput{Path: "a.ndjson", Validation: "loose"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.input.Validate(); !errors.Is(err, shared.ErrValidation) {
+				t.Errorf("Expected a validation error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestTransferTools_RejectPathsOutsideArchiveDir(t *testing.T) {
+	root := t.TempDir()
+	outside := t.TempDir()
+	if err := os.Symlink(filepath.Join(outside, "checkpoint.json"), filepath.Join(root, "movies.ndjson.export-checkpoint.json")); err != nil {
+		t.Fatal(err)
+	}
+	service := transfer.NewService(&MockMovieService{})
+	service.SetPathResolver(NewArchiveDir(root).Resolve)
+	tools := NewTransferTools(service)
+
+	for _, path := range []string{filepath.Join(outside, "movies.ndjson"), "../movies.ndjson", "movies.ndjson"} {
+		if _, _, err := tools.ExportMoviesNDJSON(context.Background(), nil, ExportMoviesNDJSONInput{Path: path}); !errors.Is(err, shared.ErrValidation) {
+			t.Errorf("Expected export to %s to be rejected, got: %v", path, err)
+		}
+	}
+	for _, path := range []string{filepath.Join(outside, "movies.ndjson"), "../movies.ndjson"} {
+		if _, _, err := tools.ImportMoviesNDJSON(context.Background(), nil, ImportMoviesNDJSONInput{Path: path}); !errors.Is(err, shared.ErrValidation) {
+			t.Errorf("Expected import from %s to be rejected, got: %v", path, err)
+		}
+	}
+	if entries, _ := os.ReadDir(outside); len(entries) != 0 {
+		t.Errorf("Expected nothing written outside the backup directory, got: %v", entries)
+	}
+}
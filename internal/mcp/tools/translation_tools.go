@@ -0,0 +1,182 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	translationApp "github.com/francknouama/movies-mcp-server/internal/application/translation"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// TranslationService defines the interface for movie translation operations
+type TranslationService interface {
+	AddTranslation(ctx context.Context, cmd translationApp.AddTranslationCommand) (*translationApp.TranslationDTO, error)
+	ListTranslations(ctx context.Context, movieID int) (*translationApp.MovieTranslationsDTO, error)
+}
+
+// MovieLocalizer finds the best translation of each movie for a language
+type MovieLocalizer interface {
+	Localize(ctx context.Context, language string, movieIDs []int) (map[int]*translationApp.TranslationDTO, error)
+}
+
+// localizeMovies replaces titles and descriptions with their translations
+// in language where one exists. Movies without a translation are left as
+// they are; nothing changes when no language or localizer is given.
+func localizeMovies(ctx context.Context, localizer MovieLocalizer, language string, movies []MovieOutput) error {
+	if language == "" || localizer == nil || len(movies) == 0 {
+		return nil
+	}
+
+	movieIDs := make([]int, len(movies))
+	for i, movie := range movies {
+		movieIDs[i] = movie.ID
+	}
+
+	translations, err := localizer.Localize(ctx, language, movieIDs)
+	if err != nil {
+		return fmt.Errorf("failed to localize movies: %w", err)
+	}
+
+	for i := range movies {
+		translation, ok := translations[movies[i].ID]
+		if !ok {
+			continue
+		}
+		if translation.Title != "" && translation.Title != movies[i].Title {
+			movies[i].OriginalTitle = movies[i].Title
+			movies[i].Title = translation.Title
+		}
+		movies[i].Description = translation.Description
+		movies[i].DescriptionSource = translation.DescriptionSource
+		movies[i].Language = translation.Language
+	}
+	return nil
+}
+
+// TranslationTools provides SDK-based MCP handlers for movie translations
+type TranslationTools struct {
+	translationService TranslationService
+}
+
+// NewTranslationTools creates a new translation tools instance
+func NewTranslationTools(translationService TranslationService) *TranslationTools {
+	return &TranslationTools{
+		translationService: translationService,
+	}
+}
+
+// TranslationOutput defines the output schema for a movie translation
+type TranslationOutput struct {
+	MovieID     int    `json:"movie_id" jsonschema:"Movie ID"`
+	Language    string `json:"language" jsonschema:"Language code (e.g. fr or pt-BR)"`
+	Title       string `json:"title,omitempty" jsonschema:"Alternative title in this language"`
+	Description string `json:"description,omitempty" jsonschema:"Description in this language"`
+
+	DescriptionSource string `json:"description_source,omitempty" jsonschema:"Where the description came from: imported, or generated by a model"`
+	DescriptionModel  string `json:"description_model,omitempty" jsonschema:"Model that generated the description"`
+}
+
+// newTranslationOutput converts a translation DTO to the output format
+func newTranslationOutput(dto *translationApp.TranslationDTO) TranslationOutput {
+	return TranslationOutput{
+		MovieID:     dto.MovieID,
+		Language:    dto.Language,
+		Title:       dto.Title,
+		Description: dto.Description,
+
+		DescriptionSource: dto.DescriptionSource,
+		DescriptionModel:  dto.DescriptionModel,
+	}
+}
+
+// ===== add_translation Tool =====
+
+// AddTranslationInput defines the input schema for add_translation tool
+type AddTranslationInput struct {
+	MovieID     int    `json:"movie_id" jsonschema:"Movie ID"`
+	Language    string `json:"language" jsonschema:"Language code (e.g. fr or pt-BR)"`
+	Title       string `json:"title,omitempty" jsonschema:"Alternative title in this language"`
+	Description string `json:"description,omitempty" jsonschema:"Description in this language"`
+}
+
+// Validate requires a language code and limits the description
+func (in AddTranslationInput) Validate() error {
+	if in.Language == "" {
+		return shared.NewValidationError("language is required")
+	}
+	return checkTextLength("description", in.Description)
+}
+
+// AddTranslation handles the add_translation tool call
+func (t *TranslationTools) AddTranslation(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input AddTranslationInput,
+) (*mcp.CallToolResult, TranslationOutput, error) {
+	dto, err := t.translationService.AddTranslation(ctx, translationApp.AddTranslationCommand{
+		MovieID:     input.MovieID,
+		Language:    input.Language,
+		Title:       input.Title,
+		Description: input.Description,
+	})
+	if err != nil {
+		return nil, TranslationOutput{}, fmt.Errorf("failed to add translation: %w", err)
+	}
+
+	output := newTranslationOutput(dto)
+	return summaryResult(ctx, output, "Saved %s translation of movie %d%s", output.Language, output.MovieID, translatedTitle(output.Title)), output, nil
+}
+
+// translatedTitle formats a translated title for a summary, if there is one
+func translatedTitle(title string) string {
+	if title == "" {
+		return ""
+	}
+	return fmt.Sprintf(": %q", title)
+}
+
+// ===== list_translations Tool =====
+
+// ListTranslationsInput defines the input schema for list_translations tool
+type ListTranslationsInput struct {
+	MovieID int `json:"movie_id" jsonschema:"Movie ID"`
+}
+
+// ListTranslationsOutput defines the output schema for list_translations tool
+type ListTranslationsOutput struct {
+	MovieID      int                 `json:"movie_id" jsonschema:"Movie ID"`
+	Title        string              `json:"title" jsonschema:"Original movie title"`
+	Year         int                 `json:"year" jsonschema:"Release year"`
+	Translations []TranslationOutput `json:"translations" jsonschema:"Translations ordered by language"`
+	Total        int                 `json:"total" jsonschema:"Number of translations"`
+}
+
+// ListTranslations handles the list_translations tool call
+func (t *TranslationTools) ListTranslations(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input ListTranslationsInput,
+) (*mcp.CallToolResult, ListTranslationsOutput, error) {
+	dto, err := t.translationService.ListTranslations(ctx, input.MovieID)
+	if err != nil {
+		return nil, ListTranslationsOutput{}, fmt.Errorf("failed to list translations: %w", err)
+	}
+
+	output := ListTranslationsOutput{
+		MovieID:      dto.MovieID,
+		Title:        dto.Title,
+		Year:         dto.Year,
+		Translations: make([]TranslationOutput, 0, len(dto.Translations)),
+	}
+	languages := make([]string, 0, len(dto.Translations))
+	for _, translation := range dto.Translations {
+		output.Translations = append(output.Translations, newTranslationOutput(translation))
+		languages = append(languages, translation.Language)
+	}
+	output.Total = len(output.Translations)
+
+	return summaryResult(ctx, output, "%s (%d) has %s%s", output.Title, output.Year,
+		countNoun(ctx, output.Total, "translation", "translations"), listSummary(ctx, languages)), output, nil
+}
@@ -0,0 +1,242 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	translationApp "github.com/francknouama/movies-mcp-server/internal/application/translation"
+)
+
+// MockTranslationService is a mock implementation of TranslationService and MovieLocalizer
+type MockTranslationService struct {
+	AddTranslationFunc   func(ctx context.Context, cmd translationApp.AddTranslationCommand) (*translationApp.TranslationDTO, error)
+	ListTranslationsFunc func(ctx context.Context, movieID int) (*translationApp.MovieTranslationsDTO, error)
+	LocalizeFunc         func(ctx context.Context, language string, movieIDs []int) (map[int]*translationApp.TranslationDTO, error)
+}
+
+func (m *MockTranslationService) AddTranslation(ctx context.Context, cmd translationApp.AddTranslationCommand) (*translationApp.TranslationDTO, error) {
+	if m.AddTranslationFunc != nil {
+		return m.AddTranslationFunc(ctx, cmd)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockTranslationService) ListTranslations(ctx context.Context, movieID int) (*translationApp.MovieTranslationsDTO, error) {
+	if m.ListTranslationsFunc != nil {
+		return m.ListTranslationsFunc(ctx, movieID)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockTranslationService) Localize(ctx context.Context, language string, movieIDs []int) (map[int]*translationApp.TranslationDTO, error) {
+	if m.LocalizeFunc != nil {
+		return m.LocalizeFunc(ctx, language, movieIDs)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func TestAddTranslation_Success(t *testing.T) {
+	var gotCmd translationApp.AddTranslationCommand
+	service := &MockTranslationService{
+		AddTranslationFunc: func(ctx context.Context, cmd translationApp.AddTranslationCommand) (*translationApp.TranslationDTO, error) {
+			gotCmd = cmd
+			return &translationApp.TranslationDTO{MovieID: cmd.MovieID, Language: "fr", Title: cmd.Title}, nil
+		},
+	}
+	tools := NewTranslationTools(service)
+
+	result, output, err := tools.AddTranslation(context.Background(), nil, AddTranslationInput{
+		MovieID:  1,
+		Language: "FR",
+		Title:    "Le Parrain",
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if gotCmd.Language != "FR" || gotCmd.Title != "Le Parrain" {
+		t.Errorf("Expected command to carry the input, got: %+v", gotCmd)
+	}
+	if output.Language != "fr" {
+		t.Errorf("Expected normalized language fr, got: %s", output.Language)
+	}
+	assertSummaryResult(t, result)
+	summary := result.Content[1].(*mcp.TextContent).Text
+	if summary != `Saved fr translation of movie 1: "Le Parrain"` {
+		t.Errorf("Unexpected summary, got: %s", summary)
+	}
+}
+
+func TestAddTranslation_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input AddTranslationInput
+		err   error
+	}{
+		{name: "missing language", input: AddTranslationInput{MovieID: 1, Title: "Le Parrain"}},
+		{name: "service error", input: AddTranslationInput{MovieID: 99, Language: "fr", Title: "Le Parrain"}, err: errors.New("movie not found")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &MockTranslationService{
+				AddTranslationFunc: func(ctx context.Context, cmd translationApp.AddTranslationCommand) (*translationApp.TranslationDTO, error) {
+					return nil, tt.err
+				},
+			}
+			tools := NewTranslationTools(service)
+
+			err := tt.input.Validate() // Run by the validation middleware before the handler
+			if err == nil {
+				_, _, err = tools.AddTranslation(context.Background(), nil, tt.input)
+			}
+			if err == nil {
+				t.Error("Expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestListTranslations_Success(t *testing.T) {
+	service := &MockTranslationService{
+		ListTranslationsFunc: func(ctx context.Context, movieID int) (*translationApp.MovieTranslationsDTO, error) {
+			return &translationApp.MovieTranslationsDTO{
+				MovieID: movieID,
+				Title:   "The Godfather",
+				Year:    1972,
+				Translations: []*translationApp.TranslationDTO{
+					{MovieID: movieID, Language: "de", Title: "Der Pate"},
+					{MovieID: movieID, Language: "fr", Title: "Le Parrain"},
+				},
+			}, nil
+		},
+	}
+	tools := NewTranslationTools(service)
+
+	result, output, err := tools.ListTranslations(context.Background(), nil, ListTranslationsInput{MovieID: 1})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Total != 2 || output.Translations[1].Title != "Le Parrain" {
+		t.Errorf("Expected 2 translations, got: %+v", output)
+	}
+	assertSummaryResult(t, result)
+	summary := result.Content[1].(*mcp.TextContent).Text
+	if summary != "The Godfather (1972) has 2 translations: de, fr" {
+		t.Errorf("Unexpected summary, got: %s", summary)
+	}
+}
+
+func TestListTranslations_ServiceError(t *testing.T) {
+	service := &MockTranslationService{
+		ListTranslationsFunc: func(ctx context.Context, movieID int) (*translationApp.MovieTranslationsDTO, error) {
+			return nil, errors.New("movie not found")
+		},
+	}
+	tools := NewTranslationTools(service)
+
+	_, _, err := tools.ListTranslations(context.Background(), nil, ListTranslationsInput{MovieID: 99})
+
+	if err == nil || !strings.Contains(err.Error(), "failed to list translations") {
+		t.Errorf("Expected list translations error, got: %v", err)
+	}
+}
+
+func TestGetMovie_Localized(t *testing.T) {
+	var gotLanguage string
+	localizer := &MockTranslationService{
+		LocalizeFunc: func(ctx context.Context, language string, movieIDs []int) (map[int]*translationApp.TranslationDTO, error) {
+			gotLanguage = language
+			return map[int]*translationApp.TranslationDTO{
+				1: {MovieID: 1, Language: "fr", Title: "Le Parrain", Description: "La saga Corleone"},
+			}, nil
+		},
+	}
+	mockService := &MockMovieService{
+		GetMovieFunc: func(ctx context.Context, id int) (*movieApp.MovieDTO, error) {
+			return &movieApp.MovieDTO{ID: id, Title: "The Godfather", Director: "Francis Ford Coppola", Year: 1972}, nil
+		},
+	}
+
+	tools := NewMovieTools(mockService)
+	tools.SetLocalizer(localizer)
+
+	_, output, err := tools.GetMovie(context.Background(), nil, GetMovieInput{MovieID: 1, Language: "fr-CA"})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if gotLanguage != "fr-CA" {
+		t.Errorf("Expected requested language to reach the localizer, got: %s", gotLanguage)
+	}
+	if output.Title != "Le Parrain" || output.OriginalTitle != "The Godfather" {
+		t.Errorf("Expected localized title with original kept, got: %s (%s)", output.Title, output.OriginalTitle)
+	}
+	if output.Description != "La saga Corleone" || output.Language != "fr" {
+		t.Errorf("Expected French description, got: %s (%s)", output.Description, output.Language)
+	}
+}
+
+func TestSearchMovies_LocalizedKeepsUntranslated(t *testing.T) {
+	localizer := &MockTranslationService{
+		LocalizeFunc: func(ctx context.Context, language string, movieIDs []int) (map[int]*translationApp.TranslationDTO, error) {
+			return map[int]*translationApp.TranslationDTO{
+				2: {MovieID: 2, Language: "de", Description: "Eine Gangstergeschichte"},
+			}, nil
+		},
+	}
+	mockService := &MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			return []*movieApp.MovieDTO{
+				{ID: 1, Title: "The Godfather", Year: 1972},
+				{ID: 2, Title: "Goodfellas", Year: 1990},
+			}, nil
+		},
+	}
+
+	tools := NewMovieTools(mockService)
+	tools.SetLocalizer(localizer)
+
+	_, output, err := tools.SearchMovies(context.Background(), nil, SearchMoviesInput{Language: "de"})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Movies[0].Title != "The Godfather" || output.Movies[0].Language != "" {
+		t.Errorf("Expected untranslated movie unchanged, got: %+v", output.Movies[0])
+	}
+	if output.Movies[1].Title != "Goodfellas" || output.Movies[1].OriginalTitle != "" {
+		t.Errorf("Expected title kept when only the description is translated, got: %+v", output.Movies[1])
+	}
+	if output.Movies[1].Description != "Eine Gangstergeschichte" {
+		t.Errorf("Expected German description, got: %s", output.Movies[1].Description)
+	}
+}
+
+func TestSearchMovies_LocalizeError(t *testing.T) {
+	localizer := &MockTranslationService{
+		LocalizeFunc: func(ctx context.Context, language string, movieIDs []int) (map[int]*translationApp.TranslationDTO, error) {
+			return nil, errors.New("invalid language")
+		},
+	}
+	mockService := &MockMovieService{
+		SearchMoviesFunc: func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			return []*movieApp.MovieDTO{{ID: 1, Title: "The Godfather"}}, nil
+		},
+	}
+
+	tools := NewMovieTools(mockService)
+	tools.SetLocalizer(localizer)
+
+	_, _, err := tools.SearchMovies(context.Background(), nil, SearchMoviesInput{Language: "klingon"})
+
+	if err == nil || !strings.Contains(err.Error(), "failed to localize movies") {
+		t.Errorf("Expected localize error, got: %v", err)
+	}
+}
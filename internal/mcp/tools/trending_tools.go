@@ -0,0 +1,148 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	popularityApp "github.com/francknouama/movies-mcp-server/internal/application/popularity"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+const (
+	// defaultTrendingDays is the period trending_movies counts over by default
+	defaultTrendingDays = 7
+	// defaultTrendingMovies is how many movies trending_movies returns by default
+	defaultTrendingMovies = 10
+)
+
+// AccessRecorder counts the movies tools return towards their popularity
+type AccessRecorder interface {
+	RecordViews(ctx context.Context, movieIDs ...int) error
+	RecordSearchHits(ctx context.Context, movieIDs []int) error
+}
+
+// recordView counts a movie a tool returned on its own. Access counts are
+// best effort: a failed write never fails the read that caused it.
+func recordView(ctx context.Context, access AccessRecorder, movieID int) {
+	if access != nil {
+		_ = access.RecordViews(ctx, movieID)
+	}
+}
+
+// recordSearchHits counts the movies a search returned, as recordView does
+func recordSearchHits(ctx context.Context, access AccessRecorder, movies []MovieOutput) {
+	if access == nil || len(movies) == 0 {
+		return
+	}
+	ids := make([]int, len(movies))
+	for i, movie := range movies {
+		ids[i] = movie.ID
+	}
+	_ = access.RecordSearchHits(ctx, ids)
+}
+
+// TrendingService defines the interface for trending movie lookups
+type TrendingService interface {
+	Trending(ctx context.Context, days, limit int) (*popularityApp.TrendingDTO, error)
+}
+
+// TrendingTools provides SDK-based MCP handlers for movie popularity
+type TrendingTools struct {
+	service TrendingService
+}
+
+// NewTrendingTools creates a new trending tools instance
+func NewTrendingTools(service TrendingService) *TrendingTools {
+	return &TrendingTools{
+		service: service,
+	}
+}
+
+// ===== trending_movies Tool =====
+
+// TrendingMoviesInput defines the input schema for trending_movies tool
+type TrendingMoviesInput struct {
+	Days  int `json:"days,omitempty" jsonschema:"Number of days to count accesses over, ending today (default 7, max 365)"`
+	Limit int `json:"limit,omitempty" jsonschema:"Maximum number of movies (default 10, max 100)"`
+}
+
+// Validate bounds the period and the limit
+func (in TrendingMoviesInput) Validate() error {
+	if in.Days < 0 || in.Days > popularityApp.MaxTrendingDays {
+		return shared.NewValidationError("days must be between 1 and %d", popularityApp.MaxTrendingDays)
+	}
+	if in.Limit < 0 || in.Limit > popularityApp.MaxTrendingMovies {
+		return shared.NewValidationError("limit must be between 1 and %d", popularityApp.MaxTrendingMovies)
+	}
+	return nil
+}
+
+// TrendingMovieOutput defines the output schema for a trending movie
+type TrendingMovieOutput struct {
+	ID         int      `json:"id" jsonschema:"Movie ID"`
+	Title      string   `json:"title" jsonschema:"Movie title"`
+	Director   string   `json:"director" jsonschema:"Movie director"`
+	Year       int      `json:"year" jsonschema:"Release year"`
+	Rating     float64  `json:"rating,omitempty" jsonschema:"Movie rating (0-10)"`
+	Genres     []string `json:"genres" jsonschema:"Movie genres"`
+	Views      int      `json:"views" jsonschema:"Times a tool returned the movie on its own, as get_movie does"`
+	SearchHits int      `json:"search_hits" jsonschema:"Times the movie was among search results"`
+	Score      float64  `json:"score" jsonschema:"Views plus a quarter of search hits, which the movies are ranked by"`
+}
+
+// TrendingMoviesOutput defines the output schema for trending_movies tool
+type TrendingMoviesOutput struct {
+	Days   int                   `json:"days" jsonschema:"Number of days counted"`
+	Since  string                `json:"since" jsonschema:"First day counted (YYYY-MM-DD, UTC)"`
+	Movies []TrendingMovieOutput `json:"movies" jsonschema:"Most accessed movies first"`
+}
+
+// TrendingMovies handles the trending_movies tool call
+func (t *TrendingTools) TrendingMovies(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input TrendingMoviesInput,
+) (*mcp.CallToolResult, TrendingMoviesOutput, error) {
+	days := input.Days
+	if days == 0 {
+		days = defaultTrendingDays
+	}
+	limit := input.Limit
+	if limit == 0 {
+		limit = defaultTrendingMovies
+	}
+
+	dto, err := t.service.Trending(ctx, days, limit)
+	if err != nil {
+		return nil, TrendingMoviesOutput{}, fmt.Errorf("failed to get trending movies: %w", err)
+	}
+
+	output := TrendingMoviesOutput{
+		Days:   dto.Days,
+		Since:  dto.Since,
+		Movies: make([]TrendingMovieOutput, len(dto.Movies)),
+	}
+	names := make([]string, len(dto.Movies))
+	for i, movie := range dto.Movies {
+		output.Movies[i] = TrendingMovieOutput{
+			ID:         movie.ID,
+			Title:      movie.Title,
+			Director:   movie.Director,
+			Year:       movie.Year,
+			Rating:     movie.Rating,
+			Genres:     nonNilStrings(movie.Genres),
+			Views:      movie.Views,
+			SearchHits: movie.SearchHits,
+			Score:      movie.Score,
+		}
+		names[i] = fmt.Sprintf("%s (%d, %g)", movie.Title, movie.Year, movie.Score)
+	}
+
+	if len(names) == 0 {
+		return summaryResult(ctx, output, "No movies accessed since %s", output.Since), output, nil
+	}
+	return summaryResult(ctx, output, "%s trending since %s%s", countNoun(ctx, len(names), "movie", "movies"),
+		output.Since, listSummary(ctx, names)), output, nil
+}
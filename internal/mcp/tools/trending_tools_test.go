@@ -0,0 +1,133 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	popularityApp "github.com/francknouama/movies-mcp-server/internal/application/popularity"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+)
+
+// MockTrendingService is a mock implementation of TrendingService
+type MockTrendingService struct {
+	TrendingFunc func(ctx context.Context, days, limit int) (*popularityApp.TrendingDTO, error)
+}
+
+func (m *MockTrendingService) Trending(ctx context.Context, days, limit int) (*popularityApp.TrendingDTO, error) {
+	if m.TrendingFunc != nil {
+		return m.TrendingFunc(ctx, days, limit)
+	}
+	return nil, errors.New("not implemented")
+}
+
+// fakeAccessRecorder records the movies counted towards their popularity
+type fakeAccessRecorder struct {
+	views      []int
+	searchHits []int
+	err        error
+}
+
+func (f *fakeAccessRecorder) RecordViews(ctx context.Context, movieIDs ...int) error {
+	f.views = append(f.views, movieIDs...)
+	return f.err
+}
+
+func (f *fakeAccessRecorder) RecordSearchHits(ctx context.Context, movieIDs []int) error {
+	f.searchHits = append(f.searchHits, movieIDs...)
+	return f.err
+}
+
+func TestTrendingMovies_Success(t *testing.T) {
+	var gotDays, gotLimit int
+	tools := NewTrendingTools(&MockTrendingService{
+		TrendingFunc: func(ctx context.Context, days, limit int) (*popularityApp.TrendingDTO, error) {
+			gotDays, gotLimit = days, limit
+			return &popularityApp.TrendingDTO{
+				Days:  days,
+				Since: "2026-03-08",
+				Movies: []*popularityApp.TrendingMovieDTO{
+					{ID: 1, Title: "Heat", Director: "Michael Mann", Year: 1995, Genres: []string{"Crime"}, Views: 4, SearchHits: 2, Score: 4.5},
+					{ID: 2, Title: "Alien", Director: "Ridley Scott", Year: 1979, SearchHits: 3, Score: 0.75},
+				},
+			}, nil
+		},
+	})
+
+	result, output, err := tools.TrendingMovies(context.Background(), nil, TrendingMoviesInput{})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if gotDays != defaultTrendingDays || gotLimit != defaultTrendingMovies {
+		t.Errorf("Expected the default period and limit, got %d days and %d movies", gotDays, gotLimit)
+	}
+	if len(output.Movies) != 2 || output.Movies[0].Views != 4 || output.Movies[1].Genres == nil {
+		t.Errorf("Unexpected output: %+v", output)
+	}
+	summary := result.Content[1].(*mcp.TextContent).Text
+	if want := "2 movies trending since 2026-03-08: Heat (1995, 4.5), Alien (1979, 0.75)"; summary != want {
+		t.Errorf("Unexpected summary: %q", summary)
+	}
+	validateAgainstSchema(t, OutputSchema[TrendingMoviesOutput](), output)
+}
+
+func TestTrendingMovies_NoneAccessed(t *testing.T) {
+	tools := NewTrendingTools(&MockTrendingService{
+		TrendingFunc: func(ctx context.Context, days, limit int) (*popularityApp.TrendingDTO, error) {
+			return &popularityApp.TrendingDTO{Days: days, Since: "2026-03-14", Movies: []*popularityApp.TrendingMovieDTO{}}, nil
+		},
+	})
+
+	result, output, err := tools.TrendingMovies(context.Background(), nil, TrendingMoviesInput{Days: 1})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Movies == nil {
+		t.Error("Expected an empty list rather than null")
+	}
+	if summary := result.Content[1].(*mcp.TextContent).Text; summary != "No movies accessed since 2026-03-14" {
+		t.Errorf("Unexpected summary: %q", summary)
+	}
+}
+
+func TestTrendingMoviesInput_Validate(t *testing.T) {
+	if err := (TrendingMoviesInput{Days: popularityApp.MaxTrendingDays, Limit: popularityApp.MaxTrendingMovies}).Validate(); err != nil {
+		t.Errorf("Expected a valid input, got: %v", err)
+	}
+	for _, input := range []TrendingMoviesInput{{Days: popularityApp.MaxTrendingDays + 1}, {Limit: -1}} {
+		if err := input.Validate(); !errors.Is(err, shared.ErrValidation) {
+			t.Errorf("Validate(%+v) error = %v, want a validation error", input, err)
+		}
+	}
+}
+
+func TestMovieTools_RecordAccess(t *testing.T) {
+	mockService := &MockMovieService{
+		GetMovieFunc: func(ctx context.Context, id int) (*movieApp.MovieDTO, error) {
+			return &movieApp.MovieDTO{ID: id, Title: "Heat", Director: "Michael Mann", Year: 1995}, nil
+		},
+		SearchMoviesFunc: func(ctx context.Context, query movieApp.SearchMoviesQuery) ([]*movieApp.MovieDTO, error) {
+			return []*movieApp.MovieDTO{{ID: 1, Title: "Heat"}, {ID: 2, Title: "Collateral"}}, nil
+		},
+	}
+	access := &fakeAccessRecorder{err: errors.New("database is locked")}
+	tools := NewMovieTools(mockService)
+	tools.SetAccessRecorder(access)
+
+	// A failed count does not fail the reads
+	if _, _, err := tools.GetMovie(context.Background(), nil, GetMovieInput{MovieID: 1}); err != nil {
+		t.Fatalf("GetMovie() error = %v", err)
+	}
+	if _, _, err := tools.SearchMovies(context.Background(), nil, SearchMoviesInput{Director: "Mann"}); err != nil {
+		t.Fatalf("SearchMovies() error = %v", err)
+	}
+	if len(access.views) != 1 || access.views[0] != 1 {
+		t.Errorf("Expected a view of movie 1, got %v", access.views)
+	}
+	if len(access.searchHits) != 2 || access.searchHits[0] != 1 || access.searchHits[1] != 2 {
+		t.Errorf("Expected search hits for movies 1 and 2, got %v", access.searchHits)
+	}
+}
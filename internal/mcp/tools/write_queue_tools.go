@@ -0,0 +1,231 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/application/writequeue"
+	"github.com/francknouama/movies-mcp-server/internal/domain/shared"
+	"github.com/francknouama/movies-mcp-server/pkg/database"
+	"github.com/francknouama/movies-mcp-server/pkg/serialization"
+)
+
+// WriteQueue defines the interface for queued write operations
+type WriteQueue interface {
+	Submit(op writequeue.Operation) (writequeue.Ticket, error)
+	Status(token string) (writequeue.Ticket, bool)
+	Pending() int
+}
+
+// WriteQueueTools provides SDK-based MCP handlers for asynchronous writes
+type WriteQueueTools struct {
+	movieService MovieService
+	queue        WriteQueue
+}
+
+// NewWriteQueueTools creates a new write queue tools instance
+func NewWriteQueueTools(movieService MovieService, queue WriteQueue) *WriteQueueTools {
+	return &WriteQueueTools{
+		movieService: movieService,
+		queue:        queue,
+	}
+}
+
+// WriteStatusOutput defines the common output schema for queued write status
+type WriteStatusOutput struct {
+	Token       string `json:"token" jsonschema:"Acknowledgment token for the queued write"`
+	Operation   string `json:"operation" jsonschema:"Queued operation (add/update/delete)"`
+	EntityKey   string `json:"entity_key" jsonschema:"Entity the write applies to"`
+	Status      string `json:"status" jsonschema:"Write status (pending/applied/failed)"`
+	MovieID     int    `json:"movie_id,omitempty" jsonschema:"ID of the affected movie once applied"`
+	Error       string `json:"error,omitempty" jsonschema:"Failure reason when status is failed"`
+	SubmittedAt string `json:"submitted_at" jsonschema:"Time the write was queued"`
+	CompletedAt string `json:"completed_at,omitempty" jsonschema:"Time the write was applied or failed"`
+	QueueDepth  int    `json:"queue_depth" jsonschema:"Number of writes waiting to be applied"`
+}
+
+// newWriteStatusOutput converts a queue ticket to the output format
+func (t *WriteQueueTools) newWriteStatusOutput(ticket writequeue.Ticket) WriteStatusOutput {
+	output := WriteStatusOutput{
+		Token:       ticket.Token,
+		Operation:   ticket.Kind,
+		EntityKey:   ticket.EntityKey,
+		Status:      string(ticket.Status),
+		MovieID:     ticket.EntityID,
+		Error:       ticket.Error,
+		SubmittedAt: serialization.Timestamp(ticket.SubmittedAt),
+		QueueDepth:  t.queue.Pending(),
+	}
+	if !ticket.CompletedAt.IsZero() {
+		output.CompletedAt = serialization.Timestamp(ticket.CompletedAt)
+	}
+	return output
+}
+
+// ===== queue_movie_write Tool =====
+
+// QueueMovieWriteInput defines the input schema for queue_movie_write tool.
+// An update changes only the fields sent, as update_movie does.
+type QueueMovieWriteInput struct {
+	Operation string   `json:"operation" jsonschema:"Write operation (add/update/delete)"`
+	MovieID   int      `json:"movie_id,omitempty" jsonschema:"Movie ID (required for update and delete)"`
+	Title     *string  `json:"title,omitempty" jsonschema:"Movie title"`
+	Director  *string  `json:"director,omitempty" jsonschema:"Movie director"`
+	Year      *int     `json:"year,omitempty" jsonschema:"Release year"`
+	Rating    *float64 `json:"rating,omitempty" jsonschema:"Movie rating (0-10); on update, 0 clears it"`
+	Genres    []string `json:"genres,omitzero" jsonschema:"List of genres; on update, replacing the current ones, and [] clears them"`
+	PosterURL *string  `json:"poster_url,omitempty" jsonschema:"URL to movie poster; on update, empty removes it"`
+
+	Certifications  map[string]string `json:"certifications,omitzero" jsonschema:"Age ratings keyed by region (US: MPAA G/PG/PG-13/R/NC-17; GB: BBFC U/PG/12A/12/15/18/R18); on update, replacing the current ones, and {} clears them"`
+	ContentWarnings []string          `json:"content_warnings,omitzero" jsonschema:"Content warnings such as violence or strong language; on update, replacing the current ones, and [] clears them"`
+	ReleaseDate     *string           `json:"release_date,omitempty" jsonschema:"Release date (YYYY-MM-DD), in year when both are given; on update, empty clears it"`
+	Duration        *int              `json:"duration,omitempty" jsonschema:"Runtime in minutes; on update, 0 clears it"`
+}
+
+// QueueMovieWrite handles the queue_movie_write tool call
+func (t *WriteQueueTools) QueueMovieWrite(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input QueueMovieWriteInput,
+) (*mcp.CallToolResult, WriteStatusOutput, error) {
+	op, err := t.buildMovieOperation(input)
+	if err != nil {
+		return nil, WriteStatusOutput{}, err
+	}
+
+	// The worker applies the write later, to the tenant library it was queued for
+	apply := op.Apply
+	op.Apply = func(workerCtx context.Context) (int, error) {
+		return apply(database.CopyTenant(workerCtx, ctx))
+	}
+
+	ticket, err := t.queue.Submit(op)
+	if err != nil {
+		return nil, WriteStatusOutput{}, fmt.Errorf("failed to queue write: %w", err)
+	}
+
+	output := t.newWriteStatusOutput(ticket)
+	return summaryResult(ctx, output, "Queued %s for %s with token %s (%d waiting)", output.Operation, output.EntityKey, output.Token, output.QueueDepth), output, nil
+}
+
+// buildMovieOperation maps the tool input onto a queued movie mutation
+func (t *WriteQueueTools) buildMovieOperation(input QueueMovieWriteInput) (writequeue.Operation, error) {
+	releaseDate, err := parseMovieReleaseDate(valueOf(input.ReleaseDate))
+	if err != nil {
+		return writequeue.Operation{}, err
+	}
+
+	switch input.Operation {
+	case "add":
+		cmd := movieApp.CreateMovieCommand{
+			Title:       valueOf(input.Title),
+			Director:    valueOf(input.Director),
+			Year:        valueOf(input.Year),
+			ReleaseDate: releaseDate,
+			Rating:      valueOf(input.Rating),
+			Duration:    valueOf(input.Duration),
+			Genres:      input.Genres,
+			PosterURL:   valueOf(input.PosterURL),
+
+			Certifications:  input.Certifications,
+			ContentWarnings: input.ContentWarnings,
+		}
+		return writequeue.Operation{
+			Kind:      "add",
+			EntityKey: "movie:new",
+			Apply: func(ctx context.Context) (int, error) {
+				movieDTO, err := t.movieService.CreateMovie(ctx, cmd)
+				if err != nil {
+					return 0, fmt.Errorf("failed to create movie: %w", err)
+				}
+				return movieDTO.ID, nil
+			},
+		}, nil
+
+	case "update":
+		if input.MovieID <= 0 {
+			return writequeue.Operation{}, shared.NewValidationError("movie_id is required for update")
+		}
+		// Fields left out keep their current value, as with update_movie
+		cmd := movieApp.UpdateMovieCommand{
+			ID:        input.MovieID,
+			Title:     input.Title,
+			Director:  input.Director,
+			Year:      input.Year,
+			Rating:    input.Rating,
+			Duration:  input.Duration,
+			Genres:    input.Genres,
+			PosterURL: input.PosterURL,
+
+			Certifications:  input.Certifications,
+			ContentWarnings: input.ContentWarnings,
+		}
+		if input.ReleaseDate != nil {
+			cmd.ReleaseDate = &releaseDate
+		}
+		return writequeue.Operation{
+			Kind:      "update",
+			EntityKey: fmt.Sprintf("movie:%d", input.MovieID),
+			Apply: func(ctx context.Context) (int, error) {
+				movieDTO, err := t.movieService.UpdateMovie(ctx, cmd)
+				if err != nil {
+					return 0, fmt.Errorf("failed to update movie: %w", err)
+				}
+				return movieDTO.ID, nil
+			},
+		}, nil
+
+	case "delete":
+		if input.MovieID <= 0 {
+			return writequeue.Operation{}, shared.NewValidationError("movie_id is required for delete")
+		}
+		movieID := input.MovieID
+		return writequeue.Operation{
+			Kind:      "delete",
+			EntityKey: fmt.Sprintf("movie:%d", movieID),
+			Apply: func(ctx context.Context) (int, error) {
+				if err := t.movieService.DeleteMovie(ctx, movieID); err != nil {
+					return 0, fmt.Errorf("failed to delete movie: %w", err)
+				}
+				return movieID, nil
+			},
+		}, nil
+
+	default:
+		return writequeue.Operation{}, shared.NewValidationError("invalid operation: %s (must be add, update or delete)", input.Operation)
+	}
+}
+
+// valueOf returns what value points to, or the zero value when it is nil
+func valueOf[T any](value *T) T {
+	if value == nil {
+		var zero T
+		return zero
+	}
+	return *value
+}
+
+// ===== get_write_status Tool =====
+
+// GetWriteStatusInput defines the input schema for get_write_status tool
+type GetWriteStatusInput struct {
+	Token string `json:"token" jsonschema:"Acknowledgment token returned by queue_movie_write"`
+}
+
+// GetWriteStatus handles the get_write_status tool call
+func (t *WriteQueueTools) GetWriteStatus(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input GetWriteStatusInput,
+) (*mcp.CallToolResult, WriteStatusOutput, error) {
+	ticket, exists := t.queue.Status(input.Token)
+	if !exists {
+		return nil, WriteStatusOutput{}, shared.NewNotFoundError("write not found: %s", input.Token)
+	}
+
+	output := t.newWriteStatusOutput(ticket)
+	return summaryResult(ctx, output, "Write %s (%s %s) is %s", output.Token, output.Operation, output.EntityKey, output.Status), output, nil
+}
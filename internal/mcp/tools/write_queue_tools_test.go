@@ -0,0 +1,204 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	"github.com/francknouama/movies-mcp-server/internal/application/writequeue"
+)
+
+func newTestWriteQueue(t *testing.T) *writequeue.Queue {
+	t.Helper()
+
+	q := writequeue.New(writequeue.Config{FlushInterval: time.Millisecond})
+	q.Start(context.Background())
+	t.Cleanup(q.Close)
+	return q
+}
+
+func waitForWrite(t *testing.T, tools *WriteQueueTools, token string) WriteStatusOutput {
+	t.Helper()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		_, output, err := tools.GetWriteStatus(context.Background(), nil, GetWriteStatusInput{Token: token})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if output.Status != string(writequeue.StatusPending) {
+			return output
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("Timed out waiting for write %s", token)
+	return WriteStatusOutput{}
+}
+
+func TestQueueMovieWrite_Add(t *testing.T) {
+	mockService := &MockMovieService{
+		CreateMovieFunc: func(ctx context.Context, cmd movieApp.CreateMovieCommand) (*movieApp.MovieDTO, error) {
+			return &movieApp.MovieDTO{ID: 7, Title: cmd.Title}, nil
+		},
+	}
+	tools := NewWriteQueueTools(mockService, newTestWriteQueue(t))
+
+	_, output, err := tools.QueueMovieWrite(context.Background(), nil, QueueMovieWriteInput{
+		Operation: "add",
+		Title:     ptr("Inception"),
+		Director:  ptr("Christopher Nolan"),
+		Year:      ptr(2010),
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Token == "" {
+		t.Fatal("Expected acknowledgment token")
+	}
+	if output.Operation != "add" {
+		t.Errorf("Expected operation 'add', got: %s", output.Operation)
+	}
+
+	result := waitForWrite(t, tools, output.Token)
+	if result.Status != "applied" {
+		t.Errorf("Expected status 'applied', got: %s", result.Status)
+	}
+	if result.MovieID != 7 {
+		t.Errorf("Expected movie ID 7, got: %d", result.MovieID)
+	}
+	if result.CompletedAt == "" {
+		t.Error("Expected completed_at to be set")
+	}
+}
+
+func TestQueueMovieWrite_UpdateFailure(t *testing.T) {
+	mockService := &MockMovieService{
+		UpdateMovieFunc: func(ctx context.Context, cmd movieApp.UpdateMovieCommand) (*movieApp.MovieDTO, error) {
+			return nil, errors.New("movie not found")
+		},
+	}
+	tools := NewWriteQueueTools(mockService, newTestWriteQueue(t))
+
+	_, output, err := tools.QueueMovieWrite(context.Background(), nil, QueueMovieWriteInput{
+		Operation: "update",
+		MovieID:   99,
+		Title:     ptr("Missing"),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.EntityKey != "movie:99" {
+		t.Errorf("Expected entity key 'movie:99', got: %s", output.EntityKey)
+	}
+
+	result := waitForWrite(t, tools, output.Token)
+	if result.Status != "failed" {
+		t.Errorf("Expected status 'failed', got: %s", result.Status)
+	}
+	if !strings.Contains(result.Error, "not found") {
+		t.Errorf("Expected not found error, got: %s", result.Error)
+	}
+}
+
+func TestQueueMovieWrite_UpdateOnlySentFields(t *testing.T) {
+	var got movieApp.UpdateMovieCommand
+	mockService := &MockMovieService{
+		UpdateMovieFunc: func(ctx context.Context, cmd movieApp.UpdateMovieCommand) (*movieApp.MovieDTO, error) {
+			got = cmd
+			return &movieApp.MovieDTO{ID: cmd.ID}, nil
+		},
+	}
+	tools := NewWriteQueueTools(mockService, newTestWriteQueue(t))
+
+	_, output, err := tools.QueueMovieWrite(context.Background(), nil, QueueMovieWriteInput{
+		Operation:   "update",
+		MovieID:     5,
+		Rating:      ptr(8.5),
+		ReleaseDate: ptr(""),
+		Genres:      []string{},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result := waitForWrite(t, tools, output.Token); result.Status != "applied" {
+		t.Fatalf("Expected status 'applied', got: %s (%s)", result.Status, result.Error)
+	}
+
+	if got.Rating == nil || *got.Rating != 8.5 {
+		t.Errorf("Expected the rating set to 8.5, got %v", got.Rating)
+	}
+	if got.ReleaseDate == nil || !got.ReleaseDate.IsZero() || got.Genres == nil || len(got.Genres) != 0 {
+		t.Errorf("Expected the release date and genres cleared, got %v, %v", got.ReleaseDate, got.Genres)
+	}
+	if got.Title != nil || got.Director != nil || got.Year != nil || got.Duration != nil || got.PosterURL != nil {
+		t.Errorf("Expected fields left out to stay unset, got %+v", got)
+	}
+	if got.Certifications != nil || got.ContentWarnings != nil {
+		t.Errorf("Expected certifications and warnings left as they are, got %v, %v", got.Certifications, got.ContentWarnings)
+	}
+}
+
+func TestQueueMovieWrite_Delete(t *testing.T) {
+	var deletedID int
+	mockService := &MockMovieService{
+		DeleteMovieFunc: func(ctx context.Context, id int) error {
+			deletedID = id
+			return nil
+		},
+	}
+	tools := NewWriteQueueTools(mockService, newTestWriteQueue(t))
+
+	_, output, err := tools.QueueMovieWrite(context.Background(), nil, QueueMovieWriteInput{
+		Operation: "delete",
+		MovieID:   3,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	result := waitForWrite(t, tools, output.Token)
+	if result.Status != "applied" {
+		t.Errorf("Expected status 'applied', got: %s", result.Status)
+	}
+	if deletedID != 3 {
+		t.Errorf("Expected movie 3 to be deleted, got: %d", deletedID)
+	}
+}
+
+func TestQueueMovieWrite_Validation(t *testing.T) {
+	tools := NewWriteQueueTools(&MockMovieService{}, newTestWriteQueue(t))
+
+	tests := []struct {
+		name  string
+		input QueueMovieWriteInput
+	}{
+		{name: "unknown operation", input: QueueMovieWriteInput{Operation: "upsert"}},
+		{name: "update without movie id", input: QueueMovieWriteInput{Operation: "update"}},
+		{name: "delete without movie id", input: QueueMovieWriteInput{Operation: "delete"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := tools.QueueMovieWrite(context.Background(), nil, tt.input)
+			if err == nil {
+				t.Error("Expected validation error")
+			}
+		})
+	}
+}
+
+func TestGetWriteStatus_UnknownToken(t *testing.T) {
+	tools := NewWriteQueueTools(&MockMovieService{}, newTestWriteQueue(t))
+
+	_, _, err := tools.GetWriteStatus(context.Background(), nil, GetWriteStatusInput{Token: "missing"})
+	if err == nil {
+		t.Fatal("Expected error for unknown token")
+	}
+	if !strings.Contains(err.Error(), "write not found") {
+		t.Errorf("Expected 'write not found' error, got: %v", err)
+	}
+}
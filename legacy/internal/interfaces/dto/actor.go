@@ -1,3 +1,5 @@
+//go:build legacy
+
 // Package dto provides data transfer objects for the movies MCP server.
 package dto
 
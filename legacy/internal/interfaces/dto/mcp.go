@@ -1,3 +1,5 @@
+//go:build legacy
+
 package dto
 
 import (
@@ -36,6 +38,8 @@ type ResourcesListResponse = protocol.ResourcesListResponse
 type ResourceReadRequest = protocol.ResourceReadRequest
 type ResourceReadResponse = protocol.ResourceReadResponse
 type ResourceContent = protocol.ResourceContent
+type ResourceTemplate = protocol.ResourceTemplate
+type ResourceTemplatesListResponse = protocol.ResourceTemplatesListResponse
 
 // Prompt and related types - using shared library.
 type Prompt = protocol.Prompt
@@ -46,20 +50,6 @@ type PromptGetResponse = protocol.PromptGetResponse
 type PromptMessage = protocol.PromptMessage
 type PromptMessageContent = protocol.PromptMessageContent
 
-// Note: ResourceTemplate not yet in shared library - keep local definition.
-// ResourceTemplate represents an MCP resource template.
-type ResourceTemplate struct {
-	URITemplate string `json:"uriTemplate"`
-	Name        string `json:"name"`
-	Description string `json:"description,omitempty"`
-	MimeType    string `json:"mimeType,omitempty"`
-}
-
-// ResourceTemplatesListResponse represents the response for resources/templates/list.
-type ResourceTemplatesListResponse struct {
-	ResourceTemplates []ResourceTemplate `json:"resourceTemplates"`
-}
-
 // Error codes - using shared library constants.
 const (
 	ParseError     = protocol.ParseError
@@ -1,3 +1,5 @@
+//go:build legacy
+
 package dto
 
 // CreateMovieRequest represents the MCP request to create a movie.
@@ -1,13 +1,15 @@
+//go:build legacy
+
 package mcp
 
 import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strconv"
 	"strings"
 
 	movieApp "github.com/francknouama/movies-mcp-server/internal/application/movie"
+	movieDomain "github.com/francknouama/movies-mcp-server/internal/domain/movie"
 	"github.com/francknouama/movies-mcp-server/internal/interfaces/dto"
 )
 
@@ -202,7 +204,7 @@ func (h *MovieHandlers) HandleSearchByDecade(id any, arguments map[string]any, s
 	}
 
 	// Parse decade to year range
-	minYear, maxYear, err := h.parseDecade(decade)
+	parsed, err := movieDomain.ParseDecade(decade)
 	if err != nil {
 		sendError(id, dto.InvalidParams, "Invalid decade format", err.Error())
 		return
@@ -210,8 +212,8 @@ func (h *MovieHandlers) HandleSearchByDecade(id any, arguments map[string]any, s
 
 	// Search movies in decade
 	query := movieApp.SearchMoviesQuery{
-		MinYear:  minYear,
-		MaxYear:  maxYear,
+		MinYear:  parsed.Start(),
+		MaxYear:  parsed.End(),
 		Limit:    50,
 		OrderBy:  "year",
 		OrderDir: "asc",
@@ -228,7 +230,7 @@ func (h *MovieHandlers) HandleSearchByDecade(id any, arguments map[string]any, s
 	response := &dto.MoviesListResponse{
 		Movies:      make([]*dto.MovieResponse, len(movieDTOs)),
 		Total:       len(movieDTOs),
-		Description: fmt.Sprintf("Movies from the %s", decade),
+		Description: fmt.Sprintf("Movies from the %s", parsed),
 	}
 
 	for i, movieDTO := range movieDTOs {
@@ -356,42 +358,6 @@ func (h *MovieHandlers) parseSearchMoviesRequest(arguments map[string]any) (*dto
 	return &req, nil
 }
 
-func (h *MovieHandlers) parseDecade(decade string) (int, int, error) {
-	decade = strings.TrimSpace(decade)
-
-	// Handle formats like "1990s", "90s", "1990"
-	decade = strings.TrimSuffix(decade, "s")
-
-	var baseYear int
-	if len(decade) == 2 {
-		// Handle "90" -> 1990
-		year, err := strconv.Atoi(decade)
-		if err != nil {
-			return 0, 0, fmt.Errorf("invalid decade format")
-		}
-		if year >= 0 && year <= 30 {
-			baseYear = 2000 + year
-		} else {
-			baseYear = 1900 + year
-		}
-	} else if len(decade) == 4 {
-		// Handle "1990" -> 1990
-		year, err := strconv.Atoi(decade)
-		if err != nil {
-			return 0, 0, fmt.Errorf("invalid decade format")
-		}
-		baseYear = year
-	} else {
-		return 0, 0, fmt.Errorf("invalid decade format")
-	}
-
-	// Convert to decade boundaries
-	decadeStart := (baseYear / 10) * 10
-	decadeEnd := decadeStart + 9
-
-	return decadeStart, decadeEnd, nil
-}
-
 func (h *MovieHandlers) toMovieResponse(movieDTO *movieApp.MovieDTO) *dto.MovieResponse {
 	return &dto.MovieResponse{
 		ID:        movieDTO.ID,
@@ -1,3 +1,5 @@
+//go:build legacy
+
 package schemas
 
 import "github.com/francknouama/movies-mcp-server/internal/interfaces/dto"
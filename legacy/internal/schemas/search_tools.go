@@ -1,3 +1,5 @@
+//go:build legacy
+
 package schemas
 
 import "github.com/francknouama/movies-mcp-server/internal/interfaces/dto"
@@ -67,7 +69,7 @@ func searchByDecadeTool() dto.Tool {
 			Properties: map[string]dto.SchemaProperty{
 				"decade": {
 					Type:        "string",
-					Description: "Decade to search (e.g., '1990s', '2000s')",
+					Description: "Decade to search (e.g., '1990s', '90s', 'the nineties', '1990-1999')",
 				},
 			},
 			Required: []string{"decade"},
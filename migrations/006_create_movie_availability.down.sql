@@ -0,0 +1,6 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_movie_availability_provider;
+DROP INDEX IF EXISTS idx_movie_availability_movie_region;
+
+-- Drop table
+DROP TABLE IF EXISTS movie_availability;
@@ -0,0 +1,19 @@
+-- Create movie_availability table (SQLite version)
+-- One row per way a movie can be watched: a provider offering it in a region
+-- under an offer type (flatrate, rent, buy, free, ads)
+CREATE TABLE IF NOT EXISTS movie_availability (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    movie_id INTEGER NOT NULL,
+    provider TEXT NOT NULL,
+    region TEXT NOT NULL,
+    offer_type TEXT NOT NULL DEFAULT 'flatrate',
+    url TEXT,
+    last_checked TEXT NOT NULL,
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (movie_id, provider, region, offer_type),
+    FOREIGN KEY (movie_id) REFERENCES movies(id) ON DELETE CASCADE
+);
+
+-- Create indexes for lookups by movie and region
+CREATE INDEX IF NOT EXISTS idx_movie_availability_movie_region ON movie_availability(movie_id, region);
+CREATE INDEX IF NOT EXISTS idx_movie_availability_provider ON movie_availability(provider);
@@ -0,0 +1,7 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_franchise_movies_position;
+DROP INDEX IF EXISTS idx_franchise_movies_movie_id;
+
+-- Drop tables
+DROP TABLE IF EXISTS franchise_movies;
+DROP TABLE IF EXISTS franchises;
@@ -0,0 +1,24 @@
+-- Create franchises table (SQLite version)
+CREATE TABLE IF NOT EXISTS franchises (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE COLLATE NOCASE,
+    description TEXT,
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+    updated_at TEXT DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create franchise_movies junction table; position is the movie's place in
+-- chronological (story) order, release order is derived from movies.year
+CREATE TABLE IF NOT EXISTS franchise_movies (
+    franchise_id INTEGER NOT NULL,
+    movie_id INTEGER NOT NULL,
+    position INTEGER NOT NULL,
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (franchise_id, movie_id),
+    FOREIGN KEY (franchise_id) REFERENCES franchises(id) ON DELETE CASCADE,
+    FOREIGN KEY (movie_id) REFERENCES movies(id) ON DELETE CASCADE
+);
+
+-- Create indexes for better query performance
+CREATE INDEX IF NOT EXISTS idx_franchise_movies_movie_id ON franchise_movies(movie_id);
+CREATE INDEX IF NOT EXISTS idx_franchise_movies_position ON franchise_movies(franchise_id, position);
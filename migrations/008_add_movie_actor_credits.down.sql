@@ -0,0 +1,5 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_movie_actors_role;
+
+-- Drop column (requires SQLite 3.35+)
+ALTER TABLE movie_actors DROP COLUMN role_type;
@@ -0,0 +1,8 @@
+-- Add credit details to movie_actors (SQLite version)
+-- role (from migration 004) holds the character name and billing_order the
+-- position in the credits; role_type classifies the part
+
+ALTER TABLE movie_actors ADD COLUMN role_type TEXT;
+
+-- Character lookups (search_by_character)
+CREATE INDEX IF NOT EXISTS idx_movie_actors_role ON movie_actors(role);
@@ -0,0 +1,5 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_movie_translations_language;
+
+-- Drop table
+DROP TABLE IF EXISTS movie_translations;
@@ -0,0 +1,16 @@
+-- Create movie_translations table (SQLite version)
+-- One row per movie and language: an alternative title and/or a localized
+-- description. language is a BCP 47 tag such as "fr" or "pt-BR"
+CREATE TABLE IF NOT EXISTS movie_translations (
+    movie_id INTEGER NOT NULL,
+    language TEXT NOT NULL,
+    title TEXT,
+    description TEXT,
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+    updated_at TEXT DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (movie_id, language),
+    FOREIGN KEY (movie_id) REFERENCES movies(id) ON DELETE CASCADE
+);
+
+-- Create index for lookups of many movies in one language
+CREATE INDEX IF NOT EXISTS idx_movie_translations_language ON movie_translations(language);
@@ -0,0 +1,3 @@
+-- Drop columns (requires SQLite 3.35+)
+ALTER TABLE movies DROP COLUMN content_warnings;
+ALTER TABLE movies DROP COLUMN certifications;
@@ -0,0 +1,7 @@
+-- Add age certifications and content warnings to movies (SQLite version)
+
+-- JSON object of age ratings keyed by region code, e.g. {"US": "PG-13", "GB": "12A"}
+ALTER TABLE movies ADD COLUMN certifications TEXT NOT NULL DEFAULT '{}';
+
+-- JSON array of lower-case content warnings, e.g. ["violence", "strong language"]
+ALTER TABLE movies ADD COLUMN content_warnings TEXT NOT NULL DEFAULT '[]';
@@ -0,0 +1,5 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_movie_media_movie_type;
+
+-- Drop table
+DROP TABLE IF EXISTS movie_media;
@@ -0,0 +1,19 @@
+-- Create movie_media table (SQLite version)
+-- Trailers, teasers, clips and stills linked from a movie. At most one
+-- trailer per movie is flagged primary; without a flag the oldest trailer is
+-- used as the primary one.
+CREATE TABLE IF NOT EXISTS movie_media (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    movie_id INTEGER NOT NULL,
+    media_type TEXT NOT NULL DEFAULT 'trailer',
+    url TEXT NOT NULL,
+    provider TEXT NOT NULL,
+    title TEXT,
+    is_primary INTEGER NOT NULL DEFAULT 0,
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (movie_id, url),
+    FOREIGN KEY (movie_id) REFERENCES movies(id) ON DELETE CASCADE
+);
+
+-- Create index for lookups by movie and type
+CREATE INDEX IF NOT EXISTS idx_movie_media_movie_type ON movie_media(movie_id, media_type);
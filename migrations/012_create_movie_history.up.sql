@@ -0,0 +1,14 @@
+-- Create movie_history table (SQLite version)
+-- One row per change to a movie, numbered per movie from version 1. The diff
+-- column holds the changed fields as JSON ({"field": {"old": ..., "new": ...}}).
+-- There is no foreign key so a movie's history outlives the movie.
+CREATE TABLE IF NOT EXISTS movie_history (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    movie_id INTEGER NOT NULL,
+    version INTEGER NOT NULL,
+    operation TEXT NOT NULL,
+    diff TEXT NOT NULL,
+    reverted_to INTEGER,
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (movie_id, version) -- Also serves lookups by movie
+);
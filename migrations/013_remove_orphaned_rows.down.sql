@@ -0,0 +1,3 @@
+-- Nothing to undo: the removed rows referred to deleted movies, actors or
+-- franchises and cannot be restored
+SELECT 1;
@@ -0,0 +1,18 @@
+-- Remove orphaned rows (SQLite version)
+-- The foreign keys declared by earlier migrations only take effect on
+-- connections that enable them, so databases written before the server did
+-- may hold rows whose movie, actor or franchise was deleted. They are removed
+-- here, as ON DELETE CASCADE would have done. movie_history is left alone:
+-- it has no foreign key so a movie's history outlives the movie.
+
+DELETE FROM movie_actors
+WHERE movie_id NOT IN (SELECT id FROM movies)
+   OR actor_id NOT IN (SELECT id FROM actors);
+
+DELETE FROM franchise_movies
+WHERE movie_id NOT IN (SELECT id FROM movies)
+   OR franchise_id NOT IN (SELECT id FROM franchises);
+
+DELETE FROM movie_availability WHERE movie_id NOT IN (SELECT id FROM movies);
+DELETE FROM movie_translations WHERE movie_id NOT IN (SELECT id FROM movies);
+DELETE FROM movie_media WHERE movie_id NOT IN (SELECT id FROM movies);
@@ -0,0 +1,8 @@
+-- Drop summary triggers and tables
+DROP TRIGGER IF EXISTS movie_summaries_update;
+DROP TRIGGER IF EXISTS movie_summaries_delete;
+DROP TRIGGER IF EXISTS movie_summaries_insert;
+DROP TABLE IF EXISTS movie_certification_counts;
+DROP TABLE IF EXISTS movie_director_stats;
+DROP TABLE IF EXISTS movie_genre_counts;
+DROP TABLE IF EXISTS movie_library_stats;
@@ -0,0 +1,144 @@
+-- Create movie summary tables (SQLite version)
+-- Library-wide aggregates kept up to date by triggers on movies, so the stats
+-- resources read a few small rows instead of scanning every movie. A rating
+-- counts towards averages when it is above zero, as in the stats resource.
+-- JSON columns that do not parse count as empty.
+
+CREATE TABLE IF NOT EXISTS movie_library_stats (
+    id INTEGER PRIMARY KEY CHECK (id = 1), -- A single row
+    total_movies INTEGER NOT NULL DEFAULT 0,
+    rated_movies INTEGER NOT NULL DEFAULT 0,
+    rating_total REAL NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS movie_genre_counts (
+    genre TEXT PRIMARY KEY,
+    movies INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS movie_director_stats (
+    director TEXT PRIMARY KEY,
+    movies INTEGER NOT NULL,
+    rated_movies INTEGER NOT NULL,
+    rating_total REAL NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_movie_director_stats_movies ON movie_director_stats (movies DESC);
+
+CREATE TABLE IF NOT EXISTS movie_certification_counts (
+    region TEXT NOT NULL,
+    certification TEXT NOT NULL,
+    movies INTEGER NOT NULL,
+    PRIMARY KEY (region, certification)
+);
+
+-- Backfill from the movies already stored
+INSERT INTO movie_library_stats (id, total_movies, rated_movies, rating_total)
+SELECT 1, COUNT(*), COUNT(CASE WHEN rating > 0 THEN 1 END), COALESCE(SUM(CASE WHEN rating > 0 THEN rating END), 0)
+FROM movies;
+
+INSERT INTO movie_genre_counts (genre, movies)
+SELECT genres.value, COUNT(DISTINCT movies.id)
+FROM movies, json_each(iif(json_valid(movies.genre), movies.genre, '[]')) AS genres
+GROUP BY genres.value;
+
+INSERT INTO movie_director_stats (director, movies, rated_movies, rating_total)
+SELECT director, COUNT(*), COUNT(CASE WHEN rating > 0 THEN 1 END), COALESCE(SUM(CASE WHEN rating > 0 THEN rating END), 0)
+FROM movies
+GROUP BY director;
+
+INSERT INTO movie_certification_counts (region, certification, movies)
+SELECT certs.key, certs.value, COUNT(*)
+FROM movies, json_each(iif(json_valid(movies.certifications), movies.certifications, '{}')) AS certs
+GROUP BY certs.key, certs.value;
+
+CREATE TRIGGER movie_summaries_insert
+AFTER INSERT ON movies
+FOR EACH ROW
+BEGIN
+    UPDATE movie_library_stats
+    SET total_movies = total_movies + 1,
+        rated_movies = rated_movies + (COALESCE(NEW.rating, 0) > 0),
+        rating_total = rating_total + iif(NEW.rating > 0, NEW.rating, 0)
+    WHERE id = 1;
+
+    INSERT INTO movie_genre_counts (genre, movies)
+    SELECT DISTINCT value, 1 FROM json_each(iif(json_valid(NEW.genre), NEW.genre, '[]')) WHERE true
+    ON CONFLICT (genre) DO UPDATE SET movies = movies + 1;
+
+    INSERT INTO movie_director_stats (director, movies, rated_movies, rating_total)
+    VALUES (NEW.director, 1, COALESCE(NEW.rating, 0) > 0, iif(NEW.rating > 0, NEW.rating, 0))
+    ON CONFLICT (director) DO UPDATE SET
+        movies = movies + 1,
+        rated_movies = rated_movies + excluded.rated_movies,
+        rating_total = rating_total + excluded.rating_total;
+
+    INSERT INTO movie_certification_counts (region, certification, movies)
+    SELECT key, value, 1 FROM json_each(iif(json_valid(NEW.certifications), NEW.certifications, '{}')) WHERE true
+    ON CONFLICT (region, certification) DO UPDATE SET movies = movies + 1;
+END;
+
+CREATE TRIGGER movie_summaries_delete
+AFTER DELETE ON movies
+FOR EACH ROW
+BEGIN
+    UPDATE movie_library_stats
+    SET total_movies = total_movies - 1,
+        rated_movies = rated_movies - (COALESCE(OLD.rating, 0) > 0),
+        rating_total = rating_total - iif(OLD.rating > 0, OLD.rating, 0)
+    WHERE id = 1;
+
+    UPDATE movie_genre_counts SET movies = movies - 1
+    WHERE genre IN (SELECT value FROM json_each(iif(json_valid(OLD.genre), OLD.genre, '[]')));
+    DELETE FROM movie_genre_counts WHERE movies <= 0;
+
+    UPDATE movie_director_stats
+    SET movies = movies - 1,
+        rated_movies = rated_movies - (COALESCE(OLD.rating, 0) > 0),
+        rating_total = rating_total - iif(OLD.rating > 0, OLD.rating, 0)
+    WHERE director = OLD.director;
+    DELETE FROM movie_director_stats WHERE director = OLD.director AND movies <= 0;
+
+    UPDATE movie_certification_counts SET movies = movies - 1
+    WHERE (region, certification) IN (SELECT key, value FROM json_each(iif(json_valid(OLD.certifications), OLD.certifications, '{}')));
+    DELETE FROM movie_certification_counts WHERE movies <= 0;
+END;
+
+-- An update takes the old values out and puts the new ones in; updated_at
+-- alone, as set by update_movies_updated_at, changes no summary
+CREATE TRIGGER movie_summaries_update
+AFTER UPDATE OF director, rating, genre, certifications ON movies
+FOR EACH ROW
+BEGIN
+    UPDATE movie_library_stats
+    SET rated_movies = rated_movies - (COALESCE(OLD.rating, 0) > 0) + (COALESCE(NEW.rating, 0) > 0),
+        rating_total = rating_total - iif(OLD.rating > 0, OLD.rating, 0) + iif(NEW.rating > 0, NEW.rating, 0)
+    WHERE id = 1;
+
+    UPDATE movie_genre_counts SET movies = movies - 1
+    WHERE genre IN (SELECT value FROM json_each(iif(json_valid(OLD.genre), OLD.genre, '[]')));
+    INSERT INTO movie_genre_counts (genre, movies)
+    SELECT DISTINCT value, 1 FROM json_each(iif(json_valid(NEW.genre), NEW.genre, '[]')) WHERE true
+    ON CONFLICT (genre) DO UPDATE SET movies = movies + 1;
+    DELETE FROM movie_genre_counts WHERE movies <= 0;
+
+    UPDATE movie_director_stats
+    SET movies = movies - 1,
+        rated_movies = rated_movies - (COALESCE(OLD.rating, 0) > 0),
+        rating_total = rating_total - iif(OLD.rating > 0, OLD.rating, 0)
+    WHERE director = OLD.director;
+    INSERT INTO movie_director_stats (director, movies, rated_movies, rating_total)
+    VALUES (NEW.director, 1, COALESCE(NEW.rating, 0) > 0, iif(NEW.rating > 0, NEW.rating, 0))
+    ON CONFLICT (director) DO UPDATE SET
+        movies = movies + 1,
+        rated_movies = rated_movies + excluded.rated_movies,
+        rating_total = rating_total + excluded.rating_total;
+    DELETE FROM movie_director_stats WHERE director = OLD.director AND movies <= 0;
+
+    UPDATE movie_certification_counts SET movies = movies - 1
+    WHERE (region, certification) IN (SELECT key, value FROM json_each(iif(json_valid(OLD.certifications), OLD.certifications, '{}')));
+    INSERT INTO movie_certification_counts (region, certification, movies)
+    SELECT key, value, 1 FROM json_each(iif(json_valid(NEW.certifications), NEW.certifications, '{}')) WHERE true
+    ON CONFLICT (region, certification) DO UPDATE SET movies = movies + 1;
+    DELETE FROM movie_certification_counts WHERE movies <= 0;
+END;
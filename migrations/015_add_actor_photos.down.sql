@@ -0,0 +1,3 @@
+-- Drop columns (requires SQLite 3.35+)
+ALTER TABLE actors DROP COLUMN photo_type;
+ALTER TABLE actors DROP COLUMN photo_data;
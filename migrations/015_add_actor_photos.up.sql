@@ -0,0 +1,5 @@
+-- Add uploaded headshots to actors (SQLite version)
+-- The photo image and its MIME type are kept apart from photo_url, the
+-- URL of a photo hosted elsewhere, which actors have had since 004.
+ALTER TABLE actors ADD COLUMN photo_data BLOB;
+ALTER TABLE actors ADD COLUMN photo_type TEXT;
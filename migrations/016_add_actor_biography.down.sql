@@ -0,0 +1,7 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_actors_nationality;
+
+-- Drop columns (requires SQLite 3.35+)
+ALTER TABLE actors DROP COLUMN bio_sections;
+ALTER TABLE actors DROP COLUMN nationality;
+ALTER TABLE actors DROP COLUMN death_year;
@@ -0,0 +1,14 @@
+-- Add death years, nationalities and structured biographies to actors (SQLite version)
+
+-- Year the actor died; NULL while they are alive
+ALTER TABLE actors ADD COLUMN death_year INTEGER;
+
+-- Nationality as written, e.g. "Canadian"; searched case-insensitively
+ALTER TABLE actors ADD COLUMN nationality TEXT;
+
+-- JSON array of titled biography sections in display order, e.g.
+-- [{"title": "Early life", "text": "..."}]; bio stays the short summary
+ALTER TABLE actors ADD COLUMN bio_sections TEXT NOT NULL DEFAULT '[]';
+
+-- Create index for nationality searches
+CREATE INDEX IF NOT EXISTS idx_actors_nationality ON actors(nationality COLLATE NOCASE);
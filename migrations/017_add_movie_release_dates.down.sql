@@ -0,0 +1,5 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_movies_release_date;
+
+-- Drop columns (requires SQLite 3.35+)
+ALTER TABLE movies DROP COLUMN release_date;
@@ -0,0 +1,11 @@
+-- Add release dates to movies (SQLite version)
+
+-- ISO 8601 release date: YYYY-MM-DD when the day is known, or just YYYY.
+-- The year column stays the movie's release year; servers that predate this
+-- column leave it NULL on insert, which reads as year-only.
+ALTER TABLE movies ADD COLUMN release_date TEXT;
+
+-- Backfill year precision from the release year
+UPDATE movies SET release_date = printf('%04d', year) WHERE release_date IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_movies_release_date ON movies (release_date);
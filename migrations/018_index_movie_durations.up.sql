@@ -0,0 +1,4 @@
+-- Index movie runtimes for duration filters (SQLite version)
+
+-- duration has been in the schema since 001 (minutes, NULL when unknown)
+CREATE INDEX IF NOT EXISTS idx_movies_duration ON movies (duration);
@@ -0,0 +1,6 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_movie_tags_tag_id;
+
+-- Drop tables
+DROP TABLE IF EXISTS movie_tags;
+DROP TABLE IF EXISTS tags;
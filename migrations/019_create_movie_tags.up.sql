@@ -0,0 +1,22 @@
+-- Create tags table; names are normalized (lowercase, hyphenated) freeform
+-- labels, kept apart from the curated genres stored on movies
+CREATE TABLE IF NOT EXISTS tags (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE,
+    description TEXT,
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+    updated_at TEXT DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create movie_tags junction table
+CREATE TABLE IF NOT EXISTS movie_tags (
+    movie_id INTEGER NOT NULL,
+    tag_id INTEGER NOT NULL,
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (movie_id, tag_id),
+    FOREIGN KEY (movie_id) REFERENCES movies(id) ON DELETE CASCADE,
+    FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE
+);
+
+-- Create indexes for better query performance
+CREATE INDEX IF NOT EXISTS idx_movie_tags_tag_id ON movie_tags(tag_id);
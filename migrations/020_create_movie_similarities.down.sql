@@ -0,0 +1,6 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_movie_similarities_similar_id;
+DROP INDEX IF EXISTS idx_movie_similarities_score;
+
+-- Drop tables
+DROP TABLE IF EXISTS movie_similarities;
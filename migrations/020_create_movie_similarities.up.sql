@@ -0,0 +1,16 @@
+-- Create movie_similarities table (SQLite version); each movie keeps its
+-- most similar movies, precomputed on writes so reads are a single lookup
+CREATE TABLE IF NOT EXISTS movie_similarities (
+    movie_id INTEGER NOT NULL,
+    similar_id INTEGER NOT NULL,
+    score REAL NOT NULL,
+    updated_at TEXT DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (movie_id, similar_id),
+    FOREIGN KEY (movie_id) REFERENCES movies(id) ON DELETE CASCADE,
+    FOREIGN KEY (similar_id) REFERENCES movies(id) ON DELETE CASCADE
+);
+
+-- Create indexes for better query performance; the table starts empty and is
+-- filled by the server the first time it starts with movies
+CREATE INDEX IF NOT EXISTS idx_movie_similarities_score ON movie_similarities(movie_id, score DESC);
+CREATE INDEX IF NOT EXISTS idx_movie_similarities_similar_id ON movie_similarities(similar_id);
@@ -0,0 +1,3 @@
+-- Drop columns (requires SQLite 3.35+)
+ALTER TABLE movie_translations DROP COLUMN description_model;
+ALTER TABLE movie_translations DROP COLUMN description_source;
@@ -0,0 +1,9 @@
+-- Record where each translated description came from (SQLite version)
+
+-- 'imported' for descriptions given to the server, 'generated' for ones a
+-- client's model wrote through sampling; existing descriptions are imported
+ALTER TABLE movie_translations ADD COLUMN description_source TEXT NOT NULL DEFAULT 'imported';
+
+-- Model that generated the description, as reported by the client; NULL for
+-- imported descriptions
+ALTER TABLE movie_translations ADD COLUMN description_model TEXT;
@@ -0,0 +1,5 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_movie_access_day;
+
+-- Drop tables
+DROP TABLE IF EXISTS movie_access;
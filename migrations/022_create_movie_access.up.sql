@@ -0,0 +1,13 @@
+-- Create movie_access table (SQLite version); each row counts how often
+-- tools retrieved a movie or returned it in search results on one UTC day
+CREATE TABLE IF NOT EXISTS movie_access (
+    movie_id INTEGER NOT NULL,
+    day TEXT NOT NULL,
+    views INTEGER NOT NULL DEFAULT 0,
+    search_hits INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (movie_id, day),
+    FOREIGN KEY (movie_id) REFERENCES movies(id) ON DELETE CASCADE
+);
+
+-- Trending reads sum every movie's counters since a day
+CREATE INDEX IF NOT EXISTS idx_movie_access_day ON movie_access(day);
@@ -0,0 +1,10 @@
+// Package migrations embeds the SQLite schema migrations, so the server
+// brings its database up to date without the files on disk.
+package migrations
+
+import "embed"
+
+// FS holds the up and down migrations (format: 001_name.up.sql) at its root
+//
+//go:embed *.sql
+var FS embed.FS
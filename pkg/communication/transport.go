@@ -67,6 +67,13 @@ func (t *StdioTransport) Receive() (*protocol.JSONRPCRequest, error) {
 		return nil, fmt.Errorf("failed to unmarshal request: %w", err)
 	}
 
+	// JSON-RPC 2.0 ids are strings, numbers or null
+	switch request.ID.(type) {
+	case nil, string, float64:
+	default:
+		return nil, fmt.Errorf("invalid request id: %v", request.ID)
+	}
+
 	return &request, nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"io"
 	"strings"
 	"sync"
 	"testing"
@@ -191,6 +192,23 @@ func TestStdioTransport_Receive(t *testing.T) {
 			wantID:     nil,
 			wantErr:    false,
 		},
+		{
+			name:       "receive valid request with fractional id",
+			input:      `{"jsonrpc":"2.0","id":1.5,"method":"test/method"}`,
+			wantMethod: "test/method",
+			wantID:     1.5,
+			wantErr:    false,
+		},
+		{
+			name:    "receive boolean id",
+			input:   `{"jsonrpc":"2.0","id":true,"method":"test/method"}`,
+			wantErr: true,
+		},
+		{
+			name:    "receive object id",
+			input:   `{"jsonrpc":"2.0","id":{"n":1},"method":"test/method"}`,
+			wantErr: true,
+		},
 		{
 			name:    "receive invalid json",
 			input:   `{invalid json}`,
@@ -263,6 +281,56 @@ func TestStdioTransport_Receive_MultipleRequests(t *testing.T) {
 	}
 }
 
+func FuzzStdioTransport_Receive(f *testing.F) {
+	for _, seed := range []string{
+		`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"get_movie","arguments":{"movie_id":1}}}`,
+		`{"jsonrpc":"2.0","id":"req-1","method":"initialize"}`,
+		`{"jsonrpc":"2.0","id":-1e308,"method":"m"}`,
+		`{"jsonrpc":"2.0","id":null,"method":"m","params":[1,2]}`,
+		`{"jsonrpc":"2.0","id":[1],"method":"m"}`,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}` + "\n\n{}",
+		`{"id":1e400}`,
+		"{invalid json}",
+		"\r\n",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		transport := NewStdioTransport(strings.NewReader(input), nil)
+
+		// Each line yields one request or error, and a scanner error repeats
+		for i := 0; i <= strings.Count(input, "\n")+1; i++ {
+			request, err := transport.Receive()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				continue
+			}
+
+			switch request.ID.(type) {
+			case nil, string, float64:
+			default:
+				t.Fatalf("Expected a string, number or null id, got: %T", request.ID)
+			}
+
+			// A response echoes the request id unchanged
+			var out bytes.Buffer
+			if err := NewStdioTransport(nil, &out).Send(createTestResponse(request.ID, "ok")); err != nil {
+				t.Fatalf("Expected the response to encode, got: %v", err)
+			}
+			var response protocol.JSONRPCResponse
+			if err := json.Unmarshal(out.Bytes(), &response); err != nil {
+				t.Fatalf("Expected the response to decode, got: %v", err)
+			}
+			if response.ID != request.ID {
+				t.Errorf("Expected response id %v, got: %v", request.ID, response.ID)
+			}
+		}
+	})
+}
+
 func TestStdioTransport_Close(t *testing.T) {
 	reader := strings.NewReader("")
 	writer := &bytes.Buffer{}
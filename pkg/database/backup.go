@@ -0,0 +1,568 @@
+package database
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// BackupFormatVersion is the archive layout version written into the manifest
+const BackupFormatVersion = 1
+
+const backupManifestName = "manifest.json"
+
+// ErrBackupChecksum is returned when an archive entry does not match its manifest checksum
+var ErrBackupChecksum = errors.New("backup checksum mismatch")
+
+// BackupManifest describes the contents of a backup archive
+type BackupManifest struct {
+	FormatVersion int               `json:"format_version"`
+	CreatedAt     time.Time         `json:"created_at"`
+	Tables        []BackupTable     `json:"tables"`
+	Checksums     map[string]string `json:"checksums"` // entry name -> SHA-256 (hex)
+}
+
+// BackupTable records how many rows of a table the archive holds
+type BackupTable struct {
+	Name string `json:"name"`
+	Rows int    `json:"rows"`
+}
+
+// TotalRows returns the number of rows across all tables
+func (m *BackupManifest) TotalRows() int {
+	total := 0
+	for _, table := range m.Tables {
+		total += table.Rows
+	}
+	return total
+}
+
+// BackupProgress is called as rows are exported or restored
+type BackupProgress func(done, total int, message string)
+
+// backupRow is one line of a table's JSON lines entry. BLOB columns are
+// stored as separate archive entries and referenced by name.
+type backupRow struct {
+	Values map[string]any    `json:"values"`
+	Blobs  map[string]string `json:"blobs,omitempty"`
+}
+
+// BackupManager exports and restores the full dataset as a zip archive
+// holding one JSON lines entry per table, poster images and other BLOBs as
+// binary entries, and a manifest with SHA-256 checksums of every entry
+type BackupManager struct {
+	db     *sql.DB
+	tables []string // In dependency order: parents before children
+}
+
+// NewBackupManager creates a new backup manager for the movie schema
+func NewBackupManager(db *sql.DB) *BackupManager {
+	return &BackupManager{
+		db:     db,
+		tables: []string{"movies", "actors", "movie_actors", "movie_availability", "franchises", "franchise_movies", "tags", "movie_tags", "movie_translations", "movie_media", "movie_history"},
+	}
+}
+
+// BackupFile writes a backup archive to path. The archive is written to a
+// temporary file first so a failed backup never leaves a truncated archive.
+func (m *BackupManager) BackupFile(ctx context.Context, path string, progress BackupProgress) (*BackupManifest, error) {
+	path = filepath.Clean(path)
+	tmpPath := path + ".tmp"
+
+	file, err := os.Create(tmpPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create backup file: %w", err)
+	}
+
+	manifest, err := m.Backup(ctx, file, progress)
+	if closeErr := file.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("failed to close backup file: %w", closeErr)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return nil, err
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = os.Remove(tmpPath)
+		return nil, fmt.Errorf("failed to finalize backup file: %w", err)
+	}
+	return manifest, nil
+}
+
+// RestoreFile restores the database from the backup archive at path
+func (m *BackupManager) RestoreFile(ctx context.Context, path string, progress BackupProgress) (*BackupManifest, error) {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open backup file: %w", err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat backup file: %w", err)
+	}
+
+	return m.Restore(ctx, file, info.Size(), progress)
+}
+
+// Backup streams a consistent snapshot of every table into w
+func (m *BackupManager) Backup(ctx context.Context, w io.Writer, progress BackupProgress) (*BackupManifest, error) {
+	tx, err := conn(ctx, m.db).BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin backup transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // Read-only; nothing to commit
+
+	manifest := &BackupManifest{
+		FormatVersion: BackupFormatVersion,
+		CreatedAt:     time.Now().UTC(),
+		Tables:        []BackupTable{},
+		Checksums:     make(map[string]string),
+	}
+
+	total := 0
+	for _, table := range m.tables {
+		var count int
+		if err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM "+table).Scan(&count); err != nil {
+			return nil, fmt.Errorf("failed to count %s: %w", table, err)
+		}
+		manifest.Tables = append(manifest.Tables, BackupTable{Name: table, Rows: count})
+		total += count
+	}
+
+	archive := zip.NewWriter(w)
+	done := 0
+	for _, table := range m.tables {
+		if err := m.exportTable(ctx, tx, archive, manifest, table, &done, total, progress); err != nil {
+			return nil, err
+		}
+	}
+
+	entry, err := archive.CreateHeader(&zip.FileHeader{
+		Name:     backupManifestName,
+		Method:   zip.Deflate,
+		Modified: manifest.CreatedAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to write manifest: %w", err)
+	}
+	encoder := json.NewEncoder(entry)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(manifest); err != nil {
+		return nil, fmt.Errorf("failed to write manifest: %w", err)
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("failed to finish backup archive: %w", err)
+	}
+	return manifest, nil
+}
+
+// exportTable writes a table's rows, then its BLOB values as separate entries.
+// Both passes read in rowid order within the same transaction, so the row
+// index used in blob entry names lines up.
+func (m *BackupManager) exportTable(
+	ctx context.Context,
+	tx *sql.Tx,
+	archive *zip.Writer,
+	manifest *BackupManifest,
+	table string,
+	done *int,
+	total int,
+	progress BackupProgress,
+) error {
+	rows, err := tx.QueryContext(ctx, "SELECT * FROM "+table+" ORDER BY rowid")
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return fmt.Errorf("failed to read %s columns: %w", table, err)
+	}
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return fmt.Errorf("failed to read %s column types: %w", table, err)
+	}
+	blobColumns := []string{}
+	isBlob := make(map[string]bool)
+	for i, columnType := range columnTypes {
+		if strings.EqualFold(columnType.DatabaseTypeName(), "BLOB") {
+			blobColumns = append(blobColumns, columns[i])
+			isBlob[columns[i]] = true
+		}
+	}
+
+	entryName := "tables/" + table + ".jsonl"
+	entry, sum, err := createHashedEntry(archive, entryName, manifest.CreatedAt)
+	if err != nil {
+		return err
+	}
+	buffered := bufio.NewWriter(entry)
+	encoder := json.NewEncoder(buffered)
+
+	values := make([]any, len(columns))
+	pointers := make([]any, len(columns))
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+
+	index := 0
+	for rows.Next() {
+		if err := rows.Scan(pointers...); err != nil {
+			return fmt.Errorf("failed to scan %s row: %w", table, err)
+		}
+
+		row := backupRow{Values: make(map[string]any, len(columns))}
+		for i, column := range columns {
+			if isBlob[column] {
+				if values[i] != nil {
+					if row.Blobs == nil {
+						row.Blobs = make(map[string]string)
+					}
+					row.Blobs[column] = blobEntryName(table, index, column)
+				}
+				continue
+			}
+			if b, ok := values[i].([]byte); ok {
+				values[i] = string(b)
+			}
+			row.Values[column] = values[i]
+		}
+
+		if err := encoder.Encode(row); err != nil {
+			return fmt.Errorf("failed to write %s row: %w", table, err)
+		}
+
+		index++
+		*done++
+		if progress != nil {
+			progress(*done, total, "Exporting "+table)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read %s: %w", table, err)
+	}
+	if err := buffered.Flush(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", table, err)
+	}
+	manifest.Checksums[entryName] = hex.EncodeToString(sum.Sum(nil))
+
+	if len(blobColumns) == 0 {
+		return nil
+	}
+	return m.exportBlobs(ctx, tx, archive, manifest, table, blobColumns)
+}
+
+// exportBlobs writes each non-NULL BLOB value as its own archive entry
+func (m *BackupManager) exportBlobs(
+	ctx context.Context,
+	tx *sql.Tx,
+	archive *zip.Writer,
+	manifest *BackupManifest,
+	table string,
+	blobColumns []string,
+) error {
+	rows, err := tx.QueryContext(ctx, "SELECT "+strings.Join(blobColumns, ", ")+" FROM "+table+" ORDER BY rowid")
+	if err != nil {
+		return fmt.Errorf("failed to read %s blobs: %w", table, err)
+	}
+	defer rows.Close()
+
+	blobs := make([][]byte, len(blobColumns))
+	pointers := make([]any, len(blobColumns))
+	for i := range blobs {
+		pointers[i] = &blobs[i]
+	}
+
+	index := 0
+	for rows.Next() {
+		if err := rows.Scan(pointers...); err != nil {
+			return fmt.Errorf("failed to scan %s blobs: %w", table, err)
+		}
+
+		for i, column := range blobColumns {
+			if blobs[i] == nil {
+				continue
+			}
+			name := blobEntryName(table, index, column)
+			entry, sum, err := createHashedEntry(archive, name, manifest.CreatedAt)
+			if err != nil {
+				return err
+			}
+			if _, err := entry.Write(blobs[i]); err != nil {
+				return fmt.Errorf("failed to write %s: %w", name, err)
+			}
+			manifest.Checksums[name] = hex.EncodeToString(sum.Sum(nil))
+		}
+		index++
+	}
+	return rows.Err()
+}
+
+// Restore verifies the archive against its manifest and then replaces the
+// contents of every table with the archived rows in a single transaction
+func (m *BackupManager) Restore(ctx context.Context, r io.ReaderAt, size int64, progress BackupProgress) (*BackupManifest, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open backup archive: %w", err)
+	}
+
+	entries := make(map[string]*zip.File, len(archive.File))
+	for _, file := range archive.File {
+		entries[file.Name] = file
+	}
+
+	manifest, err := readManifest(entries)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyChecksums(entries, manifest); err != nil {
+		return nil, err
+	}
+
+	tx, err := conn(ctx, m.db).BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin restore transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // No-op after a successful commit
+
+	for i := len(m.tables) - 1; i >= 0; i-- {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM "+m.tables[i]); err != nil {
+			return nil, fmt.Errorf("failed to clear %s: %w", m.tables[i], err)
+		}
+	}
+
+	total := manifest.TotalRows()
+	done := 0
+	for _, table := range manifest.Tables {
+		if err := m.importTable(ctx, tx, entries, table, &done, total, progress); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit restore: %w", err)
+	}
+	return manifest, nil
+}
+
+// importTable inserts every archived row of a table
+func (m *BackupManager) importTable(
+	ctx context.Context,
+	tx *sql.Tx,
+	entries map[string]*zip.File,
+	table BackupTable,
+	done *int,
+	total int,
+	progress BackupProgress,
+) error {
+	if !m.knownTable(table.Name) {
+		return fmt.Errorf("backup contains unknown table %s", table.Name)
+	}
+	columns, err := tableColumns(ctx, tx, table.Name)
+	if err != nil {
+		return err
+	}
+
+	entryName := "tables/" + table.Name + ".jsonl"
+	file, ok := entries[entryName]
+	if !ok {
+		return fmt.Errorf("backup is missing %s", entryName)
+	}
+	reader, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", entryName, err)
+	}
+	defer reader.Close()
+
+	decoder := json.NewDecoder(reader)
+	decoder.UseNumber()
+
+	for decoder.More() {
+		var row backupRow
+		if err := decoder.Decode(&row); err != nil {
+			return fmt.Errorf("failed to decode %s row: %w", table.Name, err)
+		}
+
+		names := make([]string, 0, len(row.Values)+len(row.Blobs))
+		args := make([]any, 0, len(row.Values)+len(row.Blobs))
+		for column, value := range row.Values {
+			if !columns[column] {
+				return fmt.Errorf("backup column %s.%s does not exist in the database", table.Name, column)
+			}
+			names = append(names, column)
+			args = append(args, jsonValue(value))
+		}
+		for column, blobName := range row.Blobs {
+			if !columns[column] {
+				return fmt.Errorf("backup column %s.%s does not exist in the database", table.Name, column)
+			}
+			blob, err := readEntry(entries, blobName)
+			if err != nil {
+				return err
+			}
+			names = append(names, column)
+			args = append(args, blob)
+		}
+
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(names)), ", ")
+		query := "INSERT INTO " + table.Name + " (" + strings.Join(names, ", ") + ") VALUES (" + placeholders + ")"
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("failed to restore %s row: %w", table.Name, err)
+		}
+
+		*done++
+		if progress != nil {
+			progress(*done, total, "Restoring "+table.Name)
+		}
+	}
+	return nil
+}
+
+// knownTable reports whether the table is part of the backed-up schema
+func (m *BackupManager) knownTable(name string) bool {
+	for _, table := range m.tables {
+		if table == name {
+			return true
+		}
+	}
+	return false
+}
+
+// tableColumns returns the set of column names of a table
+func tableColumns(ctx context.Context, tx *sql.Tx, table string) (map[string]bool, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT name FROM pragma_table_info(?)", table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s columns: %w", table, err)
+	}
+	defer rows.Close()
+
+	columns := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("failed to read %s columns: %w", table, err)
+		}
+		columns[name] = true
+	}
+	return columns, rows.Err()
+}
+
+// readManifest decodes the archive manifest and checks its format version
+func readManifest(entries map[string]*zip.File) (*BackupManifest, error) {
+	data, err := readEntry(entries, backupManifestName)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest BackupManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse backup manifest: %w", err)
+	}
+	if manifest.FormatVersion != BackupFormatVersion {
+		return nil, fmt.Errorf("unsupported backup format version %d", manifest.FormatVersion)
+	}
+	return &manifest, nil
+}
+
+// verifyChecksums streams every listed entry through SHA-256 and compares it
+// to the manifest; entries the manifest does not list are rejected too
+func verifyChecksums(entries map[string]*zip.File, manifest *BackupManifest) error {
+	for name := range entries {
+		if _, listed := manifest.Checksums[name]; !listed && name != backupManifestName {
+			return fmt.Errorf("%w: %s is not listed in the manifest", ErrBackupChecksum, name)
+		}
+	}
+
+	for name, expected := range manifest.Checksums {
+		file, ok := entries[name]
+		if !ok {
+			return fmt.Errorf("backup is missing %s", name)
+		}
+
+		reader, err := file.Open()
+		if err != nil {
+			return fmt.Errorf("failed to open %s: %w", name, err)
+		}
+		sum := sha256.New()
+		_, err = io.Copy(sum, reader)
+		reader.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", name, err)
+		}
+
+		if actual := hex.EncodeToString(sum.Sum(nil)); actual != expected {
+			return fmt.Errorf("%w: %s", ErrBackupChecksum, name)
+		}
+	}
+	return nil
+}
+
+// readEntry reads a whole archive entry into memory
+func readEntry(entries map[string]*zip.File, name string) ([]byte, error) {
+	file, ok := entries[name]
+	if !ok {
+		return nil, fmt.Errorf("backup is missing %s", name)
+	}
+
+	reader, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", name, err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", name, err)
+	}
+	return data, nil
+}
+
+// createHashedEntry starts an archive entry whose content is also fed to a SHA-256 hash
+func createHashedEntry(archive *zip.Writer, name string, modified time.Time) (io.Writer, hash.Hash, error) {
+	entry, err := archive.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: modified,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create %s: %w", name, err)
+	}
+	sum := sha256.New()
+	return io.MultiWriter(entry, sum), sum, nil
+}
+
+// blobEntryName returns the archive entry name for a BLOB value
+func blobEntryName(table string, index int, column string) string {
+	return fmt.Sprintf("blobs/%s/%d/%s", table, index, column)
+}
+
+// jsonValue converts decoded JSON numbers back to SQLite integer or real values
+func jsonValue(value any) any {
+	number, ok := value.(json.Number)
+	if !ok {
+		return value
+	}
+	if i, err := number.Int64(); err == nil {
+		return i
+	}
+	if f, err := number.Float64(); err == nil {
+		return f
+	}
+	return number.String()
+}
@@ -0,0 +1,305 @@
+package database
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"path/filepath"
+	"testing"
+)
+
+// setupBackupDB creates an in-memory database with the movie schema
+func setupBackupDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open test database: %v", err)
+	}
+	db.SetMaxOpenConns(1) // Each :memory: connection is a separate database
+
+	schema := `
+		CREATE TABLE movies (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			title TEXT NOT NULL,
+			director TEXT NOT NULL,
+			year INTEGER NOT NULL,
+			genre TEXT NOT NULL DEFAULT '[]',
+			rating REAL,
+			poster_data BLOB,
+			poster_type TEXT,
+			created_at TEXT DEFAULT CURRENT_TIMESTAMP
+		);
+		CREATE TABLE actors (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL,
+			birth_year INTEGER
+		);
+		CREATE TABLE movie_actors (
+			movie_id INTEGER NOT NULL,
+			actor_id INTEGER NOT NULL,
+			role TEXT,
+			PRIMARY KEY (movie_id, actor_id)
+		);
+		CREATE TABLE movie_availability (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			movie_id INTEGER NOT NULL,
+			provider TEXT NOT NULL,
+			region TEXT NOT NULL,
+			offer_type TEXT NOT NULL DEFAULT 'flatrate',
+			url TEXT,
+			last_checked TEXT NOT NULL
+		);
+		CREATE TABLE franchises (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL UNIQUE
+		);
+		CREATE TABLE franchise_movies (
+			franchise_id INTEGER NOT NULL,
+			movie_id INTEGER NOT NULL,
+			position INTEGER NOT NULL,
+			PRIMARY KEY (franchise_id, movie_id)
+		);
+		CREATE TABLE tags (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL UNIQUE
+		);
+		CREATE TABLE movie_tags (
+			movie_id INTEGER NOT NULL,
+			tag_id INTEGER NOT NULL,
+			PRIMARY KEY (movie_id, tag_id)
+		);
+		CREATE TABLE movie_translations (
+			movie_id INTEGER NOT NULL,
+			language TEXT NOT NULL,
+			title TEXT,
+			description TEXT,
+			PRIMARY KEY (movie_id, language)
+		);
+		CREATE TABLE movie_media (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			movie_id INTEGER NOT NULL,
+			media_type TEXT NOT NULL DEFAULT 'trailer',
+			url TEXT NOT NULL,
+			provider TEXT NOT NULL,
+			title TEXT,
+			is_primary INTEGER NOT NULL DEFAULT 0,
+			created_at TEXT,
+			UNIQUE (movie_id, url)
+		);
+		CREATE TABLE movie_history (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			movie_id INTEGER NOT NULL,
+			version INTEGER NOT NULL,
+			operation TEXT NOT NULL,
+			diff TEXT NOT NULL,
+			reverted_to INTEGER,
+			created_at TEXT,
+			UNIQUE (movie_id, version)
+		);
+	`
+	if _, err := db.Exec(schema); err != nil {
+		t.Fatalf("failed to create test schema: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+func seedBackupDB(t *testing.T, db *sql.DB) {
+	t.Helper()
+
+	statements := []struct {
+		query string
+		args  []any
+	}{
+		{"INSERT INTO movies (id, title, director, year, genre, rating, poster_data, poster_type) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+			[]any{1, "Inception", "Christopher Nolan", 2010, `["Sci-Fi"]`, 8.8, []byte{0x89, 'P', 'N', 'G', 0x00, 0xff}, "image/png"}},
+		{"INSERT INTO movies (id, title, director, year) VALUES (?, ?, ?, ?)",
+			[]any{2, "Heat", "Michael Mann", 1995}},
+		{"INSERT INTO actors (id, name, birth_year) VALUES (?, ?, ?)", []any{10, "Leonardo DiCaprio", 1974}},
+		{"INSERT INTO movie_actors (movie_id, actor_id, role) VALUES (?, ?, ?)", []any{1, 10, "Cobb"}},
+		{"INSERT INTO movie_availability (movie_id, provider, region, last_checked) VALUES (?, ?, ?, ?)",
+			[]any{1, "Netflix", "US", "2024-05-01T12:00:00Z"}},
+	}
+	for _, stmt := range statements {
+		if _, err := db.Exec(stmt.query, stmt.args...); err != nil {
+			t.Fatalf("failed to seed test data: %v", err)
+		}
+	}
+}
+
+func TestBackupManager_RoundTrip(t *testing.T) {
+	// Arrange
+	source := setupBackupDB(t)
+	seedBackupDB(t, source)
+	target := setupBackupDB(t)
+	if _, err := target.Exec("INSERT INTO movies (title, director, year) VALUES ('Stale', 'Nobody', 2000)"); err != nil {
+		t.Fatalf("failed to insert stale row: %v", err)
+	}
+
+	var archive bytes.Buffer
+	progressCalls := 0
+
+	// Act
+	manifest, err := NewBackupManager(source).Backup(context.Background(), &archive, func(done, total int, message string) {
+		progressCalls++
+		if total != 5 {
+			t.Errorf("Backup progress total = %d, want 5", total)
+		}
+	})
+	if err != nil {
+		t.Fatalf("Backup() unexpected error: %v", err)
+	}
+
+	restored, err := NewBackupManager(target).Restore(context.Background(), bytes.NewReader(archive.Bytes()), int64(archive.Len()), nil)
+	if err != nil {
+		t.Fatalf("Restore() unexpected error: %v", err)
+	}
+
+	// Assert
+	if manifest.TotalRows() != 5 || restored.TotalRows() != 5 {
+		t.Errorf("TotalRows() = %d/%d, want 5", manifest.TotalRows(), restored.TotalRows())
+	}
+	if progressCalls != 5 {
+		t.Errorf("progress called %d times, want 5", progressCalls)
+	}
+
+	var count int
+	if err := target.QueryRow("SELECT COUNT(*) FROM movies").Scan(&count); err != nil || count != 2 {
+		t.Errorf("restored movies = %d (err %v), want 2", count, err)
+	}
+
+	var title, posterType string
+	var rating float64
+	var poster []byte
+	err = target.QueryRow("SELECT title, rating, poster_data, poster_type FROM movies WHERE id = 1").
+		Scan(&title, &rating, &poster, &posterType)
+	if err != nil {
+		t.Fatalf("failed to read restored movie: %v", err)
+	}
+	if title != "Inception" || rating != 8.8 || posterType != "image/png" {
+		t.Errorf("restored movie = %s/%v/%s", title, rating, posterType)
+	}
+	if !bytes.Equal(poster, []byte{0x89, 'P', 'N', 'G', 0x00, 0xff}) {
+		t.Errorf("restored poster = %v, want original bytes", poster)
+	}
+
+	var posterNull sql.NullString
+	if err := target.QueryRow("SELECT poster_type FROM movies WHERE id = 2").Scan(&posterNull); err != nil || posterNull.Valid {
+		t.Errorf("expected NULL poster_type for movie 2, got %+v (err %v)", posterNull, err)
+	}
+
+	var role string
+	if err := target.QueryRow("SELECT role FROM movie_actors WHERE movie_id = 1 AND actor_id = 10").Scan(&role); err != nil || role != "Cobb" {
+		t.Errorf("restored role = %q (err %v), want Cobb", role, err)
+	}
+}
+
+func TestBackupManager_RestoreRejectsCorruptArchive(t *testing.T) {
+	source := setupBackupDB(t)
+	seedBackupDB(t, source)
+
+	var archive bytes.Buffer
+	if _, err := NewBackupManager(source).Backup(context.Background(), &archive, nil); err != nil {
+		t.Fatalf("Backup() unexpected error: %v", err)
+	}
+
+	// Rewrite the archive with a tampered movies entry but the original manifest
+	reader, err := zip.NewReader(bytes.NewReader(archive.Bytes()), int64(archive.Len()))
+	if err != nil {
+		t.Fatalf("failed to open archive: %v", err)
+	}
+	var tampered bytes.Buffer
+	writer := zip.NewWriter(&tampered)
+	for _, file := range reader.File {
+		entry, _ := writer.Create(file.Name)
+		if file.Name == "tables/movies.jsonl" {
+			_, _ = entry.Write([]byte(`{"values":{"id":1,"title":"Tampered","director":"X","year":2000}}` + "\n"))
+			continue
+		}
+		content, _ := file.Open()
+		_, _ = io.Copy(entry, content)
+		content.Close()
+	}
+	writer.Close()
+
+	target := setupBackupDB(t)
+	seedBackupDB(t, target)
+
+	_, err = NewBackupManager(target).Restore(context.Background(), bytes.NewReader(tampered.Bytes()), int64(tampered.Len()), nil)
+	if !errors.Is(err, ErrBackupChecksum) {
+		t.Fatalf("Restore() error = %v, want ErrBackupChecksum", err)
+	}
+
+	var count int
+	if err := target.QueryRow("SELECT COUNT(*) FROM movies").Scan(&count); err != nil || count != 2 {
+		t.Errorf("existing data should be untouched, got %d movies (err %v)", count, err)
+	}
+}
+
+func TestBackupManager_BackupFile(t *testing.T) {
+	source := setupBackupDB(t)
+	seedBackupDB(t, source)
+	path := filepath.Join(t.TempDir(), "movies.zip")
+
+	if _, err := NewBackupManager(source).BackupFile(context.Background(), path, nil); err != nil {
+		t.Fatalf("BackupFile() unexpected error: %v", err)
+	}
+
+	target := setupBackupDB(t)
+	manifest, err := NewBackupManager(target).RestoreFile(context.Background(), path, nil)
+	if err != nil {
+		t.Fatalf("RestoreFile() unexpected error: %v", err)
+	}
+	if manifest.FormatVersion != BackupFormatVersion {
+		t.Errorf("FormatVersion = %d, want %d", manifest.FormatVersion, BackupFormatVersion)
+	}
+}
+
+func TestBackupManager_RestoreMissingFile(t *testing.T) {
+	db := setupBackupDB(t)
+
+	if _, err := NewBackupManager(db).RestoreFile(context.Background(), filepath.Join(t.TempDir(), "missing.zip"), nil); err == nil {
+		t.Error("RestoreFile() expected error for missing file")
+	}
+}
+
+func TestBackupManager_UsesTenantDatabase(t *testing.T) {
+	defaultDB := setupBackupDB(t)
+	seedBackupDB(t, defaultDB)
+	tenantDB := setupBackupDB(t)
+	if _, err := tenantDB.Exec("INSERT INTO movies (id, title, director, year) VALUES (7, 'Thief', 'Michael Mann', 1981)"); err != nil {
+		t.Fatalf("failed to seed tenant: %v", err)
+	}
+	manager := NewBackupManager(defaultDB)
+	ctx := WithTenant(context.Background(), "acme", tenantDB)
+
+	var archive bytes.Buffer
+	manifest, err := manager.Backup(ctx, &archive, nil)
+	if err != nil {
+		t.Fatalf("Backup() unexpected error: %v", err)
+	}
+	if manifest.TotalRows() != 1 {
+		t.Errorf("Expected the tenant's one movie backed up, got %d rows", manifest.TotalRows())
+	}
+
+	if _, err := tenantDB.Exec("DELETE FROM movies"); err != nil {
+		t.Fatalf("failed to clear tenant: %v", err)
+	}
+	if _, err := manager.Restore(ctx, bytes.NewReader(archive.Bytes()), int64(archive.Len()), nil); err != nil {
+		t.Fatalf("Restore() unexpected error: %v", err)
+	}
+
+	var title string
+	if err := tenantDB.QueryRow("SELECT title FROM movies WHERE id = 7").Scan(&title); err != nil || title != "Thief" {
+		t.Errorf("Expected the tenant's movie restored, got %q (err %v)", title, err)
+	}
+	var count int
+	if err := defaultDB.QueryRow("SELECT COUNT(*) FROM movies").Scan(&count); err != nil || count != 2 {
+		t.Errorf("Expected the default library untouched, got %d movies (err %v)", count, err)
+	}
+}
@@ -3,9 +3,14 @@ package database
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"time"
 )
 
+// ErrNotFound is wrapped by the errors the helpers return when no row matched
+var ErrNotFound = errors.New("not found")
+
 // BaseRepository provides common database operations for all repositories
 type BaseRepository struct {
 	db *sql.DB
@@ -16,24 +21,31 @@ func NewBaseRepository(db *sql.DB) *BaseRepository {
 	return &BaseRepository{db: db}
 }
 
-// DB returns the underlying database connection
+// DB returns the default database connection; the query helpers instead use
+// the tenant database their context is routed to, if any
 func (r *BaseRepository) DB() *sql.DB {
 	return r.db
 }
 
 // ExecContext executes a query with context and returns the result
 func (r *BaseRepository) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
-	return r.db.ExecContext(ctx, query, args...)
+	db := conn(ctx, r.db)
+	defer timeQuery(ctx, db, query, len(args), time.Now())
+	return db.ExecContext(ctx, query, args...)
 }
 
 // QueryRowContext executes a query that returns a single row
 func (r *BaseRepository) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
-	return r.db.QueryRowContext(ctx, query, args...)
+	db := conn(ctx, r.db)
+	defer timeQuery(ctx, db, query, len(args), time.Now())
+	return db.QueryRowContext(ctx, query, args...)
 }
 
 // QueryContext executes a query that returns multiple rows
 func (r *BaseRepository) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
-	return r.db.QueryContext(ctx, query, args...)
+	db := conn(ctx, r.db)
+	defer timeQuery(ctx, db, query, len(args), time.Now())
+	return db.QueryContext(ctx, query, args...)
 }
 
 // CheckRowsAffected validates that the expected number of rows were affected
@@ -44,7 +56,7 @@ func (r *BaseRepository) CheckRowsAffected(result sql.Result, entityType string)
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("%s not found", entityType)
+		return fmt.Errorf("%s %w", entityType, ErrNotFound)
 	}
 
 	return nil
@@ -53,7 +65,7 @@ func (r *BaseRepository) CheckRowsAffected(result sql.Result, entityType string)
 // Count returns the count from a count query
 func (r *BaseRepository) Count(ctx context.Context, query string, args ...interface{}) (int, error) {
 	var count int
-	err := r.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	err := r.QueryRowContext(ctx, query, args...).Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("failed to execute count query: %w", err)
 	}
@@ -62,7 +74,7 @@ func (r *BaseRepository) Count(ctx context.Context, query string, args ...interf
 
 // Delete executes a delete query and validates the result
 func (r *BaseRepository) Delete(ctx context.Context, query string, entityType string, args ...interface{}) error {
-	result, err := r.db.ExecContext(ctx, query, args...)
+	result, err := r.ExecContext(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("failed to delete %s: %w", entityType, err)
 	}
@@ -73,7 +85,7 @@ func (r *BaseRepository) Delete(ctx context.Context, query string, entityType st
 // Insert executes an insert query and returns the new ID
 func (r *BaseRepository) InsertWithID(ctx context.Context, query string, args ...interface{}) (int, error) {
 	var id int
-	err := r.db.QueryRowContext(ctx, query, args...).Scan(&id)
+	err := r.QueryRowContext(ctx, query, args...).Scan(&id)
 	if err != nil {
 		return 0, fmt.Errorf("failed to insert record: %w", err)
 	}
@@ -82,7 +94,7 @@ func (r *BaseRepository) InsertWithID(ctx context.Context, query string, args ..
 
 // Update executes an update query and validates the result
 func (r *BaseRepository) Update(ctx context.Context, query string, entityType string, args ...interface{}) error {
-	result, err := r.db.ExecContext(ctx, query, args...)
+	result, err := r.ExecContext(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("failed to update %s: %w", entityType, err)
 	}
@@ -98,7 +110,7 @@ func (r *BaseRepository) IsNotFound(err error) bool {
 // WrapNotFound wraps sql.ErrNoRows with a more descriptive error
 func (r *BaseRepository) WrapNotFound(err error, entityType string) error {
 	if err == sql.ErrNoRows {
-		return fmt.Errorf("%s not found", entityType)
+		return fmt.Errorf("%s %w", entityType, ErrNotFound)
 	}
 	return err
 }
@@ -0,0 +1,167 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrDatabaseUnavailable is returned when the database is known to be unreachable
+var ErrDatabaseUnavailable = errors.New("database unavailable")
+
+// HealthState describes the database connectivity as seen by the monitor
+type HealthState string
+
+const (
+	HealthStateHealthy  HealthState = "healthy"
+	HealthStateDegraded HealthState = "degraded"
+)
+
+// HealthSnapshot is a point-in-time view of database health
+type HealthSnapshot struct {
+	State               HealthState `json:"state"`
+	LastCheck           time.Time   `json:"last_check"`
+	LastHealthy         time.Time   `json:"last_healthy"`
+	LastError           string      `json:"last_error,omitempty"`
+	ConsecutiveFailures int         `json:"consecutive_failures"`
+	Reconnects          int         `json:"reconnects"`
+}
+
+// HealthConfig controls how often and how patiently the database is probed
+type HealthConfig struct {
+	Interval     time.Duration // Time between background checks
+	Timeout      time.Duration // Timeout for a single ping
+	MaxIdleConns int           // Idle pool size restored after a reconnect
+}
+
+// HealthMonitor pings the database in the background and re-establishes
+// connections when pings fail, so a transient outage degrades the server
+// instead of taking it down
+type HealthMonitor struct {
+	db       *sql.DB
+	config   HealthConfig
+	mutex    sync.RWMutex
+	snapshot HealthSnapshot
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewHealthMonitor creates a new health monitor for the given connection pool
+func NewHealthMonitor(db *sql.DB, cfg HealthConfig) *HealthMonitor {
+	if cfg.Interval <= 0 {
+		cfg.Interval = 30 * time.Second
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 5 * time.Second
+	}
+	if cfg.MaxIdleConns <= 0 {
+		cfg.MaxIdleConns = 1
+	}
+
+	now := time.Now()
+	return &HealthMonitor{
+		db:     db,
+		config: cfg,
+		snapshot: HealthSnapshot{
+			State:       HealthStateHealthy,
+			LastCheck:   now,
+			LastHealthy: now,
+		},
+		stop: make(chan struct{}),
+	}
+}
+
+// Start runs periodic health checks until ctx is cancelled or Stop is called
+func (m *HealthMonitor) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(m.config.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				_ = m.Check(ctx) // Result is recorded in the snapshot
+			case <-m.stop:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop halts background health checks
+func (m *HealthMonitor) Stop() {
+	m.stopOnce.Do(func() { close(m.stop) })
+}
+
+// Check pings the database, attempting a reconnect if the ping fails
+func (m *HealthMonitor) Check(ctx context.Context) error {
+	err := m.ping(ctx)
+	reconnected := false
+	if err != nil {
+		if reconnectErr := m.reconnect(ctx); reconnectErr == nil {
+			err = nil
+			reconnected = true
+		} else {
+			err = reconnectErr
+		}
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	now := time.Now()
+	m.snapshot.LastCheck = now
+	if reconnected {
+		m.snapshot.Reconnects++
+	}
+
+	if err != nil {
+		m.snapshot.State = HealthStateDegraded
+		m.snapshot.LastError = err.Error()
+		m.snapshot.ConsecutiveFailures++
+		return fmt.Errorf("%w: %v", ErrDatabaseUnavailable, err)
+	}
+
+	m.snapshot.State = HealthStateHealthy
+	m.snapshot.LastError = ""
+	m.snapshot.LastHealthy = now
+	m.snapshot.ConsecutiveFailures = 0
+	return nil
+}
+
+// Healthy reports whether the last check succeeded
+func (m *HealthMonitor) Healthy() bool {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.snapshot.State == HealthStateHealthy
+}
+
+// Snapshot returns the most recent health information
+func (m *HealthMonitor) Snapshot() HealthSnapshot {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.snapshot
+}
+
+// ping checks connectivity with the configured timeout
+func (m *HealthMonitor) ping(ctx context.Context) error {
+	pingCtx, cancel := context.WithTimeout(ctx, m.config.Timeout)
+	defer cancel()
+	return m.db.PingContext(pingCtx)
+}
+
+// reconnect drops idle connections so the pool dials fresh ones, then pings again
+func (m *HealthMonitor) reconnect(ctx context.Context) error {
+	m.db.SetMaxIdleConns(0)
+	m.db.SetMaxIdleConns(m.config.MaxIdleConns)
+
+	if err := m.ping(ctx); err != nil {
+		return fmt.Errorf("reconnect failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestHealthMonitor_HealthyDatabase(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	monitor := NewHealthMonitor(db, HealthConfig{Timeout: time.Second})
+
+	if err := monitor.Check(context.Background()); err != nil {
+		t.Fatalf("Check() unexpected error: %v", err)
+	}
+
+	snapshot := monitor.Snapshot()
+	if snapshot.State != HealthStateHealthy {
+		t.Errorf("State = %s, want %s", snapshot.State, HealthStateHealthy)
+	}
+	if snapshot.ConsecutiveFailures != 0 {
+		t.Errorf("ConsecutiveFailures = %d, want 0", snapshot.ConsecutiveFailures)
+	}
+	if !monitor.Healthy() {
+		t.Error("Healthy() = false, want true")
+	}
+}
+
+func TestHealthMonitor_UnavailableDatabase(t *testing.T) {
+	db := setupTestDB(t)
+	monitor := NewHealthMonitor(db, HealthConfig{Timeout: time.Second})
+
+	// Closing the pool makes every ping fail
+	db.Close()
+
+	err := monitor.Check(context.Background())
+	if err == nil {
+		t.Fatal("Check() expected error for closed database")
+	}
+	if !errors.Is(err, ErrDatabaseUnavailable) {
+		t.Errorf("Check() error = %v, want ErrDatabaseUnavailable", err)
+	}
+
+	_ = monitor.Check(context.Background())
+
+	snapshot := monitor.Snapshot()
+	if snapshot.State != HealthStateDegraded {
+		t.Errorf("State = %s, want %s", snapshot.State, HealthStateDegraded)
+	}
+	if snapshot.ConsecutiveFailures != 2 {
+		t.Errorf("ConsecutiveFailures = %d, want 2", snapshot.ConsecutiveFailures)
+	}
+	if snapshot.LastError == "" {
+		t.Error("LastError should be recorded")
+	}
+	if monitor.Healthy() {
+		t.Error("Healthy() = true, want false")
+	}
+}
+
+func TestHealthMonitor_StartAndStop(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	monitor := NewHealthMonitor(db, HealthConfig{Interval: 5 * time.Millisecond, Timeout: time.Second})
+	initial := monitor.Snapshot().LastCheck
+
+	monitor.Start(context.Background())
+	defer monitor.Stop()
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if monitor.Snapshot().LastCheck.After(initial) {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Error("background check did not run")
+}
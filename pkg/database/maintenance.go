@@ -0,0 +1,274 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrMaintenanceRunning is returned when maintenance is asked for while a run
+// is still in progress
+var ErrMaintenanceRunning = errors.New("database maintenance is already running")
+
+// maxIntegrityIssues caps the problems integrity_check reports in one run
+const maxIntegrityIssues = 100
+
+// MaintenanceOptions selects the steps of a maintenance run
+type MaintenanceOptions struct {
+	SkipVacuum bool // VACUUM rewrites the whole file and holds the write lock while it runs
+	Scheduled  bool // The run was started by the schedule rather than a caller
+}
+
+// MaintenanceReport describes a maintenance run: the file size around it,
+// and the problems integrity_check and foreign_key_check found
+type MaintenanceReport struct {
+	StartedAt       time.Time
+	Duration        time.Duration
+	Tenant          string
+	Scheduled       bool
+	SizeBefore      int64 // Bytes in pages, excluding the WAL file
+	SizeAfter       int64
+	FreePagesBefore int
+	FreePagesAfter  int
+	IntegrityOK     bool
+	Issues          []string
+	Analyzed        bool
+	Vacuumed        bool
+	Error           string
+}
+
+// Reclaimed returns the bytes the run freed; ANALYZE may grow the file a
+// little, which counts as nothing reclaimed
+func (r *MaintenanceReport) Reclaimed() int64 {
+	if r.SizeAfter >= r.SizeBefore {
+		return 0
+	}
+	return r.SizeBefore - r.SizeAfter
+}
+
+// TenantDatabases lists the open tenant databases and routes a context to one
+type TenantDatabases interface {
+	Tenants() []string
+	WithTenant(ctx context.Context, tenant string) (context.Context, error)
+}
+
+// Maintainer checks the integrity of the database, refreshes its query
+// planner statistics and vacuums it, on request or on a schedule. Runs never
+// overlap, and a database with integrity problems is not vacuumed.
+type Maintainer struct {
+	db       *sql.DB
+	tenants  TenantDatabases
+	running  sync.Mutex
+	mutex    sync.RWMutex
+	last     map[string]*MaintenanceReport // By tenant; "" is the default database
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewMaintainer creates a new maintainer for the given connection pool
+func NewMaintainer(db *sql.DB) *Maintainer {
+	return &Maintainer{
+		db:   db,
+		last: make(map[string]*MaintenanceReport),
+		stop: make(chan struct{}),
+	}
+}
+
+// SetTenants also maintains every open tenant database on the schedule,
+// after the default one. Call before Start.
+func (m *Maintainer) SetTenants(tenants TenantDatabases) {
+	m.tenants = tenants
+}
+
+// Start maintains the default database, and the open tenant databases, every
+// interval until ctx is cancelled or Stop is called. Nothing runs at
+// startup, and a non-positive interval never starts.
+func (m *Maintainer) Start(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				m.maintainAll(ctx)
+			case <-m.stop:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop halts scheduled maintenance
+func (m *Maintainer) Stop() {
+	m.stopOnce.Do(func() { close(m.stop) })
+}
+
+// maintainAll runs scheduled maintenance of the default database and then
+// of each open tenant database; results are kept as each one's last report
+func (m *Maintainer) maintainAll(ctx context.Context) {
+	opts := MaintenanceOptions{Scheduled: true}
+	_, _ = m.Maintain(ctx, opts)
+	if m.tenants == nil {
+		return
+	}
+
+	for _, tenant := range m.tenants.Tenants() {
+		tenantCtx, err := m.tenants.WithTenant(ctx, tenant)
+		if err != nil {
+			m.keep(&MaintenanceReport{StartedAt: time.Now().UTC(), Tenant: tenant, Scheduled: true, Issues: []string{}, Error: err.Error()})
+			continue
+		}
+		_, _ = m.Maintain(tenantCtx, opts)
+	}
+}
+
+// Last returns the report of the most recent run of the database ctx is
+// routed to, or nil before the first
+func (m *Maintainer) Last(ctx context.Context) *MaintenanceReport {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	last, ok := m.last[TenantFromContext(ctx)]
+	if !ok {
+		return nil
+	}
+	report := *last
+	report.Issues = append([]string(nil), last.Issues...)
+	return &report
+}
+
+// keep records report as the last run of its database
+func (m *Maintainer) keep(report *MaintenanceReport) {
+	m.mutex.Lock()
+	m.last[report.Tenant] = report
+	m.mutex.Unlock()
+}
+
+// Maintain runs integrity_check and foreign_key_check, then ANALYZE, then
+// VACUUM unless skipped or the checks found problems, against the tenant
+// database ctx is routed to
+func (m *Maintainer) Maintain(ctx context.Context, opts MaintenanceOptions) (*MaintenanceReport, error) {
+	if !m.running.TryLock() {
+		return nil, ErrMaintenanceRunning
+	}
+	defer m.running.Unlock()
+
+	report := &MaintenanceReport{
+		StartedAt: time.Now().UTC(),
+		Tenant:    TenantFromContext(ctx),
+		Scheduled: opts.Scheduled,
+		Issues:    []string{},
+	}
+	err := m.maintain(ctx, conn(ctx, m.db), opts, report)
+	report.Duration = time.Since(report.StartedAt)
+	if err != nil {
+		report.Error = err.Error()
+	}
+
+	m.keep(report)
+
+	if err != nil {
+		return nil, err
+	}
+	return m.Last(ctx), nil
+}
+
+// maintain runs the steps of a maintenance run, filling in report as they
+// complete
+func (m *Maintainer) maintain(ctx context.Context, db *sql.DB, opts MaintenanceOptions, report *MaintenanceReport) error {
+	var err error
+	if report.SizeBefore, report.FreePagesBefore, err = pageStats(ctx, db); err != nil {
+		return err
+	}
+
+	issues, err := integrityIssues(ctx, db)
+	if err != nil {
+		return err
+	}
+	report.Issues = issues
+	report.IntegrityOK = len(issues) == 0
+
+	if _, err := db.ExecContext(ctx, "ANALYZE"); err != nil {
+		return fmt.Errorf("failed to analyze database: %w", err)
+	}
+	report.Analyzed = true
+
+	if !opts.SkipVacuum && report.IntegrityOK {
+		if _, err := db.ExecContext(ctx, "VACUUM"); err != nil {
+			return fmt.Errorf("failed to vacuum database: %w", err)
+		}
+		report.Vacuumed = true
+	}
+
+	report.SizeAfter, report.FreePagesAfter, err = pageStats(ctx, db)
+	return err
+}
+
+// pageStats returns the size of the database in bytes and its free pages
+func pageStats(ctx context.Context, db *sql.DB) (int64, int, error) {
+	var pageCount, pageSize int64
+	var freePages int
+	if err := db.QueryRowContext(ctx, "PRAGMA page_count").Scan(&pageCount); err != nil {
+		return 0, 0, fmt.Errorf("failed to read page count: %w", err)
+	}
+	if err := db.QueryRowContext(ctx, "PRAGMA page_size").Scan(&pageSize); err != nil {
+		return 0, 0, fmt.Errorf("failed to read page size: %w", err)
+	}
+	if err := db.QueryRowContext(ctx, "PRAGMA freelist_count").Scan(&freePages); err != nil {
+		return 0, 0, fmt.Errorf("failed to read free pages: %w", err)
+	}
+	return pageCount * pageSize, freePages, nil
+}
+
+// integrityIssues returns the problems integrity_check and foreign_key_check
+// report, or none when the database is sound
+func integrityIssues(ctx context.Context, db *sql.DB) ([]string, error) {
+	issues := []string{}
+
+	rows, err := db.QueryContext(ctx, fmt.Sprintf("PRAGMA integrity_check(%d)", maxIntegrityIssues))
+	if err != nil {
+		return nil, fmt.Errorf("failed to check integrity: %w", err)
+	}
+	for rows.Next() {
+		var message string
+		if err := rows.Scan(&message); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to read integrity check: %w", err)
+		}
+		if message != "ok" {
+			issues = append(issues, message)
+		}
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to check integrity: %w", err)
+	}
+
+	rows, err = db.QueryContext(ctx, "PRAGMA foreign_key_check")
+	if err != nil {
+		return nil, fmt.Errorf("failed to check foreign keys: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var table, parent string
+		var rowID sql.NullInt64
+		var foreignKey int
+		if err := rows.Scan(&table, &rowID, &parent, &foreignKey); err != nil {
+			return nil, fmt.Errorf("failed to read foreign key check: %w", err)
+		}
+		issues = append(issues, fmt.Sprintf("%s row %d references a missing %s row", table, rowID.Int64, parent))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to check foreign keys: %w", err)
+	}
+	return issues, nil
+}
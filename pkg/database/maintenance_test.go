@@ -0,0 +1,151 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// fillAndDelete stores and deletes enough poster data to leave free pages
+func fillAndDelete(t *testing.T, m *Maintainer) {
+	t.Helper()
+
+	for i := 0; i < 50; i++ {
+		if _, err := m.db.Exec("INSERT INTO movies (title, director, year, poster_data) VALUES ('Filler', 'Nobody', 2000, randomblob(8192))"); err != nil {
+			t.Fatalf("failed to insert filler: %v", err)
+		}
+	}
+	if _, err := m.db.Exec("DELETE FROM movies WHERE title = 'Filler'"); err != nil {
+		t.Fatalf("failed to delete filler: %v", err)
+	}
+}
+
+func TestMaintainer_VacuumsAndChecks(t *testing.T) {
+	m := NewMaintainer(setupSelfTestDB(t))
+	fillAndDelete(t, m)
+	if m.Last(context.Background()) != nil {
+		t.Fatal("Expected no report before the first run")
+	}
+
+	report, err := m.Maintain(context.Background(), MaintenanceOptions{})
+	if err != nil {
+		t.Fatalf("Expected maintenance to succeed, got %v", err)
+	}
+	if !report.IntegrityOK || len(report.Issues) != 0 || !report.Analyzed || !report.Vacuumed {
+		t.Errorf("Expected a sound database analyzed and vacuumed, got %+v", report)
+	}
+	if report.FreePagesBefore == 0 || report.FreePagesAfter != 0 {
+		t.Errorf("Expected the free pages reclaimed, got %d then %d", report.FreePagesBefore, report.FreePagesAfter)
+	}
+	if report.Reclaimed() <= 0 || report.Reclaimed() != report.SizeBefore-report.SizeAfter {
+		t.Errorf("Expected space reclaimed, got %d of %d", report.Reclaimed(), report.SizeBefore)
+	}
+	if last := m.Last(context.Background()); last == nil || last.StartedAt != report.StartedAt {
+		t.Errorf("Expected the run kept as the last report, got %+v", last)
+	}
+
+	var movies int
+	if err := m.db.QueryRow("SELECT COUNT(*) FROM movies").Scan(&movies); err != nil || movies != 1 {
+		t.Errorf("Expected the remaining movie kept, got %d: %v", movies, err)
+	}
+}
+
+func TestMaintainer_SkipVacuum(t *testing.T) {
+	m := NewMaintainer(setupSelfTestDB(t))
+	fillAndDelete(t, m)
+
+	report, err := m.Maintain(context.Background(), MaintenanceOptions{SkipVacuum: true})
+	if err != nil {
+		t.Fatalf("Expected maintenance to succeed, got %v", err)
+	}
+	if report.Vacuumed || !report.Analyzed || report.FreePagesAfter == 0 || report.Reclaimed() != 0 {
+		t.Errorf("Expected free pages kept without a vacuum, got %+v", report)
+	}
+}
+
+func TestMaintainer_ReportsForeignKeyIssues(t *testing.T) {
+	m := NewMaintainer(setupSelfTestDB(t))
+	m.db.SetMaxOpenConns(1) // foreign_keys is per connection
+	if _, err := m.db.Exec("PRAGMA foreign_keys = OFF"); err != nil {
+		t.Fatalf("failed to disable foreign keys: %v", err)
+	}
+	if _, err := m.db.Exec("INSERT INTO movie_actors (movie_id, actor_id) VALUES (999, 1)"); err != nil {
+		t.Fatalf("failed to insert orphaned cast link: %v", err)
+	}
+
+	report, err := m.Maintain(context.Background(), MaintenanceOptions{})
+	if err != nil {
+		t.Fatalf("Expected maintenance to succeed, got %v", err)
+	}
+	if report.IntegrityOK || len(report.Issues) == 0 || !strings.Contains(report.Issues[0], "movie_actors") {
+		t.Errorf("Expected the orphaned cast link reported, got %+v", report.Issues)
+	}
+	if report.Vacuumed {
+		t.Error("Expected a database with issues not to be vacuumed")
+	}
+}
+
+func TestMaintainer_RunsOneAtATime(t *testing.T) {
+	m := NewMaintainer(setupSelfTestDB(t))
+	m.running.Lock()
+	defer m.running.Unlock()
+
+	if _, err := m.Maintain(context.Background(), MaintenanceOptions{}); !errors.Is(err, ErrMaintenanceRunning) {
+		t.Errorf("Expected ErrMaintenanceRunning, got %v", err)
+	}
+}
+
+func TestMaintainer_UsesTenantDatabase(t *testing.T) {
+	m := NewMaintainer(setupSelfTestDB(t))
+	tenant := setupSelfTestDB(t)
+	tenant.Close()
+
+	ctx := WithTenant(context.Background(), "acme", tenant)
+	if _, err := m.Maintain(ctx, MaintenanceOptions{}); err == nil {
+		t.Fatal("Expected maintenance of the closed tenant database to fail")
+	}
+	last := m.Last(ctx)
+	if last == nil || last.Tenant != "acme" || last.Error == "" {
+		t.Errorf("Expected the failed tenant run kept as the last report, got %+v", last)
+	}
+	if m.Last(context.Background()) != nil {
+		t.Error("Expected the tenant's run not to be reported for the default database")
+	}
+}
+
+// fakeTenantDatabases routes contexts to open databases by tenant
+type fakeTenantDatabases struct {
+	dbs map[string]*sql.DB
+}
+
+func (f *fakeTenantDatabases) Tenants() []string {
+	return []string{"acme", "globex"}
+}
+
+func (f *fakeTenantDatabases) WithTenant(ctx context.Context, tenant string) (context.Context, error) {
+	db, ok := f.dbs[tenant]
+	if !ok {
+		return nil, errors.New("failed to open database of tenant " + tenant)
+	}
+	return WithTenant(ctx, tenant, db), nil
+}
+
+func TestMaintainer_SchedulesEveryTenant(t *testing.T) {
+	m := NewMaintainer(setupSelfTestDB(t))
+	acme := setupSelfTestDB(t)
+	m.SetTenants(&fakeTenantDatabases{dbs: map[string]*sql.DB{"acme": acme}})
+
+	m.maintainAll(context.Background())
+
+	if last := m.Last(context.Background()); last == nil || !last.Scheduled || last.Error != "" {
+		t.Errorf("Expected a scheduled run of the default database, got %+v", last)
+	}
+	if last := m.Last(WithTenant(context.Background(), "acme", acme)); last == nil || last.Tenant != "acme" || !last.Vacuumed {
+		t.Errorf("Expected a scheduled run of acme, got %+v", last)
+	}
+	if last := m.Last(WithTenant(context.Background(), "globex", nil)); last == nil || last.Error == "" {
+		t.Errorf("Expected the tenant that failed to open reported, got %+v", last)
+	}
+}
@@ -0,0 +1,106 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"strings"
+)
+
+// MigrationPhase selects the migrations Migrate applies in an expand/contract
+// rollout. Expand migrations only add to the schema, so servers built before
+// them keep working; contract migrations (NNN_name.contract.up.sql) remove
+// what those older servers still use and run once none are left. Renamed
+// columns are kept in step by triggers in the expand migration rather than by
+// fallbacks in the repositories; see docs/MIGRATIONS.md.
+type MigrationPhase string
+
+const (
+	MigrationPhaseAll      MigrationPhase = ""         // Every pending migration
+	MigrationPhaseExpand   MigrationPhase = "expand"   // Pending migrations except contract ones
+	MigrationPhaseContract MigrationPhase = "contract" // Pending contract migrations, once expand is done
+)
+
+// ParseMigrationPhase parses "", "all", "expand" or "contract"
+func ParseMigrationPhase(phase string) (MigrationPhase, error) {
+	switch strings.ToLower(phase) {
+	case "", "all":
+		return MigrationPhaseAll, nil
+	case "expand":
+		return MigrationPhaseExpand, nil
+	case "contract":
+		return MigrationPhaseContract, nil
+	}
+	return "", fmt.Errorf("migrations phase %q is not one of all, expand or contract", phase)
+}
+
+// Migrate applies the up migrations in the root of migrationFS that db has
+// not applied yet, each in its own transaction, and returns how many it
+// applied. It records versions in schema_migrations exactly as tools/migrate
+// does, so either can bring a database up to date.
+func Migrate(ctx context.Context, db *sql.DB, migrationFS fs.FS) (int, error) {
+	return MigratePhase(ctx, db, migrationFS, MigrationPhaseAll)
+}
+
+// MigratePhase applies the pending migrations of one phase, as Migrate does.
+// The contract phase refuses to run while expand migrations are pending.
+func MigratePhase(ctx context.Context, db *sql.DB, migrationFS fs.FS, phase MigrationPhase) (int, error) {
+	migrations, err := upMigrations(migrationFS)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version INTEGER PRIMARY KEY,
+			applied_at TEXT DEFAULT CURRENT_TIMESTAMP
+		);
+	`); err != nil {
+		return 0, fmt.Errorf("failed to create migrations table: %w", err)
+	}
+
+	applied, err := NewMigrationChecker(db, migrationFS).appliedVersions(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if phase == MigrationPhaseContract {
+		for _, migration := range migrations {
+			if !applied[migration.version] && !migration.contract {
+				return 0, fmt.Errorf("migration %d is pending; run the expand phase before contract", migration.version)
+			}
+		}
+	}
+
+	count := 0
+	for _, migration := range migrations {
+		if applied[migration.version] {
+			continue
+		}
+		if (phase == MigrationPhaseExpand && migration.contract) || (phase == MigrationPhaseContract && !migration.contract) {
+			continue
+		}
+
+		upSQL, err := fs.ReadFile(migrationFS, migration.path)
+		if err != nil {
+			return count, fmt.Errorf("failed to read migration %d: %w", migration.version, err)
+		}
+
+		err = NewTransactionManager(db).WithTransaction(ctx, func(tx *sql.Tx) error {
+			if _, err := tx.ExecContext(ctx, string(upSQL)); err != nil {
+				return fmt.Errorf("failed to execute migration %d: %w", migration.version, err)
+			}
+			if _, err := tx.ExecContext(ctx, "INSERT INTO schema_migrations (version) VALUES (?)", migration.version); err != nil {
+				return fmt.Errorf("failed to record migration %d: %w", migration.version, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	return count, nil
+}
@@ -0,0 +1,166 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MigrationStatus summarizes applied versus available schema migrations
+type MigrationStatus struct {
+	CurrentVersion  int  `json:"current_version"`
+	LatestVersion   int  `json:"latest_version"`
+	Pending         int  `json:"pending"`
+	PendingContract int  `json:"pending_contract"` // Contract migrations left, which the server does not need
+	UpToDate        bool `json:"up_to_date"`
+}
+
+// ErrSchemaOutdated is wrapped by CheckSchema when migrations other than
+// contract ones are pending
+var ErrSchemaOutdated = errors.New("database schema is out of date")
+
+// MigrationChecker compares the schema_migrations table against migration files
+type MigrationChecker struct {
+	db          *sql.DB
+	migrationFS fs.FS
+}
+
+// NewMigrationChecker creates a checker against the migrations in the root
+// of migrationFS
+func NewMigrationChecker(db *sql.DB, migrationFS fs.FS) *MigrationChecker {
+	return &MigrationChecker{
+		db:          db,
+		migrationFS: migrationFS,
+	}
+}
+
+// Status reports the current migration status
+func (c *MigrationChecker) Status(ctx context.Context) (MigrationStatus, error) {
+	available, err := upMigrations(c.migrationFS)
+	if err != nil {
+		return MigrationStatus{}, err
+	}
+
+	applied, err := c.appliedVersions(ctx)
+	if err != nil {
+		return MigrationStatus{}, err
+	}
+
+	status := MigrationStatus{}
+	for version := range applied {
+		if version > status.CurrentVersion {
+			status.CurrentVersion = version
+		}
+	}
+	for _, migration := range available {
+		if migration.version > status.LatestVersion {
+			status.LatestVersion = migration.version
+		}
+		if applied[migration.version] {
+			continue
+		}
+		if migration.contract {
+			status.PendingContract++
+		} else {
+			status.Pending++
+		}
+	}
+	status.UpToDate = status.Pending == 0
+
+	return status, nil
+}
+
+// CheckSchema returns the migration status, and an error wrapping
+// ErrSchemaOutdated when an available migration the server needs has not
+// been applied; pending contract migrations are allowed
+func (c *MigrationChecker) CheckSchema(ctx context.Context) (MigrationStatus, error) {
+	status, err := c.Status(ctx)
+	if err != nil {
+		return status, err
+	}
+	if !status.UpToDate {
+		return status, fmt.Errorf("%w: at version %d, expected version %d (%d migrations pending)",
+			ErrSchemaOutdated, status.CurrentVersion, status.LatestVersion, status.Pending)
+	}
+	return status, nil
+}
+
+// upMigration is an up migration file
+type upMigration struct {
+	version  int
+	path     string // Path within the migrations file system
+	contract bool   // Named NNN_name.contract.up.sql
+}
+
+// upMigrations lists the up migrations in the root of fsys (format:
+// 001_name.up.sql or 001_name.contract.up.sql), ordered by version
+func upMigrations(fsys fs.FS) ([]upMigration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations directory: %w", err)
+	}
+
+	migrations := []upMigration{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".up.sql") {
+			continue
+		}
+
+		prefix, _, found := strings.Cut(name, "_")
+		if !found {
+			continue
+		}
+		version, err := strconv.Atoi(prefix)
+		if err != nil {
+			continue
+		}
+		migrations = append(migrations, upMigration{
+			version:  version,
+			path:     name,
+			contract: strings.HasSuffix(name, ".contract.up.sql"),
+		})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].version < migrations[j].version
+	})
+	return migrations, nil
+}
+
+// appliedVersions reads applied versions; a missing table means nothing is applied
+func (c *MigrationChecker) appliedVersions(ctx context.Context) (map[int]bool, error) {
+	applied := make(map[int]bool)
+
+	var tableCount int
+	err := c.db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'schema_migrations'",
+	).Scan(&tableCount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check migrations table: %w", err)
+	}
+	if tableCount == 0 {
+		return applied, nil
+	}
+
+	rows, err := c.db.QueryContext(ctx, "SELECT version FROM schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query applied migrations: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var version int
+		if err := rows.Scan(&version); err != nil {
+			return nil, fmt.Errorf("failed to scan migration version: %w", err)
+		}
+		applied[version] = true
+	}
+
+	return applied, rows.Err()
+}
@@ -0,0 +1,197 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func writeMigrationFiles(t *testing.T, names ...string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("SELECT 1;"), 0o600); err != nil {
+			t.Fatalf("failed to write migration file: %v", err)
+		}
+	}
+	return dir
+}
+
+func TestMigrationChecker_NoMigrationsTable(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	dir := writeMigrationFiles(t, "001_init.up.sql", "001_init.down.sql", "002_more.up.sql")
+	checker := NewMigrationChecker(db, os.DirFS(dir))
+
+	status, err := checker.Status(context.Background())
+	if err != nil {
+		t.Fatalf("Status() unexpected error: %v", err)
+	}
+
+	if status.CurrentVersion != 0 || status.LatestVersion != 2 || status.Pending != 2 || status.UpToDate {
+		t.Errorf("Status() = %+v, want current 0, latest 2, pending 2", status)
+	}
+}
+
+func TestMigrationChecker_PartiallyApplied(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	if _, err := db.Exec(`CREATE TABLE schema_migrations (version INTEGER PRIMARY KEY); INSERT INTO schema_migrations (version) VALUES (1);`); err != nil {
+		t.Fatalf("failed to create migrations table: %v", err)
+	}
+
+	dir := writeMigrationFiles(t, "001_init.up.sql", "002_more.up.sql", "README.md")
+	checker := NewMigrationChecker(db, os.DirFS(dir))
+
+	status, err := checker.Status(context.Background())
+	if err != nil {
+		t.Fatalf("Status() unexpected error: %v", err)
+	}
+
+	if status.CurrentVersion != 1 || status.LatestVersion != 2 || status.Pending != 1 || status.UpToDate {
+		t.Errorf("Status() = %+v, want current 1, latest 2, pending 1", status)
+	}
+}
+
+func TestMigrationChecker_UpToDate(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	if _, err := db.Exec(`CREATE TABLE schema_migrations (version INTEGER PRIMARY KEY); INSERT INTO schema_migrations (version) VALUES (1), (2);`); err != nil {
+		t.Fatalf("failed to create migrations table: %v", err)
+	}
+
+	dir := writeMigrationFiles(t, "001_init.up.sql", "002_more.up.sql")
+	checker := NewMigrationChecker(db, os.DirFS(dir))
+
+	status, err := checker.Status(context.Background())
+	if err != nil {
+		t.Fatalf("Status() unexpected error: %v", err)
+	}
+
+	if !status.UpToDate || status.Pending != 0 {
+		t.Errorf("Status() = %+v, want up to date", status)
+	}
+}
+
+func TestMigrationChecker_CheckSchema(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	if _, err := db.Exec(`CREATE TABLE schema_migrations (version INTEGER PRIMARY KEY); INSERT INTO schema_migrations (version) VALUES (1);`); err != nil {
+		t.Fatalf("failed to create migrations table: %v", err)
+	}
+
+	dir := writeMigrationFiles(t, "001_init.up.sql", "002_more.up.sql", "003_last.up.sql")
+	_, err := NewMigrationChecker(db, os.DirFS(dir)).CheckSchema(context.Background())
+	if !errors.Is(err, ErrSchemaOutdated) {
+		t.Fatalf("CheckSchema() error = %v, want ErrSchemaOutdated", err)
+	}
+	if want := "at version 1, expected version 3 (2 migrations pending)"; !strings.Contains(err.Error(), want) {
+		t.Errorf("CheckSchema() error = %q, want it to contain %q", err, want)
+	}
+
+	dir = writeMigrationFiles(t, "001_init.up.sql")
+	if _, err := NewMigrationChecker(db, os.DirFS(dir)).CheckSchema(context.Background()); err != nil {
+		t.Errorf("CheckSchema() unexpected error: %v", err)
+	}
+}
+
+func TestMigrationChecker_MissingDirectory(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	checker := NewMigrationChecker(db, os.DirFS(filepath.Join(t.TempDir(), "missing")))
+
+	if _, err := checker.Status(context.Background()); err == nil {
+		t.Error("Status() expected error for missing directory")
+	}
+}
+
+func TestMigrate_AppliesPendingOnce(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"001_first.up.sql":   "CREATE TABLE first (id INTEGER);",
+		"001_first.down.sql": "DROP TABLE first;",
+		"002_second.up.sql":  "CREATE TABLE second (id INTEGER);",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatalf("failed to write migration file: %v", err)
+		}
+	}
+
+	if applied, err := Migrate(context.Background(), db, os.DirFS(dir)); err != nil || applied != 2 {
+		t.Fatalf("Migrate() = %d, %v, want 2 applied", applied, err)
+	}
+	if applied, err := Migrate(context.Background(), db, os.DirFS(dir)); err != nil || applied != 0 {
+		t.Errorf("Migrate() again = %d, %v, want nothing applied", applied, err)
+	}
+
+	status, err := NewMigrationChecker(db, os.DirFS(dir)).Status(context.Background())
+	if err != nil || !status.UpToDate || status.CurrentVersion != 2 {
+		t.Errorf("Status() = %+v, %v, want up to date at version 2", status, err)
+	}
+}
+
+func TestMigratePhase_ExpandThenContract(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	migrationFS := fstest.MapFS{
+		"001_first.up.sql":                  {Data: []byte("CREATE TABLE first (id INTEGER, old_name TEXT);")},
+		"002_add_new_name.up.sql":           {Data: []byte("ALTER TABLE first ADD COLUMN new_name TEXT;")},
+		"003_drop_old_name.contract.up.sql": {Data: []byte("ALTER TABLE first DROP COLUMN old_name;")},
+	}
+	ctx := context.Background()
+
+	if _, err := MigratePhase(ctx, db, migrationFS, MigrationPhaseContract); err == nil {
+		t.Error("MigratePhase(contract) expected an error while expand migrations are pending")
+	}
+	if applied, err := MigratePhase(ctx, db, migrationFS, MigrationPhaseExpand); err != nil || applied != 2 {
+		t.Fatalf("MigratePhase(expand) = %d, %v, want 2 applied", applied, err)
+	}
+
+	status, err := NewMigrationChecker(db, migrationFS).CheckSchema(ctx)
+	if err != nil {
+		t.Fatalf("CheckSchema() unexpected error with only contract migrations pending: %v", err)
+	}
+	if status.Pending != 0 || status.PendingContract != 1 || !status.UpToDate {
+		t.Errorf("Status() = %+v, want 1 pending contract migration", status)
+	}
+
+	if applied, err := MigratePhase(ctx, db, migrationFS, MigrationPhaseContract); err != nil || applied != 1 {
+		t.Fatalf("MigratePhase(contract) = %d, %v, want 1 applied", applied, err)
+	}
+	if _, err := db.Exec("SELECT old_name FROM first"); err == nil {
+		t.Error("Expected the contract migration to drop old_name")
+	}
+}
+
+func TestParseMigrationPhase(t *testing.T) {
+	for input, want := range map[string]MigrationPhase{
+		"":         MigrationPhaseAll,
+		"all":      MigrationPhaseAll,
+		"Expand":   MigrationPhaseExpand,
+		"contract": MigrationPhaseContract,
+	} {
+		if got, err := ParseMigrationPhase(input); err != nil || got != want {
+			t.Errorf("ParseMigrationPhase(%q) = %q, %v, want %q", input, got, err, want)
+		}
+	}
+	if _, err := ParseMigrationPhase("shrink"); err == nil {
+		t.Error("ParseMigrationPhase(shrink) expected error")
+	}
+}
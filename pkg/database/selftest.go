@@ -0,0 +1,148 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+)
+
+// selfTestPoster is the image the self-test stores as a poster: the
+// signature of a PNG file
+var selfTestPoster = []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1a, '\n'}
+
+// SelfTestCheck is the outcome of one self-test check
+type SelfTestCheck struct {
+	Name   string
+	Detail string // What the check found, when it passed
+	Err    error
+}
+
+// SelfTestReport lists the self-test checks in the order they ran
+type SelfTestReport struct {
+	Checks []SelfTestCheck
+}
+
+// Add records a check, which failed if err is set
+func (r *SelfTestReport) Add(name, detail string, err error) {
+	r.Checks = append(r.Checks, SelfTestCheck{Name: name, Detail: detail, Err: err})
+}
+
+// Failed reports whether any check failed
+func (r *SelfTestReport) Failed() bool {
+	for _, check := range r.Checks {
+		if check.Err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Write prints one line per check and a summary of the run
+func (r *SelfTestReport) Write(w io.Writer) error {
+	failed := 0
+	for _, check := range r.Checks {
+		var err error
+		if check.Err != nil {
+			failed++
+			_, err = fmt.Fprintf(w, "FAIL %-10s %v\n", check.Name, check.Err)
+		} else {
+			_, err = fmt.Fprintf(w, "ok   %-10s %s\n", check.Name, check.Detail)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	var err error
+	if failed > 0 {
+		_, err = fmt.Fprintf(w, "Self-test failed: %d of %d checks failed\n", failed, len(r.Checks))
+	} else {
+		_, err = fmt.Fprintf(w, "Self-test passed: %d checks\n", len(r.Checks))
+	}
+	return err
+}
+
+// SelfTest checks that db can serve the server and adds its checks to
+// report: the connection, a schema at the version of the migrations in
+// migrationFS, and a read, a write and a poster round trip inside a
+// transaction that is rolled back, so the library is left as it was. Checks
+// after a failed connection or schema check are skipped, as they could only
+// fail for the same reason.
+func SelfTest(ctx context.Context, db *sql.DB, migrationFS fs.FS, report *SelfTestReport) {
+	if err := db.PingContext(ctx); err != nil {
+		report.Add("connection", "", fmt.Errorf("failed to reach the database: %w", err))
+		return
+	}
+	report.Add("connection", "database reachable", nil)
+
+	status, err := NewMigrationChecker(db, migrationFS).CheckSchema(ctx)
+	if err != nil {
+		report.Add("schema", "", err)
+		return
+	}
+	detail := fmt.Sprintf("at version %d", status.CurrentVersion)
+	if status.PendingContract > 0 {
+		detail += fmt.Sprintf(" (%d contract migrations pending)", status.PendingContract)
+	}
+	report.Add("schema", detail, nil)
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		report.Add("read", "", fmt.Errorf("failed to begin transaction: %w", err))
+		return
+	}
+	selfTestQueries(ctx, tx, report)
+	if err := tx.Rollback(); err != nil {
+		report.Add("rollback", "", fmt.Errorf("failed to roll back the self-test writes: %w", err))
+	}
+}
+
+// selfTestQueries runs the read, write and poster checks in tx
+func selfTestQueries(ctx context.Context, tx *sql.Tx, report *SelfTestReport) {
+	var movies int
+	if err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM movies").Scan(&movies); err != nil {
+		report.Add("read", "", fmt.Errorf("failed to count movies: %w", err))
+	} else {
+		report.Add("read", fmt.Sprintf("movies counted: %d", movies), nil)
+	}
+
+	var id int64
+	err := tx.QueryRowContext(ctx,
+		"INSERT INTO movies (title, director, year) VALUES ('Self-test', 'Self-test', 2000) RETURNING id").Scan(&id)
+	if err == nil {
+		_, err = tx.ExecContext(ctx, "UPDATE movies SET rating = 5 WHERE id = ?", id)
+	}
+	if err != nil {
+		report.Add("write", "", fmt.Errorf("failed to write a movie: %w", err))
+		return
+	}
+	report.Add("write", "inserted and updated a movie, then rolled back", nil)
+
+	detail, err := selfTestPosters(ctx, tx, id)
+	report.Add("posters", detail, err)
+}
+
+// selfTestPosters stores a poster on movie id and reads it back
+func selfTestPosters(ctx context.Context, tx *sql.Tx, id int64) (string, error) {
+	var stored int
+	if err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM movies WHERE poster_data IS NOT NULL").Scan(&stored); err != nil {
+		return "", fmt.Errorf("failed to count stored posters: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE movies SET poster_data = ?, poster_type = 'image/png' WHERE id = ?", selfTestPoster, id); err != nil {
+		return "", fmt.Errorf("failed to store a poster: %w", err)
+	}
+	var data []byte
+	var mimeType string
+	if err := tx.QueryRowContext(ctx, "SELECT poster_data, poster_type FROM movies WHERE id = ?", id).Scan(&data, &mimeType); err != nil {
+		return "", fmt.Errorf("failed to read a poster back: %w", err)
+	}
+	if !bytes.Equal(data, selfTestPoster) || mimeType != "image/png" {
+		return "", errors.New("a poster read back differs from the one stored")
+	}
+	return fmt.Sprintf("stored: %d, round trip ok", stored), nil
+}
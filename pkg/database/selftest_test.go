@@ -0,0 +1,102 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/francknouama/movies-mcp-server/migrations"
+)
+
+// setupSelfTestDB creates a database file migrated to the embedded schema,
+// holding one movie
+func setupSelfTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "movies.db"))
+	if err != nil {
+		t.Fatalf("failed to open test database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	ctx := context.Background()
+	if _, err := Migrate(ctx, db, migrations.FS); err != nil {
+		t.Fatalf("failed to migrate test database: %v", err)
+	}
+	if _, err := db.Exec("INSERT INTO movies (title, director, year) VALUES ('Heat', 'Michael Mann', 1995)"); err != nil {
+		t.Fatalf("failed to insert movie: %v", err)
+	}
+	return db
+}
+
+func TestSelfTest_Passes(t *testing.T) {
+	db := setupSelfTestDB(t)
+
+	var report SelfTestReport
+	SelfTest(context.Background(), db, migrations.FS, &report)
+
+	if report.Failed() {
+		t.Fatalf("Expected every check to pass, got %+v", report.Checks)
+	}
+	var names []string
+	for _, check := range report.Checks {
+		names = append(names, check.Name)
+	}
+	if got := strings.Join(names, ","); got != "connection,schema,read,write,posters" {
+		t.Errorf("Expected the connection, schema, read, write and poster checks, got %s", got)
+	}
+	if report.Checks[2].Detail != "movies counted: 1" {
+		t.Errorf("Expected the read to count 1 movie, got %q", report.Checks[2].Detail)
+	}
+
+	// The writes were rolled back
+	var movies, posters int
+	if err := db.QueryRow("SELECT COUNT(*), COUNT(poster_data) FROM movies").Scan(&movies, &posters); err != nil {
+		t.Fatalf("failed to count movies: %v", err)
+	}
+	if movies != 1 || posters != 0 {
+		t.Errorf("Expected the library untouched, got %d movies and %d posters", movies, posters)
+	}
+}
+
+func TestSelfTest_OutdatedSchema(t *testing.T) {
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "movies.db"))
+	if err != nil {
+		t.Fatalf("failed to open test database: %v", err)
+	}
+	defer db.Close()
+
+	var report SelfTestReport
+	SelfTest(context.Background(), db, migrations.FS, &report)
+
+	if len(report.Checks) != 2 || report.Checks[1].Name != "schema" {
+		t.Fatalf("Expected the checks to stop at the schema, got %+v", report.Checks)
+	}
+	if !errors.Is(report.Checks[1].Err, ErrSchemaOutdated) {
+		t.Errorf("Expected an outdated schema, got %v", report.Checks[1].Err)
+	}
+}
+
+func TestSelfTestReport_Write(t *testing.T) {
+	var report SelfTestReport
+	report.Add("config", "environment only", nil)
+	report.Add("schema", "", errors.New("at version 3, expected version 22"))
+
+	var out bytes.Buffer
+	if err := report.Write(&out); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	want := "ok   config     environment only\n" +
+		"FAIL schema     at version 3, expected version 22\n" +
+		"Self-test failed: 1 of 2 checks failed\n"
+	if out.String() != want {
+		t.Errorf("Write() =\n%s\nwant\n%s", out.String(), want)
+	}
+	if !report.Failed() {
+		t.Error("Expected the report to have failed")
+	}
+}
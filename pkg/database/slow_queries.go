@@ -0,0 +1,135 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RecentSlowQueryLimit is how many slow statements SlowQueryLog remembers
+const RecentSlowQueryLimit = 50
+
+// SlowQuery is one statement that took longer than the slow query threshold
+type SlowQuery struct {
+	At       time.Time
+	Query    string
+	Duration time.Duration
+	Tenant   string   // "" for the default database
+	Plan     []string // EXPLAIN QUERY PLAN details, filled in by Recent
+	PlanErr  string   // Why the plan could not be read, if it could not
+
+	db   *sql.DB
+	args int // Placeholders to bind when explaining
+}
+
+// SlowQueryLog records the statements run through BaseRepository that take
+// longer than a threshold. Query plans are read when the log is, so a slow
+// statement costs no second query while it holds the connection.
+type SlowQueryLog struct {
+	threshold time.Duration
+	mutex     sync.Mutex
+	queries   []SlowQuery // Oldest first, at most RecentSlowQueryLimit
+	onRecord  func(SlowQuery)
+}
+
+// NewSlowQueryLog creates a log of statements slower than threshold
+func NewSlowQueryLog(threshold time.Duration) *SlowQueryLog {
+	return &SlowQueryLog{threshold: threshold}
+}
+
+// Threshold returns the duration above which a statement is recorded
+func (l *SlowQueryLog) Threshold() time.Duration {
+	return l.threshold
+}
+
+// OnRecord calls fn with every slow statement as it is recorded, for logging
+func (l *SlowQueryLog) OnRecord(fn func(SlowQuery)) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.onRecord = fn
+}
+
+// Recent returns the recorded statements, oldest first, with their query
+// plans
+func (l *SlowQueryLog) Recent(ctx context.Context) []SlowQuery {
+	l.mutex.Lock()
+	queries := make([]SlowQuery, len(l.queries))
+	copy(queries, l.queries)
+	l.mutex.Unlock()
+
+	for i := range queries {
+		queries[i].Plan, queries[i].PlanErr = explain(ctx, queries[i])
+	}
+	return queries
+}
+
+// record adds a statement if it ran longer than the threshold
+func (l *SlowQueryLog) record(ctx context.Context, db *sql.DB, query string, args int, duration time.Duration) {
+	if duration <= l.threshold {
+		return
+	}
+	slow := SlowQuery{
+		At:       time.Now(),
+		Query:    strings.Join(strings.Fields(query), " "),
+		Duration: duration,
+		Tenant:   TenantFromContext(ctx),
+		db:       db,
+		args:     args,
+	}
+
+	l.mutex.Lock()
+	l.queries = append(l.queries, slow)
+	if len(l.queries) > RecentSlowQueryLimit {
+		l.queries = l.queries[len(l.queries)-RecentSlowQueryLimit:]
+	}
+	onRecord := l.onRecord
+	l.mutex.Unlock()
+
+	if onRecord != nil {
+		onRecord(slow)
+	}
+}
+
+// explain reads the plan SQLite chooses for a statement, binding NULL to its
+// placeholders; SQLite plans without looking at the values
+func explain(ctx context.Context, query SlowQuery) ([]string, string) {
+	rows, err := query.db.QueryContext(ctx, "EXPLAIN QUERY PLAN "+query.Query, make([]interface{}, query.args)...)
+	if err != nil {
+		return nil, err.Error()
+	}
+	defer rows.Close()
+
+	plan := []string{}
+	for rows.Next() {
+		var id, parent, notUsed int
+		var detail string
+		if err := rows.Scan(&id, &parent, &notUsed, &detail); err != nil {
+			return nil, fmt.Sprintf("failed to scan query plan: %v", err)
+		}
+		plan = append(plan, detail)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err.Error()
+	}
+	return plan, ""
+}
+
+// slowQueryKey is the context key of the log slow statements are recorded in
+type slowQueryKey struct{}
+
+// WithSlowQueryLog returns a context whose queries through BaseRepository are
+// timed and recorded in log when slow
+func WithSlowQueryLog(ctx context.Context, log *SlowQueryLog) context.Context {
+	return context.WithValue(ctx, slowQueryKey{}, log)
+}
+
+// timeQuery records a statement started at start in the slow query log of
+// ctx, if it has one; deferred by the BaseRepository helpers
+func timeQuery(ctx context.Context, db *sql.DB, query string, args int, start time.Time) {
+	if log, ok := ctx.Value(slowQueryKey{}).(*SlowQueryLog); ok && log != nil {
+		log.record(ctx, db, query, args, time.Since(start))
+	}
+}
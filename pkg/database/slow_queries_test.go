@@ -0,0 +1,80 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSlowQueryLog_RecordsBaseRepositoryStatements(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	db.SetMaxOpenConns(1) // Each in-memory connection is its own database
+
+	// A zero threshold records every statement
+	log := NewSlowQueryLog(0)
+	var logged []SlowQuery
+	log.OnRecord(func(query SlowQuery) { logged = append(logged, query) })
+	ctx := WithSlowQueryLog(context.Background(), log)
+	repo := NewBaseRepository(db)
+
+	if _, err := repo.ExecContext(ctx, "INSERT INTO test_entities (name, value) VALUES (?, ?)", "first", 1); err != nil {
+		t.Fatalf("ExecContext() error = %v", err)
+	}
+	if _, err := repo.Count(ctx, `
+		SELECT COUNT(*)
+		FROM test_entities
+		WHERE name = ?`, "first"); err != nil {
+		t.Fatalf("Count() error = %v", err)
+	}
+
+	recent := log.Recent(context.Background())
+	if len(recent) != 2 || len(logged) != 2 {
+		t.Fatalf("Expected 2 recorded and logged statements, got: %d and %d", len(recent), len(logged))
+	}
+	count := recent[1]
+	if count.Query != "SELECT COUNT(*) FROM test_entities WHERE name = ?" {
+		t.Errorf("Expected the statement on one line, got: %q", count.Query)
+	}
+	if count.PlanErr != "" || len(count.Plan) == 0 || !strings.Contains(count.Plan[0], "test_entities") {
+		t.Errorf("Expected a plan scanning test_entities, got: %v (%s)", count.Plan, count.PlanErr)
+	}
+}
+
+func TestSlowQueryLog_Threshold(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	log := NewSlowQueryLog(time.Hour)
+	repo := NewBaseRepository(db)
+	if _, err := repo.Count(WithSlowQueryLog(context.Background(), log), "SELECT COUNT(*) FROM test_entities"); err != nil {
+		t.Fatalf("Count() error = %v", err)
+	}
+	if _, err := repo.Count(context.Background(), "SELECT COUNT(*) FROM test_entities"); err != nil {
+		t.Fatalf("Count() error = %v", err)
+	}
+
+	if recent := log.Recent(context.Background()); len(recent) != 0 {
+		t.Errorf("Expected no statements under the threshold, got: %v", recent)
+	}
+}
+
+func TestSlowQueryLog_KeepsMostRecent(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	log := NewSlowQueryLog(0)
+	for i := 0; i < RecentSlowQueryLimit+5; i++ {
+		log.record(context.Background(), db, fmt.Sprintf("SELECT %d", i), 0, time.Millisecond)
+	}
+
+	recent := log.Recent(context.Background())
+	if len(recent) != RecentSlowQueryLimit {
+		t.Fatalf("Expected %d statements, got: %d", RecentSlowQueryLimit, len(recent))
+	}
+	if recent[0].Query != "SELECT 5" {
+		t.Errorf("Expected the oldest statements to be dropped, got: %q first", recent[0].Query)
+	}
+}
@@ -0,0 +1,46 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+)
+
+// tenantKey is the context key of the tenant a request is served for
+type tenantKey struct{}
+
+// tenantDB is a tenant and the database holding its library
+type tenantDB struct {
+	name string
+	db   *sql.DB
+}
+
+// WithTenant returns a context whose queries, through BaseRepository and
+// TransactionManager, run against db, the database of the named tenant
+func WithTenant(ctx context.Context, tenant string, db *sql.DB) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenantDB{name: tenant, db: db})
+}
+
+// TenantFromContext returns the tenant ctx is served for, or "" when it uses
+// the default database
+func TenantFromContext(ctx context.Context) string {
+	tenant, _ := ctx.Value(tenantKey{}).(tenantDB)
+	return tenant.name
+}
+
+// CopyTenant returns dst routed to the same tenant database as src, for work
+// that outlives the request it was started by
+func CopyTenant(dst, src context.Context) context.Context {
+	tenant, ok := src.Value(tenantKey{}).(tenantDB)
+	if !ok {
+		return dst
+	}
+	return context.WithValue(dst, tenantKey{}, tenant)
+}
+
+// conn returns the tenant database ctx is routed to, or fallback
+func conn(ctx context.Context, fallback *sql.DB) *sql.DB {
+	if tenant, ok := ctx.Value(tenantKey{}).(tenantDB); ok && tenant.db != nil {
+		return tenant.db
+	}
+	return fallback
+}
@@ -0,0 +1,50 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+)
+
+func TestWithTenant_RoutesQueries(t *testing.T) {
+	defaultDB := setupTestDB(t)
+	defer defaultDB.Close()
+	defaultDB.SetMaxOpenConns(1)
+	tenantDB := setupTestDB(t)
+	defer tenantDB.Close()
+	tenantDB.SetMaxOpenConns(1)
+
+	repo := NewBaseRepository(defaultDB)
+	ctx := WithTenant(context.Background(), "acme", tenantDB)
+
+	if _, err := repo.InsertWithID(ctx, "INSERT INTO test_entities (name) VALUES (?) RETURNING id", "tenant"); err != nil {
+		t.Fatalf("InsertWithID() error = %v", err)
+	}
+	err := NewTransactionManager(defaultDB).WithTransaction(ctx, func(tx *sql.Tx) error {
+		_, err := tx.Exec("INSERT INTO test_entities (name) VALUES ('tenant')")
+		return err
+	})
+	if err != nil {
+		t.Fatalf("WithTransaction() error = %v", err)
+	}
+
+	if count, _ := repo.Count(ctx, "SELECT COUNT(*) FROM test_entities"); count != 2 {
+		t.Errorf("Expected 2 rows in the tenant database, got: %d", count)
+	}
+	if count, _ := repo.Count(context.Background(), "SELECT COUNT(*) FROM test_entities"); count != 0 {
+		t.Errorf("Expected the default database to be untouched, got: %d rows", count)
+	}
+}
+
+func TestCopyTenant(t *testing.T) {
+	tenantDB := setupTestDB(t)
+	defer tenantDB.Close()
+
+	request := WithTenant(context.Background(), "acme", tenantDB)
+	if tenant := TenantFromContext(CopyTenant(context.Background(), request)); tenant != "acme" {
+		t.Errorf("Expected the tenant to be copied, got: %q", tenant)
+	}
+	if tenant := TenantFromContext(CopyTenant(context.Background(), context.Background())); tenant != "" {
+		t.Errorf("Expected no tenant, got: %q", tenant)
+	}
+}
@@ -19,7 +19,7 @@ func NewTransactionManager(db *sql.DB) *TransactionManager {
 // WithTransaction executes a function within a database transaction
 // The transaction is automatically committed on success or rolled back on error
 func (tm *TransactionManager) WithTransaction(ctx context.Context, fn func(*sql.Tx) error) error {
-	tx, err := tm.db.BeginTx(ctx, nil)
+	tx, err := conn(ctx, tm.db).BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -103,7 +103,7 @@ func (th *TransactionHelper) CheckRowsAffected(result sql.Result, entityType str
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("%s not found", entityType)
+		return fmt.Errorf("%s %w", entityType, ErrNotFound)
 	}
 
 	return nil
@@ -0,0 +1,135 @@
+// Package fuzzy provides typo-tolerant string matching for the movies MCP server.
+package fuzzy
+
+import (
+	"strings"
+	"unicode"
+)
+
+// DefaultThreshold is the minimum similarity for a fuzzy match, matching the
+// pg_trgm default similarity_threshold
+const DefaultThreshold = 0.3
+
+// wordMatchThreshold is the minimum edit-distance similarity for a query word
+// to count as a misspelling of a word in the text
+const wordMatchThreshold = 0.7
+
+// Similarity scores how closely text matches query, from 0 (unrelated) to
+// 1 (identical after normalization). It takes the better of trigram
+// similarity over the whole strings and a word score that credits each query
+// word with its closest, possibly misspelled, word in the text.
+func Similarity(query, text string) float64 {
+	query, text = normalize(query), normalize(text)
+	if query == "" || text == "" {
+		return 0
+	}
+	if query == text {
+		return 1
+	}
+
+	return max(TrigramSimilarity(query, text), wordSimilarity(query, text))
+}
+
+// TrigramSimilarity returns the ratio of shared trigrams to all trigrams of
+// both strings, computed the way pg_trgm does: each word is lowercased and
+// padded with two spaces in front and one behind before splitting.
+func TrigramSimilarity(a, b string) float64 {
+	left, right := trigrams(a), trigrams(b)
+	if len(left) == 0 || len(right) == 0 {
+		return 0
+	}
+
+	shared := 0
+	for trigram := range left {
+		if _, ok := right[trigram]; ok {
+			shared++
+		}
+	}
+
+	return float64(shared) / float64(len(left)+len(right)-shared)
+}
+
+// Levenshtein returns the number of single-rune insertions, deletions or
+// substitutions needed to turn a into b
+func Levenshtein(a, b string) int {
+	source, target := []rune(a), []rune(b)
+	if len(source) == 0 {
+		return len(target)
+	}
+	if len(target) == 0 {
+		return len(source)
+	}
+
+	previous := make([]int, len(target)+1)
+	current := make([]int, len(target)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(source); i++ {
+		current[0] = i
+		for j := 1; j <= len(target); j++ {
+			cost := 1
+			if source[i-1] == target[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(target)]
+}
+
+// wordSimilarity credits each query word with the edit-distance similarity of
+// its closest word in text and divides by the longer word count, so extra
+// words on either side lower the score. Words without a close counterpart
+// contribute nothing, so unrelated strings of similar length do not match.
+func wordSimilarity(query, text string) float64 {
+	queryWords, textWords := strings.Fields(query), strings.Fields(text)
+	if len(queryWords) == 0 || len(textWords) == 0 {
+		return 0
+	}
+
+	total := 0.0
+	for _, queryWord := range queryWords {
+		best := 0.0
+		for _, textWord := range textWords {
+			best = max(best, levenshteinSimilarity(queryWord, textWord))
+		}
+		if best >= wordMatchThreshold {
+			total += best
+		}
+	}
+
+	return total / float64(max(len(queryWords), len(textWords)))
+}
+
+// levenshteinSimilarity scales the edit distance by the longer string length
+func levenshteinSimilarity(a, b string) float64 {
+	longest := max(len([]rune(a)), len([]rune(b)))
+	if longest == 0 {
+		return 0
+	}
+	return 1 - float64(Levenshtein(a, b))/float64(longest)
+}
+
+// trigrams returns the set of padded word trigrams in s
+func trigrams(s string) map[string]struct{} {
+	set := make(map[string]struct{})
+	for _, word := range strings.Fields(normalize(s)) {
+		padded := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+			set[string(padded[i:i+3])] = struct{}{}
+		}
+	}
+	return set
+}
+
+// normalize lowercases s and collapses punctuation and whitespace to single spaces
+func normalize(s string) string {
+	fields := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(fields, " ")
+}
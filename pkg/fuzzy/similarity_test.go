@@ -0,0 +1,80 @@
+package fuzzy
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"shawshank", "shawshenk", 1},
+		{"café", "cafe", 1},
+	}
+
+	for _, tt := range tests {
+		if got := Levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("Levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestTrigramSimilarity(t *testing.T) {
+	if got := TrigramSimilarity("matrix", "matrix"); got != 1 {
+		t.Errorf("TrigramSimilarity() identical = %v, want 1", got)
+	}
+	if got := TrigramSimilarity("matrix", "godfather"); got != 0 {
+		t.Errorf("TrigramSimilarity() unrelated = %v, want 0", got)
+	}
+
+	// "cat" -> {"  c", " ca", "cat", "at "}; "cab" -> {"  c", " ca", "cab", "ab "}
+	if got := TrigramSimilarity("cat", "cab"); math.Abs(got-2.0/6.0) > 1e-9 {
+		t.Errorf("TrigramSimilarity(cat, cab) = %v, want 1/3", got)
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		name        string
+		query, text string
+		wantMatch   bool
+	}{
+		{"exact ignoring case and punctuation", "the matrix", "The Matrix!", true},
+		{"typo and missing article", "Shawshenk Redemption", "The Shawshank Redemption", true},
+		{"single misspelled word", "Inceptoin", "Inception", true},
+		{"partial title", "godfather", "The Godfather Part II", true},
+		{"misspelled name", "Keanu Reevs", "Keanu Reeves", true},
+		{"unrelated", "Shawshenk Redemption", "Finding Nemo", false},
+		{"unrelated with shared letters", "Shawshenk Redemption", "Inception", false},
+		{"empty query", "", "Finding Nemo", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Similarity(tt.query, tt.text)
+			if (got >= DefaultThreshold) != tt.wantMatch {
+				t.Errorf("Similarity(%q, %q) = %.2f, want match %v", tt.query, tt.text, got, tt.wantMatch)
+			}
+		})
+	}
+}
+
+func TestSimilarity_RanksCloserMatchesHigher(t *testing.T) {
+	query := "Shawshenk Redemption"
+
+	best := Similarity(query, "The Shawshank Redemption")
+	other := Similarity(query, "Redemption Road")
+
+	if best <= other {
+		t.Errorf("Expected closer title to score higher, got %.2f <= %.2f", best, other)
+	}
+
+	if exact, sequel := Similarity("Alien", "Alien"), Similarity("Alien", "Alien 3"); exact <= sequel {
+		t.Errorf("Expected exact title to outrank a longer one, got %.2f <= %.2f", exact, sequel)
+	}
+}
@@ -0,0 +1,305 @@
+// Package httpclient provides the outbound HTTP client shared by poster
+// downloads and external integrations: it retries transient failures with
+// jittered exponential backoff, stops calling hosts that keep failing, and
+// counts what it does.
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Defaults used for unset Config fields
+const (
+	DefaultMaxRetries       = 2
+	DefaultInitialBackoff   = 200 * time.Millisecond
+	DefaultMaxBackoff       = 5 * time.Second
+	DefaultFailureThreshold = 5
+	DefaultOpenDuration     = 30 * time.Second
+)
+
+// ErrCircuitOpen is returned, wrapped, when a request is refused because
+// its host's circuit is open after repeated failures
+var ErrCircuitOpen = errors.New("circuit open")
+
+// Config controls retries and circuit breaking; zero fields use the defaults
+type Config struct {
+	MaxRetries       int           `json:"max_retries"`       // Retries after the first attempt; negative disables retrying
+	InitialBackoff   time.Duration `json:"initial_backoff"`   // Delay before the first retry, doubled for each further retry
+	MaxBackoff       time.Duration `json:"max_backoff"`       // Upper bound on a retry delay, including Retry-After
+	FailureThreshold int           `json:"failure_threshold"` // Consecutive failures that open a host's circuit
+	OpenDuration     time.Duration `json:"open_duration"`     // Time an open circuit refuses requests before a trial request
+}
+
+// Metrics is a point-in-time view of a client's outbound traffic
+type Metrics struct {
+	Requests          int64    `json:"requests"`           // Calls to Do
+	Attempts          int64    `json:"attempts"`           // HTTP attempts, including retries
+	Retries           int64    `json:"retries"`            // Attempts after the first
+	Failures          int64    `json:"failures"`           // Requests that failed after their last attempt
+	CircuitRejections int64    `json:"circuit_rejections"` // Requests refused by an open circuit
+	OpenCircuits      []string `json:"open_circuits"`      // Hosts whose circuit is open
+}
+
+// Client wraps an http.Client with retries and per-host circuit breaking.
+// It is safe for concurrent use.
+type Client struct {
+	httpClient *http.Client
+	config     Config
+
+	mutex    sync.Mutex
+	circuits map[string]*circuit
+
+	requests, attempts, retries, failures, rejections atomic.Int64
+}
+
+// circuit tracks the health of one host. It opens after FailureThreshold
+// consecutive failures; once OpenDuration passes, one trial request is let
+// through, whose success closes it again.
+type circuit struct {
+	failures  int
+	openUntil time.Time
+	trial     bool
+}
+
+// New creates a client around httpClient; a nil httpClient uses one with a
+// 30 second timeout
+func New(httpClient *http.Client, cfg Config) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	if cfg.MaxRetries == 0 {
+		cfg.MaxRetries = DefaultMaxRetries
+	}
+	if cfg.InitialBackoff <= 0 {
+		cfg.InitialBackoff = DefaultInitialBackoff
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = DefaultMaxBackoff
+	}
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = DefaultFailureThreshold
+	}
+	if cfg.OpenDuration <= 0 {
+		cfg.OpenDuration = DefaultOpenDuration
+	}
+	return &Client{
+		httpClient: httpClient,
+		config:     cfg,
+		circuits:   make(map[string]*circuit),
+	}
+}
+
+// Do sends a request, retrying network errors and 429, 500, 502, 503 and
+// 504 responses while the request's context allows. Requests with a body
+// are only retried when it can be replayed through GetBody. The final
+// response is returned as is, whatever its status; refusals by an open
+// circuit wrap ErrCircuitOpen.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	c.requests.Add(1)
+	host := req.URL.Host
+	retries := max(c.config.MaxRetries, 0)
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		retries = 0
+	}
+
+	backoff := c.config.InitialBackoff
+	for attempt := 0; ; attempt++ {
+		if !c.allow(host) {
+			c.rejections.Add(1)
+			c.failures.Add(1)
+			return nil, fmt.Errorf("%w for %s after repeated failures", ErrCircuitOpen, host)
+		}
+		if attempt > 0 {
+			c.retries.Add(1)
+			if err := rewind(req); err != nil {
+				c.failures.Add(1)
+				return nil, err
+			}
+		}
+
+		c.attempts.Add(1)
+		resp, err := c.httpClient.Do(req)
+		transient := isTransient(resp, err)
+		switch {
+		case transient:
+			c.record(host, true)
+		case err == nil:
+			c.record(host, false)
+		default: // Cancelled or refused: says nothing about the host's health
+			c.release(host)
+		}
+		if !transient || attempt == retries || req.Context().Err() != nil {
+			if err != nil || transient {
+				c.failures.Add(1)
+			}
+			return resp, err
+		}
+
+		delay := jitter(backoff)
+		if after, ok := retryAfter(resp); ok {
+			delay = after
+		}
+		delay = min(delay, c.config.MaxBackoff)
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+			_ = resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			c.failures.Add(1)
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+		backoff = min(backoff*2, c.config.MaxBackoff)
+	}
+}
+
+// Metrics returns a snapshot of the client's counters and open circuits
+func (c *Client) Metrics() Metrics {
+	c.mutex.Lock()
+	open := []string{}
+	now := time.Now()
+	for host, state := range c.circuits {
+		if state.failures >= c.config.FailureThreshold && (now.Before(state.openUntil) || state.trial) {
+			open = append(open, host)
+		}
+	}
+	c.mutex.Unlock()
+	slices.Sort(open)
+
+	return Metrics{
+		Requests:          c.requests.Load(),
+		Attempts:          c.attempts.Load(),
+		Retries:           c.retries.Load(),
+		Failures:          c.failures.Load(),
+		CircuitRejections: c.rejections.Load(),
+		OpenCircuits:      open,
+	}
+}
+
+// allow reports whether a request to host may be attempted, claiming the
+// trial request of a circuit whose open period has passed
+func (c *Client) allow(host string) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	state, ok := c.circuits[host]
+	if !ok || state.failures < c.config.FailureThreshold {
+		return true
+	}
+	if state.trial || time.Now().Before(state.openUntil) {
+		return false
+	}
+	state.trial = true
+	return true
+}
+
+// record updates host's circuit with the outcome of an attempt
+func (c *Client) record(host string, failed bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if !failed {
+		delete(c.circuits, host)
+		return
+	}
+	state, ok := c.circuits[host]
+	if !ok {
+		state = &circuit{}
+		c.circuits[host] = state
+	}
+	state.failures++
+	state.trial = false
+	if state.failures >= c.config.FailureThreshold {
+		state.openUntil = time.Now().Add(c.config.OpenDuration)
+	}
+}
+
+// release ends a trial request whose outcome says nothing about host, so
+// the next request can be the trial
+func (c *Client) release(host string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if state, ok := c.circuits[host]; ok {
+		state.trial = false
+	}
+}
+
+// isTransient reports whether an attempt failed in a way worth retrying:
+// a network error other than cancellation or a Permanent error, or a
+// throttling or server error status
+func isTransient(resp *http.Response, err error) bool {
+	if err != nil {
+		var permanent *permanentError
+		return !errors.As(err, &permanent) && !errors.Is(err, context.Canceled)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// retryAfter reads a Retry-After header given in seconds
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
+// jitter returns a random delay between half of backoff and backoff, so
+// clients that failed together do not retry together
+func jitter(backoff time.Duration) time.Duration {
+	return backoff/2 + rand.N(backoff/2+1)
+}
+
+// rewind restores a request's body before it is sent again
+func rewind(req *http.Request) error {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return fmt.Errorf("failed to replay request body: %w", err)
+	}
+	req.Body = body
+	return nil
+}
+
+// permanentError marks an error that retrying cannot fix
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent marks err as not worth retrying, e.g. a request refused by a
+// dialer or redirect policy; the result still matches err with errors.Is.
+// Permanent errors do not count against a host's circuit.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
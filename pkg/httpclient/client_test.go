@@ -0,0 +1,219 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fastConfig retries and reopens circuits quickly enough for tests
+var fastConfig = Config{
+	MaxRetries:       2,
+	InitialBackoff:   time.Millisecond,
+	MaxBackoff:       5 * time.Millisecond,
+	FailureThreshold: 3,
+	OpenDuration:     50 * time.Millisecond,
+}
+
+// newFlakyServer returns a server that answers status for its first
+// failures requests and 200 OK afterwards, counting every request
+func newFlakyServer(t *testing.T, status, failures int) (*httptest.Server, *atomic.Int64) {
+	t.Helper()
+
+	var calls atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= int64(failures) {
+			w.WriteHeader(status)
+			return
+		}
+		_, _ = io.Copy(w, r.Body)
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func get(t *testing.T, client *Client, url string) (*http.Response, error) {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	resp, err := client.Do(req)
+	if resp != nil {
+		t.Cleanup(func() { resp.Body.Close() })
+	}
+	return resp, err
+}
+
+func TestClient_Do_RetriesTransientStatus(t *testing.T) {
+	server, calls := newFlakyServer(t, http.StatusServiceUnavailable, 2)
+	client := New(server.Client(), fastConfig)
+
+	resp, err := get(t, client, server.URL)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected 200 after retries, got: %v (%v)", resp, err)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("Expected 3 attempts, got: %d", calls.Load())
+	}
+
+	metrics := client.Metrics()
+	if metrics.Requests != 1 || metrics.Attempts != 3 || metrics.Retries != 2 || metrics.Failures != 0 {
+		t.Errorf("Unexpected metrics: %+v", metrics)
+	}
+}
+
+func TestClient_Do_GivesUpAfterMaxRetries(t *testing.T) {
+	server, calls := newFlakyServer(t, http.StatusBadGateway, 10)
+	client := New(server.Client(), Config{MaxRetries: 1, InitialBackoff: time.Millisecond})
+
+	resp, err := get(t, client, server.URL)
+	if err != nil || resp.StatusCode != http.StatusBadGateway {
+		t.Fatalf("Expected the final 502 response, got: %v (%v)", resp, err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("Expected 2 attempts, got: %d", calls.Load())
+	}
+	if metrics := client.Metrics(); metrics.Failures != 1 {
+		t.Errorf("Expected 1 failure, got: %+v", metrics)
+	}
+}
+
+func TestClient_Do_DoesNotRetry(t *testing.T) {
+	t.Run("client error", func(t *testing.T) {
+		server, calls := newFlakyServer(t, http.StatusNotFound, 10)
+		client := New(server.Client(), fastConfig)
+
+		if resp, err := get(t, client, server.URL); err != nil || resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("Expected 404, got: %v (%v)", resp, err)
+		}
+		if calls.Load() != 1 {
+			t.Errorf("Expected 1 attempt, got: %d", calls.Load())
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		server, calls := newFlakyServer(t, http.StatusServiceUnavailable, 10)
+		client := New(server.Client(), Config{MaxRetries: -1})
+
+		if _, err := get(t, client, server.URL); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if calls.Load() != 1 {
+			t.Errorf("Expected 1 attempt, got: %d", calls.Load())
+		}
+	})
+
+	t.Run("permanent error", func(t *testing.T) {
+		refused := errors.New("refused")
+		httpClient := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return Permanent(refused) }}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, "/elsewhere", http.StatusFound)
+		}))
+		defer server.Close()
+		client := New(httpClient, fastConfig)
+
+		_, err := get(t, client, server.URL)
+		if !errors.Is(err, refused) {
+			t.Errorf("Expected the permanent error, got: %v", err)
+		}
+		if metrics := client.Metrics(); metrics.Attempts != 1 || len(metrics.OpenCircuits) != 0 {
+			t.Errorf("Expected 1 attempt and no open circuit, got: %+v", metrics)
+		}
+	})
+}
+
+func TestClient_Do_ReplaysBody(t *testing.T) {
+	server, _ := newFlakyServer(t, http.StatusServiceUnavailable, 1)
+	client := New(server.Client(), fastConfig)
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("payload"))
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if body, _ := io.ReadAll(resp.Body); string(body) != "payload" {
+		t.Errorf("Expected the body to be replayed, got: %q", body)
+	}
+}
+
+func TestClient_Do_HonoursContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+	client := New(server.Client(), Config{MaxBackoff: time.Minute})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+
+	start := time.Now()
+	if _, err := client.Do(req); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded while waiting to retry, got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Do() took %v, should stop waiting when the context ends", elapsed)
+	}
+}
+
+func TestClient_CircuitBreaker(t *testing.T) {
+	var healthy atomic.Bool
+	var calls atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+	client := New(server.Client(), Config{MaxRetries: -1, FailureThreshold: 3, OpenDuration: 50 * time.Millisecond})
+
+	for range 3 {
+		if _, err := get(t, client, server.URL); err != nil {
+			t.Fatalf("Expected failing responses before the circuit opens, got: %v", err)
+		}
+	}
+
+	if _, err := get(t, client, server.URL); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Expected ErrCircuitOpen, got: %v", err)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("Expected the open circuit to skip the server, got %d calls", calls.Load())
+	}
+	metrics := client.Metrics()
+	if metrics.CircuitRejections != 1 || len(metrics.OpenCircuits) != 1 {
+		t.Errorf("Expected one rejection and one open circuit, got: %+v", metrics)
+	}
+
+	// After the open period a trial request goes through and closes it
+	time.Sleep(60 * time.Millisecond)
+	healthy.Store(true)
+	if resp, err := get(t, client, server.URL); err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected the trial request to succeed, got: %v (%v)", resp, err)
+	}
+	if _, err := get(t, client, server.URL); err != nil {
+		t.Errorf("Expected the circuit to be closed, got: %v", err)
+	}
+	if metrics := client.Metrics(); len(metrics.OpenCircuits) != 0 {
+		t.Errorf("Expected no open circuits, got: %v", metrics.OpenCircuits)
+	}
+}
+
+func TestJitter(t *testing.T) {
+	for range 100 {
+		if delay := jitter(100 * time.Millisecond); delay < 50*time.Millisecond || delay > 100*time.Millisecond {
+			t.Fatalf("jitter() = %v, want between 50ms and 100ms", delay)
+		}
+	}
+}
@@ -1,11 +1,32 @@
 package image
 
+import (
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/pkg/httpclient"
+)
+
 // ImageConfig contains configuration for image processing
 type ImageConfig struct {
 	MaxSize          int64    `json:"max_size"`
 	AllowedTypes     []string `json:"allowed_types"`
 	EnableThumbnails bool     `json:"enable_thumbnails"`
 	ThumbnailSize    string   `json:"thumbnail_size"`
+
+	// Processing applied to downloaded and uploaded images; see Process
+	OutputFormat  string `json:"output_format"`  // "jpeg" or "png"; empty keeps the image's format
+	Quality       int    `json:"quality"`        // JPEG quality 1-100; 0 uses DefaultQuality
+	StripMetadata bool   `json:"strip_metadata"` // Remove EXIF, XMP and text metadata
+	MaxWidth      int    `json:"max_width"`      // Scale wider images down; 0 is unlimited
+	MaxHeight     int    `json:"max_height"`     // Scale taller images down; 0 is unlimited
+
+	// Policy for DownloadImageFromURL; refusals wrap ErrDownloadRefused
+	AllowedHosts         []string      `json:"allowed_hosts"`          // Only these hosts and their subdomains; empty allows any
+	DeniedHosts          []string      `json:"denied_hosts"`           // Never these hosts or their subdomains
+	AllowPrivateNetworks bool          `json:"allow_private_networks"` // Permit loopback, private and link-local addresses
+	DownloadTimeout      time.Duration `json:"download_timeout"`       // Whole-download limit; 0 uses DefaultDownloadTimeout
+
+	HTTP httpclient.Config `json:"http"` // Retries and circuit breaking for downloads
 }
 
 // DefaultImageConfig returns default image configuration
@@ -15,5 +36,6 @@ func DefaultImageConfig() *ImageConfig {
 		AllowedTypes:     []string{"image/jpeg", "image/png", "image/webp"},
 		EnableThumbnails: true,
 		ThumbnailSize:    "200x200",
+		DownloadTimeout:  DefaultDownloadTimeout,
 	}
 }
@@ -0,0 +1,148 @@
+package image
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/francknouama/movies-mcp-server/pkg/httpclient"
+)
+
+// DefaultDownloadTimeout bounds a whole download when
+// ImageConfig.DownloadTimeout is unset
+const DefaultDownloadTimeout = 30 * time.Second
+
+// maxRedirects is the number of redirects a download follows
+const maxRedirects = 5
+
+// ErrDownloadRefused is returned, wrapped, when the download policy refuses
+// a URL: its scheme or host is not allowed, it resolves to a private
+// address, or it redirects too often or to a refused URL
+var ErrDownloadRefused = errors.New("image download refused")
+
+// blockedPrefixes are the non-public ranges not covered by the netip.Addr
+// predicates in isPrivateAddr
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // "This" network
+	netip.MustParsePrefix("100.64.0.0/10"), // Carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),  // IETF protocol assignments
+	netip.MustParsePrefix("198.18.0.0/15"), // Benchmarking
+	netip.MustParsePrefix("240.0.0.0/4"),   // Reserved, including broadcast
+	netip.MustParsePrefix("64:ff9b::/96"),  // NAT64, which can reach private IPv4
+}
+
+// refused reports a policy refusal, marked permanent so it is neither
+// retried nor counted against the host's circuit
+func refused(format string, args ...any) error {
+	return httpclient.Permanent(fmt.Errorf("%w: %s", ErrDownloadRefused, fmt.Sprintf(format, args...)))
+}
+
+// newDownloadClient returns an HTTP client that enforces the download
+// policy on the first request, on every redirect and on every address it
+// connects to, so hostnames resolving to private addresses are refused too
+func (p *ImageProcessor) newDownloadClient() *http.Client {
+	timeout := p.config.DownloadTimeout
+	if timeout <= 0 {
+		timeout = DefaultDownloadTimeout
+	}
+
+	dialer := &net.Dialer{
+		Timeout: min(timeout, 10*time.Second),
+		Control: p.checkDialAddress,
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:                 nil, // A proxy would connect on our behalf, bypassing the address checks
+			DialContext:           dialer.DialContext,
+			TLSHandshakeTimeout:   min(timeout, 10*time.Second),
+			ResponseHeaderTimeout: timeout,
+			DisableKeepAlives:     true,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return refused("more than %d redirects", maxRedirects)
+			}
+			return p.checkURL(req.URL)
+		},
+	}
+}
+
+// checkURL applies the scheme and host rules of the download policy
+func (p *ImageProcessor) checkURL(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return refused("scheme %q is not http or https", u.Scheme)
+	}
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "" {
+		return refused("URL has no host")
+	}
+
+	if matchesHost(host, p.config.DeniedHosts) {
+		return refused("host %s is denied", host)
+	}
+	if len(p.config.AllowedHosts) > 0 && !matchesHost(host, p.config.AllowedHosts) {
+		return refused("host %s is not in the allowed hosts", host)
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && !p.config.AllowPrivateNetworks && isPrivateAddr(addr) {
+		return refused("address %s is not public", addr)
+	}
+	return nil
+}
+
+// checkDialAddress refuses connections to non-public addresses unless
+// private networks are allowed; it runs after DNS resolution
+func (p *ImageProcessor) checkDialAddress(network, address string, _ syscall.RawConn) error {
+	if p.config.AllowPrivateNetworks {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return refused("invalid address %s", address)
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return refused("invalid address %s", address)
+	}
+	if isPrivateAddr(addr) {
+		return refused("address %s is not public", addr)
+	}
+	return nil
+}
+
+// matchesHost reports whether host is one of the patterns or a subdomain
+// of one; patterns may be written as example.com, .example.com or
+// *.example.com
+func matchesHost(host string, patterns []string) bool {
+	for _, pattern := range patterns {
+		pattern = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(pattern)), ".")
+		pattern = strings.TrimPrefix(strings.TrimPrefix(pattern, "*"), ".")
+		if pattern != "" && (host == pattern || strings.HasSuffix(host, "."+pattern)) {
+			return true
+		}
+	}
+	return false
+}
+
+// isPrivateAddr reports whether addr is loopback, private, link-local,
+// multicast, unspecified or otherwise not publicly routable
+func isPrivateAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() {
+		return true
+	}
+	for _, prefix := range blockedPrefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
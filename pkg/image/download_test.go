@@ -0,0 +1,168 @@
+package image
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestImageProcessor_DownloadImageFromURL_Refused(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/jpeg")
+		w.Write(createValidJPEGImage())
+	}))
+	defer server.Close()
+	serverURL, _ := url.Parse(server.URL)
+	viaLocalhost := "http://localhost:" + serverURL.Port()
+
+	tests := []struct {
+		name   string
+		config ImageConfig
+		url    string
+	}{
+		{name: "private address", url: server.URL},
+		{name: "hostname resolving to a private address", url: viaLocalhost},
+		{name: "not http", config: ImageConfig{AllowPrivateNetworks: true}, url: "file:///etc/passwd"},
+		{name: "denied host", config: ImageConfig{AllowPrivateNetworks: true, DeniedHosts: []string{"127.0.0.1"}}, url: server.URL},
+		{name: "host not allowed", config: ImageConfig{AllowPrivateNetworks: true, AllowedHosts: []string{"image.tmdb.org"}}, url: server.URL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config.MaxSize = 1024 * 1024
+			tt.config.AllowedTypes = []string{MimeTypeJPEG}
+			processor := NewImageProcessor(&tt.config)
+
+			_, _, err := processor.DownloadImageFromURL(tt.url)
+			if !errors.Is(err, ErrDownloadRefused) {
+				t.Errorf("DownloadImageFromURL() error = %v, want ErrDownloadRefused", err)
+			}
+		})
+	}
+}
+
+func TestImageProcessor_DownloadImageFromURL_Redirects(t *testing.T) {
+	var target *httptest.Server
+	target = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/image":
+			w.Header().Set("Content-Type", "image/jpeg")
+			w.Write(createValidJPEGImage())
+		case "/loop":
+			http.Redirect(w, r, "/loop", http.StatusFound)
+		case "/elsewhere":
+			u, _ := url.Parse(target.URL)
+			http.Redirect(w, r, "http://localhost:"+u.Port()+"/image", http.StatusFound)
+		default:
+			http.Redirect(w, r, "/image", http.StatusFound)
+		}
+	}))
+	defer target.Close()
+
+	processor := NewImageProcessor(&ImageConfig{
+		MaxSize:              1024 * 1024,
+		AllowedTypes:         []string{MimeTypeJPEG},
+		AllowPrivateNetworks: true,
+		AllowedHosts:         []string{"127.0.0.1"},
+	})
+
+	if _, _, err := processor.DownloadImageFromURL(target.URL + "/poster"); err != nil {
+		t.Errorf("DownloadImageFromURL() should follow an allowed redirect, got: %v", err)
+	}
+	if _, _, err := processor.DownloadImageFromURL(target.URL + "/elsewhere"); !errors.Is(err, ErrDownloadRefused) {
+		t.Errorf("DownloadImageFromURL() error = %v, want a refused redirect to a host not allowed", err)
+	}
+	if _, _, err := processor.DownloadImageFromURL(target.URL + "/loop"); !errors.Is(err, ErrDownloadRefused) {
+		t.Errorf("DownloadImageFromURL() error = %v, want too many redirects refused", err)
+	}
+}
+
+func TestImageProcessor_DownloadImageFromURL_Limits(t *testing.T) {
+	t.Run("Declared size too large", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "image/jpeg")
+			w.Header().Set("Content-Length", "2048")
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		processor := NewImageProcessor(&ImageConfig{MaxSize: 1024, AllowedTypes: []string{MimeTypeJPEG}, AllowPrivateNetworks: true})
+		_, _, err := processor.DownloadImageFromURL(server.URL)
+		if err == nil || !strings.Contains(err.Error(), "exceeds maximum allowed size") {
+			t.Errorf("DownloadImageFromURL() error = %v, want the size limit", err)
+		}
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-release:
+			}
+		}))
+		defer server.Close()
+		defer close(release)
+
+		processor := NewImageProcessor(&ImageConfig{
+			MaxSize:              1024,
+			AllowedTypes:         []string{MimeTypeJPEG},
+			AllowPrivateNetworks: true,
+			DownloadTimeout:      20 * time.Millisecond,
+		})
+
+		start := time.Now()
+		if _, _, err := processor.DownloadImageFromURL(server.URL); err == nil {
+			t.Error("DownloadImageFromURL() should time out")
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("DownloadImageFromURL() took %v, should honour DownloadTimeout", elapsed)
+		}
+	})
+}
+
+func TestMatchesHost(t *testing.T) {
+	patterns := []string{"tmdb.org", "*.example.com", ".Wikimedia.org."}
+
+	for host, want := range map[string]bool{
+		"tmdb.org":                true,
+		"image.tmdb.org":          true,
+		"nottmdb.org":             false,
+		"cdn.example.com":         true,
+		"example.com":             true,
+		"upload.wikimedia.org":    true,
+		"wikimedia.org.evil.test": false,
+	} {
+		if got := matchesHost(host, patterns); got != want {
+			t.Errorf("matchesHost(%q) = %v, want %v", host, got, want)
+		}
+	}
+}
+
+func TestIsPrivateAddr(t *testing.T) {
+	for addr, want := range map[string]bool{
+		"127.0.0.1":        true,
+		"10.1.2.3":         true,
+		"172.16.0.1":       true,
+		"192.168.1.1":      true,
+		"169.254.169.254":  true, // Cloud metadata endpoint
+		"100.64.0.1":       true,
+		"0.0.0.0":          true,
+		"::1":              true,
+		"fd00::1":          true,
+		"fe80::1":          true,
+		"::ffff:127.0.0.1": true,
+		"64:ff9b::a00:1":   true,
+		"8.8.8.8":          false,
+		"2606:4700::1111":  false,
+	} {
+		if got := isPrivateAddr(netip.MustParseAddr(addr)); got != want {
+			t.Errorf("isPrivateAddr(%s) = %v, want %v", addr, got, want)
+		}
+	}
+}
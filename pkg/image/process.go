@@ -0,0 +1,286 @@
+package image
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"strings"
+)
+
+// DefaultQuality is the JPEG quality used when ImageConfig.Quality is unset
+const DefaultQuality = 85
+
+// OutputFormats maps the ImageConfig.OutputFormat values to the MIME type
+// images are converted to; the empty format keeps each image's own. There
+// is no WebP encoder in the standard library, so WebP is not a target.
+var OutputFormats = map[string]string{
+	"":     "",
+	"jpeg": MimeTypeJPEG,
+	"jpg":  MimeTypeJPEG,
+	"png":  MimeTypePNG,
+}
+
+// metadataChunks are the PNG chunks and WebP chunks holding EXIF, XMP,
+// text and timestamp metadata that StripMetadata removes
+var metadataChunks = map[string]bool{
+	"eXIf": true, "tEXt": true, "zTXt": true, "iTXt": true, "tIME": true, // PNG
+	"EXIF": true, "XMP ": true, // WebP
+}
+
+// Process applies the configured pipeline to a validated image: it scales
+// the image down to fit MaxWidth and MaxHeight, converts it to OutputFormat
+// and strips its metadata, returning the result and its MIME type. An image
+// that needs none of these is returned unchanged. WebP images cannot be
+// decoded, so they keep their format and fail if larger than the maximum.
+func (p *ImageProcessor) Process(data []byte, mimeType string) ([]byte, string, error) {
+	target, ok := OutputFormats[strings.ToLower(p.config.OutputFormat)]
+	if !ok {
+		return nil, "", fmt.Errorf("unsupported output format %q", p.config.OutputFormat)
+	}
+	if target == "" || mimeType == MimeTypeWebP {
+		target = mimeType
+	}
+	if target == mimeType && p.config.MaxWidth <= 0 && p.config.MaxHeight <= 0 && !p.config.StripMetadata {
+		return data, mimeType, nil
+	}
+
+	width, height, err := p.dimensions(data, mimeType)
+	if err != nil {
+		return nil, "", err
+	}
+	fitWidth, fitHeight := p.fit(width, height)
+	resize := fitWidth != width || fitHeight != height
+
+	if resize && mimeType == MimeTypeWebP {
+		return nil, "", fmt.Errorf("WebP image of %dx%d pixels exceeds the maximum of %s and cannot be resized",
+			width, height, p.maxDimensions())
+	}
+	if !resize && target == mimeType {
+		if p.config.StripMetadata {
+			stripped, err := stripMetadata(data, mimeType)
+			return stripped, mimeType, err
+		}
+		return data, mimeType, nil
+	}
+
+	// Re-encoding writes no metadata, so the result is always stripped
+	img, err := p.decodeImage(data, mimeType)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to decode image: %w", err)
+	}
+	if resize {
+		img = p.resizeImage(img, fitWidth, fitHeight)
+	}
+	encoded, err := p.encodeImage(img, target)
+	if err != nil {
+		return nil, "", err
+	}
+	return encoded, target, nil
+}
+
+// dimensions reads an image's size from its header without decoding it
+func (p *ImageProcessor) dimensions(data []byte, mimeType string) (int, int, error) {
+	if mimeType == MimeTypeWebP {
+		return webpDimensions(data)
+	}
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to read image dimensions: %w", err)
+	}
+	return cfg.Width, cfg.Height, nil
+}
+
+// fit returns the largest size within MaxWidth and MaxHeight that keeps
+// the image's aspect ratio; images already within them keep their size
+func (p *ImageProcessor) fit(width, height int) (int, int) {
+	scale := 1.0
+	if p.config.MaxWidth > 0 && width > p.config.MaxWidth {
+		scale = float64(p.config.MaxWidth) / float64(width)
+	}
+	if p.config.MaxHeight > 0 && height > p.config.MaxHeight {
+		scale = min(scale, float64(p.config.MaxHeight)/float64(height))
+	}
+	if scale == 1 {
+		return width, height
+	}
+	return max(1, int(float64(width)*scale)), max(1, int(float64(height)*scale))
+}
+
+// maxDimensions describes MaxWidth and MaxHeight, e.g. "1000x1500"
+func (p *ImageProcessor) maxDimensions() string {
+	limit := func(n int) string {
+		if n <= 0 {
+			return "any"
+		}
+		return fmt.Sprint(n)
+	}
+	return limit(p.config.MaxWidth) + "x" + limit(p.config.MaxHeight)
+}
+
+// encodeImage encodes an image as JPEG at the configured quality, with
+// transparency flattened onto white, or as PNG
+func (p *ImageProcessor) encodeImage(img image.Image, mimeType string) ([]byte, error) {
+	var buf bytes.Buffer
+	switch mimeType {
+	case MimeTypeJPEG:
+		quality := p.config.Quality
+		if quality <= 0 {
+			quality = DefaultQuality
+		}
+		flat := image.NewRGBA(img.Bounds())
+		draw.Draw(flat, flat.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+		draw.Draw(flat, flat.Bounds(), img, img.Bounds().Min, draw.Over)
+		if err := jpeg.Encode(&buf, flat, &jpeg.Options{Quality: min(quality, 100)}); err != nil {
+			return nil, fmt.Errorf("failed to encode JPEG: %w", err)
+		}
+	case MimeTypePNG:
+		if err := png.Encode(&buf, img); err != nil {
+			return nil, fmt.Errorf("failed to encode PNG: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("cannot encode images as %s", mimeType)
+	}
+	return buf.Bytes(), nil
+}
+
+// stripMetadata removes EXIF, XMP and text metadata from an image without
+// re-encoding it; color profiles and everything needed to render it stay
+func stripMetadata(data []byte, mimeType string) ([]byte, error) {
+	switch mimeType {
+	case MimeTypeJPEG:
+		return stripJPEGMetadata(data)
+	case MimeTypePNG:
+		return stripPNGMetadata(data)
+	case MimeTypeWebP:
+		return stripWebPMetadata(data)
+	default:
+		return data, nil
+	}
+}
+
+// stripJPEGMetadata drops the APP1 (EXIF, XMP), APP13 (IPTC) and comment
+// segments before the image data; JFIF, ICC and Adobe segments are kept
+func stripJPEGMetadata(data []byte) ([]byte, error) {
+	if len(data) < 2 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil, fmt.Errorf("invalid JPEG: missing start of image")
+	}
+
+	out := append(make([]byte, 0, len(data)), data[:2]...)
+	for pos := 2; pos < len(data); {
+		if data[pos] != 0xFF {
+			return nil, fmt.Errorf("invalid JPEG: expected a marker at offset %d", pos)
+		}
+		marker := data[pos+1:]
+		if len(marker) == 0 {
+			return nil, fmt.Errorf("invalid JPEG: truncated marker")
+		}
+		switch code := marker[0]; {
+		case code == 0xFF: // Fill byte
+			pos++
+			continue
+		case code == 0xDA, code == 0xD9: // Start of scan or end of image: the rest is image data
+			return append(out, data[pos:]...), nil
+		case code == 0x01 || code >= 0xD0 && code <= 0xD7: // Markers without a length
+			out = append(out, data[pos:pos+2]...)
+			pos += 2
+			continue
+		}
+
+		if pos+4 > len(data) {
+			return nil, fmt.Errorf("invalid JPEG: truncated segment")
+		}
+		end := pos + 2 + int(binary.BigEndian.Uint16(data[pos+2:]))
+		if end > len(data) || end < pos+4 {
+			return nil, fmt.Errorf("invalid JPEG: segment overruns the image")
+		}
+		if code := data[pos+1]; code != 0xE1 && code != 0xED && code != 0xFE {
+			out = append(out, data[pos:end]...)
+		}
+		pos = end
+	}
+	return nil, fmt.Errorf("invalid JPEG: missing image data")
+}
+
+// stripPNGMetadata drops the metadata chunks of a PNG
+func stripPNGMetadata(data []byte) ([]byte, error) {
+	const signatureSize = 8
+	if len(data) < signatureSize {
+		return nil, fmt.Errorf("invalid PNG: missing signature")
+	}
+
+	out := append(make([]byte, 0, len(data)), data[:signatureSize]...)
+	for pos := signatureSize; pos < len(data); {
+		if pos+8 > len(data) {
+			return nil, fmt.Errorf("invalid PNG: truncated chunk")
+		}
+		end := pos + 12 + int(binary.BigEndian.Uint32(data[pos:]))
+		if end > len(data) || end < pos+12 {
+			return nil, fmt.Errorf("invalid PNG: chunk overruns the image")
+		}
+		if !metadataChunks[string(data[pos+4:pos+8])] {
+			out = append(out, data[pos:end]...)
+		}
+		pos = end
+	}
+	return out, nil
+}
+
+// stripWebPMetadata drops the EXIF and XMP chunks of a WebP, clearing their
+// flags in the extended header and updating the RIFF size
+func stripWebPMetadata(data []byte) ([]byte, error) {
+	const headerSize = 12
+	if len(data) < headerSize || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return nil, fmt.Errorf("invalid WebP: missing RIFF header")
+	}
+
+	out := append(make([]byte, 0, len(data)), data[:headerSize]...)
+	for pos := headerSize; pos < len(data); {
+		if pos+8 > len(data) {
+			return nil, fmt.Errorf("invalid WebP: truncated chunk")
+		}
+		size := int(binary.LittleEndian.Uint32(data[pos+4:]))
+		end := pos + 8 + size + size%2 // Chunks are padded to an even size
+		if end > len(data) || size < 0 {
+			return nil, fmt.Errorf("invalid WebP: chunk overruns the image")
+		}
+		fourCC := string(data[pos : pos+4])
+		if !metadataChunks[fourCC] {
+			start := len(out)
+			out = append(out, data[pos:end]...)
+			if fourCC == "VP8X" && size > 0 {
+				out[start+8] &^= 0x08 | 0x04 // EXIF and XMP flags
+			}
+		}
+		pos = end
+	}
+	binary.LittleEndian.PutUint32(out[4:], uint32(len(out)-8))
+	return out, nil
+}
+
+// webpDimensions reads a WebP's size from its VP8X, VP8 or VP8L header
+func webpDimensions(data []byte) (int, int, error) {
+	if len(data) < 30 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return 0, 0, fmt.Errorf("invalid WebP: missing header")
+	}
+
+	chunk := data[20:]
+	switch string(data[12:16]) {
+	case "VP8X": // 24-bit canvas width and height, minus one
+		width := int(chunk[4]) | int(chunk[5])<<8 | int(chunk[6])<<16
+		height := int(chunk[7]) | int(chunk[8])<<8 | int(chunk[9])<<16
+		return width + 1, height + 1, nil
+	case "VP8 ": // 14-bit width and height after the frame tag and start code
+		return int(binary.LittleEndian.Uint16(chunk[6:])) & 0x3FFF,
+			int(binary.LittleEndian.Uint16(chunk[8:])) & 0x3FFF, nil
+	case "VP8L": // 14-bit width and height, minus one, after the signature
+		bits := binary.LittleEndian.Uint32(chunk[1:])
+		return int(bits&0x3FFF) + 1, int(bits>>14&0x3FFF) + 1, nil
+	default:
+		return 0, 0, fmt.Errorf("invalid WebP: unknown chunk %q", data[12:16])
+	}
+}
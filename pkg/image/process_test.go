@@ -0,0 +1,260 @@
+package image
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// jpegWithMetadata returns a JPEG of the given size with EXIF (APP1), IPTC
+// (APP13) and comment segments inserted after its start of image marker
+func jpegWithMetadata(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height)), nil); err != nil {
+		t.Fatalf("failed to encode JPEG: %v", err)
+	}
+	segment := func(marker byte, payload string) []byte {
+		return append([]byte{0xFF, marker, 0, byte(len(payload) + 2)}, payload...)
+	}
+
+	encoded := buf.Bytes()
+	data := append([]byte{}, encoded[:2]...)
+	data = append(data, segment(0xE1, "Exif\x00\x00GPS")...)
+	data = append(data, segment(0xED, "Photoshop 3.0")...)
+	data = append(data, segment(0xFE, "comment")...)
+	return append(data, encoded[2:]...)
+}
+
+// pngWithMetadata returns a half-transparent red PNG of the given size
+// with a tEXt chunk inserted after its header chunk
+func pngWithMetadata(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.NewUniform(color.NRGBA{R: 255, A: 128}), image.Point{}, draw.Src)
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("failed to encode PNG: %v", err)
+	}
+
+	text := []byte("Author\x00someone")
+	chunk := binary.BigEndian.AppendUint32(nil, uint32(len(text)))
+	chunk = append(append(chunk, "tEXt"...), text...)
+	chunk = binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+
+	encoded := buf.Bytes()
+	const afterIHDR = 8 + 12 + 13
+	return append(append(append([]byte{}, encoded[:afterIHDR]...), chunk...), encoded[afterIHDR:]...)
+}
+
+// webpWithMetadata returns an extended WebP declaring and carrying EXIF and
+// XMP chunks around the simple VP8 image from createTestWebPData
+func webpWithMetadata() []byte {
+	chunk := func(fourCC string, payload []byte) []byte {
+		out := binary.LittleEndian.AppendUint32([]byte(fourCC), uint32(len(payload)))
+		out = append(out, payload...)
+		if len(payload)%2 == 1 {
+			out = append(out, 0)
+		}
+		return out
+	}
+
+	vp8x := []byte{0x08 | 0x04, 0, 0, 0, 0, 0, 0, 0, 0, 0} // EXIF and XMP flags, 1x1 canvas
+	data := append([]byte("RIFF\x00\x00\x00\x00WEBP"), chunk("VP8X", vp8x)...)
+	data = append(data, chunk("VP8 ", append(createTestWebPData()[20:], 0, 0, 0, 0))...)
+	data = append(data, chunk("EXIF", []byte("Exif"))...)
+	data = append(data, chunk("XMP ", []byte("<x:xmpmeta/>."))...)
+	binary.LittleEndian.PutUint32(data[4:], uint32(len(data)-8))
+	return data
+}
+
+func TestImageProcessor_Process_Unconfigured(t *testing.T) {
+	processor := NewImageProcessor(DefaultImageConfig())
+	data := jpegWithMetadata(t, 4, 4)
+
+	out, mimeType, err := processor.Process(data, MimeTypeJPEG)
+	if err != nil {
+		t.Fatalf("Process() error = %v", err)
+	}
+	if !bytes.Equal(out, data) || mimeType != MimeTypeJPEG {
+		t.Error("Process() should return the image unchanged when nothing is configured")
+	}
+}
+
+func TestImageProcessor_Process_StripMetadata(t *testing.T) {
+	processor := NewImageProcessor(&ImageConfig{StripMetadata: true})
+
+	t.Run("JPEG", func(t *testing.T) {
+		out, mimeType, err := processor.Process(jpegWithMetadata(t, 4, 4), MimeTypeJPEG)
+		if err != nil {
+			t.Fatalf("Process() error = %v", err)
+		}
+		if mimeType != MimeTypeJPEG {
+			t.Errorf("MimeType = %s, want image/jpeg", mimeType)
+		}
+		for _, marker := range []string{"Exif", "Photoshop", "comment"} {
+			if bytes.Contains(out, []byte(marker)) {
+				t.Errorf("Processed JPEG still contains %q", marker)
+			}
+		}
+		if _, err := jpeg.Decode(bytes.NewReader(out)); err != nil {
+			t.Errorf("Processed JPEG does not decode: %v", err)
+		}
+	})
+
+	t.Run("PNG", func(t *testing.T) {
+		out, mimeType, err := processor.Process(pngWithMetadata(t, 4, 4), MimeTypePNG)
+		if err != nil {
+			t.Fatalf("Process() error = %v", err)
+		}
+		if mimeType != MimeTypePNG || bytes.Contains(out, []byte("tEXt")) {
+			t.Errorf("Expected a PNG without its tEXt chunk, got %s (%d bytes)", mimeType, len(out))
+		}
+		if _, err := png.Decode(bytes.NewReader(out)); err != nil {
+			t.Errorf("Processed PNG does not decode: %v", err)
+		}
+	})
+
+	t.Run("WebP", func(t *testing.T) {
+		out, mimeType, err := processor.Process(webpWithMetadata(), MimeTypeWebP)
+		if err != nil {
+			t.Fatalf("Process() error = %v", err)
+		}
+		if mimeType != MimeTypeWebP || bytes.Contains(out, []byte("EXIF")) || bytes.Contains(out, []byte("XMP ")) {
+			t.Errorf("Expected a WebP without its metadata chunks, got %s: %q", mimeType, out)
+		}
+		if flags := out[20]; flags&(0x08|0x04) != 0 {
+			t.Errorf("VP8X flags = %#x, want the EXIF and XMP flags cleared", flags)
+		}
+		if size := binary.LittleEndian.Uint32(out[4:]); int(size) != len(out)-8 {
+			t.Errorf("RIFF size = %d, want %d", size, len(out)-8)
+		}
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		data := jpegWithMetadata(t, 4, 4)
+		if _, _, err := processor.Process(data[:len(data)/3], MimeTypeJPEG); err == nil {
+			t.Error("Process() should fail for a truncated JPEG")
+		}
+	})
+}
+
+func TestImageProcessor_Process_Convert(t *testing.T) {
+	processor := NewImageProcessor(&ImageConfig{OutputFormat: "jpeg", Quality: 50})
+
+	out, mimeType, err := processor.Process(pngWithMetadata(t, 6, 4), MimeTypePNG)
+	if err != nil {
+		t.Fatalf("Process() error = %v", err)
+	}
+	if mimeType != MimeTypeJPEG {
+		t.Errorf("MimeType = %s, want image/jpeg", mimeType)
+	}
+	img, err := jpeg.Decode(bytes.NewReader(out))
+	if err != nil {
+		t.Fatalf("Converted image is not a JPEG: %v", err)
+	}
+	if img.Bounds().Dx() != 6 || img.Bounds().Dy() != 4 {
+		t.Errorf("Converted size = %v, want 6x4", img.Bounds())
+	}
+	// Half-transparent red is flattened onto white
+	if r, g, _, _ := img.At(0, 0).RGBA(); r>>8 < 230 || g>>8 < 100 || g>>8 > 160 {
+		t.Errorf("Expected a light red pixel, got r=%d g=%d", r>>8, g>>8)
+	}
+
+	t.Run("WebP keeps its format", func(t *testing.T) {
+		data := createTestWebPData()
+		out, mimeType, err := processor.Process(data, MimeTypeWebP)
+		if err != nil || mimeType != MimeTypeWebP || !bytes.Equal(out, data) {
+			t.Errorf("Expected the WebP unchanged, got %s (%v)", mimeType, err)
+		}
+	})
+
+	t.Run("Unsupported format", func(t *testing.T) {
+		processor := NewImageProcessor(&ImageConfig{OutputFormat: "webp"})
+		if _, _, err := processor.Process(pngWithMetadata(t, 1, 1), MimeTypePNG); err == nil {
+			t.Error("Process() should reject the webp output format")
+		}
+	})
+}
+
+func TestImageProcessor_Process_MaxDimensions(t *testing.T) {
+	tests := []struct {
+		name       string
+		config     ImageConfig
+		width      int
+		height     int
+		wantWidth  int
+		wantHeight int
+	}{
+		{name: "too wide", config: ImageConfig{MaxWidth: 50}, width: 200, height: 300, wantWidth: 50, wantHeight: 75},
+		{name: "too tall", config: ImageConfig{MaxHeight: 150}, width: 200, height: 300, wantWidth: 100, wantHeight: 150},
+		{name: "tighter height", config: ImageConfig{MaxWidth: 150, MaxHeight: 150}, width: 200, height: 300, wantWidth: 100, wantHeight: 150},
+		{name: "within limits", config: ImageConfig{MaxWidth: 400, MaxHeight: 400}, width: 200, height: 300, wantWidth: 200, wantHeight: 300},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			processor := NewImageProcessor(&tt.config)
+
+			out, mimeType, err := processor.Process(pngWithMetadata(t, tt.width, tt.height), MimeTypePNG)
+			if err != nil {
+				t.Fatalf("Process() error = %v", err)
+			}
+			cfg, err := png.DecodeConfig(bytes.NewReader(out))
+			if err != nil || mimeType != MimeTypePNG {
+				t.Fatalf("Expected a PNG, got %s (%v)", mimeType, err)
+			}
+			if cfg.Width != tt.wantWidth || cfg.Height != tt.wantHeight {
+				t.Errorf("Size = %dx%d, want %dx%d", cfg.Width, cfg.Height, tt.wantWidth, tt.wantHeight)
+			}
+		})
+	}
+
+	t.Run("WebP too large", func(t *testing.T) {
+		processor := NewImageProcessor(&ImageConfig{MaxWidth: 1})
+		data := createTestWebPData()
+		binary.LittleEndian.PutUint16(data[26:], 2) // 2 pixels wide
+
+		if _, _, err := processor.Process(data, MimeTypeWebP); err == nil {
+			t.Error("Process() should reject a WebP it cannot resize")
+		}
+	})
+}
+
+func TestImageProcessor_DownloadImageFromURL_Processes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		w.Write(pngWithMetadata(t, 20, 10))
+	}))
+	defer server.Close()
+
+	processor := NewImageProcessor(&ImageConfig{
+		MaxSize:              1024 * 1024,
+		AllowedTypes:         []string{MimeTypePNG},
+		OutputFormat:         "jpeg",
+		AllowPrivateNetworks: true,
+		MaxWidth:             10,
+	})
+
+	data, mimeType, err := processor.DownloadImageFromURL(server.URL)
+	if err != nil {
+		t.Fatalf("DownloadImageFromURL() error = %v", err)
+	}
+	cfg, err := jpeg.DecodeConfig(bytes.NewReader(data))
+	if err != nil || mimeType != MimeTypeJPEG {
+		t.Fatalf("Expected a JPEG, got %s (%v)", mimeType, err)
+	}
+	if cfg.Width != 10 || cfg.Height != 5 {
+		t.Errorf("Size = %dx%d, want 10x5", cfg.Width, cfg.Height)
+	}
+}
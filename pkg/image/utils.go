@@ -2,6 +2,7 @@ package image
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"fmt"
 	"image"
@@ -11,7 +12,8 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
-	"time"
+
+	"github.com/francknouama/movies-mcp-server/pkg/httpclient"
 	// Config will need to be passed as parameters or made configurable
 )
 
@@ -25,13 +27,22 @@ const (
 // ImageProcessor handles image operations for the MCP server
 type ImageProcessor struct {
 	config *ImageConfig
+	client *httpclient.Client
 }
 
 // NewImageProcessor creates a new image processor with the given configuration
 func NewImageProcessor(cfg *ImageConfig) *ImageProcessor {
-	return &ImageProcessor{
+	p := &ImageProcessor{
 		config: cfg,
 	}
+	p.client = httpclient.New(p.newDownloadClient(), cfg.HTTP)
+	return p
+}
+
+// DownloadMetrics reports the retries, failures and open circuits of the
+// processor's downloads
+func (p *ImageProcessor) DownloadMetrics() httpclient.Metrics {
+	return p.client.Metrics()
 }
 
 // ValidateImage checks if the image data is valid and within size limits
@@ -109,17 +120,28 @@ func (p *ImageProcessor) DecodeFromBase64(encoded string) ([]byte, error) {
 
 // DownloadImageFromURL downloads an image from a URL and returns the data and MIME type
 func (p *ImageProcessor) DownloadImageFromURL(url string) ([]byte, string, error) {
+	return p.DownloadImageFromURLContext(context.Background(), url)
+}
+
+// DownloadImageFromURLContext downloads an image from a URL, aborting the
+// request and the body read when ctx is cancelled or its deadline passes.
+// URLs the download policy refuses fail with an error wrapping
+// ErrDownloadRefused.
+func (p *ImageProcessor) DownloadImageFromURLContext(ctx context.Context, url string) ([]byte, string, error) {
 	if url == "" {
 		return nil, "", fmt.Errorf("URL cannot be empty")
 	}
 
-	// Create HTTP client with timeout
-	client := &http.Client{
-		Timeout: 30 * time.Second,
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid image URL %s: %w", url, err)
+	}
+	if err := p.checkURL(req.URL); err != nil {
+		return nil, "", fmt.Errorf("failed to download image from %s: %w", url, err)
 	}
 
 	// Make request
-	resp, err := client.Get(url)
+	resp, err := p.client.Do(req)
 	if err != nil {
 		return nil, "", fmt.Errorf("failed to download image from %s: %w", url, err)
 	}
@@ -133,6 +155,9 @@ func (p *ImageProcessor) DownloadImageFromURL(url string) ([]byte, string, error
 	if resp.StatusCode != http.StatusOK {
 		return nil, "", fmt.Errorf("failed to download image: HTTP %d", resp.StatusCode)
 	}
+	if resp.ContentLength > p.config.MaxSize {
+		return nil, "", fmt.Errorf("downloaded image size exceeds maximum allowed size")
+	}
 
 	// Read response body with size limit
 	limitedReader := io.LimitReader(resp.Body, p.config.MaxSize+1)
@@ -158,6 +183,10 @@ func (p *ImageProcessor) DownloadImageFromURL(url string) ([]byte, string, error
 		return nil, "", fmt.Errorf("downloaded image validation failed: %w", err)
 	}
 
+	data, mimeType, err = p.Process(data, mimeType)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to process downloaded image: %w", err)
+	}
 	return data, mimeType, nil
 }
 
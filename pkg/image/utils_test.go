@@ -2,12 +2,15 @@ package image
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"image"
 	"image/jpeg"
 	"image/png"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestImageProcessor_ValidateImage(t *testing.T) {
@@ -602,8 +605,9 @@ func TestImageProcessor_DownloadImageFromURL_WithMockServer(t *testing.T) {
 		defer server.Close()
 
 		cfg := &ImageConfig{
-			MaxSize:      1024 * 1024,
-			AllowedTypes: []string{"image/jpeg", "image/png"},
+			MaxSize:              1024 * 1024,
+			AllowedTypes:         []string{"image/jpeg", "image/png"},
+			AllowPrivateNetworks: true, // httptest servers listen on loopback
 		}
 		processor := NewImageProcessor(cfg)
 
@@ -629,8 +633,9 @@ func TestImageProcessor_DownloadImageFromURL_WithMockServer(t *testing.T) {
 		defer server.Close()
 
 		cfg := &ImageConfig{
-			MaxSize:      1024 * 1024,
-			AllowedTypes: []string{"image/jpeg"},
+			MaxSize:              1024 * 1024,
+			AllowedTypes:         []string{"image/jpeg"},
+			AllowPrivateNetworks: true,
 		}
 		processor := NewImageProcessor(cfg)
 
@@ -651,8 +656,9 @@ func TestImageProcessor_DownloadImageFromURL_WithMockServer(t *testing.T) {
 		defer server.Close()
 
 		cfg := &ImageConfig{
-			MaxSize:      1024 * 1024, // 1MB limit
-			AllowedTypes: []string{"image/jpeg"},
+			MaxSize:              1024 * 1024, // 1MB limit
+			AllowedTypes:         []string{"image/jpeg"},
+			AllowPrivateNetworks: true,
 		}
 		processor := NewImageProcessor(cfg)
 
@@ -672,8 +678,9 @@ func TestImageProcessor_DownloadImageFromURL_WithMockServer(t *testing.T) {
 		defer server.Close()
 
 		cfg := &ImageConfig{
-			MaxSize:      1024 * 1024,
-			AllowedTypes: []string{"image/jpeg"},
+			MaxSize:              1024 * 1024,
+			AllowedTypes:         []string{"image/jpeg"},
+			AllowPrivateNetworks: true,
 		}
 		processor := NewImageProcessor(cfg)
 
@@ -693,8 +700,9 @@ func TestImageProcessor_DownloadImageFromURL_WithMockServer(t *testing.T) {
 		defer server.Close()
 
 		cfg := &ImageConfig{
-			MaxSize:      1024 * 1024,
-			AllowedTypes: []string{"image/jpeg"},
+			MaxSize:              1024 * 1024,
+			AllowedTypes:         []string{"image/jpeg"},
+			AllowPrivateNetworks: true,
 		}
 		processor := NewImageProcessor(cfg)
 
@@ -711,8 +719,9 @@ func TestImageProcessor_DownloadImageFromURL_WithMockServer(t *testing.T) {
 	// Test network error
 	t.Run("Network error", func(t *testing.T) {
 		cfg := &ImageConfig{
-			MaxSize:      1024 * 1024,
-			AllowedTypes: []string{"image/jpeg"},
+			MaxSize:              1024 * 1024,
+			AllowedTypes:         []string{"image/jpeg"},
+			AllowPrivateNetworks: true,
 		}
 		processor := NewImageProcessor(cfg)
 
@@ -724,6 +733,36 @@ func TestImageProcessor_DownloadImageFromURL_WithMockServer(t *testing.T) {
 	})
 }
 
+func TestImageProcessor_DownloadImageFromURLContext_Cancelled(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	processor := NewImageProcessor(&ImageConfig{
+		MaxSize:              1024 * 1024,
+		AllowedTypes:         []string{"image/jpeg"},
+		AllowPrivateNetworks: true,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, _, err := processor.DownloadImageFromURLContext(ctx, server.URL)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("DownloadImageFromURLContext() error = %v, want deadline exceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("DownloadImageFromURLContext() took %v, should abort on cancellation", elapsed)
+	}
+}
+
 func BenchmarkImageProcessor_Base64Encoding(b *testing.B) {
 	cfg := &ImageConfig{}
 	processor := NewImageProcessor(cfg)
@@ -0,0 +1,245 @@
+// Package mcptest provides an MCP client for tests and example clients.
+//
+// Client wraps the official SDK client, so requests are framed, correlated and
+// initialized exactly as a real MCP client does it. It adds per-call timeouts
+// and decoding of tool and resource results into typed values.
+package mcptest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// DefaultTimeout bounds each call when Options.Timeout is zero
+const DefaultTimeout = 30 * time.Second
+
+// Options configures a Client
+type Options struct {
+	// Timeout bounds each call made through the client; zero means
+	// DefaultTimeout and a negative value disables the bound.
+	Timeout time.Duration
+	// ClientInfo identifies the client to the server.
+	ClientInfo *mcp.Implementation
+}
+
+// Client is a connected, initialized MCP client session
+type Client struct {
+	session *mcp.ClientSession
+	timeout time.Duration
+	cleanup []func() error
+}
+
+// ToolError is returned by CallToolAs when the tool reports an error result
+type ToolError struct {
+	Tool    string
+	Message string
+}
+
+// Error implements the error interface
+func (e *ToolError) Error() string {
+	return fmt.Sprintf("tool %s failed: %s", e.Tool, e.Message)
+}
+
+// Connect connects to a server over transport and completes the MCP
+// initialize handshake
+func Connect(ctx context.Context, transport mcp.Transport, opts *Options) (*Client, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	info := opts.ClientInfo
+	if info == nil {
+		info = &mcp.Implementation{Name: "mcptest-client", Version: "1.0.0"}
+	}
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+
+	c := &Client{timeout: timeout}
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
+	session, err := mcp.NewClient(info, nil).Connect(ctx, transport, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+	c.session = session
+	return c, nil
+}
+
+// ConnectServer runs server in process over in-memory transports and
+// connects to it. Closing the client also closes the server session.
+func ConnectServer(ctx context.Context, server *mcp.Server, opts *Options) (*Client, error) {
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect server: %w", err)
+	}
+
+	c, err := Connect(ctx, clientTransport, opts)
+	if err != nil {
+		serverSession.Close()
+		return nil, err
+	}
+	c.cleanup = append(c.cleanup, serverSession.Close)
+	return c, nil
+}
+
+// ConnectCommand starts cmd as a stdio server and connects to it. Closing the
+// client stops the server.
+func ConnectCommand(ctx context.Context, cmd *exec.Cmd, opts *Options) (*Client, error) {
+	return Connect(ctx, &mcp.CommandTransport{Command: cmd}, opts)
+}
+
+// Session returns the underlying SDK session, for requests the client does
+// not wrap
+func (c *Client) Session() *mcp.ClientSession {
+	return c.session
+}
+
+// ServerInfo returns the server's implementation from the initialize result
+func (c *Client) ServerInfo() *mcp.Implementation {
+	if result := c.session.InitializeResult(); result != nil {
+		return result.ServerInfo
+	}
+	return nil
+}
+
+// ListTools returns every tool the server registers
+func (c *Client) ListTools(ctx context.Context) ([]*mcp.Tool, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
+	var tools []*mcp.Tool
+	for tool, err := range c.session.Tools(ctx, nil) {
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tools: %w", err)
+		}
+		tools = append(tools, tool)
+	}
+	return tools, nil
+}
+
+// ListResources returns every resource the server registers
+func (c *Client) ListResources(ctx context.Context) ([]*mcp.Resource, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
+	var resources []*mcp.Resource
+	for resource, err := range c.session.Resources(ctx, nil) {
+		if err != nil {
+			return nil, fmt.Errorf("failed to list resources: %w", err)
+		}
+		resources = append(resources, resource)
+	}
+	return resources, nil
+}
+
+// CallTool calls a tool. A tool error is a result with IsError set; a
+// protocol error, such as invalid params, is returned as err.
+func (c *Client) CallTool(ctx context.Context, name string, arguments any) (*mcp.CallToolResult, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
+	result, err := c.session.CallTool(ctx, &mcp.CallToolParams{Name: name, Arguments: arguments})
+	if err != nil {
+		return nil, fmt.Errorf("tool %s: %w", name, err)
+	}
+	return result, nil
+}
+
+// ReadResource reads a resource
+func (c *Client) ReadResource(ctx context.Context, uri string) (*mcp.ReadResourceResult, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
+	result, err := c.session.ReadResource(ctx, &mcp.ReadResourceParams{URI: uri})
+	if err != nil {
+		return nil, fmt.Errorf("resource %s: %w", uri, err)
+	}
+	return result, nil
+}
+
+// Close ends the session and releases anything the client started
+func (c *Client) Close() error {
+	err := c.session.Close()
+	for i := len(c.cleanup) - 1; i >= 0; i-- {
+		if cleanupErr := c.cleanup[i](); err == nil {
+			err = cleanupErr
+		}
+	}
+	c.cleanup = nil
+	return err
+}
+
+// callContext applies the client's timeout to ctx
+func (c *Client) callContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.timeout < 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, c.timeout)
+}
+
+// CallToolAs calls a tool and decodes its output into T. The structured
+// content is used when present, otherwise the JSON text content. An error
+// result is returned as a *ToolError.
+func CallToolAs[T any](ctx context.Context, c *Client, name string, arguments any) (T, error) {
+	var output T
+	result, err := c.CallTool(ctx, name, arguments)
+	if err != nil {
+		return output, err
+	}
+	if result.IsError {
+		return output, &ToolError{Tool: name, Message: TextContent(result.Content)}
+	}
+
+	if result.StructuredContent != nil {
+		data, err := json.Marshal(result.StructuredContent)
+		if err != nil {
+			return output, fmt.Errorf("tool %s: failed to encode structured content: %w", name, err)
+		}
+		if err := json.Unmarshal(data, &output); err != nil {
+			return output, fmt.Errorf("tool %s: failed to decode structured content: %w", name, err)
+		}
+		return output, nil
+	}
+
+	if err := json.Unmarshal([]byte(TextContent(result.Content)), &output); err != nil {
+		return output, fmt.Errorf("tool %s: failed to decode text content: %w", name, err)
+	}
+	return output, nil
+}
+
+// ReadResourceAs reads a resource and decodes its first text contents, which
+// must be JSON, into T
+func ReadResourceAs[T any](ctx context.Context, c *Client, uri string) (T, error) {
+	var output T
+	result, err := c.ReadResource(ctx, uri)
+	if err != nil {
+		return output, err
+	}
+	if len(result.Contents) == 0 {
+		return output, fmt.Errorf("resource %s: no contents", uri)
+	}
+	if err := json.Unmarshal([]byte(result.Contents[0].Text), &output); err != nil {
+		return output, fmt.Errorf("resource %s: failed to decode contents: %w", uri, err)
+	}
+	return output, nil
+}
+
+// TextContent joins the text of every text block in content
+func TextContent(content []mcp.Content) string {
+	var texts []string
+	for _, block := range content {
+		if text, ok := block.(*mcp.TextContent); ok {
+			texts = append(texts, text.Text)
+		}
+	}
+	return strings.Join(texts, "\n")
+}
@@ -0,0 +1,142 @@
+package mcptest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type greetInput struct {
+	Name string `json:"name"`
+}
+
+type greetOutput struct {
+	Greeting string `json:"greeting"`
+}
+
+// newTestServer creates a server with a greeting tool, a failing tool, a slow
+// tool and a JSON resource
+func newTestServer() *mcp.Server {
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.2.3"}, nil)
+
+	mcp.AddTool(server, &mcp.Tool{Name: "greet"}, func(ctx context.Context, req *mcp.CallToolRequest, input greetInput) (*mcp.CallToolResult, greetOutput, error) {
+		return nil, greetOutput{Greeting: "hello " + input.Name}, nil
+	})
+	mcp.AddTool(server, &mcp.Tool{Name: "fail"}, func(ctx context.Context, req *mcp.CallToolRequest, input greetInput) (*mcp.CallToolResult, greetOutput, error) {
+		return nil, greetOutput{}, errors.New("no greeting today")
+	})
+	mcp.AddTool(server, &mcp.Tool{Name: "slow"}, func(ctx context.Context, req *mcp.CallToolRequest, input greetInput) (*mcp.CallToolResult, greetOutput, error) {
+		<-ctx.Done()
+		return nil, greetOutput{}, ctx.Err()
+	})
+	server.AddResource(&mcp.Resource{URI: "test://stats", Name: "stats", MIMEType: "application/json"},
+		func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+			return &mcp.ReadResourceResult{Contents: []*mcp.ResourceContents{
+				{URI: req.Params.URI, MIMEType: "application/json", Text: `{"total":3}`},
+			}}, nil
+		})
+	return server
+}
+
+// connectTestServer connects a client to a new test server
+func connectTestServer(t *testing.T, opts *Options) *Client {
+	t.Helper()
+	client, err := ConnectServer(context.Background(), newTestServer(), opts)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestConnectServer_Initializes(t *testing.T) {
+	client := connectTestServer(t, nil)
+
+	info := client.ServerInfo()
+	if info == nil || info.Name != "test-server" || info.Version != "1.2.3" {
+		t.Errorf("Expected server info test-server 1.2.3, got: %+v", info)
+	}
+}
+
+func TestClient_ListToolsAndResources(t *testing.T) {
+	client := connectTestServer(t, nil)
+	ctx := context.Background()
+
+	tools, err := client.ListTools(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(tools) != 3 {
+		t.Errorf("Expected 3 tools, got: %d", len(tools))
+	}
+
+	resources, err := client.ListResources(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(resources) != 1 || resources[0].URI != "test://stats" {
+		t.Errorf("Expected the stats resource, got: %+v", resources)
+	}
+}
+
+func TestCallToolAs(t *testing.T) {
+	client := connectTestServer(t, nil)
+	ctx := context.Background()
+
+	output, err := CallToolAs[greetOutput](ctx, client, "greet", map[string]any{"name": "Ada"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Greeting != "hello Ada" {
+		t.Errorf("Expected greeting 'hello Ada', got: %q", output.Greeting)
+	}
+
+	_, err = CallToolAs[greetOutput](ctx, client, "fail", map[string]any{"name": "Ada"})
+	var toolErr *ToolError
+	if !errors.As(err, &toolErr) {
+		t.Fatalf("Expected a ToolError, got: %v", err)
+	}
+	if toolErr.Tool != "fail" || toolErr.Message != "no greeting today" {
+		t.Errorf("Expected the tool's error message, got: %+v", toolErr)
+	}
+}
+
+func TestClient_CallToolProtocolError(t *testing.T) {
+	client := connectTestServer(t, nil)
+
+	_, err := client.CallTool(context.Background(), "missing", nil)
+	if err == nil {
+		t.Fatal("Expected an error for an unknown tool")
+	}
+	var toolErr *ToolError
+	if errors.As(err, &toolErr) {
+		t.Errorf("Expected a protocol error rather than a tool error, got: %v", err)
+	}
+}
+
+func TestClient_CallTimesOut(t *testing.T) {
+	client := connectTestServer(t, &Options{Timeout: 50 * time.Millisecond})
+
+	start := time.Now()
+	if _, err := client.CallTool(context.Background(), "slow", map[string]any{}); err == nil {
+		t.Fatal("Expected the call to time out")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected the call to stop at the timeout, took: %v", elapsed)
+	}
+}
+
+func TestReadResourceAs(t *testing.T) {
+	client := connectTestServer(t, nil)
+
+	stats, err := ReadResourceAs[map[string]int](context.Background(), client, "test://stats")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if stats["total"] != 3 {
+		t.Errorf("Expected total 3, got: %v", stats)
+	}
+}
@@ -0,0 +1,24 @@
+package mcptest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+// ServerPackage is the package of the server commands connect to
+const ServerPackage = "./cmd/server-sdk"
+
+// BuildServer builds the server from the repository at root into output, so
+// a command can start it with ConnectCommand. Build output goes to stderr.
+func BuildServer(ctx context.Context, root, output string) error {
+	build := exec.CommandContext(ctx, "go", "build", "-o", output, ServerPackage)
+	build.Dir = root
+	build.Stdout = os.Stderr
+	build.Stderr = os.Stderr
+	if err := build.Run(); err != nil {
+		return fmt.Errorf("failed to build server: %w", err)
+	}
+	return nil
+}
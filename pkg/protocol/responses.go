@@ -107,6 +107,20 @@ type ResourceContent struct {
 	Blob     []byte `json:"blob,omitempty"`
 }
 
+// ResourceTemplatesListResponse represents a resource templates list response.
+type ResourceTemplatesListResponse struct {
+	ResourceTemplates []ResourceTemplate `json:"resourceTemplates"`
+	NextCursor        *string            `json:"nextCursor,omitempty"`
+}
+
+// ResourceTemplate represents an MCP resource template definition.
+type ResourceTemplate struct {
+	URITemplate string `json:"uriTemplate"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	MimeType    string `json:"mimeType,omitempty"`
+}
+
 // PromptsListResponse represents a prompts list response.
 type PromptsListResponse struct {
 	Prompts    []Prompt `json:"prompts"`
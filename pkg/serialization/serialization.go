@@ -0,0 +1,32 @@
+// Package serialization holds the conventions every response of the movies
+// MCP server is encoded with, so the same value always serializes the same
+// way: timestamps are RFC3339 in UTC to the second, and collections built
+// from maps are listed in a fixed order.
+package serialization
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// TimeLayout is the layout of every timestamp in a response
+const TimeLayout = time.RFC3339
+
+// Timestamp formats t in UTC with TimeLayout; the zero time formats as ""
+func Timestamp(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Truncate(time.Second).Format(TimeLayout)
+}
+
+// SortedKeys returns the keys of m in ascending order
+func SortedKeys[M ~map[K]V, K cmp.Ordered, V any](m M) []K {
+	keys := make([]K, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,43 @@
+package serialization
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestTimestamp(t *testing.T) {
+	paris := time.FixedZone("CEST", 2*60*60)
+
+	tests := []struct {
+		name string
+		in   time.Time
+		want string
+	}{
+		{name: "utc", in: time.Date(2026, 10, 14, 9, 30, 0, 0, time.UTC), want: "2026-10-14T09:30:00Z"},
+		{name: "converted to utc", in: time.Date(2026, 10, 14, 11, 30, 0, 0, paris), want: "2026-10-14T09:30:00Z"},
+		{name: "fraction dropped", in: time.Date(2026, 10, 14, 9, 30, 0, 999999999, time.UTC), want: "2026-10-14T09:30:00Z"},
+		{name: "zero", in: time.Time{}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Timestamp(tt.in); got != tt.want {
+				t.Errorf("Timestamp() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortedKeys(t *testing.T) {
+	counts := map[string]int{"Drama": 3, "Action": 1, "Comedy": 2}
+
+	for range 10 {
+		if got := SortedKeys(counts); !slices.Equal(got, []string{"Action", "Comedy", "Drama"}) {
+			t.Fatalf("SortedKeys() = %v, want ascending keys", got)
+		}
+	}
+	if got := SortedKeys(map[int]bool{}); len(got) != 0 {
+		t.Errorf("SortedKeys() = %v, want no keys", got)
+	}
+}
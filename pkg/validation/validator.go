@@ -4,6 +4,7 @@ package validation
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -249,26 +250,33 @@ func MaxLength(maxLength int) ValidationRule {
 	}
 }
 
-// parseNumericValue converts various types to float64
+// parseNumericValue converts various types to float64. NaN and infinities
+// are rejected, since they compare false against every bound.
 func parseNumericValue(value interface{}) (float64, error) {
+	var numValue float64
 	switch v := value.(type) {
 	case int:
-		return float64(v), nil
+		numValue = float64(v)
 	case int64:
-		return float64(v), nil
+		numValue = float64(v)
 	case float32:
-		return float64(v), nil
+		numValue = float64(v)
 	case float64:
-		return v, nil
+		numValue = v
 	case string:
-		numValue, err := strconv.ParseFloat(v, 64)
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
 		if err != nil {
 			return 0, fmt.Errorf("field must be a number")
 		}
-		return numValue, nil
+		numValue = parsed
 	default:
 		return 0, fmt.Errorf("field must be a number")
 	}
+
+	if math.IsNaN(numValue) || math.IsInf(numValue, 0) {
+		return 0, fmt.Errorf("field must be a finite number")
+	}
+	return numValue, nil
 }
 
 // Min validates minimum value for numbers
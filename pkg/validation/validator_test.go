@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"math"
 	"strings"
 	"testing"
 	"time"
@@ -107,7 +108,11 @@ func TestMin(t *testing.T) {
 		{"string number low", "5", true},
 		{"string number exact", "10", false},
 		{"string number high", "15", false},
+		{"string number padded", " 15 ", false},
 		{"invalid string", "abc", true},
+		{"nan string", "NaN", true},
+		{"infinite string", "+Inf", true},
+		{"nan float", math.NaN(), true},
 		{"invalid type", []int{1, 2, 3}, true},
 	}
 
@@ -121,6 +126,30 @@ func TestMin(t *testing.T) {
 	}
 }
 
+func FuzzParseNumericValue(f *testing.F) {
+	for _, seed := range []string{"10", "-5.5", " 7 ", "1e308", "1e309", "NaN", "-Inf", "0x1p-2", "1_000", "abc", ""} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		value, err := parseNumericValue(input)
+		if err != nil {
+			return
+		}
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			t.Fatalf("Expected a finite number from %q, got: %v", input, value)
+		}
+
+		// Min and Max agree with the parsed value at its own bound
+		if err := Min(value)(input); err != nil {
+			t.Errorf("Expected %q to satisfy Min(%v), got: %v", input, value, err)
+		}
+		if err := Max(value)(input); err != nil {
+			t.Errorf("Expected %q to satisfy Max(%v), got: %v", input, value, err)
+		}
+	})
+}
+
 func TestMax(t *testing.T) {
 	rule := Max(100.0)
 
@@ -8,6 +8,7 @@ import (
 	"os"
 
 	"github.com/francknouama/movies-mcp-server/internal/config"
+	"github.com/francknouama/movies-mcp-server/pkg/httpclient"
 	"github.com/francknouama/movies-mcp-server/pkg/image"
 	_ "github.com/lib/pq"
 )
@@ -59,6 +60,24 @@ func main() {
 		AllowedTypes:     cfg.Image.AllowedTypes,
 		EnableThumbnails: cfg.Image.EnableThumbnails,
 		ThumbnailSize:    cfg.Image.ThumbnailSize,
+		OutputFormat:     cfg.Image.OutputFormat,
+		Quality:          cfg.Image.Quality,
+		StripMetadata:    cfg.Image.StripMetadata,
+		MaxWidth:         cfg.Image.MaxWidth,
+		MaxHeight:        cfg.Image.MaxHeight,
+
+		AllowedHosts:         cfg.Image.AllowedHosts,
+		DeniedHosts:          cfg.Image.DeniedHosts,
+		AllowPrivateNetworks: cfg.Image.AllowPrivateNetworks,
+		DownloadTimeout:      cfg.Image.DownloadTimeout,
+
+		HTTP: httpclient.Config{
+			MaxRetries:       cfg.HTTP.MaxRetries,
+			InitialBackoff:   cfg.HTTP.RetryBackoff,
+			MaxBackoff:       cfg.HTTP.MaxRetryBackoff,
+			FailureThreshold: cfg.HTTP.FailureThreshold,
+			OpenDuration:     cfg.HTTP.OpenDuration,
+		},
 	}
 	imageProcessor := image.NewImageProcessor(imageConfig)
 
@@ -131,6 +150,9 @@ func main() {
 	fmt.Printf("   ✅ Successful: %d\n", successCount)
 	fmt.Printf("   ❌ Failed: %d\n", errorCount)
 	fmt.Printf("   📁 Total: %d\n", len(movies))
+	if metrics := imageProcessor.DownloadMetrics(); metrics.Retries > 0 || len(metrics.OpenCircuits) > 0 {
+		fmt.Printf("   🔁 Download retries: %d (hosts given up on: %v)\n", metrics.Retries, metrics.OpenCircuits)
+	}
 
 	if successCount > 0 {
 		fmt.Println("\n🎉 Image population completed successfully!")
@@ -2,26 +2,72 @@ package bdd
 
 import (
 	"fmt"
+	"os"
+	"runtime"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/cucumber/godog"
 
+	bddContext "github.com/francknouama/movies-mcp-server/tests/bdd/context"
 	"github.com/francknouama/movies-mcp-server/tests/bdd/steps"
 )
 
-func TestBDDFeatures(t *testing.T) {
-	suite := godog.TestSuite{
-		ScenarioInitializer: InitializeScenario,
-		Options: &godog.Options{
-			Format:   "pretty",
-			Paths:    []string{"features"},
-			TestingT: t,
-		},
+// suiteRun is one pass over the features. Scenarios tagged @parallel run
+// concurrently, each against a server and database of its own; the rest,
+// and @serial scenarios that measure timings, run one at a time after them.
+type suiteRun struct {
+	name        string
+	tags        string
+	concurrency int
+}
+
+// suiteRuns returns the passes a full run of the features is made of
+func suiteRuns() []suiteRun {
+	return []suiteRun{
+		{name: "parallel", tags: "@parallel && ~@serial", concurrency: scenarioConcurrency()},
+		{name: "serial", tags: "~@parallel,@serial", concurrency: 1},
+	}
+}
+
+// scenarioConcurrency returns how many @parallel scenarios run at once: the
+// BDD_CONCURRENCY environment variable, defaulting to the number of CPUs
+func scenarioConcurrency() int {
+	if value, err := strconv.Atoi(os.Getenv("BDD_CONCURRENCY")); err == nil && value > 0 {
+		return value
+	}
+	return runtime.NumCPU()
+}
+
+// options returns the godog options of a pass. The pretty format cannot
+// interleave concurrent scenarios, so concurrent passes report progress.
+func (r suiteRun) options(t *testing.T) *godog.Options {
+	format := "pretty"
+	if r.concurrency > 1 {
+		format = "progress"
+	}
+	return &godog.Options{
+		Format:      format,
+		Paths:       []string{"features"},
+		Tags:        r.tags,
+		Concurrency: r.concurrency,
+		TestingT:    t,
 	}
+}
+
+func TestBDDFeatures(t *testing.T) {
+	for _, run := range suiteRuns() {
+		suite := godog.TestSuite{
+			Name:                 run.name,
+			TestSuiteInitializer: steps.InitializeTestSuite,
+			ScenarioInitializer:  InitializeScenario,
+			Options:              run.options(t),
+		}
 
-	if suite.Run() != 0 {
-		t.Fatal("non-zero status returned, failed to run feature tests")
+		if suite.Run() != 0 {
+			t.Fatalf("non-zero status returned, failed to run %s feature tests", run.name)
+		}
 	}
 }
 
@@ -42,22 +88,28 @@ func InitializeScenario(ctx *godog.ScenarioContext) {
 // Optional: Run BDD tests from command line
 // go test -tags bdd ./tests/bdd/...
 func TestMain(m *testing.M) {
-	status := godog.TestSuite{
-		Name:                "movies-mcp-server BDD",
-		ScenarioInitializer: InitializeScenario,
-		Options: &godog.Options{
-			Format:    "pretty",
-			Paths:     []string{"features"},
-			Randomize: time.Now().UTC().UnixNano(), // randomize scenario execution order
-		},
-	}.Run()
-
-	if status == 2 {
-		// Proper exit code when no tests are found
-		return
+	for _, run := range suiteRuns() {
+		options := run.options(nil)
+		options.Randomize = time.Now().UTC().UnixNano() // randomize scenario execution order
+
+		status := godog.TestSuite{
+			Name:                 "movies-mcp-server BDD (" + run.name + ")",
+			TestSuiteInitializer: steps.InitializeTestSuite,
+			ScenarioInitializer:  InitializeScenario,
+			Options:              options,
+		}.Run()
+
+		if status == 2 {
+			// Proper exit code when no tests are found
+			continue
+		}
+
+		if status != 0 {
+			fmt.Printf("BDD test suite %s run failed with status: %d\n", run.name, status)
+		}
 	}
 
-	if status != 0 {
-		fmt.Printf("BDD test suite failed with status: %d\n", status)
+	if err := bddContext.RemoveServerBinaries(); err != nil {
+		fmt.Printf("failed to remove the BDD server binaries: %v\n", err)
 	}
 }
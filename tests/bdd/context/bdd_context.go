@@ -1,23 +1,25 @@
 package context
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
-	"strings"
+	"sync"
 	"time"
 
-	"github.com/francknouama/movies-mcp-server/pkg/client"
-	"github.com/francknouama/movies-mcp-server/pkg/communication"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/francknouama/movies-mcp-server/pkg/mcptest"
 	"github.com/francknouama/movies-mcp-server/pkg/protocol"
 )
 
 // BDDContext provides a simplified test context for BDD scenarios
 // This replaces the complex 1,191-line TestContext with a clean, focused design
 type BDDContext struct {
-	mcpClient     *client.MCPClient
+	mcpClient     *mcptest.Client
 	serverProcess *exec.Cmd
 	testData      map[string]interface{}
 	lastResponse  *protocol.ToolCallResponse
@@ -46,7 +48,6 @@ func (ctx *BDDContext) SetDatabasePath(dbPath string) error {
 	return nil
 }
 
-
 // getProjectRoot finds the project root directory by looking for go.mod file
 func getProjectRoot() (string, error) {
 	wd, err := os.Getwd()
@@ -84,29 +85,94 @@ func getServerType() string {
 	return "sdk"
 }
 
-// buildServerBinary builds the MCP server binary based on the specified server type
-func buildServerBinary(projectRoot, serverType string) (string, error) {
-	var serverBinary, serverMainPath string
+// Server binaries built by this test process, by server type. Concurrent
+// scenarios share one build instead of racing to write the same file, and
+// each test process builds its own into serverBuildDir, so a binary left
+// over from an earlier run or a manual build is never tested by mistake.
+var (
+	serverBuildsMu sync.Mutex
+	serverBuilds   = make(map[string]*serverBuild)
+	serverBuildDir string
+)
+
+// serverBuild is the outcome of building one server binary
+type serverBuild struct {
+	once   sync.Once
+	binary string
+	err    error
+}
+
+// sharedServerBinary returns the binary of serverType, building it on first use
+func sharedServerBinary(projectRoot, serverType string) (string, error) {
+	serverBuildsMu.Lock()
+	if serverBuildDir == "" {
+		dir, err := os.MkdirTemp("", "movies-mcp-bdd-")
+		if err != nil {
+			serverBuildsMu.Unlock()
+			return "", fmt.Errorf("failed to create server build directory: %w", err)
+		}
+		serverBuildDir = dir
+	}
+	dir := serverBuildDir
+	build, exists := serverBuilds[serverType]
+	if !exists {
+		build = &serverBuild{}
+		serverBuilds[serverType] = build
+	}
+	serverBuildsMu.Unlock()
+
+	build.once.Do(func() {
+		build.binary, build.err = buildServerBinary(projectRoot, dir, serverType)
+	})
+	return build.binary, build.err
+}
+
+// RemoveServerBinaries deletes the server binaries this test process built;
+// call it once every suite has run
+func RemoveServerBinaries() error {
+	serverBuildsMu.Lock()
+	defer serverBuildsMu.Unlock()
+
+	if serverBuildDir == "" {
+		return nil
+	}
+	err := os.RemoveAll(serverBuildDir)
+	serverBuildDir = ""
+	serverBuilds = make(map[string]*serverBuild)
+	return err
+}
+
+// isolatedServerEnv is the environment of a scenario's server: the test
+// process's own, pointed at the scenario's database and with the settings
+// that make a server write to shared files switched off, so servers of
+// concurrent scenarios stay out of each other's way
+func isolatedServerEnv(dbPath string) []string {
+	return append(os.Environ(),
+		"DB_NAME="+dbPath,
+		"STATUS_FILE=",
+		"TENANT_DATABASE_DIR=",
+	)
+}
+
+// buildServerBinary builds the MCP server binary of the specified server
+// type into dir
+func buildServerBinary(projectRoot, dir, serverType string) (string, error) {
+	var serverBinary, serverPackage string
 
 	switch serverType {
 	case "sdk":
-		serverBinary = filepath.Join(projectRoot, "movies-mcp-server-sdk")
-		serverMainPath = filepath.Join(projectRoot, "cmd", "server-sdk", "main.go")
+		serverBinary = filepath.Join(dir, "movies-mcp-server-sdk")
+		serverPackage = "./cmd/server-sdk"
 	case "legacy":
-		serverBinary = filepath.Join(projectRoot, "movies-mcp-server")
-		serverMainPath = filepath.Join(projectRoot, "cmd", "server", "main.go")
+		serverBinary = filepath.Join(dir, "movies-mcp-server")
+		serverPackage = "./cmd/server"
 	default:
 		return "", fmt.Errorf("unknown server type: %s (expected 'sdk' or 'legacy')", serverType)
 	}
 
-	// Check if binary already exists
-	if _, err := os.Stat(serverBinary); err == nil {
-		return serverBinary, nil
-	}
-
 	// Build the server binary
 	// #nosec G204 - Safe: building our own Go binary in test environment
-	buildCmd := exec.Command("go", "build", "-o", serverBinary, serverMainPath)
+	buildCmd := exec.Command("go", "build", "-o", serverBinary, serverPackage)
 	buildCmd.Dir = projectRoot // Set working directory to project root
 
 	output, err := buildCmd.CombinedOutput()
@@ -128,7 +194,7 @@ func (ctx *BDDContext) StartMCPServer() error {
 	// Determine which server to test (sdk or legacy)
 	serverType := getServerType()
 
-	serverBinary, err := buildServerBinary(projectRoot, serverType)
+	serverBinary, err := sharedServerBinary(projectRoot, serverType)
 	if err != nil {
 		return fmt.Errorf("failed to build server binary: %w", err)
 	}
@@ -136,83 +202,26 @@ func (ctx *BDDContext) StartMCPServer() error {
 	// Start the real MCP server (no mocks - Phase 1 remediation)
 	// #nosec G204 - Safe: executing our own built binary in test environment
 	ctx.serverProcess = exec.Command(serverBinary)
+	ctx.serverProcess.Dir = projectRoot // Migrations are found relative to the root
 
-	// Set database environment if database path was provided (SQLite)
-	env := os.Environ()
+	// Point the server at the scenario's SQLite database. The support
+	// package has already migrated it and loads fixtures into it, so the
+	// server does not migrate it again.
 	if dbPath, exists := ctx.GetTestData("db_path"); exists {
 		if dbPathStr, ok := dbPath.(string); ok {
-			env = append(env, "DB_PATH="+dbPathStr)
+			ctx.serverProcess.Args = append(ctx.serverProcess.Args, "-skip-migrations")
+			ctx.serverProcess.Env = isolatedServerEnv(dbPathStr)
 		}
 	}
 
-	// Apply the environment to the server process
-	if len(env) > len(os.Environ()) {
-		ctx.serverProcess.Env = env
-	}
-
-	// Get pipes for communication
-	stdout, err := ctx.serverProcess.StdoutPipe()
-	if err != nil {
-		return fmt.Errorf("failed to get stdout pipe: %w", err)
-	}
-
-	stdin, err := ctx.serverProcess.StdinPipe()
-	if err != nil {
-		return fmt.Errorf("failed to get stdin pipe: %w", err)
-	}
-
-	// Create stdio transport for MCP communication
-	// reader = server's stdout (we read from), writer = server's stdin (we write to)
-	transport := communication.NewStdioTransport(stdout, stdin)
-
-	// Create MCP client with proper options
-	ctx.mcpClient = client.NewMCPClient(client.ClientOptions{
-		Transport: transport,
-		Timeout:   30 * time.Second,
-		ClientInfo: protocol.ClientInfo{
-			Name:    "bdd-test-client",
-			Version: "1.0.0",
-		},
-		Capabilities: protocol.ClientCapabilities{
-			Tools:     &protocol.ToolsCapability{},
-			Resources: &protocol.ResourcesCapability{},
-			Prompts:   &protocol.PromptsCapability{},
-		},
+	// Connect with the shared test client, which starts the server and
+	// performs the initialize handshake
+	ctx.mcpClient, err = mcptest.ConnectCommand(context.Background(), ctx.serverProcess, &mcptest.Options{
+		Timeout:    30 * time.Second,
+		ClientInfo: &mcp.Implementation{Name: "bdd-test-client", Version: "1.0.0"},
 	})
-
-	// Start the server process
-	err = ctx.serverProcess.Start()
 	if err != nil {
-		return fmt.Errorf("failed to start MCP server: %w", err)
-	}
-
-	// Wait for server to be ready with retry logic
-	time.Sleep(2 * time.Second) // Initial delay for server startup
-
-	// Initialize MCP connection with retry logic
-	var initErr error
-	for attempts := 0; attempts < 5; attempts++ {
-		initErr = ctx.mcpClient.Initialize(
-			protocol.ClientInfo{
-				Name:    "bdd-test-client",
-				Version: "1.0.0",
-			},
-			protocol.ClientCapabilities{
-				Tools:     &protocol.ToolsCapability{},
-				Resources: &protocol.ResourcesCapability{},
-				Prompts:   &protocol.PromptsCapability{},
-			},
-		)
-		if initErr == nil {
-			break // Success!
-		}
-
-		// Wait before retry
-		time.Sleep(1 * time.Second)
-	}
-
-	if initErr != nil {
-		return fmt.Errorf("failed to initialize MCP connection after retries: %w", initErr)
+		return fmt.Errorf("failed to start and initialize MCP server: %w", err)
 	}
 
 	return nil
@@ -220,13 +229,29 @@ func (ctx *BDDContext) StartMCPServer() error {
 
 // CallTool calls an MCP tool directly on the real server using correct API
 func (ctx *BDDContext) CallTool(toolName string, arguments map[string]interface{}) (*protocol.ToolCallResponse, error) {
-	response, err := ctx.mcpClient.CallTool(toolName, arguments)
+	var response *protocol.ToolCallResponse
+	result, err := ctx.mcpClient.CallTool(context.Background(), toolName, arguments)
+	if err == nil {
+		response = toToolCallResponse(result)
+	}
 	ctx.lastResponse = response
 	ctx.lastError = err
 
 	return response, err
 }
 
+// toToolCallResponse converts an SDK tool result into the response type the
+// steps assert on
+func toToolCallResponse(result *mcp.CallToolResult) *protocol.ToolCallResponse {
+	response := &protocol.ToolCallResponse{IsError: result.IsError}
+	for _, content := range result.Content {
+		if text, ok := content.(*mcp.TextContent); ok {
+			response.Content = append(response.Content, protocol.ContentBlock{Type: "text", Text: text.Text})
+		}
+	}
+	return response
+}
+
 // GetLastResponse returns the last MCP response received
 func (ctx *BDDContext) GetLastResponse() *protocol.ToolCallResponse {
 	return ctx.lastResponse
@@ -271,15 +296,9 @@ func (ctx *BDDContext) Cleanup() error {
 		}
 	}
 
-	// Stop server process
-	if ctx.serverProcess != nil && ctx.serverProcess.Process != nil {
-		if err := ctx.serverProcess.Process.Kill(); err != nil {
-			errors = append(errors, err)
-		}
-		if err := ctx.serverProcess.Wait(); err != nil {
-			errors = append(errors, err)
-		}
-	}
+	// Closing the client stops the server process
+	ctx.mcpClient = nil
+	ctx.serverProcess = nil
 
 	// Clear test data
 	ctx.testData = make(map[string]interface{})
@@ -299,7 +318,7 @@ func (ctx *BDDContext) WaitForServer(timeout time.Duration) error {
 	for time.Since(start) < timeout {
 		if ctx.mcpClient != nil {
 			// Try a simple tools list to see if server is responsive
-			_, err := ctx.mcpClient.ListTools()
+			_, err := ctx.mcpClient.ListTools(context.Background())
 			if err == nil {
 				return nil
 			}
@@ -350,6 +369,6 @@ func (ctx *BDDContext) GetErrorMessage() string {
 }
 
 // GetMCPClient returns the MCP client for direct access
-func (ctx *BDDContext) GetMCPClient() *client.MCPClient {
+func (ctx *BDDContext) GetMCPClient() *mcptest.Client {
 	return ctx.mcpClient
 }
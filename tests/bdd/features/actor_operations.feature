@@ -1,3 +1,4 @@
+@parallel
 Feature: Actor Operations
   As an MCP client
   I want to manage actors and their movie relationships
@@ -1,3 +1,4 @@
+@parallel
 Feature: Advanced Resource Testing
   As an MCP client
   I want comprehensive resource endpoint coverage
@@ -79,7 +80,7 @@ Feature: Advanced Resource Testing
     And the file sizes should be appropriate for thumbnails
     And the response time should be fast
 
-  @resources @performance
+  @resources @performance @serial
   Scenario: Resource Response Time Requirements
     Given I have a populated database
     When I request each MCP resource
@@ -114,7 +115,7 @@ Feature: Advanced Resource Testing
     Then each request should return appropriate parameter validation errors
     And the errors should include the invalid parameter values
 
-  @resources @caching
+  @resources @caching @serial
   Scenario: Resource Caching Behavior
     Given I have data in the database
     When I request the "movies://database/stats" resource twice
@@ -132,7 +133,7 @@ Feature: Advanced Resource Testing
     And no race conditions should occur
     And the server should remain stable
 
-  @resources @large-datasets
+  @resources @large-datasets @serial
   Scenario: Large Dataset Resource Performance
     Given I have 10000 movies in the database
     When I request the "movies://database/all" resource
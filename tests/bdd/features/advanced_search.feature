@@ -1,3 +1,4 @@
+@parallel
 Feature: Advanced Search and Integration
   As an MCP client
   I want to perform complex searches and operations
@@ -45,7 +46,7 @@ Feature: Advanced Search and Integration
     Then the response should contain 2 movies
     And the movies should be "Titanic" and "Inception"
 
-  @search @performance
+  @search @performance @serial
   Scenario: Large dataset search performance
     Given the database contains 1000+ movies
     When I call the "search_movies" tool with:
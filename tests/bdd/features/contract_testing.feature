@@ -1,3 +1,4 @@
+@parallel
 Feature: Contract Testing
   As an API consumer
   I want the MCP interface to remain stable
@@ -114,7 +115,7 @@ Feature: Contract Testing
     And all years should be integers between 1888 and 2030
     And all strings should have defined maximum lengths
 
-  @contract @performance-contracts
+  @contract @performance-contracts @serial
   Scenario: Performance Contract Validation
     When I validate performance contracts
     Then simple operations should complete within 100ms
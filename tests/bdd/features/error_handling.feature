@@ -1,3 +1,4 @@
+@parallel
 Feature: Error Handling
   As an MCP client
   I want proper error handling for all edge cases
@@ -114,7 +115,7 @@ Feature: Error Handling
     And appropriate error messages should be returned
     And the server should remain responsive
 
-  @error-handling @memory-pressure
+  @error-handling @memory-pressure @serial
   Scenario: Memory Pressure Handling
     When the system is under memory pressure
     And I try to perform memory-intensive operations
@@ -1,3 +1,4 @@
+@parallel
 Feature: MCP Protocol Communication
   As an MCP client
   I want to communicate with the MCP server
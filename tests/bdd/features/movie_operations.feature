@@ -1,3 +1,4 @@
+@parallel
 Feature: Movie Operations
   As an MCP client
   I want to manage movies in the database
@@ -142,7 +143,10 @@ Feature: Movie Operations
     And all movies should be from years <min_year> to <max_year>
 
     Examples:
-      | decade | min_year | max_year |
-      | 1990s  | 1990     | 1999     |
-      | 2000s  | 2000     | 2009     |
-      | 2010s  | 2010     | 2019     |
\ No newline at end of file
+      | decade       | min_year | max_year |
+      | 1990s        | 1990     | 1999     |
+      | 2000s        | 2000     | 2009     |
+      | 2010s        | 2010     | 2019     |
+      | the nineties | 1990     | 1999     |
+      | '00s         | 2000     | 2009     |
+      | 2010-2019    | 2010     | 2019     |
\ No newline at end of file
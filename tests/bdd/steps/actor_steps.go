@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/cucumber/godog"
+	"github.com/francknouama/movies-mcp-server/tests/bdd/support"
 	"github.com/francknouama/movies-mcp-server/tests/bdd/types"
 )
 
@@ -17,7 +18,7 @@ type ActorMoviesResponse = types.ActorMoviesResponse
 
 // InitializeActorSteps registers actor-related step definitions
 func InitializeActorSteps(ctx *godog.ScenarioContext) {
-	stepContext := NewCommonStepContext()
+	stepContext := scenarioStepContext(ctx)
 
 	// Actor CRUD operations
 	ctx.Step(`^the response should contain an actor with:$`, stepContext.theResponseShouldContainAnActorWith)
@@ -133,37 +134,36 @@ func (c *CommonStepContext) theFollowingActorsExist(table *godog.Table) error {
 		return fmt.Errorf("failed to load actors fixture: %w", err)
 	}
 
-	// Create actors from the table data
+	// Load the table rows as one dataset
+	fixtures := &support.Fixtures{}
 	for i, row := range table.Rows {
 		if i == 0 {
 			continue // Skip header row
 		}
 
-		actorData := make(map[string]interface{})
+		actor := support.Actor{Bio: "Test actor biography"}
 		for j, cell := range row.Cells {
 			header := table.Rows[0].Cells[j].Value
 			switch header {
+			case "name":
+				actor.Name = cell.Value
 			case "birth_year":
 				year, err := strconv.Atoi(cell.Value)
 				if err != nil {
 					return fmt.Errorf("invalid birth_year: %s", cell.Value)
 				}
-				actorData[header] = year
+				actor.BirthYear = year
+			case "bio":
+				actor.Bio = cell.Value
 			default:
-				actorData[header] = cell.Value
+				return fmt.Errorf("unknown actor column: %s", header)
 			}
 		}
+		fixtures.Actors = append(fixtures.Actors, actor)
+	}
 
-		// Set default values if not provided
-		if _, exists := actorData["bio"]; !exists {
-			actorData["bio"] = "Test actor biography"
-		}
-
-		// Call add_actor tool for each actor
-		_, err := c.bddContext.CallTool("add_actor", actorData)
-		if err != nil {
-			return fmt.Errorf("failed to create actor %d: %w", i, err)
-		}
+	if err := c.testDB.LoadDataset(fixtures); err != nil {
+		return fmt.Errorf("failed to load actors: %w", err)
 	}
 
 	return nil
@@ -5,11 +5,12 @@ import (
 	"strings"
 
 	"github.com/cucumber/godog"
+	"github.com/francknouama/movies-mcp-server/tests/bdd/support"
 )
 
 // InitializeAdvancedSearchSteps registers advanced search-related step definitions
 func InitializeAdvancedSearchSteps(ctx *godog.ScenarioContext) {
-	stepContext := NewCommonStepContext()
+	stepContext := scenarioStepContext(ctx)
 
 	// Database setup steps
 	ctx.Step(`^the database contains sample movie data$`, stepContext.theDatabaseContainsSampleMovieData)
@@ -70,23 +71,12 @@ func (c *CommonStepContext) theDatabaseContainsSampleMovieData() error {
 	return nil
 }
 
-// theDatabaseContainsMoviesWithVariousRatings ensures movies with various ratings exist
+// theDatabaseContainsMoviesWithVariousRatings loads movies spread over rating ranges
 func (c *CommonStepContext) theDatabaseContainsMoviesWithVariousRatings() error {
-	// Create movies with different ratings for testing
-	ratingsData := []map[string]interface{}{
-		{"title": "High Rating Movie 1", "director": "Director A", "year": 2020, "rating": 9.2},
-		{"title": "High Rating Movie 2", "director": "Director B", "year": 2021, "rating": 8.8},
-		{"title": "Medium Rating Movie", "director": "Director C", "year": 2019, "rating": 7.5},
-		{"title": "Low Rating Movie", "director": "Director D", "year": 2018, "rating": 6.0},
-	}
-
-	for _, movieData := range ratingsData {
-		_, err := c.bddContext.CallTool("add_movie", movieData)
-		if err != nil {
-			return fmt.Errorf("failed to create movie with rating %.1f: %w", movieData["rating"], err)
-		}
+	err := c.testDB.LoadFixtures("rating_scenarios")
+	if err != nil {
+		return fmt.Errorf("failed to load rating scenarios fixture: %w", err)
 	}
-
 	return nil
 }
 
@@ -103,22 +93,20 @@ func (c *CommonStepContext) theDatabaseContainsPlusMovies(minCount int) error {
 		return fmt.Errorf("failed to count movies: %w", err)
 	}
 
-	// Create additional movies if needed
+	// Generate the missing movies as one dataset
 	if currentCount < minCount {
-		needed := minCount - currentCount
-		for i := 0; i < needed; i++ {
-			movieData := map[string]interface{}{
-				"title":    fmt.Sprintf("Generated Movie %d", i+1),
-				"director": fmt.Sprintf("Director %d", i%10),
-				"year":     2000 + (i % 24),
-				"rating":   5.0 + float64(i%50)/10.0,
-				"genre":    []string{"Action", "Drama", "Comedy", "Sci-Fi"}[i%4],
-			}
-
-			_, err := c.bddContext.CallTool("add_movie", movieData)
-			if err != nil {
-				return fmt.Errorf("failed to create generated movie %d: %w", i, err)
-			}
+		fixtures := &support.Fixtures{}
+		for i := 0; i < minCount-currentCount; i++ {
+			fixtures.Movies = append(fixtures.Movies, support.Movie{
+				Title:    fmt.Sprintf("Generated Movie %d", i+1),
+				Director: fmt.Sprintf("Director %d", i%10),
+				Year:     2000 + (i % 24),
+				Rating:   5.0 + float64(i%50)/10.0,
+				Genre:    []string{"Action", "Drama", "Comedy", "Sci-Fi"}[i%4],
+			})
+		}
+		if err := c.testDB.LoadDataset(fixtures); err != nil {
+			return fmt.Errorf("failed to generate movies: %w", err)
 		}
 	}
 
@@ -133,7 +121,7 @@ func (c *CommonStepContext) allReturnedMoviesShouldHaveRatingBetween(minRating,
 	}
 
 	return validateMovieRange(response.Movies,
-		func(m MovieResponse) float64 { return m.Rating },
+		func(m *MovieResponse) float64 { return m.Rating },
 		func(rating, min, max float64) bool { return rating >= min && rating <= max },
 		minRating, maxRating, "rating")
 }
@@ -142,7 +130,7 @@ func (c *CommonStepContext) allReturnedMoviesShouldHaveRatingBetween(minRating,
 func (c *CommonStepContext) theResponseShouldContainMoviesWithSimilarCharacteristics() error {
 	var response MoviesResponse
 	if err := c.bddContext.ParseJSONResponse(&response); err != nil {
-		var movies []MovieResponse
+		var movies []*MovieResponse
 		if err2 := c.bddContext.ParseJSONResponse(&movies); err2 != nil {
 			return fmt.Errorf("failed to parse movies response: %w", err)
 		}
@@ -167,7 +155,7 @@ func (c *CommonStepContext) theOriginalMovieShouldNotBeIncludedInResults() error
 
 	var response MoviesResponse
 	if err := c.bddContext.ParseJSONResponse(&response); err != nil {
-		var movies []MovieResponse
+		var movies []*MovieResponse
 		if err2 := c.bddContext.ParseJSONResponse(&movies); err2 != nil {
 			return fmt.Errorf("failed to parse movies response: %w", err)
 		}
@@ -183,11 +171,12 @@ func (c *CommonStepContext) theOriginalMovieShouldNotBeIncludedInResults() error
 	return nil
 }
 
-// theFollowingMoviesWithCastExist creates movies with cast
+// theFollowingMoviesWithCastExist loads movies with their cast as one dataset
 func (c *CommonStepContext) theFollowingMoviesWithCastExist(table *godog.Table) error {
-	movieActorPairs := make(map[string][]string) // movie_title -> actor_names
+	fixtures := &support.Fixtures{}
+	movies := make(map[string]bool)
+	actors := make(map[string]bool)
 
-	// Parse the table to group actors by movie
 	for i, row := range table.Rows {
 		if i == 0 {
 			continue // Skip header row
@@ -197,75 +186,37 @@ func (c *CommonStepContext) theFollowingMoviesWithCastExist(table *godog.Table)
 		director := row.Cells[1].Value
 		actorName := row.Cells[2].Value
 
-		if _, exists := movieActorPairs[movieTitle]; !exists {
-			// Create the movie first
-			movieData := map[string]interface{}{
-				"title":    movieTitle,
-				"director": director,
-				"year":     2020,
-				"rating":   8.0,
-			}
-
-			_, err := c.bddContext.CallTool("add_movie", movieData)
-			if err != nil {
-				return fmt.Errorf("failed to create movie '%s': %w", movieTitle, err)
-			}
-
-			// Store movie ID
-			var responseData map[string]interface{}
-			if parseErr := c.bddContext.ParseJSONResponse(&responseData); parseErr == nil {
-				movieID, _ := c.dataManager.ParseIDFromResponse(responseData, "id")
-				c.dataManager.StoreID("movie_"+movieTitle, movieID)
-			}
-
-			movieActorPairs[movieTitle] = []string{}
+		if !movies[movieTitle] {
+			movies[movieTitle] = true
+			fixtures.Movies = append(fixtures.Movies, support.Movie{
+				Title:    movieTitle,
+				Director: director,
+				Year:     2020,
+				Rating:   8.0,
+			})
 		}
-
-		movieActorPairs[movieTitle] = append(movieActorPairs[movieTitle], actorName)
-	}
-
-	// Create actors and link them to movies
-	for movieTitle, actorNames := range movieActorPairs {
-		movieID, exists := c.dataManager.GetID("movie_" + movieTitle)
-		if !exists {
-			return fmt.Errorf("movie ID not found for '%s'", movieTitle)
+		if !actors[actorName] {
+			actors[actorName] = true
+			fixtures.Actors = append(fixtures.Actors, support.Actor{
+				Name:      actorName,
+				BirthYear: 1980,
+				Bio:       "Test actor",
+			})
 		}
+		fixtures.Cast = append(fixtures.Cast, support.CastLink{Movie: movieTitle, Actor: actorName})
+	}
 
-		for _, actorName := range actorNames {
-			// Create actor
-			actorData := map[string]interface{}{
-				"name":       actorName,
-				"birth_year": 1980,
-				"bio":        "Test actor",
-			}
-
-			_, err := c.bddContext.CallTool("add_actor", actorData)
-			if err != nil {
-				return fmt.Errorf("failed to create actor '%s': %w", actorName, err)
-			}
-
-			// Get actor ID
-			var responseData map[string]interface{}
-			if parseErr := c.bddContext.ParseJSONResponse(&responseData); parseErr != nil {
-				return fmt.Errorf("failed to parse actor response: %w", parseErr)
-			}
-
-			actorID, err := c.dataManager.ParseIDFromResponse(responseData, "id")
-			if err != nil {
-				return fmt.Errorf("failed to get actor ID: %w", err)
-			}
-
-			// Link actor to movie
-			linkData := map[string]interface{}{
-				"actor_id": actorID,
-				"movie_id": movieID,
-			}
+	if err := c.testDB.LoadDataset(fixtures); err != nil {
+		return fmt.Errorf("failed to load movies with cast: %w", err)
+	}
 
-			_, err = c.bddContext.CallTool("link_actor_to_movie", linkData)
-			if err != nil {
-				return fmt.Errorf("failed to link actor '%s' to movie '%s': %w", actorName, movieTitle, err)
-			}
+	// Store movie IDs for later steps
+	for movieTitle := range movies {
+		movieID, err := c.testDB.MovieIDByTitle(movieTitle)
+		if err != nil {
+			return err
 		}
+		c.dataManager.StoreID("movie_"+movieTitle, movieID)
 	}
 
 	return nil
@@ -290,7 +241,7 @@ func (c *CommonStepContext) iSearchForMoviesWith(searchTerm string) error {
 func (c *CommonStepContext) theMoviesShouldBeAnd(movie1, movie2 string) error {
 	var response MoviesResponse
 	if err := c.bddContext.ParseJSONResponse(&response); err != nil {
-		var movies []MovieResponse
+		var movies []*MovieResponse
 		if err2 := c.bddContext.ParseJSONResponse(&movies); err2 != nil {
 			return fmt.Errorf("failed to parse movies response: %w", err)
 		}
@@ -330,7 +281,7 @@ func (c *CommonStepContext) theResponseTimeShouldBeUnderSeconds(maxSeconds int)
 func (c *CommonStepContext) theResponseShouldContainUpToMovies(maxCount int) error {
 	var response MoviesResponse
 	if err := c.bddContext.ParseJSONResponse(&response); err != nil {
-		var movies []MovieResponse
+		var movies []*MovieResponse
 		if err2 := c.bddContext.ParseJSONResponse(&movies); err2 != nil {
 			return fmt.Errorf("failed to parse movies response: %w", err)
 		}
@@ -515,7 +466,7 @@ func (c *CommonStepContext) iSearchForMoviesWithTitle(title string) error {
 func (c *CommonStepContext) theResponseShouldContainTheCreatedMovie() error {
 	var response MoviesResponse
 	if err := c.bddContext.ParseJSONResponse(&response); err != nil {
-		var movies []MovieResponse
+		var movies []*MovieResponse
 		if err2 := c.bddContext.ParseJSONResponse(&movies); err2 != nil {
 			return fmt.Errorf("failed to parse movies response: %w", err)
 		}
@@ -694,7 +645,7 @@ func (c *CommonStepContext) theResultsShouldStartFromTheMovie(position int) erro
 	// In a real implementation, we would verify the actual offset
 	var response MoviesResponse
 	if err := c.bddContext.ParseJSONResponse(&response); err != nil {
-		var movies []MovieResponse
+		var movies []*MovieResponse
 		if err2 := c.bddContext.ParseJSONResponse(&movies); err2 != nil {
 			return fmt.Errorf("failed to parse movies response: %w", err)
 		}
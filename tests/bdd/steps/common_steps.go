@@ -3,6 +3,7 @@ package steps
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/cucumber/godog"
@@ -14,6 +15,8 @@ import (
 // DatabaseInterface defines common database operations for both implementations
 type DatabaseInterface interface {
 	LoadFixtures(fixtureName string) error
+	LoadDataset(fixtures *support.Fixtures) error
+	MovieIDByTitle(title string) (int, error)
 	CleanupAfterScenario() error
 	CountRows(table string, whereClause string, args ...interface{}) (int, error)
 	VerifyMovieExists(title, director string, year int) (bool, error)
@@ -29,6 +32,43 @@ type CommonStepContext struct {
 	ctx          context.Context
 }
 
+// The running suite's scenario databases
+var (
+	databasesMu  sync.Mutex
+	databases    *support.TestDatabaseManager
+	databasesErr error
+)
+
+// scenarioDatabases returns the manager handing every scenario of the
+// running suite its own database
+func scenarioDatabases() (*support.TestDatabaseManager, error) {
+	databasesMu.Lock()
+	defer databasesMu.Unlock()
+	if databases == nil && databasesErr == nil {
+		databasesErr = fmt.Errorf("the test suite was not initialized with InitializeTestSuite")
+	}
+	return databases, databasesErr
+}
+
+// InitializeTestSuite creates the scenario databases when a suite starts
+// and removes them when it ends, so each run of the features, serial or
+// concurrent, gets a directory of its own
+func InitializeTestSuite(ctx *godog.TestSuiteContext) {
+	ctx.BeforeSuite(func() {
+		databasesMu.Lock()
+		defer databasesMu.Unlock()
+		databases, databasesErr = support.NewTestDatabaseManager()
+	})
+	ctx.AfterSuite(func() {
+		databasesMu.Lock()
+		defer databasesMu.Unlock()
+		if databases != nil {
+			_ = databases.Close()
+		}
+		databases, databasesErr = nil, nil
+	})
+}
+
 // NewCommonStepContext creates a new common step context
 func NewCommonStepContext() *CommonStepContext {
 	return &CommonStepContext{
@@ -38,23 +78,49 @@ func NewCommonStepContext() *CommonStepContext {
 	}
 }
 
-// InitializeMCPSteps registers common MCP protocol step definitions
-func InitializeMCPSteps(ctx *godog.ScenarioContext) {
+// The step contexts of the scenarios being initialized
+var (
+	stepContextsMu sync.Mutex
+	stepContexts   = make(map[*godog.ScenarioContext]*CommonStepContext)
+)
+
+// scenarioStepContext returns the step context of the scenario ctx
+// initializes. Every step file registers its steps on the same context, so
+// they all reach the server and database the scenario was set up with. The
+// first call creates it and registers the hooks that set it up before the
+// scenario and tear it down after.
+func scenarioStepContext(ctx *godog.ScenarioContext) *CommonStepContext {
+	stepContextsMu.Lock()
+	defer stepContextsMu.Unlock()
+
+	if stepContext, exists := stepContexts[ctx]; exists {
+		return stepContext
+	}
 	stepContext := NewCommonStepContext()
+	stepContexts[ctx] = stepContext
 
-	// Setup and teardown hooks
+	scenario := ctx
 	ctx.Before(func(ctx context.Context, sc *godog.Scenario) (context.Context, error) {
 		return ctx, stepContext.setupScenario()
 	})
-
 	ctx.After(func(ctx context.Context, sc *godog.Scenario, err error) (context.Context, error) {
+		stepContextsMu.Lock()
+		delete(stepContexts, scenario)
+		stepContextsMu.Unlock()
 		return ctx, stepContext.teardownScenario()
 	})
+	return stepContext
+}
+
+// InitializeMCPSteps registers common MCP protocol step definitions
+func InitializeMCPSteps(ctx *godog.ScenarioContext) {
+	stepContext := scenarioStepContext(ctx)
 
 	// Common step definitions
 	ctx.Step(`^the MCP server is running$`, stepContext.theMCPServerIsRunning)
 	ctx.Step(`^the MCP connection is initialized$`, stepContext.theMCPConnectionIsInitialized)
 	ctx.Step(`^the database is clean$`, stepContext.theDatabaseIsClean)
+	ctx.Step(`^the "([^"]*)" fixture is loaded$`, stepContext.theFixtureIsLoaded)
 	ctx.Step(`^the response should be successful$`, stepContext.theResponseShouldBeSuccessful)
 	ctx.Step(`^the response should contain an error$`, stepContext.theResponseShouldContainAnError)
 	ctx.Step(`^the response should contain an error with message "([^"]*)"$`, stepContext.theResponseShouldContainAnErrorWithMessage)
@@ -62,22 +128,18 @@ func InitializeMCPSteps(ctx *godog.ScenarioContext) {
 
 // setupScenario initializes the scenario context
 func (c *CommonStepContext) setupScenario() error {
-	var err error
-
-	// Create a new SQLite test database (temporary)
-	c.sqliteDB, err = support.NewSQLiteTestDatabase("")
+	manager, err := scenarioDatabases()
 	if err != nil {
-		return fmt.Errorf("failed to initialize SQLite test database: %w", err)
+		return fmt.Errorf("failed to initialize SQLite test databases: %w", err)
 	}
 
-	// Use SQLite database for tests
-	c.testDB = c.sqliteDB
-
-	// Clean database data before each scenario
-	err = c.testDB.CleanupAfterScenario()
+	// Every scenario gets a freshly migrated database file of its own, so
+	// scenarios cannot see each other's writes even when run in parallel
+	c.sqliteDB, err = manager.Open()
 	if err != nil {
-		return fmt.Errorf("failed to clean database before scenario: %w", err)
+		return fmt.Errorf("failed to initialize SQLite test database: %w", err)
 	}
+	c.testDB = c.sqliteDB
 
 	// Configure database environment for MCP server with SQLite path
 	if c.sqliteDB != nil {
@@ -113,11 +175,16 @@ func (c *CommonStepContext) teardownScenario() error {
 		}
 	}
 
-	// Clean up SQLite test database
+	// Discard the scenario's database once its server has stopped
 	if c.sqliteDB != nil {
-		if err := c.sqliteDB.Cleanup(); err != nil {
+		manager, err := scenarioDatabases()
+		if err == nil {
+			err = manager.Release(c.sqliteDB)
+		}
+		if err != nil {
 			errors = append(errors, fmt.Errorf("sqlite database cleanup failed: %w", err))
 		}
+		c.sqliteDB = nil
 	}
 
 	// Clear test data manager
@@ -148,9 +215,17 @@ func (c *CommonStepContext) theMCPConnectionIsInitialized() error {
 	return c.bddContext.WaitForServer(5 * time.Second)
 }
 
+// theFixtureIsLoaded loads a named YAML dataset from the fixtures directory
+func (c *CommonStepContext) theFixtureIsLoaded(fixtureName string) error {
+	if err := c.testDB.LoadFixtures(fixtureName); err != nil {
+		return fmt.Errorf("failed to load fixture %s: %w", fixtureName, err)
+	}
+	return nil
+}
+
 // theDatabaseIsClean step implementation
 func (c *CommonStepContext) theDatabaseIsClean() error {
-	// Database is already cleaned in setupScenario
+	// Each scenario starts with an empty database of its own
 	// This step can verify the database is empty
 	count, err := c.testDB.CountRows("movies", "")
 	if err != nil {
@@ -3,6 +3,7 @@ package steps
 import (
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -111,7 +112,7 @@ func NewContractTestingSteps(bddContext *context.BDDContext, utilities *support.
 
 // InitializeContractTestingSteps registers all contract testing step definitions
 func InitializeContractTestingSteps(ctx *godog.ScenarioContext) {
-	stepContext := NewCommonStepContext()
+	stepContext := scenarioStepContext(ctx)
 	utilities := support.NewTestUtilities()
 	cts := &ContractTestingSteps{
 		bddContext:         stepContext.bddContext,
@@ -184,6 +185,13 @@ func InitializeContractTestingSteps(ctx *godog.ScenarioContext) {
 func (cts *ContractTestingSteps) theContractDefinitionsAreLoaded() error {
 	contractsDir := "contracts"
 
+	// Fail on drift between the generated contracts and the registered tools
+	verify := exec.Command("go", "run", "./cmd/gen-contracts", "-verify")
+	verify.Dir = filepath.Join("..", "..")
+	if output, err := verify.CombinedOutput(); err != nil {
+		return fmt.Errorf("tool contracts drifted from the server: %v\n%s", err, output)
+	}
+
 	// Load all contract files
 	contractFiles := []string{"movie_tools.yaml", "actor_tools.yaml", "resource_contracts.yaml"}
 
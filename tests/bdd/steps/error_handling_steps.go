@@ -36,7 +36,7 @@ func NewErrorHandlingSteps(bddContext *bddcontext.BDDContext, utilities *support
 
 // InitializeErrorHandlingSteps registers all error handling step definitions
 func InitializeErrorHandlingSteps(ctx *godog.ScenarioContext) {
-	stepContext := NewCommonStepContext()
+	stepContext := scenarioStepContext(ctx)
 	utilities := support.NewTestUtilities()
 	ehs := &ErrorHandlingSteps{
 		bddContext:    stepContext.bddContext,
@@ -1,6 +1,7 @@
 package steps
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,7 @@ import (
 
 // InitializeMCPProtocolSteps registers MCP protocol-related step definitions
 func InitializeMCPProtocolSteps(ctx *godog.ScenarioContext) {
-	stepContext := NewCommonStepContext()
+	stepContext := scenarioStepContext(ctx)
 
 	// MCP connection steps
 	ctx.Step(`^I have a valid MCP client connection$`, stepContext.iHaveAValidMCPClientConnection)
@@ -43,7 +44,7 @@ func (c *CommonStepContext) iHaveAValidMCPClientConnection() error {
 	// Try to list tools to verify connection is working
 	// Note: This should use the MCP client's ListTools method, not CallTool
 	if mcpClient := c.bddContext.GetMCPClient(); mcpClient != nil {
-		_, err := mcpClient.ListTools()
+		_, err := mcpClient.ListTools(context.Background())
 		if err != nil {
 			return fmt.Errorf("MCP client connection not valid: %w", err)
 		}
@@ -142,7 +143,7 @@ func (c *CommonStepContext) theProtocolVersionShouldBe(expectedVersion string) e
 func (c *CommonStepContext) iSendAToolsListRequest() error {
 	// Use our MCP client to list tools
 	if mcpClient := c.bddContext.GetMCPClient(); mcpClient != nil {
-		_, err := mcpClient.ListTools()
+		_, err := mcpClient.ListTools(context.Background())
 		if err != nil {
 			return fmt.Errorf("failed to send tools/list request: %w", err)
 		}
@@ -158,7 +159,7 @@ func (c *CommonStepContext) iSendAToolsListRequest() error {
 func (c *CommonStepContext) iSendAResourcesListRequest() error {
 	// Use our MCP client to list resources
 	if mcpClient := c.bddContext.GetMCPClient(); mcpClient != nil {
-		_, err := mcpClient.ListResources()
+		_, err := mcpClient.ListResources(context.Background())
 		if err != nil {
 			return fmt.Errorf("failed to send resources/list request: %w", err)
 		}
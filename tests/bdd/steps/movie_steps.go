@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/cucumber/godog"
+	"github.com/francknouama/movies-mcp-server/tests/bdd/support"
 	"github.com/francknouama/movies-mcp-server/tests/bdd/types"
 )
 
@@ -18,7 +19,7 @@ type MoviesResponse = types.MoviesListResponse
 
 // InitializeMovieSteps registers movie-related step definitions
 func InitializeMovieSteps(ctx *godog.ScenarioContext) {
-	stepContext := NewCommonStepContext()
+	stepContext := scenarioStepContext(ctx)
 
 	// Movie management steps
 	ctx.Step(`^I call the "([^"]*)" tool with:$`, stepContext.iCallTheToolWith)
@@ -153,7 +154,7 @@ func (c *CommonStepContext) theResponseShouldContainNMovies(expectedCount int) e
 	var response MoviesResponse
 	if err := c.bddContext.ParseJSONResponse(&response); err != nil {
 		// Try parsing as a single movie list
-		var movies []MovieResponse
+		var movies []*MovieResponse
 		if err2 := c.bddContext.ParseJSONResponse(&movies); err2 != nil {
 			return fmt.Errorf("failed to parse movies response: %w", err)
 		}
@@ -176,38 +177,47 @@ func (c *CommonStepContext) theFollowingMoviesExist(table *godog.Table) error {
 		return fmt.Errorf("failed to load movies fixture: %w", err)
 	}
 
-	// Create movies from the table data
+	// Load the table rows as one dataset; columns a row leaves out get
+	// defaults the schema accepts
+	fixtures := &support.Fixtures{}
 	for i, row := range table.Rows {
 		if i == 0 {
 			continue // Skip header row
 		}
 
-		movieData := make(map[string]interface{})
+		movie := support.Movie{Director: "Test Director", Year: 2000}
 		for j, cell := range row.Cells {
 			header := table.Rows[0].Cells[j].Value
 			switch header {
+			case "title":
+				movie.Title = cell.Value
+			case "director":
+				movie.Director = cell.Value
 			case "year":
 				year, err := strconv.Atoi(cell.Value)
 				if err != nil {
 					return fmt.Errorf("invalid year: %s", cell.Value)
 				}
-				movieData[header] = year
+				movie.Year = year
 			case "rating":
 				rating, err := strconv.ParseFloat(cell.Value, 64)
 				if err != nil {
 					return fmt.Errorf("invalid rating: %s", cell.Value)
 				}
-				movieData[header] = rating
+				movie.Rating = rating
+			case "genre":
+				movie.Genre = cell.Value
+			case "description":
+				movie.Description = cell.Value
 			default:
-				movieData[header] = cell.Value
+				return fmt.Errorf("unknown movie column: %s", header)
 			}
 		}
+		fixtures.Movies = append(fixtures.Movies, movie)
+	}
 
-		// Call add_movie tool for each movie
-		_, err := c.bddContext.CallTool("add_movie", movieData)
-		if err != nil {
-			return fmt.Errorf("failed to create movie %d: %w", i, err)
-		}
+	if err := c.testDB.LoadDataset(fixtures); err != nil {
+		return fmt.Errorf("failed to load movies: %w", err)
 	}
 
 	return nil
@@ -252,7 +262,7 @@ func (c *CommonStepContext) theMovieTitleShouldContain(expectedSubstring string)
 	var response MoviesResponse
 	if err := c.bddContext.ParseJSONResponse(&response); err != nil {
 		// Try parsing as a single movie list
-		var movies []MovieResponse
+		var movies []*MovieResponse
 		if err2 := c.bddContext.ParseJSONResponse(&movies); err2 != nil {
 			return fmt.Errorf("failed to parse movies response: %w", err)
 		}
@@ -277,7 +287,7 @@ func (c *CommonStepContext) allMoviesShouldHaveDirector(expectedDirector string)
 	var response MoviesResponse
 	if err := c.bddContext.ParseJSONResponse(&response); err != nil {
 		// Try parsing as a single movie list
-		var movies []MovieResponse
+		var movies []*MovieResponse
 		if err2 := c.bddContext.ParseJSONResponse(&movies); err2 != nil {
 			return fmt.Errorf("failed to parse movies response: %w", err)
 		}
@@ -414,7 +424,7 @@ func (c *CommonStepContext) theMoviesShouldBeOrderedByRatingDescending() error {
 	var response MoviesResponse
 	if err := c.bddContext.ParseJSONResponse(&response); err != nil {
 		// Try parsing as a single movie list
-		var movies []MovieResponse
+		var movies []*MovieResponse
 		if err2 := c.bddContext.ParseJSONResponse(&movies); err2 != nil {
 			return fmt.Errorf("failed to parse movies response: %w", err)
 		}
@@ -440,7 +450,7 @@ func (c *CommonStepContext) theFirstMovieShouldHaveRating(expectedRating float64
 	var response MoviesResponse
 	if err := c.bddContext.ParseJSONResponse(&response); err != nil {
 		// Try parsing as a single movie list
-		var movies []MovieResponse
+		var movies []*MovieResponse
 		if err2 := c.bddContext.ParseJSONResponse(&movies); err2 != nil {
 			return fmt.Errorf("failed to parse movies response: %w", err)
 		}
@@ -516,7 +526,7 @@ func (c *CommonStepContext) allMoviesShouldBeFromYearsTo(minYear, maxYear int) e
 	}
 
 	return validateMovieRange(response.Movies,
-		func(m MovieResponse) int { return m.Year },
+		func(m *MovieResponse) int { return m.Year },
 		func(year, min, max int) bool { return year >= min && year <= max },
 		minYear, maxYear, "year")
 }
@@ -48,7 +48,7 @@ func NewSimplePerformanceSteps(bddContext *context.BDDContext, utilities *suppor
 
 // InitializePerformanceSteps registers performance step definitions following the existing pattern
 func InitializePerformanceSteps(ctx *godog.ScenarioContext) {
-	stepContext := NewCommonStepContext()
+	stepContext := scenarioStepContext(ctx)
 	utilities := support.NewTestUtilities()
 	sps := &SimplePerformanceSteps{
 		bddContext: stepContext.bddContext,
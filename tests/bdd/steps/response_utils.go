@@ -10,7 +10,7 @@ func parseMoviesResponse(c *CommonStepContext) (MoviesResponse, error) {
 	var response MoviesResponse
 	if err := c.bddContext.ParseJSONResponse(&response); err != nil {
 		// Try parsing as a simple movies array
-		var movies []MovieResponse
+		var movies []*MovieResponse
 		if err2 := c.bddContext.ParseJSONResponse(&movies); err2 != nil {
 			return MoviesResponse{}, fmt.Errorf("failed to parse movies response: %w", err)
 		}
@@ -25,7 +25,7 @@ func parseMoviesResponse(c *CommonStepContext) (MoviesResponse, error) {
 }
 
 // validateMovieRange validates that all movies in the response meet a specific condition
-func validateMovieRange[T comparable](movies []MovieResponse, getValue func(MovieResponse) T, 
+func validateMovieRange[T comparable](movies []*MovieResponse, getValue func(*MovieResponse) T, 
 	isValid func(T, T, T) bool, min, max T, fieldName string) error {
 	
 	for _, movie := range movies {
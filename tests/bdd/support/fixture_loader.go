@@ -2,60 +2,116 @@ package support
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 
 	"gopkg.in/yaml.v3"
 )
 
-// FixtureInserter defines the interface for inserting fixture data
+// FixturesDir is the directory named datasets are read from, relative to the
+// BDD suite's working directory
+var FixturesDir = "fixtures"
+
+// Fixtures is a dataset loaded into the test database before a scenario
+type Fixtures struct {
+	Movies  []Movie    `yaml:"movies"`
+	Actors  []Actor    `yaml:"actors"`
+	Cast    []CastLink `yaml:"cast"`
+	Posters []Poster   `yaml:"posters"`
+}
+
+// Movie is a movie fixture. A zero ID lets the database assign one.
+type Movie struct {
+	ID          int      `yaml:"id"`
+	Title       string   `yaml:"title"`
+	Director    string   `yaml:"director"`
+	Year        int      `yaml:"year"`
+	Genre       string   `yaml:"genre"` // Single genre, used when Genres is empty
+	Genres      []string `yaml:"genres"`
+	Rating      float64  `yaml:"rating"`
+	Description string   `yaml:"description"`
+	PosterURL   string   `yaml:"poster_url"`
+}
+
+// Actor is an actor fixture. MovieIDs links the actor to those movies
+// without a role, as a shorthand for cast entries.
+type Actor struct {
+	ID        int    `yaml:"id"`
+	Name      string `yaml:"name"`
+	BirthYear int    `yaml:"birth_year"`
+	Bio       string `yaml:"bio"`
+	MovieIDs  []int  `yaml:"movie_ids"`
+}
+
+// CastLink links an actor to a movie. Each side is given by ID or, for data
+// whose IDs the database assigns, by movie title or actor name.
+type CastLink struct {
+	MovieID      int    `yaml:"movie_id"`
+	Movie        string `yaml:"movie"`
+	ActorID      int    `yaml:"actor_id"`
+	Actor        string `yaml:"actor"`
+	Role         string `yaml:"role"`
+	BillingOrder int    `yaml:"billing_order"`
+}
+
+// Poster sets a movie's poster, either as a URL or as image data read from a
+// file relative to FixturesDir
+type Poster struct {
+	MovieID  int    `yaml:"movie_id"`
+	URL      string `yaml:"url"`
+	File     string `yaml:"file"`
+	MIMEType string `yaml:"mime_type"`
+}
+
+// FixtureInserter loads a dataset, all or nothing
 type FixtureInserter interface {
-	insertMovie(movie Movie) error
-	insertActor(actor Actor) error
+	InsertFixtures(fixtures *Fixtures) error
 }
 
-// LoadFixturesFromFile loads test data from a YAML fixture file for any database type
-func LoadFixturesFromFile(fixtureName string, inserter FixtureInserter) error {
+// fixtureNamePattern matches dataset names; it keeps names inside FixturesDir
+var fixtureNamePattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// isValidFixtureName reports whether name is a dataset name
+func isValidFixtureName(name string) bool {
+	return fixtureNamePattern.MatchString(name)
+}
+
+// ReadFixtures reads the named dataset from FixturesDir
+func ReadFixtures(fixtureName string) (*Fixtures, error) {
 	// Validate fixture name to prevent path traversal
 	if !isValidFixtureName(fixtureName) {
-		return fmt.Errorf("invalid fixture name: %s", fixtureName)
+		return nil, fmt.Errorf("invalid fixture name: %s", fixtureName)
 	}
 
-	fixturesDir := "fixtures"
-	fixturePath := filepath.Join(fixturesDir, fixtureName+".yaml")
-
+	fixturePath := filepath.Join(FixturesDir, fixtureName+".yaml")
 	data, err := os.ReadFile(filepath.Clean(fixturePath))
 	if err != nil {
-		return fmt.Errorf("failed to read fixture file %s: %w", fixturePath, err)
+		return nil, fmt.Errorf("failed to read fixture file %s: %w", fixturePath, err)
 	}
 
 	var fixtures Fixtures
-	err = yaml.Unmarshal(data, &fixtures)
-	if err != nil {
-		return fmt.Errorf("failed to parse fixture file %s: %w", fixturePath, err)
+	if err := yaml.Unmarshal(data, &fixtures); err != nil {
+		return nil, fmt.Errorf("failed to parse fixture file %s: %w", fixturePath, err)
 	}
+	return &fixtures, nil
+}
 
-	// Load movies
-	for _, movie := range fixtures.Movies {
-		err = inserter.insertMovie(movie)
-		if err != nil {
-			return fmt.Errorf("failed to insert movie fixture: %w", err)
-		}
+// LoadFixturesFromFile loads the named dataset through inserter
+func LoadFixturesFromFile(fixtureName string, inserter FixtureInserter) error {
+	fixtures, err := ReadFixtures(fixtureName)
+	if err != nil {
+		return err
 	}
-
-	// Load actors
-	for _, actor := range fixtures.Actors {
-		err = inserter.insertActor(actor)
-		if err != nil {
-			return fmt.Errorf("failed to insert actor fixture: %w", err)
-		}
+	if err := inserter.InsertFixtures(fixtures); err != nil {
+		return fmt.Errorf("failed to load fixture %s: %w", fixtureName, err)
 	}
-
 	return nil
 }
 
-// DatabaseFixtureInserter provides common fixture insertion logic
+// DatabaseFixtureInserter inserts datasets into a migrated database
 type DatabaseFixtureInserter struct {
 	db *sql.DB
 }
@@ -65,38 +121,159 @@ func NewDatabaseFixtureInserter(db *sql.DB) *DatabaseFixtureInserter {
 	return &DatabaseFixtureInserter{db: db}
 }
 
-// insertMovie inserts a movie fixture into the database
-func (d *DatabaseFixtureInserter) insertMovie(movie Movie) error {
-	query := `
-		INSERT INTO movies (id, title, director, year, genre, rating, genres)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-		ON CONFLICT (id) DO NOTHING
-	`
+// InsertFixtures inserts a dataset in one transaction, so a failing row
+// rolls back the whole dataset. Rows whose ID already exists are skipped,
+// which lets datasets that share movies be loaded together.
+func (d *DatabaseFixtureInserter) InsertFixtures(fixtures *Fixtures) error {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin fixture transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, movie := range fixtures.Movies {
+		if err := insertMovie(tx, movie); err != nil {
+			return fmt.Errorf("failed to insert movie %q: %w", movie.Title, err)
+		}
+	}
 
-	var genres []byte
-	if len(movie.Genres) > 0 {
-		genresJSON := "["
-		for i, genre := range movie.Genres {
-			if i > 0 {
-				genresJSON += ", "
+	for _, actor := range fixtures.Actors {
+		if err := insertActor(tx, actor); err != nil {
+			return fmt.Errorf("failed to insert actor %q: %w", actor.Name, err)
+		}
+		for _, movieID := range actor.MovieIDs {
+			link := CastLink{MovieID: movieID, ActorID: actor.ID, Actor: actor.Name}
+			if err := insertCastLink(tx, link); err != nil {
+				return fmt.Errorf("failed to link actor %q to movie %d: %w", actor.Name, movieID, err)
 			}
-			genresJSON += fmt.Sprintf(`"%s"`, genre)
 		}
-		genresJSON += "]"
-		genres = []byte(genresJSON)
 	}
 
-	_, err := d.db.Exec(query, movie.ID, movie.Title, movie.Director, movie.Year, movie.Genre, movie.Rating, genres)
+	for _, link := range fixtures.Cast {
+		if err := insertCastLink(tx, link); err != nil {
+			return fmt.Errorf("failed to insert cast link %+v: %w", link, err)
+		}
+	}
+
+	for _, poster := range fixtures.Posters {
+		if err := insertPoster(tx, poster); err != nil {
+			return fmt.Errorf("failed to insert poster for movie %d: %w", poster.MovieID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit fixtures: %w", err)
+	}
+	return nil
+}
+
+// insertMovie inserts a movie fixture
+func insertMovie(tx *sql.Tx, movie Movie) error {
+	genres := movie.Genres
+	if len(genres) == 0 && movie.Genre != "" {
+		genres = []string{movie.Genre}
+	}
+	if genres == nil {
+		genres = []string{}
+	}
+	genreJSON, err := json.Marshal(genres)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO movies (id, title, director, year, genre, rating, description, poster_url)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO NOTHING
+	`
+	_, err = tx.Exec(query, nullableID(movie.ID), movie.Title, movie.Director, movie.Year,
+		string(genreJSON), movie.Rating, nullableString(movie.Description), nullableString(movie.PosterURL))
 	return err
 }
 
-// insertActor inserts an actor fixture into the database
-func (d *DatabaseFixtureInserter) insertActor(actor Actor) error {
+// insertActor inserts an actor fixture
+func insertActor(tx *sql.Tx, actor Actor) error {
 	query := `
 		INSERT INTO actors (id, name, birth_year, bio)
-		VALUES ($1, $2, $3, $4)
+		VALUES (?, ?, ?, ?)
 		ON CONFLICT (id) DO NOTHING
 	`
-	_, err := d.db.Exec(query, actor.ID, actor.Name, actor.BirthYear, actor.Bio)
+	_, err := tx.Exec(query, nullableID(actor.ID), actor.Name, actor.BirthYear, nullableString(actor.Bio))
 	return err
 }
+
+// insertCastLink links an actor to a movie, resolving titles and names
+func insertCastLink(tx *sql.Tx, link CastLink) error {
+	movieID, err := resolveID(tx, "SELECT id FROM movies WHERE title = ? ORDER BY id LIMIT 1", link.MovieID, link.Movie)
+	if err != nil {
+		return fmt.Errorf("movie: %w", err)
+	}
+	actorID, err := resolveID(tx, "SELECT id FROM actors WHERE name = ? ORDER BY id LIMIT 1", link.ActorID, link.Actor)
+	if err != nil {
+		return fmt.Errorf("actor: %w", err)
+	}
+
+	query := `
+		INSERT INTO movie_actors (movie_id, actor_id, role, billing_order)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT (movie_id, actor_id) DO NOTHING
+	`
+	_, err = tx.Exec(query, movieID, actorID, nullableString(link.Role), nullableID(link.BillingOrder))
+	return err
+}
+
+// insertPoster sets a movie's poster URL or image data
+func insertPoster(tx *sql.Tx, poster Poster) error {
+	var data []byte
+	if poster.File != "" {
+		var err error
+		data, err = os.ReadFile(filepath.Clean(filepath.Join(FixturesDir, poster.File)))
+		if err != nil {
+			return fmt.Errorf("failed to read poster file: %w", err)
+		}
+	}
+
+	query := `
+		UPDATE movies
+		SET poster_url = COALESCE(?, poster_url), poster_data = ?, poster_type = ?
+		WHERE id = ?
+	`
+	result, err := tx.Exec(query, nullableString(poster.URL), data, nullableString(poster.MIMEType), poster.MovieID)
+	if err != nil {
+		return err
+	}
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return fmt.Errorf("movie %d does not exist", poster.MovieID)
+	}
+	return nil
+}
+
+// resolveID returns id, or looks up the row matching key when id is zero
+func resolveID(tx *sql.Tx, query string, id int, key string) (int, error) {
+	if id != 0 {
+		return id, nil
+	}
+	if key == "" {
+		return 0, fmt.Errorf("neither an ID nor a name was given")
+	}
+	if err := tx.QueryRow(query, key).Scan(&id); err != nil {
+		return 0, fmt.Errorf("failed to find %q: %w", key, err)
+	}
+	return id, nil
+}
+
+// nullableID stores a zero ID as NULL, so the database assigns one
+func nullableID(id int) any {
+	if id == 0 {
+		return nil
+	}
+	return id
+}
+
+// nullableString stores an empty string as NULL
+func nullableString(value string) any {
+	if value == "" {
+		return nil
+	}
+	return value
+}
@@ -0,0 +1,127 @@
+package support
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// newFixtureTestDatabase creates a migrated temporary database reading
+// datasets from the suite's fixtures directory
+func newFixtureTestDatabase(t *testing.T) *SQLiteTestDatabase {
+	t.Helper()
+
+	previous := FixturesDir
+	FixturesDir = filepath.Join("..", "fixtures")
+	t.Cleanup(func() { FixturesDir = previous })
+
+	db, err := NewSQLiteTestDatabase("")
+	if err != nil {
+		t.Fatalf("failed to create test database: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Cleanup() })
+	return db
+}
+
+func TestLoadFixtures_Datasets(t *testing.T) {
+	tests := []struct {
+		name   string
+		movies int
+		actors int
+		cast   int
+	}{
+		{name: "movies", movies: 8},
+		{name: "actors", movies: 5, actors: 5, cast: 6},
+		{name: "search_scenarios", movies: 8, actors: 3, cast: 5},
+		{name: "rating_scenarios", movies: 4},
+	}
+
+	db := newFixtureTestDatabase(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := db.CleanupAfterScenario(); err != nil {
+				t.Fatalf("Expected cleanup to succeed, got: %v", err)
+			}
+			if err := db.LoadFixtures(tt.name); err != nil {
+				t.Fatalf("Expected %s to load, got: %v", tt.name, err)
+			}
+
+			for table, expected := range map[string]int{"movies": tt.movies, "actors": tt.actors, "movie_actors": tt.cast} {
+				count, err := db.CountRows(table, "")
+				if err != nil {
+					t.Fatalf("failed to count %s: %v", table, err)
+				}
+				if count != expected {
+					t.Errorf("Expected %d rows in %s, got: %d", expected, table, count)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadFixtures_RejectsInvalidNames(t *testing.T) {
+	db := newFixtureTestDatabase(t)
+
+	for _, name := range []string{"../secrets", "Movies", "movies.yaml", ""} {
+		if err := db.LoadFixtures(name); err == nil || !strings.Contains(err.Error(), "invalid fixture name") {
+			t.Errorf("Expected %q to be rejected, got: %v", name, err)
+		}
+	}
+}
+
+func TestLoadDataset_ResolvesNamesAndRollsBack(t *testing.T) {
+	db := newFixtureTestDatabase(t)
+
+	err := db.LoadDataset(&Fixtures{
+		Movies: []Movie{{Title: "Titanic", Director: "James Cameron", Year: 1997, Genre: "Drama"}},
+		Actors: []Actor{{Name: "Kate Winslet", BirthYear: 1975}},
+		Cast:   []CastLink{{Movie: "Titanic", Actor: "Kate Winslet", Role: "Rose"}},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	movieID, err := db.MovieIDByTitle("Titanic")
+	if err != nil {
+		t.Fatalf("Expected the movie to exist, got: %v", err)
+	}
+	if count, _ := db.CountRows("movie_actors", "movie_id = ? AND role = ?", movieID, "Rose"); count != 1 {
+		t.Errorf("Expected the cast link by title and name, got: %d", count)
+	}
+
+	// A cast link to a missing actor rolls back the movie inserted before it
+	err = db.LoadDataset(&Fixtures{
+		Movies: []Movie{{Title: "Avatar", Director: "James Cameron", Year: 2009}},
+		Cast:   []CastLink{{Movie: "Avatar", Actor: "Nobody"}},
+	})
+	if err == nil {
+		t.Fatal("Expected an error for an unknown actor")
+	}
+	if exists, _ := db.VerifyMovieExists("Avatar", "James Cameron", 2009); exists {
+		t.Error("Expected the failed dataset to be rolled back")
+	}
+}
+
+func TestCleanupAfterScenario_ResetsData(t *testing.T) {
+	db := newFixtureTestDatabase(t)
+
+	if err := db.LoadFixtures("search_scenarios"); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if err := db.CleanupAfterScenario(); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	for _, table := range []string{"movies", "actors", "movie_actors"} {
+		if count, _ := db.CountRows(table, ""); count != 0 {
+			t.Errorf("Expected %s to be empty, got: %d rows", table, count)
+		}
+	}
+
+	// IDs restart, so datasets without explicit IDs are predictable
+	if err := db.LoadDataset(&Fixtures{Movies: []Movie{{Title: "First", Director: "D", Year: 2000}}}); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if id, _ := db.MovieIDByTitle("First"); id != 1 {
+		t.Errorf("Expected ID 1 after cleanup, got: %d", id)
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 
 	_ "modernc.org/sqlite" // SQLite driver
@@ -36,8 +38,25 @@ func NewSQLiteTestDatabase(dbPath string) (*SQLiteTestDatabase, error) {
 		isTemporary = true
 	}
 
-	// Open SQLite database
-	db, err := sql.Open("sqlite", dbPath)
+	testDB, err := openSQLiteTestDatabase(dbPath, isTemporary)
+	if err != nil {
+		return nil, err
+	}
+
+	// Run database migrations
+	if err := testDB.runMigrations(); err != nil {
+		_ = testDB.Cleanup()
+		return nil, fmt.Errorf("failed to run migrations: %w", err)
+	}
+
+	return testDB, nil
+}
+
+// openSQLiteTestDatabase opens the database at dbPath without migrating it;
+// a temporary database is removed if it cannot be opened
+func openSQLiteTestDatabase(dbPath string, isTemporary bool) (*SQLiteTestDatabase, error) {
+	// Open SQLite database; the pragma applies to every pooled connection
+	db, err := sql.Open("sqlite", dbPath+"?_pragma=foreign_keys(1)")
 	if err != nil {
 		if isTemporary {
 			_ = os.Remove(dbPath)
@@ -54,29 +73,12 @@ func NewSQLiteTestDatabase(dbPath string) (*SQLiteTestDatabase, error) {
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
-	// Enable foreign keys for SQLite
-	if _, err := db.Exec("PRAGMA foreign_keys = ON"); err != nil {
-		_ = db.Close()
-		if isTemporary {
-			_ = os.Remove(dbPath)
-		}
-		return nil, fmt.Errorf("failed to enable foreign keys: %w", err)
-	}
-
-	testDB := &SQLiteTestDatabase{
+	return &SQLiteTestDatabase{
 		db:          db,
 		dbPath:      dbPath,
 		fixtures:    make(map[string][]interface{}),
 		isTemporary: isTemporary,
-	}
-
-	// Run database migrations
-	if err := testDB.runMigrations(); err != nil {
-		_ = testDB.Cleanup()
-		return nil, fmt.Errorf("failed to run migrations: %w", err)
-	}
-
-	return testDB, nil
+	}, nil
 }
 
 // runMigrations applies database migrations to the test database
@@ -153,20 +155,21 @@ func (tdb *SQLiteTestDatabase) runTernMigrations(migrationsDir string) error {
 	return nil
 }
 
-// runManualMigrations runs migrations by executing SQL files directly
+// runManualMigrations applies the up migrations in order and records them
+// in schema_migrations, as tools/migrate does, so the server started on this
+// database sees it as up to date
 func (tdb *SQLiteTestDatabase) runManualMigrations(migrationsDir string) error {
-	// Read migration files
-	files, err := os.ReadDir(migrationsDir)
+	sqlFiles, err := filepath.Glob(filepath.Join(migrationsDir, "*.up.sql"))
 	if err != nil {
 		return fmt.Errorf("failed to read migrations directory: %w", err)
 	}
+	sort.Strings(sqlFiles)
 
-	// Sort and execute .sql files
-	var sqlFiles []string
-	for _, file := range files {
-		if !file.IsDir() && strings.HasSuffix(file.Name(), ".sql") {
-			sqlFiles = append(sqlFiles, filepath.Join(migrationsDir, file.Name()))
-		}
+	if _, err := tdb.db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY,
+		applied_at TEXT DEFAULT CURRENT_TIMESTAMP
+	)`); err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
 	}
 
 	for _, sqlFile := range sqlFiles {
@@ -178,57 +181,87 @@ func (tdb *SQLiteTestDatabase) runManualMigrations(migrationsDir string) error {
 	return nil
 }
 
-// executeMigrationFile executes a single migration SQL file
+// executeMigrationFile executes a single migration SQL file and records its
+// version
 func (tdb *SQLiteTestDatabase) executeMigrationFile(filePath string) error {
 	content, err := os.ReadFile(filepath.Clean(filePath))
 	if err != nil {
 		return fmt.Errorf("failed to read migration file: %w", err)
 	}
 
-	// Split by semicolons and execute each statement
-	statements := strings.Split(string(content), ";")
-	for _, stmt := range statements {
-		stmt = strings.TrimSpace(stmt)
-		if stmt == "" {
-			continue
-		}
+	version, err := strconv.Atoi(strings.SplitN(filepath.Base(filePath), "_", 2)[0])
+	if err != nil {
+		return fmt.Errorf("migration file has no version prefix: %w", err)
+	}
 
-		if _, err := tdb.db.Exec(stmt); err != nil {
-			return fmt.Errorf("failed to execute statement: %w\nStatement: %s", err, stmt)
-		}
+	// The file runs as one script: splitting on semicolons would break
+	// trigger bodies
+	if _, err := tdb.db.Exec(string(content)); err != nil {
+		return fmt.Errorf("failed to execute migration: %w", err)
+	}
+	if _, err := tdb.db.Exec("INSERT INTO schema_migrations (version) VALUES (?)", version); err != nil {
+		return fmt.Errorf("failed to record migration: %w", err)
 	}
 
 	return nil
 }
 
-// LoadFixtures loads test data from a YAML fixture file
+// fixtureTables are the tables scenarios write to, children before parents
+var fixtureTables = []string{
+	"movie_history",
+	"movie_media",
+	"movie_translations",
+	"movie_availability",
+	"franchise_movies",
+	"franchises",
+	"movie_actors",
+	"actors",
+	"movies",
+}
+
+// LoadFixtures loads the named YAML dataset from the fixtures directory
 func (tdb *SQLiteTestDatabase) LoadFixtures(fixtureName string) error {
 	inserter := NewDatabaseFixtureInserter(tdb.db)
 	return LoadFixturesFromFile(fixtureName, inserter)
 }
 
-// CleanupAfterScenario cleans up test data after each scenario
+// LoadDataset loads a dataset built by a step, all or nothing
+func (tdb *SQLiteTestDatabase) LoadDataset(fixtures *Fixtures) error {
+	return NewDatabaseFixtureInserter(tdb.db).InsertFixtures(fixtures)
+}
+
+// CleanupAfterScenario truncates every table scenarios write to, leaving the
+// schema and migration records in place
 func (tdb *SQLiteTestDatabase) CleanupAfterScenario() error {
-	// Delete all data from test tables in reverse dependency order
-	tables := []string{
-		"movie_actors", // Junction table first
-		"actors",
-		"movies",
+	tx, err := tdb.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin cleanup: %w", err)
 	}
+	defer func() { _ = tx.Rollback() }()
 
-	for _, table := range tables {
-		query := fmt.Sprintf("DELETE FROM %s", table)
-		if _, err := tdb.db.Exec(query); err != nil {
+	for _, table := range fixtureTables {
+		// #nosec G201 - table names come from the fixed list above
+		if _, err := tx.Exec(fmt.Sprintf("DELETE FROM %s", table)); err != nil {
 			return fmt.Errorf("failed to clean table %s: %w", table, err)
 		}
 	}
 
-	// Reset auto-increment counters (SQLite specific)
-	if _, err := tdb.db.Exec("DELETE FROM sqlite_sequence"); err != nil {
-		// Ignore error if table doesn't exist
+	// Reset auto-increment counters so IDs are predictable per scenario
+	if _, err := tx.Exec("DELETE FROM sqlite_sequence"); err != nil {
+		return fmt.Errorf("failed to reset sequences: %w", err)
 	}
 
-	return nil
+	return tx.Commit()
+}
+
+// MovieIDByTitle returns the ID of the first movie with title
+func (tdb *SQLiteTestDatabase) MovieIDByTitle(title string) (int, error) {
+	var id int
+	err := tdb.db.QueryRow("SELECT id FROM movies WHERE title = ? ORDER BY id LIMIT 1", title).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find movie %q: %w", title, err)
+	}
+	return id, nil
 }
 
 // Cleanup closes the database and removes temporary files
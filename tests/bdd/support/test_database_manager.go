@@ -0,0 +1,147 @@
+package support
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// TestDatabaseManager hands each scenario a database of its own. The
+// migrations run once, into a template database, and every scenario gets a
+// copy of it in a temporary file that is deleted when the scenario ends.
+// Nothing a scenario writes can be seen by another, so scenarios may run
+// in parallel, each against its own server process.
+type TestDatabaseManager struct {
+	dir string
+
+	templateOnce sync.Once
+	templatePath string
+	templateErr  error
+
+	mu        sync.Mutex
+	next      int
+	scenarios map[string]*SQLiteTestDatabase
+	closed    bool
+}
+
+// NewTestDatabaseManager creates a manager keeping its databases in a new
+// temporary directory
+func NewTestDatabaseManager() (*TestDatabaseManager, error) {
+	dir, err := os.MkdirTemp("", "movies_mcp_bdd_*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create test database directory: %w", err)
+	}
+	return &TestDatabaseManager{
+		dir:       dir,
+		scenarios: make(map[string]*SQLiteTestDatabase),
+	}, nil
+}
+
+// Open returns a freshly migrated, empty database for one scenario. It is
+// a copy of the template, so only the first call pays for the migrations.
+func (m *TestDatabaseManager) Open() (*SQLiteTestDatabase, error) {
+	m.templateOnce.Do(m.createTemplate)
+	if m.templateErr != nil {
+		return nil, m.templateErr
+	}
+
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return nil, fmt.Errorf("test database manager is closed")
+	}
+	m.next++
+	path := filepath.Join(m.dir, fmt.Sprintf("scenario_%d.db", m.next))
+	m.mu.Unlock()
+
+	if err := copyFile(m.templatePath, path); err != nil {
+		_ = os.Remove(path)
+		return nil, fmt.Errorf("failed to copy template database: %w", err)
+	}
+	testDB, err := openSQLiteTestDatabase(path, true)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	m.scenarios[path] = testDB
+	m.mu.Unlock()
+	return testDB, nil
+}
+
+// Release closes a scenario's database and deletes it, discarding
+// everything the scenario wrote
+func (m *TestDatabaseManager) Release(testDB *SQLiteTestDatabase) error {
+	m.mu.Lock()
+	delete(m.scenarios, testDB.GetDBPath())
+	m.mu.Unlock()
+
+	// The server may leave its journal files behind the database
+	for _, suffix := range []string{"-wal", "-shm", "-journal"} {
+		_ = os.Remove(testDB.GetDBPath() + suffix)
+	}
+	return testDB.Cleanup()
+}
+
+// Close releases any databases still open and removes the template
+func (m *TestDatabaseManager) Close() error {
+	m.mu.Lock()
+	m.closed = true
+	open := make([]*SQLiteTestDatabase, 0, len(m.scenarios))
+	for _, testDB := range m.scenarios {
+		open = append(open, testDB)
+	}
+	m.mu.Unlock()
+
+	var errors []error
+	for _, testDB := range open {
+		if err := m.Release(testDB); err != nil {
+			errors = append(errors, err)
+		}
+	}
+	if err := os.RemoveAll(m.dir); err != nil {
+		errors = append(errors, fmt.Errorf("failed to remove test databases: %w", err))
+	}
+
+	if len(errors) > 0 {
+		return fmt.Errorf("cleanup errors: %v", errors)
+	}
+	return nil
+}
+
+// createTemplate migrates the database scenarios are copied from
+func (m *TestDatabaseManager) createTemplate() {
+	path := filepath.Join(m.dir, "template.db")
+	template, err := NewSQLiteTestDatabase(path)
+	if err != nil {
+		m.templateErr = fmt.Errorf("failed to create template database: %w", err)
+		return
+	}
+	// Closing leaves every migration in the file itself
+	if err := template.Cleanup(); err != nil {
+		m.templateErr = fmt.Errorf("failed to close template database: %w", err)
+		return
+	}
+	m.templatePath = path
+}
+
+// copyFile copies src to a new file at dst
+func copyFile(src, dst string) error {
+	in, err := os.Open(filepath.Clean(src))
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(filepath.Clean(dst), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
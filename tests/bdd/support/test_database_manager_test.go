@@ -0,0 +1,114 @@
+package support
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func newTestDatabaseManager(t *testing.T) *TestDatabaseManager {
+	t.Helper()
+
+	previous := FixturesDir
+	FixturesDir = filepath.Join("..", "fixtures")
+	t.Cleanup(func() { FixturesDir = previous })
+
+	manager, err := NewTestDatabaseManager()
+	if err != nil {
+		t.Fatalf("failed to create manager: %v", err)
+	}
+	t.Cleanup(func() { _ = manager.Close() })
+	return manager
+}
+
+func TestTestDatabaseManager_IsolatesScenarios(t *testing.T) {
+	manager := newTestDatabaseManager(t)
+
+	first, err := manager.Open()
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	if err := first.VerifySchemaExists(); err != nil {
+		t.Fatalf("Expected a migrated database, got: %v", err)
+	}
+	if err := first.LoadFixtures("movies"); err != nil {
+		t.Fatalf("Expected the fixture to load, got: %v", err)
+	}
+
+	second, err := manager.Open()
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	if first.GetDBPath() == second.GetDBPath() {
+		t.Fatal("Expected each scenario to get its own file")
+	}
+	if count, _ := second.CountRows("movies", ""); count != 0 {
+		t.Errorf("Expected the second scenario to see no movies, got: %d", count)
+	}
+
+	// Releasing discards the scenario's writes with its file
+	if err := manager.Release(first); err != nil {
+		t.Fatalf("Release() error = %v", err)
+	}
+	if _, err := os.Stat(first.GetDBPath()); !os.IsNotExist(err) {
+		t.Errorf("Expected the released database to be deleted, got: %v", err)
+	}
+	third, err := manager.Open()
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	if count, _ := third.CountRows("movies", ""); count != 0 {
+		t.Errorf("Expected a later scenario to start empty, got: %d movies", count)
+	}
+}
+
+func TestTestDatabaseManager_ParallelScenarios(t *testing.T) {
+	manager := newTestDatabaseManager(t)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			testDB, err := manager.Open()
+			if err != nil {
+				errs <- err
+				return
+			}
+			defer manager.Release(testDB)
+			if err := testDB.LoadFixtures("rating_scenarios"); err != nil {
+				errs <- err
+				return
+			}
+			if count, err := testDB.CountRows("movies", ""); err != nil || count != 4 {
+				errs <- fmt.Errorf("%d movies (error %v)", count, err)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Errorf("Expected every scenario to see only its own 4 movies, got: %v", err)
+	}
+}
+
+func TestTestDatabaseManager_Close(t *testing.T) {
+	manager := newTestDatabaseManager(t)
+
+	testDB, err := manager.Open()
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	if err := manager.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Dir(testDB.GetDBPath())); !os.IsNotExist(err) {
+		t.Errorf("Expected the database directory to be removed, got: %v", err)
+	}
+	if _, err := manager.Open(); err == nil {
+		t.Error("Expected Open to fail after Close")
+	}
+}
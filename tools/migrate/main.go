@@ -43,6 +43,15 @@ func main() {
 	// Parse SQLite URL (format: sqlite://path/to/db.db)
 	dsn := strings.TrimPrefix(dbURL, "sqlite://")
 
+	// Wait for the lock rather than failing if a running server is writing
+	if !strings.Contains(dsn, "busy_timeout") {
+		separator := "?"
+		if strings.Contains(dsn, "?") {
+			separator = "&"
+		}
+		dsn += separator + "_pragma=busy_timeout(5000)"
+	}
+
 	// Connect to SQLite database
 	db, err := sql.Open("sqlite", dsn)
 	if err != nil {